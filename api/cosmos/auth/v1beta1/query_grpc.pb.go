@@ -29,6 +29,7 @@ const (
 	Query_AddressBytesToString_FullMethodName = "/cosmos.auth.v1beta1.Query/AddressBytesToString"
 	Query_AddressStringToBytes_FullMethodName = "/cosmos.auth.v1beta1.Query/AddressStringToBytes"
 	Query_AccountInfo_FullMethodName          = "/cosmos.auth.v1beta1.Query/AccountInfo"
+	Query_AccountAuthenticator_FullMethodName = "/cosmos.auth.v1beta1.Query/AccountAuthenticator"
 )
 
 // QueryClient is the client API for Query service.
@@ -72,6 +73,10 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error)
+	// AccountAuthenticator queries the authenticator of an account.
+	//
+	// Since: cosmos-sdk 0.50
+	AccountAuthenticator(ctx context.Context, in *QueryAccountAuthenticatorRequest, opts ...grpc.CallOption) (*QueryAccountAuthenticatorResponse, error)
 }
 
 type queryClient struct {
@@ -172,6 +177,15 @@ func (c *queryClient) AccountInfo(ctx context.Context, in *QueryAccountInfoReque
 	return out, nil
 }
 
+func (c *queryClient) AccountAuthenticator(ctx context.Context, in *QueryAccountAuthenticatorRequest, opts ...grpc.CallOption) (*QueryAccountAuthenticatorResponse, error) {
+	out := new(QueryAccountAuthenticatorResponse)
+	err := c.cc.Invoke(ctx, Query_AccountAuthenticator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -213,6 +227,10 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error)
+	// AccountAuthenticator queries the authenticator of an account.
+	//
+	// Since: cosmos-sdk 0.50
+	AccountAuthenticator(context.Context, *QueryAccountAuthenticatorRequest) (*QueryAccountAuthenticatorResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -250,6 +268,9 @@ func (UnimplementedQueryServer) AddressStringToBytes(context.Context, *AddressSt
 func (UnimplementedQueryServer) AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
 }
+func (UnimplementedQueryServer) AccountAuthenticator(context.Context, *QueryAccountAuthenticatorRequest) (*QueryAccountAuthenticatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountAuthenticator not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -443,6 +464,24 @@ func _Query_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AccountAuthenticator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountAuthenticatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountAuthenticator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountAuthenticator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountAuthenticator(ctx, req.(*QueryAccountAuthenticatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -490,6 +529,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AccountInfo",
 			Handler:    _Query_AccountInfo_Handler,
 		},
+		{
+			MethodName: "AccountAuthenticator",
+			Handler:    _Query_AccountAuthenticator_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
@@ -19,7 +19,8 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName = "/cosmos.auth.v1beta1.Msg/UpdateParams"
+	Msg_UpdateParams_FullMethodName     = "/cosmos.auth.v1beta1.Msg/UpdateParams"
+	Msg_SetAuthenticator_FullMethodName = "/cosmos.auth.v1beta1.Msg/SetAuthenticator"
 )
 
 // MsgClient is the client API for Msg service.
@@ -31,6 +32,12 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetAuthenticator defines a method for designating the authenticator which
+	// validates the txs of an account, instead of the signature verification
+	// with the account pubkey.
+	//
+	// Since: cosmos-sdk 0.50
+	SetAuthenticator(ctx context.Context, in *MsgSetAuthenticator, opts ...grpc.CallOption) (*MsgSetAuthenticatorResponse, error)
 }
 
 type msgClient struct {
@@ -50,6 +57,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetAuthenticator(ctx context.Context, in *MsgSetAuthenticator, opts ...grpc.CallOption) (*MsgSetAuthenticatorResponse, error) {
+	out := new(MsgSetAuthenticatorResponse)
+	err := c.cc.Invoke(ctx, Msg_SetAuthenticator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -59,6 +75,12 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetAuthenticator defines a method for designating the authenticator which
+	// validates the txs of an account, instead of the signature verification
+	// with the account pubkey.
+	//
+	// Since: cosmos-sdk 0.50
+	SetAuthenticator(context.Context, *MsgSetAuthenticator) (*MsgSetAuthenticatorResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -69,6 +91,9 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) SetAuthenticator(context.Context, *MsgSetAuthenticator) (*MsgSetAuthenticatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAuthenticator not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -100,6 +125,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAuthenticator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAuthenticator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAuthenticator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetAuthenticator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAuthenticator(ctx, req.(*MsgSetAuthenticator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -111,6 +154,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetAuthenticator",
+			Handler:    _Msg_SetAuthenticator_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/tx.proto",
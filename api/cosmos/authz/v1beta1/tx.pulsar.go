@@ -626,27 +626,601 @@ func (x *_MsgExecResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_MsgExecResponse_2_list)(nil)
+
+type _MsgExecResponse_2_list struct {
+	list *[]*MsgExecResult
+}
+
+func (x *_MsgExecResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgExecResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgExecResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgExecResult)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgExecResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgExecResult)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgExecResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(MsgExecResult)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgExecResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgExecResponse_2_list) NewElement() protoreflect.Value {
+	v := new(MsgExecResult)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgExecResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgExecResponse             protoreflect.MessageDescriptor
+	fd_MsgExecResponse_results     protoreflect.FieldDescriptor
+	fd_MsgExecResponse_msg_results protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_authz_v1beta1_tx_proto_init()
+	md_MsgExecResponse = File_cosmos_authz_v1beta1_tx_proto.Messages().ByName("MsgExecResponse")
+	fd_MsgExecResponse_results = md_MsgExecResponse.Fields().ByName("results")
+	fd_MsgExecResponse_msg_results = md_MsgExecResponse.Fields().ByName("msg_results")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgExecResponse)(nil)
+
+type fastReflection_MsgExecResponse MsgExecResponse
+
+func (x *MsgExecResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgExecResponse)(x)
+}
+
+func (x *MsgExecResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_authz_v1beta1_tx_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgExecResponse_messageType fastReflection_MsgExecResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgExecResponse_messageType{}
+
+type fastReflection_MsgExecResponse_messageType struct{}
+
+func (x fastReflection_MsgExecResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgExecResponse)(nil)
+}
+func (x fastReflection_MsgExecResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgExecResponse)
+}
+func (x fastReflection_MsgExecResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgExecResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgExecResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgExecResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgExecResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgExecResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgExecResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgExecResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgExecResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgExecResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgExecResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Results) != 0 {
+		value := protoreflect.ValueOfList(&_MsgExecResponse_1_list{list: &x.Results})
+		if !f(fd_MsgExecResponse_results, value) {
+			return
+		}
+	}
+	if len(x.MsgResults) != 0 {
+		value := protoreflect.ValueOfList(&_MsgExecResponse_2_list{list: &x.MsgResults})
+		if !f(fd_MsgExecResponse_msg_results, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgExecResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.authz.v1beta1.MsgExecResponse.results":
+		return len(x.Results) != 0
+	case "cosmos.authz.v1beta1.MsgExecResponse.msg_results":
+		return len(x.MsgResults) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.MsgExecResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.authz.v1beta1.MsgExecResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgExecResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.authz.v1beta1.MsgExecResponse.results":
+		x.Results = nil
+	case "cosmos.authz.v1beta1.MsgExecResponse.msg_results":
+		x.MsgResults = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.MsgExecResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.authz.v1beta1.MsgExecResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgExecResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.authz.v1beta1.MsgExecResponse.results":
+		if len(x.Results) == 0 {
+			return protoreflect.ValueOfList(&_MsgExecResponse_1_list{})
+		}
+		listValue := &_MsgExecResponse_1_list{list: &x.Results}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.authz.v1beta1.MsgExecResponse.msg_results":
+		if len(x.MsgResults) == 0 {
+			return protoreflect.ValueOfList(&_MsgExecResponse_2_list{})
+		}
+		listValue := &_MsgExecResponse_2_list{list: &x.MsgResults}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.MsgExecResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.authz.v1beta1.MsgExecResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgExecResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.authz.v1beta1.MsgExecResponse.results":
+		lv := value.List()
+		clv := lv.(*_MsgExecResponse_1_list)
+		x.Results = *clv.list
+	case "cosmos.authz.v1beta1.MsgExecResponse.msg_results":
+		lv := value.List()
+		clv := lv.(*_MsgExecResponse_2_list)
+		x.MsgResults = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.MsgExecResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.authz.v1beta1.MsgExecResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgExecResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.authz.v1beta1.MsgExecResponse.results":
+		if x.Results == nil {
+			x.Results = [][]byte{}
+		}
+		value := &_MsgExecResponse_1_list{list: &x.Results}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.authz.v1beta1.MsgExecResponse.msg_results":
+		if x.MsgResults == nil {
+			x.MsgResults = []*MsgExecResult{}
+		}
+		value := &_MsgExecResponse_2_list{list: &x.MsgResults}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.MsgExecResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.authz.v1beta1.MsgExecResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgExecResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.authz.v1beta1.MsgExecResponse.results":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_MsgExecResponse_1_list{list: &list})
+	case "cosmos.authz.v1beta1.MsgExecResponse.msg_results":
+		list := []*MsgExecResult{}
+		return protoreflect.ValueOfList(&_MsgExecResponse_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.MsgExecResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.authz.v1beta1.MsgExecResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgExecResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.authz.v1beta1.MsgExecResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgExecResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgExecResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgExecResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgExecResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgExecResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Results) > 0 {
+			for _, b := range x.Results {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MsgResults) > 0 {
+			for _, e := range x.MsgResults {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgExecResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MsgResults) > 0 {
+			for iNdEx := len(x.MsgResults) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MsgResults[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Results) > 0 {
+			for iNdEx := len(x.Results) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Results[iNdEx])
+				copy(dAtA[i:], x.Results[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Results[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgExecResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Results = append(x.Results, make([]byte, postIndex-iNdEx))
+				copy(x.Results[len(x.Results)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgResults", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgResults = append(x.MsgResults, &MsgExecResult{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MsgResults[len(x.MsgResults)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 var (
-	md_MsgExecResponse         protoreflect.MessageDescriptor
-	fd_MsgExecResponse_results protoreflect.FieldDescriptor
+	md_MsgExecResult         protoreflect.MessageDescriptor
+	fd_MsgExecResult_success protoreflect.FieldDescriptor
+	fd_MsgExecResult_data    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_authz_v1beta1_tx_proto_init()
-	md_MsgExecResponse = File_cosmos_authz_v1beta1_tx_proto.Messages().ByName("MsgExecResponse")
-	fd_MsgExecResponse_results = md_MsgExecResponse.Fields().ByName("results")
+	md_MsgExecResult = File_cosmos_authz_v1beta1_tx_proto.Messages().ByName("MsgExecResult")
+	fd_MsgExecResult_success = md_MsgExecResult.Fields().ByName("success")
+	fd_MsgExecResult_data = md_MsgExecResult.Fields().ByName("data")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgExecResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgExecResult)(nil)
 
-type fastReflection_MsgExecResponse MsgExecResponse
+type fastReflection_MsgExecResult MsgExecResult
 
-func (x *MsgExecResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgExecResponse)(x)
+func (x *MsgExecResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgExecResult)(x)
 }
 
-func (x *MsgExecResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_authz_v1beta1_tx_proto_msgTypes[1]
+func (x *MsgExecResult) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_authz_v1beta1_tx_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -657,43 +1231,43 @@ func (x *MsgExecResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgExecResponse_messageType fastReflection_MsgExecResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgExecResponse_messageType{}
+var _fastReflection_MsgExecResult_messageType fastReflection_MsgExecResult_messageType
+var _ protoreflect.MessageType = fastReflection_MsgExecResult_messageType{}
 
-type fastReflection_MsgExecResponse_messageType struct{}
+type fastReflection_MsgExecResult_messageType struct{}
 
-func (x fastReflection_MsgExecResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgExecResponse)(nil)
+func (x fastReflection_MsgExecResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgExecResult)(nil)
 }
-func (x fastReflection_MsgExecResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgExecResponse)
+func (x fastReflection_MsgExecResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgExecResult)
 }
-func (x fastReflection_MsgExecResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgExecResponse
+func (x fastReflection_MsgExecResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgExecResult
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgExecResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgExecResponse
+func (x *fastReflection_MsgExecResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgExecResult
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgExecResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgExecResponse_messageType
+func (x *fastReflection_MsgExecResult) Type() protoreflect.MessageType {
+	return _fastReflection_MsgExecResult_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgExecResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgExecResponse)
+func (x *fastReflection_MsgExecResult) New() protoreflect.Message {
+	return new(fastReflection_MsgExecResult)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgExecResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgExecResponse)(x)
+func (x *fastReflection_MsgExecResult) Interface() protoreflect.ProtoMessage {
+	return (*MsgExecResult)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -701,10 +1275,16 @@ func (x *fastReflection_MsgExecResponse) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgExecResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Results) != 0 {
-		value := protoreflect.ValueOfList(&_MsgExecResponse_1_list{list: &x.Results})
-		if !f(fd_MsgExecResponse_results, value) {
+func (x *fastReflection_MsgExecResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Success != false {
+		value := protoreflect.ValueOfBool(x.Success)
+		if !f(fd_MsgExecResult_success, value) {
+			return
+		}
+	}
+	if len(x.Data) != 0 {
+		value := protoreflect.ValueOfBytes(x.Data)
+		if !f(fd_MsgExecResult_data, value) {
 			return
 		}
 	}
@@ -721,15 +1301,17 @@ func (x *fastReflection_MsgExecResponse) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgExecResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgExecResult) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.authz.v1beta1.MsgExecResponse.results":
-		return len(x.Results) != 0
+	case "cosmos.authz.v1beta1.MsgExecResult.success":
+		return x.Success != false
+	case "cosmos.authz.v1beta1.MsgExecResult.data":
+		return len(x.Data) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.MsgExecResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.MsgExecResult"))
 		}
-		panic(fmt.Errorf("message cosmos.authz.v1beta1.MsgExecResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.authz.v1beta1.MsgExecResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -739,15 +1321,17 @@ func (x *fastReflection_MsgExecResponse) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExecResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgExecResult) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.authz.v1beta1.MsgExecResponse.results":
-		x.Results = nil
+	case "cosmos.authz.v1beta1.MsgExecResult.success":
+		x.Success = false
+	case "cosmos.authz.v1beta1.MsgExecResult.data":
+		x.Data = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.MsgExecResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.MsgExecResult"))
 		}
-		panic(fmt.Errorf("message cosmos.authz.v1beta1.MsgExecResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.authz.v1beta1.MsgExecResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -757,19 +1341,19 @@ func (x *fastReflection_MsgExecResponse) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgExecResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgExecResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.authz.v1beta1.MsgExecResponse.results":
-		if len(x.Results) == 0 {
-			return protoreflect.ValueOfList(&_MsgExecResponse_1_list{})
-		}
-		listValue := &_MsgExecResponse_1_list{list: &x.Results}
-		return protoreflect.ValueOfList(listValue)
+	case "cosmos.authz.v1beta1.MsgExecResult.success":
+		value := x.Success
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.authz.v1beta1.MsgExecResult.data":
+		value := x.Data
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.MsgExecResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.MsgExecResult"))
 		}
-		panic(fmt.Errorf("message cosmos.authz.v1beta1.MsgExecResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.authz.v1beta1.MsgExecResult does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -783,17 +1367,17 @@ func (x *fastReflection_MsgExecResponse) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExecResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgExecResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.authz.v1beta1.MsgExecResponse.results":
-		lv := value.List()
-		clv := lv.(*_MsgExecResponse_1_list)
-		x.Results = *clv.list
+	case "cosmos.authz.v1beta1.MsgExecResult.success":
+		x.Success = value.Bool()
+	case "cosmos.authz.v1beta1.MsgExecResult.data":
+		x.Data = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.MsgExecResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.MsgExecResult"))
 		}
-		panic(fmt.Errorf("message cosmos.authz.v1beta1.MsgExecResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.authz.v1beta1.MsgExecResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -807,45 +1391,44 @@ func (x *fastReflection_MsgExecResponse) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExecResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgExecResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.authz.v1beta1.MsgExecResponse.results":
-		if x.Results == nil {
-			x.Results = [][]byte{}
-		}
-		value := &_MsgExecResponse_1_list{list: &x.Results}
-		return protoreflect.ValueOfList(value)
+	case "cosmos.authz.v1beta1.MsgExecResult.success":
+		panic(fmt.Errorf("field success of message cosmos.authz.v1beta1.MsgExecResult is not mutable"))
+	case "cosmos.authz.v1beta1.MsgExecResult.data":
+		panic(fmt.Errorf("field data of message cosmos.authz.v1beta1.MsgExecResult is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.MsgExecResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.MsgExecResult"))
 		}
-		panic(fmt.Errorf("message cosmos.authz.v1beta1.MsgExecResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.authz.v1beta1.MsgExecResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgExecResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgExecResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.authz.v1beta1.MsgExecResponse.results":
-		list := [][]byte{}
-		return protoreflect.ValueOfList(&_MsgExecResponse_1_list{list: &list})
+	case "cosmos.authz.v1beta1.MsgExecResult.success":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.authz.v1beta1.MsgExecResult.data":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.MsgExecResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.MsgExecResult"))
 		}
-		panic(fmt.Errorf("message cosmos.authz.v1beta1.MsgExecResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.authz.v1beta1.MsgExecResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgExecResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgExecResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.authz.v1beta1.MsgExecResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.authz.v1beta1.MsgExecResult", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -853,7 +1436,7 @@ func (x *fastReflection_MsgExecResponse) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgExecResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgExecResult) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -864,7 +1447,7 @@ func (x *fastReflection_MsgExecResponse) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExecResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgExecResult) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -876,7 +1459,7 @@ func (x *fastReflection_MsgExecResponse) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgExecResponse) IsValid() bool {
+func (x *fastReflection_MsgExecResult) IsValid() bool {
 	return x != nil
 }
 
@@ -886,9 +1469,9 @@ func (x *fastReflection_MsgExecResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgExecResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgExecResult) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgExecResponse)
+		x := input.Message.Interface().(*MsgExecResult)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -900,11 +1483,12 @@ func (x *fastReflection_MsgExecResponse) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.Results) > 0 {
-			for _, b := range x.Results {
-				l = len(b)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.Success {
+			n += 2
+		}
+		l = len(x.Data)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -916,7 +1500,7 @@ func (x *fastReflection_MsgExecResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgExecResponse)
+		x := input.Message.Interface().(*MsgExecResult)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -935,14 +1519,22 @@ func (x *fastReflection_MsgExecResponse) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Results) > 0 {
-			for iNdEx := len(x.Results) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Results[iNdEx])
-				copy(dAtA[i:], x.Results[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Results[iNdEx])))
-				i--
-				dAtA[i] = 0xa
+		if len(x.Data) > 0 {
+			i -= len(x.Data)
+			copy(dAtA[i:], x.Data)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Success {
+			i--
+			if x.Success {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
 			}
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -955,7 +1547,7 @@ func (x *fastReflection_MsgExecResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgExecResponse)
+		x := input.Message.Interface().(*MsgExecResult)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -987,15 +1579,35 @@ func (x *fastReflection_MsgExecResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecResult: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecResult: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Success = bool(v != 0)
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -1022,8 +1634,10 @@ func (x *fastReflection_MsgExecResponse) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Results = append(x.Results, make([]byte, postIndex-iNdEx))
-				copy(x.Results[len(x.Results)-1], dAtA[iNdEx:postIndex])
+				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
+				if x.Data == nil {
+					x.Data = []byte{}
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1112,9 +1726,10 @@ func (x *_MsgExec_2_list) IsValid() bool {
 }
 
 var (
-	md_MsgExec         protoreflect.MessageDescriptor
-	fd_MsgExec_grantee protoreflect.FieldDescriptor
-	fd_MsgExec_msgs    protoreflect.FieldDescriptor
+	md_MsgExec                     protoreflect.MessageDescriptor
+	fd_MsgExec_grantee             protoreflect.FieldDescriptor
+	fd_MsgExec_msgs                protoreflect.FieldDescriptor
+	fd_MsgExec_continue_on_failure protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1122,6 +1737,7 @@ func init() {
 	md_MsgExec = File_cosmos_authz_v1beta1_tx_proto.Messages().ByName("MsgExec")
 	fd_MsgExec_grantee = md_MsgExec.Fields().ByName("grantee")
 	fd_MsgExec_msgs = md_MsgExec.Fields().ByName("msgs")
+	fd_MsgExec_continue_on_failure = md_MsgExec.Fields().ByName("continue_on_failure")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgExec)(nil)
@@ -1133,7 +1749,7 @@ func (x *MsgExec) ProtoReflect() protoreflect.Message {
 }
 
 func (x *MsgExec) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_authz_v1beta1_tx_proto_msgTypes[2]
+	mi := &file_cosmos_authz_v1beta1_tx_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1201,6 +1817,12 @@ func (x *fastReflection_MsgExec) Range(f func(protoreflect.FieldDescriptor, prot
 			return
 		}
 	}
+	if x.ContinueOnFailure != false {
+		value := protoreflect.ValueOfBool(x.ContinueOnFailure)
+		if !f(fd_MsgExec_continue_on_failure, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1220,6 +1842,8 @@ func (x *fastReflection_MsgExec) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Grantee != ""
 	case "cosmos.authz.v1beta1.MsgExec.msgs":
 		return len(x.Msgs) != 0
+	case "cosmos.authz.v1beta1.MsgExec.continue_on_failure":
+		return x.ContinueOnFailure != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.MsgExec"))
@@ -1240,6 +1864,8 @@ func (x *fastReflection_MsgExec) Clear(fd protoreflect.FieldDescriptor) {
 		x.Grantee = ""
 	case "cosmos.authz.v1beta1.MsgExec.msgs":
 		x.Msgs = nil
+	case "cosmos.authz.v1beta1.MsgExec.continue_on_failure":
+		x.ContinueOnFailure = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.MsgExec"))
@@ -1265,6 +1891,9 @@ func (x *fastReflection_MsgExec) Get(descriptor protoreflect.FieldDescriptor) pr
 		}
 		listValue := &_MsgExec_2_list{list: &x.Msgs}
 		return protoreflect.ValueOfList(listValue)
+	case "cosmos.authz.v1beta1.MsgExec.continue_on_failure":
+		value := x.ContinueOnFailure
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.MsgExec"))
@@ -1291,6 +1920,8 @@ func (x *fastReflection_MsgExec) Set(fd protoreflect.FieldDescriptor, value prot
 		lv := value.List()
 		clv := lv.(*_MsgExec_2_list)
 		x.Msgs = *clv.list
+	case "cosmos.authz.v1beta1.MsgExec.continue_on_failure":
+		x.ContinueOnFailure = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.MsgExec"))
@@ -1319,6 +1950,8 @@ func (x *fastReflection_MsgExec) Mutable(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfList(value)
 	case "cosmos.authz.v1beta1.MsgExec.grantee":
 		panic(fmt.Errorf("field grantee of message cosmos.authz.v1beta1.MsgExec is not mutable"))
+	case "cosmos.authz.v1beta1.MsgExec.continue_on_failure":
+		panic(fmt.Errorf("field continue_on_failure of message cosmos.authz.v1beta1.MsgExec is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.MsgExec"))
@@ -1337,6 +1970,8 @@ func (x *fastReflection_MsgExec) NewField(fd protoreflect.FieldDescriptor) proto
 	case "cosmos.authz.v1beta1.MsgExec.msgs":
 		list := []*anypb.Any{}
 		return protoreflect.ValueOfList(&_MsgExec_2_list{list: &list})
+	case "cosmos.authz.v1beta1.MsgExec.continue_on_failure":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.authz.v1beta1.MsgExec"))
@@ -1416,6 +2051,9 @@ func (x *fastReflection_MsgExec) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.ContinueOnFailure {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1445,6 +2083,16 @@ func (x *fastReflection_MsgExec) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.ContinueOnFailure {
+			i--
+			if x.ContinueOnFailure {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
 		if len(x.Msgs) > 0 {
 			for iNdEx := len(x.Msgs) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.Msgs[iNdEx])
@@ -1583,6 +2231,26 @@ func (x *fastReflection_MsgExec) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContinueOnFailure", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.ContinueOnFailure = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1636,7 +2304,7 @@ func (x *MsgGrantResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *MsgGrantResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_authz_v1beta1_tx_proto_msgTypes[3]
+	mi := &file_cosmos_authz_v1beta1_tx_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1998,7 +2666,7 @@ func (x *MsgRevoke) ProtoReflect() protoreflect.Message {
 }
 
 func (x *MsgRevoke) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_authz_v1beta1_tx_proto_msgTypes[4]
+	mi := &file_cosmos_authz_v1beta1_tx_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2540,7 +3208,7 @@ func (x *MsgRevokeResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *MsgRevokeResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_authz_v1beta1_tx_proto_msgTypes[5]
+	mi := &file_cosmos_authz_v1beta1_tx_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2878,8 +3546,6 @@ func (x *fastReflection_MsgRevokeResponse) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Since: cosmos-sdk 0.43
-
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
@@ -2953,6 +3619,11 @@ type MsgExecResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	Results [][]byte `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// msg_results are the results of each message, set when the messages are
+	// executed with continue_on_failure.
+	//
+	// Since: cosmos-sdk 0.50
+	MsgResults []*MsgExecResult `protobuf:"bytes,2,rep,name=msg_results,json=msgResults,proto3" json:"msg_results,omitempty"`
 }
 
 func (x *MsgExecResponse) Reset() {
@@ -2982,6 +3653,61 @@ func (x *MsgExecResponse) GetResults() [][]byte {
 	return nil
 }
 
+func (x *MsgExecResponse) GetMsgResults() []*MsgExecResult {
+	if x != nil {
+		return x.MsgResults
+	}
+	return nil
+}
+
+// MsgExecResult defines the result of the execution of a message of MsgExec.
+//
+// Since: cosmos-sdk 0.50
+type MsgExecResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// success is true if the message was executed successfully.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// data is the data returned by the message handler.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *MsgExecResult) Reset() {
+	*x = MsgExecResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_authz_v1beta1_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgExecResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgExecResult) ProtoMessage() {}
+
+// Deprecated: Use MsgExecResult.ProtoReflect.Descriptor instead.
+func (*MsgExecResult) Descriptor() ([]byte, []int) {
+	return file_cosmos_authz_v1beta1_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgExecResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MsgExecResult) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 // MsgExec attempts to execute the provided messages using
 // authorizations granted to the grantee. Each message should have only
 // one signer corresponding to the granter of the authorization.
@@ -2995,12 +3721,18 @@ type MsgExec struct {
 	// The x/authz will try to find a grant matching (msg.signers[0], grantee, MsgTypeURL(msg))
 	// triple and validate it.
 	Msgs []*anypb.Any `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// continue_on_failure, when set, executes each message independently: the
+	// state changes of a failing message are reverted and the execution
+	// continues with the next message, instead of failing the whole MsgExec.
+	//
+	// Since: cosmos-sdk 0.50
+	ContinueOnFailure bool `protobuf:"varint,3,opt,name=continue_on_failure,json=continueOnFailure,proto3" json:"continue_on_failure,omitempty"`
 }
 
 func (x *MsgExec) Reset() {
 	*x = MsgExec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_authz_v1beta1_tx_proto_msgTypes[2]
+		mi := &file_cosmos_authz_v1beta1_tx_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3014,7 +3746,7 @@ func (*MsgExec) ProtoMessage() {}
 
 // Deprecated: Use MsgExec.ProtoReflect.Descriptor instead.
 func (*MsgExec) Descriptor() ([]byte, []int) {
-	return file_cosmos_authz_v1beta1_tx_proto_rawDescGZIP(), []int{2}
+	return file_cosmos_authz_v1beta1_tx_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *MsgExec) GetGrantee() string {
@@ -3031,6 +3763,13 @@ func (x *MsgExec) GetMsgs() []*anypb.Any {
 	return nil
 }
 
+func (x *MsgExec) GetContinueOnFailure() bool {
+	if x != nil {
+		return x.ContinueOnFailure
+	}
+	return false
+}
+
 // MsgGrantResponse defines the Msg/MsgGrant response type.
 type MsgGrantResponse struct {
 	state         protoimpl.MessageState
@@ -3041,7 +3780,7 @@ type MsgGrantResponse struct {
 func (x *MsgGrantResponse) Reset() {
 	*x = MsgGrantResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_authz_v1beta1_tx_proto_msgTypes[3]
+		mi := &file_cosmos_authz_v1beta1_tx_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3055,7 +3794,7 @@ func (*MsgGrantResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgGrantResponse.ProtoReflect.Descriptor instead.
 func (*MsgGrantResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_authz_v1beta1_tx_proto_rawDescGZIP(), []int{3}
+	return file_cosmos_authz_v1beta1_tx_proto_rawDescGZIP(), []int{4}
 }
 
 // MsgRevoke revokes any authorization with the provided sdk.Msg type on the
@@ -3073,7 +3812,7 @@ type MsgRevoke struct {
 func (x *MsgRevoke) Reset() {
 	*x = MsgRevoke{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_authz_v1beta1_tx_proto_msgTypes[4]
+		mi := &file_cosmos_authz_v1beta1_tx_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3087,7 +3826,7 @@ func (*MsgRevoke) ProtoMessage() {}
 
 // Deprecated: Use MsgRevoke.ProtoReflect.Descriptor instead.
 func (*MsgRevoke) Descriptor() ([]byte, []int) {
-	return file_cosmos_authz_v1beta1_tx_proto_rawDescGZIP(), []int{4}
+	return file_cosmos_authz_v1beta1_tx_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *MsgRevoke) GetGranter() string {
@@ -3121,7 +3860,7 @@ type MsgRevokeResponse struct {
 func (x *MsgRevokeResponse) Reset() {
 	*x = MsgRevokeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_authz_v1beta1_tx_proto_msgTypes[5]
+		mi := &file_cosmos_authz_v1beta1_tx_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3135,7 +3874,7 @@ func (*MsgRevokeResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgRevokeResponse.ProtoReflect.Descriptor instead.
 func (*MsgRevokeResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_authz_v1beta1_tx_proto_rawDescGZIP(), []int{5}
+	return file_cosmos_authz_v1beta1_tx_proto_rawDescGZIP(), []int{6}
 }
 
 var File_cosmos_authz_v1beta1_tx_proto protoreflect.FileDescriptor
@@ -3167,65 +3906,76 @@ var file_cosmos_authz_v1beta1_tx_proto_rawDesc = []byte{
 	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x67, 0x72, 0x61,
 	0x6e, 0x74, 0x3a, 0x24, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72,
 	0x8a, 0xe7, 0xb0, 0x2a, 0x13, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
-	0x4d, 0x73, 0x67, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x22, 0x2b, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x45,
+	0x4d, 0x73, 0x67, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x22, 0x77, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x45,
 	0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72,
 	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xa9, 0x01, 0x0a, 0x07, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65,
-	0x63, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x67, 0x72,
-	0x61, 0x6e, 0x74, 0x65, 0x65, 0x12, 0x45, 0x0a, 0x04, 0x6d, 0x73, 0x67, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x1b, 0xca, 0xb4, 0x2d, 0x17, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x6d, 0x73, 0x67, 0x73, 0x3a, 0x23, 0x82, 0xe7,
-	0xb0, 0x2a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x8a, 0xe7, 0xb0, 0x2a, 0x12, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65,
-	0x63, 0x22, 0x12, 0x0a, 0x10, 0x4d, 0x73, 0x67, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbc, 0x01, 0x0a, 0x09, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76,
-	0x6f, 0x6b, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07,
-	0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74,
-	0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x6d,
-	0x73, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x55, 0x72, 0x6c, 0x3a, 0x25, 0x82,
-	0xe7, 0xb0, 0x2a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x14,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x4d, 0x73, 0x67, 0x52, 0x65,
-	0x76, 0x6f, 0x6b, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xff, 0x01, 0x0a, 0x03, 0x4d, 0x73,
-	0x67, 0x12, 0x4f, 0x0a, 0x05, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x63, 0x6f, 0x73,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x4a, 0x0a, 0x0b, 0x6d, 0x73, 0x67, 0x5f, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x4c, 0x0a, 0x04, 0x45, 0x78, 0x65, 0x63, 0x12, 0x1d, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x52, 0x0a, 0x06, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x1a, 0x27, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xcd, 0x01, 0x0a, 0x18,
-	0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x32, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x61, 0x75, 0x74,
-	0x68, 0x7a, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x61, 0x75, 0x74, 0x68, 0x7a,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x41, 0x58, 0xaa, 0x02, 0x14,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x56, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x14, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x75,
-	0x74, 0x68, 0x7a, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x20, 0x43, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x16, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x3a, 0x3a,
-	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xc8, 0xe1, 0x1e, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x6d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x22, 0x3d, 0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0xd9, 0x01, 0x0a, 0x07, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x12, 0x32, 0x0a, 0x07,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65,
+	0x12, 0x45, 0x0a, 0x04, 0x6d, 0x73, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x41, 0x6e, 0x79, 0x42, 0x1b, 0xca, 0xb4, 0x2d, 0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x52, 0x04, 0x6d, 0x73, 0x67, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x74, 0x69,
+	0x6e, 0x75, 0x65, 0x5f, 0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x4f, 0x6e,
+	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x3a, 0x23, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x67, 0x72,
+	0x61, 0x6e, 0x74, 0x65, 0x65, 0x8a, 0xe7, 0xb0, 0x2a, 0x12, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x22, 0x12, 0x0a, 0x10,
+	0x4d, 0x73, 0x67, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xbc, 0x01, 0x0a, 0x09, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x12, 0x32,
+	0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x65, 0x72, 0x12, 0x32, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x67,
+	0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x73,
+	0x67, 0x54, 0x79, 0x70, 0x65, 0x55, 0x72, 0x6c, 0x3a, 0x25, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x67,
+	0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x22,
+	0x13, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x32, 0xff, 0x01, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x4f, 0x0a, 0x05,
+	0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x47, 0x72, 0x61, 0x6e, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a,
+	0x04, 0x45, 0x78, 0x65, 0x63, 0x12, 0x1d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x45, 0x78, 0x65, 0x63, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45,
+	0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x06, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a,
+	0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xcd, 0x01, 0xc8, 0xe1, 0x1e, 0x00, 0x0a, 0x18, 0x63,
+	0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x32, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x61, 0x75, 0x74, 0x68,
+	0x7a, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x41, 0x58, 0xaa, 0x02, 0x14, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x56, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0xca, 0x02, 0x14, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x75, 0x74,
+	0x68, 0x7a, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x20, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x16,
+	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x3a, 0x3a, 0x56,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3240,31 +3990,33 @@ func file_cosmos_authz_v1beta1_tx_proto_rawDescGZIP() []byte {
 	return file_cosmos_authz_v1beta1_tx_proto_rawDescData
 }
 
-var file_cosmos_authz_v1beta1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_cosmos_authz_v1beta1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_cosmos_authz_v1beta1_tx_proto_goTypes = []interface{}{
 	(*MsgGrant)(nil),          // 0: cosmos.authz.v1beta1.MsgGrant
 	(*MsgExecResponse)(nil),   // 1: cosmos.authz.v1beta1.MsgExecResponse
-	(*MsgExec)(nil),           // 2: cosmos.authz.v1beta1.MsgExec
-	(*MsgGrantResponse)(nil),  // 3: cosmos.authz.v1beta1.MsgGrantResponse
-	(*MsgRevoke)(nil),         // 4: cosmos.authz.v1beta1.MsgRevoke
-	(*MsgRevokeResponse)(nil), // 5: cosmos.authz.v1beta1.MsgRevokeResponse
-	(*Grant)(nil),             // 6: cosmos.authz.v1beta1.Grant
-	(*anypb.Any)(nil),         // 7: google.protobuf.Any
+	(*MsgExecResult)(nil),     // 2: cosmos.authz.v1beta1.MsgExecResult
+	(*MsgExec)(nil),           // 3: cosmos.authz.v1beta1.MsgExec
+	(*MsgGrantResponse)(nil),  // 4: cosmos.authz.v1beta1.MsgGrantResponse
+	(*MsgRevoke)(nil),         // 5: cosmos.authz.v1beta1.MsgRevoke
+	(*MsgRevokeResponse)(nil), // 6: cosmos.authz.v1beta1.MsgRevokeResponse
+	(*Grant)(nil),             // 7: cosmos.authz.v1beta1.Grant
+	(*anypb.Any)(nil),         // 8: google.protobuf.Any
 }
 var file_cosmos_authz_v1beta1_tx_proto_depIdxs = []int32{
-	6, // 0: cosmos.authz.v1beta1.MsgGrant.grant:type_name -> cosmos.authz.v1beta1.Grant
-	7, // 1: cosmos.authz.v1beta1.MsgExec.msgs:type_name -> google.protobuf.Any
-	0, // 2: cosmos.authz.v1beta1.Msg.Grant:input_type -> cosmos.authz.v1beta1.MsgGrant
-	2, // 3: cosmos.authz.v1beta1.Msg.Exec:input_type -> cosmos.authz.v1beta1.MsgExec
-	4, // 4: cosmos.authz.v1beta1.Msg.Revoke:input_type -> cosmos.authz.v1beta1.MsgRevoke
-	3, // 5: cosmos.authz.v1beta1.Msg.Grant:output_type -> cosmos.authz.v1beta1.MsgGrantResponse
-	1, // 6: cosmos.authz.v1beta1.Msg.Exec:output_type -> cosmos.authz.v1beta1.MsgExecResponse
-	5, // 7: cosmos.authz.v1beta1.Msg.Revoke:output_type -> cosmos.authz.v1beta1.MsgRevokeResponse
-	5, // [5:8] is the sub-list for method output_type
-	2, // [2:5] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	7, // 0: cosmos.authz.v1beta1.MsgGrant.grant:type_name -> cosmos.authz.v1beta1.Grant
+	2, // 1: cosmos.authz.v1beta1.MsgExecResponse.msg_results:type_name -> cosmos.authz.v1beta1.MsgExecResult
+	8, // 2: cosmos.authz.v1beta1.MsgExec.msgs:type_name -> google.protobuf.Any
+	0, // 3: cosmos.authz.v1beta1.Msg.Grant:input_type -> cosmos.authz.v1beta1.MsgGrant
+	3, // 4: cosmos.authz.v1beta1.Msg.Exec:input_type -> cosmos.authz.v1beta1.MsgExec
+	5, // 5: cosmos.authz.v1beta1.Msg.Revoke:input_type -> cosmos.authz.v1beta1.MsgRevoke
+	4, // 6: cosmos.authz.v1beta1.Msg.Grant:output_type -> cosmos.authz.v1beta1.MsgGrantResponse
+	1, // 7: cosmos.authz.v1beta1.Msg.Exec:output_type -> cosmos.authz.v1beta1.MsgExecResponse
+	6, // 8: cosmos.authz.v1beta1.Msg.Revoke:output_type -> cosmos.authz.v1beta1.MsgRevokeResponse
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_authz_v1beta1_tx_proto_init() }
@@ -3299,7 +4051,7 @@ func file_cosmos_authz_v1beta1_tx_proto_init() {
 			}
 		}
 		file_cosmos_authz_v1beta1_tx_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgExec); i {
+			switch v := v.(*MsgExecResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3311,7 +4063,7 @@ func file_cosmos_authz_v1beta1_tx_proto_init() {
 			}
 		}
 		file_cosmos_authz_v1beta1_tx_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgGrantResponse); i {
+			switch v := v.(*MsgExec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3323,7 +4075,7 @@ func file_cosmos_authz_v1beta1_tx_proto_init() {
 			}
 		}
 		file_cosmos_authz_v1beta1_tx_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRevoke); i {
+			switch v := v.(*MsgGrantResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3335,6 +4087,18 @@ func file_cosmos_authz_v1beta1_tx_proto_init() {
 			}
 		}
 		file_cosmos_authz_v1beta1_tx_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRevoke); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_authz_v1beta1_tx_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgRevokeResponse); i {
 			case 0:
 				return &v.state
@@ -3353,7 +4117,7 @@ func file_cosmos_authz_v1beta1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_authz_v1beta1_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
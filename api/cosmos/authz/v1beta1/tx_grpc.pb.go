@@ -1,5 +1,3 @@
-// Since: cosmos-sdk 0.43
-
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.3.0
@@ -30,6 +30,10 @@ const (
 	Query_DenomsMetadata_FullMethodName          = "/cosmos.bank.v1beta1.Query/DenomsMetadata"
 	Query_DenomOwners_FullMethodName             = "/cosmos.bank.v1beta1.Query/DenomOwners"
 	Query_SendEnabled_FullMethodName             = "/cosmos.bank.v1beta1.Query/SendEnabled"
+	Query_PausedDenoms_FullMethodName            = "/cosmos.bank.v1beta1.Query/PausedDenoms"
+	Query_Escrow_FullMethodName                  = "/cosmos.bank.v1beta1.Query/Escrow"
+	Query_Escrows_FullMethodName                 = "/cosmos.bank.v1beta1.Query/Escrows"
+	Query_ModuleAccountBalances_FullMethodName   = "/cosmos.bank.v1beta1.Query/ModuleAccountBalances"
 )
 
 // QueryClient is the client API for Query service.
@@ -92,6 +96,23 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error)
+	// PausedDenoms queries the denoms whose transfers are paused.
+	//
+	// Since: cosmos-sdk 0.50
+	PausedDenoms(ctx context.Context, in *QueryPausedDenomsRequest, opts ...grpc.CallOption) (*QueryPausedDenomsResponse, error)
+	// Escrow queries an escrow by its id.
+	//
+	// Since: cosmos-sdk 0.50
+	Escrow(ctx context.Context, in *QueryEscrowRequest, opts ...grpc.CallOption) (*QueryEscrowResponse, error)
+	// Escrows queries all the pending escrows.
+	//
+	// Since: cosmos-sdk 0.50
+	Escrows(ctx context.Context, in *QueryEscrowsRequest, opts ...grpc.CallOption) (*QueryEscrowsResponse, error)
+	// ModuleAccountBalances queries all the module accounts, with their
+	// permissions and balances.
+	//
+	// Since: cosmos-sdk 0.50
+	ModuleAccountBalances(ctx context.Context, in *QueryModuleAccountBalancesRequest, opts ...grpc.CallOption) (*QueryModuleAccountBalancesResponse, error)
 }
 
 type queryClient struct {
@@ -201,6 +222,42 @@ func (c *queryClient) SendEnabled(ctx context.Context, in *QuerySendEnabledReque
 	return out, nil
 }
 
+func (c *queryClient) PausedDenoms(ctx context.Context, in *QueryPausedDenomsRequest, opts ...grpc.CallOption) (*QueryPausedDenomsResponse, error) {
+	out := new(QueryPausedDenomsResponse)
+	err := c.cc.Invoke(ctx, Query_PausedDenoms_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Escrow(ctx context.Context, in *QueryEscrowRequest, opts ...grpc.CallOption) (*QueryEscrowResponse, error) {
+	out := new(QueryEscrowResponse)
+	err := c.cc.Invoke(ctx, Query_Escrow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Escrows(ctx context.Context, in *QueryEscrowsRequest, opts ...grpc.CallOption) (*QueryEscrowsResponse, error) {
+	out := new(QueryEscrowsResponse)
+	err := c.cc.Invoke(ctx, Query_Escrows_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModuleAccountBalances(ctx context.Context, in *QueryModuleAccountBalancesRequest, opts ...grpc.CallOption) (*QueryModuleAccountBalancesResponse, error) {
+	out := new(QueryModuleAccountBalancesResponse)
+	err := c.cc.Invoke(ctx, Query_ModuleAccountBalances_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -261,6 +318,23 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error)
+	// PausedDenoms queries the denoms whose transfers are paused.
+	//
+	// Since: cosmos-sdk 0.50
+	PausedDenoms(context.Context, *QueryPausedDenomsRequest) (*QueryPausedDenomsResponse, error)
+	// Escrow queries an escrow by its id.
+	//
+	// Since: cosmos-sdk 0.50
+	Escrow(context.Context, *QueryEscrowRequest) (*QueryEscrowResponse, error)
+	// Escrows queries all the pending escrows.
+	//
+	// Since: cosmos-sdk 0.50
+	Escrows(context.Context, *QueryEscrowsRequest) (*QueryEscrowsResponse, error)
+	// ModuleAccountBalances queries all the module accounts, with their
+	// permissions and balances.
+	//
+	// Since: cosmos-sdk 0.50
+	ModuleAccountBalances(context.Context, *QueryModuleAccountBalancesRequest) (*QueryModuleAccountBalancesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -301,6 +375,18 @@ func (UnimplementedQueryServer) DenomOwners(context.Context, *QueryDenomOwnersRe
 func (UnimplementedQueryServer) SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEnabled not implemented")
 }
+func (UnimplementedQueryServer) PausedDenoms(context.Context, *QueryPausedDenomsRequest) (*QueryPausedDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PausedDenoms not implemented")
+}
+func (UnimplementedQueryServer) Escrow(context.Context, *QueryEscrowRequest) (*QueryEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Escrow not implemented")
+}
+func (UnimplementedQueryServer) Escrows(context.Context, *QueryEscrowsRequest) (*QueryEscrowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Escrows not implemented")
+}
+func (UnimplementedQueryServer) ModuleAccountBalances(context.Context, *QueryModuleAccountBalancesRequest) (*QueryModuleAccountBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleAccountBalances not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -512,6 +598,78 @@ func _Query_SendEnabled_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PausedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPausedDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PausedDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PausedDenoms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PausedDenoms(ctx, req.(*QueryPausedDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Escrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Escrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Escrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Escrow(ctx, req.(*QueryEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Escrows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Escrows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Escrows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Escrows(ctx, req.(*QueryEscrowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModuleAccountBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleAccountBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleAccountBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ModuleAccountBalances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleAccountBalances(ctx, req.(*QueryModuleAccountBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -563,6 +721,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendEnabled",
 			Handler:    _Query_SendEnabled_Handler,
 		},
+		{
+			MethodName: "PausedDenoms",
+			Handler:    _Query_PausedDenoms_Handler,
+		},
+		{
+			MethodName: "Escrow",
+			Handler:    _Query_Escrow_Handler,
+		},
+		{
+			MethodName: "Escrows",
+			Handler:    _Query_Escrows_Handler,
+		},
+		{
+			MethodName: "ModuleAccountBalances",
+			Handler:    _Query_ModuleAccountBalances_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
@@ -19,10 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Send_FullMethodName           = "/cosmos.bank.v1beta1.Msg/Send"
-	Msg_MultiSend_FullMethodName      = "/cosmos.bank.v1beta1.Msg/MultiSend"
-	Msg_UpdateParams_FullMethodName   = "/cosmos.bank.v1beta1.Msg/UpdateParams"
-	Msg_SetSendEnabled_FullMethodName = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_Send_FullMethodName             = "/cosmos.bank.v1beta1.Msg/Send"
+	Msg_MultiSend_FullMethodName        = "/cosmos.bank.v1beta1.Msg/MultiSend"
+	Msg_UpdateParams_FullMethodName     = "/cosmos.bank.v1beta1.Msg/UpdateParams"
+	Msg_SetSendEnabled_FullMethodName   = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_SetPausedDenoms_FullMethodName  = "/cosmos.bank.v1beta1.Msg/SetPausedDenoms"
+	Msg_SetDenomMetadata_FullMethodName = "/cosmos.bank.v1beta1.Msg/SetDenomMetadata"
+	Msg_CreateEscrow_FullMethodName     = "/cosmos.bank.v1beta1.Msg/CreateEscrow"
+	Msg_AcceptEscrow_FullMethodName     = "/cosmos.bank.v1beta1.Msg/AcceptEscrow"
+	Msg_RefundEscrow_FullMethodName     = "/cosmos.bank.v1beta1.Msg/RefundEscrow"
 )
 
 // MsgClient is the client API for Msg service.
@@ -45,6 +50,31 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
+	// SetPausedDenoms is a governance operation for pausing or resuming the
+	// transfers of denoms. The coins of a paused denom cannot be sent.
+	//
+	// Since: cosmos-sdk 0.50
+	SetPausedDenoms(ctx context.Context, in *MsgSetPausedDenoms, opts ...grpc.CallOption) (*MsgSetPausedDenomsResponse, error)
+	// SetDenomMetadata is a governance operation for registering or updating the
+	// metadata of a denom.
+	//
+	// Since: cosmos-sdk 0.50
+	SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error)
+	// CreateEscrow moves coins of the creator into escrow, to be released to the
+	// counterparty once it accepts the escrow.
+	//
+	// Since: cosmos-sdk 0.50
+	CreateEscrow(ctx context.Context, in *MsgCreateEscrow, opts ...grpc.CallOption) (*MsgCreateEscrowResponse, error)
+	// AcceptEscrow releases the escrowed coins to the counterparty, which sends
+	// the expected coins to the creator in the same operation.
+	//
+	// Since: cosmos-sdk 0.50
+	AcceptEscrow(ctx context.Context, in *MsgAcceptEscrow, opts ...grpc.CallOption) (*MsgAcceptEscrowResponse, error)
+	// RefundEscrow returns the escrowed coins to the creator once the escrow
+	// timed out.
+	//
+	// Since: cosmos-sdk 0.50
+	RefundEscrow(ctx context.Context, in *MsgRefundEscrow, opts ...grpc.CallOption) (*MsgRefundEscrowResponse, error)
 }
 
 type msgClient struct {
@@ -91,6 +121,51 @@ func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, o
 	return out, nil
 }
 
+func (c *msgClient) SetPausedDenoms(ctx context.Context, in *MsgSetPausedDenoms, opts ...grpc.CallOption) (*MsgSetPausedDenomsResponse, error) {
+	out := new(MsgSetPausedDenomsResponse)
+	err := c.cc.Invoke(ctx, Msg_SetPausedDenoms_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error) {
+	out := new(MsgSetDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDenomMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateEscrow(ctx context.Context, in *MsgCreateEscrow, opts ...grpc.CallOption) (*MsgCreateEscrowResponse, error) {
+	out := new(MsgCreateEscrowResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateEscrow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptEscrow(ctx context.Context, in *MsgAcceptEscrow, opts ...grpc.CallOption) (*MsgAcceptEscrowResponse, error) {
+	out := new(MsgAcceptEscrowResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptEscrow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RefundEscrow(ctx context.Context, in *MsgRefundEscrow, opts ...grpc.CallOption) (*MsgRefundEscrowResponse, error) {
+	out := new(MsgRefundEscrowResponse)
+	err := c.cc.Invoke(ctx, Msg_RefundEscrow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -111,6 +186,31 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
+	// SetPausedDenoms is a governance operation for pausing or resuming the
+	// transfers of denoms. The coins of a paused denom cannot be sent.
+	//
+	// Since: cosmos-sdk 0.50
+	SetPausedDenoms(context.Context, *MsgSetPausedDenoms) (*MsgSetPausedDenomsResponse, error)
+	// SetDenomMetadata is a governance operation for registering or updating the
+	// metadata of a denom.
+	//
+	// Since: cosmos-sdk 0.50
+	SetDenomMetadata(context.Context, *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error)
+	// CreateEscrow moves coins of the creator into escrow, to be released to the
+	// counterparty once it accepts the escrow.
+	//
+	// Since: cosmos-sdk 0.50
+	CreateEscrow(context.Context, *MsgCreateEscrow) (*MsgCreateEscrowResponse, error)
+	// AcceptEscrow releases the escrowed coins to the counterparty, which sends
+	// the expected coins to the creator in the same operation.
+	//
+	// Since: cosmos-sdk 0.50
+	AcceptEscrow(context.Context, *MsgAcceptEscrow) (*MsgAcceptEscrowResponse, error)
+	// RefundEscrow returns the escrowed coins to the creator once the escrow
+	// timed out.
+	//
+	// Since: cosmos-sdk 0.50
+	RefundEscrow(context.Context, *MsgRefundEscrow) (*MsgRefundEscrowResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -130,6 +230,21 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSendEnabled not implemented")
 }
+func (UnimplementedMsgServer) SetPausedDenoms(context.Context, *MsgSetPausedDenoms) (*MsgSetPausedDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPausedDenoms not implemented")
+}
+func (UnimplementedMsgServer) SetDenomMetadata(context.Context, *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMetadata not implemented")
+}
+func (UnimplementedMsgServer) CreateEscrow(context.Context, *MsgCreateEscrow) (*MsgCreateEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEscrow not implemented")
+}
+func (UnimplementedMsgServer) AcceptEscrow(context.Context, *MsgAcceptEscrow) (*MsgAcceptEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptEscrow not implemented")
+}
+func (UnimplementedMsgServer) RefundEscrow(context.Context, *MsgRefundEscrow) (*MsgRefundEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundEscrow not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -215,6 +330,96 @@ func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetPausedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPausedDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPausedDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetPausedDenoms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPausedDenoms(ctx, req.(*MsgSetPausedDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDenomMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomMetadata(ctx, req.(*MsgSetDenomMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateEscrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateEscrow(ctx, req.(*MsgCreateEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptEscrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptEscrow(ctx, req.(*MsgAcceptEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RefundEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefundEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RefundEscrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefundEscrow(ctx, req.(*MsgRefundEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -238,6 +443,26 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetSendEnabled",
 			Handler:    _Msg_SetSendEnabled_Handler,
 		},
+		{
+			MethodName: "SetPausedDenoms",
+			Handler:    _Msg_SetPausedDenoms_Handler,
+		},
+		{
+			MethodName: "SetDenomMetadata",
+			Handler:    _Msg_SetDenomMetadata_Handler,
+		},
+		{
+			MethodName: "CreateEscrow",
+			Handler:    _Msg_CreateEscrow_Handler,
+		},
+		{
+			MethodName: "AcceptEscrow",
+			Handler:    _Msg_AcceptEscrow_Handler,
+		},
+		{
+			MethodName: "RefundEscrow",
+			Handler:    _Msg_RefundEscrow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/tx.proto",
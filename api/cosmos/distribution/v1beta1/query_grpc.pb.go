@@ -19,16 +19,20 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName                      = "/cosmos.distribution.v1beta1.Query/Params"
-	Query_ValidatorDistributionInfo_FullMethodName   = "/cosmos.distribution.v1beta1.Query/ValidatorDistributionInfo"
-	Query_ValidatorOutstandingRewards_FullMethodName = "/cosmos.distribution.v1beta1.Query/ValidatorOutstandingRewards"
-	Query_ValidatorCommission_FullMethodName         = "/cosmos.distribution.v1beta1.Query/ValidatorCommission"
-	Query_ValidatorSlashes_FullMethodName            = "/cosmos.distribution.v1beta1.Query/ValidatorSlashes"
-	Query_DelegationRewards_FullMethodName           = "/cosmos.distribution.v1beta1.Query/DelegationRewards"
-	Query_DelegationTotalRewards_FullMethodName      = "/cosmos.distribution.v1beta1.Query/DelegationTotalRewards"
-	Query_DelegatorValidators_FullMethodName         = "/cosmos.distribution.v1beta1.Query/DelegatorValidators"
-	Query_DelegatorWithdrawAddress_FullMethodName    = "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawAddress"
-	Query_CommunityPool_FullMethodName               = "/cosmos.distribution.v1beta1.Query/CommunityPool"
+	Query_Params_FullMethodName                            = "/cosmos.distribution.v1beta1.Query/Params"
+	Query_ValidatorDistributionInfo_FullMethodName         = "/cosmos.distribution.v1beta1.Query/ValidatorDistributionInfo"
+	Query_ValidatorOutstandingRewards_FullMethodName       = "/cosmos.distribution.v1beta1.Query/ValidatorOutstandingRewards"
+	Query_ValidatorCommission_FullMethodName               = "/cosmos.distribution.v1beta1.Query/ValidatorCommission"
+	Query_ValidatorSlashes_FullMethodName                  = "/cosmos.distribution.v1beta1.Query/ValidatorSlashes"
+	Query_DelegationRewards_FullMethodName                 = "/cosmos.distribution.v1beta1.Query/DelegationRewards"
+	Query_DelegationTotalRewards_FullMethodName            = "/cosmos.distribution.v1beta1.Query/DelegationTotalRewards"
+	Query_DelegatorValidators_FullMethodName               = "/cosmos.distribution.v1beta1.Query/DelegatorValidators"
+	Query_DelegatorWithdrawAddress_FullMethodName          = "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawAddress"
+	Query_CommunityPool_FullMethodName                     = "/cosmos.distribution.v1beta1.Query/CommunityPool"
+	Query_DelegatorAutoCompounds_FullMethodName            = "/cosmos.distribution.v1beta1.Query/DelegatorAutoCompounds"
+	Query_DelegatorValidatorWithdrawAddress_FullMethodName = "/cosmos.distribution.v1beta1.Query/DelegatorValidatorWithdrawAddress"
+	Query_ContinuousFund_FullMethodName                    = "/cosmos.distribution.v1beta1.Query/ContinuousFund"
+	Query_ContinuousFunds_FullMethodName                   = "/cosmos.distribution.v1beta1.Query/ContinuousFunds"
 )
 
 // QueryClient is the client API for Query service.
@@ -56,6 +60,24 @@ type QueryClient interface {
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
+	// DelegatorAutoCompounds queries the validators of a delegator whose
+	// delegation rewards are auto-compounded.
+	//
+	// Since: cosmos-sdk 0.50
+	DelegatorAutoCompounds(ctx context.Context, in *QueryDelegatorAutoCompoundsRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundsResponse, error)
+	// DelegatorValidatorWithdrawAddress queries the address receiving the
+	// rewards of a delegator from a single validator.
+	//
+	// Since: cosmos-sdk 0.50
+	DelegatorValidatorWithdrawAddress(ctx context.Context, in *QueryDelegatorValidatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorWithdrawAddressResponse, error)
+	// ContinuousFund queries a continuous fund by its id.
+	//
+	// Since: cosmos-sdk 0.50
+	ContinuousFund(ctx context.Context, in *QueryContinuousFundRequest, opts ...grpc.CallOption) (*QueryContinuousFundResponse, error)
+	// ContinuousFunds queries all the continuous funds.
+	//
+	// Since: cosmos-sdk 0.50
+	ContinuousFunds(ctx context.Context, in *QueryContinuousFundsRequest, opts ...grpc.CallOption) (*QueryContinuousFundsResponse, error)
 }
 
 type queryClient struct {
@@ -156,6 +178,42 @@ func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolR
 	return out, nil
 }
 
+func (c *queryClient) DelegatorAutoCompounds(ctx context.Context, in *QueryDelegatorAutoCompoundsRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundsResponse, error) {
+	out := new(QueryDelegatorAutoCompoundsResponse)
+	err := c.cc.Invoke(ctx, Query_DelegatorAutoCompounds_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorValidatorWithdrawAddress(ctx context.Context, in *QueryDelegatorValidatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorWithdrawAddressResponse, error) {
+	out := new(QueryDelegatorValidatorWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, Query_DelegatorValidatorWithdrawAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContinuousFund(ctx context.Context, in *QueryContinuousFundRequest, opts ...grpc.CallOption) (*QueryContinuousFundResponse, error) {
+	out := new(QueryContinuousFundResponse)
+	err := c.cc.Invoke(ctx, Query_ContinuousFund_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContinuousFunds(ctx context.Context, in *QueryContinuousFundsRequest, opts ...grpc.CallOption) (*QueryContinuousFundsResponse, error) {
+	out := new(QueryContinuousFundsResponse)
+	err := c.cc.Invoke(ctx, Query_ContinuousFunds_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -181,6 +239,24 @@ type QueryServer interface {
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
+	// DelegatorAutoCompounds queries the validators of a delegator whose
+	// delegation rewards are auto-compounded.
+	//
+	// Since: cosmos-sdk 0.50
+	DelegatorAutoCompounds(context.Context, *QueryDelegatorAutoCompoundsRequest) (*QueryDelegatorAutoCompoundsResponse, error)
+	// DelegatorValidatorWithdrawAddress queries the address receiving the
+	// rewards of a delegator from a single validator.
+	//
+	// Since: cosmos-sdk 0.50
+	DelegatorValidatorWithdrawAddress(context.Context, *QueryDelegatorValidatorWithdrawAddressRequest) (*QueryDelegatorValidatorWithdrawAddressResponse, error)
+	// ContinuousFund queries a continuous fund by its id.
+	//
+	// Since: cosmos-sdk 0.50
+	ContinuousFund(context.Context, *QueryContinuousFundRequest) (*QueryContinuousFundResponse, error)
+	// ContinuousFunds queries all the continuous funds.
+	//
+	// Since: cosmos-sdk 0.50
+	ContinuousFunds(context.Context, *QueryContinuousFundsRequest) (*QueryContinuousFundsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -218,6 +294,18 @@ func (UnimplementedQueryServer) DelegatorWithdrawAddress(context.Context, *Query
 func (UnimplementedQueryServer) CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
+func (UnimplementedQueryServer) DelegatorAutoCompounds(context.Context, *QueryDelegatorAutoCompoundsRequest) (*QueryDelegatorAutoCompoundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorAutoCompounds not implemented")
+}
+func (UnimplementedQueryServer) DelegatorValidatorWithdrawAddress(context.Context, *QueryDelegatorValidatorWithdrawAddressRequest) (*QueryDelegatorValidatorWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorValidatorWithdrawAddress not implemented")
+}
+func (UnimplementedQueryServer) ContinuousFund(context.Context, *QueryContinuousFundRequest) (*QueryContinuousFundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContinuousFund not implemented")
+}
+func (UnimplementedQueryServer) ContinuousFunds(context.Context, *QueryContinuousFundsRequest) (*QueryContinuousFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContinuousFunds not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -411,6 +499,78 @@ func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegatorAutoCompounds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorAutoCompoundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorAutoCompounds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegatorAutoCompounds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorAutoCompounds(ctx, req.(*QueryDelegatorAutoCompoundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorValidatorWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorValidatorWithdrawAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorValidatorWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegatorValidatorWithdrawAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorValidatorWithdrawAddress(ctx, req.(*QueryDelegatorValidatorWithdrawAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContinuousFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContinuousFundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContinuousFund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ContinuousFund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContinuousFund(ctx, req.(*QueryContinuousFundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContinuousFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContinuousFundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContinuousFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ContinuousFunds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContinuousFunds(ctx, req.(*QueryContinuousFundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -458,6 +618,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
 		},
+		{
+			MethodName: "DelegatorAutoCompounds",
+			Handler:    _Query_DelegatorAutoCompounds_Handler,
+		},
+		{
+			MethodName: "DelegatorValidatorWithdrawAddress",
+			Handler:    _Query_DelegatorValidatorWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "ContinuousFund",
+			Handler:    _Query_ContinuousFund_Handler,
+		},
+		{
+			MethodName: "ContinuousFunds",
+			Handler:    _Query_ContinuousFunds_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
@@ -19,13 +19,20 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_SetWithdrawAddress_FullMethodName          = "/cosmos.distribution.v1beta1.Msg/SetWithdrawAddress"
-	Msg_WithdrawDelegatorReward_FullMethodName     = "/cosmos.distribution.v1beta1.Msg/WithdrawDelegatorReward"
-	Msg_WithdrawValidatorCommission_FullMethodName = "/cosmos.distribution.v1beta1.Msg/WithdrawValidatorCommission"
-	Msg_FundCommunityPool_FullMethodName           = "/cosmos.distribution.v1beta1.Msg/FundCommunityPool"
-	Msg_UpdateParams_FullMethodName                = "/cosmos.distribution.v1beta1.Msg/UpdateParams"
-	Msg_CommunityPoolSpend_FullMethodName          = "/cosmos.distribution.v1beta1.Msg/CommunityPoolSpend"
-	Msg_DepositValidatorRewardsPool_FullMethodName = "/cosmos.distribution.v1beta1.Msg/DepositValidatorRewardsPool"
+	Msg_SetWithdrawAddress_FullMethodName                = "/cosmos.distribution.v1beta1.Msg/SetWithdrawAddress"
+	Msg_WithdrawDelegatorReward_FullMethodName           = "/cosmos.distribution.v1beta1.Msg/WithdrawDelegatorReward"
+	Msg_WithdrawValidatorCommission_FullMethodName       = "/cosmos.distribution.v1beta1.Msg/WithdrawValidatorCommission"
+	Msg_FundCommunityPool_FullMethodName                 = "/cosmos.distribution.v1beta1.Msg/FundCommunityPool"
+	Msg_UpdateParams_FullMethodName                      = "/cosmos.distribution.v1beta1.Msg/UpdateParams"
+	Msg_CommunityPoolSpend_FullMethodName                = "/cosmos.distribution.v1beta1.Msg/CommunityPoolSpend"
+	Msg_DepositValidatorRewardsPool_FullMethodName       = "/cosmos.distribution.v1beta1.Msg/DepositValidatorRewardsPool"
+	Msg_SetAutoCompound_FullMethodName                   = "/cosmos.distribution.v1beta1.Msg/SetAutoCompound"
+	Msg_WithdrawAllDelegatorRewards_FullMethodName       = "/cosmos.distribution.v1beta1.Msg/WithdrawAllDelegatorRewards"
+	Msg_SetValidatorWithdrawAddress_FullMethodName       = "/cosmos.distribution.v1beta1.Msg/SetValidatorWithdrawAddress"
+	Msg_CommunityPoolMultiSpend_FullMethodName           = "/cosmos.distribution.v1beta1.Msg/CommunityPoolMultiSpend"
+	Msg_CreateContinuousFund_FullMethodName              = "/cosmos.distribution.v1beta1.Msg/CreateContinuousFund"
+	Msg_CancelContinuousFund_FullMethodName              = "/cosmos.distribution.v1beta1.Msg/CancelContinuousFund"
+	Msg_WithdrawTokenizeShareRecordReward_FullMethodName = "/cosmos.distribution.v1beta1.Msg/WithdrawTokenizeShareRecordReward"
 )
 
 // MsgClient is the client API for Msg service.
@@ -61,6 +68,42 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.48
 	DepositValidatorRewardsPool(ctx context.Context, in *MsgDepositValidatorRewardsPool, opts ...grpc.CallOption) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// SetAutoCompound defines a method to enable or disable the auto-compounding
+	// of the rewards of a delegation, i.e. their periodic withdrawal and
+	// re-delegation to the same validator.
+	//
+	// Since: cosmos-sdk 0.50
+	SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error)
+	// WithdrawAllDelegatorRewards defines a method to withdraw rewards of a
+	// delegator from all the validators it delegates to.
+	//
+	// Since: cosmos-sdk 0.50
+	WithdrawAllDelegatorRewards(ctx context.Context, in *MsgWithdrawAllDelegatorRewards, opts ...grpc.CallOption) (*MsgWithdrawAllDelegatorRewardsResponse, error)
+	// SetValidatorWithdrawAddress defines a method to change the withdraw address
+	// for the rewards of a delegator from a single validator.
+	//
+	// Since: cosmos-sdk 0.50
+	SetValidatorWithdrawAddress(ctx context.Context, in *MsgSetValidatorWithdrawAddress, opts ...grpc.CallOption) (*MsgSetValidatorWithdrawAddressResponse, error)
+	// CommunityPoolMultiSpend defines a governance operation for sending tokens
+	// from the community pool in the x/distribution module to several accounts.
+	//
+	// Since: cosmos-sdk 0.50
+	CommunityPoolMultiSpend(ctx context.Context, in *MsgCommunityPoolMultiSpend, opts ...grpc.CallOption) (*MsgCommunityPoolMultiSpendResponse, error)
+	// CreateContinuousFund defines a governance operation for creating a
+	// continuous fund paying an amount from the community pool every period.
+	//
+	// Since: cosmos-sdk 0.50
+	CreateContinuousFund(ctx context.Context, in *MsgCreateContinuousFund, opts ...grpc.CallOption) (*MsgCreateContinuousFundResponse, error)
+	// CancelContinuousFund defines a governance operation for cancelling a
+	// continuous fund.
+	//
+	// Since: cosmos-sdk 0.50
+	CancelContinuousFund(ctx context.Context, in *MsgCancelContinuousFund, opts ...grpc.CallOption) (*MsgCancelContinuousFundResponse, error)
+	// WithdrawTokenizeShareRecordReward defines a method to withdraw the rewards
+	// of the delegation of a x/staking tokenize share record to its owner.
+	//
+	// Since: cosmos-sdk 0.50
+	WithdrawTokenizeShareRecordReward(ctx context.Context, in *MsgWithdrawTokenizeShareRecordReward, opts ...grpc.CallOption) (*MsgWithdrawTokenizeShareRecordRewardResponse, error)
 }
 
 type msgClient struct {
@@ -134,6 +177,69 @@ func (c *msgClient) DepositValidatorRewardsPool(ctx context.Context, in *MsgDepo
 	return out, nil
 }
 
+func (c *msgClient) SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error) {
+	out := new(MsgSetAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, Msg_SetAutoCompound_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawAllDelegatorRewards(ctx context.Context, in *MsgWithdrawAllDelegatorRewards, opts ...grpc.CallOption) (*MsgWithdrawAllDelegatorRewardsResponse, error) {
+	out := new(MsgWithdrawAllDelegatorRewardsResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawAllDelegatorRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetValidatorWithdrawAddress(ctx context.Context, in *MsgSetValidatorWithdrawAddress, opts ...grpc.CallOption) (*MsgSetValidatorWithdrawAddressResponse, error) {
+	out := new(MsgSetValidatorWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, Msg_SetValidatorWithdrawAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CommunityPoolMultiSpend(ctx context.Context, in *MsgCommunityPoolMultiSpend, opts ...grpc.CallOption) (*MsgCommunityPoolMultiSpendResponse, error) {
+	out := new(MsgCommunityPoolMultiSpendResponse)
+	err := c.cc.Invoke(ctx, Msg_CommunityPoolMultiSpend_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateContinuousFund(ctx context.Context, in *MsgCreateContinuousFund, opts ...grpc.CallOption) (*MsgCreateContinuousFundResponse, error) {
+	out := new(MsgCreateContinuousFundResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateContinuousFund_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelContinuousFund(ctx context.Context, in *MsgCancelContinuousFund, opts ...grpc.CallOption) (*MsgCancelContinuousFundResponse, error) {
+	out := new(MsgCancelContinuousFundResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelContinuousFund_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawTokenizeShareRecordReward(ctx context.Context, in *MsgWithdrawTokenizeShareRecordReward, opts ...grpc.CallOption) (*MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	out := new(MsgWithdrawTokenizeShareRecordRewardResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawTokenizeShareRecordReward_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -167,6 +273,42 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.48
 	DepositValidatorRewardsPool(context.Context, *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// SetAutoCompound defines a method to enable or disable the auto-compounding
+	// of the rewards of a delegation, i.e. their periodic withdrawal and
+	// re-delegation to the same validator.
+	//
+	// Since: cosmos-sdk 0.50
+	SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error)
+	// WithdrawAllDelegatorRewards defines a method to withdraw rewards of a
+	// delegator from all the validators it delegates to.
+	//
+	// Since: cosmos-sdk 0.50
+	WithdrawAllDelegatorRewards(context.Context, *MsgWithdrawAllDelegatorRewards) (*MsgWithdrawAllDelegatorRewardsResponse, error)
+	// SetValidatorWithdrawAddress defines a method to change the withdraw address
+	// for the rewards of a delegator from a single validator.
+	//
+	// Since: cosmos-sdk 0.50
+	SetValidatorWithdrawAddress(context.Context, *MsgSetValidatorWithdrawAddress) (*MsgSetValidatorWithdrawAddressResponse, error)
+	// CommunityPoolMultiSpend defines a governance operation for sending tokens
+	// from the community pool in the x/distribution module to several accounts.
+	//
+	// Since: cosmos-sdk 0.50
+	CommunityPoolMultiSpend(context.Context, *MsgCommunityPoolMultiSpend) (*MsgCommunityPoolMultiSpendResponse, error)
+	// CreateContinuousFund defines a governance operation for creating a
+	// continuous fund paying an amount from the community pool every period.
+	//
+	// Since: cosmos-sdk 0.50
+	CreateContinuousFund(context.Context, *MsgCreateContinuousFund) (*MsgCreateContinuousFundResponse, error)
+	// CancelContinuousFund defines a governance operation for cancelling a
+	// continuous fund.
+	//
+	// Since: cosmos-sdk 0.50
+	CancelContinuousFund(context.Context, *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error)
+	// WithdrawTokenizeShareRecordReward defines a method to withdraw the rewards
+	// of the delegation of a x/staking tokenize share record to its owner.
+	//
+	// Since: cosmos-sdk 0.50
+	WithdrawTokenizeShareRecordReward(context.Context, *MsgWithdrawTokenizeShareRecordReward) (*MsgWithdrawTokenizeShareRecordRewardResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -195,6 +337,27 @@ func (UnimplementedMsgServer) CommunityPoolSpend(context.Context, *MsgCommunityP
 func (UnimplementedMsgServer) DepositValidatorRewardsPool(context.Context, *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DepositValidatorRewardsPool not implemented")
 }
+func (UnimplementedMsgServer) SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoCompound not implemented")
+}
+func (UnimplementedMsgServer) WithdrawAllDelegatorRewards(context.Context, *MsgWithdrawAllDelegatorRewards) (*MsgWithdrawAllDelegatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAllDelegatorRewards not implemented")
+}
+func (UnimplementedMsgServer) SetValidatorWithdrawAddress(context.Context, *MsgSetValidatorWithdrawAddress) (*MsgSetValidatorWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetValidatorWithdrawAddress not implemented")
+}
+func (UnimplementedMsgServer) CommunityPoolMultiSpend(context.Context, *MsgCommunityPoolMultiSpend) (*MsgCommunityPoolMultiSpendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommunityPoolMultiSpend not implemented")
+}
+func (UnimplementedMsgServer) CreateContinuousFund(context.Context, *MsgCreateContinuousFund) (*MsgCreateContinuousFundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateContinuousFund not implemented")
+}
+func (UnimplementedMsgServer) CancelContinuousFund(context.Context, *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelContinuousFund not implemented")
+}
+func (UnimplementedMsgServer) WithdrawTokenizeShareRecordReward(context.Context, *MsgWithdrawTokenizeShareRecordReward) (*MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawTokenizeShareRecordReward not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -334,6 +497,132 @@ func _Msg_DepositValidatorRewardsPool_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetAutoCompound_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoCompound(ctx, req.(*MsgSetAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawAllDelegatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawAllDelegatorRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawAllDelegatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawAllDelegatorRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawAllDelegatorRewards(ctx, req.(*MsgWithdrawAllDelegatorRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetValidatorWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetValidatorWithdrawAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetValidatorWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetValidatorWithdrawAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetValidatorWithdrawAddress(ctx, req.(*MsgSetValidatorWithdrawAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CommunityPoolMultiSpend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommunityPoolMultiSpend)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommunityPoolMultiSpend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CommunityPoolMultiSpend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommunityPoolMultiSpend(ctx, req.(*MsgCommunityPoolMultiSpend))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateContinuousFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateContinuousFund)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateContinuousFund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateContinuousFund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateContinuousFund(ctx, req.(*MsgCreateContinuousFund))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelContinuousFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelContinuousFund)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelContinuousFund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelContinuousFund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelContinuousFund(ctx, req.(*MsgCancelContinuousFund))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawTokenizeShareRecordReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawTokenizeShareRecordReward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawTokenizeShareRecordReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawTokenizeShareRecordReward_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawTokenizeShareRecordReward(ctx, req.(*MsgWithdrawTokenizeShareRecordReward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -369,6 +658,34 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DepositValidatorRewardsPool",
 			Handler:    _Msg_DepositValidatorRewardsPool_Handler,
 		},
+		{
+			MethodName: "SetAutoCompound",
+			Handler:    _Msg_SetAutoCompound_Handler,
+		},
+		{
+			MethodName: "WithdrawAllDelegatorRewards",
+			Handler:    _Msg_WithdrawAllDelegatorRewards_Handler,
+		},
+		{
+			MethodName: "SetValidatorWithdrawAddress",
+			Handler:    _Msg_SetValidatorWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "CommunityPoolMultiSpend",
+			Handler:    _Msg_CommunityPoolMultiSpend_Handler,
+		},
+		{
+			MethodName: "CreateContinuousFund",
+			Handler:    _Msg_CreateContinuousFund_Handler,
+		},
+		{
+			MethodName: "CancelContinuousFund",
+			Handler:    _Msg_CancelContinuousFund_Handler,
+		},
+		{
+			MethodName: "WithdrawTokenizeShareRecordReward",
+			Handler:    _Msg_WithdrawTokenizeShareRecordReward_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/tx.proto",
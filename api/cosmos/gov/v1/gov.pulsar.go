@@ -1195,22 +1195,76 @@ func (x *_Proposal_7_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Proposal_17_list)(nil)
+
+type _Proposal_17_list struct {
+	list *[]*OptionTallyResult
+}
+
+func (x *_Proposal_17_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Proposal_17_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Proposal_17_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OptionTallyResult)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Proposal_17_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*OptionTallyResult)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Proposal_17_list) AppendMutable() protoreflect.Value {
+	v := new(OptionTallyResult)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Proposal_17_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Proposal_17_list) NewElement() protoreflect.Value {
+	v := new(OptionTallyResult)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Proposal_17_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Proposal                    protoreflect.MessageDescriptor
-	fd_Proposal_id                 protoreflect.FieldDescriptor
-	fd_Proposal_messages           protoreflect.FieldDescriptor
-	fd_Proposal_status             protoreflect.FieldDescriptor
-	fd_Proposal_final_tally_result protoreflect.FieldDescriptor
-	fd_Proposal_submit_time        protoreflect.FieldDescriptor
-	fd_Proposal_deposit_end_time   protoreflect.FieldDescriptor
-	fd_Proposal_total_deposit      protoreflect.FieldDescriptor
-	fd_Proposal_voting_start_time  protoreflect.FieldDescriptor
-	fd_Proposal_voting_end_time    protoreflect.FieldDescriptor
-	fd_Proposal_metadata           protoreflect.FieldDescriptor
-	fd_Proposal_title              protoreflect.FieldDescriptor
-	fd_Proposal_summary            protoreflect.FieldDescriptor
-	fd_Proposal_proposer           protoreflect.FieldDescriptor
-	fd_Proposal_expedited          protoreflect.FieldDescriptor
+	md_Proposal                            protoreflect.MessageDescriptor
+	fd_Proposal_id                         protoreflect.FieldDescriptor
+	fd_Proposal_messages                   protoreflect.FieldDescriptor
+	fd_Proposal_status                     protoreflect.FieldDescriptor
+	fd_Proposal_final_tally_result         protoreflect.FieldDescriptor
+	fd_Proposal_submit_time                protoreflect.FieldDescriptor
+	fd_Proposal_deposit_end_time           protoreflect.FieldDescriptor
+	fd_Proposal_total_deposit              protoreflect.FieldDescriptor
+	fd_Proposal_voting_start_time          protoreflect.FieldDescriptor
+	fd_Proposal_voting_end_time            protoreflect.FieldDescriptor
+	fd_Proposal_metadata                   protoreflect.FieldDescriptor
+	fd_Proposal_title                      protoreflect.FieldDescriptor
+	fd_Proposal_summary                    protoreflect.FieldDescriptor
+	fd_Proposal_proposer                   protoreflect.FieldDescriptor
+	fd_Proposal_expedited                  protoreflect.FieldDescriptor
+	fd_Proposal_proposal_type              protoreflect.FieldDescriptor
+	fd_Proposal_vote_options               protoreflect.FieldDescriptor
+	fd_Proposal_final_option_tally_results protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1230,6 +1284,9 @@ func init() {
 	fd_Proposal_summary = md_Proposal.Fields().ByName("summary")
 	fd_Proposal_proposer = md_Proposal.Fields().ByName("proposer")
 	fd_Proposal_expedited = md_Proposal.Fields().ByName("expedited")
+	fd_Proposal_proposal_type = md_Proposal.Fields().ByName("proposal_type")
+	fd_Proposal_vote_options = md_Proposal.Fields().ByName("vote_options")
+	fd_Proposal_final_option_tally_results = md_Proposal.Fields().ByName("final_option_tally_results")
 }
 
 var _ protoreflect.Message = (*fastReflection_Proposal)(nil)
@@ -1381,6 +1438,24 @@ func (x *fastReflection_Proposal) Range(f func(protoreflect.FieldDescriptor, pro
 			return
 		}
 	}
+	if x.ProposalType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ProposalType))
+		if !f(fd_Proposal_proposal_type, value) {
+			return
+		}
+	}
+	if x.VoteOptions != nil {
+		value := protoreflect.ValueOfMessage(x.VoteOptions.ProtoReflect())
+		if !f(fd_Proposal_vote_options, value) {
+			return
+		}
+	}
+	if len(x.FinalOptionTallyResults) != 0 {
+		value := protoreflect.ValueOfList(&_Proposal_17_list{list: &x.FinalOptionTallyResults})
+		if !f(fd_Proposal_final_option_tally_results, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1424,6 +1499,12 @@ func (x *fastReflection_Proposal) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Proposer != ""
 	case "cosmos.gov.v1.Proposal.expedited":
 		return x.Expedited != false
+	case "cosmos.gov.v1.Proposal.proposal_type":
+		return x.ProposalType != 0
+	case "cosmos.gov.v1.Proposal.vote_options":
+		return x.VoteOptions != nil
+	case "cosmos.gov.v1.Proposal.final_option_tally_results":
+		return len(x.FinalOptionTallyResults) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Proposal"))
@@ -1468,6 +1549,12 @@ func (x *fastReflection_Proposal) Clear(fd protoreflect.FieldDescriptor) {
 		x.Proposer = ""
 	case "cosmos.gov.v1.Proposal.expedited":
 		x.Expedited = false
+	case "cosmos.gov.v1.Proposal.proposal_type":
+		x.ProposalType = 0
+	case "cosmos.gov.v1.Proposal.vote_options":
+		x.VoteOptions = nil
+	case "cosmos.gov.v1.Proposal.final_option_tally_results":
+		x.FinalOptionTallyResults = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Proposal"))
@@ -1532,6 +1619,18 @@ func (x *fastReflection_Proposal) Get(descriptor protoreflect.FieldDescriptor) p
 	case "cosmos.gov.v1.Proposal.expedited":
 		value := x.Expedited
 		return protoreflect.ValueOfBool(value)
+	case "cosmos.gov.v1.Proposal.proposal_type":
+		value := x.ProposalType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.gov.v1.Proposal.vote_options":
+		value := x.VoteOptions
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.Proposal.final_option_tally_results":
+		if len(x.FinalOptionTallyResults) == 0 {
+			return protoreflect.ValueOfList(&_Proposal_17_list{})
+		}
+		listValue := &_Proposal_17_list{list: &x.FinalOptionTallyResults}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Proposal"))
@@ -1584,6 +1683,14 @@ func (x *fastReflection_Proposal) Set(fd protoreflect.FieldDescriptor, value pro
 		x.Proposer = value.Interface().(string)
 	case "cosmos.gov.v1.Proposal.expedited":
 		x.Expedited = value.Bool()
+	case "cosmos.gov.v1.Proposal.proposal_type":
+		x.ProposalType = (ProposalType)(value.Enum())
+	case "cosmos.gov.v1.Proposal.vote_options":
+		x.VoteOptions = value.Message().Interface().(*ProposalVoteOptions)
+	case "cosmos.gov.v1.Proposal.final_option_tally_results":
+		lv := value.List()
+		clv := lv.(*_Proposal_17_list)
+		x.FinalOptionTallyResults = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Proposal"))
@@ -1641,6 +1748,17 @@ func (x *fastReflection_Proposal) Mutable(fd protoreflect.FieldDescriptor) proto
 			x.VotingEndTime = new(timestamppb.Timestamp)
 		}
 		return protoreflect.ValueOfMessage(x.VotingEndTime.ProtoReflect())
+	case "cosmos.gov.v1.Proposal.vote_options":
+		if x.VoteOptions == nil {
+			x.VoteOptions = new(ProposalVoteOptions)
+		}
+		return protoreflect.ValueOfMessage(x.VoteOptions.ProtoReflect())
+	case "cosmos.gov.v1.Proposal.final_option_tally_results":
+		if x.FinalOptionTallyResults == nil {
+			x.FinalOptionTallyResults = []*OptionTallyResult{}
+		}
+		value := &_Proposal_17_list{list: &x.FinalOptionTallyResults}
+		return protoreflect.ValueOfList(value)
 	case "cosmos.gov.v1.Proposal.id":
 		panic(fmt.Errorf("field id of message cosmos.gov.v1.Proposal is not mutable"))
 	case "cosmos.gov.v1.Proposal.status":
@@ -1655,6 +1773,8 @@ func (x *fastReflection_Proposal) Mutable(fd protoreflect.FieldDescriptor) proto
 		panic(fmt.Errorf("field proposer of message cosmos.gov.v1.Proposal is not mutable"))
 	case "cosmos.gov.v1.Proposal.expedited":
 		panic(fmt.Errorf("field expedited of message cosmos.gov.v1.Proposal is not mutable"))
+	case "cosmos.gov.v1.Proposal.proposal_type":
+		panic(fmt.Errorf("field proposal_type of message cosmos.gov.v1.Proposal is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Proposal"))
@@ -1703,6 +1823,14 @@ func (x *fastReflection_Proposal) NewField(fd protoreflect.FieldDescriptor) prot
 		return protoreflect.ValueOfString("")
 	case "cosmos.gov.v1.Proposal.expedited":
 		return protoreflect.ValueOfBool(false)
+	case "cosmos.gov.v1.Proposal.proposal_type":
+		return protoreflect.ValueOfEnum(0)
+	case "cosmos.gov.v1.Proposal.vote_options":
+		m := new(ProposalVoteOptions)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.Proposal.final_option_tally_results":
+		list := []*OptionTallyResult{}
+		return protoreflect.ValueOfList(&_Proposal_17_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Proposal"))
@@ -1829,6 +1957,19 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 		if x.Expedited {
 			n += 2
 		}
+		if x.ProposalType != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalType))
+		}
+		if x.VoteOptions != nil {
+			l = options.Size(x.VoteOptions)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.FinalOptionTallyResults) > 0 {
+			for _, e := range x.FinalOptionTallyResults {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1858,6 +1999,45 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.FinalOptionTallyResults) > 0 {
+			for iNdEx := len(x.FinalOptionTallyResults) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FinalOptionTallyResults[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x8a
+			}
+		}
+		if x.VoteOptions != nil {
+			encoded, err := options.Marshal(x.VoteOptions)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if x.ProposalType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalType))
+			i--
+			dAtA[i] = 0x78
+		}
 		if x.Expedited {
 			i--
 			if x.Expedited {
@@ -2491,6 +2671,95 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.Expedited = bool(v != 0)
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalType", wireType)
+				}
+				x.ProposalType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalType |= ProposalType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VoteOptions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.VoteOptions == nil {
+					x.VoteOptions = &ProposalVoteOptions{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VoteOptions); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FinalOptionTallyResults", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FinalOptionTallyResults = append(x.FinalOptionTallyResults, &OptionTallyResult{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FinalOptionTallyResults[len(x.FinalOptionTallyResults)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2526,32 +2795,72 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_ProposalVoteOptions_1_list)(nil)
+
+type _ProposalVoteOptions_1_list struct {
+	list *[]string
+}
+
+func (x *_ProposalVoteOptions_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ProposalVoteOptions_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ProposalVoteOptions_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ProposalVoteOptions_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ProposalVoteOptions_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ProposalVoteOptions at list field Options as it is not of Message kind"))
+}
+
+func (x *_ProposalVoteOptions_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ProposalVoteOptions_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ProposalVoteOptions_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_TallyResult                    protoreflect.MessageDescriptor
-	fd_TallyResult_yes_count          protoreflect.FieldDescriptor
-	fd_TallyResult_abstain_count      protoreflect.FieldDescriptor
-	fd_TallyResult_no_count           protoreflect.FieldDescriptor
-	fd_TallyResult_no_with_veto_count protoreflect.FieldDescriptor
+	md_ProposalVoteOptions         protoreflect.MessageDescriptor
+	fd_ProposalVoteOptions_options protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gov_v1_gov_proto_init()
-	md_TallyResult = File_cosmos_gov_v1_gov_proto.Messages().ByName("TallyResult")
-	fd_TallyResult_yes_count = md_TallyResult.Fields().ByName("yes_count")
-	fd_TallyResult_abstain_count = md_TallyResult.Fields().ByName("abstain_count")
-	fd_TallyResult_no_count = md_TallyResult.Fields().ByName("no_count")
-	fd_TallyResult_no_with_veto_count = md_TallyResult.Fields().ByName("no_with_veto_count")
+	md_ProposalVoteOptions = File_cosmos_gov_v1_gov_proto.Messages().ByName("ProposalVoteOptions")
+	fd_ProposalVoteOptions_options = md_ProposalVoteOptions.Fields().ByName("options")
 }
 
-var _ protoreflect.Message = (*fastReflection_TallyResult)(nil)
+var _ protoreflect.Message = (*fastReflection_ProposalVoteOptions)(nil)
 
-type fastReflection_TallyResult TallyResult
+type fastReflection_ProposalVoteOptions ProposalVoteOptions
 
-func (x *TallyResult) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TallyResult)(x)
+func (x *ProposalVoteOptions) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ProposalVoteOptions)(x)
 }
 
-func (x *TallyResult) slowProtoReflect() protoreflect.Message {
+func (x *ProposalVoteOptions) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2563,43 +2872,43 @@ func (x *TallyResult) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TallyResult_messageType fastReflection_TallyResult_messageType
-var _ protoreflect.MessageType = fastReflection_TallyResult_messageType{}
+var _fastReflection_ProposalVoteOptions_messageType fastReflection_ProposalVoteOptions_messageType
+var _ protoreflect.MessageType = fastReflection_ProposalVoteOptions_messageType{}
 
-type fastReflection_TallyResult_messageType struct{}
+type fastReflection_ProposalVoteOptions_messageType struct{}
 
-func (x fastReflection_TallyResult_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TallyResult)(nil)
+func (x fastReflection_ProposalVoteOptions_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ProposalVoteOptions)(nil)
 }
-func (x fastReflection_TallyResult_messageType) New() protoreflect.Message {
-	return new(fastReflection_TallyResult)
+func (x fastReflection_ProposalVoteOptions_messageType) New() protoreflect.Message {
+	return new(fastReflection_ProposalVoteOptions)
 }
-func (x fastReflection_TallyResult_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TallyResult
+func (x fastReflection_ProposalVoteOptions_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProposalVoteOptions
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TallyResult) Descriptor() protoreflect.MessageDescriptor {
-	return md_TallyResult
+func (x *fastReflection_ProposalVoteOptions) Descriptor() protoreflect.MessageDescriptor {
+	return md_ProposalVoteOptions
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TallyResult) Type() protoreflect.MessageType {
-	return _fastReflection_TallyResult_messageType
+func (x *fastReflection_ProposalVoteOptions) Type() protoreflect.MessageType {
+	return _fastReflection_ProposalVoteOptions_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TallyResult) New() protoreflect.Message {
-	return new(fastReflection_TallyResult)
+func (x *fastReflection_ProposalVoteOptions) New() protoreflect.Message {
+	return new(fastReflection_ProposalVoteOptions)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TallyResult) Interface() protoreflect.ProtoMessage {
-	return (*TallyResult)(x)
+func (x *fastReflection_ProposalVoteOptions) Interface() protoreflect.ProtoMessage {
+	return (*ProposalVoteOptions)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2607,28 +2916,10 @@ func (x *fastReflection_TallyResult) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TallyResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.YesCount != "" {
-		value := protoreflect.ValueOfString(x.YesCount)
-		if !f(fd_TallyResult_yes_count, value) {
-			return
-		}
-	}
-	if x.AbstainCount != "" {
-		value := protoreflect.ValueOfString(x.AbstainCount)
-		if !f(fd_TallyResult_abstain_count, value) {
-			return
-		}
-	}
-	if x.NoCount != "" {
-		value := protoreflect.ValueOfString(x.NoCount)
-		if !f(fd_TallyResult_no_count, value) {
-			return
-		}
-	}
-	if x.NoWithVetoCount != "" {
-		value := protoreflect.ValueOfString(x.NoWithVetoCount)
-		if !f(fd_TallyResult_no_with_veto_count, value) {
+func (x *fastReflection_ProposalVoteOptions) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Options) != 0 {
+		value := protoreflect.ValueOfList(&_ProposalVoteOptions_1_list{list: &x.Options})
+		if !f(fd_ProposalVoteOptions_options, value) {
 			return
 		}
 	}
@@ -2645,21 +2936,15 @@ func (x *fastReflection_TallyResult) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TallyResult) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ProposalVoteOptions) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.TallyResult.yes_count":
-		return x.YesCount != ""
-	case "cosmos.gov.v1.TallyResult.abstain_count":
-		return x.AbstainCount != ""
-	case "cosmos.gov.v1.TallyResult.no_count":
-		return x.NoCount != ""
-	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
-		return x.NoWithVetoCount != ""
+	case "cosmos.gov.v1.ProposalVoteOptions.options":
+		return len(x.Options) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ProposalVoteOptions"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.ProposalVoteOptions does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2669,21 +2954,15 @@ func (x *fastReflection_TallyResult) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyResult) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ProposalVoteOptions) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.TallyResult.yes_count":
-		x.YesCount = ""
-	case "cosmos.gov.v1.TallyResult.abstain_count":
-		x.AbstainCount = ""
-	case "cosmos.gov.v1.TallyResult.no_count":
-		x.NoCount = ""
-	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
-		x.NoWithVetoCount = ""
+	case "cosmos.gov.v1.ProposalVoteOptions.options":
+		x.Options = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ProposalVoteOptions"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.ProposalVoteOptions does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2693,25 +2972,19 @@ func (x *fastReflection_TallyResult) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TallyResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ProposalVoteOptions) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.TallyResult.yes_count":
-		value := x.YesCount
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.TallyResult.abstain_count":
-		value := x.AbstainCount
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.TallyResult.no_count":
-		value := x.NoCount
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
-		value := x.NoWithVetoCount
-		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.ProposalVoteOptions.options":
+		if len(x.Options) == 0 {
+			return protoreflect.ValueOfList(&_ProposalVoteOptions_1_list{})
+		}
+		listValue := &_ProposalVoteOptions_1_list{list: &x.Options}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ProposalVoteOptions"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.ProposalVoteOptions does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2725,21 +2998,17 @@ func (x *fastReflection_TallyResult) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ProposalVoteOptions) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.TallyResult.yes_count":
-		x.YesCount = value.Interface().(string)
-	case "cosmos.gov.v1.TallyResult.abstain_count":
-		x.AbstainCount = value.Interface().(string)
-	case "cosmos.gov.v1.TallyResult.no_count":
-		x.NoCount = value.Interface().(string)
-	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
-		x.NoWithVetoCount = value.Interface().(string)
+	case "cosmos.gov.v1.ProposalVoteOptions.options":
+		lv := value.List()
+		clv := lv.(*_ProposalVoteOptions_1_list)
+		x.Options = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ProposalVoteOptions"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.ProposalVoteOptions does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2753,52 +3022,45 @@ func (x *fastReflection_TallyResult) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ProposalVoteOptions) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.TallyResult.yes_count":
-		panic(fmt.Errorf("field yes_count of message cosmos.gov.v1.TallyResult is not mutable"))
-	case "cosmos.gov.v1.TallyResult.abstain_count":
-		panic(fmt.Errorf("field abstain_count of message cosmos.gov.v1.TallyResult is not mutable"))
-	case "cosmos.gov.v1.TallyResult.no_count":
-		panic(fmt.Errorf("field no_count of message cosmos.gov.v1.TallyResult is not mutable"))
-	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
-		panic(fmt.Errorf("field no_with_veto_count of message cosmos.gov.v1.TallyResult is not mutable"))
+	case "cosmos.gov.v1.ProposalVoteOptions.options":
+		if x.Options == nil {
+			x.Options = []string{}
+		}
+		value := &_ProposalVoteOptions_1_list{list: &x.Options}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ProposalVoteOptions"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.ProposalVoteOptions does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TallyResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ProposalVoteOptions) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.TallyResult.yes_count":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.TallyResult.abstain_count":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.TallyResult.no_count":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
-		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.ProposalVoteOptions.options":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ProposalVoteOptions_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ProposalVoteOptions"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.ProposalVoteOptions does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TallyResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ProposalVoteOptions) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.TallyResult", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.ProposalVoteOptions", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2806,7 +3068,7 @@ func (x *fastReflection_TallyResult) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TallyResult) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ProposalVoteOptions) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2817,7 +3079,7 @@ func (x *fastReflection_TallyResult) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyResult) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ProposalVoteOptions) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2829,7 +3091,7 @@ func (x *fastReflection_TallyResult) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TallyResult) IsValid() bool {
+func (x *fastReflection_ProposalVoteOptions) IsValid() bool {
 	return x != nil
 }
 
@@ -2839,9 +3101,9 @@ func (x *fastReflection_TallyResult) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ProposalVoteOptions) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TallyResult)
+		x := input.Message.Interface().(*ProposalVoteOptions)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2853,21 +3115,11 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.YesCount)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.AbstainCount)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.NoCount)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.NoWithVetoCount)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Options) > 0 {
+			for _, s := range x.Options {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2879,7 +3131,7 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TallyResult)
+		x := input.Message.Interface().(*ProposalVoteOptions)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2898,33 +3150,14 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.NoWithVetoCount) > 0 {
-			i -= len(x.NoWithVetoCount)
-			copy(dAtA[i:], x.NoWithVetoCount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NoWithVetoCount)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.NoCount) > 0 {
-			i -= len(x.NoCount)
-			copy(dAtA[i:], x.NoCount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NoCount)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.AbstainCount) > 0 {
-			i -= len(x.AbstainCount)
-			copy(dAtA[i:], x.AbstainCount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AbstainCount)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.YesCount) > 0 {
-			i -= len(x.YesCount)
-			copy(dAtA[i:], x.YesCount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.YesCount)))
-			i--
-			dAtA[i] = 0xa
+		if len(x.Options) > 0 {
+			for iNdEx := len(x.Options) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Options[iNdEx])
+				copy(dAtA[i:], x.Options[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Options[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2937,7 +3170,7 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TallyResult)
+		x := input.Message.Interface().(*ProposalVoteOptions)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2969,111 +3202,15 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProposalVoteOptions: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ProposalVoteOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.YesCount = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.AbstainCount = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.NoCount = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NoWithVetoCount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3101,7 +3238,7 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.NoWithVetoCount = string(dAtA[iNdEx:postIndex])
+				x.Options = append(x.Options, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -3138,83 +3275,32 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Vote_4_list)(nil)
+var (
+	md_TallyResult                    protoreflect.MessageDescriptor
+	fd_TallyResult_yes_count          protoreflect.FieldDescriptor
+	fd_TallyResult_abstain_count      protoreflect.FieldDescriptor
+	fd_TallyResult_no_count           protoreflect.FieldDescriptor
+	fd_TallyResult_no_with_veto_count protoreflect.FieldDescriptor
+)
 
-type _Vote_4_list struct {
-	list *[]*WeightedVoteOption
+func init() {
+	file_cosmos_gov_v1_gov_proto_init()
+	md_TallyResult = File_cosmos_gov_v1_gov_proto.Messages().ByName("TallyResult")
+	fd_TallyResult_yes_count = md_TallyResult.Fields().ByName("yes_count")
+	fd_TallyResult_abstain_count = md_TallyResult.Fields().ByName("abstain_count")
+	fd_TallyResult_no_count = md_TallyResult.Fields().ByName("no_count")
+	fd_TallyResult_no_with_veto_count = md_TallyResult.Fields().ByName("no_with_veto_count")
 }
 
-func (x *_Vote_4_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
+var _ protoreflect.Message = (*fastReflection_TallyResult)(nil)
 
-func (x *_Vote_4_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_Vote_4_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*WeightedVoteOption)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_Vote_4_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*WeightedVoteOption)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_Vote_4_list) AppendMutable() protoreflect.Value {
-	v := new(WeightedVoteOption)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Vote_4_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Vote_4_list) NewElement() protoreflect.Value {
-	v := new(WeightedVoteOption)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Vote_4_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_Vote             protoreflect.MessageDescriptor
-	fd_Vote_proposal_id protoreflect.FieldDescriptor
-	fd_Vote_voter       protoreflect.FieldDescriptor
-	fd_Vote_options     protoreflect.FieldDescriptor
-	fd_Vote_metadata    protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_cosmos_gov_v1_gov_proto_init()
-	md_Vote = File_cosmos_gov_v1_gov_proto.Messages().ByName("Vote")
-	fd_Vote_proposal_id = md_Vote.Fields().ByName("proposal_id")
-	fd_Vote_voter = md_Vote.Fields().ByName("voter")
-	fd_Vote_options = md_Vote.Fields().ByName("options")
-	fd_Vote_metadata = md_Vote.Fields().ByName("metadata")
-}
-
-var _ protoreflect.Message = (*fastReflection_Vote)(nil)
-
-type fastReflection_Vote Vote
+type fastReflection_TallyResult TallyResult
 
-func (x *Vote) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Vote)(x)
+func (x *TallyResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TallyResult)(x)
 }
 
-func (x *Vote) slowProtoReflect() protoreflect.Message {
+func (x *TallyResult) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3226,43 +3312,43 @@ func (x *Vote) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Vote_messageType fastReflection_Vote_messageType
-var _ protoreflect.MessageType = fastReflection_Vote_messageType{}
+var _fastReflection_TallyResult_messageType fastReflection_TallyResult_messageType
+var _ protoreflect.MessageType = fastReflection_TallyResult_messageType{}
 
-type fastReflection_Vote_messageType struct{}
+type fastReflection_TallyResult_messageType struct{}
 
-func (x fastReflection_Vote_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Vote)(nil)
+func (x fastReflection_TallyResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TallyResult)(nil)
 }
-func (x fastReflection_Vote_messageType) New() protoreflect.Message {
-	return new(fastReflection_Vote)
+func (x fastReflection_TallyResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_TallyResult)
 }
-func (x fastReflection_Vote_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Vote
+func (x fastReflection_TallyResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TallyResult
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Vote) Descriptor() protoreflect.MessageDescriptor {
-	return md_Vote
+func (x *fastReflection_TallyResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_TallyResult
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Vote) Type() protoreflect.MessageType {
-	return _fastReflection_Vote_messageType
+func (x *fastReflection_TallyResult) Type() protoreflect.MessageType {
+	return _fastReflection_TallyResult_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Vote) New() protoreflect.Message {
-	return new(fastReflection_Vote)
+func (x *fastReflection_TallyResult) New() protoreflect.Message {
+	return new(fastReflection_TallyResult)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Vote) Interface() protoreflect.ProtoMessage {
-	return (*Vote)(x)
+func (x *fastReflection_TallyResult) Interface() protoreflect.ProtoMessage {
+	return (*TallyResult)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3270,28 +3356,28 @@ func (x *fastReflection_Vote) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Vote) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ProposalId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ProposalId)
-		if !f(fd_Vote_proposal_id, value) {
+func (x *fastReflection_TallyResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.YesCount != "" {
+		value := protoreflect.ValueOfString(x.YesCount)
+		if !f(fd_TallyResult_yes_count, value) {
 			return
 		}
 	}
-	if x.Voter != "" {
-		value := protoreflect.ValueOfString(x.Voter)
-		if !f(fd_Vote_voter, value) {
+	if x.AbstainCount != "" {
+		value := protoreflect.ValueOfString(x.AbstainCount)
+		if !f(fd_TallyResult_abstain_count, value) {
 			return
 		}
 	}
-	if len(x.Options) != 0 {
-		value := protoreflect.ValueOfList(&_Vote_4_list{list: &x.Options})
-		if !f(fd_Vote_options, value) {
+	if x.NoCount != "" {
+		value := protoreflect.ValueOfString(x.NoCount)
+		if !f(fd_TallyResult_no_count, value) {
 			return
 		}
 	}
-	if x.Metadata != "" {
-		value := protoreflect.ValueOfString(x.Metadata)
-		if !f(fd_Vote_metadata, value) {
+	if x.NoWithVetoCount != "" {
+		value := protoreflect.ValueOfString(x.NoWithVetoCount)
+		if !f(fd_TallyResult_no_with_veto_count, value) {
 			return
 		}
 	}
@@ -3308,21 +3394,21 @@ func (x *fastReflection_Vote) Range(f func(protoreflect.FieldDescriptor, protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Vote) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TallyResult) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Vote.proposal_id":
-		return x.ProposalId != uint64(0)
-	case "cosmos.gov.v1.Vote.voter":
-		return x.Voter != ""
-	case "cosmos.gov.v1.Vote.options":
-		return len(x.Options) != 0
-	case "cosmos.gov.v1.Vote.metadata":
-		return x.Metadata != ""
+	case "cosmos.gov.v1.TallyResult.yes_count":
+		return x.YesCount != ""
+	case "cosmos.gov.v1.TallyResult.abstain_count":
+		return x.AbstainCount != ""
+	case "cosmos.gov.v1.TallyResult.no_count":
+		return x.NoCount != ""
+	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
+		return x.NoWithVetoCount != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3332,21 +3418,21 @@ func (x *fastReflection_Vote) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Vote) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TallyResult) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Vote.proposal_id":
-		x.ProposalId = uint64(0)
-	case "cosmos.gov.v1.Vote.voter":
-		x.Voter = ""
-	case "cosmos.gov.v1.Vote.options":
-		x.Options = nil
-	case "cosmos.gov.v1.Vote.metadata":
-		x.Metadata = ""
+	case "cosmos.gov.v1.TallyResult.yes_count":
+		x.YesCount = ""
+	case "cosmos.gov.v1.TallyResult.abstain_count":
+		x.AbstainCount = ""
+	case "cosmos.gov.v1.TallyResult.no_count":
+		x.NoCount = ""
+	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
+		x.NoWithVetoCount = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3356,28 +3442,25 @@ func (x *fastReflection_Vote) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Vote) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TallyResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.Vote.proposal_id":
-		value := x.ProposalId
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.gov.v1.Vote.voter":
-		value := x.Voter
+	case "cosmos.gov.v1.TallyResult.yes_count":
+		value := x.YesCount
 		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.Vote.options":
-		if len(x.Options) == 0 {
-			return protoreflect.ValueOfList(&_Vote_4_list{})
-		}
-		listValue := &_Vote_4_list{list: &x.Options}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.gov.v1.Vote.metadata":
-		value := x.Metadata
+	case "cosmos.gov.v1.TallyResult.abstain_count":
+		value := x.AbstainCount
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.TallyResult.no_count":
+		value := x.NoCount
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
+		value := x.NoWithVetoCount
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3391,23 +3474,21 @@ func (x *fastReflection_Vote) Get(descriptor protoreflect.FieldDescriptor) proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Vote) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TallyResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Vote.proposal_id":
-		x.ProposalId = value.Uint()
-	case "cosmos.gov.v1.Vote.voter":
-		x.Voter = value.Interface().(string)
-	case "cosmos.gov.v1.Vote.options":
-		lv := value.List()
-		clv := lv.(*_Vote_4_list)
-		x.Options = *clv.list
-	case "cosmos.gov.v1.Vote.metadata":
-		x.Metadata = value.Interface().(string)
+	case "cosmos.gov.v1.TallyResult.yes_count":
+		x.YesCount = value.Interface().(string)
+	case "cosmos.gov.v1.TallyResult.abstain_count":
+		x.AbstainCount = value.Interface().(string)
+	case "cosmos.gov.v1.TallyResult.no_count":
+		x.NoCount = value.Interface().(string)
+	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
+		x.NoWithVetoCount = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3421,57 +3502,52 @@ func (x *fastReflection_Vote) Set(fd protoreflect.FieldDescriptor, value protore
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Vote) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TallyResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Vote.options":
-		if x.Options == nil {
-			x.Options = []*WeightedVoteOption{}
-		}
-		value := &_Vote_4_list{list: &x.Options}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.gov.v1.Vote.proposal_id":
-		panic(fmt.Errorf("field proposal_id of message cosmos.gov.v1.Vote is not mutable"))
-	case "cosmos.gov.v1.Vote.voter":
-		panic(fmt.Errorf("field voter of message cosmos.gov.v1.Vote is not mutable"))
-	case "cosmos.gov.v1.Vote.metadata":
-		panic(fmt.Errorf("field metadata of message cosmos.gov.v1.Vote is not mutable"))
+	case "cosmos.gov.v1.TallyResult.yes_count":
+		panic(fmt.Errorf("field yes_count of message cosmos.gov.v1.TallyResult is not mutable"))
+	case "cosmos.gov.v1.TallyResult.abstain_count":
+		panic(fmt.Errorf("field abstain_count of message cosmos.gov.v1.TallyResult is not mutable"))
+	case "cosmos.gov.v1.TallyResult.no_count":
+		panic(fmt.Errorf("field no_count of message cosmos.gov.v1.TallyResult is not mutable"))
+	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
+		panic(fmt.Errorf("field no_with_veto_count of message cosmos.gov.v1.TallyResult is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Vote) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TallyResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Vote.proposal_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.gov.v1.Vote.voter":
+	case "cosmos.gov.v1.TallyResult.yes_count":
 		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.Vote.options":
-		list := []*WeightedVoteOption{}
-		return protoreflect.ValueOfList(&_Vote_4_list{list: &list})
-	case "cosmos.gov.v1.Vote.metadata":
+	case "cosmos.gov.v1.TallyResult.abstain_count":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.TallyResult.no_count":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.TallyResult.no_with_veto_count":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyResult"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Vote) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TallyResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.Vote", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.TallyResult", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3479,7 +3555,7 @@ func (x *fastReflection_Vote) WhichOneof(d protoreflect.OneofDescriptor) protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Vote) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TallyResult) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3490,7 +3566,7 @@ func (x *fastReflection_Vote) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Vote) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TallyResult) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3502,7 +3578,7 @@ func (x *fastReflection_Vote) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Vote) IsValid() bool {
+func (x *fastReflection_TallyResult) IsValid() bool {
 	return x != nil
 }
 
@@ -3512,9 +3588,9 @@ func (x *fastReflection_Vote) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Vote)
+		x := input.Message.Interface().(*TallyResult)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3526,20 +3602,19 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.ProposalId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		l = len(x.YesCount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Voter)
+		l = len(x.AbstainCount)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Options) > 0 {
-			for _, e := range x.Options {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.NoCount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Metadata)
+		l = len(x.NoWithVetoCount)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -3553,7 +3628,7 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Vote)
+		x := input.Message.Interface().(*TallyResult)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3572,40 +3647,33 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Metadata) > 0 {
-			i -= len(x.Metadata)
-			copy(dAtA[i:], x.Metadata)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
+		if len(x.NoWithVetoCount) > 0 {
+			i -= len(x.NoWithVetoCount)
+			copy(dAtA[i:], x.NoWithVetoCount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NoWithVetoCount)))
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x22
 		}
-		if len(x.Options) > 0 {
-			for iNdEx := len(x.Options) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Options[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x22
-			}
+		if len(x.NoCount) > 0 {
+			i -= len(x.NoCount)
+			copy(dAtA[i:], x.NoCount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NoCount)))
+			i--
+			dAtA[i] = 0x1a
 		}
-		if len(x.Voter) > 0 {
-			i -= len(x.Voter)
-			copy(dAtA[i:], x.Voter)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
+		if len(x.AbstainCount) > 0 {
+			i -= len(x.AbstainCount)
+			copy(dAtA[i:], x.AbstainCount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AbstainCount)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.ProposalId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+		if len(x.YesCount) > 0 {
+			i -= len(x.YesCount)
+			copy(dAtA[i:], x.YesCount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.YesCount)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3618,7 +3686,7 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Vote)
+		x := input.Message.Interface().(*TallyResult)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3650,17 +3718,17 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Vote: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
 				}
-				x.ProposalId = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3670,14 +3738,27 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ProposalId |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.YesCount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3705,13 +3786,13 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Voter = string(dAtA[iNdEx:postIndex])
+				x.AbstainCount = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 4:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3721,29 +3802,27 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Options = append(x.Options, &WeightedVoteOption{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Options[len(x.Options)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.NoCount = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 5:
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NoWithVetoCount", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3771,7 +3850,7 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Metadata = string(dAtA[iNdEx:postIndex])
+				x.NoWithVetoCount = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -3808,79 +3887,89 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_DepositParams_1_list)(nil)
+var _ protoreflect.List = (*_TallySnapshot_7_list)(nil)
 
-type _DepositParams_1_list struct {
-	list *[]*v1beta1.Coin
+type _TallySnapshot_7_list struct {
+	list *[]*OptionTallyResult
 }
 
-func (x *_DepositParams_1_list) Len() int {
+func (x *_TallySnapshot_7_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_DepositParams_1_list) Get(i int) protoreflect.Value {
+func (x *_TallySnapshot_7_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_DepositParams_1_list) Set(i int, value protoreflect.Value) {
+func (x *_TallySnapshot_7_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	concreteValue := valueUnwrapped.Interface().(*OptionTallyResult)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_DepositParams_1_list) Append(value protoreflect.Value) {
+func (x *_TallySnapshot_7_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	concreteValue := valueUnwrapped.Interface().(*OptionTallyResult)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_DepositParams_1_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
+func (x *_TallySnapshot_7_list) AppendMutable() protoreflect.Value {
+	v := new(OptionTallyResult)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_DepositParams_1_list) Truncate(n int) {
+func (x *_TallySnapshot_7_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_DepositParams_1_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
+func (x *_TallySnapshot_7_list) NewElement() protoreflect.Value {
+	v := new(OptionTallyResult)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_DepositParams_1_list) IsValid() bool {
+func (x *_TallySnapshot_7_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_DepositParams                    protoreflect.MessageDescriptor
-	fd_DepositParams_min_deposit        protoreflect.FieldDescriptor
-	fd_DepositParams_max_deposit_period protoreflect.FieldDescriptor
+	md_TallySnapshot                      protoreflect.MessageDescriptor
+	fd_TallySnapshot_proposal_id          protoreflect.FieldDescriptor
+	fd_TallySnapshot_tally                protoreflect.FieldDescriptor
+	fd_TallySnapshot_validator_tally      protoreflect.FieldDescriptor
+	fd_TallySnapshot_delegator_tally      protoreflect.FieldDescriptor
+	fd_TallySnapshot_total_bonded_tokens  protoreflect.FieldDescriptor
+	fd_TallySnapshot_tally_time           protoreflect.FieldDescriptor
+	fd_TallySnapshot_option_tally_results protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gov_v1_gov_proto_init()
-	md_DepositParams = File_cosmos_gov_v1_gov_proto.Messages().ByName("DepositParams")
-	fd_DepositParams_min_deposit = md_DepositParams.Fields().ByName("min_deposit")
-	fd_DepositParams_max_deposit_period = md_DepositParams.Fields().ByName("max_deposit_period")
+	md_TallySnapshot = File_cosmos_gov_v1_gov_proto.Messages().ByName("TallySnapshot")
+	fd_TallySnapshot_proposal_id = md_TallySnapshot.Fields().ByName("proposal_id")
+	fd_TallySnapshot_tally = md_TallySnapshot.Fields().ByName("tally")
+	fd_TallySnapshot_validator_tally = md_TallySnapshot.Fields().ByName("validator_tally")
+	fd_TallySnapshot_delegator_tally = md_TallySnapshot.Fields().ByName("delegator_tally")
+	fd_TallySnapshot_total_bonded_tokens = md_TallySnapshot.Fields().ByName("total_bonded_tokens")
+	fd_TallySnapshot_tally_time = md_TallySnapshot.Fields().ByName("tally_time")
+	fd_TallySnapshot_option_tally_results = md_TallySnapshot.Fields().ByName("option_tally_results")
 }
 
-var _ protoreflect.Message = (*fastReflection_DepositParams)(nil)
+var _ protoreflect.Message = (*fastReflection_TallySnapshot)(nil)
 
-type fastReflection_DepositParams DepositParams
+type fastReflection_TallySnapshot TallySnapshot
 
-func (x *DepositParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_DepositParams)(x)
+func (x *TallySnapshot) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TallySnapshot)(x)
 }
 
-func (x *DepositParams) slowProtoReflect() protoreflect.Message {
+func (x *TallySnapshot) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3892,43 +3981,43 @@ func (x *DepositParams) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_DepositParams_messageType fastReflection_DepositParams_messageType
-var _ protoreflect.MessageType = fastReflection_DepositParams_messageType{}
+var _fastReflection_TallySnapshot_messageType fastReflection_TallySnapshot_messageType
+var _ protoreflect.MessageType = fastReflection_TallySnapshot_messageType{}
 
-type fastReflection_DepositParams_messageType struct{}
+type fastReflection_TallySnapshot_messageType struct{}
 
-func (x fastReflection_DepositParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_DepositParams)(nil)
+func (x fastReflection_TallySnapshot_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TallySnapshot)(nil)
 }
-func (x fastReflection_DepositParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_DepositParams)
+func (x fastReflection_TallySnapshot_messageType) New() protoreflect.Message {
+	return new(fastReflection_TallySnapshot)
 }
-func (x fastReflection_DepositParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_DepositParams
+func (x fastReflection_TallySnapshot_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TallySnapshot
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_DepositParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_DepositParams
+func (x *fastReflection_TallySnapshot) Descriptor() protoreflect.MessageDescriptor {
+	return md_TallySnapshot
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_DepositParams) Type() protoreflect.MessageType {
-	return _fastReflection_DepositParams_messageType
+func (x *fastReflection_TallySnapshot) Type() protoreflect.MessageType {
+	return _fastReflection_TallySnapshot_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_DepositParams) New() protoreflect.Message {
-	return new(fastReflection_DepositParams)
+func (x *fastReflection_TallySnapshot) New() protoreflect.Message {
+	return new(fastReflection_TallySnapshot)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_DepositParams) Interface() protoreflect.ProtoMessage {
-	return (*DepositParams)(x)
+func (x *fastReflection_TallySnapshot) Interface() protoreflect.ProtoMessage {
+	return (*TallySnapshot)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3936,16 +4025,46 @@ func (x *fastReflection_DepositParams) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_DepositParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.MinDeposit) != 0 {
-		value := protoreflect.ValueOfList(&_DepositParams_1_list{list: &x.MinDeposit})
-		if !f(fd_DepositParams_min_deposit, value) {
+func (x *fastReflection_TallySnapshot) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_TallySnapshot_proposal_id, value) {
 			return
 		}
 	}
-	if x.MaxDepositPeriod != nil {
-		value := protoreflect.ValueOfMessage(x.MaxDepositPeriod.ProtoReflect())
-		if !f(fd_DepositParams_max_deposit_period, value) {
+	if x.Tally != nil {
+		value := protoreflect.ValueOfMessage(x.Tally.ProtoReflect())
+		if !f(fd_TallySnapshot_tally, value) {
+			return
+		}
+	}
+	if x.ValidatorTally != nil {
+		value := protoreflect.ValueOfMessage(x.ValidatorTally.ProtoReflect())
+		if !f(fd_TallySnapshot_validator_tally, value) {
+			return
+		}
+	}
+	if x.DelegatorTally != nil {
+		value := protoreflect.ValueOfMessage(x.DelegatorTally.ProtoReflect())
+		if !f(fd_TallySnapshot_delegator_tally, value) {
+			return
+		}
+	}
+	if x.TotalBondedTokens != "" {
+		value := protoreflect.ValueOfString(x.TotalBondedTokens)
+		if !f(fd_TallySnapshot_total_bonded_tokens, value) {
+			return
+		}
+	}
+	if x.TallyTime != nil {
+		value := protoreflect.ValueOfMessage(x.TallyTime.ProtoReflect())
+		if !f(fd_TallySnapshot_tally_time, value) {
+			return
+		}
+	}
+	if len(x.OptionTallyResults) != 0 {
+		value := protoreflect.ValueOfList(&_TallySnapshot_7_list{list: &x.OptionTallyResults})
+		if !f(fd_TallySnapshot_option_tally_results, value) {
 			return
 		}
 	}
@@ -3962,17 +4081,27 @@ func (x *fastReflection_DepositParams) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_DepositParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TallySnapshot) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.DepositParams.min_deposit":
-		return len(x.MinDeposit) != 0
-	case "cosmos.gov.v1.DepositParams.max_deposit_period":
-		return x.MaxDepositPeriod != nil
+	case "cosmos.gov.v1.TallySnapshot.proposal_id":
+		return x.ProposalId != uint64(0)
+	case "cosmos.gov.v1.TallySnapshot.tally":
+		return x.Tally != nil
+	case "cosmos.gov.v1.TallySnapshot.validator_tally":
+		return x.ValidatorTally != nil
+	case "cosmos.gov.v1.TallySnapshot.delegator_tally":
+		return x.DelegatorTally != nil
+	case "cosmos.gov.v1.TallySnapshot.total_bonded_tokens":
+		return x.TotalBondedTokens != ""
+	case "cosmos.gov.v1.TallySnapshot.tally_time":
+		return x.TallyTime != nil
+	case "cosmos.gov.v1.TallySnapshot.option_tally_results":
+		return len(x.OptionTallyResults) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallySnapshot"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.TallySnapshot does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3982,17 +4111,27 @@ func (x *fastReflection_DepositParams) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DepositParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TallySnapshot) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.DepositParams.min_deposit":
-		x.MinDeposit = nil
-	case "cosmos.gov.v1.DepositParams.max_deposit_period":
-		x.MaxDepositPeriod = nil
+	case "cosmos.gov.v1.TallySnapshot.proposal_id":
+		x.ProposalId = uint64(0)
+	case "cosmos.gov.v1.TallySnapshot.tally":
+		x.Tally = nil
+	case "cosmos.gov.v1.TallySnapshot.validator_tally":
+		x.ValidatorTally = nil
+	case "cosmos.gov.v1.TallySnapshot.delegator_tally":
+		x.DelegatorTally = nil
+	case "cosmos.gov.v1.TallySnapshot.total_bonded_tokens":
+		x.TotalBondedTokens = ""
+	case "cosmos.gov.v1.TallySnapshot.tally_time":
+		x.TallyTime = nil
+	case "cosmos.gov.v1.TallySnapshot.option_tally_results":
+		x.OptionTallyResults = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallySnapshot"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.TallySnapshot does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4002,22 +4141,37 @@ func (x *fastReflection_DepositParams) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_DepositParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TallySnapshot) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.DepositParams.min_deposit":
-		if len(x.MinDeposit) == 0 {
-			return protoreflect.ValueOfList(&_DepositParams_1_list{})
+	case "cosmos.gov.v1.TallySnapshot.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.gov.v1.TallySnapshot.tally":
+		value := x.Tally
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.TallySnapshot.validator_tally":
+		value := x.ValidatorTally
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.TallySnapshot.delegator_tally":
+		value := x.DelegatorTally
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.TallySnapshot.total_bonded_tokens":
+		value := x.TotalBondedTokens
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.TallySnapshot.tally_time":
+		value := x.TallyTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.TallySnapshot.option_tally_results":
+		if len(x.OptionTallyResults) == 0 {
+			return protoreflect.ValueOfList(&_TallySnapshot_7_list{})
 		}
-		listValue := &_DepositParams_1_list{list: &x.MinDeposit}
+		listValue := &_TallySnapshot_7_list{list: &x.OptionTallyResults}
 		return protoreflect.ValueOfList(listValue)
-	case "cosmos.gov.v1.DepositParams.max_deposit_period":
-		value := x.MaxDepositPeriod
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallySnapshot"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.TallySnapshot does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4031,19 +4185,29 @@ func (x *fastReflection_DepositParams) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DepositParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TallySnapshot) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.DepositParams.min_deposit":
+	case "cosmos.gov.v1.TallySnapshot.proposal_id":
+		x.ProposalId = value.Uint()
+	case "cosmos.gov.v1.TallySnapshot.tally":
+		x.Tally = value.Message().Interface().(*TallyResult)
+	case "cosmos.gov.v1.TallySnapshot.validator_tally":
+		x.ValidatorTally = value.Message().Interface().(*TallyResult)
+	case "cosmos.gov.v1.TallySnapshot.delegator_tally":
+		x.DelegatorTally = value.Message().Interface().(*TallyResult)
+	case "cosmos.gov.v1.TallySnapshot.total_bonded_tokens":
+		x.TotalBondedTokens = value.Interface().(string)
+	case "cosmos.gov.v1.TallySnapshot.tally_time":
+		x.TallyTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.gov.v1.TallySnapshot.option_tally_results":
 		lv := value.List()
-		clv := lv.(*_DepositParams_1_list)
-		x.MinDeposit = *clv.list
-	case "cosmos.gov.v1.DepositParams.max_deposit_period":
-		x.MaxDepositPeriod = value.Message().Interface().(*durationpb.Duration)
+		clv := lv.(*_TallySnapshot_7_list)
+		x.OptionTallyResults = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallySnapshot"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.TallySnapshot does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4057,53 +4221,85 @@ func (x *fastReflection_DepositParams) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DepositParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TallySnapshot) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.DepositParams.min_deposit":
-		if x.MinDeposit == nil {
-			x.MinDeposit = []*v1beta1.Coin{}
-		}
-		value := &_DepositParams_1_list{list: &x.MinDeposit}
+	case "cosmos.gov.v1.TallySnapshot.tally":
+		if x.Tally == nil {
+			x.Tally = new(TallyResult)
+		}
+		return protoreflect.ValueOfMessage(x.Tally.ProtoReflect())
+	case "cosmos.gov.v1.TallySnapshot.validator_tally":
+		if x.ValidatorTally == nil {
+			x.ValidatorTally = new(TallyResult)
+		}
+		return protoreflect.ValueOfMessage(x.ValidatorTally.ProtoReflect())
+	case "cosmos.gov.v1.TallySnapshot.delegator_tally":
+		if x.DelegatorTally == nil {
+			x.DelegatorTally = new(TallyResult)
+		}
+		return protoreflect.ValueOfMessage(x.DelegatorTally.ProtoReflect())
+	case "cosmos.gov.v1.TallySnapshot.tally_time":
+		if x.TallyTime == nil {
+			x.TallyTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.TallyTime.ProtoReflect())
+	case "cosmos.gov.v1.TallySnapshot.option_tally_results":
+		if x.OptionTallyResults == nil {
+			x.OptionTallyResults = []*OptionTallyResult{}
+		}
+		value := &_TallySnapshot_7_list{list: &x.OptionTallyResults}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.gov.v1.DepositParams.max_deposit_period":
-		if x.MaxDepositPeriod == nil {
-			x.MaxDepositPeriod = new(durationpb.Duration)
-		}
-		return protoreflect.ValueOfMessage(x.MaxDepositPeriod.ProtoReflect())
+	case "cosmos.gov.v1.TallySnapshot.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.gov.v1.TallySnapshot is not mutable"))
+	case "cosmos.gov.v1.TallySnapshot.total_bonded_tokens":
+		panic(fmt.Errorf("field total_bonded_tokens of message cosmos.gov.v1.TallySnapshot is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallySnapshot"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.TallySnapshot does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_DepositParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TallySnapshot) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.DepositParams.min_deposit":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_DepositParams_1_list{list: &list})
-	case "cosmos.gov.v1.DepositParams.max_deposit_period":
-		m := new(durationpb.Duration)
+	case "cosmos.gov.v1.TallySnapshot.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.gov.v1.TallySnapshot.tally":
+		m := new(TallyResult)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.TallySnapshot.validator_tally":
+		m := new(TallyResult)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.TallySnapshot.delegator_tally":
+		m := new(TallyResult)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.TallySnapshot.total_bonded_tokens":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.TallySnapshot.tally_time":
+		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.TallySnapshot.option_tally_results":
+		list := []*OptionTallyResult{}
+		return protoreflect.ValueOfList(&_TallySnapshot_7_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallySnapshot"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.TallySnapshot does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_DepositParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TallySnapshot) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.DepositParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.TallySnapshot", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4111,7 +4307,7 @@ func (x *fastReflection_DepositParams) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_DepositParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TallySnapshot) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4122,7 +4318,7 @@ func (x *fastReflection_DepositParams) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DepositParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TallySnapshot) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4134,7 +4330,7 @@ func (x *fastReflection_DepositParams) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_DepositParams) IsValid() bool {
+func (x *fastReflection_TallySnapshot) IsValid() bool {
 	return x != nil
 }
 
@@ -4144,9 +4340,9 @@ func (x *fastReflection_DepositParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_DepositParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TallySnapshot) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*DepositParams)
+		x := input.Message.Interface().(*TallySnapshot)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4158,16 +4354,35 @@ func (x *fastReflection_DepositParams) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.MinDeposit) > 0 {
-			for _, e := range x.MinDeposit {
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		}
+		if x.Tally != nil {
+			l = options.Size(x.Tally)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ValidatorTally != nil {
+			l = options.Size(x.ValidatorTally)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DelegatorTally != nil {
+			l = options.Size(x.DelegatorTally)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TotalBondedTokens)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TallyTime != nil {
+			l = options.Size(x.TallyTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.OptionTallyResults) > 0 {
+			for _, e := range x.OptionTallyResults {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.MaxDepositPeriod != nil {
-			l = options.Size(x.MaxDepositPeriod)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4178,7 +4393,7 @@ func (x *fastReflection_DepositParams) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*DepositParams)
+		x := input.Message.Interface().(*TallySnapshot)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4197,8 +4412,24 @@ func (x *fastReflection_DepositParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.MaxDepositPeriod != nil {
-			encoded, err := options.Marshal(x.MaxDepositPeriod)
+		if len(x.OptionTallyResults) > 0 {
+			for iNdEx := len(x.OptionTallyResults) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.OptionTallyResults[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.TallyTime != nil {
+			encoded, err := options.Marshal(x.TallyTime)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4209,23 +4440,61 @@ func (x *fastReflection_DepositParams) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x32
 		}
-		if len(x.MinDeposit) > 0 {
-			for iNdEx := len(x.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.MinDeposit[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
+		if len(x.TotalBondedTokens) > 0 {
+			i -= len(x.TotalBondedTokens)
+			copy(dAtA[i:], x.TotalBondedTokens)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalBondedTokens)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.DelegatorTally != nil {
+			encoded, err := options.Marshal(x.DelegatorTally)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.ValidatorTally != nil {
+			encoded, err := options.Marshal(x.ValidatorTally)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Tally != nil {
+			encoded, err := options.Marshal(x.Tally)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4238,7 +4507,7 @@ func (x *fastReflection_DepositParams) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*DepositParams)
+		x := input.Message.Interface().(*TallySnapshot)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4270,15 +4539,34 @@ func (x *fastReflection_DepositParams) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositParams: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallySnapshot: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositParams: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallySnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4305,14 +4593,16 @@ func (x *fastReflection_DepositParams) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MinDeposit = append(x.MinDeposit, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinDeposit[len(x.MinDeposit)-1]); err != nil {
+				if x.Tally == nil {
+					x.Tally = &TallyResult{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tally); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorTally", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4339,68 +4629,208 @@ func (x *fastReflection_DepositParams) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.MaxDepositPeriod == nil {
-					x.MaxDepositPeriod = &durationpb.Duration{}
+				if x.ValidatorTally == nil {
+					x.ValidatorTally = &TallyResult{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxDepositPeriod); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValidatorTally); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegatorTally", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DelegatorTally == nil {
+					x.DelegatorTally = &TallyResult{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DelegatorTally); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalBondedTokens", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.TotalBondedTokens = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TallyTime", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_VotingParams               protoreflect.MessageDescriptor
-	fd_VotingParams_voting_period protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_cosmos_gov_v1_gov_proto_init()
-	md_VotingParams = File_cosmos_gov_v1_gov_proto.Messages().ByName("VotingParams")
-	fd_VotingParams_voting_period = md_VotingParams.Fields().ByName("voting_period")
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TallyTime == nil {
+					x.TallyTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TallyTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptionTallyResults", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OptionTallyResults = append(x.OptionTallyResults, &OptionTallyResult{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.OptionTallyResults[len(x.OptionTallyResults)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
 }
 
-var _ protoreflect.Message = (*fastReflection_VotingParams)(nil)
+var (
+	md_OptionTallyResult        protoreflect.MessageDescriptor
+	fd_OptionTallyResult_option protoreflect.FieldDescriptor
+	fd_OptionTallyResult_count  protoreflect.FieldDescriptor
+)
 
-type fastReflection_VotingParams VotingParams
+func init() {
+	file_cosmos_gov_v1_gov_proto_init()
+	md_OptionTallyResult = File_cosmos_gov_v1_gov_proto.Messages().ByName("OptionTallyResult")
+	fd_OptionTallyResult_option = md_OptionTallyResult.Fields().ByName("option")
+	fd_OptionTallyResult_count = md_OptionTallyResult.Fields().ByName("count")
+}
 
-func (x *VotingParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_VotingParams)(x)
+var _ protoreflect.Message = (*fastReflection_OptionTallyResult)(nil)
+
+type fastReflection_OptionTallyResult OptionTallyResult
+
+func (x *OptionTallyResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OptionTallyResult)(x)
 }
 
-func (x *VotingParams) slowProtoReflect() protoreflect.Message {
+func (x *OptionTallyResult) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4412,43 +4842,43 @@ func (x *VotingParams) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_VotingParams_messageType fastReflection_VotingParams_messageType
-var _ protoreflect.MessageType = fastReflection_VotingParams_messageType{}
+var _fastReflection_OptionTallyResult_messageType fastReflection_OptionTallyResult_messageType
+var _ protoreflect.MessageType = fastReflection_OptionTallyResult_messageType{}
 
-type fastReflection_VotingParams_messageType struct{}
+type fastReflection_OptionTallyResult_messageType struct{}
 
-func (x fastReflection_VotingParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_VotingParams)(nil)
+func (x fastReflection_OptionTallyResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OptionTallyResult)(nil)
 }
-func (x fastReflection_VotingParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_VotingParams)
+func (x fastReflection_OptionTallyResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_OptionTallyResult)
 }
-func (x fastReflection_VotingParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_VotingParams
+func (x fastReflection_OptionTallyResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OptionTallyResult
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_VotingParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_VotingParams
+func (x *fastReflection_OptionTallyResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_OptionTallyResult
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_VotingParams) Type() protoreflect.MessageType {
-	return _fastReflection_VotingParams_messageType
+func (x *fastReflection_OptionTallyResult) Type() protoreflect.MessageType {
+	return _fastReflection_OptionTallyResult_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_VotingParams) New() protoreflect.Message {
-	return new(fastReflection_VotingParams)
+func (x *fastReflection_OptionTallyResult) New() protoreflect.Message {
+	return new(fastReflection_OptionTallyResult)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_VotingParams) Interface() protoreflect.ProtoMessage {
-	return (*VotingParams)(x)
+func (x *fastReflection_OptionTallyResult) Interface() protoreflect.ProtoMessage {
+	return (*OptionTallyResult)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4456,10 +4886,16 @@ func (x *fastReflection_VotingParams) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_VotingParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.VotingPeriod != nil {
-		value := protoreflect.ValueOfMessage(x.VotingPeriod.ProtoReflect())
-		if !f(fd_VotingParams_voting_period, value) {
+func (x *fastReflection_OptionTallyResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Option != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Option)
+		if !f(fd_OptionTallyResult_option, value) {
+			return
+		}
+	}
+	if x.Count != "" {
+		value := protoreflect.ValueOfString(x.Count)
+		if !f(fd_OptionTallyResult_count, value) {
 			return
 		}
 	}
@@ -4476,15 +4912,17 @@ func (x *fastReflection_VotingParams) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_VotingParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_OptionTallyResult) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.VotingParams.voting_period":
-		return x.VotingPeriod != nil
+	case "cosmos.gov.v1.OptionTallyResult.option":
+		return x.Option != uint32(0)
+	case "cosmos.gov.v1.OptionTallyResult.count":
+		return x.Count != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.OptionTallyResult"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.OptionTallyResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4494,15 +4932,17 @@ func (x *fastReflection_VotingParams) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VotingParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_OptionTallyResult) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.VotingParams.voting_period":
-		x.VotingPeriod = nil
+	case "cosmos.gov.v1.OptionTallyResult.option":
+		x.Option = uint32(0)
+	case "cosmos.gov.v1.OptionTallyResult.count":
+		x.Count = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.OptionTallyResult"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.OptionTallyResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4512,16 +4952,19 @@ func (x *fastReflection_VotingParams) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_VotingParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OptionTallyResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.VotingParams.voting_period":
-		value := x.VotingPeriod
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.OptionTallyResult.option":
+		value := x.Option
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.gov.v1.OptionTallyResult.count":
+		value := x.Count
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.OptionTallyResult"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.OptionTallyResult does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4535,15 +4978,17 @@ func (x *fastReflection_VotingParams) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VotingParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_OptionTallyResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.VotingParams.voting_period":
-		x.VotingPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.gov.v1.OptionTallyResult.option":
+		x.Option = uint32(value.Uint())
+	case "cosmos.gov.v1.OptionTallyResult.count":
+		x.Count = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.OptionTallyResult"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.OptionTallyResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4557,44 +5002,44 @@ func (x *fastReflection_VotingParams) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VotingParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OptionTallyResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.VotingParams.voting_period":
-		if x.VotingPeriod == nil {
-			x.VotingPeriod = new(durationpb.Duration)
-		}
-		return protoreflect.ValueOfMessage(x.VotingPeriod.ProtoReflect())
+	case "cosmos.gov.v1.OptionTallyResult.option":
+		panic(fmt.Errorf("field option of message cosmos.gov.v1.OptionTallyResult is not mutable"))
+	case "cosmos.gov.v1.OptionTallyResult.count":
+		panic(fmt.Errorf("field count of message cosmos.gov.v1.OptionTallyResult is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.OptionTallyResult"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.OptionTallyResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_VotingParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_OptionTallyResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.VotingParams.voting_period":
-		m := new(durationpb.Duration)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.OptionTallyResult.option":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.gov.v1.OptionTallyResult.count":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.OptionTallyResult"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.OptionTallyResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_VotingParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_OptionTallyResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.VotingParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.OptionTallyResult", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4602,7 +5047,7 @@ func (x *fastReflection_VotingParams) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_VotingParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_OptionTallyResult) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4613,7 +5058,7 @@ func (x *fastReflection_VotingParams) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_VotingParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_OptionTallyResult) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4625,7 +5070,7 @@ func (x *fastReflection_VotingParams) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_VotingParams) IsValid() bool {
+func (x *fastReflection_OptionTallyResult) IsValid() bool {
 	return x != nil
 }
 
@@ -4635,9 +5080,9 @@ func (x *fastReflection_VotingParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_VotingParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_OptionTallyResult) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*VotingParams)
+		x := input.Message.Interface().(*OptionTallyResult)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4649,8 +5094,11 @@ func (x *fastReflection_VotingParams) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.VotingPeriod != nil {
-			l = options.Size(x.VotingPeriod)
+		if x.Option != 0 {
+			n += 1 + runtime.Sov(uint64(x.Option))
+		}
+		l = len(x.Count)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -4663,7 +5111,7 @@ func (x *fastReflection_VotingParams) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*VotingParams)
+		x := input.Message.Interface().(*OptionTallyResult)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4682,19 +5130,17 @@ func (x *fastReflection_VotingParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.VotingPeriod != nil {
-			encoded, err := options.Marshal(x.VotingPeriod)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Count) > 0 {
+			i -= len(x.Count)
+			copy(dAtA[i:], x.Count)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Count)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if x.Option != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Option))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4707,7 +5153,7 @@ func (x *fastReflection_VotingParams) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*VotingParams)
+		x := input.Message.Interface().(*OptionTallyResult)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4739,17 +5185,36 @@ func (x *fastReflection_VotingParams) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VotingParams: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OptionTallyResult: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VotingParams: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OptionTallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+				}
+				x.Option = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Option |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4759,27 +5224,23 @@ func (x *fastReflection_VotingParams) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.VotingPeriod == nil {
-					x.VotingPeriod = &durationpb.Duration{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingPeriod); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Count = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -4816,30 +5277,83 @@ func (x *fastReflection_VotingParams) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var (
-	md_TallyParams                protoreflect.MessageDescriptor
-	fd_TallyParams_quorum         protoreflect.FieldDescriptor
-	fd_TallyParams_threshold      protoreflect.FieldDescriptor
-	fd_TallyParams_veto_threshold protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_Vote_4_list)(nil)
 
-func init() {
-	file_cosmos_gov_v1_gov_proto_init()
-	md_TallyParams = File_cosmos_gov_v1_gov_proto.Messages().ByName("TallyParams")
-	fd_TallyParams_quorum = md_TallyParams.Fields().ByName("quorum")
-	fd_TallyParams_threshold = md_TallyParams.Fields().ByName("threshold")
-	fd_TallyParams_veto_threshold = md_TallyParams.Fields().ByName("veto_threshold")
+type _Vote_4_list struct {
+	list *[]*WeightedVoteOption
 }
 
-var _ protoreflect.Message = (*fastReflection_TallyParams)(nil)
-
-type fastReflection_TallyParams TallyParams
-
-func (x *TallyParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TallyParams)(x)
+func (x *_Vote_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-func (x *TallyParams) slowProtoReflect() protoreflect.Message {
+func (x *_Vote_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Vote_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*WeightedVoteOption)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Vote_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*WeightedVoteOption)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Vote_4_list) AppendMutable() protoreflect.Value {
+	v := new(WeightedVoteOption)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Vote_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Vote_4_list) NewElement() protoreflect.Value {
+	v := new(WeightedVoteOption)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Vote_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Vote             protoreflect.MessageDescriptor
+	fd_Vote_proposal_id protoreflect.FieldDescriptor
+	fd_Vote_voter       protoreflect.FieldDescriptor
+	fd_Vote_options     protoreflect.FieldDescriptor
+	fd_Vote_metadata    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_gov_proto_init()
+	md_Vote = File_cosmos_gov_v1_gov_proto.Messages().ByName("Vote")
+	fd_Vote_proposal_id = md_Vote.Fields().ByName("proposal_id")
+	fd_Vote_voter = md_Vote.Fields().ByName("voter")
+	fd_Vote_options = md_Vote.Fields().ByName("options")
+	fd_Vote_metadata = md_Vote.Fields().ByName("metadata")
+}
+
+var _ protoreflect.Message = (*fastReflection_Vote)(nil)
+
+type fastReflection_Vote Vote
+
+func (x *Vote) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Vote)(x)
+}
+
+func (x *Vote) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4851,43 +5365,43 @@ func (x *TallyParams) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TallyParams_messageType fastReflection_TallyParams_messageType
-var _ protoreflect.MessageType = fastReflection_TallyParams_messageType{}
+var _fastReflection_Vote_messageType fastReflection_Vote_messageType
+var _ protoreflect.MessageType = fastReflection_Vote_messageType{}
 
-type fastReflection_TallyParams_messageType struct{}
+type fastReflection_Vote_messageType struct{}
 
-func (x fastReflection_TallyParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TallyParams)(nil)
+func (x fastReflection_Vote_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Vote)(nil)
 }
-func (x fastReflection_TallyParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_TallyParams)
+func (x fastReflection_Vote_messageType) New() protoreflect.Message {
+	return new(fastReflection_Vote)
 }
-func (x fastReflection_TallyParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TallyParams
+func (x fastReflection_Vote_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Vote
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TallyParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_TallyParams
+func (x *fastReflection_Vote) Descriptor() protoreflect.MessageDescriptor {
+	return md_Vote
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TallyParams) Type() protoreflect.MessageType {
-	return _fastReflection_TallyParams_messageType
+func (x *fastReflection_Vote) Type() protoreflect.MessageType {
+	return _fastReflection_Vote_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TallyParams) New() protoreflect.Message {
-	return new(fastReflection_TallyParams)
+func (x *fastReflection_Vote) New() protoreflect.Message {
+	return new(fastReflection_Vote)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TallyParams) Interface() protoreflect.ProtoMessage {
-	return (*TallyParams)(x)
+func (x *fastReflection_Vote) Interface() protoreflect.ProtoMessage {
+	return (*Vote)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4895,22 +5409,28 @@ func (x *fastReflection_TallyParams) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TallyParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Quorum != "" {
-		value := protoreflect.ValueOfString(x.Quorum)
-		if !f(fd_TallyParams_quorum, value) {
+func (x *fastReflection_Vote) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_Vote_proposal_id, value) {
 			return
 		}
 	}
-	if x.Threshold != "" {
-		value := protoreflect.ValueOfString(x.Threshold)
-		if !f(fd_TallyParams_threshold, value) {
+	if x.Voter != "" {
+		value := protoreflect.ValueOfString(x.Voter)
+		if !f(fd_Vote_voter, value) {
 			return
 		}
 	}
-	if x.VetoThreshold != "" {
-		value := protoreflect.ValueOfString(x.VetoThreshold)
-		if !f(fd_TallyParams_veto_threshold, value) {
+	if len(x.Options) != 0 {
+		value := protoreflect.ValueOfList(&_Vote_4_list{list: &x.Options})
+		if !f(fd_Vote_options, value) {
+			return
+		}
+	}
+	if x.Metadata != "" {
+		value := protoreflect.ValueOfString(x.Metadata)
+		if !f(fd_Vote_metadata, value) {
 			return
 		}
 	}
@@ -4927,19 +5447,21 @@ func (x *fastReflection_TallyParams) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TallyParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Vote) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.TallyParams.quorum":
-		return x.Quorum != ""
-	case "cosmos.gov.v1.TallyParams.threshold":
-		return x.Threshold != ""
-	case "cosmos.gov.v1.TallyParams.veto_threshold":
-		return x.VetoThreshold != ""
+	case "cosmos.gov.v1.Vote.proposal_id":
+		return x.ProposalId != uint64(0)
+	case "cosmos.gov.v1.Vote.voter":
+		return x.Voter != ""
+	case "cosmos.gov.v1.Vote.options":
+		return len(x.Options) != 0
+	case "cosmos.gov.v1.Vote.metadata":
+		return x.Metadata != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4949,19 +5471,21 @@ func (x *fastReflection_TallyParams) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Vote) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.TallyParams.quorum":
-		x.Quorum = ""
-	case "cosmos.gov.v1.TallyParams.threshold":
-		x.Threshold = ""
-	case "cosmos.gov.v1.TallyParams.veto_threshold":
-		x.VetoThreshold = ""
+	case "cosmos.gov.v1.Vote.proposal_id":
+		x.ProposalId = uint64(0)
+	case "cosmos.gov.v1.Vote.voter":
+		x.Voter = ""
+	case "cosmos.gov.v1.Vote.options":
+		x.Options = nil
+	case "cosmos.gov.v1.Vote.metadata":
+		x.Metadata = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4971,22 +5495,28 @@ func (x *fastReflection_TallyParams) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TallyParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Vote) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.TallyParams.quorum":
-		value := x.Quorum
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.TallyParams.threshold":
-		value := x.Threshold
+	case "cosmos.gov.v1.Vote.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.gov.v1.Vote.voter":
+		value := x.Voter
 		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.TallyParams.veto_threshold":
-		value := x.VetoThreshold
+	case "cosmos.gov.v1.Vote.options":
+		if len(x.Options) == 0 {
+			return protoreflect.ValueOfList(&_Vote_4_list{})
+		}
+		listValue := &_Vote_4_list{list: &x.Options}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.gov.v1.Vote.metadata":
+		value := x.Metadata
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5000,19 +5530,23 @@ func (x *fastReflection_TallyParams) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Vote) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.TallyParams.quorum":
-		x.Quorum = value.Interface().(string)
-	case "cosmos.gov.v1.TallyParams.threshold":
-		x.Threshold = value.Interface().(string)
-	case "cosmos.gov.v1.TallyParams.veto_threshold":
-		x.VetoThreshold = value.Interface().(string)
+	case "cosmos.gov.v1.Vote.proposal_id":
+		x.ProposalId = value.Uint()
+	case "cosmos.gov.v1.Vote.voter":
+		x.Voter = value.Interface().(string)
+	case "cosmos.gov.v1.Vote.options":
+		lv := value.List()
+		clv := lv.(*_Vote_4_list)
+		x.Options = *clv.list
+	case "cosmos.gov.v1.Vote.metadata":
+		x.Metadata = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5026,48 +5560,57 @@ func (x *fastReflection_TallyParams) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Vote) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.TallyParams.quorum":
-		panic(fmt.Errorf("field quorum of message cosmos.gov.v1.TallyParams is not mutable"))
-	case "cosmos.gov.v1.TallyParams.threshold":
-		panic(fmt.Errorf("field threshold of message cosmos.gov.v1.TallyParams is not mutable"))
-	case "cosmos.gov.v1.TallyParams.veto_threshold":
-		panic(fmt.Errorf("field veto_threshold of message cosmos.gov.v1.TallyParams is not mutable"))
+	case "cosmos.gov.v1.Vote.options":
+		if x.Options == nil {
+			x.Options = []*WeightedVoteOption{}
+		}
+		value := &_Vote_4_list{list: &x.Options}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.Vote.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.gov.v1.Vote is not mutable"))
+	case "cosmos.gov.v1.Vote.voter":
+		panic(fmt.Errorf("field voter of message cosmos.gov.v1.Vote is not mutable"))
+	case "cosmos.gov.v1.Vote.metadata":
+		panic(fmt.Errorf("field metadata of message cosmos.gov.v1.Vote is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TallyParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Vote) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.TallyParams.quorum":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.TallyParams.threshold":
+	case "cosmos.gov.v1.Vote.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.gov.v1.Vote.voter":
 		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.TallyParams.veto_threshold":
+	case "cosmos.gov.v1.Vote.options":
+		list := []*WeightedVoteOption{}
+		return protoreflect.ValueOfList(&_Vote_4_list{list: &list})
+	case "cosmos.gov.v1.Vote.metadata":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Vote"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.Vote does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TallyParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Vote) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.TallyParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.Vote", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5075,7 +5618,7 @@ func (x *fastReflection_TallyParams) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TallyParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Vote) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5086,7 +5629,7 @@ func (x *fastReflection_TallyParams) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Vote) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5098,7 +5641,7 @@ func (x *fastReflection_TallyParams) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TallyParams) IsValid() bool {
+func (x *fastReflection_Vote) IsValid() bool {
 	return x != nil
 }
 
@@ -5108,9 +5651,9 @@ func (x *fastReflection_TallyParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TallyParams)
+		x := input.Message.Interface().(*Vote)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5122,15 +5665,20 @@ func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Quorum)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
 		}
-		l = len(x.Threshold)
+		l = len(x.Voter)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.VetoThreshold)
+		if len(x.Options) > 0 {
+			for _, e := range x.Options {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Metadata)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -5144,7 +5692,7 @@ func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TallyParams)
+		x := input.Message.Interface().(*Vote)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5163,26 +5711,40 @@ func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.VetoThreshold) > 0 {
-			i -= len(x.VetoThreshold)
-			copy(dAtA[i:], x.VetoThreshold)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VetoThreshold)))
+		if len(x.Metadata) > 0 {
+			i -= len(x.Metadata)
+			copy(dAtA[i:], x.Metadata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x2a
 		}
-		if len(x.Threshold) > 0 {
-			i -= len(x.Threshold)
-			copy(dAtA[i:], x.Threshold)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Threshold)))
+		if len(x.Options) > 0 {
+			for iNdEx := len(x.Options) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Options[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Voter) > 0 {
+			i -= len(x.Voter)
+			copy(dAtA[i:], x.Voter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Quorum) > 0 {
-			i -= len(x.Quorum)
-			copy(dAtA[i:], x.Quorum)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quorum)))
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5195,7 +5757,7 @@ func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TallyParams)
+		x := input.Message.Interface().(*Vote)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5227,15 +5789,34 @@ func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyParams: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Vote: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5263,13 +5844,13 @@ func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Quorum = string(dAtA[iNdEx:postIndex])
+				x.Voter = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5279,27 +5860,29 @@ func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Threshold = string(dAtA[iNdEx:postIndex])
+				x.Options = append(x.Options, &WeightedVoteOption{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Options[len(x.Options)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 3:
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5327,7 +5910,7 @@ func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.VetoThreshold = string(dAtA[iNdEx:postIndex])
+				x.Metadata = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -5364,156 +5947,79 @@ func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Params_1_list)(nil)
-
-type _Params_1_list struct {
-	list *[]*v1beta1.Coin
-}
-
-func (x *_Params_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_Params_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_Params_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_Params_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_Params_1_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Params_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Params_1_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Params_1_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_Params_12_list)(nil)
+var _ protoreflect.List = (*_DepositParams_1_list)(nil)
 
-type _Params_12_list struct {
+type _DepositParams_1_list struct {
 	list *[]*v1beta1.Coin
 }
 
-func (x *_Params_12_list) Len() int {
+func (x *_DepositParams_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_Params_12_list) Get(i int) protoreflect.Value {
+func (x *_DepositParams_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_Params_12_list) Set(i int, value protoreflect.Value) {
+func (x *_DepositParams_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_Params_12_list) Append(value protoreflect.Value) {
+func (x *_DepositParams_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_Params_12_list) AppendMutable() protoreflect.Value {
+func (x *_DepositParams_1_list) AppendMutable() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Params_12_list) Truncate(n int) {
+func (x *_DepositParams_1_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_Params_12_list) NewElement() protoreflect.Value {
+func (x *_DepositParams_1_list) NewElement() protoreflect.Value {
 	v := new(v1beta1.Coin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Params_12_list) IsValid() bool {
+func (x *_DepositParams_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_Params                               protoreflect.MessageDescriptor
-	fd_Params_min_deposit                   protoreflect.FieldDescriptor
-	fd_Params_max_deposit_period            protoreflect.FieldDescriptor
-	fd_Params_voting_period                 protoreflect.FieldDescriptor
-	fd_Params_quorum                        protoreflect.FieldDescriptor
-	fd_Params_threshold                     protoreflect.FieldDescriptor
-	fd_Params_veto_threshold                protoreflect.FieldDescriptor
-	fd_Params_min_initial_deposit_ratio     protoreflect.FieldDescriptor
-	fd_Params_proposal_cancel_ratio         protoreflect.FieldDescriptor
-	fd_Params_proposal_cancel_dest          protoreflect.FieldDescriptor
-	fd_Params_expedited_voting_period       protoreflect.FieldDescriptor
-	fd_Params_expedited_threshold           protoreflect.FieldDescriptor
-	fd_Params_expedited_min_deposit         protoreflect.FieldDescriptor
-	fd_Params_burn_vote_quorum              protoreflect.FieldDescriptor
-	fd_Params_burn_proposal_deposit_prevote protoreflect.FieldDescriptor
-	fd_Params_burn_vote_veto                protoreflect.FieldDescriptor
+	md_DepositParams                    protoreflect.MessageDescriptor
+	fd_DepositParams_min_deposit        protoreflect.FieldDescriptor
+	fd_DepositParams_max_deposit_period protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_gov_v1_gov_proto_init()
-	md_Params = File_cosmos_gov_v1_gov_proto.Messages().ByName("Params")
-	fd_Params_min_deposit = md_Params.Fields().ByName("min_deposit")
-	fd_Params_max_deposit_period = md_Params.Fields().ByName("max_deposit_period")
-	fd_Params_voting_period = md_Params.Fields().ByName("voting_period")
-	fd_Params_quorum = md_Params.Fields().ByName("quorum")
-	fd_Params_threshold = md_Params.Fields().ByName("threshold")
-	fd_Params_veto_threshold = md_Params.Fields().ByName("veto_threshold")
-	fd_Params_min_initial_deposit_ratio = md_Params.Fields().ByName("min_initial_deposit_ratio")
-	fd_Params_proposal_cancel_ratio = md_Params.Fields().ByName("proposal_cancel_ratio")
-	fd_Params_proposal_cancel_dest = md_Params.Fields().ByName("proposal_cancel_dest")
-	fd_Params_expedited_voting_period = md_Params.Fields().ByName("expedited_voting_period")
-	fd_Params_expedited_threshold = md_Params.Fields().ByName("expedited_threshold")
-	fd_Params_expedited_min_deposit = md_Params.Fields().ByName("expedited_min_deposit")
-	fd_Params_burn_vote_quorum = md_Params.Fields().ByName("burn_vote_quorum")
-	fd_Params_burn_proposal_deposit_prevote = md_Params.Fields().ByName("burn_proposal_deposit_prevote")
-	fd_Params_burn_vote_veto = md_Params.Fields().ByName("burn_vote_veto")
+	md_DepositParams = File_cosmos_gov_v1_gov_proto.Messages().ByName("DepositParams")
+	fd_DepositParams_min_deposit = md_DepositParams.Fields().ByName("min_deposit")
+	fd_DepositParams_max_deposit_period = md_DepositParams.Fields().ByName("max_deposit_period")
 }
 
-var _ protoreflect.Message = (*fastReflection_Params)(nil)
+var _ protoreflect.Message = (*fastReflection_DepositParams)(nil)
 
-type fastReflection_Params Params
+type fastReflection_DepositParams DepositParams
 
-func (x *Params) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Params)(x)
+func (x *DepositParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DepositParams)(x)
 }
 
-func (x *Params) slowProtoReflect() protoreflect.Message {
+func (x *DepositParams) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5525,43 +6031,43 @@ func (x *Params) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Params_messageType fastReflection_Params_messageType
-var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+var _fastReflection_DepositParams_messageType fastReflection_DepositParams_messageType
+var _ protoreflect.MessageType = fastReflection_DepositParams_messageType{}
 
-type fastReflection_Params_messageType struct{}
+type fastReflection_DepositParams_messageType struct{}
 
-func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Params)(nil)
+func (x fastReflection_DepositParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DepositParams)(nil)
 }
-func (x fastReflection_Params_messageType) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x fastReflection_DepositParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_DepositParams)
 }
-func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x fastReflection_DepositParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DepositParams
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x *fastReflection_DepositParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_DepositParams
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Params) Type() protoreflect.MessageType {
-	return _fastReflection_Params_messageType
+func (x *fastReflection_DepositParams) Type() protoreflect.MessageType {
+	return _fastReflection_DepositParams_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Params) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x *fastReflection_DepositParams) New() protoreflect.Message {
+	return new(fastReflection_DepositParams)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
-	return (*Params)(x)
+func (x *fastReflection_DepositParams) Interface() protoreflect.ProtoMessage {
+	return (*DepositParams)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5569,94 +6075,16 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_DepositParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if len(x.MinDeposit) != 0 {
-		value := protoreflect.ValueOfList(&_Params_1_list{list: &x.MinDeposit})
-		if !f(fd_Params_min_deposit, value) {
+		value := protoreflect.ValueOfList(&_DepositParams_1_list{list: &x.MinDeposit})
+		if !f(fd_DepositParams_min_deposit, value) {
 			return
 		}
 	}
 	if x.MaxDepositPeriod != nil {
 		value := protoreflect.ValueOfMessage(x.MaxDepositPeriod.ProtoReflect())
-		if !f(fd_Params_max_deposit_period, value) {
-			return
-		}
-	}
-	if x.VotingPeriod != nil {
-		value := protoreflect.ValueOfMessage(x.VotingPeriod.ProtoReflect())
-		if !f(fd_Params_voting_period, value) {
-			return
-		}
-	}
-	if x.Quorum != "" {
-		value := protoreflect.ValueOfString(x.Quorum)
-		if !f(fd_Params_quorum, value) {
-			return
-		}
-	}
-	if x.Threshold != "" {
-		value := protoreflect.ValueOfString(x.Threshold)
-		if !f(fd_Params_threshold, value) {
-			return
-		}
-	}
-	if x.VetoThreshold != "" {
-		value := protoreflect.ValueOfString(x.VetoThreshold)
-		if !f(fd_Params_veto_threshold, value) {
-			return
-		}
-	}
-	if x.MinInitialDepositRatio != "" {
-		value := protoreflect.ValueOfString(x.MinInitialDepositRatio)
-		if !f(fd_Params_min_initial_deposit_ratio, value) {
-			return
-		}
-	}
-	if x.ProposalCancelRatio != "" {
-		value := protoreflect.ValueOfString(x.ProposalCancelRatio)
-		if !f(fd_Params_proposal_cancel_ratio, value) {
-			return
-		}
-	}
-	if x.ProposalCancelDest != "" {
-		value := protoreflect.ValueOfString(x.ProposalCancelDest)
-		if !f(fd_Params_proposal_cancel_dest, value) {
-			return
-		}
-	}
-	if x.ExpeditedVotingPeriod != nil {
-		value := protoreflect.ValueOfMessage(x.ExpeditedVotingPeriod.ProtoReflect())
-		if !f(fd_Params_expedited_voting_period, value) {
-			return
-		}
-	}
-	if x.ExpeditedThreshold != "" {
-		value := protoreflect.ValueOfString(x.ExpeditedThreshold)
-		if !f(fd_Params_expedited_threshold, value) {
-			return
-		}
-	}
-	if len(x.ExpeditedMinDeposit) != 0 {
-		value := protoreflect.ValueOfList(&_Params_12_list{list: &x.ExpeditedMinDeposit})
-		if !f(fd_Params_expedited_min_deposit, value) {
-			return
-		}
-	}
-	if x.BurnVoteQuorum != false {
-		value := protoreflect.ValueOfBool(x.BurnVoteQuorum)
-		if !f(fd_Params_burn_vote_quorum, value) {
-			return
-		}
-	}
-	if x.BurnProposalDepositPrevote != false {
-		value := protoreflect.ValueOfBool(x.BurnProposalDepositPrevote)
-		if !f(fd_Params_burn_proposal_deposit_prevote, value) {
-			return
-		}
-	}
-	if x.BurnVoteVeto != false {
-		value := protoreflect.ValueOfBool(x.BurnVoteVeto)
-		if !f(fd_Params_burn_vote_veto, value) {
+		if !f(fd_DepositParams_max_deposit_period, value) {
 			return
 		}
 	}
@@ -5673,43 +6101,17 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_DepositParams) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Params.min_deposit":
+	case "cosmos.gov.v1.DepositParams.min_deposit":
 		return len(x.MinDeposit) != 0
-	case "cosmos.gov.v1.Params.max_deposit_period":
+	case "cosmos.gov.v1.DepositParams.max_deposit_period":
 		return x.MaxDepositPeriod != nil
-	case "cosmos.gov.v1.Params.voting_period":
-		return x.VotingPeriod != nil
-	case "cosmos.gov.v1.Params.quorum":
-		return x.Quorum != ""
-	case "cosmos.gov.v1.Params.threshold":
-		return x.Threshold != ""
-	case "cosmos.gov.v1.Params.veto_threshold":
-		return x.VetoThreshold != ""
-	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
-		return x.MinInitialDepositRatio != ""
-	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
-		return x.ProposalCancelRatio != ""
-	case "cosmos.gov.v1.Params.proposal_cancel_dest":
-		return x.ProposalCancelDest != ""
-	case "cosmos.gov.v1.Params.expedited_voting_period":
-		return x.ExpeditedVotingPeriod != nil
-	case "cosmos.gov.v1.Params.expedited_threshold":
-		return x.ExpeditedThreshold != ""
-	case "cosmos.gov.v1.Params.expedited_min_deposit":
-		return len(x.ExpeditedMinDeposit) != 0
-	case "cosmos.gov.v1.Params.burn_vote_quorum":
-		return x.BurnVoteQuorum != false
-	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
-		return x.BurnProposalDepositPrevote != false
-	case "cosmos.gov.v1.Params.burn_vote_veto":
-		return x.BurnVoteVeto != false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5719,43 +6121,17 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_DepositParams) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Params.min_deposit":
+	case "cosmos.gov.v1.DepositParams.min_deposit":
 		x.MinDeposit = nil
-	case "cosmos.gov.v1.Params.max_deposit_period":
+	case "cosmos.gov.v1.DepositParams.max_deposit_period":
 		x.MaxDepositPeriod = nil
-	case "cosmos.gov.v1.Params.voting_period":
-		x.VotingPeriod = nil
-	case "cosmos.gov.v1.Params.quorum":
-		x.Quorum = ""
-	case "cosmos.gov.v1.Params.threshold":
-		x.Threshold = ""
-	case "cosmos.gov.v1.Params.veto_threshold":
-		x.VetoThreshold = ""
-	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
-		x.MinInitialDepositRatio = ""
-	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
-		x.ProposalCancelRatio = ""
-	case "cosmos.gov.v1.Params.proposal_cancel_dest":
-		x.ProposalCancelDest = ""
-	case "cosmos.gov.v1.Params.expedited_voting_period":
-		x.ExpeditedVotingPeriod = nil
-	case "cosmos.gov.v1.Params.expedited_threshold":
-		x.ExpeditedThreshold = ""
-	case "cosmos.gov.v1.Params.expedited_min_deposit":
-		x.ExpeditedMinDeposit = nil
-	case "cosmos.gov.v1.Params.burn_vote_quorum":
-		x.BurnVoteQuorum = false
-	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
-		x.BurnProposalDepositPrevote = false
-	case "cosmos.gov.v1.Params.burn_vote_veto":
-		x.BurnVoteVeto = false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5765,64 +6141,22 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DepositParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.gov.v1.Params.min_deposit":
+	case "cosmos.gov.v1.DepositParams.min_deposit":
 		if len(x.MinDeposit) == 0 {
-			return protoreflect.ValueOfList(&_Params_1_list{})
+			return protoreflect.ValueOfList(&_DepositParams_1_list{})
 		}
-		listValue := &_Params_1_list{list: &x.MinDeposit}
+		listValue := &_DepositParams_1_list{list: &x.MinDeposit}
 		return protoreflect.ValueOfList(listValue)
-	case "cosmos.gov.v1.Params.max_deposit_period":
+	case "cosmos.gov.v1.DepositParams.max_deposit_period":
 		value := x.MaxDepositPeriod
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.gov.v1.Params.voting_period":
-		value := x.VotingPeriod
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.gov.v1.Params.quorum":
-		value := x.Quorum
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.Params.threshold":
-		value := x.Threshold
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.Params.veto_threshold":
-		value := x.VetoThreshold
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
-		value := x.MinInitialDepositRatio
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
-		value := x.ProposalCancelRatio
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.Params.proposal_cancel_dest":
-		value := x.ProposalCancelDest
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.Params.expedited_voting_period":
-		value := x.ExpeditedVotingPeriod
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.gov.v1.Params.expedited_threshold":
-		value := x.ExpeditedThreshold
-		return protoreflect.ValueOfString(value)
-	case "cosmos.gov.v1.Params.expedited_min_deposit":
-		if len(x.ExpeditedMinDeposit) == 0 {
-			return protoreflect.ValueOfList(&_Params_12_list{})
-		}
-		listValue := &_Params_12_list{list: &x.ExpeditedMinDeposit}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.gov.v1.Params.burn_vote_quorum":
-		value := x.BurnVoteQuorum
-		return protoreflect.ValueOfBool(value)
-	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
-		value := x.BurnProposalDepositPrevote
-		return protoreflect.ValueOfBool(value)
-	case "cosmos.gov.v1.Params.burn_vote_veto":
-		value := x.BurnVoteVeto
-		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5836,47 +6170,19 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_DepositParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Params.min_deposit":
+	case "cosmos.gov.v1.DepositParams.min_deposit":
 		lv := value.List()
-		clv := lv.(*_Params_1_list)
+		clv := lv.(*_DepositParams_1_list)
 		x.MinDeposit = *clv.list
-	case "cosmos.gov.v1.Params.max_deposit_period":
+	case "cosmos.gov.v1.DepositParams.max_deposit_period":
 		x.MaxDepositPeriod = value.Message().Interface().(*durationpb.Duration)
-	case "cosmos.gov.v1.Params.voting_period":
-		x.VotingPeriod = value.Message().Interface().(*durationpb.Duration)
-	case "cosmos.gov.v1.Params.quorum":
-		x.Quorum = value.Interface().(string)
-	case "cosmos.gov.v1.Params.threshold":
-		x.Threshold = value.Interface().(string)
-	case "cosmos.gov.v1.Params.veto_threshold":
-		x.VetoThreshold = value.Interface().(string)
-	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
-		x.MinInitialDepositRatio = value.Interface().(string)
-	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
-		x.ProposalCancelRatio = value.Interface().(string)
-	case "cosmos.gov.v1.Params.proposal_cancel_dest":
-		x.ProposalCancelDest = value.Interface().(string)
-	case "cosmos.gov.v1.Params.expedited_voting_period":
-		x.ExpeditedVotingPeriod = value.Message().Interface().(*durationpb.Duration)
-	case "cosmos.gov.v1.Params.expedited_threshold":
-		x.ExpeditedThreshold = value.Interface().(string)
-	case "cosmos.gov.v1.Params.expedited_min_deposit":
-		lv := value.List()
-		clv := lv.(*_Params_12_list)
-		x.ExpeditedMinDeposit = *clv.list
-	case "cosmos.gov.v1.Params.burn_vote_quorum":
-		x.BurnVoteQuorum = value.Bool()
-	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
-		x.BurnProposalDepositPrevote = value.Bool()
-	case "cosmos.gov.v1.Params.burn_vote_veto":
-		x.BurnVoteVeto = value.Bool()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5890,118 +6196,53 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DepositParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Params.min_deposit":
+	case "cosmos.gov.v1.DepositParams.min_deposit":
 		if x.MinDeposit == nil {
 			x.MinDeposit = []*v1beta1.Coin{}
 		}
-		value := &_Params_1_list{list: &x.MinDeposit}
+		value := &_DepositParams_1_list{list: &x.MinDeposit}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.gov.v1.Params.max_deposit_period":
+	case "cosmos.gov.v1.DepositParams.max_deposit_period":
 		if x.MaxDepositPeriod == nil {
 			x.MaxDepositPeriod = new(durationpb.Duration)
 		}
 		return protoreflect.ValueOfMessage(x.MaxDepositPeriod.ProtoReflect())
-	case "cosmos.gov.v1.Params.voting_period":
-		if x.VotingPeriod == nil {
-			x.VotingPeriod = new(durationpb.Duration)
-		}
-		return protoreflect.ValueOfMessage(x.VotingPeriod.ProtoReflect())
-	case "cosmos.gov.v1.Params.expedited_voting_period":
-		if x.ExpeditedVotingPeriod == nil {
-			x.ExpeditedVotingPeriod = new(durationpb.Duration)
-		}
-		return protoreflect.ValueOfMessage(x.ExpeditedVotingPeriod.ProtoReflect())
-	case "cosmos.gov.v1.Params.expedited_min_deposit":
-		if x.ExpeditedMinDeposit == nil {
-			x.ExpeditedMinDeposit = []*v1beta1.Coin{}
-		}
-		value := &_Params_12_list{list: &x.ExpeditedMinDeposit}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.gov.v1.Params.quorum":
-		panic(fmt.Errorf("field quorum of message cosmos.gov.v1.Params is not mutable"))
-	case "cosmos.gov.v1.Params.threshold":
-		panic(fmt.Errorf("field threshold of message cosmos.gov.v1.Params is not mutable"))
-	case "cosmos.gov.v1.Params.veto_threshold":
-		panic(fmt.Errorf("field veto_threshold of message cosmos.gov.v1.Params is not mutable"))
-	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
-		panic(fmt.Errorf("field min_initial_deposit_ratio of message cosmos.gov.v1.Params is not mutable"))
-	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
-		panic(fmt.Errorf("field proposal_cancel_ratio of message cosmos.gov.v1.Params is not mutable"))
-	case "cosmos.gov.v1.Params.proposal_cancel_dest":
-		panic(fmt.Errorf("field proposal_cancel_dest of message cosmos.gov.v1.Params is not mutable"))
-	case "cosmos.gov.v1.Params.expedited_threshold":
-		panic(fmt.Errorf("field expedited_threshold of message cosmos.gov.v1.Params is not mutable"))
-	case "cosmos.gov.v1.Params.burn_vote_quorum":
-		panic(fmt.Errorf("field burn_vote_quorum of message cosmos.gov.v1.Params is not mutable"))
-	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
-		panic(fmt.Errorf("field burn_proposal_deposit_prevote of message cosmos.gov.v1.Params is not mutable"))
-	case "cosmos.gov.v1.Params.burn_vote_veto":
-		panic(fmt.Errorf("field burn_vote_veto of message cosmos.gov.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DepositParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.gov.v1.Params.min_deposit":
+	case "cosmos.gov.v1.DepositParams.min_deposit":
 		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_Params_1_list{list: &list})
-	case "cosmos.gov.v1.Params.max_deposit_period":
-		m := new(durationpb.Duration)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.gov.v1.Params.voting_period":
-		m := new(durationpb.Duration)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.gov.v1.Params.quorum":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.Params.threshold":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.Params.veto_threshold":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.Params.proposal_cancel_dest":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.Params.expedited_voting_period":
+		return protoreflect.ValueOfList(&_DepositParams_1_list{list: &list})
+	case "cosmos.gov.v1.DepositParams.max_deposit_period":
 		m := new(durationpb.Duration)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.gov.v1.Params.expedited_threshold":
-		return protoreflect.ValueOfString("")
-	case "cosmos.gov.v1.Params.expedited_min_deposit":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_Params_12_list{list: &list})
-	case "cosmos.gov.v1.Params.burn_vote_quorum":
-		return protoreflect.ValueOfBool(false)
-	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
-		return protoreflect.ValueOfBool(false)
-	case "cosmos.gov.v1.Params.burn_vote_veto":
-		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.DepositParams"))
 		}
-		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.gov.v1.DepositParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_DepositParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.Params", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.DepositParams", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6009,7 +6250,7 @@ func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_DepositParams) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6020,7 +6261,7 @@ func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_DepositParams) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6032,7 +6273,7 @@ func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Params) IsValid() bool {
+func (x *fastReflection_DepositParams) IsValid() bool {
 	return x != nil
 }
 
@@ -6042,9 +6283,9 @@ func (x *fastReflection_Params) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_DepositParams) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*DepositParams)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6066,57 +6307,6 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.MaxDepositPeriod)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.VotingPeriod != nil {
-			l = options.Size(x.VotingPeriod)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Quorum)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Threshold)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.VetoThreshold)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.MinInitialDepositRatio)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ProposalCancelRatio)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ProposalCancelDest)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.ExpeditedVotingPeriod != nil {
-			l = options.Size(x.ExpeditedVotingPeriod)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ExpeditedThreshold)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.ExpeditedMinDeposit) > 0 {
-			for _, e := range x.ExpeditedMinDeposit {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.BurnVoteQuorum {
-			n += 2
-		}
-		if x.BurnProposalDepositPrevote {
-			n += 2
-		}
-		if x.BurnVoteVeto {
-			n += 2
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6127,7 +6317,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*DepositParams)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6146,39 +6336,23 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.BurnVoteVeto {
-			i--
-			if x.BurnVoteVeto {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x78
-		}
-		if x.BurnProposalDepositPrevote {
-			i--
-			if x.BurnProposalDepositPrevote {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x70
-		}
-		if x.BurnVoteQuorum {
-			i--
-			if x.BurnVoteQuorum {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
+		if x.MaxDepositPeriod != nil {
+			encoded, err := options.Marshal(x.MaxDepositPeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x68
+			dAtA[i] = 0x12
 		}
-		if len(x.ExpeditedMinDeposit) > 0 {
-			for iNdEx := len(x.ExpeditedMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.ExpeditedMinDeposit[iNdEx])
+		if len(x.MinDeposit) > 0 {
+			for iNdEx := len(x.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MinDeposit[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6189,133 +6363,26 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x62
+				dAtA[i] = 0xa
 			}
 		}
-		if len(x.ExpeditedThreshold) > 0 {
-			i -= len(x.ExpeditedThreshold)
-			copy(dAtA[i:], x.ExpeditedThreshold)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpeditedThreshold)))
-			i--
-			dAtA[i] = 0x5a
-		}
-		if x.ExpeditedVotingPeriod != nil {
-			encoded, err := options.Marshal(x.ExpeditedVotingPeriod)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x52
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
 		}
-		if len(x.ProposalCancelDest) > 0 {
-			i -= len(x.ProposalCancelDest)
-			copy(dAtA[i:], x.ProposalCancelDest)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposalCancelDest)))
-			i--
-			dAtA[i] = 0x4a
-		}
-		if len(x.ProposalCancelRatio) > 0 {
-			i -= len(x.ProposalCancelRatio)
-			copy(dAtA[i:], x.ProposalCancelRatio)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposalCancelRatio)))
-			i--
-			dAtA[i] = 0x42
-		}
-		if len(x.MinInitialDepositRatio) > 0 {
-			i -= len(x.MinInitialDepositRatio)
-			copy(dAtA[i:], x.MinInitialDepositRatio)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinInitialDepositRatio)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if len(x.VetoThreshold) > 0 {
-			i -= len(x.VetoThreshold)
-			copy(dAtA[i:], x.VetoThreshold)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VetoThreshold)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.Threshold) > 0 {
-			i -= len(x.Threshold)
-			copy(dAtA[i:], x.Threshold)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Threshold)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.Quorum) > 0 {
-			i -= len(x.Quorum)
-			copy(dAtA[i:], x.Quorum)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quorum)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.VotingPeriod != nil {
-			encoded, err := options.Marshal(x.VotingPeriod)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if x.MaxDepositPeriod != nil {
-			encoded, err := options.Marshal(x.MaxDepositPeriod)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.MinDeposit) > 0 {
-			for iNdEx := len(x.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.MinDeposit[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DepositParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
 		}
 		options := runtime.UnmarshalInputToOptions(input)
 		_ = options
@@ -6342,10 +6409,10 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositParams: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositParams: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -6418,173 +6485,2633 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.VotingPeriod == nil {
-					x.VotingPeriod = &durationpb.Duration{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingPeriod); err != nil {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Quorum = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Threshold = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.VetoThreshold = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositRatio", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_VotingParams               protoreflect.MessageDescriptor
+	fd_VotingParams_voting_period protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_gov_proto_init()
+	md_VotingParams = File_cosmos_gov_v1_gov_proto.Messages().ByName("VotingParams")
+	fd_VotingParams_voting_period = md_VotingParams.Fields().ByName("voting_period")
+}
+
+var _ protoreflect.Message = (*fastReflection_VotingParams)(nil)
+
+type fastReflection_VotingParams VotingParams
+
+func (x *VotingParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VotingParams)(x)
+}
+
+func (x *VotingParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VotingParams_messageType fastReflection_VotingParams_messageType
+var _ protoreflect.MessageType = fastReflection_VotingParams_messageType{}
+
+type fastReflection_VotingParams_messageType struct{}
+
+func (x fastReflection_VotingParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VotingParams)(nil)
+}
+func (x fastReflection_VotingParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_VotingParams)
+}
+func (x fastReflection_VotingParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VotingParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VotingParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_VotingParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VotingParams) Type() protoreflect.MessageType {
+	return _fastReflection_VotingParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VotingParams) New() protoreflect.Message {
+	return new(fastReflection_VotingParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VotingParams) Interface() protoreflect.ProtoMessage {
+	return (*VotingParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VotingParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.VotingPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.VotingPeriod.ProtoReflect())
+		if !f(fd_VotingParams_voting_period, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VotingParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.VotingParams.voting_period":
+		return x.VotingPeriod != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VotingParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.VotingParams.voting_period":
+		x.VotingPeriod = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VotingParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.VotingParams.voting_period":
+		value := x.VotingPeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VotingParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.VotingParams.voting_period":
+		x.VotingPeriod = value.Message().Interface().(*durationpb.Duration)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VotingParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.VotingParams.voting_period":
+		if x.VotingPeriod == nil {
+			x.VotingPeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.VotingPeriod.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VotingParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.VotingParams.voting_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.VotingParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.VotingParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VotingParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.VotingParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VotingParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VotingParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VotingParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VotingParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VotingParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.VotingPeriod != nil {
+			l = options.Size(x.VotingPeriod)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VotingParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.VotingPeriod != nil {
+			encoded, err := options.Marshal(x.VotingPeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VotingParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VotingParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VotingParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.VotingPeriod == nil {
+					x.VotingPeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingPeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TallyParams                protoreflect.MessageDescriptor
+	fd_TallyParams_quorum         protoreflect.FieldDescriptor
+	fd_TallyParams_threshold      protoreflect.FieldDescriptor
+	fd_TallyParams_veto_threshold protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_gov_proto_init()
+	md_TallyParams = File_cosmos_gov_v1_gov_proto.Messages().ByName("TallyParams")
+	fd_TallyParams_quorum = md_TallyParams.Fields().ByName("quorum")
+	fd_TallyParams_threshold = md_TallyParams.Fields().ByName("threshold")
+	fd_TallyParams_veto_threshold = md_TallyParams.Fields().ByName("veto_threshold")
+}
+
+var _ protoreflect.Message = (*fastReflection_TallyParams)(nil)
+
+type fastReflection_TallyParams TallyParams
+
+func (x *TallyParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TallyParams)(x)
+}
+
+func (x *TallyParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TallyParams_messageType fastReflection_TallyParams_messageType
+var _ protoreflect.MessageType = fastReflection_TallyParams_messageType{}
+
+type fastReflection_TallyParams_messageType struct{}
+
+func (x fastReflection_TallyParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TallyParams)(nil)
+}
+func (x fastReflection_TallyParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_TallyParams)
+}
+func (x fastReflection_TallyParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TallyParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TallyParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_TallyParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TallyParams) Type() protoreflect.MessageType {
+	return _fastReflection_TallyParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TallyParams) New() protoreflect.Message {
+	return new(fastReflection_TallyParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TallyParams) Interface() protoreflect.ProtoMessage {
+	return (*TallyParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TallyParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Quorum != "" {
+		value := protoreflect.ValueOfString(x.Quorum)
+		if !f(fd_TallyParams_quorum, value) {
+			return
+		}
+	}
+	if x.Threshold != "" {
+		value := protoreflect.ValueOfString(x.Threshold)
+		if !f(fd_TallyParams_threshold, value) {
+			return
+		}
+	}
+	if x.VetoThreshold != "" {
+		value := protoreflect.ValueOfString(x.VetoThreshold)
+		if !f(fd_TallyParams_veto_threshold, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TallyParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.TallyParams.quorum":
+		return x.Quorum != ""
+	case "cosmos.gov.v1.TallyParams.threshold":
+		return x.Threshold != ""
+	case "cosmos.gov.v1.TallyParams.veto_threshold":
+		return x.VetoThreshold != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TallyParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.TallyParams.quorum":
+		x.Quorum = ""
+	case "cosmos.gov.v1.TallyParams.threshold":
+		x.Threshold = ""
+	case "cosmos.gov.v1.TallyParams.veto_threshold":
+		x.VetoThreshold = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TallyParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.TallyParams.quorum":
+		value := x.Quorum
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.TallyParams.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.TallyParams.veto_threshold":
+		value := x.VetoThreshold
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TallyParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.TallyParams.quorum":
+		x.Quorum = value.Interface().(string)
+	case "cosmos.gov.v1.TallyParams.threshold":
+		x.Threshold = value.Interface().(string)
+	case "cosmos.gov.v1.TallyParams.veto_threshold":
+		x.VetoThreshold = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TallyParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.TallyParams.quorum":
+		panic(fmt.Errorf("field quorum of message cosmos.gov.v1.TallyParams is not mutable"))
+	case "cosmos.gov.v1.TallyParams.threshold":
+		panic(fmt.Errorf("field threshold of message cosmos.gov.v1.TallyParams is not mutable"))
+	case "cosmos.gov.v1.TallyParams.veto_threshold":
+		panic(fmt.Errorf("field veto_threshold of message cosmos.gov.v1.TallyParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TallyParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.TallyParams.quorum":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.TallyParams.threshold":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.TallyParams.veto_threshold":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.TallyParams"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.TallyParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TallyParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.TallyParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TallyParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TallyParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TallyParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TallyParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TallyParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Quorum)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Threshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VetoThreshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TallyParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.VetoThreshold) > 0 {
+			i -= len(x.VetoThreshold)
+			copy(dAtA[i:], x.VetoThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VetoThreshold)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Threshold) > 0 {
+			i -= len(x.Threshold)
+			copy(dAtA[i:], x.Threshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Threshold)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Quorum) > 0 {
+			i -= len(x.Quorum)
+			copy(dAtA[i:], x.Quorum)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quorum)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TallyParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Quorum = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Threshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VetoThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Params_1_list)(nil)
+
+type _Params_1_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Params_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_12_list)(nil)
+
+type _Params_12_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Params_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_12_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_12_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_17_list)(nil)
+
+type _Params_17_list struct {
+	list *[]string
+}
+
+func (x *_Params_17_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_17_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_17_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_17_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_17_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field OptimisticAuthorizedAddresses as it is not of Message kind"))
+}
+
+func (x *_Params_17_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_17_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_17_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Params                                 protoreflect.MessageDescriptor
+	fd_Params_min_deposit                     protoreflect.FieldDescriptor
+	fd_Params_max_deposit_period              protoreflect.FieldDescriptor
+	fd_Params_voting_period                   protoreflect.FieldDescriptor
+	fd_Params_quorum                          protoreflect.FieldDescriptor
+	fd_Params_threshold                       protoreflect.FieldDescriptor
+	fd_Params_veto_threshold                  protoreflect.FieldDescriptor
+	fd_Params_min_initial_deposit_ratio       protoreflect.FieldDescriptor
+	fd_Params_proposal_cancel_ratio           protoreflect.FieldDescriptor
+	fd_Params_proposal_cancel_dest            protoreflect.FieldDescriptor
+	fd_Params_expedited_voting_period         protoreflect.FieldDescriptor
+	fd_Params_expedited_threshold             protoreflect.FieldDescriptor
+	fd_Params_expedited_min_deposit           protoreflect.FieldDescriptor
+	fd_Params_burn_vote_quorum                protoreflect.FieldDescriptor
+	fd_Params_burn_proposal_deposit_prevote   protoreflect.FieldDescriptor
+	fd_Params_burn_vote_veto                  protoreflect.FieldDescriptor
+	fd_Params_proposal_cancel_max_period      protoreflect.FieldDescriptor
+	fd_Params_optimistic_authorized_addresses protoreflect.FieldDescriptor
+	fd_Params_optimistic_rejected_threshold   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_gov_proto_init()
+	md_Params = File_cosmos_gov_v1_gov_proto.Messages().ByName("Params")
+	fd_Params_min_deposit = md_Params.Fields().ByName("min_deposit")
+	fd_Params_max_deposit_period = md_Params.Fields().ByName("max_deposit_period")
+	fd_Params_voting_period = md_Params.Fields().ByName("voting_period")
+	fd_Params_quorum = md_Params.Fields().ByName("quorum")
+	fd_Params_threshold = md_Params.Fields().ByName("threshold")
+	fd_Params_veto_threshold = md_Params.Fields().ByName("veto_threshold")
+	fd_Params_min_initial_deposit_ratio = md_Params.Fields().ByName("min_initial_deposit_ratio")
+	fd_Params_proposal_cancel_ratio = md_Params.Fields().ByName("proposal_cancel_ratio")
+	fd_Params_proposal_cancel_dest = md_Params.Fields().ByName("proposal_cancel_dest")
+	fd_Params_expedited_voting_period = md_Params.Fields().ByName("expedited_voting_period")
+	fd_Params_expedited_threshold = md_Params.Fields().ByName("expedited_threshold")
+	fd_Params_expedited_min_deposit = md_Params.Fields().ByName("expedited_min_deposit")
+	fd_Params_burn_vote_quorum = md_Params.Fields().ByName("burn_vote_quorum")
+	fd_Params_burn_proposal_deposit_prevote = md_Params.Fields().ByName("burn_proposal_deposit_prevote")
+	fd_Params_burn_vote_veto = md_Params.Fields().ByName("burn_vote_veto")
+	fd_Params_proposal_cancel_max_period = md_Params.Fields().ByName("proposal_cancel_max_period")
+	fd_Params_optimistic_authorized_addresses = md_Params.Fields().ByName("optimistic_authorized_addresses")
+	fd_Params_optimistic_rejected_threshold = md_Params.Fields().ByName("optimistic_rejected_threshold")
+}
+
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
+}
+
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.MinDeposit) != 0 {
+		value := protoreflect.ValueOfList(&_Params_1_list{list: &x.MinDeposit})
+		if !f(fd_Params_min_deposit, value) {
+			return
+		}
+	}
+	if x.MaxDepositPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.MaxDepositPeriod.ProtoReflect())
+		if !f(fd_Params_max_deposit_period, value) {
+			return
+		}
+	}
+	if x.VotingPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.VotingPeriod.ProtoReflect())
+		if !f(fd_Params_voting_period, value) {
+			return
+		}
+	}
+	if x.Quorum != "" {
+		value := protoreflect.ValueOfString(x.Quorum)
+		if !f(fd_Params_quorum, value) {
+			return
+		}
+	}
+	if x.Threshold != "" {
+		value := protoreflect.ValueOfString(x.Threshold)
+		if !f(fd_Params_threshold, value) {
+			return
+		}
+	}
+	if x.VetoThreshold != "" {
+		value := protoreflect.ValueOfString(x.VetoThreshold)
+		if !f(fd_Params_veto_threshold, value) {
+			return
+		}
+	}
+	if x.MinInitialDepositRatio != "" {
+		value := protoreflect.ValueOfString(x.MinInitialDepositRatio)
+		if !f(fd_Params_min_initial_deposit_ratio, value) {
+			return
+		}
+	}
+	if x.ProposalCancelRatio != "" {
+		value := protoreflect.ValueOfString(x.ProposalCancelRatio)
+		if !f(fd_Params_proposal_cancel_ratio, value) {
+			return
+		}
+	}
+	if x.ProposalCancelDest != "" {
+		value := protoreflect.ValueOfString(x.ProposalCancelDest)
+		if !f(fd_Params_proposal_cancel_dest, value) {
+			return
+		}
+	}
+	if x.ExpeditedVotingPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.ExpeditedVotingPeriod.ProtoReflect())
+		if !f(fd_Params_expedited_voting_period, value) {
+			return
+		}
+	}
+	if x.ExpeditedThreshold != "" {
+		value := protoreflect.ValueOfString(x.ExpeditedThreshold)
+		if !f(fd_Params_expedited_threshold, value) {
+			return
+		}
+	}
+	if len(x.ExpeditedMinDeposit) != 0 {
+		value := protoreflect.ValueOfList(&_Params_12_list{list: &x.ExpeditedMinDeposit})
+		if !f(fd_Params_expedited_min_deposit, value) {
+			return
+		}
+	}
+	if x.BurnVoteQuorum != false {
+		value := protoreflect.ValueOfBool(x.BurnVoteQuorum)
+		if !f(fd_Params_burn_vote_quorum, value) {
+			return
+		}
+	}
+	if x.BurnProposalDepositPrevote != false {
+		value := protoreflect.ValueOfBool(x.BurnProposalDepositPrevote)
+		if !f(fd_Params_burn_proposal_deposit_prevote, value) {
+			return
+		}
+	}
+	if x.BurnVoteVeto != false {
+		value := protoreflect.ValueOfBool(x.BurnVoteVeto)
+		if !f(fd_Params_burn_vote_veto, value) {
+			return
+		}
+	}
+	if x.ProposalCancelMaxPeriod != "" {
+		value := protoreflect.ValueOfString(x.ProposalCancelMaxPeriod)
+		if !f(fd_Params_proposal_cancel_max_period, value) {
+			return
+		}
+	}
+	if len(x.OptimisticAuthorizedAddresses) != 0 {
+		value := protoreflect.ValueOfList(&_Params_17_list{list: &x.OptimisticAuthorizedAddresses})
+		if !f(fd_Params_optimistic_authorized_addresses, value) {
+			return
+		}
+	}
+	if x.OptimisticRejectedThreshold != "" {
+		value := protoreflect.ValueOfString(x.OptimisticRejectedThreshold)
+		if !f(fd_Params_optimistic_rejected_threshold, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.Params.min_deposit":
+		return len(x.MinDeposit) != 0
+	case "cosmos.gov.v1.Params.max_deposit_period":
+		return x.MaxDepositPeriod != nil
+	case "cosmos.gov.v1.Params.voting_period":
+		return x.VotingPeriod != nil
+	case "cosmos.gov.v1.Params.quorum":
+		return x.Quorum != ""
+	case "cosmos.gov.v1.Params.threshold":
+		return x.Threshold != ""
+	case "cosmos.gov.v1.Params.veto_threshold":
+		return x.VetoThreshold != ""
+	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
+		return x.MinInitialDepositRatio != ""
+	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
+		return x.ProposalCancelRatio != ""
+	case "cosmos.gov.v1.Params.proposal_cancel_dest":
+		return x.ProposalCancelDest != ""
+	case "cosmos.gov.v1.Params.expedited_voting_period":
+		return x.ExpeditedVotingPeriod != nil
+	case "cosmos.gov.v1.Params.expedited_threshold":
+		return x.ExpeditedThreshold != ""
+	case "cosmos.gov.v1.Params.expedited_min_deposit":
+		return len(x.ExpeditedMinDeposit) != 0
+	case "cosmos.gov.v1.Params.burn_vote_quorum":
+		return x.BurnVoteQuorum != false
+	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
+		return x.BurnProposalDepositPrevote != false
+	case "cosmos.gov.v1.Params.burn_vote_veto":
+		return x.BurnVoteVeto != false
+	case "cosmos.gov.v1.Params.proposal_cancel_max_period":
+		return x.ProposalCancelMaxPeriod != ""
+	case "cosmos.gov.v1.Params.optimistic_authorized_addresses":
+		return len(x.OptimisticAuthorizedAddresses) != 0
+	case "cosmos.gov.v1.Params.optimistic_rejected_threshold":
+		return x.OptimisticRejectedThreshold != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.Params.min_deposit":
+		x.MinDeposit = nil
+	case "cosmos.gov.v1.Params.max_deposit_period":
+		x.MaxDepositPeriod = nil
+	case "cosmos.gov.v1.Params.voting_period":
+		x.VotingPeriod = nil
+	case "cosmos.gov.v1.Params.quorum":
+		x.Quorum = ""
+	case "cosmos.gov.v1.Params.threshold":
+		x.Threshold = ""
+	case "cosmos.gov.v1.Params.veto_threshold":
+		x.VetoThreshold = ""
+	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
+		x.MinInitialDepositRatio = ""
+	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
+		x.ProposalCancelRatio = ""
+	case "cosmos.gov.v1.Params.proposal_cancel_dest":
+		x.ProposalCancelDest = ""
+	case "cosmos.gov.v1.Params.expedited_voting_period":
+		x.ExpeditedVotingPeriod = nil
+	case "cosmos.gov.v1.Params.expedited_threshold":
+		x.ExpeditedThreshold = ""
+	case "cosmos.gov.v1.Params.expedited_min_deposit":
+		x.ExpeditedMinDeposit = nil
+	case "cosmos.gov.v1.Params.burn_vote_quorum":
+		x.BurnVoteQuorum = false
+	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
+		x.BurnProposalDepositPrevote = false
+	case "cosmos.gov.v1.Params.burn_vote_veto":
+		x.BurnVoteVeto = false
+	case "cosmos.gov.v1.Params.proposal_cancel_max_period":
+		x.ProposalCancelMaxPeriod = ""
+	case "cosmos.gov.v1.Params.optimistic_authorized_addresses":
+		x.OptimisticAuthorizedAddresses = nil
+	case "cosmos.gov.v1.Params.optimistic_rejected_threshold":
+		x.OptimisticRejectedThreshold = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.Params.min_deposit":
+		if len(x.MinDeposit) == 0 {
+			return protoreflect.ValueOfList(&_Params_1_list{})
+		}
+		listValue := &_Params_1_list{list: &x.MinDeposit}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.gov.v1.Params.max_deposit_period":
+		value := x.MaxDepositPeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.Params.voting_period":
+		value := x.VotingPeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.Params.quorum":
+		value := x.Quorum
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Params.threshold":
+		value := x.Threshold
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Params.veto_threshold":
+		value := x.VetoThreshold
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
+		value := x.MinInitialDepositRatio
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
+		value := x.ProposalCancelRatio
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Params.proposal_cancel_dest":
+		value := x.ProposalCancelDest
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Params.expedited_voting_period":
+		value := x.ExpeditedVotingPeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.Params.expedited_threshold":
+		value := x.ExpeditedThreshold
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Params.expedited_min_deposit":
+		if len(x.ExpeditedMinDeposit) == 0 {
+			return protoreflect.ValueOfList(&_Params_12_list{})
+		}
+		listValue := &_Params_12_list{list: &x.ExpeditedMinDeposit}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.gov.v1.Params.burn_vote_quorum":
+		value := x.BurnVoteQuorum
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
+		value := x.BurnProposalDepositPrevote
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.gov.v1.Params.burn_vote_veto":
+		value := x.BurnVoteVeto
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.gov.v1.Params.proposal_cancel_max_period":
+		value := x.ProposalCancelMaxPeriod
+		return protoreflect.ValueOfString(value)
+	case "cosmos.gov.v1.Params.optimistic_authorized_addresses":
+		if len(x.OptimisticAuthorizedAddresses) == 0 {
+			return protoreflect.ValueOfList(&_Params_17_list{})
+		}
+		listValue := &_Params_17_list{list: &x.OptimisticAuthorizedAddresses}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.gov.v1.Params.optimistic_rejected_threshold":
+		value := x.OptimisticRejectedThreshold
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.Params.min_deposit":
+		lv := value.List()
+		clv := lv.(*_Params_1_list)
+		x.MinDeposit = *clv.list
+	case "cosmos.gov.v1.Params.max_deposit_period":
+		x.MaxDepositPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.gov.v1.Params.voting_period":
+		x.VotingPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.gov.v1.Params.quorum":
+		x.Quorum = value.Interface().(string)
+	case "cosmos.gov.v1.Params.threshold":
+		x.Threshold = value.Interface().(string)
+	case "cosmos.gov.v1.Params.veto_threshold":
+		x.VetoThreshold = value.Interface().(string)
+	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
+		x.MinInitialDepositRatio = value.Interface().(string)
+	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
+		x.ProposalCancelRatio = value.Interface().(string)
+	case "cosmos.gov.v1.Params.proposal_cancel_dest":
+		x.ProposalCancelDest = value.Interface().(string)
+	case "cosmos.gov.v1.Params.expedited_voting_period":
+		x.ExpeditedVotingPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.gov.v1.Params.expedited_threshold":
+		x.ExpeditedThreshold = value.Interface().(string)
+	case "cosmos.gov.v1.Params.expedited_min_deposit":
+		lv := value.List()
+		clv := lv.(*_Params_12_list)
+		x.ExpeditedMinDeposit = *clv.list
+	case "cosmos.gov.v1.Params.burn_vote_quorum":
+		x.BurnVoteQuorum = value.Bool()
+	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
+		x.BurnProposalDepositPrevote = value.Bool()
+	case "cosmos.gov.v1.Params.burn_vote_veto":
+		x.BurnVoteVeto = value.Bool()
+	case "cosmos.gov.v1.Params.proposal_cancel_max_period":
+		x.ProposalCancelMaxPeriod = value.Interface().(string)
+	case "cosmos.gov.v1.Params.optimistic_authorized_addresses":
+		lv := value.List()
+		clv := lv.(*_Params_17_list)
+		x.OptimisticAuthorizedAddresses = *clv.list
+	case "cosmos.gov.v1.Params.optimistic_rejected_threshold":
+		x.OptimisticRejectedThreshold = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.Params.min_deposit":
+		if x.MinDeposit == nil {
+			x.MinDeposit = []*v1beta1.Coin{}
+		}
+		value := &_Params_1_list{list: &x.MinDeposit}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.Params.max_deposit_period":
+		if x.MaxDepositPeriod == nil {
+			x.MaxDepositPeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.MaxDepositPeriod.ProtoReflect())
+	case "cosmos.gov.v1.Params.voting_period":
+		if x.VotingPeriod == nil {
+			x.VotingPeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.VotingPeriod.ProtoReflect())
+	case "cosmos.gov.v1.Params.expedited_voting_period":
+		if x.ExpeditedVotingPeriod == nil {
+			x.ExpeditedVotingPeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.ExpeditedVotingPeriod.ProtoReflect())
+	case "cosmos.gov.v1.Params.expedited_min_deposit":
+		if x.ExpeditedMinDeposit == nil {
+			x.ExpeditedMinDeposit = []*v1beta1.Coin{}
+		}
+		value := &_Params_12_list{list: &x.ExpeditedMinDeposit}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.Params.optimistic_authorized_addresses":
+		if x.OptimisticAuthorizedAddresses == nil {
+			x.OptimisticAuthorizedAddresses = []string{}
+		}
+		value := &_Params_17_list{list: &x.OptimisticAuthorizedAddresses}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.gov.v1.Params.quorum":
+		panic(fmt.Errorf("field quorum of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.threshold":
+		panic(fmt.Errorf("field threshold of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.veto_threshold":
+		panic(fmt.Errorf("field veto_threshold of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
+		panic(fmt.Errorf("field min_initial_deposit_ratio of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
+		panic(fmt.Errorf("field proposal_cancel_ratio of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.proposal_cancel_dest":
+		panic(fmt.Errorf("field proposal_cancel_dest of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.expedited_threshold":
+		panic(fmt.Errorf("field expedited_threshold of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.burn_vote_quorum":
+		panic(fmt.Errorf("field burn_vote_quorum of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
+		panic(fmt.Errorf("field burn_proposal_deposit_prevote of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.burn_vote_veto":
+		panic(fmt.Errorf("field burn_vote_veto of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.proposal_cancel_max_period":
+		panic(fmt.Errorf("field proposal_cancel_max_period of message cosmos.gov.v1.Params is not mutable"))
+	case "cosmos.gov.v1.Params.optimistic_rejected_threshold":
+		panic(fmt.Errorf("field optimistic_rejected_threshold of message cosmos.gov.v1.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.Params.min_deposit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Params_1_list{list: &list})
+	case "cosmos.gov.v1.Params.max_deposit_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.Params.voting_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.Params.quorum":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Params.threshold":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Params.veto_threshold":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Params.min_initial_deposit_ratio":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Params.proposal_cancel_ratio":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Params.proposal_cancel_dest":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Params.expedited_voting_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.Params.expedited_threshold":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Params.expedited_min_deposit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Params_12_list{list: &list})
+	case "cosmos.gov.v1.Params.burn_vote_quorum":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.gov.v1.Params.burn_proposal_deposit_prevote":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.gov.v1.Params.burn_vote_veto":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.gov.v1.Params.proposal_cancel_max_period":
+		return protoreflect.ValueOfString("")
+	case "cosmos.gov.v1.Params.optimistic_authorized_addresses":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_17_list{list: &list})
+	case "cosmos.gov.v1.Params.optimistic_rejected_threshold":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.MinDeposit) > 0 {
+			for _, e := range x.MinDeposit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxDepositPeriod != nil {
+			l = options.Size(x.MaxDepositPeriod)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.VotingPeriod != nil {
+			l = options.Size(x.VotingPeriod)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Quorum)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Threshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VetoThreshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinInitialDepositRatio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ProposalCancelRatio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ProposalCancelDest)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExpeditedVotingPeriod != nil {
+			l = options.Size(x.ExpeditedVotingPeriod)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ExpeditedThreshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ExpeditedMinDeposit) > 0 {
+			for _, e := range x.ExpeditedMinDeposit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.BurnVoteQuorum {
+			n += 2
+		}
+		if x.BurnProposalDepositPrevote {
+			n += 2
+		}
+		if x.BurnVoteVeto {
+			n += 2
+		}
+		l = len(x.ProposalCancelMaxPeriod)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.OptimisticAuthorizedAddresses) > 0 {
+			for _, s := range x.OptimisticAuthorizedAddresses {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.OptimisticRejectedThreshold)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.OptimisticRejectedThreshold) > 0 {
+			i -= len(x.OptimisticRejectedThreshold)
+			copy(dAtA[i:], x.OptimisticRejectedThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OptimisticRejectedThreshold)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if len(x.OptimisticAuthorizedAddresses) > 0 {
+			for iNdEx := len(x.OptimisticAuthorizedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.OptimisticAuthorizedAddresses[iNdEx])
+				copy(dAtA[i:], x.OptimisticAuthorizedAddresses[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OptimisticAuthorizedAddresses[iNdEx])))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x8a
+			}
+		}
+		if len(x.ProposalCancelMaxPeriod) > 0 {
+			i -= len(x.ProposalCancelMaxPeriod)
+			copy(dAtA[i:], x.ProposalCancelMaxPeriod)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposalCancelMaxPeriod)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if x.BurnVoteVeto {
+			i--
+			if x.BurnVoteVeto {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.BurnProposalDepositPrevote {
+			i--
+			if x.BurnProposalDepositPrevote {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.BurnVoteQuorum {
+			i--
+			if x.BurnVoteQuorum {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x68
+		}
+		if len(x.ExpeditedMinDeposit) > 0 {
+			for iNdEx := len(x.ExpeditedMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ExpeditedMinDeposit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.ExpeditedThreshold) > 0 {
+			i -= len(x.ExpeditedThreshold)
+			copy(dAtA[i:], x.ExpeditedThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExpeditedThreshold)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.ExpeditedVotingPeriod != nil {
+			encoded, err := options.Marshal(x.ExpeditedVotingPeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.ProposalCancelDest) > 0 {
+			i -= len(x.ProposalCancelDest)
+			copy(dAtA[i:], x.ProposalCancelDest)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposalCancelDest)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.ProposalCancelRatio) > 0 {
+			i -= len(x.ProposalCancelRatio)
+			copy(dAtA[i:], x.ProposalCancelRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposalCancelRatio)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.MinInitialDepositRatio) > 0 {
+			i -= len(x.MinInitialDepositRatio)
+			copy(dAtA[i:], x.MinInitialDepositRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinInitialDepositRatio)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.VetoThreshold) > 0 {
+			i -= len(x.VetoThreshold)
+			copy(dAtA[i:], x.VetoThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VetoThreshold)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Threshold) > 0 {
+			i -= len(x.Threshold)
+			copy(dAtA[i:], x.Threshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Threshold)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Quorum) > 0 {
+			i -= len(x.Quorum)
+			copy(dAtA[i:], x.Quorum)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quorum)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.VotingPeriod != nil {
+			encoded, err := options.Marshal(x.VotingPeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.MaxDepositPeriod != nil {
+			encoded, err := options.Marshal(x.MaxDepositPeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.MinDeposit) > 0 {
+			for iNdEx := len(x.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MinDeposit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinDeposit = append(x.MinDeposit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinDeposit[len(x.MinDeposit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MaxDepositPeriod == nil {
+					x.MaxDepositPeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxDepositPeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.VotingPeriod == nil {
+					x.VotingPeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingPeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Quorum = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Threshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VetoThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinInitialDepositRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProposalCancelRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelDest", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProposalCancelDest = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExpeditedVotingPeriod == nil {
+					x.ExpeditedVotingPeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpeditedVotingPeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExpeditedThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpeditedMinDeposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MinInitialDepositRatio = string(dAtA[iNdEx:postIndex])
+				x.ExpeditedMinDeposit = append(x.ExpeditedMinDeposit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpeditedMinDeposit[len(x.ExpeditedMinDeposit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 8:
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnVoteQuorum", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.BurnVoteQuorum = bool(v != 0)
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnProposalDepositPrevote", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.BurnProposalDepositPrevote = bool(v != 0)
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnVoteVeto", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.BurnVoteVeto = bool(v != 0)
+			case 16:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelRatio", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelMaxPeriod", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6612,11 +9139,11 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ProposalCancelRatio = string(dAtA[iNdEx:postIndex])
+				x.ProposalCancelMaxPeriod = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 9:
+			case 17:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelDest", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptimisticAuthorizedAddresses", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6644,13 +9171,13 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ProposalCancelDest = string(dAtA[iNdEx:postIndex])
+				x.OptimisticAuthorizedAddresses = append(x.OptimisticAuthorizedAddresses, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			case 10:
+			case 18:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OptimisticRejectedThreshold", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6660,33 +9187,529 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OptimisticRejectedThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ScheduledParamChange        protoreflect.MessageDescriptor
+	fd_ScheduledParamChange_id     protoreflect.FieldDescriptor
+	fd_ScheduledParamChange_msg    protoreflect.FieldDescriptor
+	fd_ScheduledParamChange_height protoreflect.FieldDescriptor
+	fd_ScheduledParamChange_time   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_gov_v1_gov_proto_init()
+	md_ScheduledParamChange = File_cosmos_gov_v1_gov_proto.Messages().ByName("ScheduledParamChange")
+	fd_ScheduledParamChange_id = md_ScheduledParamChange.Fields().ByName("id")
+	fd_ScheduledParamChange_msg = md_ScheduledParamChange.Fields().ByName("msg")
+	fd_ScheduledParamChange_height = md_ScheduledParamChange.Fields().ByName("height")
+	fd_ScheduledParamChange_time = md_ScheduledParamChange.Fields().ByName("time")
+}
+
+var _ protoreflect.Message = (*fastReflection_ScheduledParamChange)(nil)
+
+type fastReflection_ScheduledParamChange ScheduledParamChange
+
+func (x *ScheduledParamChange) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ScheduledParamChange)(x)
+}
+
+func (x *ScheduledParamChange) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_gov_v1_gov_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ScheduledParamChange_messageType fastReflection_ScheduledParamChange_messageType
+var _ protoreflect.MessageType = fastReflection_ScheduledParamChange_messageType{}
+
+type fastReflection_ScheduledParamChange_messageType struct{}
+
+func (x fastReflection_ScheduledParamChange_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ScheduledParamChange)(nil)
+}
+func (x fastReflection_ScheduledParamChange_messageType) New() protoreflect.Message {
+	return new(fastReflection_ScheduledParamChange)
+}
+func (x fastReflection_ScheduledParamChange_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledParamChange
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ScheduledParamChange) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledParamChange
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ScheduledParamChange) Type() protoreflect.MessageType {
+	return _fastReflection_ScheduledParamChange_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ScheduledParamChange) New() protoreflect.Message {
+	return new(fastReflection_ScheduledParamChange)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ScheduledParamChange) Interface() protoreflect.ProtoMessage {
+	return (*ScheduledParamChange)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ScheduledParamChange) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_ScheduledParamChange_id, value) {
+			return
+		}
+	}
+	if x.Msg != nil {
+		value := protoreflect.ValueOfMessage(x.Msg.ProtoReflect())
+		if !f(fd_ScheduledParamChange_msg, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_ScheduledParamChange_height, value) {
+			return
+		}
+	}
+	if x.Time != nil {
+		value := protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+		if !f(fd_ScheduledParamChange_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ScheduledParamChange) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.ScheduledParamChange.id":
+		return x.Id != uint64(0)
+	case "cosmos.gov.v1.ScheduledParamChange.msg":
+		return x.Msg != nil
+	case "cosmos.gov.v1.ScheduledParamChange.height":
+		return x.Height != int64(0)
+	case "cosmos.gov.v1.ScheduledParamChange.time":
+		return x.Time != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ScheduledParamChange"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.ScheduledParamChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledParamChange) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.ScheduledParamChange.id":
+		x.Id = uint64(0)
+	case "cosmos.gov.v1.ScheduledParamChange.msg":
+		x.Msg = nil
+	case "cosmos.gov.v1.ScheduledParamChange.height":
+		x.Height = int64(0)
+	case "cosmos.gov.v1.ScheduledParamChange.time":
+		x.Time = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ScheduledParamChange"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.ScheduledParamChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ScheduledParamChange) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.gov.v1.ScheduledParamChange.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.gov.v1.ScheduledParamChange.msg":
+		value := x.Msg
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.gov.v1.ScheduledParamChange.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.gov.v1.ScheduledParamChange.time":
+		value := x.Time
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ScheduledParamChange"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.ScheduledParamChange does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledParamChange) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.ScheduledParamChange.id":
+		x.Id = value.Uint()
+	case "cosmos.gov.v1.ScheduledParamChange.msg":
+		x.Msg = value.Message().Interface().(*anypb.Any)
+	case "cosmos.gov.v1.ScheduledParamChange.height":
+		x.Height = value.Int()
+	case "cosmos.gov.v1.ScheduledParamChange.time":
+		x.Time = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ScheduledParamChange"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.ScheduledParamChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledParamChange) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.ScheduledParamChange.msg":
+		if x.Msg == nil {
+			x.Msg = new(anypb.Any)
+		}
+		return protoreflect.ValueOfMessage(x.Msg.ProtoReflect())
+	case "cosmos.gov.v1.ScheduledParamChange.time":
+		if x.Time == nil {
+			x.Time = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+	case "cosmos.gov.v1.ScheduledParamChange.id":
+		panic(fmt.Errorf("field id of message cosmos.gov.v1.ScheduledParamChange is not mutable"))
+	case "cosmos.gov.v1.ScheduledParamChange.height":
+		panic(fmt.Errorf("field height of message cosmos.gov.v1.ScheduledParamChange is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ScheduledParamChange"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.ScheduledParamChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ScheduledParamChange) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.gov.v1.ScheduledParamChange.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.gov.v1.ScheduledParamChange.msg":
+		m := new(anypb.Any)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.gov.v1.ScheduledParamChange.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.gov.v1.ScheduledParamChange.time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.v1.ScheduledParamChange"))
+		}
+		panic(fmt.Errorf("message cosmos.gov.v1.ScheduledParamChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ScheduledParamChange) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.gov.v1.ScheduledParamChange", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ScheduledParamChange) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledParamChange) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ScheduledParamChange) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ScheduledParamChange) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ScheduledParamChange)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.Msg != nil {
+			l = options.Size(x.Msg)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Time != nil {
+			l = options.Size(x.Time)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ScheduledParamChange)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Time != nil {
+			encoded, err := options.Marshal(x.Time)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Msg != nil {
+			encoded, err := options.Marshal(x.Msg)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ScheduledParamChange)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.ExpeditedVotingPeriod == nil {
-					x.ExpeditedVotingPeriod = &durationpb.Duration{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpeditedVotingPeriod); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				iNdEx = postIndex
-			case 11:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledParamChange: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledParamChange: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 				}
-				var stringLen uint64
+				x.Id = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6696,27 +9719,14 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.Id |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ExpeditedThreshold = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 12:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpeditedMinDeposit", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -6743,36 +9753,18 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ExpeditedMinDeposit = append(x.ExpeditedMinDeposit, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpeditedMinDeposit[len(x.ExpeditedMinDeposit)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 13:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnVoteQuorum", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.BurnVoteQuorum = bool(v != 0)
-			case 14:
+				if x.Msg == nil {
+					x.Msg = &anypb.Any{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Msg); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnProposalDepositPrevote", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 				}
-				var v int
+				x.Height = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6782,17 +9774,16 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					x.Height |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.BurnProposalDepositPrevote = bool(v != 0)
-			case 15:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnVoteVeto", wireType)
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 				}
-				var v int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6802,12 +9793,28 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.BurnVoteVeto = bool(v != 0)
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Time == nil {
+					x.Time = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Time); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6843,8 +9850,6 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Since: cosmos-sdk 0.46
-
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
@@ -6858,6 +9863,66 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ProposalType enumerates the valid proposal types.
+// All proposal types are v1.Proposal which have different voting periods or tallying logic.
+//
+// Since: cosmos-sdk 0.50
+type ProposalType int32
+
+const (
+	// PROPOSAL_TYPE_UNSPECIFIED defines no proposal type, which fallback to PROPOSAL_TYPE_STANDARD.
+	ProposalType_PROPOSAL_TYPE_UNSPECIFIED ProposalType = 0
+	// PROPOSAL_TYPE_STANDARD defines the type for a standard proposal.
+	ProposalType_PROPOSAL_TYPE_STANDARD ProposalType = 1
+	// PROPOSAL_TYPE_MULTIPLE_CHOICE defines the type for a multiple choice proposal.
+	ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE ProposalType = 2
+	// PROPOSAL_TYPE_OPTIMISTIC defines the type for an optimistic proposal.
+	ProposalType_PROPOSAL_TYPE_OPTIMISTIC ProposalType = 3
+)
+
+// Enum value maps for ProposalType.
+var (
+	ProposalType_name = map[int32]string{
+		0: "PROPOSAL_TYPE_UNSPECIFIED",
+		1: "PROPOSAL_TYPE_STANDARD",
+		2: "PROPOSAL_TYPE_MULTIPLE_CHOICE",
+		3: "PROPOSAL_TYPE_OPTIMISTIC",
+	}
+	ProposalType_value = map[string]int32{
+		"PROPOSAL_TYPE_UNSPECIFIED":     0,
+		"PROPOSAL_TYPE_STANDARD":        1,
+		"PROPOSAL_TYPE_MULTIPLE_CHOICE": 2,
+		"PROPOSAL_TYPE_OPTIMISTIC":      3,
+	}
+)
+
+func (x ProposalType) Enum() *ProposalType {
+	p := new(ProposalType)
+	*p = x
+	return p
+}
+
+func (x ProposalType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProposalType) Descriptor() protoreflect.EnumDescriptor {
+	return file_cosmos_gov_v1_gov_proto_enumTypes[0].Descriptor()
+}
+
+func (ProposalType) Type() protoreflect.EnumType {
+	return &file_cosmos_gov_v1_gov_proto_enumTypes[0]
+}
+
+func (x ProposalType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProposalType.Descriptor instead.
+func (ProposalType) EnumDescriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{0}
+}
+
 // VoteOption enumerates the valid vote options for a given governance proposal.
 type VoteOption int32
 
@@ -6872,6 +9937,25 @@ const (
 	VoteOption_VOTE_OPTION_NO VoteOption = 3
 	// VOTE_OPTION_NO_WITH_VETO defines a no with veto vote option.
 	VoteOption_VOTE_OPTION_NO_WITH_VETO VoteOption = 4
+	// VOTE_OPTION_ONE defines the first option of a multiple choice proposal.
+	// The vote option of a vote on a multiple choice proposal is the 1-based
+	// index of one of its options, VOTE_OPTION_ONE to VOTE_OPTION_FOUR name the
+	// first four.
+	//
+	// Since: cosmos-sdk 0.50
+	VoteOption_VOTE_OPTION_ONE VoteOption = 1
+	// VOTE_OPTION_TWO defines the second option of a multiple choice proposal.
+	//
+	// Since: cosmos-sdk 0.50
+	VoteOption_VOTE_OPTION_TWO VoteOption = 2
+	// VOTE_OPTION_THREE defines the third option of a multiple choice proposal.
+	//
+	// Since: cosmos-sdk 0.50
+	VoteOption_VOTE_OPTION_THREE VoteOption = 3
+	// VOTE_OPTION_FOUR defines the fourth option of a multiple choice proposal.
+	//
+	// Since: cosmos-sdk 0.50
+	VoteOption_VOTE_OPTION_FOUR VoteOption = 4
 )
 
 // Enum value maps for VoteOption.
@@ -6882,6 +9966,10 @@ var (
 		2: "VOTE_OPTION_ABSTAIN",
 		3: "VOTE_OPTION_NO",
 		4: "VOTE_OPTION_NO_WITH_VETO",
+		// Duplicate value: 1: "VOTE_OPTION_ONE",
+		// Duplicate value: 2: "VOTE_OPTION_TWO",
+		// Duplicate value: 3: "VOTE_OPTION_THREE",
+		// Duplicate value: 4: "VOTE_OPTION_FOUR",
 	}
 	VoteOption_value = map[string]int32{
 		"VOTE_OPTION_UNSPECIFIED":  0,
@@ -6889,6 +9977,10 @@ var (
 		"VOTE_OPTION_ABSTAIN":      2,
 		"VOTE_OPTION_NO":           3,
 		"VOTE_OPTION_NO_WITH_VETO": 4,
+		"VOTE_OPTION_ONE":          1,
+		"VOTE_OPTION_TWO":          2,
+		"VOTE_OPTION_THREE":        3,
+		"VOTE_OPTION_FOUR":         4,
 	}
 )
 
@@ -6903,11 +9995,11 @@ func (x VoteOption) String() string {
 }
 
 func (VoteOption) Descriptor() protoreflect.EnumDescriptor {
-	return file_cosmos_gov_v1_gov_proto_enumTypes[0].Descriptor()
+	return file_cosmos_gov_v1_gov_proto_enumTypes[1].Descriptor()
 }
 
 func (VoteOption) Type() protoreflect.EnumType {
-	return &file_cosmos_gov_v1_gov_proto_enumTypes[0]
+	return &file_cosmos_gov_v1_gov_proto_enumTypes[1]
 }
 
 func (x VoteOption) Number() protoreflect.EnumNumber {
@@ -6916,7 +10008,7 @@ func (x VoteOption) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use VoteOption.Descriptor instead.
 func (VoteOption) EnumDescriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{0}
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{1}
 }
 
 // ProposalStatus enumerates the valid statuses of a proposal.
@@ -6940,6 +10032,11 @@ const (
 	// PROPOSAL_STATUS_FAILED defines a proposal status of a proposal that has
 	// failed.
 	ProposalStatus_PROPOSAL_STATUS_FAILED ProposalStatus = 5
+	// PROPOSAL_STATUS_TALLIED defines a proposal status of a multiple choice
+	// proposal that has reached quorum. Its outcome is the final tally result.
+	//
+	// Since: cosmos-sdk 0.50
+	ProposalStatus_PROPOSAL_STATUS_TALLIED ProposalStatus = 6
 )
 
 // Enum value maps for ProposalStatus.
@@ -6951,6 +10048,7 @@ var (
 		3: "PROPOSAL_STATUS_PASSED",
 		4: "PROPOSAL_STATUS_REJECTED",
 		5: "PROPOSAL_STATUS_FAILED",
+		6: "PROPOSAL_STATUS_TALLIED",
 	}
 	ProposalStatus_value = map[string]int32{
 		"PROPOSAL_STATUS_UNSPECIFIED":    0,
@@ -6959,6 +10057,7 @@ var (
 		"PROPOSAL_STATUS_PASSED":         3,
 		"PROPOSAL_STATUS_REJECTED":       4,
 		"PROPOSAL_STATUS_FAILED":         5,
+		"PROPOSAL_STATUS_TALLIED":        6,
 	}
 )
 
@@ -6973,11 +10072,11 @@ func (x ProposalStatus) String() string {
 }
 
 func (ProposalStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_cosmos_gov_v1_gov_proto_enumTypes[1].Descriptor()
+	return file_cosmos_gov_v1_gov_proto_enumTypes[2].Descriptor()
 }
 
 func (ProposalStatus) Type() protoreflect.EnumType {
-	return &file_cosmos_gov_v1_gov_proto_enumTypes[1]
+	return &file_cosmos_gov_v1_gov_proto_enumTypes[2]
 }
 
 func (x ProposalStatus) Number() protoreflect.EnumNumber {
@@ -6986,7 +10085,7 @@ func (x ProposalStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ProposalStatus.Descriptor instead.
 func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{1}
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{2}
 }
 
 // WeightedVoteOption defines a unit of vote for vote split.
@@ -7135,6 +10234,20 @@ type Proposal struct {
 	//
 	// Since: cosmos-sdk 0.48
 	Expedited bool `protobuf:"varint,14,opt,name=expedited,proto3" json:"expedited,omitempty"`
+	// proposal_type defines the type of the proposal.
+	//
+	// Since: cosmos-sdk 0.50
+	ProposalType ProposalType `protobuf:"varint,15,opt,name=proposal_type,json=proposalType,proto3,enum=cosmos.gov.v1.ProposalType" json:"proposal_type,omitempty"`
+	// vote_options defines the vote options of a multiple choice proposal.
+	//
+	// Since: cosmos-sdk 0.50
+	VoteOptions *ProposalVoteOptions `protobuf:"bytes,16,opt,name=vote_options,json=voteOptions,proto3" json:"vote_options,omitempty"`
+	// final_option_tally_results are the final tally results of each option of
+	// a multiple choice proposal. They are not populated until the proposal's
+	// voting period has ended.
+	//
+	// Since: cosmos-sdk 0.50
+	FinalOptionTallyResults []*OptionTallyResult `protobuf:"bytes,17,rep,name=final_option_tally_results,json=finalOptionTallyResults,proto3" json:"final_option_tally_results,omitempty"`
 }
 
 func (x *Proposal) Reset() {
@@ -7150,171 +10263,378 @@ func (x *Proposal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Proposal) ProtoMessage() {}
+func (*Proposal) ProtoMessage() {}
+
+// Deprecated: Use Proposal.ProtoReflect.Descriptor instead.
+func (*Proposal) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Proposal) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Proposal) GetMessages() []*anypb.Any {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *Proposal) GetStatus() ProposalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
+}
+
+func (x *Proposal) GetFinalTallyResult() *TallyResult {
+	if x != nil {
+		return x.FinalTallyResult
+	}
+	return nil
+}
+
+func (x *Proposal) GetSubmitTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmitTime
+	}
+	return nil
+}
+
+func (x *Proposal) GetDepositEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DepositEndTime
+	}
+	return nil
+}
+
+func (x *Proposal) GetTotalDeposit() []*v1beta1.Coin {
+	if x != nil {
+		return x.TotalDeposit
+	}
+	return nil
+}
+
+func (x *Proposal) GetVotingStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VotingStartTime
+	}
+	return nil
+}
+
+func (x *Proposal) GetVotingEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VotingEndTime
+	}
+	return nil
+}
+
+func (x *Proposal) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *Proposal) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Proposal) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *Proposal) GetProposer() string {
+	if x != nil {
+		return x.Proposer
+	}
+	return ""
+}
+
+func (x *Proposal) GetExpedited() bool {
+	if x != nil {
+		return x.Expedited
+	}
+	return false
+}
+
+func (x *Proposal) GetProposalType() ProposalType {
+	if x != nil {
+		return x.ProposalType
+	}
+	return ProposalType_PROPOSAL_TYPE_UNSPECIFIED
+}
+
+func (x *Proposal) GetVoteOptions() *ProposalVoteOptions {
+	if x != nil {
+		return x.VoteOptions
+	}
+	return nil
+}
+
+func (x *Proposal) GetFinalOptionTallyResults() []*OptionTallyResult {
+	if x != nil {
+		return x.FinalOptionTallyResults
+	}
+	return nil
+}
+
+// ProposalVoteOptions defines the vote options of a proposal. A vote is cast
+// for an option with the 1-based index of the option as vote option.
+//
+// Since: cosmos-sdk 0.50
+type ProposalVoteOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// options are the options of the proposal.
+	Options []string `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *ProposalVoteOptions) Reset() {
+	*x = ProposalVoteOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposalVoteOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposalVoteOptions) ProtoMessage() {}
+
+// Deprecated: Use ProposalVoteOptions.ProtoReflect.Descriptor instead.
+func (*ProposalVoteOptions) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProposalVoteOptions) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// TallyResult defines a standard tally for a governance proposal.
+type TallyResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// yes_count is the number of yes votes on a proposal.
+	YesCount string `protobuf:"bytes,1,opt,name=yes_count,json=yesCount,proto3" json:"yes_count,omitempty"`
+	// abstain_count is the number of abstain votes on a proposal.
+	AbstainCount string `protobuf:"bytes,2,opt,name=abstain_count,json=abstainCount,proto3" json:"abstain_count,omitempty"`
+	// no_count is the number of no votes on a proposal.
+	NoCount string `protobuf:"bytes,3,opt,name=no_count,json=noCount,proto3" json:"no_count,omitempty"`
+	// no_with_veto_count is the number of no with veto votes on a proposal.
+	NoWithVetoCount string `protobuf:"bytes,4,opt,name=no_with_veto_count,json=noWithVetoCount,proto3" json:"no_with_veto_count,omitempty"`
+}
+
+func (x *TallyResult) Reset() {
+	*x = TallyResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TallyResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TallyResult) ProtoMessage() {}
 
-// Deprecated: Use Proposal.ProtoReflect.Descriptor instead.
-func (*Proposal) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use TallyResult.ProtoReflect.Descriptor instead.
+func (*TallyResult) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Proposal) GetId() uint64 {
+func (x *TallyResult) GetYesCount() string {
 	if x != nil {
-		return x.Id
+		return x.YesCount
 	}
-	return 0
+	return ""
 }
 
-func (x *Proposal) GetMessages() []*anypb.Any {
+func (x *TallyResult) GetAbstainCount() string {
 	if x != nil {
-		return x.Messages
+		return x.AbstainCount
 	}
-	return nil
+	return ""
 }
 
-func (x *Proposal) GetStatus() ProposalStatus {
+func (x *TallyResult) GetNoCount() string {
 	if x != nil {
-		return x.Status
+		return x.NoCount
 	}
-	return ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
+	return ""
 }
 
-func (x *Proposal) GetFinalTallyResult() *TallyResult {
+func (x *TallyResult) GetNoWithVetoCount() string {
 	if x != nil {
-		return x.FinalTallyResult
+		return x.NoWithVetoCount
 	}
-	return nil
+	return ""
 }
 
-func (x *Proposal) GetSubmitTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.SubmitTime
+// TallySnapshot defines the final tally breakdown of a proposal, recorded when
+// its voting period ends.
+//
+// Since: cosmos-sdk 0.50
+type TallySnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// tally is the final tally result of the proposal.
+	Tally *TallyResult `protobuf:"bytes,2,opt,name=tally,proto3" json:"tally,omitempty"`
+	// validator_tally is the part of the tally cast by validators, including the
+	// voting power their delegators inherited.
+	ValidatorTally *TallyResult `protobuf:"bytes,3,opt,name=validator_tally,json=validatorTally,proto3" json:"validator_tally,omitempty"`
+	// delegator_tally is the part of the tally cast by delegators who voted
+	// themselves.
+	DelegatorTally *TallyResult `protobuf:"bytes,4,opt,name=delegator_tally,json=delegatorTally,proto3" json:"delegator_tally,omitempty"`
+	// total_bonded_tokens is the total amount of bonded tokens at tally time.
+	TotalBondedTokens string `protobuf:"bytes,5,opt,name=total_bonded_tokens,json=totalBondedTokens,proto3" json:"total_bonded_tokens,omitempty"`
+	// tally_time is the time at which the proposal was tallied.
+	TallyTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=tally_time,json=tallyTime,proto3" json:"tally_time,omitempty"`
+	// option_tally_results are the tally results of each option of a multiple
+	// choice proposal.
+	OptionTallyResults []*OptionTallyResult `protobuf:"bytes,7,rep,name=option_tally_results,json=optionTallyResults,proto3" json:"option_tally_results,omitempty"`
+}
+
+func (x *TallySnapshot) Reset() {
+	*x = TallySnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Proposal) GetDepositEndTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.DepositEndTime
-	}
-	return nil
+func (x *TallySnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Proposal) GetTotalDeposit() []*v1beta1.Coin {
-	if x != nil {
-		return x.TotalDeposit
-	}
-	return nil
+func (*TallySnapshot) ProtoMessage() {}
+
+// Deprecated: Use TallySnapshot.ProtoReflect.Descriptor instead.
+func (*TallySnapshot) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Proposal) GetVotingStartTime() *timestamppb.Timestamp {
+func (x *TallySnapshot) GetProposalId() uint64 {
 	if x != nil {
-		return x.VotingStartTime
+		return x.ProposalId
 	}
-	return nil
+	return 0
 }
 
-func (x *Proposal) GetVotingEndTime() *timestamppb.Timestamp {
+func (x *TallySnapshot) GetTally() *TallyResult {
 	if x != nil {
-		return x.VotingEndTime
+		return x.Tally
 	}
 	return nil
 }
 
-func (x *Proposal) GetMetadata() string {
+func (x *TallySnapshot) GetValidatorTally() *TallyResult {
 	if x != nil {
-		return x.Metadata
+		return x.ValidatorTally
 	}
-	return ""
+	return nil
 }
 
-func (x *Proposal) GetTitle() string {
+func (x *TallySnapshot) GetDelegatorTally() *TallyResult {
 	if x != nil {
-		return x.Title
+		return x.DelegatorTally
 	}
-	return ""
+	return nil
 }
 
-func (x *Proposal) GetSummary() string {
+func (x *TallySnapshot) GetTotalBondedTokens() string {
 	if x != nil {
-		return x.Summary
+		return x.TotalBondedTokens
 	}
 	return ""
 }
 
-func (x *Proposal) GetProposer() string {
+func (x *TallySnapshot) GetTallyTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Proposer
+		return x.TallyTime
 	}
-	return ""
+	return nil
 }
 
-func (x *Proposal) GetExpedited() bool {
+func (x *TallySnapshot) GetOptionTallyResults() []*OptionTallyResult {
 	if x != nil {
-		return x.Expedited
+		return x.OptionTallyResults
 	}
-	return false
+	return nil
 }
 
-// TallyResult defines a standard tally for a governance proposal.
-type TallyResult struct {
+// OptionTallyResult defines the tally result of an option of a multiple choice
+// proposal.
+//
+// Since: cosmos-sdk 0.50
+type OptionTallyResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// yes_count is the number of yes votes on a proposal.
-	YesCount string `protobuf:"bytes,1,opt,name=yes_count,json=yesCount,proto3" json:"yes_count,omitempty"`
-	// abstain_count is the number of abstain votes on a proposal.
-	AbstainCount string `protobuf:"bytes,2,opt,name=abstain_count,json=abstainCount,proto3" json:"abstain_count,omitempty"`
-	// no_count is the number of no votes on a proposal.
-	NoCount string `protobuf:"bytes,3,opt,name=no_count,json=noCount,proto3" json:"no_count,omitempty"`
-	// no_with_veto_count is the number of no with veto votes on a proposal.
-	NoWithVetoCount string `protobuf:"bytes,4,opt,name=no_with_veto_count,json=noWithVetoCount,proto3" json:"no_with_veto_count,omitempty"`
+	// option is the 1-based index of the option in the vote options of the proposal.
+	Option uint32 `protobuf:"varint,1,opt,name=option,proto3" json:"option,omitempty"`
+	// count is the number of votes for the option.
+	Count string `protobuf:"bytes,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *TallyResult) Reset() {
-	*x = TallyResult{}
+func (x *OptionTallyResult) Reset() {
+	*x = OptionTallyResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[3]
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TallyResult) String() string {
+func (x *OptionTallyResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TallyResult) ProtoMessage() {}
-
-// Deprecated: Use TallyResult.ProtoReflect.Descriptor instead.
-func (*TallyResult) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *TallyResult) GetYesCount() string {
-	if x != nil {
-		return x.YesCount
-	}
-	return ""
-}
+func (*OptionTallyResult) ProtoMessage() {}
 
-func (x *TallyResult) GetAbstainCount() string {
-	if x != nil {
-		return x.AbstainCount
-	}
-	return ""
+// Deprecated: Use OptionTallyResult.ProtoReflect.Descriptor instead.
+func (*OptionTallyResult) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *TallyResult) GetNoCount() string {
+func (x *OptionTallyResult) GetOption() uint32 {
 	if x != nil {
-		return x.NoCount
+		return x.Option
 	}
-	return ""
+	return 0
 }
 
-func (x *TallyResult) GetNoWithVetoCount() string {
+func (x *OptionTallyResult) GetCount() string {
 	if x != nil {
-		return x.NoWithVetoCount
+		return x.Count
 	}
 	return ""
 }
@@ -7339,7 +10659,7 @@ type Vote struct {
 func (x *Vote) Reset() {
 	*x = Vote{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[4]
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7353,7 +10673,7 @@ func (*Vote) ProtoMessage() {}
 
 // Deprecated: Use Vote.ProtoReflect.Descriptor instead.
 func (*Vote) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{4}
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Vote) GetProposalId() uint64 {
@@ -7402,7 +10722,7 @@ type DepositParams struct {
 func (x *DepositParams) Reset() {
 	*x = DepositParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[5]
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7416,7 +10736,7 @@ func (*DepositParams) ProtoMessage() {}
 
 // Deprecated: Use DepositParams.ProtoReflect.Descriptor instead.
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{5}
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DepositParams) GetMinDeposit() []*v1beta1.Coin {
@@ -7448,7 +10768,7 @@ type VotingParams struct {
 func (x *VotingParams) Reset() {
 	*x = VotingParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[6]
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7462,7 +10782,7 @@ func (*VotingParams) ProtoMessage() {}
 
 // Deprecated: Use VotingParams.ProtoReflect.Descriptor instead.
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{6}
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *VotingParams) GetVotingPeriod() *durationpb.Duration {
@@ -7493,7 +10813,7 @@ type TallyParams struct {
 func (x *TallyParams) Reset() {
 	*x = TallyParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[7]
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7507,7 +10827,7 @@ func (*TallyParams) ProtoMessage() {}
 
 // Deprecated: Use TallyParams.ProtoReflect.Descriptor instead.
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{7}
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *TallyParams) GetQuorum() string {
@@ -7546,15 +10866,15 @@ type Params struct {
 	MaxDepositPeriod *durationpb.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *durationpb.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 	// The cancel ratio which will not be returned back to the depositors when a proposal is cancelled.
 	//
@@ -7573,7 +10893,7 @@ type Params struct {
 	//
 	// Since: cosmos-sdk 0.48
 	ExpeditedThreshold string `protobuf:"bytes,11,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
-	// Minimum expedited deposit for a proposal to enter voting period.
+	//  Minimum expedited deposit for a proposal to enter voting period.
 	ExpeditedMinDeposit []*v1beta1.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit,omitempty"`
 	// burn deposits if a proposal does not meet quorum
 	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
@@ -7581,12 +10901,26 @@ type Params struct {
 	BurnProposalDepositPrevote bool `protobuf:"varint,14,opt,name=burn_proposal_deposit_prevote,json=burnProposalDepositPrevote,proto3" json:"burn_proposal_deposit_prevote,omitempty"`
 	// burn deposits if quorum with vote type no_veto is met
 	BurnVoteVeto bool `protobuf:"varint,15,opt,name=burn_vote_veto,json=burnVoteVeto,proto3" json:"burn_vote_veto,omitempty"`
+	// proposal_cancel_max_period defines how far in the voting period a proposer can cancel a proposal,
+	// as a fraction of the voting period. A proposal can always be canceled during its deposit period.
+	//
+	// Since: cosmos-sdk 0.50
+	ProposalCancelMaxPeriod string `protobuf:"bytes,16,opt,name=proposal_cancel_max_period,json=proposalCancelMaxPeriod,proto3" json:"proposal_cancel_max_period,omitempty"`
+	// optimistic_authorized_addresses is the list of addresses allowed to submit optimistic proposals.
+	//
+	// Since: cosmos-sdk 0.50
+	OptimisticAuthorizedAddresses []string `protobuf:"bytes,17,rep,name=optimistic_authorized_addresses,json=optimisticAuthorizedAddresses,proto3" json:"optimistic_authorized_addresses,omitempty"`
+	// optimistic_rejected_threshold is the minimum proportion of the total voting power voting no or
+	// no with veto for an optimistic proposal to be rejected.
+	//
+	// Since: cosmos-sdk 0.50
+	OptimisticRejectedThreshold string `protobuf:"bytes,18,opt,name=optimistic_rejected_threshold,json=optimisticRejectedThreshold,proto3" json:"optimistic_rejected_threshold,omitempty"`
 }
 
 func (x *Params) Reset() {
 	*x = Params{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[8]
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7600,7 +10934,7 @@ func (*Params) ProtoMessage() {}
 
 // Deprecated: Use Params.ProtoReflect.Descriptor instead.
 func (*Params) Descriptor() ([]byte, []int) {
-	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{8}
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Params) GetMinDeposit() []*v1beta1.Coin {
@@ -7708,6 +11042,96 @@ func (x *Params) GetBurnVoteVeto() bool {
 	return false
 }
 
+func (x *Params) GetProposalCancelMaxPeriod() string {
+	if x != nil {
+		return x.ProposalCancelMaxPeriod
+	}
+	return ""
+}
+
+func (x *Params) GetOptimisticAuthorizedAddresses() []string {
+	if x != nil {
+		return x.OptimisticAuthorizedAddresses
+	}
+	return nil
+}
+
+func (x *Params) GetOptimisticRejectedThreshold() string {
+	if x != nil {
+		return x.OptimisticRejectedThreshold
+	}
+	return ""
+}
+
+// ScheduledParamChange defines a governance approved parameter update, executed
+// once its scheduled height or time is reached.
+//
+// Since: cosmos-sdk 0.50
+type ScheduledParamChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id defines the unique id of the scheduled param change.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// msg is the MsgUpdateParams message executed once the change is due.
+	Msg *anypb.Any `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// height is the block height at which the change is executed, 0 if the
+	// change is scheduled by time.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the change is executed, empty if the
+	// change is scheduled by height.
+	Time *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *ScheduledParamChange) Reset() {
+	*x = ScheduledParamChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_gov_v1_gov_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledParamChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledParamChange) ProtoMessage() {}
+
+// Deprecated: Use ScheduledParamChange.ProtoReflect.Descriptor instead.
+func (*ScheduledParamChange) Descriptor() ([]byte, []int) {
+	return file_cosmos_gov_v1_gov_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ScheduledParamChange) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ScheduledParamChange) GetMsg() *anypb.Any {
+	if x != nil {
+		return x.Msg
+	}
+	return nil
+}
+
+func (x *ScheduledParamChange) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ScheduledParamChange) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
 var File_cosmos_gov_v1_gov_proto protoreflect.FileDescriptor
 
 var file_cosmos_gov_v1_gov_proto_rawDesc = []byte{
@@ -7742,7 +11166,7 @@ var file_cosmos_gov_v1_gov_proto_rawDesc = []byte{
 	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
 	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
 	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
-	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xdf, 0x05, 0x0a,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xc7, 0x07, 0x0a,
 	0x08, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x08, 0x6d, 0x65, 0x73,
 	0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
@@ -7788,21 +11212,72 @@ var file_cosmos_gov_v1_gov_proto_rawDesc = []byte{
 	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
 	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72,
 	0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x78, 0x70, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x22, 0xd7,
-	0x01, 0x0a, 0x0b, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2b,
-	0x0a, 0x09, 0x79, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x78, 0x70, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x12, 0x40,
+	0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67,
+	0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x45, 0x0a, 0x0c, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x56,
+	0x6f, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x76, 0x6f, 0x74, 0x65,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x5d, 0x0a, 0x1a, 0x66, 0x69, 0x6e, 0x61, 0x6c,
+	0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x5f, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x17, 0x66,
+	0x69, 0x6e, 0x61, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x2f, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xd7, 0x01, 0x0a, 0x0b, 0x54, 0x61, 0x6c, 0x6c,
+	0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2b, 0x0a, 0x09, 0x79, 0x65, 0x73, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x08, 0x79, 0x65, 0x73, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x0d, 0x61, 0x62, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0c, 0x61, 0x62, 0x73,
+	0x74, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x08, 0x6e, 0x6f, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x07, 0x6e, 0x6f, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x12, 0x6e, 0x6f, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f,
+	0x76, 0x65, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74,
+	0x52, 0x0f, 0x6e, 0x6f, 0x57, 0x69, 0x74, 0x68, 0x56, 0x65, 0x74, 0x6f, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0xc1, 0x03, 0x0a, 0x0d, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x05, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x12, 0x43, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x12, 0x43, 0x0a, 0x0f, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f,
+	0x76, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x61, 0x6c, 0x6c, 0x79,
+	0x12, 0x3e, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x11, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x12, 0x3f, 0x0a, 0x0a, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x52, 0x0a, 0x14, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x6c, 0x6c,
+	0x79, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x12, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x51, 0x0a, 0x11, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e,
-	0x74, 0x52, 0x08, 0x79, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x0d, 0x61,
-	0x62, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49,
-	0x6e, 0x74, 0x52, 0x0c, 0x61, 0x62, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x29, 0x0a, 0x08, 0x6e, 0x6f, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49,
-	0x6e, 0x74, 0x52, 0x07, 0x6e, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x12, 0x6e,
-	0x6f, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x76, 0x65, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0f, 0x6e, 0x6f, 0x57, 0x69, 0x74, 0x68, 0x56,
-	0x65, 0x74, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb6, 0x01, 0x0a, 0x04, 0x56, 0x6f, 0x74,
+	0x74, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb6, 0x01, 0x0a, 0x04, 0x56, 0x6f, 0x74,
 	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
 	0x49, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
@@ -7843,7 +11318,7 @@ var file_cosmos_gov_v1_gov_proto_rawDesc = []byte{
 	0x64, 0x12, 0x35, 0x0a, 0x0e, 0x76, 0x65, 0x74, 0x6f, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
 	0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x76, 0x65, 0x74, 0x6f, 0x54,
-	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x3a, 0x02, 0x18, 0x01, 0x22, 0xd3, 0x07, 0x0a,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x3a, 0x02, 0x18, 0x01, 0x22, 0xd6, 0x09, 0x0a,
 	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x45, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x64,
 	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
@@ -7905,39 +11380,81 @@ var file_cosmos_gov_v1_gov_proto_rawDesc = []byte{
 	0x70, 0x6f, 0x73, 0x69, 0x74, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e,
 	0x62, 0x75, 0x72, 0x6e, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x76, 0x65, 0x74, 0x6f, 0x18, 0x0f,
 	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x62, 0x75, 0x72, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x56, 0x65,
-	0x74, 0x6f, 0x2a, 0x89, 0x01, 0x0a, 0x0a, 0x56, 0x6f, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1b, 0x0a, 0x17, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13,
-	0x0a, 0x0f, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x59, 0x45,
-	0x53, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x41, 0x42, 0x53, 0x54, 0x41, 0x49, 0x4e, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e,
-	0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x10, 0x03,
-	0x12, 0x1c, 0x0a, 0x18, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x4e, 0x4f, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x56, 0x45, 0x54, 0x4f, 0x10, 0x04, 0x2a, 0xce,
-	0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f, 0x50, 0x45,
-	0x52, 0x49, 0x4f, 0x44, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53,
-	0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56, 0x4f, 0x54, 0x49, 0x4e, 0x47,
-	0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f,
-	0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x41, 0x53,
-	0x53, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41,
-	0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45,
-	0x44, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x42,
-	0x99, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67,
-	0x6f, 0x76, 0x2e, 0x76, 0x31, 0x42, 0x08, 0x47, 0x6f, 0x76, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76,
-	0x31, 0x3b, 0x67, 0x6f, 0x76, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x47, 0x58, 0xaa, 0x02, 0x0d,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x47, 0x6f, 0x76, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x6f, 0x76, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x6f, 0x76, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x43, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x3a, 0x3a, 0x47, 0x6f, 0x76, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x74, 0x6f, 0x12, 0x4b, 0x0a, 0x1a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x63,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x17, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61, 0x78, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x60, 0x0a, 0x1f, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x73, 0x74, 0x69, 0x63, 0x5f, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x1d, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x73, 0x74, 0x69, 0x63, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x12, 0x52, 0x0a, 0x1d, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x73, 0x74, 0x69, 0x63, 0x5f,
+	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x1b, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x9c, 0x01, 0x0a, 0x14, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26,
+	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e,
+	0x79, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x34,
+	0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x2a, 0x8a, 0x01, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41,
+	0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x41, 0x52, 0x44, 0x10, 0x01,
+	0x12, 0x21, 0x0a, 0x1d, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x50, 0x4c, 0x45, 0x5f, 0x43, 0x48, 0x4f, 0x49, 0x43,
+	0x45, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4d, 0x49, 0x53, 0x54, 0x49, 0x43, 0x10,
+	0x03, 0x2a, 0xe4, 0x01, 0x0a, 0x0a, 0x56, 0x6f, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1b, 0x0a, 0x17, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a,
+	0x0f, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x59, 0x45, 0x53,
+	0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x41, 0x42, 0x53, 0x54, 0x41, 0x49, 0x4e, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x56,
+	0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x10, 0x03, 0x12,
+	0x1c, 0x0a, 0x18, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e,
+	0x4f, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x56, 0x45, 0x54, 0x4f, 0x10, 0x04, 0x12, 0x13, 0x0a,
+	0x0f, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x4e, 0x45,
+	0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x54, 0x57, 0x4f, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x56, 0x4f, 0x54, 0x45, 0x5f,
+	0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x45, 0x10, 0x03, 0x12, 0x14,
+	0x0a, 0x10, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x4f,
+	0x55, 0x52, 0x10, 0x04, 0x1a, 0x02, 0x10, 0x01, 0x2a, 0xeb, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x1b, 0x50,
+	0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e,
+	0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x10, 0x01,
+	0x12, 0x21, 0x0a, 0x1d, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x56, 0x4f, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f,
+	0x44, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x45, 0x44, 0x10, 0x03, 0x12,
+	0x1c, 0x0a, 0x18, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1a, 0x0a,
+	0x16, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x52, 0x4f,
+	0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x54, 0x41, 0x4c,
+	0x4c, 0x49, 0x45, 0x44, 0x10, 0x06, 0x42, 0x99, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x76, 0x31, 0x42, 0x08, 0x47, 0x6f,
+	0x76, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x76, 0x31, 0x3b, 0x67, 0x6f, 0x76, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x43, 0x47, 0x58, 0xaa, 0x02, 0x0d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x47, 0x6f,
+	0x76, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x6f,
+	0x76, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x6f,
+	0x76, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x0f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x47, 0x6f, 0x76, 0x3a, 0x3a,
+	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -7952,50 +11469,65 @@ func file_cosmos_gov_v1_gov_proto_rawDescGZIP() []byte {
 	return file_cosmos_gov_v1_gov_proto_rawDescData
 }
 
-var file_cosmos_gov_v1_gov_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_cosmos_gov_v1_gov_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_cosmos_gov_v1_gov_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_cosmos_gov_v1_gov_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_cosmos_gov_v1_gov_proto_goTypes = []interface{}{
-	(VoteOption)(0),               // 0: cosmos.gov.v1.VoteOption
-	(ProposalStatus)(0),           // 1: cosmos.gov.v1.ProposalStatus
-	(*WeightedVoteOption)(nil),    // 2: cosmos.gov.v1.WeightedVoteOption
-	(*Deposit)(nil),               // 3: cosmos.gov.v1.Deposit
-	(*Proposal)(nil),              // 4: cosmos.gov.v1.Proposal
-	(*TallyResult)(nil),           // 5: cosmos.gov.v1.TallyResult
-	(*Vote)(nil),                  // 6: cosmos.gov.v1.Vote
-	(*DepositParams)(nil),         // 7: cosmos.gov.v1.DepositParams
-	(*VotingParams)(nil),          // 8: cosmos.gov.v1.VotingParams
-	(*TallyParams)(nil),           // 9: cosmos.gov.v1.TallyParams
-	(*Params)(nil),                // 10: cosmos.gov.v1.Params
-	(*v1beta1.Coin)(nil),          // 11: cosmos.base.v1beta1.Coin
-	(*anypb.Any)(nil),             // 12: google.protobuf.Any
-	(*timestamppb.Timestamp)(nil), // 13: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),   // 14: google.protobuf.Duration
+	(ProposalType)(0),             // 0: cosmos.gov.v1.ProposalType
+	(VoteOption)(0),               // 1: cosmos.gov.v1.VoteOption
+	(ProposalStatus)(0),           // 2: cosmos.gov.v1.ProposalStatus
+	(*WeightedVoteOption)(nil),    // 3: cosmos.gov.v1.WeightedVoteOption
+	(*Deposit)(nil),               // 4: cosmos.gov.v1.Deposit
+	(*Proposal)(nil),              // 5: cosmos.gov.v1.Proposal
+	(*ProposalVoteOptions)(nil),   // 6: cosmos.gov.v1.ProposalVoteOptions
+	(*TallyResult)(nil),           // 7: cosmos.gov.v1.TallyResult
+	(*TallySnapshot)(nil),         // 8: cosmos.gov.v1.TallySnapshot
+	(*OptionTallyResult)(nil),     // 9: cosmos.gov.v1.OptionTallyResult
+	(*Vote)(nil),                  // 10: cosmos.gov.v1.Vote
+	(*DepositParams)(nil),         // 11: cosmos.gov.v1.DepositParams
+	(*VotingParams)(nil),          // 12: cosmos.gov.v1.VotingParams
+	(*TallyParams)(nil),           // 13: cosmos.gov.v1.TallyParams
+	(*Params)(nil),                // 14: cosmos.gov.v1.Params
+	(*ScheduledParamChange)(nil),  // 15: cosmos.gov.v1.ScheduledParamChange
+	(*v1beta1.Coin)(nil),          // 16: cosmos.base.v1beta1.Coin
+	(*anypb.Any)(nil),             // 17: google.protobuf.Any
+	(*timestamppb.Timestamp)(nil), // 18: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 19: google.protobuf.Duration
 }
 var file_cosmos_gov_v1_gov_proto_depIdxs = []int32{
-	0,  // 0: cosmos.gov.v1.WeightedVoteOption.option:type_name -> cosmos.gov.v1.VoteOption
-	11, // 1: cosmos.gov.v1.Deposit.amount:type_name -> cosmos.base.v1beta1.Coin
-	12, // 2: cosmos.gov.v1.Proposal.messages:type_name -> google.protobuf.Any
-	1,  // 3: cosmos.gov.v1.Proposal.status:type_name -> cosmos.gov.v1.ProposalStatus
-	5,  // 4: cosmos.gov.v1.Proposal.final_tally_result:type_name -> cosmos.gov.v1.TallyResult
-	13, // 5: cosmos.gov.v1.Proposal.submit_time:type_name -> google.protobuf.Timestamp
-	13, // 6: cosmos.gov.v1.Proposal.deposit_end_time:type_name -> google.protobuf.Timestamp
-	11, // 7: cosmos.gov.v1.Proposal.total_deposit:type_name -> cosmos.base.v1beta1.Coin
-	13, // 8: cosmos.gov.v1.Proposal.voting_start_time:type_name -> google.protobuf.Timestamp
-	13, // 9: cosmos.gov.v1.Proposal.voting_end_time:type_name -> google.protobuf.Timestamp
-	2,  // 10: cosmos.gov.v1.Vote.options:type_name -> cosmos.gov.v1.WeightedVoteOption
-	11, // 11: cosmos.gov.v1.DepositParams.min_deposit:type_name -> cosmos.base.v1beta1.Coin
-	14, // 12: cosmos.gov.v1.DepositParams.max_deposit_period:type_name -> google.protobuf.Duration
-	14, // 13: cosmos.gov.v1.VotingParams.voting_period:type_name -> google.protobuf.Duration
-	11, // 14: cosmos.gov.v1.Params.min_deposit:type_name -> cosmos.base.v1beta1.Coin
-	14, // 15: cosmos.gov.v1.Params.max_deposit_period:type_name -> google.protobuf.Duration
-	14, // 16: cosmos.gov.v1.Params.voting_period:type_name -> google.protobuf.Duration
-	14, // 17: cosmos.gov.v1.Params.expedited_voting_period:type_name -> google.protobuf.Duration
-	11, // 18: cosmos.gov.v1.Params.expedited_min_deposit:type_name -> cosmos.base.v1beta1.Coin
-	19, // [19:19] is the sub-list for method output_type
-	19, // [19:19] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	1,  // 0: cosmos.gov.v1.WeightedVoteOption.option:type_name -> cosmos.gov.v1.VoteOption
+	16, // 1: cosmos.gov.v1.Deposit.amount:type_name -> cosmos.base.v1beta1.Coin
+	17, // 2: cosmos.gov.v1.Proposal.messages:type_name -> google.protobuf.Any
+	2,  // 3: cosmos.gov.v1.Proposal.status:type_name -> cosmos.gov.v1.ProposalStatus
+	7,  // 4: cosmos.gov.v1.Proposal.final_tally_result:type_name -> cosmos.gov.v1.TallyResult
+	18, // 5: cosmos.gov.v1.Proposal.submit_time:type_name -> google.protobuf.Timestamp
+	18, // 6: cosmos.gov.v1.Proposal.deposit_end_time:type_name -> google.protobuf.Timestamp
+	16, // 7: cosmos.gov.v1.Proposal.total_deposit:type_name -> cosmos.base.v1beta1.Coin
+	18, // 8: cosmos.gov.v1.Proposal.voting_start_time:type_name -> google.protobuf.Timestamp
+	18, // 9: cosmos.gov.v1.Proposal.voting_end_time:type_name -> google.protobuf.Timestamp
+	0,  // 10: cosmos.gov.v1.Proposal.proposal_type:type_name -> cosmos.gov.v1.ProposalType
+	6,  // 11: cosmos.gov.v1.Proposal.vote_options:type_name -> cosmos.gov.v1.ProposalVoteOptions
+	9,  // 12: cosmos.gov.v1.Proposal.final_option_tally_results:type_name -> cosmos.gov.v1.OptionTallyResult
+	7,  // 13: cosmos.gov.v1.TallySnapshot.tally:type_name -> cosmos.gov.v1.TallyResult
+	7,  // 14: cosmos.gov.v1.TallySnapshot.validator_tally:type_name -> cosmos.gov.v1.TallyResult
+	7,  // 15: cosmos.gov.v1.TallySnapshot.delegator_tally:type_name -> cosmos.gov.v1.TallyResult
+	18, // 16: cosmos.gov.v1.TallySnapshot.tally_time:type_name -> google.protobuf.Timestamp
+	9,  // 17: cosmos.gov.v1.TallySnapshot.option_tally_results:type_name -> cosmos.gov.v1.OptionTallyResult
+	3,  // 18: cosmos.gov.v1.Vote.options:type_name -> cosmos.gov.v1.WeightedVoteOption
+	16, // 19: cosmos.gov.v1.DepositParams.min_deposit:type_name -> cosmos.base.v1beta1.Coin
+	19, // 20: cosmos.gov.v1.DepositParams.max_deposit_period:type_name -> google.protobuf.Duration
+	19, // 21: cosmos.gov.v1.VotingParams.voting_period:type_name -> google.protobuf.Duration
+	16, // 22: cosmos.gov.v1.Params.min_deposit:type_name -> cosmos.base.v1beta1.Coin
+	19, // 23: cosmos.gov.v1.Params.max_deposit_period:type_name -> google.protobuf.Duration
+	19, // 24: cosmos.gov.v1.Params.voting_period:type_name -> google.protobuf.Duration
+	19, // 25: cosmos.gov.v1.Params.expedited_voting_period:type_name -> google.protobuf.Duration
+	16, // 26: cosmos.gov.v1.Params.expedited_min_deposit:type_name -> cosmos.base.v1beta1.Coin
+	17, // 27: cosmos.gov.v1.ScheduledParamChange.msg:type_name -> google.protobuf.Any
+	18, // 28: cosmos.gov.v1.ScheduledParamChange.time:type_name -> google.protobuf.Timestamp
+	29, // [29:29] is the sub-list for method output_type
+	29, // [29:29] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_gov_v1_gov_proto_init() }
@@ -8041,7 +11573,7 @@ func file_cosmos_gov_v1_gov_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_gov_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TallyResult); i {
+			switch v := v.(*ProposalVoteOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8053,7 +11585,7 @@ func file_cosmos_gov_v1_gov_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_gov_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Vote); i {
+			switch v := v.(*TallyResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8065,7 +11597,7 @@ func file_cosmos_gov_v1_gov_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_gov_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositParams); i {
+			switch v := v.(*TallySnapshot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8077,7 +11609,7 @@ func file_cosmos_gov_v1_gov_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_gov_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VotingParams); i {
+			switch v := v.(*OptionTallyResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8089,7 +11621,7 @@ func file_cosmos_gov_v1_gov_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_gov_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TallyParams); i {
+			switch v := v.(*Vote); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8101,6 +11633,42 @@ func file_cosmos_gov_v1_gov_proto_init() {
 			}
 		}
 		file_cosmos_gov_v1_gov_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_gov_v1_gov_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VotingParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_gov_v1_gov_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TallyParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_gov_v1_gov_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Params); i {
 			case 0:
 				return &v.state
@@ -8112,14 +11680,26 @@ func file_cosmos_gov_v1_gov_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_gov_v1_gov_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledParamChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_gov_v1_gov_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   9,
+			NumEnums:      3,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
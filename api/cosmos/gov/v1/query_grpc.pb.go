@@ -1,5 +1,3 @@
-// Since: cosmos-sdk 0.46
-
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.3.0
@@ -21,15 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Constitution_FullMethodName = "/cosmos.gov.v1.Query/Constitution"
-	Query_Proposal_FullMethodName     = "/cosmos.gov.v1.Query/Proposal"
-	Query_Proposals_FullMethodName    = "/cosmos.gov.v1.Query/Proposals"
-	Query_Vote_FullMethodName         = "/cosmos.gov.v1.Query/Vote"
-	Query_Votes_FullMethodName        = "/cosmos.gov.v1.Query/Votes"
-	Query_Params_FullMethodName       = "/cosmos.gov.v1.Query/Params"
-	Query_Deposit_FullMethodName      = "/cosmos.gov.v1.Query/Deposit"
-	Query_Deposits_FullMethodName     = "/cosmos.gov.v1.Query/Deposits"
-	Query_TallyResult_FullMethodName  = "/cosmos.gov.v1.Query/TallyResult"
+	Query_Constitution_FullMethodName          = "/cosmos.gov.v1.Query/Constitution"
+	Query_Proposal_FullMethodName              = "/cosmos.gov.v1.Query/Proposal"
+	Query_Proposals_FullMethodName             = "/cosmos.gov.v1.Query/Proposals"
+	Query_Vote_FullMethodName                  = "/cosmos.gov.v1.Query/Vote"
+	Query_Votes_FullMethodName                 = "/cosmos.gov.v1.Query/Votes"
+	Query_Params_FullMethodName                = "/cosmos.gov.v1.Query/Params"
+	Query_Deposit_FullMethodName               = "/cosmos.gov.v1.Query/Deposit"
+	Query_Deposits_FullMethodName              = "/cosmos.gov.v1.Query/Deposits"
+	Query_TallyResult_FullMethodName           = "/cosmos.gov.v1.Query/TallyResult"
+	Query_ProposalVoteOptions_FullMethodName   = "/cosmos.gov.v1.Query/ProposalVoteOptions"
+	Query_OptionTallyResults_FullMethodName    = "/cosmos.gov.v1.Query/OptionTallyResults"
+	Query_TallySnapshot_FullMethodName         = "/cosmos.gov.v1.Query/TallySnapshot"
+	Query_ScheduledParamChanges_FullMethodName = "/cosmos.gov.v1.Query/ScheduledParamChanges"
 )
 
 // QueryClient is the client API for Query service.
@@ -54,6 +56,23 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// ProposalVoteOptions queries the valid voting options for a proposal.
+	//
+	// Since: cosmos-sdk 0.50
+	ProposalVoteOptions(ctx context.Context, in *QueryProposalVoteOptionsRequest, opts ...grpc.CallOption) (*QueryProposalVoteOptionsResponse, error)
+	// OptionTallyResults queries the tally of each option of a multiple choice
+	// proposal.
+	//
+	// Since: cosmos-sdk 0.50
+	OptionTallyResults(ctx context.Context, in *QueryOptionTallyResultsRequest, opts ...grpc.CallOption) (*QueryOptionTallyResultsResponse, error)
+	// TallySnapshot queries the final tally breakdown of a proposal.
+	//
+	// Since: cosmos-sdk 0.50
+	TallySnapshot(ctx context.Context, in *QueryTallySnapshotRequest, opts ...grpc.CallOption) (*QueryTallySnapshotResponse, error)
+	// ScheduledParamChanges queries all the pending scheduled param changes.
+	//
+	// Since: cosmos-sdk 0.50
+	ScheduledParamChanges(ctx context.Context, in *QueryScheduledParamChangesRequest, opts ...grpc.CallOption) (*QueryScheduledParamChangesResponse, error)
 }
 
 type queryClient struct {
@@ -145,6 +164,42 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) ProposalVoteOptions(ctx context.Context, in *QueryProposalVoteOptionsRequest, opts ...grpc.CallOption) (*QueryProposalVoteOptionsResponse, error) {
+	out := new(QueryProposalVoteOptionsResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalVoteOptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OptionTallyResults(ctx context.Context, in *QueryOptionTallyResultsRequest, opts ...grpc.CallOption) (*QueryOptionTallyResultsResponse, error) {
+	out := new(QueryOptionTallyResultsResponse)
+	err := c.cc.Invoke(ctx, Query_OptionTallyResults_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TallySnapshot(ctx context.Context, in *QueryTallySnapshotRequest, opts ...grpc.CallOption) (*QueryTallySnapshotResponse, error) {
+	out := new(QueryTallySnapshotResponse)
+	err := c.cc.Invoke(ctx, Query_TallySnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledParamChanges(ctx context.Context, in *QueryScheduledParamChangesRequest, opts ...grpc.CallOption) (*QueryScheduledParamChangesResponse, error) {
+	out := new(QueryScheduledParamChangesResponse)
+	err := c.cc.Invoke(ctx, Query_ScheduledParamChanges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -167,6 +222,23 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// ProposalVoteOptions queries the valid voting options for a proposal.
+	//
+	// Since: cosmos-sdk 0.50
+	ProposalVoteOptions(context.Context, *QueryProposalVoteOptionsRequest) (*QueryProposalVoteOptionsResponse, error)
+	// OptionTallyResults queries the tally of each option of a multiple choice
+	// proposal.
+	//
+	// Since: cosmos-sdk 0.50
+	OptionTallyResults(context.Context, *QueryOptionTallyResultsRequest) (*QueryOptionTallyResultsResponse, error)
+	// TallySnapshot queries the final tally breakdown of a proposal.
+	//
+	// Since: cosmos-sdk 0.50
+	TallySnapshot(context.Context, *QueryTallySnapshotRequest) (*QueryTallySnapshotResponse, error)
+	// ScheduledParamChanges queries all the pending scheduled param changes.
+	//
+	// Since: cosmos-sdk 0.50
+	ScheduledParamChanges(context.Context, *QueryScheduledParamChangesRequest) (*QueryScheduledParamChangesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -201,6 +273,18 @@ func (UnimplementedQueryServer) Deposits(context.Context, *QueryDepositsRequest)
 func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (UnimplementedQueryServer) ProposalVoteOptions(context.Context, *QueryProposalVoteOptionsRequest) (*QueryProposalVoteOptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalVoteOptions not implemented")
+}
+func (UnimplementedQueryServer) OptionTallyResults(context.Context, *QueryOptionTallyResultsRequest) (*QueryOptionTallyResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OptionTallyResults not implemented")
+}
+func (UnimplementedQueryServer) TallySnapshot(context.Context, *QueryTallySnapshotRequest) (*QueryTallySnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TallySnapshot not implemented")
+}
+func (UnimplementedQueryServer) ScheduledParamChanges(context.Context, *QueryScheduledParamChangesRequest) (*QueryScheduledParamChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledParamChanges not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -376,6 +460,78 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProposalVoteOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalVoteOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalVoteOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalVoteOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalVoteOptions(ctx, req.(*QueryProposalVoteOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OptionTallyResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOptionTallyResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OptionTallyResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OptionTallyResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OptionTallyResults(ctx, req.(*QueryOptionTallyResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TallySnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTallySnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TallySnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TallySnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TallySnapshot(ctx, req.(*QueryTallySnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledParamChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledParamChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledParamChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScheduledParamChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledParamChanges(ctx, req.(*QueryScheduledParamChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -419,6 +575,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "ProposalVoteOptions",
+			Handler:    _Query_ProposalVoteOptions_Handler,
+		},
+		{
+			MethodName: "OptionTallyResults",
+			Handler:    _Query_OptionTallyResults_Handler,
+		},
+		{
+			MethodName: "TallySnapshot",
+			Handler:    _Query_TallySnapshot_Handler,
+		},
+		{
+			MethodName: "ScheduledParamChanges",
+			Handler:    _Query_ScheduledParamChanges_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
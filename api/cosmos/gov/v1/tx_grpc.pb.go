@@ -1,5 +1,3 @@
-// Since: cosmos-sdk 0.46
-
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.3.0
@@ -21,13 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_SubmitProposal_FullMethodName    = "/cosmos.gov.v1.Msg/SubmitProposal"
-	Msg_ExecLegacyContent_FullMethodName = "/cosmos.gov.v1.Msg/ExecLegacyContent"
-	Msg_Vote_FullMethodName              = "/cosmos.gov.v1.Msg/Vote"
-	Msg_VoteWeighted_FullMethodName      = "/cosmos.gov.v1.Msg/VoteWeighted"
-	Msg_Deposit_FullMethodName           = "/cosmos.gov.v1.Msg/Deposit"
-	Msg_UpdateParams_FullMethodName      = "/cosmos.gov.v1.Msg/UpdateParams"
-	Msg_CancelProposal_FullMethodName    = "/cosmos.gov.v1.Msg/CancelProposal"
+	Msg_SubmitProposal_FullMethodName               = "/cosmos.gov.v1.Msg/SubmitProposal"
+	Msg_ExecLegacyContent_FullMethodName            = "/cosmos.gov.v1.Msg/ExecLegacyContent"
+	Msg_Vote_FullMethodName                         = "/cosmos.gov.v1.Msg/Vote"
+	Msg_VoteWeighted_FullMethodName                 = "/cosmos.gov.v1.Msg/VoteWeighted"
+	Msg_Deposit_FullMethodName                      = "/cosmos.gov.v1.Msg/Deposit"
+	Msg_UpdateParams_FullMethodName                 = "/cosmos.gov.v1.Msg/UpdateParams"
+	Msg_CancelProposal_FullMethodName               = "/cosmos.gov.v1.Msg/CancelProposal"
+	Msg_SubmitMultipleChoiceProposal_FullMethodName = "/cosmos.gov.v1.Msg/SubmitMultipleChoiceProposal"
+	Msg_ScheduleParamChange_FullMethodName          = "/cosmos.gov.v1.Msg/ScheduleParamChange"
 )
 
 // MsgClient is the client API for Msg service.
@@ -54,6 +54,16 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.48
 	CancelProposal(ctx context.Context, in *MsgCancelProposal, opts ...grpc.CallOption) (*MsgCancelProposalResponse, error)
+	// SubmitMultipleChoiceProposal defines a method to create new multiple choice proposal.
+	//
+	// Since: cosmos-sdk 0.50
+	SubmitMultipleChoiceProposal(ctx context.Context, in *MsgSubmitMultipleChoiceProposal, opts ...grpc.CallOption) (*MsgSubmitMultipleChoiceProposalResponse, error)
+	// ScheduleParamChange defines a governance operation for scheduling a
+	// parameter update at a future height or time.
+	// The authority is defined in the keeper.
+	//
+	// Since: cosmos-sdk 0.50
+	ScheduleParamChange(ctx context.Context, in *MsgScheduleParamChange, opts ...grpc.CallOption) (*MsgScheduleParamChangeResponse, error)
 }
 
 type msgClient struct {
@@ -127,6 +137,24 @@ func (c *msgClient) CancelProposal(ctx context.Context, in *MsgCancelProposal, o
 	return out, nil
 }
 
+func (c *msgClient) SubmitMultipleChoiceProposal(ctx context.Context, in *MsgSubmitMultipleChoiceProposal, opts ...grpc.CallOption) (*MsgSubmitMultipleChoiceProposalResponse, error) {
+	out := new(MsgSubmitMultipleChoiceProposalResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitMultipleChoiceProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleParamChange(ctx context.Context, in *MsgScheduleParamChange, opts ...grpc.CallOption) (*MsgScheduleParamChangeResponse, error) {
+	out := new(MsgScheduleParamChangeResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleParamChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -151,6 +179,16 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.48
 	CancelProposal(context.Context, *MsgCancelProposal) (*MsgCancelProposalResponse, error)
+	// SubmitMultipleChoiceProposal defines a method to create new multiple choice proposal.
+	//
+	// Since: cosmos-sdk 0.50
+	SubmitMultipleChoiceProposal(context.Context, *MsgSubmitMultipleChoiceProposal) (*MsgSubmitMultipleChoiceProposalResponse, error)
+	// ScheduleParamChange defines a governance operation for scheduling a
+	// parameter update at a future height or time.
+	// The authority is defined in the keeper.
+	//
+	// Since: cosmos-sdk 0.50
+	ScheduleParamChange(context.Context, *MsgScheduleParamChange) (*MsgScheduleParamChangeResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -179,6 +217,12 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) CancelProposal(context.Context, *MsgCancelProposal) (*MsgCancelProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelProposal not implemented")
 }
+func (UnimplementedMsgServer) SubmitMultipleChoiceProposal(context.Context, *MsgSubmitMultipleChoiceProposal) (*MsgSubmitMultipleChoiceProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitMultipleChoiceProposal not implemented")
+}
+func (UnimplementedMsgServer) ScheduleParamChange(context.Context, *MsgScheduleParamChange) (*MsgScheduleParamChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleParamChange not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -318,6 +362,42 @@ func _Msg_CancelProposal_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitMultipleChoiceProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitMultipleChoiceProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitMultipleChoiceProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitMultipleChoiceProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitMultipleChoiceProposal(ctx, req.(*MsgSubmitMultipleChoiceProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleParamChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleParamChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleParamChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleParamChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleParamChange(ctx, req.(*MsgScheduleParamChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -353,6 +433,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelProposal",
 			Handler:    _Msg_CancelProposal_Handler,
 		},
+		{
+			MethodName: "SubmitMultipleChoiceProposal",
+			Handler:    _Msg_SubmitMultipleChoiceProposal_Handler,
+		},
+		{
+			MethodName: "ScheduleParamChange",
+			Handler:    _Msg_ScheduleParamChange_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
@@ -14,14 +14,16 @@ import (
 )
 
 var (
-	md_Class             protoreflect.MessageDescriptor
-	fd_Class_id          protoreflect.FieldDescriptor
-	fd_Class_name        protoreflect.FieldDescriptor
-	fd_Class_symbol      protoreflect.FieldDescriptor
-	fd_Class_description protoreflect.FieldDescriptor
-	fd_Class_uri         protoreflect.FieldDescriptor
-	fd_Class_uri_hash    protoreflect.FieldDescriptor
-	fd_Class_data        protoreflect.FieldDescriptor
+	md_Class                  protoreflect.MessageDescriptor
+	fd_Class_id               protoreflect.FieldDescriptor
+	fd_Class_name             protoreflect.FieldDescriptor
+	fd_Class_symbol           protoreflect.FieldDescriptor
+	fd_Class_description      protoreflect.FieldDescriptor
+	fd_Class_uri              protoreflect.FieldDescriptor
+	fd_Class_uri_hash         protoreflect.FieldDescriptor
+	fd_Class_data             protoreflect.FieldDescriptor
+	fd_Class_non_transferable protoreflect.FieldDescriptor
+	fd_Class_royalty          protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -34,6 +36,8 @@ func init() {
 	fd_Class_uri = md_Class.Fields().ByName("uri")
 	fd_Class_uri_hash = md_Class.Fields().ByName("uri_hash")
 	fd_Class_data = md_Class.Fields().ByName("data")
+	fd_Class_non_transferable = md_Class.Fields().ByName("non_transferable")
+	fd_Class_royalty = md_Class.Fields().ByName("royalty")
 }
 
 var _ protoreflect.Message = (*fastReflection_Class)(nil)
@@ -143,6 +147,18 @@ func (x *fastReflection_Class) Range(f func(protoreflect.FieldDescriptor, protor
 			return
 		}
 	}
+	if x.NonTransferable != false {
+		value := protoreflect.ValueOfBool(x.NonTransferable)
+		if !f(fd_Class_non_transferable, value) {
+			return
+		}
+	}
+	if x.Royalty != nil {
+		value := protoreflect.ValueOfMessage(x.Royalty.ProtoReflect())
+		if !f(fd_Class_royalty, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -172,6 +188,10 @@ func (x *fastReflection_Class) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.UriHash != ""
 	case "cosmos.nft.v1beta1.Class.data":
 		return x.Data != nil
+	case "cosmos.nft.v1beta1.Class.non_transferable":
+		return x.NonTransferable != false
+	case "cosmos.nft.v1beta1.Class.royalty":
+		return x.Royalty != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.nft.v1beta1.Class"))
@@ -202,6 +222,10 @@ func (x *fastReflection_Class) Clear(fd protoreflect.FieldDescriptor) {
 		x.UriHash = ""
 	case "cosmos.nft.v1beta1.Class.data":
 		x.Data = nil
+	case "cosmos.nft.v1beta1.Class.non_transferable":
+		x.NonTransferable = false
+	case "cosmos.nft.v1beta1.Class.royalty":
+		x.Royalty = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.nft.v1beta1.Class"))
@@ -239,6 +263,12 @@ func (x *fastReflection_Class) Get(descriptor protoreflect.FieldDescriptor) prot
 	case "cosmos.nft.v1beta1.Class.data":
 		value := x.Data
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.nft.v1beta1.Class.non_transferable":
+		value := x.NonTransferable
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.nft.v1beta1.Class.royalty":
+		value := x.Royalty
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.nft.v1beta1.Class"))
@@ -273,6 +303,10 @@ func (x *fastReflection_Class) Set(fd protoreflect.FieldDescriptor, value protor
 		x.UriHash = value.Interface().(string)
 	case "cosmos.nft.v1beta1.Class.data":
 		x.Data = value.Message().Interface().(*anypb.Any)
+	case "cosmos.nft.v1beta1.Class.non_transferable":
+		x.NonTransferable = value.Bool()
+	case "cosmos.nft.v1beta1.Class.royalty":
+		x.Royalty = value.Message().Interface().(*RoyaltyInfo)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.nft.v1beta1.Class"))
@@ -298,6 +332,11 @@ func (x *fastReflection_Class) Mutable(fd protoreflect.FieldDescriptor) protoref
 			x.Data = new(anypb.Any)
 		}
 		return protoreflect.ValueOfMessage(x.Data.ProtoReflect())
+	case "cosmos.nft.v1beta1.Class.royalty":
+		if x.Royalty == nil {
+			x.Royalty = new(RoyaltyInfo)
+		}
+		return protoreflect.ValueOfMessage(x.Royalty.ProtoReflect())
 	case "cosmos.nft.v1beta1.Class.id":
 		panic(fmt.Errorf("field id of message cosmos.nft.v1beta1.Class is not mutable"))
 	case "cosmos.nft.v1beta1.Class.name":
@@ -310,6 +349,8 @@ func (x *fastReflection_Class) Mutable(fd protoreflect.FieldDescriptor) protoref
 		panic(fmt.Errorf("field uri of message cosmos.nft.v1beta1.Class is not mutable"))
 	case "cosmos.nft.v1beta1.Class.uri_hash":
 		panic(fmt.Errorf("field uri_hash of message cosmos.nft.v1beta1.Class is not mutable"))
+	case "cosmos.nft.v1beta1.Class.non_transferable":
+		panic(fmt.Errorf("field non_transferable of message cosmos.nft.v1beta1.Class is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.nft.v1beta1.Class"))
@@ -338,6 +379,11 @@ func (x *fastReflection_Class) NewField(fd protoreflect.FieldDescriptor) protore
 	case "cosmos.nft.v1beta1.Class.data":
 		m := new(anypb.Any)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.nft.v1beta1.Class.non_transferable":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.nft.v1beta1.Class.royalty":
+		m := new(RoyaltyInfo)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.nft.v1beta1.Class"))
@@ -435,6 +481,13 @@ func (x *fastReflection_Class) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Data)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.NonTransferable {
+			n += 2
+		}
+		if x.Royalty != nil {
+			l = options.Size(x.Royalty)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -464,6 +517,30 @@ func (x *fastReflection_Class) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Royalty != nil {
+			encoded, err := options.Marshal(x.Royalty)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.NonTransferable {
+			i--
+			if x.NonTransferable {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
 		if x.Data != nil {
 			encoded, err := options.Marshal(x.Data)
 			if err != nil {
@@ -797,6 +874,62 @@ func (x *fastReflection_Class) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NonTransferable", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.NonTransferable = bool(v != 0)
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Royalty == nil {
+					x.Royalty = &RoyaltyInfo{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Royalty); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -839,6 +972,7 @@ var (
 	fd_NFT_uri      protoreflect.FieldDescriptor
 	fd_NFT_uri_hash protoreflect.FieldDescriptor
 	fd_NFT_data     protoreflect.FieldDescriptor
+	fd_NFT_royalty  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -849,6 +983,7 @@ func init() {
 	fd_NFT_uri = md_NFT.Fields().ByName("uri")
 	fd_NFT_uri_hash = md_NFT.Fields().ByName("uri_hash")
 	fd_NFT_data = md_NFT.Fields().ByName("data")
+	fd_NFT_royalty = md_NFT.Fields().ByName("royalty")
 }
 
 var _ protoreflect.Message = (*fastReflection_NFT)(nil)
@@ -946,6 +1081,12 @@ func (x *fastReflection_NFT) Range(f func(protoreflect.FieldDescriptor, protoref
 			return
 		}
 	}
+	if x.Royalty != nil {
+		value := protoreflect.ValueOfMessage(x.Royalty.ProtoReflect())
+		if !f(fd_NFT_royalty, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -971,6 +1112,8 @@ func (x *fastReflection_NFT) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.UriHash != ""
 	case "cosmos.nft.v1beta1.NFT.data":
 		return x.Data != nil
+	case "cosmos.nft.v1beta1.NFT.royalty":
+		return x.Royalty != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.nft.v1beta1.NFT"))
@@ -997,6 +1140,8 @@ func (x *fastReflection_NFT) Clear(fd protoreflect.FieldDescriptor) {
 		x.UriHash = ""
 	case "cosmos.nft.v1beta1.NFT.data":
 		x.Data = nil
+	case "cosmos.nft.v1beta1.NFT.royalty":
+		x.Royalty = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.nft.v1beta1.NFT"))
@@ -1028,6 +1173,9 @@ func (x *fastReflection_NFT) Get(descriptor protoreflect.FieldDescriptor) protor
 	case "cosmos.nft.v1beta1.NFT.data":
 		value := x.Data
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.nft.v1beta1.NFT.royalty":
+		value := x.Royalty
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.nft.v1beta1.NFT"))
@@ -1058,6 +1206,8 @@ func (x *fastReflection_NFT) Set(fd protoreflect.FieldDescriptor, value protoref
 		x.UriHash = value.Interface().(string)
 	case "cosmos.nft.v1beta1.NFT.data":
 		x.Data = value.Message().Interface().(*anypb.Any)
+	case "cosmos.nft.v1beta1.NFT.royalty":
+		x.Royalty = value.Message().Interface().(*RoyaltyInfo)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.nft.v1beta1.NFT"))
@@ -1083,6 +1233,11 @@ func (x *fastReflection_NFT) Mutable(fd protoreflect.FieldDescriptor) protorefle
 			x.Data = new(anypb.Any)
 		}
 		return protoreflect.ValueOfMessage(x.Data.ProtoReflect())
+	case "cosmos.nft.v1beta1.NFT.royalty":
+		if x.Royalty == nil {
+			x.Royalty = new(RoyaltyInfo)
+		}
+		return protoreflect.ValueOfMessage(x.Royalty.ProtoReflect())
 	case "cosmos.nft.v1beta1.NFT.class_id":
 		panic(fmt.Errorf("field class_id of message cosmos.nft.v1beta1.NFT is not mutable"))
 	case "cosmos.nft.v1beta1.NFT.id":
@@ -1115,6 +1270,9 @@ func (x *fastReflection_NFT) NewField(fd protoreflect.FieldDescriptor) protorefl
 	case "cosmos.nft.v1beta1.NFT.data":
 		m := new(anypb.Any)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.nft.v1beta1.NFT.royalty":
+		m := new(RoyaltyInfo)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.nft.v1beta1.NFT"))
@@ -1204,6 +1362,10 @@ func (x *fastReflection_NFT) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Data)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Royalty != nil {
+			l = options.Size(x.Royalty)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1233,6 +1395,20 @@ func (x *fastReflection_NFT) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Royalty != nil {
+			encoded, err := options.Marshal(x.Royalty)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
 		if x.Data != nil {
 			encoded, err := options.Marshal(x.Data)
 			if err != nil {
@@ -1488,6 +1664,42 @@ func (x *fastReflection_NFT) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Royalty == nil {
+					x.Royalty = &RoyaltyInfo{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Royalty); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1523,113 +1735,604 @@ func (x *fastReflection_NFT) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: cosmos/nft/v1beta1/nft.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_RoyaltyInfo              protoreflect.MessageDescriptor
+	fd_RoyaltyInfo_recipient    protoreflect.FieldDescriptor
+	fd_RoyaltyInfo_basis_points protoreflect.FieldDescriptor
 )
 
-// Class defines the class of the nft type.
-type Class struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_cosmos_nft_v1beta1_nft_proto_init()
+	md_RoyaltyInfo = File_cosmos_nft_v1beta1_nft_proto.Messages().ByName("RoyaltyInfo")
+	fd_RoyaltyInfo_recipient = md_RoyaltyInfo.Fields().ByName("recipient")
+	fd_RoyaltyInfo_basis_points = md_RoyaltyInfo.Fields().ByName("basis_points")
+}
 
-	// id defines the unique identifier of the NFT classification, similar to the contract address of ERC721
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// name defines the human-readable name of the NFT classification. Optional
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// symbol is an abbreviated name for nft classification. Optional
-	Symbol string `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	// description is a brief description of nft classification. Optional
-	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	// uri for the class metadata stored off chain. It can define schema for Class and NFT `Data` attributes. Optional
-	Uri string `protobuf:"bytes,5,opt,name=uri,proto3" json:"uri,omitempty"`
-	// uri_hash is a hash of the document pointed by uri. Optional
-	UriHash string `protobuf:"bytes,6,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
-	// data is the app specific metadata of the NFT class. Optional
-	Data *anypb.Any `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+var _ protoreflect.Message = (*fastReflection_RoyaltyInfo)(nil)
+
+type fastReflection_RoyaltyInfo RoyaltyInfo
+
+func (x *RoyaltyInfo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RoyaltyInfo)(x)
 }
 
-func (x *Class) Reset() {
-	*x = Class{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_nft_v1beta1_nft_proto_msgTypes[0]
+func (x *RoyaltyInfo) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_nft_v1beta1_nft_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *Class) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_RoyaltyInfo_messageType fastReflection_RoyaltyInfo_messageType
+var _ protoreflect.MessageType = fastReflection_RoyaltyInfo_messageType{}
 
-func (*Class) ProtoMessage() {}
+type fastReflection_RoyaltyInfo_messageType struct{}
 
-// Deprecated: Use Class.ProtoReflect.Descriptor instead.
-func (*Class) Descriptor() ([]byte, []int) {
-	return file_cosmos_nft_v1beta1_nft_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_RoyaltyInfo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RoyaltyInfo)(nil)
+}
+func (x fastReflection_RoyaltyInfo_messageType) New() protoreflect.Message {
+	return new(fastReflection_RoyaltyInfo)
+}
+func (x fastReflection_RoyaltyInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RoyaltyInfo
 }
 
-func (x *Class) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RoyaltyInfo) Descriptor() protoreflect.MessageDescriptor {
+	return md_RoyaltyInfo
 }
 
-func (x *Class) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RoyaltyInfo) Type() protoreflect.MessageType {
+	return _fastReflection_RoyaltyInfo_messageType
 }
 
-func (x *Class) GetSymbol() string {
-	if x != nil {
-		return x.Symbol
-	}
-	return ""
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RoyaltyInfo) New() protoreflect.Message {
+	return new(fastReflection_RoyaltyInfo)
 }
 
-func (x *Class) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RoyaltyInfo) Interface() protoreflect.ProtoMessage {
+	return (*RoyaltyInfo)(x)
 }
 
-func (x *Class) GetUri() string {
-	if x != nil {
-		return x.Uri
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RoyaltyInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Recipient != "" {
+		value := protoreflect.ValueOfString(x.Recipient)
+		if !f(fd_RoyaltyInfo_recipient, value) {
+			return
+		}
+	}
+	if x.BasisPoints != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.BasisPoints)
+		if !f(fd_RoyaltyInfo_basis_points, value) {
+			return
+		}
 	}
-	return ""
 }
 
-func (x *Class) GetUriHash() string {
-	if x != nil {
-		return x.UriHash
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RoyaltyInfo) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.nft.v1beta1.RoyaltyInfo.recipient":
+		return x.Recipient != ""
+	case "cosmos.nft.v1beta1.RoyaltyInfo.basis_points":
+		return x.BasisPoints != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.nft.v1beta1.RoyaltyInfo"))
+		}
+		panic(fmt.Errorf("message cosmos.nft.v1beta1.RoyaltyInfo does not contain field %s", fd.FullName()))
 	}
-	return ""
 }
 
-func (x *Class) GetData() *anypb.Any {
-	if x != nil {
-		return x.Data
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RoyaltyInfo) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.nft.v1beta1.RoyaltyInfo.recipient":
+		x.Recipient = ""
+	case "cosmos.nft.v1beta1.RoyaltyInfo.basis_points":
+		x.BasisPoints = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.nft.v1beta1.RoyaltyInfo"))
+		}
+		panic(fmt.Errorf("message cosmos.nft.v1beta1.RoyaltyInfo does not contain field %s", fd.FullName()))
 	}
-	return nil
 }
 
-// NFT defines the NFT.
-type NFT struct {
-	state         protoimpl.MessageState
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RoyaltyInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.nft.v1beta1.RoyaltyInfo.recipient":
+		value := x.Recipient
+		return protoreflect.ValueOfString(value)
+	case "cosmos.nft.v1beta1.RoyaltyInfo.basis_points":
+		value := x.BasisPoints
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.nft.v1beta1.RoyaltyInfo"))
+		}
+		panic(fmt.Errorf("message cosmos.nft.v1beta1.RoyaltyInfo does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RoyaltyInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.nft.v1beta1.RoyaltyInfo.recipient":
+		x.Recipient = value.Interface().(string)
+	case "cosmos.nft.v1beta1.RoyaltyInfo.basis_points":
+		x.BasisPoints = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.nft.v1beta1.RoyaltyInfo"))
+		}
+		panic(fmt.Errorf("message cosmos.nft.v1beta1.RoyaltyInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RoyaltyInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.nft.v1beta1.RoyaltyInfo.recipient":
+		panic(fmt.Errorf("field recipient of message cosmos.nft.v1beta1.RoyaltyInfo is not mutable"))
+	case "cosmos.nft.v1beta1.RoyaltyInfo.basis_points":
+		panic(fmt.Errorf("field basis_points of message cosmos.nft.v1beta1.RoyaltyInfo is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.nft.v1beta1.RoyaltyInfo"))
+		}
+		panic(fmt.Errorf("message cosmos.nft.v1beta1.RoyaltyInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RoyaltyInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.nft.v1beta1.RoyaltyInfo.recipient":
+		return protoreflect.ValueOfString("")
+	case "cosmos.nft.v1beta1.RoyaltyInfo.basis_points":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.nft.v1beta1.RoyaltyInfo"))
+		}
+		panic(fmt.Errorf("message cosmos.nft.v1beta1.RoyaltyInfo does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RoyaltyInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.nft.v1beta1.RoyaltyInfo", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RoyaltyInfo) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RoyaltyInfo) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RoyaltyInfo) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RoyaltyInfo) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RoyaltyInfo)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Recipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BasisPoints != 0 {
+			n += 1 + runtime.Sov(uint64(x.BasisPoints))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RoyaltyInfo)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BasisPoints != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BasisPoints))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Recipient) > 0 {
+			i -= len(x.Recipient)
+			copy(dAtA[i:], x.Recipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recipient)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RoyaltyInfo)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RoyaltyInfo: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RoyaltyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
+				}
+				x.BasisPoints = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BasisPoints |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cosmos/nft/v1beta1/nft.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Class defines the class of the nft type.
+type Class struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id defines the unique identifier of the NFT classification, similar to the contract address of ERC721
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name defines the human-readable name of the NFT classification. Optional
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// symbol is an abbreviated name for nft classification. Optional
+	Symbol string `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// description is a brief description of nft classification. Optional
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// uri for the class metadata stored off chain. It can define schema for Class and NFT `Data` attributes. Optional
+	Uri string `protobuf:"bytes,5,opt,name=uri,proto3" json:"uri,omitempty"`
+	// uri_hash is a hash of the document pointed by uri. Optional
+	UriHash string `protobuf:"bytes,6,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+	// data is the app specific metadata of the NFT class. Optional
+	Data *anypb.Any `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	// non_transferable defines whether the nfts of the class cannot be transferred
+	// once minted, e.g. for soulbound tokens. Optional
+	//
+	// Since: cosmos-sdk 0.50
+	NonTransferable bool `protobuf:"varint,8,opt,name=non_transferable,json=nonTransferable,proto3" json:"non_transferable,omitempty"`
+	// royalty defines the default royalty of the nfts of the class. Optional
+	//
+	// Since: cosmos-sdk 0.50
+	Royalty *RoyaltyInfo `protobuf:"bytes,9,opt,name=royalty,proto3" json:"royalty,omitempty"`
+}
+
+func (x *Class) Reset() {
+	*x = Class{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_nft_v1beta1_nft_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Class) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Class) ProtoMessage() {}
+
+// Deprecated: Use Class.ProtoReflect.Descriptor instead.
+func (*Class) Descriptor() ([]byte, []int) {
+	return file_cosmos_nft_v1beta1_nft_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Class) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Class) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Class) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Class) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Class) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *Class) GetUriHash() string {
+	if x != nil {
+		return x.UriHash
+	}
+	return ""
+}
+
+func (x *Class) GetData() *anypb.Any {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Class) GetNonTransferable() bool {
+	if x != nil {
+		return x.NonTransferable
+	}
+	return false
+}
+
+func (x *Class) GetRoyalty() *RoyaltyInfo {
+	if x != nil {
+		return x.Royalty
+	}
+	return nil
+}
+
+// NFT defines the NFT.
+type NFT struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
@@ -1643,6 +2346,10 @@ type NFT struct {
 	UriHash string `protobuf:"bytes,4,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
 	// data is an app specific data of the NFT. Optional
 	Data *anypb.Any `protobuf:"bytes,10,opt,name=data,proto3" json:"data,omitempty"`
+	// royalty defines the royalty of the NFT, overriding the royalty of its class. Optional
+	//
+	// Since: cosmos-sdk 0.50
+	Royalty *RoyaltyInfo `protobuf:"bytes,11,opt,name=royalty,proto3" json:"royalty,omitempty"`
 }
 
 func (x *NFT) Reset() {
@@ -1700,6 +2407,62 @@ func (x *NFT) GetData() *anypb.Any {
 	return nil
 }
 
+func (x *NFT) GetRoyalty() *RoyaltyInfo {
+	if x != nil {
+		return x.Royalty
+	}
+	return nil
+}
+
+// RoyaltyInfo defines the royalty paid to a recipient on the sales of an NFT,
+// similar to ERC2981.
+//
+// Since: cosmos-sdk 0.50
+type RoyaltyInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// recipient is the address receiving the royalty
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// basis_points is the share of the sale price paid as royalty, in basis points (1/10000)
+	BasisPoints uint32 `protobuf:"varint,2,opt,name=basis_points,json=basisPoints,proto3" json:"basis_points,omitempty"`
+}
+
+func (x *RoyaltyInfo) Reset() {
+	*x = RoyaltyInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_nft_v1beta1_nft_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoyaltyInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoyaltyInfo) ProtoMessage() {}
+
+// Deprecated: Use RoyaltyInfo.ProtoReflect.Descriptor instead.
+func (*RoyaltyInfo) Descriptor() ([]byte, []int) {
+	return file_cosmos_nft_v1beta1_nft_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RoyaltyInfo) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *RoyaltyInfo) GetBasisPoints() uint32 {
+	if x != nil {
+		return x.BasisPoints
+	}
+	return 0
+}
+
 var File_cosmos_nft_v1beta1_nft_proto protoreflect.FileDescriptor
 
 var file_cosmos_nft_v1beta1_nft_proto_rawDesc = []byte{
@@ -1707,7 +2470,7 @@ var file_cosmos_nft_v1beta1_nft_proto_rawDesc = []byte{
 	0x65, 0x74, 0x61, 0x31, 0x2f, 0x6e, 0x66, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6e, 0x66, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
 	0x61, 0x31, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbc, 0x01,
+	0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa2, 0x02,
 	0x0a, 0x05, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
@@ -1719,28 +2482,43 @@ var file_cosmos_nft_v1beta1_nft_proto_rawDesc = []byte{
 	0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x72, 0x69, 0x48, 0x61,
 	0x73, 0x68, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x87, 0x01, 0x0a,
-	0x03, 0x4e, 0x46, 0x54, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
-	0x69, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x72, 0x69, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x72, 0x69, 0x48, 0x61, 0x73, 0x68, 0x12, 0x28, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x42, 0xbc, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6e, 0x66, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x42, 0x08, 0x4e, 0x66, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6e, 0x66, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x3b, 0x6e, 0x66, 0x74, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03,
-	0x43, 0x4e, 0x58, 0xaa, 0x02, 0x12, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4e, 0x66, 0x74,
-	0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x12, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x5c, 0x4e, 0x66, 0x74, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1e,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x4e, 0x66, 0x74, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x14, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x4e, 0x66, 0x74, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x10,
+	0x6e, 0x6f, 0x6e, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6e, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x6f, 0x79, 0x61, 0x6c,
+	0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x6e, 0x66, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x6f,
+	0x79, 0x61, 0x6c, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x72, 0x6f, 0x79, 0x61, 0x6c,
+	0x74, 0x79, 0x22, 0xc2, 0x01, 0x0a, 0x03, 0x4e, 0x46, 0x54, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x72, 0x69, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x72, 0x69, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x39, 0x0a, 0x07,
+	0x72, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6e, 0x66, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x52, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07,
+	0x72, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x22, 0x4e, 0x0a, 0x0b, 0x52, 0x6f, 0x79, 0x61, 0x6c,
+	0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70,
+	0x69, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x69,
+	0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x42, 0xbc, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6e, 0x66, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x42, 0x08, 0x4e, 0x66, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6e, 0x66, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x3b, 0x6e, 0x66, 0x74, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02,
+	0x03, 0x43, 0x4e, 0x58, 0xaa, 0x02, 0x12, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4e, 0x66,
+	0x74, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x12, 0x43, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x5c, 0x4e, 0x66, 0x74, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02,
+	0x1e, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x4e, 0x66, 0x74, 0x5c, 0x56, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x14, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x4e, 0x66, 0x74, 0x3a, 0x3a, 0x56,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1755,20 +2533,23 @@ func file_cosmos_nft_v1beta1_nft_proto_rawDescGZIP() []byte {
 	return file_cosmos_nft_v1beta1_nft_proto_rawDescData
 }
 
-var file_cosmos_nft_v1beta1_nft_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cosmos_nft_v1beta1_nft_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_cosmos_nft_v1beta1_nft_proto_goTypes = []interface{}{
-	(*Class)(nil),     // 0: cosmos.nft.v1beta1.Class
-	(*NFT)(nil),       // 1: cosmos.nft.v1beta1.NFT
-	(*anypb.Any)(nil), // 2: google.protobuf.Any
+	(*Class)(nil),       // 0: cosmos.nft.v1beta1.Class
+	(*NFT)(nil),         // 1: cosmos.nft.v1beta1.NFT
+	(*RoyaltyInfo)(nil), // 2: cosmos.nft.v1beta1.RoyaltyInfo
+	(*anypb.Any)(nil),   // 3: google.protobuf.Any
 }
 var file_cosmos_nft_v1beta1_nft_proto_depIdxs = []int32{
-	2, // 0: cosmos.nft.v1beta1.Class.data:type_name -> google.protobuf.Any
-	2, // 1: cosmos.nft.v1beta1.NFT.data:type_name -> google.protobuf.Any
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 0: cosmos.nft.v1beta1.Class.data:type_name -> google.protobuf.Any
+	2, // 1: cosmos.nft.v1beta1.Class.royalty:type_name -> cosmos.nft.v1beta1.RoyaltyInfo
+	3, // 2: cosmos.nft.v1beta1.NFT.data:type_name -> google.protobuf.Any
+	2, // 3: cosmos.nft.v1beta1.NFT.royalty:type_name -> cosmos.nft.v1beta1.RoyaltyInfo
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_nft_v1beta1_nft_proto_init() }
@@ -1801,6 +2582,18 @@ func file_cosmos_nft_v1beta1_nft_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_nft_v1beta1_nft_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoyaltyInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1808,7 +2601,7 @@ func file_cosmos_nft_v1beta1_nft_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_nft_v1beta1_nft_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -19,20 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Validators_FullMethodName                    = "/cosmos.staking.v1beta1.Query/Validators"
-	Query_Validator_FullMethodName                     = "/cosmos.staking.v1beta1.Query/Validator"
-	Query_ValidatorDelegations_FullMethodName          = "/cosmos.staking.v1beta1.Query/ValidatorDelegations"
-	Query_ValidatorUnbondingDelegations_FullMethodName = "/cosmos.staking.v1beta1.Query/ValidatorUnbondingDelegations"
-	Query_Delegation_FullMethodName                    = "/cosmos.staking.v1beta1.Query/Delegation"
-	Query_UnbondingDelegation_FullMethodName           = "/cosmos.staking.v1beta1.Query/UnbondingDelegation"
-	Query_DelegatorDelegations_FullMethodName          = "/cosmos.staking.v1beta1.Query/DelegatorDelegations"
-	Query_DelegatorUnbondingDelegations_FullMethodName = "/cosmos.staking.v1beta1.Query/DelegatorUnbondingDelegations"
-	Query_Redelegations_FullMethodName                 = "/cosmos.staking.v1beta1.Query/Redelegations"
-	Query_DelegatorValidators_FullMethodName           = "/cosmos.staking.v1beta1.Query/DelegatorValidators"
-	Query_DelegatorValidator_FullMethodName            = "/cosmos.staking.v1beta1.Query/DelegatorValidator"
-	Query_HistoricalInfo_FullMethodName                = "/cosmos.staking.v1beta1.Query/HistoricalInfo"
-	Query_Pool_FullMethodName                          = "/cosmos.staking.v1beta1.Query/Pool"
-	Query_Params_FullMethodName                        = "/cosmos.staking.v1beta1.Query/Params"
+	Query_Validators_FullMethodName                      = "/cosmos.staking.v1beta1.Query/Validators"
+	Query_Validator_FullMethodName                       = "/cosmos.staking.v1beta1.Query/Validator"
+	Query_ValidatorDelegations_FullMethodName            = "/cosmos.staking.v1beta1.Query/ValidatorDelegations"
+	Query_ValidatorUnbondingDelegations_FullMethodName   = "/cosmos.staking.v1beta1.Query/ValidatorUnbondingDelegations"
+	Query_Delegation_FullMethodName                      = "/cosmos.staking.v1beta1.Query/Delegation"
+	Query_UnbondingDelegation_FullMethodName             = "/cosmos.staking.v1beta1.Query/UnbondingDelegation"
+	Query_DelegatorDelegations_FullMethodName            = "/cosmos.staking.v1beta1.Query/DelegatorDelegations"
+	Query_DelegatorUnbondingDelegations_FullMethodName   = "/cosmos.staking.v1beta1.Query/DelegatorUnbondingDelegations"
+	Query_CancellableUnbondingDelegations_FullMethodName = "/cosmos.staking.v1beta1.Query/CancellableUnbondingDelegations"
+	Query_Redelegations_FullMethodName                   = "/cosmos.staking.v1beta1.Query/Redelegations"
+	Query_DelegatorValidators_FullMethodName             = "/cosmos.staking.v1beta1.Query/DelegatorValidators"
+	Query_DelegatorValidator_FullMethodName              = "/cosmos.staking.v1beta1.Query/DelegatorValidator"
+	Query_HistoricalInfo_FullMethodName                  = "/cosmos.staking.v1beta1.Query/HistoricalInfo"
+	Query_Pool_FullMethodName                            = "/cosmos.staking.v1beta1.Query/Pool"
+	Query_Params_FullMethodName                          = "/cosmos.staking.v1beta1.Query/Params"
+	Query_LiquidStakingParams_FullMethodName             = "/cosmos.staking.v1beta1.Query/LiquidStakingParams"
+	Query_TotalLiquidStaked_FullMethodName               = "/cosmos.staking.v1beta1.Query/TotalLiquidStaked"
+	Query_ValidatorLiquidShares_FullMethodName           = "/cosmos.staking.v1beta1.Query/ValidatorLiquidShares"
+	Query_TokenizeShareRecord_FullMethodName             = "/cosmos.staking.v1beta1.Query/TokenizeShareRecord"
 )
 
 // QueryClient is the client API for Query service.
@@ -72,6 +77,15 @@ type QueryClient interface {
 	// When called from another module, this query might consume a high amount of
 	// gas if the pagination field is incorrectly set.
 	DelegatorUnbondingDelegations(ctx context.Context, in *QueryDelegatorUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorUnbondingDelegationsResponse, error)
+	// CancellableUnbondingDelegations queries the unbonding delegation entries of
+	// a given delegator address which can still be cancelled, i.e. the ones which
+	// are not complete yet and whose validator isn't jailed.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	//
+	// Since: cosmos-sdk 0.50
+	CancellableUnbondingDelegations(ctx context.Context, in *QueryCancellableUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryCancellableUnbondingDelegationsResponse, error)
 	// Redelegations queries redelegations of given address.
 	//
 	// When called from another module, this query might consume a high amount of
@@ -92,6 +106,23 @@ type QueryClient interface {
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// LiquidStakingParams queries the liquid staking parameters.
+	//
+	// Since: cosmos-sdk 0.50
+	LiquidStakingParams(ctx context.Context, in *QueryLiquidStakingParamsRequest, opts ...grpc.CallOption) (*QueryLiquidStakingParamsResponse, error)
+	// TotalLiquidStaked queries the total amount of liquid staked tokens.
+	//
+	// Since: cosmos-sdk 0.50
+	TotalLiquidStaked(ctx context.Context, in *QueryTotalLiquidStakedRequest, opts ...grpc.CallOption) (*QueryTotalLiquidStakedResponse, error)
+	// ValidatorLiquidShares queries the validator bond and liquid shares of a
+	// validator.
+	//
+	// Since: cosmos-sdk 0.50
+	ValidatorLiquidShares(ctx context.Context, in *QueryValidatorLiquidSharesRequest, opts ...grpc.CallOption) (*QueryValidatorLiquidSharesResponse, error)
+	// TokenizeShareRecord queries a tokenize share record by its id.
+	//
+	// Since: cosmos-sdk 0.50
+	TokenizeShareRecord(ctx context.Context, in *QueryTokenizeShareRecordRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordResponse, error)
 }
 
 type queryClient struct {
@@ -174,6 +205,15 @@ func (c *queryClient) DelegatorUnbondingDelegations(ctx context.Context, in *Que
 	return out, nil
 }
 
+func (c *queryClient) CancellableUnbondingDelegations(ctx context.Context, in *QueryCancellableUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryCancellableUnbondingDelegationsResponse, error) {
+	out := new(QueryCancellableUnbondingDelegationsResponse)
+	err := c.cc.Invoke(ctx, Query_CancellableUnbondingDelegations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Redelegations(ctx context.Context, in *QueryRedelegationsRequest, opts ...grpc.CallOption) (*QueryRedelegationsResponse, error) {
 	out := new(QueryRedelegationsResponse)
 	err := c.cc.Invoke(ctx, Query_Redelegations_FullMethodName, in, out, opts...)
@@ -228,6 +268,42 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) LiquidStakingParams(ctx context.Context, in *QueryLiquidStakingParamsRequest, opts ...grpc.CallOption) (*QueryLiquidStakingParamsResponse, error) {
+	out := new(QueryLiquidStakingParamsResponse)
+	err := c.cc.Invoke(ctx, Query_LiquidStakingParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalLiquidStaked(ctx context.Context, in *QueryTotalLiquidStakedRequest, opts ...grpc.CallOption) (*QueryTotalLiquidStakedResponse, error) {
+	out := new(QueryTotalLiquidStakedResponse)
+	err := c.cc.Invoke(ctx, Query_TotalLiquidStaked_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorLiquidShares(ctx context.Context, in *QueryValidatorLiquidSharesRequest, opts ...grpc.CallOption) (*QueryValidatorLiquidSharesResponse, error) {
+	out := new(QueryValidatorLiquidSharesResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorLiquidShares_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenizeShareRecord(ctx context.Context, in *QueryTokenizeShareRecordRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordResponse, error) {
+	out := new(QueryTokenizeShareRecordResponse)
+	err := c.cc.Invoke(ctx, Query_TokenizeShareRecord_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -265,6 +341,15 @@ type QueryServer interface {
 	// When called from another module, this query might consume a high amount of
 	// gas if the pagination field is incorrectly set.
 	DelegatorUnbondingDelegations(context.Context, *QueryDelegatorUnbondingDelegationsRequest) (*QueryDelegatorUnbondingDelegationsResponse, error)
+	// CancellableUnbondingDelegations queries the unbonding delegation entries of
+	// a given delegator address which can still be cancelled, i.e. the ones which
+	// are not complete yet and whose validator isn't jailed.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	//
+	// Since: cosmos-sdk 0.50
+	CancellableUnbondingDelegations(context.Context, *QueryCancellableUnbondingDelegationsRequest) (*QueryCancellableUnbondingDelegationsResponse, error)
 	// Redelegations queries redelegations of given address.
 	//
 	// When called from another module, this query might consume a high amount of
@@ -285,6 +370,23 @@ type QueryServer interface {
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// LiquidStakingParams queries the liquid staking parameters.
+	//
+	// Since: cosmos-sdk 0.50
+	LiquidStakingParams(context.Context, *QueryLiquidStakingParamsRequest) (*QueryLiquidStakingParamsResponse, error)
+	// TotalLiquidStaked queries the total amount of liquid staked tokens.
+	//
+	// Since: cosmos-sdk 0.50
+	TotalLiquidStaked(context.Context, *QueryTotalLiquidStakedRequest) (*QueryTotalLiquidStakedResponse, error)
+	// ValidatorLiquidShares queries the validator bond and liquid shares of a
+	// validator.
+	//
+	// Since: cosmos-sdk 0.50
+	ValidatorLiquidShares(context.Context, *QueryValidatorLiquidSharesRequest) (*QueryValidatorLiquidSharesResponse, error)
+	// TokenizeShareRecord queries a tokenize share record by its id.
+	//
+	// Since: cosmos-sdk 0.50
+	TokenizeShareRecord(context.Context, *QueryTokenizeShareRecordRequest) (*QueryTokenizeShareRecordResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -316,6 +418,9 @@ func (UnimplementedQueryServer) DelegatorDelegations(context.Context, *QueryDele
 func (UnimplementedQueryServer) DelegatorUnbondingDelegations(context.Context, *QueryDelegatorUnbondingDelegationsRequest) (*QueryDelegatorUnbondingDelegationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorUnbondingDelegations not implemented")
 }
+func (UnimplementedQueryServer) CancellableUnbondingDelegations(context.Context, *QueryCancellableUnbondingDelegationsRequest) (*QueryCancellableUnbondingDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancellableUnbondingDelegations not implemented")
+}
 func (UnimplementedQueryServer) Redelegations(context.Context, *QueryRedelegationsRequest) (*QueryRedelegationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Redelegations not implemented")
 }
@@ -334,6 +439,18 @@ func (UnimplementedQueryServer) Pool(context.Context, *QueryPoolRequest) (*Query
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) LiquidStakingParams(context.Context, *QueryLiquidStakingParamsRequest) (*QueryLiquidStakingParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidStakingParams not implemented")
+}
+func (UnimplementedQueryServer) TotalLiquidStaked(context.Context, *QueryTotalLiquidStakedRequest) (*QueryTotalLiquidStakedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalLiquidStaked not implemented")
+}
+func (UnimplementedQueryServer) ValidatorLiquidShares(context.Context, *QueryValidatorLiquidSharesRequest) (*QueryValidatorLiquidSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorLiquidShares not implemented")
+}
+func (UnimplementedQueryServer) TokenizeShareRecord(context.Context, *QueryTokenizeShareRecordRequest) (*QueryTokenizeShareRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareRecord not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -491,6 +608,24 @@ func _Query_DelegatorUnbondingDelegations_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CancellableUnbondingDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCancellableUnbondingDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CancellableUnbondingDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CancellableUnbondingDelegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CancellableUnbondingDelegations(ctx, req.(*QueryCancellableUnbondingDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Redelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryRedelegationsRequest)
 	if err := dec(in); err != nil {
@@ -599,6 +734,78 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LiquidStakingParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidStakingParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidStakingParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LiquidStakingParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidStakingParams(ctx, req.(*QueryLiquidStakingParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalLiquidStaked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalLiquidStakedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalLiquidStaked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TotalLiquidStaked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalLiquidStaked(ctx, req.(*QueryTotalLiquidStakedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorLiquidShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorLiquidSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorLiquidShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorLiquidShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorLiquidShares(ctx, req.(*QueryValidatorLiquidSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenizeShareRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenizeShareRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecord(ctx, req.(*QueryTokenizeShareRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -638,6 +845,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorUnbondingDelegations",
 			Handler:    _Query_DelegatorUnbondingDelegations_Handler,
 		},
+		{
+			MethodName: "CancellableUnbondingDelegations",
+			Handler:    _Query_CancellableUnbondingDelegations_Handler,
+		},
 		{
 			MethodName: "Redelegations",
 			Handler:    _Query_Redelegations_Handler,
@@ -662,6 +873,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "LiquidStakingParams",
+			Handler:    _Query_LiquidStakingParams_Handler,
+		},
+		{
+			MethodName: "TotalLiquidStaked",
+			Handler:    _Query_TotalLiquidStaked_Handler,
+		},
+		{
+			MethodName: "ValidatorLiquidShares",
+			Handler:    _Query_ValidatorLiquidShares_Handler,
+		},
+		{
+			MethodName: "TokenizeShareRecord",
+			Handler:    _Query_TokenizeShareRecord_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/query.proto",
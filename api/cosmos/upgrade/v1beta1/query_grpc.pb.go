@@ -23,6 +23,7 @@ const (
 	Query_AppliedPlan_FullMethodName            = "/cosmos.upgrade.v1beta1.Query/AppliedPlan"
 	Query_UpgradedConsensusState_FullMethodName = "/cosmos.upgrade.v1beta1.Query/UpgradedConsensusState"
 	Query_ModuleVersions_FullMethodName         = "/cosmos.upgrade.v1beta1.Query/ModuleVersions"
+	Query_ConsensusVersions_FullMethodName      = "/cosmos.upgrade.v1beta1.Query/ConsensusVersions"
 	Query_Authority_FullMethodName              = "/cosmos.upgrade.v1beta1.Query/Authority"
 )
 
@@ -46,6 +47,10 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.43
 	ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error)
+	// ConsensusVersions queries the consensus versions of the modules of the
+	// running binary, which are ahead of the module versions from state when the
+	// binary has been upgraded but the upgrade has not been applied yet.
+	ConsensusVersions(ctx context.Context, in *QueryConsensusVersionsRequest, opts ...grpc.CallOption) (*QueryConsensusVersionsResponse, error)
 	// Returns the account with authority to conduct upgrades
 	//
 	// Since: cosmos-sdk 0.46
@@ -97,6 +102,15 @@ func (c *queryClient) ModuleVersions(ctx context.Context, in *QueryModuleVersion
 	return out, nil
 }
 
+func (c *queryClient) ConsensusVersions(ctx context.Context, in *QueryConsensusVersionsRequest, opts ...grpc.CallOption) (*QueryConsensusVersionsResponse, error) {
+	out := new(QueryConsensusVersionsResponse)
+	err := c.cc.Invoke(ctx, Query_ConsensusVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Authority(ctx context.Context, in *QueryAuthorityRequest, opts ...grpc.CallOption) (*QueryAuthorityResponse, error) {
 	out := new(QueryAuthorityResponse)
 	err := c.cc.Invoke(ctx, Query_Authority_FullMethodName, in, out, opts...)
@@ -126,6 +140,10 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.43
 	ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error)
+	// ConsensusVersions queries the consensus versions of the modules of the
+	// running binary, which are ahead of the module versions from state when the
+	// binary has been upgraded but the upgrade has not been applied yet.
+	ConsensusVersions(context.Context, *QueryConsensusVersionsRequest) (*QueryConsensusVersionsResponse, error)
 	// Returns the account with authority to conduct upgrades
 	//
 	// Since: cosmos-sdk 0.46
@@ -149,6 +167,9 @@ func (UnimplementedQueryServer) UpgradedConsensusState(context.Context, *QueryUp
 func (UnimplementedQueryServer) ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModuleVersions not implemented")
 }
+func (UnimplementedQueryServer) ConsensusVersions(context.Context, *QueryConsensusVersionsRequest) (*QueryConsensusVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsensusVersions not implemented")
+}
 func (UnimplementedQueryServer) Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authority not implemented")
 }
@@ -237,6 +258,24 @@ func _Query_ModuleVersions_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ConsensusVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsensusVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConsensusVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ConsensusVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConsensusVersions(ctx, req.(*QueryConsensusVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Authority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAuthorityRequest)
 	if err := dec(in); err != nil {
@@ -278,6 +317,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ModuleVersions",
 			Handler:    _Query_ModuleVersions_Handler,
 		},
+		{
+			MethodName: "ConsensusVersions",
+			Handler:    _Query_ConsensusVersions_Handler,
+		},
 		{
 			MethodName: "Authority",
 			Handler:    _Query_Authority_Handler,
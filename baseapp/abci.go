@@ -42,6 +42,10 @@ func (app *BaseApp) InitChain(req abci.RequestInitChain) (res abci.ResponseInitC
 		panic(fmt.Sprintf("invalid chain-id on InitChain; expected: %s, got: %s", app.chainID, req.ChainId))
 	}
 
+	if app.clock != nil && req.Time.IsZero() {
+		req.Time = app.clock.Now()
+	}
+
 	// On a new chain, we consider the init chain block height as 0, even though
 	// req.InitialHeight is 1 by default.
 	initHeader := cmtproto.Header{ChainID: req.ChainId, Time: req.Time}
@@ -175,6 +179,10 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 		panic(err)
 	}
 
+	if app.clock != nil && req.Header.Time.IsZero() {
+		req.Header.Time = app.clock.Now()
+	}
+
 	// Initialize the DeliverTx state. If this is the first block, it should
 	// already be initialized in InitChain. Otherwise app.deliverState will be
 	// nil, since it is reset on Commit.
@@ -208,11 +216,14 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 		if err != nil {
 			panic(err)
 		}
-		res.Events = sdk.MarkEventsToIndex(res.Events, app.indexEvents)
+		res.Events = app.markEventsToIndex(res.Events)
 	}
 	// set the signed validators for addition to context in deliverTx
 	app.voteInfos = req.LastCommitInfo.GetVotes()
 
+	app.startOptimisticBatch(req.Header.Height)
+	app.startBlockTrace(req.Header.Height)
+
 	// call the streaming service hook with the BeginBlock messages
 	for _, abciListener := range app.streamingManager.ABCIListeners {
 		ctx := app.deliverState.ctx
@@ -237,7 +248,7 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 		if err != nil {
 			panic(err)
 		}
-		res.Events = sdk.MarkEventsToIndex(res.Events, app.indexEvents)
+		res.Events = app.markEventsToIndex(res.Events)
 	}
 
 	cp := app.GetConsensusParams(app.deliverState.ctx)
@@ -307,6 +318,8 @@ func (app *BaseApp) PrepareProposal(req abci.RequestPrepareProposal) (resp abci.
 	}()
 
 	resp = app.prepareProposal(app.prepareProposalState.ctx, req)
+	app.recordOptimisticCandidates(req.Height, resp.Txs)
+
 	return resp
 }
 
@@ -365,6 +378,10 @@ func (app *BaseApp) ProcessProposal(req abci.RequestProcessProposal) (resp abci.
 	}()
 
 	resp = app.processProposal(app.processProposalState.ctx, req)
+	if resp.Status == abci.ResponseProcessProposal_ACCEPT {
+		app.recordOptimisticCandidates(req.Height, req.Txs)
+	}
+
 	return resp
 }
 
@@ -398,7 +415,7 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 		GasUsed:   int64(gInfo.GasUsed),   // TODO: Should type accept unsigned ints?
 		Log:       result.Log,
 		Data:      result.Data,
-		Events:    sdk.MarkEventsToIndex(result.Events, app.indexEvents),
+		Events:    app.markEventsToIndex(result.Events),
 		Priority:  priority,
 	}
 }
@@ -431,19 +448,35 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
 	}()
 
-	gInfo, result, anteEvents, _, err := app.runTx(runTxModeDeliver, req.Tx)
+	var (
+		result     *sdk.Result
+		anteEvents []abci.Event
+		err        error
+		// trackedMs is the tracked multistore the tx was executed against, if any.
+		trackedMs *trackingMultiStore
+	)
+	if res, ok := app.nextOptimisticTxResult(req.Tx); ok {
+		gInfo, result, anteEvents, err = res.gInfo, res.result, res.anteEvents, res.err
+		trackedMs = res.ms
+	} else if app.blockTracer != nil && app.blockTracer.storeKeys != nil {
+		gInfo, result, anteEvents, trackedMs, err = app.runTxTraced(req.Tx)
+	} else {
+		gInfo, result, anteEvents, _, err = app.runTx(runTxModeDeliver, req.Tx)
+	}
+
 	if err != nil {
 		resultStr = "failed"
-		return sdkerrors.ResponseDeliverTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, sdk.MarkEventsToIndex(anteEvents, app.indexEvents), app.trace)
+		return app.traceDeliverTx(req.Tx, trackedMs,
+			sdkerrors.ResponseDeliverTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, app.markEventsToIndex(anteEvents), app.trace))
 	}
 
-	return abci.ResponseDeliverTx{
+	return app.traceDeliverTx(req.Tx, trackedMs, abci.ResponseDeliverTx{
 		GasWanted: int64(gInfo.GasWanted), // TODO: Should type accept unsigned ints?
 		GasUsed:   int64(gInfo.GasUsed),   // TODO: Should type accept unsigned ints?
 		Log:       result.Log,
 		Data:      result.Data,
-		Events:    sdk.MarkEventsToIndex(result.Events, app.indexEvents),
-	}
+		Events:    app.markEventsToIndex(result.Events),
+	})
 }
 
 // Commit implements the ABCI interface. It will commit all state that exists in
@@ -471,6 +504,7 @@ func (app *BaseApp) Commit() abci.ResponseCommit {
 	// MultiStore (app.cms) so when Commit() is called it persists those values.
 	app.deliverState.ms.Write()
 	commitID := app.cms.Commit()
+	app.writeBlockTrace(commitID.Hash)
 
 	res := abci.ResponseCommit{
 		Data:         commitID.Hash,
@@ -500,6 +534,7 @@ func (app *BaseApp) Commit() abci.ResponseCommit {
 
 	// empty/reset the deliver state
 	app.deliverState = nil
+	app.optimisticBatch = nil
 
 	if app.prepareCheckStater != nil {
 		app.prepareCheckStater(app.checkState.ctx)
@@ -730,16 +765,27 @@ func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req abci.RequestQu
 		return sdkerrors.QueryResult(err, app.trace)
 	}
 
-	res, err := handler(ctx, req)
+	res, err := app.runQueryHandler(handler, ctx, req)
 	if err != nil {
 		res = sdkerrors.QueryResult(gRPCErrorToSDKError(err), app.trace)
 		res.Height = req.Height
 		return res
 	}
 
+	if err := app.checkQueryResponseSize(len(res.Value)); err != nil {
+		return sdkerrors.QueryResult(err, app.trace)
+	}
+
 	return res
 }
 
+// runQueryHandler runs the given query handler, turning the panics caused by
+// the query running out of gas into errors.
+func (app *BaseApp) runQueryHandler(handler GRPCQueryHandler, ctx sdk.Context, req abci.RequestQuery) (res abci.ResponseQuery, err error) {
+	defer recoverQueryOutOfGas(&err)
+	return handler(ctx, req)
+}
+
 func gRPCErrorToSDKError(err error) error {
 	status, ok := grpcstatus.FromError(err)
 	if !ok {
@@ -749,6 +795,8 @@ func gRPCErrorToSDKError(err error) error {
 	switch status.Code() {
 	case codes.NotFound:
 		return errorsmod.Wrap(sdkerrors.ErrKeyNotFound, err.Error())
+	case codes.ResourceExhausted:
+		return errorsmod.Wrap(sdkerrors.ErrOutOfGas, err.Error())
 	case codes.InvalidArgument:
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	case codes.FailedPrecondition:
@@ -822,7 +870,12 @@ func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, e
 	// branch the commit-multistore for safety
 	ctx := sdk.NewContext(cacheMS, app.checkState.ctx.BlockHeader(), true, app.logger).
 		WithMinGasPrices(app.minGasPrices).
-		WithBlockHeight(height)
+		WithBlockHeight(height).
+		WithClock(app.clock)
+
+	if app.queryGasLimit > 0 {
+		ctx = ctx.WithGasMeter(storetypes.NewGasMeter(app.queryGasLimit))
+	}
 
 	if height != lastBlockHeight {
 		rms, ok := app.cms.(*rootmulti.Store)
@@ -976,6 +1029,10 @@ func handleQueryStore(app *BaseApp, path []string, req abci.RequestQuery) abci.R
 	resp := queryable.Query(req)
 	resp.Height = req.Height
 
+	if err := app.checkQueryResponseSize(len(resp.Value)); err != nil {
+		return sdkerrors.QueryResult(err, app.trace)
+	}
+
 	return resp
 }
 
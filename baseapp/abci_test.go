@@ -2,8 +2,10 @@ package baseapp_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -729,9 +731,14 @@ func TestABCI_DeliverTx(t *testing.T) {
 			require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
 
 			events := res.GetEvents()
-			require.Len(t, events, 3, "should contain ante handler, message type and counter events respectively")
+			require.Len(t, events, 4, "should contain ante handler, message type, counter and msg gas events respectively")
 			require.Equal(t, sdk.MarkEventsToIndex(counterEvent("ante_handler", counter).ToABCIEvents(), map[string]struct{}{})[0], events[0], "ante handler event")
 			require.Equal(t, sdk.MarkEventsToIndex(counterEvent(sdk.EventTypeMessage, counter).ToABCIEvents(), map[string]struct{}{})[0].Attributes[0], events[2].Attributes[0], "msg handler update counter event")
+			require.Equal(t, sdk.EventTypeMsgGas, events[3].Type, "msg gas event")
+			require.Equal(t, sdk.AttributeKeyMsgTypeURL, events[3].Attributes[0].Key)
+			require.Equal(t, "/MsgCounter", events[3].Attributes[0].Value)
+			require.Equal(t, sdk.AttributeKeyGasConsumed, events[3].Attributes[1].Key)
+			require.NotEqual(t, "0", events[3].Attributes[1].Value)
 		}
 
 		suite.baseApp.EndBlock(abci.RequestEndBlock{})
@@ -739,6 +746,103 @@ func TestABCI_DeliverTx(t *testing.T) {
 	}
 }
 
+func TestABCI_DeliverTx_PostHandler(t *testing.T) {
+	successKey, failureKey := []byte("post-success-key"), []byte("post-failure-key")
+	postOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetPostHandler(func(ctx sdk.Context, tx sdk.Tx, simulate, success bool) (sdk.Context, error) {
+			store := ctx.KVStore(capKey1)
+			key := successKey
+			if !success {
+				key = failureKey
+			}
+
+			setIntOnStore(store, key, getIntFromStore(t, store, key)+1)
+			ctx.EventManager().EmitEvent(sdk.NewEvent("post_handler", sdk.NewAttribute("success", strconv.FormatBool(success))))
+			return ctx, nil
+		})
+	}
+	suite := NewBaseAppSuite(t, postOpt)
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	header := cmtproto.Header{Height: 1}
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	tx := newTxCounter(t, suite.txConfig, 0, 0)
+	txBytes, err := suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	res := suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+	// the post handler runs with success set to false when a message fails,
+	// and its state is committed while the state of the messages is not
+	tx = setFailOnHandler(suite.txConfig, newTxCounter(t, suite.txConfig, 1, 1), true)
+	txBytes, err = suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	res = suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.False(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Len(t, res.Events, 1)
+	require.Equal(t, "post_handler", res.Events[0].Type)
+	require.Equal(t, "false", res.Events[0].Attributes[0].Value)
+
+	store := getDeliverStateCtx(suite.baseApp).KVStore(capKey1)
+	require.Equal(t, int64(1), getIntFromStore(t, store, successKey))
+	require.Equal(t, int64(1), getIntFromStore(t, store, failureKey))
+	require.Equal(t, int64(1), getIntFromStore(t, store, deliverKey))
+}
+
+// mockCircuitBreaker disables the processing of the messages whose type URL
+// has been added to it.
+type mockCircuitBreaker map[string]bool
+
+func (cb mockCircuitBreaker) IsAllowed(_ context.Context, typeURL string) (bool, error) {
+	return !cb[typeURL], nil
+}
+
+func TestABCI_DeliverTx_CircuitBreaker(t *testing.T) {
+	suite := NewBaseAppSuite(t)
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	circuitBreaker := mockCircuitBreaker{}
+	suite.baseApp.MsgServiceRouter().SetCircuit(circuitBreaker)
+
+	header := cmtproto.Header{Height: 1}
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	tx := newTxCounter(t, suite.txConfig, 0, 0)
+	txBytes, err := suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	res := suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+	circuitBreaker[sdk.MsgTypeURL(&baseapptestutil.MsgCounter{})] = true
+
+	tx = newTxCounter(t, suite.txConfig, 1, 1)
+	txBytes, err = suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	res = suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.False(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Contains(t, res.Log, "circuit breaker disables execution of this message")
+
+	store := getDeliverStateCtx(suite.baseApp).KVStore(capKey1)
+	require.Equal(t, int64(1), getIntFromStore(t, store, deliverKey))
+}
+
 func TestABCI_DeliverTx_MultiMsg(t *testing.T) {
 	anteKey := []byte("ante-key")
 	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
@@ -1482,6 +1586,98 @@ func TestABCI_Proposal_HappyPath(t *testing.T) {
 	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
 }
 
+// maxCountTxSelector is a TxSelector selecting at most max transactions.
+type maxCountTxSelector struct {
+	baseapp.TxSelector
+	max int
+}
+
+func (ts maxCountTxSelector) SelectTxForProposal(maxTxBytes, maxBlockGas uint64, memTx sdk.Tx, txBz []byte) bool {
+	if len(ts.SelectedTxs()) >= ts.max {
+		return true
+	}
+
+	return ts.TxSelector.SelectTxForProposal(maxTxBytes, maxBlockGas, memTx, txBz)
+}
+
+func TestABCI_Proposal_CustomTxSelector(t *testing.T) {
+	anteKey := []byte("ante-key")
+	pool := mempool.NewSenderNonceMempool()
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey))
+	}
+	prepareOpt := func(bapp *baseapp.BaseApp) {
+		handler := baseapp.NewDefaultProposalHandler(pool, bapp)
+		handler.SetTxSelector(maxCountTxSelector{TxSelector: baseapp.NewDefaultTxSelector(), max: 1})
+		bapp.SetPrepareProposal(handler.PrepareProposalHandler())
+	}
+
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetMempool(pool), prepareOpt)
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), NoopCounterServerImpl{})
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	for i := int64(0); i < 2; i++ {
+		err := pool.Insert(sdk.Context{}, newTxCounter(t, suite.txConfig, i, 1))
+		require.NoError(t, err)
+	}
+
+	reqPrepareProposal := abci.RequestPrepareProposal{
+		MaxTxBytes: 1000,
+		Height:     1,
+	}
+	resPrepareProposal := suite.baseApp.PrepareProposal(reqPrepareProposal)
+	require.Equal(t, 1, len(resPrepareProposal.Txs))
+}
+
+func TestABCI_Proposal_MaxBlockGas(t *testing.T) {
+	pool := mempool.NewSenderNonceMempool()
+	suite := NewBaseAppSuite(t, baseapp.SetMempool(pool))
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), NoopCounterServerImpl{})
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{MaxGas: 150},
+		},
+	})
+
+	txsBytes := make([][]byte, 2)
+	for i := range txsBytes {
+		builder := suite.txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgCounter{Counter: int64(i)}))
+		builder.SetMemo("counter=" + strconv.Itoa(i) + "&failOnAnte=false")
+		builder.SetGasLimit(100)
+		setTxSignature(t, builder, uint64(i))
+
+		require.NoError(t, pool.Insert(sdk.Context{}, builder.GetTx()))
+
+		bz, err := suite.txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		txsBytes[i] = bz
+	}
+
+	// only one tx fits in the block max gas
+	resPrepareProposal := suite.baseApp.PrepareProposal(abci.RequestPrepareProposal{
+		MaxTxBytes: 1000,
+		Height:     1,
+	})
+	require.Equal(t, 1, len(resPrepareProposal.Txs))
+
+	resProcessProposal := suite.baseApp.ProcessProposal(abci.RequestProcessProposal{
+		Txs:    txsBytes,
+		Height: 1,
+	})
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, resProcessProposal.Status)
+
+	resProcessProposal = suite.baseApp.ProcessProposal(abci.RequestProcessProposal{
+		Txs:    txsBytes[:1],
+		Height: 1,
+	})
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, resProcessProposal.Status)
+}
+
 func TestABCI_Proposal_Read_State_PrepareProposal(t *testing.T) {
 	someKey := []byte("some-key")
 
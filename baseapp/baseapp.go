@@ -102,6 +102,12 @@ type BaseApp struct {
 	// transaction. This is mainly used for DoS and spam prevention.
 	minGasPrices sdk.DecCoins
 
+	// queryGasLimit is the maximum gas consumed by a query, and
+	// queryMaxResponseBytes the maximum size of its response. Both are
+	// unlimited when zero.
+	queryGasLimit         uint64
+	queryMaxResponseBytes uint64
+
 	// initialHeight is the initial height at which we start the baseapp
 	initialHeight int64
 
@@ -143,10 +149,32 @@ type BaseApp struct {
 	// which informs CometBFT what to index. If empty, all events will be indexed.
 	indexEvents map[string]struct{}
 
+	// dropEvents defines the set of events in the form {eventType} or
+	// {eventType}.{attributeKey}, which are removed from the ABCI responses.
+	dropEvents map[string]struct{}
+
 	// streamingManager for managing instances and configuration of ABCIListener services
 	streamingManager storetypes.StreamingManager
 
 	chainID string
+
+	// optimisticExecution enables the concurrent execution of the txs of a
+	// block, see optimistic_exec.go.
+	optimisticExecution    bool
+	optimisticMsgs         map[string]struct{}
+	optimisticAccumulators []OptimisticAccumulator
+	optimisticCandidates   optimisticCandidates
+	optimisticBatch        *optimisticBatch
+
+	// blockTraceDir is the directory the execution traces of the blocks are
+	// written to, see block_trace.go. Block tracing is disabled when empty.
+	blockTraceDir string
+	blockTracer   *blockTracer
+
+	// clock is the clock of the contexts of the app, which also provides the
+	// time of the blocks whose header has none, e.g. in tests. It is nil by
+	// default, for the contexts to use the system clock.
+	clock sdk.Clock
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -167,6 +195,8 @@ func NewBaseApp(
 		msgServiceRouter: NewMsgServiceRouter(),
 		txDecoder:        txDecoder,
 		fauxMerkleMode:   false,
+
+		runTxRecoveryMiddleware: newDefaultRecoveryMiddleware(),
 	}
 
 	for _, option := range options {
@@ -191,8 +221,6 @@ func NewBaseApp(
 		app.cms.SetInterBlockCache(app.interBlockCache)
 	}
 
-	app.runTxRecoveryMiddleware = newDefaultRecoveryMiddleware()
-
 	return app
 }
 
@@ -216,6 +244,11 @@ func (app *BaseApp) Logger() log.Logger {
 	return app.logger
 }
 
+// Clock returns the clock of the contexts of the BaseApp, nil if none is set.
+func (app *BaseApp) Clock() sdk.Clock {
+	return app.clock
+}
+
 // Trace returns the boolean value for logging error stack traces.
 func (app *BaseApp) Trace() bool {
 	return app.trace
@@ -399,6 +432,20 @@ func (app *BaseApp) setIndexEvents(ie []string) {
 	}
 }
 
+func (app *BaseApp) setDropEvents(de []string) {
+	app.dropEvents = make(map[string]struct{})
+
+	for _, e := range de {
+		app.dropEvents[e] = struct{}{}
+	}
+}
+
+// markEventsToIndex removes the events to drop from the given events and marks
+// the remaining ones to index.
+func (app *BaseApp) markEventsToIndex(events []abci.Event) []abci.Event {
+	return sdk.MarkEventsToIndex(sdk.FilterEvents(events, app.dropEvents), app.indexEvents)
+}
+
 // Seal seals a BaseApp. It prohibits any further modifications to a BaseApp.
 func (app *BaseApp) Seal() { app.sealed = true }
 
@@ -412,7 +459,7 @@ func (app *BaseApp) setState(mode runTxMode, header cmtproto.Header) {
 	ms := app.cms.CacheMultiStore()
 	baseState := &state{
 		ms:  ms,
-		ctx: sdk.NewContext(ms, header, false, app.logger).WithStreamingManager(app.streamingManager),
+		ctx: sdk.NewContext(ms, header, false, app.logger).WithStreamingManager(app.streamingManager).WithClock(app.clock),
 	}
 
 	switch mode {
@@ -460,7 +507,10 @@ func (app *BaseApp) StoreConsensusParams(ctx sdk.Context, cp cmtproto.ConsensusP
 	// stored instead in the x/upgrade store, with its own bump logic.
 }
 
-// AddRunTxRecoveryHandler adds custom app.runTx method panic handlers.
+// AddRunTxRecoveryHandler adds custom app.runTx method panic handlers. The
+// handlers are run before the ones added previously, the out of gas handler
+// always runs first and the default handler, converting any remaining panic
+// into an ErrPanic, always runs last.
 func (app *BaseApp) AddRunTxRecoveryHandler(handlers ...RecoveryHandler) {
 	for _, h := range handlers {
 		app.runTxRecoveryMiddleware = newRecoveryMiddleware(h, app.runTxRecoveryMiddleware)
@@ -616,12 +666,19 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
+	return app.runTxWithContext(mode, app.getContextForTx(mode, txBytes), txBytes, app.mempool)
+}
+
+// runTxWithContext is runTx executed against the given context, inserting or
+// removing the tx from the given mempool.
+func (app *BaseApp) runTxWithContext(
+	mode runTxMode, ctx sdk.Context, txBytes []byte, mp mempool.Mempool,
+) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter, so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -714,12 +771,12 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 	}
 
 	if mode == runTxModeCheck {
-		err = app.mempool.Insert(ctx, tx)
+		err = mp.Insert(ctx, tx)
 		if err != nil {
 			return gInfo, nil, anteEvents, priority, err
 		}
 	} else if mode == runTxModeDeliver {
-		err = app.mempool.Remove(tx)
+		err = mp.Remove(tx)
 		if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
 			return gInfo, nil, anteEvents, priority,
 				fmt.Errorf("failed to remove tx from mempool: %w", err)
@@ -764,11 +821,42 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 			// append the events in the order of occurrence
 			result.Events = append(anteEvents, result.Events...)
 		}
+	} else if app.postHandler != nil {
+		// The messages failed and their state is discarded, but the postHandler
+		// still runs, with success set to false, on a fresh branch of the state
+		// left by the AnteHandler so that it can e.g. refund the unused fees.
+		postEvents, postErr := app.runFailedTxPostHandler(ctx, mode, txBytes, tx)
+		if postErr != nil {
+			return gInfo, nil, anteEvents, priority, errorsmod.Wrapf(err, "post handler failed: %s", postErr)
+		}
+
+		// append the events in the order of occurrence
+		anteEvents = append(anteEvents, postEvents...)
 	}
 
 	return gInfo, result, anteEvents, priority, err
 }
 
+// runFailedTxPostHandler runs the postHandler of a tx whose messages failed.
+// The state changes of the postHandler are only committed in DeliverTx and
+// if the postHandler succeeds, in which case its events are returned.
+func (app *BaseApp) runFailedTxPostHandler(ctx sdk.Context, mode runTxMode, txBytes []byte, tx sdk.Tx) ([]abci.Event, error) {
+	postCtx, msCache := app.cacheTxContext(ctx, txBytes)
+	postCtx = postCtx.WithEventManager(sdk.NewEventManager())
+
+	newCtx, err := app.postHandler(postCtx, tx, mode == runTxModeSimulate, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if mode != runTxModeDeliver {
+		return nil, nil
+	}
+
+	msCache.Write()
+	return newCtx.EventManager().ABCIEvents(), nil
+}
+
 // runMsgs iterates through a list of messages and executes them with the provided
 // Context and execution mode. Messages will only be executed during simulation
 // and DeliverTx. An error is returned if any single message fails or if a
@@ -790,6 +878,7 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 		}
 
 		// ADR 031 request type routing
+		gasBefore := ctx.GasMeter().GasConsumed()
 		msgResult, err := handler(ctx, msg)
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "failed to execute message; message index: %d", i)
@@ -797,6 +886,7 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 
 		// create message events
 		msgEvents := createEvents(msgResult.GetEvents(), msg)
+		msgEvents = msgEvents.AppendEvent(newMsgGasEvent(msg, ctx.GasMeter().GasConsumed()-gasBefore))
 
 		// append message events and data
 		//
@@ -841,6 +931,17 @@ func makeABCIData(msgResponses []*codectypes.Any) ([]byte, error) {
 	return proto.Marshal(&sdk.TxMsgData{MsgResponses: msgResponses})
 }
 
+// newMsgGasEvent returns the event attributing the gas consumed by the
+// execution of a message, so that the gas of multi-message txs can be broken
+// down per message.
+func newMsgGasEvent(msg sdk.Msg, gasConsumed uint64) sdk.Event {
+	return sdk.NewEvent(
+		sdk.EventTypeMsgGas,
+		sdk.NewAttribute(sdk.AttributeKeyMsgTypeURL, sdk.MsgTypeURL(msg)),
+		sdk.NewAttribute(sdk.AttributeKeyGasConsumed, strconv.FormatUint(gasConsumed, 10)),
+	)
+}
+
 func createEvents(events sdk.Events, msg sdk.Msg) sdk.Events {
 	eventMsgName := sdk.MsgTypeURL(msg)
 	msgEvent := sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyAction, eventMsgName))
@@ -912,16 +1013,52 @@ type (
 	DefaultProposalHandler struct {
 		mempool    mempool.Mempool
 		txVerifier ProposalTxVerifier
+		txSelector TxSelector
+	}
+
+	// TxSelector defines a helper type that assists in selecting transactions
+	// during mempool transaction selection in PrepareProposal. It keeps track of
+	// the total number of bytes and total gas of the selected transactions. An
+	// application can provide its own TxSelector to the DefaultProposalHandler,
+	// e.g. to reorder transactions or reserve room for top-of-block transactions,
+	// while reusing the default mempool iteration and transaction verification.
+	TxSelector interface {
+		// SelectedTxs should return a copy of the selected transactions.
+		SelectedTxs() [][]byte
+
+		// Clear should clear the TxSelector, nulling out all relevant fields.
+		Clear()
+
+		// SelectTxForProposal should attempt to select a transaction for inclusion
+		// in a proposal based on inclusion criteria defined by the TxSelector. It
+		// must return <true> if the caller should halt the transaction selection
+		// loop (typically over a mempool) or <false> otherwise.
+		SelectTxForProposal(maxTxBytes, maxBlockGas uint64, memTx sdk.Tx, txBz []byte) bool
+	}
+
+	// defaultTxSelector defines the default TxSelector. It selects transactions
+	// in the order they are given until one of them would exceed either the
+	// maximum number of bytes or the maximum block gas.
+	defaultTxSelector struct {
+		totalTxBytes uint64
+		totalTxGas   uint64
+		selectedTxs  [][]byte
 	}
 )
 
-func NewDefaultProposalHandler(mp mempool.Mempool, txVerifier ProposalTxVerifier) DefaultProposalHandler {
-	return DefaultProposalHandler{
+func NewDefaultProposalHandler(mp mempool.Mempool, txVerifier ProposalTxVerifier) *DefaultProposalHandler {
+	return &DefaultProposalHandler{
 		mempool:    mp,
 		txVerifier: txVerifier,
+		txSelector: NewDefaultTxSelector(),
 	}
 }
 
+// SetTxSelector sets the TxSelector used by the PrepareProposal handler.
+func (h *DefaultProposalHandler) SetTxSelector(ts TxSelector) {
+	h.txSelector = ts
+}
+
 // PrepareProposalHandler returns the default implementation for processing an
 // ABCI proposal. The application's mempool is enumerated and all valid
 // transactions are added to the proposal. Transactions are valid if they:
@@ -930,7 +1067,8 @@ func NewDefaultProposalHandler(mp mempool.Mempool, txVerifier ProposalTxVerifier
 // 2) Are valid (i.e. pass runTx, AnteHandler only).
 //
 // Enumeration is halted once RequestPrepareProposal.MaxBytes of transactions is
-// reached or the mempool is exhausted.
+// reached, the block max gas is reached, or the mempool is exhausted. Which
+// transactions are selected is decided by the handler TxSelector.
 //
 // Note:
 //
@@ -942,7 +1080,7 @@ func NewDefaultProposalHandler(mp mempool.Mempool, txVerifier ProposalTxVerifier
 // - If no mempool is set or if the mempool is a no-op mempool, the transactions
 // requested from CometBFT will simply be returned, which, by default, are in
 // FIFO order.
-func (h DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 	return func(ctx sdk.Context, req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
 		// If the mempool is nil or a no-op mempool, we simply return the transactions
 		// requested from CometBFT, which, by default, should be in FIFO order.
@@ -951,12 +1089,10 @@ func (h DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHand
 			return abci.ResponsePrepareProposal{Txs: req.Txs}
 		}
 
-		var (
-			selectedTxs  [][]byte
-			totalTxBytes int64
-		)
+		defer h.txSelector.Clear()
 
 		iterator := h.mempool.Select(ctx, req.Txs)
+		maxBlockGas := getMaxBlockGas(ctx)
 
 		for iterator != nil {
 			memTx := iterator.Tx()
@@ -971,21 +1107,14 @@ func (h DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHand
 				if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
 					panic(err)
 				}
-			} else {
-				txSize := int64(len(bz))
-				if totalTxBytes += txSize; totalTxBytes <= req.MaxTxBytes {
-					selectedTxs = append(selectedTxs, bz)
-				} else {
-					// We've reached capacity per req.MaxTxBytes so we cannot select any
-					// more transactions.
-					break
-				}
+			} else if stop := h.txSelector.SelectTxForProposal(uint64(req.MaxTxBytes), maxBlockGas, memTx, bz); stop {
+				break
 			}
 
 			iterator = iterator.Next()
 		}
 
-		return abci.ResponsePrepareProposal{Txs: selectedTxs}
+		return abci.ResponsePrepareProposal{Txs: h.txSelector.SelectedTxs()}
 	}
 }
 
@@ -995,24 +1124,92 @@ func (h DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHand
 // 1. The transaction bytes must decode to a valid transaction.
 // 2. The transaction must be valid (i.e. pass runTx, AnteHandler only)
 //
-// If any transaction fails to pass either condition, the proposal is rejected.
+// If any transaction fails to pass either condition, or if the total gas of
+// the transactions exceeds the block max gas, the proposal is rejected.
 // Note that step (2) is identical to the validation step performed in
 // DefaultPrepareProposal. It is very important that the same validation logic
 // is used in both steps, and applications must ensure that this is the case in
 // non-default handlers.
-func (h DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+func (h *DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 	return func(ctx sdk.Context, req abci.RequestProcessProposal) abci.ResponseProcessProposal {
+		var totalTxGas uint64
+
+		maxBlockGas := getMaxBlockGas(ctx)
+
 		for _, txBytes := range req.Txs {
-			_, err := h.txVerifier.ProcessProposalVerifyTx(txBytes)
+			tx, err := h.txVerifier.ProcessProposalVerifyTx(txBytes)
 			if err != nil {
 				return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
 			}
+
+			if maxBlockGas > 0 {
+				if feeTx, ok := tx.(sdk.FeeTx); ok {
+					totalTxGas += feeTx.GetGas()
+				}
+
+				if totalTxGas > maxBlockGas {
+					return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+				}
+			}
 		}
 
 		return abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}
 	}
 }
 
+// getMaxBlockGas returns the block max gas of the consensus params, or 0 when
+// the block gas is unlimited.
+func getMaxBlockGas(ctx sdk.Context) uint64 {
+	cp := ctx.ConsensusParams()
+	if cp.Block == nil || cp.Block.MaxGas <= 0 {
+		return 0
+	}
+
+	return uint64(cp.Block.MaxGas)
+}
+
+// NewDefaultTxSelector returns the default TxSelector.
+func NewDefaultTxSelector() TxSelector {
+	return &defaultTxSelector{}
+}
+
+func (ts *defaultTxSelector) SelectedTxs() [][]byte {
+	txs := make([][]byte, len(ts.selectedTxs))
+	copy(txs, ts.selectedTxs)
+	return txs
+}
+
+func (ts *defaultTxSelector) Clear() {
+	ts.totalTxBytes = 0
+	ts.totalTxGas = 0
+	ts.selectedTxs = nil
+}
+
+func (ts *defaultTxSelector) SelectTxForProposal(maxTxBytes, maxBlockGas uint64, memTx sdk.Tx, txBz []byte) bool {
+	txSize := uint64(len(txBz))
+
+	var txGasLimit uint64
+	if feeTx, ok := memTx.(sdk.FeeTx); ok {
+		txGasLimit = feeTx.GetGas()
+	}
+
+	// Halt the selection once a transaction does not fit in the proposal, as
+	// skipping it could break the sequence ordering of the sender transactions.
+	if txSize+ts.totalTxBytes > maxTxBytes {
+		return true
+	}
+
+	if maxBlockGas > 0 && txGasLimit+ts.totalTxGas > maxBlockGas {
+		return true
+	}
+
+	ts.totalTxBytes += txSize
+	ts.totalTxGas += txGasLimit
+	ts.selectedTxs = append(ts.selectedTxs, txBz)
+
+	return false
+}
+
 // NoOpPrepareProposal defines a no-op PrepareProposal handler. It will always
 // return the transactions sent by the client's request.
 func NoOpPrepareProposal() sdk.PrepareProposalHandler {
@@ -467,6 +467,37 @@ func TestCustomRunTxPanicHandler(t *testing.T) {
 	}
 }
 
+func TestCustomRunTxPanicClassifier(t *testing.T) {
+	panicErr := errorsmod.Register("fakeModule", 100501, "fakePanic")
+	typedErr := errorsmod.Register("fakeModule", 100502, "fakeTypedError")
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+			panic(errorsmod.Wrap(panicErr, "anteHandler"))
+		})
+	}
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetRecoveryHandlers(
+		baseapp.NewPanicRecoveryHandler(baseapp.MatchPanicError(panicErr), typedErr),
+	))
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	header := cmtproto.Header{Height: 1}
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	tx := newTxCounter(t, suite.txConfig, 0, 0)
+	txBytes, err := suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	// the panic is reported with the codespace and code of the typed error
+	// instead of being redacted into an internal error
+	res := suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.False(t, res.IsOK())
+	require.Equal(t, typedErr.Codespace(), res.Codespace)
+	require.Equal(t, typedErr.ABCICode(), res.Code)
+}
+
 func TestBaseAppAnteHandler(t *testing.T) {
 	anteKey := []byte("ante-key")
 	anteOpt := func(bapp *baseapp.BaseApp) {
@@ -588,6 +619,32 @@ func TestSetMinGasPrices(t *testing.T) {
 	require.Equal(t, minGasPrices, ctx.MinGasPrices())
 }
 
+func TestSetClock(t *testing.T) {
+	genesisTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := sdk.NewManualClock(genesisTime)
+	suite := NewBaseAppSuite(t, baseapp.SetClock(clock))
+
+	suite.baseApp.InitChain(abci.RequestInitChain{ConsensusParams: &cmtproto.ConsensusParams{}})
+	ctx := getDeliverStateCtx(suite.baseApp)
+	require.Equal(t, genesisTime, ctx.BlockTime())
+	require.Equal(t, clock, ctx.Clock())
+
+	// the block time is the time of the clock when the header has none
+	blockTime := clock.Advance(5 * time.Second)
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: 1}})
+	ctx = getDeliverStateCtx(suite.baseApp)
+	require.Equal(t, blockTime, ctx.BlockTime())
+	require.Equal(t, clock, ctx.Clock())
+	suite.baseApp.EndBlock(abci.RequestEndBlock{Height: 1})
+	suite.baseApp.Commit()
+
+	// and the time of the header otherwise
+	headerTime := blockTime.Add(time.Hour)
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: 2, Time: headerTime}})
+	require.Equal(t, headerTime, getDeliverStateCtx(suite.baseApp).BlockTime())
+	require.Equal(t, clock, suite.baseApp.NewContext(true, cmtproto.Header{}).Clock())
+}
+
 func TestGetMaximumBlockGas(t *testing.T) {
 	suite := NewBaseAppSuite(t)
 	suite.baseApp.InitChain(abci.RequestInitChain{})
@@ -0,0 +1,312 @@
+package baseapp
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Block tracing is a debug mode recording, for each block, the messages,
+// result, events and store accesses of every delivered tx into a JSON file
+// named after the block height. Replaying a block producing an app hash
+// mismatch with two binaries and diffing their traces, see DiffBlockTraces,
+// points at the first tx and the stores on which they diverged.
+
+type (
+	// BlockTrace is the execution trace of the txs of a block.
+	BlockTrace struct {
+		Height  int64     `json:"height"`
+		AppHash string    `json:"app_hash"`
+		Txs     []TxTrace `json:"txs"`
+	}
+
+	// TxTrace is the execution trace of a tx. Reads and Writes map the name of
+	// each store accessed by the tx to the hex encoded digest of the keys read
+	// through it, and of the keys and values written through it respectively.
+	TxTrace struct {
+		Index     int               `json:"index"`
+		Hash      string            `json:"hash"`
+		Msgs      []string          `json:"msgs"`
+		Code      uint32            `json:"code"`
+		Codespace string            `json:"codespace,omitempty"`
+		Log       string            `json:"log,omitempty"`
+		GasWanted int64             `json:"gas_wanted"`
+		GasUsed   int64             `json:"gas_used"`
+		Events    []abci.Event      `json:"events"`
+		Reads     map[string]string `json:"reads"`
+		Writes    map[string]string `json:"writes"`
+	}
+
+	// blockTracer accumulates the trace of the block being executed.
+	blockTracer struct {
+		trace     BlockTrace
+		storeKeys map[string]storetypes.StoreKey
+	}
+)
+
+// startBlockTrace starts the trace of the block at the given height if block
+// tracing is enabled. It is called once BeginBlock has been executed.
+func (app *BaseApp) startBlockTrace(height int64) {
+	app.blockTracer = nil
+	if app.blockTraceDir == "" {
+		return
+	}
+
+	tracer := &blockTracer{trace: BlockTrace{Height: height, Txs: []TxTrace{}}}
+	if cms, ok := app.cms.(interface {
+		StoreKeysByName() map[string]storetypes.StoreKey
+	}); ok {
+		tracer.storeKeys = cms.StoreKeysByName()
+	}
+
+	app.blockTracer = tracer
+}
+
+// runTxTraced is runTx in deliver mode, executed against a tracked branch of
+// the deliver state so that the store accesses of the tx can be traced.
+func (app *BaseApp) runTxTraced(txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, ms *trackingMultiStore, err error) {
+	ms = newTrackingMultiStore(app.deliverState.ms.CacheMultiStore(), app.blockTracer.storeKeys, nil)
+	ctx := app.getContextForTx(runTxModeDeliver, txBytes).WithMultiStore(ms)
+
+	gInfo, result, anteEvents, _, err = app.runTxWithContext(runTxModeDeliver, ctx, txBytes, app.mempool)
+	ms.Write()
+
+	return gInfo, result, anteEvents, ms, err
+}
+
+// traceDeliverTx records the trace of a delivered tx if block tracing is
+// enabled and returns its response unchanged. The store accesses are only
+// traced when ms is not nil.
+func (app *BaseApp) traceDeliverTx(txBytes []byte, ms *trackingMultiStore, res abci.ResponseDeliverTx) abci.ResponseDeliverTx {
+	tracer := app.blockTracer
+	if tracer == nil {
+		return res
+	}
+
+	txHash := sha256.Sum256(txBytes)
+	txTrace := TxTrace{
+		Index:     len(tracer.trace.Txs),
+		Hash:      hex.EncodeToString(txHash[:]),
+		Msgs:      []string{},
+		Code:      res.Code,
+		Codespace: res.Codespace,
+		Log:       res.Log,
+		GasWanted: res.GasWanted,
+		GasUsed:   res.GasUsed,
+		Events:    res.Events,
+		Reads:     map[string]string{},
+		Writes:    map[string]string{},
+	}
+
+	if tx, err := app.txDecoder(txBytes); err == nil {
+		for _, msg := range tx.GetMsgs() {
+			txTrace.Msgs = append(txTrace.Msgs, sdk.MsgTypeURL(msg))
+		}
+	}
+
+	if ms != nil {
+		for key, store := range ms.stores {
+			if reads := store.readsDigest(); reads != nil {
+				txTrace.Reads[key.Name()] = hex.EncodeToString(reads)
+			}
+			if writes := store.writesDigest(); writes != nil {
+				txTrace.Writes[key.Name()] = hex.EncodeToString(writes)
+			}
+		}
+	}
+
+	tracer.trace.Txs = append(tracer.trace.Txs, txTrace)
+
+	return res
+}
+
+// writeBlockTrace writes the trace of the committed block to the block trace
+// directory. Failures are logged, since tracing must not halt the node.
+func (app *BaseApp) writeBlockTrace(appHash []byte) {
+	tracer := app.blockTracer
+	if tracer == nil {
+		return
+	}
+	app.blockTracer = nil
+
+	tracer.trace.AppHash = hex.EncodeToString(appHash)
+
+	bz, err := json.MarshalIndent(tracer.trace, "", "  ")
+	if err == nil {
+		err = os.MkdirAll(app.blockTraceDir, 0o755)
+	}
+	if err == nil {
+		err = os.WriteFile(BlockTraceFile(app.blockTraceDir, tracer.trace.Height), bz, 0o600)
+	}
+	if err != nil {
+		app.logger.Error("failed to write block trace", "height", tracer.trace.Height, "err", err)
+	}
+}
+
+// BlockTraceFile returns the path of the trace of the block at the given
+// height in the given block trace directory.
+func BlockTraceFile(dir string, height int64) string {
+	return filepath.Join(dir, fmt.Sprintf("block-%d.json", height))
+}
+
+// ReadBlockTrace reads a block trace from the given file.
+func ReadBlockTrace(file string) (BlockTrace, error) {
+	var trace BlockTrace
+
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return trace, err
+	}
+
+	err = json.Unmarshal(bz, &trace)
+	return trace, err
+}
+
+// DiffBlockTraces returns a human readable description of the differences
+// between two traces of the same block, stopping at the first diverging tx
+// since the execution of the following txs depends on its result.
+func DiffBlockTraces(a, b BlockTrace) []string {
+	var diffs []string
+	if a.Height != b.Height {
+		diffs = append(diffs, fmt.Sprintf("height: %d != %d", a.Height, b.Height))
+	}
+
+	for i := 0; i < len(a.Txs) && i < len(b.Txs); i++ {
+		if txDiffs := diffTxTraces(a.Txs[i], b.Txs[i]); len(txDiffs) > 0 {
+			for _, diff := range txDiffs {
+				diffs = append(diffs, fmt.Sprintf("tx %d (%s): %s", i, a.Txs[i].Hash, diff))
+			}
+			return diffs
+		}
+	}
+
+	if len(a.Txs) != len(b.Txs) {
+		diffs = append(diffs, fmt.Sprintf("number of txs: %d != %d", len(a.Txs), len(b.Txs)))
+	}
+
+	if a.AppHash != b.AppHash {
+		diffs = append(diffs, fmt.Sprintf("app hash: %s != %s", a.AppHash, b.AppHash))
+	}
+
+	return diffs
+}
+
+func diffTxTraces(a, b TxTrace) []string {
+	var diffs []string
+	if a.Hash != b.Hash {
+		return []string{fmt.Sprintf("hash: %s != %s", a.Hash, b.Hash)}
+	}
+
+	if a.Code != b.Code || a.Codespace != b.Codespace {
+		diffs = append(diffs, fmt.Sprintf("code: %s/%d != %s/%d", a.Codespace, a.Code, b.Codespace, b.Code))
+	}
+
+	if a.GasWanted != b.GasWanted || a.GasUsed != b.GasUsed {
+		diffs = append(diffs, fmt.Sprintf("gas (wanted/used): %d/%d != %d/%d", a.GasWanted, a.GasUsed, b.GasWanted, b.GasUsed))
+	}
+
+	aEvents, _ := json.Marshal(a.Events)
+	bEvents, _ := json.Marshal(b.Events)
+	if !bytes.Equal(aEvents, bEvents) {
+		diffs = append(diffs, "events differ")
+	}
+
+	diffs = append(diffs, diffDigests("reads", a.Reads, b.Reads)...)
+	diffs = append(diffs, diffDigests("writes", a.Writes, b.Writes)...)
+
+	return diffs
+}
+
+func diffDigests(kind string, a, b map[string]string) []string {
+	names := make(map[string]struct{}, len(a)+len(b))
+	for name := range a {
+		names[name] = struct{}{}
+	}
+	for name := range b {
+		names[name] = struct{}{}
+	}
+
+	var diffs []string
+	for _, name := range sortedKeys(names) {
+		if a[name] != b[name] {
+			diffs = append(diffs, fmt.Sprintf("%s of store %s differ", kind, name))
+		}
+	}
+
+	return diffs
+}
+
+// readsDigest returns the digest of the keys and key ranges read through the
+// store, or nil if none were read.
+func (s *trackingKVStore) readsDigest() []byte {
+	if len(s.reads) == 0 && len(s.ranges) == 0 {
+		return nil
+	}
+
+	h := sha256.New()
+	for _, key := range sortedKeys(s.reads) {
+		writeDigestField(h, []byte(key))
+	}
+
+	// ranges are recorded in the order of execution, which is deterministic
+	for _, r := range s.ranges {
+		writeDigestField(h, r.start)
+		writeDigestField(h, r.end)
+	}
+
+	return h.Sum(nil)
+}
+
+// writesDigest returns the digest of the keys written through the store along
+// with their resulting values, or nil if none were written.
+func (s *trackingKVStore) writesDigest() []byte {
+	if len(s.writes) == 0 {
+		return nil
+	}
+
+	h := sha256.New()
+	for _, key := range sortedKeys(s.writes) {
+		writeDigestField(h, []byte(key))
+
+		// deleted keys are distinguished from keys set to an empty value
+		value := s.KVStore.Get([]byte(key))
+		if value == nil {
+			_, _ = h.Write([]byte{0})
+			continue
+		}
+		_, _ = h.Write([]byte{1})
+		writeDigestField(h, value)
+	}
+
+	return h.Sum(nil)
+}
+
+// writeDigestField writes a length prefixed field to a digest.
+func writeDigestField(h io.Writer, bz []byte) {
+	var length [8]byte
+	binary.BigEndian.PutUint64(length[:], uint64(len(bz)))
+	_, _ = h.Write(length[:])
+	_, _ = h.Write(bz)
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
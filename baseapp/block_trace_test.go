@@ -0,0 +1,77 @@
+package baseapp_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestABCI_BlockTrace(t *testing.T) {
+	seqDir, optDir := t.TempDir(), t.TempDir()
+	suite := newOptimisticExecSuite(t)
+	seqSuite := newOptimisticExecSuite(t, baseapp.SetBlockTraceDir(seqDir))
+	optSuite := newOptimisticExecSuite(t, baseapp.SetBlockTraceDir(optDir), baseapp.SetOptimisticExecution(true), baseapp.SetOptimisticExecutionMsgs(optimisticMsgs...))
+
+	msgs := []sdk.Msg{
+		&baseapptestutil.MsgKeyValue{Key: []byte("key"), Value: []byte("value")},
+		&baseapptestutil.MsgCounter2{Counter: 1},
+		&baseapptestutil.MsgCounter2{Counter: 2, FailOnHandler: true},
+	}
+
+	var txs [][]byte
+	for i, msg := range msgs {
+		builder := suite.txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msg))
+		setTxSignature(t, builder, uint64(i))
+
+		txBytes, err := suite.txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		txs = append(txs, txBytes)
+	}
+
+	// block tracing does not change the execution of the block
+	responses, appHash := executeBlock(suite, 1, txs, txs)
+	seqResponses, seqHash := executeBlock(seqSuite, 1, txs, txs)
+	optResponses, optHash := executeBlock(optSuite, 1, txs, txs)
+	require.Equal(t, responses, seqResponses)
+	require.Equal(t, appHash, seqHash)
+	require.Equal(t, responses, optResponses)
+	require.Equal(t, appHash, optHash)
+
+	trace, err := baseapp.ReadBlockTrace(baseapp.BlockTraceFile(seqDir, 1))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), trace.Height)
+	require.Equal(t, fmt.Sprintf("%x", appHash), trace.AppHash)
+	require.Len(t, trace.Txs, len(txs))
+
+	for i, txTrace := range trace.Txs {
+		require.Equal(t, i, txTrace.Index)
+		require.Equal(t, []string{sdk.MsgTypeURL(msgs[i])}, txTrace.Msgs)
+		require.Equal(t, responses[i].Code, txTrace.Code)
+		require.Equal(t, responses[i].GasUsed, txTrace.GasUsed)
+		require.Equal(t, responses[i].Events, txTrace.Events)
+	}
+
+	require.Contains(t, trace.Txs[0].Writes, capKey2.Name())
+	require.Contains(t, trace.Txs[1].Reads, capKey1.Name())
+	require.Contains(t, trace.Txs[1].Writes, capKey1.Name())
+	require.Empty(t, trace.Txs[2].Writes)
+
+	// the optimistic execution of the block produces the same trace
+	optTrace, err := baseapp.ReadBlockTrace(baseapp.BlockTraceFile(optDir, 1))
+	require.NoError(t, err)
+	require.Empty(t, baseapp.DiffBlockTraces(trace, optTrace))
+
+	// a diverging tx is reported along with the stores it diverged on
+	optTrace.Txs[1].Writes[capKey1.Name()] = "00"
+	optTrace.Txs[2].Code = 42
+	optTrace.AppHash = "00"
+	require.Equal(t, []string{
+		fmt.Sprintf("tx 1 (%s): writes of store %s differ", trace.Txs[1].Hash, capKey1.Name()),
+	}, baseapp.DiffBlockTraces(trace, optTrace))
+}
@@ -46,13 +46,30 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 			}
 		}
 
-		// Create the sdk.Context. Passing false as 2nd arg, as we can't
-		// actually support proofs with gRPC right now.
-		sdkCtx, err := app.CreateQueryContext(height, false)
+		// Proofs are not returned in the response, but in a trailer holding
+		// the proofs of all the store keys read by the query.
+		prove := false
+		if proveHeaders := md.Get(grpctypes.GRPCQueryProveHeader); len(proveHeaders) == 1 {
+			prove, err = strconv.ParseBool(proveHeaders[0])
+			if err != nil {
+				return nil, errorsmod.Wrapf(
+					sdkerrors.ErrInvalidRequest,
+					"Baseapp.RegisterGRPCServer: invalid prove header %q: %v", grpctypes.GRPCQueryProveHeader, err)
+			}
+		}
+
+		// Create the sdk.Context.
+		sdkCtx, err := app.CreateQueryContext(height, prove)
 		if err != nil {
 			return nil, err
 		}
 
+		var recorder *readRecorder
+		if prove {
+			recorder = newReadRecorder()
+			sdkCtx = sdkCtx.WithMultiStore(recordingMultiStore{MultiStore: sdkCtx.MultiStore(), recorder: recorder})
+		}
+
 		// Add relevant gRPC headers
 		if height == 0 {
 			height = sdkCtx.BlockHeight() // If height was not set in the request, set it to the latest
@@ -66,7 +83,35 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 			app.logger.Error("failed to set gRPC header", "err", err)
 		}
 
-		return handler(grpcCtx, req)
+		defer recoverQueryOutOfGas(&err)
+		resp, err = handler(grpcCtx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		if sizer, ok := resp.(interface{ Size() int }); ok {
+			if err := app.checkQueryResponseSize(sizer.Size()); err != nil {
+				return nil, err
+			}
+		}
+
+		if prove {
+			proofs, err := app.proveReads(recorder, height)
+			if err != nil {
+				return nil, err
+			}
+
+			md := metadata.MD{grpctypes.GRPCQueryProofsTrailer: make([]string, len(proofs))}
+			for i, proof := range proofs {
+				md[grpctypes.GRPCQueryProofsTrailer][i] = string(proof)
+			}
+
+			if err = grpc.SetTrailer(grpcCtx, md); err != nil {
+				app.logger.Error("failed to set gRPC trailer", "err", err)
+			}
+		}
+
+		return resp, nil
 	}
 
 	// Loop through all services and methods, add the interceptor, and register
@@ -0,0 +1,85 @@
+package baseapp
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ModuleMsgInvoker executes messages on behalf of the account of a module, so
+// that modules can compose the functionality of other modules through their
+// Msg services instead of importing their keepers.
+//
+// A ModuleMsgInvoker is the capability of a module to execute messages: it is
+// created by the app for a given module, and only executes the messages whose
+// type was allowed by the app and whose signers are all the module account.
+type ModuleMsgInvoker struct {
+	router      MessageRouter
+	moduleName  string
+	moduleAddr  sdk.AccAddress
+	allowedMsgs map[string]struct{}
+}
+
+// NewModuleMsgInvoker returns a new ModuleMsgInvoker executing, on behalf of
+// the account of the given module, the messages with the given type URLs.
+func NewModuleMsgInvoker(router MessageRouter, moduleName string, allowedMsgTypeURLs ...string) ModuleMsgInvoker {
+	allowedMsgs := make(map[string]struct{}, len(allowedMsgTypeURLs))
+	for _, typeURL := range allowedMsgTypeURLs {
+		allowedMsgs[typeURL] = struct{}{}
+	}
+
+	return ModuleMsgInvoker{
+		router:      router,
+		moduleName:  moduleName,
+		moduleAddr:  address.Module(moduleName),
+		allowedMsgs: allowedMsgs,
+	}
+}
+
+// ModuleAddress returns the address of the account of the module on behalf of
+// which the messages are executed.
+func (i ModuleMsgInvoker) ModuleAddress() sdk.AccAddress {
+	return i.moduleAddr
+}
+
+// InvokeMsg executes the given message on behalf of the module account and
+// emits its events. It returns an error when the message type is not allowed,
+// when any of its signers is not the module account or when it fails, in which
+// case the caller is responsible for discarding the state changes.
+func (i ModuleMsgInvoker) InvokeMsg(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+	typeURL := sdk.MsgTypeURL(msg)
+	if _, ok := i.allowedMsgs[typeURL]; !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "module %s is not allowed to invoke %s", i.moduleName, typeURL)
+	}
+
+	signers := msg.GetSigners()
+	if len(signers) == 0 {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "module %s cannot invoke %s without signers", i.moduleName, typeURL)
+	}
+
+	for _, signer := range signers {
+		if !signer.Equals(i.moduleAddr) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "module %s cannot invoke %s on behalf of %s", i.moduleName, typeURL, signer)
+		}
+	}
+
+	handler := i.router.Handler(msg)
+	if handler == nil {
+		return nil, sdkerrors.ErrUnknownRequest.Wrapf("unrecognized message route: %s", typeURL)
+	}
+
+	res, err := handler(ctx, msg)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to invoke %s", typeURL)
+	}
+
+	events := make(sdk.Events, len(res.Events))
+	for j, event := range res.Events {
+		events[j] = sdk.Event(event)
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	return res, nil
+}
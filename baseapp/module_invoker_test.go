@@ -0,0 +1,75 @@
+package baseapp_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestModuleMsgInvoker(t *testing.T) {
+	suite := NewBaseAppSuite(t)
+	baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), MsgKeyValueImpl{})
+	suite.baseApp.InitChain(abci.RequestInitChain{ConsensusParams: &cmtproto.ConsensusParams{}})
+	ctx := getCheckStateCtx(suite.baseApp)
+
+	keyValueURL := sdk.MsgTypeURL(&baseapptestutil.MsgKeyValue{})
+	invoker := baseapp.NewModuleMsgInvoker(suite.baseApp.MsgServiceRouter(), "invoker", keyValueURL)
+	moduleAddr := sdk.AccAddress(address.Module("invoker"))
+	require.Equal(t, moduleAddr, invoker.ModuleAddress())
+
+	_, _, otherAddr := testdata.KeyTestPubAddr()
+
+	testCases := []struct {
+		name   string
+		msg    sdk.Msg
+		expErr error
+	}{
+		{
+			name: "module account signer",
+			msg:  &baseapptestutil.MsgKeyValue{Key: []byte("key"), Value: []byte("value"), Signer: moduleAddr.String()},
+		},
+		{
+			name:   "other signer",
+			msg:    &baseapptestutil.MsgKeyValue{Key: []byte("key"), Value: []byte("value"), Signer: otherAddr.String()},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:   "no signer",
+			msg:    &baseapptestutil.MsgKeyValue{Key: []byte("key"), Value: []byte("value")},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:   "msg type not allowed",
+			msg:    &baseapptestutil.MsgCounter{Counter: 1},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:   "failing msg",
+			msg:    &baseapptestutil.MsgKeyValue{Key: []byte("key"), Signer: moduleAddr.String()},
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := invoker.InvokeMsg(ctx, tc.msg)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, res)
+			require.Equal(t, []byte("value"), ctx.KVStore(capKey2).Get([]byte("key")))
+		})
+	}
+}
@@ -26,6 +26,8 @@ type MessageRouter interface {
 type MsgServiceRouter struct {
 	interfaceRegistry codectypes.InterfaceRegistry
 	routes            map[string]MsgServiceHandler
+	circuitBreaker    CircuitBreaker
+	middlewares       []MsgServiceMiddleware
 }
 
 var _ gogogrpc.Server = &MsgServiceRouter{}
@@ -40,6 +42,17 @@ func NewMsgServiceRouter() *MsgServiceRouter {
 // MsgServiceHandler defines a function type which handles Msg service message.
 type MsgServiceHandler = func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error)
 
+// MsgServiceMiddleware defines a function type which wraps the execution of
+// every Msg service message. It can run logic before and after the message
+// handler, by calling next, or reject the message by returning an error
+// without calling next.
+//
+// Middlewares are run after the circuit breaker and ValidateBasic checks, in
+// the order of their registration, with the first registered middleware being
+// the outermost one. The events emitted by a middleware after calling next are
+// not part of the returned result, unless added to it by the middleware.
+type MsgServiceMiddleware = func(ctx sdk.Context, msg sdk.Msg, next MsgServiceHandler) (*sdk.Result, error)
+
 // Handler returns the MsgServiceHandler for a given msg or nil if not found.
 func (msr *MsgServiceRouter) Handler(msg sdk.Msg) MsgServiceHandler {
 	return msr.routes[sdk.MsgTypeURL(msg)]
@@ -117,9 +130,17 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 
 		msr.routes[requestTypeName] = func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 			ctx = ctx.WithEventManager(sdk.NewEventManager())
-			interceptor := func(goCtx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-				goCtx = context.WithValue(goCtx, sdk.SdkContextKey, ctx)
-				return handler(goCtx, msg)
+
+			if msr.circuitBreaker != nil {
+				msgURL := sdk.MsgTypeURL(msg)
+				isAllowed, err := msr.circuitBreaker.IsAllowed(ctx, msgURL)
+				if err != nil {
+					return nil, err
+				}
+
+				if !isAllowed {
+					return nil, fmt.Errorf("circuit breaker disables execution of this message: %s", msgURL)
+				}
 			}
 
 			if m, ok := msg.(sdk.HasValidateBasic); ok {
@@ -128,21 +149,55 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 				}
 			}
 
-			// Call the method handler from the service description with the handler object.
-			// We don't do any decoding here because the decoding was already done.
-			res, err := methodHandler(handler, ctx, noopDecoder, interceptor)
-			if err != nil {
-				return nil, err
-			}
+			next := func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+				interceptor := func(goCtx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+					goCtx = context.WithValue(goCtx, sdk.SdkContextKey, ctx)
+					return handler(goCtx, msg)
+				}
 
-			resMsg, ok := res.(proto.Message)
-			if !ok {
-				return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "Expecting proto.Message, got %T", resMsg)
+				// Call the method handler from the service description with the handler object.
+				// We don't do any decoding here because the decoding was already done.
+				res, err := methodHandler(handler, ctx, noopDecoder, interceptor)
+				if err != nil {
+					return nil, err
+				}
+
+				resMsg, ok := res.(proto.Message)
+				if !ok {
+					return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "Expecting proto.Message, got %T", resMsg)
+				}
+
+				return sdk.WrapServiceResult(ctx, resMsg, err)
 			}
 
-			return sdk.WrapServiceResult(ctx, resMsg, err)
+			return msr.chainMiddlewares(next)(ctx, msg)
+		}
+	}
+}
+
+// AddMiddlewares registers middlewares wrapping the execution of every
+// message routed by the router, see MsgServiceMiddleware. They can be
+// registered before or after the services.
+func (msr *MsgServiceRouter) AddMiddlewares(middlewares ...MsgServiceMiddleware) {
+	msr.middlewares = append(msr.middlewares, middlewares...)
+}
+
+// chainMiddlewares wraps the given handler with the registered middlewares.
+func (msr *MsgServiceRouter) chainMiddlewares(handler MsgServiceHandler) MsgServiceHandler {
+	for i := len(msr.middlewares) - 1; i >= 0; i-- {
+		middleware, next := msr.middlewares[i], handler
+		handler = func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			return middleware(ctx, msg, next)
 		}
 	}
+
+	return handler
+}
+
+// SetCircuit sets the circuit breaker checked before the execution of every
+// message, including the messages nested in other messages (e.g. x/authz).
+func (msr *MsgServiceRouter) SetCircuit(cb CircuitBreaker) {
+	msr.circuitBreaker = cb
 }
 
 // SetInterfaceRegistry sets the interface registry for the router.
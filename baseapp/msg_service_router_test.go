@@ -12,11 +12,14 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -160,3 +163,49 @@ func TestMsgService(t *testing.T) {
 	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
 	require.Equal(t, abci.CodeTypeOK, res.Code, "res=%+v", res)
 }
+
+func TestMsgServiceMiddlewares(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	testdata.RegisterInterfaces(interfaceRegistry)
+
+	router := baseapp.NewMsgServiceRouter()
+	router.SetInterfaceRegistry(interfaceRegistry)
+
+	var calls []string
+	middleware := func(name string) baseapp.MsgServiceMiddleware {
+		return func(ctx sdk.Context, msg sdk.Msg, next baseapp.MsgServiceHandler) (*sdk.Result, error) {
+			calls = append(calls, "pre "+name+" "+sdk.MsgTypeURL(msg))
+			res, err := next(ctx, msg)
+			calls = append(calls, "post "+name)
+			return res, err
+		}
+	}
+	allowList := func(ctx sdk.Context, msg sdk.Msg, next baseapp.MsgServiceHandler) (*sdk.Result, error) {
+		if dog, ok := msg.(*testdata.MsgCreateDog); ok && dog.Dog.Name != "Spot" {
+			return nil, sdkerrors.ErrUnauthorized
+		}
+		return next(ctx, msg)
+	}
+
+	// middlewares can be registered before and after the services
+	router.AddMiddlewares(middleware("first"), middleware("second"))
+	testdata.RegisterMsgServer(router, testdata.MsgServerImpl{})
+	router.AddMiddlewares(allowList)
+
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+
+	msg := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}
+	res, err := router.Handler(msg)(ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, []string{
+		"pre first /testpb.MsgCreateDog",
+		"pre second /testpb.MsgCreateDog",
+		"post second",
+		"post first",
+	}, calls)
+
+	msg = &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Rex"}}
+	_, err = router.Handler(msg)(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
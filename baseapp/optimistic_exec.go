@@ -0,0 +1,719 @@
+package baseapp
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"runtime"
+	"sync"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/tracekv"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// Optimistic execution runs the txs of a block concurrently, each of them
+// against its own branch of the state left by BeginBlock, while recording the
+// keys each tx reads and writes. The results are then committed in the block
+// order as DeliverTx is called: a tx whose read set does not intersect the
+// keys written by the txs committed before it produced exactly the result of a
+// sequential execution, and its writes are applied as is. Other txs are
+// deterministically re-executed against the latest state.
+//
+// The txs of a block are only known once the block is proposed, so the txs
+// returned by PrepareProposal or accepted by ProcessProposal are recorded as
+// the candidates of the next block. Whenever a delivered tx does not match the
+// candidates, the remaining txs of the block are executed sequentially.
+//
+// The AnteHandler, the Msg handlers and the PostHandler of the txs executed
+// concurrently must not share any mutable state other than the stores, such as
+// caches held by the keepers. The app declares the Msgs whose handlers are safe
+// for concurrent use with SetOptimisticExecutionMsgs, and the blocks which
+// contain any other Msg, or any tx that cannot be decoded, are executed
+// sequentially.
+//
+// Some keys are written by almost every tx, e.g. the fee collector balance
+// credited with the fees of each tx, which would make every pair of txs
+// conflict. The app declares such keys with SetOptimisticExecutionAccumulators:
+// their accesses are not tracked, and the change made by a tx to their value is
+// merged into their latest value when the tx is committed. The merge fails
+// closed: when a change cannot be merged, e.g. a debit, the remaining txs of the
+// block are executed sequentially.
+//
+// The txs executed concurrently read the stores of the deliver state, which are
+// not safe for concurrent use, through a lock shared by the whole batch.
+
+type (
+	// OptimisticAccumulator declares the keys of a store whose values the txs
+	// executed concurrently only add to, whatever their current value, such
+	// as the balances of the fee collector. The txs may read these values, as
+	// long as a tx succeeding against a value would also succeed against a
+	// greater one, e.g. to refund a part of the fees the tx paid. Failed txs
+	// which accessed these values are re-executed against the latest state.
+	OptimisticAccumulator struct {
+		// StoreKey is the name of the store of the keys.
+		StoreKey string
+		// Match returns true for the keys of the accumulator.
+		Match func(key []byte) bool
+		// Merge returns the value of a key once a tx which changed its value
+		// from base to value is committed on top of its latest value. A nil
+		// value stands for a missing key. It must return an error when the tx
+		// did not only add to the value, e.g. when it debited a balance.
+		Merge func(base, value, latest []byte) ([]byte, error)
+	}
+
+	// optimisticCandidates are the txs of a proposal which is expected to be
+	// delivered at the given height.
+	optimisticCandidates struct {
+		height int64
+		txs    [][]byte
+	}
+
+	// optimisticBatch tracks the optimistic execution of the txs of a block.
+	optimisticBatch struct {
+		txs          [][]byte
+		storeKeys    map[string]storetypes.StoreKey
+		accumulators map[storetypes.StoreKey][]OptimisticAccumulator
+		// parents are the stores of the deliver state, which the txs executed
+		// concurrently read through a shared lock.
+		parents map[storetypes.StoreKey]storetypes.CacheWrapper
+		// results are nil until the first tx of the block is delivered.
+		results []*optimisticTxResult
+		// next is the index of the next tx expected to be delivered.
+		next int
+		// written is the set of keys written by the txs committed so far.
+		written map[storetypes.StoreKey]map[string]struct{}
+	}
+
+	// optimisticTxResult is the result of the execution of a tx against a
+	// tracked branch of the state.
+	optimisticTxResult struct {
+		ms           *trackingMultiStore
+		mempool      *recordingMempool
+		blockGasUsed uint64
+
+		gInfo      sdk.GasInfo
+		result     *sdk.Result
+		anteEvents []abci.Event
+		err        error
+	}
+)
+
+// recordOptimisticCandidates records the txs of a proposal as the candidates
+// for the optimistic execution of the block at the given height.
+func (app *BaseApp) recordOptimisticCandidates(height int64, txs [][]byte) {
+	if !app.optimisticExecution {
+		return
+	}
+
+	app.optimisticCandidates = optimisticCandidates{height: height, txs: txs}
+}
+
+// startOptimisticBatch prepares the optimistic execution of the block at the
+// given height if its txs are known. It is called once BeginBlock has been
+// executed.
+func (app *BaseApp) startOptimisticBatch(height int64) {
+	app.optimisticBatch = nil
+
+	candidates := app.optimisticCandidates
+	if !app.optimisticExecution || candidates.height != height || len(candidates.txs) < 2 {
+		return
+	}
+
+	if !app.optimisticSafe(candidates.txs) {
+		return
+	}
+
+	cms, ok := app.cms.(interface {
+		StoreKeysByName() map[string]storetypes.StoreKey
+	})
+	if !ok {
+		return
+	}
+
+	storeKeys := cms.StoreKeysByName()
+	accumulators := make(map[storetypes.StoreKey][]OptimisticAccumulator)
+	for _, acc := range app.optimisticAccumulators {
+		if key, ok := storeKeys[acc.StoreKey]; ok {
+			accumulators[key] = append(accumulators[key], acc)
+		}
+	}
+
+	// The stores of the deliver state, e.g. cachekv stores on top of IAVL
+	// trees, are not safe for concurrent use, even for reads.
+	mtx := &sync.Mutex{}
+	ms := app.deliverState.ctx.MultiStore()
+	parents := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(storeKeys))
+	for _, key := range storeKeys {
+		parents[key] = &lockedKVStore{KVStore: ms.GetKVStore(key), mtx: mtx}
+	}
+
+	app.optimisticBatch = &optimisticBatch{
+		txs:          candidates.txs,
+		storeKeys:    storeKeys,
+		accumulators: accumulators,
+		parents:      parents,
+		written:      make(map[storetypes.StoreKey]map[string]struct{}),
+	}
+}
+
+// optimisticSafe returns true if the given txs only contain Msgs declared safe
+// for concurrent use by SetOptimisticExecutionMsgs.
+func (app *BaseApp) optimisticSafe(txs [][]byte) bool {
+	for _, txBytes := range txs {
+		tx, err := app.txDecoder(txBytes)
+		if err != nil {
+			return false
+		}
+
+		for _, msg := range tx.GetMsgs() {
+			if _, ok := app.optimisticMsgs[sdk.MsgTypeURL(msg)]; !ok {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// nextOptimisticTxResult returns the result of the optimistic execution of the
+// given delivered tx, after committing it to the deliver state. It returns
+// false if the tx must be executed sequentially instead.
+func (app *BaseApp) nextOptimisticTxResult(txBytes []byte) (*optimisticTxResult, bool) {
+	batch := app.optimisticBatch
+	if batch == nil {
+		return nil, false
+	}
+
+	if batch.next >= len(batch.txs) || !bytes.Equal(batch.txs[batch.next], txBytes) {
+		app.optimisticBatch = nil
+		return nil, false
+	}
+
+	if batch.results == nil {
+		app.logger.Debug("executing txs optimistically", "txs", len(batch.txs))
+		batch.results = app.runTxsOptimistic(app.deliverState.ctx, batch, batch.txs)
+	}
+
+	res := batch.results[batch.next]
+	batch.results[batch.next] = nil
+	batch.next++
+
+	// The tx read keys written by the txs committed before it, so its result
+	// may differ from a sequential execution: execute it again on top of the
+	// latest state. A failed tx which accessed accumulated keys may also have
+	// failed because of their value, e.g. on an insufficient balance.
+	if res.ms.conflicts(batch.written) || (res.err != nil && res.ms.accumulated()) {
+		app.logger.Debug("re-executing optimistic tx conflicting with the txs committed before it", "index", batch.next-1)
+		res = app.runTxOptimistic(app.deliverState.ctx, batch, txBytes)
+	}
+
+	// Without an AnteHandler to set up a gas meter per tx, the txs of a block
+	// share the gas meter of the deliver state: account for the gas consumed by
+	// the txs committed before this one.
+	gasMeter := app.deliverState.ctx.GasMeter()
+	sharedGasMeter := app.anteHandler == nil
+	if sharedGasMeter {
+		res.gInfo.GasUsed += gasMeter.GasConsumed()
+		res.blockGasUsed = res.gInfo.GasUsed
+		if limit := gasMeter.Limit(); res.blockGasUsed > limit {
+			res.blockGasUsed = limit
+		}
+	}
+
+	// When the tx exhausts the block gas, fall back to a sequential execution
+	// which handles it.
+	blockGasMeter := app.deliverState.ctx.BlockGasMeter()
+	if blockGasMeter.IsOutOfGas() || res.blockGasUsed > blockGasMeter.GasRemaining() {
+		app.optimisticBatch = nil
+		return nil, false
+	}
+
+	if err := res.ms.mergeAccumulators(app.deliverState.ctx.MultiStore()); err != nil {
+		app.logger.Error("failed to merge optimistic tx accumulators", "err", err)
+		app.optimisticBatch = nil
+		return nil, false
+	}
+
+	if sharedGasMeter {
+		gasMeter.ConsumeGas(res.gInfo.GasUsed-gasMeter.GasConsumed(), "optimistic tx")
+	}
+
+	blockGasMeter.ConsumeGas(res.blockGasUsed, "block gas meter")
+	res.ms.Write()
+	res.ms.collectWrites(batch.written)
+
+	for _, tx := range res.mempool.removed {
+		if err := app.mempool.Remove(tx); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+			app.logger.Error("failed to remove tx from mempool", "err", err)
+		}
+	}
+
+	return res, true
+}
+
+// runTxsOptimistic executes the given txs concurrently, each of them against
+// its own tracked branch of the state of ctx.
+func (app *BaseApp) runTxsOptimistic(ctx sdk.Context, batch *optimisticBatch, txs [][]byte) []*optimisticTxResult {
+	results := make([]*optimisticTxResult, len(txs))
+	workers := make(chan struct{}, runtime.GOMAXPROCS(0))
+
+	var wg sync.WaitGroup
+	for i, txBytes := range txs {
+		wg.Add(1)
+		workers <- struct{}{}
+
+		go func(i int, txBytes []byte) {
+			defer func() {
+				<-workers
+				wg.Done()
+			}()
+
+			results[i] = app.runTxOptimistic(ctx, batch, txBytes)
+		}(i, txBytes)
+	}
+
+	wg.Wait()
+
+	return results
+}
+
+// runTxOptimistic executes a tx against a tracked branch of the state of ctx,
+// read through the locked stores of the batch. The tx uses its own gas meters
+// and mempool, so that it does not share any mutable state with the txs
+// executed concurrently.
+func (app *BaseApp) runTxOptimistic(ctx sdk.Context, batch *optimisticBatch, txBytes []byte) *optimisticTxResult {
+	parent := cachemulti.NewFromKVStore(dbadapter.Store{DB: dbm.NewMemDB()}, batch.parents, batch.storeKeys, nil, nil)
+	res := &optimisticTxResult{
+		ms:      newTrackingMultiStore(parent, batch.storeKeys, batch.accumulators),
+		mempool: &recordingMempool{},
+	}
+
+	blockGasMeter := storetypes.NewInfiniteGasMeter()
+	ctx = ctx.
+		WithMultiStore(res.ms).
+		WithTxBytes(txBytes).
+		WithVoteInfos(app.voteInfos).
+		WithGasMeter(storetypes.NewInfiniteGasMeter()).
+		WithBlockGasMeter(blockGasMeter).
+		WithEventManager(sdk.NewEventManager())
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+
+	res.gInfo, res.result, res.anteEvents, _, res.err = app.runTxWithContext(runTxModeDeliver, ctx, txBytes, res.mempool)
+	res.blockGasUsed = blockGasMeter.GasConsumed()
+
+	return res
+}
+
+// recordingMempool is a mempool recording the txs removed by an optimistically
+// executed tx, so that they are only removed from the app mempool once the tx
+// is committed.
+type recordingMempool struct {
+	mempool.NoOpMempool
+	removed []sdk.Tx
+}
+
+var _ mempool.Mempool = (*recordingMempool)(nil)
+
+func (mp *recordingMempool) Remove(tx sdk.Tx) error {
+	mp.removed = append(mp.removed, tx)
+	return nil
+}
+
+// lockedKVStore is a KVStore whose accesses, including through its iterators,
+// are serialized by a lock shared with other stores.
+type lockedKVStore struct {
+	storetypes.KVStore
+	mtx *sync.Mutex
+}
+
+var _ storetypes.KVStore = (*lockedKVStore)(nil)
+
+func (s *lockedKVStore) Get(key []byte) []byte {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.KVStore.Get(key)
+}
+
+func (s *lockedKVStore) Has(key []byte) bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.KVStore.Has(key)
+}
+
+func (s *lockedKVStore) Set(key, value []byte) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.KVStore.Set(key, value)
+}
+
+func (s *lockedKVStore) Delete(key []byte) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.KVStore.Delete(key)
+}
+
+func (s *lockedKVStore) Iterator(start, end []byte) storetypes.Iterator {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return &lockedIterator{Iterator: s.KVStore.Iterator(start, end), mtx: s.mtx}
+}
+
+func (s *lockedKVStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return &lockedIterator{Iterator: s.KVStore.ReverseIterator(start, end), mtx: s.mtx}
+}
+
+func (s *lockedKVStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+func (s *lockedKVStore) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// lockedIterator is an iterator of a lockedKVStore, which may read the store
+// lazily.
+type lockedIterator struct {
+	storetypes.Iterator
+	mtx *sync.Mutex
+}
+
+func (it *lockedIterator) Valid() bool {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	return it.Iterator.Valid()
+}
+
+func (it *lockedIterator) Next() {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	it.Iterator.Next()
+}
+
+func (it *lockedIterator) Key() []byte {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	return it.Iterator.Key()
+}
+
+func (it *lockedIterator) Value() []byte {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	return it.Iterator.Value()
+}
+
+func (it *lockedIterator) Error() error {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	return it.Iterator.Error()
+}
+
+func (it *lockedIterator) Close() error {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+	return it.Iterator.Close()
+}
+
+// keyRange is the [start, end) range of keys read by an iterator. Nil bounds
+// are unbounded.
+type keyRange struct {
+	start, end []byte
+}
+
+func (r keyRange) contains(key []byte) bool {
+	return (r.start == nil || bytes.Compare(key, r.start) >= 0) &&
+		(r.end == nil || bytes.Compare(key, r.end) < 0)
+}
+
+// trackingKVStore is a KVStore recording the keys read and written through it.
+// The keys of its accumulators are not recorded, but their value before their
+// first access is.
+type trackingKVStore struct {
+	storetypes.KVStore
+
+	reads  map[string]struct{}
+	ranges []keyRange
+	writes map[string]struct{}
+
+	accumulators []OptimisticAccumulator
+	// bases are the values of the accumulated keys before their first access.
+	bases map[string][]byte
+}
+
+var _ storetypes.KVStore = (*trackingKVStore)(nil)
+
+func newTrackingKVStore(parent storetypes.KVStore, accumulators []OptimisticAccumulator) *trackingKVStore {
+	return &trackingKVStore{
+		KVStore:      parent,
+		reads:        make(map[string]struct{}),
+		writes:       make(map[string]struct{}),
+		accumulators: accumulators,
+		bases:        make(map[string][]byte),
+	}
+}
+
+// accumulate returns true if the key belongs to an accumulator, after
+// recording its base value.
+func (s *trackingKVStore) accumulate(key []byte) bool {
+	if s.accumulator(key) == nil {
+		return false
+	}
+
+	if _, ok := s.bases[string(key)]; !ok {
+		s.bases[string(key)] = s.KVStore.Get(key)
+	}
+
+	return true
+}
+
+// accumulator returns the accumulator of the key, if any.
+func (s *trackingKVStore) accumulator(key []byte) *OptimisticAccumulator {
+	for i := range s.accumulators {
+		if s.accumulators[i].Match(key) {
+			return &s.accumulators[i]
+		}
+	}
+
+	return nil
+}
+
+func (s *trackingKVStore) Get(key []byte) []byte {
+	if !s.accumulate(key) {
+		s.reads[string(key)] = struct{}{}
+	}
+	return s.KVStore.Get(key)
+}
+
+func (s *trackingKVStore) Has(key []byte) bool {
+	if !s.accumulate(key) {
+		s.reads[string(key)] = struct{}{}
+	}
+	return s.KVStore.Has(key)
+}
+
+func (s *trackingKVStore) Set(key, value []byte) {
+	if !s.accumulate(key) {
+		s.writes[string(key)] = struct{}{}
+	}
+	s.KVStore.Set(key, value)
+}
+
+func (s *trackingKVStore) Delete(key []byte) {
+	if !s.accumulate(key) {
+		s.writes[string(key)] = struct{}{}
+	}
+	s.KVStore.Delete(key)
+}
+
+func (s *trackingKVStore) Iterator(start, end []byte) storetypes.Iterator {
+	s.ranges = append(s.ranges, keyRange{start: start, end: end})
+	return s.KVStore.Iterator(start, end)
+}
+
+func (s *trackingKVStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	s.ranges = append(s.ranges, keyRange{start: start, end: end})
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+func (s *trackingKVStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+func (s *trackingKVStore) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// conflicts returns true if any key read through the store is in written.
+func (s *trackingKVStore) conflicts(written map[string]struct{}) bool {
+	for key := range s.reads {
+		if _, ok := written[key]; ok {
+			return true
+		}
+	}
+
+	for _, r := range s.ranges {
+		for key := range written {
+			if r.contains([]byte(key)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// mergeAccumulators merges the changes made to the accumulated keys into their
+// latest values, read from latest.
+func (s *trackingKVStore) mergeAccumulators(latest storetypes.KVStore) error {
+	for key, base := range s.bases {
+		value := s.KVStore.Get([]byte(key))
+		if bytes.Equal(value, base) {
+			continue
+		}
+
+		merged, err := s.accumulator([]byte(key)).Merge(base, value, latest.Get([]byte(key)))
+		if err != nil {
+			return err
+		}
+
+		if merged == nil {
+			s.KVStore.Delete([]byte(key))
+		} else {
+			s.KVStore.Set([]byte(key), merged)
+		}
+	}
+
+	return nil
+}
+
+// trackingMultiStore is a branch of a multistore whose stores record the keys
+// read and written through them, including through their own branches.
+type trackingMultiStore struct {
+	parent storetypes.CacheMultiStore
+	db     storetypes.KVStore
+	keys   map[string]storetypes.StoreKey
+	stores map[storetypes.StoreKey]*trackingKVStore
+}
+
+var _ storetypes.CacheMultiStore = (*trackingMultiStore)(nil)
+
+func newTrackingMultiStore(
+	parent storetypes.CacheMultiStore,
+	keys map[string]storetypes.StoreKey,
+	accumulators map[storetypes.StoreKey][]OptimisticAccumulator,
+) *trackingMultiStore {
+	ms := &trackingMultiStore{
+		parent: parent,
+		db:     dbadapter.Store{DB: dbm.NewMemDB()},
+		keys:   keys,
+		stores: make(map[storetypes.StoreKey]*trackingKVStore, len(keys)),
+	}
+
+	for _, key := range keys {
+		ms.stores[key] = newTrackingKVStore(parent.GetKVStore(key), accumulators[key])
+	}
+
+	return ms
+}
+
+func (ms *trackingMultiStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return ms.GetKVStore(key)
+}
+
+func (ms *trackingMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	store, ok := ms.stores[key]
+	if !ok {
+		panic("kv store with key " + key.String() + " has not been registered in stores")
+	}
+
+	return store
+}
+
+func (ms *trackingMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(ms.stores))
+	for key, store := range ms.stores {
+		stores[key] = store
+	}
+
+	return cachemulti.NewFromKVStore(ms.db, stores, ms.keys, nil, nil)
+}
+
+func (ms *trackingMultiStore) CacheMultiStoreWithVersion(version int64) (storetypes.CacheMultiStore, error) {
+	return ms.parent.CacheMultiStoreWithVersion(version)
+}
+
+func (ms *trackingMultiStore) CacheWrap() storetypes.CacheWrap {
+	return ms.CacheMultiStore()
+}
+
+func (ms *trackingMultiStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return ms.CacheMultiStore()
+}
+
+func (ms *trackingMultiStore) GetStoreType() storetypes.StoreType {
+	return ms.parent.GetStoreType()
+}
+
+func (ms *trackingMultiStore) LatestVersion() int64 {
+	return ms.parent.LatestVersion()
+}
+
+// Tracing is not supported by the stores of an optimistically executed tx.
+func (ms *trackingMultiStore) TracingEnabled() bool {
+	return false
+}
+
+func (ms *trackingMultiStore) SetTracer(_ io.Writer) storetypes.MultiStore {
+	return ms
+}
+
+func (ms *trackingMultiStore) SetTracingContext(_ storetypes.TraceContext) storetypes.MultiStore {
+	return ms
+}
+
+// Write writes the branch to the parent multistore.
+func (ms *trackingMultiStore) Write() {
+	ms.parent.Write()
+}
+
+// conflicts returns true if any key read through the multistore is in
+// written.
+func (ms *trackingMultiStore) conflicts(written map[storetypes.StoreKey]map[string]struct{}) bool {
+	for key, store := range ms.stores {
+		if keys, ok := written[key]; ok && store.conflicts(keys) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// accumulated returns true if any accumulated key was accessed through the
+// multistore.
+func (ms *trackingMultiStore) accumulated() bool {
+	for _, store := range ms.stores {
+		if len(store.bases) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// mergeAccumulators merges the changes made to the accumulated keys into their
+// latest values, read from latest.
+func (ms *trackingMultiStore) mergeAccumulators(latest storetypes.MultiStore) error {
+	for key, store := range ms.stores {
+		if err := store.mergeAccumulators(latest.GetKVStore(key)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// collectWrites adds the keys written through the multistore to written.
+func (ms *trackingMultiStore) collectWrites(written map[storetypes.StoreKey]map[string]struct{}) {
+	for key, store := range ms.stores {
+		if len(store.writes) == 0 {
+			continue
+		}
+
+		if written[key] == nil {
+			written[key] = make(map[string]struct{}, len(store.writes))
+		}
+
+		for k := range store.writes {
+			written[key][k] = struct{}{}
+		}
+	}
+}
@@ -0,0 +1,259 @@
+package baseapp_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	sharedCounterKey = []byte("shared-counter")
+
+	// optimisticMsgs are the Msgs of the test txs, whose handlers are safe for
+	// concurrent use.
+	optimisticMsgs = []string{
+		sdk.MsgTypeURL(&baseapptestutil.MsgKeyValue{}),
+		sdk.MsgTypeURL(&baseapptestutil.MsgCounter2{}),
+	}
+)
+
+// sharedCounterServerImpl increments a counter shared by all the txs, so that
+// txs executed concurrently conflict with each other.
+type sharedCounterServerImpl struct{}
+
+func (sharedCounterServerImpl) IncrementCounter(ctx context.Context, msg *baseapptestutil.MsgCounter2) (*baseapptestutil.MsgCreateCounterResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if msg.FailOnHandler {
+		return nil, fmt.Errorf("message handler failure")
+	}
+
+	store := sdkCtx.KVStore(capKey1)
+	counter := int64(0)
+	if bz := store.Get(sharedCounterKey); bz != nil {
+		counter = int64(bz[0])
+	}
+
+	counter++
+	store.Set(sharedCounterKey, []byte{byte(counter)})
+	sdkCtx.EventManager().EmitEvents(counterEvent(sdk.EventTypeMessage, counter))
+
+	return &baseapptestutil.MsgCreateCounterResponse{}, nil
+}
+
+func newOptimisticExecSuite(t *testing.T, opts ...func(*baseapp.BaseApp)) *BaseAppSuite {
+	suite := NewBaseAppSuite(t, opts...)
+	baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), MsgKeyValueImpl{})
+	baseapptestutil.RegisterCounter2Server(suite.baseApp.MsgServiceRouter(), sharedCounterServerImpl{})
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	return suite
+}
+
+// executeBlock proposes the given txs, then delivers the given delivered txs
+// and commits the block.
+func executeBlock(suite *BaseAppSuite, height int64, proposed, delivered [][]byte) ([]abci.ResponseDeliverTx, []byte) {
+	suite.baseApp.ProcessProposal(abci.RequestProcessProposal{Txs: proposed, Height: height})
+	suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: height}})
+
+	responses := make([]abci.ResponseDeliverTx, len(delivered))
+	for i, txBytes := range delivered {
+		responses[i] = suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	}
+
+	suite.baseApp.EndBlock(abci.RequestEndBlock{Height: height})
+	return responses, suite.baseApp.Commit().Data
+}
+
+func TestABCI_OptimisticExecution(t *testing.T) {
+	seqSuite := newOptimisticExecSuite(t)
+	optSuite := newOptimisticExecSuite(t, baseapp.SetOptimisticExecution(true), baseapp.SetOptimisticExecutionMsgs(optimisticMsgs...))
+
+	var txs [][]byte
+	for i := 0; i < 12; i++ {
+		var msg sdk.Msg
+		switch i % 3 {
+		case 0:
+			// independent txs
+			msg = &baseapptestutil.MsgKeyValue{Key: []byte(fmt.Sprintf("key-%d", i)), Value: []byte("value")}
+		case 1:
+			// conflicting txs
+			msg = &baseapptestutil.MsgCounter2{Counter: int64(i)}
+		case 2:
+			// failing txs
+			msg = &baseapptestutil.MsgCounter2{Counter: int64(i), FailOnHandler: true}
+		}
+
+		builder := seqSuite.txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msg))
+		setTxSignature(t, builder, uint64(i))
+
+		txBytes, err := seqSuite.txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		txs = append(txs, txBytes)
+	}
+
+	// the txs of the first block are delivered as proposed, the txs of the
+	// second block are not, so that the remaining txs fall back to sequential
+	// execution
+	blocks := []struct {
+		proposed, delivered [][]byte
+	}{
+		{proposed: txs[:6], delivered: txs[:6]},
+		{proposed: txs[6:], delivered: append([][]byte{txs[6], txs[7], txs[9]}, txs[10:]...)},
+	}
+
+	for i, block := range blocks {
+		height := int64(i) + 1
+
+		seqResponses, seqHash := executeBlock(seqSuite, height, block.proposed, block.delivered)
+		optResponses, optHash := executeBlock(optSuite, height, block.proposed, block.delivered)
+
+		require.Equal(t, seqResponses, optResponses)
+		require.Equal(t, seqHash, optHash)
+	}
+
+	store := getCheckStateCtx(optSuite.baseApp).KVStore(capKey1)
+	require.Equal(t, []byte{4}, store.Get(sharedCounterKey))
+}
+
+// deliveredCounterServerImpl records the number of txs delivered when its
+// handler is executed.
+type deliveredCounterServerImpl struct {
+	delivered  *int
+	executedAt map[int64]int
+}
+
+func (s deliveredCounterServerImpl) IncrementCounter(_ context.Context, msg *baseapptestutil.MsgCounter2) (*baseapptestutil.MsgCreateCounterResponse, error) {
+	s.executedAt[msg.Counter] = *s.delivered
+	return &baseapptestutil.MsgCreateCounterResponse{}, nil
+}
+
+func TestABCI_OptimisticExecutionUndeclaredMsgs(t *testing.T) {
+	suite := NewBaseAppSuite(t,
+		baseapp.SetOptimisticExecution(true),
+		baseapp.SetOptimisticExecutionMsgs(sdk.MsgTypeURL(&baseapptestutil.MsgCounter2{})),
+	)
+
+	delivered := 0
+	executedAt := make(map[int64]int)
+	baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), MsgKeyValueImpl{})
+	baseapptestutil.RegisterCounter2Server(suite.baseApp.MsgServiceRouter(), deliveredCounterServerImpl{
+		delivered:  &delivered,
+		executedAt: executedAt,
+	})
+
+	suite.baseApp.InitChain(abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+
+	newTx := func(nonce uint64, msgs ...sdk.Msg) []byte {
+		builder := suite.txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msgs...))
+		setTxSignature(t, builder, nonce)
+
+		txBytes, err := suite.txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		return txBytes
+	}
+
+	blocks := []struct {
+		txs [][]byte
+		// executedAt is the number of txs delivered when the handler of the
+		// second tx is executed.
+		executedAt int
+	}{
+		// the txs are executed concurrently when the first one is delivered
+		{
+			txs: [][]byte{
+				newTx(0, &baseapptestutil.MsgCounter2{Counter: 1}),
+				newTx(1, &baseapptestutil.MsgCounter2{Counter: 2}),
+			},
+			executedAt: 0,
+		},
+		// the first tx contains an undeclared Msg, so the txs are executed
+		// sequentially
+		{
+			txs: [][]byte{
+				newTx(2, &baseapptestutil.MsgCounter2{Counter: 3}, &baseapptestutil.MsgKeyValue{Key: []byte("key"), Value: []byte("value")}),
+				newTx(3, &baseapptestutil.MsgCounter2{Counter: 4}),
+			},
+			executedAt: 1,
+		},
+	}
+
+	for i, block := range blocks {
+		height := int64(i) + 1
+
+		suite.baseApp.ProcessProposal(abci.RequestProcessProposal{Txs: block.txs, Height: height})
+		suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: height}})
+
+		for delivered = 0; delivered < len(block.txs); delivered++ {
+			res := suite.baseApp.DeliverTx(abci.RequestDeliverTx{Tx: block.txs[delivered]})
+			require.True(t, res.IsOK(), res.Log)
+		}
+
+		suite.baseApp.EndBlock(abci.RequestEndBlock{Height: height})
+		suite.baseApp.Commit()
+
+		require.Equal(t, block.executedAt, executedAt[2*height])
+	}
+}
+
+// TestABCI_OptimisticExecutionConcurrentReads executes txs which all read and
+// iterate the same keys concurrently, and is meant to be run with -race.
+func TestABCI_OptimisticExecutionConcurrentReads(t *testing.T) {
+	// the AnteHandler reads the keys set by the previous blocks
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			store := ctx.KVStore(capKey1)
+			store.Get(sharedCounterKey)
+			it := store.Iterator(nil, nil)
+			defer it.Close()
+			for ; it.Valid(); it.Next() {
+				_ = it.Value()
+			}
+			return ctx, nil
+		})
+	}
+	seqSuite := newOptimisticExecSuite(t, anteOpt)
+	optSuite := newOptimisticExecSuite(t, anteOpt, baseapp.SetOptimisticExecution(true), baseapp.SetOptimisticExecutionMsgs(optimisticMsgs...))
+
+	nonce := uint64(0)
+	newBlock := func(size int) [][]byte {
+		txs := make([][]byte, size)
+		for i := range txs {
+			builder := seqSuite.txConfig.NewTxBuilder()
+			require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgKeyValue{Key: []byte(fmt.Sprintf("key-%d", nonce)), Value: []byte("value")}))
+			setTxSignature(t, builder, nonce)
+			nonce++
+
+			txBytes, err := seqSuite.txConfig.TxEncoder()(builder.GetTx())
+			require.NoError(t, err)
+			txs[i] = txBytes
+		}
+		return txs
+	}
+
+	for height := int64(1); height <= 3; height++ {
+		txs := newBlock(32)
+
+		seqResponses, seqHash := executeBlock(seqSuite, height, txs, txs)
+		optResponses, optHash := executeBlock(optSuite, height, txs, txs)
+
+		require.Equal(t, seqResponses, optResponses)
+		require.Equal(t, seqHash, optHash)
+	}
+}
@@ -61,6 +61,24 @@ func SetIndexEvents(ie []string) func(*BaseApp) {
 	return func(app *BaseApp) { app.setIndexEvents(ie) }
 }
 
+// SetQueryGasLimit provides a BaseApp option function that sets the maximum gas
+// consumed by a query, unlimited when zero.
+func SetQueryGasLimit(limit uint64) func(*BaseApp) {
+	return func(app *BaseApp) { app.queryGasLimit = limit }
+}
+
+// SetQueryMaxResponseBytes provides a BaseApp option function that sets the
+// maximum size in bytes of the response of a query, unlimited when zero.
+func SetQueryMaxResponseBytes(size uint64) func(*BaseApp) {
+	return func(app *BaseApp) { app.queryMaxResponseBytes = size }
+}
+
+// SetDropEvents provides a BaseApp option function that sets the events and
+// event attributes to remove from the ABCI responses.
+func SetDropEvents(de []string) func(*BaseApp) {
+	return func(app *BaseApp) { app.setDropEvents(de) }
+}
+
 // SetIAVLCacheSize provides a BaseApp option function that sets the size of IAVL cache.
 func SetIAVLCacheSize(size int) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetIAVLCacheSize(size) }
@@ -97,6 +115,64 @@ func SetChainID(chainID string) func(*BaseApp) {
 	return func(app *BaseApp) { app.chainID = chainID }
 }
 
+// SetOptimisticExecution provides a BaseApp option function that enables the
+// concurrent execution of the txs of a block. Conflicting txs are re-executed
+// so that the results match a sequential execution. Only the blocks made of
+// the Msgs declared by SetOptimisticExecutionMsgs are executed concurrently.
+func SetOptimisticExecution(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) { app.optimisticExecution = enabled }
+}
+
+// SetOptimisticExecutionMsgs provides a BaseApp option function that declares
+// the Msgs, by type URL, whose handlers are safe for concurrent use. Declaring
+// any Msg also declares the AnteHandler and the PostHandler safe for concurrent
+// use.
+func SetOptimisticExecutionMsgs(msgTypeURLs ...string) func(*BaseApp) {
+	return func(app *BaseApp) {
+		app.optimisticMsgs = make(map[string]struct{}, len(msgTypeURLs))
+		for _, typeURL := range msgTypeURLs {
+			app.optimisticMsgs[typeURL] = struct{}{}
+		}
+	}
+}
+
+// SetOptimisticExecutionAccumulators provides a BaseApp option function that
+// declares the keys whose values the txs executed concurrently only add to,
+// e.g. the balances of the fee collector, so that they don't conflict with
+// each other through these keys.
+func SetOptimisticExecutionAccumulators(accumulators ...OptimisticAccumulator) func(*BaseApp) {
+	return func(app *BaseApp) { app.optimisticAccumulators = accumulators }
+}
+
+// SetBlockTraceDir provides a BaseApp option function that enables block
+// tracing, writing the execution trace of each block to the given directory.
+// This is a debug mode meant to investigate app hash mismatches, which slows
+// down the execution of blocks.
+func SetBlockTraceDir(dir string) func(*BaseApp) {
+	return func(app *BaseApp) { app.blockTraceDir = dir }
+}
+
+// SetClock provides a BaseApp option function that sets the clock of the
+// contexts of the app. The clock also provides the time of InitChain and of the
+// blocks when the request has no time, so that tests can advance the block time
+// deterministically with a sdk.ManualClock instead of setting every header.
+func SetClock(clock sdk.Clock) func(*BaseApp) {
+	return func(app *BaseApp) { app.clock = clock }
+}
+
+// SetRecoveryHandlers provides a BaseApp option function that registers custom
+// panic recovery handlers for the runTx method, see AddRunTxRecoveryHandler.
+func SetRecoveryHandlers(handlers ...RecoveryHandler) func(*BaseApp) {
+	return func(app *BaseApp) { app.AddRunTxRecoveryHandler(handlers...) }
+}
+
+// SetMsgServiceMiddlewares provides a BaseApp option function that registers
+// middlewares wrapping the execution of every message, see
+// MsgServiceRouter.AddMiddlewares.
+func SetMsgServiceMiddlewares(middlewares ...MsgServiceMiddleware) func(*BaseApp) {
+	return func(app *BaseApp) { app.msgServiceRouter.AddMiddlewares(middlewares...) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
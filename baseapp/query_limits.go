@@ -0,0 +1,40 @@
+package baseapp
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// recoverQueryOutOfGas recovers from the panics caused by a query running out
+// of gas and sets err to a ResourceExhausted gRPC error. Other panics are
+// propagated.
+func recoverQueryOutOfGas(err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	oog, ok := r.(storetypes.ErrorOutOfGas)
+	if !ok {
+		panic(r)
+	}
+
+	*err = status.Errorf(codes.ResourceExhausted, "query out of gas in location: %v", oog.Descriptor)
+}
+
+// checkQueryResponseSize returns an error if the size of a query response
+// exceeds the configured maximum.
+func (app *BaseApp) checkQueryResponseSize(size int) error {
+	if app.queryMaxResponseBytes > 0 && uint64(size) > app.queryMaxResponseBytes {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"query response size %d exceeds the maximum of %d bytes", size, app.queryMaxResponseBytes,
+		)
+	}
+
+	return nil
+}
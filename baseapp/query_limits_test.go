@@ -0,0 +1,84 @@
+package baseapp_test
+
+import (
+	"context"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// storeQueryImpl reads the store on each echo query, so that queries consume
+// gas.
+type storeQueryImpl struct {
+	testdata.QueryImpl
+}
+
+func (storeQueryImpl) Echo(ctx context.Context, req *testdata.EchoRequest) (*testdata.EchoResponse, error) {
+	sdk.UnwrapSDKContext(ctx).KVStore(capKey1).Get([]byte(req.Message))
+	return &testdata.EchoResponse{Message: req.Message}, nil
+}
+
+func TestABCI_QueryLimits(t *testing.T) {
+	testCases := []struct {
+		name    string
+		opts    []func(*baseapp.BaseApp)
+		message string
+		expErr  *errorsmod.Error
+	}{
+		{
+			name:    "no limits",
+			message: "hello",
+		},
+		{
+			name:    "within limits",
+			opts:    []func(*baseapp.BaseApp){baseapp.SetQueryGasLimit(100000), baseapp.SetQueryMaxResponseBytes(100)},
+			message: "hello",
+		},
+		{
+			name:    "out of gas",
+			opts:    []func(*baseapp.BaseApp){baseapp.SetQueryGasLimit(10)},
+			message: "hello",
+			expErr:  sdkerrors.ErrOutOfGas,
+		},
+		{
+			name:    "response too large",
+			opts:    []func(*baseapp.BaseApp){baseapp.SetQueryMaxResponseBytes(10)},
+			message: "hello, this message is too long",
+			expErr:  sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			grpcQueryOpt := func(bapp *baseapp.BaseApp) {
+				testdata.RegisterQueryServer(bapp.GRPCQueryRouter(), storeQueryImpl{})
+			}
+
+			suite := NewBaseAppSuite(t, append(tc.opts, grpcQueryOpt)...)
+			suite.baseApp.InitChain(abci.RequestInitChain{ConsensusParams: &cmtproto.ConsensusParams{}})
+			suite.baseApp.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: 1}})
+			suite.baseApp.Commit()
+
+			reqBz, err := (&testdata.EchoRequest{Message: tc.message}).Marshal()
+			require.NoError(t, err)
+
+			res := suite.baseApp.Query(abci.RequestQuery{Data: reqBz, Path: "/testpb.Query/Echo"})
+			if tc.expErr != nil {
+				require.Equal(t, tc.expErr.ABCICode(), res.Code, res)
+				require.Equal(t, tc.expErr.Codespace(), res.Codespace, res)
+				return
+			}
+
+			require.Equal(t, abci.CodeTypeOK, res.Code, res)
+		})
+	}
+}
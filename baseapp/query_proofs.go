@@ -0,0 +1,141 @@
+package baseapp
+
+import (
+	"fmt"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// storeRead identifies a key read by a query in a store.
+type storeRead struct {
+	storeName string
+	key       string
+}
+
+// readRecorder records the keys read by a query, in the order of their first
+// read.
+type readRecorder struct {
+	mtx   sync.Mutex
+	seen  map[storeRead]struct{}
+	reads []storeRead
+}
+
+func newReadRecorder() *readRecorder {
+	return &readRecorder{seen: make(map[storeRead]struct{})}
+}
+
+func (r *readRecorder) record(storeName string, key []byte) {
+	read := storeRead{storeName: storeName, key: string(key)}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if _, ok := r.seen[read]; ok {
+		return
+	}
+
+	r.seen[read] = struct{}{}
+	r.reads = append(r.reads, read)
+}
+
+// recordingMultiStore is a MultiStore recording the keys read from its KVStores.
+type recordingMultiStore struct {
+	storetypes.MultiStore
+
+	recorder *readRecorder
+}
+
+func (rms recordingMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	return recordingKVStore{
+		KVStore:   rms.MultiStore.GetKVStore(key),
+		storeName: key.Name(),
+		recorder:  rms.recorder,
+	}
+}
+
+// recordingKVStore is a KVStore recording the keys read from it. The keys of
+// the iterators are recorded as they are read, hence the proofs of a range only
+// prove the existence of the keys iterated over, not the absence of others.
+type recordingKVStore struct {
+	storetypes.KVStore
+
+	storeName string
+	recorder  *readRecorder
+}
+
+func (rs recordingKVStore) Get(key []byte) []byte {
+	rs.recorder.record(rs.storeName, key)
+	return rs.KVStore.Get(key)
+}
+
+func (rs recordingKVStore) Has(key []byte) bool {
+	rs.recorder.record(rs.storeName, key)
+	return rs.KVStore.Has(key)
+}
+
+func (rs recordingKVStore) Iterator(start, end []byte) storetypes.Iterator {
+	return recordingIterator{Iterator: rs.KVStore.Iterator(start, end), store: rs}
+}
+
+func (rs recordingKVStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return recordingIterator{Iterator: rs.KVStore.ReverseIterator(start, end), store: rs}
+}
+
+// recordingIterator is an Iterator recording the keys it reads.
+type recordingIterator struct {
+	storetypes.Iterator
+
+	store recordingKVStore
+}
+
+func (ri recordingIterator) Key() []byte {
+	key := ri.Iterator.Key()
+	ri.store.recorder.record(ri.store.storeName, key)
+	return key
+}
+
+func (ri recordingIterator) Value() []byte {
+	ri.store.recorder.record(ri.store.storeName, ri.Iterator.Key())
+	return ri.Iterator.Value()
+}
+
+// proveReads returns the marshaled ProofOps proving the value, or absence, of
+// each of the recorded keys at the given height against the app hash.
+func (app *BaseApp) proveReads(recorder *readRecorder, height int64) ([][]byte, error) {
+	queryable, ok := app.cms.(storetypes.Queryable)
+	if !ok {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "multistore doesn't support proofs")
+	}
+
+	recorder.mtx.Lock()
+	reads := recorder.reads
+	recorder.mtx.Unlock()
+
+	proofs := make([][]byte, 0, len(reads))
+	for _, read := range reads {
+		res := queryable.Query(abci.RequestQuery{
+			Path:   fmt.Sprintf("/%s/key", read.storeName),
+			Data:   []byte(read.key),
+			Height: height,
+			Prove:  true,
+		})
+		if !res.IsOK() {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "failed to prove key %X of store %s: %s", read.key, read.storeName, res.Log)
+		}
+
+		bz, err := res.ProofOps.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		proofs = append(proofs, bz)
+	}
+
+	return proofs, nil
+}
@@ -0,0 +1,72 @@
+package baseapp
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+)
+
+func TestProveReads(t *testing.T) {
+	key := storetypes.NewKVStoreKey("proofs")
+	app := NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB(), nil)
+	app.MountStores(key)
+	require.NoError(t, app.LoadLatestVersion())
+
+	app.InitChain(abci.RequestInitChain{})
+	for height := int64(1); height <= 2; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: cmtproto.Header{Height: height}})
+		app.deliverState.ctx.KVStore(key).Set([]byte("a"), []byte("1"))
+		app.deliverState.ctx.KVStore(key).Set([]byte("c"), []byte("3"))
+		app.Commit()
+	}
+
+	ctx, err := app.CreateQueryContext(2, true)
+	require.NoError(t, err)
+
+	recorder := newReadRecorder()
+	ctx = ctx.WithMultiStore(recordingMultiStore{MultiStore: ctx.MultiStore(), recorder: recorder})
+
+	store := ctx.KVStore(key)
+	require.Equal(t, []byte("1"), store.Get([]byte("a")))
+	require.False(t, store.Has([]byte("b")))
+	iter := store.Iterator([]byte("b"), nil)
+	require.Equal(t, []byte("3"), iter.Value())
+	require.NoError(t, iter.Close())
+
+	proofs, err := app.proveReads(recorder, 2)
+	require.NoError(t, err)
+	require.Len(t, proofs, 3)
+
+	appHash := app.LastCommitID().Hash
+	prt := rootmulti.DefaultProofRuntime()
+	for i, tc := range []struct {
+		key   string
+		value []byte
+	}{
+		{"a", []byte("1")},
+		{"b", nil},
+		{"c", []byte("3")},
+	} {
+		var proofOps cmtprotocrypto.ProofOps
+		require.NoError(t, proofOps.Unmarshal(proofs[i]))
+
+		kp := merkle.KeyPath{}
+		kp = kp.AppendKey([]byte(key.Name()), merkle.KeyEncodingURL)
+		kp = kp.AppendKey([]byte(tc.key), merkle.KeyEncodingURL)
+
+		if tc.value == nil {
+			require.NoError(t, prt.VerifyAbsence(&proofOps, appHash, kp.String()))
+		} else {
+			require.NoError(t, prt.VerifyValue(&proofOps, appHash, kp.String(), tc.value))
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package baseapp
 
 import (
+	"errors"
 	"fmt"
 	"runtime/debug"
 
@@ -16,6 +17,29 @@ import (
 // Return nil if recoveryObj was not processed.
 type RecoveryHandler func(recoveryObj interface{}) error
 
+// NewPanicRecoveryHandler returns a RecoveryHandler converting the panics
+// matched by the given classifier into the given typed error, so that they are
+// reported with the codespace and code of that error instead of being redacted
+// into an internal error.
+func NewPanicRecoveryHandler(match func(recoveryObj interface{}) bool, err *errorsmod.Error) RecoveryHandler {
+	return func(recoveryObj interface{}) error {
+		if !match(recoveryObj) {
+			return nil
+		}
+
+		return errorsmod.Wrapf(err, "recovered: %v", recoveryObj)
+	}
+}
+
+// MatchPanicError returns a panic classifier matching the panics whose value
+// is an error wrapping the given target error.
+func MatchPanicError(target error) func(recoveryObj interface{}) bool {
+	return func(recoveryObj interface{}) bool {
+		err, ok := recoveryObj.(error)
+		return ok && errors.Is(err, target)
+	}
+}
+
 // recoveryMiddleware is wrapper for RecoveryHandler to create chained recovery handling.
 // returns (recoveryMiddleware, nil) if recoveryObj was not processed and should be passed to the next middleware in chain.
 // returns (nil, error) if recoveryObj was processed and middleware chain processing should be stopped.
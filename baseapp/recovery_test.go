@@ -1,10 +1,13 @@
 package baseapp
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
 )
 
 // Test that recovery chain produces expected error at specific middleware layer
@@ -62,3 +65,13 @@ func TestRecoveryChain(t *testing.T) {
 		require.Nil(t, receivedErr)
 	}
 }
+
+func TestPanicRecoveryHandler(t *testing.T) {
+	target := errors.New("target")
+	typedErr := errorsmod.Register("recoveryTest", 2, "typed error")
+	handler := NewPanicRecoveryHandler(MatchPanicError(target), typedErr)
+
+	require.NoError(t, handler("not an error"))
+	require.NoError(t, handler(errors.New("other")))
+	require.ErrorIs(t, handler(fmt.Errorf("wrapped: %w", target)), typedErr)
+}
@@ -97,7 +97,7 @@ func TestABCI_MultiListener_StateChanges(t *testing.T) {
 			require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
 
 			events := res.GetEvents()
-			require.Len(t, events, 3, "should contain ante handler, message type and counter events respectively")
+			require.Len(t, events, 4, "should contain ante handler, message type, counter and msg gas events respectively")
 			require.Equal(t, sdk.MarkEventsToIndex(counterEvent("ante_handler", counter).ToABCIEvents(), map[string]struct{}{})[0], events[0], "ante handler event")
 			require.Equal(t, sdk.MarkEventsToIndex(counterEvent(sdk.EventTypeMessage, counter).ToABCIEvents(), map[string]struct{}{})[0].Attributes[0], events[2].Attributes[0], "msg handler update counter event")
 		}
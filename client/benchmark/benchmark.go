@@ -0,0 +1,318 @@
+package benchmark
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// DefaultCommitTimeout is the default time a tx is waited for to be
+	// committed.
+	DefaultCommitTimeout = 30 * time.Second
+	// DefaultPollInterval is the default interval at which the node is queried
+	// for the commit of a tx.
+	DefaultPollInterval = 100 * time.Millisecond
+)
+
+// Config configures a load run.
+type Config struct {
+	// TPS is the number of txs sent per second.
+	TPS int
+	// Duration is the duration of the run.
+	Duration time.Duration
+	// Amount is the amount sent by each bank transfer.
+	Amount sdk.Coins
+	// CommitTimeout is how long a tx is waited for to be committed, after
+	// which it is counted as failed. Defaults to DefaultCommitTimeout.
+	CommitTimeout time.Duration
+	// PollInterval is the interval at which the node is queried for the
+	// commit of a tx, which bounds the precision of the latencies. Defaults to
+	// DefaultPollInterval.
+	PollInterval time.Duration
+}
+
+// Account is an account of the pool of test keys sending the load.
+type Account struct {
+	Name     string
+	Address  sdk.AccAddress
+	Number   uint64
+	Sequence uint64
+}
+
+// Report is the report of a load run.
+type Report struct {
+	// Sent is the number of txs sent to the node, which either succeeded or
+	// failed.
+	Sent int
+	// Succeeded is the number of txs committed successfully.
+	Succeeded int
+	// Failed is the number of txs rejected by the node, committed with an
+	// error or not committed before the commit timeout.
+	Failed int
+	// Skipped is the number of txs which were not sent because all the
+	// accounts of the pool were waiting for the commit of their previous tx.
+	Skipped int
+	Elapsed time.Duration
+	// Latencies holds the times from the broadcast to the commit of the
+	// successful txs, sorted.
+	Latencies []time.Duration
+	// Failures counts the failed txs by cause.
+	Failures map[string]int
+}
+
+// TPS returns the number of txs committed successfully per second.
+func (r Report) TPS() float64 {
+	if r.Elapsed <= 0 {
+		return 0
+	}
+
+	return float64(r.Succeeded) / r.Elapsed.Seconds()
+}
+
+// Percentile returns the q-th percentile, with q in [0, 1], of the commit
+// latencies of the successful txs.
+func (r Report) Percentile(q float64) time.Duration {
+	if len(r.Latencies) == 0 {
+		return 0
+	}
+
+	i := int(math.Ceil(q*float64(len(r.Latencies)))) - 1
+	if i < 0 {
+		i = 0
+	}
+
+	return r.Latencies[i]
+}
+
+// String implements fmt.Stringer.
+func (r Report) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "sent:      %d txs in %s (%.2f committed txs/s)\n", r.Sent, r.Elapsed.Round(time.Millisecond), r.TPS())
+	fmt.Fprintf(&sb, "succeeded: %d\n", r.Succeeded)
+	fmt.Fprintf(&sb, "failed:    %d\n", r.Failed)
+	if r.Skipped > 0 {
+		fmt.Fprintf(&sb, "skipped:   %d (no idle account in the pool, increase the number of accounts)\n", r.Skipped)
+	}
+	fmt.Fprintf(&sb, "latency:   p50=%s p90=%s p99=%s max=%s\n",
+		r.Percentile(0.5), r.Percentile(0.9), r.Percentile(0.99), r.Percentile(1))
+
+	if len(r.Failures) > 0 {
+		causes := make([]string, 0, len(r.Failures))
+		for cause := range r.Failures {
+			causes = append(causes, cause)
+		}
+		sort.Slice(causes, func(i, j int) bool {
+			if r.Failures[causes[i]] != r.Failures[causes[j]] {
+				return r.Failures[causes[i]] > r.Failures[causes[j]]
+			}
+			return causes[i] < causes[j]
+		})
+
+		sb.WriteString("failures:\n")
+		for _, cause := range causes {
+			fmt.Fprintf(&sb, "  %d\t%s\n", r.Failures[cause], cause)
+		}
+	}
+
+	return sb.String()
+}
+
+// Run sends bank transfers between the accounts of the pool, signed with their
+// keys in the keyring of txf, at the configured rate until the configured
+// duration elapses or ctx is done, and measures the time from the broadcast of
+// each tx to its commit by polling the node. Each account sends one tx at a
+// time, to the next account of the pool, and waits for its commit before
+// sending the next one, so the pool must hold at least the rate times the block
+// time accounts.
+func Run(ctx context.Context, clientCtx client.Context, txf tx.Factory, accounts []*Account, cfg Config) (Report, error) {
+	if cfg.TPS <= 0 {
+		return Report{}, fmt.Errorf("tps must be positive, got %d", cfg.TPS)
+	}
+	if len(accounts) < 2 {
+		return Report{}, fmt.Errorf("at least 2 accounts are required, got %d", len(accounts))
+	}
+	if cfg.CommitTimeout <= 0 {
+		cfg.CommitTimeout = DefaultCommitTimeout
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = DefaultPollInterval
+	}
+
+	var (
+		mu     sync.Mutex
+		report = Report{Failures: make(map[string]int)}
+		wg     sync.WaitGroup
+	)
+	record := func(latency time.Duration, cause string) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		report.Sent++
+		if cause == "" {
+			report.Succeeded++
+			report.Latencies = append(report.Latencies, latency)
+		} else {
+			report.Failed++
+			report.Failures[cause]++
+		}
+	}
+
+	// each account is used by a single worker, so that its txs are sent in
+	// the order of their sequences
+	workers := make([]chan struct{}, len(accounts))
+	for i := range accounts {
+		workers[i] = make(chan struct{}, 1)
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			to := accounts[(i+1)%len(accounts)].Address
+			for range workers[i] {
+				record(sendTx(ctx, clientCtx, txf, accounts[i], to, cfg))
+			}
+		}(i)
+	}
+
+	ticker := time.NewTicker(time.Second / time.Duration(cfg.TPS))
+	defer ticker.Stop()
+
+	start := time.Now()
+	deadline := time.NewTimer(cfg.Duration)
+	defer deadline.Stop()
+
+	next := 0
+load:
+	for {
+		select {
+		case <-ctx.Done():
+			break load
+		case <-deadline.C:
+			break load
+		case <-ticker.C:
+		}
+
+		dispatched := false
+		for j := 0; j < len(workers) && !dispatched; j++ {
+			select {
+			case workers[(next+j)%len(workers)] <- struct{}{}:
+				next = (next + j + 1) % len(workers)
+				dispatched = true
+			default:
+			}
+		}
+		if !dispatched {
+			mu.Lock()
+			report.Skipped++
+			mu.Unlock()
+		}
+	}
+
+	for _, w := range workers {
+		close(w)
+	}
+	wg.Wait()
+
+	report.Elapsed = time.Since(start)
+	sort.Slice(report.Latencies, func(i, j int) bool { return report.Latencies[i] < report.Latencies[j] })
+
+	return report, nil
+}
+
+// sendTx signs and broadcasts a bank transfer from acc to the address to, and
+// waits for its commit. It returns the time from the broadcast to the commit of
+// the tx if it succeeded, or the cause of its failure.
+func sendTx(ctx context.Context, clientCtx client.Context, txf tx.Factory, acc *Account, to sdk.AccAddress, cfg Config) (time.Duration, string) {
+	txf = txf.WithAccountNumber(acc.Number).WithSequence(acc.Sequence)
+
+	txBuilder, err := txf.BuildUnsignedTx(banktypes.NewMsgSend(acc.Address, to, cfg.Amount))
+	if err != nil {
+		return 0, err.Error()
+	}
+
+	if err := tx.Sign(ctx, txf, acc.Name, txBuilder, true); err != nil {
+		return 0, err.Error()
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return 0, err.Error()
+	}
+
+	start := time.Now()
+	res, err := clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return 0, err.Error()
+	}
+
+	if res.Code != 0 {
+		if res.Codespace == sdkerrors.ErrWrongSequence.Codespace() && res.Code == sdkerrors.ErrWrongSequence.ABCICode() {
+			// resynchronize the sequence of the account with the node
+			if _, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, acc.Address); err == nil {
+				acc.Sequence = seq
+			}
+		}
+
+		return 0, failureCause(res.Codespace, res.Code)
+	}
+
+	// the tx was admitted in the mempool, with the next sequence of the account
+	acc.Sequence++
+
+	txRes, err := waitForCommit(ctx, clientCtx, res.TxHash, cfg)
+	if err != nil {
+		return 0, err.Error()
+	}
+	if txRes.Code != 0 {
+		return 0, failureCause(txRes.Codespace, txRes.Code)
+	}
+
+	return time.Since(start), ""
+}
+
+// waitForCommit polls the node for the tx of the given hash until it is
+// committed, the commit timeout elapses or ctx is done.
+func waitForCommit(ctx context.Context, clientCtx client.Context, txHash string, cfg Config) (*sdk.TxResponse, error) {
+	timeout := time.NewTimer(cfg.CommitTimeout)
+	defer timeout.Stop()
+
+	ticker := time.NewTicker(cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timeout.C:
+			// the error is not wrapped so that the failures are counted by cause
+			return nil, fmt.Errorf("tx not committed within %s", cfg.CommitTimeout)
+		case <-ticker.C:
+		}
+
+		if txRes, err := authtx.QueryTx(clientCtx, txHash); err == nil {
+			return txRes, nil
+		}
+	}
+}
+
+// failureCause returns the description of the error of the given codespace
+// and code, without the details of the failure of the tx so that the failures
+// can be counted by cause.
+func failureCause(codespace string, code uint32) string {
+	desc := strings.TrimPrefix(errorsmod.ABCIError(codespace, code, "").Error(), ": ")
+	return fmt.Sprintf("%s (codespace %s, code %d)", desc, codespace, code)
+}
@@ -0,0 +1,222 @@
+package benchmark_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/benchmark"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+func setup(t *testing.T, numAccounts int) (*clitestutil.ScriptedCometRPC, client.Context, tx.Factory, []*benchmark.Account) {
+	t.Helper()
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(bank.AppModuleBasic{})
+	kr := keyring.NewInMemory(encCfg.Codec)
+	node := clitestutil.NewScriptedCometRPC()
+
+	clientCtx := client.Context{}.
+		WithTxConfig(encCfg.TxConfig).
+		WithCodec(encCfg.Codec).
+		WithKeyring(kr).
+		WithClient(node).
+		WithAccountRetriever(client.MockAccountRetriever{}).
+		WithBroadcastMode(flags.BroadcastSync).
+		WithChainID("test-chain")
+
+	txf := tx.Factory{}.
+		WithTxConfig(encCfg.TxConfig).
+		WithKeybase(kr).
+		WithChainID("test-chain").
+		WithGas(200000).
+		WithFees("10stake")
+
+	accounts := make([]*benchmark.Account, numAccounts)
+	for i := range accounts {
+		name := fmt.Sprintf("load-%d", i)
+		record, _, err := kr.NewMnemonic(name, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+		addr, err := record.GetAddress()
+		require.NoError(t, err)
+		accounts[i] = &benchmark.Account{Name: name, Address: addr, Number: uint64(i)}
+	}
+
+	return node, clientCtx, txf, accounts
+}
+
+// commitTxs commits the txs broadcasted to the node in a new block every 20ms,
+// with the given result, until the test ends.
+func commitTxs(t *testing.T, node *clitestutil.ScriptedCometRPC, res abci.ResponseDeliverTx) {
+	t.Helper()
+
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	t.Cleanup(func() {
+		close(done)
+		<-stopped
+	})
+
+	go func() {
+		defer close(stopped)
+
+		ticker := time.NewTicker(20 * time.Millisecond)
+		defer ticker.Stop()
+
+		height, committed := int64(1), 0
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+
+			txs := node.BroadcastedTxs()[committed:]
+			results := make([]*abci.ResponseDeliverTx, len(txs))
+			for i := range results {
+				res := res
+				results[i] = &res
+			}
+			node.AddBlock(cmttypes.MakeBlock(height, txs, nil, nil), results...)
+			height++
+			committed += len(txs)
+		}
+	}()
+}
+
+func TestRun(t *testing.T) {
+	node, clientCtx, txf, accounts := setup(t, 3)
+	commitTxs(t, node, abci.ResponseDeliverTx{})
+
+	report, err := benchmark.Run(context.Background(), clientCtx, txf, accounts, benchmark.Config{
+		TPS:          400,
+		Duration:     300 * time.Millisecond,
+		Amount:       sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+		PollInterval: 5 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	require.NotZero(t, report.Succeeded)
+	require.Zero(t, report.Failed)
+	require.Empty(t, report.Failures)
+	require.Equal(t, report.Sent, report.Succeeded)
+	require.Len(t, node.BroadcastedTxs(), report.Succeeded)
+	require.Len(t, report.Latencies, report.Succeeded)
+	// the 3 accounts wait for the commit of their txs, every 20ms, so they
+	// cannot send more than 150 txs/s and ticks are skipped
+	require.NotZero(t, report.Skipped)
+	require.Contains(t, report.String(), fmt.Sprintf("skipped:   %d", report.Skipped))
+	for _, latency := range report.Latencies {
+		require.Greater(t, latency, time.Duration(0))
+	}
+
+	// the sequences of the accounts are incremented by their successful txs
+	var sequences uint64
+	for _, acc := range accounts {
+		sequences += acc.Sequence
+	}
+	require.Equal(t, uint64(report.Succeeded), sequences)
+}
+
+func TestRunFailures(t *testing.T) {
+	node, clientCtx, txf, accounts := setup(t, 2)
+	node.SetBroadcastResponse(&coretypes.ResultBroadcastTx{
+		Code:      sdkerrors.ErrInsufficientFee.ABCICode(),
+		Codespace: sdkerrors.ErrInsufficientFee.Codespace(),
+		Log:       "insufficient fees; got: 10stake required: 20stake: insufficient fee",
+	})
+
+	report, err := benchmark.Run(context.Background(), clientCtx, txf, accounts, benchmark.Config{
+		TPS:      50,
+		Duration: 200 * time.Millisecond,
+		Amount:   sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+	})
+	require.NoError(t, err)
+
+	require.Zero(t, report.Succeeded)
+	require.NotZero(t, report.Failures["insufficient fee (codespace sdk, code 13)"])
+	require.Equal(t, len(node.BroadcastedTxs()), report.Failures["insufficient fee (codespace sdk, code 13)"])
+	for _, acc := range accounts {
+		require.Zero(t, acc.Sequence)
+	}
+	require.Contains(t, report.String(), "insufficient fee (codespace sdk, code 13)")
+
+	_, err = benchmark.Run(context.Background(), clientCtx, txf, accounts[:1], benchmark.Config{TPS: 1, Duration: time.Second})
+	require.ErrorContains(t, err, "at least 2 accounts are required")
+}
+
+func TestRunCommitFailures(t *testing.T) {
+	node, clientCtx, txf, accounts := setup(t, 2)
+	commitTxs(t, node, abci.ResponseDeliverTx{
+		Code:      sdkerrors.ErrInsufficientFunds.ABCICode(),
+		Codespace: sdkerrors.ErrInsufficientFunds.Codespace(),
+	})
+
+	report, err := benchmark.Run(context.Background(), clientCtx, txf, accounts, benchmark.Config{
+		TPS:          50,
+		Duration:     200 * time.Millisecond,
+		Amount:       sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+		PollInterval: 5 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	require.Zero(t, report.Succeeded)
+	require.Empty(t, report.Latencies)
+	require.Equal(t, len(node.BroadcastedTxs()), report.Failures["insufficient funds (codespace sdk, code 5)"])
+
+	// the txs failed after being admitted in the mempool, so the sequences
+	// were incremented
+	var sequences uint64
+	for _, acc := range accounts {
+		sequences += acc.Sequence
+	}
+	require.Equal(t, uint64(report.Failed), sequences)
+}
+
+func TestRunCommitTimeout(t *testing.T) {
+	node, clientCtx, txf, accounts := setup(t, 2)
+
+	report, err := benchmark.Run(context.Background(), clientCtx, txf, accounts, benchmark.Config{
+		TPS:           50,
+		Duration:      100 * time.Millisecond,
+		Amount:        sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+		CommitTimeout: 50 * time.Millisecond,
+		PollInterval:  5 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	require.Zero(t, report.Succeeded)
+	require.NotZero(t, report.Failed)
+	require.Equal(t, len(node.BroadcastedTxs()), report.Failures["tx not committed within 50ms"])
+}
+
+func TestReportPercentile(t *testing.T) {
+	report := benchmark.Report{Sent: 100, Succeeded: 100, Elapsed: 10 * time.Second}
+	for i := 1; i <= 100; i++ {
+		report.Latencies = append(report.Latencies, time.Duration(i)*time.Millisecond)
+	}
+
+	require.Equal(t, 50*time.Millisecond, report.Percentile(0.5))
+	require.Equal(t, 99*time.Millisecond, report.Percentile(0.99))
+	require.Equal(t, 100*time.Millisecond, report.Percentile(1))
+	require.Equal(t, time.Millisecond, report.Percentile(0))
+	require.Equal(t, float64(10), report.TPS())
+	require.Contains(t, report.String(), "latency:   p50=50ms p90=90ms p99=99ms max=100ms")
+
+	require.Zero(t, benchmark.Report{}.Percentile(0.5))
+}
@@ -0,0 +1,237 @@
+package benchmark
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	FlagTPS       = "tps"
+	FlagDuration  = "duration"
+	FlagAccounts  = "accounts"
+	FlagKeyPrefix = "key-prefix"
+	FlagAmount    = "amount"
+	FlagFund      = "fund"
+
+	FlagCommitTimeout = "commit-timeout"
+	FlagPollInterval  = "poll-interval"
+)
+
+const (
+	// fundTimeout is how long the funding tx of the pool is waited for.
+	fundTimeout = time.Minute
+	// fundGasAdjustment is the adjustment of the simulated gas of the funding
+	// tx, whose gas grows with the size of the pool.
+	fundGasAdjustment = 1.5
+)
+
+// Cmd returns the benchmark commands.
+func Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "benchmark",
+		Short: "Benchmark a node",
+	}
+
+	cmd.AddCommand(SendLoadCmd())
+
+	return cmd
+}
+
+// SendLoadCmd returns a command sending bank transfers to a node at a given
+// rate, and reporting the commit latencies and the causes of the failures.
+func SendLoadCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-load",
+		Short: "Send bank transfers to a node at a given rate and report the latencies and failures",
+		Long: `Generate, sign and broadcast bank transfers between the accounts of a pool of test keys
+against the node, at a given rate for a given duration. Each account waits for the commit of its
+tx, polled every --poll-interval, before sending the next one, so the pool must hold at least the
+rate times the block time accounts. The percentiles of the times from the broadcast of the txs to
+their commit and the failed txs by cause are reported, for capacity planning.
+
+The keys of the pool, named <key-prefix>-<i>, are created in the keyring if they don't exist. With
+--fund, each account of the pool is first funded by the --from account.`,
+		Example: fmt.Sprintf(`$ %[1]s benchmark send-load --tps 50 --duration 1m --accounts 100 --from validator --fund 1000000stake --keyring-backend test --fees 10stake
+$ %[1]s benchmark send-load --tps 100 --duration 5m --accounts 100 --keyring-backend test --fees 10stake`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var cfg Config
+			cfg.TPS, _ = cmd.Flags().GetInt(FlagTPS)
+			cfg.Duration, _ = cmd.Flags().GetDuration(FlagDuration)
+			cfg.CommitTimeout, _ = cmd.Flags().GetDuration(FlagCommitTimeout)
+			cfg.PollInterval, _ = cmd.Flags().GetDuration(FlagPollInterval)
+			amount, _ := cmd.Flags().GetString(FlagAmount)
+			if cfg.Amount, err = sdk.ParseCoinsNormalized(amount); err != nil {
+				return err
+			}
+
+			numAccounts, _ := cmd.Flags().GetInt(FlagAccounts)
+			keyPrefix, _ := cmd.Flags().GetString(FlagKeyPrefix)
+			accounts, err := poolAccounts(clientCtx.Keyring, keyPrefix, numAccounts)
+			if err != nil {
+				return err
+			}
+
+			if fund, _ := cmd.Flags().GetString(FlagFund); fund != "" {
+				coins, err := sdk.ParseCoinsNormalized(fund)
+				if err != nil {
+					return err
+				}
+
+				if err := fundAccounts(cmd.Context(), clientCtx, txf, accounts, coins); err != nil {
+					return err
+				}
+			}
+
+			for _, acc := range accounts {
+				acc.Number, acc.Sequence, err = clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, acc.Address)
+				if err != nil {
+					return fmt.Errorf("failed to get the account of key %s, fund the pool with --%s: %w", acc.Name, FlagFund, err)
+				}
+			}
+
+			cmd.PrintErrf("sending %d txs/s for %s from %d accounts\n", cfg.TPS, cfg.Duration, len(accounts))
+			report, err := Run(cmd.Context(), clientCtx, txf, accounts, cfg)
+			if err != nil {
+				return err
+			}
+
+			cmd.Print(report.String())
+			return nil
+		},
+	}
+
+	cmd.Flags().Int(FlagTPS, 10, "Number of txs sent per second")
+	cmd.Flags().Duration(FlagDuration, time.Minute, "Duration of the load")
+	cmd.Flags().Int(FlagAccounts, 10, "Number of accounts of the pool sending the txs")
+	cmd.Flags().String(FlagKeyPrefix, "load", "Prefix of the names of the keys of the pool")
+	cmd.Flags().String(FlagAmount, fmt.Sprintf("1%s", sdk.DefaultBondDenom), "Amount sent by each tx")
+	cmd.Flags().String(FlagFund, "", "Amount sent to each account of the pool by the --from account before the load")
+	cmd.Flags().Duration(FlagCommitTimeout, DefaultCommitTimeout, "Time a tx is waited for to be committed before being counted as failed")
+	cmd.Flags().Duration(FlagPollInterval, DefaultPollInterval, "Interval at which the node is queried for the commit of a tx")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// poolAccounts returns the accounts of the keys of the pool, creating the keys
+// which don't exist.
+func poolAccounts(kr keyring.Keyring, keyPrefix string, n int) ([]*Account, error) {
+	accounts := make([]*Account, n)
+	for i := range accounts {
+		name := fmt.Sprintf("%s-%d", keyPrefix, i)
+
+		record, err := kr.Key(name)
+		if err != nil {
+			if !errors.Is(err, sdkerrors.ErrKeyNotFound) {
+				return nil, err
+			}
+
+			record, _, err = kr.NewMnemonic(name, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		addr, err := record.GetAddress()
+		if err != nil {
+			return nil, err
+		}
+
+		accounts[i] = &Account{Name: name, Address: addr}
+	}
+
+	return accounts, nil
+}
+
+// fundAccounts sends coins to each account of the pool from the --from account
+// of clientCtx, and waits for the funding tx to be committed.
+func fundAccounts(ctx context.Context, clientCtx client.Context, txf tx.Factory, accounts []*Account, coins sdk.Coins) error {
+	if clientCtx.FromAddress.Empty() {
+		return fmt.Errorf("--%s is required to fund the pool", flags.FlagFrom)
+	}
+
+	outputs := make([]banktypes.Output, len(accounts))
+	total := sdk.NewCoins()
+	for i, acc := range accounts {
+		outputs[i] = banktypes.NewOutput(acc.Address, coins)
+		total = total.Add(coins...)
+	}
+	msg := banktypes.NewMsgMultiSend(banktypes.NewInput(clientCtx.FromAddress, total), outputs)
+
+	txf, err := txf.Prepare(clientCtx)
+	if err != nil {
+		return err
+	}
+
+	_, gas, err := tx.CalculateGas(clientCtx, txf.WithGasAdjustment(fundGasAdjustment), msg)
+	if err != nil {
+		return err
+	}
+
+	txBuilder, err := txf.WithGas(gas).BuildUnsignedTx(msg)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Sign(ctx, txf, clientCtx.FromName, txBuilder, true); err != nil {
+		return err
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return err
+	}
+
+	res, err := clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return err
+	}
+	if res.Code != 0 {
+		return fmt.Errorf("failed to fund the pool: %s", res.RawLog)
+	}
+
+	// wait for the funding tx to be committed
+	deadline := time.Now().Add(fundTimeout)
+	for {
+		if txRes, err := authtx.QueryTx(clientCtx, res.TxHash); err == nil {
+			if txRes.Code != 0 {
+				return fmt.Errorf("failed to fund the pool: %s", txRes.RawLog)
+			}
+			return nil
+		} else if time.Now().After(deadline) {
+			return fmt.Errorf("funding tx %s was not committed after %s: %w", res.TxHash, fundTimeout, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+}
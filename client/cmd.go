@@ -1,11 +1,14 @@
 package client
 
 import (
+	"context"
 	"crypto/tls"
+	"encoding/hex"
 	"fmt"
 	"strings"
 
 	"github.com/cockroachdb/errors"
+	"github.com/cometbft/cometbft/light"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"google.golang.org/grpc"
@@ -196,7 +199,60 @@ func readQueryCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context,
 		clientCtx = clientCtx.WithUseLedger(useLedger)
 	}
 
-	return ReadPersistentCommandFlags(clientCtx, flagSet)
+	clientCtx, err := ReadPersistentCommandFlags(clientCtx, flagSet)
+	if err != nil {
+		return clientCtx, err
+	}
+
+	return readLightClientFlags(clientCtx, flagSet)
+}
+
+// readLightClientFlags returns an updated Context with a light client set up
+// from the trust flags defined in AddQueryFlagsToCmd. It must be called once
+// the node URI and chain ID of the context are known.
+func readLightClientFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, error) {
+	if clientCtx.LightClient != nil && !flagSet.Changed(flags.FlagTrustHeight) && !flagSet.Changed(flags.FlagTrustHash) {
+		return clientCtx, nil
+	}
+
+	trustHeight, _ := flagSet.GetInt64(flags.FlagTrustHeight)
+	trustHashStr, _ := flagSet.GetString(flags.FlagTrustHash)
+	if trustHeight == 0 && trustHashStr == "" {
+		return clientCtx, nil
+	}
+
+	if trustHeight <= 0 || trustHashStr == "" {
+		return clientCtx, fmt.Errorf("--%s and --%s must be set together", flags.FlagTrustHeight, flags.FlagTrustHash)
+	}
+
+	trustHash, err := hex.DecodeString(trustHashStr)
+	if err != nil {
+		return clientCtx, fmt.Errorf("invalid --%s: %w", flags.FlagTrustHash, err)
+	}
+
+	trustingPeriod, _ := flagSet.GetDuration(flags.FlagTrustingPeriod)
+	if trustingPeriod == 0 {
+		trustingPeriod = DefaultTrustingPeriod
+	}
+
+	witnesses, _ := flagSet.GetStringSlice(flags.FlagWitnesses)
+
+	lc, err := NewLightClient(context.Background(), LightClientConfig{
+		ChainID:   clientCtx.ChainID,
+		NodeURI:   clientCtx.NodeURI,
+		HomeDir:   clientCtx.HomeDir,
+		Witnesses: witnesses,
+		TrustOptions: light.TrustOptions{
+			Period: trustingPeriod,
+			Height: trustHeight,
+			Hash:   trustHash,
+		},
+	})
+	if err != nil {
+		return clientCtx, err
+	}
+
+	return clientCtx.WithLightClient(lc), nil
 }
 
 // readTxCommandFlags returns an updated Context with fields set based on flags
@@ -240,6 +296,11 @@ func readTxCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, err
 		clientCtx = clientCtx.WithSkipConfirmation(skipConfirm)
 	}
 
+	if !clientCtx.Confirm || flagSet.Changed(flags.FlagConfirm) {
+		confirm, _ := flagSet.GetBool(flags.FlagConfirm)
+		clientCtx = clientCtx.WithConfirm(confirm)
+	}
+
 	if clientCtx.SignModeStr == "" || flagSet.Changed(flags.FlagSignMode) {
 		signModeStr, _ := flagSet.GetString(flags.FlagSignMode)
 		clientCtx = clientCtx.WithSignModeStr(signModeStr)
@@ -249,7 +310,7 @@ func readTxCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, err
 		payer, _ := flagSet.GetString(flags.FlagFeePayer)
 
 		if payer != "" {
-			payerAcc, err := sdk.AccAddressFromBech32(payer)
+			payerAcc, err := clientCtx.GetAddressCodec().StringToBytes(payer)
 			if err != nil {
 				return clientCtx, err
 			}
@@ -262,7 +323,7 @@ func readTxCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, err
 		granter, _ := flagSet.GetString(flags.FlagFeeGranter)
 
 		if granter != "" {
-			granterAcc, err := sdk.AccAddressFromBech32(granter)
+			granterAcc, err := clientCtx.GetAddressCodec().StringToBytes(granter)
 			if err != nil {
 				return clientCtx, err
 			}
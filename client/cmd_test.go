@@ -119,3 +119,61 @@ func TestSetCmdClientContextHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestGetClientQueryContextTrustFlags(t *testing.T) {
+	testCases := []struct {
+		name   string
+		args   []string
+		expErr string
+	}{
+		{
+			"no trust flags",
+			[]string{},
+			"",
+		},
+		{
+			"trust height without hash",
+			[]string{fmt.Sprintf("--%s=10", flags.FlagTrustHeight)},
+			"must be set together",
+		},
+		{
+			"trust hash without height",
+			[]string{fmt.Sprintf("--%s=ABCD", flags.FlagTrustHash)},
+			"must be set together",
+		},
+		{
+			"invalid trust hash",
+			[]string{fmt.Sprintf("--%s=10", flags.FlagTrustHeight), fmt.Sprintf("--%s=zz", flags.FlagTrustHash)},
+			"invalid --trust-hash",
+		},
+		{
+			"missing chain ID",
+			[]string{fmt.Sprintf("--%s=10", flags.FlagTrustHeight), fmt.Sprintf("--%s=ABCD", flags.FlagTrustHash)},
+			"chain ID is required",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := &cobra.Command{
+				RunE: func(cmd *cobra.Command, _ []string) error {
+					_, err := client.GetClientQueryContext(cmd)
+					return err
+				},
+			}
+			flags.AddQueryFlagsToCmd(cmd)
+			_ = testutil.ApplyMockIODiscardOutErr(cmd)
+			cmd.SetArgs(tc.args)
+
+			ctx := context.WithValue(context.Background(), client.ClientContextKey, &client.Context{})
+			err := cmd.ExecuteContext(ctx)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
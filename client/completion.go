@@ -0,0 +1,121 @@
+package client
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+const (
+	// completionCacheDir is the directory, relative to the client home, in
+	// which the results of completion queries are cached.
+	completionCacheDir = "completion-cache"
+
+	// DefaultCompletionCacheTTL is the duration for which cached completion
+	// results are considered fresh.
+	DefaultCompletionCacheTTL = 10 * time.Minute
+)
+
+// CompletionFunc defines the signature of a cobra dynamic completion function.
+type CompletionFunc = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective)
+
+// AddCompletionsToCmd walks the command tree rooted at cmd and registers the
+// dynamic completions that are common to all commands, such as the key names
+// of the keyring for the --from flag.
+//
+// It must be called once all sub-commands have been added to cmd.
+func AddCompletionsToCmd(cmd *cobra.Command) {
+	if cmd.LocalFlags().Lookup(flags.FlagFrom) != nil {
+		_ = cmd.RegisterFlagCompletionFunc(flags.FlagFrom, KeyNameCompletion)
+	}
+
+	for _, subCmd := range cmd.Commands() {
+		AddCompletionsToCmd(subCmd)
+	}
+}
+
+// KeyNameCompletion is a CompletionFunc that suggests the names of the keys
+// stored in the keyring configured for cmd.
+func KeyNameCompletion(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	clientCtx, err := ReadPersistentCommandFlags(GetClientContextFromCmd(cmd), cmd.Flags())
+	if err != nil || clientCtx.Keyring == nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	records, err := clientCtx.Keyring.List()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	names := make([]string, 0, len(records))
+	for _, record := range records {
+		names = append(names, record.Name)
+	}
+
+	return FilterCompletions(names, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// FilterCompletions returns the candidates that start with toComplete.
+func FilterCompletions(candidates []string, toComplete string) []string {
+	var out []string
+	for _, c := range candidates {
+		if strings.HasPrefix(c, toComplete) {
+			out = append(out, c)
+		}
+	}
+
+	return out
+}
+
+// completionCacheEntry is the on-disk representation of cached completion
+// results.
+type completionCacheEntry struct {
+	Timestamp time.Time `json:"timestamp"`
+	Values    []string  `json:"values"`
+}
+
+// CachedCompletions returns the completion candidates stored under key in the
+// completion cache of the client home directory. When there is no entry or the
+// entry is older than ttl, fetch is called and its result is written back to
+// the cache. The cache is scoped by chain ID so that switching networks does
+// not return stale candidates.
+//
+// Caching is best effort: failures to read or write the cache only result in
+// fetch being called.
+func CachedCompletions(clientCtx Context, key string, ttl time.Duration, fetch func() ([]string, error)) ([]string, error) {
+	if clientCtx.HomeDir == "" {
+		return fetch()
+	}
+
+	chainID := clientCtx.ChainID
+	if chainID == "" {
+		chainID = "default"
+	}
+
+	path := filepath.Join(clientCtx.HomeDir, completionCacheDir, chainID, key+".json")
+	if bz, err := os.ReadFile(path); err == nil {
+		var entry completionCacheEntry
+		if err := json.Unmarshal(bz, &entry); err == nil && time.Since(entry.Timestamp) < ttl {
+			return entry.Values, nil
+		}
+	}
+
+	values, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	if bz, err := json.Marshal(completionCacheEntry{Timestamp: time.Now(), Values: values}); err == nil {
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err == nil {
+			_ = os.WriteFile(path, bz, 0o600)
+		}
+	}
+
+	return values, nil
+}
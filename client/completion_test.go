@@ -0,0 +1,93 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestFilterCompletions(t *testing.T) {
+	candidates := []string{"alice", "alfred", "bob"}
+
+	require.Equal(t, []string{"alice", "alfred"}, client.FilterCompletions(candidates, "al"))
+	require.Equal(t, candidates, client.FilterCompletions(candidates, ""))
+	require.Empty(t, client.FilterCompletions(candidates, "carol"))
+}
+
+func TestCachedCompletions(t *testing.T) {
+	clientCtx := client.Context{}.WithHomeDir(t.TempDir()).WithChainID("test-chain")
+
+	calls := 0
+	fetch := func() ([]string, error) {
+		calls++
+		return []string{"a", "b"}, nil
+	}
+
+	values, err := client.CachedCompletions(clientCtx, "key", client.DefaultCompletionCacheTTL, fetch)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, values)
+	require.Equal(t, 1, calls)
+
+	// the second call is served from the cache
+	values, err = client.CachedCompletions(clientCtx, "key", client.DefaultCompletionCacheTTL, fetch)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, values)
+	require.Equal(t, 1, calls)
+
+	// an expired entry is refreshed
+	_, err = client.CachedCompletions(clientCtx, "key", 0, fetch)
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+
+	// the cache is scoped by chain ID
+	_, err = client.CachedCompletions(clientCtx.WithChainID("other-chain"), "key", client.DefaultCompletionCacheTTL, fetch)
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+
+	// fetch errors are returned
+	_, err = client.CachedCompletions(clientCtx, "other-key", client.DefaultCompletionCacheTTL, func() ([]string, error) {
+		return nil, errors.New("fetch failed")
+	})
+	require.EqualError(t, err, "fetch failed")
+}
+
+func TestKeyNameCompletion(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	kr := keyring.NewInMemory(encCfg.Codec)
+	for _, name := range []string{"alice", "alfred", "bob"} {
+		_, _, err := kr.NewMnemonic(name, keyring.English, "m/44'/118'/0'/0/0", keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+	}
+
+	rootCmd := &cobra.Command{Use: "root"}
+	txCmd := &cobra.Command{Use: "send", Run: func(*cobra.Command, []string) {}}
+	flags.AddTxFlagsToCmd(txCmd)
+	rootCmd.AddCommand(txCmd)
+	client.AddCompletionsToCmd(rootCmd)
+
+	clientCtx := client.Context{}.WithKeyring(kr)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+	txCmd.SetContext(ctx)
+
+	names, directive := client.KeyNameCompletion(txCmd, nil, "al")
+	require.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+	require.ElementsMatch(t, []string{"alice", "alfred"}, names)
+
+	// the --from flag completes key names through cobra's completion command
+	out := &bytes.Buffer{}
+	rootCmd.SetOut(out)
+	rootCmd.SetArgs([]string{cobra.ShellCompRequestCmd, "send", "--" + flags.FlagFrom, "b"})
+	require.NoError(t, rootCmd.ExecuteContext(ctx))
+	require.Contains(t, out.String(), "bob")
+	require.NotContains(t, out.String(), "alice")
+}
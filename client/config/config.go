@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/configschema"
 )
 
 func DefaultConfig() *ClientConfig {
@@ -24,6 +25,8 @@ type ClientConfig struct {
 	Output         string `mapstructure:"output" json:"output"`
 	Node           string `mapstructure:"node" json:"node"`
 	BroadcastMode  string `mapstructure:"broadcast-mode" json:"broadcast-mode"`
+	FeeGranter     string `mapstructure:"fee-granter" json:"fee-granter"`
+	FeePayer       string `mapstructure:"fee-payer" json:"fee-payer"`
 }
 
 func (c *ClientConfig) SetChainID(chainID string) {
@@ -46,6 +49,14 @@ func (c *ClientConfig) SetBroadcastMode(broadcastMode string) {
 	c.BroadcastMode = broadcastMode
 }
 
+func (c *ClientConfig) SetFeeGranter(feeGranter string) {
+	c.FeeGranter = feeGranter
+}
+
+func (c *ClientConfig) SetFeePayer(feePayer string) {
+	c.FeePayer = feePayer
+}
+
 // ReadFromClientConfig reads values from client.toml file and updates them in client Context
 func ReadFromClientConfig(ctx client.Context) (client.Context, error) {
 	configPath := filepath.Join(ctx.HomeDir, "config")
@@ -71,6 +82,11 @@ func ReadFromClientConfig(ctx client.Context) (client.Context, error) {
 	if err != nil {
 		return ctx, fmt.Errorf("couldn't get client config: %v", err)
 	}
+
+	if err := configschema.Validate(&configschema.ClientConfig{}, conf); err != nil {
+		return ctx, fmt.Errorf("invalid client config: %w", err)
+	}
+
 	// we need to update KeyringDir field on Client Context first cause it is used in NewKeyringFromBackend
 	ctx = ctx.WithOutputFormat(conf.Output).
 		WithChainID(conf.ChainID).
@@ -93,5 +109,23 @@ func ReadFromClientConfig(ctx client.Context) (client.Context, error) {
 		WithClient(client).
 		WithBroadcastMode(conf.BroadcastMode)
 
+	if conf.FeeGranter != "" {
+		granter, err := ctx.GetAddressCodec().StringToBytes(conf.FeeGranter)
+		if err != nil {
+			return ctx, fmt.Errorf("invalid fee granter in client config: %w", err)
+		}
+
+		ctx = ctx.WithFeeGranterAddress(granter)
+	}
+
+	if conf.FeePayer != "" {
+		payer, err := ctx.GetAddressCodec().StringToBytes(conf.FeePayer)
+		if err != nil {
+			return ctx, fmt.Errorf("invalid fee payer in client config: %w", err)
+		}
+
+		ctx = ctx.WithFeePayerAddress(payer)
+	}
+
 	return ctx, nil
 }
@@ -3,6 +3,7 @@ package config_test
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -11,6 +12,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/configschema"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 )
@@ -92,3 +95,85 @@ func TestConfigCmdEnvFlag(t *testing.T) {
 		})
 	}
 }
+
+func TestReadFromClientConfigFeeGrant(t *testing.T) {
+	granter := sdk.AccAddress("granter_____________").String()
+	payer := sdk.AccAddress("payer_______________").String()
+
+	testCases := []struct {
+		name       string
+		config     string
+		expErr     string
+		expGranter string
+		expPayer   string
+	}{
+		{
+			"no fee granter nor payer",
+			"",
+			"",
+			"",
+			"",
+		},
+		{
+			"fee granter and payer",
+			fmt.Sprintf("fee-granter = %q\nfee-payer = %q\n", granter, payer),
+			"",
+			granter,
+			payer,
+		},
+		{
+			"invalid fee granter",
+			"fee-granter = \"invalid\"\n",
+			"invalid fee granter in client config",
+			"",
+			"",
+		},
+		{
+			"invalid fee payer",
+			"fee-payer = \"invalid\"\n",
+			"invalid fee payer in client config",
+			"",
+			"",
+		},
+		{
+			"invalid output",
+			"output = \"yaml\"\n",
+			"invalid client config: invalid output \"yaml\"",
+			"",
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			home := t.TempDir()
+			require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0o700))
+			require.NoError(t, os.WriteFile(filepath.Join(home, "config", "client.toml"), []byte("keyring-backend = \"test\"\n"+tc.config), 0o600))
+
+			clientCtx, err := config.ReadFromClientConfig(client.Context{}.WithHomeDir(home).WithViper(""))
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			if tc.expGranter == "" {
+				require.Nil(t, clientCtx.FeeGranter)
+			} else {
+				require.Equal(t, tc.expGranter, clientCtx.FeeGranter.String())
+			}
+
+			if tc.expPayer == "" {
+				require.Nil(t, clientCtx.FeePayer)
+			} else {
+				require.Equal(t, tc.expPayer, clientCtx.FeePayer.String())
+			}
+		})
+	}
+}
+
+func TestClientConfigSchema(t *testing.T) {
+	require.NoError(t, configschema.CheckStruct(&configschema.ClientConfig{}, config.ClientConfig{}))
+	require.NoError(t, configschema.Validate(&configschema.ClientConfig{}, config.DefaultConfig()))
+}
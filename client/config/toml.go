@@ -6,26 +6,13 @@ import (
 	"text/template"
 
 	"github.com/spf13/viper"
-)
-
-const defaultConfigTemplate = `# This is a TOML config file.
-# For more information, see https://github.com/toml-lang/toml
 
-###############################################################################
-###                           Client Configuration                            ###
-###############################################################################
+	"github.com/cosmos/cosmos-sdk/types/configschema"
+)
 
-# The network chain ID
-chain-id = "{{ .ChainID }}"
-# The keyring's backend, where the keys are stored (os|file|kwallet|pass|test|memory)
-keyring-backend = "{{ .KeyringBackend }}"
-# CLI output format (text|json)
-output = "{{ .Output }}"
-# <host>:<port> to CometBFT RPC interface for this chain
-node = "{{ .Node }}"
-# Transaction broadcasting mode (sync|async)
-broadcast-mode = "{{ .BroadcastMode }}"
-`
+// defaultConfigTemplate is generated from the client.toml schema, see
+// proto/cosmos/config/v1/client.proto.
+var defaultConfigTemplate = configschema.MustTemplate(&configschema.ClientConfig{})
 
 // writeConfigToFile parses defaultConfigTemplate, renders config using the template and writes it to
 // configFilePath.
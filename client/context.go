@@ -8,12 +8,14 @@ import (
 	"io"
 	"os"
 
+	"cosmossdk.io/core/address"
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
 	"sigs.k8s.io/yaml"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -48,6 +50,7 @@ type Context struct {
 	GenerateOnly      bool
 	Offline           bool
 	SkipConfirm       bool
+	Confirm           bool
 	TxConfig          TxConfig
 	AccountRetriever  AccountRetriever
 	NodeURI           string
@@ -57,6 +60,21 @@ type Context struct {
 	LedgerHasProtobuf bool
 	PreprocessTxHook  PreprocessTxFn
 
+	// LightClient, when set, is used to verify that the headers committing to
+	// the state returned by queries chain to a trusted root. Queries whose
+	// responses can't be proven against these headers are rejected.
+	LightClient *LightClient
+
+	// AddressCodec, ValidatorAddressCodec and ConsensusAddressCodec convert the
+	// account, validator and consensus addresses to and from strings. They allow
+	// a process to work with the prefixes of several chains at the same time.
+	// When a codec is not set, the bech32 prefixes of the sdk.Config are used.
+	// They are only used to parse the addresses given to the client, the Msgs
+	// and the key outputs still encode the addresses with the sdk.Config.
+	AddressCodec          address.Codec
+	ValidatorAddressCodec address.Codec
+	ConsensusAddressCodec address.Codec
+
 	// IsAux is true when the signer is an auxiliary signer (e.g. the tipper).
 	IsAux bool
 
@@ -132,6 +150,12 @@ func (ctx Context) WithNodeURI(nodeURI string) Context {
 	return ctx
 }
 
+// WithLightClient returns a copy of the context with an updated light client.
+func (ctx Context) WithLightClient(lc *LightClient) Context {
+	ctx.LightClient = lc
+	return ctx
+}
+
 // WithHeight returns a copy of the context with an updated height.
 func (ctx Context) WithHeight(height int64) Context {
 	ctx.Height = height
@@ -244,6 +268,12 @@ func (ctx Context) WithSkipConfirmation(skip bool) Context {
 	return ctx
 }
 
+// WithConfirm returns a copy of the context with an updated Confirm value.
+func (ctx Context) WithConfirm(confirm bool) Context {
+	ctx.Confirm = confirm
+	return ctx
+}
+
 // WithTxConfig returns the context with an updated TxConfig
 func (ctx Context) WithTxConfig(generator TxConfig) Context {
 	ctx.TxConfig = generator
@@ -262,6 +292,64 @@ func (ctx Context) WithInterfaceRegistry(interfaceRegistry codectypes.InterfaceR
 	return ctx
 }
 
+// WithAddressCodec returns the context with an updated AddressCodec
+func (ctx Context) WithAddressCodec(addressCodec address.Codec) Context {
+	ctx.AddressCodec = addressCodec
+	return ctx
+}
+
+// WithValidatorAddressCodec returns the context with an updated ValidatorAddressCodec
+func (ctx Context) WithValidatorAddressCodec(validatorAddressCodec address.Codec) Context {
+	ctx.ValidatorAddressCodec = validatorAddressCodec
+	return ctx
+}
+
+// WithConsensusAddressCodec returns the context with an updated ConsensusAddressCodec
+func (ctx Context) WithConsensusAddressCodec(consensusAddressCodec address.Codec) Context {
+	ctx.ConsensusAddressCodec = consensusAddressCodec
+	return ctx
+}
+
+// WithBech32Prefix returns the context with bech32 address codecs for the
+// prefix, using the validator and consensus prefixes derived from it like the
+// default sdk.Config ones, e.g. cosmosvaloper and cosmosvalcons for cosmos.
+func (ctx Context) WithBech32Prefix(prefix string) Context {
+	return ctx.
+		WithAddressCodec(addresscodec.NewCachedBech32Codec(prefix)).
+		WithValidatorAddressCodec(addresscodec.NewCachedBech32Codec(prefix + sdk.PrefixValidator + sdk.PrefixOperator)).
+		WithConsensusAddressCodec(addresscodec.NewCachedBech32Codec(prefix + sdk.PrefixValidator + sdk.PrefixConsensus))
+}
+
+// GetAddressCodec returns the AddressCodec of the context, or a bech32 codec
+// for the account prefix of the sdk.Config when it is not set.
+func (ctx Context) GetAddressCodec() address.Codec {
+	if ctx.AddressCodec == nil {
+		return addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	}
+
+	return ctx.AddressCodec
+}
+
+// GetValidatorAddressCodec returns the ValidatorAddressCodec of the context,
+// or a bech32 codec for the validator prefix of the sdk.Config when it is not set.
+func (ctx Context) GetValidatorAddressCodec() address.Codec {
+	if ctx.ValidatorAddressCodec == nil {
+		return addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32ValidatorAddrPrefix())
+	}
+
+	return ctx.ValidatorAddressCodec
+}
+
+// GetConsensusAddressCodec returns the ConsensusAddressCodec of the context,
+// or a bech32 codec for the consensus prefix of the sdk.Config when it is not set.
+func (ctx Context) GetConsensusAddressCodec() address.Codec {
+	if ctx.ConsensusAddressCodec == nil {
+		return addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32ConsensusAddrPrefix())
+	}
+
+	return ctx.ConsensusAddressCodec
+}
+
 // WithViper returns the context with Viper field. This Viper instance is used to read
 // client-side config from the config file.
 func (ctx Context) WithViper(prefix string) Context {
@@ -376,7 +464,8 @@ func GetFromFields(clientCtx Context, kr keyring.Keyring, from string) (sdk.AccA
 		return nil, "", 0, nil
 	}
 
-	addr, err := sdk.AccAddressFromBech32(from)
+	bz, err := clientCtx.GetAddressCodec().StringToBytes(from)
+	addr := sdk.AccAddress(bz)
 	switch {
 	case clientCtx.Simulate:
 		if err != nil {
@@ -207,6 +207,21 @@ func TestGetFromFields(t *testing.T) {
 			clientCtx:   client.Context{}.WithSimulation(true),
 			expectedErr: "a valid bech32 address must be provided in simulation mode",
 		},
+		{
+			keyring: func() keyring.Keyring {
+				return keyring.NewInMemory(cfg.Codec)
+			},
+			from:      "osmo139f7kncmglres2nf3h4hc4tade85ekfr0t005x",
+			clientCtx: client.Context{}.WithSimulation(true).WithBech32Prefix("osmo"),
+		},
+		{
+			keyring: func() keyring.Keyring {
+				return keyring.NewInMemory(cfg.Codec)
+			},
+			from:        "cosmos139f7kncmglres2nf3h4hc4tade85ekfr8sulz5",
+			clientCtx:   client.Context{}.WithSimulation(true).WithBech32Prefix("osmo"),
+			expectedErr: "a valid bech32 address must be provided in simulation mode",
+		},
 		{
 			keyring: func() keyring.Keyring {
 				return keyring.NewInMemory(cfg.Codec)
@@ -11,6 +11,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
@@ -40,6 +41,7 @@ func Cmd() *cobra.Command {
 	cmd.AddCommand(AddrCmd())
 	cmd.AddCommand(RawBytesCmd())
 	cmd.AddCommand(PrefixesCmd())
+	cmd.AddCommand(DiffBlockTracesCmd())
 
 	return cmd
 }
@@ -203,6 +205,7 @@ $ %s debug addr cosmos1e0jnq2sun3dzjh8p2xq95kk0expwmd7shwjpfg
 			`, version.AppName),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
 			addrString := args[0]
 			var addr []byte
 
@@ -211,10 +214,10 @@ $ %s debug addr cosmos1e0jnq2sun3dzjh8p2xq95kk0expwmd7shwjpfg
 			addr, err = hex.DecodeString(addrString)
 			if err != nil {
 				var err2 error
-				addr, err2 = sdk.AccAddressFromBech32(addrString)
+				addr, err2 = clientCtx.GetAddressCodec().StringToBytes(addrString)
 				if err2 != nil {
 					var err3 error
-					addr, err3 = sdk.ValAddressFromBech32(addrString)
+					addr, err3 = clientCtx.GetValidatorAddressCodec().StringToBytes(addrString)
 
 					if err3 != nil {
 						return fmt.Errorf("expected hex or bech32. Got errors: hex: %v, bech32 acc: %v, bech32 val: %v", err, err2, err3)
@@ -222,10 +225,20 @@ $ %s debug addr cosmos1e0jnq2sun3dzjh8p2xq95kk0expwmd7shwjpfg
 				}
 			}
 
+			accAddr, err := clientCtx.GetAddressCodec().BytesToString(addr)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := clientCtx.GetValidatorAddressCodec().BytesToString(addr)
+			if err != nil {
+				return err
+			}
+
 			cmd.Println("Address:", addr)
 			cmd.Printf("Address (hex): %X\n", addr)
-			cmd.Printf("Bech32 Acc: %s\n", sdk.AccAddress(addr))
-			cmd.Printf("Bech32 Val: %s\n", sdk.ValAddress(addr))
+			cmd.Printf("Bech32 Acc: %s\n", accAddr)
+			cmd.Printf("Bech32 Val: %s\n", valAddr)
 			return nil
 		},
 	}
@@ -275,3 +288,39 @@ func PrefixesCmd() *cobra.Command {
 		},
 	}
 }
+
+func DiffBlockTracesCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "diff-block-traces [trace-a] [trace-b]",
+		Short: "Diff two execution traces of the same block",
+		Long: fmt.Sprintf(`Diff two execution traces of the same block, written by nodes started with
+--block-trace-dir, and print the differences of the first diverging tx.
+
+Example:
+$ %s debug diff-block-traces node-a/traces/block-42.json node-b/traces/block-42.json
+			`, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			a, err := baseapp.ReadBlockTrace(args[0])
+			if err != nil {
+				return err
+			}
+
+			b, err := baseapp.ReadBlockTrace(args[1])
+			if err != nil {
+				return err
+			}
+
+			diffs := baseapp.DiffBlockTraces(a, b)
+			if len(diffs) == 0 {
+				cmd.Println("block traces are identical")
+				return nil
+			}
+
+			for _, diff := range diffs {
+				cmd.Println(diff)
+			}
+			return nil
+		},
+	}
+}
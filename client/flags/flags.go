@@ -3,6 +3,7 @@ package flags
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -67,6 +68,7 @@ const (
 	FlagOffline          = "offline"
 	FlagOutputDocument   = "output-document" // inspired by wget -O
 	FlagSkipConfirmation = "yes"
+	FlagConfirm          = "confirm"
 	FlagProve            = "prove"
 	FlagKeyringBackend   = "keyring-backend"
 	FlagPage             = "page"
@@ -76,6 +78,7 @@ const (
 	FlagOffset           = "offset"
 	FlagCountTotal       = "count-total"
 	FlagTimeoutHeight    = "timeout-height"
+	FlagUnordered        = "unordered"
 	FlagKeyType          = "key-type"
 	FlagFeePayer         = "fee-payer"
 	FlagFeeGranter       = "fee-granter"
@@ -83,6 +86,10 @@ const (
 	FlagTip              = "tip"
 	FlagAux              = "aux"
 	FlagInitHeight       = "initial-height"
+	FlagTrustHeight      = "trust-height"
+	FlagTrustHash        = "trust-hash"
+	FlagTrustingPeriod   = "trusting-period"
+	FlagWitnesses        = "witnesses"
 	// FlagOutput is the flag to set the output format.
 	// This differs from FlagOutputDocument that is used to set the output file.
 	FlagOutput = "output"
@@ -108,6 +115,10 @@ func AddQueryFlagsToCmd(cmd *cobra.Command) {
 	cmd.Flags().Bool(FlagGRPCInsecure, false, "allow gRPC over insecure channels, if not the server must use TLS")
 	cmd.Flags().Int64(FlagHeight, 0, "Use a specific height to query state at (this can error if the node is pruning state)")
 	cmd.Flags().StringP(FlagOutput, "o", "text", "Output format (text|json)")
+	cmd.Flags().Int64(FlagTrustHeight, 0, "Height of a trusted header; when set with --trust-hash, query proofs are verified against light client verified headers, and gRPC queries require --grpc-addr")
+	cmd.Flags().String(FlagTrustHash, "", "Hex-encoded hash of the trusted header at --trust-height")
+	cmd.Flags().Duration(FlagTrustingPeriod, 168*time.Hour, "Period during which a verified header can be trusted to verify new headers")
+	cmd.Flags().StringSlice(FlagWitnesses, nil, "RPC addresses of the nodes cross-checking headers in light client mode (defaults to --node)")
 
 	// some base commands does not require chainID e.g `simd testnet` while subcommands do
 	// hence the flag should not be required for those commands
@@ -132,8 +143,10 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 	f.Bool(FlagGenerateOnly, false, "Build an unsigned transaction and write it to STDOUT (when enabled, the local Keybase only accessed when providing a key name)")
 	f.Bool(FlagOffline, false, "Offline mode (does not allow any online functionality)")
 	f.BoolP(FlagSkipConfirmation, "y", false, "Skip tx broadcasting prompt confirmation")
+	f.Bool(FlagConfirm, false, "Print a human-readable summary of the tx and require confirmation before signing and broadcasting, even with --yes")
 	f.String(FlagSignMode, "", "Choose sign mode (direct|amino-json|direct-aux), this is an advanced feature")
 	f.Uint64(FlagTimeoutHeight, 0, "Set a block timeout height to prevent the tx from being committed past a certain height")
+	f.Bool(FlagUnordered, false, "Mark the tx as unordered, i.e. not bound to the signer's sequence; requires --timeout-height to be set")
 	f.String(FlagFeePayer, "", "Fee payer pays fees for the transaction instead of deducting from the signer")
 	f.String(FlagFeeGranter, "", "Fee granter grants fees for the transaction")
 	f.String(FlagTip, "", "Tip is the amount that is going to be transferred to the fee payer on the target chain. This flag is only valid when used with --aux, and is ignored if the target chain didn't enable the TipDecorator")
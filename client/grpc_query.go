@@ -8,6 +8,7 @@ import (
 
 	"google.golang.org/grpc/encoding"
 
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 
 	errorsmod "cosmossdk.io/errors"
@@ -59,9 +60,18 @@ func (ctx Context) Invoke(grpcCtx gocontext.Context, method string, req, reply i
 
 	if ctx.GRPCClient != nil {
 		// Case 2-1. Invoke grpc.
+		if ctx.LightClient != nil {
+			return ctx.invokeVerified(grpcCtx, method, req, reply, opts...)
+		}
+
 		return ctx.GRPCClient.Invoke(grpcCtx, method, req, reply, opts...)
 	}
 
+	// The ABCI queries of gRPC routes don't return proofs.
+	if ctx.LightClient != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "gRPC queries can only be verified by the light client over a gRPC endpoint, set --%s", flags.FlagGRPC)
+	}
+
 	// Case 2-2. Querying state via abci query.
 	reqBz, err := ctx.gRPCCodec().Marshal(req)
 	if err != nil {
@@ -122,6 +132,24 @@ func (ctx Context) Invoke(grpcCtx gocontext.Context, method string, req, reply i
 	return nil
 }
 
+// invokeVerified invokes a gRPC query requesting the proofs of the store keys
+// it reads, and verifies them against the headers of the light client.
+func (ctx Context) invokeVerified(grpcCtx gocontext.Context, method string, req, reply interface{}, opts ...grpc.CallOption) error {
+	var header, trailer metadata.MD
+	grpcCtx = metadata.AppendToOutgoingContext(grpcCtx, grpctypes.GRPCQueryProveHeader, "true")
+	opts = append(opts, grpc.Header(&header), grpc.Trailer(&trailer))
+
+	if err := ctx.GRPCClient.Invoke(grpcCtx, method, req, reply, opts...); err != nil {
+		return err
+	}
+
+	if len(trailer.Get(grpctypes.GRPCQueryProofsTrailer)) == 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "response of query %s does not contain any proof", method)
+	}
+
+	return ctx.VerifyGRPCQueryProofs(header, trailer)
+}
+
 // NewStream implements the grpc ClientConn.NewStream method
 func (Context) NewStream(gocontext.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
 	return nil, fmt.Errorf("streaming rpc not supported")
@@ -0,0 +1,241 @@
+package keys
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+const (
+	// FlagFile reads the data to sign or verify from a file instead of the
+	// command arguments.
+	FlagFile = "file"
+
+	// msgSignDataType is the amino type of the ADR-036 MsgSignData message.
+	msgSignDataType = "sign/MsgSignData"
+)
+
+// SignedText is the output of the sign-text command and the input of the
+// verify-text command. It contains everything needed by a third party to
+// authenticate the signer of some arbitrary data.
+type SignedText struct {
+	Signer    string          `json:"signer"`
+	PubKey    json.RawMessage `json:"pub_key"`
+	Data      []byte          `json:"data"`
+	Signature []byte          `json:"signature"`
+}
+
+// ADR036SignBytes returns the bytes to sign in order to produce an ADR-036
+// off-chain signature of data by signer. They are the amino JSON sign bytes of
+// a transaction containing a single MsgSignData, with an empty chain ID, zero
+// account number and sequence, and an empty fee, so that they can never be
+// valid on-chain transaction sign bytes.
+func ADR036SignBytes(signer string, data []byte) ([]byte, error) {
+	type msgSignDataValue struct {
+		Data   []byte `json:"data"`
+		Signer string `json:"signer"`
+	}
+	type msg struct {
+		Type  string           `json:"type"`
+		Value msgSignDataValue `json:"value"`
+	}
+	type fee struct {
+		Amount []struct{} `json:"amount"`
+		Gas    string     `json:"gas"`
+	}
+	type signDoc struct {
+		AccountNumber string `json:"account_number"`
+		ChainID       string `json:"chain_id"`
+		Fee           fee    `json:"fee"`
+		Memo          string `json:"memo"`
+		Msgs          []msg  `json:"msgs"`
+		Sequence      string `json:"sequence"`
+	}
+
+	bz, err := json.Marshal(signDoc{
+		AccountNumber: "0",
+		Fee:           fee{Amount: []struct{}{}, Gas: "0"},
+		Msgs:          []msg{{Type: msgSignDataType, Value: msgSignDataValue{Data: data, Signer: signer}}},
+		Sequence:      "0",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.SortJSON(bz)
+}
+
+// SignTextCmd returns a command signing arbitrary data off-chain following
+// ADR-036.
+func SignTextCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-text [name_or_address] [text]",
+		Short: "Sign arbitrary data off-chain with a key (ADR-036)",
+		Long: `Sign arbitrary data with a key of the keyring following ADR-036. The data is
+wrapped in a MsgSignData which can never be a valid on-chain transaction, so the
+resulting signature can safely be used to prove the ownership of an account,
+e.g. to log in to an application. The data is read from the text argument, or
+from a file when --file is set.
+
+The output can be verified with the verify-text command.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			data, err := readTextData(cmd, args[1:])
+			if err != nil {
+				return err
+			}
+
+			k, err := fetchKey(clientCtx.Keyring, clientCtx.GetAddressCodec(), args[0])
+			if err != nil {
+				return fmt.Errorf("%s is not a valid name or address: %v", args[0], err)
+			}
+
+			addr, err := k.GetAddress()
+			if err != nil {
+				return err
+			}
+
+			signBytes, err := ADR036SignBytes(addr.String(), data)
+			if err != nil {
+				return err
+			}
+
+			sig, pubKey, err := clientCtx.Keyring.Sign(k.Name, signBytes, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+			if err != nil {
+				return err
+			}
+
+			pubKeyJSON, err := clientCtx.Codec.MarshalInterfaceJSON(pubKey)
+			if err != nil {
+				return err
+			}
+
+			out, err := json.Marshal(SignedText{
+				Signer:    addr.String(),
+				PubKey:    pubKeyJSON,
+				Data:      data,
+				Signature: sig,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(out)
+		},
+	}
+
+	cmd.Flags().String(FlagFile, "", "Read the data to sign from the given file")
+
+	return cmd
+}
+
+// VerifyTextCmd returns a command verifying an ADR-036 off-chain signature
+// produced by the sign-text command.
+func VerifyTextCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-text [signed-text-file] [text]",
+		Short: "Verify an off-chain signature of arbitrary data (ADR-036)",
+		Long: `Verify an ADR-036 off-chain signature produced by the sign-text command. The
+signature is checked against the public key it contains, and the public key must
+match the signer address. When a text argument or --file is given, the signed
+data must also match it.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var signed SignedText
+			if err := json.Unmarshal(bz, &signed); err != nil {
+				return fmt.Errorf("failed to parse signed text: %w", err)
+			}
+
+			file, _ := cmd.Flags().GetString(FlagFile)
+			if len(args) > 1 || file != "" {
+				data, err := readTextData(cmd, args[1:])
+				if err != nil {
+					return err
+				}
+
+				if string(data) != string(signed.Data) {
+					return errors.New("signed data does not match the expected data")
+				}
+			}
+
+			var pubKey cryptotypes.PubKey
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON(signed.PubKey, &pubKey); err != nil {
+				return fmt.Errorf("failed to parse public key: %w", err)
+			}
+
+			if err := VerifyADR036Signature(signed.Signer, pubKey, signed.Data, signed.Signature); err != nil {
+				return err
+			}
+
+			cmd.Printf("signature verified for %s\n", signed.Signer)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagFile, "", "Read the expected signed data from the given file")
+
+	return cmd
+}
+
+// VerifyADR036Signature verifies that sig is a valid ADR-036 signature of data
+// by the account signer whose public key is pubKey.
+func VerifyADR036Signature(signer string, pubKey cryptotypes.PubKey, data, sig []byte) error {
+	addr, err := sdk.AccAddressFromBech32(signer)
+	if err != nil {
+		return err
+	}
+
+	if !addr.Equals(sdk.AccAddress(pubKey.Address())) {
+		return fmt.Errorf("public key does not match signer %s", signer)
+	}
+
+	signBytes, err := ADR036SignBytes(signer, data)
+	if err != nil {
+		return err
+	}
+
+	if !pubKey.VerifySignature(signBytes, sig) {
+		return errors.New("invalid signature")
+	}
+
+	return nil
+}
+
+// readTextData returns the data passed as the text argument or, when --file
+// is set, the content of the file.
+func readTextData(cmd *cobra.Command, args []string) ([]byte, error) {
+	file, _ := cmd.Flags().GetString(FlagFile)
+	switch {
+	case file != "" && len(args) > 0:
+		return nil, fmt.Errorf("text argument and --%s cannot be used together", FlagFile)
+	case file != "":
+		return os.ReadFile(file)
+	case len(args) > 0:
+		return []byte(args[0]), nil
+	default:
+		return nil, fmt.Errorf("either a text argument or --%s must be provided", FlagFile)
+	}
+}
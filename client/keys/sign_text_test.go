@@ -0,0 +1,101 @@
+package keys
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestADR036SignBytes(t *testing.T) {
+	bz, err := ADR036SignBytes("cosmos1ulav3hsenupswqfkw2y3sup5kgtqwnvqa8eyhs", []byte("hello"))
+	require.NoError(t, err)
+	require.Equal(t,
+		`{"account_number":"0","chain_id":"","fee":{"amount":[],"gas":"0"},"memo":"","msgs":[{"type":"sign/MsgSignData","value":{"data":"aGVsbG8=","signer":"cosmos1ulav3hsenupswqfkw2y3sup5kgtqwnvqa8eyhs"}}],"sequence":"0"}`,
+		string(bz),
+	)
+}
+
+func Test_signAndVerifyText(t *testing.T) {
+	kbHome := t.TempDir()
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, nil, cdc)
+	require.NoError(t, err)
+
+	path := hd.NewFundraiserParams(0, sdk.CoinType, 0).String()
+	k, err := kb.NewAccount("signer", testdata.TestMnemonic, "", path, hd.Secp256k1)
+	require.NoError(t, err)
+	addr, err := k.GetAddress()
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.
+		WithKeyringDir(kbHome).
+		WithCodec(cdc)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	keyringFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagHome, kbHome),
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		fmt.Sprintf("--%s=json", flags.FlagOutput),
+	}
+
+	// sign
+	signCmd := SignTextCmd()
+	signCmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+	_ = testutil.ApplyMockIODiscardOutErr(signCmd)
+	signCmd.SetArgs(append([]string{"signer", "login nonce 42"}, keyringFlags...))
+	out := &bytes.Buffer{}
+	signCtx := clientCtx.WithOutput(out)
+	require.NoError(t, signCmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &signCtx)))
+
+	var signed SignedText
+	require.NoError(t, json.Unmarshal(out.Bytes(), &signed))
+	require.Equal(t, addr.String(), signed.Signer)
+	require.Equal(t, []byte("login nonce 42"), signed.Data)
+
+	signedFile := testutil.WriteToNewTempFile(t, out.String()).Name()
+
+	testCases := []struct {
+		name   string
+		args   []string
+		expErr string
+	}{
+		{"valid signature", []string{signedFile}, ""},
+		{"valid signature of expected text", []string{signedFile, "login nonce 42"}, ""},
+		{"unexpected text", []string{signedFile, "login nonce 43"}, "signed data does not match"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			verifyCmd := VerifyTextCmd()
+			verifyCmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+			_ = testutil.ApplyMockIODiscardOutErr(verifyCmd)
+			verifyCmd.SetArgs(append(tc.args, keyringFlags...))
+
+			err := verifyCmd.ExecuteContext(ctx)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+
+	// tampered data is rejected
+	pubKey, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.NoError(t, VerifyADR036Signature(signed.Signer, pubKey, signed.Data, signed.Signature))
+	require.EqualError(t, VerifyADR036Signature(signed.Signer, pubKey, []byte("tampered"), signed.Signature), "invalid signature")
+}
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/light"
+	lightstore "github.com/cometbft/cometbft/light/store/db"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+const (
+	// lightClientDir is the directory, relative to the client home, in which
+	// the trail of verified headers is stored.
+	lightClientDir = "light-client"
+
+	// DefaultTrustingPeriod is the default period during which a verified
+	// header can be used to verify new headers. It should be significantly
+	// less than the unbonding period of the chain.
+	DefaultTrustingPeriod = 168 * time.Hour
+)
+
+// LightClientConfig defines the parameters used to create a light client
+// verifying the headers served by a CometBFT node.
+type LightClientConfig struct {
+	ChainID string
+	NodeURI string
+	// HomeDir is the directory in which verified headers are persisted. When
+	// empty, verified headers are only kept in memory.
+	HomeDir string
+	// Witnesses are the RPC addresses of nodes cross-checking the headers
+	// served by NodeURI. When empty, NodeURI is its own witness.
+	Witnesses    []string
+	TrustOptions light.TrustOptions
+}
+
+// LightClient verifies the headers served by a CometBFT node, starting from
+// the header identified by its trust options. The database of verified
+// headers is only opened while a header is verified, so that no handle to it
+// outlives a query.
+type LightClient struct {
+	cfg LightClientConfig
+
+	mtx sync.Mutex
+	// memDB holds the verified headers when the config has no home directory.
+	memDB dbm.DB
+}
+
+// NewLightClient returns a light client whose root of trust is the header
+// identified by the config trust options, which is checked against the node.
+// Headers verified by the client are persisted in the client home, so that
+// the trail of trusted headers is maintained across invocations.
+func NewLightClient(ctx context.Context, cfg LightClientConfig) (*LightClient, error) {
+	if cfg.ChainID == "" {
+		return nil, errors.New("chain ID is required for light client verification")
+	}
+
+	if cfg.NodeURI == "" {
+		return nil, errors.New("node URI is required for light client verification")
+	}
+
+	lc := &LightClient{cfg: cfg}
+	if cfg.HomeDir == "" {
+		lc.memDB = dbm.NewMemDB()
+	}
+
+	if err := lc.withClient(ctx, func(*light.Client) error { return nil }); err != nil {
+		return nil, err
+	}
+
+	return lc, nil
+}
+
+// VerifyLightBlockAtHeight returns the header at the given height, once it is
+// verified to chain to the trusted root.
+func (lc *LightClient) VerifyLightBlockAtHeight(ctx context.Context, height int64, now time.Time) (*cmttypes.LightBlock, error) {
+	var lb *cmttypes.LightBlock
+	err := lc.withClient(ctx, func(c *light.Client) (err error) {
+		lb, err = c.VerifyLightBlockAtHeight(ctx, height, now)
+		return err
+	})
+
+	return lb, err
+}
+
+// LastTrustedHeight returns the height of the last verified header.
+func (lc *LightClient) LastTrustedHeight() (int64, error) {
+	var height int64
+	err := lc.withClient(context.Background(), func(c *light.Client) (err error) {
+		height, err = c.LastTrustedHeight()
+		return err
+	})
+
+	return height, err
+}
+
+// withClient runs fn with a CometBFT light client backed by the database of
+// verified headers, which is closed once fn returns.
+func (lc *LightClient) withClient(ctx context.Context, fn func(*light.Client) error) (err error) {
+	lc.mtx.Lock()
+	defer lc.mtx.Unlock()
+
+	db := lc.memDB
+	if db == nil {
+		db, err = dbm.NewGoLevelDB(lc.cfg.ChainID, filepath.Join(lc.cfg.HomeDir, lightClientDir))
+		if err != nil {
+			return err
+		}
+
+		defer func() {
+			if cerr := db.Close(); cerr != nil && err == nil {
+				err = cerr
+			}
+		}()
+	}
+
+	witnesses := lc.cfg.Witnesses
+	if len(witnesses) == 0 {
+		witnesses = []string{lc.cfg.NodeURI}
+	}
+
+	c, err := light.NewHTTPClient(
+		ctx,
+		lc.cfg.ChainID,
+		lc.cfg.TrustOptions,
+		lc.cfg.NodeURI,
+		witnesses,
+		lightstore.New(db, lc.cfg.ChainID),
+		// the trail of verified headers is kept when the trust options point
+		// to an older header, and never removed when it conflicts with them
+		light.ConfirmationFunction(func(string) bool { return false }),
+	)
+	if err != nil {
+		return err
+	}
+
+	return fn(c)
+}
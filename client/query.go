@@ -3,18 +3,26 @@ package client
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 )
 
 // GetNode returns an RPC client. If the context's client is not defined, an
@@ -92,7 +100,12 @@ func (ctx Context) queryABCI(req abci.RequestQuery) (abci.ResponseQuery, error)
 
 	opts := rpcclient.ABCIQueryOptions{
 		Height: queryHeight,
-		Prove:  req.Prove,
+		// in light client mode, queries are always verified
+		Prove: req.Prove || ctx.LightClient != nil,
+	}
+
+	if ctx.LightClient != nil && !isQueryStoreWithProof(req.Path) {
+		return abci.ResponseQuery{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "query path %s can't be verified by the light client", req.Path)
 	}
 
 	result, err := node.ABCIQueryWithOptions(context.Background(), req.Path, req.Data, opts)
@@ -109,9 +122,141 @@ func (ctx Context) queryABCI(req abci.RequestQuery) (abci.ResponseQuery, error)
 		return result.Response, nil
 	}
 
+	if err := ctx.verifyProof(req.Path, result.Response); err != nil {
+		return abci.ResponseQuery{}, err
+	}
+
 	return result.Response, nil
 }
 
+// verifyProof verifies the proof operations of a store query response against
+// the application hash committed by the chain. The state at height H is
+// committed in the header of block H+1, so the client waits for that block to
+// be available before fetching its header.
+func (ctx Context) verifyProof(queryPath string, resp abci.ResponseQuery) error {
+	if resp.ProofOps == nil || len(resp.ProofOps.Ops) == 0 {
+		return errors.New("query response does not contain a proof")
+	}
+
+	storeName, err := parseQueryStorePath(queryPath)
+	if err != nil {
+		return err
+	}
+
+	appHash, err := ctx.getAppHash(resp.Height)
+	if err != nil {
+		return err
+	}
+
+	kp := merkle.KeyPath{}
+	kp = kp.AppendKey([]byte(storeName), merkle.KeyEncodingURL)
+	kp = kp.AppendKey(resp.Key, merkle.KeyEncodingURL)
+
+	prt := rootmulti.DefaultProofRuntime()
+	if resp.Value == nil {
+		err = prt.VerifyAbsence(resp.ProofOps, appHash, kp.String())
+	} else {
+		err = prt.VerifyValue(resp.ProofOps, appHash, kp.String(), resp.Value)
+	}
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "failed to verify query proof at height %d: %s", resp.Height, err)
+	}
+
+	return nil
+}
+
+// VerifyGRPCQueryProofs verifies the proofs returned in the trailer of a gRPC
+// query made with the GRPCQueryProveHeader header set to "true", against the
+// application hash committed by the chain for the height returned in the
+// header of the query. The proofs cover every store key read by the query.
+func (ctx Context) VerifyGRPCQueryProofs(header, trailer metadata.MD) error {
+	heightHeaders := header.Get(grpctypes.GRPCBlockHeightHeader)
+	if len(heightHeaders) != 1 {
+		return fmt.Errorf("unexpected '%s' header length; got %d, expected: %d", grpctypes.GRPCBlockHeightHeader, len(heightHeaders), 1)
+	}
+
+	height, err := strconv.ParseInt(heightHeaders[0], 10, 64)
+	if err != nil {
+		return err
+	}
+
+	appHash, err := ctx.getAppHash(height)
+	if err != nil {
+		return err
+	}
+
+	prt := rootmulti.DefaultProofRuntime()
+	for _, bz := range trailer.Get(grpctypes.GRPCQueryProofsTrailer) {
+		var proofOps cmtprotocrypto.ProofOps
+		if err := proofOps.Unmarshal([]byte(bz)); err != nil {
+			return err
+		}
+
+		// a store key proof is made of the proof of the key in its store,
+		// followed by the proof of the store in the multistore
+		if len(proofOps.Ops) != 2 {
+			return fmt.Errorf("expected 2 proof operations, got %d", len(proofOps.Ops))
+		}
+
+		op, err := storetypes.CommitmentOpDecoder(proofOps.Ops[0])
+		if err != nil {
+			return err
+		}
+
+		key, storeName := proofOps.Ops[0].Key, proofOps.Ops[1].Key
+		kp := merkle.KeyPath{}
+		kp = kp.AppendKey(storeName, merkle.KeyEncodingURL)
+		kp = kp.AppendKey(key, merkle.KeyEncodingURL)
+
+		if exist := op.(storetypes.CommitmentOp).Proof.GetExist(); exist != nil {
+			err = prt.VerifyValue(&proofOps, appHash, kp.String(), exist.Value)
+		} else {
+			err = prt.VerifyAbsence(&proofOps, appHash, kp.String())
+		}
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "failed to verify proof of key %X of store %s at height %d: %s", key, storeName, height, err)
+		}
+	}
+
+	return nil
+}
+
+// getAppHash returns the application hash resulting from the execution of the
+// block at the given height, i.e. the one committed in the header of the next
+// block. When the context has a light client, the header is verified to chain
+// to its trusted root.
+func (ctx Context) getAppHash(height int64) ([]byte, error) {
+	node, err := ctx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	nextHeight := height + 1
+	if err := rpcclient.WaitForHeight(node, nextHeight, nil); err != nil {
+		return nil, err
+	}
+
+	if ctx.LightClient != nil {
+		lb, err := ctx.LightClient.VerifyLightBlockAtHeight(context.Background(), nextHeight, time.Now())
+		if err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "failed to verify header at height %d: %s", nextHeight, err)
+		}
+
+		return lb.AppHash, nil
+	}
+
+	commit, err := node.Commit(context.Background(), &nextHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	if commit.Header == nil {
+		return nil, fmt.Errorf("no header found at height %d", nextHeight)
+	}
+
+	return commit.Header.AppHash, nil
+}
+
 func sdkErrorToGRPCError(resp abci.ResponseQuery) error {
 	switch resp.Code {
 	case sdkerrors.ErrInvalidRequest.ABCICode():
@@ -149,6 +294,21 @@ func (ctx Context) queryStore(key []byte, storeName, endPath string) ([]byte, in
 	return ctx.query(path, key)
 }
 
+// parseQueryStorePath expects a format like /store/<storeName>/<subpath> and
+// returns the store name.
+func parseQueryStorePath(path string) (storeName string, err error) {
+	if !strings.HasPrefix(path, "/") {
+		return "", errors.New("expected path to start with /")
+	}
+
+	paths := strings.SplitN(path[1:], "/", 3)
+	if len(paths) != 3 || paths[0] != "store" {
+		return "", fmt.Errorf("expected query path of the form /store/<storeName>/<subpath>, got %s", path)
+	}
+
+	return paths[1], nil
+}
+
 // isQueryStoreWithProof expects a format like /<queryType>/<storeName>/<subpath>
 // queryType must be "store" and subpath must be "key" to require a proof.
 func isQueryStoreWithProof(path string) bool {
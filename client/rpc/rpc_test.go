@@ -2,16 +2,23 @@ package rpc_test
 
 import (
 	"context"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"testing"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/light"
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
+	"github.com/cosmos/cosmos-sdk/codec"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -122,6 +129,123 @@ func (s *IntegrationTestSuite) TestQueryABCIHeight() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestQueryStoreCmd() {
+	val := s.network.Validators[0]
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	// balances are stored under prefix 2 | len(address) | address | denom
+	key := append([]byte{2}, address.MustLengthPrefix(val.Address)...)
+	key = append(key, []byte(s.network.Config.BondDenom)...)
+
+	testCases := []struct {
+		name        string
+		args        []string
+		expErr      bool
+		expVerified bool
+	}{
+		{
+			"invalid hex key",
+			[]string{banktypes.StoreKey, "zz", fmt.Sprintf("--%s=json", flags.FlagOutput)},
+			true,
+			false,
+		},
+		{
+			"without proof",
+			[]string{banktypes.StoreKey, hex.EncodeToString(key), fmt.Sprintf("--%s=json", flags.FlagOutput)},
+			false,
+			false,
+		},
+		{
+			"with verified proof",
+			[]string{banktypes.StoreKey, hex.EncodeToString(key), fmt.Sprintf("--%s", flags.FlagProve), fmt.Sprintf("--%s=json", flags.FlagOutput)},
+			false,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, rpc.QueryStoreCmd(), tc.args)
+			if tc.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res rpc.StoreQueryOutput
+			s.Require().NoError(json.Unmarshal(out.Bytes(), &res))
+			s.Require().NotEmpty(res.Value)
+			s.Require().Equal(tc.expVerified, res.ProofVerified)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestStoreQueryWithLightClient() {
+	val := s.network.Validators[0]
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	trustHeight := int64(1)
+	block, err := val.RPCClient.Block(context.Background(), &trustHeight)
+	s.Require().NoError(err)
+
+	lightClientCfg := client.LightClientConfig{
+		ChainID: s.network.Config.ChainID,
+		NodeURI: val.RPCAddress,
+		TrustOptions: light.TrustOptions{
+			Period: time.Hour,
+			Height: trustHeight,
+			Hash:   block.BlockID.Hash,
+		},
+	}
+
+	// a root of trust which does not match the chain is rejected
+	invalidCfg := lightClientCfg
+	invalidCfg.TrustOptions.Hash = make([]byte, len(block.BlockID.Hash))
+	_, err = client.NewLightClient(context.Background(), invalidCfg)
+	s.Require().Error(err)
+
+	lc, err := client.NewLightClient(context.Background(), lightClientCfg)
+	s.Require().NoError(err)
+
+	// store queries are proven and verified against light client headers
+	res, err := val.ClientCtx.WithLightClient(lc).QueryABCI(abci.RequestQuery{
+		Path: fmt.Sprintf("/store/%s/key", banktypes.StoreKey),
+		Data: append([]byte{2}, address.MustLengthPrefix(val.Address)...),
+	})
+	s.Require().NoError(err)
+	s.Require().NotNil(res.ProofOps)
+
+	lastTrustedHeight, err := lc.LastTrustedHeight()
+	s.Require().NoError(err)
+	s.Require().Equal(res.Height+1, lastTrustedHeight)
+
+	// queries which can't be proven are rejected
+	_, err = val.ClientCtx.WithLightClient(lc).QueryABCI(abci.RequestQuery{
+		Path: fmt.Sprintf("/store/%s/subspace", banktypes.StoreKey),
+		Data: []byte{2},
+	})
+	s.Require().ErrorContains(err, "can't be verified by the light client")
+
+	_, err = banktypes.NewQueryClient(val.ClientCtx.WithLightClient(lc)).Params(context.Background(), &banktypes.QueryParamsRequest{})
+	s.Require().ErrorContains(err, "can only be verified by the light client over a gRPC endpoint")
+
+	// gRPC queries are verified through the proofs of the store keys they read
+	conn, err := grpc.Dial(
+		val.AppConfig.GRPC.Address,
+		grpc.WithInsecure(), //nolint:staticcheck // ignore SA1019, we don't need to use a secure connection for tests
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(codec.NewProtoCodec(s.network.Config.InterfaceRegistry).GRPCCodec())),
+	)
+	s.Require().NoError(err)
+	defer conn.Close()
+
+	balRes, err := banktypes.NewQueryClient(val.ClientCtx.WithGRPCClient(conn).WithLightClient(lc)).Balance(
+		context.Background(),
+		&banktypes.QueryBalanceRequest{Address: val.Address.String(), Denom: s.network.Config.BondDenom},
+	)
+	s.Require().NoError(err)
+	s.Require().True(balRes.Balance.IsPositive())
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
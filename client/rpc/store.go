@@ -0,0 +1,83 @@
+package rpc
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// StoreQueryOutput is the output of a raw store query.
+type StoreQueryOutput struct {
+	Height        int64             `json:"height"`
+	Key           cmtbytes.HexBytes `json:"key"`
+	Value         cmtbytes.HexBytes `json:"value"`
+	ProofVerified bool              `json:"proof_verified"`
+}
+
+// QueryStoreCmd returns a command querying the raw value stored under a key
+// of a module store. When --prove is set, the proof returned by the node is
+// verified against the application hash of the corresponding block header and
+// the verification status is reported.
+func QueryStoreCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store [store-name] [hex-key]",
+		Short: "Query the raw value stored under a key of a module store",
+		Long: `Query the raw value stored under a key of a module store.
+
+When --prove is set, the node returns a Merkle proof along with the value. The
+client fetches the header committing to the queried state, verifies the proof
+against its application hash and fails if the verification does not succeed.
+`,
+		Example: fmt.Sprintf("$ %s query store bank 0212ab... --prove", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			key, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid hex key %s: %w", args[1], err)
+			}
+
+			prove, _ := cmd.Flags().GetBool(flags.FlagProve)
+
+			res, err := clientCtx.QueryABCI(abci.RequestQuery{
+				Path:   fmt.Sprintf("/store/%s/key", args[0]),
+				Data:   key,
+				Height: clientCtx.Height,
+				Prove:  prove,
+			})
+			if err != nil {
+				return err
+			}
+
+			out, err := json.Marshal(StoreQueryOutput{
+				Height: res.Height,
+				Key:    key,
+				Value:  res.Value,
+				// QueryABCI fails when a requested proof does not verify.
+				ProofVerified: prove,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(out)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Bool(flags.FlagProve, false, "Request a Merkle proof for the value and verify it against the block header")
+
+	return cmd
+}
@@ -190,6 +190,13 @@ func (b *AuxTxBuilder) GetSignBytes() ([]byte, error) {
 		}
 	case signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON:
 		{
+			// The LEGACY_AMINO_JSON sign doc verified by the chain doesn't
+			// include the tip, so the aux signers of a tipped tx must use
+			// SIGN_MODE_DIRECT_AUX.
+			if b.auxSignerData.SignDoc.Tip != nil {
+				return nil, sdkerrors.ErrInvalidRequest.Wrapf("tips must be signed with %s", signing.SignMode_SIGN_MODE_DIRECT_AUX)
+			}
+
 			signBz = legacytx.StdSignBytes(
 				b.auxSignerData.SignDoc.ChainId, b.auxSignerData.SignDoc.AccountNumber,
 				b.auxSignerData.SignDoc.Sequence, b.body.TimeoutHeight,
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/spf13/pflag"
@@ -31,6 +32,7 @@ type Factory struct {
 	sequence           uint64
 	gas                uint64
 	timeoutHeight      uint64
+	unordered          bool
 	gasAdjustment      float64
 	chainID            string
 	offline            bool
@@ -77,6 +79,7 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 	gasAdj, _ := flagSet.GetFloat64(flags.FlagGasAdjustment)
 	memo, _ := flagSet.GetString(flags.FlagNote)
 	timeoutHeight, _ := flagSet.GetUint64(flags.FlagTimeoutHeight)
+	unordered, _ := flagSet.GetBool(flags.FlagUnordered)
 
 	gasStr, _ := flagSet.GetString(flags.FlagGas)
 	gasSetting, _ := flags.ParseGasSetting(gasStr)
@@ -93,6 +96,7 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 		accountNumber:      accNum,
 		sequence:           accSeq,
 		timeoutHeight:      timeoutHeight,
+		unordered:          unordered,
 		gasAdjustment:      gasAdj,
 		memo:               memo,
 		signMode:           signMode,
@@ -127,6 +131,9 @@ func (f Factory) Fees() sdk.Coins                           { return f.fees }
 func (f Factory) GasPrices() sdk.DecCoins                   { return f.gasPrices }
 func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRetriever }
 func (f Factory) TimeoutHeight() uint64                     { return f.timeoutHeight }
+func (f Factory) Unordered() bool                           { return f.unordered }
+func (f Factory) FeeGranter() sdk.AccAddress                { return f.feeGranter }
+func (f Factory) FeePayer() sdk.AccAddress                  { return f.feePayer }
 
 // SimulateAndExecute returns the option to simulate and then execute the transaction
 // using the gas from the simulation results
@@ -246,6 +253,12 @@ func (f Factory) WithTimeoutHeight(height uint64) Factory {
 	return f
 }
 
+// WithUnordered returns a copy of the Factory with an updated unordered flag.
+func (f Factory) WithUnordered(unordered bool) Factory {
+	f.unordered = unordered
+	return f
+}
+
 // WithFeeGranter returns a copy of the Factory with an updated fee granter.
 func (f Factory) WithFeeGranter(fg sdk.AccAddress) Factory {
 	f.feeGranter = fg
@@ -329,6 +342,18 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 	tx.SetFeePayer(f.feePayer)
 	tx.SetTimeoutHeight(f.TimeoutHeight())
 
+	if f.unordered {
+		if f.timeoutHeight == 0 {
+			return nil, errors.New("unordered transactions must have a timeout height set")
+		}
+
+		utx, ok := tx.(interface{ SetUnordered(bool) })
+		if !ok {
+			return nil, errors.New("tx builder does not support unordered transactions")
+		}
+		utx.SetUnordered(true)
+	}
+
 	return tx, nil
 }
 
@@ -451,6 +476,12 @@ func (f Factory) Prepare(clientCtx client.Context) (Factory, error) {
 
 		fc = fc.WithAccountNumber(num)
 		fc = fc.WithSequence(seq)
+
+		// The sequence of an unordered tx is not checked, it only needs to
+		// distinguish the pending txs of the signer in the mempool.
+		if fc.unordered {
+			fc = fc.WithSequence(uint64(time.Now().UnixNano()))
+		}
 	}
 
 	return fc, nil
@@ -0,0 +1,140 @@
+package tx
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// TxSummary returns a human-readable summary of an unsigned transaction,
+// listing its messages and their fields, the signers, the fee and the
+// optional memo and timeout height. It is shown before signing when --confirm
+// is set so that users can review what they are about to broadcast.
+func TxSummary(clientCtx client.Context, tx authsigning.Tx) (string, error) {
+	var sb strings.Builder
+
+	sb.WriteString("Transaction summary:\n")
+	if clientCtx.ChainID != "" {
+		fmt.Fprintf(&sb, "  Chain ID: %s\n", clientCtx.ChainID)
+	}
+
+	msgs := tx.GetMsgs()
+	fmt.Fprintf(&sb, "  Messages (%d):\n", len(msgs))
+	for i, msg := range msgs {
+		fmt.Fprintf(&sb, "    %d. %s\n", i+1, sdk.MsgTypeURL(msg))
+
+		bz, err := clientCtx.Codec.MarshalJSON(msg)
+		if err != nil {
+			return "", err
+		}
+
+		var fields map[string]interface{}
+		if err := json.Unmarshal(bz, &fields); err != nil {
+			return "", err
+		}
+
+		writeSummaryFields(&sb, fields, "       ")
+	}
+
+	signers := tx.GetSigners()
+	signerAddrs := make([]string, len(signers))
+	for i, signer := range signers {
+		signerAddrs[i] = signer.String()
+	}
+	fmt.Fprintf(&sb, "  Signers: %s\n", strings.Join(signerAddrs, ", "))
+
+	fee := tx.GetFee()
+	feeStr := fee.String()
+	if fee.IsZero() {
+		feeStr = "none"
+	}
+	fmt.Fprintf(&sb, "  Fee: %s (gas limit %d)\n", feeStr, tx.GetGas())
+
+	if payer := tx.FeePayer(); payer != nil {
+		fmt.Fprintf(&sb, "  Fee payer: %s\n", payer)
+	}
+
+	if granter := tx.FeeGranter(); granter != nil {
+		fmt.Fprintf(&sb, "  Fee granter: %s\n", granter)
+	}
+
+	if memo := tx.GetMemo(); memo != "" {
+		fmt.Fprintf(&sb, "  Memo: %s\n", memo)
+	}
+
+	if timeoutHeight := tx.GetTimeoutHeight(); timeoutHeight != 0 {
+		fmt.Fprintf(&sb, "  Timeout height: %d\n", timeoutHeight)
+	}
+
+	return sb.String(), nil
+}
+
+// writeSummaryFields writes the fields of a JSON object, sorted by name, one
+// per line. Coin amounts are rendered in their compact form, e.g. 10stake.
+func writeSummaryFields(sb *strings.Builder, fields map[string]interface{}, indent string) {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := fields[name]
+		if nested, ok := value.(map[string]interface{}); ok && !isSummaryCoin(nested) {
+			fmt.Fprintf(sb, "%s%s:\n", indent, name)
+			writeSummaryFields(sb, nested, indent+"  ")
+			continue
+		}
+
+		fmt.Fprintf(sb, "%s%s: %s\n", indent, name, formatSummaryValue(value))
+	}
+}
+
+// formatSummaryValue renders a JSON value on a single line.
+func formatSummaryValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "<empty>"
+	case string:
+		if v == "" {
+			return "<empty>"
+		}
+		return v
+	case map[string]interface{}:
+		if isSummaryCoin(v) {
+			return fmt.Sprintf("%s%s", v["amount"], v["denom"])
+		}
+	case []interface{}:
+		if len(v) == 0 {
+			return "<empty>"
+		}
+
+		items := make([]string, len(v))
+		for i, item := range v {
+			items[i] = formatSummaryValue(item)
+		}
+		return strings.Join(items, ", ")
+	}
+
+	bz, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(bz)
+}
+
+// isSummaryCoin returns true if the JSON object is a coin or a decimal coin.
+func isSummaryCoin(obj map[string]interface{}) bool {
+	if len(obj) != 2 {
+		return false
+	}
+
+	_, hasDenom := obj["denom"].(string)
+	_, hasAmount := obj["amount"].(string)
+	return hasDenom && hasAmount
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 
+	feegrantv1beta1 "cosmossdk.io/api/cosmos/feegrant/v1beta1"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/spf13/pflag"
 
@@ -76,6 +77,17 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		return err
 	}
 
+	if granter := txf.FeeGranter(); granter != nil && !clientCtx.Offline {
+		grantee := txf.FeePayer()
+		if grantee == nil {
+			grantee = clientCtx.GetFromAddress()
+		}
+
+		if err := CheckFeeGrant(clientCtx, granter, grantee); err != nil {
+			return err
+		}
+	}
+
 	if txf.SimulateAndExecute() || clientCtx.Simulate {
 		if clientCtx.Offline {
 			return errors.New("cannot estimate gas in offline mode")
@@ -99,14 +111,25 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		return err
 	}
 
-	if !clientCtx.SkipConfirm {
-		txBytes, err := clientCtx.TxConfig.TxJSONEncoder()(tx.GetTx())
-		if err != nil {
-			return err
-		}
-
-		if err := clientCtx.PrintRaw(json.RawMessage(txBytes)); err != nil {
-			_, _ = fmt.Fprintf(os.Stderr, "error: %v\n%s\n", err, txBytes)
+	// --confirm requires a confirmation of the human-readable summary of the
+	// tx, even when --yes is set.
+	if clientCtx.Confirm || !clientCtx.SkipConfirm {
+		if clientCtx.Confirm {
+			summary, err := TxSummary(clientCtx, tx.GetTx())
+			if err != nil {
+				return err
+			}
+
+			_, _ = fmt.Fprint(os.Stderr, summary)
+		} else {
+			txBytes, err := clientCtx.TxConfig.TxJSONEncoder()(tx.GetTx())
+			if err != nil {
+				return err
+			}
+
+			if err := clientCtx.PrintRaw(json.RawMessage(txBytes)); err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "error: %v\n%s\n", err, txBytes)
+			}
 		}
 
 		buf := bufio.NewReader(os.Stdin)
@@ -161,6 +184,22 @@ func CalculateGas(
 	return simRes, uint64(txf.GasAdjustment() * float64(simRes.GasInfo.GasUsed)), nil
 }
 
+// CheckFeeGrant queries the fee allowance granted by granter to grantee and
+// returns an error if it does not exist, so that transactions whose fees are
+// paid through a missing fee grant are rejected before being broadcast.
+func CheckFeeGrant(clientCtx gogogrpc.ClientConn, granter, grantee sdk.AccAddress) error {
+	queryClient := feegrantv1beta1.NewQueryClient(clientCtx)
+	_, err := queryClient.Allowance(context.Background(), &feegrantv1beta1.QueryAllowanceRequest{
+		Granter: granter.String(),
+		Grantee: grantee.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to find fee allowance granted by %s to %s: %w", granter, grantee, err)
+	}
+
+	return nil
+}
+
 // SignWithPrivKey signs a given tx with the given private key, and returns the
 // corresponding SignatureV2 if the signing is successful.
 func SignWithPrivKey(
@@ -8,6 +8,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -105,6 +107,38 @@ func TestCalculateGas(t *testing.T) {
 	}
 }
 
+// mockFeeGrantContext is a mock client.Context answering fee allowance queries,
+// used to unit test CheckFeeGrant.
+type mockFeeGrantContext struct {
+	grantExists bool
+}
+
+func (m mockFeeGrantContext) Invoke(_ context.Context, method string, _, _ interface{}, _ ...grpc.CallOption) error {
+	if method != "/cosmos.feegrant.v1beta1.Query/Allowance" {
+		return fmt.Errorf("unexpected method %s", method)
+	}
+
+	if !m.grantExists {
+		return status.Error(codes.NotFound, "fee-grant not found")
+	}
+
+	return nil
+}
+
+func (mockFeeGrantContext) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	panic("not implemented")
+}
+
+func TestCheckFeeGrant(t *testing.T) {
+	granter := sdk.AccAddress("granter")
+	grantee := sdk.AccAddress("grantee")
+
+	require.NoError(t, tx.CheckFeeGrant(mockFeeGrantContext{grantExists: true}, granter, grantee))
+
+	err := tx.CheckFeeGrant(mockFeeGrantContext{grantExists: false}, granter, grantee)
+	require.ErrorContains(t, err, fmt.Sprintf("failed to find fee allowance granted by %s to %s", granter, grantee))
+}
+
 func TestBuildSimTx(t *testing.T) {
 	txCfg, cdc := newTestTxConfig()
 	defaultSignMode, err := signing.APISignModeToInternal(txCfg.SignModeHandler().DefaultMode())
@@ -453,3 +487,34 @@ func testSigners(require *require.Assertions, tr signing.Tx, pks ...cryptotypes.
 	}
 	return sigs
 }
+
+func TestTxSummary(t *testing.T) {
+	txConfig, cdc := newTestTxConfig()
+
+	from, to := sdk.AccAddress("from"), sdk.AccAddress("to")
+	txf := tx.Factory{}.
+		WithTxConfig(txConfig).
+		WithFees("50stake").
+		WithGas(200000).
+		WithMemo("memo").
+		WithChainID("test-chain")
+
+	msg := banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	txBuilder, err := txf.BuildUnsignedTx(msg)
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.WithCodec(cdc).WithChainID("test-chain")
+	summary, err := tx.TxSummary(clientCtx, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	require.Contains(t, summary, "Chain ID: test-chain")
+	require.Contains(t, summary, "Messages (1):")
+	require.Contains(t, summary, "1. /cosmos.bank.v1beta1.MsgSend")
+	require.Contains(t, summary, fmt.Sprintf("from_address: %s", from))
+	require.Contains(t, summary, fmt.Sprintf("to_address: %s", to))
+	require.Contains(t, summary, "amount: 10stake")
+	require.Contains(t, summary, fmt.Sprintf("Signers: %s", from))
+	require.Contains(t, summary, "Fee: 50stake (gas limit 200000)")
+	require.Contains(t, summary, "Memo: memo")
+	require.NotContains(t, summary, "Fee granter")
+}
@@ -0,0 +1,104 @@
+package address
+
+import (
+	"sync"
+
+	"cosmossdk.io/core/address"
+	"github.com/hashicorp/golang-lru/simplelru"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultCacheSize is the default number of addresses cached in each direction
+// by a codec returned by NewCachedCodec.
+const DefaultCacheSize = 60000
+
+type cachedCodec struct {
+	codec address.Codec
+
+	mu            sync.Mutex
+	bytesToString *simplelru.LRU
+	stringToBytes *simplelru.LRU
+}
+
+var _ address.Codec = &cachedCodec{}
+
+// NewCachedCodec returns an address.Codec caching the last size conversions of
+// the given codec in each direction. Bech32 encoding and decoding are expensive
+// and dominantly show up in the profiles of query-heavy nodes.
+// Like the caches of sdk.AccAddress, the cache is bypassed when
+// sdk.IsAddrCacheEnabled returns false.
+func NewCachedCodec(codec address.Codec, size int) (address.Codec, error) {
+	bytesToString, err := simplelru.NewLRU(size, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	stringToBytes, err := simplelru.NewLRU(size, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cachedCodec{
+		codec:         codec,
+		bytesToString: bytesToString,
+		stringToBytes: stringToBytes,
+	}, nil
+}
+
+// NewCachedBech32Codec returns a Bech32Codec for the prefix with a cache of
+// DefaultCacheSize addresses in each direction.
+func NewCachedBech32Codec(prefix string) address.Codec {
+	codec, err := NewCachedCodec(NewBech32Codec(prefix), DefaultCacheSize)
+	if err != nil {
+		panic(err)
+	}
+
+	return codec
+}
+
+// StringToBytes encodes text to bytes
+func (cc *cachedCodec) StringToBytes(text string) ([]byte, error) {
+	if !sdk.IsAddrCacheEnabled() {
+		return cc.codec.StringToBytes(text)
+	}
+
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	if bz, ok := cc.stringToBytes.Get(text); ok {
+		// return a copy, the caller may modify it
+		return append([]byte(nil), bz.([]byte)...), nil
+	}
+
+	bz, err := cc.codec.StringToBytes(text)
+	if err != nil {
+		return nil, err
+	}
+
+	cc.stringToBytes.Add(text, append([]byte(nil), bz...))
+	return bz, nil
+}
+
+// BytesToString decodes bytes to text
+func (cc *cachedCodec) BytesToString(bz []byte) (string, error) {
+	if !sdk.IsAddrCacheEnabled() {
+		return cc.codec.BytesToString(bz)
+	}
+
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	key := string(bz)
+	if text, ok := cc.bytesToString.Get(key); ok {
+		return text.(string), nil
+	}
+
+	text, err := cc.codec.BytesToString(bz)
+	if err != nil {
+		return "", err
+	}
+
+	cc.bytesToString.Add(key, text)
+	return text, nil
+}
@@ -0,0 +1,68 @@
+package address_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/core/address"
+	"github.com/stretchr/testify/require"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// countingCodec counts the conversions of the wrapped codec.
+type countingCodec struct {
+	address.Codec
+	calls int
+}
+
+func (c *countingCodec) StringToBytes(text string) ([]byte, error) {
+	c.calls++
+	return c.Codec.StringToBytes(text)
+}
+
+func (c *countingCodec) BytesToString(bz []byte) (string, error) {
+	c.calls++
+	return c.Codec.BytesToString(bz)
+}
+
+func TestCachedCodec(t *testing.T) {
+	counting := &countingCodec{Codec: addresscodec.NewBech32Codec("cosmos")}
+	ac, err := addresscodec.NewCachedCodec(counting, 2)
+	require.NoError(t, err)
+
+	addr := sdk.AccAddress("addr1_______________")
+	expText, err := addresscodec.NewBech32Codec("cosmos").BytesToString(addr)
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		text, err := ac.BytesToString(addr)
+		require.NoError(t, err)
+		require.Equal(t, expText, text)
+
+		bz, err := ac.StringToBytes(text)
+		require.NoError(t, err)
+		require.Equal(t, []byte(addr), bz)
+
+		// the cached bytes are not shared with the caller
+		bz[0] = 'x'
+	}
+	require.Equal(t, 2, counting.calls)
+
+	// errors are not cached
+	_, err = ac.StringToBytes("cosmos1invalid")
+	require.Error(t, err)
+	_, err = ac.StringToBytes("cosmos1invalid")
+	require.Error(t, err)
+	require.Equal(t, 4, counting.calls)
+
+	// the cache is bypassed when the address caches are disabled
+	sdk.SetAddrCacheEnabled(false)
+	defer sdk.SetAddrCacheEnabled(true)
+	_, err = ac.BytesToString(addr)
+	require.NoError(t, err)
+	require.Equal(t, 5, counting.calls)
+
+	_, err = addresscodec.NewCachedCodec(counting, 0)
+	require.Error(t, err)
+}
@@ -0,0 +1,11 @@
+//go:build noamino
+// +build noamino
+
+package codec
+
+// LegacyAminoEnabled is false when the binary is built with the noamino build
+// tag. Such binaries are pure-proto: the SIGN_MODE_LEGACY_AMINO_JSON and
+// SIGN_MODE_EIP_191 sign modes, both relying on amino JSON sign docs, cannot
+// be enabled in their TxConfig, and the modules don't register their types on
+// the app's LegacyAmino codec.
+const LegacyAminoEnabled = false
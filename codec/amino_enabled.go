@@ -0,0 +1,8 @@
+//go:build !noamino
+// +build !noamino
+
+package codec
+
+// LegacyAminoEnabled is true unless the binary is built with the noamino build
+// tag, see amino_disabled.go.
+const LegacyAminoEnabled = true
@@ -0,0 +1,307 @@
+package codec
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// CBOR major types, see RFC 8949 section 3.1.
+const (
+	cborMajorUint       byte = 0
+	cborMajorNegInt     byte = 1
+	cborMajorByteString byte = 2
+	cborMajorTextString byte = 3
+	cborMajorArray      byte = 4
+	cborMajorMap        byte = 5
+	cborMajorSimple     byte = 7
+
+	cborSimpleFalse byte = 20
+	cborSimpleTrue  byte = 21
+)
+
+const anyFullName protoreflect.FullName = "google.protobuf.Any"
+
+// MarshalDeterministicCBOR returns the deterministic CBOR (RFC 8949 section
+// 4.2.1) encoding of msg, whose type must be known to the interface registry
+// of cdc, and the messages packed in its Any's registered in it as
+// implementations of an interface.
+// It is meant for the payloads signed off-chain, e.g. by hardware wallets, for
+// which the JSON encoding is too large or ambiguous.
+//
+// Messages are encoded as maps from their field numbers to the values of their
+// populated fields: integers and enums as integers, booleans as booleans,
+// strings as text strings, bytes as byte strings, repeated fields as arrays and
+// map fields as maps. An Any is encoded as a message whose value field holds
+// the encoding of the message packed in it rather than its bytes, i.e.
+// {1: type URL, 2: message}. Floating point and unknown fields are rejected, as
+// their encoding would not be deterministic.
+func MarshalDeterministicCBOR(cdc ProtoCodecMarshaler, msg gogoproto.Message) ([]byte, error) {
+	msgV2, err := protoMessageV2(cdc.InterfaceRegistry(), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	enc := cborEncoder{registry: cdc.InterfaceRegistry()}
+	var buf bytes.Buffer
+	if err := enc.encodeMessage(&buf, msgV2.ProtoReflect()); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// MustMarshalDeterministicCBOR is like MarshalDeterministicCBOR but panics if
+// an error occurs.
+func MustMarshalDeterministicCBOR(cdc ProtoCodecMarshaler, msg gogoproto.Message) []byte {
+	bz, err := MarshalDeterministicCBOR(cdc, msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// protoMessageV2 returns msg as a protoreflect message, decoding it with the
+// descriptors of the registry if it is a gogoproto message.
+func protoMessageV2(registry types.InterfaceRegistry, msg gogoproto.Message) (proto.Message, error) {
+	if msgV2, ok := msg.(proto.Message); ok {
+		return msgV2, nil
+	}
+
+	bz, err := gogoproto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeDynamic(registry, gogoproto.MessageName(msg), bz)
+}
+
+// decodeDynamic decodes bz as a dynamic message of the type named name, whose
+// descriptor is looked up in the registry.
+func decodeDynamic(registry types.InterfaceRegistry, name string, bz []byte) (proto.Message, error) {
+	desc, err := registry.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, err
+	}
+
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", name)
+	}
+
+	msg := dynamicpb.NewMessage(md)
+	if err := proto.Unmarshal(bz, msg); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+type cborEncoder struct {
+	registry types.InterfaceRegistry
+}
+
+func (e cborEncoder) encodeMessage(buf *bytes.Buffer, msg protoreflect.Message) error {
+	if len(msg.GetUnknown()) > 0 {
+		return fmt.Errorf("cannot CBOR encode %s: unknown fields", msg.Descriptor().FullName())
+	}
+
+	if msg.Descriptor().FullName() == anyFullName {
+		return e.encodeAny(buf, msg)
+	}
+
+	var entries []cborEntry
+	var err error
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		var value bytes.Buffer
+		if err = e.encodeField(&value, fd, v); err != nil {
+			return false
+		}
+
+		entries = append(entries, cborEntry{key: cborUint(uint64(fd.Number())), value: value.Bytes()})
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	return writeCBORMap(buf, entries)
+}
+
+// encodeAny encodes the Any msg with the encoding of the message packed in it
+// as value. As with UnpackAny, the packed message must be registered as an
+// implementation of an interface in the registry.
+func (e cborEncoder) encodeAny(buf *bytes.Buffer, msg protoreflect.Message) error {
+	fields := msg.Descriptor().Fields()
+	typeURL := msg.Get(fields.ByName("type_url")).String()
+
+	impl, err := e.registry.Resolve(typeURL)
+	if err != nil {
+		return fmt.Errorf("cannot CBOR encode Any of %s: %w", typeURL, err)
+	}
+
+	packed, err := decodeDynamic(e.registry, gogoproto.MessageName(impl), msg.Get(fields.ByName("value")).Bytes())
+	if err != nil {
+		return fmt.Errorf("cannot CBOR encode Any of %s: %w", typeURL, err)
+	}
+
+	var value bytes.Buffer
+	if err := e.encodeMessage(&value, packed.ProtoReflect()); err != nil {
+		return err
+	}
+
+	var url bytes.Buffer
+	writeCBORHead(&url, cborMajorTextString, uint64(len(typeURL)))
+	url.WriteString(typeURL)
+
+	return writeCBORMap(buf, []cborEntry{
+		{key: cborUint(1), value: url.Bytes()},
+		{key: cborUint(2), value: value.Bytes()},
+	})
+}
+
+func (e cborEncoder) encodeField(buf *bytes.Buffer, fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+	switch {
+	case fd.IsList():
+		list := v.List()
+		writeCBORHead(buf, cborMajorArray, uint64(list.Len()))
+		for i := 0; i < list.Len(); i++ {
+			if err := e.encodeValue(buf, fd, list.Get(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case fd.IsMap():
+		var entries []cborEntry
+		var err error
+		v.Map().Range(func(k protoreflect.MapKey, mv protoreflect.Value) bool {
+			var key, value bytes.Buffer
+			if err = e.encodeValue(&key, fd.MapKey(), k.Value()); err != nil {
+				return false
+			}
+			if err = e.encodeValue(&value, fd.MapValue(), mv); err != nil {
+				return false
+			}
+
+			entries = append(entries, cborEntry{key: key.Bytes(), value: value.Bytes()})
+			return true
+		})
+		if err != nil {
+			return err
+		}
+		return writeCBORMap(buf, entries)
+
+	default:
+		return e.encodeValue(buf, fd, v)
+	}
+}
+
+// encodeValue encodes the singular value v of a field of the kind of fd.
+func (e cborEncoder) encodeValue(buf *bytes.Buffer, fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		simple := cborSimpleFalse
+		if v.Bool() {
+			simple = cborSimpleTrue
+		}
+		writeCBORHead(buf, cborMajorSimple, uint64(simple))
+
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		writeCBORInt(buf, v.Int())
+
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		writeCBORHead(buf, cborMajorUint, v.Uint())
+
+	case protoreflect.EnumKind:
+		writeCBORInt(buf, int64(v.Enum()))
+
+	case protoreflect.StringKind:
+		writeCBORHead(buf, cborMajorTextString, uint64(len(v.String())))
+		buf.WriteString(v.String())
+
+	case protoreflect.BytesKind:
+		writeCBORHead(buf, cborMajorByteString, uint64(len(v.Bytes())))
+		buf.Write(v.Bytes())
+
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return e.encodeMessage(buf, v.Message())
+
+	default:
+		return fmt.Errorf("cannot CBOR encode field %s of kind %s", fd.FullName(), fd.Kind())
+	}
+
+	return nil
+}
+
+// cborEntry is an encoded key/value pair of a CBOR map.
+type cborEntry struct {
+	key, value []byte
+}
+
+// writeCBORMap writes the map of entries, sorted by the bytewise lexicographic
+// order of their encoded keys as required by the deterministic encoding.
+func writeCBORMap(buf *bytes.Buffer, entries []cborEntry) error {
+	sort.Slice(entries, func(i, j int) bool {
+		return bytes.Compare(entries[i].key, entries[j].key) < 0
+	})
+
+	writeCBORHead(buf, cborMajorMap, uint64(len(entries)))
+	for i, entry := range entries {
+		if i > 0 && bytes.Equal(entries[i-1].key, entry.key) {
+			return fmt.Errorf("cannot CBOR encode map with duplicate key %x", entry.key)
+		}
+
+		buf.Write(entry.key)
+		buf.Write(entry.value)
+	}
+
+	return nil
+}
+
+func cborUint(n uint64) []byte {
+	var buf bytes.Buffer
+	writeCBORHead(&buf, cborMajorUint, n)
+	return buf.Bytes()
+}
+
+func writeCBORInt(buf *bytes.Buffer, n int64) {
+	if n >= 0 {
+		writeCBORHead(buf, cborMajorUint, uint64(n))
+		return
+	}
+
+	// -1-n, i.e. the bitwise complement of n
+	writeCBORHead(buf, cborMajorNegInt, uint64(^n))
+}
+
+// writeCBORHead writes the initial byte of a data item of the major type and
+// its argument, in its shortest form.
+func writeCBORHead(buf *bytes.Buffer, major byte, arg uint64) {
+	first := major << 5
+	switch {
+	case arg < 24:
+		buf.WriteByte(first | byte(arg))
+	case arg <= math.MaxUint8:
+		buf.Write([]byte{first | 24, byte(arg)})
+	case arg <= math.MaxUint16:
+		buf.Write([]byte{first | 25, byte(arg >> 8), byte(arg)})
+	case arg <= math.MaxUint32:
+		buf.Write([]byte{first | 26, byte(arg >> 24), byte(arg >> 16), byte(arg >> 8), byte(arg)})
+	default:
+		buf.WriteByte(first | 27)
+		for shift := 56; shift >= 0; shift -= 8 {
+			buf.WriteByte(byte(arg >> shift))
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package codec_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+)
+
+func TestMarshalDeterministicCBOR(t *testing.T) {
+	cdc := codec.NewProtoCodec(NewTestInterfaceRegistry())
+
+	dog, err := types.NewAnyWithValue(&testdata.Dog{Size_: "small", Name: "Spot"})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		msg    codec.ProtoMarshaler
+		expHex string
+	}{
+		{
+			"empty message",
+			&testdata.Cat{},
+			"a0",
+		},
+		{
+			"default values are omitted",
+			&testdata.Cat{Moniker: "Garfield"},
+			"a1" + "01" + "68" + hex.EncodeToString([]byte("Garfield")),
+		},
+		{
+			"multi-byte integer",
+			&testdata.Cat{Lives: 300},
+			"a1" + "02" + "19012c",
+		},
+		{
+			"negative integer",
+			&testdata.Cat{Lives: -1000},
+			"a1" + "02" + "3903e7",
+		},
+		{
+			"any is encoded with the packed message",
+			&testdata.HasAnimal{Animal: dog, X: -2},
+			"a2" +
+				"01" + "a2" +
+				"01" + "6b" + hex.EncodeToString([]byte("/testpb.Dog")) +
+				"02" + "a2" + "01" + "65" + hex.EncodeToString([]byte("small")) + "02" + "64" + hex.EncodeToString([]byte("Spot")) +
+				"02" + "21",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := codec.MarshalDeterministicCBOR(cdc, tc.msg)
+			require.NoError(t, err)
+			require.Equal(t, tc.expHex, hex.EncodeToString(bz))
+
+			// the encoding doesn't depend on the map ordering
+			for i := 0; i < 10; i++ {
+				require.Equal(t, bz, codec.MustMarshalDeterministicCBOR(cdc, tc.msg))
+			}
+		})
+	}
+}
+
+func TestMarshalDeterministicCBORErrors(t *testing.T) {
+	cdc := codec.NewProtoCodec(NewTestInterfaceRegistry())
+
+	_, err := codec.MarshalDeterministicCBOR(cdc, structpb.NewNumberValue(1.5))
+	require.ErrorContains(t, err, "cannot CBOR encode field google.protobuf.Value.number_value of kind double")
+
+	unregistered := &testdata.HasAnimal{Animal: &types.Any{TypeUrl: "/testpb.Unknown", Value: []byte{0x0a, 0x01, 0x61}}}
+	_, err = codec.MarshalDeterministicCBOR(cdc, unregistered)
+	require.ErrorContains(t, err, "cannot CBOR encode Any of /testpb.Unknown")
+
+	// the type of the packed message is known to the registry but not registered
+	// as an implementation of an interface, so it can't be unpacked on-chain
+	cat, err := types.NewAnyWithValue(&testdata.Cat{Moniker: "Garfield"})
+	require.NoError(t, err)
+	dogRegistry := types.NewInterfaceRegistry()
+	dogRegistry.RegisterImplementations((*testdata.Animal)(nil), &testdata.Dog{})
+	_, err = codec.MarshalDeterministicCBOR(codec.NewProtoCodec(dogRegistry), &testdata.HasAnimal{Animal: cat})
+	require.ErrorContains(t, err, "cannot CBOR encode Any of /testpb.Cat: unable to resolve type URL /testpb.Cat")
+
+	require.Panics(t, func() {
+		codec.MustMarshalDeterministicCBOR(cdc, unregistered)
+	})
+}
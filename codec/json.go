@@ -2,6 +2,11 @@ package codec
 
 import (
 	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
 
 	"github.com/cosmos/gogoproto/jsonpb"
 	"github.com/cosmos/gogoproto/proto"
@@ -32,3 +37,119 @@ func ProtoMarshalJSON(msg proto.Message, resolver jsonpb.AnyResolver) ([]byte, e
 
 	return buf.Bytes(), nil
 }
+
+// ProtoMarshalDeterministicJSON is like ProtoMarshalJSON, but returns the
+// canonical JSON encoding of the message, as returned by CanonicalizeJSON, so
+// that the same message is always encoded to the same bytes. It must be used
+// when the JSON encoding is signed or hashed.
+func ProtoMarshalDeterministicJSON(msg proto.Message, resolver jsonpb.AnyResolver) ([]byte, error) {
+	bz, err := ProtoMarshalJSON(msg, resolver)
+	if err != nil {
+		return nil, err
+	}
+
+	return CanonicalizeJSON(bz)
+}
+
+// MarshalDeterministicJSON returns the canonical JSON encoding of the message
+// by the codec, as returned by CanonicalizeJSON.
+func MarshalDeterministicJSON(cdc JSONCodec, msg proto.Message) ([]byte, error) {
+	bz, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return CanonicalizeJSON(bz)
+}
+
+// MustMarshalDeterministicJSON is like MarshalDeterministicJSON but panics if
+// an error occurs.
+func MustMarshalDeterministicJSON(cdc JSONCodec, msg proto.Message) []byte {
+	bz, err := MarshalDeterministicJSON(cdc, msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// CanonicalizeJSON returns the canonical encoding of the JSON document bz:
+// without insignificant whitespace, with the keys of the objects sorted,
+// the strings escaped as by encoding/json without HTML escaping, the integers
+// in decimal notation, and the other numbers formatted as by encoding/json for
+// float64 values, e.g. 1.0 and 1e0 are both formatted as 1.
+func CanonicalizeJSON(bz []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	if dec.More() {
+		return nil, fmt.Errorf("invalid JSON: unexpected data after the top-level value")
+	}
+
+	v, err := canonicalizeJSONValue(v)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	// maps are encoded with their keys sorted
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+func canonicalizeJSONValue(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			value, err := canonicalizeJSONValue(value)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = value
+		}
+	case []interface{}:
+		for i, value := range v {
+			value, err := canonicalizeJSONValue(value)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = value
+		}
+	case json.Number:
+		return canonicalizeJSONNumber(v)
+	}
+
+	return v, nil
+}
+
+func canonicalizeJSONNumber(n json.Number) (json.Number, error) {
+	// integers are kept exact, whatever their size
+	if !strings.ContainsAny(n.String(), ".eE") {
+		i, ok := new(big.Int).SetString(n.String(), 10)
+		if !ok {
+			return "", fmt.Errorf("invalid JSON number %s", n)
+		}
+		return json.Number(i.String()), nil
+	}
+
+	f, err := strconv.ParseFloat(n.String(), 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid JSON number %s: %w", n, err)
+	}
+
+	bz, err := json.Marshal(f)
+	if err != nil {
+		return "", err
+	}
+
+	return json.Number(bz), nil
+}
@@ -0,0 +1,88 @@
+package codec_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+)
+
+func TestProtoMarshalDeterministicJSON(t *testing.T) {
+	registry := createTestInterfaceRegistry()
+	dog, err := types.NewAnyWithValue(&testdata.Dog{Name: "Spot <3", Size_: "big"})
+	require.NoError(t, err)
+	msg := &testdata.HasAnimal{Animal: dog, X: 10}
+
+	bz, err := codec.ProtoMarshalDeterministicJSON(msg, registry)
+	require.NoError(t, err)
+	require.Equal(t, `{"animal":{"@type":"/testpb.Dog","name":"Spot <3","size":"big"},"x":"10"}`, string(bz))
+
+	// the encoding is the same for each call and for each codec
+	for i := 0; i < 10; i++ {
+		bz2, err := codec.MarshalDeterministicJSON(codec.NewProtoCodec(registry), msg)
+		require.NoError(t, err)
+		require.Equal(t, bz, bz2)
+	}
+
+	// the canonical encoding is decoded to the same message
+	var decoded testdata.HasAnimal
+	require.NoError(t, codec.NewProtoCodec(registry).UnmarshalJSON(bz, &decoded))
+	require.Equal(t, msg.X, decoded.X)
+	require.Equal(t, "Spot <3", decoded.Animal.GetCachedValue().(*testdata.Dog).Name)
+}
+
+func TestCanonicalizeJSON(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  string
+		expOut string
+		expErr string
+	}{
+		{
+			name:   "keys sorted and whitespace removed",
+			input:  "{\n  \"b\": [ 1, {\"d\": true, \"c\": null} ],\n  \"a\": \"x\"\n}",
+			expOut: `{"a":"x","b":[1,{"c":null,"d":true}]}`,
+		},
+		{
+			name:   "numbers formatted",
+			input:  `[1.0, 1e0, -0, 1.50, 1e-7, 1E21, 123456789012345678901234567890]`,
+			expOut: `[1,1,0,1.5,1e-7,1e+21,123456789012345678901234567890]`,
+		},
+		{
+			name:   "strings escaped without HTML escaping",
+			input:  `{"s":"<a> & \"b\" é"}`,
+			expOut: `{"s":"<a> & \"b\" é"}`,
+		},
+		{
+			name:   "invalid JSON",
+			input:  `{"a":`,
+			expErr: "unexpected EOF",
+		},
+		{
+			name:   "trailing data",
+			input:  `{"a":1} {"b":2}`,
+			expErr: "unexpected data after the top-level value",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := codec.CanonicalizeJSON([]byte(tc.input))
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expOut, string(bz))
+
+			// the canonical encoding is a fixed point
+			bz2, err := codec.CanonicalizeJSON(bz)
+			require.NoError(t, err)
+			require.Equal(t, bz, bz2)
+		})
+	}
+}
@@ -3,9 +3,11 @@ package types
 import (
 	"fmt"
 	"reflect"
+	"sync"
 
 	"github.com/cosmos/gogoproto/jsonpb"
 	"github.com/cosmos/gogoproto/proto"
+	"github.com/hashicorp/golang-lru/simplelru"
 	"google.golang.org/protobuf/reflect/protodesc"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/reflect/protoregistry"
@@ -58,6 +60,18 @@ type InterfaceRegistry interface {
 	// EnsureRegistered ensures there is a registered interface for the given concrete type.
 	EnsureRegistered(iface interface{}) error
 
+	// SetUnpackCacheSize enables the memoization of the values unpacked by
+	// UnpackAny, for the given number of distinct values, so that the same
+	// value unpacked again from a new Any, e.g. a validator public key read
+	// from the store every block, is not unmarshaled again. A size of 0
+	// disables the cache.
+	//
+	// The unpacked values are shared between the Any's they are unpacked from,
+	// so they must not be mutated. Only values without nested Any's, and
+	// whose encoding is at most 1KiB, are cached. The cache is cleared when
+	// implementations are registered.
+	SetUnpackCacheSize(size int) error
+
 	protodesc.Resolver
 
 	// RangeFiles iterates over all registered files and calls f on each one. This
@@ -95,12 +109,21 @@ type UnpackInterfacesMessage interface {
 	UnpackInterfaces(unpacker AnyUnpacker) error
 }
 
+// maxUnpackCacheValueSize is the maximum size of the encoding of the values
+// memoized by the unpack cache of the registry.
+const maxUnpackCacheValueSize = 1024
+
 type interfaceRegistry struct {
 	*protoregistry.Files
 	interfaceNames map[string]reflect.Type
 	interfaceImpls map[reflect.Type]interfaceMap
 	implInterfaces map[reflect.Type]reflect.Type
 	typeURLMap     map[string]reflect.Type
+
+	// unpackCache holds the values unpacked by UnpackAny, by type URL and
+	// encoding, if enabled by SetUnpackCacheSize.
+	unpackCacheMu sync.Mutex
+	unpackCache   *simplelru.LRU
 }
 
 type interfaceMap = map[string]reflect.Type
@@ -210,6 +233,78 @@ func (registry *interfaceRegistry) registerImpl(iface interface{}, typeURL strin
 	registry.typeURLMap[typeURL] = implType
 	registry.implInterfaces[implType] = ityp
 	registry.interfaceImpls[ityp] = imap
+
+	// the cached values may have been unpacked to a type no longer registered
+	// under their type URL
+	registry.purgeUnpackCache()
+}
+
+func (registry *interfaceRegistry) SetUnpackCacheSize(size int) error {
+	registry.unpackCacheMu.Lock()
+	defer registry.unpackCacheMu.Unlock()
+
+	if size == 0 {
+		registry.unpackCache = nil
+		return nil
+	}
+
+	cache, err := simplelru.NewLRU(size, nil)
+	if err != nil {
+		return err
+	}
+	registry.unpackCache = cache
+
+	return nil
+}
+
+func (registry *interfaceRegistry) purgeUnpackCache() {
+	registry.unpackCacheMu.Lock()
+	defer registry.unpackCacheMu.Unlock()
+
+	if registry.unpackCache != nil {
+		registry.unpackCache.Purge()
+	}
+}
+
+// cachedUnpack returns the value unpacked from the given type URL and
+// encoding, if it is in the unpack cache.
+func (registry *interfaceRegistry) cachedUnpack(typeURL string, value []byte) (proto.Message, bool) {
+	registry.unpackCacheMu.Lock()
+	defer registry.unpackCacheMu.Unlock()
+
+	if registry.unpackCache == nil || len(value) > maxUnpackCacheValueSize {
+		return nil, false
+	}
+
+	msg, ok := registry.unpackCache.Get(unpackCacheKey(typeURL, value))
+	if !ok {
+		return nil, false
+	}
+
+	return msg.(proto.Message), true
+}
+
+// cacheUnpack adds the value unpacked from the given type URL and encoding to
+// the unpack cache, if it can be shared.
+func (registry *interfaceRegistry) cacheUnpack(typeURL string, value []byte, msg proto.Message) {
+	// the nested Any's of a value are unpacked in place, so such values
+	// are not shared
+	if _, ok := msg.(UnpackInterfacesMessage); ok {
+		return
+	}
+
+	registry.unpackCacheMu.Lock()
+	defer registry.unpackCacheMu.Unlock()
+
+	if registry.unpackCache == nil || len(value) > maxUnpackCacheValueSize {
+		return
+	}
+
+	registry.unpackCache.Add(unpackCacheKey(typeURL, value), msg)
+}
+
+func unpackCacheKey(typeURL string, value []byte) string {
+	return typeURL + "\x00" + string(value)
 }
 
 func (registry *interfaceRegistry) ListAllInterfaces() []string {
@@ -275,6 +370,12 @@ func (registry *interfaceRegistry) UnpackAny(any *Any, iface interface{}) error
 		return fmt.Errorf("no concrete type registered for type URL %s against interface %T", any.TypeUrl, iface)
 	}
 
+	if msg, ok := registry.cachedUnpack(any.TypeUrl, any.Value); ok && reflect.TypeOf(msg) == typ {
+		rv.Elem().Set(reflect.ValueOf(msg))
+		any.cachedValue = msg
+		return nil
+	}
+
 	msg, ok := reflect.New(typ.Elem()).Interface().(proto.Message)
 	if !ok {
 		return fmt.Errorf("can't proto unmarshal %T", msg)
@@ -291,6 +392,7 @@ func (registry *interfaceRegistry) UnpackAny(any *Any, iface interface{}) error
 	}
 
 	rv.Elem().Set(reflect.ValueOf(msg))
+	registry.cacheUnpack(any.TypeUrl, any.Value, msg)
 
 	any.cachedValue = msg
 
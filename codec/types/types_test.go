@@ -180,3 +180,59 @@ func TestAny_ProtoJSON(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, spot, ha2.Animal.GetCachedValue())
 }
+
+func TestUnpackCache(t *testing.T) {
+	registry := testdata.NewTestInterfaceRegistry()
+	require.NoError(t, registry.SetUnpackCacheSize(10))
+
+	spot := &testdata.Dog{Name: "Spot"}
+	bz, err := proto.Marshal(spot)
+	require.NoError(t, err)
+
+	unpack := func(typeURL string, value []byte, iface interface{}) {
+		t.Helper()
+		any := &types.Any{TypeUrl: typeURL, Value: value}
+		require.NoError(t, registry.UnpackAny(any, iface))
+	}
+
+	// the same value unpacked from new Any's is shared
+	var animal1, animal2 testdata.Animal
+	unpack("/testpb.Dog", bz, &animal1)
+	unpack("/testpb.Dog", bz, &animal2)
+	require.Equal(t, spot, animal1)
+	require.Same(t, animal1, animal2)
+
+	// a different value is not
+	rexBz, err := proto.Marshal(&testdata.Dog{Name: "Rex"})
+	require.NoError(t, err)
+	var animal3 testdata.Animal
+	unpack("/testpb.Dog", rexBz, &animal3)
+	require.Equal(t, &testdata.Dog{Name: "Rex"}, animal3)
+
+	// the values with nested Any's are not shared
+	any, err := types.NewAnyWithValue(spot)
+	require.NoError(t, err)
+	hasAnimalBz, err := proto.Marshal(&testdata.HasAnimal{Animal: any})
+	require.NoError(t, err)
+	var hasAnimal1, hasAnimal2 testdata.HasAnimalI
+	unpack("/testpb.HasAnimal", hasAnimalBz, &hasAnimal1)
+	unpack("/testpb.HasAnimal", hasAnimalBz, &hasAnimal2)
+	require.NotSame(t, hasAnimal1, hasAnimal2)
+	require.Same(t, animal1, hasAnimal1.TheAnimal())
+
+	// the cache is cleared when implementations are registered
+	registry.RegisterImplementations((*testdata.Animal)(nil), &testdata.Cat{})
+	var animal4 testdata.Animal
+	unpack("/testpb.Dog", bz, &animal4)
+	require.Equal(t, spot, animal4)
+	require.NotSame(t, animal1, animal4)
+
+	// the cache is disabled with a size of 0
+	require.NoError(t, registry.SetUnpackCacheSize(0))
+	var animal5, animal6 testdata.Animal
+	unpack("/testpb.Dog", bz, &animal5)
+	unpack("/testpb.Dog", bz, &animal6)
+	require.NotSame(t, animal5, animal6)
+
+	require.Error(t, registry.SetUnpackCacheSize(-1))
+}
@@ -3,6 +3,7 @@ package hd
 import (
 	"github.com/cosmos/go-bip39"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 )
@@ -20,10 +21,19 @@ const (
 	Ed25519Type = PubKeyType("ed25519")
 	// Sr25519Type represents the Sr25519Type signature system.
 	Sr25519Type = PubKeyType("sr25519")
+	// EthSecp256k1Type uses the secp256k1 ECDSA parameters with the Ethereum
+	// Keccak-256 hashing of the addresses and sign bytes.
+	EthSecp256k1Type = PubKeyType(ethsecp256k1.KeyType)
 )
 
-// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
-var Secp256k1 = secp256k1Algo{}
+var (
+	// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
+	Secp256k1 = secp256k1Algo{}
+	// EthSecp256k1 uses the secp256k1 ECDSA parameters, with Ethereum addresses
+	// and signatures. It must be added to the keyring supported algorithms to be
+	// used.
+	EthSecp256k1 = ethSecp256k1Algo{}
+)
 
 type (
 	DeriveFn   func(mnemonic, bip39Passphrase, hdPath string) ([]byte, error)
@@ -68,3 +78,26 @@ func (s secp256k1Algo) Generate() GenerateFn {
 		return &secp256k1.PrivKey{Key: bzArr}
 	}
 }
+
+type ethSecp256k1Algo struct{}
+
+func (s ethSecp256k1Algo) Name() PubKeyType {
+	return EthSecp256k1Type
+}
+
+// Derive derives and returns the Ethereum secp256k1 private key for the given
+// seed and HD path. The derivation is the same as for secp256k1, only the coin
+// type of the HD path usually differs.
+func (s ethSecp256k1Algo) Derive() DeriveFn {
+	return Secp256k1.Derive()
+}
+
+// Generate generates an Ethereum secp256k1 private key from the given bytes.
+func (s ethSecp256k1Algo) Generate() GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		bzArr := make([]byte, ethsecp256k1.PrivKeySize)
+		copy(bzArr, bz)
+
+		return &ethsecp256k1.PrivKey{Key: bzArr}
+	}
+}
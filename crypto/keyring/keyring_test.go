@@ -23,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	cosmosbcrypt "github.com/cosmos/cosmos-sdk/crypto/keys/bcrypt"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
@@ -1881,6 +1882,45 @@ func TestAltKeyring_ConstructorSupportedAlgos(t *testing.T) {
 	}
 }
 
+func TestAltKeyring_EthSecp256k1(t *testing.T) {
+	cdc := getCodec()
+
+	// eth_secp256k1 keys are only supported when enabled
+	kr, err := New(t.Name(), BackendTest, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+	_, _, err = kr.NewMnemonic("eth", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.EthSecp256k1)
+	require.ErrorIs(t, err, ErrUnsupportedSigningAlgo)
+
+	kr, err = New(t.Name(), BackendTest, t.TempDir(), nil, cdc, func(options *Options) {
+		options.SupportedAlgos = SigningAlgoList{hd.Secp256k1, hd.EthSecp256k1}
+	})
+	require.NoError(t, err)
+
+	k, _, err := kr.NewMnemonic("eth", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.EthSecp256k1)
+	require.NoError(t, err)
+
+	pub, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.IsType(t, &ethsecp256k1.PubKey{}, pub)
+
+	// the address is derived from the Keccak-256 hash of the pubkey
+	addr, err := k.GetAddress()
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(pub.Address()), addr)
+
+	msg := []byte("some message")
+	sig, key, err := kr.Sign("eth", msg, signing.SignMode_SIGN_MODE_EIP_191)
+	require.NoError(t, err)
+	require.True(t, key.VerifySignature(msg, sig))
+
+	// the key is persisted with its algorithm
+	k, err = kr.Key("eth")
+	require.NoError(t, err)
+	pub2, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, pub.Equals(pub2))
+}
+
 // TODO: review it
 func TestBackendConfigConstructors(t *testing.T) {
 	backend := newKWalletBackendKeyringConfig("test", "", nil)
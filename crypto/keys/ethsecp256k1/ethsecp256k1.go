@@ -0,0 +1,233 @@
+package ethsecp256k1
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cometbft/cometbft/crypto"
+	secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ cryptotypes.PrivKey  = &PrivKey{}
+	_ codec.AminoMarshaler = &PrivKey{}
+)
+
+const (
+	PrivKeySize = 32
+	KeyType     = "eth_secp256k1"
+	PrivKeyName = "cosmos-sdk/PrivKeyEthSecp256k1"
+	PubKeyName  = "cosmos-sdk/PubKeyEthSecp256k1"
+
+	// SignatureSize is the size of the signatures created by PrivKey.Sign, in
+	// the Ethereum R || S || V form.
+	SignatureSize = 65
+)
+
+// Keccak256 returns the Keccak-256 hash of the given bytes, as used by
+// Ethereum.
+func Keccak256(bz []byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(bz) // does not error
+	return hasher.Sum(nil)
+}
+
+// GenPrivKey generates a new Ethereum secp256k1 private key. It uses OS
+// randomness to generate the private key.
+func GenPrivKey() *PrivKey {
+	priv, err := secp256k1.GeneratePrivateKeyFromRand(crypto.CReader())
+	if err != nil {
+		panic(err)
+	}
+
+	return &PrivKey{Key: priv.Serialize()}
+}
+
+// Bytes returns the byte representation of the Private Key.
+func (privKey *PrivKey) Bytes() []byte {
+	return privKey.Key
+}
+
+// PubKey performs the point-scalar multiplication from the privKey on the
+// generator point to get the pubkey.
+func (privKey *PrivKey) PubKey() cryptotypes.PubKey {
+	pubkeyObject := secp256k1.PrivKeyFromBytes(privKey.Key).PubKey()
+	return &PubKey{Key: pubkeyObject.SerializeCompressed()}
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey *PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
+	return privKey.Type() == other.Type() && subtle.ConstantTimeCompare(privKey.Bytes(), other.Bytes()) == 1
+}
+
+func (privKey *PrivKey) Type() string {
+	return KeyType
+}
+
+// Sign creates an ECDSA signature on curve secp256k1, using Keccak-256 on the
+// msg, as Ethereum does. The returned signature is of the form R || S || V (in
+// lower-S form), where V is the recovery id, either 0 or 1.
+func (privKey *PrivKey) Sign(msg []byte) ([]byte, error) {
+	priv := secp256k1.PrivKeyFromBytes(privKey.Key)
+	sig := ecdsa.SignCompact(priv, Keccak256(msg), false)
+
+	// move the compactSigRecoveryCode, which is 27 + the recovery id for
+	// uncompressed keys, at the end of the signature
+	return append(sig[1:], sig[0]-27), nil
+}
+
+// MarshalAmino overrides Amino binary marshaling.
+func (privKey PrivKey) MarshalAmino() ([]byte, error) {
+	return privKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshaling.
+func (privKey *PrivKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PrivKeySize {
+		return fmt.Errorf("invalid privkey size")
+	}
+	privKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshaling.
+func (privKey PrivKey) MarshalAminoJSON() ([]byte, error) {
+	// When we marshal to Amino JSON, we don't marshal the "key" field itself,
+	// just its contents (i.e. the key bytes).
+	return privKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshaling.
+func (privKey *PrivKey) UnmarshalAminoJSON(bz []byte) error {
+	return privKey.UnmarshalAmino(bz)
+}
+
+//-------------------------------------
+
+var (
+	_ cryptotypes.PubKey   = &PubKey{}
+	_ codec.AminoMarshaler = &PubKey{}
+)
+
+// PubKeySize is comprised of 32 bytes for one field element
+// (the x-coordinate), plus one byte for the parity of the y-coordinate.
+const PubKeySize = 33
+
+// Address returns an Ethereum style address: the last 20 bytes of the
+// Keccak-256 hash of the uncompressed pubkey, without its 0x04 prefix.
+func (pubKey *PubKey) Address() crypto.Address {
+	if len(pubKey.Key) != PubKeySize {
+		panic("length of pubkey is incorrect")
+	}
+
+	pub, err := secp256k1.ParsePubKey(pubKey.Key)
+	if err != nil {
+		panic(err)
+	}
+
+	hash := Keccak256(pub.SerializeUncompressed()[1:])
+	return crypto.Address(hash[12:])
+}
+
+// Bytes returns the pubkey byte format.
+func (pubKey *PubKey) Bytes() []byte {
+	return pubKey.Key
+}
+
+func (pubKey *PubKey) String() string {
+	return fmt.Sprintf("PubKeyEthSecp256k1{%X}", pubKey.Key)
+}
+
+func (pubKey *PubKey) Type() string {
+	return KeyType
+}
+
+func (pubKey *PubKey) Equals(other cryptotypes.PubKey) bool {
+	return pubKey.Type() == other.Type() && bytes.Equal(pubKey.Bytes(), other.Bytes())
+}
+
+// VerifySignature verifies a signature of the form R || S || V over the
+// Keccak-256 hash of msg. To make the signatures non-malleable, it rejects
+// signatures which are not in lower-S form, and requires the recovery id V to
+// be the one, either 0 or 1, from which pubKey is recovered.
+func (pubKey *PubKey) VerifySignature(msg, sigStr []byte) bool {
+	if len(sigStr) != SignatureSize {
+		return false
+	}
+
+	v := sigStr[SignatureSize-1]
+	if v > 1 {
+		return false
+	}
+
+	// will return error if the signature is not in lower-S form
+	if err := checkSignature(sigStr[:SignatureSize-1]); err != nil {
+		return false
+	}
+
+	// recovering the pubkey verifies the signature
+	compactSig := append([]byte{27 + v}, sigStr[:SignatureSize-1]...)
+	recovered, _, err := ecdsa.RecoverCompact(compactSig, Keccak256(msg))
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(recovered.SerializeCompressed(), pubKey.Key)
+}
+
+// MarshalAmino overrides Amino binary marshaling.
+func (pubKey PubKey) MarshalAmino() ([]byte, error) {
+	return pubKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshaling.
+func (pubKey *PubKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PubKeySize {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "invalid pubkey size")
+	}
+	pubKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshaling.
+func (pubKey PubKey) MarshalAminoJSON() ([]byte, error) {
+	// When we marshal to Amino JSON, we don't marshal the "key" field itself,
+	// just its contents (i.e. the key bytes).
+	return pubKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshaling.
+func (pubKey *PubKey) UnmarshalAminoJSON(bz []byte) error {
+	return pubKey.UnmarshalAmino(bz)
+}
+
+// checkSignature checks that R and S of the signature R || S are in range.
+// Caller needs to ensure that len(sigStr) == 64.
+// Rejects malleable signatures (if S value if it is over half order).
+func checkSignature(sigStr []byte) error {
+	var r secp256k1.ModNScalar
+	if overflow := r.SetByteSlice(sigStr[:32]); overflow || r.IsZero() {
+		return errors.New("invalid signature R")
+	}
+	var s secp256k1.ModNScalar
+	if overflow := s.SetByteSlice(sigStr[32:64]); overflow || s.IsZero() {
+		return errors.New("invalid signature S")
+	}
+	if s.IsOverHalfOrder() {
+		return errors.New("signature is not in lower-S form")
+	}
+
+	return nil
+}
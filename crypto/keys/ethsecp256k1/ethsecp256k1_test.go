@@ -0,0 +1,122 @@
+package ethsecp256k1_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	secp "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+func TestPubKeyAddress(t *testing.T) {
+	// test vector from the EIP-155 example
+	privBz, err := hex.DecodeString("4646464646464646464646464646464646464646464646464646464646464646")
+	require.NoError(t, err)
+
+	priv := &ethsecp256k1.PrivKey{Key: privBz}
+	require.Equal(t, "9d8a62f656a8d1615c1294fd71e9cfb3e4855a4f", hex.EncodeToString(priv.PubKey().Address()))
+
+	// the compressed pubkey is the same as for secp256k1 keys, but not the address
+	secpPriv := &secp256k1.PrivKey{Key: privBz}
+	require.Equal(t, secpPriv.PubKey().Bytes(), priv.PubKey().Bytes())
+	require.NotEqual(t, secpPriv.PubKey().Address(), priv.PubKey().Address())
+}
+
+func TestSignAndVerify(t *testing.T) {
+	priv := ethsecp256k1.GenPrivKey()
+	pub := priv.PubKey()
+	msg := []byte("hello world")
+
+	sig, err := priv.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, ethsecp256k1.SignatureSize)
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	// the recovery id is required, and must be the one of pub
+	require.False(t, pub.VerifySignature(msg, sig[:64]))
+	flipped := append(append([]byte{}, sig[:64]...), sig[64]^1)
+	require.False(t, pub.VerifySignature(msg, flipped))
+	require.False(t, pub.VerifySignature(msg, append(append([]byte{}, sig[:64]...), sig[64]+27)))
+
+	// the signature is over the Keccak-256 hash, and the pubkey can be recovered
+	// from it as Ethereum does
+	compactSig := append([]byte{sig[64] + 27}, sig[:64]...)
+	recovered, _, err := ecdsa.RecoverCompact(compactSig, ethsecp256k1.Keccak256(msg))
+	require.NoError(t, err)
+	require.Equal(t, pub.Bytes(), recovered.SerializeCompressed())
+
+	// mutating the message or the signature fails the verification
+	require.False(t, pub.VerifySignature([]byte("hello world!"), sig))
+	sig[3] ^= byte(0x01)
+	require.False(t, pub.VerifySignature(msg, sig))
+	require.False(t, pub.VerifySignature(msg, sig[:10]))
+
+	// secp256k1 signatures are over the SHA-256 hash, and are not valid
+	secpSig, err := (&secp256k1.PrivKey{Key: priv.Key}).Sign(msg)
+	require.NoError(t, err)
+	require.False(t, pub.VerifySignature(msg, secpSig))
+}
+
+func TestVerifyRejectsHighS(t *testing.T) {
+	priv := ethsecp256k1.GenPrivKey()
+	msg := []byte("hello world")
+
+	sig, err := priv.Sign(msg)
+	require.NoError(t, err)
+
+	var s secp.ModNScalar
+	s.SetByteSlice(sig[32:64])
+	s.Negate()
+	highS := s.Bytes()
+	copy(sig[32:64], highS[:])
+
+	require.False(t, priv.PubKey().VerifySignature(msg, sig))
+
+	// nor with the recovery id of the high-S form
+	sig[64] ^= 1
+	require.False(t, priv.PubKey().VerifySignature(msg, sig))
+}
+
+func TestPubKeyEquals(t *testing.T) {
+	priv := ethsecp256k1.GenPrivKey()
+	pub := priv.PubKey()
+
+	require.True(t, pub.Equals(priv.PubKey()))
+	require.False(t, pub.Equals(ethsecp256k1.GenPrivKey().PubKey()))
+	require.False(t, pub.Equals(&secp256k1.PubKey{Key: pub.Bytes()}))
+	require.True(t, priv.Equals(&ethsecp256k1.PrivKey{Key: priv.Key}))
+	require.False(t, priv.Equals(&secp256k1.PrivKey{Key: priv.Key}))
+}
+
+func TestAminoMarshal(t *testing.T) {
+	cdc := codec.NewLegacyAmino()
+	cdc.RegisterInterface((*cryptotypes.PubKey)(nil), nil)
+	cdc.RegisterConcrete(&ethsecp256k1.PubKey{}, ethsecp256k1.PubKeyName, nil)
+	cdc.RegisterInterface((*cryptotypes.PrivKey)(nil), nil)
+	cdc.RegisterConcrete(&ethsecp256k1.PrivKey{}, ethsecp256k1.PrivKeyName, nil)
+
+	priv := ethsecp256k1.GenPrivKey()
+	pub := priv.PubKey()
+
+	bz, err := cdc.Marshal(priv)
+	require.NoError(t, err)
+	var priv2 ethsecp256k1.PrivKey
+	require.NoError(t, cdc.Unmarshal(bz, &priv2))
+	require.Equal(t, priv.Key, priv2.Key)
+
+	bz, err = cdc.MarshalJSON(pub)
+	require.NoError(t, err)
+	var pub2 cryptotypes.PubKey
+	require.NoError(t, cdc.UnmarshalJSON(bz, &pub2))
+	require.True(t, pub.Equals(pub2))
+
+	// invalid sizes are rejected
+	require.Error(t, (&ethsecp256k1.PubKey{}).UnmarshalAmino([]byte{0x01}))
+	require.Error(t, (&ethsecp256k1.PrivKey{}).UnmarshalAmino([]byte{0x01}))
+}
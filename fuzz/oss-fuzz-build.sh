@@ -12,6 +12,12 @@ build_go_fuzzer() {
 	compile_native_go_fuzzer "$FUZZ_ROOT"/fuzz/tests "$function" "$fuzzer"
 }
 
+(
+	cd store && \
+	go get github.com/AdamKorcz/go-118-fuzz-build/testing && \
+	compile_native_go_fuzzer cosmossdk.io/store/rootmulti FuzzMultiStoreQuery fuzz_store_rootmulti_query
+)
+
 (
 	cd math && \
 	go get github.com/AdamKorcz/go-118-fuzz-build/testing && \
@@ -26,6 +32,9 @@ go get github.com/AdamKorcz/go-118-fuzz-build/testing
 # compile_native_go_fuzzer "$FUZZ_ROOT"/types/query FuzzPagination fuzz_types_query_pagination
 compile_native_go_fuzzer "$FUZZ_ROOT"/types FuzzCoinUnmarshalJSON fuzz_types_coin_unmarshal_json
 
+build_go_fuzzer FuzzCodecAminoUnmarshalStdTx fuzz_codec_amino_unmarshalstdtx
+build_go_fuzzer FuzzCodecProtoUnmarshalInterface fuzz_codec_proto_unmarshalinterface
+
 build_go_fuzzer FuzzCryptoHDDerivePrivateKeyForPath fuzz_crypto_hd_deriveprivatekeyforpath
 build_go_fuzzer FuzzCryptoHDNewParamsFromPath fuzz_crypto_hd_newparamsfrompath
 
@@ -41,4 +50,7 @@ build_go_fuzzer FuzzTypesDecSetString fuzz_types_dec_setstring
 
 build_go_fuzzer FuzzUnknownProto fuzz_unknownproto
 
+build_go_fuzzer FuzzXAuthTxDecode fuzz_x_auth_tx_decode
+build_go_fuzzer FuzzXAuthTxJSONDecode fuzz_x_auth_tx_jsondecode
+
 build_go_fuzzer FuzzXBankTypesAddressFromBalancesStore fuzz_x_bank_types_addressfrombalancesstore
@@ -0,0 +1,61 @@
+//go:build gofuzz || go1.18
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func FuzzCodecProtoUnmarshalInterface(f *testing.F) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{})
+	addr := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("fuzz")).PubKey().Address())
+	msgBz, err := encCfg.Codec.MarshalInterface(banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))))
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(msgBz)
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var msg sdk.Msg
+		// an empty Any is unmarshaled to a nil message
+		if err := encCfg.Codec.UnmarshalInterface(data, &msg); err != nil || msg == nil {
+			return
+		}
+		// an unmarshaled message must be marshaled back
+		if _, err := encCfg.Codec.MarshalInterface(msg); err != nil {
+			t.Fatalf("failed to marshal an unmarshaled message: %v", err)
+		}
+	})
+}
+
+func FuzzCodecAminoUnmarshalStdTx(f *testing.F) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{})
+	addr := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("fuzz")).PubKey().Address())
+	stdTx := legacytx.NewStdTx(
+		[]sdk.Msg{banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))},
+		legacytx.NewStdFee(200000, sdk.NewCoins(sdk.NewInt64Coin("stake", 1))),
+		nil,
+		"fuzz",
+	)
+	for _, marshal := range []func(interface{}) ([]byte, error){encCfg.Amino.Marshal, encCfg.Amino.MarshalJSON} {
+		bz, err := marshal(stdTx)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(bz)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var tx legacytx.StdTx
+		_ = encCfg.Amino.Unmarshal(data, &tx)
+		_ = encCfg.Amino.UnmarshalJSON(data, &tx)
+	})
+}
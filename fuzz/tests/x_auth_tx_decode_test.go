@@ -0,0 +1,88 @@
+//go:build gofuzz || go1.18
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func FuzzXAuthTxDecode(f *testing.F) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{})
+	txBz, err := encCfg.TxConfig.TxEncoder()(seedTx(f, encCfg.TxConfig))
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(txBz)
+
+	decoder := encCfg.TxConfig.TxDecoder()
+	encoder := encCfg.TxConfig.TxEncoder()
+	f.Fuzz(func(t *testing.T, data []byte) {
+		tx, err := decoder(data)
+		if err != nil {
+			return
+		}
+		// a decoded transaction must be re-encoded
+		if _, err := encoder(tx); err != nil {
+			t.Fatalf("failed to encode a decoded transaction: %v", err)
+		}
+		_ = tx.GetMsgs()
+	})
+}
+
+func FuzzXAuthTxJSONDecode(f *testing.F) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{})
+	txBz, err := encCfg.TxConfig.TxJSONEncoder()(seedTx(f, encCfg.TxConfig))
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(txBz)
+
+	decoder := encCfg.TxConfig.TxJSONDecoder()
+	encoder := encCfg.TxConfig.TxJSONEncoder()
+	f.Fuzz(func(t *testing.T, data []byte) {
+		tx, err := decoder(data)
+		if err != nil {
+			return
+		}
+		if _, err := encoder(tx); err != nil {
+			t.Fatalf("failed to encode a decoded transaction: %v", err)
+		}
+	})
+}
+
+// seedTx returns a signed bank send transaction, the seed of the transaction
+// decoding fuzz tests.
+func seedTx(f *testing.F, txConfig client.TxConfig) sdk.Tx {
+	priv := secp256k1.GenPrivKeyFromSecret([]byte("fuzz"))
+	addr := sdk.AccAddress(priv.PubKey().Address())
+
+	builder := txConfig.NewTxBuilder()
+	if err := builder.SetMsgs(banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))); err != nil {
+		f.Fatal(err)
+	}
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	builder.SetGasLimit(200000)
+	builder.SetMemo("fuzz")
+
+	sig := signingtypes.SignatureV2{
+		PubKey: priv.PubKey(),
+		Data: &signingtypes.SingleSignatureData{
+			SignMode:  signingtypes.SignMode_SIGN_MODE_DIRECT,
+			Signature: []byte("signature"),
+		},
+	}
+	if err := builder.SetSignatures(sig); err != nil {
+		f.Fatal(err)
+	}
+
+	return builder.GetTx()
+}
@@ -0,0 +1,367 @@
+package math
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Dec is a fixed-point decimal with DecPrecision decimal places, for
+// computations where the rounding of the 18 decimal places of LegacyDec
+// accumulates to meaningful amounts, e.g. per-block rates compounded over a
+// year. Its operations don't mutate their operands.
+//
+// NOTE: never use new(Dec) or else we will panic unmarshalling into the
+// nil embedded big.Int
+type Dec struct {
+	i *big.Int
+}
+
+const (
+	// DecPrecision is the number of decimal places of Dec.
+	DecPrecision = 34
+
+	// decTruncateBits is the minimum number of bits removed by a truncate
+	// operation. It is equal to Floor[Log2[10^DecPrecision - 1]].
+	decTruncateBits = 112
+
+	maxDecimalBitLen = MaxBitLen + decTruncateBits
+)
+
+var (
+	decPrecisionReuse        = new(big.Int).Exp(tenInt, big.NewInt(DecPrecision), nil)
+	decFivePrecision         = new(big.Int).Quo(decPrecisionReuse, big.NewInt(2))
+	decSquaredPrecisionReuse = new(big.Int).Mul(decPrecisionReuse, decPrecisionReuse)
+	// legacyToDecMultiplier converts a LegacyDec to a Dec.
+	legacyToDecMultiplier = new(big.Int).Exp(tenInt, big.NewInt(DecPrecision-LegacyPrecision), nil)
+	legacyToDecHalf       = new(big.Int).Quo(legacyToDecMultiplier, big.NewInt(2))
+)
+
+func ZeroDec() Dec     { return Dec{new(big.Int)} }
+func OneDec() Dec      { return Dec{new(big.Int).Set(decPrecisionReuse)} }
+func SmallestDec() Dec { return Dec{big.NewInt(1)} }
+
+// NewDec returns a new Dec from an integer.
+func NewDec(i int64) Dec {
+	return NewDecWithPrec(i, 0)
+}
+
+// NewDecWithPrec returns a new Dec from an integer with the decimal point at
+// prec, e.g. NewDecWithPrec(15, 1) is 1.5.
+// CONTRACT: prec <= DecPrecision
+func NewDecWithPrec(i, prec int64) Dec {
+	return Dec{new(big.Int).Mul(big.NewInt(i), decPrecisionMultiplier(prec))}
+}
+
+// NewDecFromInt returns a new Dec from an Int.
+func NewDecFromInt(i Int) Dec {
+	return Dec{new(big.Int).Mul(i.BigInt(), decPrecisionReuse)}
+}
+
+// NewDecFromLegacyDec returns the Dec equal to the LegacyDec d, which is
+// exact since a Dec has more decimal places.
+func NewDecFromLegacyDec(d LegacyDec) Dec {
+	return Dec{new(big.Int).Mul(d.i, legacyToDecMultiplier)}
+}
+
+// NewDecFromStr returns a Dec from a decimal string, in the same format as
+// LegacyNewDecFromStr, with at most DecPrecision decimal places.
+func NewDecFromStr(str string) (Dec, error) {
+	s := str
+	neg := false
+	if len(s) > 0 && s[0] == '-' {
+		neg = true
+		s = s[1:]
+	}
+
+	if len(s) == 0 {
+		return Dec{}, ErrLegacyEmptyDecimalStr
+	}
+
+	strs := strings.Split(s, ".")
+	lenDecs := 0
+	combinedStr := strs[0]
+
+	if len(strs) == 2 { // has a decimal place
+		lenDecs = len(strs[1])
+		if lenDecs == 0 || len(combinedStr) == 0 {
+			return Dec{}, ErrLegacyInvalidDecimalLength
+		}
+		combinedStr += strs[1]
+	} else if len(strs) > 2 {
+		return Dec{}, ErrLegacyInvalidDecimalStr
+	}
+
+	if lenDecs > DecPrecision {
+		return Dec{}, fmt.Errorf("value '%s' exceeds max precision by %d decimal places: max precision %d", str, lenDecs-DecPrecision, DecPrecision)
+	}
+
+	combinedStr += strings.Repeat("0", DecPrecision-lenDecs)
+	// only digits are accepted, big.Int would also accept a sign or underscores
+	if strings.Trim(combinedStr, "0123456789") != "" {
+		return Dec{}, fmt.Errorf("failed to set decimal string with base 10: %s", combinedStr)
+	}
+
+	combined, ok := new(big.Int).SetString(combinedStr, 10)
+	if !ok {
+		return Dec{}, fmt.Errorf("failed to set decimal string with base 10: %s", combinedStr)
+	}
+	if combined.BitLen() > maxDecimalBitLen {
+		return Dec{}, fmt.Errorf("decimal '%s' out of range; bitLen: got %d, max %d", str, combined.BitLen(), maxDecimalBitLen)
+	}
+	if neg {
+		combined.Neg(combined)
+	}
+
+	return Dec{combined}, nil
+}
+
+// MustNewDecFromStr is like NewDecFromStr but panics on error.
+func MustNewDecFromStr(s string) Dec {
+	dec, err := NewDecFromStr(s)
+	if err != nil {
+		panic(err)
+	}
+	return dec
+}
+
+func decPrecisionMultiplier(prec int64) *big.Int {
+	if prec < 0 {
+		panic(fmt.Sprintf("negative precision %v", prec))
+	}
+
+	if prec > DecPrecision {
+		panic(fmt.Sprintf("too much precision, maximum %v, provided %v", DecPrecision, prec))
+	}
+
+	return new(big.Int).Exp(tenInt, big.NewInt(DecPrecision-prec), nil)
+}
+
+func (d Dec) IsNil() bool       { return d.i == nil }                 // is decimal nil
+func (d Dec) IsZero() bool      { return d.i.Sign() == 0 }            // is equal to zero
+func (d Dec) IsNegative() bool  { return d.i.Sign() == -1 }           // is negative
+func (d Dec) IsPositive() bool  { return d.i.Sign() == 1 }            // is positive
+func (d Dec) Equal(d2 Dec) bool { return d.i.Cmp(d2.i) == 0 }         // equal decimals
+func (d Dec) GT(d2 Dec) bool    { return d.i.Cmp(d2.i) > 0 }          // greater than
+func (d Dec) GTE(d2 Dec) bool   { return d.i.Cmp(d2.i) >= 0 }         // greater than or equal
+func (d Dec) LT(d2 Dec) bool    { return d.i.Cmp(d2.i) < 0 }          // less than
+func (d Dec) LTE(d2 Dec) bool   { return d.i.Cmp(d2.i) <= 0 }         // less than or equal
+func (d Dec) Neg() Dec          { return Dec{new(big.Int).Neg(d.i)} } // reverse the decimal sign
+func (d Dec) Abs() Dec          { return Dec{new(big.Int).Abs(d.i)} } // absolute value
+func (d Dec) IsInteger() bool   { return new(big.Int).Rem(d.i, decPrecisionReuse).Sign() == 0 }
+
+// BigInt returns a copy of the underlying big.Int, scaled by 10^DecPrecision.
+func (d Dec) BigInt() *big.Int {
+	if d.IsNil() {
+		return nil
+	}
+
+	return new(big.Int).Set(d.i)
+}
+
+// Add returns d + d2.
+func (d Dec) Add(d2 Dec) Dec {
+	return checkedDec(new(big.Int).Add(d.i, d2.i))
+}
+
+// Sub returns d - d2.
+func (d Dec) Sub(d2 Dec) Dec {
+	return checkedDec(new(big.Int).Sub(d.i, d2.i))
+}
+
+// Mul returns d * d2, rounded with bankers rounding.
+func (d Dec) Mul(d2 Dec) Dec {
+	return checkedDec(decChopPrecisionAndRound(new(big.Int).Mul(d.i, d2.i)))
+}
+
+// MulTruncate returns d * d2, truncated.
+func (d Dec) MulTruncate(d2 Dec) Dec {
+	i := new(big.Int).Mul(d.i, d2.i)
+	return checkedDec(i.Quo(i, decPrecisionReuse))
+}
+
+// MulInt returns d * i.
+func (d Dec) MulInt(i Int) Dec {
+	return checkedDec(new(big.Int).Mul(d.i, i.BigInt()))
+}
+
+// Quo returns d / d2, rounded with bankers rounding.
+func (d Dec) Quo(d2 Dec) Dec {
+	// multiply by precision twice
+	i := new(big.Int).Mul(d.i, decSquaredPrecisionReuse)
+	i.Quo(i, d2.i)
+	return checkedDec(decChopPrecisionAndRound(i))
+}
+
+// QuoTruncate returns d / d2, truncated.
+func (d Dec) QuoTruncate(d2 Dec) Dec {
+	i := new(big.Int).Mul(d.i, decPrecisionReuse)
+	return checkedDec(i.Quo(i, d2.i))
+}
+
+// QuoInt returns d / i, truncated.
+func (d Dec) QuoInt(i Int) Dec {
+	return Dec{new(big.Int).Quo(d.i, i.BigInt())}
+}
+
+// TruncateInt truncates the decimals of d and returns an Int.
+func (d Dec) TruncateInt() Int {
+	return NewIntFromBigInt(new(big.Int).Quo(d.i, decPrecisionReuse))
+}
+
+// RoundInt rounds d with bankers rounding and returns an Int.
+func (d Dec) RoundInt() Int {
+	return NewIntFromBigInt(decChopPrecisionAndRound(new(big.Int).Set(d.i)))
+}
+
+// LegacyDec returns d rounded with bankers rounding to the precision of a
+// LegacyDec.
+func (d Dec) LegacyDec() LegacyDec {
+	return LegacyDec{chopAndRound(new(big.Int).Set(d.i), legacyToDecMultiplier, legacyToDecHalf)}
+}
+
+// LegacyDecTruncate returns d truncated to the precision of a LegacyDec.
+func (d Dec) LegacyDecTruncate() LegacyDec {
+	return LegacyDec{new(big.Int).Quo(d.i, legacyToDecMultiplier)}
+}
+
+// checkedDec returns the Dec of i, and panics if it overflows.
+func checkedDec(i *big.Int) Dec {
+	if i.BitLen() > maxDecimalBitLen {
+		panic("Int overflow")
+	}
+
+	return Dec{i}
+}
+
+// decChopPrecisionAndRound removes DecPrecision digits of d with bankers
+// rounding. It mutates d.
+func decChopPrecisionAndRound(d *big.Int) *big.Int {
+	return chopAndRound(d, decPrecisionReuse, decFivePrecision)
+}
+
+// chopAndRound divides d by unit, a power of 10 whose half is half, with
+// bankers rounding. It mutates d.
+func chopAndRound(d, unit, half *big.Int) *big.Int {
+	// remove the negative and add it back when returning
+	if d.Sign() == -1 {
+		d.Neg(d)
+		chopAndRound(d, unit, half)
+		return d.Neg(d)
+	}
+
+	quo, rem := d.QuoRem(d, unit, new(big.Int))
+	switch rem.Cmp(half) {
+	case -1:
+		return quo
+	case 1:
+		return quo.Add(quo, oneInt)
+	default: // bankers rounding, always round to an even number
+		if quo.Bit(0) == 0 {
+			return quo
+		}
+		return quo.Add(quo, oneInt)
+	}
+}
+
+// String returns d with DecPrecision decimal places.
+func (d Dec) String() string {
+	if d.i == nil {
+		return d.i.String()
+	}
+
+	abs := new(big.Int).Abs(d.i).String()
+	if len(abs) <= DecPrecision {
+		abs = strings.Repeat("0", DecPrecision-len(abs)+1) + abs
+	}
+
+	s := abs[:len(abs)-DecPrecision] + "." + abs[len(abs)-DecPrecision:]
+	if d.IsNegative() {
+		return "-" + s
+	}
+
+	return s
+}
+
+// Format implements fmt.Formatter.
+func (d Dec) Format(s fmt.State, verb rune) {
+	_, err := s.Write([]byte(d.String()))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// MarshalJSON marshals the decimal
+func (d Dec) MarshalJSON() ([]byte, error) {
+	if d.i == nil {
+		return nilJSON, nil
+	}
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON defines custom decoding scheme
+func (d *Dec) UnmarshalJSON(bz []byte) error {
+	var text string
+	if err := json.Unmarshal(bz, &text); err != nil {
+		return err
+	}
+
+	newDec, err := NewDecFromStr(text)
+	if err != nil {
+		return err
+	}
+
+	d.i = newDec.i
+	return nil
+}
+
+// MarshalYAML returns the YAML representation.
+func (d Dec) MarshalYAML() (interface{}, error) {
+	return d.String(), nil
+}
+
+// Marshal implements the gogo proto custom type interface.
+func (d Dec) Marshal() ([]byte, error) {
+	i := d.i
+	if i == nil {
+		i = new(big.Int)
+	}
+	return i.MarshalText()
+}
+
+// MarshalTo implements the gogo proto custom type interface.
+func (d *Dec) MarshalTo(data []byte) (n int, err error) {
+	bz, err := d.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	copy(data, bz)
+	return len(bz), nil
+}
+
+// Unmarshal implements the gogo proto custom type interface.
+func (d *Dec) Unmarshal(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	i := new(big.Int)
+	if err := i.UnmarshalText(data); err != nil {
+		return err
+	}
+
+	if i.BitLen() > maxDecimalBitLen {
+		return fmt.Errorf("decimal out of range; got: %d, max: %d", i.BitLen(), maxDecimalBitLen)
+	}
+
+	d.i = i
+	return nil
+}
+
+// Size implements the gogo proto custom type interface.
+func (d *Dec) Size() int {
+	bz, _ := d.Marshal()
+	return len(bz)
+}
@@ -0,0 +1,174 @@
+package math_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+)
+
+func TestNewDecFromStr(t *testing.T) {
+	largeDecimals := "0." + strings.Repeat("1", math.DecPrecision)
+
+	tests := []struct {
+		input   string
+		expErr  bool
+		expStr  string
+		integer bool
+	}{
+		{"", true, "", false},
+		{"0.-75", true, "", false},
+		{"+1", true, "", false},
+		{"1.", true, "", false},
+		{".1", true, "", false},
+		{"1.1.1", true, "", false},
+		{"1_000", true, "", false},
+		{largeDecimals + "1", true, "", false},
+		{"0", false, "0." + strings.Repeat("0", 34), true},
+		{"1", false, "1." + strings.Repeat("0", 34), true},
+		{"-1.5", false, "-1.5" + strings.Repeat("0", 33), false},
+		{largeDecimals, false, largeDecimals, false},
+		{"-" + largeDecimals, false, "-" + largeDecimals, false},
+		{"123456789.000000000000000000000000000000000001", true, "", false},
+	}
+
+	for _, tc := range tests {
+		d, err := math.NewDecFromStr(tc.input)
+		if tc.expErr {
+			require.Error(t, err, tc.input)
+			continue
+		}
+
+		require.NoError(t, err, tc.input)
+		require.Equal(t, tc.expStr, d.String(), tc.input)
+		require.Equal(t, tc.integer, d.IsInteger(), tc.input)
+	}
+}
+
+func TestDecArithmetic(t *testing.T) {
+	one, two, three := math.NewDec(1), math.NewDec(2), math.NewDec(3)
+
+	require.Equal(t, "5."+strings.Repeat("0", 34), two.Add(three).String())
+	require.Equal(t, "-1."+strings.Repeat("0", 34), two.Sub(three).String())
+	require.Equal(t, "6."+strings.Repeat("0", 34), two.Mul(three).String())
+	require.True(t, math.NewDecWithPrec(15, 1).Equal(three.Quo(two)))
+
+	// 1/3 and 2/3 are rounded to the 34th decimal place
+	require.Equal(t, "0."+strings.Repeat("3", 34), one.Quo(three).String())
+	require.Equal(t, "0."+strings.Repeat("6", 33)+"7", two.Quo(three).String())
+	require.Equal(t, "0."+strings.Repeat("6", 34), two.QuoTruncate(three).String())
+	require.Equal(t, "-0."+strings.Repeat("6", 33)+"7", two.Neg().Quo(three).String())
+
+	third := one.Quo(three)
+	require.Equal(t, "0."+strings.Repeat("1", 34), third.Mul(third).String())
+	require.Equal(t, "0."+strings.Repeat("1", 33)+"0", third.MulTruncate(third).String())
+
+	require.Equal(t, "1.5"+strings.Repeat("0", 33), three.QuoInt(math.NewInt(2)).String())
+	require.Equal(t, "6."+strings.Repeat("0", 34), three.MulInt(math.NewInt(2)).String())
+
+	// the operands are not mutated
+	require.Equal(t, "3."+strings.Repeat("0", 34), three.String())
+	require.Equal(t, "2."+strings.Repeat("0", 34), two.String())
+
+	require.True(t, three.GT(two))
+	require.True(t, two.GTE(two))
+	require.True(t, two.LT(three))
+	require.True(t, two.LTE(two))
+	require.True(t, two.Neg().IsNegative())
+	require.True(t, two.Neg().Abs().Equal(two))
+	require.True(t, math.ZeroDec().IsZero())
+	require.True(t, math.OneDec().IsPositive())
+
+	require.Panics(t, func() {
+		max := math.NewDecFromInt(math.NewIntFromBigInt(new(big.Int).Lsh(big.NewInt(1), math.MaxBitLen-1)))
+		max.Mul(max)
+	})
+}
+
+func TestDecRounding(t *testing.T) {
+	tests := []struct {
+		dec       string
+		expRound  int64
+		expTrunc  int64
+		expLegacy string
+	}{
+		{"2.5", 2, 2, "2.500000000000000000"},
+		{"3.5", 4, 3, "3.500000000000000000"},
+		{"-2.5", -2, -2, "-2.500000000000000000"},
+		{"0.0000000000000000005", 0, 0, "0.000000000000000000"},
+		{"0.0000000000000000015", 0, 0, "0.000000000000000002"},
+		{"0.00000000000000000150000000000001", 0, 0, "0.000000000000000002"},
+		{"0.0000000000000000014999", 0, 0, "0.000000000000000001"},
+		{"-0.0000000000000000015", 0, 0, "-0.000000000000000002"},
+	}
+
+	for _, tc := range tests {
+		d := math.MustNewDecFromStr(tc.dec)
+		require.Equal(t, tc.expRound, d.RoundInt().Int64(), tc.dec)
+		require.Equal(t, tc.expTrunc, d.TruncateInt().Int64(), tc.dec)
+		require.Equal(t, tc.expLegacy, d.LegacyDec().String(), tc.dec)
+	}
+
+	require.Equal(t, "0.000000000000000001", math.MustNewDecFromStr("0.0000000000000000019").LegacyDecTruncate().String())
+}
+
+func TestDecLegacyDecConversion(t *testing.T) {
+	legacy := math.LegacyMustNewDecFromStr("-1234.567890123456789012")
+	d := math.NewDecFromLegacyDec(legacy)
+	require.Equal(t, "-1234.5678901234567890120000000000000000", d.String())
+	require.True(t, legacy.Equal(d.LegacyDec()))
+	require.True(t, legacy.Equal(d.LegacyDecTruncate()))
+}
+
+// TestDecCumulativeRounding checks that compounding a small per-block rate
+// keeps the precision lost by LegacyDec.
+func TestDecCumulativeRounding(t *testing.T) {
+	const blocks = 10_000
+	rate := "0.13"
+	blocksPerYear := int64(6_311_520)
+
+	legacyRate := math.LegacyMustNewDecFromStr(rate).QuoInt64(blocksPerYear)
+	rate34 := math.MustNewDecFromStr(rate).QuoInt(math.NewInt(blocksPerYear))
+
+	legacySum, sum := math.LegacyZeroDec(), math.ZeroDec()
+	for i := 0; i < blocks; i++ {
+		legacySum = legacySum.Add(legacyRate)
+		sum = sum.Add(rate34)
+	}
+
+	exact := math.MustNewDecFromStr(rate).MulInt(math.NewInt(blocks)).Quo(math.NewDec(blocksPerYear))
+	require.True(t, exact.Sub(sum).Abs().LTE(math.NewDecWithPrec(blocks, math.DecPrecision)))
+	require.False(t, exact.LegacyDec().Equal(legacySum))
+	require.True(t, exact.LegacyDec().Equal(sum.LegacyDec()))
+}
+
+func TestDecMarshal(t *testing.T) {
+	d := math.MustNewDecFromStr("-12.0000000000000000000000000000000001")
+
+	bz, err := json.Marshal(d)
+	require.NoError(t, err)
+	require.Equal(t, `"-12.0000000000000000000000000000000001"`, string(bz))
+
+	var d2 math.Dec
+	require.NoError(t, json.Unmarshal(bz, &d2))
+	require.True(t, d.Equal(d2))
+
+	bz, err = d.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, d.Size(), len(bz))
+	buf := make([]byte, d.Size())
+	n, err := d.MarshalTo(buf)
+	require.NoError(t, err)
+	require.Equal(t, bz, buf[:n])
+
+	var d3 math.Dec
+	require.NoError(t, d3.Unmarshal(bz))
+	require.True(t, d.Equal(d3))
+
+	require.Error(t, d3.Unmarshal([]byte("1"+strings.Repeat("0", 120))))
+	require.Error(t, json.Unmarshal([]byte(`"1.5.5"`), &d3))
+}
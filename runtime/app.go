@@ -72,9 +72,25 @@ func (a *App) RegisterModules(modules ...module.AppModule) error {
 		a.ModuleManager.Modules[name] = appModule
 		a.basicManager[name] = appModule
 		appModule.RegisterInterfaces(a.interfaceRegistry)
-		appModule.RegisterLegacyAminoCodec(a.amino)
+		if codec.LegacyAminoEnabled {
+			appModule.RegisterLegacyAminoCodec(a.amino)
+		}
 
+		if mod, ok := appModule.(module.HasServices); ok {
+			mod.RegisterServices(a.configurator)
+		}
 	}
+
+	return a.configurator.Error()
+}
+
+// RegisterStores registers the provided store keys. It is used along with
+// RegisterModules to mount the stores of the modules which are not registered
+// using the app config.
+func (a *App) RegisterStores(keys ...storetypes.StoreKey) error {
+	a.storeKeys = append(a.storeKeys, keys...)
+	a.MountStores(keys...)
+
 	return nil
 }
 
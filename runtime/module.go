@@ -143,7 +143,9 @@ func SetupAppBuilder(inputs AppInputs) {
 		if basicMod, ok := mod.(module.AppModuleBasic); ok {
 			app.basicManager[name] = basicMod
 			basicMod.RegisterInterfaces(inputs.InterfaceRegistry)
-			basicMod.RegisterLegacyAminoCodec(inputs.LegacyAmino)
+			if codec.LegacyAminoEnabled {
+				basicMod.RegisterLegacyAminoCodec(inputs.LegacyAmino)
+			}
 		}
 	}
 }
@@ -11,7 +11,9 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/configschema"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
 
 const (
@@ -79,6 +81,11 @@ type BaseConfig struct {
 	// which informs CometBFT what to index. If empty, all events will be indexed.
 	IndexEvents []string `mapstructure:"index-events"`
 
+	// DropEvents defines the set of events in the form {eventType} or
+	// {eventType}.{attributeKey}, which are removed from the ABCI responses,
+	// hence neither indexed by CometBFT nor stored in its tx results.
+	DropEvents []string `mapstructure:"drop-events"`
+
 	// IavlCacheSize set the size of the iavl tree cache.
 	IAVLCacheSize uint64 `mapstructure:"iavl-cache-size"`
 
@@ -88,6 +95,21 @@ type BaseConfig struct {
 	// IAVLLazyLoading enable/disable the lazy loading of iavl store.
 	IAVLLazyLoading bool `mapstructure:"iavl-lazy-loading"`
 
+	// OptimisticExecution enables the concurrent execution of the txs of a block.
+	OptimisticExecution bool `mapstructure:"optimistic-execution"`
+
+	// QueryGasLimit defines the maximum gas consumed by an ABCI or gRPC query.
+	// A value of 0 means unlimited.
+	QueryGasLimit uint64 `mapstructure:"query-gas-limit"`
+
+	// QueryMaxResponseBytes defines the maximum size in bytes of the response of
+	// an ABCI or gRPC query. A value of 0 means unlimited.
+	QueryMaxResponseBytes uint64 `mapstructure:"query-max-response-bytes"`
+
+	// BlockTraceDir defines the directory the execution trace of each block is
+	// written to, for debugging purposes. An empty string disables block tracing.
+	BlockTraceDir string `mapstructure:"block-trace-dir"`
+
 	// AppDBBackend defines the type of Database to use for the application and snapshots databases.
 	// An empty string indicates that the CometBFT config's DBBackend value should be used.
 	AppDBBackend string `mapstructure:"app-db-backend"`
@@ -165,7 +187,13 @@ type MempoolConfig struct {
 	// the mempool is disabled entirely, zero indicates that the mempool is
 	// unbounded in how many txs it may contain, and a positive value indicates
 	// the maximum amount of txs it may contain.
-	MaxTxs int
+	MaxTxs int `mapstructure:"max-txs"`
+
+	// Type defines the app-side mempool implementation: priority-nonce orders
+	// txs by priority while preserving the sequence ordering of each sender,
+	// sender-nonce only preserves the sequence ordering, and no-op disables the
+	// app-side mempool.
+	Type string `mapstructure:"type"`
 }
 
 // State Streaming configuration
@@ -234,9 +262,11 @@ func DefaultConfig() *Config {
 			PruningInterval:     "0",
 			MinRetainBlocks:     0,
 			IndexEvents:         make([]string, 0),
+			DropEvents:          make([]string, 0),
 			IAVLCacheSize:       781250,
 			IAVLDisableFastNode: false,
 			IAVLLazyLoading:     false,
+			OptimisticExecution: false,
 			AppDBBackend:        "",
 		},
 		Telemetry: telemetry.Config{
@@ -272,6 +302,7 @@ func DefaultConfig() *Config {
 		},
 		Mempool: MempoolConfig{
 			MaxTxs: 5_000,
+			Type:   mempool.TypePriorityNonce,
 		},
 	}
 }
@@ -296,5 +327,15 @@ func (c Config) ValidateBasic() error {
 		)
 	}
 
+	switch c.Mempool.Type {
+	case "", mempool.TypePriorityNonce, mempool.TypeSenderNonce, mempool.TypeNoOp:
+	default:
+		return sdkerrors.ErrAppConfig.Wrapf("unknown mempool type %s", c.Mempool.Type)
+	}
+
+	if err := configschema.Validate(&configschema.AppConfig{}, c); err != nil {
+		return sdkerrors.ErrAppConfig.Wrap(err.Error())
+	}
+
 	return nil
 }
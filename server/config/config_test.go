@@ -11,6 +11,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/configschema"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
 
 func TestDefaultConfig(t *testing.T) {
@@ -208,3 +210,52 @@ func TestSetConfigTemplate(t *testing.T) {
 	actual := setBuffer.String()
 	require.Equal(t, expected, actual, "resulting config strings")
 }
+
+func TestMempoolTypeWriteRead(t *testing.T) {
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	conf := DefaultConfig()
+	conf.Mempool.Type = mempool.TypeSenderNonce
+
+	WriteConfigFile(confFile, conf)
+
+	vpr := viper.New()
+	vpr.SetConfigFile(confFile)
+	require.NoError(t, vpr.ReadInConfig())
+
+	cfg, err := ParseConfig(vpr)
+	require.NoError(t, err)
+	require.Equal(t, mempool.TypeSenderNonce, cfg.Mempool.Type)
+
+	cfg.MinGasPrices = "0stake"
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.Mempool.Type = "fifo"
+	require.ErrorContains(t, cfg.ValidateBasic(), "unknown mempool type fifo")
+}
+
+func TestConfigSchema(t *testing.T) {
+	require.NoError(t, configschema.CheckStruct(&configschema.AppConfig{}, Config{}))
+
+	cfg := DefaultConfig()
+	cfg.MinGasPrices = "0stake"
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.Pruning = "sometimes"
+	require.ErrorContains(t, cfg.ValidateBasic(), `invalid pruning "sometimes"`)
+}
+
+func TestMempoolMaxTxsWriteRead(t *testing.T) {
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	conf := DefaultConfig()
+	conf.Mempool.MaxTxs = 42
+
+	WriteConfigFile(confFile, conf)
+
+	vpr := viper.New()
+	vpr.SetConfigFile(confFile)
+	require.NoError(t, vpr.ReadInConfig())
+
+	cfg, err := ParseConfig(vpr)
+	require.NoError(t, err)
+	require.Equal(t, 42, cfg.Mempool.MaxTxs)
+}
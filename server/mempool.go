@@ -0,0 +1,36 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// GetMempoolFromFlags returns the app-side mempool configured by the mempool
+// flags. When no mempool type is set, a priority-nonce mempool is returned, so
+// that txs are ordered by priority while the sequence ordering of the txs of a
+// sender is preserved.
+func GetMempoolFromFlags(appOpts types.AppOptions) (mempool.Mempool, error) {
+	maxTxs := cast.ToInt(appOpts.Get(FlagMempoolMaxTxs))
+
+	mempoolType := strings.ToLower(cast.ToString(appOpts.Get(FlagMempoolType)))
+	switch mempoolType {
+	case "", mempool.TypePriorityNonce:
+		cfg := mempool.DefaultPriorityNonceMempoolConfig()
+		cfg.MaxTx = maxTxs
+		return mempool.NewPriorityMempool(cfg), nil
+
+	case mempool.TypeSenderNonce:
+		return mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(maxTxs)), nil
+
+	case mempool.TypeNoOp:
+		return mempool.NoOpMempool{}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown mempool type %s", mempoolType)
+	}
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+func TestGetMempoolFromFlags(t *testing.T) {
+	tests := []struct {
+		name        string
+		mempoolType string
+		expected    mempool.Mempool
+		wantErr     bool
+	}{
+		{
+			name:     "default",
+			expected: &mempool.PriorityNonceMempool[int64]{},
+		},
+		{
+			name:        mempool.TypePriorityNonce,
+			mempoolType: mempool.TypePriorityNonce,
+			expected:    &mempool.PriorityNonceMempool[int64]{},
+		},
+		{
+			name:        mempool.TypeSenderNonce,
+			mempoolType: mempool.TypeSenderNonce,
+			expected:    &mempool.SenderNonceMempool{},
+		},
+		{
+			name:        mempool.TypeNoOp,
+			mempoolType: mempool.TypeNoOp,
+			expected:    mempool.NoOpMempool{},
+		},
+		{
+			name:        "unknown",
+			mempoolType: "fifo",
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(j *testing.T) {
+			v := viper.New()
+			v.Set(FlagMempoolMaxTxs, 10)
+			v.Set(FlagMempoolType, tt.mempoolType)
+
+			mp, err := GetMempoolFromFlags(v)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.IsType(t, tt.expected, mp)
+		})
+	}
+}
@@ -56,10 +56,15 @@ const (
 	FlagPruningKeepRecent   = "pruning-keep-recent"
 	FlagPruningInterval     = "pruning-interval"
 	FlagIndexEvents         = "index-events"
+	FlagDropEvents          = "drop-events"
 	FlagMinRetainBlocks     = "min-retain-blocks"
 	FlagIAVLCacheSize       = "iavl-cache-size"
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
 	FlagIAVLLazyLoading     = "iavl-lazy-loading"
+	FlagOptimisticExec      = "optimistic-execution"
+	FlagQueryGasLimit       = "query-gas-limit"
+	FlagQueryMaxRespBytes   = "query-max-response-bytes"
+	FlagBlockTraceDir       = "block-trace-dir"
 
 	// state sync-related flags
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
@@ -83,6 +88,7 @@ const (
 
 	// mempool flags
 	FlagMempoolMaxTxs = "mempool.max-txs"
+	FlagMempoolType   = "mempool.type"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -184,7 +190,12 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
+	cmd.Flags().Bool(FlagOptimisticExec, false, "Execute the txs of a block concurrently, re-executing conflicting txs")
+	cmd.Flags().Uint64(FlagQueryGasLimit, 0, "Maximum gas consumed by an ABCI or gRPC query (0 means unlimited)")
+	cmd.Flags().Uint64(FlagQueryMaxRespBytes, 0, "Maximum size in bytes of the response of an ABCI or gRPC query (0 means unlimited)")
+	cmd.Flags().String(FlagBlockTraceDir, "", "Write the execution trace of each block to the given directory, for debugging purposes (empty disables block tracing)")
 	cmd.Flags().Int(FlagMempoolMaxTxs, mempool.DefaultMaxTx, "Sets MaxTx value for the app-side mempool")
+	cmd.Flags().String(FlagMempoolType, mempool.TypePriorityNonce, "Sets the type of the app-side mempool (priority-nonce|sender-nonce|no-op)")
 
 	// support old flags name for backwards compatibility
 	cmd.Flags().SetNormalizeFunc(func(f *pflag.FlagSet, name string) pflag.NormalizedName {
@@ -0,0 +1,31 @@
+package simapp
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	circuitante "github.com/cosmos/cosmos-sdk/x/circuit/ante"
+)
+
+// setAnteHandler sets the ante handler of SimApp, which rejects the txs
+// paused by the circuit breaker and checks the tx fees with the fee market.
+func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
+	anteHandler, err := ante.NewAnteHandler(
+		ante.HandlerOptions{
+			AccountKeeper:   app.AccountKeeper,
+			BankKeeper:      app.BankKeeper,
+			SignModeHandler: txConfig.SignModeHandler(),
+			FeegrantKeeper:  app.FeeGrantKeeper,
+			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			TxFeeChecker:    app.FeeMarketKeeper.TxFeeChecker,
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	circuitDecorator := circuitante.NewCircuitBreakerDecorator(app.CircuitKeeper)
+	app.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return circuitDecorator.AnteHandle(ctx, tx, simulate, anteHandler)
+	})
+}
@@ -0,0 +1,47 @@
+package simapp
+
+import (
+	"os"
+	"testing"
+
+	appv1alpha1 "cosmossdk.io/api/cosmos/app/v1alpha1"
+	"cosmossdk.io/core/appconfig"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"sigs.k8s.io/yaml"
+)
+
+// TestAppConfigYAML checks that app.yaml, decoded as appconfig.LoadYAML does,
+// is the same configuration as AppConfig.
+func TestAppConfigYAML(t *testing.T) {
+	bz, err := os.ReadFile("app.yaml")
+	require.NoError(t, err)
+
+	j, err := yaml.YAMLToJSON(bz)
+	require.NoError(t, err)
+
+	config := &appv1alpha1.Config{}
+	require.NoError(t, protojson.Unmarshal(j, config))
+
+	expected, actual := normalizeAppConfig(t, appConfig), normalizeAppConfig(t, config)
+	require.True(t, proto.Equal(expected, actual), "app.yaml is out of sync with AppConfig:\n%s\n%s",
+		protojson.Format(expected), protojson.Format(actual))
+}
+
+// normalizeAppConfig returns a copy of the given config whose module configs
+// are wrapped with appconfig.WrapAny, so that configs only differing by the
+// prefix of their type URLs are equal.
+func normalizeAppConfig(t *testing.T, config *appv1alpha1.Config) *appv1alpha1.Config {
+	t.Helper()
+
+	config = proto.Clone(config).(*appv1alpha1.Config)
+	for _, module := range config.Modules {
+		msg, err := anypb.UnmarshalNew(module.Config, proto.UnmarshalOptions{})
+		require.NoError(t, err, module.Name)
+		module.Config = appconfig.WrapAny(msg)
+	}
+
+	return config
+}
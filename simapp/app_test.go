@@ -28,8 +28,12 @@ import (
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+	circuittypes "github.com/cosmos/cosmos-sdk/x/circuit/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/epochs"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	group "github.com/cosmos/cosmos-sdk/x/group/module"
@@ -199,6 +203,8 @@ func TestRunMigrations(t *testing.T) {
 					"evidence":     evidence.AppModule{}.ConsensusVersion(),
 					"crisis":       crisis.AppModule{}.ConsensusVersion(),
 					"genutil":      genutil.AppModule{}.ConsensusVersion(),
+					"epochs":       epochs.AppModule{}.ConsensusVersion(),
+					"feemarket":    feemarket.AppModule{}.ConsensusVersion(),
 				},
 			)
 			if tc.expRunErr {
@@ -248,6 +254,9 @@ func TestInitGenesisOnMigration(t *testing.T) {
 			"evidence":     evidence.AppModule{}.ConsensusVersion(),
 			"crisis":       crisis.AppModule{}.ConsensusVersion(),
 			"genutil":      genutil.AppModule{}.ConsensusVersion(),
+			"epochs":       epochs.AppModule{}.ConsensusVersion(),
+			"feemarket":    feemarket.AppModule{}.ConsensusVersion(),
+			"circuit":      circuit.AppModule{}.ConsensusVersion(),
 		},
 	)
 	require.NoError(t, err)
@@ -273,6 +282,23 @@ func TestUpgradeStateOnGenesis(t *testing.T) {
 	require.NotNil(t, app.UpgradeKeeper.GetVersionSetter())
 }
 
+func TestCircuitBreakerAnteHandler(t *testing.T) {
+	app := Setup(t, false)
+	ctx := app.NewContext(true, cmtproto.Header{})
+
+	msgSend := &banktypes.MsgSend{}
+	require.NoError(t, app.CircuitKeeper.DisableList.Set(ctx, sdk.MsgTypeURL(msgSend)))
+
+	txBuilder := app.TxConfig().NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(msgSend))
+	txBytes, err := app.TxConfig().TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	res := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.Equal(t, circuittypes.ModuleName, res.Codespace)
+	require.Equal(t, circuittypes.ErrCircuitBreakerTripped.ABCICode(), res.Code)
+}
+
 // TestMergedRegistry tests that fetching the gogo/protov2 merged registry
 // doesn't fail after loading all file descriptors.
 func TestMergedRegistry(t *testing.T) {
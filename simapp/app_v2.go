@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 
 	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
 	dbm "github.com/cosmos/cosmos-db"
 
 	"cosmossdk.io/client/v2/autocli"
@@ -33,6 +34,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	testdata_pulsar "github.com/cosmos/cosmos-sdk/testutil/testdata/testpb"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
@@ -44,17 +46,28 @@ import (
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+	circuitkeeper "github.com/cosmos/cosmos-sdk/x/circuit/keeper"
 	consensus "github.com/cosmos/cosmos-sdk/x/consensus"
 	consensuskeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	crisiskeeper "github.com/cosmos/cosmos-sdk/x/crisis/keeper"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs"
+	epochskeeper "github.com/cosmos/cosmos-sdk/x/epochs/keeper"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	feemarketkeeper "github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	groupkeeper "github.com/cosmos/cosmos-sdk/x/group/keeper"
 	groupmodule "github.com/cosmos/cosmos-sdk/x/group/module"
 	"github.com/cosmos/cosmos-sdk/x/mint"
@@ -67,6 +80,7 @@ import (
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 var (
@@ -99,6 +113,9 @@ var (
 		vesting.AppModuleBasic{},
 		nftmodule.AppModuleBasic{},
 		consensus.AppModuleBasic{},
+		epochs.AppModuleBasic{},
+		feemarket.AppModuleBasic{},
+		circuit.AppModuleBasic{},
 	)
 )
 
@@ -135,6 +152,9 @@ type SimApp struct {
 	GroupKeeper           groupkeeper.Keeper
 	NFTKeeper             nftkeeper.Keeper
 	ConsensusParamsKeeper consensuskeeper.Keeper
+	EpochsKeeper          *epochskeeper.Keeper
+	FeeMarketKeeper       feemarketkeeper.Keeper
+	CircuitKeeper         circuitkeeper.Keeper
 
 	// simulation manager
 	sm *module.SimulationManager
@@ -219,6 +239,7 @@ func NewSimApp(
 		&app.GroupKeeper,
 		&app.NFTKeeper,
 		&app.ConsensusParamsKeeper,
+		&app.CircuitKeeper,
 	); err != nil {
 		panic(err)
 	}
@@ -233,6 +254,7 @@ func NewSimApp(
 	// app.App = appBuilder.Build(...)
 	// nonceMempool := mempool.NewSenderNonceMempool()
 	// abciPropHandler := NewDefaultProposalHandler(nonceMempool, app.App.BaseApp)
+	// abciPropHandler.SetTxSelector(customTxSelector) // optional, e.g. for top-of-block txs
 	//
 	// app.App.BaseApp.SetMempool(nonceMempool)
 	// app.App.BaseApp.SetPrepareProposal(abciPropHandler.PrepareProposalHandler())
@@ -249,8 +271,44 @@ func NewSimApp(
 	// }
 	// baseAppOptions = append(baseAppOptions, prepareOpt)
 
+	// The handlers of these Msgs, along with the AnteHandler and the
+	// PostHandler, are safe for concurrent use: blocks made of them are executed
+	// concurrently when optimistic execution is enabled. The fees of their txs
+	// are credited to the fee collector without making them conflict.
+	baseAppOptions = append([]func(*baseapp.BaseApp){
+		baseapp.SetOptimisticExecutionMsgs(
+			sdk.MsgTypeURL(&banktypes.MsgSend{}),
+			sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+		),
+		baseapp.SetOptimisticExecutionAccumulators(feeCollectorAccumulators()...),
+	}, baseAppOptions...)
+
 	app.App = appBuilder.Build(db, traceStore, baseAppOptions...)
 
+	// the fees and the rewards of paused denoms can still be distributed
+	app.BankKeeper.SetPauseExemptModuleAccounts(authtypes.FeeCollectorName, distrtypes.ModuleName)
+
+	// register the epochs and fee market modules, which are not registered
+	// using the app config, and the ante handler checking the fees with the
+	// fee market
+	epochsKey := storetypes.NewKVStoreKey(epochstypes.StoreKey)
+	feeMarketKey := storetypes.NewKVStoreKey(feemarkettypes.StoreKey)
+	if err := app.RegisterStores(epochsKey, feeMarketKey); err != nil {
+		panic(err)
+	}
+
+	// modules can register for epoch hooks with app.EpochsKeeper.SetHooks
+	app.EpochsKeeper = epochskeeper.NewKeeper(app.appCodec, epochsKey)
+	app.FeeMarketKeeper = feemarketkeeper.NewKeeper(app.appCodec, feeMarketKey, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	if err := app.RegisterModules(
+		epochs.NewAppModule(app.appCodec, app.EpochsKeeper),
+		feemarket.NewAppModule(app.appCodec, app.FeeMarketKeeper),
+	); err != nil {
+		panic(err)
+	}
+
+	app.setAnteHandler(app.txConfig)
+
 	// register streaming services
 	if err := app.RegisterStreamingServices(appOpts, app.kvStoreKeys()); err != nil {
 		panic(err)
@@ -259,6 +317,7 @@ func NewSimApp(
 	/****  Module Options ****/
 
 	app.ModuleManager.RegisterInvariants(app.CrisisKeeper)
+	app.CrisisKeeper.SetQueryContextFn(app.CreateQueryContext)
 
 	// RegisterUpgradeHandlers is used for registering any on-chain upgrades.
 	app.RegisterUpgradeHandlers()
@@ -273,7 +332,7 @@ func NewSimApp(
 	overrideModules := map[string]module.AppModuleSimulation{
 		authtypes.ModuleName: auth.NewAppModule(app.appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts, app.GetSubspace(authtypes.ModuleName)),
 	}
-	app.sm = module.NewSimulationManagerFromAppModules(app.ModuleManager.Modules, overrideModules)
+	app.sm = app.ModuleManager.NewSimulationManager(overrideModules)
 
 	app.sm.RegisterStoreDecoders()
 
@@ -282,11 +341,10 @@ func NewSimApp(
 	// For instance, the upgrade module will set automatically the module version map in its init genesis thanks to app wiring.
 	// However, when registering a module manually (i.e. that does not support app wiring), the module version map
 	// must be set manually as follow. The upgrade module will de-duplicate the module version map.
-	//
-	// app.SetInitChainer(func(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
-	// 	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.ModuleManager.GetVersionMap())
-	// 	return app.App.InitChainer(ctx, req)
-	// })
+	app.SetInitChainer(func(ctx sdk.Context, req abci.RequestInitChain) (abci.ResponseInitChain, error) {
+		app.UpgradeKeeper.SetModuleVersionMap(ctx, app.ModuleManager.GetVersionMap())
+		return app.App.InitChainer(ctx, req)
+	})
 
 	if err := app.Load(loadLatest); err != nil {
 		panic(err)
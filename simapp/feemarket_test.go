@@ -0,0 +1,120 @@
+package simapp
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// TestFeeMarket delivers txs through the ante handler of SimApp with the fee
+// market enabled, and checks that the base fee is required and adjusted.
+func TestFeeMarket(t *testing.T) {
+	const gasLimit = 200000
+
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	acc := authtypes.NewBaseAccount(addr, priv.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: addr.String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100000000000000))),
+	}
+
+	appOptions := make(simtestutil.AppOptionsMap, 0)
+	appOptions[flags.FlagHome] = DefaultNodeHome
+	app := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, appOptions)
+
+	genesisState, err := simtestutil.GenesisStateWithValSet(app.AppCodec(), app.DefaultGenesis(), valSet, []authtypes.GenesisAccount{acc}, balance)
+	require.NoError(t, err)
+
+	feeMarketGenesis := feemarkettypes.DefaultGenesisState()
+	feeMarketGenesis.Params.Enabled = true
+	feeMarketGenesis.BaseFee = sdkmath.LegacyNewDecWithPrec(1, 2)
+	genesisState[feemarkettypes.ModuleName] = app.AppCodec().MustMarshalJSON(feeMarketGenesis)
+
+	stateBytes, err := json.MarshalIndent(genesisState, "", " ")
+	require.NoError(t, err)
+
+	app.InitChain(abci.RequestInitChain{
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	app.Commit()
+
+	r := rand.New(rand.NewSource(0))
+	txConfig := app.TxConfig()
+	var seq uint64
+	newTx := func(fee int64) []byte {
+		msg := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+		fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, fee))
+		tx, err := simtestutil.GenSignedMockTx(r, txConfig, []sdk.Msg{msg}, fees, gasLimit, "", []uint64{0}, []uint64{seq}, priv)
+		require.NoError(t, err)
+
+		txBytes, err := txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		return txBytes
+	}
+
+	// the fee required by the base fee of 0.01 is 2000
+	baseFee := app.FeeMarketKeeper.GetBaseFee(app.NewContext(true, cmtproto.Header{}))
+	require.Equal(t, sdkmath.LegacyNewDecWithPrec(1, 2), baseFee)
+
+	header := cmtproto.Header{Height: 2, Time: time.Unix(2, 0).UTC()}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: newTx(1999)})
+	require.Equal(t, sdkerrors.ErrInsufficientFee.ABCICode(), res.Code, res.Log)
+
+	res = app.DeliverTx(abci.RequestDeliverTx{Tx: newTx(2000)})
+	require.True(t, res.IsOK(), res.Log)
+	seq++
+
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	// the block used less gas than targeted, so the base fee decreased
+	ctx := app.NewContext(true, cmtproto.Header{})
+	baseFee = app.FeeMarketKeeper.GetBaseFee(ctx)
+	require.True(t, baseFee.LT(sdkmath.LegacyNewDecWithPrec(1, 2)), baseFee.String())
+	require.True(t, baseFee.GTE(app.FeeMarketKeeper.GetParams(ctx).MinBaseFee), baseFee.String())
+
+	required := baseFee.MulInt64(gasLimit).Ceil().TruncateInt64()
+	checkRes := app.CheckTx(abci.RequestCheckTx{Tx: newTx(required - 1)})
+	require.Equal(t, sdkerrors.ErrInsufficientFee.ABCICode(), checkRes.Code, checkRes.Log)
+
+	header = cmtproto.Header{Height: 3, Time: time.Unix(3, 0).UTC()}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	res = app.DeliverTx(abci.RequestDeliverTx{Tx: newTx(required - 1)})
+	require.Equal(t, sdkerrors.ErrInsufficientFee.ABCICode(), res.Code, res.Log)
+
+	res = app.DeliverTx(abci.RequestDeliverTx{Tx: newTx(required)})
+	require.True(t, res.IsOK(), res.Log)
+
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+}
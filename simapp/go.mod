@@ -20,6 +20,7 @@ require (
 	github.com/cosmos/cosmos-db v1.0.0-rc.1
 	// this version is not used as it is always replaced by the latest Cosmos SDK version
 	github.com/cosmos/cosmos-sdk v0.48.0
+	github.com/cosmos/cosmos-sdk/x/circuit v0.0.0-00010101000000-000000000000
 	github.com/cosmos/gogoproto v1.4.8
 	github.com/golang/mock v1.6.0
 	github.com/spf13/cast v1.5.0
@@ -28,6 +29,7 @@ require (
 	github.com/spf13/viper v1.15.0
 	github.com/stretchr/testify v1.8.2
 	google.golang.org/protobuf v1.30.0
+	sigs.k8s.io/yaml v1.3.0
 )
 
 require (
@@ -189,7 +191,6 @@ require (
 	gotest.tools/v3 v3.4.0 // indirect
 	nhooyr.io/websocket v1.8.6 // indirect
 	pgregory.net/rapid v0.5.5 // indirect
-	sigs.k8s.io/yaml v1.3.0 // indirect
 )
 
 // Here are the short-lived replace from the SimApp
@@ -204,6 +205,7 @@ replace (
 	cosmossdk.io/x/feegrant => ../x/feegrant
 	cosmossdk.io/x/nft => ../x/nft
 	cosmossdk.io/x/upgrade => ../x/upgrade
+	github.com/cosmos/cosmos-sdk/x/circuit => ../x/circuit
 )
 
 // Below are the long-lived replace of the SimApp
@@ -0,0 +1,29 @@
+package simapp
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// feeCollectorAccumulators returns the optimistic execution accumulators of the
+// balances of the fee collector, which are credited with the fees of every tx.
+// Were the unused fees refunded, e.g. by posthandler.FeeRefundDecorator, they
+// would be debited from the fee collector, but never more than the fees the tx
+// paid, so that its balances would still only be credited by each tx.
+func feeCollectorAccumulators() []baseapp.OptimisticAccumulator {
+	addr := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	return []baseapp.OptimisticAccumulator{
+		{
+			StoreKey: banktypes.StoreKey,
+			Match:    func(key []byte) bool { return banktypes.IsBalanceKeyOf(key, addr) },
+			Merge:    banktypes.MergeBalanceCredit,
+		},
+		{
+			StoreKey: banktypes.StoreKey,
+			Match:    func(key []byte) bool { return banktypes.IsDenomAddressKeyOf(key, addr) },
+			Merge:    banktypes.MergeDenomAddressIndex,
+		},
+	}
+}
@@ -0,0 +1,244 @@
+package simapp
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/rand"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// TestOptimisticExecution executes blocks of bank and staking txs sequentially
+// and concurrently, and checks that the results match. It is meant to be run
+// with the race detector.
+func TestOptimisticExecution(t *testing.T) {
+	const numAccounts = 8
+
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+	valAddr := sdk.ValAddress(pubKey.Address())
+
+	privs := make([]*secp256k1.PrivKey, numAccounts)
+	accs := make([]authtypes.GenesisAccount, numAccounts)
+	balances := make([]banktypes.Balance, numAccounts)
+	for i := range privs {
+		privs[i] = secp256k1.GenPrivKeyFromSecret([]byte{byte(i)})
+		addr := sdk.AccAddress(privs[i].PubKey().Address())
+		accs[i] = authtypes.NewBaseAccount(addr, privs[i].PubKey(), uint64(i), 0)
+		balances[i] = banktypes.Balance{
+			Address: addr.String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100000000000000))),
+		}
+	}
+
+	newApp := func(opts ...func(*baseapp.BaseApp)) *SimApp {
+		appOptions := make(simtestutil.AppOptionsMap, 0)
+		appOptions[flags.FlagHome] = DefaultNodeHome
+
+		return NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, appOptions, opts...)
+	}
+
+	seqApp := newApp()
+	optApp := newApp(baseapp.SetOptimisticExecution(true))
+
+	genesisState, err := simtestutil.GenesisStateWithValSet(seqApp.AppCodec(), seqApp.DefaultGenesis(), valSet, accs, balances...)
+	require.NoError(t, err)
+	stateBytes, err := json.MarshalIndent(genesisState, "", " ")
+	require.NoError(t, err)
+
+	for _, app := range []*SimApp{seqApp, optApp} {
+		app.InitChain(abci.RequestInitChain{
+			Validators:      []abci.ValidatorUpdate{},
+			ConsensusParams: simtestutil.DefaultConsensusParams,
+			AppStateBytes:   stateBytes,
+		})
+		app.Commit()
+	}
+
+	r := rand.New(rand.NewSource(0))
+	txConfig := seqApp.TxConfig()
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	seqs := make([]uint64, numAccounts)
+
+	for height := int64(2); height <= 4; height++ {
+		var txs [][]byte
+		for i, priv := range privs {
+			from := sdk.AccAddress(priv.PubKey().Address())
+			to := sdk.AccAddress(privs[(i+1)%numAccounts].PubKey().Address())
+
+			var msg sdk.Msg
+			var txFees sdk.Coins
+			switch i % 4 {
+			case 0:
+				msg = banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+			case 1:
+				msg = banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+				txFees = fees
+			case 2:
+				msg = stakingtypes.NewMsgDelegate(from, valAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+			case 3:
+				msg = stakingtypes.NewMsgDelegate(from, valAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+				txFees = fees
+			}
+
+			tx, err := simtestutil.GenSignedMockTx(r, txConfig, []sdk.Msg{msg}, txFees, 200000,
+				"", []uint64{uint64(i)}, []uint64{seqs[i]}, priv)
+			require.NoError(t, err)
+			seqs[i]++
+
+			txBytes, err := txConfig.TxEncoder()(tx)
+			require.NoError(t, err)
+			txs = append(txs, txBytes)
+		}
+
+		var results [2][]abci.ResponseDeliverTx
+		var appHashes [2][]byte
+		for i, app := range []*SimApp{seqApp, optApp} {
+			header := cmtproto.Header{Height: height, Time: time.Unix(height, 0).UTC()}
+			res := app.ProcessProposal(abci.RequestProcessProposal{Txs: txs, Height: height, Time: header.Time})
+			require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Status)
+
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+			for _, txBytes := range txs {
+				res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+				require.True(t, res.IsOK(), res.Log)
+				results[i] = append(results[i], res)
+			}
+			app.EndBlock(abci.RequestEndBlock{Height: height})
+			appHashes[i] = app.Commit().Data
+		}
+
+		require.Equal(t, results[0], results[1])
+		require.Equal(t, appHashes[0], appHashes[1])
+	}
+}
+
+// syncBuffer is a buffer safe for concurrent writes.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// TestOptimisticExecutionFees checks that independent txs paying fees do not
+// conflict with each other through the fee collector balance.
+func TestOptimisticExecutionFees(t *testing.T) {
+	const numAccounts = 8
+
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 1)})
+
+	// the senders are followed by their recipients, which already exist so that
+	// the txs don't conflict through the creation of accounts
+	privs := make([]*secp256k1.PrivKey, 2*numAccounts)
+	accs := make([]authtypes.GenesisAccount, len(privs))
+	balances := make([]banktypes.Balance, numAccounts)
+	for i := range privs {
+		privs[i] = secp256k1.GenPrivKeyFromSecret([]byte{byte(i)})
+		addr := sdk.AccAddress(privs[i].PubKey().Address())
+		accs[i] = authtypes.NewBaseAccount(addr, privs[i].PubKey(), uint64(i), 0)
+		if i < numAccounts {
+			balances[i] = banktypes.Balance{
+				Address: addr.String(),
+				Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100000000000000))),
+			}
+		}
+	}
+
+	logs := &syncBuffer{}
+	appOptions := make(simtestutil.AppOptionsMap, 0)
+	appOptions[flags.FlagHome] = DefaultNodeHome
+	app := NewSimApp(log.NewLogger(logs, log.OutputJSONOption()), dbm.NewMemDB(), nil, true, appOptions, baseapp.SetOptimisticExecution(true))
+
+	genesisState, err := simtestutil.GenesisStateWithValSet(app.AppCodec(), app.DefaultGenesis(), valSet, accs, balances...)
+	require.NoError(t, err)
+	stateBytes, err := json.MarshalIndent(genesisState, "", " ")
+	require.NoError(t, err)
+
+	app.InitChain(abci.RequestInitChain{
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	app.Commit()
+
+	r := rand.New(rand.NewSource(0))
+	txConfig := app.TxConfig()
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	ctx := app.NewUncachedContext(false, cmtproto.Header{})
+	feesBefore := app.BankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom)
+
+	var txs [][]byte
+	for i, priv := range privs[:numAccounts] {
+		from := sdk.AccAddress(priv.PubKey().Address())
+		to := sdk.AccAddress(privs[numAccounts+i].PubKey().Address())
+		msg := banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+
+		tx, err := simtestutil.GenSignedMockTx(r, txConfig, []sdk.Msg{msg}, fees, 200000,
+			"", []uint64{uint64(i)}, []uint64{0}, priv)
+		require.NoError(t, err)
+
+		txBytes, err := txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		txs = append(txs, txBytes)
+	}
+
+	header := cmtproto.Header{Height: 2, Time: time.Unix(2, 0).UTC()}
+	res := app.ProcessProposal(abci.RequestProcessProposal{Txs: txs, Height: header.Height, Time: header.Time})
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Status)
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	for _, txBytes := range txs {
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+		require.True(t, res.IsOK(), res.Log)
+	}
+
+	// the fees of all the txs are collected before the block is ended
+	ctx = app.NewContext(false, header)
+	require.Equal(t,
+		feesBefore.AddAmount(fees.AmountOf(sdk.DefaultBondDenom).MulRaw(numAccounts)),
+		app.BankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom),
+	)
+
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	require.True(t, strings.Contains(logs.String(), "executing txs optimistically"))
+	require.False(t, strings.Contains(logs.String(), "conflicting"), logs.String())
+}
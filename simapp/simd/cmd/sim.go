@@ -0,0 +1,128 @@
+//go:build sims
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/simapp"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+const (
+	flagReplayOutput = "output-dir"
+	flagReplayPeriod = "period"
+	flagFauxMerkle   = "faux-merkle"
+)
+
+// simCommands returns the simulation commands, which are only built in simd with
+// the sims build tag.
+func simCommands() []*cobra.Command {
+	return []*cobra.Command{NewSimCmd()}
+}
+
+// NewSimCmd returns the simulation commands.
+func NewSimCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sim",
+		Short: "Simulation subcommands",
+	}
+
+	cmd.AddCommand(NewSimReplayCmd())
+
+	return cmd
+}
+
+// NewSimReplayCmd returns a command replaying the simulation of a reproduction
+// bundle, written by a failed simulation run with -ReproBundleDir.
+func NewSimReplayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay [bundle]",
+		Short: "Replay the simulation of a reproduction bundle",
+		Long: `Replay the simulation of a reproduction bundle, written by a failed simulation run with -ReproBundleDir.
+The replayed simulation writes its own reproduction bundle when it fails. The command fails if the failure
+is not reproduced at the same height with the same app hash, which reveals a nondeterminism: the operations
+logs of both bundles can then be compared to find where the simulations diverged.`,
+		Example: "$ simd sim replay ./bundle --period 5",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bundle, err := simtypes.ReadReproBundle(args[0])
+			if err != nil {
+				return err
+			}
+
+			outputDir, _ := cmd.Flags().GetString(flagReplayOutput)
+			if outputDir == "" {
+				outputDir = args[0] + "-replay"
+			}
+			if err := os.RemoveAll(outputDir); err != nil {
+				return err
+			}
+
+			period, _ := cmd.Flags().GetUint(flagReplayPeriod)
+			fauxMerkle, _ := cmd.Flags().GetBool(flagFauxMerkle)
+
+			appOptions := make(simtestutil.AppOptionsMap, 0)
+			appOptions[flags.FlagHome] = tempDir()
+			appOptions[server.FlagInvCheckPeriod] = period
+
+			baseAppOptions := []func(*baseapp.BaseApp){baseapp.SetChainID(bundle.Config.ChainID)}
+			if fauxMerkle {
+				baseAppOptions = append(baseAppOptions, func(bapp *baseapp.BaseApp) { bapp.SetFauxMerkleMode() })
+			}
+
+			app := simapp.NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, appOptions, baseAppOptions...)
+
+			replayed, failed, err := simulation.ReplayReproBundle(
+				cmd.OutOrStdout(),
+				bundle,
+				outputDir,
+				app.BaseApp,
+				simtestutil.AppStateFn(app.AppCodec(), app.SimulationManager(), app.DefaultGenesis()),
+				simtypes.RandomAccounts,
+				simtestutil.SimulationOperations(app, app.AppCodec(), bundle.Config),
+				simapp.BlockedAddresses(),
+				app.AppCodec(),
+			)
+			if err != nil {
+				return err
+			}
+
+			if !failed {
+				return fmt.Errorf("the replayed simulation did not fail, the simulation is nondeterministic or was run with another invariant check period")
+			}
+
+			if err := simtestutil.ExportReproBundleAppState(app, replayed.Config); err != nil {
+				return err
+			}
+
+			if replayed.Height != bundle.Height || replayed.LastAppHash != bundle.LastAppHash {
+				return fmt.Errorf("the replayed simulation failed at height %d with app hash %s instead of height %d with app hash %s, "+
+					"the simulation is nondeterministic: compare the reproduction bundles %s and %s",
+					replayed.Height, replayed.LastAppHash, bundle.Height, bundle.LastAppHash, args[0], outputDir)
+			}
+
+			cmd.Printf("\nThe failure was reproduced at height %d with app hash %s, see the reproduction bundle %s:\n%s\n",
+				replayed.Height, replayed.LastAppHash, outputDir, replayed.Failure)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagReplayOutput, "", "The directory of the reproduction bundle of the replayed simulation (default [bundle]-replay)")
+	cmd.Flags().Uint(flagReplayPeriod, 0, "The invariant check period, which must be the -Period of the failed simulation")
+	cmd.Flags().Bool(flagFauxMerkle, true, "Use the faux merkle mode, as the simulations of simapp do")
+
+	return cmd
+}
@@ -0,0 +1,11 @@
+//go:build !sims
+
+package cmd
+
+import "github.com/spf13/cobra"
+
+// simCommands returns no command, the simulation commands being only built in
+// simd with the sims build tag.
+func simCommands() []*cobra.Command {
+	return nil
+}
@@ -47,12 +47,16 @@ var (
 	flagRPCAddress        = "rpc.address"
 	flagAPIAddress        = "api.address"
 	flagPrintMnemonic     = "print-mnemonic"
+	flagManifests         = "manifests"
+	flagDockerImage       = "docker-image"
 )
 
 type initArgs struct {
 	algo              string
 	chainID           string
+	dockerImage       string
 	keyringBackend    string
+	manifests         []string
 	minGasPrices      string
 	nodeDaemonHome    string
 	nodeDirPrefix     string
@@ -121,8 +125,13 @@ or a similar setup where each node has a manually configurable IP address.
 
 Note, strict routability for addresses is turned off in the config file.
 
+With --manifests, a docker-compose.yml file and/or a kubernetes.yaml file running the nodes
+are written to the output directory as well. The node homes are mounted as volumes, or copied
+to persistent volumes on Kubernetes, and the ports of the nodes are mapped on the host.
+
 Example:
 	simd testnet init-files --v 4 --output-dir ./.testnets --starting-ip-address 192.168.10.2
+	simd testnet init-files --v 4 --output-dir ./.testnets --starting-ip-address 192.168.10.2 --manifests docker-compose
 	`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -143,6 +152,12 @@ Example:
 			args.startingIPAddress, _ = cmd.Flags().GetString(flagStartingIPAddress)
 			args.numValidators, _ = cmd.Flags().GetInt(flagNumValidators)
 			args.algo, _ = cmd.Flags().GetString(flags.FlagKeyType)
+			args.manifests, _ = cmd.Flags().GetStringSlice(flagManifests)
+			args.dockerImage, _ = cmd.Flags().GetString(flagDockerImage)
+
+			if err := validateManifests(args.manifests, args.startingIPAddress, args.numValidators); err != nil {
+				return err
+			}
 
 			return initTestnetFiles(clientCtx, cmd, config, mbm, genBalIterator, args)
 		},
@@ -153,6 +168,8 @@ Example:
 	cmd.Flags().String(flagNodeDaemonHome, "simd", "Home directory of the node's daemon configuration")
 	cmd.Flags().String(flagStartingIPAddress, "192.168.0.1", "Starting IP address (192.168.0.1 results in persistent peers list ID0@192.168.0.1:46656, ID1@192.168.0.2:46656, ...)")
 	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+	cmd.Flags().StringSlice(flagManifests, nil, fmt.Sprintf("Manifests to write to run the testnet in containers (%s|%s)", manifestDockerCompose, manifestKubernetes))
+	cmd.Flags().String(flagDockerImage, "cosmossdk/simd", "Docker image of the nodes in the manifests")
 
 	return cmd
 }
@@ -219,11 +236,17 @@ func initTestnetFiles(
 	simappConfig.Telemetry.PrometheusRetentionTime = 60
 	simappConfig.Telemetry.EnableHostnameLabel = false
 	simappConfig.Telemetry.GlobalLabels = [][]string{{"chain_id", args.chainID}}
+	if len(args.manifests) > 0 {
+		// the API and gRPC servers of containers must listen on all interfaces to be reachable
+		simappConfig.API.Address = "tcp://0.0.0.0:1317"
+		simappConfig.GRPC.Address = "0.0.0.0:9090"
+	}
 
 	var (
 		genAccounts []authtypes.GenesisAccount
 		genBalances []banktypes.Balance
 		genFiles    []string
+		nodes       []testnetNode
 	)
 
 	inBuf := bufio.NewReader(cmd.InOrStdin())
@@ -256,6 +279,12 @@ func initTestnetFiles(
 
 		memo := fmt.Sprintf("%s@%s:26656", nodeIDs[i], ip)
 		genFiles = append(genFiles, nodeConfig.GenesisFile())
+		nodes = append(nodes, testnetNode{
+			Name:  nodeDirName,
+			IP:    ip,
+			Home:  filepath.Join(nodeDirName, args.nodeDaemonHome),
+			Index: i,
+		})
 
 		kb, err := keyring.New(sdk.KeyringServiceName(), args.keyringBackend, nodeDir, inBuf, clientCtx.Codec)
 		if err != nil {
@@ -351,6 +380,10 @@ func initTestnetFiles(
 		return err
 	}
 
+	if err := writeManifests(args.manifests, args.outputDir, args.dockerImage, args.nodeDaemonHome, nodes); err != nil {
+		return err
+	}
+
 	cmd.PrintErrf("Successfully initialized %d node directories\n", args.numValidators)
 	return nil
 }
@@ -0,0 +1,274 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+const (
+	manifestDockerCompose = "docker-compose"
+	manifestKubernetes    = "kubernetes"
+
+	dockerComposeFile = "docker-compose.yml"
+	kubernetesFile    = "kubernetes.yaml"
+)
+
+// testnetNode describes a node initialized by init-files, as needed to run it
+// in a container.
+type testnetNode struct {
+	Name string
+	IP   string
+	// Home is the home directory of the node, relative to the output directory.
+	Home string
+	// Index is the index of the node, used to map its ports on the host.
+	Index int
+}
+
+// testnetManifest holds the data of the docker-compose and Kubernetes templates.
+type testnetManifest struct {
+	Image         string
+	ContainerHome string
+	Subnet        string
+	Nodes         []testnetNode
+	// Files holds the base64 encoded files of the home of each node, by node name.
+	Files map[string]map[string]string
+}
+
+var dockerComposeTemplate = newManifestTemplate(dockerComposeFile, `# Generated by "simd testnet init-files", start the testnet with "docker compose up".
+version: "3"
+
+services:
+{{- range .Nodes }}
+  {{ .Name }}:
+    container_name: {{ .Name }}
+    image: "{{ $.Image }}"
+    command: ["simd", "start", "--home", "{{ $.ContainerHome }}"]
+    ports:
+      - "{{ add 26656 (mul .Index 10) }}-{{ add 26657 (mul .Index 10) }}:26656-26657"
+      - "{{ add 1317 .Index }}:1317"
+      - "{{ add 9090 .Index }}:9090"
+    volumes:
+      - ./{{ .Home }}:{{ $.ContainerHome }}:Z
+    networks:
+      testnet:
+        ipv4_address: {{ .IP }}
+{{- end }}
+
+networks:
+  testnet:
+    driver: bridge
+    ipam:
+      driver: default
+      config:
+        - subnet: {{ .Subnet }}
+`)
+
+var kubernetesTemplate = newManifestTemplate(kubernetesFile, `# Generated by "simd testnet init-files", start the testnet with "kubectl apply -f kubernetes.yaml".
+{{- range .Nodes }}
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: {{ .Name }}-home
+data:
+{{- range $file, $content := index $.Files .Name }}
+  {{ $file }}: {{ $content }}
+{{- end }}
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: {{ .Name }}
+spec:
+  selector:
+    app: {{ .Name }}
+  ports:
+    - name: p2p
+      port: 26656
+    - name: rpc
+      port: 26657
+    - name: api
+      port: 1317
+    - name: grpc
+      port: 9090
+---
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: {{ .Name }}
+spec:
+  serviceName: {{ .Name }}
+  replicas: 1
+  selector:
+    matchLabels:
+      app: {{ .Name }}
+  template:
+    metadata:
+      labels:
+        app: {{ .Name }}
+    spec:
+      initContainers:
+        - name: init-home
+          image: "{{ $.Image }}"
+          command:
+            - sh
+            - -c
+            - >-
+              mkdir -p {{ $.ContainerHome }}/config {{ $.ContainerHome }}/data &&
+              cp /init/config.toml /init/app.toml /init/genesis.json /init/node_key.json /init/priv_validator_key.json {{ $.ContainerHome }}/config/ &&
+              (test -f {{ $.ContainerHome }}/data/priv_validator_state.json || cp /init/priv_validator_state.json {{ $.ContainerHome }}/data/)
+          volumeMounts:
+            - name: init
+              mountPath: /init
+            - name: home
+              mountPath: {{ $.ContainerHome }}
+      containers:
+        - name: simd
+          image: "{{ $.Image }}"
+          command: ["simd", "start", "--home", "{{ $.ContainerHome }}"]
+          ports:
+            - containerPort: 26656
+            - containerPort: 26657
+            - containerPort: 1317
+            - containerPort: 9090
+          volumeMounts:
+            - name: home
+              mountPath: {{ $.ContainerHome }}
+      volumes:
+        - name: init
+          secret:
+            secretName: {{ .Name }}-home
+  volumeClaimTemplates:
+    - metadata:
+        name: home
+      spec:
+        accessModes: ["ReadWriteOnce"]
+        resources:
+          requests:
+            storage: 10Gi
+{{- end }}
+`)
+
+func newManifestTemplate(name, text string) *template.Template {
+	return template.Must(template.New(name).Funcs(template.FuncMap{
+		"add": func(a, b int) int { return a + b },
+		"mul": func(a, b int) int { return a * b },
+	}).Parse(text))
+}
+
+// validateManifests checks the requested manifests, and that the nodes of a
+// docker-compose testnet get valid IP addresses in a /24 subnet.
+func validateManifests(manifests []string, startingIPAddress string, numValidators int) error {
+	for _, manifest := range manifests {
+		switch manifest {
+		case manifestDockerCompose:
+			ip := net.ParseIP(startingIPAddress).To4()
+			if ip == nil {
+				return fmt.Errorf("%s manifest requires an IPv4 starting IP address, got %q", manifestDockerCompose, startingIPAddress)
+			}
+			// the first address of the subnet is reserved for the gateway of the network
+			if ip[3] < 2 || int(ip[3])+numValidators-1 > 254 {
+				return fmt.Errorf(
+					"%s manifest requires the IP addresses of the %d nodes to be in %s, from .2 to .254, e.g. with --%s=192.168.10.2",
+					manifestDockerCompose, numValidators, subnet(ip), flagStartingIPAddress,
+				)
+			}
+		case manifestKubernetes:
+		default:
+			return fmt.Errorf("unknown manifest %q, must be one of %s, %s", manifest, manifestDockerCompose, manifestKubernetes)
+		}
+	}
+
+	return nil
+}
+
+// writeManifests writes the requested manifests to the output directory, to
+// run the nodes initialized by init-files in containers of the given image.
+func writeManifests(manifests []string, outputDir, image, nodeDaemonHome string, nodes []testnetNode) error {
+	m := testnetManifest{
+		Image:         image,
+		ContainerHome: "/root/." + nodeDaemonHome,
+		Nodes:         nodes,
+	}
+
+	for _, manifest := range manifests {
+		var (
+			tmpl *template.Template
+			file string
+		)
+
+		switch manifest {
+		case manifestDockerCompose:
+			m.Subnet = subnet(net.ParseIP(nodes[0].IP).To4())
+			tmpl, file = dockerComposeTemplate, dockerComposeFile
+		case manifestKubernetes:
+			files, err := kubernetesNodeFiles(outputDir, nodes)
+			if err != nil {
+				return err
+			}
+			m.Files = files
+			tmpl, file = kubernetesTemplate, kubernetesFile
+		}
+
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, m); err != nil {
+			return err
+		}
+
+		if err := writeFile(file, outputDir, buf.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// kubernetesNodeFiles returns the base64 encoded files needed to start each
+// node. The persistent peers of the nodes are addressed by their service name
+// instead of their IP address.
+func kubernetesNodeFiles(outputDir string, nodes []testnetNode) (map[string]map[string]string, error) {
+	peers := make([]string, 0, 2*len(nodes))
+	for _, node := range nodes {
+		peers = append(peers, fmt.Sprintf("@%s:26656", node.IP), fmt.Sprintf("@%s:26656", node.Name))
+	}
+	peersReplacer := strings.NewReplacer(peers...)
+
+	files := make(map[string]map[string]string, len(nodes))
+	for _, node := range nodes {
+		home := filepath.Join(outputDir, node.Home)
+		files[node.Name] = make(map[string]string)
+
+		for _, file := range []string{
+			"config/config.toml",
+			"config/app.toml",
+			"config/genesis.json",
+			"config/node_key.json",
+			"config/priv_validator_key.json",
+			"data/priv_validator_state.json",
+		} {
+			bz, err := os.ReadFile(filepath.Join(home, file))
+			if err != nil {
+				return nil, err
+			}
+
+			if file == "config/config.toml" {
+				bz = []byte(peersReplacer.Replace(string(bz)))
+			}
+
+			files[node.Name][filepath.Base(file)] = base64.StdEncoding.EncodeToString(bz)
+		}
+	}
+
+	return files, nil
+}
+
+// subnet returns the /24 subnet of ip.
+func subnet(ip net.IP) string {
+	return (&net.IPNet{IP: ip.Mask(net.CIDRMask(24, 32)), Mask: net.CIDRMask(24, 32)}).String()
+}
@@ -3,6 +3,8 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/spf13/viper"
@@ -52,3 +54,47 @@ func Test_TestnetCmd(t *testing.T) {
 	bankGenState := banktypes.GetGenesisStateFromAppState(encodingConfig.Codec, appState)
 	require.NotEmpty(t, bankGenState.Supply.String())
 }
+
+func Test_TestnetCmdManifests(t *testing.T) {
+	home := t.TempDir()
+	encodingConfig := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}, auth.AppModuleBasic{})
+	logger := log.NewNopLogger()
+	cfg, err := genutiltest.CreateDefaultCometConfig(home)
+	require.NoError(t, err)
+
+	err = genutiltest.ExecInitCmd(simapp.ModuleBasics, home, encodingConfig.Codec)
+	require.NoError(t, err)
+
+	serverCtx := server.NewContext(viper.New(), cfg, logger)
+	clientCtx := client.Context{}.
+		WithCodec(encodingConfig.Codec).
+		WithHomeDir(home).
+		WithTxConfig(encodingConfig.TxConfig)
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, server.ServerContextKey, serverCtx)
+	ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
+
+	// the default starting IP address is the gateway of the docker network
+	cmd := testnetInitFilesCmd(simapp.ModuleBasics, banktypes.GenesisBalancesIterator{})
+	cmd.SetArgs([]string{fmt.Sprintf("--%s=test", flags.FlagKeyringBackend), fmt.Sprintf("--output-dir=%s", home), "--manifests=docker-compose"})
+	require.ErrorContains(t, cmd.ExecuteContext(ctx), "192.168.0.0/24")
+
+	cmd = testnetInitFilesCmd(simapp.ModuleBasics, banktypes.GenesisBalancesIterator{})
+	cmd.SetArgs([]string{
+		fmt.Sprintf("--%s=test", flags.FlagKeyringBackend), fmt.Sprintf("--output-dir=%s", home),
+		"--starting-ip-address=192.168.10.2", "--manifests=docker-compose,kubernetes",
+	})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	compose, err := os.ReadFile(filepath.Join(home, "docker-compose.yml"))
+	require.NoError(t, err)
+	require.Contains(t, string(compose), "ipv4_address: 192.168.10.5")
+	require.Contains(t, string(compose), "- ./node3/simd:/root/.simd:Z")
+	require.Contains(t, string(compose), "- subnet: 192.168.10.0/24")
+
+	k8s, err := os.ReadFile(filepath.Join(home, "kubernetes.yaml"))
+	require.NoError(t, err)
+	require.Contains(t, string(k8s), "secretName: node3-home")
+	require.Contains(t, string(k8s), "serviceName: node3")
+}
@@ -0,0 +1,134 @@
+package cachekv_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/types"
+)
+
+var (
+	// nestedBenchSizes are the numbers of keys of the parent store.
+	nestedBenchSizes = []int{1_000, 10_000, 100_000}
+	// nestedBenchDepths are the numbers of nested branches above the parent store.
+	nestedBenchDepths = []int{1, 4, 16}
+)
+
+// nestedFixture returns a stack of depth cachekv branches above a parent
+// store holding size keys, each branch overwriting 1% of the keys, and the
+// keys. The keys are generated from a fixed seed, so that the results of runs
+// on different revisions are comparable.
+func nestedFixture(size, depth int) (*CacheStack, [][]byte) {
+	r := rand.New(rand.NewSource(int64(size)))
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+
+	value := make([]byte, 100)
+	keys := make([][]byte, size)
+	for i := range keys {
+		keys[i] = make([]byte, 32)
+		r.Read(keys[i])
+		parent.Set(keys[i], value)
+	}
+
+	stack := &CacheStack{}
+	stack.Reset(cachekv.NewStore(parent))
+	for i := 0; i < depth; i++ {
+		stack.Snapshot()
+		writeBranch(stack.CurrentStore(), keys, i, value)
+	}
+
+	return stack, keys
+}
+
+// writeBranch overwrites 1% of the keys in store, from the i-th percent.
+func writeBranch(store types.KVStore, keys [][]byte, i int, value []byte) {
+	n := len(keys) / 100
+	for j := 0; j < n; j++ {
+		store.Set(keys[(i*n+j)%len(keys)], value)
+	}
+}
+
+func runNested(b *testing.B, fn func(b *testing.B, size, depth int)) {
+	for _, size := range nestedBenchSizes {
+		for _, depth := range nestedBenchDepths {
+			b.Run(fmt.Sprintf("size=%d/depth=%d", size, depth), func(b *testing.B) {
+				fn(b, size, depth)
+			})
+		}
+	}
+}
+
+func BenchmarkNestedGet(b *testing.B) {
+	runNested(b, func(b *testing.B, size, depth int) {
+		stack, keys := nestedFixture(size, depth)
+		store := stack.CurrentStore()
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			sink = store.Get(keys[i%len(keys)])
+		}
+	})
+}
+
+func BenchmarkNestedSet(b *testing.B) {
+	runNested(b, func(b *testing.B, size, depth int) {
+		stack, keys := nestedFixture(size, depth)
+		store := stack.CurrentStore()
+		value := make([]byte, 100)
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			store.Set(keys[i%len(keys)], value)
+		}
+	})
+}
+
+func BenchmarkNestedIterate(b *testing.B) {
+	runNested(b, func(b *testing.B, size, depth int) {
+		stack, _ := nestedFixture(size, depth)
+		store := stack.CurrentStore()
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			n := 0
+			iter := store.Iterator(nil, nil)
+			for ; iter.Valid(); iter.Next() {
+				sink = iter.Value()
+				n++
+			}
+			iter.Close()
+			if n != size {
+				b.Fatalf("expected %d keys, got %d", size, n)
+			}
+		}
+	})
+}
+
+// BenchmarkNestedWrite measures branching depth times above the parent store,
+// writing 1% of the keys in each branch, and writing the branches back to the
+// parent store, as done by nested message executions.
+func BenchmarkNestedWrite(b *testing.B) {
+	runNested(b, func(b *testing.B, size, depth int) {
+		stack, keys := nestedFixture(size, 0)
+		value := make([]byte, 100)
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for j := 0; j < depth; j++ {
+				stack.Snapshot()
+				value[0] = byte(i)
+				writeBranch(stack.CurrentStore(), keys, i*depth+j, value)
+			}
+			stack.Commit()
+		}
+	})
+}
@@ -0,0 +1,122 @@
+package iavl
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/iavl"
+	"github.com/stretchr/testify/require"
+)
+
+// benchSizes are the numbers of keys of the trees the benchmarks run against.
+var benchSizes = []int{1_000, 10_000, 100_000}
+
+// benchFixture returns a store holding size keys, committed to the db, and
+// its keys. The keys and values are generated from a fixed seed, so that the
+// results of runs on different revisions are comparable.
+func benchFixture(b *testing.B, size int) (*Store, [][]byte) {
+	b.Helper()
+
+	r := rand.New(rand.NewSource(int64(size)))
+	tree, err := iavl.NewMutableTree(dbm.NewMemDB(), 10_000, false)
+	require.NoError(b, err)
+
+	keys := make([][]byte, size)
+	for i := range keys {
+		keys[i] = make([]byte, 32)
+		r.Read(keys[i])
+
+		value := make([]byte, 100)
+		r.Read(value)
+
+		_, err := tree.Set(keys[i], value)
+		require.NoError(b, err)
+	}
+
+	_, _, err = tree.SaveVersion()
+	require.NoError(b, err)
+
+	return UnsafeNewStore(tree), keys
+}
+
+func BenchmarkStoreGet(b *testing.B) {
+	for _, size := range benchSizes {
+		b.Run(fmt.Sprintf("size=%d", size), func(b *testing.B) {
+			store, keys := benchFixture(b, size)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if store.Get(keys[i%len(keys)]) == nil {
+					b.Fatal("missing key")
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkStoreSet(b *testing.B) {
+	for _, size := range benchSizes {
+		b.Run(fmt.Sprintf("size=%d", size), func(b *testing.B) {
+			store, keys := benchFixture(b, size)
+			value := make([]byte, 100)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				value[0] = byte(i)
+				store.Set(keys[i%len(keys)], value)
+			}
+		})
+	}
+}
+
+func BenchmarkStoreIterate(b *testing.B) {
+	for _, size := range benchSizes {
+		b.Run(fmt.Sprintf("size=%d", size), func(b *testing.B) {
+			store, _ := benchFixture(b, size)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				n := 0
+				iter := store.Iterator(nil, nil)
+				for ; iter.Valid(); iter.Next() {
+					_ = iter.Value()
+					n++
+				}
+				require.NoError(b, iter.Close())
+				if n != size {
+					b.Fatalf("expected %d keys, got %d", size, n)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkStoreCommit measures the commit of a block updating 1% of the keys
+// of the store.
+func BenchmarkStoreCommit(b *testing.B) {
+	for _, size := range benchSizes {
+		b.Run(fmt.Sprintf("size=%d", size), func(b *testing.B) {
+			store, keys := benchFixture(b, size)
+			value := make([]byte, 100)
+			updates := size / 100
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				for j := 0; j < updates; j++ {
+					value[0] = byte(i)
+					store.Set(keys[(i*updates+j)%len(keys)], value)
+				}
+				b.StartTimer()
+
+				store.Commit()
+			}
+		})
+	}
+}
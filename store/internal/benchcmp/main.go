@@ -0,0 +1,200 @@
+// benchcmp compares the results of two runs of the store benchmarks, as output
+// by "go test -bench", and fails if a benchmark regressed by more than a
+// threshold. The median of the samples of each benchmark is compared, so the
+// benchmarks should be run with -count of at least 5 to smooth out the noise.
+// For a statistical comparison of the runs, use benchstat.
+//
+// Usage:
+//
+//	go run ./internal/benchcmp [-threshold 10] [-metrics ns/op,allocs/op] old.txt new.txt
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// procsSuffix matches the GOMAXPROCS suffix of the names of the benchmarks.
+var procsSuffix = regexp.MustCompile(`-\d+$`)
+
+// results holds the samples of each metric of each benchmark, e.g.
+// results["cosmossdk.io/store/iavl.BenchmarkStoreGet/size=1000"]["ns/op"].
+type results map[string]map[string][]float64
+
+// comparison is the comparison of a metric of a benchmark between two runs.
+type comparison struct {
+	Name   string
+	Metric string
+	Old    float64
+	New    float64
+	// Delta is the change of the median, in percent of the old median.
+	Delta float64
+}
+
+func (c comparison) regressed(threshold float64) bool {
+	return c.Delta > threshold
+}
+
+func main() {
+	threshold := flag.Float64("threshold", 10, "Maximum increase of a metric of a benchmark, in percent")
+	metrics := flag.String("metrics", "ns/op,allocs/op", "Comma separated metrics checked against the threshold")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: benchcmp [flags] old.txt new.txt\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(os.Stdout, flag.Arg(0), flag.Arg(1), *threshold, strings.Split(*metrics, ",")); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(w io.Writer, oldFile, newFile string, threshold float64, metrics []string) error {
+	oldResults, err := parseFile(oldFile)
+	if err != nil {
+		return err
+	}
+
+	newResults, err := parseFile(newFile)
+	if err != nil {
+		return err
+	}
+
+	comparisons := compare(oldResults, newResults, metrics)
+	if len(comparisons) == 0 {
+		return fmt.Errorf("no common benchmarks in %s and %s", oldFile, newFile)
+	}
+
+	regressions := 0
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "benchmark\tmetric\told\tnew\tdelta\t")
+	for _, c := range comparisons {
+		status := ""
+		if c.regressed(threshold) {
+			status = "REGRESSION"
+			regressions++
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%.4g\t%.4g\t%+.2f%%\t%s\n", c.Name, c.Metric, c.Old, c.New, c.Delta, status)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if regressions > 0 {
+		return fmt.Errorf("%d benchmark metrics regressed by more than %.2f%%", regressions, threshold)
+	}
+
+	return nil
+}
+
+func parseFile(name string) (results, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return parse(f)
+}
+
+// parse parses the output of "go test -bench". The names of the benchmarks
+// are prefixed by their package, and stripped of their GOMAXPROCS suffix.
+func parse(r io.Reader) (results, error) {
+	res := make(results)
+	pkg := ""
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if p, ok := strings.CutPrefix(line, "pkg: "); ok {
+			pkg = strings.TrimSpace(p)
+			continue
+		}
+
+		fields := strings.Fields(line)
+		// a result is the name, the number of iterations, and value/metric pairs
+		if len(fields) < 4 || len(fields)%2 != 0 || !strings.HasPrefix(fields[0], "Benchmark") {
+			continue
+		}
+		if _, err := strconv.Atoi(fields[1]); err != nil {
+			continue
+		}
+
+		name := procsSuffix.ReplaceAllString(fields[0], "")
+		if pkg != "" {
+			name = pkg + "." + name
+		}
+		if res[name] == nil {
+			res[name] = make(map[string][]float64)
+		}
+
+		for i := 2; i < len(fields); i += 2 {
+			v, err := strconv.ParseFloat(fields[i], 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %q of benchmark %s: %w", fields[i], name, err)
+			}
+			res[name][fields[i+1]] = append(res[name][fields[i+1]], v)
+		}
+	}
+
+	return res, scanner.Err()
+}
+
+// compare compares the medians of the given metrics of the benchmarks found
+// in both runs, sorted by name.
+func compare(oldResults, newResults results, metrics []string) []comparison {
+	names := make([]string, 0, len(newResults))
+	for name := range newResults {
+		if _, ok := oldResults[name]; ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var comparisons []comparison
+	for _, name := range names {
+		for _, metric := range metrics {
+			oldSamples, newSamples := oldResults[name][metric], newResults[name][metric]
+			if len(oldSamples) == 0 || len(newSamples) == 0 {
+				continue
+			}
+
+			c := comparison{Name: name, Metric: metric, Old: median(oldSamples), New: median(newSamples)}
+			switch {
+			case c.Old != 0:
+				c.Delta = (c.New - c.Old) / c.Old * 100
+			case c.New != 0:
+				c.Delta = 100
+			}
+			comparisons = append(comparisons, c)
+		}
+	}
+
+	return comparisons
+}
+
+func median(samples []float64) float64 {
+	sorted := append([]float64(nil), samples...)
+	sort.Float64s(sorted)
+
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
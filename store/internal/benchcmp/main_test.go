@@ -0,0 +1,97 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const oldRun = `goos: linux
+goarch: amd64
+pkg: cosmossdk.io/store/iavl
+BenchmarkStoreGet/size=1000-8     	 1000000	       200 ns/op	      48 B/op	       1 allocs/op
+BenchmarkStoreGet/size=1000-8     	 1000000	       210 ns/op	      48 B/op	       1 allocs/op
+BenchmarkStoreGet/size=1000-8     	 1000000	       900 ns/op	      48 B/op	       1 allocs/op
+BenchmarkStoreSet/size=1000-8     	  100000	      6800 ns/op	    2579 B/op	      13 allocs/op
+PASS
+ok  	cosmossdk.io/store/iavl	3.5s
+pkg: cosmossdk.io/store/cachekv
+BenchmarkNestedGet/size=1000/depth=1-8	 1000000	      1000 ns/op
+`
+
+const newRun = `pkg: cosmossdk.io/store/iavl
+BenchmarkStoreGet/size=1000-8     	 1000000	       205 ns/op	      48 B/op	       1 allocs/op
+BenchmarkStoreGet/size=1000-8     	 1000000	       215 ns/op	      48 B/op	       1 allocs/op
+BenchmarkStoreGet/size=1000-8     	 1000000	       220 ns/op	      48 B/op	       1 allocs/op
+BenchmarkStoreSet/size=1000-8     	  100000	      6800 ns/op	    2579 B/op	      20 allocs/op
+BenchmarkStoreCommit/size=1000-8  	    1000	    720000 ns/op
+pkg: cosmossdk.io/store/cachekv
+BenchmarkNestedGet/size=1000/depth=1-8	 1000000	      1000 ns/op
+`
+
+func TestParse(t *testing.T) {
+	res, err := parse(strings.NewReader(oldRun))
+	require.NoError(t, err)
+
+	require.Len(t, res, 3)
+	require.Equal(t, []float64{200, 210, 900}, res["cosmossdk.io/store/iavl.BenchmarkStoreGet/size=1000"]["ns/op"])
+	require.Equal(t, []float64{13}, res["cosmossdk.io/store/iavl.BenchmarkStoreSet/size=1000"]["allocs/op"])
+	require.Equal(t, []float64{1000}, res["cosmossdk.io/store/cachekv.BenchmarkNestedGet/size=1000/depth=1"]["ns/op"])
+
+	_, err = parse(strings.NewReader("BenchmarkStoreGet-8 100 abc ns/op\n"))
+	require.ErrorContains(t, err, `invalid value "abc"`)
+}
+
+func TestCompare(t *testing.T) {
+	oldResults, err := parse(strings.NewReader(oldRun))
+	require.NoError(t, err)
+	newResults, err := parse(strings.NewReader(newRun))
+	require.NoError(t, err)
+
+	comparisons := compare(oldResults, newResults, []string{"ns/op", "allocs/op"})
+	// the benchmarks which are not in both runs are not compared
+	require.Len(t, comparisons, 5)
+
+	byName := make(map[string]comparison)
+	for _, c := range comparisons {
+		byName[c.Name+" "+c.Metric] = c
+	}
+
+	// the median is compared, so the outlier of the old run is ignored
+	get := byName["cosmossdk.io/store/iavl.BenchmarkStoreGet/size=1000 ns/op"]
+	require.Equal(t, float64(210), get.Old)
+	require.Equal(t, float64(215), get.New)
+	require.InDelta(t, 2.38, get.Delta, 0.01)
+	require.False(t, get.regressed(10))
+
+	set := byName["cosmossdk.io/store/iavl.BenchmarkStoreSet/size=1000 allocs/op"]
+	require.InDelta(t, 53.85, set.Delta, 0.01)
+	require.True(t, set.regressed(10))
+}
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	oldFile, newFile := filepath.Join(dir, "old.txt"), filepath.Join(dir, "new.txt")
+	require.NoError(t, os.WriteFile(oldFile, []byte(oldRun), 0o600))
+	require.NoError(t, os.WriteFile(newFile, []byte(newRun), 0o600))
+
+	var out bytes.Buffer
+	err := run(&out, oldFile, newFile, 10, []string{"ns/op", "allocs/op"})
+	require.EqualError(t, err, "1 benchmark metrics regressed by more than 10.00%")
+	require.Regexp(t, `cosmossdk.io/store/iavl.BenchmarkStoreSet/size=1000 +allocs/op +13 +20 +\+53.85% +REGRESSION`, out.String())
+
+	out.Reset()
+	require.NoError(t, run(&out, oldFile, newFile, 60, []string{"ns/op", "allocs/op"}))
+	require.NotContains(t, out.String(), "REGRESSION")
+
+	require.ErrorContains(t, run(&out, oldFile, newFile, 10, []string{"MB/s"}), "no common benchmarks")
+}
+
+func TestMedian(t *testing.T) {
+	require.Equal(t, float64(2), median([]float64{3, 1, 2}))
+	require.Equal(t, 2.5, median([]float64{4, 1, 3, 2}))
+}
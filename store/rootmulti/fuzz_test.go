@@ -0,0 +1,33 @@
+package rootmulti
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/types"
+)
+
+func FuzzMultiStoreQuery(f *testing.F) {
+	multi := newMultiStoreWithMounts(dbm.NewMemDB(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	if err := multi.LoadLatestVersion(); err != nil {
+		f.Fatal(err)
+	}
+	multi.GetStoreByName("store1").(types.KVStore).Set([]byte("wind"), []byte("blows"))
+	multi.GetStoreByName("store2").(types.KVStore).Set([]byte("water"), []byte("flows"))
+	ver := multi.Commit().Version
+
+	f.Add("/store1/key", []byte("wind"), ver, true)
+	f.Add("/store2/subspace", []byte("wa"), ver, false)
+	f.Add("/store3/key", []byte{}, int64(0), true)
+	f.Add("/garbage/key", []byte("wind"), ver, false)
+	f.Add("/key", []byte("wind"), int64(-1), false)
+	f.Add("store1", []byte(nil), ver+1, true)
+
+	f.Fuzz(func(t *testing.T, path string, data []byte, height int64, prove bool) {
+		// malformed requests must fail without panicking
+		_ = multi.Query(abci.RequestQuery{Path: path, Data: data, Height: height, Prove: prove})
+	})
+}
@@ -71,3 +71,16 @@ func SetGaugeWithLabels(keys []string, val float32, labels []metrics.Label) {
 func MeasureSince(start time.Time, keys ...string) {
 	metrics.MeasureSinceWithLabels(keys, start.UTC(), globalLabels)
 }
+
+// MeasureSinceWithLabels provides a wrapper functionality for emitting a time
+// measure metric with global labels (if any) along with the provided labels.
+func MeasureSinceWithLabels(keys []string, start time.Time, labels []metrics.Label) {
+	metrics.MeasureSinceWithLabels(keys, start.UTC(), append(labels, globalLabels...))
+}
+
+// MeasureDurationWithLabels provides a wrapper functionality for emitting a
+// time measure metric of a duration measured by the caller, e.g. with the clock
+// of a context, with global labels (if any) along with the provided labels.
+func MeasureDurationWithLabels(keys []string, d time.Duration, labels []metrics.Label) {
+	metrics.AddSampleWithLabels(keys, float32(d.Nanoseconds())/float32(time.Millisecond), append(labels, globalLabels...))
+}
@@ -1683,9 +1683,6 @@ func (s *E2ETestSuite) TestAuxSigner() {
 }
 
 func (s *E2ETestSuite) TestAuxToFeeWithTips() {
-	// Skipping this test as it needs a simapp with the TipDecorator in post handler.
-	s.T().Skip()
-
 	require := s.Require()
 	val := s.network.Validators[0]
 
@@ -1739,7 +1736,7 @@ func (s *E2ETestSuite) TestAuxToFeeWithTips() {
 			},
 		},
 		{
-			name:     "both tipper, fee payer uses AMINO: no error",
+			name:     "both tipper, fee payer uses AMINO: error",
 			tipper:   tipper,
 			feePayer: feePayer,
 			tip:      tip,
@@ -1748,6 +1745,7 @@ func (s *E2ETestSuite) TestAuxToFeeWithTips() {
 				fmt.Sprintf("--%s=%s", flags.FlagTip, tip),
 				fmt.Sprintf("--%s=true", flags.FlagAux),
 			},
+			expectErrAux: true,
 			feePayerArgs: []string{
 				fmt.Sprintf("--%s=%s", flags.FlagSignMode, flags.SignModeLegacyAminoJSON),
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
@@ -1792,7 +1790,7 @@ func (s *E2ETestSuite) TestAuxToFeeWithTips() {
 			},
 		},
 		{
-			name:     "legacy amino json: no error",
+			name:     "tipper uses legacy amino json: error",
 			tipper:   tipper,
 			feePayer: feePayer,
 			tip:      tip,
@@ -1801,6 +1799,7 @@ func (s *E2ETestSuite) TestAuxToFeeWithTips() {
 				fmt.Sprintf("--%s=%s", flags.FlagTip, tip),
 				fmt.Sprintf("--%s=true", flags.FlagAux),
 			},
+			expectErrAux: true,
 			feePayerArgs: []string{
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
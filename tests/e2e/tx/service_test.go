@@ -183,12 +183,13 @@ func (s *E2ETestSuite) TestSimulateTx_GRPC() {
 				s.Require().NoError(err)
 				// Check the result and gas used are correct.
 				//
-				// The 12 events are:
+				// The 13 events are:
 				// - Sending Fee to the pool: coin_spent, coin_received, transfer and message.sender=<val1>
 				// - tx.* events: tx.fee, tx.acc_seq, tx.signature
 				// - Sending Amount to recipient: coin_spent, coin_received, transfer and message.sender=<val1>
 				// - Msg events: message.module=bank and message.action=/cosmos.bank.v1beta1.MsgSend (in one message)
-				s.Require().Equal(12, len(res.GetResult().GetEvents()))
+				// - Msg gas event: msg_gas.msg_type_url=/cosmos.bank.v1beta1.MsgSend and msg_gas.gas_consumed
+				s.Require().Equal(13, len(res.GetResult().GetEvents()))
 				s.Require().True(res.GetGasInfo().GetGasUsed() > 0) // Gas used sometimes change, just check it's not empty.
 			}
 		})
@@ -230,7 +231,7 @@ func (s *E2ETestSuite) TestSimulateTx_GRPCGateway() {
 				s.Require().NoError(err)
 				// Check the result and gas used are correct.
 				s.Require().Len(result.GetResult().MsgResponses, 1)
-				s.Require().Equal(12, len(result.GetResult().GetEvents())) // See TestSimulateTx_GRPC for the 12 events.
+				s.Require().Equal(13, len(result.GetResult().GetEvents())) // See TestSimulateTx_GRPC for the 13 events.
 				s.Require().True(result.GetGasInfo().GetGasUsed() > 0)     // Gas used sometimes change, just check it's not empty.
 			}
 		})
@@ -720,10 +720,11 @@ func TestMsgUpdateParams(t *testing.T) {
 			msg: &distrtypes.MsgUpdateParams{
 				Authority: f.distrKeeper.GetAuthority(),
 				Params: distrtypes.Params{
-					CommunityTax:        communityTax,
-					BaseProposerReward:  sdk.ZeroDec(),
-					BonusProposerReward: sdk.ZeroDec(),
-					WithdrawAddrEnabled: withdrawAddrEnabled,
+					CommunityTax:             communityTax,
+					BaseProposerReward:       sdk.ZeroDec(),
+					BonusProposerReward:      sdk.ZeroDec(),
+					WithdrawAddrEnabled:      withdrawAddrEnabled,
+					MaxAutoCompoundsPerBlock: distrtypes.DefaultParams().MaxAutoCompoundsPerBlock,
 				},
 			},
 			expErr: false,
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"gotest.tools/v3/assert"
 
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
@@ -501,3 +502,78 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 
 	assert.Assert(t, tallyResults.Equals(expectedTallyResult))
 }
+
+func TestTallyMultipleChoice(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	app, ctx := f.app, f.ctx
+
+	addrs, _ := createValidators(t, ctx, app, []int64{5, 5, 5})
+
+	voteOptions := v1.ProposalVoteOptions{Options: []string{"Option A", "Option B", "Option C", "Option D", "Option E"}}
+	proposal, err := app.GovKeeper.SubmitMultipleChoiceProposal(ctx, "", "test", "description", addrs[0], voteOptions)
+	assert.NilError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionOne), ""))
+	assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], v1.NewNonSplitVoteOption(v1.VoteOption(5)), ""))
+	assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], v1.NewNonSplitVoteOption(v1.VoteOption(5)), ""))
+	// option six is not defined by the proposal
+	assert.ErrorContains(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], v1.NewNonSplitVoteOption(v1.VoteOption(6)), ""), "is not an option")
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	assert.Assert(t, ok)
+	passes, burnDeposits, snapshot := app.GovKeeper.TallyWithSnapshot(ctx, proposal)
+
+	assert.Assert(t, passes)
+	assert.Assert(t, burnDeposits == false)
+
+	// the options are tallied by index instead of in the standard tally result
+	assert.Assert(t, snapshot.Tally.Equals(v1.EmptyTallyResult()))
+	assert.DeepEqual(t, []*v1.OptionTallyResult{
+		v1.NewOptionTallyResult(v1.OptionOne, app.StakingKeeper.TokensFromConsensusPower(ctx, 5)),
+		v1.NewOptionTallyResult(v1.OptionTwo, math.ZeroInt()),
+		v1.NewOptionTallyResult(v1.OptionThree, math.ZeroInt()),
+		v1.NewOptionTallyResult(v1.OptionFour, math.ZeroInt()),
+		v1.NewOptionTallyResult(v1.VoteOption(5), app.StakingKeeper.TokensFromConsensusPower(ctx, 10)),
+	}, snapshot.OptionTallyResults)
+}
+
+func TestTallyOptimistic(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	app, ctx := f.app, f.ctx
+
+	addrs, _ := createValidators(t, ctx, app, []int64{5, 5, 5})
+
+	params := app.GovKeeper.GetParams(ctx)
+	params.OptimisticAuthorizedAddresses = []string{addrs[0].String()}
+	assert.NilError(t, app.GovKeeper.SetParams(ctx, params))
+
+	tp := TestProposal
+	_, err := app.GovKeeper.SubmitOptimisticProposal(ctx, tp, "", "test", "description", addrs[1])
+	assert.ErrorContains(t, err, "not authorized")
+
+	proposal, err := app.GovKeeper.SubmitOptimisticProposal(ctx, tp, "", "test", "description", addrs[0])
+	assert.NilError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	// an optimistic proposal passes without any vote
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	assert.Assert(t, ok)
+	passes, burnDeposits, _ := app.GovKeeper.Tally(ctx, proposal)
+	assert.Assert(t, passes)
+	assert.Assert(t, burnDeposits == false)
+
+	// and is rejected once a third of the voting power votes no
+	assert.NilError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+	passes, burnDeposits, _ = app.GovKeeper.Tally(ctx, proposal)
+	assert.Assert(t, passes == false)
+	assert.Assert(t, burnDeposits == false)
+}
@@ -100,3 +100,49 @@ func TestUnbondingDelegationsMaxEntries(t *testing.T) {
 	assert.Assert(math.IntEq(t, newBonded, oldBonded.SubRaw(1)))
 	assert.Assert(math.IntEq(t, newNotBonded, oldNotBonded.AddRaw(1)))
 }
+
+func TestTransferDelegationAndUnbonding(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	addrs, valAddrs, _ := createValidators(t, ctx, app, []int64{9, 8, 7})
+	power := func(p int64) math.Int { return app.StakingKeeper.TokensFromConsensusPower(ctx, p) }
+
+	// move part of the delegation of addrs[0] to valAddrs[0] to addrs[3]
+	shares, err := app.StakingKeeper.TransferDelegation(ctx, addrs[0], addrs[3], valAddrs[0], math.LegacyNewDecFromInt(power(4)))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(power(4)), shares)
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addrs[0], valAddrs[0])
+	assert.Assert(t, found)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(power(5)), delegation.Shares)
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrs[3], valAddrs[0])
+	assert.Assert(t, found)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(power(4)), delegation.Shares)
+
+	// no more than the delegation shares are moved, and the delegation is removed
+	shares, err = app.StakingKeeper.TransferDelegation(ctx, addrs[0], addrs[3], valAddrs[0], math.LegacyNewDecFromInt(power(10)))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(power(5)), shares)
+	_, found = app.StakingKeeper.GetDelegation(ctx, addrs[0], valAddrs[0])
+	assert.Assert(t, !found)
+
+	// move part of an unbonding delegation, which completes at the same time
+	_, _, err = app.StakingKeeper.Undelegate(ctx, addrs[3], valAddrs[0], math.LegacyNewDecFromInt(power(3)))
+	assert.NilError(t, err)
+	moved, err := app.StakingKeeper.TransferUnbonding(ctx, addrs[3], addrs[4], valAddrs[0], power(1))
+	assert.NilError(t, err)
+	assert.Assert(math.IntEq(t, power(1), moved))
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrs[3], valAddrs[0])
+	assert.Assert(t, found)
+	assert.Assert(math.IntEq(t, power(2), ubd.Entries[0].Balance))
+	toUbd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrs[4], valAddrs[0])
+	assert.Assert(t, found)
+	assert.Assert(math.IntEq(t, power(1), toUbd.Entries[0].Balance))
+	assert.Equal(t, ubd.Entries[0].CompletionTime, toUbd.Entries[0].CompletionTime)
+
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	balance := app.BankKeeper.GetBalance(ctx, addrs[4], bondDenom)
+	ctx = ctx.WithBlockTime(toUbd.Entries[0].CompletionTime)
+	_, err = app.StakingKeeper.CompleteUnbonding(ctx, addrs[4], valAddrs[0])
+	assert.NilError(t, err)
+	assert.Assert(math.IntEq(t, balance.Amount.Add(power(1)), app.BankKeeper.GetBalance(ctx, addrs[4], bondDenom).Amount))
+}
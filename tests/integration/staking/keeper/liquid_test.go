@@ -0,0 +1,267 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"gotest.tools/v3/assert"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestTokenizeAndRedeemShares(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	addrs, valAddrs, _ := createValidators(t, ctx, app, []int64{9, 8, 7})
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	tokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 2)
+
+	shareToken, err := app.StakingKeeper.TokenizeShares(ctx, addrs[0], valAddrs[0], tokens, addrs[2])
+	assert.NilError(t, err)
+	assert.Equal(t, strings.ToLower(valAddrs[0].String())+"/1", shareToken.Denom)
+	assert.Assert(math.IntEq(t, tokens, shareToken.Amount))
+	assert.DeepEqual(t, shareToken, app.BankKeeper.GetBalance(ctx, addrs[2], shareToken.Denom))
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addrs[0], valAddrs[0])
+	assert.Assert(t, found)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(app.StakingKeeper.TokensFromConsensusPower(ctx, 7)), delegation.Shares)
+
+	record, found := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	assert.Assert(t, found)
+	assert.Equal(t, valAddrs[0].String(), record.Validator)
+	assert.Equal(t, addrs[2].String(), record.Owner)
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddrs[0])
+	assert.Assert(t, found)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(tokens), delegation.Shares)
+
+	assert.Assert(math.IntEq(t, tokens, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx)))
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(tokens), app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddrs[0]).LiquidShares)
+
+	// the share tokens are redeemed for delegation shares of their holder
+	half := sdk.NewCoin(shareToken.Denom, tokens.QuoRaw(2))
+	returned, err := app.StakingKeeper.RedeemTokensForShares(ctx, addrs[2], half)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, sdk.NewCoin(bondDenom, tokens.QuoRaw(2)), returned)
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrs[2], valAddrs[0])
+	assert.Assert(t, found)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(tokens.QuoRaw(2)), delegation.Shares)
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	assert.Assert(t, found)
+
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, addrs[2], shareToken)
+	assert.ErrorContains(t, err, "insufficient funds")
+
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, addrs[2], half)
+	assert.NilError(t, err)
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrs[2], valAddrs[0])
+	assert.Assert(t, found)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(tokens), delegation.Shares)
+
+	// the record is removed with its delegation
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	assert.Assert(t, !found)
+	_, found = app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddrs[0])
+	assert.Assert(t, !found)
+	assert.Assert(math.IntEq(t, math.ZeroInt(), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx)))
+	assert.Assert(t, app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddrs[0]).LiquidShares.IsZero())
+	assert.Assert(math.IntEq(t, math.ZeroInt(), app.BankKeeper.GetSupply(ctx, shareToken.Denom).Amount))
+
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, addrs[2], sdk.NewCoin("stake/1", math.OneInt()))
+	assert.ErrorIs(t, err, types.ErrTokenizeShareRecordNotFound)
+
+	// share tokens cannot be sent to an address blocked by bank
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrs[0], valAddrs[0], tokens, authtypes.NewModuleAddress(types.BondedPoolName))
+	assert.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestLiquidStakingCaps(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	addrs, valAddrs, _ := createValidators(t, ctx, app, []int64{9, 8, 7})
+	power := func(p int64) math.Int { return app.StakingKeeper.TokensFromConsensusPower(ctx, p) }
+
+	// 1 out of the 24 bonded tokens exceeds the global cap
+	assert.NilError(t, app.StakingKeeper.SetLiquidStakingParams(ctx, types.NewLiquidStakingParams(
+		math.LegacyNewDecWithPrec(1, 2), math.LegacyOneDec(), types.DefaultValidatorBondFactor,
+	)))
+	_, err := app.StakingKeeper.TokenizeShares(ctx, addrs[0], valAddrs[0], power(1), addrs[0])
+	assert.ErrorIs(t, err, types.ErrGlobalLiquidStakingCapExceeded)
+
+	// 1 out of the 9 shares of the validator exceeds the validator cap
+	assert.NilError(t, app.StakingKeeper.SetLiquidStakingParams(ctx, types.NewLiquidStakingParams(
+		math.LegacyOneDec(), math.LegacyNewDecWithPrec(1, 1), types.DefaultValidatorBondFactor,
+	)))
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrs[0], valAddrs[0], power(1), addrs[0])
+	assert.ErrorIs(t, err, types.ErrValidatorLiquidStakingCapExceeded)
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrs[0], valAddrs[0], power(1).QuoRaw(2), addrs[0])
+	assert.NilError(t, err)
+
+	assert.Error(t, app.StakingKeeper.SetLiquidStakingParams(ctx, types.NewLiquidStakingParams(
+		math.LegacyOneDec(), math.LegacyNewDec(2), types.DefaultValidatorBondFactor,
+	)), "validator liquid staking cap: cap must be between 0 and 1: 2.000000000000000000")
+}
+
+func TestValidatorBond(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	addrs, valAddrs, _ := createValidators(t, ctx, app, []int64{9, 8, 7})
+	power := func(p int64) math.Int { return app.StakingKeeper.TokensFromConsensusPower(ctx, p) }
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	assert.Assert(t, found)
+	_, err := app.StakingKeeper.Delegate(ctx, addrs[2], power(2), types.Unbonded, validator, true)
+	assert.NilError(t, err)
+
+	// the liquid shares of the validator are capped by twice its validator bond shares
+	assert.NilError(t, app.StakingKeeper.SetLiquidStakingParams(ctx, types.NewLiquidStakingParams(
+		math.LegacyOneDec(), math.LegacyOneDec(), math.LegacyNewDec(2),
+	)))
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrs[2], valAddrs[0], power(1), addrs[2])
+	assert.ErrorIs(t, err, types.ErrInsufficientValidatorBondShares)
+
+	assert.NilError(t, app.StakingKeeper.SetValidatorBond(ctx, addrs[0], valAddrs[0]))
+	assert.ErrorIs(t, app.StakingKeeper.SetValidatorBond(ctx, addrs[0], valAddrs[0]), types.ErrValidatorBondAlreadySet)
+	assert.ErrorIs(t, app.StakingKeeper.SetValidatorBond(ctx, addrs[3], valAddrs[0]), types.ErrNoDelegation)
+	assert.Assert(t, app.StakingKeeper.IsValidatorBond(ctx, addrs[0], valAddrs[0]))
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(power(9)), app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddrs[0]).ValidatorBondShares)
+
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrs[0], valAddrs[0], power(1), addrs[0])
+	assert.ErrorIs(t, err, types.ErrValidatorBondNotAllowedForTokenizeShares)
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrs[2], valAddrs[0], power(1), addrs[2])
+	assert.NilError(t, err)
+
+	// the delegations to the validator bond increase the validator bond shares
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	assert.Assert(t, found)
+	_, err = app.StakingKeeper.Delegate(ctx, addrs[0], power(1), types.Unbonded, validator, true)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(power(10)), app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddrs[0]).ValidatorBondShares)
+
+	// the validator bond cannot be unbonded below half the liquid shares
+	_, err = app.StakingKeeper.Unbond(ctx, addrs[0], valAddrs[0], math.LegacyNewDecFromInt(power(10).Sub(power(1).QuoRaw(4))))
+	assert.ErrorIs(t, err, types.ErrInsufficientValidatorBondShares)
+	_, err = app.StakingKeeper.Unbond(ctx, addrs[0], valAddrs[0], math.LegacyNewDecFromInt(power(9)))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(power(1)), app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddrs[0]).ValidatorBondShares)
+}
+
+func TestLiquidStakingGenesis(t *testing.T) {
+	app, ctx, addrs := bootstrapGenesisTest(t, 2)
+	params := app.StakingKeeper.GetParams(ctx)
+	valTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 1)
+	tokens := valTokens.QuoRaw(2)
+
+	pk, err := codectypes.NewAnyWithValue(PKs[0])
+	assert.NilError(t, err)
+
+	// add a bonded validator delegated by the first address
+	delAddr, valAddr := addrs[0], sdk.ValAddress(addrs[0])
+	validators := append(app.StakingKeeper.GetAllValidators(ctx), types.Validator{
+		OperatorAddress: valAddr.String(),
+		ConsensusPubkey: pk,
+		Status:          types.Bonded,
+		Tokens:          valTokens,
+		DelegatorShares: sdk.NewDecFromInt(valTokens),
+		Description:     types.NewDescription("hoop", "", "", "", ""),
+	})
+	delegations := append(app.StakingKeeper.GetAllDelegations(ctx), types.NewDelegation(delAddr, valAddr, sdk.NewDecFromInt(valTokens)))
+	assert.NilError(t, testutil.FundModuleAccount(
+		app.BankKeeper, ctx, types.BondedPoolName, sdk.NewCoins(sdk.NewCoin(params.BondDenom, valTokens)),
+	))
+	app.StakingKeeper.InitGenesis(ctx, types.NewGenesisState(params, validators, delegations))
+
+	// tokenize half of the delegation, and designate the rest as validator bond
+	shareToken, err := app.StakingKeeper.TokenizeShares(ctx, delAddr, valAddr, tokens, addrs[1])
+	assert.NilError(t, err)
+	assert.NilError(t, app.StakingKeeper.SetValidatorBond(ctx, delAddr, valAddr))
+
+	exported := app.StakingKeeper.ExportGenesis(ctx)
+	assert.Assert(math.IntEq(t, tokens, exported.TotalLiquidStakedTokens))
+	assert.Equal(t, uint64(1), exported.LastTokenizeShareRecordId)
+	assert.Equal(t, 1, len(exported.TokenizeShareRecords))
+	assert.Equal(t, 1, len(exported.LiquidValidators))
+	assert.DeepEqual(t, []types.ValidatorBondDelegation{{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+	}}, exported.ValidatorBondDelegations)
+
+	// wipe the liquid staking state, which is restored by the import
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	for _, prefix := range [][]byte{
+		types.LiquidStakingParamsKey, types.TotalLiquidStakedTokensKey, types.ValidatorLiquidSharesKey,
+		types.ValidatorBondDelegationKey, types.TokenizeShareRecordKey, types.LastTokenizeShareRecordIDKey,
+	} {
+		iterator := storetypes.KVStorePrefixIterator(store, prefix)
+		for ; iterator.Valid(); iterator.Next() {
+			store.Delete(iterator.Key())
+		}
+		iterator.Close()
+	}
+	_, found := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	assert.Assert(t, !found)
+
+	app.StakingKeeper.InitGenesis(ctx, exported)
+	assert.DeepEqual(t, exported, app.StakingKeeper.ExportGenesis(ctx))
+	assert.Assert(t, app.StakingKeeper.IsValidatorBond(ctx, delAddr, valAddr))
+
+	// the share tokens can still be redeemed
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, addrs[1], shareToken)
+	assert.NilError(t, err)
+	assert.Assert(math.IntEq(t, math.ZeroInt(), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx)))
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	assert.Assert(t, !found)
+}
+
+func TestTokenizeShareRecordRewards(t *testing.T) {
+	app, ctx, addrs := bootstrapGenesisTest(t, 3)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	tokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 1)
+
+	validator := app.StakingKeeper.GetAllValidators(ctx)[0]
+	valAddr := validator.GetOperator()
+	assert.NilError(t, testutil.FundAccount(app.BankKeeper, ctx, addrs[0], sdk.NewCoins(sdk.NewCoin(bondDenom, tokens))))
+	_, err := app.StakingKeeper.Delegate(ctx, addrs[0], tokens, types.Unbonded, validator, true)
+	assert.NilError(t, err)
+	shareToken, err := app.StakingKeeper.TokenizeShares(ctx, addrs[0], valAddr, tokens, addrs[1])
+	assert.NilError(t, err)
+	record, found := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	assert.Assert(t, found)
+
+	// allocate rewards to the validator, part of which accrue to the record delegation
+	allocateRewards := func() {
+		rewards := sdk.NewCoins(sdk.NewCoin(bondDenom, tokens))
+		assert.NilError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, distrtypes.ModuleName, rewards))
+		validator, _ = app.StakingKeeper.GetValidator(ctx, valAddr)
+		app.DistrKeeper.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoinsFromCoins(rewards...))
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	}
+	allocateRewards()
+
+	// only the record owner can withdraw the rewards of the record
+	_, err = app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, addrs[0], 1)
+	assert.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, addrs[1], 2)
+	assert.ErrorIs(t, err, types.ErrTokenizeShareRecordNotFound)
+
+	balance := app.BankKeeper.GetBalance(ctx, addrs[1], bondDenom)
+	rewards, err := app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, addrs[1], 1)
+	assert.NilError(t, err)
+	assert.Assert(t, rewards.AmountOf(bondDenom).IsPositive())
+	assert.DeepEqual(t, balance.AddAmount(rewards.AmountOf(bondDenom)), app.BankKeeper.GetBalance(ctx, addrs[1], bondDenom))
+	assert.Assert(t, app.BankKeeper.GetAllBalances(ctx, record.GetModuleAddress()).IsZero())
+
+	// the rewards left when the record is removed are sent to its owner
+	allocateRewards()
+	balance = app.BankKeeper.GetBalance(ctx, addrs[1], bondDenom)
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, addrs[1], shareToken)
+	assert.NilError(t, err)
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	assert.Assert(t, !found)
+	assert.Assert(t, balance.IsLT(app.BankKeeper.GetBalance(ctx, addrs[1], bondDenom)))
+	assert.Assert(t, app.BankKeeper.GetAllBalances(ctx, record.GetModuleAddress()).IsZero())
+}
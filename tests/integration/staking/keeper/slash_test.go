@@ -203,12 +203,28 @@ func TestSlashValidatorAtCurrentHeight(t *testing.T) {
 
 	_, found := app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
 	assert.Assert(t, found)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
 	app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), 10, fraction)
 
 	// read updated state
 	validator, found := app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
 	assert.Assert(t, found)
 
+	// a single event is emitted for all the delegations
+	var slashEvents []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeSlashDelegations {
+			slashEvents = append(slashEvents, event)
+		}
+	}
+	assert.Equal(t, 1, len(slashEvents))
+	assert.DeepEqual(t, sdk.NewEvent(
+		types.EventTypeSlashDelegations,
+		sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+		sdk.NewAttribute(types.AttributeKeyDelegatorShares, validator.DelegatorShares.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, app.StakingKeeper.TokensFromConsensusPower(ctx, 5).String()),
+	), slashEvents[0])
+
 	// end block
 	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, 1)
 
@@ -0,0 +1,421 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+var _ client.CometRPC = (*ScriptedCometRPC)(nil)
+
+// ScriptedCometRPC is a mock CometBFT RPC client whose responses are scripted
+// by the test: blocks and their tx results, the node status, ABCI queries, tx
+// and block searches and broadcast responses. It allows CLI tests, e.g. with
+// ExecTestCLICmd, to exercise the query and broadcast paths of the client
+// without a live node.
+//
+// Heights and hashes which were not scripted return the errors a CometBFT node
+// would return. It is safe for concurrent use.
+type ScriptedCometRPC struct {
+	mu sync.Mutex
+
+	status        *coretypes.ResultStatus
+	blocks        map[int64]*coretypes.ResultBlock
+	blockResults  map[int64]*coretypes.ResultBlockResults
+	txs           map[string]*coretypes.ResultTx
+	validators    map[int64]*coretypes.ResultValidators
+	queries       map[string]abci.ResponseQuery
+	txSearches    map[string]*coretypes.ResultTxSearch
+	blockSearches map[string]*coretypes.ResultBlockSearch
+	latestHeight  int64
+
+	broadcastRes *coretypes.ResultBroadcastTx
+	broadcastTxs []cmttypes.Tx
+}
+
+// NewScriptedCometRPC returns a ScriptedCometRPC without any block.
+func NewScriptedCometRPC() *ScriptedCometRPC {
+	return &ScriptedCometRPC{
+		blocks:        make(map[int64]*coretypes.ResultBlock),
+		blockResults:  make(map[int64]*coretypes.ResultBlockResults),
+		txs:           make(map[string]*coretypes.ResultTx),
+		validators:    make(map[int64]*coretypes.ResultValidators),
+		queries:       make(map[string]abci.ResponseQuery),
+		txSearches:    make(map[string]*coretypes.ResultTxSearch),
+		blockSearches: make(map[string]*coretypes.ResultBlockSearch),
+	}
+}
+
+// AddBlock scripts a block along with the results of its txs, which must be
+// given in the order of the txs of the block. The txs of the block can then be
+// queried by hash, and the block becomes the latest one if it is the highest.
+func (m *ScriptedCometRPC) AddBlock(block *cmttypes.Block, txResults ...*abci.ResponseDeliverTx) {
+	if len(txResults) != len(block.Txs) {
+		panic(fmt.Sprintf("block %d contains %d txs but %d tx results were given", block.Height, len(block.Txs), len(txResults)))
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.blocks[block.Height] = &coretypes.ResultBlock{
+		BlockID: cmttypes.BlockID{Hash: block.Hash()},
+		Block:   block,
+	}
+	m.blockResults[block.Height] = &coretypes.ResultBlockResults{
+		Height:     block.Height,
+		TxsResults: txResults,
+	}
+	for i, tx := range block.Txs {
+		m.txs[string(tx.Hash())] = &coretypes.ResultTx{
+			Hash:     tx.Hash(),
+			Height:   block.Height,
+			Index:    uint32(i),
+			TxResult: *txResults[i],
+			Tx:       tx,
+		}
+	}
+
+	if block.Height > m.latestHeight {
+		m.latestHeight = block.Height
+	}
+}
+
+// SetStatus scripts the status of the node. By default, the status reports the
+// latest block added with AddBlock.
+func (m *ScriptedCometRPC) SetStatus(status *coretypes.ResultStatus) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.status = status
+}
+
+// SetValidators scripts the validator set at the given height.
+func (m *ScriptedCometRPC) SetValidators(height int64, validators []*cmttypes.Validator) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.validators[height] = &coretypes.ResultValidators{
+		BlockHeight: height,
+		Validators:  validators,
+		Count:       len(validators),
+		Total:       len(validators),
+	}
+}
+
+// SetQueryResponse scripts the response of the ABCI queries on path.
+func (m *ScriptedCometRPC) SetQueryResponse(path string, res abci.ResponseQuery) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.queries[path] = res
+}
+
+// SetGRPCQueryResponse scripts the response of the gRPC queries of the given
+// method, e.g. "/cosmos.bank.v1beta1.Query/Balance", made through the client
+// context. It panics if res cannot be marshaled.
+func (m *ScriptedCometRPC) SetGRPCQueryResponse(method string, res proto.Message) {
+	bz, err := proto.Marshal(res)
+	if err != nil {
+		panic(err)
+	}
+
+	m.SetQueryResponse(method, abci.ResponseQuery{Value: bz})
+}
+
+// SetTxSearchResult scripts the result of the tx searches with the given query.
+// The searches with other queries return no tx.
+func (m *ScriptedCometRPC) SetTxSearchResult(query string, txs ...*coretypes.ResultTx) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.txSearches[query] = &coretypes.ResultTxSearch{Txs: txs, TotalCount: len(txs)}
+}
+
+// SetBlockSearchResult scripts the result of the block searches with the given
+// query. The searches with other queries return no block.
+func (m *ScriptedCometRPC) SetBlockSearchResult(query string, blocks ...*coretypes.ResultBlock) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.blockSearches[query] = &coretypes.ResultBlockSearch{Blocks: blocks, TotalCount: len(blocks)}
+}
+
+// SetBroadcastResponse scripts the response of the broadcasted txs. By default,
+// txs are accepted with a zero code.
+func (m *ScriptedCometRPC) SetBroadcastResponse(res *coretypes.ResultBroadcastTx) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.broadcastRes = res
+}
+
+// BroadcastedTxs returns the txs broadcasted so far, in order.
+func (m *ScriptedCometRPC) BroadcastedTxs() []cmttypes.Tx {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]cmttypes.Tx(nil), m.broadcastTxs...)
+}
+
+func (m *ScriptedCometRPC) ABCIInfo(context.Context) (*coretypes.ResultABCIInfo, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return &coretypes.ResultABCIInfo{Response: abci.ResponseInfo{LastBlockHeight: m.latestHeight}}, nil
+}
+
+func (m *ScriptedCometRPC) ABCIQuery(ctx context.Context, path string, data cmtbytes.HexBytes) (*coretypes.ResultABCIQuery, error) {
+	return m.ABCIQueryWithOptions(ctx, path, data, rpcclient.DefaultABCIQueryOptions)
+}
+
+func (m *ScriptedCometRPC) ABCIQueryWithOptions(
+	_ context.Context,
+	path string,
+	_ cmtbytes.HexBytes,
+	opts rpcclient.ABCIQueryOptions,
+) (*coretypes.ResultABCIQuery, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	res, ok := m.queries[path]
+	if !ok {
+		return &coretypes.ResultABCIQuery{Response: abci.ResponseQuery{
+			Code: 1,
+			Log:  fmt.Sprintf("no response scripted for query %s", path),
+		}}, nil
+	}
+
+	if res.Height == 0 {
+		res.Height = opts.Height
+		if res.Height == 0 {
+			res.Height = m.latestHeight
+		}
+	}
+
+	return &coretypes.ResultABCIQuery{Response: res}, nil
+}
+
+func (m *ScriptedCometRPC) BroadcastTxCommit(ctx context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTxCommit, error) {
+	res, err := m.BroadcastTxSync(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &coretypes.ResultBroadcastTxCommit{
+		CheckTx: abci.ResponseCheckTx{Code: res.Code, Data: res.Data, Log: res.Log, Codespace: res.Codespace},
+		Hash:    res.Hash,
+	}, nil
+}
+
+func (m *ScriptedCometRPC) BroadcastTxAsync(ctx context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	return m.BroadcastTxSync(ctx, tx)
+}
+
+func (m *ScriptedCometRPC) BroadcastTxSync(_ context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.broadcastTxs = append(m.broadcastTxs, tx)
+
+	res := coretypes.ResultBroadcastTx{}
+	if m.broadcastRes != nil {
+		res = *m.broadcastRes
+	}
+	res.Hash = tx.Hash()
+
+	return &res, nil
+}
+
+func (m *ScriptedCometRPC) Validators(_ context.Context, height *int64, _, _ *int) (*coretypes.ResultValidators, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	h, err := m.height(height)
+	if err != nil {
+		return nil, err
+	}
+
+	res, ok := m.validators[h]
+	if !ok {
+		return nil, fmt.Errorf("validators at height %d not found", h)
+	}
+
+	return res, nil
+}
+
+func (m *ScriptedCometRPC) Status(context.Context) (*coretypes.ResultStatus, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.status != nil {
+		return m.status, nil
+	}
+
+	status := &coretypes.ResultStatus{}
+	if latest, ok := m.blocks[m.latestHeight]; ok {
+		status.SyncInfo = coretypes.SyncInfo{
+			LatestBlockHash:   latest.BlockID.Hash,
+			LatestAppHash:     latest.Block.AppHash,
+			LatestBlockHeight: latest.Block.Height,
+			LatestBlockTime:   latest.Block.Time,
+		}
+	}
+
+	return status, nil
+}
+
+func (m *ScriptedCometRPC) Block(_ context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	h, err := m.height(height)
+	if err != nil {
+		return nil, err
+	}
+
+	res, ok := m.blocks[h]
+	if !ok {
+		return nil, fmt.Errorf("block at height %d not found", h)
+	}
+
+	return res, nil
+}
+
+func (m *ScriptedCometRPC) BlockByHash(_ context.Context, hash []byte) (*coretypes.ResultBlock, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, res := range m.blocks {
+		if res.BlockID.Hash.String() == cmtbytes.HexBytes(hash).String() {
+			return res, nil
+		}
+	}
+
+	return nil, fmt.Errorf("block with hash %X not found", hash)
+}
+
+func (m *ScriptedCometRPC) BlockResults(_ context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	h, err := m.height(height)
+	if err != nil {
+		return nil, err
+	}
+
+	res, ok := m.blockResults[h]
+	if !ok {
+		return nil, fmt.Errorf("results for block at height %d not found", h)
+	}
+
+	return res, nil
+}
+
+func (m *ScriptedCometRPC) BlockchainInfo(_ context.Context, minHeight, maxHeight int64) (*coretypes.ResultBlockchainInfo, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if maxHeight == 0 || maxHeight > m.latestHeight {
+		maxHeight = m.latestHeight
+	}
+
+	heights := make([]int64, 0, len(m.blocks))
+	for h := range m.blocks {
+		if h >= minHeight && h <= maxHeight {
+			heights = append(heights, h)
+		}
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] > heights[j] })
+
+	metas := make([]*cmttypes.BlockMeta, len(heights))
+	for i, h := range heights {
+		res := m.blocks[h]
+		parts, err := res.Block.MakePartSet(cmttypes.BlockPartSizeBytes)
+		if err != nil {
+			return nil, err
+		}
+		metas[i] = cmttypes.NewBlockMeta(res.Block, parts)
+	}
+
+	return &coretypes.ResultBlockchainInfo{LastHeight: m.latestHeight, BlockMetas: metas}, nil
+}
+
+func (m *ScriptedCometRPC) Commit(_ context.Context, height *int64) (*coretypes.ResultCommit, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	h, err := m.height(height)
+	if err != nil {
+		return nil, err
+	}
+
+	res, ok := m.blocks[h]
+	if !ok {
+		return nil, fmt.Errorf("commit at height %d not found", h)
+	}
+
+	commit := &cmttypes.Commit{Height: h, BlockID: res.BlockID}
+	if next, ok := m.blocks[h+1]; ok && next.Block.LastCommit != nil {
+		commit = next.Block.LastCommit
+	}
+
+	return coretypes.NewResultCommit(&res.Block.Header, commit, h < m.latestHeight), nil
+}
+
+func (m *ScriptedCometRPC) Tx(_ context.Context, hash []byte, _ bool) (*coretypes.ResultTx, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	res, ok := m.txs[string(hash)]
+	if !ok {
+		return nil, fmt.Errorf("tx (%X) not found", hash)
+	}
+
+	return res, nil
+}
+
+func (m *ScriptedCometRPC) TxSearch(_ context.Context, query string, _ bool, _, _ *int, _ string) (*coretypes.ResultTxSearch, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if res, ok := m.txSearches[query]; ok {
+		return res, nil
+	}
+
+	return &coretypes.ResultTxSearch{Txs: []*coretypes.ResultTx{}}, nil
+}
+
+func (m *ScriptedCometRPC) BlockSearch(_ context.Context, query string, _, _ *int, _ string) (*coretypes.ResultBlockSearch, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if res, ok := m.blockSearches[query]; ok {
+		return res, nil
+	}
+
+	return &coretypes.ResultBlockSearch{Blocks: []*coretypes.ResultBlock{}}, nil
+}
+
+// height returns the given height, or the latest one if it is nil, and an error
+// if it is above the latest height like a CometBFT node would.
+func (m *ScriptedCometRPC) height(height *int64) (int64, error) {
+	if height == nil {
+		return m.latestHeight, nil
+	}
+
+	if *height > m.latestHeight {
+		return 0, fmt.Errorf("height %d must be less than or equal to the current blockchain height %d", *height, m.latestHeight)
+	}
+
+	return *height, nil
+}
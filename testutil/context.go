@@ -35,6 +35,9 @@ type TestContext struct {
 	Ctx sdk.Context
 	DB  *dbm.MemDB
 	CMS store.CommitMultiStore
+	// Clock is the clock of Ctx, which provides its block time. Tests advance
+	// the block time with ctx.WithBlockTime(Clock.Advance(d)).
+	Clock *sdk.ManualClock
 }
 
 func DefaultContextWithDB(t testing.TB, key, tkey storetypes.StoreKey) TestContext {
@@ -45,7 +48,8 @@ func DefaultContextWithDB(t testing.TB, key, tkey storetypes.StoreKey) TestConte
 	err := cms.LoadLatestVersion()
 	assert.NoError(t, err)
 
-	ctx := sdk.NewContext(cms, cmtproto.Header{Time: time.Now()}, false, log.NewNopLogger())
+	clock := sdk.NewManualClock(time.Now())
+	ctx := sdk.NewContext(cms, cmtproto.Header{Time: clock.Now()}, false, log.NewNopLogger()).WithClock(clock)
 
-	return TestContext{ctx, db, cms}
+	return TestContext{ctx, db, cms, clock}
 }
@@ -0,0 +1,23 @@
+/*
+Package fixture creates apps for module integration tests, started from a
+genesis state built with a GenesisBuilder rather than by hand.
+
+The app is created from an app config with depinject, which injects its keepers
+into the outputs given to New:
+
+	var (
+		bankKeeper bankkeeper.Keeper
+		govKeeper  *govkeeper.Keeper
+	)
+
+	addrs := sims.CreateIncrementalAccounts(2)
+	genesis := fixture.NewGenesisBuilder().
+		WithFundedAccounts(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)), addrs...).
+		WithGovParams(govParams)
+
+	f, err := fixture.New(appConfig, genesis, &bankKeeper, &govKeeper)
+	require.NoError(t, err)
+
+	balance := bankKeeper.GetBalance(f.Ctx, addrs[0], sdk.DefaultBondDenom)
+*/
+package fixture
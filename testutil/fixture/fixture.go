@@ -0,0 +1,59 @@
+package fixture
+
+import (
+	"cosmossdk.io/depinject"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Fixture is an app for integration tests, started from the genesis state of a
+// GenesisBuilder and ready to execute its first block.
+type Fixture struct {
+	App *runtime.App
+	// Ctx is the context of the block being executed by the app.
+	Ctx sdk.Context
+	// Accounts are the genesis accounts of the app.
+	Accounts []sims.GenesisAccount
+	// ValidatorSet is the validator set of the app.
+	ValidatorSet *cmttypes.ValidatorSet
+}
+
+// New creates an app of the app config, started from the genesis state of the
+// builder, and injects its keepers, or any other output of the app config, into
+// extraOutputs:
+//
+//	var bankKeeper bankkeeper.Keeper
+//	f, err := fixture.New(appConfig, fixture.NewGenesisBuilder().WithFundedAccounts(coins, addr), &bankKeeper)
+//
+// A nil builder starts the app from the default genesis state.
+func New(appConfig depinject.Config, genesis *GenesisBuilder, extraOutputs ...interface{}) (*Fixture, error) {
+	if genesis == nil {
+		genesis = NewGenesisBuilder()
+	}
+
+	startupConfig, err := genesis.StartupConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	valSet, err := startupConfig.ValidatorSet()
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := sims.SetupWithConfiguration(appConfig, startupConfig, extraOutputs...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Fixture{
+		App:          app,
+		Ctx:          app.BaseApp.NewContext(false, cmtproto.Header{Height: app.LastBlockHeight() + 1}),
+		Accounts:     startupConfig.GenesisAccounts,
+		ValidatorSet: valSet,
+	}, nil
+}
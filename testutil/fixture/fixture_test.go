@@ -0,0 +1,116 @@
+package fixture_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/configurator"
+	"github.com/cosmos/cosmos-sdk/testutil/fixture"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	"github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/x/auth"
+	_ "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
+	_ "github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	_ "github.com/cosmos/cosmos-sdk/x/consensus"
+	_ "github.com/cosmos/cosmos-sdk/x/distribution"
+	_ "github.com/cosmos/cosmos-sdk/x/gov"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	_ "github.com/cosmos/cosmos-sdk/x/params"
+	_ "github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+)
+
+var appConfig = depinject.Configs(
+	configurator.NewAppConfig(
+		configurator.ParamsModule(),
+		configurator.AuthModule(),
+		configurator.StakingModule(),
+		configurator.BankModule(),
+		configurator.GovModule(),
+		configurator.ConsensusModule(),
+		configurator.DistributionModule(),
+		configurator.TxModule(),
+	),
+	depinject.Supply(log.NewNopLogger()),
+)
+
+func TestNewDefaultGenesis(t *testing.T) {
+	var bankKeeper bankkeeper.Keeper
+	f, err := fixture.New(appConfig, nil, &bankKeeper)
+	require.NoError(t, err)
+
+	require.Len(t, f.Accounts, 1)
+	require.Equal(t, 1, f.ValidatorSet.Size())
+	require.Equal(t, int64(2), f.Ctx.BlockHeight())
+	require.Equal(t, f.Accounts[0].Coins, bankKeeper.GetAllBalances(f.Ctx, f.Accounts[0].GetAddress()))
+}
+
+func TestNewGenesisBuilder(t *testing.T) {
+	validators := make([]*cmttypes.Validator, 2)
+	for i := range validators {
+		pubKey, err := mock.NewPV().GetPubKey()
+		require.NoError(t, err)
+		validators[i] = cmttypes.NewValidator(pubKey, 1)
+	}
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), sdk.NewInt64Coin("foo", 10))
+	addrs := sims.CreateIncrementalAccounts(3)
+
+	govParams := govv1.DefaultParams()
+	votingPeriod := 72 * time.Hour
+	govParams.VotingPeriod = &votingPeriod
+
+	var (
+		bankKeeper    bankkeeper.Keeper
+		govKeeper     *govkeeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+	f, err := fixture.New(appConfig,
+		fixture.NewGenesisBuilder().
+			WithFundedAccounts(coins, addrs...).
+			WithValidators(validators...).
+			WithGovParams(govParams),
+		&bankKeeper, &govKeeper, &stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	require.Len(t, f.Accounts, 3)
+	for _, addr := range addrs {
+		require.Equal(t, coins, bankKeeper.GetAllBalances(f.Ctx, addr))
+	}
+	require.Len(t, stakingKeeper.GetLastValidators(f.Ctx), 2)
+	require.Equal(t, votingPeriod, *govKeeper.GetParams(f.Ctx).VotingPeriod)
+}
+
+func TestGenesisBuilderErrors(t *testing.T) {
+	_, err := fixture.New(appConfig, fixture.NewGenesisBuilder().WithGovParams(govv1.Params{}))
+	require.ErrorContains(t, err, "invalid gov params")
+
+	_, err = fixture.New(appConfig, fixture.NewGenesisBuilder().WithModuleGenesis("unknown", govv1.DefaultGenesisState()))
+	require.ErrorContains(t, err, "module unknown, it is not in the app")
+
+	_, err = fixture.New(
+		depinject.Configs(
+			configurator.NewAppConfig(
+				configurator.ParamsModule(),
+				configurator.AuthModule(),
+				configurator.StakingModule(),
+				configurator.BankModule(),
+				configurator.ConsensusModule(),
+				configurator.TxModule(),
+			),
+			depinject.Supply(log.NewNopLogger()),
+		),
+		fixture.NewGenesisBuilder().WithModuleGenesis(govtypes.ModuleName, govv1.DefaultGenesisState()),
+	)
+	require.ErrorContains(t, err, "module gov, it is not in the app")
+}
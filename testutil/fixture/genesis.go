@@ -0,0 +1,181 @@
+package fixture
+
+import (
+	"encoding/json"
+	"fmt"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// GenesisBuilder builds the genesis state of an app for integration tests, on
+// top of the default genesis state of its modules. Its methods can be chained:
+//
+//	genesis := fixture.NewGenesisBuilder().
+//		WithFundedAccounts(coins, addr1, addr2).
+//		WithValidators(val1, val2).
+//		WithGovParams(govParams)
+type GenesisBuilder struct {
+	accounts      []sims.GenesisAccount
+	validators    []*cmttypes.Validator
+	govParams     *govv1.Params
+	moduleGenesis map[string]proto.Message
+	moduleNames   []string
+}
+
+// NewGenesisBuilder returns a GenesisBuilder of the default genesis state.
+func NewGenesisBuilder() *GenesisBuilder {
+	return &GenesisBuilder{
+		moduleGenesis: make(map[string]proto.Message),
+	}
+}
+
+// WithAccounts adds genesis accounts, with their balances. Without any genesis
+// account, a random account is funded with the bond denom.
+func (b *GenesisBuilder) WithAccounts(accounts ...sims.GenesisAccount) *GenesisBuilder {
+	b.accounts = append(b.accounts, accounts...)
+	return b
+}
+
+// WithFundedAccounts adds a base account funded with coins for each address.
+func (b *GenesisBuilder) WithFundedAccounts(coins sdk.Coins, addrs ...sdk.AccAddress) *GenesisBuilder {
+	for _, addr := range addrs {
+		b.accounts = append(b.accounts, sims.GenesisAccount{
+			GenesisAccount: authtypes.NewBaseAccount(addr, nil, 0, 0),
+			Coins:          coins,
+		})
+	}
+
+	return b
+}
+
+// WithValidators adds bonded validators, delegated to by the first genesis
+// account. Without any validator, the validator set is made of a random
+// validator.
+func (b *GenesisBuilder) WithValidators(validators ...*cmttypes.Validator) *GenesisBuilder {
+	b.validators = append(b.validators, validators...)
+	return b
+}
+
+// WithGovParams sets the params of the gov module.
+func (b *GenesisBuilder) WithGovParams(params govv1.Params) *GenesisBuilder {
+	b.govParams = &params
+	return b
+}
+
+// WithModuleGenesis sets the genesis state of a module, replacing its default
+// genesis state. It is applied after the other options of the builder, e.g. a
+// genesis state of the gov module replaces the params set with WithGovParams.
+func (b *GenesisBuilder) WithModuleGenesis(moduleName string, genesis proto.Message) *GenesisBuilder {
+	if _, ok := b.moduleGenesis[moduleName]; !ok {
+		b.moduleNames = append(b.moduleNames, moduleName)
+	}
+	b.moduleGenesis[moduleName] = genesis
+
+	return b
+}
+
+// Build returns the genesis state built on top of the default genesis state of
+// the modules of an app, e.g. for the genesis file of a test network.
+func (b *GenesisBuilder) Build(cdc codec.Codec, defaultGenesis map[string]json.RawMessage) (map[string]json.RawMessage, error) {
+	startupConfig, err := b.StartupConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	valSet, err := startupConfig.ValidatorSet()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		genAccounts []authtypes.GenesisAccount
+		balances    []banktypes.Balance
+	)
+	for _, ga := range startupConfig.GenesisAccounts {
+		genAccounts = append(genAccounts, ga.GenesisAccount)
+		balances = append(balances, banktypes.Balance{Address: ga.GenesisAccount.GetAddress().String(), Coins: ga.Coins})
+	}
+
+	genesisState, err := sims.GenesisStateWithValSet(cdc, defaultGenesis, valSet, genAccounts, balances...)
+	if err != nil {
+		return nil, err
+	}
+
+	return startupConfig.ModifyGenesis(cdc, genesisState)
+}
+
+// StartupConfig returns the startup config of an app started from the genesis
+// state of the builder, for sims.SetupWithConfiguration.
+func (b *GenesisBuilder) StartupConfig() (sims.StartupConfig, error) {
+	startupConfig := sims.DefaultStartUpConfig()
+	if len(b.accounts) > 0 {
+		startupConfig.GenesisAccounts = b.accounts
+	}
+
+	if len(b.validators) > 0 {
+		valSet := cmttypes.NewValidatorSet(b.validators)
+		if err := valSet.ValidateBasic(); err != nil {
+			return sims.StartupConfig{}, fmt.Errorf("invalid validator set: %w", err)
+		}
+		startupConfig.ValidatorSet = func() (*cmttypes.ValidatorSet, error) { return valSet, nil }
+	} else {
+		valSet, err := sims.CreateRandomValidatorSet()
+		if err != nil {
+			return sims.StartupConfig{}, err
+		}
+		startupConfig.ValidatorSet = func() (*cmttypes.ValidatorSet, error) { return valSet, nil }
+	}
+
+	startupConfig.ModifyGenesis = b.modifyGenesis
+
+	return startupConfig, nil
+}
+
+func (b *GenesisBuilder) modifyGenesis(cdc codec.Codec, genesisState map[string]json.RawMessage) (map[string]json.RawMessage, error) {
+	if b.govParams != nil {
+		govGenesisBz, ok := genesisState[govtypes.ModuleName]
+		if !ok {
+			return nil, fmt.Errorf("cannot set gov params, the gov module is not in the app")
+		}
+
+		var govGenesis govv1.GenesisState
+		if err := cdc.UnmarshalJSON(govGenesisBz, &govGenesis); err != nil {
+			return nil, err
+		}
+
+		params := *b.govParams
+		govGenesis.Params = &params
+		if err := govGenesis.Params.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("invalid gov params: %w", err)
+		}
+
+		govGenesisBz, err := cdc.MarshalJSON(&govGenesis)
+		if err != nil {
+			return nil, err
+		}
+		genesisState[govtypes.ModuleName] = govGenesisBz
+	}
+
+	for _, moduleName := range b.moduleNames {
+		if _, ok := genesisState[moduleName]; !ok {
+			return nil, fmt.Errorf("cannot set the genesis state of module %s, it is not in the app", moduleName)
+		}
+
+		bz, err := cdc.MarshalJSON(b.moduleGenesis[moduleName])
+		if err != nil {
+			return nil, err
+		}
+		genesisState[moduleName] = bz
+	}
+
+	return genesisState, nil
+}
@@ -0,0 +1,63 @@
+package testutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"gotest.tools/v3/golden"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// AssertGoldenProto marshals msg, e.g. a genesis state or a query response, to
+// JSON with cdc and compares it with the golden file testdata/<filename>.
+//
+// Golden files are written, instead of compared, when the tests are run with the
+// -update flag: `go test ./... -update`.
+func AssertGoldenProto(t *testing.T, cdc codec.JSONCodec, msg proto.Message, filename string) {
+	t.Helper()
+
+	bz, err := cdc.MarshalJSON(msg)
+	require.NoError(t, err)
+	AssertGoldenJSON(t, bz, filename)
+}
+
+// AssertGoldenJSON compares the JSON bz, e.g. the genesis exported by a module,
+// with the golden file testdata/<filename>. The JSON is normalized by
+// NormalizeGoldenJSON first so that golden files are deterministic and readable.
+//
+// Golden files are written, instead of compared, when the tests are run with the
+// -update flag: `go test ./... -update`.
+func AssertGoldenJSON(t *testing.T, bz []byte, filename string) {
+	t.Helper()
+
+	normalized, err := NormalizeGoldenJSON(bz)
+	require.NoError(t, err)
+	golden.Assert(t, string(normalized), filename)
+}
+
+// NormalizeGoldenJSON returns bz with its object keys sorted and indented by two
+// spaces. Numbers are kept as they are, so that large integers don't lose their
+// precision.
+func NormalizeGoldenJSON(bz []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
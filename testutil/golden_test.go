@@ -0,0 +1,28 @@
+package testutil_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+)
+
+func TestNormalizeGoldenJSON(t *testing.T) {
+	bz, err := testutil.NormalizeGoldenJSON([]byte(`{"b":[1,{"d":"<>","c":18446744073709551615}],"a":null}`))
+	require.NoError(t, err)
+	require.Equal(t, `{
+  "a": null,
+  "b": [
+    1,
+    {
+      "c": 18446744073709551615,
+      "d": "<>"
+    }
+  ]
+}
+`, string(bz))
+
+	_, err = testutil.NormalizeGoldenJSON([]byte(`{"a":`))
+	require.Error(t, err)
+}
@@ -19,6 +19,24 @@ exposed. Due to this exact same limitation, only a single test network can exist
 at a time. A caller must be certain it calls Cleanup after it no longer needs
 the network.
 
+Besides its validators, a test network can run full nodes, set with NumFullNodes,
+which sync the chain from the validators. The pruning strategy of the full nodes
+can differ from the one of the validators, and GRPCOnAllNodes enables the gRPC
+server of every node. Any node can be stopped, started and restarted, e.g. to
+test a full node catching up with the chain:
+
+	cfg.NumFullNodes = 1
+	cfg.FullNodePruning = pruningtypes.PruningOptionEverything
+
+	fullNode := s.network.FullNodes[0]
+	s.Require().NoError(s.network.StopNode(fullNode))
+	height, err := s.network.WaitForHeight(10)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.network.StartNode(fullNode))
+	_, err = s.network.WaitForNodeHeight(fullNode, height, time.Minute)
+	s.Require().NoError(err)
+
 A typical testing flow might look like the following:
 
 	type IntegrationTestSuite struct {
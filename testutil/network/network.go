@@ -22,6 +22,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/math/unsafe"
 	pruningtypes "cosmossdk.io/store/pruning/types"
+	cmtdb "github.com/cometbft/cometbft-db"
 	"github.com/cometbft/cometbft/node"
 	cmtclient "github.com/cometbft/cometbft/rpc/client"
 	dbm "github.com/cosmos/cosmos-db"
@@ -114,6 +115,7 @@ type Config struct {
 	TimeoutCommit    time.Duration              // the consensus commitment timeout
 	ChainID          string                     // the network chain-id
 	NumValidators    int                        // the total number of validators to create and bond
+	NumFullNodes     int                        // the number of full nodes to create, which sync the chain from the validators
 	Mnemonics        []string                   // custom user-provided validator operator mnemonics
 	BondDenom        string                     // the staking bond denomination
 	MinGasPrices     string                     // the minimum gas prices each validator will accept
@@ -121,6 +123,8 @@ type Config struct {
 	StakingTokens    sdkmath.Int                // the amount of tokens each validator has available to stake
 	BondedTokens     sdkmath.Int                // the amount of tokens each validator stakes
 	PruningStrategy  string                     // the pruning strategy each validator will have
+	FullNodePruning  string                     // the pruning strategy each full node will have, PruningStrategy if empty
+	GRPCOnAllNodes   bool                       // enable the gRPC server of every node and not only of the first validator
 	EnableLogging    bool                       // enable logging to STDOUT
 	CleanupDir       bool                       // remove base temporary directory during cleanup
 	SigningAlgo      string                     // signing algorithm for keys
@@ -246,17 +250,24 @@ type (
 	// sure to Cleanup after testing is finished in order to allow other tests
 	// to create networks. In addition, only the first validator will have a valid
 	// RPC and API server/client.
+	//
+	// Besides its validators, a network may run full nodes, which have no
+	// voting power and sync the chain from the validators, their persistent
+	// peers. Any node can be stopped and started again, e.g. to test upgrades
+	// or a full node catching up with the chain.
 	Network struct {
 		Logger     Logger
 		BaseDir    string
 		Validators []*Validator
+		FullNodes  []*Validator
 
 		Config Config
 	}
 
 	// Validator defines an in-process CometBFT validator node. Through this object,
 	// a client can make RPC and API calls and interact with any client command
-	// or handler.
+	// or handler. It also defines the full nodes of the network, which have an
+	// account but no ValAddress.
 	Validator struct {
 		AppConfig  *srvconfig.Config
 		ClientCtx  client.Context
@@ -278,6 +289,7 @@ type (
 		grpcWeb  *http.Server
 		errGroup *errgroup.Group
 		cancelFn context.CancelFunc
+		dbs      []cmtdb.DB
 	}
 
 	// ValidatorI expose a validator's context and configuration
@@ -338,14 +350,16 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 		Logger:     l,
 		BaseDir:    baseDir,
 		Validators: make([]*Validator, cfg.NumValidators),
+		FullNodes:  make([]*Validator, cfg.NumFullNodes),
 		Config:     cfg,
 	}
 
 	l.Logf("preparing test network with chain-id \"%s\"\n", cfg.ChainID)
 
-	monikers := make([]string, cfg.NumValidators)
-	nodeIDs := make([]string, cfg.NumValidators)
-	valPubKeys := make([]cryptotypes.PubKey, cfg.NumValidators)
+	numNodes := cfg.NumValidators + cfg.NumFullNodes
+	monikers := make([]string, numNodes)
+	nodeIDs := make([]string, numNodes)
+	valPubKeys := make([]cryptotypes.PubKey, numNodes)
 
 	var (
 		genAccounts []authtypes.GenesisAccount
@@ -356,9 +370,14 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 	buf := bufio.NewReader(os.Stdin)
 
 	// generate private keys, node IDs, and initial transactions
-	for i := 0; i < cfg.NumValidators; i++ {
+	for i := 0; i < numNodes; i++ {
+		isValidator := i < cfg.NumValidators
+
 		appCfg := srvconfig.DefaultConfig()
 		appCfg.Pruning = cfg.PruningStrategy
+		if !isValidator && cfg.FullNodePruning != "" {
+			appCfg.Pruning = cfg.FullNodePruning
+		}
 		appCfg.MinGasPrices = cfg.MinGasPrices
 		appCfg.API.Enable = true
 		appCfg.API.Swagger = false
@@ -369,7 +388,8 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 		cmtCfg.Consensus.TimeoutCommit = cfg.TimeoutCommit
 
 		// Only allow the first validator to expose an RPC, API and gRPC
-		// server/client due to CometBFT in-process constraints.
+		// server/client due to CometBFT in-process constraints. The other
+		// nodes may only expose a gRPC server, with GRPCOnAllNodes.
 		apiAddr := ""
 		cmtCfg.RPC.ListenAddress = ""
 		appCfg.GRPC.Enable = false
@@ -414,6 +434,13 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 			}
 			appCfg.GRPC.Enable = true
 			appCfg.GRPCWeb.Enable = true
+		} else if cfg.GRPCOnAllNodes {
+			if len(portPool) == 0 {
+				return nil, fmt.Errorf("failed to get port for GRPC server")
+			}
+			port := <-portPool
+			appCfg.GRPC.Address = fmt.Sprintf("0.0.0.0:%s", port)
+			appCfg.GRPC.Enable = true
 		}
 
 		logger := log.NewNopLogger()
@@ -424,6 +451,9 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 		ctx.Logger = logger
 
 		nodeDirName := fmt.Sprintf("node%d", i)
+		if !isValidator {
+			nodeDirName = fmt.Sprintf("fullnode%d", i-cfg.NumValidators)
+		}
 		nodeDir := filepath.Join(network.BaseDir, nodeDirName, "simd")
 		clientDir := filepath.Join(network.BaseDir, nodeDirName, "simcli")
 		gentxsDir := filepath.Join(network.BaseDir, "gentxs")
@@ -514,59 +544,14 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 		genBalances = append(genBalances, banktypes.Balance{Address: addr.String(), Coins: balances.Sort()})
 		genAccounts = append(genAccounts, authtypes.NewBaseAccount(addr, nil, 0, 0))
 
-		commission, err := sdkmath.LegacyNewDecFromStr("0.5")
-		if err != nil {
-			return nil, err
-		}
-
-		createValMsg, err := stakingtypes.NewMsgCreateValidator(
-			sdk.ValAddress(addr),
-			valPubKeys[i],
-			sdk.NewCoin(cfg.BondDenom, cfg.BondedTokens),
-			stakingtypes.NewDescription(nodeDirName, "", "", "", ""),
-			stakingtypes.NewCommissionRates(commission, sdkmath.LegacyOneDec(), sdkmath.LegacyOneDec()),
-			sdkmath.OneInt(),
-		)
-		if err != nil {
-			return nil, err
-		}
-
-		p2pURL, err := url.Parse(p2pAddr)
-		if err != nil {
-			return nil, err
-		}
-
-		memo := fmt.Sprintf("%s@%s:%s", nodeIDs[i], p2pURL.Hostname(), p2pURL.Port())
-		fee := sdk.NewCoins(sdk.NewCoin(fmt.Sprintf("%stoken", nodeDirName), sdkmath.NewInt(0)))
-		txBuilder := cfg.TxConfig.NewTxBuilder()
-		err = txBuilder.SetMsgs(createValMsg)
-		if err != nil {
-			return nil, err
-		}
-		txBuilder.SetFeeAmount(fee)    // Arbitrary fee
-		txBuilder.SetGasLimit(1000000) // Need at least 100386
-		txBuilder.SetMemo(memo)
-
-		txFactory := tx.Factory{}
-		txFactory = txFactory.
-			WithChainID(cfg.ChainID).
-			WithMemo(memo).
-			WithKeybase(kb).
-			WithTxConfig(cfg.TxConfig)
-
-		err = tx.Sign(context.Background(), txFactory, nodeDirName, txBuilder, true)
-		if err != nil {
-			return nil, err
+		var valAddr sdk.ValAddress
+		if isValidator {
+			valAddr = sdk.ValAddress(addr)
+			if err := writeGenTx(cfg, kb, nodeDirName, addr, nodeID, pubKey, p2pAddr, gentxsDir); err != nil {
+				return nil, err
+			}
 		}
 
-		txBz, err := cfg.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
-		if err != nil {
-			return nil, err
-		}
-		err = writeFile(fmt.Sprintf("%v.json", nodeDirName), gentxsDir, txBz)
-		if err != nil {
-			return nil, err
-		}
 		srvconfig.WriteConfigFile(filepath.Join(nodeDir, "config", "app.toml"), appCfg)
 
 		clientCtx := client.Context{}.
@@ -583,7 +568,7 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 		// Provide ChainID here since we can't modify it in the Comet config.
 		ctx.Viper.Set(flags.FlagChainID, cfg.ChainID)
 
-		network.Validators[i] = &Validator{
+		node := &Validator{
 			AppConfig:  appCfg,
 			ClientCtx:  clientCtx,
 			Ctx:        ctx,
@@ -595,7 +580,12 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 			P2PAddress: cmtCfg.P2P.ListenAddress,
 			APIAddress: apiAddr,
 			Address:    addr,
-			ValAddress: sdk.ValAddress(addr),
+			ValAddress: valAddr,
+		}
+		if isValidator {
+			network.Validators[i] = node
+		} else {
+			network.FullNodes[i-cfg.NumValidators] = node
 		}
 	}
 
@@ -603,7 +593,7 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 	if err != nil {
 		return nil, err
 	}
-	err = collectGenFiles(cfg, network.Validators, network.BaseDir)
+	err = collectGenFiles(cfg, network.Nodes(), network.BaseDir)
 	if err != nil {
 		return nil, err
 	}
@@ -616,6 +606,13 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 		}
 		l.Log("started validator", idx)
 	}
+	for idx, v := range network.FullNodes {
+		err := startInProcess(cfg, v)
+		if err != nil {
+			return nil, err
+		}
+		l.Log("started full node", idx)
+	}
 
 	height, err := network.LatestHeight()
 	if err != nil {
@@ -631,6 +628,69 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 	return network, nil
 }
 
+// writeGenTx writes to gentxsDir the gentx of a validator, which creates the
+// validator with the key of its moniker and its node as a persistent peer.
+func writeGenTx(
+	cfg Config,
+	kb keyring.Keyring,
+	moniker string,
+	addr sdk.AccAddress,
+	nodeID string,
+	pubKey cryptotypes.PubKey,
+	p2pAddr, gentxsDir string,
+) error {
+	commission, err := sdkmath.LegacyNewDecFromStr("0.5")
+	if err != nil {
+		return err
+	}
+
+	createValMsg, err := stakingtypes.NewMsgCreateValidator(
+		sdk.ValAddress(addr),
+		pubKey,
+		sdk.NewCoin(cfg.BondDenom, cfg.BondedTokens),
+		stakingtypes.NewDescription(moniker, "", "", "", ""),
+		stakingtypes.NewCommissionRates(commission, sdkmath.LegacyOneDec(), sdkmath.LegacyOneDec()),
+		sdkmath.OneInt(),
+	)
+	if err != nil {
+		return err
+	}
+
+	p2pURL, err := url.Parse(p2pAddr)
+	if err != nil {
+		return err
+	}
+
+	memo := fmt.Sprintf("%s@%s:%s", nodeID, p2pURL.Hostname(), p2pURL.Port())
+	fee := sdk.NewCoins(sdk.NewCoin(fmt.Sprintf("%stoken", moniker), sdkmath.NewInt(0)))
+	txBuilder := cfg.TxConfig.NewTxBuilder()
+	err = txBuilder.SetMsgs(createValMsg)
+	if err != nil {
+		return err
+	}
+	txBuilder.SetFeeAmount(fee)    // Arbitrary fee
+	txBuilder.SetGasLimit(1000000) // Need at least 100386
+	txBuilder.SetMemo(memo)
+
+	txFactory := tx.Factory{}
+	txFactory = txFactory.
+		WithChainID(cfg.ChainID).
+		WithMemo(memo).
+		WithKeybase(kb).
+		WithTxConfig(cfg.TxConfig)
+
+	err = tx.Sign(context.Background(), txFactory, moniker, txBuilder, true)
+	if err != nil {
+		return err
+	}
+
+	txBz, err := cfg.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return err
+	}
+	return writeFile(fmt.Sprintf("%v.json", moniker), gentxsDir, txBz)
+}
+
 // trapSignal traps SIGINT and SIGTERM and calls os.Exit once a signal is received.
 func trapSignal(cleanupFunc func()) {
 	sigs := make(chan os.Signal, 1)
@@ -760,6 +820,77 @@ func (n *Network) WaitForNextBlock() error {
 	return err
 }
 
+// Nodes returns the validators followed by the full nodes of the network.
+func (n *Network) Nodes() []*Validator {
+	nodes := make([]*Validator, 0, len(n.Validators)+len(n.FullNodes))
+	nodes = append(nodes, n.Validators...)
+	return append(nodes, n.FullNodes...)
+}
+
+// StopNode stops the CometBFT node and the gRPC and API servers of a validator
+// or full node of the network. Its data is kept so that it can be started again
+// with StartNode. Note, LatestHeight and WaitForHeight query the first
+// validator, which must be running.
+func (n *Network) StopNode(node *Validator) error {
+	if node.tmNode == nil || !node.tmNode.IsRunning() {
+		return fmt.Errorf("node %s is not running", node.Moniker)
+	}
+
+	n.Logger.Log("stopping node", node.Moniker)
+	return stopInProcess(node)
+}
+
+// StartNode starts a node of the network stopped with StopNode. A new app is
+// created by the AppConstructor of the network config, to which CometBFT
+// replays the blocks the app does not have.
+func (n *Network) StartNode(node *Validator) error {
+	if node.tmNode != nil && node.tmNode.IsRunning() {
+		return fmt.Errorf("node %s is already running", node.Moniker)
+	}
+
+	n.Logger.Log("starting node", node.Moniker)
+	return startInProcess(n.Config, node)
+}
+
+// RestartNode stops then starts a node of the network.
+func (n *Network) RestartNode(node *Validator) error {
+	if err := n.StopNode(node); err != nil {
+		return err
+	}
+
+	return n.StartNode(node)
+}
+
+// WaitForNodeHeight waits for a validator or full node of the network to
+// commit the block at the given height, e.g. for a full node to catch up with
+// the validators, without querying the node. If that height is not reached
+// within the timeout, an error is returned. Regardless, the latest height of
+// the node is returned.
+func (n *Network) WaitForNodeHeight(node *Validator, h int64, t time.Duration) (int64, error) {
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	timeout := time.NewTimer(t)
+	defer timeout.Stop()
+
+	var latestHeight int64
+	for {
+		select {
+		case <-timeout.C:
+			return latestHeight, fmt.Errorf("timeout exceeded waiting for block %d of node %s", h, node.Moniker)
+		case <-ticker.C:
+			if node.tmNode == nil || !node.tmNode.IsRunning() {
+				continue
+			}
+
+			latestHeight = node.tmNode.BlockStore().Height()
+			if latestHeight >= h {
+				return latestHeight, nil
+			}
+		}
+	}
+}
+
 // Cleanup removes the root testing (temporary) directory and stops both the
 // CometBFT and API services. It allows other callers to create and start
 // test networks. This method must be called when a test is finished, typically
@@ -772,23 +903,9 @@ func (n *Network) Cleanup() {
 
 	n.Logger.Log("cleaning up test network...")
 
-	for _, v := range n.Validators {
-		// cancel the validator's context which will signal to the gRPC and API
-		// goroutines that they should gracefully exit.
-		v.cancelFn()
-
-		if err := v.errGroup.Wait(); err != nil {
-			n.Logger.Log("unexpected error waiting for validator gRPC and API processes to exit", "err", err)
-		}
-
-		if v.tmNode != nil && v.tmNode.IsRunning() {
-			if err := v.tmNode.Stop(); err != nil {
-				n.Logger.Log("failed to stop validator CometBFT node", "err", err)
-			}
-		}
-
-		if v.grpcWeb != nil {
-			_ = v.grpcWeb.Close()
+	for _, v := range n.Nodes() {
+		if err := stopInProcess(v); err != nil {
+			n.Logger.Log("failed to stop node", "err", err)
 		}
 	}
 
@@ -0,0 +1,54 @@
+package network_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	pruningtypes "cosmossdk.io/store/pruning/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+)
+
+func TestNetworkFullNodes(t *testing.T) {
+	cfg, err := network.DefaultConfigWithAppConfig(network.MinimumAppConfig())
+	require.NoError(t, err)
+	cfg.NumValidators = 2
+	cfg.NumFullNodes = 1
+	cfg.FullNodePruning = pruningtypes.PruningOptionEverything
+	cfg.GRPCOnAllNodes = true
+	cfg.TimeoutCommit = 500 * time.Millisecond
+
+	n, err := network.New(t, t.TempDir(), cfg)
+	require.NoError(t, err)
+	defer n.Cleanup()
+
+	require.Len(t, n.Nodes(), 3)
+	fullNode := n.FullNodes[0]
+	require.Equal(t, "fullnode0", fullNode.Moniker)
+	require.Nil(t, fullNode.ValAddress)
+	require.Equal(t, pruningtypes.PruningOptionEverything, fullNode.AppConfig.Pruning)
+	require.True(t, fullNode.AppConfig.GRPC.Enable)
+
+	height, err := n.WaitForHeight(3)
+	require.NoError(t, err)
+	_, err = n.WaitForNodeHeight(fullNode, height, 10*time.Second)
+	require.NoError(t, err)
+
+	require.NoError(t, n.StopNode(fullNode))
+	require.Error(t, n.StopNode(fullNode))
+
+	height, err = n.WaitForHeight(height + 2)
+	require.NoError(t, err)
+
+	require.NoError(t, n.StartNode(fullNode))
+	_, err = n.WaitForNodeHeight(fullNode, height, 20*time.Second)
+	require.NoError(t, err)
+
+	require.NoError(t, n.RestartNode(n.Validators[1]))
+	height, err = n.WaitForHeight(height + 2)
+	require.NoError(t, err)
+	_, err = n.WaitForNodeHeight(n.Validators[1], height, 20*time.Second)
+	require.NoError(t, err)
+}
@@ -3,11 +3,13 @@ package network
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
 	"os"
 	"path/filepath"
 
+	cmtdb "github.com/cometbft/cometbft-db"
 	"github.com/cometbft/cometbft/node"
 	"github.com/cometbft/cometbft/p2p"
 	pvm "github.com/cometbft/cometbft/privval"
@@ -41,6 +43,19 @@ func startInProcess(cfg Config, val *Validator) error {
 	}
 
 	app := cfg.AppConstructor(*val)
+	// keep the databases of the node to close them when it stops, as CometBFT
+	// does not close all of them and the node could not be started again
+	val.dbs = nil
+	dbProvider := func(ctx *node.DBContext) (cmtdb.DB, error) {
+		db, err := node.DefaultDBProvider(ctx)
+		if err != nil {
+			return nil, err
+		}
+		val.dbs = append(val.dbs, db)
+
+		return db, nil
+	}
+
 	appGenesisProvider := func() (*cmttypes.GenesisDoc, error) {
 		appGenesis, err := genutiltypes.AppGenesisFromFile(cmtCfg.GenesisFile())
 		if err != nil {
@@ -56,7 +71,7 @@ func startInProcess(cfg Config, val *Validator) error {
 		nodeKey,
 		proxy.NewLocalClientCreator(app),
 		appGenesisProvider,
-		node.DefaultDBProvider,
+		dbProvider,
 		node.DefaultMetricsProvider(cmtCfg.Instrumentation),
 		servercmtlog.CometZeroLogWrapper{Logger: logger.With("module", val.Moniker)},
 	)
@@ -118,19 +133,57 @@ func startInProcess(cfg Config, val *Validator) error {
 	return nil
 }
 
-func collectGenFiles(cfg Config, vals []*Validator, outputDir string) error {
+// stopInProcess stops the CometBFT node and the gRPC and API servers of a
+// validator or full node. The teardown goes on when one of them fails to stop,
+// and the errors are returned together.
+func stopInProcess(val *Validator) error {
+	var errs []error
+
+	if val.cancelFn != nil {
+		// cancel the node's context which will signal to the gRPC and API
+		// goroutines that they should gracefully exit.
+		val.cancelFn()
+
+		if err := val.errGroup.Wait(); err != nil {
+			errs = append(errs, fmt.Errorf("unexpected error waiting for gRPC and API processes of %s to exit: %w", val.Moniker, err))
+		}
+	}
+
+	if val.tmNode != nil && val.tmNode.IsRunning() {
+		if err := val.tmNode.Stop(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to stop CometBFT node of %s: %w", val.Moniker, err))
+		}
+	}
+
+	if val.grpcWeb != nil {
+		_ = val.grpcWeb.Close()
+	}
+
+	// the databases closed by CometBFT return an error when closed again
+	for _, db := range val.dbs {
+		_ = db.Close()
+	}
+	val.dbs = nil
+
+	return errors.Join(errs...)
+}
+
+// collectGenFiles writes the genesis file of every node, validators and full
+// nodes alike, with the gentxs of the validators. Since full nodes have no
+// gentx, all the validators are their persistent peers.
+func collectGenFiles(cfg Config, nodes []*Validator, outputDir string) error {
 	genTime := cmttime.Now()
 
-	for i := 0; i < cfg.NumValidators; i++ {
-		cmtCfg := vals[i].Ctx.Config
+	for _, node := range nodes {
+		cmtCfg := node.Ctx.Config
 
-		nodeDir := filepath.Join(outputDir, vals[i].Moniker, "simd")
+		nodeDir := filepath.Join(outputDir, node.Moniker, "simd")
 		gentxsDir := filepath.Join(outputDir, "gentxs")
 
-		cmtCfg.Moniker = vals[i].Moniker
+		cmtCfg.Moniker = node.Moniker
 		cmtCfg.SetRoot(nodeDir)
 
-		initCfg := genutiltypes.NewInitConfig(cfg.ChainID, gentxsDir, vals[i].NodeID, vals[i].PubKey)
+		initCfg := genutiltypes.NewInitConfig(cfg.ChainID, gentxsDir, node.NodeID, node.PubKey)
 
 		genFile := cmtCfg.GenesisFile()
 		appGenesis, err := genutiltypes.AppGenesisFromFile(genFile)
@@ -186,9 +239,9 @@ func initGenFiles(cfg Config, genAccounts []authtypes.GenesisAccount, genBalance
 		},
 	}
 
-	// generate empty genesis files for each validator and save
-	for i := 0; i < cfg.NumValidators; i++ {
-		if err := appGenesis.SaveAs(genFiles[i]); err != nil {
+	// generate empty genesis files for each node and save
+	for _, genFile := range genFiles {
+		if err := appGenesis.SaveAs(genFile); err != nil {
 			return err
 		}
 	}
@@ -72,12 +72,14 @@ type GenesisAccount struct {
 // ValidatorSet defines a custom validator set to be validating the app.
 // BaseAppOption defines the additional operations that must be run on baseapp before app start.
 // AtGenesis defines if the app started should already have produced block or not.
+// ModifyGenesis, if set, modifies the genesis state of the app before InitChain.
 type StartupConfig struct {
 	ValidatorSet    func() (*cmttypes.ValidatorSet, error)
 	BaseAppOption   runtime.BaseAppOption
 	AtGenesis       bool
 	GenesisAccounts []GenesisAccount
 	DB              dbm.DB
+	ModifyGenesis   func(codec codec.Codec, genesisState map[string]json.RawMessage) (map[string]json.RawMessage, error)
 }
 
 func DefaultStartUpConfig() StartupConfig {
@@ -150,6 +152,13 @@ func SetupWithConfiguration(appConfig depinject.Config, startupConfig StartupCon
 		return nil, fmt.Errorf("failed to create genesis state: %w", err)
 	}
 
+	if startupConfig.ModifyGenesis != nil {
+		genesisState, err = startupConfig.ModifyGenesis(codec, genesisState)
+		if err != nil {
+			return nil, fmt.Errorf("failed to modify genesis state: %w", err)
+		}
+	}
+
 	// init chain must be called to stop deliverState from being nil
 	stateBytes, err := cmtjson.MarshalIndent(genesisState, "", " ")
 	if err != nil {
@@ -240,10 +249,10 @@ func GenesisStateWithValSet(
 		totalSupply = totalSupply.Add(sdk.NewCoin(sdk.DefaultBondDenom, bondAmt))
 	}
 
-	// add bonded amount to bonded pool module account
+	// add bonded amount of every validator to bonded pool module account
 	balances = append(balances, banktypes.Balance{
 		Address: authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String(),
-		Coins:   sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, bondAmt)},
+		Coins:   sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, bondAmt.MulRaw(int64(len(delegations))))},
 	})
 
 	// update total supply
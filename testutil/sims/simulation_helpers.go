@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"sync"
 
 	dbm "github.com/cosmos/cosmos-db"
@@ -60,15 +61,12 @@ func SimulationOperations(app runtime.AppI, cdc codec.JSONCodec, config simtypes
 	}
 
 	if config.ParamsFile != "" {
-		bz, err := os.ReadFile(config.ParamsFile)
+		appParams, err := simtypes.ReadAppParams(config.ParamsFile)
 		if err != nil {
 			panic(err)
 		}
 
-		err = json.Unmarshal(bz, &simState.AppParams)
-		if err != nil {
-			panic(err)
-		}
+		simState.AppParams = appParams
 	}
 
 	simState.LegacyProposalContents = app.SimulationManager().GetProposalContents(simState) //nolint:staticcheck // used for legacy testing
@@ -105,6 +103,27 @@ func CheckExportSimulation(app runtime.AppI, config simtypes.Config, params simt
 	return nil
 }
 
+// ExportReproBundleAppState exports the app state to the reproduction bundle of
+// a failed simulation, if one was written to config.ReproBundleDir. The app state
+// is the one of the last committed block, as the failed block is not committed.
+// It should be deferred, to be run even if the simulation panics.
+func ExportReproBundleAppState(app runtime.AppI, config simtypes.Config) error {
+	if config.ReproBundleDir == "" {
+		return nil
+	}
+
+	if _, err := os.Stat(filepath.Join(config.ReproBundleDir, simtypes.ReproBundleFile)); os.IsNotExist(err) {
+		return nil
+	}
+
+	exported, err := app.ExportAppStateAndValidators(false, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(config.ReproBundleDir, simtypes.ReproBundleAppStateFile), exported.AppState, 0o600)
+}
+
 // PrintStats prints the corresponding statistics from the app DB.
 func PrintStats(db dbm.DB) {
 	fmt.Println("\nLevelDB Stats")
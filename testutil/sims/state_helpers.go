@@ -73,10 +73,9 @@ func AppStateFnWithExtendedCbs(
 
 		chainID = config.ChainID
 		switch {
-		case config.ParamsFile != "" && config.GenesisFile != "":
-			panic("cannot provide both a genesis file and a params file")
-
 		case config.GenesisFile != "":
+			// a params file only overrides the operation weights and params, the
+			// genesis file providing the app state
 			// override the default chain-id from simapp to set it later to the config
 			genesisDoc, accounts, err := AppStateFromGenesisFileFn(r, cdc, config.GenesisFile)
 			if err != nil {
@@ -93,13 +92,7 @@ func AppStateFnWithExtendedCbs(
 			simAccs = accounts
 
 		case config.ParamsFile != "":
-			appParams := make(simtypes.AppParams)
-			bz, err := os.ReadFile(config.ParamsFile)
-			if err != nil {
-				panic(err)
-			}
-
-			err = json.Unmarshal(bz, &appParams)
+			appParams, err := simtypes.ReadAppParams(config.ParamsFile)
 			if err != nil {
 				panic(err)
 			}
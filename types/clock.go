@@ -0,0 +1,62 @@
+package types
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock is a source of the current time. The state machine must use the block
+// time of the context, but the logic outside of consensus, e.g. measuring the
+// duration of an operation, uses the clock of the context rather than time.Now,
+// so that tests and simulations can use a ManualClock.
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+// SystemClock is the Clock of the system time, the clock of a Context without
+// any clock set.
+var SystemClock Clock = systemClock{}
+
+// ManualClock is a Clock whose time only changes when it is set or advanced,
+// which makes the time of tests and simulations deterministic. It is safe for
+// concurrent use.
+type ManualClock struct {
+	mtx sync.RWMutex
+	now time.Time
+}
+
+var _ Clock = (*ManualClock)(nil)
+
+// NewManualClock returns a ManualClock set to the given time.
+func NewManualClock(now time.Time) *ManualClock {
+	return &ManualClock{now: now}
+}
+
+// Now implements Clock.
+func (c *ManualClock) Now() time.Time {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	return c.now
+}
+
+// Set sets the time of the clock.
+func (c *ManualClock) Set(now time.Time) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.now = now
+}
+
+// Advance advances the time of the clock by d and returns the new time.
+func (c *ManualClock) Advance(d time.Duration) time.Time {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.now = c.now.Add(d)
+	return c.now
+}
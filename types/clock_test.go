@@ -0,0 +1,35 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestManualClock(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := sdk.NewManualClock(start)
+	require.Equal(t, start, clock.Now())
+	require.Equal(t, start, clock.Now())
+
+	require.Equal(t, start.Add(time.Minute), clock.Advance(time.Minute))
+	require.Equal(t, start.Add(time.Minute), clock.Now())
+
+	clock.Set(start)
+	require.Equal(t, start, clock.Now())
+}
+
+func TestContextClock(t *testing.T) {
+	var ctx sdk.Context
+	require.Equal(t, sdk.SystemClock, ctx.Clock())
+
+	clock := sdk.NewManualClock(time.Unix(0, 0))
+	ctx = ctx.WithClock(clock)
+	require.Equal(t, clock, ctx.Clock())
+
+	ctx = ctx.WithClock(nil)
+	require.Equal(t, sdk.SystemClock, ctx.Clock())
+}
@@ -318,7 +318,15 @@ func (coins Coins) Add(coinsB ...Coin) Coins {
 // denomination and addition only occurs when the denominations match, otherwise
 // the coin is simply added to the sum assuming it's not zero.
 // The function panics if `coins` or  `coinsB` are not sorted (ascending).
-func (coins Coins) safeAdd(coinsB Coins) (coalesced Coins) {
+func (coins Coins) safeAdd(coinsB Coins) Coins {
+	return coins.merge(coinsB, false)
+}
+
+// merge adds, or subtracts when sub is true, coinsB to coins. As both sets are
+// sorted, they are merged in a single pass and a single allocation. The coins
+// of the same denomination are coalesced, and the zero coins are removed.
+// The function panics if `coins` or  `coinsB` are not sorted (ascending).
+func (coins Coins) merge(coinsB Coins, sub bool) Coins {
 	// probably the best way will be to make Coins and interface and hide the structure
 	// definition (type alias)
 	if !coins.isSorted() {
@@ -328,27 +336,48 @@ func (coins Coins) safeAdd(coinsB Coins) (coalesced Coins) {
 		panic("Wrong argument: coins must be sorted")
 	}
 
-	uniqCoins := make(map[string]Coins, len(coins)+len(coinsB))
-	// Traverse all the coins for each of the coins and coinsB.
-	for _, cL := range []Coins{coins, coinsB} {
-		for _, c := range cL {
-			uniqCoins[c.Denom] = append(uniqCoins[c.Denom], c)
+	coalesced := make(Coins, 0, len(coins)+len(coinsB))
+	i, j := 0, 0
+	for i < len(coins) || j < len(coinsB) {
+		var coin Coin
+		switch {
+		case j == len(coinsB) || (i < len(coins) && coins[i].Denom < coinsB[j].Denom):
+			coin = coins[i]
+			i++
+		case i == len(coins) || coinsB[j].Denom < coins[i].Denom:
+			coin = coinsB[j]
+			if sub {
+				coin = Coin{Denom: coin.Denom, Amount: coin.Amount.Neg()}
+			}
+			j++
+		default:
+			coin = coins[i]
+			if sub {
+				coin = Coin{Denom: coin.Denom, Amount: coin.Amount.Sub(coinsB[j].Amount)}
+			} else {
+				coin = Coin{Denom: coin.Denom, Amount: coin.Amount.Add(coinsB[j].Amount)}
+			}
+			i++
+			j++
 		}
-	}
 
-	for denom, cL := range uniqCoins { //#nosec
-		comboCoin := Coin{Denom: denom, Amount: NewInt(0)}
-		for _, c := range cL {
-			comboCoin = comboCoin.Add(c)
-		}
-		if !comboCoin.IsZero() {
-			coalesced = append(coalesced, comboCoin)
+		// a set may contain the same denomination several times
+		if last := len(coalesced) - 1; last >= 0 && coalesced[last].Denom == coin.Denom {
+			coalesced[last] = Coin{Denom: coin.Denom, Amount: coalesced[last].Amount.Add(coin.Amount)}
+			continue
 		}
+		coalesced = append(coalesced, coin)
 	}
-	if coalesced == nil {
-		return Coins{}
+
+	// remove the zero coins in place, once all the coins of a denomination are summed
+	nonZeros := coalesced[:0]
+	for _, coin := range coalesced {
+		if !coin.IsZero() {
+			nonZeros = append(nonZeros, coin)
+		}
 	}
-	return coalesced.Sort()
+
+	return nonZeros
 }
 
 // DenomsSubsetOf returns true if receiver's denom set
@@ -390,7 +419,7 @@ func (coins Coins) Sub(coinsB ...Coin) Coins {
 // negative coin amount was returned.
 // The function panics if `coins` or  `coinsB` are not sorted (ascending).
 func (coins Coins) SafeSub(coinsB ...Coin) (Coins, bool) {
-	diff := coins.safeAdd(NewCoins(coinsB...).negative())
+	diff := coins.merge(NewCoins(coinsB...), true)
 	return diff, diff.IsAnyNegative()
 }
 
@@ -583,8 +612,32 @@ func (coins Coins) IsAllGTE(coinsB Coins) bool {
 		return false
 	}
 
+	if !coins.isSorted() || !coinsB.isSorted() {
+		for _, coinB := range coinsB {
+			if coinB.Amount.GT(coins.AmountOf(coinB.Denom)) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	// both sets are sorted, walk them in a single pass
+	i := 0
 	for _, coinB := range coinsB {
-		if coinB.Amount.GT(coins.AmountOf(coinB.Denom)) {
+		for i < len(coins) && coins[i].Denom < coinB.Denom {
+			i++
+		}
+
+		if i < len(coins) && coins[i].Denom == coinB.Denom {
+			if coinB.Amount.GT(coins[i].Amount) {
+				return false
+			}
+			continue
+		}
+
+		// the denom is missing from coins
+		if coinB.Amount.IsPositive() {
 			return false
 		}
 	}
@@ -773,22 +826,6 @@ func (coins Coins) IsAnyNil() bool {
 	return false
 }
 
-// negative returns a set of coins with all amount negative.
-//
-// TODO: Remove once unsigned integers are used.
-func (coins Coins) negative() Coins {
-	res := make([]Coin, 0, len(coins))
-
-	for _, coin := range coins {
-		res = append(res, Coin{
-			Denom:  coin.Denom,
-			Amount: coin.Amount.Neg(),
-		})
-	}
-
-	return res
-}
-
 // removeZeroCoins removes all zero coins from the given coin set in-place.
 func removeZeroCoins(coins Coins) Coins {
 	nonZeros := make([]Coin, 0, len(coins))
@@ -833,6 +870,10 @@ var (
 	reSpc       = `[[:space:]]*`
 	reDnm       *regexp.Regexp
 	reDecCoin   *regexp.Regexp
+
+	// reDecCoinAnyDenom splits a coin whose denom is validated by a custom
+	// DenomValidator, and so may not match the denom regex.
+	reDecCoinAnyDenom = regexp.MustCompile(fmt.Sprintf(`^(%s)%s([^[:space:]]+)$`, reDecAmt, reSpc))
 )
 
 func init() {
@@ -856,12 +897,73 @@ func SetCoinDenomRegex(reFn func() string) {
 	reDecCoin = regexp.MustCompile(fmt.Sprintf(`^(%s)%s(%s)$`, reDecAmt, reSpc, coinDenomRegex()))
 }
 
+// DenomValidator validates a denomination, returning an error if it is invalid.
+type DenomValidator func(denom string) error
+
+var (
+	// denomValidator is the validator replacing the denom regex, if any.
+	denomValidator DenomValidator
+	// extraDenomValidators are the validators accepting the denoms rejected by
+	// the denom regex or by denomValidator.
+	extraDenomValidators []DenomValidator
+)
+
+// SetDenomValidator replaces the validation of the denoms with the denom regex
+// by the given validator, e.g. to validate the denoms without regular
+// expression. A nil validator restores the validation with the denom regex.
+// The validators registered with RegisterDenomValidator still apply.
+//
+// Like SetCoinDenomRegex, it must be called when the application starts,
+// before any denom is validated.
+func SetDenomValidator(validator DenomValidator) {
+	denomValidator = validator
+}
+
+// RegisterDenomValidator extends the validation of the denoms: a denom
+// rejected by the denom regex, or by the validator set with SetDenomValidator,
+// is valid if any of the registered validators accepts it. It allows an
+// application to accept additional denoms, such as longer token factory paths,
+// while keeping the default rules.
+//
+// Like SetCoinDenomRegex, it must be called when the application starts,
+// before any denom is validated.
+func RegisterDenomValidator(validator DenomValidator) {
+	extraDenomValidators = append(extraDenomValidators, validator)
+}
+
+// ResetDenomValidators removes the validators set with SetDenomValidator and
+// RegisterDenomValidator, and restores the validation with the denom regex.
+func ResetDenomValidators() {
+	denomValidator = nil
+	extraDenomValidators = nil
+}
+
+// hasCustomDenomValidation returns true if the denoms are not only validated
+// with the denom regex.
+func hasCustomDenomValidation() bool {
+	return denomValidator != nil || len(extraDenomValidators) > 0
+}
+
 // ValidateDenom is the default validation function for Coin.Denom.
 func ValidateDenom(denom string) error {
-	if !reDnm.MatchString(denom) {
-		return fmt.Errorf("invalid denom: %s", denom)
+	var err error
+	if denomValidator != nil {
+		err = denomValidator(denom)
+	} else if !reDnm.MatchString(denom) {
+		err = fmt.Errorf("invalid denom: %s", denom)
 	}
-	return nil
+
+	if err == nil {
+		return nil
+	}
+
+	for _, validator := range extraDenomValidators {
+		if validator(denom) == nil {
+			return nil
+		}
+	}
+
+	return err
 }
 
 func mustValidateDenom(denom string) {
@@ -109,6 +109,13 @@ func BenchmarkSumOfCoinAdds(b *testing.B) {
 		}
 		return sum.ToCoins()
 	}
+	CoinsBuilderSumFn := func(coins []Coins) Coins {
+		sum := NewCoinsBuilder(0)
+		for _, coin := range coins {
+			sum.Add(coin...)
+		}
+		return sum.Build()
+	}
 	CoinsSumFn := func(coins []Coins) Coins {
 		sum := Coins{}
 		for _, coin := range coins {
@@ -124,10 +131,10 @@ func BenchmarkSumOfCoinAdds(b *testing.B) {
 		name string
 		fn   func([]Coins) Coins
 	}{
-		{"MapCoins", MapCoinsSumFn}, {"Coins", CoinsSumFn},
+		{"MapCoins", MapCoinsSumFn}, {"CoinsBuilder", CoinsBuilderSumFn}, {"Coins", CoinsSumFn},
 	}
 	for i := 0; i < len(benchmarkSizes); i++ {
-		for j := 0; j < 2; j++ {
+		for j := 0; j < len(sumFns); j++ {
 			coinsPerAdd := benchmarkSizes[i][0]
 			intersectingCoinsPerAdd := benchmarkSizes[i][1]
 			numAdds := benchmarkSizes[i][2]
@@ -138,3 +145,33 @@ func BenchmarkSumOfCoinAdds(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkCoinsIsAllGTE(b *testing.B) {
+	benchmarkingFunc := func(numCoinsA, numCoinsB int) func(b *testing.B) {
+		return func(b *testing.B) {
+			b.ReportAllocs()
+			coinsA := Coins(make([]Coin, numCoinsA))
+			coinsB := Coins(make([]Coin, numCoinsB))
+
+			for i := 0; i < numCoinsA; i++ {
+				coinsA[i] = NewCoin(coinName(i), NewInt(int64(i+1)))
+			}
+			for i := 0; i < numCoinsB; i++ {
+				coinsB[i] = NewCoin(coinName(i), NewInt(int64(i)))
+			}
+
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				coinsA.IsAllGTE(coinsB)
+			}
+		}
+	}
+
+	benchmarkSizes := [][]int{{1, 1}, {5, 5}, {20, 5}, {1000, 1000}}
+	for i := 0; i < len(benchmarkSizes); i++ {
+		sizeA := benchmarkSizes[i][0]
+		sizeB := benchmarkSizes[i][1]
+		b.Run(fmt.Sprintf("sizes: A_%d, B_%d", sizeA, sizeB), benchmarkingFunc(sizeA, sizeB))
+	}
+}
@@ -127,6 +127,51 @@ func (s *coinTestSuite) TestCustomValidation() {
 	sdk.SetCoinDenomRegex(sdk.DefaultCoinDenomRegex)
 }
 
+func (s *coinTestSuite) TestDenomValidators() {
+	defer sdk.ResetDenomValidators()
+
+	longFactoryDenom := "factory/" + strings.Repeat("a", 200)
+	s.Require().Error(sdk.ValidateDenom(longFactoryDenom))
+
+	// extend the default rules
+	sdk.RegisterDenomValidator(func(denom string) error {
+		if !strings.HasPrefix(denom, "factory/") {
+			return fmt.Errorf("invalid factory denom: %s", denom)
+		}
+		return nil
+	})
+	s.Require().NoError(sdk.ValidateDenom(longFactoryDenom))
+	s.Require().NoError(sdk.ValidateDenom(testDenom1))
+	s.Require().Error(sdk.ValidateDenom("a"))
+
+	coins, err := sdk.ParseCoinsNormalized("10" + longFactoryDenom + ",5" + testDenom1)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(10), coins.AmountOf(longFactoryDenom))
+
+	// replace the default rules, the registered validator still applies
+	sdk.SetDenomValidator(func(denom string) error {
+		if denom != "x" {
+			return fmt.Errorf("invalid denom: %s", denom)
+		}
+		return nil
+	})
+	s.Require().NoError(sdk.ValidateDenom("x"))
+	s.Require().NoError(sdk.ValidateDenom(longFactoryDenom))
+	s.Require().Error(sdk.ValidateDenom(testDenom1))
+
+	coin, err := sdk.ParseCoinNormalized("3x")
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin("x", 3), coin)
+	_, err = sdk.ParseCoinNormalized("3" + testDenom1)
+	s.Require().Error(err)
+
+	sdk.ResetDenomValidators()
+	s.Require().Error(sdk.ValidateDenom(longFactoryDenom))
+	s.Require().NoError(sdk.ValidateDenom(testDenom1))
+	_, err = sdk.ParseCoinNormalized("3x")
+	s.Require().Error(err)
+}
+
 func (s *coinTestSuite) TestCoinsDenoms() {
 	cases := []struct {
 		coins      sdk.Coins
@@ -0,0 +1,35 @@
+package types
+
+// CoinsBuilder builds a coin set from coins added in any order, e.g. when
+// summing the balances of many accounts. Unlike Coins.Add, which merges the
+// sets on each addition, the coins are only sorted and coalesced once, when
+// the set is built.
+type CoinsBuilder struct {
+	coins Coins
+}
+
+// NewCoinsBuilder returns a CoinsBuilder with room for capacity coins.
+func NewCoinsBuilder(capacity int) *CoinsBuilder {
+	return &CoinsBuilder{coins: make(Coins, 0, capacity)}
+}
+
+// Add adds coins to the set. The coins do not need to be sorted and may have
+// the same denomination several times.
+func (b *CoinsBuilder) Add(coins ...Coin) *CoinsBuilder {
+	b.coins = append(b.coins, coins...)
+	return b
+}
+
+// Len returns the number of coins added to the builder.
+func (b *CoinsBuilder) Len() int {
+	return len(b.coins)
+}
+
+// Build returns the sorted set of the coins added so far, with the coins of
+// the same denomination coalesced and the zero coins removed. Like
+// MapCoins.ToCoins, it does not validate the denominations. The builder can
+// be used after Build.
+func (b *CoinsBuilder) Build() Coins {
+	b.coins.Sort()
+	return Coins{}.merge(b.coins, false)
+}
@@ -0,0 +1,32 @@
+package types_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *coinTestSuite) TestCoinsBuilder() {
+	s.Require().Equal(sdk.Coins{}, sdk.NewCoinsBuilder(0).Build())
+
+	b := sdk.NewCoinsBuilder(4).
+		Add(s.cm1, s.ca1).
+		Add(s.ca0, s.cm2).
+		Add(s.ca1)
+	s.Require().Equal(5, b.Len())
+	s.Require().Equal(sdk.Coins{s.ca2, sdk.NewInt64Coin(testDenom2, 3)}, b.Build())
+
+	// zero sums are removed and the builder can still be used
+	b.Add(sdk.Coin{Denom: testDenom1, Amount: sdk.NewInt(-2)})
+	s.Require().Equal(sdk.Coins{sdk.NewInt64Coin(testDenom2, 3)}, b.Build())
+
+	// the result is the same as with Coins.Add
+	coins := sdk.Coins{}
+	b = sdk.NewCoinsBuilder(0)
+	for i := int64(1); i <= 20; i++ {
+		coin := sdk.NewInt64Coin(fmt.Sprintf("coin%02d", i%7), i)
+		coins = coins.Add(coin)
+		b.Add(coin)
+	}
+	s.Require().Equal(coins, b.Build())
+}
@@ -0,0 +1,4424 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/config/v1/app.proto
+
+package configschema
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AppConfig is the schema of the app.toml file.
+type AppConfig struct {
+	// base is the base configuration of the server.
+	Base *BaseConfig `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// telemetry is the telemetry configuration.
+	Telemetry *TelemetryConfig `protobuf:"bytes,2,opt,name=telemetry,proto3" json:"telemetry,omitempty"`
+	// api is the API server configuration.
+	Api *APIConfig `protobuf:"bytes,3,opt,name=api,proto3" json:"api,omitempty"`
+	// grpc is the gRPC server configuration.
+	Grpc *GRPCConfig `protobuf:"bytes,4,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	// grpc_web is the gRPC-web server configuration.
+	GrpcWeb *GRPCWebConfig `protobuf:"bytes,5,opt,name=grpc_web,json=grpcWeb,proto3" json:"grpc_web,omitempty"`
+	// state_sync is the state sync snapshots configuration.
+	StateSync *StateSyncConfig `protobuf:"bytes,6,opt,name=state_sync,json=stateSync,proto3" json:"state_sync,omitempty"`
+	// streaming is the state streaming configuration.
+	Streaming *StreamingConfig `protobuf:"bytes,7,opt,name=streaming,proto3" json:"streaming,omitempty"`
+	// mempool is the app-side mempool configuration.
+	Mempool *MempoolConfig `protobuf:"bytes,8,opt,name=mempool,proto3" json:"mempool,omitempty"`
+}
+
+func (m *AppConfig) Reset()         { *m = AppConfig{} }
+func (m *AppConfig) String() string { return proto.CompactTextString(m) }
+func (*AppConfig) ProtoMessage()    {}
+func (*AppConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f08b132e1898b03a, []int{0}
+}
+func (m *AppConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AppConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppConfig.Merge(m, src)
+}
+func (m *AppConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppConfig proto.InternalMessageInfo
+
+func (m *AppConfig) GetBase() *BaseConfig {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *AppConfig) GetTelemetry() *TelemetryConfig {
+	if m != nil {
+		return m.Telemetry
+	}
+	return nil
+}
+
+func (m *AppConfig) GetApi() *APIConfig {
+	if m != nil {
+		return m.Api
+	}
+	return nil
+}
+
+func (m *AppConfig) GetGrpc() *GRPCConfig {
+	if m != nil {
+		return m.Grpc
+	}
+	return nil
+}
+
+func (m *AppConfig) GetGrpcWeb() *GRPCWebConfig {
+	if m != nil {
+		return m.GrpcWeb
+	}
+	return nil
+}
+
+func (m *AppConfig) GetStateSync() *StateSyncConfig {
+	if m != nil {
+		return m.StateSync
+	}
+	return nil
+}
+
+func (m *AppConfig) GetStreaming() *StreamingConfig {
+	if m != nil {
+		return m.Streaming
+	}
+	return nil
+}
+
+func (m *AppConfig) GetMempool() *MempoolConfig {
+	if m != nil {
+		return m.Mempool
+	}
+	return nil
+}
+
+// BaseConfig is the base configuration of the server.
+type BaseConfig struct {
+	// minimum_gas_prices are the minimum gas prices of the validator.
+	MinimumGasPrices string `protobuf:"bytes,1,opt,name=minimum_gas_prices,json=minimumGasPrices,proto3" json:"minimum_gas_prices,omitempty"`
+	// pruning is the pruning strategy.
+	Pruning string `protobuf:"bytes,2,opt,name=pruning,proto3" json:"pruning,omitempty"`
+	// pruning_keep_recent is the number of recent states kept by the custom pruning.
+	PruningKeepRecent string `protobuf:"bytes,3,opt,name=pruning_keep_recent,json=pruningKeepRecent,proto3" json:"pruning_keep_recent,omitempty"`
+	// pruning_interval is the interval of the custom pruning.
+	PruningInterval string `protobuf:"bytes,4,opt,name=pruning_interval,json=pruningInterval,proto3" json:"pruning_interval,omitempty"`
+	// halt_height is the height at which the node halts.
+	HaltHeight uint64 `protobuf:"varint,5,opt,name=halt_height,json=haltHeight,proto3" json:"halt_height,omitempty"`
+	// halt_time is the block time at which the node halts.
+	HaltTime uint64 `protobuf:"varint,6,opt,name=halt_time,json=haltTime,proto3" json:"halt_time,omitempty"`
+	// min_retain_blocks is the minimum number of blocks retained by CometBFT.
+	MinRetainBlocks uint64 `protobuf:"varint,7,opt,name=min_retain_blocks,json=minRetainBlocks,proto3" json:"min_retain_blocks,omitempty"`
+	// inter_block_cache enables the inter-block cache.
+	InterBlockCache bool `protobuf:"varint,8,opt,name=inter_block_cache,json=interBlockCache,proto3" json:"inter_block_cache,omitempty"`
+	// index_events are the events indexed by CometBFT.
+	IndexEvents []string `protobuf:"bytes,9,rep,name=index_events,json=indexEvents,proto3" json:"index_events,omitempty"`
+	// drop_events are the events removed from the ABCI responses.
+	DropEvents []string `protobuf:"bytes,10,rep,name=drop_events,json=dropEvents,proto3" json:"drop_events,omitempty"`
+	// iavl_cache_size is the size of the iavl tree cache.
+	IavlCacheSize uint64 `protobuf:"varint,11,opt,name=iavl_cache_size,json=iavlCacheSize,proto3" json:"iavl_cache_size,omitempty"`
+	// iavl_disable_fastnode disables the fast node feature of IAVL.
+	IavlDisableFastnode bool `protobuf:"varint,12,opt,name=iavl_disable_fastnode,json=iavlDisableFastnode,proto3" json:"iavl_disable_fastnode,omitempty"`
+	// iavl_lazy_loading enables the lazy loading of the iavl store.
+	IavlLazyLoading bool `protobuf:"varint,13,opt,name=iavl_lazy_loading,json=iavlLazyLoading,proto3" json:"iavl_lazy_loading,omitempty"`
+	// optimistic_execution enables the concurrent execution of the txs of a block.
+	OptimisticExecution bool `protobuf:"varint,14,opt,name=optimistic_execution,json=optimisticExecution,proto3" json:"optimistic_execution,omitempty"`
+	// query_gas_limit is the maximum gas consumed by a query.
+	QueryGasLimit uint64 `protobuf:"varint,15,opt,name=query_gas_limit,json=queryGasLimit,proto3" json:"query_gas_limit,omitempty"`
+	// query_max_response_bytes is the maximum size of the response of a query.
+	QueryMaxResponseBytes uint64 `protobuf:"varint,16,opt,name=query_max_response_bytes,json=queryMaxResponseBytes,proto3" json:"query_max_response_bytes,omitempty"`
+	// block_trace_dir is the directory the block traces are written to.
+	BlockTraceDir string `protobuf:"bytes,17,opt,name=block_trace_dir,json=blockTraceDir,proto3" json:"block_trace_dir,omitempty"`
+	// app_db_backend is the database backend of the application.
+	AppDbBackend string `protobuf:"bytes,18,opt,name=app_db_backend,json=appDbBackend,proto3" json:"app_db_backend,omitempty"`
+}
+
+func (m *BaseConfig) Reset()         { *m = BaseConfig{} }
+func (m *BaseConfig) String() string { return proto.CompactTextString(m) }
+func (*BaseConfig) ProtoMessage()    {}
+func (*BaseConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f08b132e1898b03a, []int{1}
+}
+func (m *BaseConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseConfig.Merge(m, src)
+}
+func (m *BaseConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseConfig proto.InternalMessageInfo
+
+func (m *BaseConfig) GetMinimumGasPrices() string {
+	if m != nil {
+		return m.MinimumGasPrices
+	}
+	return ""
+}
+
+func (m *BaseConfig) GetPruning() string {
+	if m != nil {
+		return m.Pruning
+	}
+	return ""
+}
+
+func (m *BaseConfig) GetPruningKeepRecent() string {
+	if m != nil {
+		return m.PruningKeepRecent
+	}
+	return ""
+}
+
+func (m *BaseConfig) GetPruningInterval() string {
+	if m != nil {
+		return m.PruningInterval
+	}
+	return ""
+}
+
+func (m *BaseConfig) GetHaltHeight() uint64 {
+	if m != nil {
+		return m.HaltHeight
+	}
+	return 0
+}
+
+func (m *BaseConfig) GetHaltTime() uint64 {
+	if m != nil {
+		return m.HaltTime
+	}
+	return 0
+}
+
+func (m *BaseConfig) GetMinRetainBlocks() uint64 {
+	if m != nil {
+		return m.MinRetainBlocks
+	}
+	return 0
+}
+
+func (m *BaseConfig) GetInterBlockCache() bool {
+	if m != nil {
+		return m.InterBlockCache
+	}
+	return false
+}
+
+func (m *BaseConfig) GetIndexEvents() []string {
+	if m != nil {
+		return m.IndexEvents
+	}
+	return nil
+}
+
+func (m *BaseConfig) GetDropEvents() []string {
+	if m != nil {
+		return m.DropEvents
+	}
+	return nil
+}
+
+func (m *BaseConfig) GetIavlCacheSize() uint64 {
+	if m != nil {
+		return m.IavlCacheSize
+	}
+	return 0
+}
+
+func (m *BaseConfig) GetIavlDisableFastnode() bool {
+	if m != nil {
+		return m.IavlDisableFastnode
+	}
+	return false
+}
+
+func (m *BaseConfig) GetIavlLazyLoading() bool {
+	if m != nil {
+		return m.IavlLazyLoading
+	}
+	return false
+}
+
+func (m *BaseConfig) GetOptimisticExecution() bool {
+	if m != nil {
+		return m.OptimisticExecution
+	}
+	return false
+}
+
+func (m *BaseConfig) GetQueryGasLimit() uint64 {
+	if m != nil {
+		return m.QueryGasLimit
+	}
+	return 0
+}
+
+func (m *BaseConfig) GetQueryMaxResponseBytes() uint64 {
+	if m != nil {
+		return m.QueryMaxResponseBytes
+	}
+	return 0
+}
+
+func (m *BaseConfig) GetBlockTraceDir() string {
+	if m != nil {
+		return m.BlockTraceDir
+	}
+	return ""
+}
+
+func (m *BaseConfig) GetAppDbBackend() string {
+	if m != nil {
+		return m.AppDbBackend
+	}
+	return ""
+}
+
+// TelemetryConfig is the telemetry configuration.
+type TelemetryConfig struct {
+	// service_name is the prefix of the telemetry keys.
+	ServiceName string `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// enabled enables the telemetry.
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// enable_hostname prefixes the gauge values with the hostname.
+	EnableHostname bool `protobuf:"varint,3,opt,name=enable_hostname,json=enableHostname,proto3" json:"enable_hostname,omitempty"`
+	// enable_hostname_label adds the hostname to the labels.
+	EnableHostnameLabel bool `protobuf:"varint,4,opt,name=enable_hostname_label,json=enableHostnameLabel,proto3" json:"enable_hostname_label,omitempty"`
+	// enable_service_label adds the service to the labels.
+	EnableServiceLabel bool `protobuf:"varint,5,opt,name=enable_service_label,json=enableServiceLabel,proto3" json:"enable_service_label,omitempty"`
+	// prometheus_retention_time enables a Prometheus metrics sink when positive.
+	PrometheusRetentionTime int64 `protobuf:"varint,6,opt,name=prometheus_retention_time,json=prometheusRetentionTime,proto3" json:"prometheus_retention_time,omitempty"`
+	// global_labels are the labels applied to all the metrics.
+	GlobalLabels []*Label `protobuf:"bytes,7,rep,name=global_labels,json=globalLabels,proto3" json:"global_labels,omitempty"`
+}
+
+func (m *TelemetryConfig) Reset()         { *m = TelemetryConfig{} }
+func (m *TelemetryConfig) String() string { return proto.CompactTextString(m) }
+func (*TelemetryConfig) ProtoMessage()    {}
+func (*TelemetryConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f08b132e1898b03a, []int{2}
+}
+func (m *TelemetryConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TelemetryConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TelemetryConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TelemetryConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TelemetryConfig.Merge(m, src)
+}
+func (m *TelemetryConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *TelemetryConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_TelemetryConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TelemetryConfig proto.InternalMessageInfo
+
+func (m *TelemetryConfig) GetServiceName() string {
+	if m != nil {
+		return m.ServiceName
+	}
+	return ""
+}
+
+func (m *TelemetryConfig) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *TelemetryConfig) GetEnableHostname() bool {
+	if m != nil {
+		return m.EnableHostname
+	}
+	return false
+}
+
+func (m *TelemetryConfig) GetEnableHostnameLabel() bool {
+	if m != nil {
+		return m.EnableHostnameLabel
+	}
+	return false
+}
+
+func (m *TelemetryConfig) GetEnableServiceLabel() bool {
+	if m != nil {
+		return m.EnableServiceLabel
+	}
+	return false
+}
+
+func (m *TelemetryConfig) GetPrometheusRetentionTime() int64 {
+	if m != nil {
+		return m.PrometheusRetentionTime
+	}
+	return 0
+}
+
+func (m *TelemetryConfig) GetGlobalLabels() []*Label {
+	if m != nil {
+		return m.GlobalLabels
+	}
+	return nil
+}
+
+// Label is a name/value label tuple.
+type Label struct {
+	// name is the name of the label.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// value is the value of the label.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *Label) Reset()         { *m = Label{} }
+func (m *Label) String() string { return proto.CompactTextString(m) }
+func (*Label) ProtoMessage()    {}
+func (*Label) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f08b132e1898b03a, []int{3}
+}
+func (m *Label) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Label) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Label.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Label) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Label.Merge(m, src)
+}
+func (m *Label) XXX_Size() int {
+	return m.Size()
+}
+func (m *Label) XXX_DiscardUnknown() {
+	xxx_messageInfo_Label.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Label proto.InternalMessageInfo
+
+func (m *Label) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Label) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// APIConfig is the API server configuration.
+type APIConfig struct {
+	// enable enables the API server.
+	Enable bool `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+	// swagger registers the swagger documentation.
+	Swagger bool `protobuf:"varint,2,opt,name=swagger,proto3" json:"swagger,omitempty"`
+	// address is the address the API server listens on.
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// max_open_connections is the maximum number of open connections.
+	MaxOpenConnections uint32 `protobuf:"varint,4,opt,name=max_open_connections,json=maxOpenConnections,proto3" json:"max_open_connections,omitempty"`
+	// rpc_read_timeout is the CometBFT RPC read timeout.
+	RpcReadTimeout uint32 `protobuf:"varint,5,opt,name=rpc_read_timeout,json=rpcReadTimeout,proto3" json:"rpc_read_timeout,omitempty"`
+	// rpc_write_timeout is the CometBFT RPC write timeout.
+	RpcWriteTimeout uint32 `protobuf:"varint,6,opt,name=rpc_write_timeout,json=rpcWriteTimeout,proto3" json:"rpc_write_timeout,omitempty"`
+	// rpc_max_body_bytes is the CometBFT maximum request body.
+	RpcMaxBodyBytes uint32 `protobuf:"varint,7,opt,name=rpc_max_body_bytes,json=rpcMaxBodyBytes,proto3" json:"rpc_max_body_bytes,omitempty"`
+	// enabled_unsafe_cors enables CORS.
+	EnabledUnsafeCors bool `protobuf:"varint,8,opt,name=enabled_unsafe_cors,json=enabledUnsafeCors,proto3" json:"enabled_unsafe_cors,omitempty"`
+}
+
+func (m *APIConfig) Reset()         { *m = APIConfig{} }
+func (m *APIConfig) String() string { return proto.CompactTextString(m) }
+func (*APIConfig) ProtoMessage()    {}
+func (*APIConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f08b132e1898b03a, []int{4}
+}
+func (m *APIConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *APIConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_APIConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *APIConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_APIConfig.Merge(m, src)
+}
+func (m *APIConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *APIConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_APIConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_APIConfig proto.InternalMessageInfo
+
+func (m *APIConfig) GetEnable() bool {
+	if m != nil {
+		return m.Enable
+	}
+	return false
+}
+
+func (m *APIConfig) GetSwagger() bool {
+	if m != nil {
+		return m.Swagger
+	}
+	return false
+}
+
+func (m *APIConfig) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *APIConfig) GetMaxOpenConnections() uint32 {
+	if m != nil {
+		return m.MaxOpenConnections
+	}
+	return 0
+}
+
+func (m *APIConfig) GetRpcReadTimeout() uint32 {
+	if m != nil {
+		return m.RpcReadTimeout
+	}
+	return 0
+}
+
+func (m *APIConfig) GetRpcWriteTimeout() uint32 {
+	if m != nil {
+		return m.RpcWriteTimeout
+	}
+	return 0
+}
+
+func (m *APIConfig) GetRpcMaxBodyBytes() uint32 {
+	if m != nil {
+		return m.RpcMaxBodyBytes
+	}
+	return 0
+}
+
+func (m *APIConfig) GetEnabledUnsafeCors() bool {
+	if m != nil {
+		return m.EnabledUnsafeCors
+	}
+	return false
+}
+
+// GRPCConfig is the gRPC server configuration.
+type GRPCConfig struct {
+	// enable enables the gRPC server.
+	Enable bool `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+	// address is the address the gRPC server listens on.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// max_recv_msg_size is the maximum size of the messages received by the server.
+	MaxRecvMsgSize string `protobuf:"bytes,3,opt,name=max_recv_msg_size,json=maxRecvMsgSize,proto3" json:"max_recv_msg_size,omitempty"`
+	// max_send_msg_size is the maximum size of the messages sent by the server.
+	MaxSendMsgSize string `protobuf:"bytes,4,opt,name=max_send_msg_size,json=maxSendMsgSize,proto3" json:"max_send_msg_size,omitempty"`
+}
+
+func (m *GRPCConfig) Reset()         { *m = GRPCConfig{} }
+func (m *GRPCConfig) String() string { return proto.CompactTextString(m) }
+func (*GRPCConfig) ProtoMessage()    {}
+func (*GRPCConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f08b132e1898b03a, []int{5}
+}
+func (m *GRPCConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GRPCConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GRPCConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GRPCConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GRPCConfig.Merge(m, src)
+}
+func (m *GRPCConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *GRPCConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_GRPCConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GRPCConfig proto.InternalMessageInfo
+
+func (m *GRPCConfig) GetEnable() bool {
+	if m != nil {
+		return m.Enable
+	}
+	return false
+}
+
+func (m *GRPCConfig) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GRPCConfig) GetMaxRecvMsgSize() string {
+	if m != nil {
+		return m.MaxRecvMsgSize
+	}
+	return ""
+}
+
+func (m *GRPCConfig) GetMaxSendMsgSize() string {
+	if m != nil {
+		return m.MaxSendMsgSize
+	}
+	return ""
+}
+
+// GRPCWebConfig is the gRPC-web server configuration.
+type GRPCWebConfig struct {
+	// enable enables the gRPC-web server.
+	Enable bool `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+}
+
+func (m *GRPCWebConfig) Reset()         { *m = GRPCWebConfig{} }
+func (m *GRPCWebConfig) String() string { return proto.CompactTextString(m) }
+func (*GRPCWebConfig) ProtoMessage()    {}
+func (*GRPCWebConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f08b132e1898b03a, []int{6}
+}
+func (m *GRPCWebConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GRPCWebConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GRPCWebConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GRPCWebConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GRPCWebConfig.Merge(m, src)
+}
+func (m *GRPCWebConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *GRPCWebConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_GRPCWebConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GRPCWebConfig proto.InternalMessageInfo
+
+func (m *GRPCWebConfig) GetEnable() bool {
+	if m != nil {
+		return m.Enable
+	}
+	return false
+}
+
+// StateSyncConfig is the state sync snapshots configuration.
+type StateSyncConfig struct {
+	// snapshot_interval is the block interval of the snapshots.
+	SnapshotInterval uint64 `protobuf:"varint,1,opt,name=snapshot_interval,json=snapshotInterval,proto3" json:"snapshot_interval,omitempty"`
+	// snapshot_keep_recent is the number of recent snapshots kept.
+	SnapshotKeepRecent uint32 `protobuf:"varint,2,opt,name=snapshot_keep_recent,json=snapshotKeepRecent,proto3" json:"snapshot_keep_recent,omitempty"`
+}
+
+func (m *StateSyncConfig) Reset()         { *m = StateSyncConfig{} }
+func (m *StateSyncConfig) String() string { return proto.CompactTextString(m) }
+func (*StateSyncConfig) ProtoMessage()    {}
+func (*StateSyncConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f08b132e1898b03a, []int{7}
+}
+func (m *StateSyncConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateSyncConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateSyncConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StateSyncConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateSyncConfig.Merge(m, src)
+}
+func (m *StateSyncConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateSyncConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateSyncConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateSyncConfig proto.InternalMessageInfo
+
+func (m *StateSyncConfig) GetSnapshotInterval() uint64 {
+	if m != nil {
+		return m.SnapshotInterval
+	}
+	return 0
+}
+
+func (m *StateSyncConfig) GetSnapshotKeepRecent() uint32 {
+	if m != nil {
+		return m.SnapshotKeepRecent
+	}
+	return 0
+}
+
+// StreamingConfig is the state streaming configuration.
+type StreamingConfig struct {
+	// abci is the ABCI listener streaming configuration.
+	Abci *ABCIListenerConfig `protobuf:"bytes,1,opt,name=abci,proto3" json:"abci,omitempty"`
+}
+
+func (m *StreamingConfig) Reset()         { *m = StreamingConfig{} }
+func (m *StreamingConfig) String() string { return proto.CompactTextString(m) }
+func (*StreamingConfig) ProtoMessage()    {}
+func (*StreamingConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f08b132e1898b03a, []int{8}
+}
+func (m *StreamingConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamingConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamingConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamingConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamingConfig.Merge(m, src)
+}
+func (m *StreamingConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamingConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamingConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamingConfig proto.InternalMessageInfo
+
+func (m *StreamingConfig) GetAbci() *ABCIListenerConfig {
+	if m != nil {
+		return m.Abci
+	}
+	return nil
+}
+
+// ABCIListenerConfig is the ABCI listener streaming configuration.
+type ABCIListenerConfig struct {
+	// keys are the store keys streamed.
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	// plugin is the streaming plugin.
+	Plugin string `protobuf:"bytes,2,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	// stop_node_on_err stops the node on a delivery error.
+	StopNodeOnErr bool `protobuf:"varint,3,opt,name=stop_node_on_err,json=stopNodeOnErr,proto3" json:"stop_node_on_err,omitempty"`
+}
+
+func (m *ABCIListenerConfig) Reset()         { *m = ABCIListenerConfig{} }
+func (m *ABCIListenerConfig) String() string { return proto.CompactTextString(m) }
+func (*ABCIListenerConfig) ProtoMessage()    {}
+func (*ABCIListenerConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f08b132e1898b03a, []int{9}
+}
+func (m *ABCIListenerConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ABCIListenerConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ABCIListenerConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ABCIListenerConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ABCIListenerConfig.Merge(m, src)
+}
+func (m *ABCIListenerConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *ABCIListenerConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ABCIListenerConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ABCIListenerConfig proto.InternalMessageInfo
+
+func (m *ABCIListenerConfig) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *ABCIListenerConfig) GetPlugin() string {
+	if m != nil {
+		return m.Plugin
+	}
+	return ""
+}
+
+func (m *ABCIListenerConfig) GetStopNodeOnErr() bool {
+	if m != nil {
+		return m.StopNodeOnErr
+	}
+	return false
+}
+
+// MempoolConfig is the app-side mempool configuration.
+type MempoolConfig struct {
+	// max_txs is the maximum number of txs in the mempool.
+	MaxTxs string `protobuf:"bytes,1,opt,name=max_txs,json=maxTxs,proto3" json:"max_txs,omitempty"`
+	// type is the type of the app-side mempool.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (m *MempoolConfig) Reset()         { *m = MempoolConfig{} }
+func (m *MempoolConfig) String() string { return proto.CompactTextString(m) }
+func (*MempoolConfig) ProtoMessage()    {}
+func (*MempoolConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f08b132e1898b03a, []int{10}
+}
+func (m *MempoolConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MempoolConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MempoolConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MempoolConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MempoolConfig.Merge(m, src)
+}
+func (m *MempoolConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MempoolConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MempoolConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MempoolConfig proto.InternalMessageInfo
+
+func (m *MempoolConfig) GetMaxTxs() string {
+	if m != nil {
+		return m.MaxTxs
+	}
+	return ""
+}
+
+func (m *MempoolConfig) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*AppConfig)(nil), "cosmos.config.v1.AppConfig")
+	proto.RegisterType((*BaseConfig)(nil), "cosmos.config.v1.BaseConfig")
+	proto.RegisterType((*TelemetryConfig)(nil), "cosmos.config.v1.TelemetryConfig")
+	proto.RegisterType((*Label)(nil), "cosmos.config.v1.Label")
+	proto.RegisterType((*APIConfig)(nil), "cosmos.config.v1.APIConfig")
+	proto.RegisterType((*GRPCConfig)(nil), "cosmos.config.v1.GRPCConfig")
+	proto.RegisterType((*GRPCWebConfig)(nil), "cosmos.config.v1.GRPCWebConfig")
+	proto.RegisterType((*StateSyncConfig)(nil), "cosmos.config.v1.StateSyncConfig")
+	proto.RegisterType((*StreamingConfig)(nil), "cosmos.config.v1.StreamingConfig")
+	proto.RegisterType((*ABCIListenerConfig)(nil), "cosmos.config.v1.ABCIListenerConfig")
+	proto.RegisterType((*MempoolConfig)(nil), "cosmos.config.v1.MempoolConfig")
+}
+
+func init() { proto.RegisterFile("cosmos/config/v1/app.proto", fileDescriptor_f08b132e1898b03a) }
+
+var fileDescriptor_f08b132e1898b03a = []byte{
+	// 4736 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0x5d, 0x8c, 0x24, 0xc9,
+	0x51, 0x76, 0xcf, 0xcc, 0xee, 0xec, 0xe6, 0xfe, 0xcc, 0x6e, 0xed, 0x19, 0xb7, 0x0d, 0x2c, 0xe9,
+	0x01, 0xb4, 0xb3, 0xa7, 0xe9, 0xda, 0x9f, 0xc3, 0x96, 0x3d, 0x27, 0xc0, 0xdb, 0xb3, 0x7b, 0xe7,
+	0xf1, 0xed, 0xdc, 0xae, 0x6b, 0xe6, 0x7c, 0xb6, 0xcf, 0x4b, 0x93, 0x5d, 0x15, 0xdd, 0x9d, 0x9e,
+	0xaa, 0xcc, 0xba, 0xcc, 0xac, 0x99, 0xe9, 0xb5, 0xcc, 0xaf, 0x8c, 0x91, 0x85, 0xc1, 0x92, 0xed,
+	0x07, 0x0b, 0x24, 0x03, 0x4f, 0x7e, 0x41, 0x46, 0x48, 0x48, 0x48, 0xfc, 0x18, 0x24, 0xfe, 0x2c,
+	0x04, 0xb2, 0x04, 0x92, 0x91, 0x85, 0xc1, 0xba, 0x03, 0xc9, 0x2f, 0x20, 0x90, 0x8c, 0xf9, 0x79,
+	0x01, 0x45, 0x64, 0x56, 0x75, 0xf5, 0xec, 0xec, 0x9d, 0x64, 0xc4, 0xd3, 0x4c, 0x67, 0x46, 0x46,
+	0x7c, 0x19, 0x19, 0x11, 0x19, 0x19, 0x51, 0xec, 0x2d, 0xa9, 0xb6, 0x85, 0xb6, 0xd7, 0x52, 0xad,
+	0x46, 0x72, 0x7c, 0x6d, 0xff, 0xc6, 0x35, 0x51, 0x96, 0x71, 0x69, 0xb4, 0xd3, 0xd1, 0x05, 0x3f,
+	0x17, 0xfb, 0xb9, 0x78, 0xff, 0xc6, 0x5b, 0x2e, 0x3f, 0x42, 0xad, 0x4b, 0x27, 0xb5, 0xb2, 0x7e,
+	0xc5, 0xea, 0x7f, 0x2c, 0xb1, 0xd3, 0xb7, 0xca, 0x72, 0x93, 0xa6, 0xa3, 0x3b, 0x6c, 0x69, 0x28,
+	0x2c, 0x74, 0x3b, 0xbc, 0xb3, 0x76, 0xe6, 0xe6, 0xf7, 0xc4, 0x47, 0xd9, 0xc5, 0x7d, 0x61, 0xc1,
+	0xd3, 0xf6, 0x2f, 0xfd, 0xfa, 0x37, 0x7f, 0xf3, 0xc9, 0xf3, 0x11, 0x9b, 0x0d, 0x75, 0x3b, 0x09,
+	0x2d, 0x8f, 0x7e, 0x94, 0x9d, 0x76, 0x90, 0x43, 0x01, 0xce, 0x4c, 0xbb, 0x0b, 0xc4, 0xeb, 0xad,
+	0x8f, 0xf2, 0xda, 0xad, 0x49, 0xfc, 0xea, 0x64, 0xb6, 0x26, 0x7a, 0x27, 0x5b, 0x14, 0xa5, 0xec,
+	0x2e, 0xd2, 0xd2, 0xef, 0x7e, 0x74, 0xe9, 0xad, 0xfb, 0x5b, 0x01, 0x05, 0x43, 0x14, 0x27, 0xa2,
+	0xc5, 0x5b, 0xf7, 0xb7, 0x12, 0x5c, 0x13, 0xfd, 0x30, 0x5b, 0x1a, 0x9b, 0x32, 0xed, 0x2e, 0x3d,
+	0x6e, 0x0b, 0xcf, 0x26, 0xf7, 0x37, 0xc3, 0xe2, 0x33, 0xb8, 0xf8, 0x64, 0xb4, 0x84, 0x43, 0x09,
+	0x2d, 0x8b, 0xde, 0xc3, 0x4e, 0xe1, 0xdf, 0xc1, 0x01, 0x0c, 0xbb, 0x27, 0x88, 0xc5, 0xf7, 0x1d,
+	0xcf, 0xe2, 0x45, 0x18, 0x06, 0x2e, 0xe7, 0x91, 0xcb, 0xe9, 0x68, 0x39, 0x8c, 0x26, 0xcb, 0xc8,
+	0xe0, 0x45, 0x18, 0x46, 0xef, 0x62, 0xcc, 0x3a, 0xe1, 0x60, 0x60, 0xa7, 0x2a, 0xed, 0x9e, 0x7c,
+	0x9c, 0x1e, 0x76, 0x90, 0x66, 0x67, 0xaa, 0xd2, 0x5a, 0x0f, 0xb6, 0x1e, 0x40, 0x45, 0x5a, 0x67,
+	0x40, 0x14, 0x52, 0x8d, 0xbb, 0xcb, 0x8f, 0x67, 0x10, 0x48, 0x66, 0x0c, 0xc2, 0x40, 0xf4, 0x4e,
+	0xb6, 0x5c, 0x40, 0x51, 0x6a, 0x9d, 0x77, 0x4f, 0x3d, 0x6e, 0x37, 0xdb, 0x9e, 0x20, 0x2c, 0xae,
+	0xe9, 0x37, 0x7e, 0x0c, 0xb7, 0xf5, 0x81, 0xe8, 0xc5, 0xdd, 0x89, 0xb4, 0x5c, 0x5a, 0x2e, 0xf8,
+	0xee, 0xbd, 0xed, 0xbb, 0xdc, 0xaf, 0xe3, 0x23, 0x99, 0x43, 0xcc, 0x9e, 0xd1, 0x86, 0x17, 0xda,
+	0x00, 0x97, 0x6a, 0xa4, 0x4d, 0x21, 0xd0, 0xb2, 0xd6, 0xb9, 0x05, 0xe0, 0x13, 0xe7, 0x4a, 0xbb,
+	0x71, 0xed, 0xda, 0x58, 0xba, 0x49, 0x35, 0x8c, 0x53, 0x5d, 0x5c, 0x73, 0xba, 0xc8, 0x7b, 0xb9,
+	0x50, 0x63, 0xfa, 0x6f, 0xf5, 0x1f, 0x7e, 0x90, 0xb5, 0x6c, 0x27, 0xfa, 0xf4, 0x02, 0x8b, 0x0a,
+	0xa9, 0x64, 0x51, 0x15, 0x83, 0xb1, 0xb0, 0x83, 0xd2, 0xc8, 0x14, 0x2c, 0x59, 0xe2, 0xe9, 0xfe,
+	0x3f, 0x76, 0x10, 0xcc, 0xdf, 0x77, 0xd8, 0x5f, 0x76, 0x76, 0x27, 0xc0, 0x03, 0x1d, 0x1f, 0x0b,
+	0xcb, 0x3d, 0x1d, 0x17, 0x7c, 0x5f, 0xe4, 0x32, 0x13, 0x4e, 0x1b, 0x04, 0x7b, 0x20, 0xf3, 0x5c,
+	0xaa, 0x31, 0x77, 0x9a, 0x8b, 0x34, 0x85, 0xd2, 0xf1, 0x91, 0x36, 0xbc, 0x34, 0x3a, 0x05, 0x6b,
+	0x71, 0x42, 0x30, 0x67, 0x84, 0xb2, 0x22, 0x45, 0xc8, 0x31, 0xbf, 0xc5, 0x5b, 0x3f, 0xaf, 0x58,
+	0x3e, 0x02, 0xb0, 0xbc, 0xa8, 0xac, 0xe3, 0x05, 0x80, 0xe3, 0xae, 0x25, 0x54, 0x8f, 0xb8, 0x50,
+	0x53, 0x9e, 0x81, 0xd2, 0x85, 0x54, 0xb4, 0x65, 0x66, 0x4b, 0x48, 0xe5, 0x48, 0x42, 0xc6, 0xa5,
+	0xe2, 0x0e, 0x15, 0x16, 0xd4, 0xb4, 0x06, 0xf1, 0x38, 0xe6, 0xd7, 0xe3, 0x9b, 0x6f, 0x73, 0x7a,
+	0x0f, 0xd4, 0x8d, 0xa7, 0xaf, 0xc7, 0xd7, 0xaf, 0x5f, 0xbf, 0x41, 0x3f, 0x6e, 0x5e, 0x8d, 0xa3,
+	0xb3, 0xdb, 0x52, 0x3d, 0x2b, 0xec, 0x7d, 0xda, 0x44, 0x72, 0x21, 0xc8, 0x68, 0x46, 0xa2, 0x5f,
+	0x5b, 0x64, 0xcb, 0xa5, 0xa9, 0x14, 0x9e, 0xff, 0x02, 0xa9, 0xe2, 0xe7, 0x16, 0x51, 0x15, 0x3f,
+	0xb5, 0xc8, 0xbe, 0xba, 0x90, 0xc1, 0x48, 0x54, 0xb9, 0xdb, 0x20, 0x78, 0xb9, 0xb0, 0x8e, 0x3f,
+	0xf5, 0xf6, 0x9b, 0xef, 0x78, 0xc7, 0x75, 0x4e, 0x06, 0x64, 0xb9, 0x30, 0xc0, 0xf7, 0xa0, 0x74,
+	0xeb, 0x3c, 0xb0, 0xe0, 0xc2, 0xf1, 0x1b, 0xd7, 0xf9, 0x30, 0xd7, 0xe9, 0x1e, 0x97, 0xca, 0x81,
+	0xd9, 0x17, 0xb9, 0x65, 0x4a, 0xbb, 0x89, 0x54, 0xe3, 0x0d, 0x2e, 0xf2, 0x9c, 0x4f, 0xa4, 0x75,
+	0xda, 0xc8, 0xb4, 0x66, 0x82, 0x9a, 0xe4, 0x43, 0xe0, 0x56, 0xec, 0x43, 0xb6, 0xce, 0x03, 0x6d,
+	0x33, 0x9c, 0x41, 0x0e, 0x0e, 0x32, 0xbe, 0x26, 0x63, 0x88, 0xb9, 0x30, 0xe9, 0x44, 0xee, 0xe3,
+	0xbc, 0xd2, 0x19, 0x5c, 0x65, 0xb0, 0x0f, 0x66, 0x1a, 0x98, 0xdf, 0xe4, 0x39, 0x32, 0x74, 0x47,
+	0x19, 0x23, 0xc2, 0xa7, 0x5f, 0x1b, 0x61, 0xcc, 0xd2, 0xca, 0x3a, 0x5d, 0x10, 0x42, 0x7d, 0xd0,
+	0x10, 0x87, 0x50, 0x86, 0xa7, 0x3c, 0x04, 0x5e, 0x08, 0x55, 0x89, 0x3c, 0x9f, 0xf2, 0xd9, 0x69,
+	0xb8, 0x89, 0xd1, 0xd5, 0x78, 0xc2, 0xaf, 0x84, 0x15, 0xbd, 0x3d, 0x80, 0xb2, 0x67, 0x20, 0x05,
+	0xe5, 0xae, 0xac, 0x73, 0xa1, 0xb2, 0xd9, 0x54, 0x2d, 0xed, 0xca, 0xea, 0x72, 0x50, 0xec, 0xea,
+	0x72, 0xd8, 0xed, 0x6a, 0x6b, 0x23, 0xab, 0x27, 0x3d, 0x96, 0xa4, 0x3e, 0x97, 0xe8, 0x80, 0x5d,
+	0x0a, 0xff, 0x0e, 0x90, 0xfd, 0xc0, 0xb3, 0xa7, 0xe0, 0x75, 0xba, 0xff, 0x2c, 0x9e, 0x56, 0x9f,
+	0xbd, 0x6b, 0x77, 0x02, 0x16, 0xe8, 0x44, 0x44, 0x59, 0xe6, 0x64, 0x29, 0x23, 0x92, 0xaf, 0x55,
+	0x3e, 0xc5, 0xff, 0xf1, 0x0c, 0xeb, 0x7d, 0x59, 0x67, 0x84, 0x83, 0xf1, 0x94, 0xa3, 0x21, 0x91,
+	0xb4, 0x38, 0xb9, 0x18, 0x26, 0x9f, 0x03, 0x28, 0x13, 0x92, 0x10, 0x5d, 0x65, 0x17, 0x6a, 0xc1,
+	0x35, 0x78, 0x0a, 0x7b, 0xa7, 0x93, 0x95, 0x30, 0xbe, 0x15, 0x86, 0xa3, 0x9f, 0x59, 0x60, 0x67,
+	0x26, 0x22, 0x77, 0x83, 0x09, 0xc8, 0xf1, 0xc4, 0x51, 0x68, 0x5b, 0xea, 0x7f, 0x83, 0xdc, 0xea,
+	0x6b, 0x1d, 0xf6, 0xd5, 0xce, 0xbb, 0x45, 0xee, 0xde, 0x4d, 0x73, 0x68, 0xba, 0x4e, 0x48, 0x65,
+	0xb9, 0xe0, 0x4a, 0xab, 0xde, 0x43, 0x30, 0x3a, 0x1c, 0x88, 0x5f, 0xcc, 0x85, 0xe3, 0x07, 0x13,
+	0x99, 0x4e, 0x88, 0x20, 0x03, 0x7f, 0x92, 0x63, 0x23, 0x52, 0x18, 0x55, 0x79, 0x3e, 0x65, 0x28,
+	0x88, 0xf6, 0x66, 0x27, 0x95, 0xcb, 0xf4, 0x01, 0xba, 0x84, 0x70, 0x3c, 0x15, 0x0a, 0x8f, 0xa9,
+	0xb2, 0x78, 0x30, 0x9a, 0x0b, 0x6b, 0xa5, 0x75, 0xbc, 0x2a, 0xc7, 0x46, 0x64, 0x60, 0x69, 0x01,
+	0xda, 0x87, 0x54, 0xe3, 0x98, 0xb1, 0xe7, 0xb5, 0x83, 0x0d, 0xbe, 0xa9, 0x8b, 0x42, 0xba, 0x02,
+	0x94, 0x43, 0xb7, 0x23, 0xc3, 0x69, 0xec, 0x46, 0x38, 0x07, 0x45, 0x89, 0xb6, 0xa7, 0x15, 0x69,
+	0x2f, 0xd5, 0xc6, 0x80, 0x2d, 0xb5, 0xca, 0x50, 0x87, 0x84, 0x38, 0x4e, 0xd8, 0xa4, 0xd9, 0x58,
+	0xf4, 0x99, 0x05, 0x76, 0x9a, 0x94, 0xe0, 0x64, 0x01, 0x14, 0x8f, 0x97, 0xfa, 0xdf, 0x26, 0x15,
+	0xfc, 0x6b, 0x87, 0xfd, 0x33, 0xa9, 0x60, 0x57, 0x16, 0x70, 0xac, 0x02, 0x6a, 0xef, 0xf7, 0x8a,
+	0x40, 0x06, 0x7c, 0x4d, 0x2a, 0xfe, 0x82, 0x92, 0x87, 0xdc, 0x42, 0xaa, 0x55, 0x66, 0xaf, 0x36,
+	0x8a, 0x61, 0xc7, 0x2b, 0x86, 0x7f, 0x47, 0x8a, 0x61, 0xff, 0x3f, 0x8a, 0x39, 0x35, 0x09, 0xdb,
+	0x8d, 0x7e, 0xf9, 0x24, 0xbb, 0x58, 0x48, 0x35, 0x30, 0x80, 0xbb, 0x1e, 0xd0, 0xbc, 0xa5, 0xdb,
+	0x66, 0xa9, 0xff, 0x2f, 0x27, 0x50, 0x3d, 0xdf, 0x3c, 0xc1, 0xfe, 0xe9, 0xc4, 0xb6, 0x54, 0x09,
+	0x51, 0xf4, 0x89, 0x80, 0x67, 0x30, 0x92, 0x0a, 0xec, 0x5c, 0x6c, 0x9c, 0x33, 0x13, 0x3d, 0x1a,
+	0x59, 0x70, 0x7c, 0x64, 0x74, 0xe1, 0x61, 0x54, 0xc6, 0x80, 0x72, 0xcc, 0x13, 0x0d, 0x01, 0xc1,
+	0xa4, 0xb4, 0x0f, 0x87, 0x91, 0xc5, 0x56, 0xe9, 0xc4, 0xeb, 0x03, 0xc3, 0x90, 0x47, 0xc1, 0x4b,
+	0x61, 0x9d, 0x0f, 0xa8, 0x81, 0x1b, 0x7a, 0x10, 0x1a, 0x37, 0x64, 0x8c, 0x18, 0x6f, 0xea, 0x02,
+	0x5c, 0xff, 0x99, 0xdd, 0x98, 0x6f, 0x39, 0x2e, 0xad, 0x57, 0x22, 0x5e, 0x0b, 0xc2, 0x90, 0x6a,
+	0xbc, 0x5b, 0x51, 0xd8, 0xc7, 0x9f, 0x19, 0x38, 0x30, 0x88, 0x16, 0xef, 0x86, 0x09, 0xb0, 0x84,
+	0xf4, 0x82, 0x97, 0x10, 0xe2, 0x88, 0xfd, 0x06, 0x83, 0x1f, 0xec, 0x8b, 0xbc, 0x02, 0x9e, 0x55,
+	0x06, 0x89, 0x6f, 0xf5, 0x37, 0xb7, 0x82, 0xd6, 0xf1, 0xb6, 0xf0, 0x73, 0x7a, 0xc4, 0xaf, 0x73,
+	0xa9, 0x32, 0x99, 0x0a, 0x07, 0x96, 0x11, 0x78, 0xa5, 0x6b, 0xec, 0x76, 0xa2, 0xab, 0x3c, 0xe3,
+	0xc3, 0x1a, 0x70, 0xcc, 0xd8, 0xee, 0xec, 0x6a, 0xa8, 0x0c, 0x5d, 0x1d, 0x81, 0x93, 0xb4, 0x3e,
+	0x10, 0xf8, 0x73, 0xb2, 0x72, 0x98, 0x43, 0xb8, 0xb1, 0x7c, 0x44, 0xa8, 0xb7, 0x19, 0x78, 0xc7,
+	0x6c, 0xcb, 0xf1, 0x89, 0xb0, 0x24, 0x0d, 0x04, 0x21, 0xd4, 0xca, 0x87, 0x96, 0xd4, 0xf3, 0xf5,
+	0x36, 0x51, 0xaf, 0xf7, 0xfe, 0x2a, 0x6d, 0xa3, 0x00, 0xc8, 0xf8, 0x70, 0x4a, 0x2a, 0x58, 0xad,
+	0x03, 0xe0, 0x93, 0xab, 0xf3, 0xd0, 0x6c, 0xdb, 0xda, 0x5a, 0xd2, 0x1b, 0xa6, 0xc4, 0xae, 0x04,
+	0x95, 0x09, 0xe5, 0xbc, 0xad, 0x49, 0x52, 0xbc, 0x28, 0x50, 0x08, 0x97, 0x0a, 0xf9, 0x7d, 0xb8,
+	0x52, 0x74, 0xa9, 0xb2, 0x03, 0xe9, 0x26, 0x74, 0x1c, 0x95, 0x1a, 0x06, 0x53, 0x5c, 0xb3, 0x62,
+	0x04, 0x0e, 0x61, 0x18, 0xb0, 0x13, 0x9d, 0x67, 0x57, 0x79, 0x09, 0x46, 0xea, 0x6c, 0xfd, 0x08,
+	0x7c, 0xf2, 0x19, 0x1a, 0xc1, 0x54, 0x8a, 0x59, 0x25, 0x4a, 0x3b, 0xd1, 0x6e, 0x26, 0x8c, 0xee,
+	0x80, 0x66, 0x6f, 0x33, 0xab, 0x4f, 0x5d, 0x63, 0x9b, 0xf5, 0x91, 0xbd, 0xd6, 0x99, 0xc7, 0xc9,
+	0x4a, 0x31, 0x6f, 0xe6, 0xd1, 0x80, 0x5d, 0xa4, 0xe0, 0xea, 0xfd, 0x62, 0x90, 0x8a, 0x74, 0x02,
+	0x94, 0x4b, 0x9d, 0xea, 0x3f, 0x85, 0xbe, 0x11, 0xb3, 0x75, 0x8a, 0xb2, 0x44, 0xbe, 0x89, 0xb3,
+	0x1c, 0x94, 0x18, 0xe6, 0x60, 0xfd, 0xfd, 0xd5, 0xa3, 0x75, 0x1c, 0xd7, 0xa1, 0x03, 0x27, 0x2b,
+	0x72, 0x9e, 0x3a, 0xfa, 0xcf, 0x0e, 0x3b, 0x2b, 0x55, 0x06, 0x87, 0x03, 0xd8, 0x07, 0xe5, 0x6c,
+	0xf7, 0x34, 0x5f, 0x5c, 0x3b, 0xdd, 0xff, 0x1b, 0x0a, 0x4c, 0x7f, 0xd5, 0x61, 0x7f, 0xd1, 0xd9,
+	0xc2, 0xd9, 0x3b, 0x34, 0x39, 0xe7, 0x75, 0x16, 0xc8, 0xc4, 0xfd, 0x32, 0x9f, 0x79, 0x90, 0xd5,
+	0x14, 0xfc, 0x23, 0x34, 0xb6, 0x3b, 0x2d, 0xe1, 0xa3, 0xf1, 0x47, 0x84, 0x73, 0x46, 0x0e, 0x2b,
+	0x07, 0xcf, 0xc1, 0xf4, 0xa3, 0xeb, 0x2c, 0x98, 0x02, 0x25, 0x6c, 0x76, 0x76, 0xb4, 0x07, 0x68,
+	0xbc, 0x4e, 0x73, 0x82, 0x12, 0xf3, 0xad, 0x11, 0xc7, 0x20, 0x32, 0x5d, 0x27, 0x6f, 0x0c, 0x22,
+	0xea, 0x08, 0x43, 0x34, 0x64, 0xd0, 0x77, 0x0e, 0x45, 0x51, 0xe6, 0xb0, 0xc1, 0x5e, 0x5a, 0x2d,
+	0xc0, 0x5a, 0x31, 0x86, 0xd8, 0x82, 0xca, 0xc0, 0xac, 0xae, 0xf3, 0x66, 0xc4, 0x40, 0x2a, 0x4b,
+	0x09, 0xca, 0xad, 0x3e, 0x48, 0xce, 0xc8, 0xd9, 0x66, 0xa2, 0x5f, 0x59, 0x60, 0x67, 0x32, 0xa3,
+	0xcb, 0x7a, 0xe7, 0x8c, 0x76, 0xfe, 0xdf, 0xb4, 0xf3, 0x6f, 0x75, 0xd8, 0xbf, 0x75, 0x6e, 0x1b,
+	0x5d, 0x7e, 0xa7, 0x1b, 0xe7, 0xda, 0xb0, 0xd7, 0xd0, 0x43, 0x7d, 0x85, 0x19, 0xe0, 0x06, 0x0a,
+	0xbd, 0x0f, 0xd9, 0x2c, 0x52, 0x91, 0xb3, 0x07, 0x57, 0x04, 0xbb, 0xce, 0x27, 0xa0, 0x52, 0x60,
+	0x0a, 0xa4, 0x9b, 0x80, 0xa9, 0x77, 0x8f, 0xfe, 0xd3, 0x68, 0x4f, 0x69, 0xc3, 0x31, 0x6f, 0xf2,
+	0x19, 0xa0, 0x74, 0x96, 0xbb, 0x43, 0xe4, 0x50, 0xe5, 0xce, 0xce, 0xab, 0x29, 0xd5, 0x52, 0x0d,
+	0x6c, 0x89, 0xda, 0x58, 0xe7, 0x47, 0x95, 0xf6, 0x20, 0x61, 0x59, 0xb3, 0xe5, 0xe8, 0x63, 0x1d,
+	0xb6, 0x22, 0xc5, 0x7e, 0xee, 0x8d, 0x6e, 0x60, 0xe5, 0x43, 0xe8, 0x9e, 0xa1, 0xb0, 0xfc, 0x00,
+	0x35, 0xf4, 0x7e, 0xf6, 0xec, 0x96, 0xd8, 0xcf, 0xc9, 0x8a, 0x76, 0xe4, 0x43, 0xaf, 0x16, 0x52,
+	0x0f, 0xfe, 0x08, 0xb1, 0x0f, 0xd7, 0x73, 0x67, 0x00, 0xc8, 0x02, 0xfd, 0xa5, 0xa5, 0xaa, 0x62,
+	0x08, 0x06, 0x29, 0xf0, 0x96, 0xb2, 0x57, 0xe3, 0xe8, 0xdc, 0xd6, 0xad, 0xf7, 0xdd, 0x6d, 0x18,
+	0x25, 0xe7, 0x64, 0x9b, 0x6f, 0xf4, 0x85, 0x0e, 0x7b, 0x23, 0xe1, 0xc8, 0xa4, 0x45, 0xcb, 0x1e,
+	0x8c, 0x84, 0x75, 0xb8, 0xb4, 0x7b, 0x96, 0xfc, 0xc0, 0x21, 0x1a, 0xcd, 0xde, 0x8f, 0x4c, 0x6e,
+	0x7b, 0x92, 0x67, 0x84, 0x75, 0xcf, 0xeb, 0x6c, 0xe6, 0x0b, 0xda, 0xf0, 0xb0, 0xda, 0x1f, 0x21,
+	0xb2, 0x20, 0xf1, 0x7c, 0x04, 0xc2, 0x55, 0x86, 0x00, 0x23, 0x83, 0x98, 0xdd, 0xf6, 0xd9, 0x17,
+	0x97, 0x96, 0x8f, 0x44, 0x6e, 0x21, 0x8e, 0x2e, 0x1d, 0xc3, 0x39, 0xb9, 0x84, 0x90, 0x5a, 0x83,
+	0xc8, 0x2c, 0xfa, 0x4c, 0x87, 0x5d, 0x24, 0xa8, 0xb9, 0x78, 0x38, 0x1d, 0xe4, 0x5a, 0x60, 0x9c,
+	0xe9, 0x9e, 0x23, 0x98, 0x13, 0x84, 0x99, 0xb2, 0xfb, 0xc8, 0xec, 0xae, 0x78, 0x38, 0xbd, 0xeb,
+	0x67, 0x03, 0xc4, 0x6b, 0x01, 0x5e, 0x48, 0xa6, 0x1f, 0x4e, 0x79, 0x58, 0x8d, 0xc0, 0x48, 0x8b,
+	0x74, 0xbe, 0xc7, 0xc1, 0x5b, 0x39, 0xc2, 0x31, 0xa1, 0x53, 0x6b, 0x0d, 0x44, 0x7f, 0xb6, 0xc0,
+	0x9e, 0xc0, 0x64, 0xb5, 0x90, 0xd6, 0xc9, 0x74, 0x00, 0x87, 0x90, 0x56, 0x18, 0x14, 0xbb, 0xe7,
+	0x09, 0xd9, 0xa7, 0x17, 0x10, 0xda, 0x27, 0x17, 0xd8, 0x27, 0x16, 0xee, 0x35, 0x54, 0x77, 0x6a,
+	0xa2, 0x46, 0x87, 0x3e, 0xaa, 0xa9, 0x70, 0x8f, 0xf2, 0x86, 0x4b, 0x7d, 0xd6, 0xee, 0x90, 0xee,
+	0x38, 0xe1, 0xc3, 0xf4, 0x3a, 0x33, 0xd0, 0x0b, 0x34, 0x6a, 0xdc, 0x10, 0x60, 0x88, 0xcf, 0x65,
+	0xea, 0x7c, 0x12, 0xef, 0x26, 0x1c, 0x44, 0x3a, 0xe1, 0x3a, 0x58, 0x36, 0x91, 0xd1, 0x72, 0xae,
+	0x4d, 0x06, 0x26, 0x66, 0xf7, 0xf0, 0x5a, 0x6a, 0x46, 0x2d, 0x2f, 0x44, 0xd6, 0x18, 0xd7, 0xb6,
+	0x1d, 0xa3, 0x63, 0xa6, 0xb9, 0x40, 0xc3, 0xc7, 0x60, 0x4e, 0x97, 0x56, 0x0b, 0x63, 0x65, 0x21,
+	0xdc, 0x33, 0x78, 0x39, 0x31, 0xf4, 0x36, 0x0f, 0x09, 0xb2, 0x16, 0x59, 0x3e, 0x3d, 0x46, 0xad,
+	0xc9, 0x25, 0xfd, 0xa8, 0x2e, 0xa2, 0xcf, 0x76, 0xd8, 0xca, 0xcb, 0x15, 0x98, 0x29, 0x3d, 0x12,
+	0x73, 0x59, 0x48, 0xd7, 0x5d, 0x21, 0x9f, 0x28, 0x50, 0x87, 0x13, 0x36, 0x7a, 0x2f, 0xce, 0x3e,
+	0x2b, 0xec, 0x5d, 0x9c, 0x9b, 0xcf, 0x52, 0xc4, 0x61, 0xf3, 0x6c, 0x4c, 0xb5, 0xb2, 0x55, 0xe1,
+	0xbd, 0x58, 0x28, 0xef, 0xf1, 0xda, 0xf0, 0x71, 0x72, 0x7f, 0x93, 0x13, 0xff, 0x98, 0xcd, 0xdd,
+	0xf2, 0x05, 0x08, 0x65, 0x79, 0xa5, 0x48, 0x22, 0x64, 0x71, 0x72, 0xee, 0xe5, 0xb6, 0x9c, 0xe8,
+	0x8f, 0x3a, 0xac, 0xeb, 0x71, 0x15, 0xe2, 0x70, 0x50, 0xc7, 0x8e, 0xc1, 0x70, 0xea, 0xc0, 0x76,
+	0x2f, 0x10, 0xc0, 0x8f, 0x53, 0x5c, 0xfb, 0xe9, 0x0e, 0xfb, 0x49, 0x82, 0xb8, 0x2d, 0x0e, 0xeb,
+	0x9b, 0xa8, 0x8f, 0x54, 0xc7, 0x42, 0x25, 0x57, 0x96, 0x8a, 0x13, 0x9f, 0x5a, 0xed, 0x35, 0x77,
+	0xff, 0x14, 0x65, 0xc7, 0x60, 0xe7, 0xaf, 0x8d, 0xfd, 0x8d, 0x2f, 0x1f, 0x07, 0x20, 0xfa, 0xc2,
+	0x22, 0x5b, 0xf1, 0xb7, 0x9c, 0xc3, 0x9c, 0x75, 0x90, 0x49, 0xd3, 0xbd, 0x48, 0xaf, 0x98, 0xff,
+	0x21, 0x03, 0xfd, 0xaf, 0x05, 0xf6, 0xed, 0x05, 0xba, 0xb9, 0x76, 0x71, 0xfe, 0xb6, 0x34, 0x73,
+	0x98, 0x33, 0x89, 0xf7, 0xad, 0x36, 0xfe, 0xb8, 0x67, 0xd6, 0x49, 0xcc, 0x28, 0x5e, 0xa3, 0xa5,
+	0x85, 0xa7, 0x9d, 0x65, 0x07, 0x46, 0x3a, 0x07, 0x8a, 0x3b, 0xed, 0x1f, 0xb0, 0x21, 0x14, 0xda,
+	0xf5, 0x10, 0x37, 0xd7, 0xeb, 0xf8, 0xee, 0x2f, 0x7f, 0x4d, 0x81, 0x5a, 0x64, 0xd7, 0x70, 0x1d,
+	0x0a, 0x1b, 0x83, 0x75, 0xa4, 0x12, 0xe9, 0x2c, 0x73, 0x87, 0x36, 0xe6, 0xb7, 0xe5, 0x68, 0xd4,
+	0x98, 0x3a, 0x0a, 0x6d, 0xbb, 0x03, 0x66, 0x81, 0x59, 0x95, 0x86, 0xbc, 0xe7, 0x40, 0xf3, 0xa1,
+	0x54, 0xc2, 0x48, 0xb0, 0x7c, 0x02, 0x79, 0x69, 0xf9, 0x48, 0xfa, 0x9c, 0x04, 0x53, 0xa2, 0x54,
+	0x54, 0xb5, 0x76, 0xd1, 0x6c, 0xf9, 0x44, 0xd8, 0x09, 0x2f, 0xa4, 0x2d, 0x84, 0x4b, 0x27, 0x31,
+	0x27, 0x15, 0x90, 0x04, 0x5c, 0x60, 0x73, 0x7d, 0x60, 0x79, 0xc8, 0xe6, 0xc1, 0xc7, 0x34, 0x84,
+	0x2c, 0x2d, 0x29, 0xde, 0x31, 0x74, 0x89, 0x0c, 0x86, 0xd5, 0x78, 0x8c, 0xe4, 0x65, 0x65, 0x4a,
+	0x6d, 0xc1, 0xe7, 0x7b, 0x31, 0xbf, 0xa5, 0xfc, 0x3d, 0xcb, 0xad, 0xa3, 0x2c, 0xae, 0x09, 0x92,
+	0xc3, 0xb6, 0x90, 0x38, 0x39, 0x37, 0x6c, 0xeb, 0x3d, 0xfa, 0xf8, 0x22, 0x3b, 0x2f, 0xca, 0x72,
+	0x90, 0x0d, 0x07, 0x43, 0x91, 0xee, 0x81, 0xca, 0xba, 0x11, 0x9d, 0xd4, 0x37, 0xe8, 0xa4, 0xbe,
+	0xb6, 0xc0, 0xbe, 0xbc, 0x70, 0xab, 0x2c, 0x6f, 0xf7, 0xfb, 0x7e, 0x76, 0xfe, 0xa0, 0x84, 0x13,
+	0x43, 0x81, 0x9e, 0x19, 0x26, 0xdd, 0xb4, 0x04, 0xee, 0x34, 0x79, 0x2b, 0xa2, 0x0d, 0xee, 0xda,
+	0xe4, 0x92, 0x74, 0x02, 0x21, 0xe7, 0xb2, 0xfc, 0x76, 0xdf, 0xc6, 0xec, 0x28, 0xee, 0x26, 0x0b,
+	0x0e, 0x29, 0x3c, 0x3a, 0x71, 0x8e, 0xfc, 0x9b, 0xa4, 0x01, 0x33, 0xf3, 0x98, 0x3d, 0x23, 0x8d,
+	0x75, 0xb3, 0x49, 0x19, 0x10, 0x41, 0x69, 0x20, 0x15, 0x3e, 0x2a, 0x14, 0xa5, 0xcc, 0xa1, 0x47,
+	0x4f, 0x2c, 0x04, 0x66, 0xe3, 0xd9, 0x2e, 0xc8, 0xa8, 0x63, 0xb6, 0x43, 0x0f, 0xae, 0x19, 0x97,
+	0xb5, 0xf0, 0x7e, 0x3e, 0x4a, 0x2e, 0x72, 0xab, 0xb9, 0xb4, 0xea, 0x8a, 0xe3, 0x16, 0xdc, 0xd5,
+	0xf5, 0x46, 0xdc, 0xb0, 0x37, 0x6c, 0x73, 0xc4, 0x59, 0x2e, 0x55, 0x73, 0x9d, 0x5f, 0xa9, 0x93,
+	0xf4, 0x18, 0x2b, 0x55, 0x71, 0x74, 0xb6, 0xad, 0xca, 0xe4, 0xac, 0x28, 0xcb, 0xdb, 0xc3, 0xf0,
+	0x6b, 0xe3, 0xcd, 0xa8, 0xef, 0x27, 0x58, 0x84, 0xa5, 0x2c, 0xbe, 0xd9, 0xce, 0x9f, 0x57, 0x3f,
+	0x71, 0x8a, 0xad, 0x1c, 0x29, 0x72, 0x46, 0x09, 0x3b, 0x6b, 0xc1, 0xec, 0xcb, 0x14, 0x06, 0x4a,
+	0x14, 0x10, 0xea, 0x5b, 0xd7, 0x90, 0xc9, 0x93, 0x6c, 0xed, 0xbe, 0x81, 0x91, 0xc4, 0xdc, 0x82,
+	0x22, 0xf3, 0x1e, 0x4c, 0x29, 0xaf, 0xb5, 0x80, 0x79, 0xae, 0x03, 0x1e, 0x56, 0xda, 0x38, 0x39,
+	0x13, 0xfe, 0x7d, 0x5e, 0x14, 0x10, 0xfd, 0x75, 0x87, 0x2d, 0xfb, 0x3b, 0x22, 0xa3, 0x22, 0xd1,
+	0xa9, 0xfe, 0xef, 0x50, 0xa8, 0xf9, 0xad, 0x0e, 0xfb, 0x62, 0xe7, 0x8e, 0x9f, 0x98, 0xbb, 0x44,
+	0xda, 0x67, 0xdb, 0x14, 0x5d, 0xf9, 0x28, 0x64, 0xea, 0x22, 0x97, 0x6e, 0x1a, 0xf3, 0x17, 0x27,
+	0x50, 0x5f, 0x3d, 0xd9, 0x3a, 0x13, 0x8a, 0x4b, 0xd5, 0x2b, 0xa0, 0x40, 0xff, 0xb6, 0x52, 0xd1,
+	0xd1, 0x91, 0x7e, 0x03, 0x09, 0xba, 0x55, 0xa8, 0x9b, 0xc4, 0xfc, 0x5e, 0x09, 0x46, 0x38, 0x6d,
+	0x2c, 0x2f, 0xc4, 0x74, 0x8e, 0x8c, 0xf9, 0x9b, 0x06, 0x39, 0x58, 0xff, 0xe4, 0x13, 0x96, 0xdf,
+	0x37, 0xa8, 0xf8, 0x09, 0x54, 0x36, 0x4e, 0xea, 0x9d, 0x44, 0x1f, 0x62, 0x2b, 0xfe, 0xdf, 0xc1,
+	0x44, 0x5b, 0x47, 0xca, 0x5a, 0x9c, 0x4b, 0xbb, 0xfd, 0xce, 0x78, 0x49, 0x3a, 0x43, 0x1b, 0x1c,
+	0x8b, 0x6a, 0x0c, 0xfe, 0x44, 0xad, 0xd7, 0x60, 0xbd, 0x32, 0x4e, 0xce, 0x7b, 0x5e, 0xef, 0x0e,
+	0x03, 0xd1, 0x87, 0xd8, 0x1b, 0x8f, 0x70, 0x1f, 0xe4, 0x62, 0x08, 0xbe, 0x80, 0x72, 0xaa, 0xbf,
+	0x86, 0x32, 0xbe, 0x9f, 0xbd, 0x35, 0xc8, 0x10, 0x19, 0xc5, 0x86, 0x9a, 0x14, 0x0f, 0x85, 0xa8,
+	0x6d, 0x9c, 0x5c, 0x9a, 0x67, 0x7c, 0x17, 0x87, 0xa3, 0x0f, 0xb0, 0x27, 0x02, 0xf7, 0xfa, 0xb0,
+	0x3d, 0xf3, 0x13, 0xc4, 0xfc, 0x0a, 0x32, 0x5f, 0x65, 0x7c, 0x9e, 0x79, 0xa0, 0x6c, 0xf3, 0x8e,
+	0x3c, 0x93, 0x1d, 0x3f, 0xe3, 0x59, 0x7f, 0xb2, 0xc3, 0xde, 0x5c, 0x36, 0xfa, 0xc2, 0x47, 0x3b,
+	0x28, 0x3c, 0xb5, 0x59, 0x51, 0x63, 0xb1, 0xff, 0x5e, 0x14, 0x70, 0x97, 0xbd, 0x67, 0xa6, 0xd7,
+	0xa4, 0x26, 0xc3, 0x17, 0x3f, 0xe6, 0xbe, 0xa0, 0x78, 0xa9, 0xad, 0x74, 0x72, 0x1f, 0xd6, 0x1b,
+	0xdb, 0x10, 0xad, 0x93, 0xe0, 0x68, 0x14, 0x32, 0xb5, 0x74, 0x54, 0x71, 0xf2, 0xa6, 0xf2, 0x78,
+	0x5e, 0xd1, 0xc7, 0x16, 0xd8, 0xb9, 0x71, 0xae, 0x87, 0x22, 0xf7, 0x7b, 0xc4, 0xca, 0xc1, 0xe2,
+	0xda, 0x99, 0x9b, 0x6f, 0x7a, 0xb4, 0xd0, 0x4c, 0x1b, 0xe8, 0xff, 0x29, 0xd9, 0xe6, 0x1f, 0x76,
+	0xd8, 0xef, 0x77, 0x9e, 0xa5, 0x75, 0x34, 0x3c, 0xbb, 0xfe, 0x04, 0xf7, 0xec, 0xea, 0x2c, 0x1f,
+	0xb5, 0x7a, 0xcd, 0xbb, 0x29, 0x09, 0xe0, 0xae, 0x2a, 0x09, 0x6c, 0xa8, 0xa3, 0x39, 0xcd, 0x45,
+	0x9e, 0xb3, 0x1a, 0x2e, 0xf8, 0x7a, 0x02, 0xaf, 0x6c, 0x7d, 0x15, 0x1c, 0x18, 0x51, 0x96, 0x60,
+	0x1a, 0xfb, 0xae, 0x45, 0xf9, 0x62, 0x6d, 0x63, 0xfe, 0xa5, 0x48, 0xf7, 0x30, 0x15, 0x6f, 0x67,
+	0xeb, 0x2f, 0xad, 0xa6, 0x13, 0xac, 0x89, 0xc8, 0x0c, 0x93, 0x75, 0xbf, 0xa1, 0x49, 0x35, 0xec,
+	0xdd, 0x58, 0x7d, 0xf0, 0x20, 0x39, 0x3b, 0x6e, 0xc1, 0xdf, 0xb8, 0x8c, 0xbb, 0x7a, 0x33, 0x7b,
+	0x53, 0xe3, 0xf0, 0x47, 0x82, 0xc1, 0x0d, 0x76, 0xc2, 0x1f, 0x60, 0xc4, 0x96, 0x66, 0x9e, 0x9f,
+	0xd0, 0xff, 0xd1, 0x13, 0xec, 0x04, 0xed, 0xd0, 0xd7, 0x78, 0x13, 0xff, 0x63, 0xf5, 0x5b, 0xcb,
+	0xec, 0x74, 0xd3, 0xe9, 0x88, 0x12, 0x76, 0xd2, 0x9f, 0x13, 0xad, 0x3c, 0xd5, 0xdf, 0x40, 0x81,
+	0x6f, 0x63, 0x4f, 0x05, 0x2b, 0xaa, 0xd5, 0x17, 0x42, 0xe1, 0xad, 0xfb, 0x5b, 0x64, 0x51, 0x60,
+	0x5a, 0x75, 0x88, 0xe0, 0x5a, 0x71, 0x12, 0x38, 0x45, 0x05, 0x5b, 0xb6, 0x07, 0x62, 0x3c, 0x06,
+	0x13, 0x02, 0xc7, 0x0e, 0x32, 0x7d, 0x9e, 0xdd, 0xdd, 0xf1, 0xa3, 0x6d, 0xae, 0xb6, 0x1e, 0xd2,
+	0x69, 0x55, 0x80, 0x72, 0xa1, 0xcc, 0xe0, 0x99, 0x8b, 0xca, 0xe9, 0x42, 0x38, 0x99, 0x52, 0x01,
+	0x76, 0x08, 0xdc, 0xc0, 0x58, 0x5a, 0x07, 0x06, 0xa5, 0xd5, 0x32, 0xa2, 0x6d, 0xb6, 0x2c, 0xb2,
+	0xcc, 0x80, 0xb5, 0xa1, 0x3a, 0x5a, 0xbb, 0xf2, 0x2d, 0x3f, 0x3a, 0x77, 0x4b, 0xb5, 0x76, 0x80,
+	0x2e, 0x81, 0xdc, 0x14, 0xd7, 0x2a, 0x4e, 0x6a, 0x1e, 0xd1, 0x47, 0xd8, 0x13, 0x98, 0x6c, 0xe9,
+	0x12, 0xd4, 0x20, 0xd5, 0x4a, 0x81, 0x3f, 0x5e, 0x72, 0xe1, 0x73, 0xfd, 0x2d, 0xe4, 0x7d, 0x9b,
+	0xf5, 0xb7, 0xc5, 0xe1, 0xbd, 0x12, 0xd4, 0xe6, 0x8c, 0x60, 0x4e, 0xcc, 0xec, 0x31, 0x54, 0xe7,
+	0x5c, 0xc8, 0x8f, 0xb7, 0xf8, 0xc5, 0x49, 0x54, 0x3c, 0xc2, 0x23, 0xfa, 0xd9, 0x0e, 0xbb, 0x80,
+	0x8d, 0x22, 0xcc, 0x49, 0xc8, 0xf7, 0x74, 0xe5, 0xcb, 0xaa, 0xe7, 0xfa, 0x1f, 0x40, 0xc9, 0xbb,
+	0xac, 0x8f, 0x5d, 0x25, 0x10, 0xd9, 0xae, 0x9f, 0x9c, 0x93, 0xda, 0x3c, 0x1c, 0x31, 0x67, 0x43,
+	0x16, 0x3c, 0xb0, 0xa0, 0x07, 0x5a, 0x5d, 0x50, 0x8c, 0xa3, 0xf3, 0xf3, 0x3c, 0x92, 0xf3, 0xa6,
+	0x4c, 0x5b, 0xbf, 0xa3, 0x9f, 0xef, 0xb0, 0x8b, 0xd4, 0xae, 0x32, 0xd2, 0x41, 0x03, 0xe3, 0x24,
+	0xc1, 0xf8, 0x10, 0xc2, 0x78, 0x91, 0xdd, 0xc6, 0xb6, 0x14, 0x4e, 0xbf, 0x2e, 0x0e, 0x62, 0xf2,
+	0x18, 0x20, 0x2b, 0x47, 0xb8, 0x24, 0x2b, 0xd8, 0xe4, 0x6a, 0x0d, 0x44, 0x9f, 0xe8, 0xb0, 0x08,
+	0xa1, 0xe0, 0x91, 0x0c, 0x75, 0x36, 0x0d, 0xb9, 0xef, 0x32, 0x61, 0x09, 0x0f, 0xd6, 0x3b, 0xc9,
+	0xfd, 0xcd, 0x6d, 0x71, 0xd8, 0xd7, 0xd9, 0xf4, 0xd1, 0x9c, 0xb7, 0xc1, 0x52, 0x1f, 0x84, 0x81,
+	0x97, 0x2b, 0xb0, 0x8e, 0x23, 0x37, 0x82, 0x43, 0x1c, 0x03, 0x98, 0x36, 0x1b, 0x02, 0xd3, 0x1e,
+	0x88, 0x3e, 0xdf, 0x61, 0x21, 0x30, 0x67, 0x83, 0x4a, 0xe1, 0xcb, 0x64, 0x90, 0x6a, 0x63, 0x43,
+	0xe1, 0x46, 0x23, 0x9a, 0x0f, 0xb3, 0xf7, 0x79, 0xd7, 0x79, 0x81, 0xe6, 0x37, 0xef, 0x25, 0x3b,
+	0x6d, 0x73, 0xa7, 0xdf, 0x8f, 0xb8, 0x0e, 0x5f, 0xf3, 0xdc, 0x78, 0x8f, 0x92, 0x25, 0xe9, 0xb8,
+	0x70, 0x7c, 0xaa, 0x2b, 0xc3, 0x31, 0xff, 0x33, 0xd2, 0xee, 0x5d, 0x8d, 0xa3, 0x0b, 0x47, 0xf9,
+	0x26, 0x17, 0xc3, 0xf2, 0x30, 0xa4, 0x8d, 0xdd, 0xe8, 0x22, 0x86, 0x4b, 0xec, 0x22, 0x9a, 0xf9,
+	0x7c, 0xa4, 0xf8, 0xd4, 0x12, 0x63, 0xb3, 0x26, 0x65, 0xb4, 0x73, 0xc4, 0xef, 0x9f, 0xc6, 0x85,
+	0x6f, 0x67, 0x3f, 0x74, 0xbc, 0xdf, 0xd3, 0x23, 0xe0, 0xf5, 0x1d, 0x7f, 0x77, 0xe6, 0x89, 0xbe,
+	0xad, 0x54, 0x47, 0x93, 0xe3, 0x3c, 0xb1, 0xcd, 0x33, 0xac, 0xa2, 0x96, 0x8b, 0x54, 0x18, 0x7d,
+	0x5b, 0x0e, 0xf9, 0xb9, 0x0e, 0xbb, 0xe8, 0x9f, 0x3f, 0xe9, 0xfe, 0xa0, 0xb0, 0x63, 0x5f, 0xb2,
+	0xf0, 0xae, 0xae, 0x50, 0x80, 0x64, 0x63, 0x7a, 0x75, 0xa4, 0xfb, 0xdb, 0x76, 0x4c, 0x35, 0x8b,
+	0x23, 0x8f, 0x9e, 0xfa, 0x15, 0x70, 0xe4, 0xe1, 0xe3, 0x26, 0x50, 0x03, 0x48, 0x85, 0xe2, 0x06,
+	0x52, 0x90, 0xfb, 0x10, 0xb3, 0xdd, 0x09, 0xd4, 0x49, 0xc7, 0xac, 0x90, 0x7a, 0xe3, 0xfa, 0x76,
+	0x3f, 0x4e, 0xce, 0x17, 0x73, 0x82, 0xa2, 0xcf, 0x07, 0x6c, 0x16, 0x54, 0x36, 0xc3, 0x46, 0xed,
+	0x92, 0xba, 0x80, 0x51, 0x6c, 0x8b, 0xc3, 0x1d, 0x50, 0xd9, 0xff, 0x09, 0x1b, 0xf2, 0x7f, 0x0c,
+	0xb0, 0x42, 0xb8, 0x49, 0xbc, 0x2d, 0x0e, 0xb7, 0x94, 0x7b, 0xea, 0xa6, 0x47, 0xd8, 0x12, 0xd7,
+	0x64, 0x92, 0xa4, 0xee, 0x79, 0x93, 0xf8, 0xc5, 0x05, 0x76, 0x6e, 0xae, 0xe9, 0x1c, 0x7d, 0xbd,
+	0x73, 0xc4, 0x2c, 0xfe, 0x98, 0xae, 0xd5, 0x3f, 0xe8, 0xb0, 0xdf, 0xed, 0x04, 0xca, 0xc7, 0x1b,
+	0x48, 0xef, 0x00, 0x86, 0xc7, 0x58, 0x07, 0x7b, 0xfe, 0xde, 0xee, 0x9d, 0x0d, 0xa2, 0xf0, 0xbd,
+	0x4f, 0x4a, 0xdc, 0x66, 0x04, 0xeb, 0xbe, 0x4c, 0x70, 0x20, 0x2d, 0xac, 0xb7, 0xbb, 0x9c, 0x01,
+	0xa5, 0x6f, 0x12, 0x2b, 0xdd, 0xd3, 0x65, 0x9b, 0x55, 0xef, 0x45, 0x18, 0xa2, 0xb3, 0x04, 0x4d,
+	0x89, 0x02, 0x1a, 0x23, 0x12, 0x47, 0x23, 0x7d, 0x63, 0xa0, 0x1b, 0xdf, 0x8b, 0xbb, 0xe9, 0xb2,
+	0xef, 0x22, 0x30, 0xc8, 0x61, 0x5e, 0x21, 0xbf, 0xbd, 0xc4, 0x56, 0x8e, 0xf4, 0xcd, 0xa3, 0x5f,
+	0xed, 0xb0, 0x8b, 0xf5, 0x2b, 0x65, 0xd6, 0x10, 0xeb, 0xd0, 0xdb, 0xdb, 0x22, 0x3b, 0xc5, 0xf2,
+	0x7a, 0xbe, 0xe9, 0xf6, 0x35, 0xcd, 0x42, 0xdb, 0x2a, 0x7d, 0x34, 0x93, 0x4d, 0xa7, 0x2b, 0xd7,
+	0x29, 0xd2, 0x36, 0x25, 0xe8, 0xd6, 0x73, 0x48, 0x18, 0x60, 0x4e, 0xec, 0x81, 0xe2, 0x6b, 0xd7,
+	0xa9, 0x0a, 0xed, 0x5f, 0x70, 0x57, 0xe3, 0xe4, 0x42, 0x4d, 0xd4, 0xb4, 0xe1, 0x3e, 0xd7, 0x61,
+	0x4f, 0x34, 0x10, 0xdb, 0xcd, 0xc2, 0x05, 0x8a, 0x92, 0x63, 0x44, 0x39, 0x64, 0x3f, 0xde, 0xa0,
+	0x6c, 0xb5, 0x2b, 0x8f, 0x00, 0x9d, 0x5d, 0x5b, 0xf5, 0x74, 0x03, 0xc8, 0x69, 0x8e, 0xeb, 0xfc,
+	0xab, 0x0d, 0xd5, 0x1a, 0x80, 0xf9, 0xc1, 0x3c, 0xbf, 0x1a, 0x27, 0x51, 0x4d, 0x3d, 0xeb, 0x26,
+	0x6e, 0xbc, 0x42, 0x06, 0xf4, 0x77, 0x1d, 0xd6, 0x25, 0xc5, 0x72, 0xd4, 0xec, 0xbc, 0xd6, 0xa3,
+	0x2f, 0x75, 0x76, 0x8e, 0xd5, 0x00, 0x35, 0x65, 0x7d, 0x62, 0x4f, 0x15, 0x45, 0x94, 0x66, 0x44,
+	0x29, 0xb3, 0x7c, 0xca, 0x3f, 0xac, 0x43, 0x55, 0x49, 0x81, 0x3b, 0xd0, 0x66, 0x8f, 0x12, 0x73,
+	0xbc, 0x5f, 0x0c, 0x94, 0xb9, 0x98, 0x52, 0x5a, 0x1d, 0x5a, 0xce, 0x22, 0xf7, 0xbd, 0x21, 0xbb,
+	0xce, 0xa5, 0xb2, 0x0e, 0x6f, 0x45, 0x7c, 0x56, 0xd7, 0x75, 0x38, 0xdc, 0x0f, 0x26, 0x7d, 0xb4,
+	0x46, 0x34, 0xf2, 0xeb, 0xaa, 0xc8, 0xa3, 0xcd, 0x0f, 0x7a, 0x87, 0x8e, 0xe5, 0x3e, 0xa8, 0xd0,
+	0x92, 0x8a, 0x57, 0x7f, 0xa3, 0x83, 0x76, 0x33, 0xf7, 0xb9, 0x04, 0x7e, 0xf4, 0x22, 0x86, 0xa9,
+	0x0c, 0x1f, 0xbd, 0xfc, 0xc0, 0x31, 0x5f, 0x9b, 0xf4, 0x37, 0xb7, 0xee, 0x52, 0xfe, 0x01, 0xe6,
+	0xc8, 0x97, 0x23, 0x38, 0x95, 0xd0, 0xf2, 0x8d, 0x90, 0x3a, 0xad, 0x04, 0xed, 0xd5, 0x42, 0xa2,
+	0xa7, 0x9b, 0x7f, 0xbd, 0xa2, 0xec, 0x4c, 0x47, 0xfe, 0x3b, 0x8d, 0x80, 0xd6, 0x69, 0x0e, 0x87,
+	0x0e, 0x8c, 0x42, 0x43, 0x9b, 0x5a, 0x07, 0x85, 0x8d, 0x57, 0xbf, 0xbe, 0xc4, 0xa2, 0x47, 0xc5,
+	0x47, 0x5f, 0xeb, 0xb0, 0x25, 0x7c, 0x1d, 0x76, 0x3b, 0x54, 0x31, 0xff, 0x13, 0x3a, 0xba, 0x2f,
+	0x75, 0xd8, 0xef, 0x75, 0x90, 0x10, 0x35, 0xb2, 0xb7, 0x1f, 0x6a, 0x26, 0xcd, 0x23, 0xd2, 0xcb,
+	0x42, 0xcd, 0xef, 0x4b, 0x41, 0x9e, 0xe9, 0xc3, 0x54, 0x43, 0x46, 0x99, 0xb6, 0xe5, 0xdb, 0x2f,
+	0xec, 0xe0, 0x15, 0xec, 0x52, 0xdf, 0xad, 0x29, 0x74, 0x56, 0xe5, 0x70, 0xc5, 0xf2, 0x1d, 0xa4,
+	0x7b, 0x2e, 0x90, 0xcd, 0xd7, 0xb4, 0x45, 0x9a, 0x62, 0x7e, 0x3c, 0x14, 0x6a, 0x0f, 0xff, 0x8e,
+	0xf5, 0x3e, 0xfe, 0xb1, 0x4e, 0xec, 0x61, 0xc3, 0x1c, 0xcb, 0xdc, 0x52, 0xb9, 0xd5, 0x97, 0xd6,
+	0xe3, 0x38, 0x7e, 0xf0, 0x80, 0xbd, 0xb4, 0xfa, 0xe4, 0xea, 0x03, 0xbf, 0x5d, 0xac, 0x84, 0xa0,
+	0x56, 0x08, 0x65, 0x9c, 0xd0, 0x9e, 0xf0, 0x85, 0x73, 0xb2, 0xcc, 0xab, 0xb1, 0x54, 0xe1, 0x6e,
+	0xaa, 0x70, 0x77, 0x25, 0x53, 0x88, 0xd6, 0x4f, 0x10, 0x06, 0xdf, 0xeb, 0x1b, 0x69, 0x13, 0xf6,
+	0x86, 0x1a, 0x9e, 0x6d, 0x6d, 0xa6, 0xf5, 0xba, 0xb7, 0x56, 0x5f, 0xe1, 0x14, 0x08, 0xfd, 0x37,
+	0x2d, 0x16, 0x5c, 0xcc, 0x76, 0xaa, 0xb2, 0xd4, 0xc6, 0x41, 0x16, 0x98, 0xdb, 0x0d, 0x8e, 0x07,
+	0x9a, 0x04, 0x10, 0xd1, 0x4f, 0xb0, 0x0b, 0xd6, 0xe9, 0x72, 0x80, 0xc7, 0x36, 0xd0, 0x6a, 0x00,
+	0xc6, 0x84, 0x97, 0xe8, 0x0b, 0x08, 0xec, 0x3e, 0x7b, 0x1e, 0xa7, 0x7b, 0x38, 0xdd, 0xd3, 0xaa,
+	0x07, 0xc6, 0xb4, 0x7c, 0xf5, 0x60, 0x02, 0xe4, 0x17, 0x74, 0x02, 0xba, 0x9c, 0x95, 0x88, 0xb4,
+	0x6a, 0xee, 0x93, 0x0c, 0x72, 0x89, 0xdf, 0x17, 0x70, 0x30, 0x46, 0x9b, 0x38, 0x39, 0x87, 0x94,
+	0x58, 0xe3, 0xbe, 0xa7, 0xee, 0x18, 0xb3, 0xf1, 0x41, 0x94, 0xf2, 0x42, 0xb4, 0xd3, 0xec, 0x32,
+	0x46, 0x78, 0x47, 0xe2, 0xc1, 0x7c, 0x24, 0xae, 0x8b, 0x38, 0x68, 0x3a, 0xbc, 0xb6, 0x9d, 0x96,
+	0x9a, 0xc2, 0x5b, 0x33, 0x5e, 0xfd, 0xf2, 0x49, 0x76, 0x6e, 0xee, 0xfb, 0x9f, 0xe8, 0x8b, 0x8b,
+	0x6c, 0x19, 0xef, 0x49, 0x77, 0x58, 0x7f, 0x7c, 0xf3, 0x59, 0xfa, 0xe2, 0xe4, 0x97, 0x16, 0xd9,
+	0x2f, 0x2c, 0xee, 0x80, 0xa3, 0xc2, 0x71, 0x21, 0x0e, 0x7b, 0x58, 0x4c, 0x76, 0x9a, 0x5f, 0xf7,
+	0x05, 0x1e, 0x1f, 0x03, 0x50, 0xae, 0xa0, 0x06, 0x5f, 0xa5, 0x32, 0xc8, 0xb8, 0x28, 0x74, 0xe5,
+	0x5b, 0xd4, 0xad, 0xcf, 0x6b, 0x9a, 0x8e, 0x4d, 0xf8, 0xd2, 0x28, 0x66, 0x2d, 0xb6, 0x83, 0xc0,
+	0x56, 0xc1, 0x58, 0xe0, 0xe3, 0x94, 0xdf, 0xe0, 0x6b, 0xbd, 0x1b, 0x57, 0xbd, 0x90, 0xa6, 0x44,
+	0xdf, 0x66, 0x46, 0x5d, 0x1b, 0xdf, 0x4d, 0x96, 0xca, 0x02, 0x1d, 0xa4, 0x54, 0x4e, 0xbf, 0xae,
+	0x08, 0xd1, 0xbc, 0x80, 0xeb, 0x68, 0xba, 0xf6, 0x23, 0xfc, 0x7a, 0x10, 0x45, 0xb5, 0xd2, 0x23,
+	0x91, 0xf6, 0x35, 0xf6, 0xb0, 0x5e, 0x57, 0xb9, 0x67, 0xdf, 0x9e, 0xf8, 0xbd, 0x87, 0x1e, 0xea,
+	0xb1, 0xf7, 0x25, 0x59, 0xa5, 0x7f, 0xc6, 0x12, 0xa0, 0x9d, 0xdb, 0xcf, 0xf1, 0x61, 0x25, 0x73,
+	0xbc, 0x9d, 0x70, 0xbc, 0x67, 0x65, 0xd6, 0x48, 0x60, 0x12, 0xbd, 0xad, 0x79, 0x78, 0xd9, 0x38,
+	0x39, 0x59, 0x88, 0xc3, 0xdd, 0x43, 0x1b, 0xfd, 0xfb, 0x02, 0x5b, 0xc2, 0xca, 0x57, 0x77, 0x61,
+	0xbe, 0x02, 0xf8, 0xd5, 0x05, 0x6c, 0x7c, 0xb5, 0xc2, 0xe3, 0x1c, 0xbf, 0x0d, 0xd6, 0xe3, 0xa5,
+	0x91, 0xda, 0x48, 0x37, 0xed, 0x29, 0xad, 0x52, 0xd8, 0xa0, 0xfe, 0x80, 0x30, 0xe0, 0x4b, 0xff,
+	0xbe, 0xa4, 0x53, 0x93, 0x84, 0x2f, 0x99, 0x46, 0x00, 0x57, 0xa9, 0x75, 0x16, 0x1a, 0x25, 0x75,
+	0xcb, 0x81, 0x71, 0xee, 0x5b, 0x58, 0xcd, 0x47, 0x47, 0x9c, 0x9e, 0x0f, 0x2f, 0x57, 0xa0, 0x52,
+	0xa8, 0x7b, 0x09, 0xbd, 0x40, 0xd4, 0xc8, 0x6b, 0x71, 0x78, 0xfd, 0xf5, 0xeb, 0x81, 0xc4, 0x5f,
+	0xb9, 0x28, 0x30, 0x87, 0x14, 0x4f, 0xdb, 0x08, 0x95, 0xe9, 0x02, 0x9b, 0x08, 0x3d, 0x9f, 0x7e,
+	0x6c, 0x1c, 0xbb, 0x63, 0xea, 0x57, 0x7b, 0x0b, 0xca, 0xfc, 0xd7, 0x26, 0x61, 0xbb, 0x52, 0xa5,
+	0x79, 0x95, 0xd5, 0x1f, 0x6f, 0x05, 0x69, 0x8c, 0xd7, 0x9a, 0x9b, 0xbd, 0x4b, 0x82, 0x31, 0x25,
+	0xa4, 0xee, 0x0d, 0xff, 0xdd, 0x1f, 0x5b, 0x0e, 0xfe, 0xd3, 0xdf, 0xfa, 0xf3, 0x57, 0x2e, 0x77,
+	0xbe, 0xf2, 0xca, 0xe5, 0xce, 0x37, 0x5e, 0xb9, 0xdc, 0xf9, 0xd4, 0xab, 0x97, 0xdf, 0xf0, 0x95,
+	0x57, 0x2f, 0xbf, 0xe1, 0x6f, 0x5f, 0xbd, 0xfc, 0x86, 0x0f, 0xb6, 0xbf, 0x82, 0x6b, 0xbe, 0xc7,
+	0xc4, 0x3f, 0x3d, 0x9b, 0xed, 0x5d, 0x43, 0x66, 0xf5, 0x07, 0x9a, 0x36, 0x9d, 0x40, 0x21, 0x86,
+	0x27, 0xe9, 0xe3, 0xcc, 0xa7, 0xfe, 0x77, 0x00, 0x78, 0xca, 0x17, 0x7f, 0xec, 0x29, 0x00, 0x00,
+}
+
+func (m *AppConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mempool != nil {
+		{
+			size, err := m.Mempool.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Streaming != nil {
+		{
+			size, err := m.Streaming.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.StateSync != nil {
+		{
+			size, err := m.StateSync.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.GrpcWeb != nil {
+		{
+			size, err := m.GrpcWeb.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Grpc != nil {
+		{
+			size, err := m.Grpc.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Api != nil {
+		{
+			size, err := m.Api.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Telemetry != nil {
+		{
+			size, err := m.Telemetry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Base != nil {
+		{
+			size, err := m.Base.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BaseConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppDbBackend) > 0 {
+		i -= len(m.AppDbBackend)
+		copy(dAtA[i:], m.AppDbBackend)
+		i = encodeVarintApp(dAtA, i, uint64(len(m.AppDbBackend)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.BlockTraceDir) > 0 {
+		i -= len(m.BlockTraceDir)
+		copy(dAtA[i:], m.BlockTraceDir)
+		i = encodeVarintApp(dAtA, i, uint64(len(m.BlockTraceDir)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.QueryMaxResponseBytes != 0 {
+		i = encodeVarintApp(dAtA, i, uint64(m.QueryMaxResponseBytes))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.QueryGasLimit != 0 {
+		i = encodeVarintApp(dAtA, i, uint64(m.QueryGasLimit))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.OptimisticExecution {
+		i--
+		if m.OptimisticExecution {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.IavlLazyLoading {
+		i--
+		if m.IavlLazyLoading {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.IavlDisableFastnode {
+		i--
+		if m.IavlDisableFastnode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.IavlCacheSize != 0 {
+		i = encodeVarintApp(dAtA, i, uint64(m.IavlCacheSize))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.DropEvents) > 0 {
+		for iNdEx := len(m.DropEvents) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DropEvents[iNdEx])
+			copy(dAtA[i:], m.DropEvents[iNdEx])
+			i = encodeVarintApp(dAtA, i, uint64(len(m.DropEvents[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.IndexEvents) > 0 {
+		for iNdEx := len(m.IndexEvents) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IndexEvents[iNdEx])
+			copy(dAtA[i:], m.IndexEvents[iNdEx])
+			i = encodeVarintApp(dAtA, i, uint64(len(m.IndexEvents[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.InterBlockCache {
+		i--
+		if m.InterBlockCache {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MinRetainBlocks != 0 {
+		i = encodeVarintApp(dAtA, i, uint64(m.MinRetainBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.HaltTime != 0 {
+		i = encodeVarintApp(dAtA, i, uint64(m.HaltTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.HaltHeight != 0 {
+		i = encodeVarintApp(dAtA, i, uint64(m.HaltHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PruningInterval) > 0 {
+		i -= len(m.PruningInterval)
+		copy(dAtA[i:], m.PruningInterval)
+		i = encodeVarintApp(dAtA, i, uint64(len(m.PruningInterval)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PruningKeepRecent) > 0 {
+		i -= len(m.PruningKeepRecent)
+		copy(dAtA[i:], m.PruningKeepRecent)
+		i = encodeVarintApp(dAtA, i, uint64(len(m.PruningKeepRecent)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Pruning) > 0 {
+		i -= len(m.Pruning)
+		copy(dAtA[i:], m.Pruning)
+		i = encodeVarintApp(dAtA, i, uint64(len(m.Pruning)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MinimumGasPrices) > 0 {
+		i -= len(m.MinimumGasPrices)
+		copy(dAtA[i:], m.MinimumGasPrices)
+		i = encodeVarintApp(dAtA, i, uint64(len(m.MinimumGasPrices)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TelemetryConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TelemetryConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TelemetryConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GlobalLabels) > 0 {
+		for iNdEx := len(m.GlobalLabels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GlobalLabels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.PrometheusRetentionTime != 0 {
+		i = encodeVarintApp(dAtA, i, uint64(m.PrometheusRetentionTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EnableServiceLabel {
+		i--
+		if m.EnableServiceLabel {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EnableHostnameLabel {
+		i--
+		if m.EnableHostnameLabel {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EnableHostname {
+		i--
+		if m.EnableHostname {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ServiceName) > 0 {
+		i -= len(m.ServiceName)
+		copy(dAtA[i:], m.ServiceName)
+		i = encodeVarintApp(dAtA, i, uint64(len(m.ServiceName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Label) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Label) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Label) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintApp(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintApp(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *APIConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *APIConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *APIConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EnabledUnsafeCors {
+		i--
+		if m.EnabledUnsafeCors {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RpcMaxBodyBytes != 0 {
+		i = encodeVarintApp(dAtA, i, uint64(m.RpcMaxBodyBytes))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RpcWriteTimeout != 0 {
+		i = encodeVarintApp(dAtA, i, uint64(m.RpcWriteTimeout))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RpcReadTimeout != 0 {
+		i = encodeVarintApp(dAtA, i, uint64(m.RpcReadTimeout))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxOpenConnections != 0 {
+		i = encodeVarintApp(dAtA, i, uint64(m.MaxOpenConnections))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintApp(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Swagger {
+		i--
+		if m.Swagger {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enable {
+		i--
+		if m.Enable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GRPCConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GRPCConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GRPCConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxSendMsgSize) > 0 {
+		i -= len(m.MaxSendMsgSize)
+		copy(dAtA[i:], m.MaxSendMsgSize)
+		i = encodeVarintApp(dAtA, i, uint64(len(m.MaxSendMsgSize)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MaxRecvMsgSize) > 0 {
+		i -= len(m.MaxRecvMsgSize)
+		copy(dAtA[i:], m.MaxRecvMsgSize)
+		i = encodeVarintApp(dAtA, i, uint64(len(m.MaxRecvMsgSize)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintApp(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enable {
+		i--
+		if m.Enable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GRPCWebConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GRPCWebConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GRPCWebConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enable {
+		i--
+		if m.Enable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateSyncConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateSyncConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateSyncConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SnapshotKeepRecent != 0 {
+		i = encodeVarintApp(dAtA, i, uint64(m.SnapshotKeepRecent))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SnapshotInterval != 0 {
+		i = encodeVarintApp(dAtA, i, uint64(m.SnapshotInterval))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamingConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamingConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamingConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Abci != nil {
+		{
+			size, err := m.Abci.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ABCIListenerConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ABCIListenerConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ABCIListenerConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StopNodeOnErr {
+		i--
+		if m.StopNodeOnErr {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Plugin) > 0 {
+		i -= len(m.Plugin)
+		copy(dAtA[i:], m.Plugin)
+		i = encodeVarintApp(dAtA, i, uint64(len(m.Plugin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintApp(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MempoolConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MempoolConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MempoolConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintApp(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MaxTxs) > 0 {
+		i -= len(m.MaxTxs)
+		copy(dAtA[i:], m.MaxTxs)
+		i = encodeVarintApp(dAtA, i, uint64(len(m.MaxTxs)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintApp(dAtA []byte, offset int, v uint64) int {
+	offset -= sovApp(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AppConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Base != nil {
+		l = m.Base.Size()
+		n += 1 + l + sovApp(uint64(l))
+	}
+	if m.Telemetry != nil {
+		l = m.Telemetry.Size()
+		n += 1 + l + sovApp(uint64(l))
+	}
+	if m.Api != nil {
+		l = m.Api.Size()
+		n += 1 + l + sovApp(uint64(l))
+	}
+	if m.Grpc != nil {
+		l = m.Grpc.Size()
+		n += 1 + l + sovApp(uint64(l))
+	}
+	if m.GrpcWeb != nil {
+		l = m.GrpcWeb.Size()
+		n += 1 + l + sovApp(uint64(l))
+	}
+	if m.StateSync != nil {
+		l = m.StateSync.Size()
+		n += 1 + l + sovApp(uint64(l))
+	}
+	if m.Streaming != nil {
+		l = m.Streaming.Size()
+		n += 1 + l + sovApp(uint64(l))
+	}
+	if m.Mempool != nil {
+		l = m.Mempool.Size()
+		n += 1 + l + sovApp(uint64(l))
+	}
+	return n
+}
+
+func (m *BaseConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MinimumGasPrices)
+	if l > 0 {
+		n += 1 + l + sovApp(uint64(l))
+	}
+	l = len(m.Pruning)
+	if l > 0 {
+		n += 1 + l + sovApp(uint64(l))
+	}
+	l = len(m.PruningKeepRecent)
+	if l > 0 {
+		n += 1 + l + sovApp(uint64(l))
+	}
+	l = len(m.PruningInterval)
+	if l > 0 {
+		n += 1 + l + sovApp(uint64(l))
+	}
+	if m.HaltHeight != 0 {
+		n += 1 + sovApp(uint64(m.HaltHeight))
+	}
+	if m.HaltTime != 0 {
+		n += 1 + sovApp(uint64(m.HaltTime))
+	}
+	if m.MinRetainBlocks != 0 {
+		n += 1 + sovApp(uint64(m.MinRetainBlocks))
+	}
+	if m.InterBlockCache {
+		n += 2
+	}
+	if len(m.IndexEvents) > 0 {
+		for _, s := range m.IndexEvents {
+			l = len(s)
+			n += 1 + l + sovApp(uint64(l))
+		}
+	}
+	if len(m.DropEvents) > 0 {
+		for _, s := range m.DropEvents {
+			l = len(s)
+			n += 1 + l + sovApp(uint64(l))
+		}
+	}
+	if m.IavlCacheSize != 0 {
+		n += 1 + sovApp(uint64(m.IavlCacheSize))
+	}
+	if m.IavlDisableFastnode {
+		n += 2
+	}
+	if m.IavlLazyLoading {
+		n += 2
+	}
+	if m.OptimisticExecution {
+		n += 2
+	}
+	if m.QueryGasLimit != 0 {
+		n += 1 + sovApp(uint64(m.QueryGasLimit))
+	}
+	if m.QueryMaxResponseBytes != 0 {
+		n += 2 + sovApp(uint64(m.QueryMaxResponseBytes))
+	}
+	l = len(m.BlockTraceDir)
+	if l > 0 {
+		n += 2 + l + sovApp(uint64(l))
+	}
+	l = len(m.AppDbBackend)
+	if l > 0 {
+		n += 2 + l + sovApp(uint64(l))
+	}
+	return n
+}
+
+func (m *TelemetryConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServiceName)
+	if l > 0 {
+		n += 1 + l + sovApp(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.EnableHostname {
+		n += 2
+	}
+	if m.EnableHostnameLabel {
+		n += 2
+	}
+	if m.EnableServiceLabel {
+		n += 2
+	}
+	if m.PrometheusRetentionTime != 0 {
+		n += 1 + sovApp(uint64(m.PrometheusRetentionTime))
+	}
+	if len(m.GlobalLabels) > 0 {
+		for _, e := range m.GlobalLabels {
+			l = e.Size()
+			n += 1 + l + sovApp(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Label) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApp(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovApp(uint64(l))
+	}
+	return n
+}
+
+func (m *APIConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enable {
+		n += 2
+	}
+	if m.Swagger {
+		n += 2
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovApp(uint64(l))
+	}
+	if m.MaxOpenConnections != 0 {
+		n += 1 + sovApp(uint64(m.MaxOpenConnections))
+	}
+	if m.RpcReadTimeout != 0 {
+		n += 1 + sovApp(uint64(m.RpcReadTimeout))
+	}
+	if m.RpcWriteTimeout != 0 {
+		n += 1 + sovApp(uint64(m.RpcWriteTimeout))
+	}
+	if m.RpcMaxBodyBytes != 0 {
+		n += 1 + sovApp(uint64(m.RpcMaxBodyBytes))
+	}
+	if m.EnabledUnsafeCors {
+		n += 2
+	}
+	return n
+}
+
+func (m *GRPCConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enable {
+		n += 2
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovApp(uint64(l))
+	}
+	l = len(m.MaxRecvMsgSize)
+	if l > 0 {
+		n += 1 + l + sovApp(uint64(l))
+	}
+	l = len(m.MaxSendMsgSize)
+	if l > 0 {
+		n += 1 + l + sovApp(uint64(l))
+	}
+	return n
+}
+
+func (m *GRPCWebConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enable {
+		n += 2
+	}
+	return n
+}
+
+func (m *StateSyncConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SnapshotInterval != 0 {
+		n += 1 + sovApp(uint64(m.SnapshotInterval))
+	}
+	if m.SnapshotKeepRecent != 0 {
+		n += 1 + sovApp(uint64(m.SnapshotKeepRecent))
+	}
+	return n
+}
+
+func (m *StreamingConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Abci != nil {
+		l = m.Abci.Size()
+		n += 1 + l + sovApp(uint64(l))
+	}
+	return n
+}
+
+func (m *ABCIListenerConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovApp(uint64(l))
+		}
+	}
+	l = len(m.Plugin)
+	if l > 0 {
+		n += 1 + l + sovApp(uint64(l))
+	}
+	if m.StopNodeOnErr {
+		n += 2
+	}
+	return n
+}
+
+func (m *MempoolConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MaxTxs)
+	if l > 0 {
+		n += 1 + l + sovApp(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovApp(uint64(l))
+	}
+	return n
+}
+
+func sovApp(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozApp(x uint64) (n int) {
+	return sovApp(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AppConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Base == nil {
+				m.Base = &BaseConfig{}
+			}
+			if err := m.Base.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Telemetry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Telemetry == nil {
+				m.Telemetry = &TelemetryConfig{}
+			}
+			if err := m.Telemetry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Api", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Api == nil {
+				m.Api = &APIConfig{}
+			}
+			if err := m.Api.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grpc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Grpc == nil {
+				m.Grpc = &GRPCConfig{}
+			}
+			if err := m.Grpc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrpcWeb", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GrpcWeb == nil {
+				m.GrpcWeb = &GRPCWebConfig{}
+			}
+			if err := m.GrpcWeb.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateSync", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StateSync == nil {
+				m.StateSync = &StateSyncConfig{}
+			}
+			if err := m.StateSync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Streaming", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Streaming == nil {
+				m.Streaming = &StreamingConfig{}
+			}
+			if err := m.Streaming.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mempool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mempool == nil {
+				m.Mempool = &MempoolConfig{}
+			}
+			if err := m.Mempool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BaseConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumGasPrices = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pruning", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pruning = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningKeepRecent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PruningKeepRecent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningInterval", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PruningInterval = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltHeight", wireType)
+			}
+			m.HaltHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HaltHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltTime", wireType)
+			}
+			m.HaltTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HaltTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRetainBlocks", wireType)
+			}
+			m.MinRetainBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinRetainBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterBlockCache", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InterBlockCache = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexEvents", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexEvents = append(m.IndexEvents, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropEvents", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DropEvents = append(m.DropEvents, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IavlCacheSize", wireType)
+			}
+			m.IavlCacheSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IavlCacheSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IavlDisableFastnode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IavlDisableFastnode = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IavlLazyLoading", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IavlLazyLoading = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticExecution", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptimisticExecution = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryGasLimit", wireType)
+			}
+			m.QueryGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryMaxResponseBytes", wireType)
+			}
+			m.QueryMaxResponseBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryMaxResponseBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTraceDir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockTraceDir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppDbBackend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppDbBackend = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TelemetryConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TelemetryConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TelemetryConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableHostname", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableHostname = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableHostnameLabel", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableHostnameLabel = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableServiceLabel", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableServiceLabel = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrometheusRetentionTime", wireType)
+			}
+			m.PrometheusRetentionTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrometheusRetentionTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GlobalLabels = append(m.GlobalLabels, &Label{})
+			if err := m.GlobalLabels[len(m.GlobalLabels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Label) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Label: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Label: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *APIConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: APIConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: APIConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enable = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Swagger", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Swagger = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenConnections", wireType)
+			}
+			m.MaxOpenConnections = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOpenConnections |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RpcReadTimeout", wireType)
+			}
+			m.RpcReadTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RpcReadTimeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RpcWriteTimeout", wireType)
+			}
+			m.RpcWriteTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RpcWriteTimeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RpcMaxBodyBytes", wireType)
+			}
+			m.RpcMaxBodyBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RpcMaxBodyBytes |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnabledUnsafeCors", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnabledUnsafeCors = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GRPCConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GRPCConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GRPCConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enable = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecvMsgSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxRecvMsgSize = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSendMsgSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxSendMsgSize = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GRPCWebConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GRPCWebConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GRPCWebConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enable = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateSyncConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateSyncConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateSyncConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotInterval", wireType)
+			}
+			m.SnapshotInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotKeepRecent", wireType)
+			}
+			m.SnapshotKeepRecent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotKeepRecent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamingConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamingConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamingConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abci", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Abci == nil {
+				m.Abci = &ABCIListenerConfig{}
+			}
+			if err := m.Abci.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ABCIListenerConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ABCIListenerConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ABCIListenerConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plugin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopNodeOnErr", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StopNodeOnErr = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MempoolConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MempoolConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MempoolConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxTxs = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipApp(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowApp
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowApp
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthApp
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupApp
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthApp
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthApp        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowApp          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupApp = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,661 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/config/v1/client.proto
+
+package configschema
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ClientConfig is the schema of the client.toml file.
+type ClientConfig struct {
+	// chain_id is the network chain ID.
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// keyring_backend is the backend of the keyring.
+	KeyringBackend string `protobuf:"bytes,2,opt,name=keyring_backend,json=keyringBackend,proto3" json:"keyring_backend,omitempty"`
+	// output is the CLI output format.
+	Output string `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
+	// node is the CometBFT RPC endpoint.
+	Node string `protobuf:"bytes,4,opt,name=node,proto3" json:"node,omitempty"`
+	// broadcast_mode is the transaction broadcasting mode.
+	BroadcastMode string `protobuf:"bytes,5,opt,name=broadcast_mode,json=broadcastMode,proto3" json:"broadcast_mode,omitempty"`
+	// fee_granter is the default fee granter.
+	FeeGranter string `protobuf:"bytes,6,opt,name=fee_granter,json=feeGranter,proto3" json:"fee_granter,omitempty"`
+	// fee_payer is the default fee payer.
+	FeePayer string `protobuf:"bytes,7,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+}
+
+func (m *ClientConfig) Reset()         { *m = ClientConfig{} }
+func (m *ClientConfig) String() string { return proto.CompactTextString(m) }
+func (*ClientConfig) ProtoMessage()    {}
+func (*ClientConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e4072058befa8b9, []int{0}
+}
+func (m *ClientConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientConfig.Merge(m, src)
+}
+func (m *ClientConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientConfig proto.InternalMessageInfo
+
+func (m *ClientConfig) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ClientConfig) GetKeyringBackend() string {
+	if m != nil {
+		return m.KeyringBackend
+	}
+	return ""
+}
+
+func (m *ClientConfig) GetOutput() string {
+	if m != nil {
+		return m.Output
+	}
+	return ""
+}
+
+func (m *ClientConfig) GetNode() string {
+	if m != nil {
+		return m.Node
+	}
+	return ""
+}
+
+func (m *ClientConfig) GetBroadcastMode() string {
+	if m != nil {
+		return m.BroadcastMode
+	}
+	return ""
+}
+
+func (m *ClientConfig) GetFeeGranter() string {
+	if m != nil {
+		return m.FeeGranter
+	}
+	return ""
+}
+
+func (m *ClientConfig) GetFeePayer() string {
+	if m != nil {
+		return m.FeePayer
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ClientConfig)(nil), "cosmos.config.v1.ClientConfig")
+}
+
+func init() { proto.RegisterFile("cosmos/config/v1/client.proto", fileDescriptor_3e4072058befa8b9) }
+
+var fileDescriptor_3e4072058befa8b9 = []byte{
+	// 677 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x5f, 0x4b, 0x33, 0x47,
+	0x14, 0xc6, 0x5d, 0x1b, 0x13, 0x9d, 0xb6, 0xb6, 0x2c, 0x5e, 0x84, 0x82, 0xe9, 0x61, 0x29, 0x74,
+	0x15, 0x4d, 0xb0, 0x85, 0x5e, 0xa4, 0xa2, 0x25, 0x11, 0x25, 0x45, 0xab, 0xac, 0x81, 0x42, 0x6f,
+	0xc2, 0x64, 0xf7, 0xec, 0xee, 0x34, 0xd9, 0x99, 0x75, 0xe6, 0x44, 0x0d, 0x84, 0xde, 0xf4, 0x0b,
+	0xf4, 0x33, 0xf4, 0xbe, 0xd0, 0x8f, 0xd1, 0x4b, 0x2f, 0x7b, 0x59, 0x94, 0xc2, 0xfb, 0x31, 0x5e,
+	0x66, 0x36, 0xaf, 0x0a, 0xef, 0xc5, 0x7b, 0xb3, 0xf3, 0xe7, 0x3c, 0xe7, 0xf7, 0x1c, 0xe6, 0xec,
+	0x61, 0xdb, 0xb1, 0x32, 0x85, 0x32, 0x9d, 0x58, 0xc9, 0x54, 0x64, 0x9d, 0xdb, 0x83, 0x4e, 0x3c,
+	0x15, 0x28, 0xa9, 0x5d, 0x6a, 0x45, 0xca, 0xff, 0xbc, 0x0a, 0xb7, 0xab, 0x70, 0xfb, 0xf6, 0xe0,
+	0x8b, 0xd6, 0x7b, 0x09, 0xaa, 0x24, 0xa1, 0xa4, 0xa9, 0x32, 0x82, 0xff, 0xeb, 0xec, 0x93, 0xbe,
+	0x43, 0xf4, 0x9d, 0xc2, 0x3f, 0x66, 0xeb, 0x71, 0xce, 0x85, 0x1c, 0x89, 0xa4, 0xe9, 0x81, 0x17,
+	0x6e, 0xf4, 0xbe, 0xfa, 0xf3, 0xcd, 0xdf, 0xbb, 0x5f, 0xb2, 0xad, 0x61, 0x8e, 0x20, 0x91, 0xee,
+	0x94, 0x9e, 0x80, 0x93, 0xc0, 0xe0, 0xc4, 0x6f, 0xf4, 0xed, 0x6e, 0x70, 0x12, 0x35, 0xdc, 0xd5,
+	0x20, 0xf1, 0xff, 0xf2, 0xd8, 0x67, 0x13, 0x9c, 0x6b, 0x21, 0xb3, 0xd1, 0x98, 0xc7, 0x13, 0x94,
+	0x49, 0x73, 0xd5, 0x81, 0x7e, 0xf7, 0x2c, 0xe9, 0x37, 0x76, 0x6d, 0x49, 0x4b, 0xc9, 0xd7, 0x06,
+	0x96, 0xa2, 0x3d, 0xb8, 0xcb, 0x51, 0x23, 0x50, 0x15, 0x34, 0xc0, 0x35, 0x82, 0x21, 0xa5, 0x31,
+	0x81, 0x50, 0x99, 0x45, 0x2a, 0xa6, 0xb8, 0x98, 0xdc, 0xf1, 0xe9, 0x14, 0x69, 0x51, 0x72, 0x63,
+	0x16, 0x84, 0x86, 0x16, 0x05, 0x16, 0x4a, 0xcf, 0x77, 0x82, 0x55, 0x65, 0x82, 0x9a, 0xd5, 0x04,
+	0x8d, 0xa5, 0x28, 0xa8, 0x59, 0x55, 0x50, 0xb3, 0xb2, 0xa0, 0x5e, 0xe9, 0x42, 0x2f, 0xda, 0x5c,
+	0x3a, 0xf7, 0x2a, 0x5b, 0xff, 0x47, 0x56, 0x57, 0x33, 0x2a, 0x67, 0xd4, 0xfc, 0xc8, 0x55, 0xf9,
+	0x8d, 0x2d, 0x72, 0x9f, 0x6d, 0xf7, 0xcf, 0x07, 0x50, 0x05, 0x20, 0x55, 0xba, 0xe0, 0x04, 0x21,
+	0xe1, 0x3d, 0x2d, 0x7e, 0x35, 0x4a, 0xee, 0x58, 0xea, 0x3d, 0x05, 0x35, 0x7b, 0x08, 0xbd, 0x68,
+	0x49, 0xf0, 0xaf, 0x59, 0x4d, 0xaa, 0x04, 0x9b, 0x35, 0x47, 0x3a, 0xb6, 0xa4, 0x2e, 0xfb, 0xee,
+	0x30, 0x57, 0x86, 0x8e, 0xba, 0x87, 0xa5, 0xd2, 0x74, 0x04, 0xa4, 0xa0, 0xaf, 0x0a, 0xa4, 0xde,
+	0xe9, 0x10, 0xa2, 0xab, 0x3e, 0x08, 0x49, 0xa8, 0x53, 0x1e, 0xa3, 0xb5, 0x01, 0xca, 0x85, 0xa9,
+	0x5e, 0x37, 0xf4, 0x22, 0x07, 0xf3, 0x33, 0xb6, 0x39, 0xd6, 0x8a, 0x27, 0x31, 0x37, 0x34, 0x2a,
+	0x2c, 0x7e, 0xcd, 0xe1, 0x7f, 0xb0, 0xf8, 0xef, 0xd9, 0xee, 0x50, 0x73, 0x69, 0x78, 0x6c, 0xbb,
+	0x0a, 0xcf, 0x42, 0x21, 0x33, 0xb0, 0x5a, 0x08, 0xcd, 0x5c, 0xc6, 0x0b, 0x6e, 0xbf, 0x3b, 0x41,
+	0xcd, 0x2e, 0xc1, 0x9a, 0x3b, 0x85, 0x5e, 0xf4, 0xe9, 0xb3, 0xfc, 0xc2, 0x1a, 0x29, 0xf6, 0x71,
+	0x8a, 0x38, 0xca, 0x34, 0xb7, 0x25, 0x35, 0xeb, 0xce, 0xe5, 0x27, 0xeb, 0x32, 0x60, 0x67, 0x27,
+	0x98, 0xf2, 0xd9, 0x94, 0x20, 0x45, 0x84, 0xa5, 0x02, 0x4a, 0x3e, 0xb7, 0x3e, 0xb6, 0x63, 0x29,
+	0xa2, 0x01, 0x95, 0x02, 0xbd, 0x14, 0x63, 0x80, 0x72, 0xad, 0x66, 0x59, 0x0e, 0xfc, 0x25, 0x2b,
+	0x62, 0x29, 0xe2, 0x59, 0x95, 0xef, 0xdf, 0xb0, 0x0d, 0x6b, 0x58, 0xf2, 0x39, 0xea, 0x66, 0xc3,
+	0xd9, 0x0d, 0xad, 0xdd, 0x25, 0xbb, 0x78, 0x6d, 0xe7, 0xe2, 0x1f, 0x34, 0x13, 0xd2, 0x10, 0xf2,
+	0xc4, 0xdd, 0x5b, 0x8d, 0xd0, 0x86, 0xc0, 0x88, 0x4c, 0xa2, 0x8e, 0xd6, 0x53, 0xc4, 0x2b, 0x4b,
+	0xe9, 0xde, 0x5a, 0xfe, 0x8d, 0xff, 0xf3, 0xd0, 0xbe, 0xb3, 0x30, 0xc0, 0x61, 0x78, 0x79, 0x71,
+	0x0e, 0xd5, 0x78, 0x80, 0xfd, 0x7f, 0xda, 0xec, 0x54, 0x69, 0x28, 0x94, 0x46, 0x10, 0xb2, 0xea,
+	0xbc, 0x50, 0x72, 0x0f, 0x0c, 0x22, 0xe4, 0x44, 0xa5, 0xe9, 0x76, 0x3a, 0x99, 0xa0, 0x7c, 0x36,
+	0x6e, 0xc7, 0xaa, 0xe8, 0x90, 0x2a, 0xa6, 0xfb, 0x53, 0x2e, 0x33, 0xb7, 0x63, 0x5b, 0xd5, 0x30,
+	0x41, 0x35, 0x4d, 0x33, 0xed, 0x92, 0x9b, 0x5e, 0x6f, 0xf0, 0xcf, 0x63, 0xcb, 0x7b, 0x78, 0x6c,
+	0x79, 0xff, 0x3d, 0xb6, 0xbc, 0x3f, 0x9e, 0x5a, 0x2b, 0x0f, 0x4f, 0xad, 0x95, 0x7f, 0x9f, 0x5a,
+	0x2b, 0xbf, 0xbc, 0x86, 0x3d, 0x0f, 0xab, 0x5d, 0xf6, 0x4d, 0x32, 0xe9, 0xd0, 0xbc, 0xc4, 0x77,
+	0xd3, 0x6b, 0xe2, 0x1c, 0x0b, 0x3e, 0xae, 0xbb, 0xc9, 0xfd, 0xf6, 0xed, 0x00, 0x5a, 0xd5, 0x2d,
+	0xc8, 0x0c, 0x04, 0x00, 0x00,
+}
+
+func (m *ClientConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.FeeGranter) > 0 {
+		i -= len(m.FeeGranter)
+		copy(dAtA[i:], m.FeeGranter)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.FeeGranter)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.BroadcastMode) > 0 {
+		i -= len(m.BroadcastMode)
+		copy(dAtA[i:], m.BroadcastMode)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.BroadcastMode)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Node) > 0 {
+		i -= len(m.Node)
+		copy(dAtA[i:], m.Node)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.Node)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Output) > 0 {
+		i -= len(m.Output)
+		copy(dAtA[i:], m.Output)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.Output)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.KeyringBackend) > 0 {
+		i -= len(m.KeyringBackend)
+		copy(dAtA[i:], m.KeyringBackend)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.KeyringBackend)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintClient(dAtA []byte, offset int, v uint64) int {
+	offset -= sovClient(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClientConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.KeyringBackend)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.Output)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.Node)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.BroadcastMode)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.FeeGranter)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	return n
+}
+
+func sovClient(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozClient(x uint64) (n int) {
+	return sovClient(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClientConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyringBackend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyringBackend = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Output = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Node = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BroadcastMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BroadcastMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGranter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeGranter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipClient(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthClient
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupClient
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthClient
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthClient        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowClient          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupClient = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,815 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/config/v1/options.proto
+
+package configschema
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SectionOptions describes how a config message is written to a TOML file.
+// The fields of a message are written to the TOML table named after the
+// field of the parent message holding it, e.g. [api].
+type SectionOptions struct {
+	// title is written in a banner before the section.
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// comment is written before the section, one comment line per line.
+	Comment string `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	// compact omits the blank lines between the fields of the section.
+	Compact bool `protobuf:"varint,3,opt,name=compact,proto3" json:"compact,omitempty"`
+}
+
+func (m *SectionOptions) Reset()         { *m = SectionOptions{} }
+func (m *SectionOptions) String() string { return proto.CompactTextString(m) }
+func (*SectionOptions) ProtoMessage()    {}
+func (*SectionOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8349d04ef88c9689, []int{0}
+}
+func (m *SectionOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SectionOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SectionOptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SectionOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SectionOptions.Merge(m, src)
+}
+func (m *SectionOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *SectionOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_SectionOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SectionOptions proto.InternalMessageInfo
+
+func (m *SectionOptions) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *SectionOptions) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+func (m *SectionOptions) GetCompact() bool {
+	if m != nil {
+		return m.Compact
+	}
+	return false
+}
+
+// FieldOptions describes how a config field is written to a TOML file and
+// validated. The TOML key of a field is its name with dashes instead of
+// underscores.
+type FieldOptions struct {
+	// comment is written before the field, one comment line per line.
+	Comment string `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	// go_name is the name of the Go struct field holding the value of the field,
+	// when it is not the camel case name of the field.
+	GoName string `protobuf:"bytes,2,opt,name=go_name,json=goName,proto3" json:"go_name,omitempty"`
+	// inline writes the fields of a message field to the TOML table of the
+	// parent message instead of a table of its own, like a squashed struct.
+	Inline bool `protobuf:"varint,3,opt,name=inline,proto3" json:"inline,omitempty"`
+	// allowed_values are the only values allowed for a string field, if any.
+	AllowedValues []string `protobuf:"bytes,4,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"`
+	// required fields must not be empty.
+	Required bool `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+}
+
+func (m *FieldOptions) Reset()         { *m = FieldOptions{} }
+func (m *FieldOptions) String() string { return proto.CompactTextString(m) }
+func (*FieldOptions) ProtoMessage()    {}
+func (*FieldOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8349d04ef88c9689, []int{1}
+}
+func (m *FieldOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FieldOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FieldOptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FieldOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldOptions.Merge(m, src)
+}
+func (m *FieldOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *FieldOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldOptions proto.InternalMessageInfo
+
+func (m *FieldOptions) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+func (m *FieldOptions) GetGoName() string {
+	if m != nil {
+		return m.GoName
+	}
+	return ""
+}
+
+func (m *FieldOptions) GetInline() bool {
+	if m != nil {
+		return m.Inline
+	}
+	return false
+}
+
+func (m *FieldOptions) GetAllowedValues() []string {
+	if m != nil {
+		return m.AllowedValues
+	}
+	return nil
+}
+
+func (m *FieldOptions) GetRequired() bool {
+	if m != nil {
+		return m.Required
+	}
+	return false
+}
+
+var E_Section = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+	ExtensionType: (*SectionOptions)(nil),
+	Field:         11110020,
+	Name:          "cosmos.config.v1.section",
+	Tag:           "bytes,11110020,opt,name=section",
+	Filename:      "cosmos/config/v1/options.proto",
+}
+
+var E_Field = &proto.ExtensionDesc{
+	ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+	ExtensionType: (*FieldOptions)(nil),
+	Field:         11110020,
+	Name:          "cosmos.config.v1.field",
+	Tag:           "bytes,11110020,opt,name=field",
+	Filename:      "cosmos/config/v1/options.proto",
+}
+
+func init() {
+	proto.RegisterType((*SectionOptions)(nil), "cosmos.config.v1.SectionOptions")
+	proto.RegisterType((*FieldOptions)(nil), "cosmos.config.v1.FieldOptions")
+	proto.RegisterExtension(E_Section)
+	proto.RegisterExtension(E_Field)
+}
+
+func init() { proto.RegisterFile("cosmos/config/v1/options.proto", fileDescriptor_8349d04ef88c9689) }
+
+var fileDescriptor_8349d04ef88c9689 = []byte{
+	// 378 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xbf, 0x8a, 0xdb, 0x40,
+	0x10, 0xc6, 0xbd, 0x71, 0xfc, 0x6f, 0x93, 0x98, 0xb0, 0x84, 0x44, 0x18, 0xb2, 0x11, 0x86, 0x80,
+	0x9b, 0xec, 0xe2, 0xa4, 0x73, 0x99, 0x22, 0x90, 0x22, 0x09, 0x28, 0xe0, 0xc2, 0x45, 0x8c, 0xbc,
+	0x1a, 0xcb, 0x4b, 0x24, 0xad, 0x4e, 0xbb, 0xf2, 0x71, 0xfd, 0xb5, 0x07, 0xf7, 0x00, 0x57, 0xdf,
+	0xb3, 0x5c, 0xe9, 0xf2, 0xca, 0xc3, 0x7e, 0x91, 0x43, 0x5a, 0xc9, 0x67, 0x9f, 0xab, 0xe5, 0x9b,
+	0x6f, 0xe7, 0xc7, 0xcc, 0xc7, 0x60, 0x2a, 0x94, 0x8e, 0x95, 0xe6, 0x42, 0x25, 0x4b, 0x19, 0xf2,
+	0xf5, 0x98, 0xab, 0xd4, 0x48, 0x95, 0x68, 0x96, 0x66, 0xca, 0x28, 0xf2, 0xd6, 0xfa, 0xcc, 0xfa,
+	0x6c, 0x3d, 0x1e, 0xb8, 0xa1, 0x52, 0x61, 0x04, 0xbc, 0xf4, 0x17, 0xf9, 0x92, 0x07, 0xa0, 0x45,
+	0x26, 0x53, 0xa3, 0x32, 0xdb, 0x33, 0x9c, 0xe1, 0xfe, 0x5f, 0x10, 0x05, 0xe5, 0x8f, 0x65, 0x91,
+	0x77, 0xb8, 0x65, 0xa4, 0x89, 0xc0, 0x41, 0x2e, 0x1a, 0xf5, 0x3c, 0x2b, 0x88, 0x83, 0x3b, 0x42,
+	0xc5, 0x31, 0x24, 0xc6, 0x79, 0x51, 0xd6, 0x6b, 0x59, 0x39, 0xa9, 0x2f, 0x8c, 0xd3, 0x74, 0xd1,
+	0xa8, 0xeb, 0xd5, 0x72, 0x78, 0x83, 0xf0, 0xeb, 0x1f, 0x12, 0xa2, 0xa0, 0x46, 0x1f, 0x40, 0xd0,
+	0x31, 0xe4, 0x03, 0xee, 0x84, 0x6a, 0x9e, 0xf8, 0x31, 0x54, 0xf8, 0x76, 0xa8, 0x7e, 0xfb, 0x31,
+	0x90, 0xf7, 0xb8, 0x2d, 0x93, 0x48, 0x26, 0x50, 0xc1, 0x2b, 0x45, 0x3e, 0xe3, 0xbe, 0x1f, 0x45,
+	0xea, 0x1c, 0x82, 0xf9, 0xda, 0x8f, 0x72, 0xd0, 0xce, 0x4b, 0xb7, 0x39, 0xea, 0x79, 0x6f, 0xaa,
+	0xea, 0xb4, 0x2c, 0x92, 0x01, 0xee, 0x66, 0x70, 0x96, 0xcb, 0x0c, 0x02, 0xa7, 0x55, 0x02, 0xf6,
+	0x7a, 0xf2, 0x0f, 0x77, 0xb4, 0x5d, 0x9d, 0x7c, 0x62, 0x36, 0x28, 0x56, 0x07, 0xc5, 0x7e, 0x81,
+	0xd6, 0x7e, 0x08, 0xd5, 0xe4, 0xce, 0xe5, 0xd5, 0x6d, 0xd1, 0xfe, 0xea, 0xab, 0xcb, 0x9e, 0x87,
+	0xcc, 0x8e, 0xf3, 0xf3, 0x6a, 0xe8, 0x64, 0x8a, 0x5b, 0xcb, 0x62, 0x7b, 0xf2, 0xf1, 0x84, 0x7e,
+	0x98, 0xca, 0x13, 0x9b, 0x9e, 0xb2, 0x0f, 0x3f, 0x7a, 0x16, 0xf7, 0xfd, 0xe7, 0xdd, 0x96, 0xa2,
+	0xcd, 0x96, 0xa2, 0x87, 0x2d, 0x45, 0xd7, 0x3b, 0xda, 0xd8, 0xec, 0x68, 0xe3, 0x7e, 0x47, 0x1b,
+	0x33, 0x1e, 0x4a, 0xb3, 0xca, 0x17, 0x4c, 0xa8, 0x98, 0xef, 0x6f, 0xa5, 0x78, 0xbe, 0xe8, 0xe0,
+	0x3f, 0x37, 0x17, 0x29, 0xd4, 0xc7, 0xa3, 0xc5, 0x0a, 0x62, 0x7f, 0xd1, 0x2e, 0x27, 0xfa, 0xf6,
+	0x38, 0x00, 0xb0, 0x15, 0x98, 0x51, 0x5a, 0x02, 0x00, 0x00,
+}
+
+func (m *SectionOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SectionOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SectionOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Compact {
+		i--
+		if m.Compact {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Comment) > 0 {
+		i -= len(m.Comment)
+		copy(dAtA[i:], m.Comment)
+		i = encodeVarintOptions(dAtA, i, uint64(len(m.Comment)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintOptions(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FieldOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FieldOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Required {
+		i--
+		if m.Required {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AllowedValues) > 0 {
+		for iNdEx := len(m.AllowedValues) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedValues[iNdEx])
+			copy(dAtA[i:], m.AllowedValues[iNdEx])
+			i = encodeVarintOptions(dAtA, i, uint64(len(m.AllowedValues[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Inline {
+		i--
+		if m.Inline {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.GoName) > 0 {
+		i -= len(m.GoName)
+		copy(dAtA[i:], m.GoName)
+		i = encodeVarintOptions(dAtA, i, uint64(len(m.GoName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Comment) > 0 {
+		i -= len(m.Comment)
+		copy(dAtA[i:], m.Comment)
+		i = encodeVarintOptions(dAtA, i, uint64(len(m.Comment)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOptions(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOptions(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SectionOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovOptions(uint64(l))
+	}
+	l = len(m.Comment)
+	if l > 0 {
+		n += 1 + l + sovOptions(uint64(l))
+	}
+	if m.Compact {
+		n += 2
+	}
+	return n
+}
+
+func (m *FieldOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Comment)
+	if l > 0 {
+		n += 1 + l + sovOptions(uint64(l))
+	}
+	l = len(m.GoName)
+	if l > 0 {
+		n += 1 + l + sovOptions(uint64(l))
+	}
+	if m.Inline {
+		n += 2
+	}
+	if len(m.AllowedValues) > 0 {
+		for _, s := range m.AllowedValues {
+			l = len(s)
+			n += 1 + l + sovOptions(uint64(l))
+		}
+	}
+	if m.Required {
+		n += 2
+	}
+	return n
+}
+
+func sovOptions(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOptions(x uint64) (n int) {
+	return sovOptions(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SectionOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOptions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SectionOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SectionOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOptions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOptions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOptions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOptions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compact", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Compact = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOptions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOptions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FieldOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOptions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FieldOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FieldOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOptions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOptions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOptions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOptions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inline", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Inline = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedValues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOptions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOptions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedValues = append(m.AllowedValues, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOptions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Required = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOptions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOptions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOptions(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOptions
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOptions
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOptions
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOptions
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOptions
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOptions
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOptions        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOptions          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOptions = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,454 @@
+// Package configschema defines the client.toml and app.toml files as protobuf
+// messages, see proto/cosmos/config/v1. The TOML templates of the files are
+// generated from the messages and their annotations, which also validate the
+// values of the files.
+package configschema
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/cosmos/gogoproto/protoc-gen-gogo/descriptor"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// bannerWidth is the width of the banners written before the titled sections.
+const bannerWidth = 79
+
+// Template generates the text/template of the TOML file described by the
+// schema msg, e.g. &AppConfig{}. The template is executed against the Go
+// struct holding the configuration, whose fields are named after the schema
+// fields (see FieldOptions.GoName).
+func Template(msg descriptor.Message) (string, error) {
+	_, md := descriptor.ForMessage(msg)
+	section := sectionOptions(md)
+
+	var sb strings.Builder
+	writeComment(&sb, section.GetComment())
+	if title := section.GetTitle(); title != "" {
+		writeBanner(&sb, title)
+	}
+
+	if err := writeFields(&sb, md, "", "", section.GetCompact()); err != nil {
+		return "", err
+	}
+
+	return sb.String(), nil
+}
+
+// MustTemplate is like Template but panics on error.
+func MustTemplate(msg descriptor.Message) string {
+	tmpl, err := Template(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return tmpl
+}
+
+// Keys returns the TOML keys of the schema msg, tables included, in the order
+// they are written to the TOML file.
+func Keys(msg descriptor.Message) ([]string, error) {
+	_, md := descriptor.ForMessage(msg)
+
+	var keys []string
+	err := walk(md, "", "", func(fd *descriptor.FieldDescriptorProto, _ *FieldOptions, key, _ string) error {
+		keys = append(keys, key)
+		return nil
+	})
+
+	return keys, err
+}
+
+// Validate checks config against the required and allowed values of the
+// schema msg. config is the Go struct, or a pointer to it, holding the
+// configuration described by msg.
+func Validate(msg descriptor.Message, config interface{}) error {
+	_, md := descriptor.ForMessage(msg)
+
+	return walk(md, "", "", func(fd *descriptor.FieldDescriptorProto, opts *FieldOptions, key, goPath string) error {
+		if fd.GetType() != descriptor.FieldDescriptorProto_TYPE_STRING || fd.IsRepeated() ||
+			(!opts.GetRequired() && len(opts.GetAllowedValues()) == 0) {
+			return nil
+		}
+
+		v, err := lookup(reflect.ValueOf(config), goPath)
+		if err != nil {
+			return err
+		}
+
+		if v.Kind() != reflect.String {
+			return fmt.Errorf("config field %s of %s must be a string, got %s", goPath, key, v.Kind())
+		}
+
+		value := v.String()
+		if value == "" {
+			if opts.GetRequired() {
+				return fmt.Errorf("%s is required", key)
+			}
+
+			return nil
+		}
+
+		if allowed := opts.GetAllowedValues(); len(allowed) > 0 && !contains(allowed, value) {
+			return fmt.Errorf("invalid %s %q, must be one of: %s", key, value, strings.Join(allowed, ", "))
+		}
+
+		return nil
+	})
+}
+
+// CheckStruct checks that the Go struct config, or a pointer to it, holds
+// every field of the schema msg under the mapstructure key of the field, so
+// that the TOML file written from the schema is read back into config.
+func CheckStruct(msg descriptor.Message, config interface{}) error {
+	_, md := descriptor.ForMessage(msg)
+	typ := reflect.TypeOf(config)
+
+	return walkAll(md, "", "", func(fd *descriptor.FieldDescriptorProto, opts *FieldOptions, key, goPath string) error {
+		field, err := lookupField(typ, goPath)
+		if err != nil {
+			return err
+		}
+
+		name, tagOpts, _ := strings.Cut(field.Tag.Get("mapstructure"), ",")
+		if opts.GetInline() {
+			if !field.Anonymous || tagOpts != "squash" {
+				return fmt.Errorf("config field %s of %s must be an embedded struct tagged mapstructure:\",squash\"", goPath, fd.GetName())
+			}
+
+			return nil
+		}
+
+		if want := tomlKey(fd); name != want {
+			return fmt.Errorf("config field %s of %s must be tagged mapstructure:%q, got %q", goPath, key, want, name)
+		}
+
+		return nil
+	})
+}
+
+// walk calls fn for each field of md, recursing into its message fields.
+// tomlPrefix and goPrefix are the TOML key and Go field path of md.
+func walk(
+	md *descriptor.DescriptorProto,
+	tomlPrefix, goPrefix string,
+	fn func(fd *descriptor.FieldDescriptorProto, opts *FieldOptions, key, goPath string) error,
+) error {
+	return walkFields(md, tomlPrefix, goPrefix, false, fn)
+}
+
+// walkAll is like walk but also calls fn for the inline message fields.
+func walkAll(
+	md *descriptor.DescriptorProto,
+	tomlPrefix, goPrefix string,
+	fn func(fd *descriptor.FieldDescriptorProto, opts *FieldOptions, key, goPath string) error,
+) error {
+	return walkFields(md, tomlPrefix, goPrefix, true, fn)
+}
+
+func walkFields(
+	md *descriptor.DescriptorProto,
+	tomlPrefix, goPrefix string,
+	inline bool,
+	fn func(fd *descriptor.FieldDescriptorProto, opts *FieldOptions, key, goPath string) error,
+) error {
+	for _, fd := range md.GetField() {
+		opts := fieldOptions(fd)
+		key, goPath := join(tomlPrefix, tomlKey(fd)), join(goPrefix, goName(fd, opts))
+
+		if !isSection(fd) {
+			if err := fn(fd, opts, key, goPath); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		sub, err := messageDescriptor(fd)
+		if err != nil {
+			return err
+		}
+
+		if opts.GetInline() {
+			key = tomlPrefix
+		}
+
+		if !opts.GetInline() || inline {
+			if err := fn(fd, opts, key, goPath); err != nil {
+				return err
+			}
+		}
+
+		if err := walkFields(sub, key, goPath, inline, fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeFields writes the fields of md to sb, the tables of its message fields
+// included.
+func writeFields(sb *strings.Builder, md *descriptor.DescriptorProto, tomlPrefix, goPrefix string, compact bool) error {
+	for i, fd := range md.GetField() {
+		opts := fieldOptions(fd)
+		key, goPath := join(tomlPrefix, tomlKey(fd)), join(goPrefix, goName(fd, opts))
+
+		if !isSection(fd) {
+			if comment := opts.GetComment(); comment != "" {
+				if !compact || i == 0 {
+					sb.WriteString("\n")
+				}
+
+				writeComment(sb, comment)
+			}
+
+			value, err := templateValue(fd, goPath)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(sb, "%s = %s\n", tomlKey(fd), value)
+			continue
+		}
+
+		sub, err := messageDescriptor(fd)
+		if err != nil {
+			return err
+		}
+
+		section := sectionOptions(sub)
+		if title := section.GetTitle(); title != "" {
+			writeBanner(sb, title)
+		}
+
+		if opts.GetInline() {
+			key = tomlPrefix
+		} else {
+			sb.WriteString("\n")
+			writeComment(sb, section.GetComment())
+			fmt.Fprintf(sb, "[%s]\n", key)
+		}
+
+		if err := writeFields(sb, sub, key, goPath, section.GetCompact()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// templateValue returns the template of the TOML value of fd, held by the Go
+// field at goPath.
+func templateValue(fd *descriptor.FieldDescriptorProto, goPath string) (string, error) {
+	switch {
+	case fd.IsRepeated() && fd.IsMessage():
+		// repeated messages are written as arrays of tuples, e.g. [["a", "b"]]
+		sub, err := messageDescriptor(fd)
+		if err != nil {
+			return "", err
+		}
+
+		elems := make([]string, len(sub.GetField()))
+		for i := range elems {
+			elems[i] = fmt.Sprintf(`{{ printf "%%q" (index $v %d) }}`, i)
+		}
+
+		return fmt.Sprintf("[{{ range $v := .%s }}\n  [%s],{{ end }}\n]", goPath, strings.Join(elems, ", ")), nil
+
+	case fd.IsRepeated():
+		return fmt.Sprintf(`[{{ range .%s }}{{ printf "%%q, " . }}{{end}}]`, goPath), nil
+
+	case fd.GetType() == descriptor.FieldDescriptorProto_TYPE_STRING:
+		return fmt.Sprintf(`"{{ .%s }}"`, goPath), nil
+
+	default:
+		return fmt.Sprintf("{{ .%s }}", goPath), nil
+	}
+}
+
+func writeComment(sb *strings.Builder, comment string) {
+	if comment == "" {
+		return
+	}
+
+	for _, line := range strings.Split(comment, "\n") {
+		sb.WriteString(strings.TrimRight("# "+line, " ") + "\n")
+	}
+}
+
+func writeBanner(sb *strings.Builder, title string) {
+	border := strings.Repeat("#", bannerWidth)
+	inner := bannerWidth - 6
+	left := (inner - len(title)) / 2
+	right := inner - len(title) - left
+
+	fmt.Fprintf(sb, "\n%s\n###%s%s%s###\n%s\n", border, strings.Repeat(" ", left), title, strings.Repeat(" ", right), border)
+}
+
+// isSection returns true if fd is written to a TOML table of its own.
+func isSection(fd *descriptor.FieldDescriptorProto) bool {
+	return fd.IsMessage() && !fd.IsRepeated()
+}
+
+func messageDescriptor(fd *descriptor.FieldDescriptorProto) (*descriptor.DescriptorProto, error) {
+	name := strings.TrimPrefix(fd.GetTypeName(), ".")
+	typ := proto.MessageType(name)
+	if typ == nil {
+		return nil, fmt.Errorf("unknown config message %s", name)
+	}
+
+	msg, ok := reflect.New(typ.Elem()).Interface().(descriptor.Message)
+	if !ok {
+		return nil, fmt.Errorf("config message %s has no descriptor", name)
+	}
+
+	_, md := descriptor.ForMessage(msg)
+	return md, nil
+}
+
+func sectionOptions(md *descriptor.DescriptorProto) *SectionOptions {
+	opts := &SectionOptions{}
+	if !getExtension(md.GetOptions(), E_Section, opts) {
+		return nil
+	}
+
+	return opts
+}
+
+func fieldOptions(fd *descriptor.FieldDescriptorProto) *FieldOptions {
+	opts := &FieldOptions{}
+	if !getExtension(fd.GetOptions(), E_Field, opts) {
+		return nil
+	}
+
+	return opts
+}
+
+// getExtension decodes the extension ext of the descriptor options into
+// value. The extensions are declared on the descriptorpb options, so they are
+// decoded from the raw options rather than through proto.GetExtension.
+func getExtension(options proto.Message, ext *proto.ExtensionDesc, value proto.Message) bool {
+	if options == nil || reflect.ValueOf(options).IsNil() {
+		return false
+	}
+
+	bz, err := proto.Marshal(options)
+	if err != nil {
+		return false
+	}
+
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return false
+		}
+		bz = bz[n:]
+
+		if num == protowire.Number(ext.Field) && typ == protowire.BytesType {
+			v, n := protowire.ConsumeBytes(bz)
+			if n < 0 {
+				return false
+			}
+
+			return proto.Unmarshal(v, value) == nil
+		}
+
+		if n = protowire.ConsumeFieldValue(num, typ, bz); n < 0 {
+			return false
+		}
+		bz = bz[n:]
+	}
+
+	return false
+}
+
+// tomlKey returns the TOML key of fd, its name with dashes instead of
+// underscores.
+func tomlKey(fd *descriptor.FieldDescriptorProto) string {
+	return strings.ReplaceAll(fd.GetName(), "_", "-")
+}
+
+// goName returns the name of the Go struct field holding fd.
+func goName(fd *descriptor.FieldDescriptorProto, opts *FieldOptions) string {
+	if name := opts.GetGoName(); name != "" {
+		return name
+	}
+
+	parts := strings.Split(fd.GetName(), "_")
+	for i, part := range parts {
+		if part != "" {
+			parts[i] = strings.ToUpper(part[:1]) + part[1:]
+		}
+	}
+
+	return strings.Join(parts, "")
+}
+
+// lookup returns the value of the field at the dot separated goPath of v,
+// dereferencing pointers.
+func lookup(v reflect.Value, goPath string) (reflect.Value, error) {
+	for _, name := range strings.Split(goPath, ".") {
+		for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+			if v.IsNil() {
+				return reflect.Value{}, fmt.Errorf("config field %s is nil", goPath)
+			}
+			v = v.Elem()
+		}
+
+		if v.Kind() != reflect.Struct {
+			return reflect.Value{}, fmt.Errorf("config field %s is not a struct", goPath)
+		}
+
+		if v = v.FieldByName(name); !v.IsValid() {
+			return reflect.Value{}, fmt.Errorf("config has no field %s", goPath)
+		}
+	}
+
+	return v, nil
+}
+
+// lookupField returns the struct field at the dot separated goPath of typ,
+// dereferencing pointers.
+func lookupField(typ reflect.Type, goPath string) (reflect.StructField, error) {
+	var field reflect.StructField
+	for _, name := range strings.Split(goPath, ".") {
+		for typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+
+		if typ.Kind() != reflect.Struct {
+			return field, fmt.Errorf("config field %s is not a struct", goPath)
+		}
+
+		var ok bool
+		if field, ok = typ.FieldByName(name); !ok {
+			return field, fmt.Errorf("config has no field %s", goPath)
+		}
+
+		typ = field.Type
+	}
+
+	return field, nil
+}
+
+func join(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+
+	return prefix + "." + name
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,123 @@
+package configschema_test
+
+import (
+	"bytes"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/configschema"
+)
+
+type clientConfig struct {
+	ChainID        string `mapstructure:"chain-id"`
+	KeyringBackend string `mapstructure:"keyring-backend"`
+	Output         string `mapstructure:"output"`
+	Node           string `mapstructure:"node"`
+	BroadcastMode  string `mapstructure:"broadcast-mode"`
+	FeeGranter     string `mapstructure:"fee-granter"`
+	FeePayer       string `mapstructure:"fee-payer"`
+}
+
+func validClientConfig() *clientConfig {
+	return &clientConfig{
+		ChainID:        "test-chain",
+		KeyringBackend: "test",
+		Output:         "json",
+		Node:           "tcp://localhost:26657",
+		BroadcastMode:  "sync",
+	}
+}
+
+func TestTemplate(t *testing.T) {
+	tmpl, err := configschema.Template(&configschema.ClientConfig{})
+	require.NoError(t, err)
+
+	parsed, err := template.New("client").Parse(tmpl)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, parsed.Execute(&buf, validClientConfig()))
+
+	out := buf.String()
+	require.Contains(t, out, "# This is a TOML config file.\n")
+	require.Contains(t, out, "###                          Client Configuration                           ###\n")
+	require.Contains(t, out, "# The network chain ID\nchain-id = \"test-chain\"\n# The keyring's backend")
+	require.Contains(t, out, "keyring-backend = \"test\"\n")
+	require.Contains(t, out, "fee-payer = \"\"\n")
+}
+
+func TestAppTemplate(t *testing.T) {
+	tmpl, err := configschema.Template(&configschema.AppConfig{})
+	require.NoError(t, err)
+
+	// base fields are inlined, the other sections have tables of their own
+	require.Contains(t, tmpl, "\nminimum-gas-prices = \"{{ .BaseConfig.MinGasPrices }}\"\n")
+	require.NotContains(t, tmpl, "[base]")
+	require.Contains(t, tmpl, "\n[grpc-web]\n\n# GRPCWebEnable")
+	require.Contains(t, tmpl, "\n[streaming.abci]\n")
+	require.Contains(t, tmpl, "keys = [{{ range .Streaming.ABCI.Keys }}")
+	require.Contains(t, tmpl, "halt-height = {{ .BaseConfig.HaltHeight }}\n")
+	require.Contains(t, tmpl, "global-labels = [{{ range $v := .Telemetry.GlobalLabels }}")
+
+	// a field without a comment directly follows the previous one
+	require.Contains(t, tmpl, "pruning-keep-recent = \"{{ .BaseConfig.PruningKeepRecent }}\"\npruning-interval")
+}
+
+func TestKeys(t *testing.T) {
+	keys, err := configschema.Keys(&configschema.ClientConfig{})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"chain-id", "keyring-backend", "output", "node", "broadcast-mode", "fee-granter", "fee-payer",
+	}, keys)
+
+	keys, err = configschema.Keys(&configschema.AppConfig{})
+	require.NoError(t, err)
+	require.Contains(t, keys, "minimum-gas-prices")
+	require.Contains(t, keys, "streaming.abci")
+	require.Contains(t, keys, "streaming.abci.stop-node-on-err")
+	require.NotContains(t, keys, "base")
+}
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(c *clientConfig)
+		expErr   string
+	}{
+		{"valid", func(c *clientConfig) {}, ""},
+		{"empty optional field", func(c *clientConfig) { c.ChainID = "" }, ""},
+		{"empty required field", func(c *clientConfig) { c.Node = "" }, "node is required"},
+		{"disallowed value", func(c *clientConfig) { c.Output = "yaml" }, `invalid output "yaml", must be one of: text, json`},
+		{"empty required allowed value", func(c *clientConfig) { c.BroadcastMode = "" }, "broadcast-mode is required"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := validClientConfig()
+			tc.malleate(c)
+
+			err := configschema.Validate(&configschema.ClientConfig{}, c)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expErr)
+			}
+		})
+	}
+
+	err := configschema.Validate(&configschema.ClientConfig{}, struct{ ChainID string }{})
+	require.ErrorContains(t, err, "config has no field KeyringBackend")
+}
+
+func TestCheckStruct(t *testing.T) {
+	require.NoError(t, configschema.CheckStruct(&configschema.ClientConfig{}, clientConfig{}))
+
+	type badTag struct {
+		clientConfig
+		FeePayer string `mapstructure:"feepayer"`
+	}
+	err := configschema.CheckStruct(&configschema.ClientConfig{}, &badTag{})
+	require.EqualError(t, err, `config field FeePayer of fee-payer must be tagged mapstructure:"fee-payer", got "feepayer"`)
+}
@@ -41,6 +41,7 @@ type Context struct {
 	kvGasConfig          storetypes.GasConfig
 	transientKVGasConfig storetypes.GasConfig
 	streamingManager     storetypes.StreamingManager
+	clock                Clock
 }
 
 // Proposed rename, not done to avoid API breakage
@@ -66,6 +67,15 @@ func (c Context) KVGasConfig() storetypes.GasConfig             { return c.kvGas
 func (c Context) TransientKVGasConfig() storetypes.GasConfig    { return c.transientKVGasConfig }
 func (c Context) StreamingManager() storetypes.StreamingManager { return c.streamingManager }
 
+// Clock returns the clock of the context, or the SystemClock if none is set.
+func (c Context) Clock() Clock {
+	if c.clock == nil {
+		return SystemClock
+	}
+
+	return c.clock
+}
+
 // clone the header before returning
 func (c Context) BlockHeader() cmtproto.Header {
 	msg := proto.Clone(&c.header).(*cmtproto.Header)
@@ -256,6 +266,13 @@ func (c Context) WithPriority(p int64) Context {
 	return c
 }
 
+// WithClock returns a Context with an updated clock. A nil clock resets the
+// clock of the context to the SystemClock.
+func (c Context) WithClock(clock Clock) Context {
+	c.clock = clock
+	return c
+}
+
 // WithStreamingManager returns a Context with an updated streaming manager
 func (c Context) WithStreamingManager(sm storetypes.StreamingManager) Context {
 	c.streamingManager = sm
@@ -621,6 +621,10 @@ func ParseDecCoin(coinStr string) (coin DecCoin, err error) {
 	coinStr = strings.TrimSpace(coinStr)
 
 	matches := reDecCoin.FindStringSubmatch(coinStr)
+	if matches == nil && hasCustomDenomValidation() {
+		// the denom is checked by ValidateDenom below
+		matches = reDecCoinAnyDenom.FindStringSubmatch(coinStr)
+	}
 	if matches == nil {
 		return DecCoin{}, fmt.Errorf("invalid decimal coin expression: %s", coinStr)
 	}
@@ -12,8 +12,10 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/gogoproto/jsonpb"
 	proto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 )
 
 type EventManagerI interface {
@@ -86,6 +88,90 @@ func (em *EventManager) EmitTypedEvents(tevs ...proto.Message) error {
 	return nil
 }
 
+// EmitTypedEvent emits the given typed event on the event manager of the
+// context. Unlike EventManager.EmitTypedEvent, the type of the event is known
+// at compile time, which lets modules restrict the events they emit.
+func EmitTypedEvent[E proto.Message](ctx Context, tev E) error {
+	return ctx.EventManager().EmitTypedEvent(tev)
+}
+
+// EmitTypedEvents emits the given typed events, all of the same type, on the
+// event manager of the context.
+func EmitTypedEvents[E proto.Message](ctx Context, tevs ...E) error {
+	msgs := make([]proto.Message, len(tevs))
+	for i, tev := range tevs {
+		msgs[i] = tev
+	}
+
+	return ctx.EventManager().EmitTypedEvents(msgs...)
+}
+
+// ValidateTypedEvent checks that the given typed event is registered in the
+// interface registry, along with the types packed in its Any fields, so that
+// it can be marshaled to JSON and parsed back with ParseTypedEvent.
+func ValidateTypedEvent(registry codectypes.InterfaceRegistry, tev proto.Message) error {
+	name := proto.MessageName(tev)
+	if name == "" {
+		return fmt.Errorf("typed event %T is not a registered protobuf message", tev)
+	}
+
+	if _, err := registry.FindDescriptorByName(protoreflect.FullName(name)); err != nil {
+		return fmt.Errorf("typed event %s is not registered in the interface registry: %w", name, err)
+	}
+
+	if proto.MessageType(name) == nil {
+		return fmt.Errorf("typed event %s has no registered go type", name)
+	}
+
+	if _, err := codec.ProtoMarshalJSON(tev, registry); err != nil {
+		return fmt.Errorf("typed event %s cannot be marshaled: %w", name, err)
+	}
+
+	return nil
+}
+
+var _ EventManagerI = (*ValidatingEventManager)(nil)
+
+// ValidatingEventManager is an EventManagerI rejecting the typed events which
+// are not registered in the interface registry, see ValidateTypedEvent. It is
+// meant to be set on the context in tests, in order to catch unregistered event
+// types before they fail at runtime.
+type ValidatingEventManager struct {
+	EventManagerI
+
+	registry codectypes.InterfaceRegistry
+}
+
+// NewValidatingEventManager returns a new ValidatingEventManager wrapping the
+// given event manager.
+func NewValidatingEventManager(em EventManagerI, registry codectypes.InterfaceRegistry) *ValidatingEventManager {
+	return &ValidatingEventManager{
+		EventManagerI: em,
+		registry:      registry,
+	}
+}
+
+// EmitTypedEvent validates and emits the given typed event.
+func (em *ValidatingEventManager) EmitTypedEvent(tev proto.Message) error {
+	if err := ValidateTypedEvent(em.registry, tev); err != nil {
+		return err
+	}
+
+	return em.EventManagerI.EmitTypedEvent(tev)
+}
+
+// EmitTypedEvents validates and emits the given typed events. No event is
+// emitted if any of them is invalid.
+func (em *ValidatingEventManager) EmitTypedEvents(tevs ...proto.Message) error {
+	for _, tev := range tevs {
+		if err := ValidateTypedEvent(em.registry, tev); err != nil {
+			return err
+		}
+	}
+
+	return em.EventManagerI.EmitTypedEvents(tevs...)
+}
+
 // TypedEventToEvent takes typed event and converts to Event object
 func TypedEventToEvent(tev proto.Message) (Event, error) {
 	evtType := proto.MessageName(tev)
@@ -267,6 +353,11 @@ const (
 	AttributeKeyModule = "module"
 	AttributeKeySender = "sender"
 	AttributeKeyAmount = "amount"
+
+	EventTypeMsgGas = "msg_gas"
+
+	AttributeKeyMsgTypeURL  = "msg_type_url"
+	AttributeKeyGasConsumed = "gas_consumed"
 )
 
 type (
@@ -314,6 +405,39 @@ func StringifyEvents(events []abci.Event) StringEvents {
 	return res
 }
 
+// FilterEvents returns the set of ABCI events without the events and attributes
+// matching the provided deny set, in the form {eventType} for events and
+// {eventType}.{attributeKey} for attributes. Events left without any attribute
+// once filtered are dropped.
+func FilterEvents(events []abci.Event, denySet map[string]struct{}) []abci.Event {
+	if len(denySet) == 0 {
+		return events
+	}
+
+	filteredEvents := make([]abci.Event, 0, len(events))
+	for _, e := range events {
+		if _, deny := denySet[e.Type]; deny {
+			continue
+		}
+
+		attrs := make([]abci.EventAttribute, 0, len(e.Attributes))
+		for _, attr := range e.Attributes {
+			if _, deny := denySet[fmt.Sprintf("%s.%s", e.Type, attr.Key)]; !deny {
+				attrs = append(attrs, attr)
+			}
+		}
+
+		switch {
+		case len(attrs) == len(e.Attributes):
+			filteredEvents = append(filteredEvents, e)
+		case len(attrs) > 0:
+			filteredEvents = append(filteredEvents, abci.Event{Type: e.Type, Attributes: attrs})
+		}
+	}
+
+	return filteredEvents
+}
+
 // MarkEventsToIndex returns the set of ABCI events, where each event's attribute
 // has it's index value marked based on the provided set of events to index.
 func MarkEventsToIndex(events []abci.Event, indexSet map[string]struct{}) []abci.Event {
@@ -132,6 +132,38 @@ func (s *eventsTestSuite) TestEventManagerTypedEvents() {
 	s.Require().Equal(hasAnimal.Animal.String(), response.Animal.String())
 }
 
+func (s *eventsTestSuite) TestEmitTypedEventsGeneric() {
+	ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager())
+
+	coin1 := sdk.NewCoin("fakedenom", sdk.NewInt(1))
+	coin2 := sdk.NewCoin("fakedenom", sdk.NewInt(2))
+	s.Require().NoError(sdk.EmitTypedEvent(ctx, &coin1))
+	s.Require().NoError(sdk.EmitTypedEvents(ctx, &coin1, &coin2))
+	s.Require().Len(ctx.EventManager().Events(), 3)
+
+	msg, err := sdk.ParseTypedEvent(ctx.EventManager().ABCIEvents()[2])
+	s.Require().NoError(err)
+	s.Require().Equal(coin2.String(), msg.String())
+}
+
+func (s *eventsTestSuite) TestValidatingEventManager() {
+	cat, err := codectypes.NewAnyWithValue(&testdata.Cat{Moniker: "Garfield"})
+	s.Require().NoError(err)
+	hasAnimal := &testdata.HasAnimal{X: 1, Animal: cat}
+
+	// the Cat packed in the event is not registered
+	registry := codectypes.NewInterfaceRegistry()
+	em := sdk.NewValidatingEventManager(sdk.NewEventManager(), registry)
+	s.Require().Error(em.EmitTypedEvent(hasAnimal))
+	s.Require().Error(em.EmitTypedEvents(&testdata.Dog{}, hasAnimal))
+	s.Require().Empty(em.Events())
+
+	testdata.RegisterInterfaces(registry)
+	s.Require().NoError(em.EmitTypedEvent(hasAnimal))
+	s.Require().NoError(em.EmitTypedEvents(&testdata.Dog{}, hasAnimal))
+	s.Require().Len(em.Events(), 3)
+}
+
 func (s *eventsTestSuite) TestStringifyEvents() {
 	cases := []struct {
 		name       string
@@ -272,3 +304,62 @@ func (s *eventsTestSuite) TestMarkEventsToIndex() {
 		})
 	}
 }
+
+func (s *eventsTestSuite) TestFilterEvents() {
+	events := []abci.Event{
+		{
+			Type: "message",
+			Attributes: []abci.EventAttribute{
+				{Key: "sender", Value: "foo"},
+				{Key: "recipient", Value: "bar"},
+			},
+		},
+		{
+			Type: "staking",
+			Attributes: []abci.EventAttribute{
+				{Key: "deposit", Value: "5"},
+			},
+		},
+		{
+			Type: "coin_spent",
+		},
+	}
+
+	testCases := map[string]struct {
+		denySet  map[string]struct{}
+		expected []abci.Event
+	}{
+		"empty deny set": {
+			denySet:  map[string]struct{}{},
+			expected: events,
+		},
+		"drop events and attributes": {
+			denySet: map[string]struct{}{
+				"message.sender": {},
+				"coin_spent":     {},
+			},
+			expected: []abci.Event{
+				{
+					Type: "message",
+					Attributes: []abci.EventAttribute{
+						{Key: "recipient", Value: "bar"},
+					},
+				},
+				events[1],
+			},
+		},
+		"drop events left without attributes": {
+			denySet: map[string]struct{}{
+				"staking.deposit": {},
+			},
+			expected: []abci.Event{events[0], events[2]},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		s.T().Run(name, func(_ *testing.T) {
+			s.Require().Equal(tc.expected, sdk.FilterEvents(events, tc.denySet))
+		})
+	}
+}
@@ -3,4 +3,12 @@ package grpc
 const (
 	// GRPCBlockHeightHeader is the gRPC header for block height.
 	GRPCBlockHeightHeader = "x-cosmos-block-height"
+
+	// GRPCQueryProveHeader is the gRPC header requesting the proofs of the
+	// store keys read by a query, it must be set to "true".
+	GRPCQueryProveHeader = "x-cosmos-query-prove"
+
+	// GRPCQueryProofsTrailer is the gRPC trailer holding the proofs of the
+	// store keys read by a query, one marshaled ProofOps per key.
+	GRPCQueryProofsTrailer = "x-cosmos-query-proofs-bin"
 )
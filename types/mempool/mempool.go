@@ -7,6 +7,18 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// Types of the built-in app-side mempool implementations, as configured in
+// app.toml.
+const (
+	// TypePriorityNonce orders txs by priority while preserving the nonce
+	// (sequence) ordering of the txs of each sender.
+	TypePriorityNonce = "priority-nonce"
+	// TypeSenderNonce orders txs by nonce, selecting senders randomly.
+	TypeSenderNonce = "sender-nonce"
+	// TypeNoOp disables the app-side mempool, leaving tx ordering to CometBFT.
+	TypeNoOp = "no-op"
+)
+
 type Mempool interface {
 	// Insert attempts to insert a Tx into the app-side mempool returning
 	// an error upon failure.
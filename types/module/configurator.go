@@ -46,6 +46,16 @@ type Configurator interface {
 	// will panic. If the ConsensusVersion bump does not introduce any store
 	// changes, then a no-op function must be registered here.
 	RegisterMigration(moduleName string, fromVersion uint64, handler MigrationHandler) error
+
+	// RegisterInvariant registers an invariant of a module under the given
+	// route. The invariants are registered on the invariant registry of the
+	// app by the module Manager, see Manager.RegisterInvariants.
+	RegisterInvariant(moduleName, route string, invariant sdk.Invariant)
+
+	// RegisterSimulation registers the simulation of a module. The simulations
+	// are added to the simulation manager of the app by the module Manager, see
+	// Manager.NewSimulationManager.
+	RegisterSimulation(moduleName string, simulation AppModuleSimulation)
 }
 
 type configurator struct {
@@ -56,6 +66,11 @@ type configurator struct {
 	// migrations is a map of moduleName -> fromVersion -> migration script handler
 	migrations map[string]map[uint64]MigrationHandler
 
+	// invariants and simulations registered by the modules, collected by the
+	// module Manager in RegisterServices
+	invariants  []moduleInvariant
+	simulations map[string]AppModuleSimulation
+
 	registryCache *protoregistry.Files
 	err           error
 }
@@ -91,6 +106,7 @@ func NewConfigurator(cdc codec.Codec, msgServer, queryServer grpc.Server) Config
 		msgServer:   msgServer,
 		queryServer: queryServer,
 		migrations:  map[string]map[uint64]MigrationHandler{},
+		simulations: map[string]AppModuleSimulation{},
 	}
 }
 
@@ -125,6 +141,16 @@ func (c *configurator) RegisterMigration(moduleName string, fromVersion uint64,
 	return nil
 }
 
+// RegisterInvariant implements the Configurator.RegisterInvariant method
+func (c *configurator) RegisterInvariant(moduleName, route string, invariant sdk.Invariant) {
+	c.invariants = append(c.invariants, moduleInvariant{moduleName: moduleName, route: route, invariant: invariant})
+}
+
+// RegisterSimulation implements the Configurator.RegisterSimulation method
+func (c *configurator) RegisterSimulation(moduleName string, simulation AppModuleSimulation) {
+	c.simulations[moduleName] = simulation
+}
+
 // runModuleMigrations runs all in-place store migrations for one given module from a
 // version to another version.
 func (c *configurator) runModuleMigrations(ctx sdk.Context, moduleName string, fromVersion, toVersion uint64) error {
@@ -192,6 +192,17 @@ type HasServices interface {
 	RegisterServices(Configurator)
 }
 
+// AppModuleV2 is the interface for modules which register all their services
+// through a single RegisterServices call: their Msg and Query services, their
+// invariants, their simulation and their store migrations are all registered
+// with the Configurator. Such modules do not need to implement HasInvariants
+// or AppModuleSimulation, which the module Manager otherwise probes for.
+type AppModuleV2 interface {
+	AppModule
+	HasServices
+	HasConsensusVersion
+}
+
 // HasConsensusVersion is the interface for declaring a module consensus version.
 type HasConsensusVersion interface {
 	// ConsensusVersion is a sequence number for state-breaking change of the
@@ -280,6 +291,18 @@ type Manager struct {
 	OrderPrepareCheckStaters []string
 	OrderPrecommiters        []string
 	OrderMigrations          []string
+
+	// invariants and simulations registered by the modules through the Configurator
+	invariants        []moduleInvariant
+	invariantRegistry sdk.InvariantRegistry
+	simulations       map[string]AppModuleSimulation
+}
+
+// moduleInvariant is an invariant registered by a module through the Configurator.
+type moduleInvariant struct {
+	moduleName string
+	route      string
+	invariant  sdk.Invariant
 }
 
 // NewManager creates a new Manager object.
@@ -358,9 +381,7 @@ func (m *Manager) SetOrderExportGenesis(moduleNames ...string) {
 func (m *Manager) SetOrderBeginBlockers(moduleNames ...string) {
 	m.assertNoForgottenModules("SetOrderBeginBlockers", moduleNames,
 		func(moduleName string) bool {
-			module := m.Modules[moduleName]
-			_, hasBeginBlock := module.(BeginBlockAppModule)
-			return !hasBeginBlock
+			return !hasBeginBlocker(m.Modules[moduleName])
 		})
 	m.OrderBeginBlockers = moduleNames
 }
@@ -369,9 +390,7 @@ func (m *Manager) SetOrderBeginBlockers(moduleNames ...string) {
 func (m *Manager) SetOrderEndBlockers(moduleNames ...string) {
 	m.assertNoForgottenModules("SetOrderEndBlockers", moduleNames,
 		func(moduleName string) bool {
-			module := m.Modules[moduleName]
-			_, hasEndBlock := module.(EndBlockAppModule)
-			return !hasEndBlock
+			return !hasEndBlocker(m.Modules[moduleName])
 		})
 	m.OrderEndBlockers = moduleNames
 }
@@ -405,16 +424,27 @@ func (m *Manager) SetOrderMigrations(moduleNames ...string) {
 	m.OrderMigrations = moduleNames
 }
 
-// RegisterInvariants registers all module invariants
+// RegisterInvariants registers all module invariants, those of the modules
+// implementing HasInvariants as well as those registered through the
+// Configurator in RegisterServices, whether RegisterServices is called before
+// or after RegisterInvariants.
 func (m *Manager) RegisterInvariants(ir sdk.InvariantRegistry) {
 	for _, module := range m.Modules {
 		if module, ok := module.(HasInvariants); ok {
 			module.RegisterInvariants(ir)
 		}
 	}
+
+	for _, inv := range m.invariants {
+		ir.RegisterRoute(inv.moduleName, inv.route, inv.invariant)
+	}
+
+	m.invariantRegistry = ir
 }
 
-// RegisterServices registers all module services
+// RegisterServices registers all module services. The modules implementing
+// AppModuleV2 register all their services, invariants, simulations and
+// migrations through the Configurator.
 func (m *Manager) RegisterServices(cfg Configurator) error {
 	for _, module := range m.Modules {
 		if module, ok := module.(HasServices); ok {
@@ -433,6 +463,25 @@ func (m *Manager) RegisterServices(cfg Configurator) error {
 		}
 	}
 
+	// collect the invariants and simulations registered through the configurator
+	if c, ok := cfg.(*configurator); ok {
+		for _, inv := range c.invariants {
+			// the invariants registered after RegisterInvariants are registered directly
+			if m.invariantRegistry != nil {
+				m.invariantRegistry.RegisterRoute(inv.moduleName, inv.route, inv.invariant)
+			}
+		}
+		m.invariants = append(m.invariants, c.invariants...)
+		c.invariants = nil
+
+		if m.simulations == nil {
+			m.simulations = make(map[string]AppModuleSimulation)
+		}
+		for moduleName, simulation := range c.simulations {
+			m.simulations[moduleName] = simulation
+		}
+	}
+
 	return nil
 }
 
@@ -563,11 +612,15 @@ func (m *Manager) checkModulesExists(moduleName []string) error {
 }
 
 // assertNoForgottenModules checks that we didn't forget any modules in the
-// SetOrder* functions.
+// SetOrder* functions, and that no module is listed twice.
 // `pass` is a closure which allows one to omit modules from `moduleNames`. If you provide non-nil `pass` and it returns true, the module would not be subject of the assertion.
+// Modules which are not registered in the manager may be listed, so that an order can be shared by several apps.
 func (m *Manager) assertNoForgottenModules(setOrderFnName string, moduleNames []string, pass func(moduleName string) bool) {
 	ms := make(map[string]bool)
 	for _, m := range moduleNames {
+		if ms[m] {
+			panic(fmt.Sprintf("module %s is listed more than once when setting %s", m, setOrderFnName))
+		}
 		ms[m] = true
 	}
 	var missing []string
@@ -588,6 +641,23 @@ func (m *Manager) assertNoForgottenModules(setOrderFnName string, moduleNames []
 	}
 }
 
+// hasBeginBlocker returns whether the module runs a begin blocker, either as a
+// legacy or as a core API module.
+func hasBeginBlocker(module interface{}) bool {
+	_, hasBeginBlock := module.(BeginBlockAppModule)
+	_, hasCoreBeginBlock := module.(appmodule.HasBeginBlocker)
+	return hasBeginBlock || hasCoreBeginBlock
+}
+
+// hasEndBlocker returns whether the module runs an end blocker, either as a
+// legacy or as a core API module.
+func hasEndBlocker(module interface{}) bool {
+	_, hasEndBlock := module.(EndBlockAppModule)
+	_, hasABCIEndBlock := module.(HasABCIEndblock)
+	_, hasCoreEndBlock := module.(appmodule.HasEndBlocker)
+	return hasEndBlock || hasABCIEndBlock || hasCoreEndBlock
+}
+
 // MigrationHandler is the migration function that each module registers.
 type MigrationHandler func(sdk.Context) error
 
@@ -831,3 +901,92 @@ func DefaultMigrationsOrder(modules []string) []string {
 	}
 	return out
 }
+
+// OrderByDependencies returns the module names sorted so that each module comes
+// after the modules it depends on, dependencies mapping the name of a module to
+// the names of the modules which must come before it. The modules are otherwise
+// kept in their order in moduleNames.
+// An error is returned if a module of dependencies is not in moduleNames or if
+// the dependencies contain a cycle.
+func OrderByDependencies(moduleNames []string, dependencies map[string][]string) ([]string, error) {
+	known := make(map[string]bool, len(moduleNames))
+	for _, name := range moduleNames {
+		known[name] = true
+	}
+
+	for name, deps := range dependencies {
+		if !known[name] {
+			return nil, fmt.Errorf("module %s has dependencies but is not in the order", name)
+		}
+		for _, dep := range deps {
+			if !known[dep] {
+				return nil, fmt.Errorf("module %s depends on module %s which is not in the order", name, dep)
+			}
+		}
+	}
+
+	ordered := make([]string, 0, len(moduleNames))
+	placed := make(map[string]bool, len(moduleNames))
+	for len(ordered) < len(moduleNames) {
+		progress := false
+		for _, name := range moduleNames {
+			if placed[name] {
+				continue
+			}
+
+			ready := true
+			for _, dep := range dependencies[name] {
+				if !placed[dep] {
+					ready = false
+					break
+				}
+			}
+
+			// start over after placing a module, so that the modules it
+			// unblocks keep their order in moduleNames
+			if ready {
+				ordered = append(ordered, name)
+				placed[name] = true
+				progress = true
+				break
+			}
+		}
+
+		if !progress {
+			var cycle []string
+			for _, name := range moduleNames {
+				if !placed[name] {
+					cycle = append(cycle, name)
+				}
+			}
+			return nil, fmt.Errorf("cannot order modules %v, their dependencies contain a cycle", cycle)
+		}
+	}
+
+	return ordered, nil
+}
+
+// SetOrderByDependencies sets the orders of InitGenesis, ExportGenesis,
+// BeginBlockers, EndBlockers, Precommiters and PrepareCheckStaters from the
+// dependencies between the modules, see OrderByDependencies. Modules without
+// dependencies between them are ordered by name.
+// Apps needing a different order for some of these calls can still set it
+// explicitly afterwards, for instance with OrderByDependencies.
+func (m *Manager) SetOrderByDependencies(dependencies map[string][]string) error {
+	moduleNames := m.ModuleNames()
+	sort.Strings(moduleNames)
+
+	order, err := OrderByDependencies(moduleNames, dependencies)
+	if err != nil {
+		return err
+	}
+
+	m.SetOrderInitGenesis(order...)
+	m.SetOrderExportGenesis(order...)
+	m.SetOrderBeginBlockers(order...)
+	m.SetOrderEndBlockers(order...)
+	m.SetOrderPrecommiters(order...)
+	m.SetOrderPrepareCheckStaters(order...)
+
+	return nil
+}
@@ -28,6 +28,7 @@ func (s *TestSuite) TestAssertNoForgottenModules() {
 		{"less modules", false, []string{"a"}, nil},
 		{"same modules", true, []string{"a", "b"}, nil},
 		{"more modules", true, []string{"a", "b", "c"}, nil},
+		{"duplicate modules", false, []string{"a", "b", "a"}, nil},
 		{"pass module b", true, []string{"a"}, func(moduleName string) bool { return moduleName == "b" }},
 	}
 
@@ -21,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/mock"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/simulation"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -152,6 +153,73 @@ func TestAssertNoForgottenModules(t *testing.T) {
 	require.PanicsWithValue(t, "all modules must be defined when setting SetOrderPrepareCheckStaters, missing: [module5]", func() {
 		mm.SetOrderPrepareCheckStaters("module2", "module1")
 	})
+
+	require.PanicsWithValue(t, "module module1 is listed more than once when setting SetOrderEndBlockers", func() {
+		mm.SetOrderEndBlockers("module1", "module3", "module1")
+	})
+}
+
+func TestAssertNoForgottenCoreAPIModules(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+	mm := module.NewManagerFromMap(map[string]appmodule.AppModule{
+		"module1": mock.NewMockCoreAppModule(mockCtrl),
+		"module2": MockCoreAppModule{},
+	})
+
+	require.PanicsWithValue(t, "all modules must be defined when setting SetOrderBeginBlockers, missing: [module1]", func() {
+		mm.SetOrderBeginBlockers("module2")
+	})
+	require.PanicsWithValue(t, "all modules must be defined when setting SetOrderEndBlockers, missing: [module1]", func() {
+		mm.SetOrderEndBlockers("module2")
+	})
+}
+
+func TestOrderByDependencies(t *testing.T) {
+	order, err := module.OrderByDependencies([]string{"a", "b", "c", "d"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b", "c", "d"}, order)
+
+	order, err = module.OrderByDependencies([]string{"a", "b", "c", "d"}, map[string][]string{
+		"a": {"c"},
+		"b": {"d", "a"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"c", "a", "d", "b"}, order)
+
+	_, err = module.OrderByDependencies([]string{"a", "b"}, map[string][]string{"a": {"c"}})
+	require.EqualError(t, err, "module a depends on module c which is not in the order")
+
+	_, err = module.OrderByDependencies([]string{"a", "b"}, map[string][]string{"c": {"a"}})
+	require.EqualError(t, err, "module c has dependencies but is not in the order")
+
+	_, err = module.OrderByDependencies([]string{"a", "b", "c"}, map[string][]string{
+		"a": {"b"},
+		"b": {"a"},
+	})
+	require.EqualError(t, err, "cannot order modules [a b], their dependencies contain a cycle")
+}
+
+func TestManager_SetOrderByDependencies(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+	mm := module.NewManagerFromMap(map[string]appmodule.AppModule{
+		"module1": mock.NewMockCoreAppModule(mockCtrl),
+		"module2": mock.NewMockCoreAppModule(mockCtrl),
+		"module3": MockCoreAppModule{},
+	})
+
+	require.NoError(t, mm.SetOrderByDependencies(map[string][]string{"module1": {"module3"}}))
+	expected := []string{"module2", "module3", "module1"}
+	require.Equal(t, expected, mm.OrderInitGenesis)
+	require.Equal(t, expected, mm.OrderExportGenesis)
+	require.Equal(t, expected, mm.OrderBeginBlockers)
+	require.Equal(t, expected, mm.OrderEndBlockers)
+	require.Equal(t, expected, mm.OrderPrecommiters)
+	require.Equal(t, expected, mm.OrderPrepareCheckStaters)
+
+	require.Error(t, mm.SetOrderByDependencies(map[string][]string{"module1": {"module4"}}))
+	require.Equal(t, expected, mm.OrderBeginBlockers)
 }
 
 func TestManagerOrderSetters(t *testing.T) {
@@ -644,3 +712,58 @@ var (
 	_ appmodule.HasGenesis  = MockCoreAppModule{}
 	_ appmodule.HasServices = MockCoreAppModule{}
 )
+
+// v2AppModule registers all its services through the Configurator.
+type v2AppModule struct {
+	module.AppModuleBasic
+}
+
+func (v2AppModule) Name() string { return "v2" }
+
+func (v2AppModule) ConsensusVersion() uint64 { return 1 }
+
+func (v2AppModule) RegisterServices(cfg module.Configurator) {
+	cfg.RegisterInvariant("v2", "total", func(sdk.Context) (string, bool) { return "", false })
+	cfg.RegisterSimulation("v2", v2Simulation{})
+}
+
+type v2Simulation struct{}
+
+func (v2Simulation) GenerateGenesisState(*module.SimulationState) {}
+
+func (v2Simulation) RegisterStoreDecoder(simulation.StoreDecoderRegistry) {}
+
+func (v2Simulation) WeightedOperations(module.SimulationState) []simulation.WeightedOperation {
+	return nil
+}
+
+func TestManager_RegisterServicesV2(t *testing.T) {
+	var _ module.AppModuleV2 = v2AppModule{}
+
+	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+
+	for _, invariantsFirst := range []bool{true, false} {
+		mockCtrl := gomock.NewController(t)
+
+		mm := module.NewManager(v2AppModule{})
+		cfg := module.NewConfigurator(cdc, mock.NewMockServer(mockCtrl), mock.NewMockServer(mockCtrl))
+
+		// the invariants are registered once, whether the services are registered
+		// before or after the invariants
+		ir := mock.NewMockInvariantRegistry(mockCtrl)
+		ir.EXPECT().RegisterRoute("v2", "total", gomock.Any()).Times(1)
+
+		if invariantsFirst {
+			mm.RegisterInvariants(ir)
+			require.NoError(t, mm.RegisterServices(cfg))
+		} else {
+			require.NoError(t, mm.RegisterServices(cfg))
+			mm.RegisterInvariants(ir)
+		}
+
+		sm := mm.NewSimulationManager(nil)
+		require.Equal(t, []module.AppModuleSimulation{v2Simulation{}}, sm.Modules)
+
+		mockCtrl.Finish()
+	}
+}
@@ -86,6 +86,26 @@ func NewSimulationManagerFromAppModules(modules map[string]interface{}, override
 	return NewSimulationManager(simModules...)
 }
 
+// NewSimulationManager creates a new SimulationManager object from the modules
+// of the Manager, like NewSimulationManagerFromAppModules. The simulations
+// registered by the modules through the Configurator are used for the modules
+// which do not implement AppModuleSimulation, so RegisterServices must be
+// called before.
+func (m *Manager) NewSimulationManager(overrideModules map[string]AppModuleSimulation) *SimulationManager {
+	modules := make(map[string]interface{}, len(m.Modules))
+	for moduleName, module := range m.Modules {
+		modules[moduleName] = module
+	}
+
+	for moduleName, simulation := range m.simulations {
+		if _, ok := modules[moduleName].(AppModuleSimulation); !ok {
+			modules[moduleName] = simulation
+		}
+	}
+
+	return NewSimulationManagerFromAppModules(modules, overrideModules)
+}
+
 // Deprecated: Use GetProposalMsgs instead.
 // GetProposalContents returns each module's proposal content generator function
 // with their default operation weight and key.
@@ -2,13 +2,14 @@ package simulation
 
 // Config contains the necessary configuration flags for the simulator
 type Config struct {
-	GenesisFile string // custom simulation genesis file; cannot be used with params file
-	ParamsFile  string // custom simulation params file which overrides any random params; cannot be used with genesis
+	GenesisFile string // custom simulation genesis file
+	ParamsFile  string // custom simulation params file (JSON or TOML) which overrides any random operation weights and params
 
 	ExportParamsPath   string // custom file path to save the exported params JSON
 	ExportParamsHeight int    // height to which export the randomly generated params
 	ExportStatePath    string // custom file path to save the exported app state JSON
 	ExportStatsPath    string // custom file path to save the exported simulation statistics JSON
+	ReproBundleDir     string // custom directory to save a reproduction bundle to if the simulation fails
 
 	Seed               int64  // simulation random seed
 	InitialBlockHeight int    // initial block to start the simulation
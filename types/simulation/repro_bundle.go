@@ -0,0 +1,68 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Files of a reproduction bundle.
+const (
+	ReproBundleFile           = "bundle.json"
+	ReproBundleParamsFile     = "params.json"
+	ReproBundleParamsTOMLFile = "params.toml"
+	ReproBundleGenesisFile    = "genesis.json"
+	ReproBundleOpsFile        = "ops.log"
+	ReproBundleAppStateFile   = "app_state.json"
+)
+
+// ReproBundle describes a failed simulation, for it to be replayed and debugged.
+// It is written to the directory of a reproduction bundle, along with the
+// params and genesis files of the simulation, the tail of its operations log and
+// the app state at the failure.
+type ReproBundle struct {
+	// Config is the config of the simulation, which includes its seed.
+	Config Config `json:"config"`
+	// Height is the height of the block at which the simulation failed.
+	Height int64 `json:"height"`
+	// Operations is the number of operations run before the failure.
+	Operations int `json:"operations"`
+	// Failure is the error or panic which failed the simulation.
+	Failure string `json:"failure"`
+	// LastAppHash is the hex encoded app hash of the last committed block.
+	LastAppHash string `json:"last_app_hash"`
+}
+
+// ReadReproBundle reads the reproduction bundle of the directory. The params and
+// genesis files of the config are set to those of the bundle.
+func ReadReproBundle(dir string) (ReproBundle, error) {
+	bz, err := os.ReadFile(filepath.Join(dir, ReproBundleFile))
+	if err != nil {
+		return ReproBundle{}, err
+	}
+
+	var bundle ReproBundle
+	if err := json.Unmarshal(bz, &bundle); err != nil {
+		return ReproBundle{}, fmt.Errorf("failed to parse reproduction bundle %s: %w", dir, err)
+	}
+
+	bundle.Config.ParamsFile = ""
+	for _, params := range []string{ReproBundleParamsFile, ReproBundleParamsTOMLFile} {
+		if paramsFile := filepath.Join(dir, params); fileExists(paramsFile) {
+			bundle.Config.ParamsFile = paramsFile
+		}
+	}
+
+	bundle.Config.GenesisFile = ""
+	if genesisFile := filepath.Join(dir, ReproBundleGenesisFile); fileExists(genesisFile) {
+		bundle.Config.GenesisFile = genesisFile
+	}
+
+	return bundle, nil
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
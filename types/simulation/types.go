@@ -2,9 +2,14 @@ package simulation
 
 import (
 	"encoding/json"
+	"fmt"
 	"math/rand"
+	"os"
+	"path/filepath"
 	"time"
 
+	"github.com/pelletier/go-toml/v2"
+
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -168,6 +173,38 @@ func (sp AppParams) GetOrGenerate(_ codec.JSONCodec, key string, ptr interface{}
 	ps(r)
 }
 
+// ReadAppParams reads the AppParams of a simulation from a JSON file or, if its
+// extension is .toml, from a TOML file, so that the operation weights and the
+// parameters can be tuned for each run.
+func ReadAppParams(path string) (AppParams, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	appParams := make(AppParams)
+	if filepath.Ext(path) != ".toml" {
+		if err := json.Unmarshal(bz, &appParams); err != nil {
+			return nil, fmt.Errorf("failed to parse simulation params file %s: %w", path, err)
+		}
+
+		return appParams, nil
+	}
+
+	var values map[string]interface{}
+	if err := toml.Unmarshal(bz, &values); err != nil {
+		return nil, fmt.Errorf("failed to parse simulation params file %s: %w", path, err)
+	}
+
+	for key, value := range values {
+		if appParams[key], err = json.Marshal(value); err != nil {
+			return nil, fmt.Errorf("failed to encode simulation param %s: %w", key, err)
+		}
+	}
+
+	return appParams, nil
+}
+
 type ParamSimulator func(r *rand.Rand)
 
 type SelectOpFn func(r *rand.Rand) Operation
@@ -0,0 +1,47 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestReadAppParams(t *testing.T) {
+	dir := t.TempDir()
+	expected := simulation.AppParams{
+		"op_weight_msg_send":  json.RawMessage(`50`),
+		"send_enabled":        json.RawMessage(`false`),
+		"unbonding_time":      json.RawMessage(`"1814400s"`),
+		"op_weight_msg_grant": json.RawMessage(`0`),
+	}
+
+	jsonFile := filepath.Join(dir, "params.json")
+	require.NoError(t, os.WriteFile(jsonFile, []byte(`{"op_weight_msg_send": 50, "send_enabled": false, "unbonding_time": "1814400s", "op_weight_msg_grant": 0}`), 0o600))
+	appParams, err := simulation.ReadAppParams(jsonFile)
+	require.NoError(t, err)
+	require.Equal(t, expected, appParams)
+
+	tomlFile := filepath.Join(dir, "params.toml")
+	require.NoError(t, os.WriteFile(tomlFile, []byte(`op_weight_msg_send = 50
+send_enabled = false
+unbonding_time = "1814400s"
+op_weight_msg_grant = 0
+`), 0o600))
+	appParams, err = simulation.ReadAppParams(tomlFile)
+	require.NoError(t, err)
+	require.Equal(t, expected, appParams)
+
+	var weight int
+	appParams.GetOrGenerate(nil, "op_weight_msg_send", &weight, nil, func(_ *rand.Rand) { weight = 100 })
+	require.Equal(t, 50, weight)
+
+	require.NoError(t, os.WriteFile(tomlFile, []byte(`op_weight_msg_send = `), 0o600))
+	_, err = simulation.ReadAppParams(tomlFile)
+	require.ErrorContains(t, err, "failed to parse simulation params file")
+}
@@ -209,4 +209,5 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Tx)(nil), &Tx{})
 
 	registry.RegisterInterface("cosmos.tx.v1beta1.TxExtensionOptionI", (*ExtensionOptionI)(nil))
+	registry.RegisterImplementations((*ExtensionOptionI)(nil), &ExtensionOptionUnordered{})
 }
@@ -0,0 +1,36 @@
+package tx
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// NewUnorderedExtensionOption returns the extension option marking a tx as
+// unordered.
+func NewUnorderedExtensionOption() *types.Any {
+	opt, err := types.NewAnyWithValue(&ExtensionOptionUnordered{})
+	if err != nil {
+		panic(err)
+	}
+
+	return opt
+}
+
+// IsUnorderedExtensionOption returns whether the given extension option marks
+// a tx as unordered.
+func IsUnorderedExtensionOption(opt *types.Any) bool {
+	return opt != nil && opt.TypeUrl == "/"+proto.MessageName(&ExtensionOptionUnordered{})
+}
+
+// HasUnorderedExtensionOption returns whether the given extension options
+// mark a tx as unordered.
+func HasUnorderedExtensionOption(opts []*types.Any) bool {
+	for _, opt := range opts {
+		if IsUnorderedExtensionOption(opt) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,269 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/tx/v1beta1/unordered.proto
+
+package tx
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ExtensionOptionUnordered is a tx extension option marking a tx as unordered:
+// the sequence of its signers is neither checked nor incremented, which allows
+// the concurrent submission of txs from a single account. An unordered tx must
+// set a timeout height, until which it is deduplicated by its hash.
+//
+// Since: cosmos-sdk 0.50
+type ExtensionOptionUnordered struct {
+}
+
+func (m *ExtensionOptionUnordered) Reset()         { *m = ExtensionOptionUnordered{} }
+func (m *ExtensionOptionUnordered) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionUnordered) ProtoMessage()    {}
+func (*ExtensionOptionUnordered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29d147d236189c5a, []int{0}
+}
+func (m *ExtensionOptionUnordered) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionUnordered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionUnordered.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionOptionUnordered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionUnordered.Merge(m, src)
+}
+func (m *ExtensionOptionUnordered) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionUnordered) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionUnordered.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionOptionUnordered proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ExtensionOptionUnordered)(nil), "cosmos.tx.v1beta1.ExtensionOptionUnordered")
+}
+
+func init() { proto.RegisterFile("cosmos/tx/v1beta1/unordered.proto", fileDescriptor_29d147d236189c5a) }
+
+var fileDescriptor_29d147d236189c5a = []byte{
+	// 149 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
+	0xcd, 0x2f, 0xd6, 0x2f, 0xa9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xcd,
+	0xcb, 0x2f, 0x4a, 0x49, 0x2d, 0x4a, 0x4d, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84,
+	0x28, 0xd1, 0x2b, 0xa9, 0xd0, 0x83, 0x2a, 0x51, 0x92, 0xe2, 0x92, 0x70, 0xad, 0x28, 0x49, 0xcd,
+	0x2b, 0xce, 0xcc, 0xcf, 0xf3, 0x2f, 0x28, 0xc9, 0xcc, 0xcf, 0x0b, 0x85, 0x69, 0x72, 0xb2, 0x3f,
+	0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63,
+	0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xd5, 0xf4, 0xcc, 0x92, 0x8c, 0xd2,
+	0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0xa8, 0xb5, 0x10, 0x4a, 0xb7, 0x38, 0x25, 0x5b, 0xbf, 0xa4,
+	0xb2, 0x20, 0x15, 0xe4, 0x8e, 0x24, 0x36, 0xb0, 0xb5, 0xc6, 0x80, 0x01, 0x00, 0xe4, 0x47, 0x84,
+	0xbf, 0x9b, 0x00, 0x00, 0x00,
+}
+
+func (m *ExtensionOptionUnordered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionUnordered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionUnordered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintUnordered(dAtA []byte, offset int, v uint64) int {
+	offset -= sovUnordered(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ExtensionOptionUnordered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovUnordered(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozUnordered(x uint64) (n int) {
+	return sovUnordered(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ExtensionOptionUnordered) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUnordered
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionUnordered: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionUnordered: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUnordered(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUnordered
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipUnordered(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowUnordered
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUnordered
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUnordered
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthUnordered
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupUnordered
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthUnordered
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthUnordered        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowUnordered          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupUnordered = fmt.Errorf("proto: unexpected end of group")
+)
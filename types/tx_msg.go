@@ -67,6 +67,15 @@ type (
 		GetTimeoutHeight() uint64
 	}
 
+	// TxWithUnordered extends the Tx interface by allowing a transaction to be
+	// unordered, i.e. executed without checking nor incrementing the sequence
+	// of its signers. Unordered transactions must set a timeout height.
+	TxWithUnordered interface {
+		TxWithTimeoutHeight
+
+		GetUnordered() bool
+	}
+
 	// HasValidateBasic defines a type that has a ValidateBasic method.
 	// ValidateBasic is deprecated and now facultative.
 	// Prefer validating messages directly in the msg server.
@@ -21,6 +21,20 @@ type HandlerOptions struct {
 	SignModeHandler        *txsigning.HandlerMap
 	SigGasConsumer         func(meter storetypes.GasMeter, sig signing.SignatureV2, params types.Params) error
 	TxFeeChecker           TxFeeChecker
+
+	// UnorderedTxManager deduplicates unordered txs. It defaults to the
+	// AccountKeeper when it implements UnorderedTxManager, otherwise unordered
+	// txs are rejected.
+	UnorderedTxManager UnorderedTxManager
+	// MaxUnorderedTxTimeout is the maximum number of blocks between the current
+	// block height and the timeout height of an unordered tx. It defaults to
+	// DefaultMaxUnorderedTxTimeout.
+	MaxUnorderedTxTimeout uint64
+
+	// GasCostsKeeper provides the gas costs charged for store accesses. It
+	// defaults to the AccountKeeper when it implements GasCostsKeeper,
+	// otherwise the default gas costs of the Context are used.
+	GasCostsKeeper GasCostsKeeper
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -39,11 +53,28 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
 
+	unorderedTxManager := options.UnorderedTxManager
+	if unorderedTxManager == nil {
+		unorderedTxManager, _ = options.AccountKeeper.(UnorderedTxManager)
+	}
+
+	maxUnorderedTxTimeout := options.MaxUnorderedTxTimeout
+	if maxUnorderedTxTimeout == 0 {
+		maxUnorderedTxTimeout = DefaultMaxUnorderedTxTimeout
+	}
+
+	gasCostsKeeper := options.GasCostsKeeper
+	if gasCostsKeeper == nil {
+		gasCostsKeeper, _ = options.AccountKeeper.(GasCostsKeeper)
+	}
+
 	anteDecorators := []sdk.AnteDecorator{
 		NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		NewSetGasCostsDecorator(gasCostsKeeper),
 		NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		NewValidateBasicDecorator(),
 		NewTxTimeoutHeightDecorator(),
+		NewUnorderedTxDecorator(maxUnorderedTxTimeout, unorderedTxManager),
 		NewValidateMemoDecorator(options.AccountKeeper),
 		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
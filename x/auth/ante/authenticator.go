@@ -0,0 +1,27 @@
+package ante
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AuthenticatorKeeper defines the contract needed to retrieve the
+// authenticators validating the txs of the accounts. The signature verification
+// decorators use it when the AccountKeeper implements it.
+type AuthenticatorKeeper interface {
+	GetAuthenticator(ctx context.Context, addr sdk.AccAddress) (types.AuthenticateFn, error)
+}
+
+// GetSignerAuthenticator returns the authenticator validating the txs of the
+// signer with the given address, nil if they are validated with the signer
+// pubkey.
+func GetSignerAuthenticator(ctx sdk.Context, ak AccountKeeper, addr sdk.AccAddress) (types.AuthenticateFn, error) {
+	authk, ok := ak.(AuthenticatorKeeper)
+	if !ok {
+		return nil, nil
+	}
+
+	return authk.GetAuthenticator(ctx, addr)
+}
@@ -0,0 +1,99 @@
+package ante_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestSigVerificationAuthenticator(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+
+	accPriv, _, addr := testdata.KeyTestPubAddr()
+	sessionPriv, _, _ := testdata.KeyTestPubAddr()
+	otherPriv, _, _ := testdata.KeyTestPubAddr()
+
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	// the session authenticator only accepts the signatures of the session key
+	suite.accountKeeper.AddAuthenticator("session", func(ctx sdk.Context, req types.AuthenticationRequest) error {
+		if req.Signature.PubKey == nil || !req.Signature.PubKey.Equals(sessionPriv.PubKey()) {
+			return errors.New("not signed by the session key")
+		}
+		ctx.GasMeter().ConsumeGas(1000, "session authenticator")
+		return req.VerifySignature(req.Signature.PubKey)
+	})
+
+	antehandler := sdk.ChainAnteDecorators(
+		ante.NewSetPubKeyDecorator(suite.accountKeeper),
+		ante.NewSigGasConsumeDecorator(suite.accountKeeper, ante.DefaultSigVerificationGasConsumer),
+		ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler()),
+	)
+
+	runTx := func(priv cryptotypes.PrivKey, badSig bool) error {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		signMode := signing.SignMode_SIGN_MODE_DIRECT
+		require.NoError(t, suite.txBuilder.SetSignatures(signing.SignatureV2{
+			PubKey:   priv.PubKey(),
+			Data:     &signing.SingleSignatureData{SignMode: signMode},
+			Sequence: 0,
+		}))
+		signerData := authsigning.SignerData{
+			Address:       addr.String(),
+			ChainID:       suite.ctx.ChainID(),
+			AccountNumber: acc.GetAccountNumber(),
+			Sequence:      0,
+			PubKey:        priv.PubKey(),
+		}
+		sig, err := tx.SignWithPrivKey(suite.ctx, signMode, signerData, suite.txBuilder, priv, suite.clientCtx.TxConfig, 0)
+		require.NoError(t, err)
+		if badSig {
+			sig.Data.(*signing.SingleSignatureData).Signature[0] ^= 0x01
+		}
+		require.NoError(t, suite.txBuilder.SetSignatures(sig))
+
+		txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(suite.txBuilder.GetTx())
+		require.NoError(t, err)
+		_, err = antehandler(suite.ctx.WithTxBytes(txBytes), suite.txBuilder.GetTx(), false)
+		return err
+	}
+
+	// without authenticator, only the account key can sign
+	require.ErrorIs(t, runTx(sessionPriv, false), sdkerrors.ErrInvalidPubKey)
+
+	require.Error(t, suite.accountKeeper.SetAccountAuthenticator(suite.ctx, addr, "unknown"))
+	require.NoError(t, suite.accountKeeper.SetAccountAuthenticator(suite.ctx, addr, "session"))
+
+	// with the session authenticator, the session key signs for the account
+	require.NoError(t, runTx(sessionPriv, false))
+	require.Nil(t, suite.accountKeeper.GetAccount(suite.ctx, addr).GetPubKey())
+
+	require.ErrorIs(t, runTx(sessionPriv, true), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, runTx(otherPriv, false), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, runTx(accPriv, false), sdkerrors.ErrUnauthorized)
+
+	// the account key signs again once the authenticator is removed
+	require.NoError(t, suite.accountKeeper.SetAccountAuthenticator(suite.ctx, addr, ""))
+	require.NoError(t, runTx(accPriv, false))
+	require.Equal(t, accPriv.PubKey(), suite.accountKeeper.GetAccount(suite.ctx, addr).GetPubKey())
+
+	// the txs are rejected if the authenticator of the account is no longer registered
+	require.NoError(t, suite.accountKeeper.AccountAuthenticators.Set(suite.ctx, addr, "removed"))
+	require.ErrorIs(t, runTx(accPriv, false), sdkerrors.ErrUnauthorized)
+}
@@ -4,6 +4,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 type HasExtensionOptionsTx interface {
@@ -21,7 +22,8 @@ func rejectExtensionOption(*codectypes.Any) bool {
 }
 
 // RejectExtensionOptionsDecorator is an AnteDecorator that rejects all extension
-// options which can optionally be included in protobuf transactions. Users that
+// options which can optionally be included in protobuf transactions, except the
+// ExtensionOptionUnordered option handled by the UnorderedTxDecorator. Users that
 // need extension options should create a custom AnteHandler chain that handles
 // needed extension options properly and rejects unknown ones.
 type RejectExtensionOptionsDecorator struct {
@@ -55,6 +57,10 @@ func (r RejectExtensionOptionsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx,
 func checkExtOpts(tx sdk.Tx, checker ExtensionOptionChecker) error {
 	if hasExtOptsTx, ok := tx.(HasExtensionOptionsTx); ok {
 		for _, opt := range hasExtOptsTx.GetExtensionOptions() {
+			if txtypes.IsUnorderedExtensionOption(opt) {
+				continue
+			}
+
 			if !checker(opt) {
 				return sdkerrors.ErrUnknownExtensionOptions
 			}
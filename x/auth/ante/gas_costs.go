@@ -0,0 +1,45 @@
+package ante
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// GasCostsKeeper defines the contract needed to read the on-chain gas costs
+// charged for store accesses.
+type GasCostsKeeper interface {
+	GetGasCosts(ctx context.Context) types.GasCosts
+}
+
+// SetGasCostsDecorator sets the KV store gas config of the Context from the
+// on-chain gas costs, so that the store accesses of the remaining decorators
+// and of the tx messages are metered with them.
+// CONTRACT: Should be right after the SetUpContextDecorator in the chain
+type SetGasCostsDecorator struct {
+	gk GasCostsKeeper
+}
+
+// NewSetGasCostsDecorator returns a new SetGasCostsDecorator. The gas config of
+// the Context is left untouched when the keeper is nil.
+func NewSetGasCostsDecorator(gk GasCostsKeeper) SetGasCostsDecorator {
+	return SetGasCostsDecorator{
+		gk: gk,
+	}
+}
+
+func (sgcd SetGasCostsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if sgcd.gk == nil {
+		return next(ctx, tx, simulate)
+	}
+
+	// Reading the gas costs isn't charged to the tx, as it is done for every
+	// tx, before the gas costs to charge are even known.
+	gasCosts := sgcd.gk.GetGasCosts(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()))
+	ctx = ctx.WithKVGasConfig(gasCosts.GasConfig())
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,45 @@
+package ante_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestSetGasCostsDecorator(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+
+	var gasConfig storetypes.GasConfig
+	terminator := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		gasConfig = ctx.KVGasConfig()
+		return ctx, nil
+	}
+
+	// the default gas costs are used when none are set in the params
+	params := suite.accountKeeper.GetParams(suite.ctx)
+	params.GasCosts = nil
+	require.NoError(t, suite.accountKeeper.SetParams(suite.ctx, params))
+
+	_, err := ante.NewSetGasCostsDecorator(suite.accountKeeper).AnteHandle(suite.ctx, nil, false, terminator)
+	require.NoError(t, err)
+	require.Equal(t, storetypes.KVGasConfig(), gasConfig)
+
+	gasCosts := types.DefaultGasCosts()
+	gasCosts.WriteCostPerByte = 100
+	params.GasCosts = &gasCosts
+	require.NoError(t, suite.accountKeeper.SetParams(suite.ctx, params))
+
+	_, err = ante.NewSetGasCostsDecorator(suite.accountKeeper).AnteHandle(suite.ctx, nil, false, terminator)
+	require.NoError(t, err)
+	require.Equal(t, gasCosts.GasConfig(), gasConfig)
+
+	// the gas config is left untouched without keeper
+	_, err = ante.NewSetGasCostsDecorator(nil).AnteHandle(suite.ctx, nil, false, terminator)
+	require.NoError(t, err)
+	require.Equal(t, storetypes.KVGasConfig(), gasConfig)
+}
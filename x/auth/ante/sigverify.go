@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/registry"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
@@ -80,6 +81,16 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 			}
 			pk = simSecp256k1Pubkey
 		}
+		// The txs of the accounts with an authenticator may be signed with other
+		// keys than the account one, which is left untouched
+		authenticate, err := GetSignerAuthenticator(ctx, spkd.ak, signers[i])
+		if err != nil {
+			return ctx, err
+		}
+		if authenticate != nil {
+			continue
+		}
+
 		// Only make check if simulate=false
 		if !simulate && !bytes.Equal(pk.Address(), signers[i]) {
 			return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey,
@@ -176,6 +187,20 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 
 		pubKey := signerAcc.GetPubKey()
 
+		// The signatures of the accounts with an authenticator are charged
+		// according to the pubkey of the tx signer infos, the authenticator
+		// consuming the gas of any additional verification.
+		authenticate, err := GetSignerAuthenticator(ctx, sgcd.ak, signerAddrs[i])
+		if err != nil {
+			return ctx, err
+		}
+		if authenticate != nil {
+			pubKey = sig.PubKey
+			if pubKey == nil {
+				pubKey = simSecp256k1Pubkey
+			}
+		}
+
 		// In simulate mode the transaction comes with no signatures, thus if the
 		// account's pubkey is nil, both signature verification and gasKVStore.Set()
 		// shall consume the largest amount, i.e. it takes more gas to verify
@@ -258,20 +283,30 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		return ctx, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signerAddrs), len(sigs))
 	}
 
+	unordered := IsUnorderedTx(tx)
 	for i, sig := range sigs {
 		acc, err := GetSignerAcc(ctx, svd.ak, signerAddrs[i])
 		if err != nil {
 			return ctx, err
 		}
 
-		// retrieve pubkey
+		authenticate, err := GetSignerAuthenticator(ctx, svd.ak, signerAddrs[i])
+		if err != nil {
+			return ctx, err
+		}
+
+		// retrieve pubkey, which the accounts with an authenticator don't need
 		pubKey := acc.GetPubKey()
-		if !simulate && pubKey == nil {
+		if !simulate && pubKey == nil && authenticate == nil {
 			return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
 		}
 
-		// Check account sequence number.
-		if sig.Sequence != acc.GetSequence() {
+		// Check account sequence number, unless the tx is unordered in which
+		// case the sequence is only part of the signed data.
+		seq := acc.GetSequence()
+		if unordered {
+			seq = sig.Sequence
+		} else if sig.Sequence != acc.GetSequence() {
 			return ctx, errorsmod.Wrapf(
 				sdkerrors.ErrWrongSequence,
 				"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
@@ -288,18 +323,6 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 
 		// no need to verify signatures on recheck tx
 		if !simulate && !ctx.IsReCheckTx() {
-			anyPk, _ := codectypes.NewAnyWithValue(pubKey)
-
-			signerData := txsigning.SignerData{
-				Address:       acc.GetAddress().String(),
-				ChainID:       chainID,
-				AccountNumber: accNum,
-				Sequence:      acc.GetSequence(),
-				PubKey: &anypb.Any{
-					TypeUrl: anyPk.TypeUrl,
-					Value:   anyPk.Value,
-				},
-			}
 			decodeCtx, err := decode.NewDecoder(decode.Options{ProtoFiles: registry.MergedProtoRegistry()})
 			if err != nil {
 				return ctx, err
@@ -316,13 +339,42 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 				AuthInfoBytes: decodedTx.TxRaw.AuthInfoBytes,
 				BodyBytes:     decodedTx.TxRaw.BodyBytes,
 			}
-			err = authsigning.VerifySignature(ctx, pubKey, signerData, sig.Data, svd.signModeHandler, txData)
+			verify := func(pubKey cryptotypes.PubKey) error {
+				anyPk, _ := codectypes.NewAnyWithValue(pubKey)
+
+				signerData := txsigning.SignerData{
+					Address:       acc.GetAddress().String(),
+					ChainID:       chainID,
+					AccountNumber: accNum,
+					Sequence:      seq,
+					PubKey: &anypb.Any{
+						TypeUrl: anyPk.TypeUrl,
+						Value:   anyPk.Value,
+					},
+				}
+				return authsigning.VerifySignature(ctx, pubKey, signerData, sig.Data, svd.signModeHandler, txData)
+			}
+
+			if authenticate != nil {
+				err = authenticate(ctx, types.AuthenticationRequest{
+					Account:         acc,
+					Tx:              tx,
+					Signature:       sig,
+					VerifySignature: verify,
+				})
+				if err != nil {
+					return ctx, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "authentication failed for account %s: %s", acc.GetAddress(), err)
+				}
+				continue
+			}
+
+			err = verify(pubKey)
 			if err != nil {
 				var errMsg string
 				if OnlyLegacyAminoSigners(sig.Data) {
 					// If all signers are using SIGN_MODE_LEGACY_AMINO, we rely on VerifySignature to check account sequence number,
 					// and therefore communicate sequence number as a potential cause of error.
-					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, acc.GetSequence(), chainID)
+					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, seq, chainID)
 				} else {
 					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d) and chain-id (%s): (%s)", accNum, chainID, err.Error())
 				}
@@ -360,6 +412,11 @@ func (isd IncrementSequenceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
 	}
 
+	// the sequence of the signers of unordered txs is left untouched
+	if IsUnorderedTx(tx) {
+		return next(ctx, tx, simulate)
+	}
+
 	// increment sequence of all signers
 	for _, addr := range sigTx.GetSigners() {
 		acc := isd.ak.GetAccount(ctx, addr)
@@ -427,6 +484,10 @@ func DefaultSigVerificationGasConsumer(
 		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: secp256k1")
 		return nil
 
+	case *ethsecp256k1.PubKey:
+		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: eth_secp256k1")
+		return nil
+
 	case *secp256r1.PubKey:
 		meter.ConsumeGas(params.SigVerifyCostSecp256r1(), "ante verify: secp256r1")
 		return nil
@@ -0,0 +1,157 @@
+package ante
+
+import (
+	"context"
+	"crypto/sha256"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// DefaultMaxUnorderedTxTimeout defines the default maximum number of blocks
+// between the current block height and the timeout height of an unordered tx.
+const DefaultMaxUnorderedTxTimeout = 1024
+
+// UnorderedTxManager defines the contract needed to deduplicate unordered txs
+// until their timeout height.
+type UnorderedTxManager interface {
+	ContainsUnorderedTx(ctx context.Context, timeoutHeight uint64, txHash []byte) (bool, error)
+	AddUnorderedTx(ctx context.Context, timeoutHeight uint64, txHash []byte) error
+}
+
+// IsUnorderedTx returns whether the tx is unordered, in which case the
+// sequence of its signers is neither checked nor incremented.
+func IsUnorderedTx(tx sdk.Tx) bool {
+	unorderedTx, ok := tx.(sdk.TxWithUnordered)
+	return ok && unorderedTx.GetUnordered()
+}
+
+// UnorderedTxHash returns the hash under which the unordered tx encoded as
+// txBytes is deduplicated: the SHA-256 hash of the tx without its signatures.
+// Signatures are left out as they may be re-encoded, or signed again, without
+// invalidating the tx, which could then be replayed. The hash only identifies
+// the tx for the sign modes committing to both its body and auth info bytes,
+// which is why UnorderedTxDecorator only accepts SIGN_MODE_DIRECT and
+// SIGN_MODE_TEXTUAL signatures.
+func UnorderedTxHash(txBytes []byte) ([]byte, error) {
+	var raw tx.TxRaw
+	if err := raw.Unmarshal(txBytes); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+
+	bz, err := (&tx.TxRaw{BodyBytes: raw.BodyBytes, AuthInfoBytes: raw.AuthInfoBytes}).Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(bz)
+	return hash[:], nil
+}
+
+// UnorderedTxDecorator rejects the unordered txs which don't set a timeout
+// height, set a timeout height too far in the future, are signed with a sign
+// mode other than SIGN_MODE_DIRECT or SIGN_MODE_TEXTUAL, or were already
+// executed before their timeout height. Since the sequence of the signers of unordered
+// txs is not checked, this decorator is what prevents them from being
+// replayed, and must be part of any ante handler chain accepting them.
+type UnorderedTxDecorator struct {
+	maxTimeout uint64
+	manager    UnorderedTxManager
+}
+
+// NewUnorderedTxDecorator returns a new UnorderedTxDecorator. Unordered txs are
+// rejected when the manager is nil.
+func NewUnorderedTxDecorator(maxTimeout uint64, manager UnorderedTxManager) UnorderedTxDecorator {
+	return UnorderedTxDecorator{
+		maxTimeout: maxTimeout,
+		manager:    manager,
+	}
+}
+
+func (utd UnorderedTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !IsUnorderedTx(tx) {
+		return next(ctx, tx, simulate)
+	}
+
+	if utd.manager == nil {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrNotSupported, "unordered txs are not supported")
+	}
+
+	timeoutHeight := tx.(sdk.TxWithUnordered).GetTimeoutHeight()
+	if timeoutHeight == 0 {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unordered tx must set a timeout height")
+	}
+
+	if maxTimeoutHeight := uint64(ctx.BlockHeight()) + utd.maxTimeout; timeoutHeight > maxTimeoutHeight {
+		return ctx, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"unordered tx timeout height cannot be greater than %d; got: %d", maxTimeoutHeight, timeoutHeight,
+		)
+	}
+
+	// The other sign modes don't commit to both the body and auth info bytes,
+	// e.g. the amino JSON sign bytes don't commit to the tx encoding, and
+	// SIGN_MODE_DIRECT_AUX signers don't sign the auth info of the fee payer,
+	// so the same signatures could be replayed in a tx with a new hash.
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+	for _, sig := range sigs {
+		if mode, ok := unorderedSignModesOnly(sig.Data); !ok {
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrNotSupported, "unordered txs cannot be signed with %s", mode)
+		}
+	}
+
+	txHash, err := UnorderedTxHash(ctx.TxBytes())
+	if err != nil {
+		return ctx, err
+	}
+
+	executed, err := utd.manager.ContainsUnorderedTx(ctx, timeoutHeight, txHash)
+	if err != nil {
+		return ctx, err
+	}
+	if executed {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unordered tx %X is duplicated", txHash)
+	}
+
+	if err := utd.manager.AddUnorderedTx(ctx, timeoutHeight, txHash); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// unorderedSignModesOnly returns whether the signature data, and all the
+// signatures of a multisig, use a sign mode accepted for unordered txs. If not,
+// the first sign mode which isn't accepted is returned.
+func unorderedSignModesOnly(data signing.SignatureData) (signing.SignMode, bool) {
+	switch data := data.(type) {
+	case *signing.SingleSignatureData:
+		switch data.SignMode {
+		case signing.SignMode_SIGN_MODE_DIRECT, signing.SignMode_SIGN_MODE_TEXTUAL:
+			return data.SignMode, true
+		default:
+			return data.SignMode, false
+		}
+	case *signing.MultiSignatureData:
+		for _, s := range data.Signatures {
+			if mode, ok := unorderedSignModesOnly(s); !ok {
+				return mode, false
+			}
+		}
+		return signing.SignMode_SIGN_MODE_UNSPECIFIED, true
+	}
+
+	return signing.SignMode_SIGN_MODE_UNSPECIFIED, false
+}
@@ -0,0 +1,295 @@
+package ante_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	secp "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/stretchr/testify/require"
+
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+func TestUnorderedTx(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		timeoutHeight uint64
+		expErr        error
+	}{
+		{"valid unordered tx", 10, nil},
+		{"no timeout height", 0, sdkerrors.ErrInvalidRequest},
+		{"timeout height too far", 1 + ante.DefaultMaxUnorderedTxTimeout + 1, sdkerrors.ErrInvalidRequest},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			suite := SetupTestSuite(t, false)
+			accs := suite.CreateTestAccounts(1)
+			acc, priv := accs[0].acc, accs[0].priv
+
+			require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(acc.GetAddress())))
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+			suite.txBuilder.SetTimeoutHeight(tc.timeoutHeight)
+			suite.txBuilder.(interface{ SetUnordered(bool) }).SetUnordered(true)
+
+			// the sequence of an unordered tx is not checked
+			theTx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{priv}, []uint64{acc.GetAccountNumber()}, []uint64{42}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+			require.NoError(t, err)
+			require.True(t, ante.IsUnorderedTx(theTx))
+
+			txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(theTx)
+			require.NoError(t, err)
+			ctx := suite.ctx.WithTxBytes(txBytes)
+
+			_, err = suite.anteHandler(ctx, theTx, false)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			// the sequence of the signer is not incremented
+			require.Equal(t, uint64(0), suite.accountKeeper.GetAccount(ctx, acc.GetAddress()).GetSequence())
+
+			// the same tx is rejected until its timeout height
+			_, err = suite.anteHandler(ctx, theTx, false)
+			require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+			txHash, err := ante.UnorderedTxHash(txBytes)
+			require.NoError(t, err)
+			contains, err := suite.accountKeeper.ContainsUnorderedTx(ctx, tc.timeoutHeight, txHash)
+			require.NoError(t, err)
+			require.True(t, contains)
+
+			ctx = ctx.WithBlockHeight(int64(tc.timeoutHeight))
+			require.NoError(t, suite.accountKeeper.RemoveExpiredUnorderedTxs(ctx))
+			contains, err = suite.accountKeeper.ContainsUnorderedTx(ctx, tc.timeoutHeight, txHash)
+			require.NoError(t, err)
+			require.False(t, contains)
+		})
+	}
+}
+
+func TestUnorderedTxReplayWithNewSignature(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	accs := suite.CreateTestAccounts(1)
+	acc, priv := accs[0].acc, accs[0].priv
+
+	require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(acc.GetAddress())))
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	suite.txBuilder.SetTimeoutHeight(10)
+	suite.txBuilder.(interface{ SetUnordered(bool) }).SetUnordered(true)
+
+	theTx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{priv}, []uint64{acc.GetAccountNumber()}, []uint64{0}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(theTx)
+	require.NoError(t, err)
+
+	_, err = suite.anteHandler(suite.ctx.WithTxBytes(txBytes), theTx, false)
+	require.NoError(t, err)
+
+	// sign the same tx again, with another nonce than the deterministic one
+	signerData := authsigning.SignerData{
+		Address:       acc.GetAddress().String(),
+		ChainID:       suite.ctx.ChainID(),
+		AccountNumber: acc.GetAccountNumber(),
+		Sequence:      0,
+		PubKey:        priv.PubKey(),
+	}
+	signBytes, err := authsigning.GetSignBytesAdapter(
+		suite.ctx, suite.clientCtx.TxConfig.TxEncoder(), suite.clientCtx.TxConfig.SignModeHandler(),
+		signing.SignMode_SIGN_MODE_DIRECT, signerData, theTx)
+	require.NoError(t, err)
+
+	sig := signWithRandomNonce(t, priv.Bytes(), signBytes)
+	require.True(t, priv.PubKey().VerifySignature(signBytes, sig))
+
+	require.NoError(t, suite.txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   priv.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: sig},
+		Sequence: 0,
+	}))
+	replayTx := suite.txBuilder.GetTx()
+	replayBytes, err := suite.clientCtx.TxConfig.TxEncoder()(replayTx)
+	require.NoError(t, err)
+	require.NotEqual(t, txBytes, replayBytes)
+
+	_, err = suite.anteHandler(suite.ctx.WithTxBytes(replayBytes), replayTx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.ErrorContains(t, err, "is duplicated")
+}
+
+func TestUnorderedTxReencodedAminoJSON(t *testing.T) {
+	// SIGN_MODE_EIP_191 signs the amino JSON sign bytes too
+	for _, signMode := range []signing.SignMode{signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signing.SignMode_SIGN_MODE_EIP_191} {
+		t.Run(signMode.String(), func(t *testing.T) {
+			suite := SetupTestSuite(t, false)
+			accs := suite.CreateTestAccounts(1)
+			acc, priv := accs[0].acc, accs[0].priv
+
+			require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(acc.GetAddress())))
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+			suite.txBuilder.SetTimeoutHeight(10)
+			suite.txBuilder.(interface{ SetUnordered(bool) }).SetUnordered(true)
+
+			// the amino JSON sign bytes cannot be computed for an unordered tx, whose
+			// body holds an extension option, so only the sign mode is swapped here:
+			// the decorator must reject the tx before any signature is verified
+			_, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{priv}, []uint64{acc.GetAccountNumber()}, []uint64{0}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+			require.NoError(t, err)
+			sigs, err := suite.txBuilder.GetTx().GetSignaturesV2()
+			require.NoError(t, err)
+			sigs[0].Data.(*signing.SingleSignatureData).SignMode = signMode
+			require.NoError(t, suite.txBuilder.SetSignatures(sigs...))
+			theTx := suite.txBuilder.GetTx()
+			txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(theTx)
+			require.NoError(t, err)
+
+			_, err = suite.anteHandler(suite.ctx.WithTxBytes(txBytes), theTx, false)
+			require.ErrorIs(t, err, sdkerrors.ErrNotSupported)
+
+			// re-encode the same tx with a non-critical unknown field in its body, which
+			// changes its hash but not the JSON the amino sign bytes are derived from
+			var raw tx.TxRaw
+			require.NoError(t, raw.Unmarshal(txBytes))
+			raw.BodyBytes = append(raw.BodyBytes, 0x88, 0x40, 0x01) // field 1025, varint 1
+			reencodedBytes, err := raw.Marshal()
+			require.NoError(t, err)
+			reencodedTx, err := suite.clientCtx.TxConfig.TxDecoder()(reencodedBytes)
+			require.NoError(t, err)
+
+			txHash, err := ante.UnorderedTxHash(txBytes)
+			require.NoError(t, err)
+			reencodedHash, err := ante.UnorderedTxHash(reencodedBytes)
+			require.NoError(t, err)
+			require.NotEqual(t, txHash, reencodedHash)
+
+			_, err = suite.anteHandler(suite.ctx.WithTxBytes(reencodedBytes), reencodedTx, false)
+			require.ErrorIs(t, err, sdkerrors.ErrNotSupported)
+			require.ErrorContains(t, err, signMode.String())
+		})
+	}
+}
+
+func TestUnorderedTxReplayDirectAuxWithNewFee(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	accs := suite.CreateTestAccounts(2)
+	signer, feePayer := accs[0], accs[1]
+
+	require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(signer.acc.GetAddress())))
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetFeePayer(feePayer.acc.GetAddress())
+	suite.txBuilder.SetTimeoutHeight(10)
+	suite.txBuilder.(interface{ SetUnordered(bool) }).SetUnordered(true)
+
+	signerData := func(acc TestAccount) authsigning.SignerData {
+		return authsigning.SignerData{
+			Address:       acc.acc.GetAddress().String(),
+			ChainID:       suite.ctx.ChainID(),
+			AccountNumber: acc.acc.GetAccountNumber(),
+			Sequence:      0,
+			PubKey:        acc.priv.PubKey(),
+		}
+	}
+	sign := func(acc TestAccount, signMode signing.SignMode) signing.SignatureV2 {
+		sig, err := clienttx.SignWithPrivKey(suite.ctx, signMode, signerData(acc), suite.txBuilder, acc.priv, suite.clientCtx.TxConfig, 0)
+		require.NoError(t, err)
+		return sig
+	}
+	setSignatures := func(auxSig signing.SignatureV2) {
+		// the signer infos must be set before the fee payer signs
+		require.NoError(t, suite.txBuilder.SetSignatures(auxSig, signing.SignatureV2{
+			PubKey:   feePayer.priv.PubKey(),
+			Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+			Sequence: 0,
+		}))
+		require.NoError(t, suite.txBuilder.SetSignatures(auxSig, sign(feePayer, signing.SignMode_SIGN_MODE_DIRECT)))
+	}
+
+	// the signer only signs the body and its own signer info
+	require.NoError(t, suite.txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   signer.priv.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT_AUX},
+		Sequence: 0,
+	}))
+	auxSig := sign(signer, signing.SignMode_SIGN_MODE_DIRECT_AUX)
+	setSignatures(auxSig)
+	theTx := suite.txBuilder.GetTx()
+	txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(theTx)
+	require.NoError(t, err)
+
+	_, err = suite.anteHandler(suite.ctx.WithTxBytes(txBytes), theTx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrNotSupported)
+
+	// the fee payer rewraps the signature of the signer with another fee
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount().Add(testdata.NewTestFeeAmount()...))
+	setSignatures(auxSig)
+	replayTx := suite.txBuilder.GetTx()
+	replayBytes, err := suite.clientCtx.TxConfig.TxEncoder()(replayTx)
+	require.NoError(t, err)
+
+	signBytes, err := authsigning.GetSignBytesAdapter(
+		suite.ctx, suite.clientCtx.TxConfig.TxEncoder(), suite.clientCtx.TxConfig.SignModeHandler(),
+		signing.SignMode_SIGN_MODE_DIRECT_AUX, signerData(signer), replayTx)
+	require.NoError(t, err)
+	require.True(t, signer.priv.PubKey().VerifySignature(signBytes, auxSig.Data.(*signing.SingleSignatureData).Signature))
+
+	txHash, err := ante.UnorderedTxHash(txBytes)
+	require.NoError(t, err)
+	replayHash, err := ante.UnorderedTxHash(replayBytes)
+	require.NoError(t, err)
+	require.NotEqual(t, txHash, replayHash)
+
+	_, err = suite.anteHandler(suite.ctx.WithTxBytes(replayBytes), replayTx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrNotSupported)
+	require.ErrorContains(t, err, "SIGN_MODE_DIRECT_AUX")
+}
+
+// signWithRandomNonce returns a valid secp256k1 signature, in lower-S form, of
+// the SHA-256 hash of msg, which differs from the one of PrivKey.Sign.
+func signWithRandomNonce(t *testing.T, privBz, msg []byte) []byte {
+	t.Helper()
+
+	nonce, err := secp.GeneratePrivateKey()
+	require.NoError(t, err)
+	k := nonce.Key
+
+	var point secp.JacobianPoint
+	secp.ScalarBaseMultNonConst(&k, &point)
+	point.ToAffine()
+
+	var r, d, e, s secp.ModNScalar
+	r.SetByteSlice(point.X.Bytes()[:])
+	d.SetByteSlice(privBz)
+	hash := sha256.Sum256(msg)
+	e.SetByteSlice(hash[:])
+
+	// s = k^-1 * (e + r * d)
+	s.Mul2(&r, &d).Add(&e).Mul(k.InverseNonConst())
+	if s.IsOverHalfOrder() {
+		s.Negate()
+	}
+
+	rBz, sBz := r.Bytes(), s.Bytes()
+	return append(rBz[:], sBz[:]...)
+}
+
+func TestUnorderedTxDecoratorNotSupported(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+
+	suite.txBuilder.SetTimeoutHeight(10)
+	suite.txBuilder.(interface{ SetUnordered(bool) }).SetUnordered(true)
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(ante.DefaultMaxUnorderedTxTimeout, nil))
+	_, err := antehandler(suite.ctx, suite.txBuilder.GetTx(), false)
+	require.ErrorIs(t, err, sdkerrors.ErrNotSupported)
+}
@@ -411,6 +411,51 @@ $ %s query tx --%s=%s <sig1_base64>,<sig2_base64...>
 	return cmd
 }
 
+// QueryBlockResultsCmd implements a command that fetches a committed block and
+// prints every transaction it contains in decoded form.
+func QueryBlockResultsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-results [height]",
+		Short: "Query the decoded transactions, events and gas usage of a committed block",
+		Long: `Fetch a committed block and its execution results and decode every transaction
+with the application's TxConfig. For each transaction the decoded messages, the
+emitted events and the gas wanted and used are printed. If no height is given,
+the latest committed block is queried.
+`,
+		Example: fmt.Sprintf("$ %s query block-results 42 --output json", version.AppName),
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var height *int64
+			if len(args) > 0 {
+				h, err := strconv.ParseInt(args[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid block height %s: %w", args[0], err)
+				}
+				if h <= 0 {
+					return fmt.Errorf("block height must be positive, got %d", h)
+				}
+				height = &h
+			}
+
+			txs, err := authtx.QueryBlockTxs(clientCtx, height)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(txs)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // ParseSigArgs parses comma-separated signatures from the CLI arguments.
 func ParseSigArgs(args []string) ([]string, error) {
 	if len(args) != 1 || args[0] == "" {
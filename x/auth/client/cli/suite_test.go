@@ -4,13 +4,17 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/math"
 	abci "github.com/cometbft/cometbft/abci/types"
 	rpcclientmock "github.com/cometbft/cometbft/rpc/client/mock"
+	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -287,6 +291,80 @@ func (s *CLITestSuite) TestCLIQueryTxCmdByEvents() {
 	}
 }
 
+func (s *CLITestSuite) TestCLIQueryBlockResultsCmd() {
+	testCases := []struct {
+		name   string
+		args   []string
+		expErr string
+	}{
+		{
+			"too many args",
+			[]string{"1", "2"},
+			"accepts at most 1 arg(s)",
+		},
+		{
+			"non-numeric height",
+			[]string{"foo"},
+			"invalid block height foo",
+		},
+		{
+			"non-positive height",
+			[]string{"0"},
+			"block height must be positive",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := clitestutil.ExecTestCLICmd(s.clientCtx, authcli.QueryBlockResultsCmd(), tc.args)
+			s.Require().ErrorContains(err, tc.expErr)
+		})
+	}
+}
+
+func (s *CLITestSuite) TestCLIBroadcastAndQueryTxWithScriptedNode() {
+	node := clitestutil.NewScriptedCometRPC()
+	clientCtx := s.baseCtx.WithClient(node)
+
+	// broadcast a tx to the node
+	out, err := s.createBankMsg(clientCtx, s.val1, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	s.Require().NoError(err)
+
+	var broadcastRes sdk.TxResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &broadcastRes))
+	txs := node.BroadcastedTxs()
+	s.Require().Len(txs, 1)
+	s.Require().Equal(fmt.Sprintf("%X", txs[0].Hash()), broadcastRes.TxHash)
+
+	// and include it in a block
+	block := cmttypes.MakeBlock(5, txs, nil, nil)
+	block.Time = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	node.AddBlock(block, &abci.ResponseDeliverTx{GasWanted: 200000, GasUsed: 50000})
+
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, authcli.QueryTxCmd(), []string{broadcastRes.TxHash, fmt.Sprintf("--%s=json", flags.FlagOutput)})
+	s.Require().NoError(err)
+	var txRes sdk.TxResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txRes))
+	s.Require().Equal(broadcastRes.TxHash, txRes.TxHash)
+	s.Require().Equal(int64(5), txRes.Height)
+	s.Require().Equal(int64(50000), txRes.GasUsed)
+	s.Require().Equal("2023-01-01T00:00:00Z", txRes.Timestamp)
+
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, authcli.QueryBlockResultsCmd(), []string{fmt.Sprintf("--%s=json", flags.FlagOutput)})
+	s.Require().NoError(err)
+	var blockTxs sdk.SearchTxsResult
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &blockTxs))
+	s.Require().Len(blockTxs.Txs, 1)
+	s.Require().Equal(broadcastRes.TxHash, blockTxs.Txs[0].TxHash)
+
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, authcli.QueryBlockResultsCmd(), []string{"6"})
+	s.Require().ErrorContains(err, "must be less than or equal to the current blockchain height 5")
+
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, authcli.QueryTxCmd(), []string{"C7E7D3A86A17AB3A321172239F3B61357937AF0F25D9FA4D2F4DCCAD9B0D7747"})
+	s.Require().ErrorContains(err, "not found")
+}
+
 func (s *CLITestSuite) TestCLIQueryTxsCmdByEvents() {
 	testCases := []struct {
 		name         string
@@ -522,6 +600,71 @@ func (s *CLITestSuite) TestCLIEncode() {
 	s.Require().Equal("deadbeef", txBuilder.GetTx().GetMemo())
 }
 
+func (s *CLITestSuite) TestCLIComposeTx() {
+	sendMsg := func(to sdk.AccAddress, amount int64) string {
+		bz, err := s.encCfg.Codec.MarshalInterfaceJSON(banktypes.NewMsgSend(s.val, to, sdk.NewCoins(sdk.NewInt64Coin("stake", amount))))
+		s.Require().NoError(err)
+		return string(bz)
+	}
+
+	msgsDir := s.T().TempDir()
+	s.Require().NoError(os.WriteFile(filepath.Join(msgsDir, "1_send.json"), []byte(sendMsg(s.val1, 3)), 0o600))
+	s.Require().NoError(os.WriteFile(filepath.Join(msgsDir, "README.md"), []byte("ignored"), 0o600))
+
+	commonFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, s.val.String()),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	}
+
+	testCases := []struct {
+		name    string
+		args    []string
+		expErr  string
+		expMsgs int
+	}{
+		{
+			"no messages",
+			[]string{},
+			"at least one message must be provided",
+			0,
+		},
+		{
+			"invalid message",
+			[]string{"--msg={}"},
+			"failed to decode message from --msg #1",
+			0,
+		},
+		{
+			"messages from flags",
+			[]string{"--msg=" + sendMsg(s.val1, 1), "--msg=" + sendMsg(s.val, 2)},
+			"",
+			2,
+		},
+		{
+			"messages from flags and directory",
+			[]string{"--msg=" + sendMsg(s.val1, 1), "--msgs-dir=" + msgsDir},
+			"",
+			2,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			out, err := clitestutil.ExecTestCLICmd(s.clientCtx, authcli.GetComposeCommand(), append(tc.args, commonFlags...))
+			if tc.expErr != "" {
+				s.Require().ErrorContains(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			tx, err := s.clientCtx.TxConfig.TxJSONDecoder()(out.Bytes())
+			s.Require().NoError(err)
+			s.Require().Len(tx.GetMsgs(), tc.expMsgs)
+		})
+	}
+}
+
 func (s *CLITestSuite) TestCLIMultisignSortSignatures() {
 	// Generate 2 accounts and a multisig.
 	account1, err := s.clientCtx.Keyring.Key("newAccount1")
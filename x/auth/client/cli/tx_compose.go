@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagMsg     = "msg"
+	flagMsgsDir = "msgs-dir"
+)
+
+// GetComposeCommand returns the tx compose command, which builds a single
+// transaction out of several, possibly heterogeneous, messages.
+func GetComposeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compose --msg [msg_json] [--msg [msg_json]...] [--msgs-dir [dir]]",
+		Short: "Build, sign and broadcast a transaction containing multiple messages",
+		Long: strings.TrimSpace(fmt.Sprintf(`Build a single transaction out of several messages, which may be of different
+types, then sign and broadcast it.
+
+Each message is given in its protobuf JSON representation, including its "@type"
+field, either through a --%[1]s flag or as a .json file of the --%[2]s directory.
+Messages passed with --%[1]s come first, in the given order, followed by the messages
+of the --%[2]s directory, sorted by file name.
+
+Example:
+$ %[3]s tx compose --from mykey \
+	--%[1]s '{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"cosmos1...","to_address":"cosmos1...","amount":[{"denom":"stake","amount":"10"}]}' \
+	--%[1]s '{"@type":"/cosmos.staking.v1beta1.MsgDelegate","delegator_address":"cosmos1...","validator_address":"cosmosvaloper1...","amount":{"denom":"stake","amount":"10"}}'
+`, flagMsg, flagMsgsDir, version.AppName)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msgs, err := readComposedMsgs(clientCtx, cmd)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	cmd.Flags().StringArray(flagMsg, nil, "JSON encoded message to include in the transaction; can be repeated")
+	cmd.Flags().String(flagMsgsDir, "", "Directory of JSON encoded messages to include in the transaction")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readComposedMsgs decodes the messages given through the --msg flags and the
+// --msgs-dir directory.
+func readComposedMsgs(clientCtx client.Context, cmd *cobra.Command) ([]sdk.Msg, error) {
+	msgsJSON, _ := cmd.Flags().GetStringArray(flagMsg)
+	sources := make([]string, len(msgsJSON))
+	for i := range msgsJSON {
+		sources[i] = fmt.Sprintf("--%s #%d", flagMsg, i+1)
+	}
+
+	if dir, _ := cmd.Flags().GetString(flagMsgsDir); dir != "" {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entry := range entries {
+			if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+				continue
+			}
+
+			path := filepath.Join(dir, entry.Name())
+			bz, err := os.ReadFile(path)
+			if err != nil {
+				return nil, err
+			}
+
+			msgsJSON = append(msgsJSON, string(bz))
+			sources = append(sources, path)
+		}
+	}
+
+	if len(msgsJSON) == 0 {
+		return nil, fmt.Errorf("at least one message must be provided through --%s or --%s", flagMsg, flagMsgsDir)
+	}
+
+	msgs := make([]sdk.Msg, len(msgsJSON))
+	for i, msgJSON := range msgsJSON {
+		var msg sdk.Msg
+		if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(msgJSON), &msg); err != nil {
+			return nil, fmt.Errorf("failed to decode message from %s: %w", sources[i], err)
+		}
+
+		if msg == nil {
+			return nil, errors.New("message cannot be empty")
+		}
+
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
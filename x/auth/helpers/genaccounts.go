@@ -37,38 +37,32 @@ func AddGenesisAccount(
 		return fmt.Errorf("failed to parse vesting amount: %w", err)
 	}
 
-	// create concrete account type based on input parameters
-	var genAccount authtypes.GenesisAccount
-
-	balances := banktypes.Balance{Address: accAddr.String(), Coins: coins.Sort()}
-	baseAccount := authtypes.NewBaseAccount(accAddr, nil, 0, 0)
-
-	if !vestingAmt.IsZero() {
-		baseVestingAccount := authvesting.NewBaseVestingAccount(baseAccount, vestingAmt.Sort(), vestingEnd)
-
-		if (balances.Coins.IsZero() && !baseVestingAccount.OriginalVesting.IsZero()) ||
-			baseVestingAccount.OriginalVesting.IsAnyGT(balances.Coins) {
-			return errors.New("vesting amount cannot be greater than total amount")
-		}
-
-		switch {
-		case vestingStart != 0 && vestingEnd != 0:
-			genAccount = authvesting.NewContinuousVestingAccountRaw(baseVestingAccount, vestingStart)
-
-		case vestingEnd != 0:
-			genAccount = authvesting.NewDelayedVestingAccountRaw(baseVestingAccount)
-
-		default:
-			return errors.New("invalid vesting parameters; must supply start and end time or end time")
-		}
-	} else {
-		genAccount = baseAccount
-	}
+	return AddGenesisAccounts(cdc, []GenesisAccountEntry{{
+		Address:      accAddr,
+		Coins:        coins,
+		VestingAmt:   vestingAmt,
+		VestingStart: vestingStart,
+		VestingEnd:   vestingEnd,
+	}}, appendAcct, genesisFileURL)
+}
 
-	if err := genAccount.Validate(); err != nil {
-		return fmt.Errorf("failed to validate new genesis account: %w", err)
-	}
+// GenesisAccountEntry defines an account to be added to the genesis state, with
+// its initial coins and optional vesting schedule.
+type GenesisAccountEntry struct {
+	Address      sdk.AccAddress
+	Coins        sdk.Coins
+	VestingAmt   sdk.Coins
+	VestingStart int64
+	VestingEnd   int64
+}
 
+// AddGenesisAccounts adds the given accounts to the genesis state in a single
+// pass: the genesis file is read and written only once, whatever the number of
+// accounts. The accounts are added in order, an account which is already in
+// the genesis state, or which is repeated in the given accounts, is an error
+// unless `appendAcct` is set, in which case its coins are appended to the
+// balance of the existing account and its vesting parameters are ignored.
+func AddGenesisAccounts(cdc codec.Codec, accounts []GenesisAccountEntry, appendAcct bool, genesisFileURL string) error {
 	appState, appGenesis, err := genutiltypes.GenesisStateFromGenFile(genesisFileURL)
 	if err != nil {
 		return fmt.Errorf("failed to unmarshal genesis state: %w", err)
@@ -82,24 +76,54 @@ func AddGenesisAccount(
 	}
 
 	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
-	if accs.Contains(accAddr) {
-		if !appendAcct {
-			return fmt.Errorf(" Account %s already exists\nUse `append` flag to append account at existing address", accAddr)
-		}
 
-		genesisB := banktypes.GetGenesisStateFromAppState(cdc, appState)
-		for idx, acc := range genesisB.Balances {
-			if acc.Address != accAddr.String() {
-				continue
+	// index the existing accounts and balances by address, so that each account
+	// is looked up in constant time
+	existingAccs := make(map[string]struct{}, len(accs))
+	for _, acc := range accs {
+		existingAccs[acc.GetAddress().String()] = struct{}{}
+	}
+
+	balanceIdx := make(map[string]int, len(bankGenState.Balances))
+	for idx, balance := range bankGenState.Balances {
+		balanceIdx[balance.Address] = idx
+	}
+
+	newAccs := false
+	for _, entry := range accounts {
+		addr := entry.Address.String()
+		coins := entry.Coins.Sort()
+
+		if _, ok := existingAccs[addr]; ok {
+			if !appendAcct {
+				return fmt.Errorf(" Account %s already exists\nUse `append` flag to append account at existing address", addr)
 			}
 
-			updatedCoins := acc.Coins.Add(coins...)
-			bankGenState.Balances[idx] = banktypes.Balance{Address: accAddr.String(), Coins: updatedCoins.Sort()}
-			break
+			if idx, ok := balanceIdx[addr]; ok {
+				bankGenState.Balances[idx].Coins = bankGenState.Balances[idx].Coins.Add(coins...)
+			} else {
+				balanceIdx[addr] = len(bankGenState.Balances)
+				bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{Address: addr, Coins: coins})
+			}
+		} else {
+			genAccount, err := newGenesisAccount(entry.Address, coins, entry.VestingAmt, entry.VestingStart, entry.VestingEnd)
+			if err != nil {
+				return fmt.Errorf("account %s: %w", addr, err)
+			}
+
+			accs = append(accs, genAccount)
+			existingAccs[addr] = struct{}{}
+			newAccs = true
+
+			balanceIdx[addr] = len(bankGenState.Balances)
+			bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{Address: addr, Coins: coins})
 		}
-	} else {
-		// Add the new account to the set of genesis accounts and sanitize the accounts afterwards.
-		accs = append(accs, genAccount)
+
+		bankGenState.Supply = bankGenState.Supply.Add(coins...)
+	}
+
+	if newAccs {
+		// sanitize the accounts once all of them are added
 		accs = authtypes.SanitizeGenesisAccounts(accs)
 
 		genAccs, err := authtypes.PackAccounts(accs)
@@ -113,14 +137,10 @@ func AddGenesisAccount(
 			return fmt.Errorf("failed to marshal auth genesis state: %w", err)
 		}
 		appState[authtypes.ModuleName] = authGenStateBz
-
-		bankGenState.Balances = append(bankGenState.Balances, balances)
 	}
 
 	bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
 
-	bankGenState.Supply = bankGenState.Supply.Add(balances.Coins...)
-
 	bankGenStateBz, err := cdc.MarshalJSON(bankGenState)
 	if err != nil {
 		return fmt.Errorf("failed to marshal bank genesis state: %w", err)
@@ -135,3 +155,43 @@ func AddGenesisAccount(
 	appGenesis.AppState = appStateJSON
 	return genutil.ExportGenesisFile(appGenesis, genesisFileURL)
 }
+
+// newGenesisAccount creates the concrete account type based on the given
+// vesting parameters and validates it.
+func newGenesisAccount(
+	accAddr sdk.AccAddress,
+	coins, vestingAmt sdk.Coins,
+	vestingStart, vestingEnd int64,
+) (authtypes.GenesisAccount, error) {
+	var genAccount authtypes.GenesisAccount
+
+	baseAccount := authtypes.NewBaseAccount(accAddr, nil, 0, 0)
+
+	if !vestingAmt.IsZero() {
+		baseVestingAccount := authvesting.NewBaseVestingAccount(baseAccount, vestingAmt.Sort(), vestingEnd)
+
+		if (coins.IsZero() && !baseVestingAccount.OriginalVesting.IsZero()) ||
+			baseVestingAccount.OriginalVesting.IsAnyGT(coins) {
+			return nil, errors.New("vesting amount cannot be greater than total amount")
+		}
+
+		switch {
+		case vestingStart != 0 && vestingEnd != 0:
+			genAccount = authvesting.NewContinuousVestingAccountRaw(baseVestingAccount, vestingStart)
+
+		case vestingEnd != 0:
+			genAccount = authvesting.NewDelayedVestingAccountRaw(baseVestingAccount)
+
+		default:
+			return nil, errors.New("invalid vesting parameters; must supply start and end time or end time")
+		}
+	} else {
+		genAccount = baseAccount
+	}
+
+	if err := genAccount.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate new genesis account: %w", err)
+	}
+
+	return genAccount, nil
+}
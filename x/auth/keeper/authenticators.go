@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AddAuthenticator registers the authenticator with the given name, which the
+// accounts can then designate to validate their txs. It panics if an
+// authenticator is already registered with the name.
+func (ak AccountKeeper) AddAuthenticator(name string, fn types.AuthenticateFn) {
+	ak.authenticators.add(name, fn)
+}
+
+// SetAccountAuthenticator designates the registered authenticator with the
+// given name to validate the txs of the account, instead of the signature
+// verification with its pubkey. An empty name removes the authenticator of the
+// account.
+func (ak AccountKeeper) SetAccountAuthenticator(ctx context.Context, addr sdk.AccAddress, name string) error {
+	if name == "" {
+		return ak.AccountAuthenticators.Remove(ctx, addr)
+	}
+
+	if _, ok := ak.authenticators.get(name); !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown authenticator %s", name)
+	}
+
+	return ak.AccountAuthenticators.Set(ctx, addr, name)
+}
+
+// GetAccountAuthenticator returns the name of the authenticator of the account,
+// empty if it has none.
+func (ak AccountKeeper) GetAccountAuthenticator(ctx context.Context, addr sdk.AccAddress) (string, error) {
+	name, err := ak.AccountAuthenticators.Get(ctx, addr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return "", nil
+	}
+
+	return name, err
+}
+
+// GetAuthenticator returns the authenticator validating the txs of the account,
+// nil if they are validated with its pubkey. An error is returned if the
+// authenticator of the account is no longer registered, rather than falling
+// back to its pubkey.
+func (ak AccountKeeper) GetAuthenticator(ctx context.Context, addr sdk.AccAddress) (types.AuthenticateFn, error) {
+	name, err := ak.GetAccountAuthenticator(ctx, addr)
+	if err != nil || name == "" {
+		return nil, err
+	}
+
+	fn, ok := ak.authenticators.get(name)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "authenticator %s of account %s is not registered", name, addr)
+	}
+
+	return fn, nil
+}
+
+// authenticators is a struct that houses the registered authenticators.
+// It exists so that authenticators can be added to the AccountKeeper without
+// needing to have a pointer receiver on the AccountKeeper.
+type authenticators struct {
+	fns map[string]types.AuthenticateFn
+}
+
+// newAuthenticators creates a new authenticators without any authenticator.
+func newAuthenticators() *authenticators {
+	return &authenticators{
+		fns: make(map[string]types.AuthenticateFn),
+	}
+}
+
+// add registers the authenticator with the given name.
+func (a *authenticators) add(name string, fn types.AuthenticateFn) {
+	if name == "" || fn == nil {
+		panic("authenticator name and function cannot be empty")
+	}
+
+	if _, ok := a.fns[name]; ok {
+		panic(fmt.Sprintf("authenticator %s is already registered", name))
+	}
+
+	a.fns[name] = fn
+}
+
+// get returns the authenticator with the given name, and whether it exists.
+func (a *authenticators) get(name string) (types.AuthenticateFn, bool) {
+	fn, ok := a.fns[name]
+	return fn, ok
+}
@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/core/address"
 	errorsmod "cosmossdk.io/errors"
 
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -22,6 +23,17 @@ func NewBech32Codec(prefix string) address.Codec {
 	return bech32Codec{prefix}
 }
 
+// NewCachedBech32Codec returns a bech32 address codec for the prefix caching
+// the last conversions, see addresscodec.NewCachedCodec.
+func NewCachedBech32Codec(prefix string) address.Codec {
+	ac, err := addresscodec.NewCachedCodec(NewBech32Codec(prefix), addresscodec.DefaultCacheSize)
+	if err != nil {
+		panic(err)
+	}
+
+	return ac
+}
+
 // StringToBytes encodes text to bytes
 func (bc bech32Codec) StringToBytes(text string) ([]byte, error) {
 	if len(strings.TrimSpace(text)) == 0 {
@@ -1,6 +1,14 @@
 package keeper
 
 import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"golang.org/x/exp/maps"
+
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -32,6 +40,33 @@ func (ak AccountKeeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 	}
 
 	ak.GetModuleAccount(ctx, types.FeeCollectorName)
+
+	// Create the reserved module accounts in the lexical order of their names,
+	// so that their account numbers only depend on the genesis accounts, and
+	// not on the order in which the modules first use them.
+	names := maps.Keys(ak.reservedModuleAccounts)
+	sort.Strings(names)
+	for _, name := range names {
+		addr := ak.permAddrs[name].GetAddress()
+		if acc := ak.GetAccount(ctx, addr); acc != nil {
+			if _, ok := acc.(sdk.ModuleAccountI); !ok {
+				panic(fmt.Sprintf("module account %s address %s collides with a non module account", name, addr))
+			}
+			continue
+		}
+		ak.GetModuleAccount(ctx, name)
+	}
+
+	for _, aa := range data.AccountAuthenticators {
+		addr, err := ak.StringToBytes(aa.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := ak.SetAccountAuthenticator(ctx, addr, aa.Authenticator); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper
@@ -45,5 +80,18 @@ func (ak AccountKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		return false
 	})
 
-	return types.NewGenesisState(params, genAccounts)
+	genState := types.NewGenesisState(params, genAccounts)
+
+	err := ak.AccountAuthenticators.Walk(ctx, nil, func(addr sdk.AccAddress, name string) bool {
+		genState.AccountAuthenticators = append(genState.AccountAuthenticators, types.AccountAuthenticator{
+			Address:       addr.String(),
+			Authenticator: name,
+		})
+		return false
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		panic(err)
+	}
+
+	return genState
 }
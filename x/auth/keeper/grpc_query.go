@@ -106,6 +106,25 @@ func (ak AccountKeeper) Params(c context.Context, req *types.QueryParamsRequest)
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// AccountAuthenticator returns the authenticator of an account.
+func (ak AccountKeeper) AccountAuthenticator(c context.Context, req *types.QueryAccountAuthenticatorRequest) (*types.QueryAccountAuthenticatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := ak.StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	name, err := ak.GetAccountAuthenticator(c, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountAuthenticatorResponse{Authenticator: name}, nil
+}
+
 // ModuleAccounts returns all the existing Module Accounts
 func (ak AccountKeeper) ModuleAccounts(c context.Context, req *types.QueryModuleAccountsRequest) (*types.QueryModuleAccountsResponse, error) {
 	if req == nil {
@@ -165,12 +184,7 @@ func (ak AccountKeeper) ModuleAccountByName(c context.Context, req *types.QueryM
 
 // Bech32Prefix returns the keeper internally stored bech32 prefix.
 func (ak AccountKeeper) Bech32Prefix(ctx context.Context, req *types.Bech32PrefixRequest) (*types.Bech32PrefixResponse, error) {
-	bech32Prefix, err := ak.getBech32Prefix()
-	if err != nil {
-		return nil, err
-	}
-
-	return &types.Bech32PrefixResponse{Bech32Prefix: bech32Prefix}, nil
+	return &types.Bech32PrefixResponse{Bech32Prefix: ak.bech32Prefix}, nil
 }
 
 // AddressBytesToString converts an address from bytes to string, using the
@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"cosmossdk.io/collections"
+	"golang.org/x/exp/slices"
 
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/store"
@@ -61,11 +63,15 @@ type AccountKeeperI interface {
 // encoding/decoding library.
 type AccountKeeper struct {
 	address.Codec
+	bech32Prefix string
 
 	storeService store.KVStoreService
 	cdc          codec.BinaryCodec
 	permAddrs    map[string]types.PermissionsForAddress
 
+	// the names of the module accounts reserved with ReserveModuleAccount
+	reservedModuleAccounts map[string]struct{}
+
 	// The prototypical AccountI constructor.
 	proto func() sdk.AccountI
 
@@ -73,9 +79,15 @@ type AccountKeeper struct {
 	// should be the x/gov module account.
 	authority string
 
+	// the authenticators provided by the modules, by module name
+	authenticators *authenticators
+
 	// State
 	ParamsState   collections.Item[types.Params] // NOTE: name is this because it conflicts with the Params gRPC method impl
 	AccountNumber collections.Sequence
+	UnorderedTxs  collections.KeySet[collections.Pair[uint64, []byte]]
+	// AccountAuthenticators maps the accounts to the name of their authenticator.
+	AccountAuthenticators collections.Map[sdk.AccAddress, string]
 }
 
 var _ AccountKeeperI = &AccountKeeper{}
@@ -98,14 +110,21 @@ func NewAccountKeeper(
 	sb := collections.NewSchemaBuilder(storeService)
 
 	return AccountKeeper{
-		Codec:         NewBech32Codec(bech32Prefix),
-		storeService:  storeService,
-		proto:         proto,
-		cdc:           cdc,
-		permAddrs:     permAddrs,
-		authority:     authority,
-		ParamsState:   collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		AccountNumber: collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
+		Codec:                  NewCachedBech32Codec(bech32Prefix),
+		bech32Prefix:           bech32Prefix,
+		storeService:           storeService,
+		proto:                  proto,
+		cdc:                    cdc,
+		permAddrs:              permAddrs,
+		reservedModuleAccounts: make(map[string]struct{}),
+		authority:              authority,
+		authenticators:         newAuthenticators(),
+		ParamsState:            collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		AccountNumber:          collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
+		UnorderedTxs:           collections.NewKeySet(sb, types.UnorderedTxsKey, "unordered_txs", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey)),
+		AccountAuthenticators: collections.NewMap(
+			sb, types.AccountAuthenticatorsKey, "account_authenticators", sdk.AccAddressKey, collections.StringValue,
+		),
 	}
 }
 
@@ -160,6 +179,61 @@ func (ak AccountKeeper) GetModulePermissions() map[string]types.PermissionsForAd
 	return ak.permAddrs
 }
 
+// ReserveModuleAccount reserves the module account with the given name and
+// permissions, so that it is created at genesis with a stable account number,
+// the reserved module accounts being created in the lexical order of their
+// names after the genesis accounts and the fee collector. It must be called at
+// app wiring time, before the keeper is used.
+//
+// Reserving a module account with the same permissions as an existing one is
+// allowed, while an error is returned if it has other permissions, or if its
+// address collides with the one of another module account.
+func (ak AccountKeeper) ReserveModuleAccount(name string, permissions ...string) error {
+	if strings.TrimSpace(name) == "" {
+		return errors.New("module account name cannot be blank")
+	}
+
+	if err := types.NewModuleAccountReservation(permissions...).Validate(); err != nil {
+		return fmt.Errorf("invalid module account %s: %w", name, err)
+	}
+
+	if permAddr, ok := ak.permAddrs[name]; ok {
+		if !samePermissions(permAddr.GetPermissions(), permissions) {
+			return fmt.Errorf(
+				"module account %s is already reserved with permissions %v, got %v",
+				name, permAddr.GetPermissions(), permissions,
+			)
+		}
+		ak.reservedModuleAccounts[name] = struct{}{}
+		return nil
+	}
+
+	permAddr := types.NewPermissionsForAddress(name, permissions)
+	for otherName, other := range ak.permAddrs {
+		if other.GetAddress().Equals(permAddr.GetAddress()) {
+			return fmt.Errorf("module account %s address %s collides with module account %s", name, permAddr.GetAddress(), otherName)
+		}
+	}
+
+	ak.permAddrs[name] = permAddr
+	ak.reservedModuleAccounts[name] = struct{}{}
+	return nil
+}
+
+// samePermissions returns whether both permissions lists contain the same
+// permissions, in any order.
+func samePermissions(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	sortedA := slices.Clone(a)
+	sortedB := slices.Clone(b)
+	slices.Sort(sortedA)
+	slices.Sort(sortedB)
+	return slices.Equal(sortedA, sortedB)
+}
+
 // ValidatePermissions validates that the module account has been granted
 // permissions within its set of allowed permissions.
 func (ak AccountKeeper) ValidatePermissions(macc sdk.ModuleAccountI) error {
@@ -254,16 +328,6 @@ func (ak AccountKeeper) UnmarshalAccount(bz []byte) (sdk.AccountI, error) {
 // GetCodec return codec.Codec object used by the keeper
 func (ak AccountKeeper) GetCodec() codec.BinaryCodec { return ak.cdc }
 
-// add getter for bech32Prefix
-func (ak AccountKeeper) getBech32Prefix() (string, error) {
-	bech32Codec, ok := ak.Codec.(bech32Codec)
-	if !ok {
-		return "", fmt.Errorf("unable cast addressCdc to bech32Codec; expected %T got %T", bech32Codec, ak.Codec)
-	}
-
-	return bech32Codec.bech32Prefix, nil
-}
-
 // SetParams sets the auth module's parameters.
 // CONTRACT: This method performs no validation of the parameters.
 func (ak AccountKeeper) SetParams(ctx context.Context, params types.Params) error {
@@ -278,3 +342,9 @@ func (ak AccountKeeper) GetParams(ctx context.Context) (params types.Params) {
 	}
 	return params
 }
+
+// GetGasCosts gets the gas costs charged for store accesses from the params,
+// defaulting to types.DefaultGasCosts when they are unset.
+func (ak AccountKeeper) GetGasCosts(ctx context.Context) types.GasCosts {
+	return ak.GetParams(ctx).GetGasCostsOrDefault()
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -289,3 +290,119 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	// we expect nextNum to be 2 because we initialize fee_collector as account number 1
 	suite.Require().Equal(2, int(nextNum))
 }
+
+func (suite *KeeperTestSuite) TestReserveModuleAccount() {
+	suite.SetupTest() // reset
+	ctx := suite.ctx
+
+	suite.Require().Error(suite.accountKeeper.ReserveModuleAccount(" "))
+	suite.Require().Error(suite.accountKeeper.ReserveModuleAccount("zeta", ""))
+
+	suite.Require().NoError(suite.accountKeeper.ReserveModuleAccount("zeta", types.Minter))
+	suite.Require().NoError(suite.accountKeeper.ReserveModuleAccount("alpha"))
+	// reserving an existing module account with the same permissions, in any order
+	suite.Require().NoError(suite.accountKeeper.ReserveModuleAccount("zeta", types.Minter))
+	suite.Require().NoError(suite.accountKeeper.ReserveModuleAccount(multiPerm, types.Staking, types.Minter, types.Burner))
+	// but not with other permissions
+	err := suite.accountKeeper.ReserveModuleAccount("zeta", types.Burner)
+	suite.Require().ErrorContains(err, "module account zeta is already reserved")
+	err = suite.accountKeeper.ReserveModuleAccount("mint", types.Burner)
+	suite.Require().ErrorContains(err, "module account mint is already reserved")
+
+	addr, perms := suite.accountKeeper.GetModuleAddressAndPermissions("zeta")
+	suite.Require().Equal(types.NewModuleAddress("zeta"), addr)
+	suite.Require().Equal([]string{types.Minter}, perms)
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	genState := types.GenesisState{
+		Params: types.DefaultParams(),
+		Accounts: []*codectypes.Any{
+			codectypes.UnsafePackAny(&types.BaseAccount{
+				Address:       sdk.AccAddress(pubKey.Address()).String(),
+				PubKey:        codectypes.UnsafePackAny(pubKey),
+				AccountNumber: 3,
+			}),
+		},
+	}
+	suite.accountKeeper.InitGenesis(ctx, genState)
+
+	// the reserved module accounts are created after the fee collector, in the
+	// lexical order of their names
+	for name, accNum := range map[string]uint64{
+		types.FeeCollectorName: 4,
+		"alpha":                5,
+		multiPerm:              6,
+		"zeta":                 7,
+	} {
+		acc := suite.accountKeeper.GetAccount(ctx, types.NewModuleAddress(name))
+		suite.Require().NotNil(acc, name)
+		suite.Require().Equal(accNum, acc.GetAccountNumber(), name)
+	}
+	suite.Require().Equal(uint64(8), suite.accountKeeper.NextAccountNumber(ctx))
+
+	// genesis fails if a reserved module account address is used by another account
+	suite.SetupTest() // reset
+	ctx = suite.ctx
+	suite.Require().NoError(suite.accountKeeper.ReserveModuleAccount("zeta"))
+	genState.Accounts = []*codectypes.Any{
+		codectypes.UnsafePackAny(&types.BaseAccount{
+			Address:       types.NewModuleAddress("zeta").String(),
+			AccountNumber: 3,
+		}),
+	}
+	suite.Require().PanicsWithValue(
+		fmt.Sprintf("module account zeta address %s collides with a non module account", types.NewModuleAddress("zeta")),
+		func() { suite.accountKeeper.InitGenesis(ctx, genState) },
+	)
+}
+
+func (suite *KeeperTestSuite) TestAuthenticatorsGenesis() {
+	suite.SetupTest() // reset
+	ctx := suite.ctx
+
+	suite.accountKeeper.AddAuthenticator("session", func(sdk.Context, types.AuthenticationRequest) error {
+		return nil
+	})
+
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+	}
+	genState := types.DefaultGenesisState()
+	genState.AccountAuthenticators = []types.AccountAuthenticator{
+		{Address: addrs[0].String(), Authenticator: "session"},
+		{Address: addrs[1].String(), Authenticator: "session"},
+	}
+	suite.accountKeeper.InitGenesis(ctx, *genState)
+
+	name, err := suite.accountKeeper.GetAccountAuthenticator(ctx, addrs[1])
+	suite.Require().NoError(err)
+	suite.Require().Equal("session", name)
+
+	exported := suite.accountKeeper.ExportGenesis(ctx)
+	suite.Require().ElementsMatch(genState.AccountAuthenticators, exported.AccountAuthenticators)
+
+	// the authenticators must be registered
+	suite.SetupTest() // reset
+	suite.Require().Panics(func() { suite.accountKeeper.InitGenesis(suite.ctx, *genState) })
+}
+
+func (suite *KeeperTestSuite) TestRemoveExpiredUnorderedTxs() {
+	ctx := suite.ctx.WithBlockHeight(10)
+
+	// nothing to remove
+	suite.Require().NoError(suite.accountKeeper.RemoveExpiredUnorderedTxs(ctx))
+
+	txHash := []byte("tx-hash")
+	for _, timeout := range []uint64{9, 10, 11} {
+		suite.Require().NoError(suite.accountKeeper.AddUnorderedTx(ctx, timeout, txHash))
+	}
+
+	suite.Require().NoError(suite.accountKeeper.RemoveExpiredUnorderedTxs(ctx))
+
+	for timeout, expContains := range map[uint64]bool{9: false, 10: false, 11: true} {
+		contains, err := suite.accountKeeper.ContainsUnorderedTx(ctx, timeout, txHash)
+		suite.Require().NoError(err)
+		suite.Require().Equal(expContains, contains, timeout)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
@@ -39,3 +40,17 @@ func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdatePara
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (ms msgServer) SetAuthenticator(goCtx context.Context, msg *types.MsgSetAuthenticator) (*types.MsgSetAuthenticatorResponse, error) {
+	addr, err := ms.StringToBytes(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.SetAccountAuthenticator(ctx, addr, msg.Authenticator); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAuthenticatorResponse{}, nil
+}
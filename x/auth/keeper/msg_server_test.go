@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -109,3 +110,132 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestUpdateParamsGasCosts() {
+	validGasCosts := types.DefaultGasCosts()
+	validGasCosts.WriteCostPerByte = 100
+
+	invalidGasCosts := types.DefaultGasCosts()
+	invalidGasCosts.ReadCostFlat = 0
+
+	testCases := []struct {
+		name        string
+		gasCosts    *types.GasCosts
+		expectErr   bool
+		expErrMsg   string
+		expGasCosts types.GasCosts
+	}{
+		{
+			name:      "set invalid read cost flat",
+			gasCosts:  &invalidGasCosts,
+			expectErr: true,
+			expErrMsg: "invalid read cost flat",
+		},
+		{
+			name:        "set valid gas costs",
+			gasCosts:    &validGasCosts,
+			expectErr:   false,
+			expGasCosts: validGasCosts,
+		},
+		{
+			name:        "unset gas costs",
+			gasCosts:    nil,
+			expectErr:   false,
+			expGasCosts: types.DefaultGasCosts(),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			params := types.DefaultParams()
+			params.GasCosts = tc.gasCosts
+
+			_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{
+				Authority: s.accountKeeper.GetAuthority(),
+				Params:    params,
+			})
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expGasCosts, s.accountKeeper.GetGasCosts(s.ctx))
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSetAuthenticator() {
+	addr := sdk.AccAddress([]byte("authenticated_addr__"))
+	s.accountKeeper.AddAuthenticator("session", func(sdk.Context, types.AuthenticationRequest) error {
+		return nil
+	})
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgSetAuthenticator
+		expectErr bool
+		expErrMsg string
+		expName   string
+	}{
+		{
+			name: "invalid address",
+			req: &types.MsgSetAuthenticator{
+				Address:       "foo",
+				Authenticator: "session",
+			},
+			expectErr: true,
+			expErrMsg: "invalid address",
+		},
+		{
+			name: "unknown authenticator",
+			req: &types.MsgSetAuthenticator{
+				Address:       addr.String(),
+				Authenticator: "unknown",
+			},
+			expectErr: true,
+			expErrMsg: "unknown authenticator unknown",
+		},
+		{
+			name: "set authenticator",
+			req: &types.MsgSetAuthenticator{
+				Address:       addr.String(),
+				Authenticator: "session",
+			},
+			expName: "session",
+		},
+		{
+			name: "remove authenticator",
+			req: &types.MsgSetAuthenticator{
+				Address: addr.String(),
+			},
+			expName: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.SetAuthenticator(s.ctx, tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+
+			s.Require().NoError(err)
+			res, err := s.accountKeeper.AccountAuthenticator(s.ctx, &types.QueryAccountAuthenticatorRequest{Address: addr.String()})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expName, res.Authenticator)
+
+			authenticate, err := s.accountKeeper.GetAuthenticator(s.ctx, addr)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expName != "", authenticate != nil)
+		})
+	}
+
+	s.Require().Panics(func() {
+		s.accountKeeper.AddAuthenticator("session", func(sdk.Context, types.AuthenticationRequest) error { return nil })
+	})
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ContainsUnorderedTx returns whether the unordered tx with the given timeout
+// height and hash has already been executed.
+func (ak AccountKeeper) ContainsUnorderedTx(ctx context.Context, timeoutHeight uint64, txHash []byte) (bool, error) {
+	return ak.UnorderedTxs.Has(ctx, collections.Join(timeoutHeight, txHash))
+}
+
+// AddUnorderedTx records the execution of the unordered tx with the given
+// timeout height and hash, so that it is rejected until its timeout height.
+func (ak AccountKeeper) AddUnorderedTx(ctx context.Context, timeoutHeight uint64, txHash []byte) error {
+	return ak.UnorderedTxs.Set(ctx, collections.Join(timeoutHeight, txHash))
+}
+
+// RemoveExpiredUnorderedTxs removes the unordered txs whose timeout height is
+// lower than or equal to the current block height, since they can no longer
+// be included in a block.
+func (ak AccountKeeper) RemoveExpiredUnorderedTxs(ctx context.Context) error {
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+
+	rng := new(collections.Range[collections.Pair[uint64, []byte]]).
+		EndExclusive(collections.PairPrefix[uint64, []byte](height + 1))
+
+	var expired []collections.Pair[uint64, []byte]
+	err := ak.UnorderedTxs.Walk(ctx, rng, func(key collections.Pair[uint64, []byte]) bool {
+		expired = append(expired, key)
+		return false
+	})
+	// an empty range yields an invalid iterator
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		return err
+	}
+
+	for _, key := range expired {
+		if err := ak.UnorderedTxs.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
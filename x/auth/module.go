@@ -4,10 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
+	"golang.org/x/exp/maps"
 
 	"cosmossdk.io/depinject"
 
@@ -62,6 +65,11 @@ func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
 	return cdc.MustMarshalJSON(types.DefaultGenesisState())
 }
 
+// NewGenesisState returns an empty genesis state of the auth module.
+func (AppModuleBasic) NewGenesisState() proto.Message {
+	return &types.GenesisState{}
+}
+
 // ValidateGenesis performs genesis state validation for the auth module.
 func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
 	var data types.GenesisState
@@ -105,7 +113,10 @@ type AppModule struct {
 	legacySubspace exported.Subspace
 }
 
-var _ appmodule.AppModule = AppModule{}
+var (
+	_ appmodule.AppModule     = AppModule{}
+	_ appmodule.HasEndBlocker = AppModule{}
+)
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
 func (am AppModule) IsOnePerModuleType() {}
@@ -131,7 +142,8 @@ func (AppModule) Name() string {
 // RegisterServices registers a GRPC query service to respond to the
 // module-specific GRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
-	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.accountKeeper))
+	msgServer := keeper.NewMsgServerImpl(am.accountKeeper)
+	types.RegisterMsgServer(cfg.MsgServer(), msgServer)
 	types.RegisterQueryServer(cfg.QueryServer(), am.accountKeeper)
 
 	m := keeper.NewMigrator(am.accountKeeper, cfg.QueryServer(), am.legacySubspace)
@@ -171,6 +183,11 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+// EndBlock removes the unordered txs which reached their timeout height.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.accountKeeper.RemoveExpiredUnorderedTxs(ctx)
+}
+
 // AppModuleSimulation functions
 
 // GenerateGenesisState creates a randomized GenState of the auth module
@@ -201,13 +218,14 @@ func init() {
 	appmodule.Register(&modulev1.Module{},
 		appmodule.Provide(ProvideAddressCodec),
 		appmodule.Provide(ProvideModule),
+		appmodule.Invoke(InvokeAddAuthenticators),
 	)
 }
 
 // ProvideAddressCodec provides an address.Codec to the container for any
 // modules that want to do address string <> bytes conversion.
 func ProvideAddressCodec(config *modulev1.Module) address.Codec {
-	return keeper.NewBech32Codec(config.Bech32Prefix)
+	return keeper.NewCachedBech32Codec(config.Bech32Prefix)
 }
 
 type ModuleInputs struct {
@@ -220,6 +238,9 @@ type ModuleInputs struct {
 	RandomGenesisAccountsFn types.RandomGenesisAccountsFn `optional:"true"`
 	AccountI                func() sdk.AccountI           `optional:"true"`
 
+	// ModuleAccountReservations are the module accounts reserved by the modules.
+	ModuleAccountReservations map[string]types.ModuleAccountReservation
+
 	// LegacySubspace is used solely for migration of x/params managed parameters
 	LegacySubspace exported.Subspace `optional:"true"`
 }
@@ -252,7 +273,32 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	}
 
 	k := keeper.NewAccountKeeper(in.Cdc, in.StoreService, in.AccountI, maccPerms, in.Config.Bech32Prefix, authority.String())
+
+	// Default ordering is lexical by module name.
+	reserved := maps.Keys(in.ModuleAccountReservations)
+	sort.Strings(reserved)
+	for _, modName := range reserved {
+		if err := k.ReserveModuleAccount(modName, in.ModuleAccountReservations[modName].Permissions...); err != nil {
+			panic(err)
+		}
+	}
+
 	m := NewAppModule(in.Cdc, k, in.RandomGenesisAccountsFn, in.LegacySubspace)
 
 	return ModuleOutputs{AccountKeeper: k, Module: m}
 }
+
+// InvokeAddAuthenticators registers the authenticators provided by the modules
+// to the account keeper, under the name of the module providing them.
+func InvokeAddAuthenticators(keeper keeper.AccountKeeper, authenticators map[string]types.AuthenticateFn) {
+	if len(authenticators) == 0 {
+		return
+	}
+
+	order := maps.Keys(authenticators)
+	sort.Strings(order)
+
+	for _, modName := range order {
+		keeper.AddAuthenticator(modName, authenticators[modName])
+	}
+}
@@ -0,0 +1,132 @@
+package posthandler
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AttributeKeyFeeRefund is the attribute of the tx event holding the fees
+// refunded for the unused gas.
+const AttributeKeyFeeRefund = "fee_refund"
+
+// DefaultMinGasChargedRatio is the default minimum ratio of the gas limit of a
+// tx which is charged, whatever the gas it used.
+var DefaultMinGasChargedRatio = math.LegacyNewDecWithPrec(5, 1)
+
+// BankKeeper defines the contract needed to refund fees from the fee collector.
+type BankKeeper interface {
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// FeegrantKeeper defines the contract needed to give the refunded fees back to
+// the fee allowance which paid them.
+type FeegrantKeeper interface {
+	RefundGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) error
+}
+
+// FeeRefundDecorator refunds the fees paid for the gas which was not used by a
+// tx, i.e. fee * (gasWanted - gasCharged) / gasWanted, where gasCharged is the
+// gas used by the tx, but at least minGasChargedRatio * gasWanted so that the
+// gas limit stays meaningful to block proposers. The fees are refunded to the
+// account which paid them, i.e. the fee granter if set or else the fee payer,
+// whether the messages of the tx succeeded or not. When the fees were paid with
+// a fee allowance, the refund is also given back to the allowance, see
+// feegrant.Keeper.RefundGrantedFees.
+//
+// CONTRACT: Tx must implement FeeTx interface and the fees must have been
+// deducted to the fee collector, e.g. by ante.DeductFeeDecorator.
+type FeeRefundDecorator struct {
+	bankKeeper         BankKeeper
+	feegrantKeeper     FeegrantKeeper
+	minGasChargedRatio math.LegacyDec
+}
+
+// NewFeeRefundDecorator returns a new FeeRefundDecorator. The FeegrantKeeper
+// may be nil when fee grants are not enabled. It panics if the minimum ratio
+// of gas charged is not within [0, 1].
+func NewFeeRefundDecorator(bk BankKeeper, fk FeegrantKeeper, minGasChargedRatio math.LegacyDec) FeeRefundDecorator {
+	if minGasChargedRatio.IsNegative() || minGasChargedRatio.GT(math.LegacyOneDec()) {
+		panic("minimum ratio of gas charged must be within [0, 1]")
+	}
+
+	return FeeRefundDecorator{
+		bankKeeper:         bk,
+		feegrantKeeper:     fk,
+		minGasChargedRatio: minGasChargedRatio,
+	}
+}
+
+func (frd FeeRefundDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	refund := frd.refundAmount(feeTx.GetFee(), feeTx.GetGas(), ctx.GasMeter().GasConsumed())
+	if refund.IsZero() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	// The refund doesn't consume the gas of the tx, so that it can't run out
+	// of gas once the gas used is known.
+	refundCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	refundTo := feeTx.FeePayer()
+	if feeGranter := feeTx.FeeGranter(); feeGranter != nil {
+		if frd.feegrantKeeper == nil {
+			return ctx, sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
+		} else if !feeGranter.Equals(refundTo) {
+			if err := frd.feegrantKeeper.RefundGrantedFees(refundCtx, feeGranter, refundTo, refund); err != nil {
+				return ctx, errorsmod.Wrapf(err, "failed to refund the fee allowance of %s", refundTo)
+			}
+		}
+
+		refundTo = feeGranter
+	}
+
+	if err := frd.bankKeeper.SendCoinsFromModuleToAccount(refundCtx, types.FeeCollectorName, refundTo, refund); err != nil {
+		return ctx, errorsmod.Wrapf(err, "failed to refund fees")
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(AttributeKeyFeeRefund, refund.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, refundTo.String()),
+		),
+	)
+
+	return next(ctx, tx, simulate, success)
+}
+
+// refundAmount returns the fees paid for the unused gas of a tx.
+func (frd FeeRefundDecorator) refundAmount(fee sdk.Coins, gasWanted, gasUsed uint64) sdk.Coins {
+	if fee.IsZero() || gasWanted == 0 {
+		return nil
+	}
+
+	gasLimit := math.LegacyNewDecFromInt(math.NewIntFromUint64(gasWanted))
+	gasCharged := math.LegacyMaxDec(math.LegacyNewDecFromInt(math.NewIntFromUint64(gasUsed)), gasLimit.Mul(frd.minGasChargedRatio))
+	if gasCharged.GTE(gasLimit) {
+		return nil
+	}
+
+	unusedRatio := gasLimit.Sub(gasCharged).Quo(gasLimit)
+
+	refund := sdk.NewCoins()
+	for _, coin := range fee {
+		amount := math.LegacyNewDecFromInt(coin.Amount).Mul(unusedRatio).TruncateInt()
+		if amount.IsPositive() {
+			refund = refund.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	return refund
+}
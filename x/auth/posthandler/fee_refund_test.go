@@ -0,0 +1,167 @@
+package posthandler_test
+
+import (
+	"context"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+type refund struct {
+	module string
+	to     sdk.AccAddress
+	amount sdk.Coins
+}
+
+type mockBankKeeper struct {
+	refunds []refund
+}
+
+func (bk *mockBankKeeper) SendCoinsFromModuleToAccount(_ sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	bk.refunds = append(bk.refunds, refund{senderModule, recipientAddr, amt})
+	return nil
+}
+
+type grantRefund struct {
+	granter, grantee sdk.AccAddress
+	amount           sdk.Coins
+}
+
+type mockFeegrantKeeper struct {
+	refunds []grantRefund
+}
+
+func (fk *mockFeegrantKeeper) RefundGrantedFees(_ context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) error {
+	fk.refunds = append(fk.refunds, grantRefund{granter, grantee, fee})
+	return nil
+}
+
+func TestFeeRefundDecorator(t *testing.T) {
+	_, _, payer := testdata.KeyTestPubAddr()
+	_, _, granter := testdata.KeyTestPubAddr()
+
+	testCases := []struct {
+		name           string
+		fee            sdk.Coins
+		gasUsed        uint64
+		granter        sdk.AccAddress
+		expRefund      *refund
+		expGrantRefund *grantRefund
+	}{
+		{
+			name:      "refund unused gas",
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("stake", 10)),
+			gasUsed:   70000,
+			expRefund: &refund{types.FeeCollectorName, payer, sdk.NewCoins(sdk.NewInt64Coin("atom", 300), sdk.NewInt64Coin("stake", 3))},
+		},
+		{
+			name:           "refund to fee granter",
+			fee:            sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)),
+			gasUsed:        70000,
+			granter:        granter,
+			expRefund:      &refund{types.FeeCollectorName, granter, sdk.NewCoins(sdk.NewInt64Coin("atom", 300))},
+			expGrantRefund: &grantRefund{granter, payer, sdk.NewCoins(sdk.NewInt64Coin("atom", 300))},
+		},
+		{
+			name:      "refund to fee payer granting itself",
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)),
+			gasUsed:   70000,
+			granter:   payer,
+			expRefund: &refund{types.FeeCollectorName, payer, sdk.NewCoins(sdk.NewInt64Coin("atom", 300))},
+		},
+		{
+			name:      "charge at least the minimum ratio of gas",
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)),
+			gasUsed:   10000,
+			expRefund: &refund{types.FeeCollectorName, payer, sdk.NewCoins(sdk.NewInt64Coin("atom", 500))},
+		},
+		{
+			name:    "no refund when all gas is used",
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)),
+			gasUsed: 100000,
+		},
+		{
+			name:    "no refund without fees",
+			gasUsed: 10000,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txConfig := moduletestutil.MakeTestEncodingConfig().TxConfig
+			txBuilder := txConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(payer)))
+			txBuilder.SetFeeAmount(tc.fee)
+			txBuilder.SetGasLimit(100000)
+			txBuilder.SetFeeGranter(tc.granter)
+
+			bankKeeper, feegrantKeeper := &mockBankKeeper{}, &mockFeegrantKeeper{}
+			postHandler, err := posthandler.NewPostHandler(posthandler.HandlerOptions{BankKeeper: bankKeeper, FeegrantKeeper: feegrantKeeper})
+			require.NoError(t, err)
+
+			ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger()).
+				WithGasMeter(storetypes.NewGasMeter(100000))
+			ctx.GasMeter().ConsumeGas(tc.gasUsed, "test")
+
+			_, err = postHandler(ctx, txBuilder.GetTx(), false, false)
+			require.NoError(t, err)
+			require.Equal(t, tc.gasUsed, ctx.GasMeter().GasConsumed())
+
+			if tc.expGrantRefund == nil {
+				require.Empty(t, feegrantKeeper.refunds)
+			} else {
+				require.Equal(t, []grantRefund{*tc.expGrantRefund}, feegrantKeeper.refunds)
+			}
+
+			if tc.expRefund == nil {
+				require.Empty(t, bankKeeper.refunds)
+				return
+			}
+			require.Equal(t, []refund{*tc.expRefund}, bankKeeper.refunds)
+		})
+	}
+}
+
+func TestFeeRefundDecoratorWithoutFeegrant(t *testing.T) {
+	_, _, payer := testdata.KeyTestPubAddr()
+	_, _, granter := testdata.KeyTestPubAddr()
+
+	txConfig := moduletestutil.MakeTestEncodingConfig().TxConfig
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(payer)))
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)))
+	txBuilder.SetGasLimit(100000)
+	txBuilder.SetFeeGranter(granter)
+
+	bankKeeper := &mockBankKeeper{}
+	postHandler, err := posthandler.NewPostHandler(posthandler.HandlerOptions{BankKeeper: bankKeeper})
+	require.NoError(t, err)
+
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger()).
+		WithGasMeter(storetypes.NewGasMeter(100000))
+	ctx.GasMeter().ConsumeGas(70000, "test")
+
+	_, err = postHandler(ctx, txBuilder.GetTx(), false, false)
+	require.ErrorContains(t, err, "fee grants are not enabled")
+	require.Empty(t, bankKeeper.refunds)
+}
+
+func TestNewPostHandlerInvalidMinGasChargedRatio(t *testing.T) {
+	ratio := math.LegacyNewDec(2)
+	_, err := posthandler.NewPostHandler(posthandler.HandlerOptions{
+		BankKeeper:         &mockBankKeeper{},
+		MinGasChargedRatio: &ratio,
+	})
+	require.Error(t, err)
+}
@@ -1,15 +1,51 @@
 package posthandler
 
 import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // HandlerOptions are the options required for constructing a default SDK PostHandler.
-type HandlerOptions struct{}
+type HandlerOptions struct {
+	// BankKeeper enables the refund of the fees paid for unused gas when set,
+	// see FeeRefundDecorator.
+	BankKeeper BankKeeper
+	// FeegrantKeeper gives the refunded fees back to the fee allowances which
+	// paid them. It is required to refund the fees paid by a fee granter.
+	FeegrantKeeper FeegrantKeeper
+	// MinGasChargedRatio is the minimum ratio of the gas limit of a tx which
+	// is charged when refunding fees, it defaults to DefaultMinGasChargedRatio.
+	MinGasChargedRatio *math.LegacyDec
+	// TipBankKeeper enables the transfer of the tips of txs to their fee payer
+	// when set, see TipDecorator.
+	TipBankKeeper TipBankKeeper
+}
 
-// NewPostHandler returns an empty PostHandler chain.
-func NewPostHandler(_ HandlerOptions) (sdk.PostHandler, error) {
+// NewPostHandler returns a PostHandler chain, which transfers tips when a
+// TipBankKeeper is provided and refunds the fees paid for unused gas when a
+// BankKeeper is provided, and is empty otherwise.
+func NewPostHandler(options HandlerOptions) (sdk.PostHandler, error) {
 	postDecorators := []sdk.PostDecorator{}
 
+	if options.TipBankKeeper != nil {
+		postDecorators = append(postDecorators, NewTipDecorator(options.TipBankKeeper))
+	}
+
+	if options.BankKeeper != nil {
+		minGasChargedRatio := DefaultMinGasChargedRatio
+		if options.MinGasChargedRatio != nil {
+			minGasChargedRatio = *options.MinGasChargedRatio
+		}
+
+		if minGasChargedRatio.IsNegative() || minGasChargedRatio.GT(math.LegacyOneDec()) {
+			return nil, fmt.Errorf("minimum ratio of gas charged must be within [0, 1], got %s", minGasChargedRatio)
+		}
+
+		postDecorators = append(postDecorators, NewFeeRefundDecorator(options.BankKeeper, options.FeegrantKeeper, minGasChargedRatio))
+	}
+
 	return sdk.ChainPostDecorators(postDecorators...), nil
 }
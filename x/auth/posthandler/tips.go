@@ -3,40 +3,63 @@ package posthandler
 import (
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx"
-	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+const (
+	// AttributeKeyTip is the attribute of the tx event holding the tip
+	// transferred to the fee payer.
+	AttributeKeyTip = "tip"
+	// AttributeKeyTipper is the attribute of the tx event holding the tipper.
+	AttributeKeyTipper = "tipper"
 )
 
-// ValidateBasicDecorator will call tx.ValidateBasic and return any non-nil error.
-// If ValidateBasic passes, decorator calls next AnteHandler in chain. Note,
-// ValidateBasicDecorator decorator will not get executed on ReCheckTx since it
-// is not dependent on application state.
-type tipDecorator struct {
-	bankKeeper types.BankKeeper
+// TipBankKeeper defines the contract needed to transfer tips.
+type TipBankKeeper interface {
+	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
+	SendCoins(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) error
+}
+
+// TipDecorator transfers the tip of a tx from the tipper to the fee payer,
+// once the messages of the tx succeeded. The tipper signs the tx
+// with its tip in any denom using SIGN_MODE_DIRECT_AUX, and the fee payer
+// pays the fees in the denoms accepted by the chain in exchange for the tip.
+//
+// CONTRACT: The tipper must be one of the signers of the tx, whose signatures
+// must have been verified, e.g. by ante.SigVerificationDecorator.
+type TipDecorator struct {
+	bankKeeper TipBankKeeper
 }
 
 // NewTipDecorator returns a new decorator for handling transactions with
 // tips.
-//
-// IMPORTANT: This decorator is still in beta, please use it at your own risk.
-func NewTipDecorator(bankKeeper types.BankKeeper) sdk.AnteDecorator {
-	return tipDecorator{
+func NewTipDecorator(bankKeeper TipBankKeeper) TipDecorator {
+	return TipDecorator{
 		bankKeeper: bankKeeper,
 	}
 }
 
-func (d tipDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
-	err := d.transferTip(ctx, tx)
-	if err != nil {
+func (d TipDecorator) PostHandle(ctx sdk.Context, sdkTx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	// The tipper only pays for the execution of its messages.
+	if !success {
+		return next(ctx, sdkTx, simulate, success)
+	}
+
+	if err := d.transferTip(ctx, sdkTx); err != nil {
 		return ctx, err
 	}
 
-	return next(ctx, tx, simulate)
+	return next(ctx, sdkTx, simulate, success)
 }
 
 // transferTip transfers the tip from the tipper to the fee payer.
-func (d tipDecorator) transferTip(ctx sdk.Context, sdkTx sdk.Tx) error {
+func (d TipDecorator) transferTip(ctx sdk.Context, sdkTx sdk.Tx) error {
 	tipTx, ok := sdkTx.(tx.TipTx)
 
 	// No-op if the tx doesn't have tips.
@@ -44,15 +67,70 @@ func (d tipDecorator) transferTip(ctx sdk.Context, sdkTx sdk.Tx) error {
 		return nil
 	}
 
-	tipper, err := sdk.AccAddressFromBech32(tipTx.GetTip().Tipper)
+	tip := tipTx.GetTip()
+	tipper, err := sdk.AccAddressFromBech32(tip.Tipper)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid tipper address: %s", err)
+	}
+
+	// Without this check, the fee payer could make anyone pay the tip.
+	sigTx, ok := sdkTx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+	signMode, err := signerSignMode(sigTx, tipper)
 	if err != nil {
 		return err
 	}
+	// The LEGACY_AMINO_JSON sign doc doesn't include the tip, so that the
+	// tipper's signature wouldn't cover it.
+	if signMode != signing.SignMode_SIGN_MODE_DIRECT_AUX && signMode != signing.SignMode_SIGN_MODE_DIRECT {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "tipper %s must sign with %s", tip.Tipper, signing.SignMode_SIGN_MODE_DIRECT_AUX)
+	}
+
+	if tip.Amount.IsZero() {
+		return nil
+	}
 
-	coins := tipTx.GetTip().Amount
-	if err := d.bankKeeper.IsSendEnabledCoins(ctx, coins...); err != nil {
+	if err := d.bankKeeper.IsSendEnabledCoins(ctx, tip.Amount...); err != nil {
 		return fmt.Errorf("cannot tip these coins: %w", err)
 	}
 
-	return d.bankKeeper.SendCoins(ctx, tipper, tipTx.FeePayer(), coins)
+	if err := d.bankKeeper.SendCoins(ctx, tipper, tipTx.FeePayer(), tip.Amount); err != nil {
+		return errorsmod.Wrapf(err, "failed to transfer tip")
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(AttributeKeyTip, tip.Amount.String()),
+			sdk.NewAttribute(AttributeKeyTipper, tip.Tipper),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, tipTx.FeePayer().String()),
+		),
+	)
+
+	return nil
+}
+
+// signerSignMode returns the sign mode used by the given signer of the tx.
+func signerSignMode(tx authsigning.SigVerifiableTx, addr sdk.AccAddress) (signing.SignMode, error) {
+	sigs, err := tx.GetSignaturesV2()
+	if err != nil {
+		return 0, err
+	}
+
+	for i, signer := range tx.GetSigners() {
+		if !signer.Equals(addr) {
+			continue
+		}
+		if i >= len(sigs) {
+			break
+		}
+		if data, ok := sigs[i].Data.(*signing.SingleSignatureData); ok {
+			return data.SignMode, nil
+		}
+		return 0, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "tipper %s must use a single signature", addr)
+	}
+
+	return 0, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "tipper %s must be a signer of the tx", addr)
 }
@@ -0,0 +1,125 @@
+package posthandler_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
+)
+
+const sendGas = 1000
+
+type transfer struct {
+	from, to sdk.AccAddress
+	amount   sdk.Coins
+}
+
+type mockTipBankKeeper struct {
+	transfers []transfer
+}
+
+func (bk *mockTipBankKeeper) IsSendEnabledCoins(_ sdk.Context, _ ...sdk.Coin) error {
+	return nil
+}
+
+func (bk *mockTipBankKeeper) SendCoins(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	ctx.GasMeter().ConsumeGas(sendGas, "send coins")
+	bk.transfers = append(bk.transfers, transfer{from, to, amt})
+	return nil
+}
+
+func TestTipDecorator(t *testing.T) {
+	_, tipperPub, tipper := testdata.KeyTestPubAddr()
+	_, _, feePayer := testdata.KeyTestPubAddr()
+	_, _, other := testdata.KeyTestPubAddr()
+	tip := sdk.NewCoins(sdk.NewInt64Coin("tiptoken", 100))
+
+	testCases := []struct {
+		name         string
+		tip          *tx.Tip
+		signMode     signing.SignMode
+		success      bool
+		expErr       error
+		expTransfers []transfer
+	}{
+		{
+			name:         "transfer tip to fee payer",
+			tip:          &tx.Tip{Amount: tip, Tipper: tipper.String()},
+			signMode:     signing.SignMode_SIGN_MODE_DIRECT_AUX,
+			success:      true,
+			expTransfers: []transfer{{tipper, feePayer, tip}},
+		},
+		{
+			name:     "no transfer when msgs failed",
+			tip:      &tx.Tip{Amount: tip, Tipper: tipper.String()},
+			signMode: signing.SignMode_SIGN_MODE_DIRECT_AUX,
+			success:  false,
+		},
+		{
+			name:    "no tip",
+			success: true,
+		},
+		{
+			name:     "tipper is not a signer",
+			tip:      &tx.Tip{Amount: tip, Tipper: other.String()},
+			signMode: signing.SignMode_SIGN_MODE_DIRECT_AUX,
+			success:  true,
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:     "tipper signed with amino json",
+			tip:      &tx.Tip{Amount: tip, Tipper: tipper.String()},
+			signMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+			success:  true,
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:    "invalid tipper",
+			tip:     &tx.Tip{Amount: tip, Tipper: "invalid"},
+			success: true,
+			expErr:  sdkerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txConfig := moduletestutil.MakeTestEncodingConfig().TxConfig
+			txBuilder := txConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(tipper)))
+			txBuilder.SetFeePayer(feePayer)
+			txBuilder.SetTip(tc.tip)
+			require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{
+				PubKey: tipperPub,
+				Data:   &signing.SingleSignatureData{SignMode: tc.signMode},
+			}))
+
+			bankKeeper := &mockTipBankKeeper{}
+			postHandler, err := posthandler.NewPostHandler(posthandler.HandlerOptions{TipBankKeeper: bankKeeper})
+			require.NoError(t, err)
+
+			ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger()).
+				WithGasMeter(storetypes.NewGasMeter(100000))
+
+			_, err = postHandler(ctx, txBuilder.GetTx(), false, tc.success)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expTransfers, bankKeeper.transfers)
+			// the transfer is charged to the tx
+			require.Equal(t, storetypes.Gas(len(tc.expTransfers)*sendGas), ctx.GasMeter().GasConsumed())
+		})
+	}
+}
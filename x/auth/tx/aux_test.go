@@ -63,7 +63,7 @@ func TestBuilderWithAux(t *testing.T) {
 	require.NoError(t, err)
 	aux2Builder.SetExtensionOptions(extOptAny)
 	aux2Builder.SetNonCriticalExtensionOptions(extOptAny)
-	err = aux2Builder.SetSignMode(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	err = aux2Builder.SetSignMode(signing.SignMode_SIGN_MODE_DIRECT_AUX)
 	require.NoError(t, err)
 	signBz, err := aux2Builder.GetSignBytes()
 	require.NoError(t, err)
@@ -178,7 +178,7 @@ func TestBuilderWithAux(t *testing.T) {
 	}, sigs[0])
 	require.Equal(t, signing.SignatureV2{
 		PubKey:   aux2Pk,
-		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, Signature: aux2Sig},
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT_AUX, Signature: aux2Sig},
 		Sequence: 12,
 	}, sigs[1])
 	require.Equal(t, signing.SignatureV2{
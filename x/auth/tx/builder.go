@@ -41,6 +41,7 @@ var (
 	_ tx.TipTx                   = &wrapper{}
 	_ ante.HasExtensionOptionsTx = &wrapper{}
 	_ ExtensionOptionsTxBuilder  = &wrapper{}
+	_ sdk.TxWithUnordered        = &wrapper{}
 	_ tx.TipTx                   = &wrapper{}
 )
 
@@ -169,6 +170,12 @@ func (w *wrapper) GetTimeoutHeight() uint64 {
 	return w.tx.Body.TimeoutHeight
 }
 
+// GetUnordered returns whether the transaction is unordered, i.e. whether its
+// extension options contain the ExtensionOptionUnordered option.
+func (w *wrapper) GetUnordered() bool {
+	return tx.HasUnorderedExtensionOption(w.tx.Body.ExtensionOptions)
+}
+
 func (w *wrapper) GetSignaturesV2() ([]signing.SignatureV2, error) {
 	signerInfos := w.tx.AuthInfo.SignerInfos
 	sigs := w.tx.Signatures
@@ -372,6 +379,23 @@ func (w *wrapper) SetExtensionOptions(extOpts ...*codectypes.Any) {
 	w.bodyBz = nil
 }
 
+// SetUnordered sets whether the transaction is unordered by adding or removing
+// the ExtensionOptionUnordered option from its extension options.
+func (w *wrapper) SetUnordered(unordered bool) {
+	extOpts := make([]*codectypes.Any, 0, len(w.tx.Body.ExtensionOptions)+1)
+	for _, opt := range w.tx.Body.ExtensionOptions {
+		if !tx.IsUnorderedExtensionOption(opt) {
+			extOpts = append(extOpts, opt)
+		}
+	}
+
+	if unordered {
+		extOpts = append(extOpts, tx.NewUnorderedExtensionOption())
+	}
+
+	w.SetExtensionOptions(extOpts...)
+}
+
 func (w *wrapper) SetNonCriticalExtensionOptions(extOpts ...*codectypes.Any) {
 	w.tx.Body.NonCriticalExtensionOptions = extOpts
 	w.bodyBz = nil
@@ -298,6 +298,7 @@ func TestBuilderFeePayer(t *testing.T) {
 func TestBuilderFeeGranter(t *testing.T) {
 	// keys and addresses
 	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
 
 	// msg and signatures
 	msg1 := testdata.NewTestMsg(addr1, addr2)
@@ -316,3 +317,23 @@ func TestBuilderFeeGranter(t *testing.T) {
 	txBuilder.SetFeeGranter(addr1)
 	require.Equal(t, addr1, txBuilder.GetTx().FeeGranter())
 }
+
+func TestBuilderUnordered(t *testing.T) {
+	txBuilder := newBuilder(nil)
+	extOpt, err := codectypes.NewAnyWithValue(testdata.NewTestMsg())
+	require.NoError(t, err)
+	txBuilder.SetExtensionOptions(extOpt)
+
+	require.False(t, txBuilder.GetUnordered())
+
+	// setting the flag twice only adds the extension option once
+	txBuilder.SetUnordered(true)
+	txBuilder.SetUnordered(true)
+	require.True(t, txBuilder.GetUnordered())
+	require.Len(t, txBuilder.tx.Body.ExtensionOptions, 2)
+
+	// unsetting the flag keeps the other extension options
+	txBuilder.SetUnordered(false)
+	require.False(t, txBuilder.GetUnordered())
+	require.Equal(t, []*codectypes.Any{extOpt}, txBuilder.tx.Body.ExtensionOptions)
+}
@@ -29,8 +29,8 @@ type config struct {
 // first enabled sign mode will become the default sign mode.
 //
 // NOTE: Use NewTxConfigWithHandler to provide a custom signing handler in case the sign mode
-// is not supported by default (eg: SignMode_SIGN_MODE_EIP_191). Use NewTxConfigWithOptions
-// to enable SIGN_MODE_TEXTUAL (for testing purposes for now).
+// is not supported by default. SignMode_SIGN_MODE_EIP_191 is supported but must be explicitly
+// enabled. Use NewTxConfigWithOptions to enable SIGN_MODE_TEXTUAL (for testing purposes for now).
 //
 // We prefer to use depinject to provide client.TxConfig, but we permit this constructor usage.  Within the SDK,
 // this constructor is primarily used in tests, but also sees usage in app chains like:
@@ -57,12 +57,21 @@ func NewTxConfig(protoCodec codec.ProtoCodecMarshaler, enabledSignModes []signin
 				SignersContext: signersContext,
 			}
 		case signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON:
+			mustLegacyAminoEnabled(m)
 			aminoJSONEncoder := aminojson.NewAminoJSON()
 			signModeOptions.AminoJSON = &aminojson.SignModeHandlerOptions{
 				FileResolver: protoFiles,
 				TypeResolver: typeResolver,
 				Encoder:      &aminoJSONEncoder,
 			}
+		case signingtypes.SignMode_SIGN_MODE_EIP_191:
+			mustLegacyAminoEnabled(m)
+			aminoJSONEncoder := aminojson.NewAminoJSON()
+			signModeOptions.EIP191 = &aminojson.SignModeHandlerOptions{
+				FileResolver: protoFiles,
+				TypeResolver: typeResolver,
+				Encoder:      &aminoJSONEncoder,
+			}
 		case signingtypes.SignMode_SIGN_MODE_TEXTUAL:
 			panic("cannot use NewTxConfig with SIGN_MODE_TEXTUAL enabled; please use NewTxConfigWithOptions")
 		}
@@ -71,6 +80,20 @@ func NewTxConfig(protoCodec codec.ProtoCodecMarshaler, enabledSignModes []signin
 	return NewTxConfigWithHandler(protoCodec, makeSignModeHandler(*signModeOptions, customSignModes...))
 }
 
+// NewPureProtoTxConfig returns a new protobuf TxConfig enabling only the ProtoSignModes, SIGN_MODE_DIRECT
+// being the default sign mode. The sign modes relying on amino JSON sign docs are rejected, including the
+// custom ones. Apps opting out of amino entirely should also be built with the noamino build tag, see
+// codec.LegacyAminoEnabled.
+func NewPureProtoTxConfig(protoCodec codec.ProtoCodecMarshaler, customSignModes ...txsigning.SignModeHandler) client.TxConfig {
+	for _, h := range customSignModes {
+		if isAminoSignMode(h.Mode()) {
+			panic(fmt.Sprintf("cannot use %s in a pure-proto TxConfig", h.Mode()))
+		}
+	}
+
+	return NewTxConfig(protoCodec, ProtoSignModes, customSignModes...)
+}
+
 func NewTxConfigWithOptions(protoCodec codec.ProtoCodecMarshaler, signModeOptions SignModeOptions,
 	customSignModes ...txsigning.SignModeHandler,
 ) client.TxConfig {
@@ -95,13 +95,16 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 			// meaning that both `runMsgs` and `postHandler` state will be committed if
 			// both are successful, and both will be reverted if any of the two fails.
 			//
-			// The SDK exposes a default empty postHandlers chain.
+			// The SDK exposes a default postHandlers chain, which transfers the tips
+			// of txs to their fee payer when a BankKeeper is provided.
 			//
 			// Please note that changing any of the anteHandler or postHandler chain is
 			// likely to be a state-machine breaking change, which needs a coordinated
 			// upgrade.
 			postHandler, err := posthandler.NewPostHandler(
-				posthandler.HandlerOptions{},
+				posthandler.HandlerOptions{
+					TipBankKeeper: in.BankKeeper,
+				},
 			)
 			if err != nil {
 				panic(err)
@@ -206,7 +209,6 @@ func NewSignModeOptionsWithMetadataQueryFn(fn textual.CoinMetadataQueryFn) (tx.S
 		return tx.SignModeOptions{}, err
 	}
 
-	aminoJSONEncoder := aminojson.NewAminoJSON()
 	signModeOptions := tx.SignModeOptions{
 		Direct: &direct.SignModeHandler{},
 		DirectAux: &directaux.SignModeHandlerOptions{
@@ -214,11 +216,6 @@ func NewSignModeOptionsWithMetadataQueryFn(fn textual.CoinMetadataQueryFn) (tx.S
 			TypeResolver:   typeResolver,
 			SignersContext: signersContext,
 		},
-		AminoJSON: &aminojson.SignModeHandlerOptions{
-			FileResolver: protoFiles,
-			TypeResolver: typeResolver,
-			Encoder:      &aminoJSONEncoder,
-		},
 		Textual: &textual.SignModeOptions{
 			CoinMetadataQuerier: fn,
 			FileResolver:        protoFiles,
@@ -226,6 +223,16 @@ func NewSignModeOptionsWithMetadataQueryFn(fn textual.CoinMetadataQueryFn) (tx.S
 		},
 	}
 
+	// SIGN_MODE_LEGACY_AMINO_JSON is left out of binaries built with the noamino build tag.
+	if codec.LegacyAminoEnabled {
+		aminoJSONEncoder := aminojson.NewAminoJSON()
+		signModeOptions.AminoJSON = &aminojson.SignModeHandlerOptions{
+			FileResolver: protoFiles,
+			TypeResolver: typeResolver,
+			Encoder:      &aminoJSONEncoder,
+		}
+	}
+
 	return signModeOptions, nil
 }
 
@@ -0,0 +1,23 @@
+//go:build noamino
+// +build noamino
+
+package tx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestNoAminoTxConfig(t *testing.T) {
+	require.Equal(t, ProtoSignModes, DefaultSignModes)
+
+	protoCodec := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	require.PanicsWithValue(t, "cannot enable SIGN_MODE_LEGACY_AMINO_JSON in a binary built with the noamino build tag", func() {
+		NewTxConfig(protoCodec, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON})
+	})
+}
@@ -3,8 +3,12 @@ package tx
 import (
 	"testing"
 
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	"cosmossdk.io/x/tx/signing/aminojson"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/std"
@@ -20,3 +24,23 @@ func TestGenerator(t *testing.T) {
 	protoCodec := codec.NewProtoCodec(interfaceRegistry)
 	suite.Run(t, txtestutil.NewTxConfigTestSuite(NewTxConfig(protoCodec, DefaultSignModes)))
 }
+
+func TestPureProtoTxConfig(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(interfaceRegistry)
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	protoCodec := codec.NewProtoCodec(interfaceRegistry)
+
+	txConfig := NewPureProtoTxConfig(protoCodec)
+	suite.Run(t, txtestutil.NewTxConfigTestSuite(txConfig))
+
+	require.Equal(t, signingv1beta1.SignMode_SIGN_MODE_DIRECT, txConfig.SignModeHandler().DefaultMode())
+	require.ElementsMatch(t, []signingv1beta1.SignMode{
+		signingv1beta1.SignMode_SIGN_MODE_DIRECT,
+		signingv1beta1.SignMode_SIGN_MODE_DIRECT_AUX,
+	}, txConfig.SignModeHandler().SupportedModes())
+
+	require.PanicsWithValue(t, "cannot use SIGN_MODE_EIP_191 in a pure-proto TxConfig", func() {
+		NewPureProtoTxConfig(protoCodec, NewSignModeEIP191Handler(aminojson.SignModeHandlerOptions{}))
+	})
+}
@@ -0,0 +1,50 @@
+package tx
+
+import (
+	"context"
+	"fmt"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txsigning "cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/aminojson"
+)
+
+// EIP191MessagePrefix is the prefix of the messages signed with Ethereum's
+// personal_sign, as defined by EIP-191, followed by the length of the message.
+const EIP191MessagePrefix = "\x19Ethereum Signed Message:\n"
+
+var _ txsigning.SignModeHandler = SignModeEIP191Handler{}
+
+// SignModeEIP191Handler defines the SIGN_MODE_EIP_191 SignModeHandler. Its sign
+// bytes are the SIGN_MODE_LEGACY_AMINO_JSON sign bytes, prefixed as defined by
+// EIP-191, so that they can be signed by Ethereum wallets with personal_sign.
+// Ref: https://eips.ethereum.org/EIPS/eip-191
+//
+// It is not enabled by default, and is meant to be used together with
+// eth_secp256k1 keys, which sign over the Keccak-256 hash of the sign bytes.
+// Like the amino JSON sign bytes, its sign bytes don't commit to the encoding
+// of the tx, which is why unordered txs cannot be signed with this sign mode.
+type SignModeEIP191Handler struct {
+	aminoJSON *aminojson.SignModeHandler
+}
+
+// NewSignModeEIP191Handler returns a new SignModeEIP191Handler, using the
+// given options to produce the underlying amino JSON sign bytes.
+func NewSignModeEIP191Handler(options aminojson.SignModeHandlerOptions) *SignModeEIP191Handler {
+	return &SignModeEIP191Handler{aminoJSON: aminojson.NewSignModeHandler(options)}
+}
+
+// Mode implements the Mode method of the SignModeHandler interface.
+func (SignModeEIP191Handler) Mode() signingv1beta1.SignMode {
+	return signingv1beta1.SignMode_SIGN_MODE_EIP_191
+}
+
+// GetSignBytes implements the GetSignBytes method of the SignModeHandler interface.
+func (h SignModeEIP191Handler) GetSignBytes(ctx context.Context, signerData txsigning.SignerData, txData txsigning.TxData) ([]byte, error) {
+	aminoJSONBz, err := h.aminoJSON.GetSignBytes(ctx, signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(fmt.Sprintf("%s%d", EIP191MessagePrefix, len(aminoJSONBz))), aminoJSONBz...), nil
+}
@@ -0,0 +1,111 @@
+//go:build !noamino
+// +build !noamino
+
+package tx_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	_ "cosmossdk.io/api/cosmos/bank/v1beta1"
+	"cosmossdk.io/x/tx/decode"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/registry"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestEIP191Handler(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(interfaceRegistry)
+	banktypes.RegisterInterfaces(interfaceRegistry)
+	txConfig := tx.NewTxConfig(codec.NewProtoCodec(interfaceRegistry), []signing.SignMode{
+		signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		signing.SignMode_SIGN_MODE_EIP_191,
+	})
+
+	priv := ethsecp256k1.GenPrivKey()
+	pubKey := priv.PubKey()
+	addr := sdk.AccAddress(pubKey.Address())
+
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))))
+	txBuilder.SetMemo(memo)
+	txBuilder.SetFeeAmount(fee)
+	txBuilder.SetGasLimit(gas)
+	require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{PubKey: pubKey, Sequence: 1}))
+
+	signerData := authsigning.SignerData{
+		Address:       addr.String(),
+		ChainID:       chainID,
+		AccountNumber: 3,
+		Sequence:      1,
+		PubKey:        pubKey,
+	}
+
+	aminoJSONBz, err := authsigning.GetSignBytesAdapter(
+		context.Background(), txConfig.TxEncoder(), txConfig.SignModeHandler(), signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		signerData, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	signBz, err := authsigning.GetSignBytesAdapter(
+		context.Background(), txConfig.TxEncoder(), txConfig.SignModeHandler(), signing.SignMode_SIGN_MODE_EIP_191,
+		signerData, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	// the sign bytes are the amino JSON sign bytes, with the EIP-191 prefix
+	expected := append([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d", len(aminoJSONBz))), aminoJSONBz...)
+	require.Equal(t, expected, signBz)
+
+	// the signature of an eth_secp256k1 key is verified
+	sig, err := priv.Sign(signBz)
+	require.NoError(t, err)
+	sigData := &signing.SingleSignatureData{
+		SignMode:  signing.SignMode_SIGN_MODE_EIP_191,
+		Signature: sig,
+	}
+	require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{PubKey: pubKey, Data: sigData, Sequence: 1}))
+
+	txBz, err := txConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+	decodeCtx, err := decode.NewDecoder(decode.Options{ProtoFiles: registry.MergedProtoRegistry()})
+	require.NoError(t, err)
+	decodedTx, err := decodeCtx.Decode(txBz)
+	require.NoError(t, err)
+	txData := txsigning.TxData{
+		Body:          decodedTx.Tx.Body,
+		AuthInfo:      decodedTx.Tx.AuthInfo,
+		AuthInfoBytes: decodedTx.TxRaw.AuthInfoBytes,
+		BodyBytes:     decodedTx.TxRaw.BodyBytes,
+	}
+	anyPk, err := codectypes.NewAnyWithValue(pubKey)
+	require.NoError(t, err)
+	txSignerData := txsigning.SignerData{
+		Address:       signerData.Address,
+		ChainID:       signerData.ChainID,
+		AccountNumber: signerData.AccountNumber,
+		Sequence:      signerData.Sequence,
+		PubKey:        &anypb.Any{TypeUrl: anyPk.TypeUrl, Value: anyPk.Value},
+	}
+
+	err = authsigning.VerifySignature(context.Background(), pubKey, txSignerData, sigData, txConfig.SignModeHandler(), txData)
+	require.NoError(t, err)
+
+	// a signature over other sign bytes is rejected
+	sigData.Signature, err = priv.Sign(aminoJSONBz)
+	require.NoError(t, err)
+	err = authsigning.VerifySignature(context.Background(), pubKey, txSignerData, sigData, txConfig.SignModeHandler(), txData)
+	require.Error(t, err)
+}
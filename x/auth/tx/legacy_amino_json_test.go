@@ -1,3 +1,6 @@
+//go:build !noamino
+// +build !noamino
+
 package tx
 
 import (
@@ -1,11 +1,16 @@
 package tx
 
 import (
+	"fmt"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
 	txsigning "cosmossdk.io/x/tx/signing"
 	"cosmossdk.io/x/tx/signing/aminojson"
 	"cosmossdk.io/x/tx/signing/direct"
 	"cosmossdk.io/x/tx/signing/directaux"
 	"cosmossdk.io/x/tx/signing/textual"
+
+	"github.com/cosmos/cosmos-sdk/codec"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
@@ -18,19 +23,50 @@ type SignModeOptions struct {
 	AminoJSON *aminojson.SignModeHandlerOptions
 	// Direct is the SignModeHandler for SIGN_MODE_DIRECT since it takes options
 	Direct *direct.SignModeHandler
+	// EIP191 are options for SIGN_MODE_EIP_191, which is not enabled by default
+	EIP191 *aminojson.SignModeHandlerOptions
 }
 
-// DefaultSignModes are the default sign modes enabled for protobuf transactions.
-var DefaultSignModes = []signingtypes.SignMode{
+// ProtoSignModes are the sign modes whose sign docs are protobuf encoded, i.e.
+// the sign modes of a pure-proto TxConfig, see NewPureProtoTxConfig.
+var ProtoSignModes = []signingtypes.SignMode{
 	signingtypes.SignMode_SIGN_MODE_DIRECT,
 	signingtypes.SignMode_SIGN_MODE_DIRECT_AUX,
-	signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+}
+
+// DefaultSignModes are the default sign modes enabled for protobuf transactions.
+// SIGN_MODE_LEGACY_AMINO_JSON is left out of binaries built with the noamino
+// build tag.
+var DefaultSignModes = defaultSignModes()
+
+func defaultSignModes() []signingtypes.SignMode {
+	modes := append([]signingtypes.SignMode{}, ProtoSignModes...)
+	if codec.LegacyAminoEnabled {
+		modes = append(modes, signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	}
+
 	// We currently don't add SIGN_MODE_TEXTUAL as part of the default sign
 	// modes, as it's not released yet (including the Ledger app). However,
 	// textual's sign mode handler is already available in this package. If you
 	// want to use textual for **TESTING** purposes, feel free to create a
 	// handler that includes SIGN_MODE_TEXTUAL.
 	// ref: Tracking issue for SIGN_MODE_TEXTUAL https://github.com/cosmos/cosmos-sdk/issues/11970
+	return modes
+}
+
+// isAminoSignMode returns true if mode relies on amino JSON sign docs.
+func isAminoSignMode(mode signingv1beta1.SignMode) bool {
+	return mode == signingv1beta1.SignMode_SIGN_MODE_LEGACY_AMINO_JSON ||
+		mode == signingv1beta1.SignMode_SIGN_MODE_EIP_191
+}
+
+// mustLegacyAminoEnabled panics if the binary is built with the noamino build
+// tag, in which case the sign modes relying on amino JSON sign docs cannot be
+// enabled.
+func mustLegacyAminoEnabled(mode fmt.Stringer) {
+	if !codec.LegacyAminoEnabled {
+		panic(fmt.Sprintf("cannot enable %s in a binary built with the noamino build tag", mode))
+	}
 }
 
 // makeSignModeHandler returns the default protobuf SignModeHandler supporting
@@ -58,8 +94,13 @@ func makeSignModeHandler(
 		handlers = append(handlers, h)
 	}
 	if opts.AminoJSON != nil {
+		mustLegacyAminoEnabled(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
 		handlers = append(handlers, aminojson.NewSignModeHandler(*opts.AminoJSON))
 	}
+	if opts.EIP191 != nil {
+		mustLegacyAminoEnabled(signingtypes.SignMode_SIGN_MODE_EIP_191)
+		handlers = append(handlers, NewSignModeEIP191Handler(*opts.EIP191))
+	}
 	handlers = append(handlers, customSignModes...)
 	return txsigning.NewHandlerMap(handlers...)
 }
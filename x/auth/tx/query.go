@@ -93,6 +93,55 @@ func QueryTx(clientCtx client.Context, hashHexStr string) (*sdk.TxResponse, erro
 	return out, nil
 }
 
+// QueryBlockTxs fetches the block at the given height along with its
+// execution results and decodes every transaction it contains with the
+// client's TxConfig. The returned responses carry the decoded messages, the
+// emitted events and the gas consumed by each transaction. A nil height
+// queries the latest committed block.
+func QueryBlockTxs(clientCtx client.Context, height *int64) (*sdk.SearchTxsResult, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	resBlock, err := node.Block(context.Background(), height)
+	if err != nil {
+		return nil, err
+	}
+
+	resBlockResults, err := node.BlockResults(context.Background(), &resBlock.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	blockTxs := resBlock.Block.Txs
+	if len(blockTxs) != len(resBlockResults.TxsResults) {
+		return nil, fmt.Errorf(
+			"block %d contains %d txs but %d tx results were returned",
+			resBlock.Block.Height, len(blockTxs), len(resBlockResults.TxsResults),
+		)
+	}
+
+	txs := make([]*sdk.TxResponse, len(blockTxs))
+	for i, txBytes := range blockTxs {
+		resTx := &coretypes.ResultTx{
+			Hash:     txBytes.Hash(),
+			Height:   resBlock.Block.Height,
+			Index:    uint32(i),
+			TxResult: *resBlockResults.TxsResults[i],
+			Tx:       txBytes,
+		}
+
+		txs[i], err = mkTxResult(clientCtx.TxConfig, resTx, resBlock)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	count := uint64(len(txs))
+	return sdk.NewSearchTxsResult(count, count, 1, count, txs), nil
+}
+
 // formatTxResults parses the indexed txs into a slice of TxResponse objects.
 func formatTxResults(txConfig client.TxConfig, resTxs []*coretypes.ResultTx, resBlocks map[int64]*coretypes.ResultBlock) ([]*sdk.TxResponse, error) {
 	var err error
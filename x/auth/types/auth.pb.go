@@ -174,6 +174,11 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty"`
+	// gas_costs defines the gas charged for store accesses. The store defaults
+	// are used when unset.
+	//
+	// Since: cosmos-sdk 0.50
+	GasCosts *GasCosts `protobuf:"bytes,6,opt,name=gas_costs,json=gasCosts,proto3" json:"gas_costs,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -244,6 +249,13 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetGasCosts() *GasCosts {
+	if m != nil {
+		return m.GasCosts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
@@ -254,53 +266,55 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 733 bytes of a gzipped FileDescriptorProto
+	// 760 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xc1, 0x4e, 0xeb, 0x46,
-	0x14, 0x8d, 0x93, 0x14, 0xca, 0x04, 0x68, 0x31, 0x29, 0x35, 0x51, 0x15, 0x9b, 0x48, 0x2d, 0x29,
-	0x2a, 0x76, 0x93, 0x8a, 0x4a, 0xcd, 0x8e, 0xa4, 0x55, 0x85, 0x28, 0x14, 0x39, 0x2a, 0x0b, 0x36,
-	0xd6, 0xd8, 0x19, 0xcc, 0x88, 0x8c, 0xc7, 0xf5, 0x8c, 0x51, 0xcc, 0xba, 0x0b, 0xd4, 0x55, 0xd5,
-	0x2f, 0xa0, 0xfd, 0x02, 0x16, 0x7c, 0x44, 0xd5, 0x15, 0xea, 0xa6, 0x6f, 0x15, 0x3d, 0x85, 0x05,
-	0xe8, 0xe9, 0x7d, 0xc4, 0x93, 0x67, 0x1c, 0x48, 0x78, 0xd9, 0x44, 0x9e, 0x73, 0xce, 0xbd, 0xf7,
-	0xdc, 0xe3, 0x89, 0x41, 0xd5, 0xa3, 0x8c, 0x50, 0x66, 0xc1, 0x98, 0x9f, 0x59, 0x17, 0x0d, 0x17,
-	0x71, 0xd8, 0x10, 0x07, 0x33, 0x8c, 0x28, 0xa7, 0xea, 0xaa, 0xe4, 0x4d, 0x01, 0x65, 0x7c, 0x65,
-	0x05, 0x12, 0x1c, 0x50, 0x4b, 0xfc, 0x4a, 0x5d, 0x65, 0x5d, 0xea, 0x1c, 0x71, 0xb2, 0xb2, 0x22,
-	0x49, 0x95, 0x7d, 0xea, 0x53, 0x89, 0xa7, 0x4f, 0xe3, 0x02, 0x9f, 0x52, 0xbf, 0x8f, 0x2c, 0x71,
-	0x72, 0xe3, 0x53, 0x0b, 0x06, 0x89, 0xa4, 0x6a, 0x7f, 0xe5, 0x41, 0xa9, 0x0d, 0x19, 0xda, 0xf5,
-	0x3c, 0x1a, 0x07, 0x5c, 0x6d, 0x82, 0x79, 0xd8, 0xeb, 0x45, 0x88, 0x31, 0x4d, 0x31, 0x94, 0xfa,
-	0x42, 0x5b, 0xfb, 0xef, 0x76, 0xbb, 0x9c, 0xcd, 0xd8, 0x95, 0x4c, 0x97, 0x47, 0x38, 0xf0, 0xed,
-	0xb1, 0x50, 0x3d, 0x06, 0xf3, 0x61, 0xec, 0x3a, 0xe7, 0x28, 0xd1, 0xf2, 0x86, 0x52, 0x2f, 0x35,
-	0xcb, 0xa6, 0x1c, 0x68, 0x8e, 0x07, 0x9a, 0xbb, 0x41, 0xd2, 0xde, 0x7c, 0x33, 0xd4, 0xcb, 0x61,
-	0xec, 0xf6, 0xb1, 0x97, 0x6a, 0xbf, 0xa2, 0x04, 0x73, 0x44, 0x42, 0x9e, 0xfc, 0xfd, 0x70, 0xb3,
-	0x05, 0x9e, 0x09, 0x7b, 0x2e, 0x8c, 0xdd, 0x7d, 0x94, 0xa8, 0x9f, 0x83, 0x65, 0x28, 0x6d, 0x39,
-	0x41, 0x4c, 0x5c, 0x14, 0x69, 0x05, 0x43, 0xa9, 0x17, 0xed, 0xa5, 0x0c, 0x3d, 0x14, 0xa0, 0x5a,
-	0x01, 0x1f, 0x32, 0xf4, 0x6b, 0x8c, 0x02, 0x0f, 0x69, 0x45, 0x21, 0x78, 0x3a, 0xb7, 0x3a, 0x57,
-	0xd7, 0x7a, 0xee, 0xf1, 0x5a, 0xcf, 0xfd, 0x7b, 0xbb, 0xfd, 0xd9, 0x8c, 0x78, 0xcd, 0x6c, 0xef,
-	0xbd, 0xdf, 0x1f, 0x6e, 0xb6, 0xd6, 0xa4, 0x60, 0x9b, 0xf5, 0xce, 0xad, 0x89, 0x4c, 0x6a, 0x6f,
-	0x15, 0xb0, 0x74, 0x40, 0x7b, 0x71, 0xff, 0x29, 0xa5, 0x3d, 0xb0, 0xe8, 0x42, 0x86, 0x9c, 0xcc,
-	0x88, 0x88, 0xaa, 0xd4, 0x34, 0xcc, 0x59, 0x13, 0x26, 0x3a, 0xb5, 0x8b, 0x77, 0x43, 0x5d, 0xb1,
-	0x4b, 0xee, 0x44, 0xe0, 0x2a, 0x28, 0x06, 0x90, 0x20, 0x91, 0xdc, 0x82, 0x2d, 0x9e, 0x55, 0x03,
-	0x94, 0x42, 0x14, 0x11, 0xcc, 0x18, 0xa6, 0x01, 0xd3, 0x0a, 0x46, 0xa1, 0xbe, 0x60, 0x4f, 0x42,
-	0xad, 0x93, 0x2b, 0xb9, 0x53, 0x6d, 0xd6, 0xc4, 0x29, 0xaf, 0x62, 0x33, 0x6d, 0x62, 0xb3, 0x29,
-	0xf6, 0xcf, 0x87, 0x9b, 0xad, 0x65, 0x22, 0x90, 0xf1, 0x32, 0xb5, 0xdf, 0x14, 0xf0, 0xb1, 0x14,
-	0x75, 0x22, 0xd4, 0x43, 0x01, 0xc7, 0xb0, 0xaf, 0xea, 0xa0, 0x94, 0xc9, 0x84, 0x5b, 0x71, 0x37,
-	0x6c, 0x20, 0xa1, 0xc3, 0xd4, 0xf3, 0x26, 0xf8, 0xa8, 0x87, 0x22, 0x7c, 0x01, 0x39, 0xa6, 0x41,
-	0xfa, 0x1a, 0x99, 0x96, 0x37, 0x0a, 0xf5, 0x45, 0x7b, 0xf9, 0x19, 0xde, 0x47, 0x09, 0x6b, 0x7d,
-	0x91, 0x1a, 0xda, 0x98, 0x30, 0xf4, 0x63, 0x44, 0xe3, 0x30, 0xf3, 0xf3, 0x3c, 0xb1, 0xf6, 0x7f,
-	0x1e, 0xcc, 0x1d, 0xc1, 0x08, 0x12, 0xa6, 0x9a, 0x60, 0x95, 0xc0, 0x81, 0x43, 0x10, 0xa1, 0x8e,
-	0x77, 0x06, 0x23, 0xe8, 0x71, 0x14, 0xc9, 0x0b, 0x5a, 0xb4, 0x57, 0x08, 0x1c, 0x1c, 0x20, 0x42,
-	0x3b, 0x4f, 0x84, 0x6a, 0x80, 0x45, 0x3e, 0x70, 0x18, 0xf6, 0x9d, 0x3e, 0x26, 0x98, 0x8b, 0x6c,
-	0x8b, 0x36, 0xe0, 0x83, 0x2e, 0xf6, 0x7f, 0x4a, 0x11, 0xf5, 0x6b, 0xf0, 0x89, 0x50, 0x5c, 0x22,
-	0xc7, 0xa3, 0x8c, 0x3b, 0x21, 0x8a, 0x1c, 0x37, 0xe1, 0x28, 0xbb, 0x61, 0x2b, 0xa9, 0xf4, 0x12,
-	0x75, 0x28, 0xe3, 0x47, 0x28, 0x6a, 0x27, 0x1c, 0xa9, 0x3f, 0x83, 0x4f, 0xd3, 0x86, 0x17, 0x28,
-	0xc2, 0xa7, 0x89, 0x2c, 0x42, 0xbd, 0xe6, 0xce, 0x4e, 0xe3, 0x3b, 0x79, 0xe9, 0xda, 0xda, 0x68,
-	0xa8, 0x97, 0xbb, 0xd8, 0x3f, 0x16, 0x8a, 0xb4, 0xf4, 0x87, 0xef, 0x05, 0x6f, 0x97, 0xd9, 0x14,
-	0x2a, 0xab, 0xd4, 0x5f, 0xc0, 0xfa, 0xcb, 0x86, 0x0c, 0x79, 0x61, 0x73, 0xe7, 0xdb, 0xf3, 0x86,
-	0xf6, 0x81, 0x68, 0x59, 0x19, 0x0d, 0xf5, 0xb5, 0xa9, 0x96, 0xdd, 0xb1, 0xc2, 0x5e, 0x63, 0x33,
-	0xf1, 0xd6, 0xc6, 0xe3, 0xb5, 0xae, 0xbc, 0x7c, 0xe7, 0x03, 0xf9, 0xcd, 0x91, 0x71, 0xb6, 0x3b,
-	0xff, 0x8c, 0xaa, 0xca, 0xdd, 0xa8, 0xaa, 0xbc, 0x1e, 0x55, 0x95, 0x3f, 0xee, 0xab, 0xb9, 0xbb,
-	0xfb, 0x6a, 0xee, 0xd5, 0x7d, 0x35, 0x77, 0xf2, 0xa5, 0x8f, 0xf9, 0x59, 0xec, 0x9a, 0x1e, 0x25,
-	0xd9, 0x77, 0xc5, 0x7a, 0xbf, 0x0b, 0x4f, 0x42, 0xc4, 0xdc, 0x39, 0xf1, 0xdf, 0xfe, 0xe6, 0x5d,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xc4, 0x84, 0x5b, 0x40, 0xd5, 0x04, 0x00, 0x00,
+	0x14, 0x8d, 0x49, 0x9a, 0xf7, 0x32, 0xe1, 0xd1, 0xe2, 0x97, 0x52, 0x13, 0x95, 0xd8, 0x44, 0x6a,
+	0x49, 0x51, 0xb1, 0x9b, 0x54, 0x54, 0x6a, 0x76, 0x24, 0xad, 0x10, 0xa2, 0x50, 0xe4, 0xa8, 0x2c,
+	0xd8, 0x58, 0x63, 0x67, 0x30, 0x23, 0x32, 0x1e, 0xd7, 0x33, 0x46, 0x31, 0xeb, 0x2e, 0x50, 0x57,
+	0x55, 0xfb, 0x03, 0xb4, 0x5f, 0xc0, 0x82, 0x8f, 0xa8, 0xba, 0x42, 0x5d, 0x75, 0x15, 0x55, 0x61,
+	0x01, 0xaa, 0xfa, 0x11, 0x95, 0x67, 0x1c, 0x48, 0x68, 0x36, 0xd6, 0xdc, 0x73, 0xce, 0xbd, 0x73,
+	0xee, 0xf5, 0xd5, 0x80, 0x9a, 0x47, 0x19, 0xa1, 0xcc, 0x82, 0x31, 0x3f, 0xb3, 0x2e, 0x9a, 0x2e,
+	0xe2, 0xb0, 0x29, 0x02, 0x33, 0x8c, 0x28, 0xa7, 0xea, 0x5b, 0xc9, 0x9b, 0x02, 0xca, 0xf8, 0xea,
+	0x32, 0x24, 0x38, 0xa0, 0x96, 0xf8, 0x4a, 0x5d, 0x75, 0x55, 0xea, 0x1c, 0x11, 0x59, 0x59, 0x92,
+	0xa4, 0x2a, 0x3e, 0xf5, 0xa9, 0xc4, 0xd3, 0xd3, 0x24, 0xc1, 0xa7, 0xd4, 0x1f, 0x20, 0x4b, 0x44,
+	0x6e, 0x7c, 0x6a, 0xc1, 0x20, 0xc9, 0xa8, 0xb5, 0x79, 0x9e, 0x7c, 0x98, 0xd5, 0xab, 0xff, 0xba,
+	0x00, 0xca, 0x1d, 0xc8, 0xd0, 0x8e, 0xe7, 0xd1, 0x38, 0xe0, 0x6a, 0x0b, 0xbc, 0x82, 0xfd, 0x7e,
+	0x84, 0x18, 0xd3, 0x14, 0x43, 0x69, 0x94, 0x3a, 0xda, 0x9f, 0xb7, 0x5b, 0x95, 0xcc, 0xc2, 0x8e,
+	0x64, 0x7a, 0x3c, 0xc2, 0x81, 0x6f, 0x4f, 0x84, 0xea, 0x31, 0x78, 0x15, 0xc6, 0xae, 0x73, 0x8e,
+	0x12, 0x6d, 0xc1, 0x50, 0x1a, 0xe5, 0x56, 0xc5, 0x94, 0x7e, 0xcc, 0x89, 0x1f, 0x73, 0x27, 0x48,
+	0x3a, 0x1b, 0xff, 0x8c, 0xf4, 0x4a, 0x18, 0xbb, 0x03, 0xec, 0xa5, 0xda, 0x4f, 0x29, 0xc1, 0x1c,
+	0x91, 0x90, 0x27, 0xbf, 0x3d, 0xdc, 0x6c, 0x82, 0x67, 0xc2, 0x2e, 0x86, 0xb1, 0xbb, 0x8f, 0x12,
+	0xf5, 0x23, 0xb0, 0x04, 0xa5, 0x2d, 0x27, 0x88, 0x89, 0x8b, 0x22, 0x2d, 0x6f, 0x28, 0x8d, 0x82,
+	0xfd, 0x26, 0x43, 0x0f, 0x05, 0xa8, 0x56, 0xc1, 0x6b, 0x86, 0xbe, 0x8f, 0x51, 0xe0, 0x21, 0xad,
+	0x20, 0x04, 0x4f, 0x71, 0xbb, 0x7b, 0x75, 0xad, 0xe7, 0x1e, 0xaf, 0xf5, 0xdc, 0x1f, 0xb7, 0x5b,
+	0x1f, 0xce, 0x99, 0xbe, 0x99, 0xf5, 0xbd, 0xf7, 0xe3, 0xc3, 0xcd, 0xe6, 0x8a, 0x14, 0x6c, 0xb1,
+	0xfe, 0xb9, 0x35, 0x35, 0x93, 0xfa, 0xbf, 0x0a, 0x78, 0x73, 0x40, 0xfb, 0xf1, 0xe0, 0x69, 0x4a,
+	0x7b, 0x60, 0xd1, 0x85, 0x0c, 0x39, 0x99, 0x11, 0x31, 0xaa, 0x72, 0xcb, 0x30, 0xe7, 0xdd, 0x30,
+	0x55, 0xa9, 0x53, 0xb8, 0x1b, 0xe9, 0x8a, 0x5d, 0x76, 0xa7, 0x06, 0xae, 0x82, 0x42, 0x00, 0x09,
+	0x12, 0x93, 0x2b, 0xd9, 0xe2, 0xac, 0x1a, 0xa0, 0x1c, 0xa2, 0x88, 0x60, 0xc6, 0x30, 0x0d, 0x98,
+	0x96, 0x37, 0xf2, 0x8d, 0x92, 0x3d, 0x0d, 0xb5, 0x4f, 0xae, 0x64, 0x4f, 0xf5, 0x79, 0x37, 0xce,
+	0x78, 0x15, 0x9d, 0x69, 0x53, 0x9d, 0xcd, 0xb0, 0x3f, 0x3f, 0xdc, 0x6c, 0x2e, 0x11, 0x81, 0x4c,
+	0x9a, 0xa9, 0xff, 0xa0, 0x80, 0xf7, 0xa4, 0xa8, 0x1b, 0xa1, 0x3e, 0x0a, 0x38, 0x86, 0x03, 0x55,
+	0x07, 0xe5, 0x4c, 0x26, 0xdc, 0x8a, 0xdd, 0xb0, 0x81, 0x84, 0x0e, 0x53, 0xcf, 0x1b, 0xe0, 0xdd,
+	0x3e, 0x8a, 0xf0, 0x05, 0xe4, 0x98, 0x06, 0xe9, 0x6f, 0x64, 0xda, 0x82, 0x91, 0x6f, 0x2c, 0xda,
+	0x4b, 0xcf, 0xf0, 0x3e, 0x4a, 0x58, 0xfb, 0xe3, 0xd4, 0xd0, 0xfa, 0x94, 0xa1, 0xdd, 0x88, 0xc6,
+	0x61, 0xe6, 0xe7, 0xf9, 0xc6, 0xfa, 0x2f, 0x79, 0x50, 0x3c, 0x82, 0x11, 0x24, 0x4c, 0x35, 0xc1,
+	0x5b, 0x02, 0x87, 0x0e, 0x41, 0x84, 0x3a, 0xde, 0x19, 0x8c, 0xa0, 0xc7, 0x51, 0x24, 0x17, 0xb4,
+	0x60, 0x2f, 0x13, 0x38, 0x3c, 0x40, 0x84, 0x76, 0x9f, 0x08, 0xd5, 0x00, 0x8b, 0x7c, 0xe8, 0x30,
+	0xec, 0x3b, 0x03, 0x4c, 0x30, 0x17, 0xb3, 0x2d, 0xd8, 0x80, 0x0f, 0x7b, 0xd8, 0xff, 0x26, 0x45,
+	0xd4, 0xcf, 0xc0, 0xfb, 0x42, 0x71, 0x89, 0x1c, 0x8f, 0x32, 0xee, 0x84, 0x28, 0x72, 0xdc, 0x84,
+	0xa3, 0x6c, 0xc3, 0x96, 0x53, 0xe9, 0x25, 0xea, 0x52, 0xc6, 0x8f, 0x50, 0xd4, 0x49, 0x38, 0x52,
+	0xbf, 0x05, 0x1f, 0xa4, 0x05, 0x2f, 0x50, 0x84, 0x4f, 0x13, 0x99, 0x84, 0xfa, 0xad, 0xed, 0xed,
+	0xe6, 0x97, 0x72, 0xe9, 0x3a, 0xda, 0x78, 0xa4, 0x57, 0x7a, 0xd8, 0x3f, 0x16, 0x8a, 0x34, 0xf5,
+	0xeb, 0xaf, 0x04, 0x6f, 0x57, 0xd8, 0x0c, 0x2a, 0xb3, 0xd4, 0xef, 0xc0, 0xea, 0xcb, 0x82, 0x0c,
+	0x79, 0x61, 0x6b, 0xfb, 0x8b, 0xf3, 0xa6, 0xf6, 0x8e, 0x28, 0x59, 0x1d, 0x8f, 0xf4, 0x95, 0x99,
+	0x92, 0xbd, 0x89, 0xc2, 0x5e, 0x61, 0x73, 0x71, 0xb5, 0x0d, 0x4a, 0x3e, 0x64, 0xa2, 0x1e, 0xd3,
+	0x8a, 0x62, 0x2f, 0xd7, 0xe6, 0xee, 0xe5, 0x2e, 0x64, 0x69, 0x26, 0xb3, 0x5f, 0xfb, 0xd9, 0xa9,
+	0xbd, 0xfe, 0x78, 0xad, 0x2b, 0x2f, 0xf7, 0x65, 0x28, 0x9f, 0x0e, 0xf9, 0x2b, 0x3a, 0xdd, 0xdf,
+	0xc7, 0x35, 0xe5, 0x6e, 0x5c, 0x53, 0xfe, 0x1e, 0xd7, 0x94, 0x9f, 0xee, 0x6b, 0xb9, 0xbb, 0xfb,
+	0x5a, 0xee, 0xaf, 0xfb, 0x5a, 0xee, 0xe4, 0x13, 0x1f, 0xf3, 0xb3, 0xd8, 0x35, 0x3d, 0x4a, 0xb2,
+	0x27, 0xcb, 0xfa, 0x7f, 0x15, 0x9e, 0x84, 0x88, 0xb9, 0x45, 0xf1, 0x2e, 0x7c, 0xfe, 0xdf, 0x00,
+	0x9b, 0x9d, 0x4e, 0xed, 0x30, 0x05, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -337,6 +351,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if !this.GasCosts.Equal(that1.GasCosts) {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -501,6 +518,18 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GasCosts != nil {
+		{
+			size, err := m.GasCosts.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -626,6 +655,10 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
+	if m.GasCosts != nil {
+		l = m.GasCosts.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
 	return n
 }
 
@@ -1179,6 +1212,42 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCosts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasCosts == nil {
+				m.GasCosts = &GasCosts{}
+			}
+			if err := m.GasCosts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
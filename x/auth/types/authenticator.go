@@ -0,0 +1,34 @@
+package types
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// AuthenticationRequest holds the data an AuthenticateFn validates a signature
+// of a tx with.
+type AuthenticationRequest struct {
+	// Account is the signer account, whose authenticator is called.
+	Account sdk.AccountI
+	// Tx is the tx being authenticated.
+	Tx sdk.Tx
+	// Signature is the signature of the account, with the pubkey provided in
+	// the tx signer infos, which isn't necessarily the pubkey of the account.
+	Signature signing.SignatureV2
+	// VerifySignature verifies the signature data against the given pubkey, over
+	// the sign bytes of the tx for the account.
+	VerifySignature func(pubKey cryptotypes.PubKey) error
+}
+
+// AuthenticateFn validates a signature of a tx for an account which designated
+// the module providing it as its authenticator, instead of the signature
+// verification with the account pubkey. It returns an error when the signature
+// is invalid, and consumes the gas of its verification from the Context.
+//
+// It enables e.g. session keys, or social recovery without multisig accounts,
+// by accepting the signatures of pubkeys other than the account one.
+type AuthenticateFn func(ctx sdk.Context, req AuthenticationRequest) error
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (AuthenticateFn) IsOnePerModuleType() {}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// NewGasCosts creates a new GasCosts object from a store gas config.
+func NewGasCosts(config storetypes.GasConfig) GasCosts {
+	return GasCosts{
+		HasCost:          config.HasCost,
+		DeleteCost:       config.DeleteCost,
+		ReadCostFlat:     config.ReadCostFlat,
+		ReadCostPerByte:  config.ReadCostPerByte,
+		WriteCostFlat:    config.WriteCostFlat,
+		WriteCostPerByte: config.WriteCostPerByte,
+		IterNextCostFlat: config.IterNextCostFlat,
+	}
+}
+
+// DefaultGasCosts returns the default gas costs, matching the gas config the
+// KV stores are metered with when no gas costs are set on chain.
+func DefaultGasCosts() GasCosts {
+	return NewGasCosts(storetypes.KVGasConfig())
+}
+
+// GasConfig returns the store gas config defined by the gas costs.
+func (gc GasCosts) GasConfig() storetypes.GasConfig {
+	return storetypes.GasConfig{
+		HasCost:          gc.HasCost,
+		DeleteCost:       gc.DeleteCost,
+		ReadCostFlat:     gc.ReadCostFlat,
+		ReadCostPerByte:  gc.ReadCostPerByte,
+		WriteCostFlat:    gc.WriteCostFlat,
+		WriteCostPerByte: gc.WriteCostPerByte,
+		IterNextCostFlat: gc.IterNextCostFlat,
+	}
+}
+
+// Validate checks that the gas costs are valid. Flat costs must be positive
+// so that no store access is free, per byte costs may be zero.
+func (gc GasCosts) Validate() error {
+	for _, c := range []struct {
+		name string
+		cost uint64
+	}{
+		{"has cost", gc.HasCost},
+		{"delete cost", gc.DeleteCost},
+		{"read cost flat", gc.ReadCostFlat},
+		{"write cost flat", gc.WriteCostFlat},
+		{"iter next cost flat", gc.IterNextCostFlat},
+	} {
+		if c.cost == 0 {
+			return fmt.Errorf("invalid %s: must be positive", c.name)
+		}
+	}
+
+	return nil
+}
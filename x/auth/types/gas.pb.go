@@ -0,0 +1,565 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/auth/v1beta1/gas.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GasCosts defines the gas charged for accessing the KV stores of the modules
+// during tx execution. They are part of the auth module parameters.
+//
+// Since: cosmos-sdk 0.50
+type GasCosts struct {
+	HasCost          uint64 `protobuf:"varint,1,opt,name=has_cost,json=hasCost,proto3" json:"has_cost,omitempty"`
+	DeleteCost       uint64 `protobuf:"varint,2,opt,name=delete_cost,json=deleteCost,proto3" json:"delete_cost,omitempty"`
+	ReadCostFlat     uint64 `protobuf:"varint,3,opt,name=read_cost_flat,json=readCostFlat,proto3" json:"read_cost_flat,omitempty"`
+	ReadCostPerByte  uint64 `protobuf:"varint,4,opt,name=read_cost_per_byte,json=readCostPerByte,proto3" json:"read_cost_per_byte,omitempty"`
+	WriteCostFlat    uint64 `protobuf:"varint,5,opt,name=write_cost_flat,json=writeCostFlat,proto3" json:"write_cost_flat,omitempty"`
+	WriteCostPerByte uint64 `protobuf:"varint,6,opt,name=write_cost_per_byte,json=writeCostPerByte,proto3" json:"write_cost_per_byte,omitempty"`
+	IterNextCostFlat uint64 `protobuf:"varint,7,opt,name=iter_next_cost_flat,json=iterNextCostFlat,proto3" json:"iter_next_cost_flat,omitempty"`
+}
+
+func (m *GasCosts) Reset()         { *m = GasCosts{} }
+func (m *GasCosts) String() string { return proto.CompactTextString(m) }
+func (*GasCosts) ProtoMessage()    {}
+func (*GasCosts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8abf3790598e498c, []int{0}
+}
+func (m *GasCosts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasCosts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasCosts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasCosts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasCosts.Merge(m, src)
+}
+func (m *GasCosts) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasCosts) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasCosts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasCosts proto.InternalMessageInfo
+
+func (m *GasCosts) GetHasCost() uint64 {
+	if m != nil {
+		return m.HasCost
+	}
+	return 0
+}
+
+func (m *GasCosts) GetDeleteCost() uint64 {
+	if m != nil {
+		return m.DeleteCost
+	}
+	return 0
+}
+
+func (m *GasCosts) GetReadCostFlat() uint64 {
+	if m != nil {
+		return m.ReadCostFlat
+	}
+	return 0
+}
+
+func (m *GasCosts) GetReadCostPerByte() uint64 {
+	if m != nil {
+		return m.ReadCostPerByte
+	}
+	return 0
+}
+
+func (m *GasCosts) GetWriteCostFlat() uint64 {
+	if m != nil {
+		return m.WriteCostFlat
+	}
+	return 0
+}
+
+func (m *GasCosts) GetWriteCostPerByte() uint64 {
+	if m != nil {
+		return m.WriteCostPerByte
+	}
+	return 0
+}
+
+func (m *GasCosts) GetIterNextCostFlat() uint64 {
+	if m != nil {
+		return m.IterNextCostFlat
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GasCosts)(nil), "cosmos.auth.v1beta1.GasCosts")
+}
+
+func init() { proto.RegisterFile("cosmos/auth/v1beta1/gas.proto", fileDescriptor_8abf3790598e498c) }
+
+var fileDescriptor_8abf3790598e498c = []byte{
+	// 306 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0x3f, 0x4f, 0xc2, 0x40,
+	0x18, 0x87, 0x39, 0x44, 0x20, 0xe7, 0x1f, 0xcc, 0xe1, 0x80, 0x26, 0x1e, 0xc6, 0x18, 0xa3, 0x31,
+	0xf4, 0x42, 0xdc, 0x1c, 0x21, 0xd1, 0xcd, 0x18, 0x47, 0x97, 0xe6, 0x0a, 0xaf, 0x2d, 0x11, 0x3c,
+	0x72, 0xf7, 0xa2, 0xf4, 0x5b, 0xf8, 0x11, 0x5c, 0xfc, 0x2e, 0x8e, 0x8c, 0x8e, 0xa6, 0x5d, 0xfc,
+	0x18, 0xa6, 0x77, 0xb4, 0x76, 0x6a, 0xf3, 0x7b, 0x9e, 0x3c, 0xc3, 0xbd, 0xf4, 0x68, 0xa4, 0xcc,
+	0x4c, 0x19, 0x21, 0x17, 0x18, 0x89, 0xd7, 0x7e, 0x00, 0x28, 0xfb, 0x22, 0x94, 0xc6, 0x9b, 0x6b,
+	0x85, 0x8a, 0xb5, 0x1d, 0xf6, 0x32, 0xec, 0xad, 0xf1, 0xe1, 0x7e, 0xa8, 0x42, 0x65, 0xb9, 0xc8,
+	0xfe, 0x9c, 0x7a, 0xf2, 0x59, 0xa5, 0xcd, 0x5b, 0x69, 0x86, 0xca, 0xa0, 0x61, 0x07, 0xb4, 0x19,
+	0x49, 0xe3, 0x8f, 0x94, 0xc1, 0x0e, 0x39, 0x26, 0xe7, 0xb5, 0x87, 0x46, 0xe4, 0x18, 0xeb, 0xd2,
+	0xad, 0x31, 0x4c, 0x01, 0xc1, 0xd1, 0xaa, 0xa5, 0xd4, 0x4d, 0x56, 0x38, 0xa5, 0xbb, 0x1a, 0xe4,
+	0xd8, 0x62, 0xff, 0x69, 0x2a, 0xb1, 0xb3, 0x61, 0x9d, 0xed, 0x6c, 0xcd, 0x8c, 0x9b, 0xa9, 0x44,
+	0x76, 0x49, 0xd9, 0xbf, 0x35, 0x07, 0xed, 0x07, 0x31, 0x42, 0xa7, 0x66, 0xcd, 0x56, 0x6e, 0xde,
+	0x83, 0x1e, 0xc4, 0x08, 0xec, 0x8c, 0xb6, 0xde, 0xf4, 0x04, 0xa1, 0xd4, 0xdc, 0xb4, 0xe6, 0x8e,
+	0x9d, 0x8b, 0x68, 0x8f, 0xb6, 0x4b, 0x5e, 0x51, 0xad, 0x5b, 0x77, 0xaf, 0x70, 0xf3, 0x6c, 0x8f,
+	0xb6, 0x27, 0x08, 0xda, 0x7f, 0x81, 0x25, 0x96, 0xd2, 0x0d, 0xa7, 0x67, 0xe8, 0x0e, 0x96, 0x98,
+	0xd7, 0xaf, 0x6b, 0xbf, 0x1f, 0x5d, 0x32, 0x18, 0x7e, 0x25, 0x9c, 0xac, 0x12, 0x4e, 0x7e, 0x12,
+	0x4e, 0xde, 0x53, 0x5e, 0x59, 0xa5, 0xbc, 0xf2, 0x9d, 0xf2, 0xca, 0xe3, 0x45, 0x38, 0xc1, 0x68,
+	0x11, 0x78, 0x23, 0x35, 0x13, 0xeb, 0xb3, 0xb8, 0x4f, 0xcf, 0x8c, 0x9f, 0xc5, 0xd2, 0xdd, 0x08,
+	0xe3, 0x39, 0x98, 0xa0, 0x6e, 0xdf, 0xfc, 0xea, 0x6f, 0x00, 0xb5, 0xef, 0x68, 0xfc, 0xbf, 0x01,
+	0x00, 0x00,
+}
+
+func (this *GasCosts) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GasCosts)
+	if !ok {
+		that2, ok := that.(GasCosts)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.HasCost != that1.HasCost {
+		return false
+	}
+	if this.DeleteCost != that1.DeleteCost {
+		return false
+	}
+	if this.ReadCostFlat != that1.ReadCostFlat {
+		return false
+	}
+	if this.ReadCostPerByte != that1.ReadCostPerByte {
+		return false
+	}
+	if this.WriteCostFlat != that1.WriteCostFlat {
+		return false
+	}
+	if this.WriteCostPerByte != that1.WriteCostPerByte {
+		return false
+	}
+	if this.IterNextCostFlat != that1.IterNextCostFlat {
+		return false
+	}
+	return true
+}
+func (m *GasCosts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasCosts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasCosts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IterNextCostFlat != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.IterNextCostFlat))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.WriteCostPerByte != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.WriteCostPerByte))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.WriteCostFlat != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.WriteCostFlat))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ReadCostPerByte != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.ReadCostPerByte))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReadCostFlat != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.ReadCostFlat))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DeleteCost != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.DeleteCost))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HasCost != 0 {
+		i = encodeVarintGas(dAtA, i, uint64(m.HasCost))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGas(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGas(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GasCosts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasCost != 0 {
+		n += 1 + sovGas(uint64(m.HasCost))
+	}
+	if m.DeleteCost != 0 {
+		n += 1 + sovGas(uint64(m.DeleteCost))
+	}
+	if m.ReadCostFlat != 0 {
+		n += 1 + sovGas(uint64(m.ReadCostFlat))
+	}
+	if m.ReadCostPerByte != 0 {
+		n += 1 + sovGas(uint64(m.ReadCostPerByte))
+	}
+	if m.WriteCostFlat != 0 {
+		n += 1 + sovGas(uint64(m.WriteCostFlat))
+	}
+	if m.WriteCostPerByte != 0 {
+		n += 1 + sovGas(uint64(m.WriteCostPerByte))
+	}
+	if m.IterNextCostFlat != 0 {
+		n += 1 + sovGas(uint64(m.IterNextCostFlat))
+	}
+	return n
+}
+
+func sovGas(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGas(x uint64) (n int) {
+	return sovGas(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GasCosts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGas
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasCosts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasCosts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasCost", wireType)
+			}
+			m.HasCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HasCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteCost", wireType)
+			}
+			m.DeleteCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeleteCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadCostFlat", wireType)
+			}
+			m.ReadCostFlat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadCostFlat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadCostPerByte", wireType)
+			}
+			m.ReadCostPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadCostPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteCostFlat", wireType)
+			}
+			m.WriteCostFlat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteCostFlat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteCostPerByte", wireType)
+			}
+			m.WriteCostPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteCostPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IterNextCostFlat", wireType)
+			}
+			m.IterNextCostFlat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IterNextCostFlat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGas(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGas
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGas(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGas
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGas
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGas
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGas
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGas
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGas        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGas          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGas = fmt.Errorf("proto: unexpected end of group")
+)
@@ -71,7 +71,33 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
-	return ValidateGenAccounts(genAccs)
+	if err := ValidateGenAccounts(genAccs); err != nil {
+		return err
+	}
+
+	return validateAccountAuthenticators(data.AccountAuthenticators)
+}
+
+// validateAccountAuthenticators checks that the account authenticators have
+// valid addresses and names, and that each account has at most one.
+func validateAccountAuthenticators(accountAuthenticators []AccountAuthenticator) error {
+	seen := make(map[string]struct{}, len(accountAuthenticators))
+	for _, aa := range accountAuthenticators {
+		if _, err := sdk.AccAddressFromBech32(aa.Address); err != nil {
+			return fmt.Errorf("invalid account authenticator address %s: %w", aa.Address, err)
+		}
+
+		if aa.Authenticator == "" {
+			return fmt.Errorf("empty authenticator for account %s", aa.Address)
+		}
+
+		if _, ok := seen[aa.Address]; ok {
+			return fmt.Errorf("duplicate authenticator for account %s", aa.Address)
+		}
+		seen[aa.Address] = struct{}{}
+	}
+
+	return nil
 }
 
 // SanitizeGenesisAccounts sorts accounts and coin sets.
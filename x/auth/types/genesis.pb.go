@@ -31,6 +31,10 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// accounts are the accounts present at genesis.
 	Accounts []*types.Any `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// account_authenticators are the authenticators designated by the accounts.
+	//
+	// Since: cosmos-sdk 0.50
+	AccountAuthenticators []AccountAuthenticator `protobuf:"bytes,3,rep,name=account_authenticators,json=accountAuthenticators,proto3" json:"account_authenticators"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,6 +84,13 @@ func (m *GenesisState) GetAccounts() []*types.Any {
 	return nil
 }
 
+func (m *GenesisState) GetAccountAuthenticators() []AccountAuthenticator {
+	if m != nil {
+		return m.AccountAuthenticators
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.auth.v1beta1.GenesisState")
 }
@@ -87,24 +98,27 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/genesis.proto", fileDescriptor_d897ccbce9822332) }
 
 var fileDescriptor_d897ccbce9822332 = []byte{
-	// 269 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0x91, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5,
-	0x07, 0x2b, 0x49, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xa8, 0x97, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0xa8, 0xa8, 0x1c, 0x36, 0x8b, 0xc0, 0x46, 0x42, 0xe4, 0x05,
-	0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x48, 0xa9, 0x81, 0x91, 0x8b, 0xc7, 0x1d,
-	0xe2, 0x94, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x3b, 0x2e, 0xb6, 0x82, 0xc4, 0xa2, 0xc4, 0xdc,
-	0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x69, 0x3d, 0x2c, 0x4e, 0xd3, 0x0b, 0x00, 0x2b,
-	0x71, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x5d, 0x42,
-	0x06, 0x5c, 0x1c, 0x89, 0xc9, 0xc9, 0xf9, 0xa5, 0x79, 0x25, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a,
-	0xdc, 0x46, 0x22, 0x7a, 0x10, 0x7f, 0xe8, 0xc1, 0xfc, 0xa1, 0xe7, 0x98, 0x57, 0x19, 0x04, 0x57,
-	0xe5, 0xe4, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e,
-	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x9a, 0xe9, 0x99,
-	0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0xaf, 0x41, 0x28, 0xdd, 0xe2, 0x94,
-	0x6c, 0xfd, 0x0a, 0x88, 0x3f, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x86, 0x1b, 0x03,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x70, 0x8d, 0xea, 0x6c, 0x01, 0x00, 0x00,
+	// 315 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xb1, 0x4e, 0xf3, 0x30,
+	0x14, 0x85, 0xe3, 0xbf, 0xbf, 0x2a, 0x48, 0x59, 0x08, 0x05, 0x95, 0x22, 0x99, 0xc2, 0x42, 0x8b,
+	0x84, 0x4d, 0xcb, 0x8e, 0xd4, 0x32, 0xb0, 0xa2, 0xb2, 0xb1, 0x20, 0x27, 0xb8, 0x6e, 0x04, 0xf1,
+	0xad, 0x6a, 0x07, 0x91, 0xb7, 0xe0, 0x31, 0x18, 0x79, 0x8c, 0x8e, 0x1d, 0x99, 0x10, 0x4a, 0x06,
+	0x78, 0x0c, 0x14, 0xdb, 0x20, 0x90, 0xc2, 0x62, 0x5f, 0xdd, 0xfb, 0x1d, 0xfb, 0x9c, 0xeb, 0xef,
+	0x45, 0xa0, 0x12, 0x50, 0x94, 0xa5, 0x7a, 0x4a, 0xef, 0xfb, 0x21, 0xd7, 0xac, 0x4f, 0x05, 0x97,
+	0x5c, 0xc5, 0x8a, 0xcc, 0xe6, 0xa0, 0x21, 0xd8, 0xb0, 0x08, 0x29, 0x11, 0xe2, 0x90, 0xf6, 0xb6,
+	0x00, 0x10, 0x77, 0x9c, 0x1a, 0x24, 0x4c, 0x27, 0x94, 0xc9, 0xcc, 0xf2, 0xed, 0xa6, 0x00, 0x01,
+	0xa6, 0xa4, 0x65, 0xe5, 0xba, 0xb8, 0xea, 0x23, 0xf3, 0xa4, 0x9d, 0x1f, 0xfc, 0x35, 0xe7, 0x52,
+	0xc7, 0x11, 0xd3, 0x30, 0x77, 0xe0, 0x3a, 0x4b, 0x62, 0x09, 0xd4, 0x9c, 0xb6, 0xb5, 0xff, 0x81,
+	0xfc, 0xb5, 0x73, 0xeb, 0xf9, 0x52, 0x33, 0xcd, 0x83, 0x53, 0xbf, 0x3e, 0x63, 0x73, 0x96, 0xa8,
+	0x16, 0xea, 0xa0, 0x6e, 0x63, 0xb0, 0x43, 0x2a, 0x32, 0x90, 0x0b, 0x83, 0x8c, 0x56, 0x17, 0xaf,
+	0xbb, 0xde, 0xd3, 0xfb, 0xf3, 0x21, 0x1a, 0x3b, 0x55, 0x70, 0xec, 0xaf, 0xb0, 0x28, 0x82, 0x54,
+	0x6a, 0xd5, 0xfa, 0xd7, 0xa9, 0x75, 0x1b, 0x83, 0x26, 0xb1, 0x81, 0xc9, 0x57, 0x60, 0x32, 0x94,
+	0xd9, 0xf8, 0x9b, 0x0a, 0x26, 0xfe, 0x96, 0xab, 0xaf, 0x7f, 0x99, 0x56, 0xad, 0x9a, 0xd1, 0xf7,
+	0x2a, 0x1d, 0x0c, 0xad, 0x64, 0xf8, 0x53, 0x31, 0xfa, 0x5f, 0xfa, 0x19, 0x6f, 0xb2, 0x8a, 0x99,
+	0x1a, 0x9d, 0x2d, 0x72, 0x8c, 0x96, 0x39, 0x46, 0x6f, 0x39, 0x46, 0x8f, 0x05, 0xf6, 0x96, 0x05,
+	0xf6, 0x5e, 0x0a, 0xec, 0x5d, 0xf5, 0x44, 0xac, 0xa7, 0x69, 0x48, 0x22, 0x48, 0xa8, 0xdb, 0xa5,
+	0xbd, 0x8e, 0xd4, 0xcd, 0x2d, 0x7d, 0xb0, 0x8b, 0xd5, 0xd9, 0x8c, 0xab, 0xb0, 0x6e, 0x42, 0x9c,
+	0x7c, 0x0e, 0x00, 0xbf, 0x2f, 0x3f, 0xa4, 0xfd, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +141,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AccountAuthenticators) > 0 {
+		for iNdEx := len(m.AccountAuthenticators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountAuthenticators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -179,6 +207,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AccountAuthenticators) > 0 {
+		for _, e := range m.AccountAuthenticators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -284,6 +318,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAuthenticators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAuthenticators = append(m.AccountAuthenticators, AccountAuthenticator{})
+			if err := m.AccountAuthenticators[len(m.AccountAuthenticators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
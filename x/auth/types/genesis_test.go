@@ -52,6 +52,53 @@ func TestValidateGenesisDuplicateAccounts(t *testing.T) {
 	require.Error(t, types.ValidateGenAccounts(genAccs))
 }
 
+func TestValidateGenesisAccountAuthenticators(t *testing.T) {
+	addr := sdk.AccAddress(addr1).String()
+
+	testCases := []struct {
+		name                  string
+		accountAuthenticators []types.AccountAuthenticator
+		expErr                string
+	}{
+		{
+			name:                  "valid",
+			accountAuthenticators: []types.AccountAuthenticator{{Address: addr, Authenticator: "session"}},
+		},
+		{
+			name:                  "invalid address",
+			accountAuthenticators: []types.AccountAuthenticator{{Address: "invalid", Authenticator: "session"}},
+			expErr:                "invalid account authenticator address",
+		},
+		{
+			name:                  "empty authenticator",
+			accountAuthenticators: []types.AccountAuthenticator{{Address: addr}},
+			expErr:                "empty authenticator",
+		},
+		{
+			name: "duplicate account",
+			accountAuthenticators: []types.AccountAuthenticator{
+				{Address: addr, Authenticator: "session"},
+				{Address: addr, Authenticator: "other"},
+			},
+			expErr: "duplicate authenticator",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			genState := types.DefaultGenesisState()
+			genState.AccountAuthenticators = tc.accountAuthenticators
+
+			err := types.ValidateGenesis(*genState)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
 func TestGenesisAccountIterator(t *testing.T) {
 	encodingConfig := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{})
 	cdc := encodingConfig.Codec
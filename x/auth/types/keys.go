@@ -29,6 +29,14 @@ var (
 
 	// AccountNumberStoreKeyPrefix prefix for account-by-id store
 	AccountNumberStoreKeyPrefix = []byte("accountNumber")
+
+	// UnorderedTxsKey is the prefix of the unordered txs executed until their
+	// timeout height, indexed by timeout height and tx hash.
+	UnorderedTxsKey = collections.NewPrefix(3)
+
+	// AccountAuthenticatorsKey is the prefix of the authenticators of the
+	// accounts, indexed by address.
+	AccountAuthenticatorsKey = collections.NewPrefix(5)
 )
 
 // AddressStoreKey turn an address to key used to get it from the account store
@@ -8,6 +8,8 @@ import (
 var (
 	_ sdk.Msg            = &MsgUpdateParams{}
 	_ legacytx.LegacyMsg = &MsgUpdateParams{}
+	_ sdk.Msg            = &MsgSetAuthenticator{}
+	_ legacytx.LegacyMsg = &MsgSetAuthenticator{}
 )
 
 // GetSignBytes implements the LegacyMsg interface.
@@ -20,3 +22,14 @@ func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
 	return []sdk.AccAddress{addr}
 }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgSetAuthenticator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgSetAuthenticator message.
+func (msg MsgSetAuthenticator) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Address)
+	return []sdk.AccAddress{addr}
+}
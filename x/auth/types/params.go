@@ -26,12 +26,14 @@ func NewParams(maxMemoCharacters, txSigLimit, txSizeCostPerByte, sigVerifyCostED
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
+	gasCosts := DefaultGasCosts()
 	return Params{
 		MaxMemoCharacters:      DefaultMaxMemoCharacters,
 		TxSigLimit:             DefaultTxSigLimit,
 		TxSizeCostPerByte:      DefaultTxSizeCostPerByte,
 		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
 		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
+		GasCosts:               &gasCosts,
 	}
 }
 
@@ -47,6 +49,15 @@ func (p Params) SigVerifyCostSecp256r1() uint64 {
 	return p.SigVerifyCostSecp256k1 / 2
 }
 
+// GetGasCostsOrDefault returns the gas costs charged for store accesses,
+// defaulting to DefaultGasCosts when they are unset.
+func (p Params) GetGasCostsOrDefault() GasCosts {
+	if p.GasCosts == nil {
+		return DefaultGasCosts()
+	}
+	return *p.GasCosts
+}
+
 func validateTxSigLimit(i interface{}) error {
 	v, ok := i.(uint64)
 	if !ok {
@@ -129,6 +140,11 @@ func (p Params) Validate() error {
 	if err := validateTxSizeCostPerByte(p.TxSizeCostPerByte); err != nil {
 		return err
 	}
+	if p.GasCosts != nil {
+		if err := p.GasCosts.Validate(); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -48,6 +48,28 @@ func (pa PermissionsForAddress) GetPermissions() []string {
 	return pa.permissions
 }
 
+// ModuleAccountReservation reserves the module account of the module providing
+// it at app wiring time, with the given permissions, in addition to the module
+// account permissions of the auth module config. The address of a module
+// account is derived from its name, and its account number is assigned at
+// genesis in the lexical order of the module names, so that both are stable.
+type ModuleAccountReservation struct {
+	Permissions []string
+}
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (ModuleAccountReservation) IsOnePerModuleType() {}
+
+// NewModuleAccountReservation creates a new ModuleAccountReservation object
+func NewModuleAccountReservation(permissions ...string) ModuleAccountReservation {
+	return ModuleAccountReservation{Permissions: permissions}
+}
+
+// Validate performs basic validation of the reserved permissions.
+func (r ModuleAccountReservation) Validate() error {
+	return validatePermissions(r.Permissions...)
+}
+
 // performs basic permission validation
 func validatePermissions(permissions ...string) error {
 	for _, perm := range permissions {
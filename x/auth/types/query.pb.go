@@ -962,6 +962,105 @@ func (m *QueryAccountInfoResponse) GetInfo() *BaseAccount {
 	return nil
 }
 
+// QueryAccountAuthenticatorRequest is the request type for the
+// Query/AccountAuthenticator RPC method.
+//
+// Since: cosmos-sdk 0.50
+type QueryAccountAuthenticatorRequest struct {
+	// address is the address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAccountAuthenticatorRequest) Reset()         { *m = QueryAccountAuthenticatorRequest{} }
+func (m *QueryAccountAuthenticatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountAuthenticatorRequest) ProtoMessage()    {}
+func (*QueryAccountAuthenticatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{20}
+}
+func (m *QueryAccountAuthenticatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountAuthenticatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountAuthenticatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountAuthenticatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountAuthenticatorRequest.Merge(m, src)
+}
+func (m *QueryAccountAuthenticatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountAuthenticatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountAuthenticatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountAuthenticatorRequest proto.InternalMessageInfo
+
+func (m *QueryAccountAuthenticatorRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryAccountAuthenticatorResponse is the response type for the
+// Query/AccountAuthenticator RPC method.
+//
+// Since: cosmos-sdk 0.50
+type QueryAccountAuthenticatorResponse struct {
+	// authenticator is the name of the authenticator of the account, empty if
+	// the txs of the account are validated with its pubkey.
+	Authenticator string `protobuf:"bytes,1,opt,name=authenticator,proto3" json:"authenticator,omitempty"`
+}
+
+func (m *QueryAccountAuthenticatorResponse) Reset()         { *m = QueryAccountAuthenticatorResponse{} }
+func (m *QueryAccountAuthenticatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountAuthenticatorResponse) ProtoMessage()    {}
+func (*QueryAccountAuthenticatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{21}
+}
+func (m *QueryAccountAuthenticatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountAuthenticatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountAuthenticatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountAuthenticatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountAuthenticatorResponse.Merge(m, src)
+}
+func (m *QueryAccountAuthenticatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountAuthenticatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountAuthenticatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountAuthenticatorResponse proto.InternalMessageInfo
+
+func (m *QueryAccountAuthenticatorResponse) GetAuthenticator() string {
+	if m != nil {
+		return m.Authenticator
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountsRequest)(nil), "cosmos.auth.v1beta1.QueryAccountsRequest")
 	proto.RegisterType((*QueryAccountsResponse)(nil), "cosmos.auth.v1beta1.QueryAccountsResponse")
@@ -983,79 +1082,85 @@ func init() {
 	proto.RegisterType((*QueryAccountAddressByIDResponse)(nil), "cosmos.auth.v1beta1.QueryAccountAddressByIDResponse")
 	proto.RegisterType((*QueryAccountInfoRequest)(nil), "cosmos.auth.v1beta1.QueryAccountInfoRequest")
 	proto.RegisterType((*QueryAccountInfoResponse)(nil), "cosmos.auth.v1beta1.QueryAccountInfoResponse")
+	proto.RegisterType((*QueryAccountAuthenticatorRequest)(nil), "cosmos.auth.v1beta1.QueryAccountAuthenticatorRequest")
+	proto.RegisterType((*QueryAccountAuthenticatorResponse)(nil), "cosmos.auth.v1beta1.QueryAccountAuthenticatorResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/query.proto", fileDescriptor_c451370b3929a27c) }
 
 var fileDescriptor_c451370b3929a27c = []byte{
-	// 1065 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xc7, 0xe3, 0x6e, 0x69, 0xbb, 0xaf, 0xd9, 0x22, 0x4d, 0xb3, 0x22, 0x38, 0x6d, 0x12, 0xb9,
-	0xd0, 0xa6, 0x65, 0x63, 0xd3, 0x34, 0x2b, 0xf1, 0xe3, 0x54, 0xef, 0x02, 0xea, 0x61, 0x51, 0x70,
-	0x57, 0x08, 0x71, 0x20, 0x72, 0x62, 0x27, 0xb5, 0xd8, 0x78, 0xb2, 0xb1, 0x03, 0x1b, 0xaa, 0x5c,
-	0x90, 0x90, 0x7a, 0x41, 0x42, 0x82, 0x3f, 0x60, 0x0f, 0x88, 0xf3, 0x22, 0x95, 0x1b, 0x7f, 0xc0,
-	0x6a, 0x4f, 0x2b, 0xb8, 0x70, 0x42, 0xa8, 0x45, 0x82, 0x1b, 0xff, 0x02, 0xca, 0xcc, 0xb3, 0x63,
-	0xb7, 0x93, 0xc4, 0x85, 0x53, 0x9d, 0x99, 0xf7, 0xbe, 0xef, 0x33, 0x6f, 0x9e, 0xfd, 0x2d, 0x14,
-	0x9a, 0xd4, 0xeb, 0x50, 0x4f, 0x33, 0xfb, 0xfe, 0x91, 0xf6, 0xd9, 0x6e, 0xc3, 0xf6, 0xcd, 0x5d,
-	0xed, 0x61, 0xdf, 0xee, 0x0d, 0xd4, 0x6e, 0x8f, 0xfa, 0x94, 0xac, 0xf2, 0x00, 0x75, 0x14, 0xa0,
-	0x62, 0x80, 0xbc, 0x83, 0x59, 0x0d, 0xd3, 0xb3, 0x79, 0x74, 0x98, 0xdb, 0x35, 0xdb, 0x8e, 0x6b,
-	0xfa, 0x0e, 0x75, 0xb9, 0x80, 0x9c, 0x69, 0xd3, 0x36, 0x65, 0x8f, 0xda, 0xe8, 0x09, 0x57, 0x5f,
-	0x6e, 0x53, 0xda, 0x7e, 0x60, 0x6b, 0xec, 0x57, 0xa3, 0xdf, 0xd2, 0x4c, 0x17, 0x2b, 0xca, 0x6b,
-	0xb8, 0x65, 0x76, 0x1d, 0xcd, 0x74, 0x5d, 0xea, 0x33, 0x35, 0x0f, 0x77, 0xf3, 0x22, 0x60, 0x06,
-	0x87, 0xc2, 0x7c, 0xbf, 0xce, 0x2b, 0x22, 0x3c, 0xdf, 0xca, 0x61, 0x6a, 0x00, 0x1c, 0x3d, 0xa7,
-	0xf2, 0x09, 0x64, 0x3e, 0x18, 0xfd, 0xdc, 0x6f, 0x36, 0x69, 0xdf, 0xf5, 0x3d, 0xc3, 0x7e, 0xd8,
-	0xb7, 0x3d, 0x9f, 0xbc, 0x0b, 0x30, 0x3e, 0x52, 0x56, 0x2a, 0x4a, 0xa5, 0xe5, 0xca, 0xa6, 0x8a,
-	0xba, 0xa3, 0xf3, 0xab, 0x5c, 0x05, 0x51, 0xd4, 0x9a, 0xd9, 0xb6, 0x31, 0xd7, 0x88, 0x64, 0x2a,
-	0xa7, 0x12, 0xdc, 0xbc, 0x50, 0xc0, 0xeb, 0x52, 0xd7, 0xb3, 0x89, 0x01, 0x4b, 0x26, 0xae, 0x65,
-	0xa5, 0xe2, 0xb5, 0xd2, 0x72, 0x25, 0xa3, 0xf2, 0x16, 0xa8, 0x41, 0x77, 0xd4, 0x7d, 0x77, 0xa0,
-	0x17, 0x9f, 0x9d, 0x96, 0xd7, 0x04, 0xb7, 0xa1, 0xa2, 0xe2, 0x81, 0x11, 0xea, 0x90, 0xf7, 0x62,
-	0xd4, 0x73, 0x8c, 0x7a, 0x6b, 0x26, 0x35, 0x07, 0x8a, 0x61, 0x1f, 0xc2, 0x6a, 0x94, 0x3a, 0xe8,
-	0x4a, 0x05, 0x16, 0x4d, 0xcb, 0xea, 0xd9, 0x9e, 0xc7, 0x5a, 0x72, 0x5d, 0xcf, 0xfe, 0x72, 0x5a,
-	0xce, 0xa0, 0xfe, 0x3e, 0xdf, 0x39, 0xf4, 0x7b, 0x8e, 0xdb, 0x36, 0x82, 0xc0, 0xb7, 0x96, 0x4e,
-	0x1e, 0x17, 0x52, 0x7f, 0x3f, 0x2e, 0xa4, 0x94, 0xa3, 0x78, 0xaf, 0xc3, 0x4e, 0xd4, 0x60, 0x11,
-	0x4f, 0x80, 0x8d, 0xfe, 0xaf, 0x8d, 0x08, 0x64, 0x94, 0x0c, 0x10, 0x56, 0xa9, 0x66, 0xf6, 0xcc,
-	0x4e, 0x70, 0xa7, 0x4a, 0x0d, 0x0f, 0x15, 0xac, 0x62, 0xf9, 0x37, 0x61, 0xa1, 0xcb, 0x56, 0xb0,
-	0x7a, 0x4e, 0x15, 0x15, 0xe1, 0x49, 0xfa, 0xfc, 0xd3, 0xdf, 0x0b, 0x29, 0x03, 0x13, 0x94, 0x35,
-	0x90, 0x99, 0xe2, 0x3d, 0x6a, 0xf5, 0x1f, 0xd8, 0x17, 0x66, 0x48, 0xf9, 0x1c, 0x72, 0xc2, 0x5d,
-	0xac, 0xfb, 0x51, 0xc2, 0x01, 0xd8, 0x7c, 0x76, 0x5a, 0x56, 0x44, 0x48, 0x31, 0xdd, 0xc8, 0x18,
-	0x28, 0xb7, 0xa1, 0x70, 0xb9, 0xb0, 0x3e, 0x78, 0xdf, 0xec, 0x04, 0x33, 0x4a, 0x08, 0xcc, 0xbb,
-	0x66, 0xc7, 0xe6, 0xd7, 0x68, 0xb0, 0x67, 0xe5, 0x0b, 0x28, 0x4e, 0x4e, 0x43, 0xe8, 0x0f, 0x93,
-	0xdd, 0x55, 0x52, 0xe6, 0xf0, 0xc6, 0x6e, 0xc2, 0xaa, 0x6e, 0x37, 0x8f, 0xf6, 0x2a, 0xb5, 0x9e,
-	0xdd, 0x72, 0x1e, 0x05, 0x2d, 0x7c, 0x1b, 0x32, 0xf1, 0x65, 0xc4, 0xd8, 0x80, 0x1b, 0x0d, 0xb6,
-	0x5e, 0xef, 0xb2, 0x0d, 0x3c, 0x47, 0xba, 0x11, 0x09, 0x56, 0x74, 0xc8, 0xe1, 0x4c, 0xea, 0x03,
-	0xdf, 0xf6, 0xee, 0x53, 0x1c, 0x4d, 0x6c, 0xc1, 0x06, 0xdc, 0xc0, 0x19, 0xad, 0x37, 0x46, 0xfb,
-	0x4c, 0x23, 0x6d, 0xa4, 0xcd, 0x48, 0x8e, 0xf2, 0x0e, 0xac, 0x89, 0x35, 0x10, 0xe4, 0x55, 0x58,
-	0x09, 0x44, 0x3c, 0xb6, 0x83, 0x24, 0x81, 0x34, 0x0f, 0x57, 0xee, 0x86, 0x28, 0x7c, 0xe1, 0x3e,
-	0x65, 0x72, 0x01, 0x4a, 0x42, 0x95, 0x3b, 0x21, 0xcc, 0x05, 0x95, 0x71, 0x57, 0x66, 0x9f, 0xe8,
-	0x10, 0xf2, 0xd1, 0xb7, 0x30, 0x3c, 0xdd, 0xc1, 0xdd, 0xf1, 0x6c, 0xcc, 0x39, 0x16, 0xcb, 0xbd,
-	0xa6, 0xcf, 0x65, 0x25, 0x63, 0xce, 0xb1, 0xc8, 0x3a, 0x00, 0x5e, 0x55, 0xdd, 0xb1, 0xd8, 0x97,
-	0x65, 0xde, 0xb8, 0x8e, 0x2b, 0x07, 0x96, 0x62, 0xe1, 0xc4, 0x89, 0x44, 0x11, 0x6e, 0x1f, 0x5e,
-	0x0c, 0x14, 0x92, 0x7e, 0x43, 0x56, 0xcc, 0x98, 0x9c, 0x72, 0x0f, 0x5e, 0x8a, 0x56, 0x39, 0x70,
-	0x5b, 0xf4, 0x7f, 0x7c, 0x99, 0x94, 0x1a, 0x64, 0x2f, 0xcb, 0x21, 0x6d, 0x15, 0xe6, 0x1d, 0xb7,
-	0x45, 0x71, 0xc8, 0x8b, 0xc2, 0x4f, 0x82, 0x6e, 0x7a, 0xc1, 0x24, 0x1b, 0x2c, 0xba, 0xf2, 0x4f,
-	0x1a, 0x5e, 0x60, 0x92, 0xe4, 0x6b, 0x09, 0x96, 0x82, 0x37, 0x9e, 0x6c, 0x0b, 0xd3, 0x45, 0xbe,
-	0x23, 0xef, 0x24, 0x09, 0xe5, 0x8c, 0xca, 0xce, 0xc9, 0x5f, 0x4f, 0x76, 0xa4, 0x2f, 0x7f, 0xfd,
-	0xf3, 0xdb, 0xb9, 0x02, 0x59, 0xd7, 0x84, 0x0e, 0x19, 0x20, 0x7c, 0x27, 0xc1, 0x22, 0x0a, 0x90,
-	0xd2, 0xcc, 0x1a, 0x01, 0xcd, 0x76, 0x82, 0x48, 0x84, 0xa9, 0x8e, 0x61, 0xb6, 0xc9, 0xd6, 0x54,
-	0x18, 0xed, 0x18, 0x6f, 0x60, 0x48, 0x7e, 0x92, 0x80, 0x5c, 0x9e, 0x19, 0xb2, 0x37, 0xb3, 0xee,
-	0xe5, 0xb1, 0x95, 0xab, 0x57, 0x4b, 0xba, 0x02, 0x77, 0xf8, 0x4e, 0xd5, 0x1d, 0x4b, 0x3b, 0x76,
-	0xac, 0x21, 0xf9, 0x4a, 0x82, 0x05, 0xee, 0x08, 0x64, 0x6b, 0x72, 0xd9, 0x98, 0xfd, 0xc8, 0xa5,
-	0xd9, 0x81, 0xc8, 0x54, 0x1a, 0x33, 0xad, 0x93, 0x9c, 0x90, 0x89, 0x1b, 0x10, 0xf9, 0x41, 0x82,
-	0x95, 0xb8, 0xbd, 0x10, 0x6d, 0x72, 0x19, 0xa1, 0x4d, 0xc9, 0xaf, 0x27, 0x4f, 0x40, 0xbe, 0xdd,
-	0x31, 0xdf, 0x26, 0x79, 0x45, 0xc8, 0xd7, 0x61, 0x99, 0xf5, 0x70, 0xfe, 0x7e, 0x96, 0x60, 0x55,
-	0xe0, 0x2b, 0xa4, 0x9a, 0xb0, 0x78, 0xcc, 0xbd, 0xe4, 0xdb, 0x57, 0xcc, 0x42, 0xee, 0x37, 0xc6,
-	0xdc, 0x65, 0xf2, 0x5a, 0x12, 0x6e, 0xed, 0x78, 0xe4, 0x8c, 0x43, 0x72, 0x22, 0x41, 0x3a, 0x6a,
-	0x44, 0x13, 0xde, 0x21, 0x81, 0x85, 0x4d, 0x78, 0x87, 0x44, 0xae, 0xa6, 0x6c, 0x4c, 0xbd, 0x72,
-	0xee, 0x6d, 0xe4, 0x89, 0x04, 0x19, 0x91, 0x25, 0x11, 0xf1, 0x3d, 0x4e, 0x71, 0x40, 0x79, 0xf7,
-	0x0a, 0x19, 0x88, 0xb8, 0x37, 0xb5, 0x7b, 0x1c, 0x31, 0x7c, 0xbf, 0xb9, 0x0b, 0x0d, 0xc9, 0x8f,
-	0x63, 0xe4, 0x98, 0x71, 0x4d, 0x47, 0x16, 0x39, 0xe5, 0x74, 0x64, 0xa1, 0x2b, 0x2a, 0x55, 0x86,
-	0xac, 0x92, 0x5b, 0x89, 0x90, 0xb9, 0xff, 0x0e, 0xc9, 0xf7, 0x12, 0x2c, 0x47, 0x8c, 0x81, 0xdc,
-	0x9a, 0xf9, 0x75, 0x89, 0xd8, 0x91, 0x5c, 0x4e, 0x18, 0x9d, 0x7c, 0x30, 0x43, 0xf7, 0x75, 0x5b,
-	0x74, 0xfc, 0x01, 0xd5, 0xef, 0x3c, 0x3d, 0xcb, 0x4b, 0xcf, 0xcf, 0xf2, 0xd2, 0x1f, 0x67, 0x79,
-	0xe9, 0x9b, 0xf3, 0x7c, 0xea, 0xf9, 0x79, 0x3e, 0xf5, 0xdb, 0x79, 0x3e, 0xf5, 0xf1, 0x76, 0xdb,
-	0xf1, 0x8f, 0xfa, 0x0d, 0xb5, 0x49, 0x3b, 0x81, 0x20, 0xff, 0x53, 0xf6, 0xac, 0x4f, 0xb5, 0x47,
-	0x5c, 0xdd, 0x1f, 0x74, 0x6d, 0xaf, 0xb1, 0xc0, 0xfe, 0x77, 0xdb, 0xfb, 0x37, 0x00, 0x00, 0xff,
-	0xff, 0x1f, 0xa2, 0x5f, 0xef, 0x16, 0x0e, 0x00, 0x00,
+	// 1130 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xbd, 0x69, 0x48, 0xd2, 0x97, 0x1f, 0x48, 0x13, 0x57, 0x84, 0x4d, 0x62, 0x87, 0x4d,
+	0xc9, 0x2f, 0xea, 0x5d, 0xf2, 0x0b, 0x41, 0x39, 0x65, 0x5b, 0x40, 0x39, 0x14, 0x99, 0x4d, 0x55,
+	0x21, 0x0e, 0x58, 0x63, 0xef, 0xc6, 0x59, 0x51, 0xef, 0xb8, 0xde, 0x35, 0xd4, 0x44, 0xbe, 0x20,
+	0x21, 0xe5, 0x82, 0x84, 0x04, 0x7f, 0x40, 0x0f, 0x88, 0x73, 0x11, 0xe1, 0xc6, 0x85, 0x5b, 0xd5,
+	0x53, 0x05, 0x17, 0x4e, 0x08, 0x25, 0x48, 0xf0, 0x67, 0x20, 0xcf, 0xbc, 0x5d, 0xef, 0x26, 0x63,
+	0x7b, 0xdd, 0x9e, 0x62, 0xcf, 0xbc, 0xf7, 0x7d, 0x9f, 0x79, 0xf3, 0x76, 0xbf, 0x0e, 0xe4, 0x2b,
+	0xcc, 0xaf, 0x31, 0xdf, 0xa0, 0xcd, 0xe0, 0xc8, 0xf8, 0x7c, 0xb3, 0xec, 0x04, 0x74, 0xd3, 0x78,
+	0xd0, 0x74, 0x1a, 0x2d, 0xbd, 0xde, 0x60, 0x01, 0x23, 0xb3, 0x22, 0x40, 0xef, 0x04, 0xe8, 0x18,
+	0xa0, 0x6e, 0x60, 0x56, 0x99, 0xfa, 0x8e, 0x88, 0x8e, 0x72, 0xeb, 0xb4, 0xea, 0x7a, 0x34, 0x70,
+	0x99, 0x27, 0x04, 0xd4, 0x6c, 0x95, 0x55, 0x19, 0xff, 0x68, 0x74, 0x3e, 0xe1, 0xea, 0xab, 0x55,
+	0xc6, 0xaa, 0xf7, 0x1d, 0x83, 0x7f, 0x2b, 0x37, 0x0f, 0x0d, 0xea, 0x61, 0x45, 0x75, 0x01, 0xb7,
+	0x68, 0xdd, 0x35, 0xa8, 0xe7, 0xb1, 0x80, 0xab, 0xf9, 0xb8, 0x9b, 0x93, 0x01, 0x73, 0x38, 0x14,
+	0x16, 0xfb, 0x25, 0x51, 0x11, 0xe1, 0xc5, 0xd6, 0x3c, 0xa6, 0x86, 0xc0, 0xf1, 0x73, 0x6a, 0x9f,
+	0x42, 0xf6, 0xa3, 0xce, 0xd7, 0xbd, 0x4a, 0x85, 0x35, 0xbd, 0xc0, 0xb7, 0x9c, 0x07, 0x4d, 0xc7,
+	0x0f, 0xc8, 0xfb, 0x00, 0xdd, 0x23, 0xcd, 0x29, 0x4b, 0xca, 0xda, 0xe4, 0xd6, 0x8a, 0x8e, 0xba,
+	0x9d, 0xf3, 0xeb, 0x42, 0x05, 0x51, 0xf4, 0x22, 0xad, 0x3a, 0x98, 0x6b, 0xc5, 0x32, 0xb5, 0x53,
+	0x05, 0xae, 0x5d, 0x28, 0xe0, 0xd7, 0x99, 0xe7, 0x3b, 0xc4, 0x82, 0x09, 0x8a, 0x6b, 0x73, 0xca,
+	0xd2, 0x95, 0xb5, 0xc9, 0xad, 0xac, 0x2e, 0x5a, 0xa0, 0x87, 0xdd, 0xd1, 0xf7, 0xbc, 0x96, 0xb9,
+	0xf4, 0xf4, 0xb4, 0xb0, 0x20, 0xb9, 0x0d, 0x1d, 0x15, 0xf7, 0xad, 0x48, 0x87, 0x7c, 0x90, 0xa0,
+	0x1e, 0xe1, 0xd4, 0xab, 0x03, 0xa9, 0x05, 0x50, 0x02, 0xfb, 0x00, 0x66, 0xe3, 0xd4, 0x61, 0x57,
+	0xb6, 0x60, 0x9c, 0xda, 0x76, 0xc3, 0xf1, 0x7d, 0xde, 0x92, 0xab, 0xe6, 0xdc, 0xef, 0xa7, 0x85,
+	0x2c, 0xea, 0xef, 0x89, 0x9d, 0x83, 0xa0, 0xe1, 0x7a, 0x55, 0x2b, 0x0c, 0xbc, 0x39, 0x71, 0xf2,
+	0x28, 0x9f, 0xf9, 0xef, 0x51, 0x3e, 0xa3, 0x1d, 0x25, 0x7b, 0x1d, 0x75, 0xa2, 0x08, 0xe3, 0x78,
+	0x02, 0x6c, 0xf4, 0xf3, 0x36, 0x22, 0x94, 0xd1, 0xb2, 0x40, 0x78, 0xa5, 0x22, 0x6d, 0xd0, 0x5a,
+	0x78, 0xa7, 0x5a, 0x11, 0x66, 0x13, 0xab, 0x58, 0xfe, 0x1d, 0x18, 0xab, 0xf3, 0x15, 0xac, 0x3e,
+	0xaf, 0xcb, 0x8a, 0x88, 0x24, 0x73, 0xf4, 0xc9, 0x5f, 0xf9, 0x8c, 0x85, 0x09, 0xda, 0x02, 0xa8,
+	0x5c, 0xf1, 0x0e, 0xb3, 0x9b, 0xf7, 0x9d, 0x0b, 0x33, 0xa4, 0x7d, 0x01, 0xf3, 0xd2, 0x5d, 0xac,
+	0xfb, 0x71, 0xca, 0x01, 0x58, 0x79, 0x7a, 0x5a, 0xd0, 0x64, 0x48, 0x09, 0xdd, 0xd8, 0x18, 0x68,
+	0xbb, 0x90, 0xbf, 0x5c, 0xd8, 0x6c, 0x7d, 0x48, 0x6b, 0xe1, 0x8c, 0x12, 0x02, 0xa3, 0x1e, 0xad,
+	0x39, 0xe2, 0x1a, 0x2d, 0xfe, 0x59, 0xfb, 0x12, 0x96, 0x7a, 0xa7, 0x21, 0xf4, 0xbd, 0x74, 0x77,
+	0x95, 0x96, 0x39, 0xba, 0xb1, 0x6b, 0x30, 0x6b, 0x3a, 0x95, 0xa3, 0xed, 0xad, 0x62, 0xc3, 0x39,
+	0x74, 0x1f, 0x86, 0x2d, 0x7c, 0x17, 0xb2, 0xc9, 0x65, 0xc4, 0x58, 0x86, 0xe9, 0x32, 0x5f, 0x2f,
+	0xd5, 0xf9, 0x06, 0x9e, 0x63, 0xaa, 0x1c, 0x0b, 0xd6, 0x4c, 0x98, 0xc7, 0x99, 0x34, 0x5b, 0x81,
+	0xe3, 0xdf, 0x65, 0x38, 0x9a, 0xd8, 0x82, 0x65, 0x98, 0xc6, 0x19, 0x2d, 0x95, 0x3b, 0xfb, 0x5c,
+	0x63, 0xca, 0x9a, 0xa2, 0xb1, 0x1c, 0xed, 0x3d, 0x58, 0x90, 0x6b, 0x20, 0xc8, 0xeb, 0x30, 0x13,
+	0x8a, 0xf8, 0x7c, 0x07, 0x49, 0x42, 0x69, 0x11, 0xae, 0xdd, 0x8e, 0x50, 0xc4, 0xc2, 0x5d, 0xc6,
+	0xe5, 0x42, 0x94, 0x94, 0x2a, 0xb7, 0x22, 0x98, 0x0b, 0x2a, 0xdd, 0xae, 0x0c, 0x3e, 0xd1, 0x01,
+	0xe4, 0xe2, 0x4f, 0x61, 0x74, 0xba, 0xfd, 0xdb, 0xdd, 0xd9, 0x18, 0x71, 0x6d, 0x9e, 0x7b, 0xc5,
+	0x1c, 0x99, 0x53, 0xac, 0x11, 0xd7, 0x26, 0x8b, 0x00, 0x78, 0x55, 0x25, 0xd7, 0xe6, 0x6f, 0x96,
+	0x51, 0xeb, 0x2a, 0xae, 0xec, 0xdb, 0x9a, 0x0d, 0xf9, 0x9e, 0xa2, 0x08, 0xb7, 0x07, 0x2f, 0x87,
+	0x0a, 0x69, 0xdf, 0x21, 0x33, 0x34, 0x21, 0xa7, 0xdd, 0x81, 0x57, 0xe2, 0x55, 0xf6, 0xbd, 0x43,
+	0xf6, 0x02, 0x6f, 0x26, 0xad, 0x08, 0x73, 0x97, 0xe5, 0x90, 0x76, 0x07, 0x46, 0x5d, 0xef, 0x90,
+	0xe1, 0x90, 0x2f, 0x49, 0x5f, 0x09, 0x26, 0xf5, 0xc3, 0x49, 0xb6, 0x78, 0xb4, 0x76, 0x0f, 0x9f,
+	0xa0, 0xb0, 0x0d, 0xcd, 0xe0, 0xc8, 0xf1, 0x02, 0xb7, 0x42, 0x03, 0xd6, 0x78, 0x11, 0xd2, 0x7d,
+	0x78, 0xad, 0x8f, 0x2e, 0x22, 0x5f, 0x87, 0x69, 0x1a, 0xdf, 0x88, 0x66, 0x28, 0xbe, 0xb8, 0xf5,
+	0xf3, 0x0c, 0xbc, 0xc4, 0xb5, 0xc8, 0x37, 0x0a, 0x4c, 0xec, 0x85, 0xce, 0xb1, 0x2e, 0x3d, 0xa1,
+	0xcc, 0x1a, 0xd5, 0x8d, 0x34, 0xa1, 0x82, 0x49, 0xdb, 0x38, 0xf9, 0xf7, 0xf1, 0x86, 0xf2, 0xd5,
+	0x1f, 0xff, 0x7c, 0x37, 0x92, 0x27, 0x8b, 0x86, 0xd4, 0xc4, 0x43, 0x84, 0xef, 0x15, 0x18, 0x47,
+	0x01, 0xb2, 0x36, 0xb0, 0x46, 0x48, 0xb3, 0x9e, 0x22, 0x12, 0x61, 0x76, 0xba, 0x30, 0xeb, 0x64,
+	0xb5, 0x2f, 0x8c, 0x71, 0x8c, 0xad, 0x6f, 0x93, 0x5f, 0x14, 0x20, 0x97, 0xc7, 0x9a, 0x6c, 0x0f,
+	0xac, 0x7b, 0xf9, 0xc9, 0x52, 0x77, 0x86, 0x4b, 0x1a, 0x82, 0x3b, 0x7a, 0xec, 0x4b, 0xae, 0x6d,
+	0x1c, 0xbb, 0x76, 0x9b, 0x7c, 0xad, 0xc0, 0x98, 0x30, 0x2d, 0xb2, 0xda, 0xbb, 0x6c, 0xc2, 0x21,
+	0xd5, 0xb5, 0xc1, 0x81, 0xc8, 0xb4, 0xd6, 0x65, 0x5a, 0x24, 0xf3, 0x52, 0x26, 0xe1, 0x91, 0xe4,
+	0x47, 0x05, 0x66, 0x92, 0x0e, 0x48, 0x8c, 0xde, 0x65, 0xa4, 0x4e, 0xaa, 0xbe, 0x99, 0x3e, 0x01,
+	0xf9, 0x36, 0xbb, 0x7c, 0x2b, 0xe4, 0xba, 0x94, 0xaf, 0xc6, 0x33, 0x4b, 0xd1, 0xfc, 0xfd, 0xaa,
+	0xc0, 0xac, 0xc4, 0xfa, 0xc8, 0x4e, 0xca, 0xe2, 0x09, 0x83, 0x55, 0x77, 0x87, 0xcc, 0x42, 0xee,
+	0xb7, 0xbb, 0xdc, 0x05, 0xf2, 0x46, 0x1a, 0x6e, 0xe3, 0xb8, 0x63, 0xde, 0x6d, 0x72, 0xa2, 0xc0,
+	0x54, 0xdc, 0x2b, 0x7b, 0x3c, 0x43, 0x12, 0x97, 0x55, 0xd7, 0x53, 0x44, 0x22, 0xdf, 0x72, 0xdf,
+	0x2b, 0x17, 0xf6, 0x4b, 0x1e, 0x2b, 0x90, 0x95, 0xb9, 0x26, 0x91, 0xdf, 0x63, 0x1f, 0x93, 0x56,
+	0x37, 0x87, 0xc8, 0x40, 0xc4, 0xed, 0xbe, 0xdd, 0x13, 0x88, 0xc6, 0x71, 0xc2, 0x28, 0xdb, 0xe4,
+	0xa7, 0x2e, 0x72, 0xc2, 0x5b, 0xfb, 0x23, 0xcb, 0xcc, 0x5c, 0xdd, 0x1c, 0x22, 0x23, 0x7c, 0xc2,
+	0x39, 0xb2, 0x4e, 0x6e, 0xa4, 0x42, 0x16, 0x3f, 0x11, 0xda, 0xe4, 0x07, 0x05, 0x26, 0x63, 0xde,
+	0x45, 0x6e, 0x0c, 0x7c, 0xbb, 0xc4, 0x1c, 0x53, 0x2d, 0xa4, 0x8c, 0x4e, 0x3f, 0x98, 0xd1, 0x0f,
+	0x04, 0xef, 0x90, 0xc5, 0x5e, 0xa0, 0xbf, 0x75, 0x5a, 0x2b, 0x31, 0x2e, 0xb2, 0x3b, 0xf8, 0x6d,
+	0x28, 0x31, 0x50, 0xf5, 0xad, 0x61, 0xd3, 0xf0, 0x04, 0x37, 0xbb, 0x27, 0x30, 0x48, 0xc1, 0xe8,
+	0xf5, 0x0f, 0x65, 0x94, 0x18, 0x33, 0x01, 0xf3, 0xd6, 0x93, 0xb3, 0x9c, 0xf2, 0xec, 0x2c, 0xa7,
+	0xfc, 0x7d, 0x96, 0x53, 0xbe, 0x3d, 0xcf, 0x65, 0x9e, 0x9d, 0xe7, 0x32, 0x7f, 0x9e, 0xe7, 0x32,
+	0x9f, 0xac, 0x57, 0xdd, 0xe0, 0xa8, 0x59, 0xd6, 0x2b, 0xac, 0x16, 0x4a, 0x8a, 0x3f, 0x05, 0xdf,
+	0xfe, 0xcc, 0x78, 0x28, 0xf4, 0x83, 0x56, 0xdd, 0xf1, 0xcb, 0x63, 0xfc, 0x27, 0xf2, 0xf6, 0xff,
+	0x03, 0x00, 0x86, 0x1b, 0xc8, 0x45, 0x7d, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1107,6 +1212,10 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error)
+	// AccountAuthenticator queries the authenticator of an account.
+	//
+	// Since: cosmos-sdk 0.50
+	AccountAuthenticator(ctx context.Context, in *QueryAccountAuthenticatorRequest, opts ...grpc.CallOption) (*QueryAccountAuthenticatorResponse, error)
 }
 
 type queryClient struct {
@@ -1207,6 +1316,15 @@ func (c *queryClient) AccountInfo(ctx context.Context, in *QueryAccountInfoReque
 	return out, nil
 }
 
+func (c *queryClient) AccountAuthenticator(ctx context.Context, in *QueryAccountAuthenticatorRequest, opts ...grpc.CallOption) (*QueryAccountAuthenticatorResponse, error) {
+	out := new(QueryAccountAuthenticatorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/AccountAuthenticator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Accounts returns all the existing accounts.
@@ -1246,6 +1364,10 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error)
+	// AccountAuthenticator queries the authenticator of an account.
+	//
+	// Since: cosmos-sdk 0.50
+	AccountAuthenticator(context.Context, *QueryAccountAuthenticatorRequest) (*QueryAccountAuthenticatorResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1282,6 +1404,9 @@ func (*UnimplementedQueryServer) AddressStringToBytes(ctx context.Context, req *
 func (*UnimplementedQueryServer) AccountInfo(ctx context.Context, req *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
 }
+func (*UnimplementedQueryServer) AccountAuthenticator(ctx context.Context, req *QueryAccountAuthenticatorRequest) (*QueryAccountAuthenticatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountAuthenticator not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1467,6 +1592,24 @@ func _Query_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AccountAuthenticator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountAuthenticatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountAuthenticator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/AccountAuthenticator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountAuthenticator(ctx, req.(*QueryAccountAuthenticatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.auth.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1511,6 +1654,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AccountInfo",
 			Handler:    _Query_AccountInfo_Handler,
 		},
+		{
+			MethodName: "AccountAuthenticator",
+			Handler:    _Query_AccountAuthenticator_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
@@ -2147,6 +2294,66 @@ func (m *QueryAccountInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAccountAuthenticatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountAuthenticatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountAuthenticatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountAuthenticatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountAuthenticatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountAuthenticatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authenticator) > 0 {
+		i -= len(m.Authenticator)
+		copy(dAtA[i:], m.Authenticator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Authenticator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2414,6 +2621,32 @@ func (m *QueryAccountInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAccountAuthenticatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountAuthenticatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authenticator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4031,6 +4264,170 @@ func (m *QueryAccountInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAccountAuthenticatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountAuthenticatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountAuthenticatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountAuthenticatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountAuthenticatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountAuthenticatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authenticator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authenticator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
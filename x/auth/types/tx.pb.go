@@ -130,37 +130,144 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetAuthenticator is the Msg/SetAuthenticator request type.
+//
+// Since: cosmos-sdk 0.50
+type MsgSetAuthenticator struct {
+	// address is the address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// authenticator is the name of the authenticator validating the txs of the
+	// account. The txs of the account are validated with its pubkey again when
+	// it is empty.
+	Authenticator string `protobuf:"bytes,2,opt,name=authenticator,proto3" json:"authenticator,omitempty"`
+}
+
+func (m *MsgSetAuthenticator) Reset()         { *m = MsgSetAuthenticator{} }
+func (m *MsgSetAuthenticator) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAuthenticator) ProtoMessage()    {}
+func (*MsgSetAuthenticator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d62bd9c4c212e5, []int{2}
+}
+func (m *MsgSetAuthenticator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAuthenticator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAuthenticator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAuthenticator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAuthenticator.Merge(m, src)
+}
+func (m *MsgSetAuthenticator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAuthenticator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAuthenticator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAuthenticator proto.InternalMessageInfo
+
+func (m *MsgSetAuthenticator) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgSetAuthenticator) GetAuthenticator() string {
+	if m != nil {
+		return m.Authenticator
+	}
+	return ""
+}
+
+// MsgSetAuthenticatorResponse defines the response structure for executing a
+// MsgSetAuthenticator message.
+//
+// Since: cosmos-sdk 0.50
+type MsgSetAuthenticatorResponse struct {
+}
+
+func (m *MsgSetAuthenticatorResponse) Reset()         { *m = MsgSetAuthenticatorResponse{} }
+func (m *MsgSetAuthenticatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAuthenticatorResponse) ProtoMessage()    {}
+func (*MsgSetAuthenticatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2d62bd9c4c212e5, []int{3}
+}
+func (m *MsgSetAuthenticatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAuthenticatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAuthenticatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAuthenticatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAuthenticatorResponse.Merge(m, src)
+}
+func (m *MsgSetAuthenticatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAuthenticatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAuthenticatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAuthenticatorResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.auth.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.auth.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetAuthenticator)(nil), "cosmos.auth.v1beta1.MsgSetAuthenticator")
+	proto.RegisterType((*MsgSetAuthenticatorResponse)(nil), "cosmos.auth.v1beta1.MsgSetAuthenticatorResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/tx.proto", fileDescriptor_c2d62bd9c4c212e5) }
 
 var fileDescriptor_c2d62bd9c4c212e5 = []byte{
-	// 343 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xc8, 0xea, 0x81, 0x64, 0xf5,
-	0xa0, 0xb2, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x79, 0x7d, 0x10, 0x0b, 0xa2, 0x54, 0x4a,
-	0x12, 0xa2, 0x34, 0x1e, 0x22, 0x01, 0xd5, 0x07, 0x91, 0x12, 0x87, 0xda, 0x91, 0x5b, 0x9c, 0xae,
-	0x5f, 0x66, 0x08, 0xa2, 0xa0, 0x12, 0x82, 0x89, 0xb9, 0x99, 0x79, 0xf9, 0xfa, 0x60, 0x12, 0x2a,
-	0x24, 0x87, 0xcd, 0x3d, 0x60, 0xeb, 0xc1, 0xf2, 0x4a, 0xfb, 0x19, 0xb9, 0xf8, 0x7d, 0x8b, 0xd3,
-	0x43, 0x0b, 0x52, 0x12, 0x4b, 0x52, 0x03, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0x85, 0xcc, 0xb8, 0x38,
-	0x41, 0x2a, 0xf2, 0x8b, 0x32, 0x4b, 0x2a, 0x25, 0x18, 0x15, 0x18, 0x35, 0x38, 0x9d, 0x24, 0x2e,
-	0x6d, 0xd1, 0x15, 0x81, 0x3a, 0xc2, 0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x38, 0xb8, 0xa4, 0x28,
-	0x33, 0x2f, 0x3d, 0x08, 0xa1, 0x54, 0xc8, 0x8e, 0x8b, 0xad, 0x00, 0x6c, 0x82, 0x04, 0x93, 0x02,
-	0xa3, 0x06, 0xb7, 0x91, 0xb4, 0x1e, 0x16, 0xef, 0xea, 0x41, 0x2c, 0x71, 0xe2, 0x3c, 0x71, 0x4f,
-	0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x5d, 0x56, 0x26, 0x4d, 0xcf, 0x37, 0x68,
-	0x21, 0xcc, 0xeb, 0x7a, 0xbe, 0x41, 0x4b, 0x11, 0x62, 0x82, 0x6e, 0x71, 0x4a, 0xb6, 0x7e, 0x05,
-	0xc4, 0x13, 0x68, 0xae, 0x55, 0x92, 0xe4, 0x12, 0x47, 0x13, 0x0a, 0x4a, 0x2d, 0x2e, 0xc8, 0xcf,
-	0x2b, 0x4e, 0x35, 0x2a, 0xe0, 0x62, 0xf6, 0x2d, 0x4e, 0x17, 0x4a, 0xe2, 0xe2, 0x41, 0xf1, 0x9f,
-	0x0a, 0x56, 0x77, 0xa1, 0x19, 0x22, 0xa5, 0x43, 0x8c, 0x2a, 0x98, 0x55, 0x52, 0xac, 0x0d, 0x20,
-	0xaf, 0x38, 0x39, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c,
-	0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x66, 0x7a,
-	0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0x2e, 0x34, 0x36, 0xf5, 0x31, 0xfd, 0x56, 0x52,
-	0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x8e, 0x1a, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xba,
-	0x2c, 0xd6, 0xf9, 0x4c, 0x02, 0x00, 0x00,
+	// 435 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x41, 0x8f, 0xd2, 0x40,
+	0x18, 0xed, 0x68, 0x5c, 0xd3, 0x51, 0xa3, 0x76, 0x37, 0x59, 0xb6, 0xab, 0x75, 0x6d, 0x30, 0x41,
+	0x22, 0x1d, 0x41, 0xc3, 0x81, 0x83, 0x09, 0x78, 0x26, 0x31, 0x25, 0x5e, 0xbc, 0x98, 0x29, 0x9d,
+	0x0c, 0x8d, 0x69, 0xa7, 0xe9, 0x0c, 0x04, 0x6e, 0xc6, 0xa3, 0x27, 0xff, 0x85, 0x1e, 0x39, 0x78,
+	0xf7, 0xca, 0x91, 0x78, 0xf2, 0x64, 0x08, 0x1c, 0xf8, 0x1b, 0xa6, 0x9d, 0x69, 0x90, 0x52, 0x23,
+	0x97, 0xb6, 0xf9, 0xde, 0xfb, 0xde, 0xfb, 0xde, 0xd7, 0x19, 0xf8, 0x60, 0xc8, 0x78, 0xc8, 0x38,
+	0xc2, 0x63, 0x31, 0x42, 0x93, 0xa6, 0x47, 0x04, 0x6e, 0x22, 0x31, 0x75, 0xe2, 0x84, 0x09, 0x66,
+	0x9c, 0x4a, 0xd4, 0x49, 0x51, 0x47, 0xa1, 0xe6, 0x19, 0x65, 0x94, 0x65, 0x38, 0x4a, 0xbf, 0x24,
+	0xd5, 0xbc, 0x90, 0xd4, 0xf7, 0x12, 0x50, 0x7d, 0x12, 0x3a, 0x57, 0x1e, 0x21, 0xa7, 0x68, 0xd2,
+	0x4c, 0x5f, 0x0a, 0xb8, 0x8f, 0xc3, 0x20, 0x62, 0x28, 0x7b, 0xaa, 0x92, 0x55, 0x36, 0x4f, 0x66,
+	0x9f, 0xe1, 0xf6, 0x0f, 0x00, 0xef, 0xf6, 0x39, 0x7d, 0x1b, 0xfb, 0x58, 0x90, 0x37, 0x38, 0xc1,
+	0x21, 0x37, 0xda, 0x50, 0x4f, 0x19, 0x2c, 0x09, 0xc4, 0xac, 0x02, 0xae, 0x40, 0x4d, 0xef, 0x55,
+	0x7e, 0x7e, 0x6f, 0x9c, 0xa9, 0x21, 0xba, 0xbe, 0x9f, 0x10, 0xce, 0x07, 0x22, 0x09, 0x22, 0xea,
+	0xee, 0xa8, 0xc6, 0x2b, 0x78, 0x12, 0x67, 0x0a, 0x95, 0x6b, 0x57, 0xa0, 0x76, 0xab, 0x75, 0xe9,
+	0x94, 0xc4, 0x75, 0xa4, 0x49, 0x4f, 0x5f, 0xfc, 0x7e, 0xa4, 0x7d, 0xdb, 0xce, 0xeb, 0xc0, 0x55,
+	0x5d, 0x9d, 0x97, 0x9f, 0xb6, 0xf3, 0xfa, 0x4e, 0xef, 0xf3, 0x76, 0x5e, 0x7f, 0x2c, 0x15, 0x1a,
+	0xdc, 0xff, 0x80, 0xa6, 0x32, 0x44, 0x61, 0x5a, 0xfb, 0x02, 0x9e, 0x17, 0x4a, 0x2e, 0xe1, 0x31,
+	0x8b, 0x38, 0xb1, 0xbf, 0x02, 0x78, 0xda, 0xe7, 0x74, 0x40, 0x44, 0x77, 0x2c, 0x46, 0x24, 0x12,
+	0xc1, 0x10, 0x0b, 0x96, 0x18, 0x2d, 0x78, 0x13, 0xcb, 0x10, 0xff, 0x8d, 0x97, 0x13, 0x8d, 0x2a,
+	0xbc, 0x83, 0xff, 0x16, 0xc9, 0x32, 0xea, 0xee, 0x7e, 0xb1, 0xd3, 0x4e, 0x23, 0xe4, 0x3d, 0x69,
+	0x80, 0x27, 0xa5, 0x01, 0x8a, 0x13, 0xd9, 0x0f, 0xe1, 0x65, 0x49, 0x39, 0x0f, 0xd2, 0x5a, 0x01,
+	0x78, 0xbd, 0xcf, 0xa9, 0xe1, 0xc1, 0xdb, 0x7b, 0x7f, 0xaa, 0x5a, 0xba, 0xe1, 0xc2, 0x3a, 0xcc,
+	0x67, 0xc7, 0xb0, 0x72, 0x2f, 0x23, 0x82, 0xf7, 0x0e, 0x16, 0x56, 0xfb, 0x97, 0x42, 0x91, 0x69,
+	0x3e, 0x3f, 0x96, 0x99, 0xfb, 0x99, 0x37, 0x3e, 0xa6, 0x87, 0xa0, 0xf7, 0x7a, 0xb1, 0xb6, 0xc0,
+	0x72, 0x6d, 0x81, 0xd5, 0xda, 0x02, 0x5f, 0x36, 0x96, 0xb6, 0xdc, 0x58, 0xda, 0xaf, 0x8d, 0xa5,
+	0xbd, 0x7b, 0x4a, 0x03, 0x31, 0x1a, 0x7b, 0xce, 0x90, 0x85, 0xea, 0x1e, 0xa0, 0xc3, 0xa5, 0x8a,
+	0x59, 0x4c, 0xb8, 0x77, 0x92, 0x1d, 0xea, 0x17, 0x7f, 0x06, 0x00, 0x08, 0xb3, 0xa5, 0x0f, 0x86,
+	0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -180,6 +287,12 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetAuthenticator defines a method for designating the authenticator which
+	// validates the txs of an account, instead of the signature verification
+	// with the account pubkey.
+	//
+	// Since: cosmos-sdk 0.50
+	SetAuthenticator(ctx context.Context, in *MsgSetAuthenticator, opts ...grpc.CallOption) (*MsgSetAuthenticatorResponse, error)
 }
 
 type msgClient struct {
@@ -199,6 +312,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetAuthenticator(ctx context.Context, in *MsgSetAuthenticator, opts ...grpc.CallOption) (*MsgSetAuthenticatorResponse, error) {
+	out := new(MsgSetAuthenticatorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Msg/SetAuthenticator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the x/auth module
@@ -206,6 +328,12 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetAuthenticator defines a method for designating the authenticator which
+	// validates the txs of an account, instead of the signature verification
+	// with the account pubkey.
+	//
+	// Since: cosmos-sdk 0.50
+	SetAuthenticator(context.Context, *MsgSetAuthenticator) (*MsgSetAuthenticatorResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -215,6 +343,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetAuthenticator(ctx context.Context, req *MsgSetAuthenticator) (*MsgSetAuthenticatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAuthenticator not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -238,6 +369,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAuthenticator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAuthenticator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAuthenticator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Msg/SetAuthenticator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAuthenticator(ctx, req.(*MsgSetAuthenticator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.auth.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -246,6 +395,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetAuthenticator",
+			Handler:    _Msg_SetAuthenticator_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/tx.proto",
@@ -314,6 +467,66 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetAuthenticator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAuthenticator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAuthenticator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authenticator) > 0 {
+		i -= len(m.Authenticator)
+		copy(dAtA[i:], m.Authenticator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authenticator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAuthenticatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAuthenticatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAuthenticatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -349,6 +562,32 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetAuthenticator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authenticator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAuthenticatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -520,6 +759,170 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetAuthenticator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAuthenticator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAuthenticator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authenticator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authenticator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAuthenticatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAuthenticatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAuthenticatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
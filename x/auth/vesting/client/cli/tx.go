@@ -20,6 +20,7 @@ import (
 // Transaction command flags
 const (
 	FlagDelayed = "delayed"
+	FlagDest    = "dest"
 )
 
 // GetTxCmd returns vesting module's transaction commands.
@@ -36,6 +37,8 @@ func GetTxCmd(ac address.Codec) *cobra.Command {
 		NewMsgCreateVestingAccountCmd(ac),
 		NewMsgCreatePermanentLockedAccountCmd(ac),
 		NewMsgCreatePeriodicVestingAccountCmd(ac),
+		NewMsgCreateClawbackVestingAccountCmd(ac),
+		NewMsgClawbackCmd(ac),
 	)
 
 	return txCmd
@@ -174,41 +177,129 @@ func NewMsgCreatePeriodicVestingAccountCmd(ac address.Codec) *cobra.Command {
 				return err
 			}
 
-			contents, err := os.ReadFile(args[1])
+			startTime, periods, err := readVestingPeriods(args[1])
 			if err != nil {
 				return err
 			}
 
-			var vestingData VestingData
+			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, startTime, periods)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgCreateClawbackVestingAccountCmd returns a CLI command handler for creating a
+// MsgCreateClawbackVestingAccount transaction.
+func NewMsgCreateClawbackVestingAccountCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-clawback-vesting-account [to_address] [periods_json_file]",
+		Short: "Create a new vesting account funded with an allocation of tokens, which can be clawed back by the funder.",
+		Long: `Create a new periodic vesting account funded with an allocation of tokens. The
+periods_json_file has the same format as for create-periodic-vesting-account. The
+sender of the transaction is the funder of the account, and can claw back its
+unvested tokens with the clawback command.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := ac.StringToBytes(args[0])
+			if err != nil {
+				return err
+			}
 
-			err = json.Unmarshal(contents, &vestingData)
+			startTime, periods, err := readVestingPeriods(args[1])
 			if err != nil {
 				return err
 			}
 
-			var periods []types.Period
+			msg := types.NewMsgCreateClawbackVestingAccount(clientCtx.GetFromAddress(), toAddr, startTime, periods)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
 
-			for i, p := range vestingData.Periods {
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
 
-				amount, err := sdk.ParseCoinsNormalized(p.Coins)
+// NewMsgClawbackCmd returns a CLI command handler for creating a MsgClawback
+// transaction.
+func NewMsgClawbackCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [address]",
+		Short: "Claw back the unvested tokens of a clawback vesting account.",
+		Long: `Claw back the unvested tokens of a clawback vesting account, which are not
+delegated. The sender of the transaction must be the funder of the account. The
+tokens are sent to the funder, or to the '--dest' address if provided.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := ac.StringToBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			var destAddr []byte
+			if dest, _ := cmd.Flags().GetString(FlagDest); dest != "" {
+				destAddr, err = ac.StringToBytes(dest)
 				if err != nil {
 					return err
 				}
-
-				if p.Length < 0 {
-					return fmt.Errorf("invalid period length of %d in period %d, length must be greater than 0", p.Length, i)
-				}
-
-				period := types.Period{Length: p.Length, Amount: amount}
-				periods = append(periods, period)
 			}
 
-			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, vestingData.StartTime, periods)
+			msg := types.NewMsgClawback(clientCtx.GetFromAddress(), addr, destAddr)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagDest, "", "Address receiving the clawed back tokens, defaults to the funder")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// readVestingPeriods reads the start time and the vesting periods from the
+// given JSON file.
+func readVestingPeriods(path string) (int64, []types.Period, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var vestingData VestingData
+
+	err = json.Unmarshal(contents, &vestingData)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var periods []types.Period
+
+	for i, p := range vestingData.Periods {
+
+		amount, err := sdk.ParseCoinsNormalized(p.Coins)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		if p.Length < 0 {
+			return 0, nil, fmt.Errorf("invalid period length of %d in period %d, length must be greater than 0", p.Length, i)
+		}
+
+		period := types.Period{Length: p.Length, Amount: amount}
+		periods = append(periods, period)
+	}
+
+	return vestingData.StartTime, periods, nil
+}
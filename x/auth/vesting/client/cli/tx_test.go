@@ -275,3 +275,123 @@ func (s *CLITestSuite) TestNewMsgCreatePeriodicVestingAccountCmd() {
 		})
 	}
 }
+
+func (s *CLITestSuite) TestNewMsgCreateClawbackVestingAccountCmd() {
+	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
+	cmd := cli.NewMsgCreateClawbackVestingAccountCmd(address.NewBech32Codec("cosmos"))
+	cmd.SetOutput(io.Discard)
+
+	extraArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("photon", sdkmath.NewInt(10))).String()),
+		fmt.Sprintf("--%s=test-chain", flags.FlagChainID),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, accounts[0].Address),
+	}
+
+	testCases := []struct {
+		name      string
+		to        sdk.AccAddress
+		file      string
+		expectErr bool
+	}{
+		{
+			"valid transaction",
+			accounts[0].Address,
+			"./test.json",
+			false,
+		},
+		{
+			"invalid to Address",
+			sdk.AccAddress{},
+			"./test.json",
+			true,
+		},
+		{
+			"missing periods file",
+			accounts[0].Address,
+			"./missing.json",
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+
+			cmd.SetContext(ctx)
+			cmd.SetArgs(append([]string{tc.to.String(), tc.file}, extraArgs...))
+
+			s.Require().NoError(client.SetCmdClientContextHandler(s.baseCtx, cmd))
+
+			err := cmd.Execute()
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *CLITestSuite) TestNewMsgClawbackCmd() {
+	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 2)
+
+	extraArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("photon", sdkmath.NewInt(10))).String()),
+		fmt.Sprintf("--%s=test-chain", flags.FlagChainID),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, accounts[0].Address),
+	}
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			"valid transaction",
+			[]string{accounts[1].Address.String()},
+			false,
+		},
+		{
+			"valid transaction with destination",
+			[]string{accounts[1].Address.String(), fmt.Sprintf("--%s=%s", cli.FlagDest, accounts[0].Address)},
+			false,
+		},
+		{
+			"invalid address",
+			[]string{"invalid"},
+			true,
+		},
+		{
+			"invalid destination",
+			[]string{accounts[1].Address.String(), fmt.Sprintf("--%s=invalid", cli.FlagDest)},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.NewMsgClawbackCmd(address.NewBech32Codec("cosmos"))
+			cmd.SetOutput(io.Discard)
+
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+
+			cmd.SetContext(ctx)
+			cmd.SetArgs(append(tc.args, extraArgs...))
+
+			s.Require().NoError(client.SetCmdClientContextHandler(s.baseCtx, cmd))
+
+			err := cmd.Execute()
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
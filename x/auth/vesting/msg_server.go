@@ -2,10 +2,12 @@ package vesting
 
 import (
 	"context"
+	stdmath "math"
 
 	"github.com/armon/go-metrics"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,12 +20,14 @@ import (
 type msgServer struct {
 	keeper.AccountKeeper
 	types.BankKeeper
+	StakingKeeper types.StakingKeeper
 }
 
 // NewMsgServerImpl returns an implementation of the vesting MsgServer interface,
-// wrapping the corresponding AccountKeeper and BankKeeper.
-func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper) types.MsgServer {
-	return &msgServer{AccountKeeper: k, BankKeeper: bk}
+// wrapping the corresponding AccountKeeper, BankKeeper and StakingKeeper. The
+// delegated unvested coins are not clawed back if the StakingKeeper is nil.
+func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: k, BankKeeper: bk, StakingKeeper: sk}
 }
 
 var _ types.MsgServer = msgServer{}
@@ -173,11 +177,19 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 		totalCoins = totalCoins.Add(period.Amount...)
 	}
 
+	if err := validateAmount(totalCoins); err != nil {
+		return nil, err
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if acc := s.AccountKeeper.GetAccount(ctx, to); acc != nil {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
 	}
 
+	if s.BankKeeper.BlockedAddr(to) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
 	if err := s.BankKeeper.IsSendEnabledCoins(ctx, totalCoins...); err != nil {
 		return nil, err
 	}
@@ -209,6 +221,188 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
 }
 
+func (s msgServer) CreateClawbackVestingAccount(goCtx context.Context, msg *types.MsgCreateClawbackVestingAccount) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	from, err := s.AccountKeeper.StringToBytes(msg.FromAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'from' address: %s", err)
+	}
+
+	to, err := s.AccountKeeper.StringToBytes(msg.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'to' address: %s", err)
+	}
+
+	if msg.StartTime < 1 {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid start time of %d, length must be greater than 0", msg.StartTime)
+	}
+
+	var totalCoins sdk.Coins
+	for i, period := range msg.VestingPeriods {
+		if period.Length < 1 {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid period length of %d in period %d, length must be greater than 0", period.Length, i)
+		}
+
+		totalCoins = totalCoins.Add(period.Amount...)
+	}
+
+	if err := validateAmount(totalCoins); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if s.BankKeeper.BlockedAddr(to) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	if acc := s.AccountKeeper.GetAccount(ctx, to); acc != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+	}
+
+	if err := s.BankKeeper.IsSendEnabledCoins(ctx, totalCoins...); err != nil {
+		return nil, err
+	}
+
+	baseAccount := authtypes.NewBaseAccountWithAddress(to)
+	baseAccount = s.AccountKeeper.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
+	vestingAccount := types.NewClawbackVestingAccount(baseAccount, from, totalCoins.Sort(), msg.StartTime, msg.VestingPeriods)
+
+	s.AccountKeeper.SetAccount(ctx, vestingAccount)
+
+	defer func() {
+		telemetry.IncrCounter(1, "new", "account")
+
+		for _, a := range totalCoins {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "create_clawback_vesting_account"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	if err = s.BankKeeper.SendCoins(ctx, from, to, totalCoins); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+func (s msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	funder, err := s.AccountKeeper.StringToBytes(msg.FunderAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid funder address: %s", err)
+	}
+
+	addr, err := s.AccountKeeper.StringToBytes(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid account address: %s", err)
+	}
+
+	dest := funder
+	if msg.DestAddress != "" {
+		dest, err = s.AccountKeeper.StringToBytes(msg.DestAddress)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid destination address: %s", err)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	acc := s.AccountKeeper.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "account %s does not exist", msg.Address)
+	}
+
+	vestingAccount, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a clawback vesting account", msg.Address)
+	}
+
+	if vestingAccount.FunderAddress != msg.FunderAddress {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the funder of account %s", msg.FunderAddress, msg.Address)
+	}
+
+	if s.BankKeeper.BlockedAddr(dest) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", sdk.AccAddress(dest))
+	}
+
+	// The delegated unvested coins are clawed back by transferring the
+	// unbonding delegations and delegations of the account to the destination.
+	blockTime := ctx.BlockTime()
+	delegated := vestingAccount.GetVestingCoins(blockTime).Sub(vestingAccount.LockedCoins(blockTime)...)
+	transferred := sdk.NewCoins()
+	if s.StakingKeeper != nil && !delegated.IsZero() {
+		bondDenom := s.StakingKeeper.BondDenom(ctx)
+		amt, err := s.transferDelegations(ctx, addr, dest, delegated.AmountOf(bondDenom))
+		if err != nil {
+			return nil, err
+		}
+		transferred = sdk.NewCoins(sdk.NewCoin(bondDenom, amt))
+	}
+
+	// The account is updated before sending the coins, so that they are no
+	// longer locked.
+	clawback := vestingAccount.Clawback(blockTime, transferred)
+	s.AccountKeeper.SetAccount(ctx, vestingAccount)
+
+	if unbonded := clawback.Sub(transferred...); !unbonded.IsZero() {
+		if err := s.BankKeeper.SendCoins(ctx, addr, dest, unbonded); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgClawbackResponse{Amount: clawback}, nil
+}
+
+// transferDelegations transfers up to amt tokens of the unbonding delegations
+// of addr, and then of its delegations, to dest. It returns the transferred
+// tokens.
+func (s msgServer) transferDelegations(ctx sdk.Context, addr, dest sdk.AccAddress, amt math.Int) (math.Int, error) {
+	transferred := math.ZeroInt()
+	for _, ubd := range s.StakingKeeper.GetUnbondingDelegations(ctx, addr, stdmath.MaxUint16) {
+		if transferred.GTE(amt) {
+			return transferred, nil
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(ubd.ValidatorAddress)
+		if err != nil {
+			return transferred, err
+		}
+
+		moved, err := s.StakingKeeper.TransferUnbonding(ctx, addr, dest, valAddr, amt.Sub(transferred))
+		if err != nil {
+			return transferred, err
+		}
+		transferred = transferred.Add(moved)
+	}
+
+	for _, delegation := range s.StakingKeeper.GetDelegatorDelegations(ctx, addr, stdmath.MaxUint16) {
+		if transferred.GTE(amt) {
+			return transferred, nil
+		}
+
+		validator, found := s.StakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found {
+			continue
+		}
+
+		shares, err := validator.SharesFromTokensTruncated(amt.Sub(transferred))
+		if err != nil {
+			// the validator has no tokens left, so its shares are worth nothing
+			continue
+		}
+
+		moved, err := s.StakingKeeper.TransferDelegation(ctx, addr, dest, validator.GetOperator(), shares)
+		if err != nil {
+			return transferred, err
+		}
+		transferred = transferred.Add(validator.TokensFromShares(moved).TruncateInt())
+	}
+
+	return transferred, nil
+}
+
 func validateAmount(amount sdk.Coins) error {
 	if !amount.IsValid() {
 		return sdkerrors.ErrInvalidCoins.Wrap(amount.String())
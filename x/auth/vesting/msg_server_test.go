@@ -1,6 +1,7 @@
 package vesting_test
 
 import (
+	stdmath "math"
 	"testing"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -20,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	vestingtestutil "github.com/cosmos/cosmos-sdk/x/auth/vesting/testutil"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 var (
@@ -37,6 +40,7 @@ type VestingTestSuite struct {
 	ctx           sdk.Context
 	accountKeeper authkeeper.AccountKeeper
 	bankKeeper    *vestingtestutil.MockBankKeeper
+	stakingKeeper *vestingtestutil.MockStakingKeeper
 	msgServer     vestingtypes.MsgServer
 }
 
@@ -51,6 +55,7 @@ func (s *VestingTestSuite) SetupTest() {
 
 	ctrl := gomock.NewController(s.T())
 	s.bankKeeper = vestingtestutil.NewMockBankKeeper(ctrl)
+	s.stakingKeeper = vestingtestutil.NewMockStakingKeeper(ctrl)
 	s.accountKeeper = authkeeper.NewAccountKeeper(
 		encCfg.Codec,
 		storeService,
@@ -62,7 +67,7 @@ func (s *VestingTestSuite) SetupTest() {
 
 	vestingtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
 	authtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
-	s.msgServer = vesting.NewMsgServerImpl(s.accountKeeper, s.bankKeeper)
+	s.msgServer = vesting.NewMsgServerImpl(s.accountKeeper, s.bankKeeper, s.stakingKeeper)
 }
 
 func (s *VestingTestSuite) TestCreateVestingAccount() {
@@ -337,9 +342,45 @@ func (s *VestingTestSuite) TestCreatePeriodicVestingAccount() {
 			expErr:    true,
 			expErrMsg: "already exists",
 		},
+		{
+			name: "empty periods",
+			input: vestingtypes.NewMsgCreatePeriodicVestingAccount(
+				fromAddr,
+				to2Addr,
+				time.Now().Unix(),
+				[]vestingtypes.Period{
+					{
+						Length: 10,
+						Amount: sdk.NewCoins(),
+					},
+				},
+			),
+			expErr:    true,
+			expErrMsg: "invalid coins",
+		},
+		{
+			name: "blocked to address",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(to2Addr).Return(true)
+			},
+			input: vestingtypes.NewMsgCreatePeriodicVestingAccount(
+				fromAddr,
+				to2Addr,
+				time.Now().Unix(),
+				[]vestingtypes.Period{
+					{
+						Length: 10,
+						Amount: sdk.NewCoins(periodCoin),
+					},
+				},
+			),
+			expErr:    true,
+			expErrMsg: "is not allowed to receive funds",
+		},
 		{
 			name: "create a valid periodic vesting account",
 			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(to2Addr).Return(false)
 				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), periodCoin.Add(fooCoin)).Return(nil)
 				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to2Addr, gomock.Any()).Return(nil)
 			},
@@ -379,6 +420,209 @@ func (s *VestingTestSuite) TestCreatePeriodicVestingAccount() {
 	}
 }
 
+func (s *VestingTestSuite) TestCreateClawbackVestingAccount() {
+	periods := []vestingtypes.Period{
+		{
+			Length: 10,
+			Amount: sdk.NewCoins(periodCoin),
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		preRun    func()
+		input     *vestingtypes.MsgCreateClawbackVestingAccount
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "empty from address",
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount([]byte{}, to1Addr, time.Now().Unix(), periods),
+			expErr:    true,
+			expErrMsg: "invalid 'from' address",
+		},
+		{
+			name:      "invalid start time",
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, 0, periods),
+			expErr:    true,
+			expErrMsg: "invalid start time",
+		},
+		{
+			name:      "empty periods",
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, time.Now().Unix(), nil),
+			expErr:    true,
+			expErrMsg: "invalid coins",
+		},
+		{
+			name: "blocked to address",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(true)
+			},
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, time.Now().Unix(), periods),
+			expErr:    true,
+			expErrMsg: "is not allowed to receive funds",
+		},
+		{
+			name: "create a valid clawback vesting account",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), periodCoin).Return(nil)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to1Addr, sdk.NewCoins(periodCoin)).Return(nil)
+			},
+			input: vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, time.Now().Unix(), periods),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+			_, err := s.msgServer.CreateClawbackVestingAccount(s.ctx, tc.input)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+
+				acc, ok := s.accountKeeper.GetAccount(s.ctx, to1Addr).(*vestingtypes.ClawbackVestingAccount)
+				s.Require().True(ok)
+				s.Require().Equal(fromAddr.String(), acc.FunderAddress)
+				s.Require().Equal(sdk.NewCoins(periodCoin), acc.OriginalVesting)
+			}
+		})
+	}
+}
+
+func (s *VestingTestSuite) TestClawback() {
+	startTime := s.ctx.BlockTime().Add(-15 * time.Second).Unix()
+	periods := []vestingtypes.Period{
+		{
+			Length: 10,
+			Amount: sdk.NewCoins(periodCoin),
+		},
+		{
+			Length: 20,
+			Amount: sdk.NewCoins(fooCoin),
+		},
+	}
+
+	baseAccount := s.accountKeeper.NewAccountWithAddress(s.ctx, to1Addr).(*authtypes.BaseAccount)
+	s.accountKeeper.SetAccount(s.ctx, vestingtypes.NewClawbackVestingAccount(baseAccount, fromAddr, sdk.NewCoins(periodCoin.Add(fooCoin)), startTime, periods))
+	s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccountWithAddress(s.ctx, to2Addr))
+
+	testCases := []struct {
+		name      string
+		preRun    func()
+		input     *vestingtypes.MsgClawback
+		expErr    bool
+		expErrMsg string
+		expAmount sdk.Coins
+	}{
+		{
+			name:      "empty funder address",
+			input:     vestingtypes.NewMsgClawback([]byte{}, to1Addr, nil),
+			expErr:    true,
+			expErrMsg: "invalid funder address",
+		},
+		{
+			name:      "account does not exist",
+			input:     vestingtypes.NewMsgClawback(fromAddr, to3Addr, nil),
+			expErr:    true,
+			expErrMsg: "does not exist",
+		},
+		{
+			name:      "not a clawback vesting account",
+			input:     vestingtypes.NewMsgClawback(fromAddr, to2Addr, nil),
+			expErr:    true,
+			expErrMsg: "is not a clawback vesting account",
+		},
+		{
+			name:      "not the funder",
+			input:     vestingtypes.NewMsgClawback(to2Addr, to1Addr, nil),
+			expErr:    true,
+			expErrMsg: "is not the funder",
+		},
+		{
+			name: "blocked destination address",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(to3Addr).Return(true)
+			},
+			input:     vestingtypes.NewMsgClawback(fromAddr, to1Addr, to3Addr),
+			expErr:    true,
+			expErrMsg: "is not allowed to receive funds",
+		},
+		{
+			name: "claw back the unvested coins to the funder",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(fromAddr).Return(false)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), to1Addr, fromAddr, sdk.NewCoins(fooCoin)).Return(nil)
+			},
+			input:     vestingtypes.NewMsgClawback(fromAddr, to1Addr, nil),
+			expAmount: sdk.NewCoins(fooCoin),
+		},
+		{
+			name: "nothing left to claw back",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(to3Addr).Return(false)
+			},
+			input:     vestingtypes.NewMsgClawback(fromAddr, to1Addr, to3Addr),
+			expAmount: sdk.Coins{},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+			res, err := s.msgServer.Clawback(s.ctx, tc.input)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expAmount, res.Amount)
+
+				acc := s.accountKeeper.GetAccount(s.ctx, to1Addr).(*vestingtypes.ClawbackVestingAccount)
+				s.Require().Equal(sdk.NewCoins(periodCoin), acc.OriginalVesting)
+				s.Require().True(acc.LockedCoins(s.ctx.BlockTime()).IsZero())
+			}
+		})
+	}
+}
+
+func (s *VestingTestSuite) TestClawbackDelegations() {
+	periods := []vestingtypes.Period{{Length: 10, Amount: sdk.NewCoins(fooCoin)}}
+	baseAccount := s.accountKeeper.NewAccountWithAddress(s.ctx, to1Addr).(*authtypes.BaseAccount)
+	acc := vestingtypes.NewClawbackVestingAccount(baseAccount, fromAddr, sdk.NewCoins(fooCoin), s.ctx.BlockTime().Unix(), periods)
+	acc.TrackDelegation(s.ctx.BlockTime(), sdk.NewCoins(fooCoin), sdk.NewCoins(sdk.NewInt64Coin("foo", 80)))
+	s.accountKeeper.SetAccount(s.ctx, acc)
+
+	// 30 of the 80 delegated unvested coins are unbonding, and 50 are delegated
+	// to a validator whose shares are worth half a token
+	valAddr := sdk.ValAddress(to2Addr)
+	validator := stakingtypes.Validator{OperatorAddress: valAddr.String(), Tokens: math.NewInt(100), DelegatorShares: math.LegacyNewDec(200)}
+	s.bankKeeper.EXPECT().BlockedAddr(fromAddr).Return(false)
+	s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("foo")
+	s.stakingKeeper.EXPECT().GetUnbondingDelegations(gomock.Any(), to1Addr, uint16(stdmath.MaxUint16)).
+		Return([]stakingtypes.UnbondingDelegation{{DelegatorAddress: to1Addr.String(), ValidatorAddress: valAddr.String()}})
+	s.stakingKeeper.EXPECT().TransferUnbonding(gomock.Any(), to1Addr, fromAddr, valAddr, math.NewInt(80)).Return(math.NewInt(30), nil)
+	s.stakingKeeper.EXPECT().GetDelegatorDelegations(gomock.Any(), to1Addr, uint16(stdmath.MaxUint16)).
+		Return([]stakingtypes.Delegation{stakingtypes.NewDelegation(to1Addr, valAddr, math.LegacyNewDec(100))})
+	s.stakingKeeper.EXPECT().GetValidator(gomock.Any(), valAddr).Return(validator, true)
+	s.stakingKeeper.EXPECT().TransferDelegation(gomock.Any(), to1Addr, fromAddr, valAddr, math.LegacyNewDec(100)).Return(math.LegacyNewDec(100), nil)
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), to1Addr, fromAddr, sdk.NewCoins(sdk.NewInt64Coin("foo", 20))).Return(nil)
+
+	res, err := s.msgServer.Clawback(s.ctx, vestingtypes.NewMsgClawback(fromAddr, to1Addr, nil))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(fooCoin), res.Amount)
+
+	acc = s.accountKeeper.GetAccount(s.ctx, to1Addr).(*vestingtypes.ClawbackVestingAccount)
+	s.Require().True(acc.OriginalVesting.IsZero())
+	s.Require().True(acc.DelegatedVesting.IsZero())
+}
+
 func TestVestingTestSuite(t *testing.T) {
 	suite.Run(t, new(VestingTestSuite))
 }
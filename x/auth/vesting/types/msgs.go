@@ -9,10 +9,14 @@ var (
 	_ sdk.Msg = &MsgCreateVestingAccount{}
 	_ sdk.Msg = &MsgCreatePermanentLockedAccount{}
 	_ sdk.Msg = &MsgCreatePeriodicVestingAccount{}
+	_ sdk.Msg = &MsgCreateClawbackVestingAccount{}
+	_ sdk.Msg = &MsgClawback{}
 
 	_ legacytx.LegacyMsg = &MsgCreateVestingAccount{}
 	_ legacytx.LegacyMsg = &MsgCreatePermanentLockedAccount{}
 	_ legacytx.LegacyMsg = &MsgCreatePeriodicVestingAccount{}
+	_ legacytx.LegacyMsg = &MsgCreateClawbackVestingAccount{}
+	_ legacytx.LegacyMsg = &MsgClawback{}
 )
 
 // NewMsgCreateVestingAccount returns a reference to a new MsgCreateVestingAccount.
@@ -83,3 +87,51 @@ func (msg MsgCreatePeriodicVestingAccount) GetSigners() []sdk.AccAddress {
 func (msg MsgCreatePeriodicVestingAccount) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
 }
+
+// NewMsgCreateClawbackVestingAccount returns a reference to a new MsgCreateClawbackVestingAccount.
+func NewMsgCreateClawbackVestingAccount(fromAddr, toAddr sdk.AccAddress, startTime int64, periods []Period) *MsgCreateClawbackVestingAccount {
+	return &MsgCreateClawbackVestingAccount{
+		FromAddress:    fromAddr.String(),
+		ToAddress:      toAddr.String(),
+		StartTime:      startTime,
+		VestingPeriods: periods,
+	}
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgClawback returns a reference to a new MsgClawback. The clawed back
+// coins are sent to the funder if destAddr is empty.
+func NewMsgClawback(funderAddr, addr, destAddr sdk.AccAddress) *MsgClawback {
+	msg := &MsgClawback{
+		FunderAddress: funderAddr.String(),
+		Address:       addr.String(),
+	}
+	if !destAddr.Empty() {
+		msg.DestAddress = destAddr.String()
+	}
+
+	return msg
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgClawback.
+func (msg MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgClawback.
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	funder, _ := sdk.AccAddressFromBech32(msg.FunderAddress)
+	return []sdk.AccAddress{funder}
+}
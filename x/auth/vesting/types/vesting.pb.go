@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -289,6 +290,54 @@ func (m *PermanentLockedAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PermanentLockedAccount proto.InternalMessageInfo
 
+// ClawbackVestingAccount implements the VestingAccount interface. It
+// periodically vests by unlocking coins during each specified period, like a
+// PeriodicVestingAccount, and its unvested coins can be clawed back by the
+// account that funded it.
+//
+// Since: cosmos-sdk 0.50
+type ClawbackVestingAccount struct {
+	*BaseVestingAccount `protobuf:"bytes,1,opt,name=base_vesting_account,json=baseVestingAccount,proto3,embedded=base_vesting_account" json:"base_vesting_account,omitempty"`
+	// funder_address is the address of the account that funded the vesting
+	// account, and which can claw back its unvested coins.
+	FunderAddress  string   `protobuf:"bytes,2,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	StartTime      int64    `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	VestingPeriods []Period `protobuf:"bytes,4,rep,name=vesting_periods,json=vestingPeriods,proto3" json:"vesting_periods"`
+}
+
+func (m *ClawbackVestingAccount) Reset()         { *m = ClawbackVestingAccount{} }
+func (m *ClawbackVestingAccount) String() string { return proto.CompactTextString(m) }
+func (*ClawbackVestingAccount) ProtoMessage()    {}
+func (*ClawbackVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89e80273ca606d6e, []int{6}
+}
+func (m *ClawbackVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClawbackVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClawbackVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClawbackVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClawbackVestingAccount.Merge(m, src)
+}
+func (m *ClawbackVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClawbackVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClawbackVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClawbackVestingAccount proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*BaseVestingAccount)(nil), "cosmos.vesting.v1beta1.BaseVestingAccount")
 	proto.RegisterType((*ContinuousVestingAccount)(nil), "cosmos.vesting.v1beta1.ContinuousVestingAccount")
@@ -296,6 +345,7 @@ func init() {
 	proto.RegisterType((*Period)(nil), "cosmos.vesting.v1beta1.Period")
 	proto.RegisterType((*PeriodicVestingAccount)(nil), "cosmos.vesting.v1beta1.PeriodicVestingAccount")
 	proto.RegisterType((*PermanentLockedAccount)(nil), "cosmos.vesting.v1beta1.PermanentLockedAccount")
+	proto.RegisterType((*ClawbackVestingAccount)(nil), "cosmos.vesting.v1beta1.ClawbackVestingAccount")
 }
 
 func init() {
@@ -303,45 +353,50 @@ func init() {
 }
 
 var fileDescriptor_89e80273ca606d6e = []byte{
-	// 605 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x4d, 0x6b, 0x13, 0x41,
-	0x18, 0xce, 0x34, 0x35, 0xda, 0x69, 0xed, 0xc7, 0x52, 0x43, 0x5a, 0xe8, 0x26, 0xae, 0x22, 0x31,
-	0xe8, 0x2e, 0xa9, 0xb7, 0xdc, 0x4c, 0xa5, 0x20, 0x7a, 0x90, 0x20, 0x1e, 0xbc, 0x84, 0xd9, 0xdd,
-	0x71, 0x33, 0x34, 0x3b, 0x13, 0x76, 0x26, 0xc5, 0xfc, 0x83, 0x22, 0x22, 0x9e, 0xed, 0xc5, 0x93,
-	0x14, 0x4f, 0xf9, 0x19, 0x05, 0x11, 0x72, 0xf4, 0x54, 0x25, 0x39, 0xe4, 0x6f, 0xc8, 0xce, 0xcc,
-	0x6e, 0x43, 0x3e, 0x3c, 0x06, 0x2f, 0xc9, 0xbe, 0x1f, 0xf3, 0x3e, 0xcf, 0xf3, 0xe6, 0xd9, 0x0c,
-	0xbc, 0xef, 0x31, 0x1e, 0x32, 0xee, 0x9c, 0x62, 0x2e, 0x08, 0x0d, 0x9c, 0xd3, 0xaa, 0x8b, 0x05,
-	0xaa, 0x26, 0xb1, 0xdd, 0x89, 0x98, 0x60, 0x46, 0x5e, 0x75, 0xd9, 0x49, 0x56, 0x77, 0xed, 0xef,
-	0xa0, 0x90, 0x50, 0xe6, 0xc8, 0x4f, 0xd5, 0xba, 0xbf, 0x1b, 0xb0, 0x80, 0xc9, 0x47, 0x27, 0x7e,
-	0xd2, 0x59, 0x53, 0xc3, 0xb8, 0x88, 0xe3, 0x14, 0xc3, 0x63, 0x84, 0x4e, 0xd5, 0x51, 0x57, 0xb4,
-	0xd2, 0x7a, 0x1c, 0xa8, 0xba, 0xf5, 0x73, 0x15, 0x1a, 0x75, 0xc4, 0xf1, 0x1b, 0x45, 0xe0, 0xa9,
-	0xe7, 0xb1, 0x2e, 0x15, 0xc6, 0x73, 0xb8, 0x11, 0x4f, 0x6c, 0x22, 0x15, 0x17, 0x40, 0x09, 0x94,
-	0xd7, 0x0f, 0x4b, 0xb6, 0xa6, 0x2b, 0x07, 0xe8, 0x69, 0x76, 0x7c, 0x5c, 0x9f, 0xab, 0xaf, 0x0e,
-	0xae, 0x8a, 0xa0, 0xb1, 0xee, 0x5e, 0xa7, 0x8c, 0x8f, 0x00, 0x6e, 0xb3, 0x88, 0x04, 0x84, 0xa2,
-	0x76, 0x53, 0xeb, 0x2c, 0xac, 0x94, 0xb2, 0xe5, 0xf5, 0xc3, 0xbd, 0x64, 0x5e, 0xdc, 0x9f, 0xce,
-	0x3b, 0x62, 0x84, 0xd6, 0x8f, 0x2f, 0xaf, 0x8a, 0x99, 0xef, 0xbf, 0x8b, 0xe5, 0x80, 0x88, 0x56,
-	0xd7, 0xb5, 0x3d, 0x16, 0x3a, 0x5a, 0x8a, 0xfa, 0x7a, 0xcc, 0xfd, 0x13, 0x47, 0xf4, 0x3a, 0x98,
-	0xcb, 0x03, 0xfc, 0xcb, 0xb8, 0x5f, 0xd9, 0x68, 0xe3, 0x00, 0x79, 0xbd, 0x66, 0xac, 0x9f, 0x5f,
-	0x8c, 0xfb, 0x15, 0xd0, 0xd8, 0x4a, 0xa0, 0xb5, 0x40, 0xe3, 0x0c, 0xc0, 0x4d, 0x1f, 0xc7, 0x8d,
-	0x02, 0xfb, 0xcd, 0x77, 0x11, 0xc6, 0x85, 0xec, 0xb2, 0xc8, 0xdc, 0x4e, 0x81, 0x8f, 0x23, 0x8c,
-	0x8d, 0x4f, 0x00, 0xee, 0x5c, 0x53, 0x49, 0x56, 0xb3, 0xba, 0x2c, 0x36, 0xdb, 0x29, 0x76, 0xb2,
-	0x9b, 0x3d, 0x78, 0x0b, 0x53, 0xbf, 0x29, 0x48, 0x88, 0x0b, 0x37, 0x4a, 0xa0, 0x9c, 0x6d, 0xdc,
-	0xc4, 0xd4, 0x7f, 0x4d, 0x42, 0x5c, 0x7b, 0x70, 0xf6, 0xb5, 0x98, 0xf9, 0x30, 0xee, 0x57, 0x0e,
-	0x26, 0x30, 0x66, 0x8d, 0x63, 0xfd, 0x00, 0xb0, 0x70, 0xc4, 0xa8, 0x20, 0xb4, 0xcb, 0xba, 0x7c,
-	0xca, 0x55, 0x2e, 0xdc, 0x95, 0xae, 0xd2, 0x52, 0xa7, 0xdc, 0x55, 0xb1, 0xe7, 0xbf, 0x0c, 0xf6,
-	0x2c, 0x8c, 0xf6, 0x99, 0xe1, 0xce, 0x3a, 0xf7, 0x00, 0x42, 0x2e, 0x50, 0x24, 0x94, 0x8a, 0x15,
-	0xa9, 0x62, 0x4d, 0x66, 0xa4, 0x8e, 0x47, 0x89, 0x8e, 0x7b, 0x13, 0x3a, 0x16, 0x11, 0xb6, 0xbe,
-	0x01, 0x78, 0xe7, 0x19, 0x6e, 0xa3, 0x5e, 0xba, 0xa3, 0x25, 0x4a, 0xa9, 0x3d, 0x4c, 0xb8, 0x96,
-	0x26, 0xb8, 0xce, 0xa5, 0x63, 0x9d, 0x03, 0x98, 0x7b, 0x85, 0x23, 0xc2, 0x7c, 0x23, 0x0f, 0x73,
-	0x6d, 0x4c, 0x03, 0xd1, 0x92, 0x5c, 0xb2, 0x0d, 0x1d, 0x19, 0x3d, 0x98, 0x43, 0xa1, 0xe4, 0xb8,
-	0xb4, 0x97, 0x4f, 0x03, 0x5a, 0xe7, 0x2b, 0x30, 0xaf, 0xd8, 0x11, 0xef, 0xbf, 0xb3, 0x84, 0xd1,
-	0x80, 0x5b, 0x09, 0x7a, 0x47, 0x92, 0xe4, 0xfa, 0x1f, 0xc1, 0x5c, 0x84, 0xae, 0xb4, 0xd4, 0xd7,
-	0xe2, 0x35, 0x29, 0xa5, 0x9b, 0xba, 0x45, 0x55, 0x78, 0xad, 0x92, 0xfc, 0x74, 0x77, 0x27, 0x16,
-	0x36, 0x7f, 0x05, 0xd6, 0x05, 0x90, 0xdb, 0x09, 0x11, 0xc5, 0x54, 0xbc, 0x64, 0xde, 0x09, 0xf6,
-	0x97, 0xe9, 0xb2, 0x45, 0x54, 0xe7, 0xf0, 0xa9, 0xbf, 0xb8, 0x1c, 0x9a, 0x60, 0x30, 0x34, 0xc1,
-	0x9f, 0xa1, 0x09, 0x3e, 0x8f, 0xcc, 0xcc, 0x60, 0x64, 0x66, 0x7e, 0x8d, 0xcc, 0xcc, 0xdb, 0xea,
-	0x3f, 0xad, 0xf2, 0x5e, 0xdf, 0x3f, 0xfa, 0x2e, 0x94, 0xce, 0x71, 0x73, 0xf2, 0x06, 0x7a, 0xf2,
-	0x37, 0x00, 0x00, 0xff, 0xff, 0x47, 0xcb, 0xcd, 0xc9, 0x2a, 0x07, 0x00, 0x00,
+	// 681 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xce, 0x24, 0x31, 0xda, 0xe9, 0xef, 0xa5, 0x86, 0xb4, 0xd0, 0x4d, 0x5c, 0x45, 0x62, 0xb0,
+	0x1b, 0x5a, 0x6f, 0xbd, 0x48, 0x53, 0x29, 0x88, 0x1e, 0x64, 0x15, 0x0f, 0x5e, 0x96, 0xd9, 0xdd,
+	0xe9, 0x76, 0x68, 0x76, 0xa6, 0xec, 0xcc, 0x56, 0xf3, 0x1f, 0x14, 0x11, 0xf1, 0x6c, 0x2f, 0x9e,
+	0xa4, 0x78, 0xea, 0xc1, 0x3f, 0xa2, 0x20, 0x42, 0xf1, 0xe4, 0x41, 0xaa, 0xb4, 0x87, 0xfe, 0x1b,
+	0xb2, 0x33, 0xb3, 0x69, 0x4c, 0x53, 0xf1, 0x62, 0xf4, 0x92, 0xec, 0xbc, 0xf7, 0xe6, 0x7d, 0xdf,
+	0xf7, 0xe6, 0x9b, 0x6c, 0xe0, 0x0d, 0x9f, 0xf1, 0x88, 0xf1, 0xe6, 0x36, 0xe6, 0x82, 0xd0, 0xb0,
+	0xb9, 0xbd, 0xe8, 0x61, 0x81, 0x16, 0xb3, 0xb5, 0xbd, 0x15, 0x33, 0xc1, 0x8c, 0xb2, 0xaa, 0xb2,
+	0xb3, 0xa8, 0xae, 0x9a, 0x9b, 0x46, 0x11, 0xa1, 0xac, 0x29, 0x3f, 0x55, 0xe9, 0xdc, 0x4c, 0xc8,
+	0x42, 0x26, 0x1f, 0x9b, 0xe9, 0x93, 0x8e, 0xce, 0xaa, 0x06, 0xae, 0x4a, 0xe8, 0x6e, 0x2a, 0x65,
+	0x6a, 0x06, 0x1e, 0xe2, 0xb8, 0x0b, 0xef, 0x33, 0x42, 0xfb, 0xf2, 0x28, 0x11, 0x1b, 0xdd, 0x7c,
+	0xba, 0x50, 0x79, 0xeb, 0x73, 0x11, 0x1a, 0x2d, 0xc4, 0xf1, 0x53, 0xc5, 0x6d, 0xc5, 0xf7, 0x59,
+	0x42, 0x85, 0x71, 0x1f, 0x8e, 0xa5, 0x1d, 0x5d, 0xa4, 0xd6, 0x15, 0x50, 0x03, 0xf5, 0xd1, 0xa5,
+	0x9a, 0xad, 0xb1, 0x65, 0x03, 0xdd, 0xcd, 0x4e, 0xb7, 0xeb, 0x7d, 0xad, 0xe2, 0xe1, 0x51, 0x15,
+	0x38, 0xa3, 0xde, 0x59, 0xc8, 0x78, 0x05, 0xe0, 0x14, 0x8b, 0x49, 0x48, 0x28, 0x6a, 0xbb, 0x7a,
+	0x04, 0x95, 0x7c, 0xad, 0x50, 0x1f, 0x5d, 0x9a, 0xcd, 0xfa, 0xa5, 0xf5, 0xdd, 0x7e, 0xab, 0x8c,
+	0xd0, 0xd6, 0xda, 0xc1, 0x51, 0x35, 0xf7, 0xe1, 0x7b, 0xb5, 0x1e, 0x12, 0xb1, 0x91, 0x78, 0xb6,
+	0xcf, 0x22, 0x2d, 0x5c, 0x7f, 0x2d, 0xf0, 0x60, 0xb3, 0x29, 0x3a, 0x5b, 0x98, 0xcb, 0x0d, 0xfc,
+	0xed, 0xe9, 0x7e, 0x63, 0xac, 0x8d, 0x43, 0xe4, 0x77, 0xdc, 0x54, 0x3f, 0xdf, 0x3b, 0xdd, 0x6f,
+	0x00, 0x67, 0x32, 0x83, 0xd6, 0x02, 0x8d, 0x1d, 0x00, 0x27, 0x02, 0x9c, 0x16, 0x0a, 0x1c, 0xb8,
+	0xeb, 0x31, 0xc6, 0x95, 0xc2, 0xb0, 0xc8, 0x8c, 0x77, 0x81, 0xd7, 0x62, 0x8c, 0x8d, 0xd7, 0x00,
+	0x4e, 0x9f, 0x51, 0xc9, 0x46, 0x53, 0x1c, 0x16, 0x9b, 0xa9, 0x2e, 0x76, 0x36, 0x9b, 0x59, 0x78,
+	0x05, 0xd3, 0xc0, 0x15, 0x24, 0xc2, 0x95, 0x4b, 0x35, 0x50, 0x2f, 0x38, 0x97, 0x31, 0x0d, 0x9e,
+	0x90, 0x08, 0x2f, 0xdf, 0xdc, 0x79, 0x57, 0xcd, 0xbd, 0x3c, 0xdd, 0x6f, 0xcc, 0xf7, 0x60, 0x9c,
+	0x37, 0x8e, 0xf5, 0x09, 0xc0, 0xca, 0x2a, 0xa3, 0x82, 0xd0, 0x84, 0x25, 0xbc, 0xcf, 0x55, 0x1e,
+	0x9c, 0x91, 0xae, 0xd2, 0x52, 0xfb, 0xdc, 0xd5, 0xb0, 0x07, 0xdf, 0x13, 0xfb, 0x3c, 0x8c, 0xf6,
+	0x99, 0xe1, 0x9d, 0x77, 0xee, 0x3c, 0x84, 0x5c, 0xa0, 0x58, 0x28, 0x15, 0x79, 0xa9, 0x62, 0x44,
+	0x46, 0xa4, 0x8e, 0xdb, 0x99, 0x8e, 0xeb, 0x3d, 0x3a, 0x2e, 0x22, 0x6c, 0xbd, 0x07, 0xf0, 0xea,
+	0x3d, 0xdc, 0x46, 0x1d, 0x1c, 0xfc, 0x9a, 0x19, 0x86, 0x94, 0xe5, 0x5b, 0x19, 0xd7, 0x5a, 0x0f,
+	0xd7, 0x81, 0x74, 0xac, 0x5d, 0x00, 0x4b, 0x8f, 0x70, 0x4c, 0x58, 0x60, 0x94, 0x61, 0xa9, 0x8d,
+	0x69, 0x28, 0x36, 0x24, 0x97, 0x82, 0xa3, 0x57, 0x46, 0x07, 0x96, 0x50, 0x24, 0x39, 0x0e, 0xed,
+	0xf2, 0x69, 0x40, 0x6b, 0x37, 0x0f, 0xcb, 0x8a, 0x1d, 0xf1, 0xff, 0x3b, 0x4b, 0x18, 0x0e, 0x9c,
+	0xcc, 0xd0, 0xb7, 0x24, 0x49, 0xae, 0x7f, 0x11, 0xcc, 0x8b, 0xd0, 0x95, 0x96, 0xd6, 0x48, 0x3a,
+	0x26, 0xa5, 0x74, 0x42, 0x97, 0xa8, 0x0c, 0x5f, 0x6e, 0x64, 0x47, 0x77, 0xad, 0x67, 0x60, 0x83,
+	0x47, 0x60, 0xed, 0x01, 0x39, 0x9d, 0x08, 0x51, 0x4c, 0xc5, 0x43, 0xe6, 0x6f, 0xe2, 0x60, 0x98,
+	0x2e, 0xbb, 0x88, 0xea, 0x00, 0x3e, 0xd6, 0xb7, 0x3c, 0x2c, 0xaf, 0xb6, 0xd1, 0x73, 0x0f, 0xf9,
+	0x9b, 0xff, 0xe0, 0x20, 0xef, 0xc2, 0x89, 0xf5, 0x84, 0x06, 0x38, 0x76, 0x51, 0x10, 0xc4, 0x98,
+	0x73, 0x79, 0x98, 0x23, 0xad, 0xca, 0x97, 0x8f, 0x0b, 0x33, 0x1a, 0x60, 0x45, 0x65, 0x1e, 0x8b,
+	0x98, 0xd0, 0xd0, 0x19, 0x57, 0xf5, 0x3a, 0xd8, 0xe7, 0x84, 0xc2, 0x1f, 0x38, 0xa1, 0xf8, 0x57,
+	0x9c, 0x30, 0x78, 0x86, 0xad, 0x07, 0x07, 0xc7, 0x26, 0x38, 0x3c, 0x36, 0xc1, 0x8f, 0x63, 0x13,
+	0xbc, 0x39, 0x31, 0x73, 0x87, 0x27, 0x66, 0xee, 0xeb, 0x89, 0x99, 0x7b, 0xb6, 0xf8, 0xdb, 0x9b,
+	0xf8, 0x42, 0xbf, 0xde, 0xf5, 0xbf, 0x10, 0x79, 0x31, 0xbd, 0x92, 0x7c, 0xc1, 0xdf, 0xf9, 0x39,
+	0x00, 0x31, 0x5f, 0x59, 0x01, 0xa4, 0x08, 0x00, 0x00,
 }
 
 func (m *BaseVestingAccount) Marshal() (dAtA []byte, err error) {
@@ -632,6 +687,67 @@ func (m *PermanentLockedAccount) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClawbackVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BaseVestingAccount != nil {
+		{
+			size, err := m.BaseVestingAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVesting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVesting(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVesting(v)
 	base := offset
@@ -759,6 +875,32 @@ func (m *PermanentLockedAccount) Size() (n int) {
 	return n
 }
 
+func (m *ClawbackVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseVestingAccount != nil {
+		l = m.BaseVestingAccount.Size()
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovVesting(uint64(m.StartTime))
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovVesting(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1491,6 +1633,177 @@ func (m *PermanentLockedAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClawbackVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseVestingAccount == nil {
+				m.BaseVestingAccount = &BaseVestingAccount{}
+			}
+			if err := m.BaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipVesting(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
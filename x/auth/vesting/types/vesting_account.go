@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"cosmossdk.io/math"
@@ -486,3 +487,111 @@ func (plva PermanentLockedAccount) Validate() error {
 
 	return plva.BaseVestingAccount.Validate()
 }
+
+//-----------------------------------------------------------------------------
+// Clawback Vesting Account
+
+var (
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+	_ authtypes.GenesisAccount    = (*ClawbackVestingAccount)(nil)
+)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount, whose unvested
+// coins can be clawed back by funder.
+func NewClawbackVestingAccount(baseAcc *authtypes.BaseAccount, funder sdk.AccAddress, originalVesting sdk.Coins, startTime int64, periods Periods) *ClawbackVestingAccount {
+	pva := NewPeriodicVestingAccount(baseAcc, originalVesting, startTime, periods)
+
+	return &ClawbackVestingAccount{
+		BaseVestingAccount: pva.BaseVestingAccount,
+		FunderAddress:      funder.String(),
+		StartTime:          startTime,
+		VestingPeriods:     periods,
+	}
+}
+
+// periodic returns the PeriodicVestingAccount following the same vesting
+// schedule as the clawback vesting account.
+func (cva ClawbackVestingAccount) periodic() *PeriodicVestingAccount {
+	return NewPeriodicVestingAccountRaw(cva.BaseVestingAccount, cva.StartTime, cva.VestingPeriods)
+}
+
+// GetVestedCoins returns the total number of vested coins. If no coins are vested,
+// nil is returned.
+func (cva ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	return cva.periodic().GetVestedCoins(blockTime)
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (cva ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return cva.OriginalVesting.Sub(cva.GetVestedCoins(blockTime)...)
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked),
+// defined as the vesting coins that are not delegated.
+func (cva ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return cva.BaseVestingAccount.LockedCoinsFromVesting(cva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (cva *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	cva.BaseVestingAccount.TrackDelegation(balance, cva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a clawback vesting
+// account.
+func (cva ClawbackVestingAccount) GetStartTime() int64 {
+	return cva.StartTime
+}
+
+// GetVestingPeriods returns vesting periods associated with clawback vesting account.
+func (cva ClawbackVestingAccount) GetVestingPeriods() Periods {
+	return cva.VestingPeriods
+}
+
+// GetFunderAddress returns the address of the account which can claw back the
+// unvested coins.
+func (cva ClawbackVestingAccount) GetFunderAddress() string {
+	return cva.FunderAddress
+}
+
+// Clawback removes the locked coins, i.e. the unvested coins which are not
+// delegated, and the given delegated coins from the vesting schedule and
+// returns them. They are removed from the latest periods first. The caller is
+// responsible for moving the returned coins out of the account, the delegated
+// ones by transferring the delegations of the account, which must not exceed
+// its delegated vesting coins.
+func (cva *ClawbackVestingAccount) Clawback(blockTime time.Time, delegated sdk.Coins) sdk.Coins {
+	clawback := cva.LockedCoins(blockTime).Add(delegated...)
+	if clawback.IsZero() {
+		return clawback
+	}
+
+	// copy the periods, which may be shared with the caller
+	periods := make(Periods, len(cva.VestingPeriods))
+	copy(periods, cva.VestingPeriods)
+
+	remaining := clawback
+	for i := len(periods) - 1; i >= 0 && !remaining.IsZero(); i-- {
+		removed := periods[i].Amount.Min(remaining)
+		periods[i].Amount = periods[i].Amount.Sub(removed...)
+		remaining = remaining.Sub(removed...)
+	}
+
+	cva.VestingPeriods = periods
+	cva.OriginalVesting = cva.OriginalVesting.Sub(clawback...)
+	cva.DelegatedVesting = cva.DelegatedVesting.Sub(delegated...)
+
+	return clawback
+}
+
+// Validate checks for errors on the account fields
+func (cva ClawbackVestingAccount) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(cva.FunderAddress); err != nil {
+		return fmt.Errorf("invalid funder address: %w", err)
+	}
+
+	return cva.periodic().Validate()
+}
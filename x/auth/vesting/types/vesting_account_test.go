@@ -780,6 +780,89 @@ func TestGenesisAccountValidate(t *testing.T) {
 	}
 }
 
+func TestGetVestedCoinsClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	_, _, funder := testdata.KeyTestPubAddr()
+	bacc, origCoins := initBaseAccount()
+	cva := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods)
+	require.NoError(t, cva.Validate())
+
+	// require no coins vested at the beginning of the vesting schedule
+	require.Nil(t, cva.GetVestedCoins(now))
+	require.Equal(t, origCoins, cva.GetVestingCoins(now))
+
+	// require 75% of coins vested after period 2
+	vestedCoins := cva.GetVestedCoins(now.Add(18 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 750), sdk.NewInt64Coin(stakeDenom, 75)}, vestedCoins)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}, cva.LockedCoins(now.Add(18*time.Hour)))
+
+	// require all coins vested at the end of the vesting schedule
+	require.Equal(t, origCoins, cva.GetVestedCoins(now.Add(24*time.Hour)))
+}
+
+func TestClawbackClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+	_, _, funder := testdata.KeyTestPubAddr()
+
+	// require all coins clawed back before the start of the vesting schedule
+	bacc, origCoins := initBaseAccount()
+	cva := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods)
+	require.Equal(t, origCoins, cva.Clawback(now, nil))
+	require.True(t, cva.OriginalVesting.IsZero())
+	require.NoError(t, cva.Validate())
+
+	// require the vested coins are kept after period 1
+	bacc, origCoins = initBaseAccount()
+	cva = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods)
+	clawback := cva.Clawback(now.Add(12*time.Hour), nil)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, clawback)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, cva.OriginalVesting)
+	require.Equal(t, cva.OriginalVesting, cva.GetVestedCoins(now.Add(12*time.Hour)))
+	require.True(t, cva.LockedCoins(now.Add(12*time.Hour)).IsZero())
+	require.NoError(t, cva.Validate())
+
+	// require the delegated vesting coins are not clawed back unless given, and
+	// are removed from the latest periods last
+	bacc, origCoins = initBaseAccount()
+	cva = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods)
+	cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 30)})
+	clawback = cva.Clawback(now.Add(12*time.Hour), nil)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 20)}, clawback)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, cva.VestingPeriods[1].Amount)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 5)}, cva.VestingPeriods[2].Amount)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 30)}, cva.GetVestingCoins(now.Add(12*time.Hour)))
+	require.True(t, cva.LockedCoins(now.Add(12*time.Hour)).IsZero())
+	require.NoError(t, cva.Validate())
+
+	// require the given delegated vesting coins are clawed back too
+	bacc, origCoins = initBaseAccount()
+	cva = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods)
+	cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 30)})
+	clawback = cva.Clawback(now.Add(12*time.Hour), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 20)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 40)}, clawback)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 10)}, cva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 10)}, cva.GetVestingCoins(now.Add(12*time.Hour)))
+	require.True(t, cva.LockedCoins(now.Add(12*time.Hour)).IsZero())
+	require.NoError(t, cva.Validate())
+
+	// require nothing is clawed back at the end of the vesting schedule
+	bacc, origCoins = initBaseAccount()
+	cva = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods)
+	require.True(t, cva.Clawback(now.Add(24*time.Hour), nil).IsZero())
+	require.Equal(t, origCoins, cva.OriginalVesting)
+}
+
 func (s *VestingAccountTestSuite) TestContinuousVestingAccountMarshal() {
 	require := s.Require()
 	baseAcc, coins := initBaseAccount()
@@ -817,6 +900,25 @@ func (s *VestingAccountTestSuite) TestPeriodicVestingAccountMarshal() {
 	require.NotNil(err)
 }
 
+func (s *VestingAccountTestSuite) TestClawbackVestingAccountMarshal() {
+	require := s.Require()
+	baseAcc, coins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	acc := types.NewClawbackVestingAccount(baseAcc, funder, coins, time.Now().Unix(), types.Periods{types.Period{3600, coins}})
+
+	bz, err := s.accountKeeper.MarshalAccount(acc)
+	require.Nil(err)
+
+	acc2, err := s.accountKeeper.UnmarshalAccount(bz)
+	require.Nil(err)
+	require.IsType(&types.ClawbackVestingAccount{}, acc2)
+	require.Equal(acc.String(), acc2.String())
+
+	// error on bad bytes
+	_, err = s.accountKeeper.UnmarshalAccount(bz[:len(bz)/2])
+	require.NotNil(err)
+}
+
 func (s *VestingAccountTestSuite) TestDelayedVestingAccountMarshal() {
 	require := s.Require()
 	baseAcc, coins := initBaseAccount()
@@ -6,7 +6,9 @@ package authz
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -70,10 +72,105 @@ func (m *GenericAuthorization) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenericAuthorization proto.InternalMessageInfo
 
+// RulesAuthorization gives the grantee permissions to execute the provided
+// method on behalf of the granter's account, as long as the fields of the
+// executed message satisfy all the rules of the authorization.
+//
+// Since: cosmos-sdk 0.50
+type RulesAuthorization struct {
+	// Msg, identified by it's type URL, to grant permissions to execute
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// rules are the constraints on the fields of the executed message.
+	Rules []FieldRule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules"`
+}
+
+func (m *RulesAuthorization) Reset()         { *m = RulesAuthorization{} }
+func (m *RulesAuthorization) String() string { return proto.CompactTextString(m) }
+func (*RulesAuthorization) ProtoMessage()    {}
+func (*RulesAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{1}
+}
+func (m *RulesAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RulesAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RulesAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RulesAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RulesAuthorization.Merge(m, src)
+}
+func (m *RulesAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *RulesAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_RulesAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RulesAuthorization proto.InternalMessageInfo
+
+// FieldRule constrains a field of a message executed with a RulesAuthorization.
+//
+// Since: cosmos-sdk 0.50
+type FieldRule struct {
+	// field is the proto JSON name of the constrained field. Fields of nested
+	// messages are separated by dots, e.g. "amount" or "description.moniker".
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// allowed_values is the list of values the field can take. If the field is
+	// a list, all its values must be allowed.
+	AllowedValues []string `protobuf:"bytes,2,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"`
+	// max_coins is the maximum total amount the field can hold in the messages
+	// executed with the authorization. The amount of the field is deducted from
+	// it for each message, and the authorization is deleted once it is spent.
+	// The field must be a coin or a list of coins.
+	MaxCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=max_coins,json=maxCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_coins"`
+}
+
+func (m *FieldRule) Reset()         { *m = FieldRule{} }
+func (m *FieldRule) String() string { return proto.CompactTextString(m) }
+func (*FieldRule) ProtoMessage()    {}
+func (*FieldRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{2}
+}
+func (m *FieldRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FieldRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FieldRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FieldRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldRule.Merge(m, src)
+}
+func (m *FieldRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *FieldRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldRule proto.InternalMessageInfo
+
 // Grant gives permissions to execute
 // the provide method with expiration time.
 type Grant struct {
-	Authorization *types.Any `protobuf:"bytes,1,opt,name=authorization,proto3" json:"authorization,omitempty"`
+	Authorization *types1.Any `protobuf:"bytes,1,opt,name=authorization,proto3" json:"authorization,omitempty"`
 	// time when the grant will expire and will be pruned. If null, then the grant
 	// doesn't have a time expiration (other conditions  in `authorization`
 	// may apply to invalidate the grant)
@@ -84,7 +181,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{1}
+	return fileDescriptor_544dc2e84b61c637, []int{3}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -116,17 +213,17 @@ var xxx_messageInfo_Grant proto.InternalMessageInfo
 // GrantAuthorization extends a grant with both the addresses of the grantee and granter.
 // It is used in genesis.proto and query.proto
 type GrantAuthorization struct {
-	Granter       string     `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
-	Grantee       string     `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
-	Authorization *types.Any `protobuf:"bytes,3,opt,name=authorization,proto3" json:"authorization,omitempty"`
-	Expiration    *time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	Granter       string      `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	Grantee       string      `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	Authorization *types1.Any `protobuf:"bytes,3,opt,name=authorization,proto3" json:"authorization,omitempty"`
+	Expiration    *time.Time  `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
 }
 
 func (m *GrantAuthorization) Reset()         { *m = GrantAuthorization{} }
 func (m *GrantAuthorization) String() string { return proto.CompactTextString(m) }
 func (*GrantAuthorization) ProtoMessage()    {}
 func (*GrantAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{2}
+	return fileDescriptor_544dc2e84b61c637, []int{4}
 }
 func (m *GrantAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -165,7 +262,7 @@ func (m *GrantQueueItem) Reset()         { *m = GrantQueueItem{} }
 func (m *GrantQueueItem) String() string { return proto.CompactTextString(m) }
 func (*GrantQueueItem) ProtoMessage()    {}
 func (*GrantQueueItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{3}
+	return fileDescriptor_544dc2e84b61c637, []int{5}
 }
 func (m *GrantQueueItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -196,6 +293,8 @@ var xxx_messageInfo_GrantQueueItem proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*GenericAuthorization)(nil), "cosmos.authz.v1beta1.GenericAuthorization")
+	proto.RegisterType((*RulesAuthorization)(nil), "cosmos.authz.v1beta1.RulesAuthorization")
+	proto.RegisterType((*FieldRule)(nil), "cosmos.authz.v1beta1.FieldRule")
 	proto.RegisterType((*Grant)(nil), "cosmos.authz.v1beta1.Grant")
 	proto.RegisterType((*GrantAuthorization)(nil), "cosmos.authz.v1beta1.GrantAuthorization")
 	proto.RegisterType((*GrantQueueItem)(nil), "cosmos.authz.v1beta1.GrantQueueItem")
@@ -204,35 +303,45 @@ func init() {
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/authz.proto", fileDescriptor_544dc2e84b61c637) }
 
 var fileDescriptor_544dc2e84b61c637 = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x3f, 0x8f, 0xd3, 0x30,
-	0x18, 0xc6, 0xe3, 0xeb, 0xf1, 0xe7, 0x7c, 0x3a, 0x04, 0x51, 0x86, 0xd2, 0x21, 0xa9, 0x22, 0x84,
-	0x4e, 0x48, 0x8d, 0x75, 0x07, 0x13, 0x13, 0x8d, 0x90, 0x4e, 0xb0, 0x11, 0x8e, 0x85, 0xa5, 0x72,
-	0x5a, 0xe3, 0x5a, 0xd4, 0x71, 0x64, 0x3b, 0xe8, 0x72, 0x1f, 0x81, 0xe9, 0x3e, 0x03, 0x9f, 0x00,
-	0xa4, 0x7e, 0x88, 0x8a, 0xa9, 0x62, 0x62, 0xe2, 0x4f, 0x3b, 0xf0, 0x35, 0x50, 0xed, 0x44, 0x34,
-	0xb4, 0x12, 0x1d, 0x58, 0x22, 0xdb, 0xef, 0xf3, 0xbc, 0xef, 0x93, 0x5f, 0x62, 0xd8, 0x1d, 0x0a,
-	0xc5, 0x85, 0x42, 0xb8, 0xd0, 0xe3, 0x4b, 0xf4, 0xee, 0x24, 0x25, 0x1a, 0x9f, 0xd8, 0x5d, 0x94,
-	0x4b, 0xa1, 0x85, 0xeb, 0x59, 0x45, 0x64, 0xcf, 0x2a, 0x45, 0xe7, 0x0e, 0xe6, 0x2c, 0x13, 0xc8,
-	0x3c, 0xad, 0xb0, 0x73, 0xd7, 0x0a, 0x07, 0x66, 0x87, 0x2a, 0x97, 0x2d, 0x05, 0x54, 0x08, 0x3a,
-	0x21, 0xc8, 0xec, 0xd2, 0xe2, 0x0d, 0xd2, 0x8c, 0x13, 0xa5, 0x31, 0xcf, 0x2b, 0x81, 0x47, 0x05,
-	0x15, 0xd6, 0xb8, 0x5a, 0xd5, 0x1d, 0xff, 0xb6, 0xe1, 0xac, 0xb4, 0xa5, 0x50, 0x43, 0xef, 0x8c,
-	0x64, 0x44, 0xb2, 0x61, 0xbf, 0xd0, 0x63, 0x21, 0xd9, 0x25, 0xd6, 0x4c, 0x64, 0xee, 0x6d, 0xd8,
-	0xe2, 0x8a, 0xb6, 0x41, 0x17, 0x1c, 0x1f, 0x24, 0xab, 0xe5, 0xe3, 0xe7, 0x9f, 0xa7, 0xbd, 0x70,
-	0xdb, 0x3b, 0x44, 0x0d, 0xe7, 0xfb, 0x5f, 0x1f, 0x1f, 0x04, 0x56, 0xd6, 0x53, 0xa3, 0xb7, 0x68,
-	0x5b, 0xf7, 0xf0, 0x13, 0x80, 0xd7, 0xce, 0x24, 0xce, 0xb4, 0x9b, 0xc2, 0x23, 0xbc, 0x5e, 0x32,
-	0x13, 0x0f, 0x4f, 0xbd, 0xc8, 0x46, 0x8e, 0xea, 0xc8, 0x51, 0x3f, 0x2b, 0xe3, 0xfb, 0xbb, 0x45,
-	0x48, 0x9a, 0x2d, 0xdd, 0xa7, 0x10, 0x92, 0x8b, 0x9c, 0x49, 0x3b, 0x60, 0xcf, 0x0c, 0xe8, 0x6c,
-	0x0c, 0x38, 0xaf, 0x51, 0xc6, 0x37, 0x67, 0xdf, 0x02, 0x70, 0xf5, 0x3d, 0x00, 0xc9, 0x9a, 0x2f,
-	0xfc, 0xb0, 0x07, 0x5d, 0x93, 0xb9, 0x09, 0xea, 0x14, 0xde, 0xa0, 0xab, 0x53, 0x22, 0x2d, 0xac,
-	0xb8, 0xfd, 0x65, 0xda, 0xab, 0xbf, 0x75, 0x7f, 0x34, 0x92, 0x44, 0xa9, 0x97, 0x5a, 0xb2, 0x8c,
-	0x26, 0xb5, 0xf0, 0x8f, 0x87, 0x98, 0x34, 0x3b, 0x78, 0xc8, 0x26, 0xa8, 0xd6, 0xff, 0x07, 0xf5,
-	0xa4, 0x01, 0x6a, 0xff, 0x9f, 0xa0, 0xf6, 0x37, 0x20, 0x3d, 0x82, 0xb7, 0x0c, 0xa3, 0x17, 0x05,
-	0x29, 0xc8, 0x33, 0x4d, 0xb8, 0x1b, 0xc2, 0x23, 0xae, 0xe8, 0x40, 0x97, 0x39, 0x19, 0x14, 0x72,
-	0xa2, 0xda, 0xa0, 0xdb, 0x3a, 0x3e, 0x48, 0x0e, 0xb9, 0xa2, 0xe7, 0x65, 0x4e, 0x5e, 0xc9, 0x89,
-	0x8a, 0xe3, 0xd9, 0x4f, 0xdf, 0x99, 0x2d, 0x7c, 0x30, 0x5f, 0xf8, 0xe0, 0xc7, 0xc2, 0x07, 0x57,
-	0x4b, 0xdf, 0x99, 0x2f, 0x7d, 0xe7, 0xeb, 0xd2, 0x77, 0x5e, 0xdf, 0xa3, 0x4c, 0x8f, 0x8b, 0x34,
-	0x1a, 0x0a, 0x5e, 0xdd, 0x06, 0xb4, 0xf6, 0x7f, 0x5d, 0xd8, 0x4b, 0x96, 0x5e, 0x37, 0xf9, 0x1e,
-	0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x50, 0x89, 0xaf, 0x02, 0x89, 0x03, 0x00, 0x00,
+	// 608 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x31, 0x6f, 0xd3, 0x4e,
+	0x1c, 0xcd, 0x25, 0xed, 0xff, 0xdf, 0x5c, 0x48, 0x05, 0x56, 0x06, 0x37, 0x12, 0x76, 0x64, 0x01,
+	0x8a, 0x22, 0xc5, 0x56, 0x03, 0x53, 0xa7, 0xc6, 0xa0, 0x16, 0xd8, 0x30, 0x85, 0x81, 0x25, 0x3a,
+	0x27, 0x57, 0xc7, 0xc2, 0xf6, 0x45, 0xbe, 0x73, 0x49, 0x3a, 0xf0, 0x01, 0x98, 0x3a, 0x33, 0x32,
+	0x21, 0xa6, 0x80, 0xfa, 0x21, 0x22, 0xa6, 0x8a, 0x89, 0xa9, 0x85, 0x64, 0xc8, 0xd7, 0x40, 0xbe,
+	0xb3, 0x43, 0x42, 0x22, 0x9a, 0x81, 0x25, 0xba, 0xdf, 0xdd, 0x7b, 0xf7, 0x7b, 0xbf, 0x77, 0x2f,
+	0x86, 0x95, 0x36, 0xa1, 0x3e, 0xa1, 0x06, 0x8a, 0x58, 0xf7, 0xd4, 0x38, 0xd9, 0xb5, 0x31, 0x43,
+	0xbb, 0xa2, 0xd2, 0x7b, 0x21, 0x61, 0x44, 0x2a, 0x09, 0x84, 0x2e, 0xf6, 0x12, 0x44, 0xf9, 0x16,
+	0xf2, 0xdd, 0x80, 0x18, 0xfc, 0x57, 0x00, 0xcb, 0x3b, 0x02, 0xd8, 0xe2, 0x95, 0x91, 0xb0, 0xc4,
+	0x91, 0xea, 0x10, 0xe2, 0x78, 0xd8, 0xe0, 0x95, 0x1d, 0x1d, 0x1b, 0xcc, 0xf5, 0x31, 0x65, 0xc8,
+	0xef, 0x25, 0x80, 0x92, 0x43, 0x1c, 0x22, 0x88, 0xf1, 0x2a, 0xbd, 0xf1, 0x4f, 0x1a, 0x0a, 0x06,
+	0xc9, 0x91, 0x92, 0xe8, 0xb6, 0x11, 0xc5, 0x33, 0xd9, 0x6d, 0xe2, 0x06, 0xe2, 0x5c, 0x63, 0xb0,
+	0x74, 0x88, 0x03, 0x1c, 0xba, 0xed, 0x66, 0xc4, 0xba, 0x24, 0x74, 0x4f, 0x11, 0x73, 0x49, 0x20,
+	0xdd, 0x84, 0x39, 0x9f, 0x3a, 0x32, 0xa8, 0x80, 0x6a, 0xde, 0x8a, 0x97, 0x7b, 0x4f, 0xbf, 0x9e,
+	0xd7, 0xb5, 0x55, 0x33, 0xea, 0x0b, 0xcc, 0x77, 0xd3, 0x61, 0x4d, 0x15, 0xb0, 0x3a, 0xed, 0xbc,
+	0x36, 0x56, 0xdd, 0xae, 0x7d, 0x01, 0x50, 0xb2, 0x22, 0x0f, 0xd3, 0x6b, 0x9a, 0x4a, 0xfb, 0x70,
+	0x33, 0x8c, 0x71, 0x72, 0xb6, 0x92, 0xab, 0x16, 0x1a, 0xaa, 0xbe, 0x52, 0xc0, 0x81, 0x8b, 0xbd,
+	0x4e, 0x7c, 0x9f, 0x99, 0x1f, 0x5d, 0xaa, 0x99, 0x8f, 0xd3, 0x61, 0x0d, 0x58, 0x82, 0xb8, 0xf7,
+	0x78, 0x7d, 0xd9, 0xb7, 0xe7, 0x64, 0x2f, 0xab, 0xd3, 0x46, 0x00, 0xe6, 0x67, 0x9d, 0xa4, 0x12,
+	0xdc, 0x3c, 0x8e, 0x8b, 0x44, 0xad, 0x28, 0xa4, 0xbb, 0x70, 0x1b, 0x79, 0x1e, 0x79, 0x83, 0x3b,
+	0xad, 0x13, 0xe4, 0x45, 0x89, 0xf0, 0xbc, 0x55, 0x4c, 0x76, 0x5f, 0xf2, 0x4d, 0xe9, 0x2d, 0xcc,
+	0xfb, 0xa8, 0xdf, 0x8a, 0xdf, 0x81, 0xca, 0x39, 0x3e, 0xda, 0x4e, 0x3a, 0x5a, 0xfc, 0x52, 0x33,
+	0x8d, 0x0f, 0x89, 0x1b, 0x98, 0x07, 0xf1, 0x50, 0x9f, 0xae, 0xd4, 0xaa, 0xe3, 0xb2, 0x6e, 0x64,
+	0xeb, 0x6d, 0xe2, 0x27, 0xb1, 0x31, 0xe6, 0x14, 0xb3, 0x41, 0x0f, 0x53, 0x4e, 0xa0, 0xef, 0xa7,
+	0xc3, 0xda, 0x0d, 0x0f, 0x3b, 0xa8, 0x3d, 0x10, 0x3d, 0x84, 0x23, 0x5b, 0x3e, 0xea, 0xf3, 0x73,
+	0xed, 0x33, 0x80, 0x9b, 0x87, 0x21, 0x0a, 0x98, 0x64, 0xc3, 0x22, 0x9a, 0x9f, 0x92, 0x8f, 0x53,
+	0x68, 0x94, 0x74, 0x11, 0x29, 0x3d, 0x8d, 0x94, 0xde, 0x0c, 0x06, 0xe6, 0xbd, 0xf5, 0xbc, 0xb4,
+	0x16, 0xaf, 0x94, 0x1e, 0x41, 0x88, 0xfb, 0x3d, 0x37, 0x14, 0x0d, 0xb2, 0xbc, 0x41, 0x79, 0xa9,
+	0xc1, 0x51, 0x1a, 0x75, 0x73, 0x6b, 0x74, 0xa9, 0x82, 0xb3, 0x2b, 0x15, 0x58, 0x73, 0x3c, 0xed,
+	0x43, 0x16, 0x4a, 0x5c, 0xf3, 0x62, 0x66, 0x1a, 0xf0, 0x7f, 0x27, 0xde, 0xc5, 0xa1, 0x78, 0x09,
+	0x53, 0xfe, 0x76, 0x5e, 0x4f, 0xff, 0x8b, 0xcd, 0x4e, 0x27, 0xc4, 0x94, 0x3e, 0x67, 0xa1, 0x1b,
+	0x38, 0x56, 0x0a, 0xfc, 0xcd, 0xc1, 0x72, 0x76, 0x3d, 0x0e, 0x5e, 0x36, 0x2a, 0xf7, 0xef, 0x8d,
+	0xda, 0x5f, 0x30, 0x6a, 0xe3, 0x5a, 0xa3, 0x36, 0x96, 0x4c, 0x7a, 0x00, 0xb7, 0xb9, 0x47, 0xcf,
+	0x22, 0x1c, 0xe1, 0x27, 0x0c, 0xfb, 0x92, 0x06, 0x8b, 0x3e, 0x75, 0x5a, 0x71, 0x3a, 0x5a, 0x51,
+	0xe8, 0x51, 0x19, 0xf0, 0x40, 0x16, 0x7c, 0xea, 0x1c, 0x0d, 0x7a, 0xf8, 0x45, 0xe8, 0x51, 0xd3,
+	0x1c, 0xfd, 0x54, 0x32, 0xa3, 0xb1, 0x02, 0x2e, 0xc6, 0x0a, 0xf8, 0x31, 0x56, 0xc0, 0xd9, 0x44,
+	0xc9, 0x5c, 0x4c, 0x94, 0xcc, 0xf7, 0x89, 0x92, 0x79, 0x75, 0xe7, 0xaf, 0xb1, 0xeb, 0x8b, 0x8f,
+	0xa0, 0xfd, 0x1f, 0xd7, 0x77, 0xff, 0xd7, 0x00, 0xdb, 0xf9, 0xe3, 0x13, 0x29, 0x05, 0x00, 0x00,
 }
 
 func (m *GenericAuthorization) Marshal() (dAtA []byte, err error) {
@@ -265,6 +374,103 @@ func (m *GenericAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RulesAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RulesAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RulesAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FieldRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FieldRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxCoins) > 0 {
+		for iNdEx := len(m.MaxCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedValues) > 0 {
+		for iNdEx := len(m.AllowedValues) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedValues[iNdEx])
+			copy(dAtA[i:], m.AllowedValues[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedValues[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -425,6 +631,50 @@ func (m *GenericAuthorization) Size() (n int) {
 	return n
 }
 
+func (m *RulesAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FieldRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.AllowedValues) > 0 {
+		for _, s := range m.AllowedValues {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.MaxCoins) > 0 {
+		for _, e := range m.MaxCoins {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Grant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -570,6 +820,270 @@ func (m *GenericAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RulesAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RulesAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RulesAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, FieldRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FieldRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FieldRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FieldRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedValues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedValues = append(m.AllowedValues, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxCoins = append(m.MaxCoins, types.Coin{})
+			if err := m.MaxCoins[len(m.MaxCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -629,7 +1143,7 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Authorization == nil {
-				m.Authorization = &types.Any{}
+				m.Authorization = &types1.Any{}
 			}
 			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -815,7 +1329,7 @@ func (m *GrantAuthorization) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Authorization == nil {
-				m.Authorization = &types.Any{}
+				m.Authorization = &types1.Any{}
 			}
 			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2,7 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"cosmossdk.io/core/address"
 	"github.com/spf13/cobra"
@@ -29,6 +31,7 @@ func GetQueryCmd(ac address.Codec) *cobra.Command {
 		GetCmdQueryGrants(ac),
 		GetQueryGranterGrants(ac),
 		GetQueryGranteeGrants(ac),
+		GetQueryExpiringGrants(),
 	)
 
 	return authorizationQueryCmd
@@ -105,8 +108,9 @@ func GetQueryGranterGrants(ac address.Codec) *cobra.Command {
 			fmt.Sprintf(`Query authorization grants granted by granter.
 Examples:
 $ %s q %s grants-by-granter cosmos1skj..
+$ %s q %s grants-by-granter cosmos1skj.. --msg-type=/cosmos.bank.v1beta1.MsgSend
 `,
-				version.AppName, authz.ModuleName),
+				version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -119,6 +123,11 @@ $ %s q %s grants-by-granter cosmos1skj..
 				return err
 			}
 
+			msgType, err := cmd.Flags().GetString(FlagMsgType)
+			if err != nil {
+				return err
+			}
+
 			pageReq, err := client.ReadPageRequest(cmd.Flags())
 			if err != nil {
 				return err
@@ -130,6 +139,7 @@ $ %s q %s grants-by-granter cosmos1skj..
 				&authz.QueryGranterGrantsRequest{
 					Granter:    args[0],
 					Pagination: pageReq,
+					MsgTypeUrl: msgType,
 				},
 			)
 			if err != nil {
@@ -141,6 +151,7 @@ $ %s q %s grants-by-granter cosmos1skj..
 	}
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "granter-grants")
+	cmd.Flags().String(FlagMsgType, "", "Query only the grants of the given Msg type URL")
 	return cmd
 }
 
@@ -154,8 +165,9 @@ func GetQueryGranteeGrants(ac address.Codec) *cobra.Command {
 			fmt.Sprintf(`Query authorization grants granted to a grantee.
 Examples:
 $ %s q %s grants-by-grantee cosmos1skj..
+$ %s q %s grants-by-grantee cosmos1skj.. --msg-type=/cosmos.bank.v1beta1.MsgSend
 `,
-				version.AppName, authz.ModuleName),
+				version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -168,6 +180,11 @@ $ %s q %s grants-by-grantee cosmos1skj..
 				return err
 			}
 
+			msgType, err := cmd.Flags().GetString(FlagMsgType)
+			if err != nil {
+				return err
+			}
+
 			pageReq, err := client.ReadPageRequest(cmd.Flags())
 			if err != nil {
 				return err
@@ -179,6 +196,7 @@ $ %s q %s grants-by-grantee cosmos1skj..
 				&authz.QueryGranteeGrantsRequest{
 					Grantee:    args[0],
 					Pagination: pageReq,
+					MsgTypeUrl: msgType,
 				},
 			)
 			if err != nil {
@@ -190,5 +208,56 @@ $ %s q %s grants-by-grantee cosmos1skj..
 	}
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "grantee-grants")
+	cmd.Flags().String(FlagMsgType, "", "Query only the grants of the given Msg type URL")
+	return cmd
+}
+
+// GetQueryExpiringGrants returns cmd to query for all grants expiring before a given time.
+func GetQueryExpiringGrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "expiring-grants [expiration-unix-timestamp]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query authorization grants expiring before a given time",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query authorization grants expiring before a given time, expressed as a unix timestamp in seconds.
+Examples:
+$ %s q %s expiring-grants 1672531200
+`,
+				version.AppName, authz.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			timestamp, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid expiration timestamp %s: %w", args[0], err)
+			}
+			expiringBefore := time.Unix(timestamp, 0)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := authz.NewQueryClient(clientCtx)
+			res, err := queryClient.ExpiringGrants(
+				cmd.Context(),
+				&authz.QueryExpiringGrantsRequest{
+					ExpiringBefore: &expiringBefore,
+					Pagination:     pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "expiring-grants")
 	return cmd
 }
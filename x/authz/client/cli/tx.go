@@ -1,8 +1,10 @@
 package cli
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
@@ -28,6 +30,8 @@ const (
 	FlagAllowedValidators = "allowed-validators"
 	FlagDenyValidators    = "deny-validators"
 	FlagAllowList         = "allow-list"
+	FlagRules             = "rules"
+	FlagContinueOnFailure = "continue-on-failure"
 	delegate              = "delegate"
 	redelegate            = "redelegate"
 	unbond                = "unbond"
@@ -56,7 +60,7 @@ func GetTxCmd(ac address.Codec) *cobra.Command {
 // NewCmdGrantAuthorization returns a CLI command handler for creating a MsgGrant transaction.
 func NewCmdGrantAuthorization(ac address.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "grant <grantee> <authorization_type=\"send\"|\"generic\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from <granter>",
+		Use:   "grant <grantee> <authorization_type=\"send\"|\"generic\"|\"rules\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from <granter>",
 		Short: "Grant authorization to an address",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`create a new grant authorization to an address to execute a transaction on your behalf:
@@ -64,7 +68,11 @@ func NewCmdGrantAuthorization(ac address.Codec) *cobra.Command {
 Examples:
  $ %s tx %s grant cosmos1skjw.. send --spend-limit=1000stake --from=cosmos1skl..
  $ %s tx %s grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1.MsgVote --from=cosmos1sk..
-	`, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
+ $ %s tx %s grant cosmos1skjw.. rules --msg-type=/cosmos.bank.v1beta1.MsgSend --rules=rules.json --from=cosmos1sk..
+
+where rules.json constrains the fields of the authorized message, e.g.:
+[{"field": "to_address", "allowed_values": ["cosmos1.."]}, {"field": "amount", "max_coins": [{"denom": "stake", "amount": "100"}]}]
+	`, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
 		),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -127,6 +135,28 @@ Examples:
 				}
 
 				authorization = authz.NewGenericAuthorization(msgType)
+			case "rules":
+				msgType, err := cmd.Flags().GetString(FlagMsgType)
+				if err != nil {
+					return err
+				}
+
+				rulesFile, err := cmd.Flags().GetString(FlagRules)
+				if err != nil {
+					return err
+				}
+
+				contents, err := os.ReadFile(rulesFile)
+				if err != nil {
+					return err
+				}
+
+				var rules []authz.FieldRule
+				if err := json.Unmarshal(contents, &rules); err != nil {
+					return fmt.Errorf("failed to parse rules file %s: %w", rulesFile, err)
+				}
+
+				authorization = authz.NewRulesAuthorization(msgType, rules)
 			case delegate, unbond, redelegate:
 				limit, err := cmd.Flags().GetString(FlagSpendLimit)
 				if err != nil {
@@ -206,7 +236,8 @@ Examples:
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
-	cmd.Flags().String(FlagMsgType, "", "The Msg method name for which we are creating a GenericAuthorization")
+	cmd.Flags().String(FlagMsgType, "", "The Msg method name for which we are creating a GenericAuthorization or a RulesAuthorization")
+	cmd.Flags().String(FlagRules, "", "Path to a JSON file with the field rules of a RulesAuthorization")
 	cmd.Flags().String(FlagSpendLimit, "", "SpendLimit for Send Authorization, an array of Coins allowed spend")
 	cmd.Flags().StringSlice(FlagAllowedValidators, []string{}, "Allowed validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
@@ -271,7 +302,8 @@ func NewCmdExecAuthorization() *cobra.Command {
 Example:
  $ %s tx %s exec tx.json --from grantee
  $ %s tx bank send <granter> <recipient> --from <granter> --chain-id <chain-id> --generate-only > tx.json && %s tx %s exec tx.json --from grantee
-			`, version.AppName, authz.ModuleName, version.AppName, version.AppName, authz.ModuleName),
+ $ %s tx %s exec tx.json --from grantee --continue-on-failure
+			`, version.AppName, authz.ModuleName, version.AppName, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
 		),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -291,11 +323,17 @@ Example:
 			}
 			msg := authz.NewMsgExec(grantee, theTx.GetMsgs())
 
+			msg.ContinueOnFailure, err = cmd.Flags().GetBool(FlagContinueOnFailure)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Bool(FlagContinueOnFailure, false, "Execute each message independently, reverting only the failing ones instead of the whole tx")
 
 	return cmd
 }
@@ -25,6 +25,7 @@ import (
 	testutilmod "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/cosmos/cosmos-sdk/x/authz/client/cli"
 	authzclitestutil "github.com/cosmos/cosmos-sdk/x/authz/client/testutil"
+	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
@@ -56,7 +57,7 @@ func TestCLITestSuite(t *testing.T) {
 }
 
 func (s *CLITestSuite) SetupSuite() {
-	s.encCfg = testutilmod.MakeTestEncodingConfig(gov.AppModuleBasic{}, bank.AppModuleBasic{})
+	s.encCfg = testutilmod.MakeTestEncodingConfig(gov.AppModuleBasic{}, bank.AppModuleBasic{}, authzmodule.AppModuleBasic{})
 	s.kr = keyring.NewInMemory(s.encCfg.Codec)
 	s.baseCtx = client.Context{}.
 		WithKeyring(s.kr).
@@ -391,6 +392,22 @@ func (s *CLITestSuite) TestCLITxGrantAuthorization() {
 			false,
 			"",
 		},
+		{
+			"invalid rules file",
+			[]string{
+				grantee.String(),
+				"rules",
+				fmt.Sprintf("--%s=%s", cli.FlagMsgType, typeMsgSend),
+				fmt.Sprintf("--%s=%s", cli.FlagRules, "./not-found.json"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10))).String()),
+			},
+			true,
+			"no such file or directory",
+		},
 		{
 			"fail when granter = grantee",
 			[]string{
@@ -441,6 +458,30 @@ func (s *CLITestSuite) TestCLITxGrantAuthorization() {
 	}
 }
 
+func (s *CLITestSuite) TestCLITxGrantRulesAuthorization() {
+	val := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
+	grantee := s.grantee[0]
+	twoHours := time.Now().Add(time.Minute * 120).Unix()
+
+	rulesFile := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf(`[{"field": "to_address", "allowed_values": [%q]}, {"field": "amount", "max_coins": [{"denom": "stake", "amount": "100"}]}]`, grantee.String()))
+	defer rulesFile.Close()
+
+	out, err := authzclitestutil.CreateGrant(s.clientCtx,
+		[]string{
+			grantee.String(),
+			"rules",
+			fmt.Sprintf("--%s=%s", cli.FlagMsgType, typeMsgSend),
+			fmt.Sprintf("--%s=%s", cli.FlagRules, rulesFile.Name()),
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+			fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+			fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+		},
+	)
+	s.Require().NoError(err)
+	s.Require().Contains(out.String(), "/cosmos.authz.v1beta1.RulesAuthorization")
+	s.Require().Contains(out.String(), grantee.String())
+}
+
 func (s *CLITestSuite) TestCmdRevokeAuthorizations() {
 	val := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
 
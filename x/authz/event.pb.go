@@ -151,31 +151,171 @@ func (m *EventRevoke) GetGrantee() string {
 	return ""
 }
 
+// EventPruneExpiredGrant is emitted when an expired grant is pruned from state.
+//
+// Since: cosmos-sdk 0.50
+type EventPruneExpiredGrant struct {
+	// Msg type URL for which the expired authorization was granted
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// Granter account address
+	Granter string `protobuf:"bytes,3,opt,name=granter,proto3" json:"granter,omitempty"`
+	// Grantee account address
+	Grantee string `protobuf:"bytes,4,opt,name=grantee,proto3" json:"grantee,omitempty"`
+}
+
+func (m *EventPruneExpiredGrant) Reset()         { *m = EventPruneExpiredGrant{} }
+func (m *EventPruneExpiredGrant) String() string { return proto.CompactTextString(m) }
+func (*EventPruneExpiredGrant) ProtoMessage()    {}
+func (*EventPruneExpiredGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f88cbc71a8baf1f, []int{2}
+}
+func (m *EventPruneExpiredGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPruneExpiredGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPruneExpiredGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPruneExpiredGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPruneExpiredGrant.Merge(m, src)
+}
+func (m *EventPruneExpiredGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPruneExpiredGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPruneExpiredGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPruneExpiredGrant proto.InternalMessageInfo
+
+func (m *EventPruneExpiredGrant) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventPruneExpiredGrant) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *EventPruneExpiredGrant) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+// EventExecMsgFailure is emitted when a message of a MsgExec executed with
+// continue_on_failure fails.
+//
+// Since: cosmos-sdk 0.50
+type EventExecMsgFailure struct {
+	// Index of the failed message in the MsgExec
+	MsgIndex uint64 `protobuf:"varint,1,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty"`
+	// Msg type URL of the failed message
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// Error returned by the failed message
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EventExecMsgFailure) Reset()         { *m = EventExecMsgFailure{} }
+func (m *EventExecMsgFailure) String() string { return proto.CompactTextString(m) }
+func (*EventExecMsgFailure) ProtoMessage()    {}
+func (*EventExecMsgFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f88cbc71a8baf1f, []int{3}
+}
+func (m *EventExecMsgFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventExecMsgFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventExecMsgFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventExecMsgFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventExecMsgFailure.Merge(m, src)
+}
+func (m *EventExecMsgFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventExecMsgFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventExecMsgFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventExecMsgFailure proto.InternalMessageInfo
+
+func (m *EventExecMsgFailure) GetMsgIndex() uint64 {
+	if m != nil {
+		return m.MsgIndex
+	}
+	return 0
+}
+
+func (m *EventExecMsgFailure) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventExecMsgFailure) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventGrant)(nil), "cosmos.authz.v1beta1.EventGrant")
 	proto.RegisterType((*EventRevoke)(nil), "cosmos.authz.v1beta1.EventRevoke")
+	proto.RegisterType((*EventPruneExpiredGrant)(nil), "cosmos.authz.v1beta1.EventPruneExpiredGrant")
+	proto.RegisterType((*EventExecMsgFailure)(nil), "cosmos.authz.v1beta1.EventExecMsgFailure")
 }
 
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/event.proto", fileDescriptor_1f88cbc71a8baf1f) }
 
 var fileDescriptor_1f88cbc71a8baf1f = []byte{
-	// 245 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xa8, 0xd2, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x81, 0xa8,
-	0xd0, 0x03, 0xab, 0xd0, 0x83, 0xaa, 0x90, 0x92, 0x84, 0x88, 0xc6, 0x83, 0xd5, 0xe8, 0x43, 0x95,
-	0x80, 0x39, 0x4a, 0xd3, 0x18, 0xb9, 0xb8, 0x5c, 0x41, 0x06, 0xb8, 0x17, 0x25, 0xe6, 0x95, 0x08,
-	0x29, 0x70, 0xf1, 0xe4, 0x16, 0xa7, 0xc7, 0x97, 0x54, 0x16, 0xa4, 0xc6, 0x97, 0x16, 0xe5, 0x48,
-	0x30, 0x29, 0x30, 0x6a, 0x70, 0x06, 0x71, 0xe5, 0x16, 0xa7, 0x87, 0x54, 0x16, 0xa4, 0x86, 0x16,
-	0xe5, 0x08, 0x19, 0x71, 0xb1, 0xa7, 0x83, 0x94, 0xa6, 0x16, 0x49, 0x30, 0x83, 0x24, 0x9d, 0x24,
-	0x2e, 0x6d, 0xd1, 0x85, 0x59, 0xeb, 0x98, 0x92, 0x52, 0x94, 0x5a, 0x5c, 0x1c, 0x5c, 0x52, 0x94,
-	0x99, 0x97, 0x1e, 0x04, 0x53, 0x88, 0xd0, 0x93, 0x2a, 0xc1, 0x42, 0x9c, 0x9e, 0x54, 0xa5, 0xe9,
-	0x8c, 0x5c, 0xdc, 0x60, 0x87, 0x05, 0xa5, 0x96, 0xe5, 0x67, 0xa7, 0x0e, 0x1e, 0x97, 0x39, 0xd9,
-	0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb,
-	0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x4a, 0x7a, 0x66, 0x49, 0x46,
-	0x69, 0x92, 0x5e, 0x72, 0x7e, 0x2e, 0x34, 0x94, 0xa1, 0x94, 0x6e, 0x71, 0x4a, 0xb6, 0x7e, 0x05,
-	0x24, 0xde, 0x92, 0xd8, 0xc0, 0x21, 0x6f, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x74, 0xc2,
-	0x29, 0xce, 0x01, 0x00, 0x00,
+	// 322 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x92, 0x3f, 0x4b, 0xc3, 0x40,
+	0x18, 0xc6, 0x7b, 0x5a, 0xff, 0xf4, 0x74, 0x8a, 0x45, 0xa2, 0xc2, 0x11, 0x8a, 0x43, 0x97, 0x26,
+	0x54, 0x77, 0xc1, 0x42, 0x15, 0x07, 0x41, 0xa2, 0x2e, 0x2e, 0x25, 0x6d, 0x5e, 0xae, 0xb1, 0x4d,
+	0x2e, 0xbc, 0x77, 0x29, 0xa9, 0x9f, 0xc2, 0x49, 0x77, 0x3f, 0x83, 0x1f, 0xc2, 0xb1, 0x38, 0x39,
+	0x4a, 0xf2, 0x45, 0x24, 0x97, 0x14, 0xd7, 0x8e, 0x9d, 0x8e, 0xe7, 0xee, 0xf7, 0xde, 0xfd, 0x38,
+	0x1e, 0x6a, 0x8d, 0x84, 0x0c, 0x85, 0x74, 0xbc, 0x44, 0x8d, 0x5f, 0x9c, 0x59, 0x77, 0x08, 0xca,
+	0xeb, 0x3a, 0x30, 0x83, 0x48, 0xd9, 0x31, 0x0a, 0x25, 0x8c, 0x66, 0x49, 0xd8, 0x9a, 0xb0, 0x2b,
+	0xe2, 0xf8, 0xa8, 0xdc, 0x1d, 0x68, 0xc6, 0xa9, 0x10, 0x1d, 0x5a, 0x6f, 0x84, 0xd2, 0x7e, 0x71,
+	0xc1, 0x35, 0x7a, 0x91, 0x32, 0x2c, 0xba, 0x1f, 0x4a, 0x3e, 0x50, 0xf3, 0x18, 0x06, 0x09, 0x4e,
+	0xcd, 0x0d, 0x8b, 0xb4, 0x1b, 0x2e, 0x0d, 0x25, 0x7f, 0x98, 0xc7, 0xf0, 0x88, 0x53, 0xe3, 0x8c,
+	0xee, 0xf0, 0x02, 0x05, 0x34, 0x37, 0x8b, 0xc3, 0x9e, 0xf9, 0xfd, 0xd9, 0x59, 0x3e, 0x7b, 0xe9,
+	0xfb, 0x08, 0x52, 0xde, 0x2b, 0x0c, 0x22, 0xee, 0x2e, 0xc1, 0xff, 0x19, 0x30, 0xeb, 0xab, 0xcd,
+	0x40, 0xeb, 0x9d, 0xd0, 0x3d, 0x2d, 0xe6, 0xc2, 0x4c, 0x4c, 0x60, 0x8d, 0xcc, 0x3e, 0x08, 0x3d,
+	0xd4, 0x66, 0x77, 0x98, 0x44, 0xd0, 0x4f, 0xe3, 0x00, 0xc1, 0x5f, 0xb7, 0xef, 0x7b, 0xa6, 0x07,
+	0xda, 0xb1, 0x9f, 0xc2, 0xe8, 0x56, 0xf2, 0x2b, 0x2f, 0x98, 0x26, 0x08, 0xc6, 0x09, 0x6d, 0x14,
+	0x82, 0x41, 0xe4, 0x43, 0x6a, 0x12, 0x8b, 0xb4, 0xeb, 0xee, 0x6e, 0x28, 0xf9, 0x4d, 0x91, 0x57,
+	0xb0, 0x6f, 0xd2, 0x2d, 0x40, 0x14, 0x95, 0xbb, 0x5b, 0x86, 0xde, 0xc5, 0x57, 0xc6, 0xc8, 0x22,
+	0x63, 0xe4, 0x37, 0x63, 0xe4, 0x35, 0x67, 0xb5, 0x45, 0xce, 0x6a, 0x3f, 0x39, 0xab, 0x3d, 0x9d,
+	0xf2, 0x40, 0x8d, 0x93, 0xa1, 0x3d, 0x12, 0x61, 0x55, 0xbb, 0x6a, 0xe9, 0x48, 0x7f, 0xe2, 0xa4,
+	0x65, 0x91, 0x87, 0xdb, 0xba, 0x8a, 0xe7, 0x7f, 0x03, 0x00, 0x93, 0x92, 0x7c, 0xf2, 0xdf, 0x02,
+	0x00, 0x00,
 }
 
 func (m *EventGrant) Marshal() (dAtA []byte, err error) {
@@ -266,6 +406,92 @@ func (m *EventRevoke) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventPruneExpiredGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPruneExpiredGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPruneExpiredGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventExecMsgFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventExecMsgFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventExecMsgFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MsgIndex != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -319,6 +545,47 @@ func (m *EventRevoke) Size() (n int) {
 	return n
 }
 
+func (m *EventPruneExpiredGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventExecMsgFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgIndex != 0 {
+		n += 1 + sovEvent(uint64(m.MsgIndex))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -617,6 +884,285 @@ func (m *EventRevoke) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventPruneExpiredGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPruneExpiredGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPruneExpiredGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventExecMsgFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventExecMsgFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventExecMsgFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
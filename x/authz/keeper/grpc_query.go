@@ -9,6 +9,7 @@ import (
 
 	"cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -111,6 +112,10 @@ func (k Keeper) GranterGrants(c context.Context, req *authz.QueryGranterGrantsRe
 			return nil, err
 		}
 
+		if req.MsgTypeUrl != "" && auth1.MsgTypeURL() != req.MsgTypeUrl {
+			return nil, nil
+		}
+
 		any, err := codectypes.NewAnyWithValue(auth1)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, err.Error())
@@ -156,11 +161,15 @@ func (k Keeper) GranteeGrants(c context.Context, req *authz.QueryGranteeGrantsRe
 			return nil, err
 		}
 
-		granter, g, _ := parseGrantStoreKey(append(GrantKey, key...))
+		granter, g, msgType := parseGrantStoreKey(append(GrantKey, key...))
 		if !bytes.Equal(g, grantee) {
 			return nil, nil
 		}
 
+		if req.MsgTypeUrl != "" && msgType != req.MsgTypeUrl {
+			return nil, nil
+		}
+
 		authorizationAny, err := codectypes.NewAnyWithValue(auth1)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, err.Error())
@@ -184,3 +193,82 @@ func (k Keeper) GranteeGrants(c context.Context, req *authz.QueryGranteeGrantsRe
 		Pagination: pageRes,
 	}, nil
 }
+
+// ExpiringGrants implements the Query/ExpiringGrants gRPC method.
+// It returns the grants expiring before the given time, paginating over the
+// granter-grantee pairs of the grant expiration queue. Since the queue is
+// ordered by expiration time, only the pairs expiring before that time are read.
+func (k Keeper) ExpiringGrants(c context.Context, req *authz.QueryExpiringGrantsRequest) (*authz.QueryExpiringGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ExpiringBefore == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "expiring before time cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	queueStore := boundedStore{
+		KVStore: prefix.NewStore(ctx.KVStore(k.storeKey), GrantQueuePrefix),
+		end:     sdk.FormatTimeBytes(*req.ExpiringBefore),
+	}
+
+	var grants []*authz.GrantAuthorization
+	pageRes, err := query.Paginate(queueStore, req.Pagination, func(key, value []byte) error {
+		_, granter, grantee, err := parseGrantQueueKey(append(GrantQueuePrefix, key...))
+		if err != nil {
+			return err
+		}
+
+		var queueItem authz.GrantQueueItem
+		if err := k.cdc.Unmarshal(value, &queueItem); err != nil {
+			return err
+		}
+
+		for _, typeURL := range queueItem.MsgTypeUrls {
+			grant, found := k.getGrant(ctx, grantStoreKey(grantee, granter, typeURL))
+			if !found {
+				continue
+			}
+
+			grants = append(grants, &authz.GrantAuthorization{
+				Granter:       granter.String(),
+				Grantee:       grantee.String(),
+				Authorization: grant.Authorization,
+				Expiration:    grant.Expiration,
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &authz.QueryExpiringGrantsResponse{
+		Grants:     grants,
+		Pagination: pageRes,
+	}, nil
+}
+
+// boundedStore is a KVStore whose iterators stop before the end key.
+type boundedStore struct {
+	storetypes.KVStore
+	end []byte
+}
+
+func (s boundedStore) Iterator(start, end []byte) storetypes.Iterator {
+	return s.KVStore.Iterator(start, s.bound(end))
+}
+
+func (s boundedStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return s.KVStore.ReverseIterator(start, s.bound(end))
+}
+
+func (s boundedStore) bound(end []byte) []byte {
+	if end == nil || bytes.Compare(end, s.end) > 0 {
+		return s.end
+	}
+
+	return end
+}
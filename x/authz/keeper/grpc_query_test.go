@@ -197,6 +197,26 @@ func (suite *TestSuite) TestGRPCQueryGranterGrants() {
 			},
 			2,
 		},
+		{
+			"valid case, msg type filter",
+			func() {},
+			false,
+			authz.QueryGranterGrantsRequest{
+				Granter:    addrs[0].String(),
+				MsgTypeUrl: bankSendAuthMsgType,
+			},
+			2,
+		},
+		{
+			"valid case, no authorization for msg type",
+			func() {},
+			false,
+			authz.QueryGranterGrantsRequest{
+				Granter:    addrs[0].String(),
+				MsgTypeUrl: "/cosmos.gov.v1.MsgVote",
+			},
+			0,
+		},
 		{
 			"valid case, pagination",
 			func() {
@@ -276,6 +296,26 @@ func (suite *TestSuite) TestGRPCQueryGranteeGrants() {
 			},
 			2,
 		},
+		{
+			"valid case, msg type filter",
+			func() {},
+			false,
+			authz.QueryGranteeGrantsRequest{
+				Grantee:    addrs[0].String(),
+				MsgTypeUrl: bankSendAuthMsgType,
+			},
+			2,
+		},
+		{
+			"valid case, no authorization for msg type",
+			func() {},
+			false,
+			authz.QueryGranteeGrantsRequest{
+				Grantee:    addrs[0].String(),
+				MsgTypeUrl: "/cosmos.gov.v1.MsgVote",
+			},
+			0,
+		},
 		{
 			"valid case, pagination",
 			func() {},
@@ -304,6 +344,105 @@ func (suite *TestSuite) TestGRPCQueryGranteeGrants() {
 	}
 }
 
+func (suite *TestSuite) TestGRPCQueryExpiringGrants() {
+	require := suite.Require()
+	queryClient, addrs := suite.queryClient, suite.addrs
+	blockTime := suite.ctx.BlockTime()
+	expiringBefore := blockTime.Add(2 * time.Hour)
+	expiredBefore := blockTime.Add(30 * time.Minute)
+
+	testCases := []struct {
+		msg      string
+		preRun   func()
+		expError bool
+		request  authz.QueryExpiringGrantsRequest
+		numItems int
+	}{
+		{
+			"fail empty expiration time",
+			func() {},
+			true,
+			authz.QueryExpiringGrantsRequest{},
+			0,
+		},
+		{
+			"valid case, no authorization found",
+			func() {},
+			false,
+			authz.QueryExpiringGrantsRequest{
+				ExpiringBefore: &expiringBefore,
+			},
+			0,
+		},
+		{
+			"valid case, multiple authorization",
+			func() {
+				suite.createSendAuthorization(addrs[0], addrs[1])
+				suite.createSendAuthorization(addrs[0], addrs[2])
+			},
+			false,
+			authz.QueryExpiringGrantsRequest{
+				ExpiringBefore: &expiringBefore,
+			},
+			2,
+		},
+		{
+			"valid case, no authorization expiring before the given time",
+			func() {},
+			false,
+			authz.QueryExpiringGrantsRequest{
+				ExpiringBefore: &expiredBefore,
+			},
+			0,
+		},
+		{
+			"valid case, pagination",
+			func() {},
+			false,
+			authz.QueryExpiringGrantsRequest{
+				ExpiringBefore: &expiringBefore,
+				Pagination: &query.PageRequest{
+					Limit: 1,
+				},
+			},
+			1,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			tc.preRun()
+			result, err := queryClient.ExpiringGrants(gocontext.Background(), &tc.request)
+			if tc.expError {
+				require.Error(err)
+			} else {
+				require.NoError(err)
+				require.Len(result.Grants, tc.numItems)
+			}
+		})
+	}
+
+	// the grants expiring after the given time are neither returned nor counted
+	laterExp := blockTime.Add(3 * time.Hour)
+	err := suite.authzKeeper.SaveGrant(suite.ctx, addrs[3], addrs[0], &banktypes.SendAuthorization{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("steak", 100))}, &laterExp)
+	require.NoError(err)
+
+	result, err := queryClient.ExpiringGrants(gocontext.Background(), &authz.QueryExpiringGrantsRequest{
+		ExpiringBefore: &expiringBefore,
+		Pagination:     &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(err)
+	require.Len(result.Grants, 2)
+	require.Equal(uint64(2), result.Pagination.Total)
+
+	result, err = queryClient.ExpiringGrants(gocontext.Background(), &authz.QueryExpiringGrantsRequest{
+		ExpiringBefore: &expiringBefore,
+		Pagination:     &query.PageRequest{Reverse: true},
+	})
+	require.NoError(err)
+	require.Len(result.Grants, 2)
+}
+
 func (suite *TestSuite) createSendAuthorization(grantee, granter sdk.AccAddress) authz.Authorization {
 	exp := suite.ctx.BlockHeader().Time.Add(time.Hour)
 	newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
@@ -86,80 +86,121 @@ func (k Keeper) update(ctx sdk.Context, grantee, granter sdk.AccAddress, updated
 // grants from the message signer to the grantee.
 func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
 	results := make([][]byte, len(msgs))
-	now := ctx.BlockTime()
 
 	for i, msg := range msgs {
-		signers := msg.GetSigners()
-		if len(signers) != 1 {
-			return nil, authz.ErrAuthorizationNumOfSigners
+		data, err := k.dispatchAction(ctx, grantee, i, msg)
+		if err != nil {
+			return nil, err
 		}
 
-		granter := signers[0]
+		results[i] = data
+	}
 
-		// If granter != grantee then check authorization.Accept, otherwise we
-		// implicitly accept.
-		if !granter.Equals(grantee) {
-			skey := grantStoreKey(grantee, granter, sdk.MsgTypeURL(msg))
+	return results, nil
+}
 
-			grant, found := k.getGrant(ctx, skey)
-			if !found {
-				return nil, errorsmod.Wrapf(authz.ErrNoAuthorizationFound, "failed to update grant with key %s", string(skey))
-			}
+// DispatchActionsContinueOnFailure attempts to execute each of the provided
+// messages via authorization grants from the message signer to the grantee.
+// Unlike DispatchActions, a failing message does not abort the execution: its
+// state changes are discarded, an EventExecMsgFailure is emitted and the next
+// message is executed.
+func (k Keeper) DispatchActionsContinueOnFailure(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([]authz.MsgExecResult, error) {
+	results := make([]authz.MsgExecResult, len(msgs))
 
-			if grant.Expiration != nil && grant.Expiration.Before(now) {
-				return nil, authz.ErrAuthorizationExpired
-			}
+	for i, msg := range msgs {
+		cacheCtx, writeCache := ctx.CacheContext()
 
-			authorization, err := grant.GetAuthorization()
-			if err != nil {
+		data, err := k.dispatchAction(cacheCtx, grantee, i, msg)
+		if err != nil {
+			if err := ctx.EventManager().EmitTypedEvent(&authz.EventExecMsgFailure{
+				MsgIndex:   uint64(i),
+				MsgTypeUrl: sdk.MsgTypeURL(msg),
+				Error:      err.Error(),
+			}); err != nil {
 				return nil, err
 			}
 
-			resp, err := authorization.Accept(ctx, msg)
-			if err != nil {
-				return nil, err
-			}
+			continue
+		}
 
-			if resp.Delete {
-				err = k.DeleteGrant(ctx, grantee, granter, sdk.MsgTypeURL(msg))
-			} else if resp.Updated != nil {
-				err = k.update(ctx, grantee, granter, resp.Updated)
-			}
-			if err != nil {
-				return nil, err
-			}
+		writeCache()
+		results[i] = authz.MsgExecResult{Success: true, Data: data}
+	}
 
-			if !resp.Accept {
-				return nil, sdkerrors.ErrUnauthorized
-			}
+	return results, nil
+}
+
+// dispatchAction executes the i-th message of a MsgExec on behalf of its
+// signer, checking and updating the grant of the grantee when they differ.
+func (k Keeper) dispatchAction(ctx sdk.Context, grantee sdk.AccAddress, i int, msg sdk.Msg) ([]byte, error) {
+	signers := msg.GetSigners()
+	if len(signers) != 1 {
+		return nil, authz.ErrAuthorizationNumOfSigners
+	}
+
+	granter := signers[0]
+
+	// If granter != grantee then check authorization.Accept, otherwise we
+	// implicitly accept.
+	if !granter.Equals(grantee) {
+		skey := grantStoreKey(grantee, granter, sdk.MsgTypeURL(msg))
+
+		grant, found := k.getGrant(ctx, skey)
+		if !found {
+			return nil, errorsmod.Wrapf(authz.ErrNoAuthorizationFound, "failed to update grant with key %s", string(skey))
 		}
 
-		handler := k.router.Handler(msg)
-		if handler == nil {
-			return nil, sdkerrors.ErrUnknownRequest.Wrapf("unrecognized message route: %s", sdk.MsgTypeURL(msg))
+		if grant.Expiration != nil && grant.Expiration.Before(ctx.BlockTime()) {
+			return nil, authz.ErrAuthorizationExpired
 		}
 
-		msgResp, err := handler(ctx, msg)
+		authorization, err := grant.GetAuthorization()
 		if err != nil {
-			return nil, errorsmod.Wrapf(err, "failed to execute message; message %v", msg)
+			return nil, err
 		}
 
-		results[i] = msgResp.Data
+		resp, err := authorization.Accept(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
 
-		// emit the events from the dispatched actions
-		events := msgResp.Events
-		sdkEvents := make([]sdk.Event, 0, len(events))
-		for _, event := range events {
-			e := event
-			e.Attributes = append(e.Attributes, abci.EventAttribute{Key: "authz_msg_index", Value: strconv.Itoa(i)})
+		if resp.Delete {
+			err = k.DeleteGrant(ctx, grantee, granter, sdk.MsgTypeURL(msg))
+		} else if resp.Updated != nil {
+			err = k.update(ctx, grantee, granter, resp.Updated)
+		}
+		if err != nil {
+			return nil, err
+		}
 
-			sdkEvents = append(sdkEvents, sdk.Event(e))
+		if !resp.Accept {
+			return nil, sdkerrors.ErrUnauthorized
 		}
+	}
 
-		ctx.EventManager().EmitEvents(sdkEvents)
+	handler := k.router.Handler(msg)
+	if handler == nil {
+		return nil, sdkerrors.ErrUnknownRequest.Wrapf("unrecognized message route: %s", sdk.MsgTypeURL(msg))
 	}
 
-	return results, nil
+	msgResp, err := handler(ctx, msg)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to execute message; message %v", msg)
+	}
+
+	// emit the events from the dispatched actions
+	events := msgResp.Events
+	sdkEvents := make([]sdk.Event, 0, len(events))
+	for _, event := range events {
+		e := event
+		e.Attributes = append(e.Attributes, abci.EventAttribute{Key: "authz_msg_index", Value: strconv.Itoa(i)})
+
+		sdkEvents = append(sdkEvents, sdk.Event(e))
+	}
+
+	ctx.EventManager().EmitEvents(sdkEvents)
+
+	return msgResp.Data, nil
 }
 
 // SaveGrant method grants the provided authorization to the grantee on the granter's account
@@ -398,6 +439,14 @@ func (k Keeper) DequeueAndDeleteExpiredGrants(ctx sdk.Context) error {
 
 		for _, typeURL := range queueItem.MsgTypeUrls {
 			store.Delete(grantStoreKey(grantee, granter, typeURL))
+
+			if err := ctx.EventManager().EmitTypedEvent(&authz.EventPruneExpiredGrant{
+				MsgTypeUrl: typeURL,
+				Granter:    granter.String(),
+				Grantee:    grantee.String(),
+			}); err != nil {
+				return err
+			}
 		}
 	}
 
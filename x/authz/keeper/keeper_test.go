@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	cmttime "github.com/cometbft/cometbft/types/time"
 	"github.com/golang/mock/gomock"
@@ -355,6 +356,57 @@ func (s *TestSuite) TestDispatchedEvents() {
 	}
 }
 
+func (s *TestSuite) TestDispatchActionsContinueOnFailure() {
+	require := s.Require()
+	granterAddr := s.addrs[0]
+	granteeAddr := s.addrs[1]
+	recipientAddr := s.addrs[2]
+	expiration := s.ctx.BlockTime().AddDate(0, 1, 0)
+
+	err := s.authzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, banktypes.NewSendAuthorization(coins100, nil), &expiration)
+	require.NoError(err)
+
+	send := func(amount sdk.Coins) sdk.Msg {
+		return &banktypes.MsgSend{
+			Amount:      amount,
+			FromAddress: granterAddr.String(),
+			ToAddress:   recipientAddr.String(),
+		}
+	}
+
+	// the second message is over the spend limit and fails, the others succeed
+	msgs := []sdk.Msg{send(coins10), send(coins1000), send(coins10)}
+
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	results, err := s.authzKeeper.DispatchActionsContinueOnFailure(ctx, granteeAddr, msgs)
+	require.NoError(err)
+	require.Len(results, 3)
+	require.True(results[0].Success)
+	require.False(results[1].Success)
+	require.Nil(results[1].Data)
+	require.True(results[2].Success)
+
+	// only the successful messages spent from the grant
+	authzs, err := s.authzKeeper.GetAuthorizations(ctx, granteeAddr, granterAddr)
+	require.NoError(err)
+	require.Len(authzs, 1)
+	require.Equal(coins100.Sub(coins10...).Sub(coins10...), authzs[0].(*banktypes.SendAuthorization).SpendLimit)
+
+	var failures []sdk.Event
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == "cosmos.authz.v1beta1.EventExecMsgFailure" {
+			failures = append(failures, e)
+		}
+	}
+	require.Len(failures, 1)
+
+	failure, err := sdk.ParseTypedEvent(abci.Event(failures[0]))
+	require.NoError(err)
+	require.Equal(uint64(1), failure.(*authz.EventExecMsgFailure).MsgIndex)
+	require.Equal(bankSendAuthMsgType, failure.(*authz.EventExecMsgFailure).MsgTypeUrl)
+	require.Contains(failure.(*authz.EventExecMsgFailure).Error, "requested amount is more than spend limit")
+}
+
 func (s *TestSuite) TestDequeueAllGrantsQueue() {
 	require := s.Require()
 	addrs := s.addrs
@@ -399,6 +451,15 @@ func (s *TestSuite) TestDequeueAllGrantsQueue() {
 	authzs, err = s.authzKeeper.GetAuthorizations(newCtx, granter, grantee)
 	require.NoError(err)
 	require.Len(authzs, 1)
+
+	s.T().Log("verify an event is emitted for each pruned grant")
+	var pruneEvents int
+	for _, event := range newCtx.EventManager().Events() {
+		if event.Type == "cosmos.authz.v1beta1.EventPruneExpiredGrant" {
+			pruneEvents++
+		}
+	}
+	require.Equal(3, pruneEvents)
 }
 
 func (s *TestSuite) TestGetAuthorization() {
@@ -112,6 +112,20 @@ func (k Keeper) Exec(goCtx context.Context, msg *authz.MsgExec) (*authz.MsgExecR
 		return nil, err
 	}
 
+	if msg.ContinueOnFailure {
+		msgResults, err := k.DispatchActionsContinueOnFailure(ctx, grantee, msgs)
+		if err != nil {
+			return nil, err
+		}
+
+		results := make([][]byte, len(msgResults))
+		for i, res := range msgResults {
+			results[i] = res.Data
+		}
+
+		return &authz.MsgExecResponse{Results: results, MsgResults: msgResults}, nil
+	}
+
 	results, err := k.DispatchActions(ctx, grantee, msgs)
 	if err != nil {
 		return nil, err
@@ -316,10 +316,11 @@ func (suite *TestSuite) TestExec() {
 	}
 
 	testCases := []struct {
-		name     string
-		malleate func() authz.MsgExec
-		expErr   bool
-		errMsg   string
+		name       string
+		malleate   func() authz.MsgExec
+		expErr     bool
+		errMsg     string
+		expResults []authz.MsgExecResult
 	}{
 		{
 			name: "invalid grantee (empty)",
@@ -352,17 +353,32 @@ func (suite *TestSuite) TestExec() {
 				return authz.NewMsgExec(grantee, []sdk.Msg{msg})
 			},
 		},
+		{
+			name: "continue on failure with a failing message",
+			malleate: func() authz.MsgExec {
+				// the first message is over the spend limit of the grant
+				req := authz.NewMsgExec(grantee, []sdk.Msg{&banktypes.MsgSend{
+					FromAddress: granter.String(),
+					ToAddress:   grantee.String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin("steak", sdkmath.NewInt(1000))),
+				}, msg})
+				req.ContinueOnFailure = true
+				return req
+			},
+			expResults: []authz.MsgExecResult{{Success: false}, {Success: true, Data: []byte{}}},
+		},
 	}
 
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
 			req := tc.malleate()
-			_, err := suite.msgSrvr.Exec(suite.ctx, &req)
+			resp, err := suite.msgSrvr.Exec(suite.ctx, &req)
 			if tc.expErr {
 				suite.Require().Error(err)
 				suite.Require().Contains(err.Error(), tc.errMsg)
 			} else {
 				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResults, resp.MsgResults)
 			}
 		})
 	}
@@ -0,0 +1,224 @@
+package authz
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// gasCostPerRuleValue is the gas consumed for each value checked by the rules
+// of a RulesAuthorization.
+const gasCostPerRuleValue = uint64(10)
+
+var _ Authorization = &RulesAuthorization{}
+
+// NewRulesAuthorization creates a new RulesAuthorization object.
+func NewRulesAuthorization(msgTypeURL string, rules []FieldRule) *RulesAuthorization {
+	return &RulesAuthorization{
+		Msg:   msgTypeURL,
+		Rules: rules,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a RulesAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements Authorization.Accept. The message is accepted if its fields
+// satisfy all the rules of the authorization. The amounts of the fields with
+// max coins are deducted from them, and the authorization is deleted once the
+// max coins of one of its rules are spent.
+func (a RulesAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	if sdk.MsgTypeURL(msg) != a.Msg {
+		return AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	bz, err := codec.ProtoMarshalJSON(msg, nil)
+	if err != nil {
+		return AcceptResponse{}, err
+	}
+
+	var fields map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+	if err := decoder.Decode(&fields); err != nil {
+		return AcceptResponse{}, err
+	}
+
+	rules := make([]FieldRule, len(a.Rules))
+	spent, spentAll := false, false
+	for i, rule := range a.Rules {
+		value, found := lookupField(fields, rule.Field)
+		if !found {
+			return AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("field %s not found in %s", rule.Field, a.Msg)
+		}
+
+		left, err := rule.accept(ctx, value)
+		if err != nil {
+			return AcceptResponse{}, err
+		}
+
+		rules[i] = rule
+		if len(rule.MaxCoins) > 0 {
+			rules[i].MaxCoins = left
+			spent = true
+			spentAll = spentAll || left.IsZero()
+		}
+	}
+
+	switch {
+	case spentAll:
+		return AcceptResponse{Accept: true, Delete: true}, nil
+	case spent:
+		return AcceptResponse{Accept: true, Updated: &RulesAuthorization{Msg: a.Msg, Rules: rules}}, nil
+	default:
+		return AcceptResponse{Accept: true}, nil
+	}
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a RulesAuthorization) ValidateBasic() error {
+	if a.Msg == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("msg type URL cannot be empty")
+	}
+
+	if len(a.Rules) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("rules cannot be empty")
+	}
+
+	for _, rule := range a.Rules {
+		if err := rule.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateBasic performs a basic validation of the field rule.
+func (r FieldRule) ValidateBasic() error {
+	if r.Field == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("rule field cannot be empty")
+	}
+
+	if len(r.AllowedValues) == 0 && len(r.MaxCoins) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("rule of field %s must set allowed values or max coins", r.Field)
+	}
+
+	if len(r.MaxCoins) > 0 && !r.MaxCoins.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid max coins of field %s: %s", r.Field, r.MaxCoins)
+	}
+
+	return nil
+}
+
+// accept checks that the value of the field satisfies the rule, and returns the
+// max coins left once the amount of the field is deducted from them.
+func (r FieldRule) accept(ctx sdk.Context, value interface{}) (sdk.Coins, error) {
+	if len(r.AllowedValues) > 0 {
+		values, ok := value.([]interface{})
+		if !ok {
+			values = []interface{}{value}
+		}
+
+		for _, v := range values {
+			s, ok := scalarToString(v)
+			if !ok {
+				return nil, sdkerrors.ErrUnauthorized.Wrapf("field %s is not a scalar or a list of scalars", r.Field)
+			}
+
+			if !r.isAllowed(ctx, s) {
+				return nil, sdkerrors.ErrUnauthorized.Wrapf("value %s of field %s is not allowed", s, r.Field)
+			}
+		}
+	}
+
+	if len(r.MaxCoins) == 0 {
+		return nil, nil
+	}
+
+	ctx.GasMeter().ConsumeGas(gasCostPerRuleValue, "rules authorization")
+
+	coins, err := valueToCoins(value)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "field %s is not a coin or a list of coins: %s", r.Field, err)
+	}
+
+	left, isNegative := r.MaxCoins.SafeSub(coins...)
+	if isNegative {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("field %s amount %s is more than the maximum %s left", r.Field, coins, r.MaxCoins)
+	}
+
+	return left, nil
+}
+
+func (r FieldRule) isAllowed(ctx sdk.Context, value string) bool {
+	for _, allowed := range r.AllowedValues {
+		ctx.GasMeter().ConsumeGas(gasCostPerRuleValue, "rules authorization")
+		if allowed == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// lookupField returns the value of the field at the given dot separated path.
+func lookupField(fields map[string]interface{}, path string) (interface{}, bool) {
+	var value interface{} = fields
+	for _, name := range strings.Split(path, ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+
+		value, ok = object[name]
+		if !ok {
+			return nil, false
+		}
+	}
+
+	return value, true
+}
+
+func scalarToString(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case json.Number, bool:
+		return fmt.Sprint(v), true
+	default:
+		return "", false
+	}
+}
+
+func valueToCoins(value interface{}) (sdk.Coins, error) {
+	bz, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := value.([]interface{}); ok {
+		var coins sdk.Coins
+		if err := json.Unmarshal(bz, &coins); err != nil {
+			return nil, err
+		}
+
+		return coins, nil
+	}
+
+	var coin sdk.Coin
+	if err := json.Unmarshal(bz, &coin); err != nil {
+		return nil, err
+	}
+
+	return sdk.Coins{coin}, nil
+}
@@ -0,0 +1,106 @@
+package authz_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestRulesAuthorization(t *testing.T) {
+	ctx := testutil.DefaultContextWithDB(t, storetypes.NewKVStoreKey(authz.ModuleName), storetypes.NewTransientStoreKey("transient_test")).Ctx.WithBlockHeader(cmtproto.Header{})
+	fromAddr := sdk.AccAddress("_____from _____")
+	toAddr := sdk.AccAddress("_______to________")
+	unknownAddr := sdk.AccAddress("_____unknown_____")
+	coins40 := sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(40)))
+	coins60 := sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(60)))
+	coins100 := sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(100)))
+	coins500 := sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(500)))
+
+	t.Log("verify ValidateBasic rejects invalid rules")
+	sendTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	require.Error(t, authz.NewRulesAuthorization("", []authz.FieldRule{{Field: "to_address", AllowedValues: []string{toAddr.String()}}}).ValidateBasic())
+	require.Error(t, authz.NewRulesAuthorization(sendTypeURL, nil).ValidateBasic())
+	require.Error(t, authz.NewRulesAuthorization(sendTypeURL, []authz.FieldRule{{Field: "to_address"}}).ValidateBasic())
+	require.Error(t, authz.NewRulesAuthorization(sendTypeURL, []authz.FieldRule{{AllowedValues: []string{toAddr.String()}}}).ValidateBasic())
+
+	a := authz.NewRulesAuthorization(sendTypeURL, []authz.FieldRule{
+		{Field: "to_address", AllowedValues: []string{toAddr.String()}},
+		{Field: "amount", MaxCoins: coins100},
+	})
+	require.NoError(t, a.ValidateBasic())
+	require.Equal(t, sendTypeURL, a.MsgTypeURL())
+
+	t.Log("verify a message satisfying all the rules is accepted and spends the max coins")
+	resp, err := a.Accept(ctx, banktypes.NewMsgSend(fromAddr, toAddr, coins40))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Equal(t, authz.NewRulesAuthorization(sendTypeURL, []authz.FieldRule{
+		{Field: "to_address", AllowedValues: []string{toAddr.String()}},
+		{Field: "amount", MaxCoins: coins60},
+	}), resp.Updated)
+	require.Equal(t, coins100, a.Rules[1].MaxCoins)
+
+	t.Log("verify the max coins are cumulative across messages")
+	updated := resp.Updated.(*authz.RulesAuthorization)
+	_, err = updated.Accept(ctx, banktypes.NewMsgSend(fromAddr, toAddr, coins100))
+	require.ErrorContains(t, err, "is more than the maximum")
+
+	resp, err = updated.Accept(ctx, banktypes.NewMsgSend(fromAddr, toAddr, coins60))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+
+	t.Log("verify a message with a value that is not allowed is rejected")
+	_, err = a.Accept(ctx, banktypes.NewMsgSend(fromAddr, unknownAddr, coins100))
+	require.ErrorContains(t, err, "is not allowed")
+
+	t.Log("verify a message with an amount over the maximum is rejected")
+	_, err = a.Accept(ctx, banktypes.NewMsgSend(fromAddr, toAddr, coins500))
+	require.ErrorContains(t, err, "is more than the maximum")
+
+	t.Log("verify a message of another type is rejected")
+	_, err = a.Accept(ctx, &stakingtypes.MsgDelegate{})
+	require.Error(t, err)
+
+	t.Log("verify rules apply to single coins and nested fields")
+	valAddr := sdk.ValAddress(toAddr)
+	delegate := authz.NewRulesAuthorization(sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}), []authz.FieldRule{
+		{Field: "validator_address", AllowedValues: []string{valAddr.String()}},
+		{Field: "amount", MaxCoins: coins100},
+		{Field: "amount.denom", AllowedValues: []string{"stake"}},
+	})
+	require.NoError(t, delegate.ValidateBasic())
+
+	resp, err = delegate.Accept(ctx, stakingtypes.NewMsgDelegate(fromAddr, valAddr, coins40[0]))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.NotNil(t, resp.Updated)
+
+	_, err = delegate.Accept(ctx, stakingtypes.NewMsgDelegate(fromAddr, valAddr, coins500[0]))
+	require.ErrorContains(t, err, "is more than the maximum")
+
+	t.Log("verify an authorization without max coins is not updated")
+	recipients := authz.NewRulesAuthorization(sendTypeURL, []authz.FieldRule{{Field: "to_address", AllowedValues: []string{toAddr.String()}}})
+	resp, err = recipients.Accept(ctx, banktypes.NewMsgSend(fromAddr, toAddr, coins500))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+
+	t.Log("verify a rule on an unknown field rejects the message")
+	unknown := authz.NewRulesAuthorization(sendTypeURL, []authz.FieldRule{{Field: "unknown", AllowedValues: []string{"value"}}})
+	_, err = unknown.Accept(ctx, banktypes.NewMsgSend(fromAddr, toAddr, coins100))
+	require.ErrorContains(t, err, "not found")
+}
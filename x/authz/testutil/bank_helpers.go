@@ -23,3 +23,23 @@ func (k MockBankKeeper) UpdateParams(goCtx context.Context, req *bank.MsgUpdateP
 func (k MockBankKeeper) SetSendEnabled(goCtx context.Context, req *bank.MsgSetSendEnabled) (*bank.MsgSetSendEnabledResponse, error) {
 	return nil, nil
 }
+
+func (k MockBankKeeper) SetPausedDenoms(goCtx context.Context, req *bank.MsgSetPausedDenoms) (*bank.MsgSetPausedDenomsResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) SetDenomMetadata(goCtx context.Context, req *bank.MsgSetDenomMetadata) (*bank.MsgSetDenomMetadataResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) CreateEscrow(goCtx context.Context, req *bank.MsgCreateEscrow) (*bank.MsgCreateEscrowResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) AcceptEscrow(goCtx context.Context, req *bank.MsgAcceptEscrow) (*bank.MsgAcceptEscrowResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) RefundEscrow(goCtx context.Context, req *bank.MsgRefundEscrow) (*bank.MsgRefundEscrowResponse, error) {
+	return nil, nil
+}
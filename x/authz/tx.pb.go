@@ -76,6 +76,11 @@ var xxx_messageInfo_MsgGrant proto.InternalMessageInfo
 // MsgExecResponse defines the Msg/MsgExecResponse response type.
 type MsgExecResponse struct {
 	Results [][]byte `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// msg_results are the results of each message, set when the messages are
+	// executed with continue_on_failure.
+	//
+	// Since: cosmos-sdk 0.50
+	MsgResults []MsgExecResult `protobuf:"bytes,2,rep,name=msg_results,json=msgResults,proto3" json:"msg_results"`
 }
 
 func (m *MsgExecResponse) Reset()         { *m = MsgExecResponse{} }
@@ -111,6 +116,49 @@ func (m *MsgExecResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgExecResponse proto.InternalMessageInfo
 
+// MsgExecResult defines the result of the execution of a message of MsgExec.
+//
+// Since: cosmos-sdk 0.50
+type MsgExecResult struct {
+	// success is true if the message was executed successfully.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// data is the data returned by the message handler.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *MsgExecResult) Reset()         { *m = MsgExecResult{} }
+func (m *MsgExecResult) String() string { return proto.CompactTextString(m) }
+func (*MsgExecResult) ProtoMessage()    {}
+func (*MsgExecResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{2}
+}
+func (m *MsgExecResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecResult.Merge(m, src)
+}
+func (m *MsgExecResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecResult proto.InternalMessageInfo
+
 // MsgExec attempts to execute the provided messages using
 // authorizations granted to the grantee. Each message should have only
 // one signer corresponding to the granter of the authorization.
@@ -120,13 +168,19 @@ type MsgExec struct {
 	// The x/authz will try to find a grant matching (msg.signers[0], grantee, MsgTypeURL(msg))
 	// triple and validate it.
 	Msgs []*types.Any `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// continue_on_failure, when set, executes each message independently: the
+	// state changes of a failing message are reverted and the execution
+	// continues with the next message, instead of failing the whole MsgExec.
+	//
+	// Since: cosmos-sdk 0.50
+	ContinueOnFailure bool `protobuf:"varint,3,opt,name=continue_on_failure,json=continueOnFailure,proto3" json:"continue_on_failure,omitempty"`
 }
 
 func (m *MsgExec) Reset()         { *m = MsgExec{} }
 func (m *MsgExec) String() string { return proto.CompactTextString(m) }
 func (*MsgExec) ProtoMessage()    {}
 func (*MsgExec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ceddab7d8589ad1, []int{2}
+	return fileDescriptor_3ceddab7d8589ad1, []int{3}
 }
 func (m *MsgExec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -163,7 +217,7 @@ func (m *MsgGrantResponse) Reset()         { *m = MsgGrantResponse{} }
 func (m *MsgGrantResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgGrantResponse) ProtoMessage()    {}
 func (*MsgGrantResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ceddab7d8589ad1, []int{3}
+	return fileDescriptor_3ceddab7d8589ad1, []int{4}
 }
 func (m *MsgGrantResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -204,7 +258,7 @@ func (m *MsgRevoke) Reset()         { *m = MsgRevoke{} }
 func (m *MsgRevoke) String() string { return proto.CompactTextString(m) }
 func (*MsgRevoke) ProtoMessage()    {}
 func (*MsgRevoke) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ceddab7d8589ad1, []int{4}
+	return fileDescriptor_3ceddab7d8589ad1, []int{5}
 }
 func (m *MsgRevoke) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -241,7 +295,7 @@ func (m *MsgRevokeResponse) Reset()         { *m = MsgRevokeResponse{} }
 func (m *MsgRevokeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRevokeResponse) ProtoMessage()    {}
 func (*MsgRevokeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ceddab7d8589ad1, []int{5}
+	return fileDescriptor_3ceddab7d8589ad1, []int{6}
 }
 func (m *MsgRevokeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -273,6 +327,7 @@ var xxx_messageInfo_MsgRevokeResponse proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*MsgGrant)(nil), "cosmos.authz.v1beta1.MsgGrant")
 	proto.RegisterType((*MsgExecResponse)(nil), "cosmos.authz.v1beta1.MsgExecResponse")
+	proto.RegisterType((*MsgExecResult)(nil), "cosmos.authz.v1beta1.MsgExecResult")
 	proto.RegisterType((*MsgExec)(nil), "cosmos.authz.v1beta1.MsgExec")
 	proto.RegisterType((*MsgGrantResponse)(nil), "cosmos.authz.v1beta1.MsgGrantResponse")
 	proto.RegisterType((*MsgRevoke)(nil), "cosmos.authz.v1beta1.MsgRevoke")
@@ -282,42 +337,47 @@ func init() {
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/tx.proto", fileDescriptor_3ceddab7d8589ad1) }
 
 var fileDescriptor_3ceddab7d8589ad1 = []byte{
-	// 555 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x4f, 0x6f, 0x12, 0x4f,
-	0x18, 0x66, 0x4a, 0x29, 0x3f, 0xa6, 0x4d, 0x7e, 0x76, 0x4b, 0xe2, 0x76, 0x9b, 0x6e, 0x37, 0x6b,
-	0xab, 0x04, 0xc3, 0x4c, 0xc0, 0x1b, 0xf1, 0x52, 0x92, 0xc6, 0x8b, 0xc4, 0x64, 0xd5, 0x8b, 0x17,
-	0xb2, 0xc0, 0x38, 0x25, 0x65, 0x77, 0xc8, 0xce, 0x42, 0xc0, 0x93, 0xf1, 0xe8, 0xc9, 0x8f, 0xa1,
-	0x37, 0x0e, 0x3d, 0xfa, 0x01, 0x88, 0xa7, 0xc6, 0x83, 0xf1, 0x64, 0x14, 0x0e, 0x7c, 0x0c, 0xcd,
-	0xce, 0x1f, 0xa4, 0x86, 0xd6, 0x9e, 0xbc, 0xc0, 0xbc, 0xef, 0xf3, 0xbc, 0xb3, 0xef, 0xf3, 0x3e,
-	0x6f, 0x06, 0xee, 0xb7, 0x18, 0x0f, 0x18, 0xc7, 0x7e, 0x3f, 0x3e, 0x7d, 0x85, 0x07, 0xe5, 0x26,
-	0x89, 0xfd, 0x32, 0x8e, 0x87, 0xa8, 0x17, 0xb1, 0x98, 0x19, 0x79, 0x09, 0x23, 0x01, 0x23, 0x05,
-	0x5b, 0xbb, 0x32, 0xdb, 0x10, 0x1c, 0xac, 0x28, 0x22, 0xb0, 0xf2, 0x94, 0x51, 0x26, 0xf3, 0xc9,
-	0x49, 0x65, 0x77, 0x29, 0x63, 0xb4, 0x4b, 0xb0, 0x88, 0x9a, 0xfd, 0x97, 0xd8, 0x0f, 0x47, 0x0a,
-	0x72, 0x56, 0x36, 0x20, 0xbf, 0x27, 0x19, 0xb7, 0x15, 0x23, 0xe0, 0x14, 0x0f, 0xca, 0xc9, 0x9f,
-	0x02, 0xb6, 0xfd, 0xa0, 0x13, 0x32, 0x2c, 0x7e, 0x65, 0xca, 0xfd, 0x02, 0xe0, 0x7f, 0x75, 0x4e,
-	0x1f, 0x45, 0x7e, 0x18, 0x1b, 0x15, 0x98, 0xa5, 0xc9, 0x81, 0x44, 0x26, 0x70, 0x40, 0x21, 0x57,
-	0x33, 0x3f, 0x9f, 0x97, 0xb4, 0xa2, 0xe3, 0x76, 0x3b, 0x22, 0x9c, 0x3f, 0x8d, 0xa3, 0x4e, 0x48,
-	0x3d, 0x4d, 0xfc, 0x5d, 0x43, 0xcc, 0xb5, 0x9b, 0xd5, 0x10, 0xe3, 0x21, 0xcc, 0x88, 0xa3, 0x99,
-	0x76, 0x40, 0x61, 0xb3, 0xb2, 0x87, 0x56, 0x0d, 0x0d, 0x89, 0x9e, 0x6a, 0xb9, 0xc9, 0xb7, 0x83,
-	0xd4, 0xfb, 0xf9, 0xb8, 0x08, 0x3c, 0x59, 0x54, 0x3d, 0x7c, 0x33, 0x1f, 0x17, 0xf5, 0xf7, 0xdf,
-	0xce, 0xc7, 0xc5, 0x1d, 0x59, 0x5e, 0xe2, 0xed, 0x33, 0xac, 0xb5, 0xb8, 0xf7, 0xe1, 0xff, 0x75,
-	0x4e, 0x4f, 0x86, 0xa4, 0xe5, 0x11, 0xde, 0x63, 0x21, 0x27, 0x86, 0x09, 0xb3, 0x11, 0xe1, 0xfd,
-	0x6e, 0xcc, 0x4d, 0xe0, 0xa4, 0x0b, 0x5b, 0x9e, 0x0e, 0xdd, 0x0f, 0x00, 0x66, 0x15, 0x7b, 0x59,
-	0x10, 0xb8, 0xa9, 0xa0, 0x13, 0xb8, 0x1e, 0x70, 0xca, 0xcd, 0x35, 0x27, 0x5d, 0xd8, 0xac, 0xe4,
-	0x91, 0x74, 0x0f, 0x69, 0xf7, 0xd0, 0x71, 0x38, 0xaa, 0xed, 0x7d, 0x3a, 0x2f, 0x29, 0x67, 0x50,
-	0xd3, 0xe7, 0x64, 0xa1, 0xb3, 0xce, 0xa9, 0x27, 0xca, 0xab, 0x77, 0x96, 0x94, 0x91, 0x44, 0x99,
-	0x71, 0x59, 0x59, 0xd2, 0x9f, 0x6b, 0xc0, 0x5b, 0x5a, 0xa4, 0x56, 0xe6, 0x7e, 0x04, 0x30, 0x97,
-	0x5c, 0x43, 0x06, 0xec, 0x8c, 0xfc, 0x33, 0x1b, 0x1d, 0xb8, 0x15, 0x70, 0xda, 0x88, 0x47, 0x3d,
-	0xd2, 0xe8, 0x47, 0x5d, 0xe1, 0x66, 0xce, 0x83, 0x01, 0xa7, 0xcf, 0x46, 0x3d, 0xf2, 0x3c, 0xea,
-	0x56, 0x8f, 0xfe, 0xb4, 0x2a, 0x7f, 0x59, 0x90, 0x6c, 0xd8, 0xdd, 0x81, 0xdb, 0x8b, 0x40, 0x6b,
-	0xaa, 0xfc, 0x04, 0x30, 0x5d, 0xe7, 0xd4, 0x78, 0x02, 0x33, 0x72, 0x3b, 0xed, 0xd5, 0x6b, 0xa2,
-	0x87, 0x61, 0xdd, 0xbd, 0x1e, 0x5f, 0xac, 0xc1, 0x63, 0xb8, 0x2e, 0x8c, 0xde, 0xbf, 0x92, 0x9f,
-	0xc0, 0xd6, 0xd1, 0xb5, 0xf0, 0xe2, 0x36, 0x0f, 0x6e, 0xa8, 0xb1, 0x1f, 0x5c, 0x59, 0x20, 0x09,
-	0xd6, 0xbd, 0xbf, 0x10, 0xf4, 0x9d, 0x56, 0xe6, 0x75, 0xb2, 0xef, 0xb5, 0xda, 0xe4, 0x87, 0x9d,
-	0x9a, 0x4c, 0x6d, 0x70, 0x31, 0xb5, 0xc1, 0xf7, 0xa9, 0x0d, 0xde, 0xcd, 0xec, 0xd4, 0xc5, 0xcc,
-	0x4e, 0x7d, 0x9d, 0xd9, 0xa9, 0x17, 0x87, 0xb4, 0x13, 0x9f, 0xf6, 0x9b, 0xa8, 0xc5, 0x02, 0xf5,
-	0xa2, 0xe0, 0xa5, 0xe1, 0x0e, 0xe5, 0x8b, 0xd0, 0xdc, 0x10, 0x3b, 0xf8, 0xe0, 0x57, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xbb, 0x9d, 0x9d, 0x9e, 0xb7, 0x04, 0x00, 0x00,
+	// 633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x3d, 0x6f, 0xd3, 0x4e,
+	0x18, 0xcf, 0x35, 0xe9, 0x4b, 0xae, 0xfd, 0xeb, 0x4f, 0xdd, 0x48, 0xb8, 0xae, 0xea, 0x5a, 0x6e,
+	0x0b, 0x51, 0xa5, 0xda, 0x6a, 0xd8, 0x2a, 0x18, 0x6a, 0xa9, 0x20, 0x21, 0xa2, 0x4a, 0x06, 0x16,
+	0x96, 0xc8, 0x71, 0xae, 0xd7, 0xa8, 0xb6, 0x2f, 0xf2, 0x9d, 0x43, 0xc2, 0x84, 0x18, 0x99, 0xf8,
+	0x18, 0x8c, 0x19, 0x3a, 0xf2, 0x01, 0x22, 0xa6, 0x8a, 0x01, 0xc1, 0x82, 0x20, 0x19, 0xf2, 0x31,
+	0x40, 0xbe, 0xf3, 0xb5, 0x09, 0x4a, 0x5f, 0x26, 0x96, 0xe4, 0x9e, 0xe7, 0xf7, 0x3c, 0xf7, 0xbc,
+	0xfc, 0x7e, 0x3e, 0xb8, 0xee, 0x13, 0x1a, 0x12, 0x6a, 0x7b, 0x09, 0x3b, 0x79, 0x63, 0xb7, 0xf7,
+	0xea, 0x88, 0x79, 0x7b, 0x36, 0xeb, 0x58, 0xad, 0x98, 0x30, 0xa2, 0x94, 0x04, 0x6c, 0x71, 0xd8,
+	0xca, 0x60, 0x6d, 0x55, 0x78, 0x6b, 0x3c, 0xc6, 0xce, 0x42, 0xb8, 0xa1, 0x95, 0x30, 0xc1, 0x44,
+	0xf8, 0xd3, 0x53, 0xe6, 0x5d, 0xc5, 0x84, 0xe0, 0x00, 0xd9, 0xdc, 0xaa, 0x27, 0xc7, 0xb6, 0x17,
+	0x75, 0x33, 0xc8, 0x98, 0xda, 0x80, 0xa8, 0x27, 0x22, 0xee, 0x66, 0x11, 0x21, 0xc5, 0x76, 0x7b,
+	0x2f, 0xfd, 0xcb, 0x80, 0x65, 0x2f, 0x6c, 0x46, 0xc4, 0xe6, 0xbf, 0xc2, 0x65, 0x7e, 0x05, 0x70,
+	0xa1, 0x4a, 0xf1, 0x93, 0xd8, 0x8b, 0x98, 0x52, 0x81, 0xf3, 0x38, 0x3d, 0xa0, 0x58, 0x05, 0x06,
+	0x28, 0x17, 0x1d, 0xf5, 0xcb, 0xd9, 0xae, 0x9c, 0xe8, 0xa0, 0xd1, 0x88, 0x11, 0xa5, 0xcf, 0x59,
+	0xdc, 0x8c, 0xb0, 0x2b, 0x03, 0x2f, 0x73, 0x90, 0x3a, 0x73, 0xbb, 0x1c, 0xa4, 0x3c, 0x84, 0xb3,
+	0xfc, 0xa8, 0xe6, 0x0d, 0x50, 0x5e, 0xac, 0xac, 0x59, 0xd3, 0x96, 0x66, 0xf1, 0x9e, 0x9c, 0x62,
+	0xff, 0xc7, 0x46, 0xee, 0xe3, 0xa8, 0xb7, 0x03, 0x5c, 0x91, 0xb4, 0xbf, 0xf5, 0x6e, 0xd4, 0xdb,
+	0x91, 0xf5, 0xdf, 0x8f, 0x7a, 0x3b, 0x2b, 0x22, 0x7d, 0x97, 0x36, 0x4e, 0x6d, 0x39, 0x8b, 0xf9,
+	0x1a, 0xfe, 0x5f, 0xa5, 0xf8, 0xb0, 0x83, 0x7c, 0x17, 0xd1, 0x16, 0x89, 0x28, 0x52, 0x54, 0x38,
+	0x1f, 0x23, 0x9a, 0x04, 0x8c, 0xaa, 0xc0, 0xc8, 0x97, 0x97, 0x5c, 0x69, 0x2a, 0x4f, 0xe1, 0x62,
+	0x48, 0x71, 0x4d, 0xa2, 0x33, 0x46, 0xbe, 0xbc, 0x58, 0xd9, 0x9c, 0xde, 0xd6, 0xe5, 0xad, 0x49,
+	0xc0, 0x9c, 0x42, 0xda, 0x9e, 0x0b, 0x43, 0x8a, 0x85, 0x83, 0x9a, 0x8f, 0xe0, 0x7f, 0x13, 0x21,
+	0x69, 0x59, 0x9a, 0xf8, 0x3e, 0xa2, 0x94, 0x6f, 0x75, 0xc1, 0x95, 0xa6, 0xa2, 0xc0, 0x42, 0xc3,
+	0x63, 0x1e, 0x5f, 0xdc, 0x92, 0xcb, 0xcf, 0xe6, 0x77, 0x00, 0xe7, 0xb3, 0xfc, 0xf1, 0xdd, 0x82,
+	0xdb, 0xee, 0xf6, 0x10, 0x16, 0x42, 0x8a, 0xe5, 0x0c, 0x25, 0x4b, 0x08, 0xc9, 0x92, 0x42, 0xb2,
+	0x0e, 0xa2, 0xae, 0xb3, 0xf6, 0xf9, 0x6c, 0x37, 0x13, 0x89, 0x55, 0xf7, 0x28, 0x1a, 0x9f, 0xcd,
+	0xe5, 0xe9, 0x8a, 0x05, 0x57, 0x7c, 0x12, 0xb1, 0x66, 0x94, 0xa0, 0x1a, 0x89, 0x6a, 0xc7, 0x5e,
+	0x33, 0x48, 0x62, 0xc4, 0x09, 0x5b, 0x70, 0x97, 0x25, 0x74, 0x14, 0x3d, 0x16, 0xc0, 0xfe, 0xe6,
+	0x18, 0x29, 0x28, 0x25, 0x45, 0x99, 0x24, 0x25, 0x9d, 0xc7, 0x54, 0xe0, 0x1d, 0xc9, 0x8f, 0x24,
+	0xc5, 0xfc, 0x04, 0x60, 0x31, 0x2d, 0x8b, 0xda, 0xe4, 0x14, 0xfd, 0x33, 0x05, 0x1a, 0x70, 0x29,
+	0x25, 0x9c, 0x75, 0x5b, 0xa8, 0x96, 0xc4, 0x01, 0x9f, 0xab, 0xc8, 0x69, 0x7c, 0xd1, 0x6d, 0xa1,
+	0x97, 0x71, 0xb0, 0xbf, 0xfd, 0xb7, 0xca, 0x4a, 0x93, 0x03, 0x89, 0x86, 0xcd, 0x15, 0xb8, 0x7c,
+	0x61, 0xc8, 0x99, 0x2a, 0xbf, 0x01, 0xcc, 0x57, 0x29, 0x56, 0x8e, 0xe0, 0xac, 0xf8, 0xb0, 0xf4,
+	0x2b, 0xa5, 0xc4, 0x71, 0xed, 0xde, 0xf5, 0xf8, 0x85, 0x82, 0x9f, 0xc1, 0x02, 0x17, 0xc6, 0xfa,
+	0xb5, 0xd2, 0xd4, 0xb6, 0x6f, 0x52, 0xae, 0xb8, 0xcd, 0x85, 0x73, 0xd9, 0xda, 0x37, 0xae, 0x4c,
+	0x10, 0x01, 0xda, 0xfd, 0x1b, 0x02, 0xe4, 0x9d, 0xda, 0xec, 0xdb, 0xf4, 0x53, 0x75, 0x9c, 0xfe,
+	0x2f, 0x3d, 0xd7, 0x1f, 0xe8, 0xe0, 0x7c, 0xa0, 0x83, 0x9f, 0x03, 0x1d, 0x7c, 0x18, 0xea, 0xb9,
+	0xf3, 0xa1, 0x9e, 0xfb, 0x36, 0xd4, 0x73, 0xaf, 0xb6, 0x70, 0x93, 0x9d, 0x24, 0x75, 0xcb, 0x27,
+	0x61, 0xf6, 0x18, 0xda, 0x63, 0xcb, 0xed, 0x88, 0xc7, 0xac, 0x3e, 0xc7, 0x35, 0xfb, 0xe0, 0xcf,
+	0x00, 0x0d, 0xd7, 0x5b, 0xec, 0x72, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -557,6 +617,20 @@ func (m *MsgExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgResults) > 0 {
+		for iNdEx := len(m.MsgResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Results) > 0 {
 		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Results[iNdEx])
@@ -569,6 +643,46 @@ func (m *MsgExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgExecResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgExec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -589,6 +703,16 @@ func (m *MsgExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ContinueOnFailure {
+		i--
+		if m.ContinueOnFailure {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Msgs) > 0 {
 		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -745,6 +869,28 @@ func (m *MsgExecResponse) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if len(m.MsgResults) > 0 {
+		for _, e := range m.MsgResults {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExecResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -764,6 +910,9 @@ func (m *MsgExec) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if m.ContinueOnFailure {
+		n += 2
+	}
 	return n
 }
 
@@ -1020,6 +1169,144 @@ func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 			m.Results = append(m.Results, make([]byte, postIndex-iNdEx))
 			copy(m.Results[len(m.Results)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgResults = append(m.MsgResults, MsgExecResult{})
+			if err := m.MsgResults[len(m.MsgResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1136,6 +1423,26 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOnFailure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ContinueOnFailure = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// denomsCompletionCacheKey is the completion cache key under which the denoms
+// registered in the bank metadata are stored.
+const denomsCompletionCacheKey = "bank-denoms"
+
+// DenomCompletion is a client.CompletionFunc that suggests the base denoms for
+// which metadata has been registered in the bank module.
+func DenomCompletion(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	denoms, err := client.CachedCompletions(clientCtx, denomsCompletionCacheKey, client.DefaultCompletionCacheTTL, func() ([]string, error) {
+		queryClient := types.NewQueryClient(clientCtx)
+
+		var (
+			denoms []string
+			key    []byte
+		)
+		for {
+			res, err := queryClient.DenomsMetadata(cmd.Context(), &types.QueryDenomsMetadataRequest{
+				Pagination: &query.PageRequest{Key: key},
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			for _, metadata := range res.Metadatas {
+				denoms = append(denoms, metadata.Base)
+			}
+
+			if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+				return denoms, nil
+			}
+			key = res.Pagination.NextKey
+		}
+	})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	return client.FilterCompletions(denoms, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
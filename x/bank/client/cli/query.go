@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -36,6 +37,10 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryTotalSupply(),
 		GetCmdDenomsMetadata(),
 		GetCmdQuerySendEnabled(),
+		GetCmdQueryPausedDenoms(),
+		GetCmdQueryDenomOwners(),
+		GetCmdQueryEscrow(),
+		GetCmdQueryEscrows(),
 	)
 
 	return cmd
@@ -113,6 +118,7 @@ Example:
 	cmd.Flags().Bool(FlagResolveDenom, false, "Resolve denom to human-readable denom from metadata")
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "all balances")
+	_ = cmd.RegisterFlagCompletionFunc(FlagDenom, DenomCompletion)
 
 	return cmd
 }
@@ -173,6 +179,7 @@ func GetSpendableBalancesCmd() *cobra.Command {
 	cmd.Flags().String(FlagDenom, "", "The specific balance denomination to query for")
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "spendable balances")
+	_ = cmd.RegisterFlagCompletionFunc(FlagDenom, DenomCompletion)
 
 	return cmd
 }
@@ -228,6 +235,7 @@ To query for the client metadata of a specific coin denomination use:
 
 	cmd.Flags().String(FlagDenom, "", "The specific denomination to query client metadata for")
 	flags.AddQueryFlagsToCmd(cmd)
+	_ = cmd.RegisterFlagCompletionFunc(FlagDenom, DenomCompletion)
 
 	return cmd
 }
@@ -289,6 +297,7 @@ To query for the total supply of a specific coin denomination use:
 	cmd.Flags().String(FlagDenom, "", "The specific balance denomination to query for")
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "all supply totals")
+	_ = cmd.RegisterFlagCompletionFunc(FlagDenom, DenomCompletion)
 
 	return cmd
 }
@@ -344,6 +353,157 @@ Getting all entries:
 
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "send enabled entries")
+	cmd.ValidArgsFunction = DenomCompletion
+
+	return cmd
+}
+
+func GetCmdQueryPausedDenoms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "paused-denoms",
+		Short: "Query for the denoms whose transfers are paused",
+		Example: fmt.Sprintf("$ %s query %s paused-denoms",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			reqPag, err := client.ReadPageRequest(client.MustFlagSetWithPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PausedDenoms(cmd.Context(), &types.QueryPausedDenomsRequest{Pagination: reqPag})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "paused denoms")
+
+	return cmd
+}
+
+// GetCmdQueryDenomOwners defines the cobra command to query the accounts holding
+// a denom.
+func GetCmdQueryDenomOwners() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-owners [denom]",
+		Short: "Query for the accounts holding a denom",
+		Long:  "Query for the accounts holding a denom, with their balance of the denom.",
+		Example: fmt.Sprintf("$ %s query %s denom-owners uatom",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			reqPag, err := client.ReadPageRequest(client.MustFlagSetWithPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := sdk.ValidateDenom(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DenomOwners(cmd.Context(), &types.QueryDenomOwnersRequest{
+				Denom:      args[0],
+				Pagination: reqPag,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denom owners")
+	cmd.ValidArgsFunction = DenomCompletion
+
+	return cmd
+}
+
+func GetCmdQueryEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "escrow [id]",
+		Short: "Query for an escrow by its id",
+		Example: fmt.Sprintf("$ %s query %s escrow 1",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid escrow id: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Escrow(cmd.Context(), &types.QueryEscrowRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Escrow)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryEscrows() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "escrows",
+		Short: "Query for the pending escrows",
+		Example: fmt.Sprintf("$ %s query %s escrows",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			reqPag, err := client.ReadPageRequest(client.MustFlagSetWithPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Escrows(cmd.Context(), &types.QueryEscrowsRequest{Pagination: reqPag})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "escrows")
 
 	return cmd
 }
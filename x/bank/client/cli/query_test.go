@@ -116,6 +116,30 @@ func (s *CLITestSuite) TestGetBalancesCmd() {
 	}
 }
 
+func (s *CLITestSuite) TestGetBalancesCmdScriptedNode() {
+	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
+
+	node := clitestutil.NewScriptedCometRPC()
+	balance := sdk.NewInt64Coin("photon", 42)
+	node.SetGRPCQueryResponse("/cosmos.bank.v1beta1.Query/Balance", &types.QueryBalanceResponse{Balance: &balance})
+	clientCtx := s.baseCtx.WithClient(node)
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetBalancesCmd(), []string{
+		accounts[0].Address.String(),
+		fmt.Sprintf("--%s=photon", cli.FlagDenom),
+		fmt.Sprintf("--%s=json", flags.FlagOutput),
+	})
+	s.Require().NoError(err)
+
+	var res sdk.Coin
+	s.Require().NoError(s.encCfg.Codec.UnmarshalJSON(out.Bytes(), &res))
+	s.Require().Equal(balance, res)
+
+	// queries without a scripted response fail
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, cli.GetBalancesCmd(), []string{accounts[0].Address.String()})
+	s.Require().Error(err)
+}
+
 func (s *CLITestSuite) TestGetSpendableBalancesCmd() {
 	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
 
@@ -426,3 +450,214 @@ func (s *CLITestSuite) TestGetCmdQuerySendEnabled() {
 		})
 	}
 }
+
+func (s *CLITestSuite) TestGetCmdQueryPausedDenoms() {
+	cmd := cli.GetCmdQueryPausedDenoms()
+	cmd.SetOutput(io.Discard)
+
+	testCases := []struct {
+		name         string
+		ctxGen       func() client.Context
+		args         []string
+		expectResult proto.Message
+		expectErr    bool
+	}{
+		{
+			"valid query",
+			func() client.Context {
+				bz, _ := s.encCfg.Codec.Marshal(&types.QueryPausedDenomsResponse{
+					Denoms: []string{"photon"},
+				})
+				c := clitestutil.NewMockCometRPC(abci.ResponseQuery{
+					Value: bz,
+				})
+				return s.baseCtx.WithClient(c)
+			},
+			[]string{
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			&types.QueryPausedDenomsResponse{},
+			false,
+		},
+		{
+			"invalid args",
+			func() client.Context {
+				return s.baseCtx
+			},
+			[]string{
+				"photon",
+			},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+
+			cmd.SetContext(ctx)
+			cmd.SetArgs(tc.args)
+
+			s.Require().NoError(client.SetCmdClientContextHandler(tc.ctxGen(), cmd))
+
+			out, err := clitestutil.ExecTestCLICmd(tc.ctxGen(), cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(s.encCfg.Codec.UnmarshalJSON(out.Bytes(), tc.expectResult))
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *CLITestSuite) TestGetCmdQueryDenomOwners() {
+	cmd := cli.GetCmdQueryDenomOwners()
+	cmd.SetOutput(io.Discard)
+
+	testCases := []struct {
+		name         string
+		ctxGen       func() client.Context
+		args         []string
+		expectResult proto.Message
+		expectErr    bool
+	}{
+		{
+			"valid query",
+			func() client.Context {
+				bz, _ := s.encCfg.Codec.Marshal(&types.QueryDenomOwnersResponse{
+					DenomOwners: []*types.DenomOwner{
+						{
+							Address: sdk.AccAddress("addr1_______________").String(),
+							Balance: sdk.NewInt64Coin("photon", 10),
+						},
+					},
+				})
+				c := clitestutil.NewMockCometRPC(abci.ResponseQuery{
+					Value: bz,
+				})
+				return s.baseCtx.WithClient(c)
+			},
+			[]string{
+				"photon",
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			&types.QueryDenomOwnersResponse{},
+			false,
+		},
+		{
+			"invalid denom",
+			func() client.Context {
+				return s.baseCtx
+			},
+			[]string{
+				"1photon",
+			},
+			nil,
+			true,
+		},
+		{
+			"missing denom",
+			func() client.Context {
+				return s.baseCtx
+			},
+			[]string{},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+
+			cmd.SetContext(ctx)
+			cmd.SetArgs(tc.args)
+
+			s.Require().NoError(client.SetCmdClientContextHandler(tc.ctxGen(), cmd))
+
+			out, err := clitestutil.ExecTestCLICmd(tc.ctxGen(), cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(s.encCfg.Codec.UnmarshalJSON(out.Bytes(), tc.expectResult))
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *CLITestSuite) TestGetCmdQueryEscrows() {
+	cmd := cli.GetCmdQueryEscrows()
+	cmd.SetOutput(io.Discard)
+
+	testCases := []struct {
+		name         string
+		ctxGen       func() client.Context
+		args         []string
+		expectResult proto.Message
+		expectErr    bool
+	}{
+		{
+			"valid query",
+			func() client.Context {
+				bz, _ := s.encCfg.Codec.Marshal(&types.QueryEscrowsResponse{
+					Escrows: []types.Escrow{
+						{
+							Id:           1,
+							Creator:      sdk.AccAddress("addr1_______________").String(),
+							Counterparty: sdk.AccAddress("addr2_______________").String(),
+							Amount:       sdk.NewCoins(sdk.NewInt64Coin("photon", 10)),
+						},
+					},
+				})
+				c := clitestutil.NewMockCometRPC(abci.ResponseQuery{
+					Value: bz,
+				})
+				return s.baseCtx.WithClient(c)
+			},
+			[]string{
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			&types.QueryEscrowsResponse{},
+			false,
+		},
+		{
+			"invalid args",
+			func() client.Context {
+				return s.baseCtx
+			},
+			[]string{
+				"1",
+			},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+
+			cmd.SetContext(ctx)
+			cmd.SetArgs(tc.args)
+
+			s.Require().NoError(client.SetCmdClientContextHandler(tc.ctxGen(), cmd))
+
+			out, err := clitestutil.ExecTestCLICmd(tc.ctxGen(), cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(s.encCfg.Codec.UnmarshalJSON(out.Bytes(), tc.expectResult))
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
@@ -2,6 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"os"
+	"strconv"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/spf13/cobra"
@@ -10,10 +13,18 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 )
 
-var FlagSplit = "split"
+var (
+	FlagSplit     = "split"
+	FlagAuthority = "authority"
+	FlagExpected  = "expected"
+	FlagTimeout   = "timeout"
+)
 
 // NewTxCmd returns a root CLI command handler for all x/bank transaction commands.
 func NewTxCmd() *cobra.Command {
@@ -28,6 +39,10 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		NewSendTxCmd(),
 		NewMultiSendTxCmd(),
+		NewSetDenomMetadataCmd(),
+		NewCreateEscrowCmd(),
+		NewAcceptEscrowCmd(),
+		NewRefundEscrowCmd(),
 	)
 
 	return txCmd
@@ -147,3 +162,196 @@ When using '--dry-run' a key name cannot be used, only a bech32 address.
 
 	return cmd
 }
+
+// NewSetDenomMetadataCmd returns a CLI command handler for submitting a
+// governance proposal to register or update the metadata of a denom.
+func NewSetDenomMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-metadata [metadata-file]",
+		Short: "Submit a proposal to register or update the metadata of a denom",
+		Long: `Submit a governance proposal to register or update the metadata of a denom.
+The metadata is read from a JSON file, and replaces the existing metadata of its base denom.`,
+		Example: fmt.Sprintf(`$ %s tx bank set-denom-metadata metadata.json --title="Register uatom metadata" --summary="..." --deposit=10000000stake --from=mykey
+
+Where metadata.json contains:
+
+{
+  "description": "The native staking token of the Cosmos Hub.",
+  "denom_units": [
+    {"denom": "uatom", "exponent": 0, "aliases": ["microatom"]},
+    {"denom": "atom", "exponent": 6}
+  ],
+  "base": "uatom",
+  "display": "atom",
+  "name": "Cosmos Hub Atom",
+  "symbol": "ATOM"
+}`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var metadata types.Metadata
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &metadata); err != nil {
+				return fmt.Errorf("failed to parse metadata: %w", err)
+			}
+
+			if err := metadata.Validate(); err != nil {
+				return err
+			}
+
+			authority, _ := cmd.Flags().GetString(FlagAuthority)
+			if authority != "" {
+				if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+					return fmt.Errorf("invalid authority address: %w", err)
+				}
+			} else {
+				authority = sdk.AccAddress(address.Module("gov")).String()
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{types.NewMsgSetDenomMetadata(authority, metadata)}); err != nil {
+				return fmt.Errorf("failed to create set denom metadata message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	cmd.Flags().String(FlagAuthority, "", "The address of the bank module authority (defaults to gov)")
+
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	cmd.MarkFlagRequired(govcli.FlagTitle)
+
+	return cmd
+}
+
+// NewCreateEscrowCmd returns a CLI command handler for creating a
+// MsgCreateEscrow transaction.
+func NewCreateEscrowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-escrow [from_key_or_address] [counterparty] [amount]",
+		Short: "Escrow funds until the counterparty accepts them or the escrow times out",
+		Long: `Escrow funds until the counterparty accepts them or the escrow times out.
+The counterparty must send the coins given with the '--expected' flag to accept the escrow,
+e.g. for an OTC swap. Once the escrow timed out, the funds can be refunded.
+Note, the '--from' flag is ignored as it is implied from [from_key_or_address].
+`,
+		Example: fmt.Sprintf("$ %s tx bank create-escrow mykey cosmos1.. 100stake --expected=50photon --timeout=24h", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			counterparty, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			if amount.Empty() {
+				return fmt.Errorf("invalid coins")
+			}
+
+			expectedStr, _ := cmd.Flags().GetString(FlagExpected)
+			expected, err := sdk.ParseCoinsNormalized(expectedStr)
+			if err != nil {
+				return err
+			}
+
+			timeout, _ := cmd.Flags().GetDuration(FlagTimeout)
+			if timeout <= 0 {
+				return fmt.Errorf("timeout must be positive")
+			}
+
+			msg := types.NewMsgCreateEscrow(clientCtx.GetFromAddress(), counterparty, amount, expected, time.Now().Add(timeout))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagExpected, "", "The coins the counterparty must send to accept the escrow")
+	cmd.Flags().Duration(FlagTimeout, 24*time.Hour, "The duration after which the escrow times out")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewAcceptEscrowCmd returns a CLI command handler for creating a
+// MsgAcceptEscrow transaction.
+func NewAcceptEscrowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "accept-escrow [id]",
+		Short:   "Accept an escrow, sending the expected funds to its creator",
+		Example: fmt.Sprintf("$ %s tx bank accept-escrow 1 --from=mykey", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid escrow id: %w", err)
+			}
+
+			msg := types.NewMsgAcceptEscrow(clientCtx.GetFromAddress(), id)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRefundEscrowCmd returns a CLI command handler for creating a
+// MsgRefundEscrow transaction.
+func NewRefundEscrowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "refund-escrow [id]",
+		Short:   "Refund the funds of a timed out escrow to its creator",
+		Example: fmt.Sprintf("$ %s tx bank refund-escrow 1 --from=mykey", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid escrow id: %w", err)
+			}
+
+			msg := types.NewMsgRefundEscrow(clientCtx.GetFromAddress(), id)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -14,6 +14,7 @@ import (
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/client/cli"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 )
 
 func (s *CLITestSuite) TestSendTxCmd() {
@@ -219,3 +220,160 @@ func (s *CLITestSuite) TestMultiSendTxCmd() {
 		})
 	}
 }
+
+func (s *CLITestSuite) TestSetDenomMetadataCmd() {
+	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
+	cmd := cli.NewSetDenomMetadataCmd()
+	cmd.SetOutput(io.Discard)
+
+	validFile := testutil.WriteToNewTempFile(s.T(), `{
+  "description": "The native staking token",
+  "denom_units": [
+    {"denom": "ustake", "exponent": 0, "aliases": ["microstake"]},
+    {"denom": "stake", "exponent": 6}
+  ],
+  "base": "ustake",
+  "display": "stake",
+  "name": "Stake",
+  "symbol": "STAKE"
+}`)
+	invalidMetadataFile := testutil.WriteToNewTempFile(s.T(), `{"base": "ustake", "display": "stake"}`)
+	invalidJSONFile := testutil.WriteToNewTempFile(s.T(), `{"base":`)
+
+	extraArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, accounts[0].Address),
+		fmt.Sprintf("--%s=Register ustake metadata", govcli.FlagTitle),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("photon", sdkmath.NewInt(10))).String()),
+		fmt.Sprintf("--%s=test-chain", flags.FlagChainID),
+	}
+
+	testCases := []struct {
+		name         string
+		file         string
+		extraArgs    []string
+		expectErrMsg string
+	}{
+		{
+			"valid transaction",
+			validFile.Name(),
+			extraArgs,
+			"",
+		},
+		{
+			"valid transaction with authority",
+			validFile.Name(),
+			append([]string{fmt.Sprintf("--%s=%s", cli.FlagAuthority, accounts[0].Address)}, extraArgs...),
+			"",
+		},
+		{
+			"invalid authority",
+			validFile.Name(),
+			append([]string{fmt.Sprintf("--%s=invalid", cli.FlagAuthority)}, extraArgs...),
+			"invalid authority address",
+		},
+		{
+			"missing file",
+			"missing.json",
+			extraArgs,
+			"no such file or directory",
+		},
+		{
+			"invalid json",
+			invalidJSONFile.Name(),
+			extraArgs,
+			"failed to parse metadata",
+		},
+		{
+			"invalid metadata",
+			invalidMetadataFile.Name(),
+			extraArgs,
+			"name field cannot be blank",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			args := append([]string{tc.file}, tc.extraArgs...)
+
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+			cmd.SetContext(ctx)
+			cmd.SetArgs(args)
+			s.Require().NoError(client.SetCmdClientContextHandler(s.baseCtx, cmd))
+
+			out, err := clitestutil.ExecTestCLICmd(s.baseCtx, cmd, args)
+			if tc.expectErrMsg != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expectErrMsg)
+			} else {
+				s.Require().NoError(err)
+				msg := &sdk.TxResponse{}
+				s.Require().NoError(s.baseCtx.Codec.UnmarshalJSON(out.Bytes(), msg), out.String())
+			}
+		})
+	}
+}
+
+func (s *CLITestSuite) TestCreateEscrowCmd() {
+	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 2)
+
+	extraArgs := []string{
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("photon", sdkmath.NewInt(10))).String()),
+		fmt.Sprintf("--%s=test-chain", flags.FlagChainID),
+	}
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErrMsg string
+	}{
+		{
+			"valid transaction",
+			append([]string{accounts[0].Address.String(), accounts[1].Address.String(), "10stake", fmt.Sprintf("--%s=5photon", cli.FlagExpected)}, extraArgs...),
+			"",
+		},
+		{
+			"invalid counterparty",
+			append([]string{accounts[0].Address.String(), "invalid", "10stake"}, extraArgs...),
+			"decoding bech32 failed",
+		},
+		{
+			"invalid expected coins",
+			append([]string{accounts[0].Address.String(), accounts[1].Address.String(), "10stake", fmt.Sprintf("--%s=invalid", cli.FlagExpected)}, extraArgs...),
+			"invalid decimal coin expression",
+		},
+		{
+			"invalid timeout",
+			append([]string{accounts[0].Address.String(), accounts[1].Address.String(), "10stake", fmt.Sprintf("--%s=-1h", cli.FlagTimeout)}, extraArgs...),
+			"timeout must be positive",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			// use a new command for each case, as the flags aren't reset
+			cmd := cli.NewCreateEscrowCmd()
+			cmd.SetOutput(io.Discard)
+
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+			cmd.SetContext(ctx)
+			cmd.SetArgs(tc.args)
+			s.Require().NoError(client.SetCmdClientContextHandler(s.baseCtx, cmd))
+
+			out, err := clitestutil.ExecTestCLICmd(s.baseCtx, cmd, tc.args)
+			if tc.expectErrMsg != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expectErrMsg)
+			} else {
+				s.Require().NoError(err)
+				msg := &sdk.TxResponse{}
+				s.Require().NoError(s.baseCtx.Codec.UnmarshalJSON(out.Bytes(), msg), out.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// CreateEscrow moves amt coins of the creator into escrow, until the
+// counterparty accepts the escrow or it times out, and returns the id of the
+// escrow. The counterparty must send the expected coins, which can be empty, to
+// the creator to accept the escrow.
+func (k BaseKeeper) CreateEscrow(
+	ctx sdk.Context, creator, counterparty sdk.AccAddress, amt, expected sdk.Coins, timeout time.Time,
+) (uint64, error) {
+	if !amt.IsValid() || amt.Empty() {
+		return 0, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	if !expected.IsValid() {
+		return 0, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, expected.String())
+	}
+
+	if !timeout.After(ctx.BlockTime()) {
+		return 0, sdkerrors.ErrInvalidRequest.Wrapf("timeout %s must be after the block time", timeout)
+	}
+
+	escrowAcc := k.ak.GetModuleAccount(ctx, types.EscrowAccountName)
+	if escrowAcc == nil {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", types.EscrowAccountName)
+	}
+
+	// The coins are moved like any other transfer, but the send restrictions
+	// can't redirect them out of escrow.
+	if err := k.sendCoins(ctx, creator, escrowAcc.GetAddress(), amt, true); err != nil {
+		return 0, err
+	}
+
+	id, err := k.EscrowSeq.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	escrow := types.Escrow{
+		Id:           id,
+		Creator:      creator.String(),
+		Counterparty: counterparty.String(),
+		Amount:       amt,
+		Expected:     expected,
+		Timeout:      timeout,
+	}
+	if err := k.BaseViewKeeper.Escrows.Set(ctx, id, escrow); err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateEscrow,
+			sdk.NewAttribute(types.AttributeKeyEscrowID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, escrow.Creator),
+			sdk.NewAttribute(types.AttributeKeyCounterparty, escrow.Counterparty),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+		),
+	)
+
+	return id, nil
+}
+
+// AcceptEscrow releases the coins of the escrow to its counterparty, which
+// sends the expected coins to the creator of the escrow. An error is returned
+// if the escrow timed out.
+func (k BaseKeeper) AcceptEscrow(ctx sdk.Context, counterparty sdk.AccAddress, id uint64) error {
+	escrow, err := k.getEscrow(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if escrow.Counterparty != counterparty.String() {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the counterparty of escrow %d", counterparty, id)
+	}
+
+	if !ctx.BlockTime().Before(escrow.Timeout) {
+		return errorsmod.Wrapf(types.ErrEscrowTimedOut, "escrow %d timed out at %s", id, escrow.Timeout)
+	}
+
+	creator := sdk.MustAccAddressFromBech32(escrow.Creator)
+	if !escrow.Expected.Empty() {
+		if err := k.SendCoins(ctx, counterparty, creator, escrow.Expected); err != nil {
+			return err
+		}
+	}
+
+	if err := k.releaseEscrowedCoins(ctx, counterparty, escrow.Amount); err != nil {
+		return err
+	}
+
+	if err := k.BaseViewKeeper.Escrows.Remove(ctx, id); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAcceptEscrow,
+			sdk.NewAttribute(types.AttributeKeyEscrowID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCounterparty, escrow.Counterparty),
+		),
+	)
+
+	return nil
+}
+
+// RefundEscrow returns the coins of the escrow to its creator. An error is
+// returned if the escrow did not time out yet.
+func (k BaseKeeper) RefundEscrow(ctx sdk.Context, creator sdk.AccAddress, id uint64) error {
+	escrow, err := k.getEscrow(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if escrow.Creator != creator.String() {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the creator of escrow %d", creator, id)
+	}
+
+	if ctx.BlockTime().Before(escrow.Timeout) {
+		return errorsmod.Wrapf(types.ErrEscrowNotTimedOut, "escrow %d times out at %s", id, escrow.Timeout)
+	}
+
+	if err := k.releaseEscrowedCoins(ctx, creator, escrow.Amount); err != nil {
+		return err
+	}
+
+	if err := k.BaseViewKeeper.Escrows.Remove(ctx, id); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefundEscrow,
+			sdk.NewAttribute(types.AttributeKeyEscrowID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, escrow.Creator),
+		),
+	)
+
+	return nil
+}
+
+// releaseEscrowedCoins moves amt escrowed coins to toAddr. The transfer is
+// subject to the paused denoms, the send restrictions and the hooks like any
+// other transfer, except that the send restrictions can't redirect it.
+func (k BaseKeeper) releaseEscrowedCoins(ctx sdk.Context, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if k.BlockedAddr(toAddr) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", toAddr)
+	}
+
+	return k.sendCoins(ctx, types.EscrowAddress(), toAddr, amt, true)
+}
+
+// GetEscrow returns the escrow with the given id, and whether it exists.
+func (k BaseKeeper) GetEscrow(ctx sdk.Context, id uint64) (types.Escrow, bool) {
+	escrow, err := k.BaseViewKeeper.Escrows.Get(ctx, id)
+	return escrow, err == nil
+}
+
+// getEscrow returns the escrow with the given id, or ErrEscrowNotFound.
+func (k BaseKeeper) getEscrow(ctx sdk.Context, id uint64) (types.Escrow, error) {
+	escrow, err := k.BaseViewKeeper.Escrows.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Escrow{}, errorsmod.Wrapf(types.ErrEscrowNotFound, "escrow %d", id)
+	}
+
+	return escrow, err
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/collections"
@@ -48,6 +49,20 @@ func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
+
+	for _, denom := range genState.PausedDenoms {
+		k.SetDenomPaused(ctx, denom, true)
+	}
+
+	for _, escrow := range genState.Escrows {
+		if err := k.BaseViewKeeper.Escrows.Set(ctx, escrow.Id, escrow); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := k.EscrowSeq.Set(ctx, genState.EscrowSeq); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -64,5 +79,20 @@ func (k BaseKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		k.GetAllDenomMetaData(ctx),
 		k.GetAllSendEnabledEntries(ctx),
 	)
+	rv.PausedDenoms = k.GetPausedDenoms(ctx)
+
+	err = k.BaseViewKeeper.Escrows.Walk(ctx, nil, func(_ uint64, escrow types.Escrow) bool {
+		rv.Escrows = append(rv.Escrows, escrow)
+		return false
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		panic(err)
+	}
+
+	rv.EscrowSeq, err = k.EscrowSeq.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return rv
 }
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -38,6 +40,7 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	}
 
 	suite.Require().NoError(suite.bankKeeper.SetParams(ctx, types.DefaultParams()))
+	suite.bankKeeper.SetDenomPaused(ctx, "testcoin1", true)
 
 	exportGenesis := suite.bankKeeper.ExportGenesis(ctx)
 
@@ -46,6 +49,7 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	suite.Require().Equal(expTotalSupply, exportGenesis.Supply)
 	suite.Require().Subset(exportGenesis.Balances, expectedBalances)
 	suite.Require().Equal(expectedMetadata, exportGenesis.DenomMetadata)
+	suite.Require().Equal([]string{"testcoin1"}, exportGenesis.PausedDenoms)
 }
 
 func (suite *KeeperTestSuite) getTestBalancesAndSupply() ([]types.Balance, sdk.Coins) {
@@ -73,6 +77,44 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	m2, found := bk.GetDenomMetaData(suite.ctx, m.Base)
 	suite.Require().True(found)
 	suite.Require().Equal(m, m2)
+
+	g = types.DefaultGenesisState()
+	g.PausedDenoms = []string{"bridged"}
+	bk.InitGenesis(suite.ctx, g)
+	suite.Require().True(bk.IsDenomPaused(suite.ctx, "bridged"))
+}
+
+func (suite *KeeperTestSuite) TestEscrowsGenesis() {
+	ctx := suite.ctx
+	amt := sdk.NewCoins(sdk.NewInt64Coin("testcoin", 10))
+	escrow := types.Escrow{
+		Id:           3,
+		Creator:      accAddrs[0].String(),
+		Counterparty: accAddrs[1].String(),
+		Amount:       amt,
+		Timeout:      ctx.BlockTime().Add(time.Hour),
+	}
+
+	g := types.DefaultGenesisState()
+	g.Balances = []types.Balance{{Address: types.EscrowAddress().String(), Coins: amt}}
+	g.Escrows = []types.Escrow{escrow}
+	g.EscrowSeq = 4
+	suite.Require().NoError(g.Validate())
+	suite.bankKeeper.InitGenesis(ctx, g)
+
+	got, found := suite.bankKeeper.GetEscrow(ctx, escrow.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(escrow, got)
+
+	exported := suite.bankKeeper.ExportGenesis(ctx)
+	suite.Require().Equal([]types.Escrow{escrow}, exported.Escrows)
+	suite.Require().Equal(uint64(4), exported.EscrowSeq)
+
+	// the next escrow id follows the imported sequence
+	suite.mockCreateEscrow(ctx, escrowAcc)
+	id, err := suite.bankKeeper.CreateEscrow(ctx, types.EscrowAddress(), accAddrs[1], amt, nil, escrow.Timeout)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(4), id)
 }
 
 func (suite *KeeperTestSuite) TestTotalSupply() {
@@ -2,9 +2,11 @@ package keeper
 
 import (
 	"context"
+	"sort"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
+	"golang.org/x/exp/maps"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -279,3 +281,91 @@ func (k BaseKeeper) SendEnabled(goCtx context.Context, req *types.QuerySendEnabl
 
 	return resp, nil
 }
+
+// PausedDenoms implements the Query/PausedDenoms gRPC method.
+func (k BaseKeeper) PausedDenoms(goCtx context.Context, req *types.QueryPausedDenomsRequest) (*types.QueryPausedDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	results, pageResp, err := query.CollectionPaginate[string, collections.NoValue](ctx, k.BaseViewKeeper.PausedDenoms, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	denoms := make([]string, 0, len(results))
+	for _, r := range results {
+		denoms = append(denoms, r.Key)
+	}
+
+	return &types.QueryPausedDenomsResponse{Denoms: denoms, Pagination: pageResp}, nil
+}
+
+// Escrow implements the Query/Escrow gRPC method.
+func (k BaseKeeper) Escrow(goCtx context.Context, req *types.QueryEscrowRequest) (*types.QueryEscrowResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	escrow, found := k.GetEscrow(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "escrow %d not found", req.Id)
+	}
+
+	return &types.QueryEscrowResponse{Escrow: escrow}, nil
+}
+
+// Escrows implements the Query/Escrows gRPC method.
+func (k BaseKeeper) Escrows(goCtx context.Context, req *types.QueryEscrowsRequest) (*types.QueryEscrowsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	results, pageResp, err := query.CollectionPaginate[uint64, types.Escrow](ctx, k.BaseViewKeeper.Escrows, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	escrows := make([]types.Escrow, 0, len(results))
+	for _, r := range results {
+		escrows = append(escrows, r.Value)
+	}
+
+	return &types.QueryEscrowsResponse{Escrows: escrows, Pagination: pageResp}, nil
+}
+
+// ModuleAccountBalances implements the Query/ModuleAccountBalances gRPC method.
+// The module accounts which were not created yet are omitted.
+func (k BaseKeeper) ModuleAccountBalances(goCtx context.Context, req *types.QueryModuleAccountBalancesRequest) (*types.QueryModuleAccountBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// For deterministic output, sort the module accounts by name.
+	permAddrs := k.ak.GetModulePermissions()
+	names := maps.Keys(permAddrs)
+	sort.Strings(names)
+
+	moduleAccounts := make([]types.ModuleAccountBalance, 0, len(names))
+	for _, name := range names {
+		addr := permAddrs[name].GetAddress()
+		acc, ok := k.ak.GetAccount(ctx, addr).(sdk.ModuleAccountI)
+		if !ok {
+			continue
+		}
+
+		moduleAccounts = append(moduleAccounts, types.ModuleAccountBalance{
+			Name:          name,
+			Address:       addr.String(),
+			AccountNumber: acc.GetAccountNumber(),
+			Permissions:   permAddrs[name].GetPermissions(),
+			Balances:      k.GetAllBalances(ctx, addr),
+		})
+	}
+
+	return &types.QueryModuleAccountBalancesResponse{ModuleAccounts: moduleAccounts}, nil
+}
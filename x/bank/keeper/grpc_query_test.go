@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -672,3 +675,110 @@ func (suite *KeeperTestSuite) TestQuerySendEnabled() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryPausedDenoms() {
+	ctx, bankKeeper := suite.ctx, suite.bankKeeper
+
+	res, err := bankKeeper.PausedDenoms(ctx, &types.QueryPausedDenomsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Denoms)
+
+	bankKeeper.SetDenomPaused(ctx, "bridgedcoin", true)
+	bankKeeper.SetDenomPaused(ctx, "othercoin", true)
+
+	res, err = bankKeeper.PausedDenoms(ctx, &types.QueryPausedDenomsRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"bridgedcoin"}, res.Denoms)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	res, err = bankKeeper.PausedDenoms(ctx, &types.QueryPausedDenomsRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"othercoin"}, res.Denoms)
+
+	_, err = bankKeeper.PausedDenoms(ctx, nil)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryEscrows() {
+	ctx, bankKeeper := suite.ctx, suite.bankKeeper
+	amt := sdk.NewCoins(sdk.NewInt64Coin("foo", 10))
+	timeout := ctx.BlockTime().Add(time.Hour)
+
+	res, err := bankKeeper.Escrows(ctx, &types.QueryEscrowsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Escrows)
+
+	_, err = bankKeeper.Escrow(ctx, &types.QueryEscrowRequest{Id: 0})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.mockFundAccount(accAddrs[0])
+	suite.Require().NoError(testutil.FundAccount(bankKeeper, ctx, accAddrs[0], amt.Add(amt...)))
+	for i := 0; i < 2; i++ {
+		suite.mockCreateEscrow(ctx, acc0)
+		_, err := bankKeeper.CreateEscrow(ctx, accAddrs[0], accAddrs[1], amt, nil, timeout)
+		suite.Require().NoError(err)
+	}
+
+	escrowRes, err := bankKeeper.Escrow(ctx, &types.QueryEscrowRequest{Id: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), escrowRes.Escrow.Id)
+	suite.Require().Equal(accAddrs[0].String(), escrowRes.Escrow.Creator)
+	suite.Require().Equal(amt, escrowRes.Escrow.Amount)
+
+	res, err = bankKeeper.Escrows(ctx, &types.QueryEscrowsRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Escrows, 1)
+	suite.Require().Equal(uint64(0), res.Escrows[0].Id)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	_, err = bankKeeper.Escrows(ctx, nil)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryModuleAccountBalances() {
+	ctx, bankKeeper := suite.ctx, suite.bankKeeper
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 100))
+	suite.mockMintCoins(mintAcc)
+	suite.Require().NoError(bankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+
+	unused := authtypes.NewPermissionsForAddress("unused", nil)
+	suite.authKeeper.EXPECT().GetModulePermissions().Return(map[string]authtypes.PermissionsForAddress{
+		minttypes.ModuleName: authtypes.NewPermissionsForAddress(minttypes.ModuleName, mintAcc.Permissions),
+		authtypes.Burner:     authtypes.NewPermissionsForAddress(authtypes.Burner, burnerAcc.Permissions),
+		"unused":             unused,
+	})
+	suite.authKeeper.EXPECT().GetAccount(ctx, mintAcc.GetAddress()).Return(mintAcc)
+	suite.authKeeper.EXPECT().GetAccount(ctx, burnerAcc.GetAddress()).Return(burnerAcc)
+	suite.authKeeper.EXPECT().GetAccount(ctx, unused.GetAddress()).Return(nil)
+
+	res, err := bankKeeper.ModuleAccountBalances(ctx, &types.QueryModuleAccountBalancesRequest{})
+	suite.Require().NoError(err)
+	// the module accounts are sorted by name, and the ones not created yet are omitted
+	suite.Require().Equal([]types.ModuleAccountBalance{
+		{
+			Name:          authtypes.Burner,
+			Address:       burnerAcc.Address,
+			AccountNumber: burnerAcc.AccountNumber,
+			Permissions:   burnerAcc.Permissions,
+			Balances:      sdk.NewCoins(),
+		},
+		{
+			Name:          minttypes.ModuleName,
+			Address:       mintAcc.Address,
+			AccountNumber: mintAcc.AccountNumber,
+			Permissions:   mintAcc.Permissions,
+			Balances:      coins,
+		},
+	}, res.ModuleAccounts)
+
+	_, err = bankKeeper.ModuleAccountBalances(ctx, nil)
+	suite.Require().Error(err)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
@@ -49,6 +50,11 @@ type Keeper interface {
 	DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
 
+	CreateEscrow(ctx sdk.Context, creator, counterparty sdk.AccAddress, amt, expected sdk.Coins, timeout time.Time) (uint64, error)
+	AcceptEscrow(ctx sdk.Context, counterparty sdk.AccAddress, id uint64) error
+	RefundEscrow(ctx sdk.Context, creator sdk.AccAddress, id uint64) error
+	GetEscrow(ctx sdk.Context, id uint64) (types.Escrow, bool)
+
 	types.QueryServer
 }
 
@@ -384,7 +390,7 @@ func (k BaseKeeper) MintCoins(ctx sdk.Context, moduleName string, amounts sdk.Co
 		types.NewCoinMintEvent(acc.GetAddress(), amounts),
 	)
 
-	return nil
+	return k.Hooks().AfterMint(ctx, acc.GetAddress(), amounts)
 }
 
 // BurnCoins burns coins deletes coins from the balance of the module account.
@@ -417,7 +423,7 @@ func (k BaseKeeper) BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Co
 		types.NewCoinBurnEvent(acc.GetAddress(), amounts),
 	)
 
-	return nil
+	return k.Hooks().AfterBurn(ctx, acc.GetAddress(), amounts)
 }
 
 // setSupply sets the supply for the given coin
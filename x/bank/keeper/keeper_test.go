@@ -21,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -51,6 +52,7 @@ var (
 	minterAcc    = authtypes.NewEmptyModuleAccount(authtypes.Minter, authtypes.Minter)
 	mintAcc      = authtypes.NewEmptyModuleAccount(minttypes.ModuleName, authtypes.Minter)
 	multiPermAcc = authtypes.NewEmptyModuleAccount(multiPerm, authtypes.Burner, authtypes.Minter, authtypes.Staking)
+	escrowAcc    = authtypes.NewEmptyModuleAccount(banktypes.EscrowAccountName)
 
 	baseAcc = authtypes.NewBaseAccountWithAddress(sdk.AccAddress([]byte("baseAcc")))
 
@@ -193,6 +195,11 @@ func (suite *KeeperTestSuite) mockSendCoins(ctx sdk.Context, sender sdk.AccountI
 	suite.authKeeper.EXPECT().HasAccount(ctx, receiver).Return(true)
 }
 
+func (suite *KeeperTestSuite) mockCreateEscrow(ctx sdk.Context, creator sdk.AccountI) {
+	suite.authKeeper.EXPECT().GetModuleAccount(ctx, banktypes.EscrowAccountName).Return(escrowAcc)
+	suite.mockSendCoins(ctx, creator, escrowAcc.GetAddress())
+}
+
 func (suite *KeeperTestSuite) mockFundAccount(receiver sdk.AccAddress) {
 	suite.mockMintCoins(mintAcc)
 	suite.mockSendCoinsFromModuleToAccount(mintAcc, receiver)
@@ -605,6 +612,355 @@ func (suite *KeeperTestSuite) TestSendCoins() {
 	require.Equal(newBarCoin(25), coins[0], "expected only bar coins in the account balance, got: %v", coins)
 }
 
+func (suite *KeeperTestSuite) TestSendRestrictions() {
+	ctx := suite.ctx
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+	sendAmt := sdk.NewCoins(newFooCoin(10))
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.bankKeeper, ctx, accAddrs[0], balances))
+
+	// block the sends to accAddrs[2] and redirect the sends to accAddrs[3] to accAddrs[1]
+	var calls []sdk.AccAddress
+	suite.bankKeeper.AppendSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, toAddr)
+		if toAddr.Equals(accAddrs[2]) {
+			return nil, fmt.Errorf("%s is blocked", toAddr)
+		}
+		return toAddr, nil
+	})
+	suite.bankKeeper.PrependSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		if toAddr.Equals(accAddrs[3]) {
+			return accAddrs[1], nil
+		}
+		return toAddr, nil
+	})
+
+	require.ErrorContains(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[2], sendAmt), "is blocked")
+	require.Equal(balances, suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[3], sendAmt))
+	require.Equal(sendAmt, suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+	require.True(suite.bankKeeper.GetAllBalances(ctx, accAddrs[3]).IsZero())
+
+	input := banktypes.Input{Address: accAddrs[0].String(), Coins: sendAmt.Add(sendAmt...)}
+	outputs := []banktypes.Output{
+		{Address: accAddrs[3].String(), Coins: sendAmt},
+		{Address: accAddrs[2].String(), Coins: sendAmt},
+	}
+	require.ErrorContains(suite.bankKeeper.InputOutputCoins(ctx, input, outputs), "is blocked")
+	require.Equal(sendAmt, suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+
+	outputs[1].Address = accAddrs[1].String()
+	suite.mockInputOutputCoins([]sdk.AccountI{acc0}, accAddrs[1:2])
+	suite.authKeeper.EXPECT().HasAccount(ctx, accAddrs[1]).Return(true)
+	require.NoError(suite.bankKeeper.InputOutputCoins(ctx, input, outputs))
+	require.Equal(sendAmt.Add(sendAmt...).Add(sendAmt...), suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+
+	// the restrictions were run in order, after the redirection
+	require.Equal([]sdk.AccAddress{accAddrs[2], accAddrs[1], accAddrs[1], accAddrs[2], accAddrs[1], accAddrs[1]}, calls)
+
+	suite.bankKeeper.ClearSendRestriction()
+	suite.mockSendCoins(ctx, acc0, accAddrs[2])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[2], sendAmt))
+	require.Equal(sendAmt, suite.bankKeeper.GetAllBalances(ctx, accAddrs[2]))
+}
+
+func (suite *KeeperTestSuite) TestLockedCoinsGetters() {
+	ctx := suite.ctx
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.bankKeeper, ctx, accAddrs[0], balances))
+
+	// lock more bar than the account holds
+	lock := func(coins sdk.Coins) banktypes.GetLockedCoinsFn {
+		return func(_ sdk.Context, addr sdk.AccAddress) sdk.Coins {
+			if addr.Equals(accAddrs[0]) {
+				return coins
+			}
+			return nil
+		}
+	}
+	suite.bankKeeper.AppendLockedCoinsGetter(lock(sdk.NewCoins(newFooCoin(30), newBarCoin(80))))
+	suite.bankKeeper.AppendLockedCoinsGetter(lock(sdk.NewCoins(newFooCoin(20))))
+
+	suite.mockSpendableCoins(ctx, acc0)
+	require.Equal(sdk.NewCoins(newFooCoin(50), newBarCoin(80)), suite.bankKeeper.LockedCoins(ctx, accAddrs[0]))
+
+	suite.mockSpendableCoins(ctx, acc0)
+	require.Equal(sdk.NewCoins(newFooCoin(50)), suite.bankKeeper.SpendableCoins(ctx, accAddrs[0]))
+
+	suite.mockSpendableCoins(ctx, acc0)
+	require.Equal(newFooCoin(50), suite.bankKeeper.SpendableCoin(ctx, accAddrs[0], fooDenom))
+
+	suite.mockSpendableCoins(ctx, acc0)
+	require.Equal(newBarCoin(0), suite.bankKeeper.SpendableCoin(ctx, accAddrs[0], barDenom))
+
+	suite.mockSpendableCoins(ctx, acc0)
+	res, err := suite.queryClient.SpendableBalances(ctx, banktypes.NewQuerySpendableBalancesRequest(accAddrs[0], nil))
+	require.NoError(err)
+	require.Equal(sdk.Coins{newBarCoin(0), newFooCoin(50)}, res.Balances)
+
+	// the locked coins cannot be sent
+	suite.mockSpendableCoins(ctx, acc0)
+	require.ErrorIs(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(60))), sdkerrors.ErrInsufficientFunds)
+
+	suite.mockSpendableCoins(ctx, acc0)
+	require.ErrorIs(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newBarCoin(1))), sdkerrors.ErrInsufficientFunds)
+
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(50))))
+	require.Equal(sdk.NewCoins(newFooCoin(50)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+
+	suite.bankKeeper.ClearLockedCoinsGetter()
+	suite.mockSpendableCoins(ctx, acc0)
+	require.Equal(sdk.NewCoins(newFooCoin(50), newBarCoin(50)), suite.bankKeeper.SpendableCoins(ctx, accAddrs[0]))
+}
+
+func (suite *KeeperTestSuite) TestEscrow() {
+	ctx := suite.ctx
+	require := suite.Require()
+	escrowAddr := banktypes.EscrowAddress()
+	require.Equal(escrowAcc.GetAddress(), escrowAddr)
+	amt := sdk.NewCoins(newFooCoin(10))
+	expected := sdk.NewCoins(newBarCoin(5))
+	timeout := ctx.BlockTime().Add(time.Hour)
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	acc1 := authtypes.NewBaseAccountWithAddress(accAddrs[1])
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.bankKeeper, ctx, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+	suite.mockFundAccount(accAddrs[1])
+	require.NoError(banktestutil.FundAccount(suite.bankKeeper, ctx, accAddrs[1], sdk.NewCoins(newBarCoin(100))))
+
+	// the timeout must be in the future
+	_, err := suite.bankKeeper.CreateEscrow(ctx, accAddrs[0], accAddrs[1], amt, expected, ctx.BlockTime())
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	suite.mockCreateEscrow(ctx, acc0)
+	id, err := suite.bankKeeper.CreateEscrow(ctx, accAddrs[0], accAddrs[1], amt, expected, timeout)
+	require.NoError(err)
+	require.Equal(uint64(0), id)
+	require.Equal(sdk.NewCoins(newFooCoin(90)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Equal(amt, suite.bankKeeper.GetAllBalances(ctx, escrowAddr))
+
+	escrow, found := suite.bankKeeper.GetEscrow(ctx, id)
+	require.True(found)
+	require.Equal(accAddrs[1].String(), escrow.Counterparty)
+	require.Equal(expected, escrow.Expected)
+
+	// only the counterparty can accept the escrow, and the creator can't
+	// refund it before its timeout
+	require.ErrorIs(suite.bankKeeper.AcceptEscrow(ctx, accAddrs[2], id), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(suite.bankKeeper.RefundEscrow(ctx, accAddrs[0], id), banktypes.ErrEscrowNotTimedOut)
+
+	suite.mockSendCoins(ctx, acc1, accAddrs[0])
+	suite.mockSendCoins(ctx, escrowAcc, accAddrs[1])
+	require.NoError(suite.bankKeeper.AcceptEscrow(ctx, accAddrs[1], id))
+	require.Equal(sdk.NewCoins(newFooCoin(90), newBarCoin(5)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Equal(sdk.NewCoins(newFooCoin(10), newBarCoin(95)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+	require.True(suite.bankKeeper.GetAllBalances(ctx, escrowAddr).IsZero())
+
+	_, found = suite.bankKeeper.GetEscrow(ctx, id)
+	require.False(found)
+	require.ErrorIs(suite.bankKeeper.AcceptEscrow(ctx, accAddrs[1], id), banktypes.ErrEscrowNotFound)
+
+	// a timed out escrow can't be accepted anymore, and is refunded
+	suite.mockCreateEscrow(ctx, acc0)
+	id, err = suite.bankKeeper.CreateEscrow(ctx, accAddrs[0], accAddrs[1], amt, nil, timeout)
+	require.NoError(err)
+	require.Equal(uint64(1), id)
+
+	ctx = ctx.WithBlockTime(timeout)
+	require.ErrorIs(suite.bankKeeper.AcceptEscrow(ctx, accAddrs[1], id), banktypes.ErrEscrowTimedOut)
+	require.ErrorIs(suite.bankKeeper.RefundEscrow(ctx, accAddrs[1], id), sdkerrors.ErrUnauthorized)
+
+	suite.mockSendCoins(ctx, escrowAcc, accAddrs[0])
+	require.NoError(suite.bankKeeper.RefundEscrow(ctx, accAddrs[0], id))
+	require.Equal(sdk.NewCoins(newFooCoin(90), newBarCoin(5)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	require.True(suite.bankKeeper.GetAllBalances(ctx, escrowAddr).IsZero())
+	require.ErrorIs(suite.bankKeeper.RefundEscrow(ctx, accAddrs[0], id), banktypes.ErrEscrowNotFound)
+}
+
+func (suite *KeeperTestSuite) TestEscrowSendRestrictionsPauseAndHooks() {
+	ctx := suite.ctx
+	require := suite.Require()
+	escrowAddr := banktypes.EscrowAddress()
+	amt := sdk.NewCoins(newFooCoin(10))
+	timeout := ctx.BlockTime().Add(time.Hour)
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.bankKeeper, ctx, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+
+	// the escrowed coins are moved like any other transfer
+	hooks := &mockBankHooks{}
+	suite.bankKeeper.SetHooks(hooks)
+	suite.mockCreateEscrow(ctx, acc0)
+	id, err := suite.bankKeeper.CreateEscrow(ctx, accAddrs[0], accAddrs[1], amt, nil, timeout)
+	require.NoError(err)
+	require.Equal([]string{
+		fmt.Sprintf("BeforeSend %s %s %s", accAddrs[0], escrowAddr, amt),
+		fmt.Sprintf("AfterSend %s %s %s", accAddrs[0], escrowAddr, amt),
+	}, hooks.calls)
+
+	// the send restrictions can block the moves, but not redirect them
+	restriction := func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		return nil, fmt.Errorf("%s is restricted", toAddr)
+	}
+	suite.bankKeeper.AppendSendRestriction(restriction)
+	suite.authKeeper.EXPECT().GetModuleAccount(ctx, banktypes.EscrowAccountName).Return(escrowAcc).Times(3)
+	_, err = suite.bankKeeper.CreateEscrow(ctx, accAddrs[0], accAddrs[1], amt, nil, timeout)
+	require.ErrorContains(err, "is restricted")
+	require.ErrorContains(suite.bankKeeper.AcceptEscrow(ctx, accAddrs[1], id), "is restricted")
+	suite.bankKeeper.ClearSendRestriction()
+
+	suite.bankKeeper.AppendSendRestriction(func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		return accAddrs[2], nil
+	})
+	_, err = suite.bankKeeper.CreateEscrow(ctx, accAddrs[0], accAddrs[1], amt, nil, timeout)
+	require.ErrorContains(err, "send restrictions redirect the coins")
+	require.ErrorContains(suite.bankKeeper.AcceptEscrow(ctx, accAddrs[1], id), "send restrictions redirect the coins")
+	suite.bankKeeper.ClearSendRestriction()
+
+	// the escrowed coins of paused denoms can't be moved
+	suite.bankKeeper.SetDenomPaused(ctx, fooDenom, true)
+	_, err = suite.bankKeeper.CreateEscrow(ctx, accAddrs[0], accAddrs[1], amt, nil, timeout)
+	require.ErrorIs(err, banktypes.ErrDenomPaused)
+	require.ErrorIs(suite.bankKeeper.AcceptEscrow(ctx, accAddrs[1], id), banktypes.ErrDenomPaused)
+	suite.bankKeeper.SetDenomPaused(ctx, fooDenom, false)
+
+	suite.mockSendCoins(ctx, escrowAcc, accAddrs[1])
+	require.NoError(suite.bankKeeper.AcceptEscrow(ctx, accAddrs[1], id))
+	require.Equal(amt, suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+	require.True(suite.bankKeeper.GetAllBalances(ctx, escrowAddr).IsZero())
+}
+
+// mockBankHooks records the hook calls and blocks the sends to blockedAddr.
+type mockBankHooks struct {
+	calls       []string
+	blockedAddr sdk.AccAddress
+}
+
+func (h *mockBankHooks) BeforeSend(_ sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	if to.Equals(h.blockedAddr) {
+		return fmt.Errorf("%s is blocked", to)
+	}
+	h.calls = append(h.calls, fmt.Sprintf("BeforeSend %s %s %s", from, to, amt))
+	return nil
+}
+
+func (h *mockBankHooks) AfterSend(_ sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	h.calls = append(h.calls, fmt.Sprintf("AfterSend %s %s %s", from, to, amt))
+	return nil
+}
+
+func (h *mockBankHooks) AfterMint(_ sdk.Context, minter sdk.AccAddress, amt sdk.Coins) error {
+	h.calls = append(h.calls, fmt.Sprintf("AfterMint %s %s", minter, amt))
+	return nil
+}
+
+func (h *mockBankHooks) AfterBurn(_ sdk.Context, burner sdk.AccAddress, amt sdk.Coins) error {
+	h.calls = append(h.calls, fmt.Sprintf("AfterBurn %s %s", burner, amt))
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestBankHooks() {
+	ctx := suite.ctx
+	require := suite.Require()
+	coins := sdk.NewCoins(newFooCoin(100))
+
+	hooks := &mockBankHooks{blockedAddr: accAddrs[2]}
+	suite.bankKeeper.SetHooks(hooks)
+	require.Panics(func() { suite.bankKeeper.SetHooks(hooks) })
+
+	suite.mockMintCoins(burnerAcc)
+	require.Panics(func() { _ = suite.bankKeeper.MintCoins(ctx, authtypes.Burner, coins) })
+
+	suite.mockMintCoins(multiPermAcc)
+	require.NoError(suite.bankKeeper.MintCoins(ctx, multiPerm, coins))
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.mockSendCoinsFromModuleToAccount(multiPermAcc, accAddrs[0])
+	require.NoError(suite.bankKeeper.SendCoinsFromModuleToAccount(ctx, multiPerm, accAddrs[0], coins))
+
+	require.ErrorContains(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[2], coins), "is blocked")
+
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], coins))
+
+	acc1 := authtypes.NewBaseAccountWithAddress(accAddrs[1])
+	suite.mockInputOutputCoins([]sdk.AccountI{acc1}, []sdk.AccAddress{multiPermAcc.GetAddress()})
+	input := banktypes.Input{Address: accAddrs[1].String(), Coins: coins}
+	outputs := []banktypes.Output{{Address: multiPermAcc.GetAddress().String(), Coins: coins}}
+	require.NoError(suite.bankKeeper.InputOutputCoins(ctx, input, outputs))
+
+	suite.mockBurnCoins(multiPermAcc)
+	require.NoError(suite.bankKeeper.BurnCoins(ctx, multiPerm, coins))
+
+	require.Equal([]string{
+		fmt.Sprintf("AfterMint %s %s", multiPermAcc.GetAddress(), coins),
+		fmt.Sprintf("BeforeSend %s %s %s", multiPermAcc.GetAddress(), accAddrs[0], coins),
+		fmt.Sprintf("AfterSend %s %s %s", multiPermAcc.GetAddress(), accAddrs[0], coins),
+		fmt.Sprintf("BeforeSend %s %s %s", accAddrs[0], accAddrs[1], coins),
+		fmt.Sprintf("AfterSend %s %s %s", accAddrs[0], accAddrs[1], coins),
+		fmt.Sprintf("BeforeSend %s %s %s", accAddrs[1], multiPermAcc.GetAddress(), coins),
+		fmt.Sprintf("AfterSend %s %s %s", accAddrs[1], multiPermAcc.GetAddress(), coins),
+		fmt.Sprintf("AfterBurn %s %s", multiPermAcc.GetAddress(), coins),
+	}, hooks.calls)
+}
+
+func (suite *KeeperTestSuite) TestPausedDenoms() {
+	ctx := suite.ctx
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.bankKeeper, ctx, accAddrs[0], balances))
+
+	suite.bankKeeper.SetDenomPaused(ctx, fooDenom, true)
+	require.True(suite.bankKeeper.IsDenomPaused(ctx, fooDenom))
+	require.False(suite.bankKeeper.IsDenomPaused(ctx, barDenom))
+
+	err := suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10), newBarCoin(10)))
+	require.ErrorIs(err, banktypes.ErrDenomPaused)
+
+	input := banktypes.Input{Address: accAddrs[0].String(), Coins: sdk.NewCoins(newFooCoin(10))}
+	outputs := []banktypes.Output{{Address: accAddrs[1].String(), Coins: sdk.NewCoins(newFooCoin(10))}}
+	require.ErrorIs(suite.bankKeeper.InputOutputCoins(ctx, input, outputs), banktypes.ErrDenomPaused)
+	require.Equal(balances, suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+
+	// module accounts can't send the coins either, unless they are exempt
+	suite.mockMintCoins(mintAcc)
+	require.NoError(suite.bankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(newFooCoin(10))))
+	suite.authKeeper.EXPECT().GetModuleAddress(mintAcc.Name).Return(mintAcc.GetAddress())
+	err = suite.bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, accAddrs[1], sdk.NewCoins(newFooCoin(10)))
+	require.ErrorIs(err, banktypes.ErrDenomPaused)
+
+	suite.bankKeeper.SetPauseExemptModuleAccounts(minttypes.ModuleName)
+	suite.mockSendCoinsFromModuleToAccount(mintAcc, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+	suite.bankKeeper.SetPauseExemptModuleAccounts()
+
+	// the other denoms can still be sent
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newBarCoin(10))))
+
+	suite.bankKeeper.SetDenomPaused(ctx, fooDenom, false)
+	require.False(suite.bankKeeper.IsDenomPaused(ctx, fooDenom))
+
+	suite.mockInputOutputCoins([]sdk.AccountI{acc0}, accAddrs[1:2])
+	require.NoError(suite.bankKeeper.InputOutputCoins(ctx, input, outputs))
+	require.Equal(sdk.NewCoins(newFooCoin(20), newBarCoin(10)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+}
+
 func (suite *KeeperTestSuite) TestSendCoins_Invalid_SendLockedCoins() {
 	balances := sdk.NewCoins(newFooCoin(50))
 
@@ -167,3 +167,122 @@ func (k msgServer) SetSendEnabled(goCtx context.Context, msg *types.MsgSetSendEn
 
 	return &types.MsgSetSendEnabledResponse{}, nil
 }
+
+func (k msgServer) SetPausedDenoms(goCtx context.Context, msg *types.MsgSetPausedDenoms) (*types.MsgSetPausedDenomsResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	seen := map[string]bool{}
+	for _, denom := range append(msg.Pause, msg.Unpause...) {
+		if seen[denom] {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("duplicate denom entries found for %q", denom)
+		}
+		seen[denom] = true
+
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid denom %q: %s", denom, err)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, denom := range msg.Pause {
+		k.SetDenomPaused(ctx, denom, true)
+	}
+	for _, denom := range msg.Unpause {
+		k.SetDenomPaused(ctx, denom, false)
+	}
+
+	return &types.MsgSetPausedDenomsResponse{}, nil
+}
+
+func (k msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if err := msg.Metadata.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid metadata: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetDenomMetaData(ctx, msg.Metadata)
+
+	return &types.MsgSetDenomMetadataResponse{}, nil
+}
+
+func (k msgServer) CreateEscrow(goCtx context.Context, msg *types.MsgCreateEscrow) (*types.MsgCreateEscrowResponse, error) {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+
+	counterparty, err := sdk.AccAddressFromBech32(msg.Counterparty)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid counterparty address: %s", err)
+	}
+
+	if creator.Equals(counterparty) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("counterparty cannot be the creator")
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsAllPositive() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	if !msg.Expected.IsValid() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Expected.String())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return nil, err
+	}
+
+	if err := k.IsSendEnabledCoins(ctx, msg.Expected...); err != nil {
+		return nil, err
+	}
+
+	if k.BlockedAddr(counterparty) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.Counterparty)
+	}
+
+	if k.BlockedAddr(creator) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.Creator)
+	}
+
+	id, err := k.Keeper.CreateEscrow(ctx, creator, counterparty, msg.Amount, msg.Expected, msg.Timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateEscrowResponse{Id: id}, nil
+}
+
+func (k msgServer) AcceptEscrow(goCtx context.Context, msg *types.MsgAcceptEscrow) (*types.MsgAcceptEscrowResponse, error) {
+	counterparty, err := sdk.AccAddressFromBech32(msg.Counterparty)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid counterparty address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.AcceptEscrow(ctx, counterparty, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAcceptEscrowResponse{}, nil
+}
+
+func (k msgServer) RefundEscrow(goCtx context.Context, msg *types.MsgRefundEscrow) (*types.MsgRefundEscrowResponse, error) {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.RefundEscrow(ctx, creator, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRefundEscrowResponse{}, nil
+}
@@ -1,8 +1,11 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
@@ -360,3 +363,179 @@ func (suite *KeeperTestSuite) TestMsgSetSendEnabled() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgSetPausedDenoms() {
+	testCases := []struct {
+		name     string
+		req      *banktypes.MsgSetPausedDenoms
+		isExpErr bool
+		errMsg   string
+		expPause []string
+	}{
+		{
+			name:     "pause denoms",
+			req:      banktypes.NewMsgSetPausedDenoms(govAcc.GetAddress().String(), []string{"atom", "bridged"}, nil),
+			expPause: []string{"atom", "bridged"},
+		},
+		{
+			name:     "pause and unpause denoms",
+			req:      banktypes.NewMsgSetPausedDenoms(govAcc.GetAddress().String(), []string{"other"}, []string{"atom"}),
+			expPause: []string{"bridged", "other"},
+		},
+		{
+			name:     "duplicate denoms",
+			req:      banktypes.NewMsgSetPausedDenoms(govAcc.GetAddress().String(), []string{"atom"}, []string{"atom"}),
+			isExpErr: true,
+			errMsg:   `duplicate denom entries found for "atom": invalid request`,
+		},
+		{
+			name:     "invalid denom",
+			req:      banktypes.NewMsgSetPausedDenoms(govAcc.GetAddress().String(), []string{"not a denom"}, nil),
+			isExpErr: true,
+			errMsg:   `invalid denom "not a denom": invalid denom: not a denom: invalid request`,
+		},
+		{
+			name:     "invalid authority",
+			req:      banktypes.NewMsgSetPausedDenoms("invalid", []string{"atom"}, nil),
+			isExpErr: true,
+			errMsg:   "invalid authority",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.SetPausedDenoms(suite.ctx, tc.req)
+
+			if tc.isExpErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expPause, suite.bankKeeper.GetPausedDenoms(suite.ctx))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgSetDenomMetadata() {
+	metadata := suite.getTestMetadata()[0]
+	updated := metadata
+	updated.Description = "The updated description."
+
+	invalid := metadata
+	invalid.Name = ""
+
+	testCases := []struct {
+		name     string
+		req      *banktypes.MsgSetDenomMetadata
+		isExpErr bool
+		errMsg   string
+		expMeta  banktypes.Metadata
+	}{
+		{
+			name:    "register metadata",
+			req:     banktypes.NewMsgSetDenomMetadata(govAcc.GetAddress().String(), metadata),
+			expMeta: metadata,
+		},
+		{
+			name:    "update metadata",
+			req:     banktypes.NewMsgSetDenomMetadata(govAcc.GetAddress().String(), updated),
+			expMeta: updated,
+		},
+		{
+			name:     "invalid metadata",
+			req:      banktypes.NewMsgSetDenomMetadata(govAcc.GetAddress().String(), invalid),
+			isExpErr: true,
+			errMsg:   "invalid metadata: name field cannot be blank",
+		},
+		{
+			name:     "invalid authority",
+			req:      banktypes.NewMsgSetDenomMetadata("invalid", metadata),
+			isExpErr: true,
+			errMsg:   "invalid authority",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.SetDenomMetadata(suite.ctx, tc.req)
+
+			if tc.isExpErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				suite.Require().NoError(err)
+				actual, found := suite.bankKeeper.GetDenomMetaData(suite.ctx, tc.req.Metadata.Base)
+				suite.Require().True(found)
+				suite.Require().Equal(tc.expMeta, actual)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgCreateEscrow() {
+	amt := sdk.NewCoins(sdk.NewInt64Coin("foo", 10))
+	timeout := suite.ctx.BlockTime().Add(time.Hour)
+	suite.Require().NoError(suite.bankKeeper.SetParams(suite.ctx, banktypes.DefaultParams()))
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.mockFundAccount(accAddrs[0])
+	suite.Require().NoError(banktestutil.FundAccount(suite.bankKeeper, suite.ctx, accAddrs[0], amt))
+
+	testCases := []struct {
+		name     string
+		req      *banktypes.MsgCreateEscrow
+		isExpErr bool
+		errMsg   string
+	}{
+		{
+			name:     "invalid counterparty",
+			req:      &banktypes.MsgCreateEscrow{Creator: accAddrs[0].String(), Counterparty: "invalid", Amount: amt, Timeout: timeout},
+			isExpErr: true,
+			errMsg:   "invalid counterparty address",
+		},
+		{
+			name:     "counterparty is the creator",
+			req:      banktypes.NewMsgCreateEscrow(accAddrs[0], accAddrs[0], amt, nil, timeout),
+			isExpErr: true,
+			errMsg:   "counterparty cannot be the creator",
+		},
+		{
+			name:     "no coins",
+			req:      banktypes.NewMsgCreateEscrow(accAddrs[0], accAddrs[1], nil, nil, timeout),
+			isExpErr: true,
+			errMsg:   "invalid coins",
+		},
+		{
+			name:     "blocked counterparty",
+			req:      banktypes.NewMsgCreateEscrow(accAddrs[0], accAddrs[4], amt, nil, timeout),
+			isExpErr: true,
+			errMsg:   "is not allowed to receive funds",
+		},
+		{
+			name: "valid escrow",
+			req:  banktypes.NewMsgCreateEscrow(accAddrs[0], accAddrs[1], amt, sdk.NewCoins(sdk.NewInt64Coin("bar", 5)), timeout),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			if !tc.isExpErr {
+				suite.mockCreateEscrow(suite.ctx, acc0)
+			}
+
+			res, err := suite.msgServer.CreateEscrow(suite.ctx, tc.req)
+
+			if tc.isExpErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				suite.Require().NoError(err)
+				escrow, found := suite.bankKeeper.GetEscrow(suite.ctx, res.Id)
+				suite.Require().True(found)
+				suite.Require().Equal(tc.req.Amount, escrow.Amount)
+				suite.Require().Equal(tc.req.Expected, escrow.Expected)
+			}
+		})
+	}
+}
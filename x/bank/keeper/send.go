@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -21,6 +22,16 @@ import (
 type SendKeeper interface {
 	ViewKeeper
 
+	AppendSendRestriction(restriction types.SendRestrictionFn)
+	PrependSendRestriction(restriction types.SendRestrictionFn)
+	ClearSendRestriction()
+
+	AppendLockedCoinsGetter(getter types.GetLockedCoinsFn)
+	ClearLockedCoinsGetter()
+
+	SetHooks(bh types.BankHooks)
+	Hooks() types.BankHooks
+
 	InputOutputCoins(ctx sdk.Context, inputs types.Input, outputs []types.Output) error
 	SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 
@@ -38,6 +49,11 @@ type SendKeeper interface {
 	IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool
 	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
 
+	IsDenomPaused(ctx sdk.Context, denom string) bool
+	SetDenomPaused(ctx sdk.Context, denom string, paused bool)
+	GetPausedDenoms(ctx sdk.Context) []string
+	SetPauseExemptModuleAccounts(moduleNames ...string)
+
 	BlockedAddr(addr sdk.AccAddress) bool
 	GetBlockedAddresses() map[string]bool
 
@@ -62,6 +78,17 @@ type BaseSendKeeper struct {
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
+
+	sendRestriction *sendRestriction
+	hooks           *bankHooks
+	pauseExempt     *pauseExemptAddrs
+}
+
+// pauseExemptAddrs holds the addresses of the module accounts allowed to send
+// the coins of paused denoms, keyed by their raw bytes. It is shared by the
+// copies of the keeper.
+type pauseExemptAddrs struct {
+	addrs map[string]bool
 }
 
 func NewBaseSendKeeper(
@@ -77,14 +104,71 @@ func NewBaseSendKeeper(
 	}
 
 	return BaseSendKeeper{
-		BaseViewKeeper: NewBaseViewKeeper(cdc, storeKey, ak, logger),
-		cdc:            cdc,
-		ak:             ak,
-		storeKey:       storeKey,
-		blockedAddrs:   blockedAddrs,
-		authority:      authority,
-		logger:         logger,
+		BaseViewKeeper:  NewBaseViewKeeper(cdc, storeKey, ak, logger),
+		cdc:             cdc,
+		ak:              ak,
+		storeKey:        storeKey,
+		blockedAddrs:    blockedAddrs,
+		authority:       authority,
+		logger:          logger,
+		sendRestriction: newSendRestriction(),
+		hooks:           &bankHooks{},
+		pauseExempt:     &pauseExemptAddrs{addrs: map[string]bool{}},
+	}
+}
+
+// Hooks gets the hooks for bank.
+func (k BaseSendKeeper) Hooks() types.BankHooks {
+	if k.hooks.hooks == nil {
+		// return a no-op implementation if no hooks are set
+		return types.MultiBankHooks{}
+	}
+
+	return k.hooks.hooks
+}
+
+// SetHooks sets the bank hooks. Each hook is run in isolation, see
+// types.MultiBankHooks.
+func (k BaseSendKeeper) SetHooks(bh types.BankHooks) {
+	if k.hooks.hooks != nil {
+		panic("cannot set bank hooks twice")
+	}
+
+	if _, ok := bh.(types.MultiBankHooks); !ok {
+		bh = types.NewMultiBankHooks(bh)
 	}
+
+	k.hooks.hooks = bh
+}
+
+// AppendSendRestriction adds the provided SendRestrictionFn to run after
+// previously provided restrictions.
+func (k BaseSendKeeper) AppendSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.append(restriction)
+}
+
+// PrependSendRestriction adds the provided SendRestrictionFn to run before
+// previously provided restrictions.
+func (k BaseSendKeeper) PrependSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.prepend(restriction)
+}
+
+// ClearSendRestriction removes the send restriction (if there is one).
+func (k BaseSendKeeper) ClearSendRestriction() {
+	k.sendRestriction.clear()
+}
+
+// SetPauseExemptModuleAccounts sets the module accounts allowed to send the
+// coins of paused denoms, e.g. the fee collector and the distribution module
+// accounts, so that pausing a denom can't halt their block logic. Any other
+// sender, including the other module accounts, can't send paused denoms.
+func (k BaseSendKeeper) SetPauseExemptModuleAccounts(moduleNames ...string) {
+	addrs := make(map[string]bool, len(moduleNames))
+	for _, moduleName := range moduleNames {
+		addrs[string(authtypes.NewModuleAddress(moduleName))] = true
+	}
+
+	k.pauseExempt.addrs = addrs
 }
 
 // GetAuthority returns the x/bank module's authority.
@@ -129,6 +213,29 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, input types.Input, out
 		return err
 	}
 
+	if err := k.checkDenomsNotPaused(ctx, inAddress, input.Coins); err != nil {
+		return err
+	}
+
+	// The send restrictions are applied to every output before any balance is
+	// updated.
+	outAddresses := make([]sdk.AccAddress, len(outputs))
+	for i, out := range outputs {
+		outAddress, err := sdk.AccAddressFromBech32(out.Address)
+		if err != nil {
+			return err
+		}
+
+		outAddresses[i], err = k.sendRestriction.apply(ctx, inAddress, outAddress, out.Coins)
+		if err != nil {
+			return err
+		}
+
+		if err := k.Hooks().BeforeSend(ctx, inAddress, outAddresses[i], out.Coins); err != nil {
+			return err
+		}
+	}
+
 	err = k.subUnlockedCoins(ctx, inAddress, input.Coins)
 	if err != nil {
 		return err
@@ -141,12 +248,8 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, input types.Input, out
 		),
 	)
 
-	for _, out := range outputs {
-		outAddress, err := sdk.AccAddressFromBech32(out.Address)
-		if err != nil {
-			return err
-		}
-
+	for i, out := range outputs {
+		outAddress := outAddresses[i]
 		if err := k.addCoins(ctx, outAddress, out.Coins); err != nil {
 			return err
 		}
@@ -154,7 +257,7 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, input types.Input, out
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeTransfer,
-				sdk.NewAttribute(types.AttributeKeyRecipient, out.Address),
+				sdk.NewAttribute(types.AttributeKeyRecipient, outAddress.String()),
 				sdk.NewAttribute(sdk.AttributeKeyAmount, out.Coins.String()),
 			),
 		)
@@ -168,15 +271,44 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, input types.Input, out
 			defer telemetry.IncrCounter(1, "new", "account")
 			k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, outAddress))
 		}
+
+		if err := k.Hooks().AfterSend(ctx, inAddress, outAddress, out.Coins); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
 // SendCoins transfers amt coins from a sending account to a receiving account.
+// The coins of paused denoms cannot be sent but by module accounts, and the
+// send restrictions may block the transfer or change its receiver.
 // An error is returned upon failure.
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
-	err := k.subUnlockedCoins(ctx, fromAddr, amt)
+	return k.sendCoins(ctx, fromAddr, toAddr, amt, false)
+}
+
+// sendCoins implements SendCoins. If fixedReceiver is true, an error is
+// returned when the send restrictions change the receiver.
+func (k BaseSendKeeper) sendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins, fixedReceiver bool) error {
+	if err := k.checkDenomsNotPaused(ctx, fromAddr, amt); err != nil {
+		return err
+	}
+
+	restrictedAddr, err := k.sendRestriction.apply(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+	if fixedReceiver && !restrictedAddr.Equals(toAddr) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "send restrictions redirect the coins sent to %s", toAddr)
+	}
+	toAddr = restrictedAddr
+
+	if err := k.Hooks().BeforeSend(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
+	err = k.subUnlockedCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
 	}
@@ -211,7 +343,7 @@ func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddre
 		),
 	})
 
-	return nil
+	return k.Hooks().AfterSend(ctx, fromAddr, toAddr, amt)
 }
 
 // subUnlockedCoins removes the unlocked amt coins of the given account. An error is
@@ -322,6 +454,51 @@ func (k BaseSendKeeper) IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
 	return k.IsSendEnabledDenom(ctx, coin.Denom)
 }
 
+// IsDenomPaused returns whether the transfers of the given denom are paused.
+func (k BaseSendKeeper) IsDenomPaused(ctx sdk.Context, denom string) bool {
+	paused, _ := k.PausedDenoms.Has(ctx, denom)
+	return paused
+}
+
+// SetDenomPaused pauses or resumes the transfers of the given denom.
+func (k BaseSendKeeper) SetDenomPaused(ctx sdk.Context, denom string, paused bool) {
+	if paused {
+		_ = k.PausedDenoms.Set(ctx, denom)
+		return
+	}
+	_ = k.PausedDenoms.Remove(ctx, denom)
+}
+
+// GetPausedDenoms returns the denoms whose transfers are paused.
+func (k BaseSendKeeper) GetPausedDenoms(ctx sdk.Context) []string {
+	var denoms []string
+	_ = k.PausedDenoms.Walk(ctx, nil, func(denom string) bool {
+		denoms = append(denoms, denom)
+		return false
+	})
+
+	return denoms
+}
+
+// checkDenomsNotPaused returns an error if the transfers of any of the given
+// coins sent by the sender are paused. Only the module accounts set with
+// SetPauseExemptModuleAccounts can send the coins of paused denoms.
+func (k BaseSendKeeper) checkDenomsNotPaused(ctx sdk.Context, sender sdk.AccAddress, coins sdk.Coins) error {
+	for _, coin := range coins {
+		if !k.IsDenomPaused(ctx, coin.Denom) {
+			continue
+		}
+
+		// the sender is only looked up once a paused denom is sent
+		if k.pauseExempt.addrs[string(sender)] {
+			return nil
+		}
+		return types.ErrDenomPaused.Wrapf("%s transfers are paused", coin.Denom)
+	}
+
+	return nil
+}
+
 // BlockedAddr checks if a given address is restricted from
 // receiving funds.
 func (k BaseSendKeeper) BlockedAddr(addr sdk.AccAddress) bool {
@@ -420,3 +597,48 @@ func (k BaseSendKeeper) getSendEnabledOrDefault(ctx sdk.Context, denom string, d
 
 	return defaultVal
 }
+
+// sendRestriction is a struct that houses a SendRestrictionFn.
+// It exists so that the SendRestrictionFn can be updated in the SendKeeper
+// without needing to have a pointer receiver on the SendKeeper.
+type sendRestriction struct {
+	fn types.SendRestrictionFn
+}
+
+// newSendRestriction creates a new sendRestriction with nil send restriction.
+func newSendRestriction() *sendRestriction {
+	return &sendRestriction{
+		fn: nil,
+	}
+}
+
+// append adds the provided restriction to this, to be run after the existing function.
+func (r *sendRestriction) append(restriction types.SendRestrictionFn) {
+	r.fn = r.fn.Then(restriction)
+}
+
+// prepend adds the provided restriction to this, to be run before the existing function.
+func (r *sendRestriction) prepend(restriction types.SendRestrictionFn) {
+	r.fn = restriction.Then(r.fn)
+}
+
+// clear removes the send restriction (sets it to nil).
+func (r *sendRestriction) clear() {
+	r.fn = nil
+}
+
+var _ types.SendRestrictionFn = (*sendRestriction)(nil).apply
+
+// apply applies the send restriction if there is one. If not, it's a no-op.
+func (r *sendRestriction) apply(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	if r == nil || r.fn == nil {
+		return toAddr, nil
+	}
+	return r.fn(ctx, fromAddr, toAddr, amt)
+}
+
+// bankHooks houses the BankHooks so that they can be set on the SendKeeper
+// without needing to have a pointer receiver on the SendKeeper.
+type bankHooks struct {
+	hooks types.BankHooks
+}
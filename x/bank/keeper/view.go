@@ -72,6 +72,11 @@ type BaseViewKeeper struct {
 	SendEnabled   collections.Map[string, bool]
 	Balances      *collections.IndexedMap[collections.Pair[sdk.AccAddress, string], math.Int, BalancesIndexes]
 	Params        collections.Item[types.Params]
+	PausedDenoms  collections.KeySet[string]
+	EscrowSeq     collections.Sequence
+	Escrows       collections.Map[uint64, types.Escrow]
+
+	lockedCoinsGetter *lockedCoinsGetter
 }
 
 // NewBaseViewKeeper returns a new BaseViewKeeper.
@@ -87,6 +92,11 @@ func NewBaseViewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak t
 		SendEnabled:   collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
 		Balances:      collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.NewBalanceCompatValueCodec(), newBalancesIndexes(sb)),
 		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		PausedDenoms:  collections.NewKeySet(sb, types.PausedDenomsPrefix, "paused_denoms", collections.StringKey),
+		EscrowSeq:     collections.NewSequence(sb, types.EscrowSeqKey, "escrow_seq"),
+		Escrows:       collections.NewMap(sb, types.EscrowsPrefix, "escrows", collections.Uint64Key, codec.CollValue[types.Escrow](cdc)),
+
+		lockedCoinsGetter: newLockedCoinsGetter(),
 	}
 
 	schema, err := sb.Build()
@@ -181,17 +191,30 @@ func (k BaseViewKeeper) IterateAllBalances(ctx sdk.Context, cb func(sdk.AccAddre
 // LockedCoins returns all the coins that are not spendable (i.e. locked) for an
 // account by address. For standard accounts, the result will always be no coins.
 // For vesting accounts, LockedCoins is delegated to the concrete vesting account
-// type.
+// type. The coins locked by the modules through the locked coins getters are
+// added to the result.
 func (k BaseViewKeeper) LockedCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	locked := sdk.NewCoins()
 	acc := k.ak.GetAccount(ctx, addr)
 	if acc != nil {
 		vacc, ok := acc.(types.VestingAccount)
 		if ok {
-			return vacc.LockedCoins(ctx.BlockTime())
+			locked = vacc.LockedCoins(ctx.BlockTime())
 		}
 	}
 
-	return sdk.NewCoins()
+	return locked.Add(k.lockedCoinsGetter.get(ctx, addr)...)
+}
+
+// AppendLockedCoinsGetter adds the provided GetLockedCoinsFn, whose coins are
+// locked in addition to the ones of the previously provided getters.
+func (k BaseViewKeeper) AppendLockedCoinsGetter(getter types.GetLockedCoinsFn) {
+	k.lockedCoinsGetter.append(getter)
+}
+
+// ClearLockedCoinsGetter removes the locked coins getter (if there is one).
+func (k BaseViewKeeper) ClearLockedCoinsGetter() {
+	k.lockedCoinsGetter.clear()
 }
 
 // SpendableCoins returns the total balances of spendable coins for an account
@@ -207,23 +230,30 @@ func (k BaseViewKeeper) SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk
 // is returned.
 func (k BaseViewKeeper) SpendableCoin(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
 	balance := k.GetBalance(ctx, addr, denom)
-	locked := k.LockedCoins(ctx, addr)
-	return balance.SubAmount(locked.AmountOf(denom))
+	locked := k.LockedCoins(ctx, addr).AmountOf(denom)
+	if locked.GTE(balance.Amount) {
+		return sdk.NewCoin(denom, math.ZeroInt())
+	}
+
+	return balance.SubAmount(locked)
 }
 
 // spendableCoins returns the coins the given address can spend alongside the total amount of coins it holds.
 // It exists for gas efficiency, in order to avoid to have to get balance multiple times.
+// A denom whose locked amount exceeds its balance has no spendable coins.
 func (k BaseViewKeeper) spendableCoins(ctx sdk.Context, addr sdk.AccAddress) (spendable, total sdk.Coins) {
 	total = k.GetAllBalances(ctx, addr)
 	locked := k.LockedCoins(ctx, addr)
 
-	spendable, hasNeg := total.SafeSub(locked...)
-	if hasNeg {
-		spendable = sdk.NewCoins()
-		return
+	spendable = sdk.NewCoins()
+	for _, coin := range total {
+		amt := coin.Amount.Sub(locked.AmountOf(coin.Denom))
+		if amt.IsPositive() {
+			spendable = append(spendable, sdk.NewCoin(coin.Denom, amt))
+		}
 	}
 
-	return
+	return spendable, total
 }
 
 // ValidateBalance validates all balances for a given account address returning
@@ -254,3 +284,35 @@ func (k BaseViewKeeper) ValidateBalance(ctx sdk.Context, addr sdk.AccAddress) er
 
 	return nil
 }
+
+// lockedCoinsGetter is a struct that houses a GetLockedCoinsFn.
+// It exists so that the GetLockedCoinsFn can be updated in the ViewKeeper
+// without needing to have a pointer receiver on the ViewKeeper.
+type lockedCoinsGetter struct {
+	fn types.GetLockedCoinsFn
+}
+
+// newLockedCoinsGetter creates a new lockedCoinsGetter with nil getter.
+func newLockedCoinsGetter() *lockedCoinsGetter {
+	return &lockedCoinsGetter{
+		fn: nil,
+	}
+}
+
+// append adds the provided getter to this, its coins being added to the existing ones.
+func (g *lockedCoinsGetter) append(getter types.GetLockedCoinsFn) {
+	g.fn = types.ComposeGetLockedCoins(g.fn, getter)
+}
+
+// clear removes the locked coins getter (sets it to nil).
+func (g *lockedCoinsGetter) clear() {
+	g.fn = nil
+}
+
+// get returns the coins locked by the getter if there is one. If not, no coins are locked.
+func (g *lockedCoinsGetter) get(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	if g == nil || g.fn == nil {
+		return nil
+	}
+	return g.fn(ctx, addr)
+}
@@ -74,10 +74,13 @@ func TestMigrateJSON(t *testing.T) {
 		}
 	],
 	"denom_metadata": [],
+	"escrow_seq": "0",
+	"escrows": [],
 	"params": {
 		"default_send_enabled": false,
 		"send_enabled": []
 	},
+	"paused_denoms": [],
 	"send_enabled": [],
 	"supply": [
 		{
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"time"
 
 	modulev1 "cosmossdk.io/api/cosmos/bank/module/v1"
@@ -11,8 +12,10 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
+	"golang.org/x/exp/maps"
 
 	store "cosmossdk.io/store/types"
 
@@ -61,6 +64,11 @@ func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
 	return cdc.MustMarshalJSON(types.DefaultGenesisState())
 }
 
+// NewGenesisState returns an empty genesis state of the bank module.
+func (AppModuleBasic) NewGenesisState() proto.Message {
+	return &types.GenesisState{}
+}
+
 // ValidateGenesis performs genesis state validation for the bank module.
 func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
 	var data types.GenesisState
@@ -117,7 +125,8 @@ func (am AppModule) IsAppModule() {}
 
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
-	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
+	msgServer := keeper.NewMsgServerImpl(am.keeper)
+	types.RegisterMsgServer(cfg.MsgServer(), msgServer)
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 
 	m := keeper.NewMigrator(am.keeper.(keeper.BaseKeeper), am.legacySubspace)
@@ -204,6 +213,7 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 func init() {
 	appmodule.Register(&modulev1.Module{},
 		appmodule.Provide(ProvideModule),
+		appmodule.Invoke(InvokeSetSendRestrictions, InvokeSetLockedCoinsGetters, InvokeSetHooks),
 	)
 }
 
@@ -262,3 +272,55 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 
 	return ModuleOutputs{BankKeeper: bankKeeper, Module: m}
 }
+
+// InvokeSetSendRestrictions appends the send restrictions provided by the
+// modules to the bank keeper.
+func InvokeSetSendRestrictions(keeper keeper.BaseKeeper, restrictions map[string]types.SendRestrictionFn) {
+	if len(restrictions) == 0 {
+		return
+	}
+
+	// Default ordering is lexical by module name.
+	// Explicit ordering can be added to the module config if required.
+	order := maps.Keys(restrictions)
+	sort.Strings(order)
+
+	for _, modName := range order {
+		keeper.AppendSendRestriction(restrictions[modName])
+	}
+}
+
+// InvokeSetLockedCoinsGetters appends the locked coins getters provided by the
+// modules to the bank keeper.
+func InvokeSetLockedCoinsGetters(keeper keeper.BaseKeeper, getters map[string]types.GetLockedCoinsFn) {
+	if len(getters) == 0 {
+		return
+	}
+
+	order := maps.Keys(getters)
+	sort.Strings(order)
+
+	for _, modName := range order {
+		keeper.AppendLockedCoinsGetter(getters[modName])
+	}
+}
+
+// InvokeSetHooks sets the bank hooks provided by the modules on the bank
+// keeper.
+func InvokeSetHooks(keeper keeper.BaseKeeper, bankHooks map[string]types.BankHooksWrapper) {
+	if len(bankHooks) == 0 {
+		return
+	}
+
+	// Default ordering is lexical by module name.
+	// Explicit ordering can be added to the module config if required.
+	order := maps.Keys(bankHooks)
+	sort.Strings(order)
+
+	var multiHooks types.MultiBankHooks
+	for _, modName := range order {
+		multiHooks = append(multiHooks, bankHooks[modName])
+	}
+
+	keeper.SetHooks(multiHooks)
+}
@@ -20,6 +20,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgMultiSend{}, "cosmos-sdk/MsgMultiSend")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/bank/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgSetSendEnabled{}, "cosmos-sdk/MsgSetSendEnabled")
+	legacy.RegisterAminoMsg(cdc, &MsgSetPausedDenoms{}, "cosmos-sdk/MsgSetPausedDenoms")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDenomMetadata{}, "cosmos-sdk/MsgSetDenomMetadata")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateEscrow{}, "cosmos-sdk/MsgCreateEscrow")
+	legacy.RegisterAminoMsg(cdc, &MsgAcceptEscrow{}, "cosmos-sdk/MsgAcceptEscrow")
+	legacy.RegisterAminoMsg(cdc, &MsgRefundEscrow{}, "cosmos-sdk/MsgRefundEscrow")
 
 	cdc.RegisterConcrete(&SendAuthorization{}, "cosmos-sdk/SendAuthorization", nil)
 	cdc.RegisterConcrete(&Params{}, "cosmos-sdk/x/bank/Params", nil)
@@ -30,6 +35,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSend{},
 		&MsgMultiSend{},
 		&MsgUpdateParams{},
+		&MsgSetPausedDenoms{},
+		&MsgSetDenomMetadata{},
+		&MsgCreateEscrow{},
+		&MsgAcceptEscrow{},
+		&MsgRefundEscrow{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
@@ -12,4 +12,8 @@ var (
 	ErrInvalidKey            = errors.Register(ModuleName, 7, "invalid key")
 	ErrDuplicateEntry        = errors.Register(ModuleName, 8, "duplicate entry")
 	ErrMultipleSenders       = errors.Register(ModuleName, 9, "multiple senders not allowed")
+	ErrDenomPaused           = errors.Register(ModuleName, 10, "denom transfers are paused")
+	ErrEscrowNotFound        = errors.Register(ModuleName, 11, "escrow not found")
+	ErrEscrowTimedOut        = errors.Register(ModuleName, 12, "escrow timed out")
+	ErrEscrowNotTimedOut     = errors.Register(ModuleName, 13, "escrow not timed out")
 )
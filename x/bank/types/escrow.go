@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// EscrowAccountName is the name of the module account holding the escrowed
+// coins until they are released or refunded. Apps must register it, without
+// permissions, and block it from receiving funds.
+const EscrowAccountName = "bank_escrow"
+
+// EscrowAddress returns the address of the escrow module account.
+func EscrowAddress() sdk.AccAddress {
+	return address.Module(EscrowAccountName)
+}
+
+// Validate performs a basic validation of the escrow.
+func (e Escrow) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(e.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(e.Counterparty); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid counterparty address: %s", err)
+	}
+
+	if e.Creator == e.Counterparty {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "the counterparty cannot be the creator")
+	}
+
+	if !e.Amount.IsValid() || e.Amount.Empty() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, e.Amount.String())
+	}
+
+	if !e.Expected.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, e.Expected.String())
+	}
+
+	if e.Timeout.IsZero() {
+		return fmt.Errorf("escrow %d has no timeout", e.Id)
+	}
+
+	return nil
+}
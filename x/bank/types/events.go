@@ -21,6 +21,15 @@ const (
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyMinter   = "minter"
 	AttributeKeyBurner   = "burner"
+
+	// escrow events name and attributes
+	EventTypeCreateEscrow = "create_escrow"
+	EventTypeAcceptEscrow = "accept_escrow"
+	EventTypeRefundEscrow = "refund_escrow"
+
+	AttributeKeyEscrowID     = "escrow_id"
+	AttributeKeyCreator      = "creator"
+	AttributeKeyCounterparty = "counterparty"
 )
 
 // NewCoinSpentEvent constructs a new coin spent sdk.Event
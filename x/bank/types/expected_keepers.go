@@ -29,3 +29,22 @@ type AccountKeeper interface {
 	SetModuleAccount(ctx context.Context, macc sdk.ModuleAccountI)
 	GetModulePermissions() map[string]types.PermissionsForAddress
 }
+
+// Event Hooks
+// These can be utilized to communicate between a bank keeper and another
+// keeper which must take particular actions when balances change. The second
+// keeper must implement this interface, which then the bank keeper can call.
+
+// BankHooks event hooks for balance changes (noalias)
+type BankHooks interface {
+	BeforeSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error // Must be called before coins are sent, an error blocks the send
+	AfterSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error  // Must be called after coins are sent
+	AfterMint(ctx sdk.Context, minterAddr sdk.AccAddress, amt sdk.Coins) error        // Must be called after coins are minted to a module account
+	AfterBurn(ctx sdk.Context, burnerAddr sdk.AccAddress, amt sdk.Coins) error        // Must be called after coins are burned from a module account
+}
+
+// BankHooksWrapper is a wrapper for modules to inject BankHooks using depinject.
+type BankHooksWrapper struct{ BankHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (BankHooksWrapper) IsOnePerModuleType() {}
@@ -62,6 +62,23 @@ func (gs GenesisState) Validate() error {
 		seenMetadatas[metadata.Base] = true
 	}
 
+	seenPausedDenoms := make(map[string]bool)
+	for _, denom := range gs.PausedDenoms {
+		if seenPausedDenoms[denom] {
+			return fmt.Errorf("duplicate paused denom %s", denom)
+		}
+
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid paused denom %q: %w", denom, err)
+		}
+
+		seenPausedDenoms[denom] = true
+	}
+
+	if err := gs.validateEscrows(); err != nil {
+		return err
+	}
+
 	if !gs.Supply.Empty() {
 		// NOTE: this errors if supply for any given coin is zero
 		err := gs.Supply.Validate()
@@ -77,6 +94,42 @@ func (gs GenesisState) Validate() error {
 	return nil
 }
 
+// validateEscrows validates the escrows, whose coins must be held by the
+// escrow address.
+func (gs GenesisState) validateEscrows() error {
+	seenEscrows := make(map[uint64]bool)
+	escrowed := sdk.NewCoins()
+	for _, escrow := range gs.Escrows {
+		if seenEscrows[escrow.Id] {
+			return fmt.Errorf("duplicate escrow %d", escrow.Id)
+		}
+
+		if escrow.Id >= gs.EscrowSeq {
+			return fmt.Errorf("escrow %d is not lower than the escrow sequence %d", escrow.Id, gs.EscrowSeq)
+		}
+
+		if err := escrow.Validate(); err != nil {
+			return fmt.Errorf("invalid escrow %d: %w", escrow.Id, err)
+		}
+
+		seenEscrows[escrow.Id] = true
+		escrowed = escrowed.Add(escrow.Amount...)
+	}
+
+	if escrowed.Empty() {
+		return nil
+	}
+
+	escrowAddr := EscrowAddress().String()
+	for _, balance := range gs.Balances {
+		if balance.Address == escrowAddr && balance.Coins.IsAllGTE(escrowed) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("the balance of the escrow address %s must hold the escrowed coins %s", escrowAddr, escrowed)
+}
+
 // NewGenesisState creates a new genesis state.
 func NewGenesisState(params Params, balances []Balance, supply sdk.Coins, denomMetaData []Metadata, sendEnabled []SendEnabled) *GenesisState {
 	rv := &GenesisState{
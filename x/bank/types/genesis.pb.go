@@ -42,6 +42,18 @@ type GenesisState struct {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled []SendEnabled `protobuf:"bytes,5,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled"`
+	// paused_denoms defines the denoms whose transfers are paused.
+	//
+	// Since: cosmos-sdk 0.50
+	PausedDenoms []string `protobuf:"bytes,6,rep,name=paused_denoms,json=pausedDenoms,proto3" json:"paused_denoms,omitempty"`
+	// escrows defines the pending escrows.
+	//
+	// Since: cosmos-sdk 0.50
+	Escrows []Escrow `protobuf:"bytes,7,rep,name=escrows,proto3" json:"escrows"`
+	// escrow_seq is the id of the next escrow.
+	//
+	// Since: cosmos-sdk 0.50
+	EscrowSeq uint64 `protobuf:"varint,8,opt,name=escrow_seq,json=escrowSeq,proto3" json:"escrow_seq,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -112,6 +124,27 @@ func (m *GenesisState) GetSendEnabled() []SendEnabled {
 	return nil
 }
 
+func (m *GenesisState) GetPausedDenoms() []string {
+	if m != nil {
+		return m.PausedDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEscrows() []Escrow {
+	if m != nil {
+		return m.Escrows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEscrowSeq() uint64 {
+	if m != nil {
+		return m.EscrowSeq
+	}
+	return 0
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -162,36 +195,40 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/genesis.proto", fileDescriptor_8f007de11b420c6e) }
 
 var fileDescriptor_8f007de11b420c6e = []byte{
-	// 461 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x6d, 0x42, 0xd3, 0xf6, 0x12, 0x90, 0x38, 0x3a, 0xb8, 0x05, 0x9c, 0xd0, 0x29, 0x54,
-	0xaa, 0xad, 0x86, 0x8d, 0x01, 0x09, 0x47, 0xc0, 0xc4, 0x0f, 0x35, 0x1b, 0x4b, 0x74, 0xf6, 0x3d,
-	0x19, 0xab, 0xf1, 0x9d, 0x95, 0x77, 0x05, 0xf2, 0x1f, 0x30, 0x32, 0x33, 0x75, 0x44, 0x2c, 0x74,
-	0xe0, 0x0f, 0x60, 0xec, 0x58, 0x31, 0x31, 0x01, 0x4a, 0x86, 0xf2, 0x67, 0x20, 0xdf, 0x5d, 0x5d,
-	0x4b, 0x58, 0x8c, 0x5d, 0x12, 0xeb, 0xbe, 0xdf, 0xf7, 0xf9, 0xbe, 0xf7, 0xee, 0xc8, 0xdd, 0x44,
-	0x62, 0x2e, 0x31, 0x8c, 0x99, 0x38, 0x08, 0xdf, 0xec, 0xc5, 0xa0, 0xd8, 0x5e, 0x98, 0x82, 0x00,
-	0xcc, 0x30, 0x28, 0x66, 0x52, 0x49, 0x7a, 0xd3, 0x58, 0x82, 0xd2, 0x12, 0x58, 0xcb, 0xd6, 0x46,
-	0x2a, 0x53, 0xa9, 0xf5, 0xb0, 0xfc, 0x32, 0xd6, 0x2d, 0xbf, 0xa2, 0x21, 0x54, 0xb4, 0x44, 0x66,
-	0xe2, 0x1f, 0xbd, 0x96, 0xa6, 0xb9, 0x46, 0xdf, 0x34, 0xfa, 0xc4, 0x80, 0x6d, 0xae, 0x91, 0x6e,
-	0xb0, 0x3c, 0x13, 0x32, 0xd4, 0xbf, 0xe6, 0x68, 0xfb, 0x4b, 0x8b, 0x74, 0x9f, 0x9a, 0x56, 0xc7,
-	0x8a, 0x29, 0xa0, 0x0f, 0x49, 0xbb, 0x60, 0x33, 0x96, 0xa3, 0xe7, 0xf6, 0xdd, 0x41, 0x67, 0x78,
-	0x2b, 0x68, 0x68, 0x3d, 0x78, 0xa9, 0x2d, 0xd1, 0xfa, 0xc9, 0xcf, 0x9e, 0xf3, 0xe9, 0xec, 0x78,
-	0xc7, 0xdd, 0xb7, 0x55, 0x74, 0x44, 0xd6, 0x62, 0x36, 0x65, 0x22, 0x01, 0xf4, 0xae, 0xf4, 0x5b,
-	0x83, 0xce, 0xf0, 0x76, 0x23, 0x21, 0x32, 0xa6, 0x3a, 0xa2, 0x2a, 0xa4, 0x73, 0xd2, 0xc6, 0xc3,
-	0xa2, 0x98, 0xce, 0xbd, 0x96, 0x46, 0x6c, 0x5e, 0x20, 0x10, 0x2a, 0xc4, 0x48, 0x66, 0x22, 0x7a,
-	0x52, 0xd6, 0x7f, 0xfe, 0xd5, 0x1b, 0xa4, 0x99, 0x7a, 0x7d, 0x18, 0x07, 0x89, 0xcc, 0xed, 0xd0,
-	0xf6, 0x6f, 0x17, 0xf9, 0x41, 0xa8, 0xe6, 0x05, 0xa0, 0x2e, 0xc0, 0x8f, 0x67, 0xc7, 0x3b, 0xdd,
-	0x29, 0xa4, 0x2c, 0x99, 0x4f, 0xca, 0xb5, 0xa2, 0xed, 0xdf, 0x04, 0xd2, 0x17, 0xe4, 0x3a, 0x07,
-	0x21, 0xf3, 0x49, 0x0e, 0x8a, 0x71, 0xa6, 0x98, 0x77, 0x55, 0xb7, 0x70, 0xa7, 0x71, 0x8a, 0x67,
-	0xd6, 0x54, 0x1f, 0xe3, 0x9a, 0xae, 0x3f, 0x57, 0xe8, 0x73, 0xd2, 0x45, 0x10, 0x7c, 0x02, 0x82,
-	0xc5, 0x53, 0xe0, 0xde, 0x8a, 0xc6, 0xf5, 0x1b, 0x71, 0x63, 0x10, 0xfc, 0xb1, 0xf1, 0xd5, 0x89,
-	0x1d, 0xbc, 0x38, 0xdf, 0xfe, 0xe6, 0x92, 0x55, 0xbb, 0x3c, 0x3a, 0x24, 0xab, 0x8c, 0xf3, 0x19,
-	0xa0, 0xb9, 0xad, 0xf5, 0xc8, 0xfb, 0xfe, 0x75, 0x77, 0xc3, 0x92, 0x1f, 0x19, 0x65, 0xac, 0x66,
-	0x99, 0x48, 0xf7, 0xcf, 0x8d, 0xf4, 0x2d, 0x59, 0xd1, 0x63, 0xdb, 0xdb, 0xb9, 0x84, 0xd5, 0x9a,
-	0xbc, 0x07, 0x6b, 0xef, 0x8f, 0x7a, 0xce, 0x9f, 0xa3, 0x9e, 0x13, 0x8d, 0x4e, 0x16, 0xbe, 0x7b,
-	0xba, 0xf0, 0xdd, 0xdf, 0x0b, 0xdf, 0xfd, 0xb0, 0xf4, 0x9d, 0xd3, 0xa5, 0xef, 0xfc, 0x58, 0xfa,
-	0xce, 0xab, 0x7b, 0xff, 0x8d, 0x7a, 0x67, 0x1e, 0xbd, 0x4e, 0x8c, 0xdb, 0xfa, 0x01, 0xdf, 0xff,
-	0x1b, 0x00, 0x00, 0xff, 0xff, 0x78, 0x65, 0xc7, 0x6b, 0x7e, 0x03, 0x00, 0x00,
+	// 526 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xb7, 0x9b, 0x34, 0x69, 0x2e, 0x29, 0x12, 0x47, 0x07, 0xb7, 0x50, 0xc7, 0x94, 0x25, 0x54,
+	0xaa, 0xad, 0x86, 0x0d, 0x24, 0x24, 0x1c, 0x0a, 0x13, 0x7f, 0x94, 0x6c, 0x2c, 0xd6, 0xd9, 0x7e,
+	0x32, 0x56, 0xe3, 0x3b, 0x37, 0xcf, 0xa1, 0xe4, 0x1b, 0x30, 0x32, 0x33, 0x75, 0x44, 0x4c, 0x19,
+	0xf8, 0x00, 0x8c, 0x1d, 0x2b, 0x26, 0x26, 0x40, 0xc9, 0x50, 0x3e, 0x06, 0xca, 0xdd, 0x25, 0x8d,
+	0x44, 0x60, 0x64, 0xb1, 0x4f, 0xef, 0xf7, 0xef, 0xde, 0xbd, 0x3b, 0x72, 0x3b, 0x12, 0x98, 0x09,
+	0xf4, 0x42, 0xc6, 0x8f, 0xbd, 0x37, 0x87, 0x21, 0x14, 0xec, 0xd0, 0x4b, 0x80, 0x03, 0xa6, 0xe8,
+	0xe6, 0x03, 0x51, 0x08, 0x7a, 0x43, 0x51, 0xdc, 0x19, 0xc5, 0xd5, 0x94, 0x9d, 0xad, 0x44, 0x24,
+	0x42, 0xe2, 0xde, 0x6c, 0xa5, 0xa8, 0x3b, 0xf6, 0xc2, 0x0d, 0x61, 0xe1, 0x16, 0x89, 0x94, 0xff,
+	0x81, 0x2f, 0xa5, 0x49, 0x5f, 0x85, 0x3b, 0xab, 0x70, 0xc0, 0x68, 0x20, 0x4e, 0x35, 0x63, 0x5b,
+	0x31, 0x02, 0x15, 0xad, 0x77, 0xa6, 0xa0, 0xeb, 0x2c, 0x4b, 0xb9, 0xf0, 0xe4, 0x57, 0x95, 0xf6,
+	0xc6, 0x65, 0xd2, 0x78, 0xaa, 0x9a, 0xe9, 0x15, 0xac, 0x00, 0xfa, 0x90, 0x54, 0x72, 0x36, 0x60,
+	0x19, 0x5a, 0xa6, 0x63, 0xb6, 0xea, 0xed, 0x9b, 0xee, 0x8a, 0xe6, 0xdc, 0x97, 0x92, 0xe2, 0xd7,
+	0xce, 0xbf, 0x37, 0x8d, 0x8f, 0x97, 0xe3, 0x7d, 0xb3, 0xab, 0x55, 0xb4, 0x43, 0x36, 0x42, 0xd6,
+	0x67, 0x3c, 0x02, 0xb4, 0xd6, 0x9c, 0x52, 0xab, 0xde, 0xbe, 0xb5, 0xd2, 0xc1, 0x57, 0xa4, 0x65,
+	0x8b, 0x85, 0x90, 0x8e, 0x48, 0x05, 0x87, 0x79, 0xde, 0x1f, 0x59, 0x25, 0x69, 0xb1, 0x7d, 0x65,
+	0x81, 0xb0, 0xb0, 0xe8, 0x88, 0x94, 0xfb, 0x4f, 0x66, 0xfa, 0x4f, 0x3f, 0x9a, 0xad, 0x24, 0x2d,
+	0x5e, 0x0f, 0x43, 0x37, 0x12, 0x99, 0x6e, 0x5a, 0xff, 0x0e, 0x30, 0x3e, 0xf6, 0x8a, 0x51, 0x0e,
+	0x28, 0x05, 0xf8, 0xe1, 0x72, 0xbc, 0xdf, 0xe8, 0x43, 0xc2, 0xa2, 0x51, 0x30, 0x3b, 0x78, 0xd4,
+	0xfb, 0x57, 0x81, 0xf4, 0x05, 0xb9, 0x16, 0x03, 0x17, 0x59, 0x90, 0x41, 0xc1, 0x62, 0x56, 0x30,
+	0xab, 0x2c, 0xb7, 0xb0, 0xbb, 0xb2, 0x8b, 0x67, 0x9a, 0xb4, 0xdc, 0xc6, 0xa6, 0xd4, 0xcf, 0x11,
+	0xfa, 0x9c, 0x34, 0x10, 0x78, 0x1c, 0x00, 0x67, 0x61, 0x1f, 0x62, 0x6b, 0x5d, 0xda, 0x39, 0x2b,
+	0xed, 0x7a, 0xc0, 0xe3, 0x23, 0xc5, 0x5b, 0x76, 0xac, 0xe3, 0x55, 0x9d, 0xde, 0x21, 0x9b, 0x39,
+	0x1b, 0x22, 0xc4, 0x81, 0xcc, 0x41, 0xab, 0xe2, 0x94, 0x5a, 0xb5, 0x6e, 0x43, 0x15, 0x1f, 0xcb,
+	0x1a, 0x7d, 0x40, 0xaa, 0xea, 0x52, 0xa0, 0x55, 0x75, 0x4a, 0x7f, 0x1d, 0xe3, 0x91, 0xe4, 0xf8,
+	0xe5, 0x59, 0x54, 0x77, 0xae, 0xa0, 0xbb, 0x84, 0xa8, 0x65, 0x80, 0x70, 0x62, 0x6d, 0x38, 0x66,
+	0xab, 0xdc, 0xad, 0xa9, 0x4a, 0x0f, 0x4e, 0xf6, 0xbe, 0x98, 0xa4, 0xaa, 0xa7, 0x47, 0xdb, 0xa4,
+	0xca, 0xe2, 0x78, 0x00, 0xa8, 0xae, 0x4b, 0xcd, 0xb7, 0xbe, 0x7e, 0x3e, 0xd8, 0xd2, 0x51, 0x8f,
+	0x14, 0xd2, 0x2b, 0x06, 0x29, 0x4f, 0xba, 0x73, 0x22, 0x3d, 0x25, 0xeb, 0xf2, 0xdc, 0xad, 0xb5,
+	0xff, 0x35, 0x5b, 0x95, 0x77, 0x7f, 0xe3, 0xdd, 0x59, 0xd3, 0xf8, 0x75, 0xd6, 0x34, 0xfc, 0xce,
+	0xf9, 0xc4, 0x36, 0x2f, 0x26, 0xb6, 0xf9, 0x73, 0x62, 0x9b, 0xef, 0xa7, 0xb6, 0x71, 0x31, 0xb5,
+	0x8d, 0x6f, 0x53, 0xdb, 0x78, 0x75, 0xf7, 0x9f, 0x51, 0x6f, 0xd5, 0xbb, 0x93, 0x89, 0x61, 0x45,
+	0xbe, 0xa0, 0x7b, 0xbf, 0x07, 0x00, 0x23, 0x58, 0x45, 0xc8, 0x21, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -214,6 +251,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EscrowSeq != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EscrowSeq))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Escrows) > 0 {
+		for iNdEx := len(m.Escrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PausedDenoms) > 0 {
+		for iNdEx := len(m.PausedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PausedDenoms[iNdEx])
+			copy(dAtA[i:], m.PausedDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PausedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.SendEnabled) > 0 {
 		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -370,6 +435,21 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PausedDenoms) > 0 {
+		for _, s := range m.PausedDenoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Escrows) > 0 {
+		for _, e := range m.Escrows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.EscrowSeq != 0 {
+		n += 1 + sovGenesis(uint64(m.EscrowSeq))
+	}
 	return n
 }
 
@@ -596,6 +676,91 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedDenoms = append(m.PausedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrows = append(m.Escrows, Escrow{})
+			if err := m.Escrows[len(m.Escrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowSeq", wireType)
+			}
+			m.EscrowSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EscrowSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,14 +2,36 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+var escrowCoins = sdk.NewCoins(sdk.NewInt64Coin("uatom", 10))
+
+// escrowGenesis returns a genesis state with an escrow of escrowCoins, whose
+// escrow address holds the given balance.
+func escrowGenesis(id, seq uint64, balance sdk.Coins) GenesisState {
+	return GenesisState{
+		Balances: []Balance{{Address: EscrowAddress().String(), Coins: balance}},
+		Escrows: []Escrow{{
+			Id:           id,
+			Creator:      "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+			Counterparty: "cosmos1vy0ga0klndqy92ceqehfkvgmn4t94eteq4hmqv",
+			Amount:       escrowCoins,
+			Timeout:      time.Unix(1700000000, 0).UTC(),
+		}},
+		EscrowSeq: seq,
+	}
+}
+
 func TestGenesisStateValidate(t *testing.T) {
 	testCases := []struct {
 		name         string
@@ -142,6 +164,22 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{"paused denoms", GenesisState{PausedDenoms: []string{"uatom", "bridged"}}, false},
+		{"duplicate paused denom", GenesisState{PausedDenoms: []string{"uatom", "uatom"}}, true},
+		{"invalid paused denom", GenesisState{PausedDenoms: []string{"not a denom"}}, true},
+		{"escrow", escrowGenesis(1, 2, escrowCoins), false},
+		{"escrow not below the sequence", escrowGenesis(1, 1, escrowCoins), true},
+		{"escrowed coins not held by the escrow address", escrowGenesis(1, 2, sdk.NewCoins(sdk.NewInt64Coin("uatom", 5))), true},
+		{"duplicate escrow", func() GenesisState {
+			gs := escrowGenesis(1, 2, escrowCoins.Add(escrowCoins...))
+			gs.Escrows = append(gs.Escrows, gs.Escrows[0])
+			return gs
+		}(), true},
+		{"escrow without timeout", func() GenesisState {
+			gs := escrowGenesis(1, 2, escrowCoins)
+			gs.Escrows[0].Timeout = time.Time{}
+			return gs
+		}(), true},
 	}
 
 	for _, tc := range testCases {
@@ -158,6 +196,20 @@ func TestGenesisStateValidate(t *testing.T) {
 	}
 }
 
+func TestGenesisStateGolden(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	genesis := NewGenesisState(
+		DefaultParams(),
+		[]Balance{{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))}},
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+		[]Metadata{{Description: "The native staking token", Base: "stake", Display: "stake", Name: "Stake", Symbol: "STAKE"}},
+		[]SendEnabled{{Denom: "stake", Enabled: true}},
+	)
+
+	testutil.AssertGoldenProto(t, cdc, genesis, "genesis.json")
+	testutil.AssertGoldenProto(t, cdc, &QueryBalanceResponse{Balance: &genesis.Balances[0].Coins[0]}, "query_balance_response.json")
+}
+
 func TestMigrateSendEnabled(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -0,0 +1,90 @@
+package types
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// combine multiple bank hooks, all hook functions are run in array sequence.
+//
+// Each hook is run in isolation: if it panics, the state changes and events of
+// the hook are discarded and the panic is returned as an error, which aborts
+// the operation like an error returned by the hook. Out of gas panics are not
+// recovered, so that hooks are metered.
+var _ BankHooks = &MultiBankHooks{}
+
+type MultiBankHooks []BankHooks
+
+func NewMultiBankHooks(hooks ...BankHooks) MultiBankHooks {
+	return hooks
+}
+
+func (h MultiBankHooks) BeforeSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	for i := range h {
+		if err := runHook(ctx, "BeforeSend", func(ctx sdk.Context) error {
+			return h[i].BeforeSend(ctx, fromAddr, toAddr, amt)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiBankHooks) AfterSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	for i := range h {
+		if err := runHook(ctx, "AfterSend", func(ctx sdk.Context) error {
+			return h[i].AfterSend(ctx, fromAddr, toAddr, amt)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiBankHooks) AfterMint(ctx sdk.Context, minterAddr sdk.AccAddress, amt sdk.Coins) error {
+	for i := range h {
+		if err := runHook(ctx, "AfterMint", func(ctx sdk.Context) error {
+			return h[i].AfterMint(ctx, minterAddr, amt)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiBankHooks) AfterBurn(ctx sdk.Context, burnerAddr sdk.AccAddress, amt sdk.Coins) error {
+	for i := range h {
+		if err := runHook(ctx, "AfterBurn", func(ctx sdk.Context) error {
+			return h[i].AfterBurn(ctx, burnerAddr, amt)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runHook runs a hook in a cached context, which is only written if the hook
+// neither panicked nor returned an error. A panic is returned as an error.
+func runHook(ctx sdk.Context, name string, hook func(ctx sdk.Context) error) (err error) {
+	cacheCtx, write := ctx.CacheContext()
+
+	defer func() {
+		if r := recover(); r != nil {
+			switch r.(type) {
+			case storetypes.ErrorOutOfGas, storetypes.ErrorGasOverflow:
+				panic(r)
+			}
+
+			err = sdkerrors.ErrPanic.Wrapf("bank hook %s panicked: %v", name, r)
+		}
+	}()
+
+	if err := hook(cacheCtx); err != nil {
+		return err
+	}
+
+	write()
+	return nil
+}
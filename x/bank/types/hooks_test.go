@@ -0,0 +1,125 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// recordingHooks writes the name of the hook under its key, then runs fn.
+type recordingHooks struct {
+	key   *storetypes.KVStoreKey
+	name  string
+	fn    func(ctx sdk.Context) error
+	calls int
+}
+
+func (h *recordingHooks) run(ctx sdk.Context) error {
+	h.calls++
+	ctx.KVStore(h.key).Set([]byte(h.name), []byte{1})
+	if h.fn == nil {
+		return nil
+	}
+	return h.fn(ctx)
+}
+
+func (h *recordingHooks) BeforeSend(ctx sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) error {
+	return h.run(ctx)
+}
+
+func (h *recordingHooks) AfterSend(ctx sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) error {
+	return h.run(ctx)
+}
+
+func (h *recordingHooks) AfterMint(ctx sdk.Context, _ sdk.AccAddress, _ sdk.Coins) error {
+	return h.run(ctx)
+}
+
+func (h *recordingHooks) AfterBurn(ctx sdk.Context, _ sdk.AccAddress, _ sdk.Coins) error {
+	return h.run(ctx)
+}
+
+func TestMultiBankHooks(t *testing.T) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	errHook := errors.New("hook error")
+
+	testCases := []struct {
+		name       string
+		fns        []func(ctx sdk.Context) error
+		expErr     error
+		expPanic   bool
+		expCalls   []int
+		expWritten []bool
+	}{
+		{
+			name:       "all hooks succeed",
+			fns:        []func(ctx sdk.Context) error{nil, nil},
+			expCalls:   []int{1, 1},
+			expWritten: []bool{true, true},
+		},
+		{
+			name:       "panic stops the hooks",
+			fns:        []func(ctx sdk.Context) error{nil, func(sdk.Context) error { panic("boom") }, nil},
+			expErr:     sdkerrors.ErrPanic,
+			expCalls:   []int{1, 1, 0},
+			expWritten: []bool{true, false, false},
+		},
+		{
+			name:       "error stops the hooks",
+			fns:        []func(ctx sdk.Context) error{func(sdk.Context) error { return errHook }, nil},
+			expErr:     errHook,
+			expCalls:   []int{1, 0},
+			expWritten: []bool{false, false},
+		},
+		{
+			name: "out of gas is not recovered",
+			fns: []func(ctx sdk.Context) error{func(ctx sdk.Context) error {
+				ctx.GasMeter().ConsumeGas(1_000_000, "test")
+				return nil
+			}, nil},
+			expPanic: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test")).
+				WithGasMeter(storetypes.NewGasMeter(100_000))
+
+			var (
+				hooks     types.MultiBankHooks
+				recorders []*recordingHooks
+			)
+			for i, fn := range tc.fns {
+				h := &recordingHooks{key: key, name: string(rune('a' + i)), fn: fn}
+				recorders = append(recorders, h)
+				hooks = append(hooks, h)
+			}
+
+			if tc.expPanic {
+				require.Panics(t, func() { _ = hooks.AfterSend(ctx, fromAddr, toAddr, coins500) })
+				return
+			}
+
+			err := hooks.AfterSend(ctx, fromAddr, toAddr, coins500)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			for i, h := range recorders {
+				require.Equal(t, tc.expCalls[i], h.calls)
+				require.Equal(t, tc.expWritten[i], ctx.KVStore(key).Has([]byte(h.name)))
+			}
+		})
+	}
+}
@@ -31,6 +31,14 @@ var (
 
 	// ParamsKey is the prefix for x/bank parameters
 	ParamsKey = collections.NewPrefix(5)
+
+	// PausedDenomsPrefix is the prefix for the denoms whose transfers are paused.
+	PausedDenomsPrefix = collections.NewPrefix(6)
+
+	// EscrowSeqKey is the key of the sequence of the escrow ids.
+	EscrowSeqKey = collections.NewPrefix(7)
+	// EscrowsPrefix is the prefix for the pending escrows, by id.
+	EscrowsPrefix = collections.NewPrefix(8)
 )
 
 // NewBalanceCompatValueCodec is a codec for encoding Balances in a backwards compatible way
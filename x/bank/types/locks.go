@@ -0,0 +1,41 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetLockedCoinsFn returns the coins of an account that are locked by a module,
+// e.g. escrowed or pledged. These coins cannot be spent, in addition to the
+// coins locked by a vesting account.
+type GetLockedCoinsFn func(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (GetLockedCoinsFn) IsOnePerModuleType() {}
+
+// ComposeGetLockedCoins combines multiple locked coins getters into one, which
+// returns the sum of the coins they lock. Nil getters are skipped, and nil is
+// returned if there is none to run.
+func ComposeGetLockedCoins(getters ...GetLockedCoinsFn) GetLockedCoinsFn {
+	toRun := make([]GetLockedCoinsFn, 0, len(getters))
+	for _, g := range getters {
+		if g != nil {
+			toRun = append(toRun, g)
+		}
+	}
+
+	switch len(toRun) {
+	case 0:
+		return nil
+	case 1:
+		return toRun[0]
+	}
+
+	return func(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+		locked := sdk.NewCoins()
+		for _, g := range toRun {
+			locked = locked.Add(g(ctx, addr)...)
+		}
+
+		return locked
+	}
+}
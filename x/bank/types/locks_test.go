@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestComposeGetLockedCoins(t *testing.T) {
+	addr := sdk.AccAddress("addr1_______________")
+	lock := func(coins ...sdk.Coin) types.GetLockedCoinsFn {
+		return func(sdk.Context, sdk.AccAddress) sdk.Coins {
+			return sdk.NewCoins(coins...)
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		getters   []types.GetLockedCoinsFn
+		expNil    bool
+		expLocked sdk.Coins
+	}{
+		{
+			name:   "no getters",
+			expNil: true,
+		},
+		{
+			name:    "only nil getters",
+			getters: []types.GetLockedCoinsFn{nil, nil},
+			expNil:  true,
+		},
+		{
+			name:      "single getter",
+			getters:   []types.GetLockedCoinsFn{nil, lock(sdk.NewInt64Coin("foo", 10)), nil},
+			expLocked: sdk.NewCoins(sdk.NewInt64Coin("foo", 10)),
+		},
+		{
+			name: "locked coins are summed",
+			getters: []types.GetLockedCoinsFn{
+				lock(sdk.NewInt64Coin("foo", 10)),
+				lock(sdk.NewInt64Coin("foo", 5), sdk.NewInt64Coin("bar", 1)),
+				lock(),
+			},
+			expLocked: sdk.NewCoins(sdk.NewInt64Coin("foo", 15), sdk.NewInt64Coin("bar", 1)),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			getter := types.ComposeGetLockedCoins(tc.getters...)
+			if tc.expNil {
+				require.Nil(t, getter)
+				return
+			}
+
+			require.Equal(t, tc.expLocked, getter(sdk.Context{}, addr))
+		})
+	}
+}
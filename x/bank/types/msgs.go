@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 )
@@ -9,10 +11,20 @@ var (
 	_ sdk.Msg = &MsgSend{}
 	_ sdk.Msg = &MsgMultiSend{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetPausedDenoms{}
+	_ sdk.Msg = &MsgSetDenomMetadata{}
+	_ sdk.Msg = &MsgCreateEscrow{}
+	_ sdk.Msg = &MsgAcceptEscrow{}
+	_ sdk.Msg = &MsgRefundEscrow{}
 
 	_ legacytx.LegacyMsg = &MsgSend{}
 	_ legacytx.LegacyMsg = &MsgMultiSend{}
 	_ legacytx.LegacyMsg = &MsgUpdateParams{}
+	_ legacytx.LegacyMsg = &MsgSetPausedDenoms{}
+	_ legacytx.LegacyMsg = &MsgSetDenomMetadata{}
+	_ legacytx.LegacyMsg = &MsgCreateEscrow{}
+	_ legacytx.LegacyMsg = &MsgAcceptEscrow{}
+	_ legacytx.LegacyMsg = &MsgRefundEscrow{}
 )
 
 // NewMsgSend - construct a msg to send coins from one account to another.
@@ -85,3 +97,106 @@ func (msg MsgSetSendEnabled) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgSetPausedDenoms constructs a message to pause or resume the transfers
+// of denoms.
+func NewMsgSetPausedDenoms(authority string, pause, unpause []string) *MsgSetPausedDenoms {
+	return &MsgSetPausedDenoms{
+		Authority: authority,
+		Pause:     pause,
+		Unpause:   unpause,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgSetPausedDenoms) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgSetPausedDenoms message.
+func (msg MsgSetPausedDenoms) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSetDenomMetadata constructs a message to register or update the
+// metadata of a denom.
+func NewMsgSetDenomMetadata(authority string, metadata Metadata) *MsgSetDenomMetadata {
+	return &MsgSetDenomMetadata{
+		Authority: authority,
+		Metadata:  metadata,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgSetDenomMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgSetDenomMetadata message.
+func (msg MsgSetDenomMetadata) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgCreateEscrow constructs a message to escrow coins until the
+// counterparty accepts the escrow, in exchange of the expected coins, or until
+// the timeout.
+func NewMsgCreateEscrow(creator, counterparty sdk.AccAddress, amount, expected sdk.Coins, timeout time.Time) *MsgCreateEscrow {
+	return &MsgCreateEscrow{
+		Creator:      creator.String(),
+		Counterparty: counterparty.String(),
+		Amount:       amount,
+		Expected:     expected,
+		Timeout:      timeout,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgCreateEscrow) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgCreateEscrow message.
+func (msg MsgCreateEscrow) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgAcceptEscrow constructs a message to accept an escrow.
+func NewMsgAcceptEscrow(counterparty sdk.AccAddress, id uint64) *MsgAcceptEscrow {
+	return &MsgAcceptEscrow{
+		Counterparty: counterparty.String(),
+		Id:           id,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgAcceptEscrow) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgAcceptEscrow message.
+func (msg MsgAcceptEscrow) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Counterparty)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgRefundEscrow constructs a message to refund a timed out escrow.
+func NewMsgRefundEscrow(creator sdk.AccAddress, id uint64) *MsgRefundEscrow {
+	return &MsgRefundEscrow{
+		Creator: creator.String(),
+		Id:      id,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgRefundEscrow) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgRefundEscrow message.
+func (msg MsgRefundEscrow) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Creator)
+	return []sdk.AccAddress{addr}
+}
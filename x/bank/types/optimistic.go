@@ -0,0 +1,97 @@
+package types
+
+import (
+	"bytes"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// The helpers below let apps declare the balances of an address, e.g. of the
+// fee collector module account, as only ever credited by the txs executed
+// optimistically, see baseapp.SetOptimisticExecutionAccumulators.
+
+var (
+	balancesKeyCodec     = collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey)
+	denomAddressKeyCodec = collections.PairKeyCodec(collections.StringKey, sdk.AddressKeyAsIndexKey(sdk.AccAddressKey)) // nolint:staticcheck // the key codec of the denom index of the balances.
+)
+
+// IsBalanceKeyOf returns true if key is the store key of a balance of addr.
+func IsBalanceKeyOf(key []byte, addr sdk.AccAddress) bool {
+	return matchBalanceKey(key, BalancesPrefix, addr, func(bz []byte) (sdk.AccAddress, error) {
+		_, k, err := balancesKeyCodec.Decode(bz)
+		return k.K1(), err
+	})
+}
+
+// IsDenomAddressKeyOf returns true if key is the store key of the denom index
+// entry of a balance of addr, which is set along with the balance.
+func IsDenomAddressKeyOf(key []byte, addr sdk.AccAddress) bool {
+	return matchBalanceKey(key, DenomAddressPrefix, addr, func(bz []byte) (sdk.AccAddress, error) {
+		_, k, err := denomAddressKeyCodec.Decode(bz)
+		return k.K2(), err
+	})
+}
+
+// matchBalanceKey returns true if key has the given prefix and the address
+// decoded from the rest of the key is addr.
+func matchBalanceKey(key []byte, prefix collections.Prefix, addr sdk.AccAddress, decode func([]byte) (sdk.AccAddress, error)) bool {
+	if !bytes.HasPrefix(key, prefix) {
+		return false
+	}
+
+	keyAddr, err := decode(key[len(prefix):])
+	return err == nil && keyAddr.Equals(addr)
+}
+
+// MergeBalanceCredit adds the credit made to a balance by a tx, from base to
+// value, to its latest value. Zero balances are removed. An error is returned
+// if the tx debited the balance, as the result of the tx may then depend on the
+// balance it read.
+func MergeBalanceCredit(base, value, latest []byte) ([]byte, error) {
+	codec := NewBalanceCompatValueCodec()
+	decode := func(bz []byte) (math.Int, error) {
+		if bz == nil {
+			return math.ZeroInt(), nil
+		}
+		return codec.Decode(bz)
+	}
+
+	baseAmount, err := decode(base)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := decode(value)
+	if err != nil {
+		return nil, err
+	}
+	latestAmount, err := decode(latest)
+	if err != nil {
+		return nil, err
+	}
+
+	credit := amount.Sub(baseAmount)
+	if credit.IsNegative() {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("balance debited by %s", credit.Neg())
+	}
+
+	merged := latestAmount.Add(credit)
+	if merged.IsZero() {
+		return nil, nil
+	}
+
+	return codec.Encode(merged)
+}
+
+// MergeDenomAddressIndex merges the denom index entry of a balance, which is
+// set along with the balance.
+func MergeDenomAddressIndex(_, value, latest []byte) ([]byte, error) {
+	if value == nil {
+		return latest, nil
+	}
+
+	return value, nil
+}
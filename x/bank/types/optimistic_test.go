@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestOptimisticBalanceMerge(t *testing.T) {
+	addr := sdk.AccAddress("fee_collector_______")
+	other := sdk.AccAddress("other_______________")
+
+	balanceKey := func(addr sdk.AccAddress) []byte {
+		keyCodec := collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey)
+		key := collections.Join(addr, "stake")
+		bz := make([]byte, keyCodec.Size(key))
+		_, err := keyCodec.Encode(bz, key)
+		require.NoError(t, err)
+		return append(types.BalancesPrefix.Bytes(), bz...)
+	}
+	indexKey := func(addr sdk.AccAddress) []byte {
+		keyCodec := collections.PairKeyCodec(collections.StringKey, sdk.AddressKeyAsIndexKey(sdk.AccAddressKey)) // nolint:staticcheck // the key codec of the denom index.
+		key := collections.Join("stake", addr)
+		bz := make([]byte, keyCodec.Size(key))
+		_, err := keyCodec.Encode(bz, key)
+		require.NoError(t, err)
+		return append(types.DenomAddressPrefix.Bytes(), bz...)
+	}
+
+	require.True(t, types.IsBalanceKeyOf(balanceKey(addr), addr))
+	require.False(t, types.IsBalanceKeyOf(balanceKey(other), addr))
+	require.False(t, types.IsBalanceKeyOf(indexKey(addr), addr))
+	require.True(t, types.IsDenomAddressKeyOf(indexKey(addr), addr))
+	require.False(t, types.IsDenomAddressKeyOf(indexKey(other), addr))
+	require.False(t, types.IsDenomAddressKeyOf(balanceKey(addr), addr))
+
+	codec := types.NewBalanceCompatValueCodec()
+	encode := func(amount int64) []byte {
+		bz, err := codec.Encode(math.NewInt(amount))
+		require.NoError(t, err)
+		return bz
+	}
+
+	// a tx credited 10 on top of 100, while other txs credited 50
+	merged, err := types.MergeBalanceCredit(encode(100), encode(110), encode(150))
+	require.NoError(t, err)
+	require.Equal(t, encode(160), merged)
+
+	// a tx credited 10 to a missing balance
+	merged, err = types.MergeBalanceCredit(nil, encode(10), nil)
+	require.NoError(t, err)
+	require.Equal(t, encode(10), merged)
+
+	// debits are not merged, even when the latest balance covers them
+	_, err = types.MergeBalanceCredit(encode(10), encode(5), encode(20))
+	require.Error(t, err)
+	_, err = types.MergeBalanceCredit(encode(10), nil, encode(10))
+	require.Error(t, err)
+
+	// the denom index entry is set along with the balance
+	merged, err = types.MergeDenomAddressIndex(nil, []byte{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte{}, merged)
+}
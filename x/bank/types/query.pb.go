@@ -1173,6 +1173,287 @@ func (m *QuerySendEnabledResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryPausedDenomsRequest is the request type for the Query/PausedDenoms RPC
+// method.
+//
+// Since: cosmos-sdk 0.50
+type QueryPausedDenomsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPausedDenomsRequest) Reset()         { *m = QueryPausedDenomsRequest{} }
+func (m *QueryPausedDenomsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedDenomsRequest) ProtoMessage()    {}
+func (*QueryPausedDenomsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{23}
+}
+func (m *QueryPausedDenomsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausedDenomsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedDenomsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPausedDenomsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedDenomsRequest.Merge(m, src)
+}
+func (m *QueryPausedDenomsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausedDenomsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedDenomsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPausedDenomsRequest proto.InternalMessageInfo
+
+func (m *QueryPausedDenomsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPausedDenomsResponse is the response type for the Query/PausedDenoms
+// RPC method.
+//
+// Since: cosmos-sdk 0.50
+type QueryPausedDenomsResponse struct {
+	// denoms are the denoms whose transfers are paused.
+	Denoms []string `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPausedDenomsResponse) Reset()         { *m = QueryPausedDenomsResponse{} }
+func (m *QueryPausedDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedDenomsResponse) ProtoMessage()    {}
+func (*QueryPausedDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{24}
+}
+func (m *QueryPausedDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausedDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPausedDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedDenomsResponse.Merge(m, src)
+}
+func (m *QueryPausedDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausedDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedDenomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPausedDenomsResponse proto.InternalMessageInfo
+
+func (m *QueryPausedDenomsResponse) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+func (m *QueryPausedDenomsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// ModuleAccountBalance defines a module account, with its permissions and
+// balances.
+//
+// Since: cosmos-sdk 0.50
+type ModuleAccountBalance struct {
+	// name is the name of the module account.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// address is the address of the module account.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// account_number is the account number of the module account.
+	AccountNumber uint64 `protobuf:"varint,3,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	// permissions are the permissions of the module account.
+	Permissions []string `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	// balances are the balances of the module account.
+	Balances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
+}
+
+func (m *ModuleAccountBalance) Reset()         { *m = ModuleAccountBalance{} }
+func (m *ModuleAccountBalance) String() string { return proto.CompactTextString(m) }
+func (*ModuleAccountBalance) ProtoMessage()    {}
+func (*ModuleAccountBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{25}
+}
+func (m *ModuleAccountBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleAccountBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleAccountBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleAccountBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleAccountBalance.Merge(m, src)
+}
+func (m *ModuleAccountBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleAccountBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleAccountBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleAccountBalance proto.InternalMessageInfo
+
+func (m *ModuleAccountBalance) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ModuleAccountBalance) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ModuleAccountBalance) GetAccountNumber() uint64 {
+	if m != nil {
+		return m.AccountNumber
+	}
+	return 0
+}
+
+func (m *ModuleAccountBalance) GetPermissions() []string {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *ModuleAccountBalance) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+// QueryModuleAccountBalancesRequest is the request type for the
+// Query/ModuleAccountBalances RPC method.
+//
+// Since: cosmos-sdk 0.50
+type QueryModuleAccountBalancesRequest struct {
+}
+
+func (m *QueryModuleAccountBalancesRequest) Reset()         { *m = QueryModuleAccountBalancesRequest{} }
+func (m *QueryModuleAccountBalancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleAccountBalancesRequest) ProtoMessage()    {}
+func (*QueryModuleAccountBalancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{26}
+}
+func (m *QueryModuleAccountBalancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleAccountBalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleAccountBalancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleAccountBalancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleAccountBalancesRequest.Merge(m, src)
+}
+func (m *QueryModuleAccountBalancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleAccountBalancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleAccountBalancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleAccountBalancesRequest proto.InternalMessageInfo
+
+// QueryModuleAccountBalancesResponse is the response type for the
+// Query/ModuleAccountBalances RPC method.
+//
+// Since: cosmos-sdk 0.50
+type QueryModuleAccountBalancesResponse struct {
+	// module_accounts are the existing module accounts, sorted by name.
+	ModuleAccounts []ModuleAccountBalance `protobuf:"bytes,1,rep,name=module_accounts,json=moduleAccounts,proto3" json:"module_accounts"`
+}
+
+func (m *QueryModuleAccountBalancesResponse) Reset()         { *m = QueryModuleAccountBalancesResponse{} }
+func (m *QueryModuleAccountBalancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleAccountBalancesResponse) ProtoMessage()    {}
+func (*QueryModuleAccountBalancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{27}
+}
+func (m *QueryModuleAccountBalancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleAccountBalancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleAccountBalancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleAccountBalancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleAccountBalancesResponse.Merge(m, src)
+}
+func (m *QueryModuleAccountBalancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleAccountBalancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleAccountBalancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleAccountBalancesResponse proto.InternalMessageInfo
+
+func (m *QueryModuleAccountBalancesResponse) GetModuleAccounts() []ModuleAccountBalance {
+	if m != nil {
+		return m.ModuleAccounts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.bank.v1beta1.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceResponse)(nil), "cosmos.bank.v1beta1.QueryBalanceResponse")
@@ -1197,89 +1478,110 @@ func init() {
 	proto.RegisterType((*QueryDenomOwnersResponse)(nil), "cosmos.bank.v1beta1.QueryDenomOwnersResponse")
 	proto.RegisterType((*QuerySendEnabledRequest)(nil), "cosmos.bank.v1beta1.QuerySendEnabledRequest")
 	proto.RegisterType((*QuerySendEnabledResponse)(nil), "cosmos.bank.v1beta1.QuerySendEnabledResponse")
+	proto.RegisterType((*QueryPausedDenomsRequest)(nil), "cosmos.bank.v1beta1.QueryPausedDenomsRequest")
+	proto.RegisterType((*QueryPausedDenomsResponse)(nil), "cosmos.bank.v1beta1.QueryPausedDenomsResponse")
+	proto.RegisterType((*ModuleAccountBalance)(nil), "cosmos.bank.v1beta1.ModuleAccountBalance")
+	proto.RegisterType((*QueryModuleAccountBalancesRequest)(nil), "cosmos.bank.v1beta1.QueryModuleAccountBalancesRequest")
+	proto.RegisterType((*QueryModuleAccountBalancesResponse)(nil), "cosmos.bank.v1beta1.QueryModuleAccountBalancesResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/query.proto", fileDescriptor_9c6fc1939682df13) }
 
 var fileDescriptor_9c6fc1939682df13 = []byte{
-	// 1225 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xa4, 0xaa, 0x93, 0x3c, 0xa7, 0x95, 0x3a, 0x0d, 0x34, 0xd9, 0x10, 0x3b, 0x6c, 0xaa,
-	0xc6, 0x09, 0xc9, 0x6e, 0xe3, 0x20, 0x44, 0xab, 0x12, 0xa9, 0x4e, 0x49, 0x0f, 0x08, 0xb5, 0x38,
-	0xf4, 0x02, 0x07, 0x6b, 0xed, 0x1d, 0x8c, 0x15, 0x7b, 0xd7, 0xf5, 0xac, 0x5b, 0xac, 0x2a, 0x12,
-	0x42, 0x42, 0xea, 0x11, 0x89, 0x9e, 0x2a, 0x21, 0x45, 0x48, 0x40, 0x05, 0x12, 0xaa, 0x10, 0x47,
-	0x8e, 0x1c, 0x7a, 0xac, 0xe0, 0x00, 0xa7, 0x82, 0x12, 0xa4, 0xf6, 0xcf, 0x40, 0x9e, 0x1f, 0xde,
-	0x5d, 0x7b, 0xbc, 0xd9, 0xa4, 0x06, 0x21, 0x2e, 0xad, 0x3d, 0xf3, 0xde, 0xbc, 0xef, 0x7d, 0xef,
-	0xcd, 0xbc, 0xcf, 0x81, 0x4c, 0xd9, 0xa5, 0x75, 0x97, 0x9a, 0x25, 0xcb, 0xd9, 0x36, 0x6f, 0xad,
-	0x96, 0x88, 0x67, 0xad, 0x9a, 0x37, 0x5b, 0xa4, 0xd9, 0x36, 0x1a, 0x4d, 0xd7, 0x73, 0xf1, 0x69,
-	0x6e, 0x60, 0x74, 0x0c, 0x0c, 0x61, 0xa0, 0x2d, 0x75, 0xbd, 0x28, 0xe1, 0xd6, 0x5d, 0xdf, 0x86,
-	0x55, 0xa9, 0x3a, 0x96, 0x57, 0x75, 0x1d, 0x7e, 0x80, 0x36, 0x59, 0x71, 0x2b, 0x2e, 0xfb, 0x68,
-	0x76, 0x3e, 0x89, 0xd5, 0x97, 0x2a, 0xae, 0x5b, 0xa9, 0x11, 0xd3, 0x6a, 0x54, 0x4d, 0xcb, 0x71,
-	0x5c, 0x8f, 0xb9, 0x50, 0xb1, 0x9b, 0x0e, 0x9e, 0x2f, 0x4f, 0x2e, 0xbb, 0x55, 0xa7, 0x6f, 0x3f,
-	0x80, 0x9a, 0x21, 0xe4, 0xfb, 0xd3, 0x7c, 0xbf, 0xc8, 0xc3, 0x8a, 0x0c, 0xf8, 0xd6, 0x8c, 0x70,
-	0x95, 0xa8, 0x83, 0xc9, 0x6a, 0xa7, 0xac, 0x7a, 0xd5, 0x71, 0x4d, 0xf6, 0x2f, 0x5f, 0xd2, 0xab,
-	0x70, 0xfa, 0x9d, 0x8e, 0x45, 0xde, 0xaa, 0x59, 0x4e, 0x99, 0x14, 0xc8, 0xcd, 0x16, 0xa1, 0x1e,
-	0xce, 0xc1, 0xa8, 0x65, 0xdb, 0x4d, 0x42, 0xe9, 0x14, 0x9a, 0x43, 0xd9, 0xf1, 0xfc, 0xd4, 0x2f,
-	0x3f, 0xae, 0x4c, 0x8a, 0x48, 0x97, 0xf9, 0xce, 0x96, 0xd7, 0xac, 0x3a, 0x95, 0x82, 0x34, 0xc4,
-	0x93, 0x70, 0xdc, 0x26, 0x8e, 0x5b, 0x9f, 0x1a, 0xe9, 0x78, 0x14, 0xf8, 0x97, 0x8b, 0x63, 0x77,
-	0x77, 0x33, 0x89, 0x67, 0xbb, 0x99, 0x84, 0xfe, 0x16, 0x4c, 0x86, 0x43, 0xd1, 0x86, 0xeb, 0x50,
-	0x82, 0xd7, 0x60, 0xb4, 0xc4, 0x97, 0x58, 0xac, 0x54, 0x6e, 0xda, 0xe8, 0x16, 0x85, 0x12, 0x59,
-	0x14, 0x63, 0xc3, 0xad, 0x3a, 0x05, 0x69, 0xa9, 0xff, 0x8c, 0xe0, 0x0c, 0x3b, 0xed, 0x72, 0xad,
-	0x26, 0x0e, 0xa4, 0xcf, 0x03, 0x7e, 0x13, 0xc0, 0x2f, 0x2d, 0xcb, 0x20, 0x95, 0x3b, 0x17, 0xc2,
-	0xc1, 0x89, 0x94, 0x68, 0xae, 0x5b, 0x15, 0x49, 0x56, 0x21, 0xe0, 0x89, 0xe7, 0xe1, 0x44, 0x93,
-	0x50, 0xb7, 0x76, 0x8b, 0x14, 0x39, 0x19, 0xc7, 0xe6, 0x50, 0x76, 0xac, 0x30, 0x21, 0x16, 0xaf,
-	0xf4, 0x70, 0xb2, 0x87, 0x60, 0xaa, 0x3f, 0x0d, 0x41, 0xcc, 0x0e, 0x8c, 0x89, 0x74, 0x3b, 0x89,
-	0x1c, 0x8b, 0x64, 0x26, 0xbf, 0xf9, 0xe8, 0x49, 0x26, 0xf1, 0xed, 0x1f, 0x99, 0x6c, 0xa5, 0xea,
-	0x7d, 0xd8, 0x2a, 0x19, 0x65, 0xb7, 0x2e, 0x3a, 0x43, 0xfc, 0xb7, 0x42, 0xed, 0x6d, 0xd3, 0x6b,
-	0x37, 0x08, 0x65, 0x0e, 0xf4, 0xfe, 0xd3, 0x87, 0x4b, 0x13, 0x35, 0x52, 0xb1, 0xca, 0xed, 0x62,
-	0xa7, 0xf7, 0xe8, 0x83, 0xa7, 0x0f, 0x97, 0x50, 0xa1, 0x1b, 0x12, 0x5f, 0x55, 0x50, 0xb2, 0x70,
-	0x20, 0x25, 0x1c, 0x7b, 0x90, 0x13, 0xfd, 0x2b, 0x04, 0xb3, 0x2c, 0xc9, 0xad, 0x06, 0x71, 0x6c,
-	0xab, 0x54, 0x23, 0xff, 0xa1, 0x8a, 0x05, 0x8a, 0xf1, 0x0c, 0x41, 0x7a, 0x10, 0xce, 0xff, 0x59,
-	0x49, 0xda, 0x30, 0xaf, 0xcc, 0x34, 0xdf, 0x66, 0x1d, 0xfa, 0x4f, 0x3e, 0x03, 0xef, 0xc3, 0xd9,
-	0xe8, 0xd0, 0xcf, 0xf3, 0x2c, 0x6c, 0x8b, 0x57, 0xe1, 0x5d, 0xd7, 0xb3, 0x6a, 0x5b, 0xad, 0x46,
-	0xa3, 0xd6, 0x96, 0xb9, 0x84, 0xfb, 0x05, 0x0d, 0xa1, 0x5f, 0x9e, 0xc8, 0xcb, 0x1b, 0x8a, 0x26,
-	0xe0, 0xb7, 0x21, 0x49, 0xd9, 0xca, 0xbf, 0xd7, 0x27, 0x22, 0xe0, 0xf0, 0xba, 0x64, 0x59, 0xbc,
-	0xd8, 0x3c, 0xb5, 0x6b, 0x1f, 0x48, 0x2a, 0xbb, 0x25, 0x46, 0x81, 0x12, 0xeb, 0x37, 0xe0, 0x85,
-	0x1e, 0x6b, 0x41, 0xc5, 0x25, 0x48, 0x5a, 0x75, 0xb7, 0xe5, 0x78, 0x07, 0x16, 0x32, 0x3f, 0xde,
-	0xa1, 0x42, 0x64, 0xc3, 0x7d, 0xf4, 0x49, 0xc0, 0xec, 0xd8, 0xeb, 0x56, 0xd3, 0xaa, 0xcb, 0x17,
-	0x43, 0xbf, 0x21, 0xe6, 0x96, 0x5c, 0x15, 0xa1, 0xd6, 0x21, 0xd9, 0x60, 0x2b, 0x22, 0xd4, 0x8c,
-	0xa1, 0x98, 0xef, 0x06, 0x77, 0x0a, 0x05, 0xe3, 0x5e, 0xba, 0x0d, 0x1a, 0x3b, 0x96, 0xb5, 0x22,
-	0x7d, 0x9b, 0x78, 0x96, 0x6d, 0x79, 0xd6, 0x90, 0x5b, 0x48, 0xff, 0x1e, 0xc1, 0x8c, 0x32, 0x8c,
-	0xc8, 0x62, 0x13, 0xc6, 0xeb, 0x62, 0x4d, 0x3e, 0x33, 0xb3, 0xca, 0x44, 0xa4, 0x67, 0x30, 0x15,
-	0xdf, 0x75, 0x78, 0x8d, 0xb0, 0x0a, 0xd3, 0x3e, 0xde, 0x5e, 0x56, 0xd4, 0xdd, 0x50, 0x0a, 0x32,
-	0xd9, 0x97, 0xe1, 0x15, 0x18, 0x93, 0x30, 0x05, 0x8f, 0xf1, 0x13, 0xec, 0x7a, 0xea, 0xb7, 0xc5,
-	0x6d, 0x67, 0x31, 0xae, 0xdd, 0x76, 0x48, 0x93, 0x46, 0x82, 0x1a, 0xd6, 0xcc, 0xd0, 0x3f, 0x46,
-	0x00, 0x7e, 0xd0, 0x23, 0x3d, 0x93, 0xeb, 0xfe, 0xf3, 0x36, 0x72, 0x88, 0x5b, 0xd1, 0x7d, 0xe9,
-	0xbe, 0x91, 0x8f, 0x4f, 0x28, 0x79, 0x41, 0x6f, 0x1e, 0x26, 0x58, 0xc2, 0x45, 0x97, 0xad, 0x8b,
-	0x1e, 0xca, 0x28, 0x29, 0xf6, 0xfd, 0x0b, 0x29, 0xdb, 0x3f, 0x6b, 0x98, 0xb3, 0x86, 0x57, 0x69,
-	0x8b, 0x38, 0xf6, 0x9b, 0x4e, 0xe7, 0xc5, 0xb7, 0x65, 0x95, 0x5e, 0x84, 0x24, 0x0b, 0xc9, 0x11,
-	0x8e, 0x17, 0xc4, 0xb7, 0x9e, 0x3a, 0x95, 0x8f, 0x5c, 0xa7, 0x07, 0x92, 0xa4, 0x50, 0x6c, 0x41,
-	0xd2, 0x06, 0x4c, 0x50, 0xe2, 0xd8, 0x45, 0xc2, 0xd7, 0x05, 0x49, 0x73, 0x4a, 0x92, 0x82, 0xfe,
-	0x29, 0xea, 0x7f, 0xe9, 0x61, 0xa9, 0x7c, 0x64, 0x96, 0x72, 0x3f, 0x9c, 0x84, 0xe3, 0x0c, 0x2a,
-	0xfe, 0x02, 0xc1, 0xa8, 0x98, 0x89, 0x38, 0xab, 0x44, 0xa3, 0x50, 0xec, 0xda, 0x62, 0x0c, 0x4b,
-	0x1e, 0x56, 0x7f, 0xe3, 0x6e, 0xa7, 0x95, 0x3e, 0xf9, 0xf5, 0xaf, 0xcf, 0x47, 0x72, 0xf8, 0xbc,
-	0xa9, 0xfe, 0xb1, 0xc1, 0x15, 0x87, 0x79, 0x47, 0xf4, 0xeb, 0x8e, 0x59, 0x6a, 0x73, 0x45, 0x8b,
-	0x77, 0x11, 0xa4, 0x02, 0x72, 0x15, 0x2f, 0x0f, 0x8e, 0xdc, 0x2f, 0xce, 0xb5, 0x95, 0x98, 0xd6,
-	0x02, 0xeb, 0xab, 0x3e, 0xd6, 0x45, 0xbc, 0x10, 0x13, 0x2b, 0xfe, 0x09, 0xc1, 0xa9, 0x3e, 0x11,
-	0x87, 0x73, 0x83, 0x43, 0x0f, 0x52, 0xa6, 0xda, 0xda, 0xa1, 0x7c, 0x04, 0xe8, 0x75, 0x1f, 0xf4,
-	0x1a, 0x5e, 0x55, 0x82, 0xa6, 0xd2, 0xb9, 0xa8, 0x80, 0xff, 0x1b, 0x82, 0x33, 0x03, 0xe4, 0x11,
-	0x7e, 0x3d, 0x3e, 0xa0, 0xb0, 0x98, 0xd3, 0x2e, 0x1c, 0xc1, 0x53, 0x24, 0x74, 0xd5, 0x4f, 0xe8,
-	0x12, 0xbe, 0x78, 0xe8, 0x84, 0xfc, 0xde, 0xb9, 0x87, 0x20, 0x15, 0x50, 0x4b, 0x51, 0xbd, 0xd3,
-	0x2f, 0xe1, 0xa2, 0x7a, 0x47, 0x21, 0xc1, 0xf4, 0xac, 0x8f, 0x7a, 0x16, 0xcf, 0xa8, 0x51, 0x73,
-	0x18, 0xf7, 0x10, 0x8c, 0x49, 0xd9, 0x82, 0x23, 0x6e, 0x52, 0x8f, 0x10, 0xd2, 0x96, 0xe2, 0x98,
-	0x0a, 0x34, 0xab, 0x3e, 0x9a, 0x73, 0xf8, 0x6c, 0x04, 0x1a, 0x9f, 0xad, 0x4f, 0x11, 0x24, 0xb9,
-	0x56, 0xc1, 0x0b, 0x83, 0x23, 0x85, 0x84, 0x91, 0x96, 0x3d, 0xd8, 0x30, 0x3e, 0x3d, 0x5c, 0x15,
-	0xe1, 0xef, 0x10, 0x9c, 0x08, 0xcd, 0x71, 0x6c, 0x0c, 0x8e, 0xa2, 0xd2, 0x08, 0x9a, 0x19, 0xdb,
-	0x5e, 0x80, 0xbb, 0xe0, 0x83, 0x33, 0xf0, 0xb2, 0x12, 0x1c, 0x9f, 0x15, 0x45, 0xa9, 0x06, 0xcc,
-	0x3b, 0x6c, 0x61, 0x07, 0x7f, 0x8d, 0xe0, 0x64, 0x58, 0x58, 0xe1, 0x83, 0xc2, 0xf7, 0x2a, 0x3d,
-	0xed, 0x7c, 0x7c, 0x87, 0xf8, 0xe5, 0xed, 0x01, 0x8c, 0xbf, 0x44, 0x90, 0x0a, 0x4c, 0xef, 0xa8,
-	0xcb, 0xd0, 0xaf, 0x70, 0xa2, 0x2e, 0x83, 0x42, 0x12, 0xe8, 0xaf, 0xf9, 0xf8, 0x5e, 0xc1, 0x8b,
-	0x83, 0xf1, 0x09, 0xc9, 0xd0, 0x65, 0xf3, 0x3e, 0x82, 0x54, 0x60, 0xfa, 0x45, 0x81, 0xec, 0x1f,
-	0xf0, 0x51, 0x20, 0x15, 0x23, 0x59, 0x37, 0x7c, 0x90, 0xf3, 0xf8, 0x65, 0xf5, 0x1d, 0x09, 0x8c,
-	0xec, 0xfc, 0xc6, 0xa3, 0xbd, 0x34, 0x7a, 0xbc, 0x97, 0x46, 0x7f, 0xee, 0xa5, 0xd1, 0x67, 0xfb,
-	0xe9, 0xc4, 0xe3, 0xfd, 0x74, 0xe2, 0xf7, 0xfd, 0x74, 0xe2, 0xbd, 0xc5, 0xc8, 0xdf, 0x52, 0x1f,
-	0xf1, 0x33, 0xd9, 0x4f, 0xaa, 0x52, 0x92, 0xfd, 0x25, 0x6c, 0xed, 0xef, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x71, 0x4c, 0x05, 0x91, 0x2c, 0x14, 0x00, 0x00,
+	// 1484 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xdf, 0x6f, 0x14, 0xd5,
+	0x17, 0xef, 0x2d, 0xb0, 0x6d, 0xcf, 0x16, 0xbe, 0xe1, 0xb6, 0x7c, 0x69, 0xa7, 0xb0, 0x2d, 0x53,
+	0x84, 0xb6, 0xb2, 0x3b, 0xb4, 0x35, 0x28, 0x04, 0x49, 0x58, 0x7e, 0x3d, 0x18, 0x04, 0xb7, 0xf2,
+	0xa2, 0x31, 0x9b, 0xd9, 0x9d, 0xeb, 0xba, 0x61, 0x77, 0x66, 0xd9, 0xbb, 0x0b, 0x6e, 0xb0, 0x89,
+	0xd1, 0x98, 0x60, 0x7c, 0x31, 0x91, 0x27, 0x12, 0x0d, 0x31, 0x11, 0x89, 0x24, 0x86, 0x07, 0xdf,
+	0xf4, 0xd1, 0x07, 0x1e, 0x89, 0x3e, 0xe8, 0x13, 0x9a, 0x62, 0x02, 0x7f, 0x86, 0x99, 0x7b, 0xcf,
+	0xec, 0xcc, 0xec, 0xde, 0x9d, 0x4e, 0xcb, 0x4a, 0x8c, 0x2f, 0xba, 0x73, 0xe7, 0x9c, 0x7b, 0x3e,
+	0xe7, 0x73, 0xce, 0x3d, 0xf3, 0xb9, 0x05, 0xa6, 0x8b, 0x0e, 0xaf, 0x3a, 0xdc, 0x28, 0x98, 0xf6,
+	0x65, 0xe3, 0xea, 0x62, 0x81, 0x35, 0xcc, 0x45, 0xe3, 0x4a, 0x93, 0xd5, 0x5b, 0x99, 0x5a, 0xdd,
+	0x69, 0x38, 0x74, 0x4c, 0x1a, 0x64, 0x5c, 0x83, 0x0c, 0x1a, 0x68, 0x0b, 0x6d, 0x2f, 0xce, 0xa4,
+	0x75, 0xdb, 0xb7, 0x66, 0x96, 0xca, 0xb6, 0xd9, 0x28, 0x3b, 0xb6, 0xdc, 0x40, 0x1b, 0x2f, 0x39,
+	0x25, 0x47, 0xfc, 0x34, 0xdc, 0x5f, 0xb8, 0xba, 0xa7, 0xe4, 0x38, 0xa5, 0x0a, 0x33, 0xcc, 0x5a,
+	0xd9, 0x30, 0x6d, 0xdb, 0x69, 0x08, 0x17, 0x8e, 0x6f, 0x53, 0xc1, 0xfd, 0xbd, 0x9d, 0x8b, 0x4e,
+	0xd9, 0xee, 0x7a, 0x1f, 0x40, 0xed, 0x3e, 0xe0, 0xfb, 0x19, 0xd5, 0x7b, 0xc6, 0x8b, 0x75, 0xe7,
+	0x1a, 0x5a, 0x4c, 0x4a, 0x8b, 0xbc, 0x04, 0x26, 0x1f, 0xf0, 0xd5, 0x14, 0x3a, 0x7b, 0x79, 0x05,
+	0xe9, 0xd0, 0x76, 0x9a, 0xd5, 0xb2, 0xed, 0x18, 0xe2, 0xbf, 0x72, 0x49, 0x2f, 0xc3, 0xd8, 0x1b,
+	0xae, 0x45, 0xd6, 0xac, 0x98, 0x76, 0x91, 0xe5, 0xd8, 0x95, 0x26, 0xe3, 0x0d, 0xba, 0x04, 0x43,
+	0xa6, 0x65, 0xd5, 0x19, 0xe7, 0x13, 0x64, 0x86, 0xcc, 0x8d, 0x64, 0x27, 0x7e, 0xf9, 0x21, 0x3d,
+	0x8e, 0x91, 0x4e, 0xca, 0x37, 0x2b, 0x8d, 0x7a, 0xd9, 0x2e, 0xe5, 0x3c, 0x43, 0x3a, 0x0e, 0xdb,
+	0x2c, 0x66, 0x3b, 0xd5, 0x89, 0x41, 0xd7, 0x23, 0x27, 0x1f, 0x8e, 0x0d, 0xdf, 0xb8, 0x3d, 0x3d,
+	0xf0, 0xf4, 0xf6, 0xf4, 0x80, 0xfe, 0x1a, 0x8c, 0x87, 0x43, 0xf1, 0x9a, 0x63, 0x73, 0x46, 0x97,
+	0x61, 0xa8, 0x20, 0x97, 0x44, 0xac, 0xe4, 0xd2, 0x64, 0xa6, 0x5d, 0x36, 0xce, 0xbc, 0xb2, 0x65,
+	0x4e, 0x39, 0x65, 0x3b, 0xe7, 0x59, 0xea, 0x3f, 0x13, 0xd8, 0x2d, 0x76, 0x3b, 0x59, 0xa9, 0xe0,
+	0x86, 0xfc, 0x59, 0xc0, 0x9f, 0x05, 0xf0, 0x8b, 0x2f, 0x32, 0x48, 0x2e, 0x1d, 0x08, 0xe1, 0x90,
+	0x44, 0x7a, 0x68, 0x2e, 0x9a, 0x25, 0x8f, 0xac, 0x5c, 0xc0, 0x93, 0xce, 0xc2, 0xf6, 0x3a, 0xe3,
+	0x4e, 0xe5, 0x2a, 0xcb, 0x4b, 0x32, 0xb6, 0xcc, 0x90, 0xb9, 0xe1, 0xdc, 0x28, 0x2e, 0x9e, 0xee,
+	0xe0, 0x64, 0x8d, 0xc0, 0x44, 0x77, 0x1a, 0x48, 0xcc, 0x2a, 0x0c, 0x63, 0xba, 0x6e, 0x22, 0x5b,
+	0x22, 0x99, 0xc9, 0x9e, 0x7d, 0xf0, 0x68, 0x7a, 0xe0, 0xbb, 0x3f, 0xa6, 0xe7, 0x4a, 0xe5, 0xc6,
+	0x7b, 0xcd, 0x42, 0xa6, 0xe8, 0x54, 0xb1, 0x33, 0xf0, 0x7f, 0x69, 0x6e, 0x5d, 0x36, 0x1a, 0xad,
+	0x1a, 0xe3, 0xc2, 0x81, 0xdf, 0x7a, 0x72, 0x7f, 0x61, 0xb4, 0xc2, 0x4a, 0x66, 0xb1, 0x95, 0x77,
+	0xbb, 0x93, 0xdf, 0x7d, 0x72, 0x7f, 0x81, 0xe4, 0xda, 0x21, 0xe9, 0x39, 0x05, 0x25, 0x07, 0xd7,
+	0xa5, 0x44, 0x62, 0x0f, 0x72, 0xa2, 0x7f, 0x43, 0x60, 0xaf, 0x48, 0x72, 0xa5, 0xc6, 0x6c, 0xcb,
+	0x2c, 0x54, 0xd8, 0xbf, 0xa8, 0x62, 0x81, 0x62, 0x3c, 0x25, 0x90, 0xea, 0x85, 0xf3, 0x3f, 0x56,
+	0x92, 0x16, 0xcc, 0x2a, 0x33, 0xcd, 0xb6, 0x44, 0x87, 0xfe, 0x93, 0x63, 0xe0, 0x6d, 0xd8, 0x1f,
+	0x1d, 0xfa, 0x59, 0xc6, 0xc2, 0x65, 0x9c, 0x0a, 0x6f, 0x3a, 0x0d, 0xb3, 0xb2, 0xd2, 0xac, 0xd5,
+	0x2a, 0x2d, 0x2f, 0x97, 0x70, 0xbf, 0x90, 0x3e, 0xf4, 0xcb, 0x23, 0xef, 0xf0, 0x86, 0xa2, 0x21,
+	0xfc, 0x16, 0x24, 0xb8, 0x58, 0x79, 0x7e, 0x7d, 0x82, 0x01, 0xfb, 0xd7, 0x25, 0x87, 0x70, 0x62,
+	0xcb, 0xd4, 0x2e, 0xbc, 0xeb, 0x51, 0xd9, 0x2e, 0x31, 0x09, 0x94, 0x58, 0xbf, 0x04, 0xbb, 0x3a,
+	0xac, 0x91, 0x8a, 0xe3, 0x90, 0x30, 0xab, 0x4e, 0xd3, 0x6e, 0xac, 0x5b, 0xc8, 0xec, 0x88, 0x4b,
+	0x05, 0x66, 0x23, 0x7d, 0xf4, 0x71, 0xa0, 0x62, 0xdb, 0x8b, 0x66, 0xdd, 0xac, 0x7a, 0x13, 0x43,
+	0xbf, 0x04, 0x63, 0xa1, 0x55, 0x0c, 0x75, 0x02, 0x12, 0x35, 0xb1, 0x82, 0xa1, 0xa6, 0x32, 0x0a,
+	0x05, 0x90, 0x91, 0x4e, 0xa1, 0x60, 0xd2, 0x4b, 0xb7, 0x40, 0x13, 0xdb, 0x8a, 0x56, 0xe4, 0xe7,
+	0x59, 0xc3, 0xb4, 0xcc, 0x86, 0xd9, 0xe7, 0x16, 0xd2, 0xbf, 0x27, 0x30, 0xa5, 0x0c, 0x83, 0x59,
+	0x9c, 0x85, 0x91, 0x2a, 0xae, 0x79, 0x63, 0x66, 0xaf, 0x32, 0x11, 0xcf, 0x33, 0x98, 0x8a, 0xef,
+	0xda, 0xbf, 0x46, 0x58, 0x84, 0x49, 0x1f, 0x6f, 0x27, 0x2b, 0xea, 0x6e, 0x28, 0x80, 0xa6, 0x72,
+	0xc1, 0x0c, 0x4f, 0xc3, 0xb0, 0x07, 0x13, 0x79, 0x8c, 0x9f, 0x60, 0xdb, 0x53, 0xbf, 0x06, 0xbb,
+	0xfd, 0x18, 0x17, 0xae, 0xd9, 0xac, 0xce, 0x23, 0x41, 0xf5, 0xeb, 0x9b, 0xa1, 0x7f, 0x48, 0x00,
+	0xfc, 0xa0, 0x9b, 0x1a, 0x93, 0x27, 0xfc, 0xf1, 0x36, 0xb8, 0x81, 0x53, 0xd1, 0x9e, 0x74, 0xdf,
+	0x7a, 0xc3, 0x27, 0x94, 0x3c, 0xd2, 0x9b, 0x85, 0x51, 0x91, 0x70, 0xde, 0x11, 0xeb, 0xd8, 0x43,
+	0xd3, 0x4a, 0x8a, 0x7d, 0xff, 0x5c, 0xd2, 0xf2, 0xf7, 0xea, 0xe7, 0xb7, 0x46, 0x56, 0x69, 0x85,
+	0xd9, 0xd6, 0x19, 0xdb, 0x9d, 0xf8, 0x96, 0x57, 0xa5, 0xff, 0x43, 0x42, 0x84, 0x94, 0x08, 0x47,
+	0x72, 0xf8, 0xd4, 0x51, 0xa7, 0xe2, 0xa6, 0xeb, 0x74, 0xd7, 0x23, 0x29, 0x14, 0x1b, 0x49, 0x3a,
+	0x05, 0xa3, 0x9c, 0xd9, 0x56, 0x9e, 0xc9, 0x75, 0x24, 0x69, 0x46, 0x49, 0x52, 0xd0, 0x3f, 0xc9,
+	0xfd, 0x07, 0x7a, 0x4e, 0x81, 0x74, 0x53, 0x2c, 0x15, 0x10, 0xe9, 0x45, 0xb3, 0xc9, 0x99, 0x25,
+	0x07, 0x43, 0xbf, 0xe7, 0xce, 0x07, 0x30, 0xa9, 0x88, 0x81, 0x74, 0xf4, 0xaa, 0x45, 0xdf, 0xfa,
+	0xe0, 0xce, 0x20, 0x8c, 0x9f, 0x77, 0xac, 0x66, 0x85, 0x9d, 0x2c, 0x16, 0xdd, 0xd1, 0x8e, 0x1f,
+	0x7e, 0x4a, 0x61, 0xab, 0x6d, 0x56, 0x19, 0x1e, 0x55, 0xf1, 0x3b, 0x78, 0xa4, 0x06, 0xe3, 0x1e,
+	0xa9, 0x17, 0x60, 0x87, 0x29, 0x77, 0xce, 0xdb, 0xcd, 0x6a, 0x81, 0xd5, 0x85, 0xf8, 0xde, 0x9a,
+	0xdb, 0x8e, 0xab, 0xaf, 0x8b, 0x45, 0x3a, 0x03, 0xc9, 0x1a, 0xab, 0x57, 0xcb, 0x9c, 0xbb, 0x97,
+	0xb6, 0x89, 0xad, 0x22, 0xdb, 0xe0, 0x52, 0x48, 0xe5, 0x6d, 0x7b, 0xee, 0x2a, 0x4f, 0x9f, 0x85,
+	0x7d, 0xa2, 0x4c, 0x2a, 0xb2, 0xda, 0x1f, 0xc0, 0x8f, 0x09, 0xe8, 0x51, 0x56, 0x58, 0xd5, 0x77,
+	0xe0, 0x7f, 0x55, 0x61, 0x90, 0x47, 0x12, 0xbc, 0x61, 0x30, 0xaf, 0x9e, 0xb7, 0x8a, 0xcd, 0x82,
+	0xe3, 0x67, 0x47, 0x35, 0x68, 0xc0, 0x97, 0xee, 0x8d, 0xc1, 0x36, 0x81, 0x82, 0x7e, 0x49, 0x60,
+	0xc8, 0x2b, 0xe8, 0x9c, 0x72, 0x6f, 0xc5, 0x3d, 0x53, 0x9b, 0x8f, 0x61, 0x29, 0x33, 0xd1, 0x5f,
+	0xbd, 0xe1, 0x22, 0xf8, 0xe8, 0xd7, 0xbf, 0xbe, 0x18, 0x5c, 0xa2, 0x87, 0x0d, 0xf5, 0x25, 0x5a,
+	0x66, 0x6f, 0x5c, 0xc7, 0x96, 0x58, 0x35, 0x0a, 0x2d, 0x79, 0x0f, 0xa3, 0xb7, 0x09, 0x24, 0x03,
+	0x97, 0x2c, 0x7a, 0xa8, 0x77, 0xe4, 0xee, 0x2b, 0xa5, 0x96, 0x8e, 0x69, 0x8d, 0x58, 0x5f, 0xf2,
+	0xb1, 0xce, 0xd3, 0x83, 0x31, 0xb1, 0xd2, 0x9f, 0x08, 0xec, 0xec, 0xba, 0x7a, 0xd0, 0xa5, 0xde,
+	0xa1, 0x7b, 0xdd, 0xa7, 0xb4, 0xe5, 0x0d, 0xf9, 0x20, 0xe8, 0x13, 0x3e, 0xe8, 0x65, 0xba, 0xa8,
+	0x04, 0xcd, 0x3d, 0xe7, 0xbc, 0x02, 0xfe, 0x6f, 0x04, 0x76, 0xf7, 0x10, 0xf5, 0xf4, 0x95, 0xf8,
+	0x80, 0xc2, 0x57, 0x10, 0xed, 0xe8, 0x26, 0x3c, 0x31, 0xa1, 0x73, 0x7e, 0x42, 0xc7, 0xe9, 0xb1,
+	0x0d, 0x27, 0xe4, 0xf7, 0xce, 0x4d, 0x02, 0xc9, 0x80, 0xc6, 0x8f, 0xea, 0x9d, 0xee, 0x8b, 0x87,
+	0x96, 0x8e, 0x69, 0x8d, 0xa8, 0xe7, 0x7c, 0xd4, 0x7b, 0xe9, 0x94, 0x1a, 0xb5, 0x84, 0x71, 0x93,
+	0xc0, 0xb0, 0x27, 0xb6, 0x69, 0xc4, 0x49, 0xea, 0x90, 0xef, 0xda, 0x42, 0x1c, 0x53, 0x44, 0xb3,
+	0xe8, 0xa3, 0x39, 0x40, 0xf7, 0x47, 0xa0, 0xf1, 0xd9, 0xfa, 0x84, 0x40, 0x42, 0x2a, 0x6c, 0x7a,
+	0xb0, 0x77, 0xa4, 0x90, 0x9c, 0xd7, 0xe6, 0xd6, 0x37, 0x8c, 0x4f, 0x8f, 0xd4, 0xf2, 0xf4, 0x1e,
+	0x81, 0xed, 0x21, 0xf5, 0x49, 0x33, 0xbd, 0xa3, 0xa8, 0x94, 0xad, 0x66, 0xc4, 0xb6, 0x47, 0x70,
+	0x47, 0x7d, 0x70, 0x19, 0x7a, 0x48, 0x09, 0x4e, 0x7e, 0x55, 0xf3, 0x9e, 0x86, 0x35, 0xae, 0x8b,
+	0x85, 0x55, 0x7a, 0x87, 0xc0, 0x8e, 0xf0, 0x75, 0x80, 0xae, 0x17, 0xbe, 0xf3, 0x7e, 0xa2, 0x1d,
+	0x8e, 0xef, 0x10, 0xbf, 0xbc, 0x1d, 0x80, 0xe9, 0xd7, 0x04, 0x92, 0x01, 0xcd, 0x19, 0x75, 0x18,
+	0xba, 0x75, 0xb9, 0x96, 0x8e, 0x69, 0x8d, 0xf8, 0x8e, 0xf8, 0xf8, 0x5e, 0xa4, 0xf3, 0xbd, 0xf1,
+	0xa1, 0xd0, 0x6d, 0xb3, 0x79, 0x8b, 0x40, 0x32, 0xa0, 0xd9, 0xa2, 0x40, 0x76, 0xcb, 0x52, 0x2d,
+	0x1d, 0xd3, 0x1a, 0x41, 0x66, 0x7c, 0x90, 0xb3, 0x74, 0x9f, 0xfa, 0x8c, 0x04, 0x84, 0x26, 0xfd,
+	0x8a, 0xc0, 0x68, 0x50, 0x82, 0xd1, 0x74, 0x54, 0xf7, 0x77, 0xc9, 0x41, 0x2d, 0x13, 0xd7, 0x1c,
+	0xf1, 0x19, 0x3e, 0xbe, 0xfd, 0x54, 0xef, 0x71, 0x64, 0x5c, 0xbf, 0x3c, 0x4a, 0xbe, 0xcf, 0x08,
+	0x24, 0xce, 0x88, 0x3f, 0x38, 0x47, 0x9d, 0x60, 0x69, 0x11, 0xe3, 0x04, 0x7b, 0x86, 0xf1, 0xe9,
+	0x92, 0x7f, 0xed, 0xe6, 0xc6, 0xf5, 0xb2, 0xb5, 0x4a, 0x3f, 0x25, 0x30, 0x24, 0xb7, 0xe0, 0x74,
+	0xdd, 0x28, 0x3c, 0x86, 0xb2, 0x68, 0x5b, 0x22, 0xa0, 0x79, 0x1f, 0x50, 0x8a, 0xee, 0x89, 0x02,
+	0x44, 0x7f, 0x24, 0xb0, 0x4b, 0x29, 0xb8, 0xe8, 0x91, 0xde, 0xf1, 0xa2, 0x74, 0x9c, 0xf6, 0xf2,
+	0x86, 0xfd, 0xe2, 0x1f, 0xdd, 0x0e, 0xe5, 0x97, 0x3d, 0xf5, 0x60, 0x2d, 0x45, 0x1e, 0xae, 0xa5,
+	0xc8, 0x9f, 0x6b, 0x29, 0xf2, 0xf9, 0xe3, 0xd4, 0xc0, 0xc3, 0xc7, 0xa9, 0x81, 0xdf, 0x1f, 0xa7,
+	0x06, 0xde, 0x9a, 0x8f, 0x14, 0xaf, 0xef, 0xcb, 0x6d, 0x85, 0x86, 0x2d, 0x24, 0xc4, 0x3f, 0x1c,
+	0x2c, 0xff, 0x3d, 0x00, 0x9a, 0xb2, 0x8c, 0xd1, 0x7d, 0x19, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1350,6 +1652,23 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error)
+	// PausedDenoms queries the denoms whose transfers are paused.
+	//
+	// Since: cosmos-sdk 0.50
+	PausedDenoms(ctx context.Context, in *QueryPausedDenomsRequest, opts ...grpc.CallOption) (*QueryPausedDenomsResponse, error)
+	// Escrow queries an escrow by its id.
+	//
+	// Since: cosmos-sdk 0.50
+	Escrow(ctx context.Context, in *QueryEscrowRequest, opts ...grpc.CallOption) (*QueryEscrowResponse, error)
+	// Escrows queries all the pending escrows.
+	//
+	// Since: cosmos-sdk 0.50
+	Escrows(ctx context.Context, in *QueryEscrowsRequest, opts ...grpc.CallOption) (*QueryEscrowsResponse, error)
+	// ModuleAccountBalances queries all the module accounts, with their
+	// permissions and balances.
+	//
+	// Since: cosmos-sdk 0.50
+	ModuleAccountBalances(ctx context.Context, in *QueryModuleAccountBalancesRequest, opts ...grpc.CallOption) (*QueryModuleAccountBalancesResponse, error)
 }
 
 type queryClient struct {
@@ -1459,6 +1778,42 @@ func (c *queryClient) SendEnabled(ctx context.Context, in *QuerySendEnabledReque
 	return out, nil
 }
 
+func (c *queryClient) PausedDenoms(ctx context.Context, in *QueryPausedDenomsRequest, opts ...grpc.CallOption) (*QueryPausedDenomsResponse, error) {
+	out := new(QueryPausedDenomsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/PausedDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Escrow(ctx context.Context, in *QueryEscrowRequest, opts ...grpc.CallOption) (*QueryEscrowResponse, error) {
+	out := new(QueryEscrowResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/Escrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Escrows(ctx context.Context, in *QueryEscrowsRequest, opts ...grpc.CallOption) (*QueryEscrowsResponse, error) {
+	out := new(QueryEscrowsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/Escrows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModuleAccountBalances(ctx context.Context, in *QueryModuleAccountBalancesRequest, opts ...grpc.CallOption) (*QueryModuleAccountBalancesResponse, error) {
+	out := new(QueryModuleAccountBalancesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/ModuleAccountBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Balance queries the balance of a single coin for a single account.
@@ -1517,6 +1872,23 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error)
+	// PausedDenoms queries the denoms whose transfers are paused.
+	//
+	// Since: cosmos-sdk 0.50
+	PausedDenoms(context.Context, *QueryPausedDenomsRequest) (*QueryPausedDenomsResponse, error)
+	// Escrow queries an escrow by its id.
+	//
+	// Since: cosmos-sdk 0.50
+	Escrow(context.Context, *QueryEscrowRequest) (*QueryEscrowResponse, error)
+	// Escrows queries all the pending escrows.
+	//
+	// Since: cosmos-sdk 0.50
+	Escrows(context.Context, *QueryEscrowsRequest) (*QueryEscrowsResponse, error)
+	// ModuleAccountBalances queries all the module accounts, with their
+	// permissions and balances.
+	//
+	// Since: cosmos-sdk 0.50
+	ModuleAccountBalances(context.Context, *QueryModuleAccountBalancesRequest) (*QueryModuleAccountBalancesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1556,6 +1928,18 @@ func (*UnimplementedQueryServer) DenomOwners(ctx context.Context, req *QueryDeno
 func (*UnimplementedQueryServer) SendEnabled(ctx context.Context, req *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEnabled not implemented")
 }
+func (*UnimplementedQueryServer) PausedDenoms(ctx context.Context, req *QueryPausedDenomsRequest) (*QueryPausedDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PausedDenoms not implemented")
+}
+func (*UnimplementedQueryServer) Escrow(ctx context.Context, req *QueryEscrowRequest) (*QueryEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Escrow not implemented")
+}
+func (*UnimplementedQueryServer) Escrows(ctx context.Context, req *QueryEscrowsRequest) (*QueryEscrowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Escrows not implemented")
+}
+func (*UnimplementedQueryServer) ModuleAccountBalances(ctx context.Context, req *QueryModuleAccountBalancesRequest) (*QueryModuleAccountBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleAccountBalances not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1759,6 +2143,78 @@ func _Query_SendEnabled_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PausedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPausedDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PausedDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/PausedDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PausedDenoms(ctx, req.(*QueryPausedDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Escrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Escrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/Escrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Escrow(ctx, req.(*QueryEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Escrows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Escrows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/Escrows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Escrows(ctx, req.(*QueryEscrowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModuleAccountBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleAccountBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleAccountBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/ModuleAccountBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleAccountBalances(ctx, req.(*QueryModuleAccountBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.bank.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1807,12 +2263,28 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendEnabled",
 			Handler:    _Query_SendEnabled_Handler,
 		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmos/bank/v1beta1/query.proto",
-}
-
-func (m *QueryBalanceRequest) Marshal() (dAtA []byte, err error) {
+		{
+			MethodName: "PausedDenoms",
+			Handler:    _Query_PausedDenoms_Handler,
+		},
+		{
+			MethodName: "Escrow",
+			Handler:    _Query_Escrow_Handler,
+		},
+		{
+			MethodName: "Escrows",
+			Handler:    _Query_Escrows_Handler,
+		},
+		{
+			MethodName: "ModuleAccountBalances",
+			Handler:    _Query_ModuleAccountBalances_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/bank/v1beta1/query.proto",
+}
+
+func (m *QueryBalanceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2726,6 +3198,210 @@ func (m *QuerySendEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPausedDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleAccountBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleAccountBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleAccountBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Permissions[iNdEx])
+			copy(dAtA[i:], m.Permissions[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Permissions[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.AccountNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AccountNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleAccountBalancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleAccountBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleAccountBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleAccountBalancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleAccountBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleAccountBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModuleAccounts) > 0 {
+		for iNdEx := len(m.ModuleAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ModuleAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3093,35 +3769,123 @@ func (m *QuerySendEnabledResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryPausedDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryPausedDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+
+func (m *ModuleAccountBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovQuery(uint64(m.AccountNumber))
+	}
+	if len(m.Permissions) > 0 {
+		for _, s := range m.Permissions {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryModuleAccountBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryModuleAccountBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ModuleAccounts) > 0 {
+		for _, e := range m.ModuleAccounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
 			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
@@ -5461,6 +6225,543 @@ func (m *QuerySendEnabledResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPausedDenomsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedDenomsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausedDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleAccountBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleAccountBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleAccountBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			}
+			m.AccountNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleAccountBalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleAccountBalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleAccountBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleAccountBalancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleAccountBalancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleAccountBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccounts = append(m.ModuleAccounts, ModuleAccountBalance{})
+			if err := m.ModuleAccounts[len(m.ModuleAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
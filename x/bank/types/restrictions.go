@@ -0,0 +1,61 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendRestrictionFn can restrict sends and/or provide a new receiver address.
+// It is called with the sender, the receiver and the amount of every transfer
+// made by the bank keeper, before any balance is updated, and returns the
+// address that should receive the coins. Returning an error blocks the
+// transfer.
+type SendRestrictionFn func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (newToAddr sdk.AccAddress, err error)
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (SendRestrictionFn) IsOnePerModuleType() {}
+
+var _ SendRestrictionFn = NoOpSendRestrictionFn
+
+// NoOpSendRestrictionFn is a no-op SendRestrictionFn.
+func NoOpSendRestrictionFn(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+	return toAddr, nil
+}
+
+// Then creates a composite restriction that runs this one then the provided
+// second one, which is given the receiver address returned by the first.
+// A nil restriction is skipped.
+func (r SendRestrictionFn) Then(second SendRestrictionFn) SendRestrictionFn {
+	return ComposeSendRestrictions(r, second)
+}
+
+// ComposeSendRestrictions combines multiple send restrictions into one, run in
+// the given order. Each restriction is given the receiver address returned by
+// the previous one, and the first error stops the chain. Nil restrictions are
+// skipped, and nil is returned if there is none to run.
+func ComposeSendRestrictions(restrictions ...SendRestrictionFn) SendRestrictionFn {
+	toRun := make([]SendRestrictionFn, 0, len(restrictions))
+	for _, r := range restrictions {
+		if r != nil {
+			toRun = append(toRun, r)
+		}
+	}
+
+	switch len(toRun) {
+	case 0:
+		return nil
+	case 1:
+		return toRun[0]
+	}
+
+	return func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		var err error
+		for _, r := range toRun {
+			toAddr, err = r(ctx, fromAddr, toAddr, amt)
+			if err != nil {
+				return toAddr, err
+			}
+		}
+
+		return toAddr, nil
+	}
+}
@@ -0,0 +1,108 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestComposeSendRestrictions(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+	errBlocked := errors.New("blocked")
+
+	redirect := func(from, to sdk.AccAddress) types.SendRestrictionFn {
+		return func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+			if toAddr.Equals(from) {
+				return to, nil
+			}
+			return toAddr, nil
+		}
+	}
+	block := func(addr sdk.AccAddress) types.SendRestrictionFn {
+		return func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+			if toAddr.Equals(addr) {
+				return nil, errBlocked
+			}
+			return toAddr, nil
+		}
+	}
+
+	testCases := []struct {
+		name         string
+		restrictions []types.SendRestrictionFn
+		toAddr       sdk.AccAddress
+		expNil       bool
+		expToAddr    sdk.AccAddress
+		expErr       error
+	}{
+		{
+			name:   "no restrictions",
+			expNil: true,
+		},
+		{
+			name:         "only nil restrictions",
+			restrictions: []types.SendRestrictionFn{nil, nil},
+			expNil:       true,
+		},
+		{
+			name:         "single restriction",
+			restrictions: []types.SendRestrictionFn{nil, redirect(addr1, addr2), nil},
+			toAddr:       addr1,
+			expToAddr:    addr2,
+		},
+		{
+			name:         "restrictions are chained",
+			restrictions: []types.SendRestrictionFn{redirect(addr1, addr2), redirect(addr2, addr3)},
+			toAddr:       addr1,
+			expToAddr:    addr3,
+		},
+		{
+			name:         "restrictions are run in order",
+			restrictions: []types.SendRestrictionFn{redirect(addr2, addr3), redirect(addr1, addr2)},
+			toAddr:       addr1,
+			expToAddr:    addr2,
+		},
+		{
+			name:         "error stops the chain",
+			restrictions: []types.SendRestrictionFn{redirect(addr1, addr2), block(addr2), redirect(addr2, addr3)},
+			toAddr:       addr1,
+			expErr:       errBlocked,
+		},
+		{
+			name:         "no-op restriction",
+			restrictions: []types.SendRestrictionFn{types.NoOpSendRestrictionFn},
+			toAddr:       addr1,
+			expToAddr:    addr1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			restriction := types.ComposeSendRestrictions(tc.restrictions...)
+			if tc.expNil {
+				require.Nil(t, restriction)
+				return
+			}
+
+			toAddr, err := restriction(sdk.Context{}, addr1, tc.toAddr, sdk.NewCoins())
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expToAddr, toAddr)
+		})
+	}
+
+	// Then composes the restrictions in the same order
+	then := redirect(addr1, addr2).Then(redirect(addr2, addr3))
+	toAddr, err := then(sdk.Context{}, addr1, addr1, sdk.NewCoins())
+	require.NoError(t, err)
+	require.Equal(t, addr3, toAddr)
+}
@@ -412,6 +412,111 @@ func (m *MsgSetSendEnabledResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetSendEnabledResponse proto.InternalMessageInfo
 
+// MsgSetPausedDenoms is the Msg/SetPausedDenoms request type.
+//
+// Since: cosmos-sdk 0.50
+type MsgSetPausedDenoms struct {
+	// authority is the address that controls the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// pause is the list of denoms whose transfers are paused.
+	Pause []string `protobuf:"bytes,2,rep,name=pause,proto3" json:"pause,omitempty"`
+	// unpause is the list of denoms whose transfers are resumed.
+	Unpause []string `protobuf:"bytes,3,rep,name=unpause,proto3" json:"unpause,omitempty"`
+}
+
+func (m *MsgSetPausedDenoms) Reset()         { *m = MsgSetPausedDenoms{} }
+func (m *MsgSetPausedDenoms) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPausedDenoms) ProtoMessage()    {}
+func (*MsgSetPausedDenoms) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{8}
+}
+func (m *MsgSetPausedDenoms) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPausedDenoms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPausedDenoms.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPausedDenoms) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPausedDenoms.Merge(m, src)
+}
+func (m *MsgSetPausedDenoms) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPausedDenoms) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPausedDenoms.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPausedDenoms proto.InternalMessageInfo
+
+func (m *MsgSetPausedDenoms) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetPausedDenoms) GetPause() []string {
+	if m != nil {
+		return m.Pause
+	}
+	return nil
+}
+
+func (m *MsgSetPausedDenoms) GetUnpause() []string {
+	if m != nil {
+		return m.Unpause
+	}
+	return nil
+}
+
+// MsgSetPausedDenomsResponse defines the Msg/SetPausedDenoms response type.
+//
+// Since: cosmos-sdk 0.50
+type MsgSetPausedDenomsResponse struct {
+}
+
+func (m *MsgSetPausedDenomsResponse) Reset()         { *m = MsgSetPausedDenomsResponse{} }
+func (m *MsgSetPausedDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPausedDenomsResponse) ProtoMessage()    {}
+func (*MsgSetPausedDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{9}
+}
+func (m *MsgSetPausedDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPausedDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPausedDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPausedDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPausedDenomsResponse.Merge(m, src)
+}
+func (m *MsgSetPausedDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPausedDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPausedDenomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPausedDenomsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSend)(nil), "cosmos.bank.v1beta1.MsgSend")
 	proto.RegisterType((*MsgSendResponse)(nil), "cosmos.bank.v1beta1.MsgSendResponse")
@@ -421,56 +526,68 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.bank.v1beta1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgSetSendEnabled)(nil), "cosmos.bank.v1beta1.MsgSetSendEnabled")
 	proto.RegisterType((*MsgSetSendEnabledResponse)(nil), "cosmos.bank.v1beta1.MsgSetSendEnabledResponse")
+	proto.RegisterType((*MsgSetPausedDenoms)(nil), "cosmos.bank.v1beta1.MsgSetPausedDenoms")
+	proto.RegisterType((*MsgSetPausedDenomsResponse)(nil), "cosmos.bank.v1beta1.MsgSetPausedDenomsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/tx.proto", fileDescriptor_1d8cb1613481f5b7) }
 
 var fileDescriptor_1d8cb1613481f5b7 = []byte{
-	// 700 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x4f, 0xd3, 0x50,
-	0x1c, 0x5f, 0x99, 0x8e, 0xec, 0x31, 0x25, 0x54, 0x22, 0xac, 0x90, 0x0e, 0x16, 0x43, 0x00, 0xa5,
-	0x15, 0x34, 0x9a, 0xcc, 0x68, 0x74, 0x28, 0x89, 0x26, 0x8b, 0x66, 0xc4, 0x83, 0x5e, 0x96, 0xd7,
-	0xf5, 0x51, 0x1a, 0xd6, 0xbe, 0xa6, 0xef, 0x95, 0xb0, 0x9b, 0x7a, 0x32, 0x9e, 0x3c, 0x7b, 0xe2,
-	0x68, 0x8c, 0x07, 0x0e, 0x1e, 0x4d, 0xbc, 0x72, 0x24, 0x9e, 0x3c, 0xa9, 0x81, 0x03, 0xfa, 0x5f,
-	0x98, 0xf7, 0xa3, 0xa5, 0x8c, 0x8d, 0x11, 0x2f, 0x6b, 0xf7, 0x3e, 0x3f, 0xbe, 0xef, 0xf3, 0xed,
-	0xf7, 0x3d, 0x30, 0xd9, 0xc4, 0xc4, 0xc3, 0xc4, 0xb4, 0xa0, 0xbf, 0x61, 0x6e, 0x2e, 0x5a, 0x88,
-	0xc2, 0x45, 0x93, 0x6e, 0x19, 0x41, 0x88, 0x29, 0x56, 0x2f, 0x09, 0xd4, 0x60, 0xa8, 0x21, 0x51,
-	0x6d, 0xd4, 0xc1, 0x0e, 0xe6, 0xb8, 0xc9, 0xde, 0x04, 0x55, 0xd3, 0x13, 0x23, 0x82, 0x12, 0xa3,
-	0x26, 0x76, 0xfd, 0x13, 0x78, 0xaa, 0x10, 0xf7, 0x15, 0x78, 0x51, 0xe0, 0x0d, 0x61, 0x2c, 0xeb,
-	0x0a, 0x68, 0x4c, 0x4a, 0x3d, 0xe2, 0x98, 0x9b, 0x8b, 0xec, 0x21, 0x81, 0x11, 0xe8, 0xb9, 0x3e,
-	0x36, 0xf9, 0xaf, 0x58, 0x2a, 0x7f, 0x1e, 0x00, 0x83, 0x35, 0xe2, 0xac, 0x22, 0xdf, 0x56, 0xef,
-	0x80, 0xc2, 0x5a, 0x88, 0xbd, 0x06, 0xb4, 0xed, 0x10, 0x11, 0x32, 0xae, 0x4c, 0x29, 0xb3, 0xf9,
-	0xea, 0xf8, 0xf7, 0x2f, 0x0b, 0xa3, 0xd2, 0xff, 0x81, 0x40, 0x56, 0x69, 0xe8, 0xfa, 0x4e, 0x7d,
-	0x88, 0xb1, 0xe5, 0x92, 0x7a, 0x1b, 0x00, 0x8a, 0x13, 0xe9, 0x40, 0x1f, 0x69, 0x9e, 0xe2, 0x58,
-	0xd8, 0x06, 0x39, 0xe8, 0xe1, 0xc8, 0xa7, 0xe3, 0xd9, 0xa9, 0xec, 0xec, 0xd0, 0x52, 0xd1, 0x48,
-	0x9a, 0x48, 0x50, 0xdc, 0x44, 0x63, 0x19, 0xbb, 0x7e, 0x75, 0x65, 0xf7, 0x67, 0x29, 0xf3, 0xe9,
-	0x57, 0x69, 0xd6, 0x71, 0xe9, 0x7a, 0x64, 0x19, 0x4d, 0xec, 0xc9, 0xe4, 0xf2, 0xb1, 0x40, 0xec,
-	0x0d, 0x93, 0xb6, 0x03, 0x44, 0xb8, 0x80, 0x7c, 0x38, 0xdc, 0x99, 0x2f, 0xb4, 0x90, 0x03, 0x9b,
-	0xed, 0x06, 0xeb, 0x2d, 0xf9, 0x78, 0xb8, 0x33, 0xaf, 0xd4, 0x65, 0xc1, 0xca, 0xf5, 0xb7, 0xdb,
-	0xa5, 0xcc, 0x9f, 0xed, 0x52, 0xe6, 0x0d, 0xe3, 0xa5, 0xb3, 0xbf, 0x3b, 0xdc, 0x99, 0x57, 0x53,
-	0x9e, 0xb2, 0x45, 0xe5, 0x11, 0x30, 0x2c, 0x5f, 0xeb, 0x88, 0x04, 0xd8, 0x27, 0xa8, 0xfc, 0x55,
-	0x01, 0x85, 0x1a, 0x71, 0x6a, 0x51, 0x8b, 0xba, 0xbc, 0x8d, 0x77, 0x41, 0xce, 0xf5, 0x83, 0x88,
-	0xb2, 0x06, 0xb2, 0x40, 0x9a, 0xd1, 0x65, 0x2a, 0x8c, 0xc7, 0x8c, 0x52, 0xcd, 0xb3, 0x44, 0x72,
-	0x53, 0x42, 0xa4, 0xde, 0x07, 0x83, 0x38, 0xa2, 0x5c, 0x3f, 0xc0, 0xf5, 0x13, 0x5d, 0xf5, 0x4f,
-	0x39, 0x27, 0x6d, 0x10, 0xcb, 0x2a, 0x57, 0xe3, 0x48, 0xd2, 0x92, 0x85, 0x19, 0x3b, 0x1e, 0x26,
-	0xd9, 0x6d, 0xf9, 0x32, 0x18, 0x4d, 0xff, 0x4f, 0x62, 0x7d, 0x53, 0x78, 0xd4, 0xe7, 0x81, 0x0d,
-	0x29, 0x7a, 0x06, 0x43, 0xe8, 0x11, 0xf5, 0x16, 0xc8, 0xc3, 0x88, 0xae, 0xe3, 0xd0, 0xa5, 0xed,
-	0xbe, 0xd3, 0x71, 0x44, 0x55, 0xef, 0x81, 0x5c, 0xc0, 0x1d, 0xf8, 0x5c, 0xf4, 0x4a, 0x24, 0x8a,
-	0x1c, 0x6b, 0x89, 0x50, 0x55, 0x6e, 0xb2, 0x30, 0x47, 0x7e, 0x2c, 0xcf, 0x74, 0x2a, 0xcf, 0x96,
-	0x38, 0x24, 0x1d, 0xbb, 0x2d, 0x17, 0xc1, 0x58, 0xc7, 0x52, 0x12, 0xee, 0xaf, 0x02, 0x46, 0xf8,
-	0x77, 0xa4, 0x2c, 0xf3, 0x23, 0x1f, 0x5a, 0x2d, 0x64, 0xff, 0x77, 0xbc, 0x65, 0x50, 0x20, 0xc8,
-	0xb7, 0x1b, 0x48, 0xf8, 0xc8, 0xcf, 0x36, 0xd5, 0x35, 0x64, 0xaa, 0x5e, 0x7d, 0x88, 0xa4, 0x8a,
-	0xcf, 0x80, 0xe1, 0x88, 0xa0, 0x86, 0x8d, 0xd6, 0x60, 0xd4, 0xa2, 0x8d, 0x35, 0x1c, 0xf2, 0xf3,
-	0x90, 0xaf, 0x5f, 0x88, 0x08, 0x7a, 0x28, 0x56, 0x57, 0x70, 0x58, 0x31, 0x4f, 0xf6, 0x62, 0xb2,
-	0x73, 0x50, 0xd3, 0xa9, 0xca, 0x13, 0xa0, 0x78, 0x62, 0x31, 0x6e, 0xc4, 0xd2, 0xeb, 0x2c, 0xc8,
-	0xd6, 0x88, 0xa3, 0x3e, 0x01, 0xe7, 0xf8, 0xec, 0x4e, 0x76, 0xdd, 0xb4, 0x1c, 0x79, 0xed, 0xca,
-	0x69, 0x68, 0xec, 0xa9, 0xbe, 0x00, 0xf9, 0xa3, 0xc3, 0x30, 0xdd, 0x4b, 0x92, 0x50, 0xb4, 0xb9,
-	0xbe, 0x94, 0xc4, 0xda, 0x02, 0x85, 0x63, 0x03, 0xd9, 0x73, 0x43, 0x69, 0x96, 0x76, 0xed, 0x2c,
-	0xac, 0xa4, 0xc6, 0x3a, 0xb8, 0xd8, 0x31, 0x17, 0x33, 0xbd, 0x63, 0xa7, 0x79, 0x9a, 0x71, 0x36,
-	0x5e, 0x5c, 0x49, 0x3b, 0xff, 0x8a, 0x4d, 0x79, 0x75, 0x79, 0x77, 0x5f, 0x57, 0xf6, 0xf6, 0x75,
-	0xe5, 0xf7, 0xbe, 0xae, 0xbc, 0x3f, 0xd0, 0x33, 0x7b, 0x07, 0x7a, 0xe6, 0xc7, 0x81, 0x9e, 0x79,
-	0x39, 0x77, 0xea, 0x3d, 0x27, 0xc7, 0x9e, 0x5f, 0x77, 0x56, 0x8e, 0x5f, 0xe7, 0x37, 0xfe, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x5b, 0x5b, 0x43, 0xa9, 0xa0, 0x06, 0x00, 0x00,
+	// 864 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x8f, 0xdb, 0x44,
+	0x14, 0x8e, 0x77, 0x69, 0xa2, 0xcc, 0x06, 0x96, 0x35, 0x11, 0x9b, 0xb8, 0x21, 0x49, 0x2d, 0x54,
+	0xd2, 0x25, 0xb5, 0x77, 0x0b, 0x02, 0x29, 0x08, 0x44, 0x93, 0xb6, 0x12, 0x48, 0x11, 0x55, 0x56,
+	0x1c, 0xe0, 0x12, 0x4d, 0xe2, 0x89, 0xd7, 0xda, 0xd8, 0x63, 0x79, 0xc6, 0xa5, 0xb9, 0x21, 0x4e,
+	0xa8, 0x27, 0xce, 0x9c, 0x7a, 0x44, 0x08, 0xa1, 0x3d, 0x70, 0x44, 0xe2, 0xda, 0x63, 0xc5, 0x89,
+	0x13, 0xa0, 0xdd, 0xc3, 0xc2, 0x7f, 0x81, 0xe6, 0x87, 0xbd, 0x93, 0xdf, 0x11, 0xbd, 0xc4, 0x9e,
+	0xf7, 0xbe, 0xf7, 0xbd, 0xf7, 0xbd, 0x37, 0xe3, 0x09, 0xa8, 0x0c, 0x31, 0xf1, 0x31, 0xb1, 0x07,
+	0x30, 0x38, 0xb5, 0x1f, 0x1d, 0x0d, 0x10, 0x85, 0x47, 0x36, 0x7d, 0x6c, 0x85, 0x11, 0xa6, 0x58,
+	0x7f, 0x4d, 0x78, 0x2d, 0xe6, 0xb5, 0xa4, 0xd7, 0x28, 0xba, 0xd8, 0xc5, 0xdc, 0x6f, 0xb3, 0x37,
+	0x01, 0x35, 0xaa, 0x29, 0x11, 0x41, 0x29, 0xd1, 0x10, 0x7b, 0xc1, 0x9c, 0x5f, 0x49, 0xc4, 0x79,
+	0x85, 0xbf, 0xbe, 0xc8, 0x8f, 0xc8, 0x30, 0xc2, 0x5f, 0x49, 0x84, 0xb9, 0x08, 0xe1, 0x23, 0x0a,
+	0x1d, 0x48, 0xa1, 0xc4, 0x94, 0x05, 0xa6, 0x2f, 0xca, 0x93, 0xd5, 0x0b, 0xd7, 0xbe, 0x0c, 0xf7,
+	0x89, 0x6b, 0x3f, 0x3a, 0x62, 0x0f, 0xe9, 0xd8, 0x83, 0xbe, 0x17, 0x60, 0x9b, 0xff, 0x0a, 0x93,
+	0xf9, 0xd3, 0x16, 0xc8, 0x75, 0x89, 0x7b, 0x8c, 0x02, 0x47, 0xff, 0x00, 0x14, 0x46, 0x11, 0xf6,
+	0xfb, 0xd0, 0x71, 0x22, 0x44, 0x48, 0x49, 0xab, 0x6b, 0x8d, 0x7c, 0xbb, 0xf4, 0xfb, 0x2f, 0xb7,
+	0x8b, 0x92, 0xff, 0xae, 0xf0, 0x1c, 0xd3, 0xc8, 0x0b, 0xdc, 0xde, 0x0e, 0x43, 0x4b, 0x93, 0xfe,
+	0x3e, 0x00, 0x14, 0xa7, 0xa1, 0x5b, 0x6b, 0x42, 0xf3, 0x14, 0x27, 0x81, 0x13, 0x90, 0x85, 0x3e,
+	0x8e, 0x03, 0x5a, 0xda, 0xae, 0x6f, 0x37, 0x76, 0xee, 0x94, 0xad, 0x74, 0x14, 0x04, 0x25, 0xa3,
+	0xb0, 0x3a, 0xd8, 0x0b, 0xda, 0x0f, 0x9e, 0xfd, 0x59, 0xcb, 0xfc, 0xf8, 0x57, 0xad, 0xe1, 0x7a,
+	0xf4, 0x24, 0x1e, 0x58, 0x43, 0xec, 0x4b, 0xe5, 0xf2, 0x71, 0x9b, 0x38, 0xa7, 0x36, 0x9d, 0x84,
+	0x88, 0xf0, 0x00, 0xf2, 0xfd, 0xe5, 0xd9, 0x41, 0x61, 0x8c, 0x5c, 0x38, 0x9c, 0xf4, 0xd9, 0x84,
+	0xc8, 0x0f, 0x97, 0x67, 0x07, 0x5a, 0x4f, 0x26, 0x6c, 0x1d, 0x7e, 0xfb, 0xb4, 0x96, 0xf9, 0xe7,
+	0x69, 0x2d, 0xf3, 0x0d, 0xc3, 0xa9, 0xda, 0x9f, 0x5c, 0x9e, 0x1d, 0xe8, 0x0a, 0xa7, 0x6c, 0x91,
+	0xb9, 0x07, 0x76, 0xe5, 0x6b, 0x0f, 0x91, 0x10, 0x07, 0x04, 0x99, 0xbf, 0x6a, 0xa0, 0xd0, 0x25,
+	0x6e, 0x37, 0x1e, 0x53, 0x8f, 0xb7, 0xf1, 0x43, 0x90, 0xf5, 0x82, 0x30, 0xa6, 0xac, 0x81, 0x4c,
+	0x90, 0x61, 0x2d, 0xd8, 0x5b, 0xd6, 0x27, 0x0c, 0xd2, 0xce, 0x33, 0x45, 0xb2, 0x28, 0x11, 0xa4,
+	0x7f, 0x0c, 0x72, 0x38, 0xa6, 0x3c, 0x7e, 0x8b, 0xc7, 0x5f, 0x5f, 0x18, 0xff, 0x59, 0x4c, 0x67,
+	0x08, 0x92, 0xb0, 0xd6, 0xdb, 0x89, 0x24, 0x49, 0xc9, 0xc4, 0xec, 0x4f, 0x8b, 0x49, 0xab, 0x35,
+	0x5f, 0x07, 0x45, 0x75, 0x9d, 0xca, 0xfa, 0x4d, 0xe3, 0x52, 0x3f, 0x0f, 0x1d, 0x48, 0xd1, 0x43,
+	0x18, 0x41, 0x9f, 0xe8, 0xef, 0x81, 0x3c, 0x8c, 0xe9, 0x09, 0x8e, 0x3c, 0x3a, 0x59, 0xbb, 0x3b,
+	0xae, 0xa0, 0xfa, 0x47, 0x20, 0x1b, 0x72, 0x06, 0xbe, 0x2f, 0x96, 0x29, 0x12, 0x49, 0xa6, 0x5a,
+	0x22, 0xa2, 0x5a, 0xef, 0x32, 0x31, 0x57, 0x7c, 0x4c, 0xcf, 0x0d, 0x45, 0xcf, 0x63, 0x71, 0x50,
+	0x66, 0xaa, 0x35, 0xcb, 0x60, 0x7f, 0xc6, 0x94, 0x8a, 0xfb, 0x57, 0x03, 0x7b, 0x7c, 0x8e, 0x94,
+	0x69, 0xbe, 0x1f, 0xc0, 0xc1, 0x18, 0x39, 0xff, 0x5b, 0x5e, 0x07, 0x14, 0x08, 0x0a, 0x9c, 0x3e,
+	0x12, 0x3c, 0x72, 0x6c, 0xf5, 0x85, 0x22, 0x95, 0x7c, 0xbd, 0x1d, 0xa2, 0x24, 0xbf, 0x09, 0x76,
+	0x63, 0x82, 0xfa, 0x0e, 0x1a, 0xc1, 0x78, 0x4c, 0xfb, 0x23, 0x1c, 0xf1, 0xf3, 0x90, 0xef, 0xbd,
+	0x1c, 0x13, 0x74, 0x4f, 0x58, 0x1f, 0xe0, 0xa8, 0x65, 0xcf, 0xf7, 0xa2, 0x32, 0xbb, 0x51, 0x55,
+	0x55, 0xe6, 0x75, 0x50, 0x9e, 0x33, 0xa6, 0x8d, 0xf8, 0x59, 0x03, 0xba, 0xf0, 0x3e, 0x84, 0x31,
+	0x41, 0xce, 0x3d, 0x14, 0xe0, 0x17, 0x18, 0x74, 0x11, 0x5c, 0x0b, 0x19, 0x0f, 0x6f, 0x41, 0xbe,
+	0x27, 0x16, 0x7a, 0x09, 0xe4, 0xe2, 0x40, 0xd8, 0x85, 0xa4, 0x64, 0xd9, 0x3a, 0x9c, 0x17, 0xf3,
+	0xc6, 0x9c, 0x18, 0xb5, 0x32, 0xb3, 0x02, 0x8c, 0x79, 0x6b, 0x22, 0xe7, 0xce, 0x93, 0x1c, 0xd8,
+	0xee, 0x12, 0x57, 0xff, 0x14, 0xbc, 0xc4, 0x8f, 0x62, 0x65, 0xe1, 0x0c, 0xe4, 0x09, 0x36, 0xde,
+	0x5c, 0xe5, 0x4d, 0x38, 0xf5, 0x2f, 0x40, 0xfe, 0xea, 0x6c, 0xdf, 0x58, 0x16, 0x92, 0x42, 0x8c,
+	0x5b, 0x6b, 0x21, 0x29, 0xf5, 0x00, 0x14, 0xa6, 0xce, 0xd7, 0xd2, 0x82, 0x54, 0x94, 0xd1, 0xdc,
+	0x04, 0x95, 0xe6, 0x38, 0x01, 0xaf, 0xcc, 0x6c, 0xf3, 0x9b, 0xcb, 0x65, 0xab, 0x38, 0xc3, 0xda,
+	0x0c, 0x97, 0x66, 0x3a, 0x05, 0xbb, 0xb3, 0xfb, 0xe8, 0xad, 0x15, 0x14, 0x2a, 0xd0, 0xb0, 0x37,
+	0x04, 0xa6, 0xc9, 0x02, 0xf0, 0xea, 0x31, 0xa2, 0xdc, 0xd8, 0x95, 0x17, 0xa3, 0xde, 0x58, 0x41,
+	0x32, 0x85, 0x34, 0x0e, 0x37, 0x45, 0xaa, 0xa3, 0xea, 0x44, 0x08, 0x52, 0x74, 0x9f, 0x5f, 0xd4,
+	0xcb, 0x47, 0xa5, 0xa2, 0x8c, 0xe6, 0x26, 0x28, 0x35, 0xc7, 0xdd, 0xe1, 0x10, 0x85, 0x74, 0x5d,
+	0x0e, 0x15, 0x65, 0x34, 0x37, 0x41, 0xa9, 0x39, 0x7a, 0x68, 0x14, 0x07, 0xce, 0xba, 0x1c, 0x2a,
+	0xca, 0x68, 0x6e, 0x82, 0x4a, 0x72, 0x18, 0xd7, 0xbe, 0x66, 0x5f, 0xef, 0x76, 0xe7, 0xd9, 0x79,
+	0x55, 0x7b, 0x7e, 0x5e, 0xd5, 0xfe, 0x3e, 0xaf, 0x6a, 0xdf, 0x5d, 0x54, 0x33, 0xcf, 0x2f, 0xaa,
+	0x99, 0x3f, 0x2e, 0xaa, 0x99, 0x2f, 0x6f, 0xad, 0xbc, 0xbf, 0xe5, 0xe7, 0x9c, 0x5f, 0xe3, 0x83,
+	0x2c, 0xff, 0x9b, 0xf2, 0xce, 0x7f, 0x03, 0x00, 0xf0, 0x26, 0xa2, 0x59, 0xbe, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -501,6 +618,31 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
+	// SetPausedDenoms is a governance operation for pausing or resuming the
+	// transfers of denoms. The coins of a paused denom cannot be sent.
+	//
+	// Since: cosmos-sdk 0.50
+	SetPausedDenoms(ctx context.Context, in *MsgSetPausedDenoms, opts ...grpc.CallOption) (*MsgSetPausedDenomsResponse, error)
+	// SetDenomMetadata is a governance operation for registering or updating the
+	// metadata of a denom.
+	//
+	// Since: cosmos-sdk 0.50
+	SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error)
+	// CreateEscrow moves coins of the creator into escrow, to be released to the
+	// counterparty once it accepts the escrow.
+	//
+	// Since: cosmos-sdk 0.50
+	CreateEscrow(ctx context.Context, in *MsgCreateEscrow, opts ...grpc.CallOption) (*MsgCreateEscrowResponse, error)
+	// AcceptEscrow releases the escrowed coins to the counterparty, which sends
+	// the expected coins to the creator in the same operation.
+	//
+	// Since: cosmos-sdk 0.50
+	AcceptEscrow(ctx context.Context, in *MsgAcceptEscrow, opts ...grpc.CallOption) (*MsgAcceptEscrowResponse, error)
+	// RefundEscrow returns the escrowed coins to the creator once the escrow
+	// timed out.
+	//
+	// Since: cosmos-sdk 0.50
+	RefundEscrow(ctx context.Context, in *MsgRefundEscrow, opts ...grpc.CallOption) (*MsgRefundEscrowResponse, error)
 }
 
 type msgClient struct {
@@ -547,6 +689,51 @@ func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, o
 	return out, nil
 }
 
+func (c *msgClient) SetPausedDenoms(ctx context.Context, in *MsgSetPausedDenoms, opts ...grpc.CallOption) (*MsgSetPausedDenomsResponse, error) {
+	out := new(MsgSetPausedDenomsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/SetPausedDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error) {
+	out := new(MsgSetDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/SetDenomMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateEscrow(ctx context.Context, in *MsgCreateEscrow, opts ...grpc.CallOption) (*MsgCreateEscrowResponse, error) {
+	out := new(MsgCreateEscrowResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/CreateEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptEscrow(ctx context.Context, in *MsgAcceptEscrow, opts ...grpc.CallOption) (*MsgAcceptEscrowResponse, error) {
+	out := new(MsgAcceptEscrowResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/AcceptEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RefundEscrow(ctx context.Context, in *MsgRefundEscrow, opts ...grpc.CallOption) (*MsgRefundEscrowResponse, error) {
+	out := new(MsgRefundEscrowResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/RefundEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Send defines a method for sending coins from one account to another account.
@@ -565,6 +752,31 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
+	// SetPausedDenoms is a governance operation for pausing or resuming the
+	// transfers of denoms. The coins of a paused denom cannot be sent.
+	//
+	// Since: cosmos-sdk 0.50
+	SetPausedDenoms(context.Context, *MsgSetPausedDenoms) (*MsgSetPausedDenomsResponse, error)
+	// SetDenomMetadata is a governance operation for registering or updating the
+	// metadata of a denom.
+	//
+	// Since: cosmos-sdk 0.50
+	SetDenomMetadata(context.Context, *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error)
+	// CreateEscrow moves coins of the creator into escrow, to be released to the
+	// counterparty once it accepts the escrow.
+	//
+	// Since: cosmos-sdk 0.50
+	CreateEscrow(context.Context, *MsgCreateEscrow) (*MsgCreateEscrowResponse, error)
+	// AcceptEscrow releases the escrowed coins to the counterparty, which sends
+	// the expected coins to the creator in the same operation.
+	//
+	// Since: cosmos-sdk 0.50
+	AcceptEscrow(context.Context, *MsgAcceptEscrow) (*MsgAcceptEscrowResponse, error)
+	// RefundEscrow returns the escrowed coins to the creator once the escrow
+	// timed out.
+	//
+	// Since: cosmos-sdk 0.50
+	RefundEscrow(context.Context, *MsgRefundEscrow) (*MsgRefundEscrowResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -583,6 +795,21 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) SetSendEnabled(ctx context.Context, req *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSendEnabled not implemented")
 }
+func (*UnimplementedMsgServer) SetPausedDenoms(ctx context.Context, req *MsgSetPausedDenoms) (*MsgSetPausedDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPausedDenoms not implemented")
+}
+func (*UnimplementedMsgServer) SetDenomMetadata(ctx context.Context, req *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMetadata not implemented")
+}
+func (*UnimplementedMsgServer) CreateEscrow(ctx context.Context, req *MsgCreateEscrow) (*MsgCreateEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEscrow not implemented")
+}
+func (*UnimplementedMsgServer) AcceptEscrow(ctx context.Context, req *MsgAcceptEscrow) (*MsgAcceptEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptEscrow not implemented")
+}
+func (*UnimplementedMsgServer) RefundEscrow(ctx context.Context, req *MsgRefundEscrow) (*MsgRefundEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundEscrow not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -660,6 +887,96 @@ func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetPausedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPausedDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPausedDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/SetPausedDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPausedDenoms(ctx, req.(*MsgSetPausedDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/SetDenomMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomMetadata(ctx, req.(*MsgSetDenomMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/CreateEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateEscrow(ctx, req.(*MsgCreateEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/AcceptEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptEscrow(ctx, req.(*MsgAcceptEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RefundEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefundEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/RefundEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefundEscrow(ctx, req.(*MsgRefundEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.bank.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -680,6 +997,26 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetSendEnabled",
 			Handler:    _Msg_SetSendEnabled_Handler,
 		},
+		{
+			MethodName: "SetPausedDenoms",
+			Handler:    _Msg_SetPausedDenoms_Handler,
+		},
+		{
+			MethodName: "SetDenomMetadata",
+			Handler:    _Msg_SetDenomMetadata_Handler,
+		},
+		{
+			MethodName: "CreateEscrow",
+			Handler:    _Msg_CreateEscrow_Handler,
+		},
+		{
+			MethodName: "AcceptEscrow",
+			Handler:    _Msg_AcceptEscrow_Handler,
+		},
+		{
+			MethodName: "RefundEscrow",
+			Handler:    _Msg_RefundEscrow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/tx.proto",
@@ -972,6 +1309,77 @@ func (m *MsgSetSendEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetPausedDenoms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPausedDenoms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPausedDenoms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unpause) > 0 {
+		for iNdEx := len(m.Unpause) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Unpause[iNdEx])
+			copy(dAtA[i:], m.Unpause[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Unpause[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Pause) > 0 {
+		for iNdEx := len(m.Pause) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Pause[iNdEx])
+			copy(dAtA[i:], m.Pause[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Pause[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPausedDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPausedDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPausedDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1103,6 +1511,40 @@ func (m *MsgSetSendEnabledResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetPausedDenoms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Pause) > 0 {
+		for _, s := range m.Pause {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Unpause) > 0 {
+		for _, s := range m.Unpause {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetPausedDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1838,6 +2280,202 @@ func (m *MsgSetSendEnabledResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetPausedDenoms) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPausedDenoms: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPausedDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pause = append(m.Pause, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unpause", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unpause = append(m.Unpause, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPausedDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPausedDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPausedDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
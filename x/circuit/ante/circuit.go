@@ -0,0 +1,41 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// CircuitBreakerDecorator rejects the txs containing a Msg whose processing
+// has been paused by the circuit breaker, so that they are not even included
+// in the mempool.
+type CircuitBreakerDecorator struct {
+	circuitKeeper baseapp.CircuitBreaker
+}
+
+// NewCircuitBreakerDecorator returns a new CircuitBreakerDecorator checking the
+// Msgs of the txs against the given circuit breaker.
+func NewCircuitBreakerDecorator(ck baseapp.CircuitBreaker) CircuitBreakerDecorator {
+	return CircuitBreakerDecorator{
+		circuitKeeper: ck,
+	}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (cbd CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		msgURL := sdk.MsgTypeURL(msg)
+		isAllowed, err := cbd.circuitKeeper.IsAllowed(ctx, msgURL)
+		if err != nil {
+			return ctx, err
+		}
+
+		if !isAllowed {
+			return ctx, errorsmod.Wrapf(types.ErrCircuitBreakerTripped, "tx type not allowed: %s", msgURL)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,44 @@
+package ante_test
+
+import (
+	"context"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/ante"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+type mockCircuitBreaker map[string]bool
+
+func (cb mockCircuitBreaker) IsAllowed(_ context.Context, typeURL string) (bool, error) {
+	return !cb[typeURL], nil
+}
+
+type mockTx []sdk.Msg
+
+func (tx mockTx) GetMsgs() []sdk.Msg   { return tx }
+func (tx mockTx) ValidateBasic() error { return nil }
+
+func TestCircuitBreakerDecorator(t *testing.T) {
+	cb := mockCircuitBreaker{sdk.MsgTypeURL(&banktypes.MsgMultiSend{}): true}
+	decorator := ante.NewCircuitBreakerDecorator(cb)
+
+	nextCalled := false
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		nextCalled = true
+		return ctx, nil
+	}
+
+	_, err := decorator.AnteHandle(sdk.Context{}, mockTx{&banktypes.MsgSend{}}, false, next)
+	assert.NilError(t, err)
+	assert.Assert(t, nextCalled)
+
+	nextCalled = false
+	_, err = decorator.AnteHandle(sdk.Context{}, mockTx{&banktypes.MsgSend{}, &banktypes.MsgMultiSend{}}, false, next)
+	assert.ErrorIs(t, err, types.ErrCircuitBreakerTripped)
+	assert.Assert(t, !nextCalled)
+}
@@ -0,0 +1,122 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	circuitQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the circuit module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	circuitQueryCmd.AddCommand(
+		GetCmdQueryAccount(),
+		GetCmdQueryAccounts(),
+		GetCmdQueryDisabledList(),
+	)
+
+	return circuitQueryCmd
+}
+
+// GetCmdQueryAccount returns cmd to query the circuit breaker permissions of an account.
+func GetCmdQueryAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the circuit breaker permissions of an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the circuit breaker permissions of an account.
+
+Example:
+$ %s query %s account cosmos1skjw...
+`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			res, err := queryClient.Account(cmd.Context(), &types.QueryAccountRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAccounts returns cmd to query the circuit breaker permissions of all the accounts.
+func GetCmdQueryAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accounts",
+		Args:  cobra.NoArgs,
+		Short: "Query the circuit breaker permissions of all the accounts",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Accounts(cmd.Context(), &types.QueryAccountsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "accounts")
+
+	return cmd
+}
+
+// GetCmdQueryDisabledList returns cmd to query the msg type urls whose processing is disabled.
+func GetCmdQueryDisabledList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disabled-list",
+		Args:  cobra.NoArgs,
+		Short: "Query the msg type urls whose processing is disabled",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DisabledList(cmd.Context(), &types.QueryDisabledListRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
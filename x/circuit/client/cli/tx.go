@@ -0,0 +1,159 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	circuitTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Circuit breaker transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	circuitTxCmd.AddCommand(
+		AuthorizeCircuitBreakerCmd(),
+		TripCircuitBreakerCmd(),
+		ResetCircuitBreakerCmd(),
+	)
+
+	return circuitTxCmd
+}
+
+// AuthorizeCircuitBreakerCmd returns a CLI command handler for creating a MsgAuthorizeCircuitBreaker transaction.
+func AuthorizeCircuitBreakerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authorize [grantee] [level] [msg_type_urls]",
+		Short: "Authorize an account to trip the circuit breaker",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Authorize an account to trip the circuit breaker. The level is one of
+LEVEL_NONE_UNSPECIFIED (revokes the permissions), LEVEL_SOME_MSGS, LEVEL_ALL_MSGS or
+LEVEL_SUPER_ADMIN. The comma separated list of msg type urls must only be given with
+LEVEL_SOME_MSGS.
+
+Example:
+$ %s tx %s authorize cosmos1skjw... LEVEL_SOME_MSGS /cosmos.bank.v1beta1.MsgSend,/cosmos.bank.v1beta1.MsgMultiSend --from mykey
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			level, ok := types.Permissions_Level_value[args[1]]
+			if !ok {
+				return fmt.Errorf("invalid permission level %s", args[1])
+			}
+
+			var msgTypeURLs []string
+			if len(args) > 2 {
+				msgTypeURLs = strings.Split(args[2], ",")
+			}
+
+			permissions := &types.Permissions{Level: types.Permissions_Level(level), LimitTypeUrls: msgTypeURLs}
+			if err := permissions.Validate(); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAuthorizeCircuitBreaker(clientCtx.GetFromAddress().String(), grantee.String(), permissions)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// TripCircuitBreakerCmd returns a CLI command handler for creating a MsgTripCircuitBreaker transaction.
+func TripCircuitBreakerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disable [msg_type_urls]",
+		Short: "Disable the processing of messages",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Disable the processing of the given comma separated msg type urls, or of all
+the messages (but the circuit breaker ones) if none is given.
+
+Example:
+$ %s tx %s disable /cosmos.bank.v1beta1.MsgSend,/cosmos.bank.v1beta1.MsgMultiSend --from mykey
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var msgTypeURLs []string
+			if len(args) > 0 {
+				msgTypeURLs = strings.Split(args[0], ",")
+			}
+
+			msg := types.NewMsgTripCircuitBreaker(clientCtx.GetFromAddress().String(), msgTypeURLs)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ResetCircuitBreakerCmd returns a CLI command handler for creating a MsgResetCircuitBreaker transaction.
+func ResetCircuitBreakerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset [msg_type_urls]",
+		Short: "Resume the processing of disabled messages",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Resume the processing of the given comma separated msg type urls, or of all the
+disabled messages the account is allowed to reset if none is given.
+
+Example:
+$ %s tx %s reset /cosmos.bank.v1beta1.MsgSend,/cosmos.bank.v1beta1.MsgMultiSend --from mykey
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var msgTypeURLs []string
+			if len(args) > 0 {
+				msgTypeURLs = strings.Split(args[0], ",")
+			}
+
+			msg := types.NewMsgResetCircuitBreaker(clientCtx.GetFromAddress().String(), msgTypeURLs)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,65 +1,76 @@
 Feature: MsgResetCircuitBreaker
-	- Circuit breaker can be reset:
-	- when the permissions are valid
+  Circuit breaker can be reset:
+  - when the permissions are valid
+  - when the message is disabled
 
   Rule: caller must have a permission to reset the circuit
 
-    Example: caller attempts to reset a disabled message
+    Example: caller is a super admin
       Given "acct1" has permission "LEVEL_SUPER_ADMIN"
-      When "acct1" attempts to enable a disabled message
+      And "/cosmos.bank.v1beta1.MsgSend" is disabled
+      When "acct1" attempts to reset a disabled message
         """
-        {
-        	"msg": "cosmos.bank.v1beta1.MsgSend"
-        }
+        { "msg_type_urls": ["/cosmos.bank.v1beta1.MsgSend"] }
         """
       Then expect success
+      And expect that "/cosmos.bank.v1beta1.MsgSend" is enabled
 
     Example: caller has no permissions
       Given "acct1" has no permissions
+      And "/cosmos.bank.v1beta1.MsgSend" is disabled
       When "acct1" attempts to reset a disabled message
         """
-        {
-        	"msg": "cosmos.bank.v1beta1.MsgSend"
-        }
+        { "msg_type_urls": ["/cosmos.bank.v1beta1.MsgSend"] }
         """
       Then expect an "unauthorized" error
 
-    Example: caller attempts to reset a disabled message
+    Example: caller has permission for all messages
       Given "acct1" has permission "LEVEL_ALL_MSGS"
+      And "/cosmos.bank.v1beta1.MsgSend" is disabled
       When "acct1" attempts to reset a disabled message
         """
-        {
-        	"msg": "cosmos.bank.v1beta1.MsgSend"
-        }
+        { "msg_type_urls": ["/cosmos.bank.v1beta1.MsgSend"] }
         """
       Then expect success
 
     Example: caller attempts to reset a message they have permission to trip
-      Given "acct1" has permission to trip circuit breaker for "cosmos.bank.v1beta1.MsgSend"
+      Given "acct1" has permission to trip circuit breaker for "/cosmos.bank.v1beta1.MsgSend"
+      And "/cosmos.bank.v1beta1.MsgSend" is disabled
       When "acct1" attempts to reset a disabled message
         """
-        {
-        	"msg": "cosmos.bank.v1beta1.MsgSend"
-        }
+        { "msg_type_urls": ["/cosmos.bank.v1beta1.MsgSend"] }
         """
       Then expect success
 
     Example: caller attempts to reset a message they don't have permission to trip
-      Given "acct1" has permission to trip circuit breaker for "cosmos.bank.v1beta1.MsgSend"
+      Given "acct1" has permission to trip circuit breaker for "/cosmos.bank.v1beta1.MsgSend"
+      And "/cosmos.bank.v1beta1.MsgMultiSend" is disabled
       When "acct1" attempts to reset a disabled message
         """
-        {
-        	"msg": "cosmos.bank.v1beta1.MultiSend"
-        }
+        { "msg_type_urls": ["/cosmos.bank.v1beta1.MsgMultiSend"] }
         """
-      Then expect success
+      Then expect an "unauthorized" error
 
-    Example: caller attempts to reset a message that has been tripped
-      Given "acct1" has permission "LEVEL_SUPER_ADMIN" & "cosmos.bank.v1beta1.MultiSend" has been enabled
+  Rule: the message must be disabled
+
+    Example: caller attempts to reset a message that has not been tripped
+      Given "acct1" has permission "LEVEL_SUPER_ADMIN"
       When "acct1" attempts to reset a disabled message
         """
-        {
-        	"msg": "cosmos.bank.v1beta1.MultiSend"
-        }
+        { "msg_type_urls": ["/cosmos.bank.v1beta1.MsgMultiSend"] }
         """
       Then expect an "msg enabled" error
+
+  Rule: all the messages the caller can reset are reset when no message is given
+
+    Example: caller has permission for some messages
+      Given "acct1" has permission to trip circuit breaker for "/cosmos.bank.v1beta1.MsgSend"
+      And "/cosmos.bank.v1beta1.MsgSend" is disabled
+      And "/cosmos.bank.v1beta1.MsgMultiSend" is disabled
+      When "acct1" attempts to reset a disabled message
+        """
+        {}
+        """
+      Then expect success
+      And expect that "/cosmos.bank.v1beta1.MsgSend" is enabled
+      And expect that "/cosmos.bank.v1beta1.MsgMultiSend" is disabled
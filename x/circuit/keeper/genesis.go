@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// InitGenesis initializes the circuit module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, genState *types.GenesisState) {
+	for _, accounts := range genState.AccountPermissions {
+		addr, err := sdk.AccAddressFromBech32(accounts.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.Permissions.Set(ctx, addr, *accounts.Permissions); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, url := range genState.DisabledTypeUrls {
+		if err := k.DisableList.Set(ctx, url); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the circuit module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
+	genState := types.DefaultGenesisState()
+
+	err := k.Permissions.Walk(ctx, nil, func(addr []byte, perms types.Permissions) bool {
+		genState.AccountPermissions = append(genState.AccountPermissions, &types.GenesisAccountPermissions{
+			Address:     sdk.AccAddress(addr).String(),
+			Permissions: &perms,
+		})
+		return false
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		panic(err)
+	}
+
+	err = k.DisableList.Walk(ctx, nil, func(url string) bool {
+		genState.DisabledTypeUrls = append(genState.DisabledTypeUrls, url)
+		return false
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		panic(err)
+	}
+
+	return genState
+}
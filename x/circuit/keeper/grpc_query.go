@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Account returns the circuit breaker permissions of an account.
+func (k Keeper) Account(ctx context.Context, req *types.QueryAccountRequest) (*types.AccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	perms, err := k.GetPermissions(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.AccountResponse{Permission: &perms}, nil
+}
+
+// Accounts returns the circuit breaker permissions of all the accounts.
+func (k Keeper) Accounts(ctx context.Context, req *types.QueryAccountsRequest) (*types.AccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	results, pageRes, err := query.CollectionPaginate[[]byte, types.Permissions](ctx, k.Permissions, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]*types.GenesisAccountPermissions, len(results))
+	for i, result := range results {
+		perms := result.Value
+		accounts[i] = &types.GenesisAccountPermissions{
+			Address:     sdk.AccAddress(result.Key).String(),
+			Permissions: &perms,
+		}
+	}
+
+	return &types.AccountsResponse{Accounts: accounts, Pagination: pageRes}, nil
+}
+
+// DisabledList returns the Msg type URLs whose processing is paused.
+func (k Keeper) DisabledList(ctx context.Context, req *types.QueryDisabledListRequest) (*types.DisabledListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var msgTypeURLs []string
+	err := k.DisableList.Walk(ctx, nil, func(msgTypeURL string) bool {
+		msgTypeURLs = append(msgTypeURLs, msgTypeURL)
+		return false
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		return nil, err
+	}
+
+	return &types.DisabledListResponse{DisabledList: msgTypeURLs}, nil
+}
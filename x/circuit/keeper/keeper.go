@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// Keeper defines the circuit module's keeper. It stores the circuit breaker
+// permissions of the accounts and the Msg type URLs whose processing is
+// paused.
+type Keeper struct {
+	storeService store.KVStoreService
+
+	// authority is the account allowed to take all the circuit breaker
+	// actions, usually the gov module account.
+	authority sdk.AccAddress
+
+	Schema      collections.Schema
+	Permissions collections.Map[[]byte, types.Permissions]
+	DisableList collections.KeySet[string]
+}
+
+// NewKeeper constructs a new circuit Keeper instance.
+func NewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService, authority string) Keeper {
+	auth, err := sdk.AccAddressFromBech32(authority)
+	if err != nil {
+		panic(err)
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		storeService: storeService,
+		authority:    auth,
+		Permissions: collections.NewMap(
+			sb,
+			types.AccountPermissionPrefix,
+			"permissions",
+			collections.BytesKey,
+			codec.CollValue[types.Permissions](cdc),
+		),
+		DisableList: collections.NewKeySet(
+			sb,
+			types.DisableListPrefix,
+			"disable_list",
+			collections.StringKey,
+		),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the circuit module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority.String()
+}
+
+// GetPermissions returns the circuit breaker permissions of the given account.
+// The authority has the LEVEL_SUPER_ADMIN permissions, and accounts without
+// permissions have the LEVEL_NONE_UNSPECIFIED ones.
+func (k Keeper) GetPermissions(ctx context.Context, addr sdk.AccAddress) (types.Permissions, error) {
+	if addr.Equals(k.authority) {
+		return types.Permissions{Level: types.Permissions_LEVEL_SUPER_ADMIN}, nil
+	}
+
+	perms, err := k.Permissions.Get(ctx, addr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Permissions{Level: types.Permissions_LEVEL_NONE_UNSPECIFIED}, nil
+	}
+
+	return perms, err
+}
+
+// IsAllowed reports whether the processing of the Msg with the given type URL
+// is allowed, i.e. whether its circuit breaker has not been tripped.
+func (k Keeper) IsAllowed(ctx context.Context, msgURL string) (bool, error) {
+	// the circuit breaker must remain resettable whatever the disable list holds
+	if strings.HasPrefix(msgURL, types.CircuitMsgTypeURLPrefix) {
+		return true, nil
+	}
+
+	disabled, err := k.DisableList.Has(ctx, msgURL)
+	if err != nil || disabled {
+		return false, err
+	}
+
+	disabled, err = k.DisableList.Has(ctx, types.DisableAllMsgsTypeURL)
+	if err != nil {
+		return false, err
+	}
+
+	return !disabled, nil
+}
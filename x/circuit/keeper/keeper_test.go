@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/regen-network/gocuke"
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type baseFixture struct {
+	t   gocuke.TestingT
+	err error
+
+	ctx       context.Context
+	cdc       codec.Codec
+	k         Keeper
+	msgServer types.MsgServer
+}
+
+func initFixture(t gocuke.TestingT) *baseFixture {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	types.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	k := NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), authtypes.NewModuleAddress(govtypes.ModuleName).String())
+
+	return &baseFixture{
+		t:         t,
+		ctx:       testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test")),
+		cdc:       encCfg.Codec,
+		k:         k,
+		msgServer: NewMsgServerImpl(k),
+	}
+}
+
+// addr returns the address of the account with the given name.
+func (s *baseFixture) addr(name string) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(name)))
+}
+
+func (s *baseFixture) HasPermission(a, b string) {
+	level, ok := types.Permissions_Level_value[b]
+	assert.Assert(s.t, ok)
+	assert.NilError(s.t, s.k.Permissions.Set(s.ctx, s.addr(a), types.Permissions{Level: types.Permissions_Level(level)}))
+}
+
+func (s *baseFixture) HasNoPermissions(a string) {
+	assert.NilError(s.t, s.k.Permissions.Remove(s.ctx, s.addr(a)))
+}
+
+func (s *baseFixture) HasPermissionToTripCircuitBreakerFor(a, b string) {
+	perms := types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: strings.Split(b, ",")}
+	assert.NilError(s.t, s.k.Permissions.Set(s.ctx, s.addr(a), perms))
+}
+
+func (s *baseFixture) IsDisabled(a string) {
+	assert.NilError(s.t, s.k.DisableList.Set(s.ctx, a))
+}
+
+func (s *baseFixture) ExpectSuccess() {
+	assert.NilError(s.t, s.err)
+}
+
+func (s *baseFixture) ExpectAnError(a string) {
+	assert.ErrorContains(s.t, s.err, a)
+}
+
+func (s *baseFixture) ExpectThatHasPermission(a, b string) {
+	perms, err := s.k.GetPermissions(s.ctx, s.addr(a))
+	assert.NilError(s.t, err)
+	assert.Equal(s.t, b, perms.Level.String())
+}
+
+func (s *baseFixture) ExpectThatHasNoPermissions(a string) {
+	has, err := s.k.Permissions.Has(s.ctx, s.addr(a))
+	assert.NilError(s.t, err)
+	assert.Assert(s.t, !has)
+}
+
+func (s *baseFixture) ExpectThatIsDisabled(a string) {
+	isAllowed, err := s.k.IsAllowed(s.ctx, a)
+	assert.NilError(s.t, err)
+	assert.Assert(s.t, !isAllowed)
+}
+
+func (s *baseFixture) ExpectThatIsEnabled(a string) {
+	isAllowed, err := s.k.IsAllowed(s.ctx, a)
+	assert.NilError(s.t, err)
+	assert.Assert(s.t, isAllowed)
+}
@@ -5,10 +5,11 @@ import (
 
 	"github.com/regen-network/gocuke"
 	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
 )
 
 func TestAuthorize(t *testing.T) {
-	t.Skip("TODO: uncomment this after implementing")
 	gocuke.NewRunner(t, &authorizeSuite{}).Path("../features/msg_authorize.feature").Run()
 }
 
@@ -16,30 +17,26 @@ type authorizeSuite struct {
 	*baseFixture
 }
 
-func (s *authorizeSuite) Before(t *testing.T) {
+func (s *authorizeSuite) Before(t gocuke.TestingT) {
 	s.baseFixture = initFixture(t)
 }
 
-func (s *authorizeSuite) HasPermission(a, b string) {
-	panic("PENDING")
-}
-
-func (s *authorizeSuite) HasNoPermissions(a string) {
-	panic("PENDING")
-}
-
 func (s *authorizeSuite) AttemptsToGrantThePermissions(a, b string, c gocuke.DocString) {
-	panic("PENDING")
+	s.authorize(s.addr(a).String(), b, c)
 }
 
-func (s *authorizeSuite) ExpectSuccess() {
-	assert.NilError(s.t, s.err)
+func (s *authorizeSuite) TheAuthorityAttemptsToGrantThePermissions(a string, b gocuke.DocString) {
+	s.authorize(s.k.GetAuthority(), a, b)
 }
 
-func (s *authorizeSuite) ExpectAnError(a string) {
-	assert.Error(s.t, s.err, a)
+func (s *authorizeSuite) AttemptsToRevokeThePermissions(a, b string, c gocuke.DocString) {
+	s.authorize(s.addr(a).String(), b, c)
 }
 
-func (s *authorizeSuite) ExpectThatHasNoPermissions(a string) {
-	panic("PENDING")
+func (s *authorizeSuite) authorize(granter, grantee string, permissions gocuke.DocString) {
+	var perms types.Permissions
+	assert.NilError(s.t, s.cdc.UnmarshalJSON([]byte(permissions.Content), &perms))
+
+	msg := types.NewMsgAuthorizeCircuitBreaker(granter, s.addr(grantee).String(), &perms)
+	_, s.err = s.msgServer.AuthorizeCircuitBreaker(s.ctx, msg)
 }
@@ -0,0 +1,202 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the circuit MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// AuthorizeCircuitBreaker grants, or revokes, the circuit breaker permissions
+// of an account. Only super admins can authorize other accounts.
+func (srv msgServer) AuthorizeCircuitBreaker(goCtx context.Context, msg *types.MsgAuthorizeCircuitBreaker) (*types.MsgAuthorizeCircuitBreakerResponse, error) {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid granter address: %s", err)
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address: %s", err)
+	}
+
+	perms, err := srv.GetPermissions(goCtx, granter)
+	if err != nil {
+		return nil, err
+	}
+
+	if perms.Level != types.Permissions_LEVEL_SUPER_ADMIN {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only super admins can authorize circuit breaker actions")
+	}
+
+	if msg.Permissions == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "permissions cannot be nil")
+	}
+
+	if err := msg.Permissions.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if msg.Permissions.Level == types.Permissions_LEVEL_NONE_UNSPECIFIED {
+		err = srv.Permissions.Remove(goCtx, grantee)
+	} else {
+		err = srv.Permissions.Set(goCtx, grantee, *msg.Permissions)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuthorizeCircuitBreaker,
+			sdk.NewAttribute(types.AttributeKeyGranter, msg.Granter),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee),
+			sdk.NewAttribute(types.AttributeKeyPermission, msg.Permissions.String()),
+		),
+	)
+
+	return &types.MsgAuthorizeCircuitBreakerResponse{Success: true}, nil
+}
+
+// TripCircuitBreaker pauses the processing of the given Msg type URLs, or of
+// all the Msgs if none is given.
+func (srv msgServer) TripCircuitBreaker(goCtx context.Context, msg *types.MsgTripCircuitBreaker) (*types.MsgTripCircuitBreakerResponse, error) {
+	perms, err := srv.authorityPermissions(goCtx, msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	msgTypeURLs := msg.MsgTypeUrls
+	if len(msgTypeURLs) == 0 {
+		msgTypeURLs = []string{types.DisableAllMsgsTypeURL}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, msgTypeURL := range msgTypeURLs {
+		if strings.HasPrefix(msgTypeURL, types.CircuitMsgTypeURLPrefix) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cannot trip the circuit breaker of %s", msgTypeURL)
+		}
+
+		if !perms.CanTrip(msgTypeURL) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account does not have permission to trip circuit breaker for %s", msgTypeURL)
+		}
+
+		disabled, err := srv.DisableList.Has(goCtx, msgTypeURL)
+		if err != nil {
+			return nil, err
+		}
+
+		if disabled {
+			return nil, errorsmod.Wrap(types.ErrMsgDisabled, msgTypeURL)
+		}
+
+		if err := srv.DisableList.Set(goCtx, msgTypeURL); err != nil {
+			return nil, err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTripCircuitBreaker,
+				sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+				sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msgTypeURL),
+			),
+		)
+	}
+
+	return &types.MsgTripCircuitBreakerResponse{Success: true}, nil
+}
+
+// ResetCircuitBreaker resumes the processing of the given Msg type URLs, or of
+// all the paused Msgs the account is allowed to reset if none is given.
+func (srv msgServer) ResetCircuitBreaker(goCtx context.Context, msg *types.MsgResetCircuitBreaker) (*types.MsgResetCircuitBreakerResponse, error) {
+	perms, err := srv.authorityPermissions(goCtx, msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	msgTypeURLs := msg.MsgTypeUrls
+	if len(msgTypeURLs) == 0 {
+		err := srv.DisableList.Walk(goCtx, nil, func(msgTypeURL string) bool {
+			if perms.CanTrip(msgTypeURL) {
+				msgTypeURLs = append(msgTypeURLs, msgTypeURL)
+			}
+			return false
+		})
+		if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+			return nil, err
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, msgTypeURL := range msgTypeURLs {
+		if strings.HasPrefix(msgTypeURL, types.CircuitMsgTypeURLPrefix) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cannot trip the circuit breaker of %s", msgTypeURL)
+		}
+
+		if !perms.CanTrip(msgTypeURL) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account does not have permission to reset circuit breaker for %s", msgTypeURL)
+		}
+
+		disabled, err := srv.DisableList.Has(goCtx, msgTypeURL)
+		if err != nil {
+			return nil, err
+		}
+
+		if !disabled {
+			return nil, errorsmod.Wrap(types.ErrMsgEnabled, msgTypeURL)
+		}
+
+		if err := srv.DisableList.Remove(goCtx, msgTypeURL); err != nil {
+			return nil, err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeResetCircuitBreaker,
+				sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+				sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msgTypeURL),
+			),
+		)
+	}
+
+	return &types.MsgResetCircuitBreakerResponse{Success: true}, nil
+}
+
+// authorityPermissions returns the permissions of the account tripping or
+// resetting the circuit breaker, which must have some.
+func (srv msgServer) authorityPermissions(ctx context.Context, authority string) (types.Permissions, error) {
+	addr, err := sdk.AccAddressFromBech32(authority)
+	if err != nil {
+		return types.Permissions{}, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	perms, err := srv.GetPermissions(ctx, addr)
+	if err != nil {
+		return types.Permissions{}, err
+	}
+
+	if perms.Level == types.Permissions_LEVEL_NONE_UNSPECIFIED {
+		return types.Permissions{}, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "account does not have circuit breaker permissions")
+	}
+
+	return perms, nil
+}
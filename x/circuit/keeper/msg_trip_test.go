@@ -5,10 +5,11 @@ import (
 
 	"github.com/regen-network/gocuke"
 	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
 )
 
 func TestTrip(t *testing.T) {
-	t.Skip("TODO: uncomment this after implementing")
 	gocuke.NewRunner(t, &tripSuite{}).Path("../features/msg_trip.feature").Run()
 }
 
@@ -16,30 +17,22 @@ type tripSuite struct {
 	*baseFixture
 }
 
-func (s *tripSuite) Before(t *testing.T) {
+func (s *tripSuite) Before(t gocuke.TestingT) {
 	s.baseFixture = initFixture(t)
 }
 
-func (s *tripSuite) HasPermission(a, b string) {
-	panic("PENDING")
-}
-
-func (s *tripSuite) HasNoPermissions(a string) {
-	panic("PENDING")
+func (s *tripSuite) AttemptsToDisableMsgExecution(a string, b gocuke.DocString) {
+	s.trip(s.addr(a).String(), b)
 }
 
-func (s *tripSuite) AttemptsToTripCircuit(a, b string, c gocuke.DocString) {
-	panic("PENDING")
+func (s *tripSuite) TheAuthorityAttemptsToDisableMsgExecution(a gocuke.DocString) {
+	s.trip(s.k.GetAuthority(), a)
 }
 
-func (s *tripSuite) ExpectSuccess() {
-	assert.NilError(s.t, s.err)
-}
-
-func (s *tripSuite) ExpectAnError(a string) {
-	assert.Error(s.t, s.err, a)
-}
+func (s *tripSuite) trip(authority string, msgTypeURLs gocuke.DocString) {
+	var msg types.MsgTripCircuitBreaker
+	assert.NilError(s.t, s.cdc.UnmarshalJSON([]byte(msgTypeURLs.Content), &msg))
 
-func (s *tripSuite) ExpectThatHasNoPermissions(a string) {
-	panic("PENDING")
+	msg.Authority = authority
+	_, s.err = s.msgServer.TripCircuitBreaker(s.ctx, &msg)
 }
@@ -0,0 +1,17 @@
+package types
+
+import "cosmossdk.io/errors"
+
+var (
+	// ErrMsgDisabled is returned when a Msg type URL whose processing has
+	// already been paused is disabled again.
+	ErrMsgDisabled = errors.Register(ModuleName, 2, "msg disabled")
+
+	// ErrMsgEnabled is returned when a Msg type URL whose processing has not
+	// been paused is reset.
+	ErrMsgEnabled = errors.Register(ModuleName, 3, "msg enabled")
+
+	// ErrCircuitBreakerTripped is returned when the execution of a Msg whose
+	// processing has been paused is attempted.
+	ErrCircuitBreakerTripped = errors.Register(ModuleName, 4, "circuit breaker tripped")
+)
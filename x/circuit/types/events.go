@@ -0,0 +1,14 @@
+package types
+
+// circuit module event types
+const (
+	EventTypeAuthorizeCircuitBreaker = "authorize_circuit_breaker"
+	EventTypeTripCircuitBreaker      = "trip_circuit_breaker"
+	EventTypeResetCircuitBreaker     = "reset_circuit_breaker"
+
+	AttributeKeyGranter    = "granter"
+	AttributeKeyGrantee    = "grantee"
+	AttributeKeyPermission = "permission"
+	AttributeKeyAuthority  = "authority"
+	AttributeKeyMsgTypeURL = "msg_type_url"
+)
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesisState returns the default genesis state of the circuit module,
+// without any permission nor disabled Msg type URL.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic validation of the circuit genesis state.
+func (gs *GenesisState) Validate() error {
+	seen := make(map[string]struct{}, len(gs.AccountPermissions))
+	for _, a := range gs.AccountPermissions {
+		if a == nil {
+			return fmt.Errorf("account permissions cannot be nil")
+		}
+
+		if _, err := sdk.AccAddressFromBech32(a.Address); err != nil {
+			return fmt.Errorf("invalid account address %s: %w", a.Address, err)
+		}
+
+		if _, ok := seen[a.Address]; ok {
+			return fmt.Errorf("duplicate permissions for account %s", a.Address)
+		}
+		seen[a.Address] = struct{}{}
+
+		if a.Permissions == nil {
+			return fmt.Errorf("permissions of account %s cannot be nil", a.Address)
+		}
+
+		if err := a.Permissions.Validate(); err != nil {
+			return fmt.Errorf("invalid permissions of account %s: %w", a.Address, err)
+		}
+	}
+
+	for _, url := range gs.DisabledTypeUrls {
+		if url == "" {
+			return fmt.Errorf("disabled type url cannot be empty")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "circuit"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// DisableAllMsgsTypeURL is the entry of the disable list pausing the
+	// processing of every Msg but the x/circuit ones, so that the circuit
+	// breaker can still be reset.
+	DisableAllMsgsTypeURL = "*"
+
+	// CircuitMsgTypeURLPrefix is the prefix of the type URLs of the x/circuit
+	// Msgs, whose circuit breakers can't be tripped.
+	CircuitMsgTypeURLPrefix = "/cosmos.circuit."
+)
+
+var (
+	// AccountPermissionPrefix is the prefix of the circuit breaker permissions
+	// of the accounts.
+	// - 0x01<account_address_bytes>: Permissions
+	AccountPermissionPrefix = collections.NewPrefix(1)
+
+	// DisableListPrefix is the prefix of the disabled Msg type URLs.
+	// - 0x02<msg_type_url>: <empty value>
+	DisableListPrefix = collections.NewPrefix(2)
+)
@@ -0,0 +1,70 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_, _, _ sdk.Msg = &MsgAuthorizeCircuitBreaker{}, &MsgTripCircuitBreaker{}, &MsgResetCircuitBreaker{}
+	// For amino support.
+	_, _, _ legacytx.LegacyMsg = &MsgAuthorizeCircuitBreaker{}, &MsgTripCircuitBreaker{}, &MsgResetCircuitBreaker{}
+)
+
+// NewMsgAuthorizeCircuitBreaker creates a new MsgAuthorizeCircuitBreaker instance.
+func NewMsgAuthorizeCircuitBreaker(granter, grantee string, permission *Permissions) *MsgAuthorizeCircuitBreaker {
+	return &MsgAuthorizeCircuitBreaker{
+		Granter:     granter,
+		Grantee:     grantee,
+		Permissions: permission,
+	}
+}
+
+// GetSigners returns the granter of the permissions.
+func (msg MsgAuthorizeCircuitBreaker) GetSigners() []sdk.AccAddress {
+	granter, _ := sdk.AccAddressFromBech32(msg.Granter)
+	return []sdk.AccAddress{granter}
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgAuthorizeCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgTripCircuitBreaker creates a new MsgTripCircuitBreaker instance.
+func NewMsgTripCircuitBreaker(authority string, urls []string) *MsgTripCircuitBreaker {
+	return &MsgTripCircuitBreaker{
+		Authority:   authority,
+		MsgTypeUrls: urls,
+	}
+}
+
+// GetSigners returns the account tripping the circuit breaker.
+func (msg MsgTripCircuitBreaker) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgTripCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// NewMsgResetCircuitBreaker creates a new MsgResetCircuitBreaker instance.
+func NewMsgResetCircuitBreaker(authority string, urls []string) *MsgResetCircuitBreaker {
+	return &MsgResetCircuitBreaker{
+		Authority:   authority,
+		MsgTypeUrls: urls,
+	}
+}
+
+// GetSigners returns the account resetting the circuit breaker.
+func (msg MsgResetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgResetCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
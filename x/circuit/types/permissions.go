@@ -0,0 +1,38 @@
+package types
+
+import "fmt"
+
+// Validate checks that limit_type_urls is only set, and non-empty, for the
+// LEVEL_SOME_MSGS level.
+func (p Permissions) Validate() error {
+	if _, ok := Permissions_Level_name[int32(p.Level)]; !ok {
+		return fmt.Errorf("unknown permission level %d", p.Level)
+	}
+
+	if p.Level == Permissions_LEVEL_SOME_MSGS {
+		if len(p.LimitTypeUrls) == 0 {
+			return fmt.Errorf("limit_type_urls must be set with %s", Permissions_LEVEL_SOME_MSGS)
+		}
+	} else if len(p.LimitTypeUrls) != 0 {
+		return fmt.Errorf("limit_type_urls can only be set with %s", Permissions_LEVEL_SOME_MSGS)
+	}
+
+	return nil
+}
+
+// CanTrip reports whether the permissions allow tripping or resetting the
+// circuit breaker of the given Msg type URL.
+func (p Permissions) CanTrip(msgTypeURL string) bool {
+	switch p.Level {
+	case Permissions_LEVEL_SUPER_ADMIN, Permissions_LEVEL_ALL_MSGS:
+		return true
+	case Permissions_LEVEL_SOME_MSGS:
+		for _, url := range p.LimitTypeUrls {
+			if url == msgTypeURL {
+				return true
+			}
+		}
+	}
+
+	return false
+}
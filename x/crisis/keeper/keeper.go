@@ -2,7 +2,7 @@ package keeper
 
 import (
 	"fmt"
-	"time"
+	"sync"
 
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/log"
@@ -30,8 +30,20 @@ type Keeper struct {
 	feeCollectorName string // name of the FeeCollector ModuleAccount
 
 	addressCodec address.Codec
+
+	// queryContextFn creates a read-only context on a committed height, it is
+	// required to run asynchronous invariant checks.
+	queryContextFn QueryContextFn
+	// running tracks the asynchronous invariant checks in progress by full route.
+	running *sync.Map
+	// asyncWg waits for the asynchronous invariant checks in progress.
+	asyncWg *sync.WaitGroup
 }
 
+// QueryContextFn returns a read-only context on the committed state at the
+// given height, such as baseapp.BaseApp.CreateQueryContext.
+type QueryContextFn func(height int64, prove bool) (sdk.Context, error)
+
 // NewKeeper creates a new Keeper object
 func NewKeeper(
 	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, invCheckPeriod uint,
@@ -46,6 +58,8 @@ func NewKeeper(
 		feeCollectorName: feeCollectorName,
 		authority:        authority,
 		addressCodec:     ac,
+		running:          &sync.Map{},
+		asyncWg:          &sync.WaitGroup{},
 	}
 }
 
@@ -65,6 +79,19 @@ func (k *Keeper) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
 	k.routes = append(k.routes, invarRoute)
 }
 
+// RegisterRouteWithSchedule registers an invariant which, in addition to the
+// checks of all invariants, is checked periodically following the given schedule.
+func (k *Keeper) RegisterRouteWithSchedule(moduleName, route string, invar sdk.Invariant, schedule types.InvarSchedule) {
+	invarRoute := types.NewScheduledInvarRoute(moduleName, route, invar, schedule)
+	k.routes = append(k.routes, invarRoute)
+}
+
+// SetQueryContextFn sets the function used to create the contexts on which the
+// asynchronous invariant checks are run.
+func (k *Keeper) SetQueryContextFn(fn QueryContextFn) {
+	k.queryContextFn = fn
+}
+
 // Routes - return the keeper's invariant routes
 func (k *Keeper) Routes() []types.InvarRoute {
 	return k.routes
@@ -84,7 +111,7 @@ func (k *Keeper) Invariants() []sdk.Invariant {
 func (k *Keeper) AssertInvariants(ctx sdk.Context) {
 	logger := k.Logger(ctx)
 
-	start := time.Now()
+	start := ctx.Clock().Now()
 	invarRoutes := k.Routes()
 	n := len(invarRoutes)
 	for i, ir := range invarRoutes {
@@ -100,7 +127,7 @@ func (k *Keeper) AssertInvariants(ctx sdk.Context) {
 		}
 	}
 
-	diff := time.Since(start)
+	diff := ctx.Clock().Now().Sub(start)
 	logger.Info("asserted all invariants", "duration", diff, "height", ctx.BlockHeight())
 }
 
@@ -62,3 +62,48 @@ func TestAssertInvariants(t *testing.T) {
 	keeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "", true })
 	require.Panics(t, func() { keeper.AssertInvariants(testCtx.Ctx) })
 }
+
+func TestCheckScheduledInvariants(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	supplyKeeper := crisistestutil.NewMockSupplyKeeper(ctrl)
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(crisis.AppModuleBasic{})
+	keeper := keeper.NewKeeper(encCfg.Codec, key, 0, supplyKeeper, "", "", addresscodec.NewBech32Codec("cosmos"))
+
+	keeper.RegisterRouteWithSchedule("testModule", "testRoute", func(sdk.Context) (string, bool) { return "", true }, types.InvarSchedule{Period: 3})
+	require.NotPanics(t, func() { keeper.CheckScheduledInvariants(testCtx.Ctx.WithBlockHeight(2)) })
+	require.Panics(t, func() { keeper.CheckScheduledInvariants(testCtx.Ctx.WithBlockHeight(3)) })
+}
+
+func TestCheckScheduledInvariantsAsync(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	supplyKeeper := crisistestutil.NewMockSupplyKeeper(ctrl)
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(crisis.AppModuleBasic{})
+	keeper := keeper.NewKeeper(encCfg.Codec, key, 0, supplyKeeper, "", "", addresscodec.NewBech32Codec("cosmos"))
+
+	var checkedHeights []int64
+	keeper.RegisterRouteWithSchedule("testModule", "testRoute", func(ctx sdk.Context) (string, bool) {
+		checkedHeights = append(checkedHeights, ctx.BlockHeight())
+		return "broken", true
+	}, types.InvarSchedule{Period: 2, Async: true})
+
+	// without a query context function the check is skipped
+	require.NotPanics(t, func() { keeper.CheckScheduledInvariants(testCtx.Ctx.WithBlockHeight(2)) })
+	keeper.WaitAsyncInvariants()
+	require.Empty(t, checkedHeights)
+
+	keeper.SetQueryContextFn(func(height int64, _ bool) (sdk.Context, error) {
+		return testCtx.Ctx.WithBlockHeight(height), nil
+	})
+
+	// a broken invariant checked asynchronously does not panic
+	require.NotPanics(t, func() { keeper.CheckScheduledInvariants(testCtx.Ctx.WithBlockHeight(3)) })
+	require.NotPanics(t, func() { keeper.CheckScheduledInvariants(testCtx.Ctx.WithBlockHeight(4)) })
+	keeper.WaitAsyncInvariants()
+	require.Equal(t, []int64{3}, checkedHeights)
+}
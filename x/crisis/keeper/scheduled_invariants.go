@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// CheckScheduledInvariants checks the registered invariants whose periodic check
+// is due at the current block height. Synchronous checks panic if the invariant
+// is broken, asynchronous checks are started in the background.
+func (k *Keeper) CheckScheduledInvariants(ctx sdk.Context) {
+	for _, ir := range k.Routes() {
+		if !ir.IsDue(ctx.BlockHeight()) {
+			continue
+		}
+
+		if ir.Schedule.Async {
+			k.checkInvariantAsync(ctx, ir)
+			continue
+		}
+
+		invCtx, _ := ctx.CacheContext()
+		if res, stop := k.checkInvariant(invCtx, ir); stop {
+			panic(fmt.Errorf("invariant broken: %s\n"+
+				"\tCRITICAL please submit the following transaction:\n"+
+				"\t\t tx crisis invariant-broken %s %s", res, ir.ModuleName, ir.Route))
+		}
+	}
+}
+
+// WaitAsyncInvariants blocks until all the asynchronous invariant checks in
+// progress are done.
+func (k *Keeper) WaitAsyncInvariants() {
+	k.asyncWg.Wait()
+}
+
+// checkInvariant runs the invariant and reports the duration of the check.
+func (k *Keeper) checkInvariant(ctx sdk.Context, ir types.InvarRoute) (string, bool) {
+	start := ctx.Clock().Now()
+	defer func() {
+		telemetry.MeasureDurationWithLabels(
+			[]string{types.ModuleName, "invariant", "duration"},
+			ctx.Clock().Now().Sub(start),
+			[]metrics.Label{telemetry.NewLabel("route", ir.FullRoute())},
+		)
+	}()
+
+	return ir.Invar(ctx)
+}
+
+// checkInvariantAsync checks the invariant in the background against the state
+// of the last committed block. A check is skipped if the previous check of the
+// same invariant is still in progress.
+func (k *Keeper) checkInvariantAsync(ctx sdk.Context, ir types.InvarRoute) {
+	logger := k.Logger(ctx)
+
+	if k.queryContextFn == nil {
+		logger.Error("cannot check invariant asynchronously, no query context function set", "name", ir.FullRoute())
+		return
+	}
+
+	if _, running := k.running.LoadOrStore(ir.FullRoute(), struct{}{}); running {
+		logger.Info("skipping invariant check, previous check still in progress", "name", ir.FullRoute(), "height", ctx.BlockHeight())
+		return
+	}
+
+	height := ctx.BlockHeight() - 1
+	invCtx, err := k.queryContextFn(height, false)
+	if err != nil {
+		k.running.Delete(ir.FullRoute())
+		logger.Error("cannot check invariant asynchronously", "name", ir.FullRoute(), "height", height, "err", err)
+		return
+	}
+
+	k.asyncWg.Add(1)
+	go func() {
+		defer k.asyncWg.Done()
+		defer k.running.Delete(ir.FullRoute())
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Error("invariant check panicked", "name", ir.FullRoute(), "height", height, "err", r)
+			}
+		}()
+
+		res, broken := k.checkInvariant(invCtx, ir)
+		if broken {
+			telemetry.IncrCounterWithLabels(
+				[]string{types.ModuleName, "invariant", "broken"},
+				1,
+				[]metrics.Label{telemetry.NewLabel("route", ir.FullRoute())},
+			)
+			logger.Error("invariant broken", "name", ir.FullRoute(), "height", height, "res", res)
+			return
+		}
+
+		logger.Info("asserted invariant", "name", ir.FullRoute(), "height", height)
+	}()
+}
@@ -4,11 +4,25 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// InvarSchedule defines how often a registered invariant is checked outside of
+// the global invariant check period.
+type InvarSchedule struct {
+	// Period is the number of blocks between two checks of the invariant. A zero
+	// period disables the periodic check.
+	Period uint64
+	// Async runs the check in the background, against the state of the last
+	// committed block, instead of in the EndBlocker. A broken invariant checked
+	// asynchronously is logged and reported through telemetry but does not halt
+	// the chain.
+	Async bool
+}
+
 // invariant route
 type InvarRoute struct {
 	ModuleName string
 	Route      string
 	Invar      sdk.Invariant
+	Schedule   InvarSchedule
 }
 
 // NewInvarRoute - create an InvarRoute object
@@ -20,6 +34,18 @@ func NewInvarRoute(moduleName, route string, invar sdk.Invariant) InvarRoute {
 	}
 }
 
+// NewScheduledInvarRoute - create an InvarRoute object checked following the given schedule
+func NewScheduledInvarRoute(moduleName, route string, invar sdk.Invariant, schedule InvarSchedule) InvarRoute {
+	invarRoute := NewInvarRoute(moduleName, route, invar)
+	invarRoute.Schedule = schedule
+	return invarRoute
+}
+
+// IsDue returns true if the invariant has a periodic check due at the given height.
+func (i InvarRoute) IsDue(height int64) bool {
+	return i.Schedule.Period != 0 && height%int64(i.Schedule.Period) == 0
+}
+
 // get the full invariance route
 func (i InvarRoute) FullRoute() string {
 	return i.ModuleName + "/" + i.Route
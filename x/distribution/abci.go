@@ -31,3 +31,13 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) error {
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
 	return nil
 }
+
+// EndBlocker pays out the continuous funds due and auto-compounds the rewards
+// of a batch of delegations.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.ProcessContinuousFunds(ctx)
+	k.ProcessAutoCompounds(ctx)
+	return nil
+}
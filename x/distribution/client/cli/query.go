@@ -32,7 +32,11 @@ func GetQueryCmd(ac address.Codec) *cobra.Command {
 		GetCmdQueryValidatorCommission(),
 		GetCmdQueryValidatorSlashes(),
 		GetCmdQueryDelegatorRewards(ac),
+		GetCmdQueryDelegatorAutoCompounds(ac),
+		GetCmdQueryDelegatorValidatorWithdrawAddress(ac),
 		GetCmdQueryCommunityPool(),
+		GetCmdQueryContinuousFund(),
+		GetCmdQueryContinuousFunds(),
 	)
 
 	return distQueryCmd
@@ -365,3 +369,179 @@ $ %s query distribution community-pool
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryDelegatorAutoCompounds implements the query delegator auto-compounds command.
+func GetCmdQueryDelegatorAutoCompounds(ac address.Codec) *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "auto-compounds [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the validators whose delegation rewards are auto-compounded for a delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validators whose delegation rewards are auto-compounded for a delegator.
+
+Example:
+$ %s query distribution auto-compounds %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if _, err := ac.StringToBytes(args[0]); err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorAutoCompounds(
+				cmd.Context(),
+				&types.QueryDelegatorAutoCompoundsRequest{DelegatorAddress: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryDelegatorValidatorWithdrawAddress implements the query delegator
+// validator withdraw address command.
+func GetCmdQueryDelegatorValidatorWithdrawAddress(ac address.Codec) *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "validator-withdraw-addr [delegator-addr] [validator-addr]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the address receiving the rewards of a delegation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the address receiving the rewards of a delegation to a single validator.
+This is the delegator withdraw address unless another address is set for the validator.
+
+Example:
+$ %s query distribution validator-withdraw-addr %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixAccAddr, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if _, err := ac.StringToBytes(args[0]); err != nil {
+				return err
+			}
+
+			if _, err := sdk.ValAddressFromBech32(args[1]); err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorValidatorWithdrawAddress(
+				cmd.Context(),
+				&types.QueryDelegatorValidatorWithdrawAddressRequest{DelegatorAddress: args[0], ValidatorAddress: args[1]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryContinuousFund implements the query continuous fund command.
+func GetCmdQueryContinuousFund() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "continuous-fund [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a continuous fund paid from the community pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a continuous fund paid from the community pool by its id.
+
+Example:
+$ %s query distribution continuous-fund 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("continuous fund id %s not a valid uint, please input a valid id", args[0])
+			}
+
+			res, err := queryClient.ContinuousFund(cmd.Context(), &types.QueryContinuousFundRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryContinuousFunds implements the query continuous funds command.
+func GetCmdQueryContinuousFunds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "continuous-funds",
+		Args:  cobra.NoArgs,
+		Short: "Query all the continuous funds paid from the community pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the continuous funds paid from the community pool.
+
+Example:
+$ %s query distribution continuous-funds
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContinuousFunds(cmd.Context(), &types.QueryContinuousFundsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "continuous funds")
+	return cmd
+}
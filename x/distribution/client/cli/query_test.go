@@ -87,7 +87,7 @@ func (s *CLITestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", flags.FlagOutput)},
-			`{"community_tax":"0","base_proposer_reward":"0","bonus_proposer_reward":"0","withdraw_addr_enabled":false}`,
+			`{"community_tax":"0","base_proposer_reward":"0","bonus_proposer_reward":"0","withdraw_addr_enabled":false,"max_auto_compounds_per_block":0}`,
 		},
 		{
 			"text output",
@@ -95,6 +95,7 @@ func (s *CLITestSuite) TestGetCmdQueryParams() {
 			`base_proposer_reward: "0"
 bonus_proposer_reward: "0"
 community_tax: "0"
+max_auto_compounds_per_block: 0
 withdraw_addr_enabled: false`,
 		},
 	}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"cosmossdk.io/core/address"
@@ -20,6 +21,7 @@ import (
 var (
 	FlagCommission       = "commission"
 	FlagMaxMessagesPerTx = "max-msgs"
+	FlagSingleMsg        = "single-msg"
 )
 
 const (
@@ -39,7 +41,10 @@ func NewTxCmd(ac address.Codec) *cobra.Command {
 	distTxCmd.AddCommand(
 		NewWithdrawRewardsCmd(),
 		NewWithdrawAllRewardsCmd(),
+		NewWithdrawTokenizeShareRecordRewardCmd(),
 		NewSetWithdrawAddrCmd(ac),
+		NewSetValidatorWithdrawAddrCmd(ac),
+		NewSetAutoCompoundCmd(),
 		NewFundCommunityPoolCmd(),
 		NewDepositValidatorRewardsPoolCmd(),
 	)
@@ -121,7 +126,8 @@ $ %s tx distribution withdraw-rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 	return cmd
 }
 
-// NewWithdrawAllRewardsCmd returns a CLI command handler for creating a MsgWithdrawDelegatorReward transaction.
+// NewWithdrawAllRewardsCmd returns a CLI command handler for creating MsgWithdrawDelegatorReward transactions,
+// or a single MsgWithdrawAllDelegatorRewards transaction.
 func NewWithdrawAllRewardsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "withdraw-all-rewards",
@@ -129,11 +135,14 @@ func NewWithdrawAllRewardsCmd() *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Withdraw all rewards for a single delegator.
 Note that if you use this command with --%[2]s=%[3]s or --%[2]s=%[4]s, the %[5]s flag will automatically be set to 0.
+With --%[6]s, the rewards are withdrawn with a single message iterating the delegations on chain,
+in which case the transaction can also be generated offline.
 
 Example:
 $ %[1]s tx distribution withdraw-all-rewards --from mykey
+$ %[1]s tx distribution withdraw-all-rewards --%[6]s --from mykey
 `,
-				version.AppName, flags.FlagBroadcastMode, flags.BroadcastSync, flags.BroadcastAsync, FlagMaxMessagesPerTx,
+				version.AppName, flags.FlagBroadcastMode, flags.BroadcastSync, flags.BroadcastAsync, FlagMaxMessagesPerTx, FlagSingleMsg,
 			),
 		),
 		Args: cobra.NoArgs,
@@ -144,6 +153,11 @@ $ %[1]s tx distribution withdraw-all-rewards --from mykey
 			}
 			delAddr := clientCtx.GetFromAddress()
 
+			if singleMsg, _ := cmd.Flags().GetBool(FlagSingleMsg); singleMsg {
+				msg := types.NewMsgWithdrawAllDelegatorRewards(delAddr)
+				return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			}
+
 			// The transaction cannot be generated offline since it requires a query
 			// to get all the validators.
 			if clientCtx.Offline {
@@ -176,6 +190,44 @@ $ %[1]s tx distribution withdraw-all-rewards --from mykey
 	}
 
 	cmd.Flags().Int(FlagMaxMessagesPerTx, MaxMessagesPerTxDefault, "Limit the number of messages per tx (0 for unlimited)")
+	cmd.Flags().Bool(FlagSingleMsg, false, "Withdraw the rewards with a single MsgWithdrawAllDelegatorRewards message")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewWithdrawTokenizeShareRecordRewardCmd returns a CLI command handler for creating a MsgWithdrawTokenizeShareRecordReward transaction.
+func NewWithdrawTokenizeShareRecordRewardCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-tokenize-share-rewards [record-id]",
+		Short: "Withdraw the rewards of the delegation of a tokenize share record owned by the sender",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw the rewards of the delegation of a x/staking tokenize share record to its owner.
+
+Example:
+$ %s tx distribution withdraw-tokenize-share-rewards 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recordID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid tokenize share record id: %w", err)
+			}
+
+			msg := types.NewMsgWithdrawTokenizeShareRecordReward(clientCtx.GetFromAddress(), recordID)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -220,6 +272,101 @@ $ %s tx distribution set-withdraw-addr %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 	return cmd
 }
 
+// NewSetValidatorWithdrawAddrCmd returns a CLI command handler for creating a MsgSetValidatorWithdrawAddress transaction.
+func NewSetValidatorWithdrawAddrCmd(ac address.Codec) *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "set-validator-withdraw-addr [validator-addr] [withdraw-addr]",
+		Short: "change the withdraw address for rewards associated with a delegation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the withdraw address for rewards associated with a delegation to a single validator.
+If the withdraw address is omitted, it is removed and the rewards are withdrawn to the delegator withdraw address.
+
+Example:
+$ %[1]s tx distribution set-validator-withdraw-addr %[3]s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj %[2]s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
+$ %[1]s tx distribution set-validator-withdraw-addr %[3]s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey
+`,
+				version.AppName, bech32PrefixAccAddr, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var withdrawAddr sdk.AccAddress
+			if len(args) == 2 {
+				withdrawAddr, err = ac.StringToBytes(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgSetValidatorWithdrawAddress(delAddr, valAddr, withdrawAddr)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetAutoCompoundCmd returns a CLI command handler for creating a MsgSetAutoCompound transaction.
+func NewSetAutoCompoundCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "set-auto-compound [validator-addr] [true|false]",
+		Short: "enable or disable the auto-compounding of the rewards of a delegation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable or disable the auto-compounding of the rewards of a delegation.
+Auto-compounded rewards are periodically withdrawn and delegated back to the same validator.
+
+Example:
+$ %s tx distribution set-auto-compound %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj true --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoCompound(delAddr, valAddr, enabled)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewFundCommunityPoolCmd returns a CLI command handler for creating a MsgFundCommunityPool transaction.
 func NewFundCommunityPoolCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"bytes"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// HasAutoCompound returns true if the rewards of the delegation are auto-compounded.
+func (k Keeper) HasAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetAutoCompoundDelegationKey(delAddr, valAddr))
+}
+
+// SetAutoCompound enables the auto-compounding of the rewards of the delegation.
+func (k Keeper) SetAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAutoCompoundDelegationKey(delAddr, valAddr), []byte{})
+}
+
+// DeleteAutoCompound disables the auto-compounding of the rewards of the delegation.
+func (k Keeper) DeleteAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAutoCompoundDelegationKey(delAddr, valAddr))
+}
+
+// IterateAutoCompounds iterates over the delegations whose rewards are auto-compounded.
+func (k Keeper) IterateAutoCompounds(ctx sdk.Context, handler func(delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := storetypes.KVStorePrefixIterator(store, types.AutoCompoundDelegationPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		delAddr, valAddr := types.GetAutoCompoundDelegationAddresses(iter.Key())
+		if handler(delAddr, valAddr) {
+			break
+		}
+	}
+}
+
+// GetDelegatorAutoCompounds returns the validators of a delegator whose
+// delegation rewards are auto-compounded.
+func (k Keeper) GetDelegatorAutoCompounds(ctx sdk.Context, delAddr sdk.AccAddress) []sdk.ValAddress {
+	store := ctx.KVStore(k.storeKey)
+	iter := storetypes.KVStorePrefixIterator(store, types.GetAutoCompoundDelegationsPrefix(delAddr))
+	defer iter.Close()
+
+	var valAddrs []sdk.ValAddress
+	for ; iter.Valid(); iter.Next() {
+		_, valAddr := types.GetAutoCompoundDelegationAddresses(iter.Key())
+		valAddrs = append(valAddrs, valAddr)
+	}
+
+	return valAddrs
+}
+
+// ProcessAutoCompounds withdraws and re-delegates the rewards of at most
+// MaxAutoCompoundsPerBlock auto-compounded delegations. The delegations are
+// processed in a round-robin fashion, starting after the last delegation
+// processed in the previous block.
+func (k Keeper) ProcessAutoCompounds(ctx sdk.Context) {
+	limit := int(k.GetParams(ctx).MaxAutoCompoundsPerBlock)
+	if limit == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	autoCompoundStore := prefix.NewStore(store, types.AutoCompoundDelegationPrefix)
+	cursor := store.Get(types.AutoCompoundCursorKey)
+
+	// collect the keys first, as processing a delegation may delete its key
+	var keys [][]byte
+	collect := func(start, end []byte) {
+		iter := autoCompoundStore.Iterator(start, end)
+		defer iter.Close()
+		for ; iter.Valid() && len(keys) < limit; iter.Next() {
+			keys = append(keys, append(types.AutoCompoundDelegationPrefix, iter.Key()...))
+		}
+	}
+
+	if cursor == nil {
+		collect(nil, nil)
+	} else {
+		// the smallest key after the cursor is the cursor followed by a zero byte
+		next := append(bytes.Clone(cursor), 0x00)
+		collect(next, nil)
+		collect(nil, next)
+	}
+
+	if len(keys) == 0 {
+		store.Delete(types.AutoCompoundCursorKey)
+		return
+	}
+
+	for _, key := range keys {
+		delAddr, valAddr := types.GetAutoCompoundDelegationAddresses(key)
+		k.autoCompound(ctx, delAddr, valAddr)
+	}
+
+	store.Set(types.AutoCompoundCursorKey, keys[len(keys)-1][len(types.AutoCompoundDelegationPrefix):])
+}
+
+// autoCompound withdraws the rewards of a delegation and re-delegates them to
+// the same validator. The rewards are only re-delegated when the delegator is
+// its own withdraw address for the validator, otherwise they are simply
+// withdrawn. The record of a delegation which no longer exists is removed.
+func (k Keeper) autoCompound(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	if k.stakingKeeper.Delegation(ctx, delAddr, valAddr) == nil {
+		k.DeleteAutoCompound(ctx, delAddr, valAddr)
+		return
+	}
+
+	// changes are only committed when both the withdrawal and the delegation
+	// succeed
+	cacheCtx, write := ctx.CacheContext()
+
+	rewards, err := k.WithdrawDelegationRewards(cacheCtx, delAddr, valAddr)
+	if err != nil {
+		k.Logger(ctx).Error("failed to withdraw auto-compounded rewards", "delegator", delAddr.String(), "validator", valAddr.String(), "error", err)
+		return
+	}
+
+	amount := rewards.AmountOf(k.stakingKeeper.BondDenom(cacheCtx))
+	if amount.IsPositive() && k.GetDelegatorValidatorWithdrawAddr(cacheCtx, delAddr, valAddr).Equals(delAddr) {
+		validator, found := k.stakingKeeper.GetValidator(cacheCtx, valAddr)
+		if !found {
+			return
+		}
+
+		if _, err := k.stakingKeeper.Delegate(cacheCtx, delAddr, amount, stakingtypes.Unbonded, validator, true); err != nil {
+			k.Logger(ctx).Error("failed to delegate auto-compounded rewards", "delegator", delAddr.String(), "validator", valAddr.String(), "error", err)
+			return
+		}
+
+		cacheCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAutoCompound,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(k.stakingKeeper.BondDenom(cacheCtx), amount).String()),
+				sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			),
+		)
+	}
+
+	write()
+}
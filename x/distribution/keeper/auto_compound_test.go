@@ -0,0 +1,206 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestAutoCompoundStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 1})
+	addrs := simtestutil.CreateIncrementalAccounts(3)
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	delAddr := addrs[0]
+	valAddr0, valAddr1 := sdk.ValAddress(addrs[1]), sdk.ValAddress(addrs[2])
+
+	require.False(t, distrKeeper.HasAutoCompound(ctx, delAddr, valAddr0))
+	require.Empty(t, distrKeeper.GetDelegatorAutoCompounds(ctx, delAddr))
+
+	distrKeeper.SetAutoCompound(ctx, delAddr, valAddr0)
+	distrKeeper.SetAutoCompound(ctx, delAddr, valAddr1)
+	require.True(t, distrKeeper.HasAutoCompound(ctx, delAddr, valAddr0))
+	require.Equal(t, []sdk.ValAddress{valAddr0, valAddr1}, distrKeeper.GetDelegatorAutoCompounds(ctx, delAddr))
+
+	// the auto-compounds of other delegators are not returned
+	require.Empty(t, distrKeeper.GetDelegatorAutoCompounds(ctx, addrs[1]))
+
+	distrKeeper.DeleteAutoCompound(ctx, delAddr, valAddr0)
+	require.False(t, distrKeeper.HasAutoCompound(ctx, delAddr, valAddr0))
+	require.Equal(t, []sdk.ValAddress{valAddr1}, distrKeeper.GetDelegatorAutoCompounds(ctx, delAddr))
+}
+
+func TestProcessAutoCompounds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// reset fee pool
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+	require.NoError(t, distrKeeper.SetParams(ctx, disttypes.DefaultParams()))
+
+	// create validator with 50% commission
+	valAddr := sdk.ValAddress(valConsAddr0)
+	addr := sdk.AccAddress(valAddr)
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+
+	val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+
+	// delegation mock
+	del := stakingtypes.NewDelegation(addr, valAddr, val.DelegatorShares)
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del).AnyTimes()
+	stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom).AnyTimes()
+
+	// run the necessary hooks manually (given that we are not running an actual staking module)
+	err = distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr)
+	require.NoError(t, err)
+
+	distrKeeper.SetAutoCompound(ctx, addr, valAddr)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	initial := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}
+	distrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+
+	// the withdrawn rewards are delegated back to the validator
+	expRewards := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))}
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, addr, expRewards)
+	stakingKeeper.EXPECT().GetValidator(gomock.Any(), valAddr).Return(val, true)
+	stakingKeeper.EXPECT().Delegate(gomock.Any(), addr, initial.QuoRaw(2), stakingtypes.Unbonded, val, true).Return(math.LegacyZeroDec(), nil)
+
+	distrKeeper.ProcessAutoCompounds(ctx)
+	require.True(t, distrKeeper.HasAutoCompound(ctx, addr, valAddr))
+
+	// no rewards are delegated when the delegator has another withdraw address
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	distrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+
+	withdrawAddr := sdk.AccAddress([]byte("withdraw_address____"))
+	bankKeeper.EXPECT().BlockedAddr(withdrawAddr).Return(false)
+	require.NoError(t, distrKeeper.SetWithdrawAddr(ctx, addr, withdrawAddr))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, withdrawAddr, expRewards)
+
+	distrKeeper.ProcessAutoCompounds(ctx)
+	require.True(t, distrKeeper.HasAutoCompound(ctx, addr, valAddr))
+}
+
+func TestProcessAutoCompoundsLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 1})
+	addrs := simtestutil.CreateIncrementalAccounts(4)
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	params := disttypes.DefaultParams()
+	params.MaxAutoCompoundsPerBlock = 2
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+
+	valAddr := sdk.ValAddress(addrs[3])
+	for _, delAddr := range addrs[:3] {
+		distrKeeper.SetAutoCompound(ctx, delAddr, valAddr)
+	}
+
+	// the validator has no distribution info, so the rewards cannot be
+	// withdrawn and the records are kept
+	del := stakingtypes.NewDelegation(addrs[0], valAddr, math.LegacyOneDec())
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(nil).AnyTimes()
+
+	// the first block processes the first two records
+	gomock.InOrder(
+		stakingKeeper.EXPECT().Delegation(gomock.Any(), addrs[0], valAddr).Return(del),
+		stakingKeeper.EXPECT().Delegation(gomock.Any(), addrs[1], valAddr).Return(del),
+	)
+	distrKeeper.ProcessAutoCompounds(ctx)
+
+	// the second block resumes with the last record and wraps around; the
+	// record of a removed delegation is deleted
+	gomock.InOrder(
+		stakingKeeper.EXPECT().Delegation(gomock.Any(), addrs[2], valAddr).Return(nil),
+		stakingKeeper.EXPECT().Delegation(gomock.Any(), addrs[0], valAddr).Return(del),
+	)
+	distrKeeper.ProcessAutoCompounds(ctx)
+
+	require.True(t, distrKeeper.HasAutoCompound(ctx, addrs[0], valAddr))
+	require.True(t, distrKeeper.HasAutoCompound(ctx, addrs[1], valAddr))
+	require.False(t, distrKeeper.HasAutoCompound(ctx, addrs[2], valAddr))
+
+	// auto-compounding is disabled with a zero limit
+	params.MaxAutoCompoundsPerBlock = 0
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	distrKeeper.ProcessAutoCompounds(ctx)
+}
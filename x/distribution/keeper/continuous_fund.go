@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// GetNextContinuousFundID returns the identifier of the next continuous fund.
+func (k Keeper) GetNextContinuousFundID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextContinuousFundIDKey)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetNextContinuousFundID sets the identifier of the next continuous fund.
+func (k Keeper) SetNextContinuousFundID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	store.Set(types.NextContinuousFundIDKey, bz)
+}
+
+// GetContinuousFund returns a continuous fund by its identifier.
+func (k Keeper) GetContinuousFund(ctx sdk.Context, id uint64) (fund types.ContinuousFund, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetContinuousFundKey(id))
+	if bz == nil {
+		return fund, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &fund)
+	return fund, true
+}
+
+// SetContinuousFund sets a continuous fund.
+func (k Keeper) SetContinuousFund(ctx sdk.Context, fund types.ContinuousFund) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&fund)
+	store.Set(types.GetContinuousFundKey(fund.Id), bz)
+}
+
+// DeleteContinuousFund deletes a continuous fund.
+func (k Keeper) DeleteContinuousFund(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetContinuousFundKey(id))
+}
+
+// IterateContinuousFunds iterates over the continuous funds in the order of
+// their identifiers.
+func (k Keeper) IterateContinuousFunds(ctx sdk.Context, handler func(fund types.ContinuousFund) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := storetypes.KVStorePrefixIterator(store, types.ContinuousFundPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var fund types.ContinuousFund
+		k.cdc.MustUnmarshal(iter.Value(), &fund)
+		if handler(fund) {
+			break
+		}
+	}
+}
+
+// CreateContinuousFund creates a continuous fund paying amount from the
+// community pool to recipient every period blocks, the first payout being made
+// one period after the current block. It returns the identifier of the
+// continuous fund.
+func (k Keeper) CreateContinuousFund(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins, period uint64, endHeight int64) (uint64, error) {
+	id := k.GetNextContinuousFundID(ctx)
+	fund := types.NewContinuousFund(id, recipient, amount, period, ctx.BlockHeight()+int64(period), endHeight)
+	if err := fund.Validate(); err != nil {
+		return 0, err
+	}
+
+	k.SetContinuousFund(ctx, fund)
+	k.SetNextContinuousFundID(ctx, id+1)
+	return id, nil
+}
+
+// ProcessContinuousFunds pays out the continuous funds due at the current
+// height and removes the expired ones. A payout which cannot be covered by the
+// community pool is skipped.
+func (k Keeper) ProcessContinuousFunds(ctx sdk.Context) {
+	var funds []types.ContinuousFund
+	k.IterateContinuousFunds(ctx, func(fund types.ContinuousFund) (stop bool) {
+		funds = append(funds, fund)
+		return false
+	})
+
+	height := ctx.BlockHeight()
+	for _, fund := range funds {
+		if fund.IsPayoutHeight(height) {
+			k.payContinuousFund(ctx, fund)
+		}
+
+		// the last payout, if any, is made at the end height
+		if fund.EndHeight != 0 && height >= fund.EndHeight {
+			k.DeleteContinuousFund(ctx, fund.Id)
+		}
+	}
+}
+
+// payContinuousFund pays out a continuous fund from the community pool.
+func (k Keeper) payContinuousFund(ctx sdk.Context, fund types.ContinuousFund) {
+	recipient, err := k.authKeeper.StringToBytes(fund.Recipient)
+	if err != nil {
+		k.Logger(ctx).Error("invalid continuous fund recipient", "id", fund.Id, "recipient", fund.Recipient, "error", err)
+		return
+	}
+
+	// changes are only committed when the payout succeeds
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.DistributeFromFeePool(cacheCtx, fund.Amount, recipient); err != nil {
+		k.Logger(ctx).Error("failed to pay out continuous fund", "id", fund.Id, "recipient", fund.Recipient, "amount", fund.Amount.String(), "error", err)
+		return
+	}
+
+	cacheCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeContinuousFundPayout,
+			sdk.NewAttribute(types.AttributeKeyContinuousFund, strconv.FormatUint(fund.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, fund.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, fund.Amount.String()),
+		),
+	)
+
+	write()
+}
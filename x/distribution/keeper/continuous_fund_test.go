@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestProcessContinuousFunds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 10})
+	addrs := simtestutil.CreateIncrementalAccounts(2)
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().StringToBytes(gomock.Any()).DoAndReturn(
+		func(addr string) ([]byte, error) { return sdk.AccAddressFromBech32(addr) },
+	).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// fund the community pool
+	feePool := disttypes.InitialFeePool()
+	feePool.CommunityPool = sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 25))
+	distrKeeper.SetFeePool(ctx, feePool)
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	// a continuous fund paid every 2 blocks until height 14, and a continuous
+	// fund paid every 3 blocks which never expires
+	id, err := distrKeeper.CreateContinuousFund(ctx, addrs[0], amount, 2, 14)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), id)
+	id, err = distrKeeper.CreateContinuousFund(ctx, addrs[1], amount, 3, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), id)
+
+	_, err = distrKeeper.CreateContinuousFund(ctx, addrs[0], amount, 0, 0)
+	require.ErrorIs(t, err, disttypes.ErrInvalidContinuousFund)
+	_, err = distrKeeper.CreateContinuousFund(ctx, addrs[0], amount, 10, 15)
+	require.ErrorIs(t, err, disttypes.ErrInvalidContinuousFund)
+
+	// no payout is due before the first period elapsed
+	distrKeeper.ProcessContinuousFunds(ctx.WithBlockHeight(11))
+
+	// height 12: first payout of the first continuous fund
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, addrs[0], amount)
+	distrKeeper.ProcessContinuousFunds(ctx.WithBlockHeight(12))
+
+	// height 13: first payout of the second continuous fund
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, addrs[1], amount)
+	distrKeeper.ProcessContinuousFunds(ctx.WithBlockHeight(13))
+
+	// height 14: the community pool cannot cover the last payout of the first
+	// continuous fund, which is skipped and removed
+	distrKeeper.ProcessContinuousFunds(ctx.WithBlockHeight(14))
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 5)), distrKeeper.GetFeePoolCommunityCoins(ctx))
+
+	_, found := distrKeeper.GetContinuousFund(ctx, 1)
+	require.False(t, found)
+	_, found = distrKeeper.GetContinuousFund(ctx, 2)
+	require.True(t, found)
+	require.Equal(t, uint64(3), distrKeeper.GetNextContinuousFundID(ctx))
+}
@@ -10,8 +10,10 @@ import (
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
@@ -471,6 +473,158 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestWithdrawAllDelegationRewards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// reset fee pool
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+	distrKeeper.SetParams(ctx, disttypes.DefaultParams())
+
+	addr := sdk.AccAddress(valConsAddr2)
+
+	// no delegations to withdraw from
+	stakingKeeper.EXPECT().IterateDelegations(gomock.Any(), addr, gomock.Any())
+	_, err := distrKeeper.WithdrawAllDelegationRewards(ctx, addr)
+	require.ErrorIs(t, err, disttypes.ErrNoDelegationExists)
+
+	// create two validators without commission, delegated to by the same delegator
+	var dels []stakingtypes.Delegation
+	var vals []stakingtypes.Validator
+	for _, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk1} {
+		valAddr := sdk.ValAddress(pk.Address())
+		val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+		require.NoError(t, err)
+
+		del := stakingtypes.NewDelegation(addr, valAddr, val.DelegatorShares)
+		stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val).AnyTimes()
+		stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del).AnyTimes()
+
+		// run the necessary hooks manually (given that we are not running an actual staking module)
+		require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr))
+
+		dels = append(dels, del)
+		vals = append(vals, val)
+	}
+
+	stakingKeeper.EXPECT().IterateDelegations(gomock.Any(), addr, gomock.Any()).Do(
+		func(_ sdk.Context, _ sdk.AccAddress, fn func(int64, stakingtypes.DelegationI) bool) {
+			for i, del := range dels {
+				if fn(int64(i), del) {
+					return
+				}
+			}
+		},
+	)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards to both validators
+	initial := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}
+	for _, val := range vals {
+		distrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+	}
+
+	// the rewards of each delegation are sent to the delegator
+	expRewards := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial)}
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, disttypes.ModuleName, addr, expRewards).Times(2)
+
+	rewards, err := distrKeeper.WithdrawAllDelegationRewards(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.MulRaw(2))}, rewards)
+}
+
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// reset fee pool
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+	distrKeeper.SetParams(ctx, disttypes.DefaultParams())
+
+	// create a validator without commission, delegated to by a tokenize share record
+	owner := sdk.AccAddress(valConsAddr2)
+	valAddr := sdk.ValAddress(valConsAddr0)
+	record := stakingtypes.NewTokenizeShareRecord(1, valAddr, owner)
+	recordAddr := record.GetModuleAddress()
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+
+	del := stakingtypes.NewDelegation(recordAddr, valAddr, val.DelegatorShares)
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), recordAddr, valAddr).Return(del).AnyTimes()
+	stakingKeeper.EXPECT().GetTokenizeShareRecord(gomock.Any(), uint64(1)).Return(record, true).AnyTimes()
+	stakingKeeper.EXPECT().GetTokenizeShareRecord(gomock.Any(), uint64(2)).Return(stakingtypes.TokenizeShareRecord{}, false)
+
+	// run the necessary hooks manually (given that we are not running an actual staking module)
+	require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, recordAddr, valAddr))
+
+	// only the owner of an existing record can withdraw its rewards
+	_, err = distrKeeper.WithdrawTokenizeShareRecordReward(ctx, owner, 2)
+	require.ErrorIs(t, err, stakingtypes.ErrTokenizeShareRecordNotFound)
+	_, err = distrKeeper.WithdrawTokenizeShareRecordReward(ctx, recordAddr, 1)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards to the validator
+	initial := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	distrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+
+	// the rewards are withdrawn to the record account, which also holds the
+	// rewards withdrawn when the delegation was modified, and sent to the owner
+	expRewards := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial)}
+	recordBalance := expRewards.Add(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(5)))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, disttypes.ModuleName, recordAddr, expRewards)
+	bankKeeper.EXPECT().GetAllBalances(ctx, recordAddr).Return(recordBalance)
+	bankKeeper.EXPECT().SendCoins(ctx, recordAddr, owner, recordBalance)
+
+	rewards, err := distrKeeper.WithdrawTokenizeShareRecordReward(ctx, owner, 1)
+	require.NoError(t, err)
+	require.Equal(t, recordBalance, rewards)
+}
+
 func TestCalculateRewardsAfterManySlashesInSameBlock(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
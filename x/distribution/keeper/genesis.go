@@ -88,6 +88,43 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 		k.SetValidatorSlashEvent(ctx, valAddr, evt.Height, evt.Period, evt.ValidatorSlashEvent)
 	}
+	for _, dvwi := range data.DelegatorValidatorWithdrawInfos {
+		delegatorAddress, err := k.authKeeper.StringToBytes(dvwi.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		valAddr, err := sdk.ValAddressFromBech32(dvwi.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		withdrawAddress, err := k.authKeeper.StringToBytes(dvwi.WithdrawAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetDelegatorValidatorWithdrawAddr(ctx, delegatorAddress, valAddr, withdrawAddress)
+	}
+	nextContinuousFundID := data.NextContinuousFundId
+	for _, fund := range data.ContinuousFunds {
+		k.SetContinuousFund(ctx, fund)
+		if fund.Id >= nextContinuousFundID {
+			nextContinuousFundID = fund.Id + 1
+		}
+	}
+	if nextContinuousFundID == 0 {
+		nextContinuousFundID = 1
+	}
+	k.SetNextContinuousFundID(ctx, nextContinuousFundID)
+	for _, acd := range data.AutoCompoundDelegations {
+		delegatorAddress, err := k.authKeeper.StringToBytes(acd.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		valAddr, err := sdk.ValAddressFromBech32(acd.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetAutoCompound(ctx, delegatorAddress, valAddr)
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -193,5 +230,39 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	acds := make([]types.AutoCompoundDelegation, 0)
+	k.IterateAutoCompounds(ctx,
+		func(del sdk.AccAddress, val sdk.ValAddress) (stop bool) {
+			acds = append(acds, types.AutoCompoundDelegation{
+				DelegatorAddress: del.String(),
+				ValidatorAddress: val.String(),
+			})
+			return false
+		},
+	)
+
+	dvwis := make([]types.DelegatorValidatorWithdrawInfo, 0)
+	k.IterateDelegatorValidatorWithdrawAddrs(ctx,
+		func(del sdk.AccAddress, val sdk.ValAddress, addr sdk.AccAddress) (stop bool) {
+			dvwis = append(dvwis, types.DelegatorValidatorWithdrawInfo{
+				DelegatorAddress: del.String(),
+				ValidatorAddress: val.String(),
+				WithdrawAddress:  addr.String(),
+			})
+			return false
+		},
+	)
+
+	gs := types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	gs.AutoCompoundDelegations = acds
+	gs.DelegatorValidatorWithdrawInfos = dvwis
+
+	funds := make([]types.ContinuousFund, 0)
+	k.IterateContinuousFunds(ctx, func(fund types.ContinuousFund) (stop bool) {
+		funds = append(funds, fund)
+		return false
+	})
+	gs.ContinuousFunds = funds
+	gs.NextContinuousFundId = k.GetNextContinuousFundID(ctx)
+	return gs
 }
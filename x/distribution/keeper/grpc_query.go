@@ -297,6 +297,36 @@ func (k Querier) DelegatorWithdrawAddress(c context.Context, req *types.QueryDel
 	return &types.QueryDelegatorWithdrawAddressResponse{WithdrawAddress: withdrawAddr.String()}, nil
 }
 
+// DelegatorValidatorWithdrawAddress queries Query/DelegatorValidatorWithdrawAddress
+func (k Querier) DelegatorValidatorWithdrawAddress(c context.Context, req *types.QueryDelegatorValidatorWithdrawAddressRequest) (*types.QueryDelegatorValidatorWithdrawAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	delAdr, err := k.authKeeper.StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	valAdr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	withdrawAddr := k.GetDelegatorValidatorWithdrawAddr(ctx, delAdr, valAdr)
+
+	return &types.QueryDelegatorValidatorWithdrawAddressResponse{WithdrawAddress: withdrawAddr.String()}, nil
+}
+
 // CommunityPool queries the community pool coins
 func (k Querier) CommunityPool(c context.Context, req *types.QueryCommunityPoolRequest) (*types.QueryCommunityPoolResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -304,3 +334,72 @@ func (k Querier) CommunityPool(c context.Context, req *types.QueryCommunityPoolR
 
 	return &types.QueryCommunityPoolResponse{Pool: pool}, nil
 }
+
+// DelegatorAutoCompounds queries the validators of a delegator whose delegation rewards are auto-compounded
+func (k Querier) DelegatorAutoCompounds(c context.Context, req *types.QueryDelegatorAutoCompoundsRequest) (*types.QueryDelegatorAutoCompoundsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+	delAdr, err := k.authKeeper.StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	validators := make([]string, 0)
+	for _, valAddr := range k.GetDelegatorAutoCompounds(ctx, delAdr) {
+		validators = append(validators, valAddr.String())
+	}
+
+	return &types.QueryDelegatorAutoCompoundsResponse{Validators: validators}, nil
+}
+
+// ContinuousFund queries a continuous fund by its id
+func (k Querier) ContinuousFund(c context.Context, req *types.QueryContinuousFundRequest) (*types.QueryContinuousFundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "continuous fund id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	fund, found := k.GetContinuousFund(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "continuous fund %d doesn't exist", req.Id)
+	}
+
+	return &types.QueryContinuousFundResponse{ContinuousFund: fund}, nil
+}
+
+// ContinuousFunds queries all the continuous funds
+func (k Querier) ContinuousFunds(c context.Context, req *types.QueryContinuousFundsRequest) (*types.QueryContinuousFundsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	fundsStore := prefix.NewStore(store, types.ContinuousFundPrefix)
+
+	var funds []types.ContinuousFund
+	pageRes, err := query.Paginate(fundsStore, req.Pagination, func(_, value []byte) error {
+		var fund types.ContinuousFund
+		if err := k.cdc.Unmarshal(value, &fund); err != nil {
+			return err
+		}
+
+		funds = append(funds, fund)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryContinuousFundsResponse{ContinuousFunds: funds, Pagination: pageRes}, nil
+}
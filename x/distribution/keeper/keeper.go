@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
@@ -11,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Keeper of the distribution store
@@ -80,6 +82,33 @@ func (k Keeper) SetWithdrawAddr(ctx sdk.Context, delegatorAddr, withdrawAddr sdk
 	return nil
 }
 
+// SetValidatorWithdrawAddr sets a new address that will receive the rewards of
+// a delegation upon withdrawal, instead of the delegator withdraw address.
+func (k Keeper) SetValidatorWithdrawAddr(ctx sdk.Context, delegatorAddr sdk.AccAddress, valAddr sdk.ValAddress, withdrawAddr sdk.AccAddress) error {
+	if k.bankKeeper.BlockedAddr(withdrawAddr) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", withdrawAddr)
+	}
+
+	if !k.GetWithdrawAddrEnabled(ctx) {
+		return types.ErrSetWithdrawAddrDisabled
+	}
+
+	if k.stakingKeeper.Delegation(ctx, delegatorAddr, valAddr) == nil {
+		return errorsmod.Wrapf(types.ErrNoDelegationExists, "delegator %s, validator %s", delegatorAddr, valAddr)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetValidatorWithdrawAddress,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, withdrawAddr.String()),
+		),
+	)
+
+	k.SetDelegatorValidatorWithdrawAddr(ctx, delegatorAddr, valAddr, withdrawAddr)
+	return nil
+}
+
 // withdraw rewards from a delegation
 func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	val := k.stakingKeeper.Validator(ctx, valAddr)
@@ -103,6 +132,78 @@ func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddres
 	return rewards, nil
 }
 
+// WithdrawAllDelegationRewards withdraws the rewards of all the delegations of
+// a delegator and returns their sum.
+func (k Keeper) WithdrawAllDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress) (sdk.Coins, error) {
+	// collect the validators first, as withdrawing reinitializes the delegations
+	var valAddrs []sdk.ValAddress
+	k.stakingKeeper.IterateDelegations(ctx, delAddr, func(_ int64, del stakingtypes.DelegationI) (stop bool) {
+		valAddrs = append(valAddrs, del.GetValidatorAddr())
+		return false
+	})
+
+	if len(valAddrs) == 0 {
+		return nil, types.ErrNoDelegationExists
+	}
+
+	total := sdk.NewCoins()
+	for _, valAddr := range valAddrs {
+		rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		total = total.Add(rewards...)
+	}
+
+	return total, nil
+}
+
+// WithdrawTokenizeShareRecordReward withdraws the rewards of the delegation of
+// a tokenize share record, and sends them to the owner of the record along with
+// the rewards already withdrawn to the record account, e.g. when the delegation
+// was modified. It returns the rewards sent to the owner.
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx sdk.Context, ownerAddr sdk.AccAddress, recordID uint64) (sdk.Coins, error) {
+	record, found := k.stakingKeeper.GetTokenizeShareRecord(ctx, recordID)
+	if !found {
+		return nil, errorsmod.Wrapf(stakingtypes.ErrTokenizeShareRecordNotFound, "id %d", recordID)
+	}
+
+	if record.Owner != ownerAddr.String() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of tokenize share record %d", ownerAddr, recordID)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	recordAddr := record.GetModuleAddress()
+	if k.stakingKeeper.Delegation(ctx, recordAddr, valAddr) != nil {
+		if _, err := k.WithdrawDelegationRewards(ctx, recordAddr, valAddr); err != nil {
+			return nil, err
+		}
+	}
+
+	rewards := k.bankKeeper.GetAllBalances(ctx, recordAddr)
+	if !rewards.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, recordAddr, ownerAddr, rewards); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawTokenizeShareReward,
+			sdk.NewAttribute(types.AttributeKeyRecordID, strconv.FormatUint(recordID, 10)),
+			sdk.NewAttribute(types.AttributeKeyOwner, ownerAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+		),
+	)
+
+	return rewards, nil
+}
+
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	// fetch validator accumulated commission
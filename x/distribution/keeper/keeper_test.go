@@ -20,6 +20,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func TestSetWithdrawAddr(t *testing.T) {
@@ -68,6 +69,68 @@ func TestSetWithdrawAddr(t *testing.T) {
 	require.Error(t, distrKeeper.SetWithdrawAddr(ctx, delegatorAddr, distrAcc.GetAddress()))
 }
 
+func TestSetValidatorWithdrawAddr(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Time: time.Now()})
+	addrs := simtestutil.CreateIncrementalAccounts(5)
+
+	delegatorAddr := addrs[0]
+	withdrawAddr := addrs[1]
+	valWithdrawAddr := addrs[2]
+	valAddr0, valAddr1 := sdk.ValAddress(addrs[3]), sdk.ValAddress(addrs[4])
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	bankKeeper.EXPECT().BlockedAddr(withdrawAddr).Return(false).AnyTimes()
+	bankKeeper.EXPECT().BlockedAddr(valWithdrawAddr).Return(false).AnyTimes()
+	bankKeeper.EXPECT().BlockedAddr(distrAcc.GetAddress()).Return(true).AnyTimes()
+
+	del := stakingtypes.NewDelegation(delegatorAddr, valAddr0, math.LegacyOneDec())
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), delegatorAddr, valAddr0).Return(del).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), delegatorAddr, valAddr1).Return(nil).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	params := types.DefaultParams()
+	params.WithdrawAddrEnabled = false
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+
+	err := distrKeeper.SetValidatorWithdrawAddr(ctx, delegatorAddr, valAddr0, valWithdrawAddr)
+	require.ErrorIs(t, err, types.ErrSetWithdrawAddrDisabled)
+
+	params.WithdrawAddrEnabled = true
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+
+	require.Error(t, distrKeeper.SetValidatorWithdrawAddr(ctx, delegatorAddr, valAddr0, distrAcc.GetAddress()))
+	require.ErrorIs(t, distrKeeper.SetValidatorWithdrawAddr(ctx, delegatorAddr, valAddr1, valWithdrawAddr), types.ErrNoDelegationExists)
+
+	require.NoError(t, distrKeeper.SetWithdrawAddr(ctx, delegatorAddr, withdrawAddr))
+	require.NoError(t, distrKeeper.SetValidatorWithdrawAddr(ctx, delegatorAddr, valAddr0, valWithdrawAddr))
+
+	// the validator withdraw address takes precedence over the delegator withdraw address
+	require.Equal(t, valWithdrawAddr, distrKeeper.GetDelegatorValidatorWithdrawAddr(ctx, delegatorAddr, valAddr0))
+	require.Equal(t, withdrawAddr, distrKeeper.GetDelegatorValidatorWithdrawAddr(ctx, delegatorAddr, valAddr1))
+
+	// the validator withdraw address is removed with the delegation
+	require.NoError(t, distrKeeper.Hooks().BeforeDelegationRemoved(ctx, delegatorAddr, valAddr0))
+	require.Equal(t, withdrawAddr, distrKeeper.GetDelegatorValidatorWithdrawAddr(ctx, delegatorAddr, valAddr0))
+}
+
 func TestWithdrawValidatorCommission(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	key := storetypes.NewKVStoreKey(types.StoreKey)
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	"cosmossdk.io/errors"
 	"github.com/armon/go-metrics"
@@ -45,6 +46,45 @@ func (k msgServer) SetWithdrawAddress(goCtx context.Context, msg *types.MsgSetWi
 	return &types.MsgSetWithdrawAddressResponse{}, nil
 }
 
+func (k msgServer) SetValidatorWithdrawAddress(goCtx context.Context, msg *types.MsgSetValidatorWithdrawAddress) (*types.MsgSetValidatorWithdrawAddressResponse, error) {
+	delegatorAddress, err := k.authKeeper.StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// an empty withdraw address falls back to the delegator withdraw address
+	if msg.WithdrawAddress == "" {
+		k.DeleteDelegatorValidatorWithdrawAddr(ctx, delegatorAddress, valAddr)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSetValidatorWithdrawAddress,
+				sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+				sdk.NewAttribute(types.AttributeKeyWithdrawAddress, ""),
+			),
+		)
+
+		return &types.MsgSetValidatorWithdrawAddressResponse{}, nil
+	}
+
+	withdrawAddress, err := k.authKeeper.StringToBytes(msg.WithdrawAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid withdraw address: %s", err)
+	}
+
+	if err := k.SetValidatorWithdrawAddr(ctx, delegatorAddress, valAddr, withdrawAddress); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetValidatorWithdrawAddressResponse{}, nil
+}
+
 func (k msgServer) WithdrawDelegatorReward(goCtx context.Context, msg *types.MsgWithdrawDelegatorReward) (*types.MsgWithdrawDelegatorRewardResponse, error) {
 	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
 	if err != nil {
@@ -77,6 +117,48 @@ func (k msgServer) WithdrawDelegatorReward(goCtx context.Context, msg *types.Msg
 	return &types.MsgWithdrawDelegatorRewardResponse{Amount: amount}, nil
 }
 
+func (k msgServer) WithdrawAllDelegatorRewards(goCtx context.Context, msg *types.MsgWithdrawAllDelegatorRewards) (*types.MsgWithdrawAllDelegatorRewardsResponse, error) {
+	delegatorAddress, err := k.authKeeper.StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	amount, err := k.WithdrawAllDelegationRewards(ctx, delegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		for _, a := range amount {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "withdraw_reward"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	return &types.MsgWithdrawAllDelegatorRewardsResponse{Amount: amount}, nil
+}
+
+func (k msgServer) WithdrawTokenizeShareRecordReward(goCtx context.Context, msg *types.MsgWithdrawTokenizeShareRecordReward) (*types.MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	ownerAddress, err := k.authKeeper.StringToBytes(msg.OwnerAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	amount, err := k.Keeper.WithdrawTokenizeShareRecordReward(ctx, ownerAddress, msg.RecordId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawTokenizeShareRecordRewardResponse{Amount: amount}, nil
+}
+
 func (k msgServer) WithdrawValidatorCommission(goCtx context.Context, msg *types.MsgWithdrawValidatorCommission) (*types.MsgWithdrawValidatorCommissionResponse, error) {
 	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
 	if err != nil {
@@ -173,6 +255,88 @@ func (k msgServer) CommunityPoolSpend(goCtx context.Context, msg *types.MsgCommu
 	return &types.MsgCommunityPoolSpendResponse{}, nil
 }
 
+func (k msgServer) CommunityPoolMultiSpend(goCtx context.Context, msg *types.MsgCommunityPoolMultiSpend) (*types.MsgCommunityPoolMultiSpendResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if len(msg.Recipients) == 0 {
+		return nil, errors.Wrap(types.ErrEmptyProposalRecipient, "recipients cannot be empty")
+	}
+
+	recipients := make([]sdk.AccAddress, len(msg.Recipients))
+	for i, r := range msg.Recipients {
+		if err := validateAmount(r.Amount); err != nil {
+			return nil, err
+		}
+
+		recipient, err := k.authKeeper.StringToBytes(r.Recipient)
+		if err != nil {
+			return nil, err
+		}
+
+		if k.bankKeeper.BlockedAddr(recipient) {
+			return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", r.Recipient)
+		}
+
+		recipients[i] = recipient
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	logger := k.Logger(ctx)
+	for i, r := range msg.Recipients {
+		if err := k.DistributeFromFeePool(ctx, r.Amount, recipients[i]); err != nil {
+			return nil, err
+		}
+
+		logger.Info("transferred from the community pool to recipient", "amount", r.Amount.String(), "recipient", r.Recipient)
+	}
+
+	return &types.MsgCommunityPoolMultiSpendResponse{}, nil
+}
+
+func (k msgServer) CreateContinuousFund(goCtx context.Context, msg *types.MsgCreateContinuousFund) (*types.MsgCreateContinuousFundResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := validateAmount(msg.Amount); err != nil {
+		return nil, err
+	}
+
+	recipient, err := k.authKeeper.StringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", msg.Recipient)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	id, err := k.Keeper.CreateContinuousFund(ctx, recipient, msg.Amount, msg.Period, msg.EndHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateContinuousFundResponse{Id: id}, nil
+}
+
+func (k msgServer) CancelContinuousFund(goCtx context.Context, msg *types.MsgCancelContinuousFund) (*types.MsgCancelContinuousFundResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetContinuousFund(ctx, msg.Id); !found {
+		return nil, errors.Wrapf(types.ErrContinuousFundNotFound, "id %d", msg.Id)
+	}
+
+	k.DeleteContinuousFund(ctx, msg.Id)
+
+	return &types.MsgCancelContinuousFundResponse{}, nil
+}
+
 func (k msgServer) DepositValidatorRewardsPool(goCtx context.Context, msg *types.MsgDepositValidatorRewardsPool) (*types.MsgDepositValidatorRewardsPoolResponse, error) {
 	depositor, err := k.authKeeper.StringToBytes(msg.Depositor)
 	if err != nil {
@@ -211,6 +375,40 @@ func (k msgServer) DepositValidatorRewardsPool(goCtx context.Context, msg *types
 	return &types.MsgDepositValidatorRewardsPoolResponse{}, nil
 }
 
+func (k msgServer) SetAutoCompound(goCtx context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	delegatorAddress, err := k.authKeeper.StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Enabled {
+		if k.stakingKeeper.Delegation(ctx, delegatorAddress, valAddr) == nil {
+			return nil, errors.Wrapf(types.ErrNoDelegationExists, "delegator %s, validator %s", msg.DelegatorAddress, msg.ValidatorAddress)
+		}
+
+		k.Keeper.SetAutoCompound(ctx, delegatorAddress, valAddr)
+	} else {
+		k.DeleteAutoCompound(ctx, delegatorAddress, valAddr)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(msg.Enabled)),
+		),
+	)
+
+	return &types.MsgSetAutoCompoundResponse{}, nil
+}
+
 func (k *Keeper) validateAuthority(authority string) error {
 	if _, err := k.authKeeper.StringToBytes(authority); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
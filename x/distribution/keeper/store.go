@@ -45,6 +45,46 @@ func (k Keeper) IterateDelegatorWithdrawAddrs(ctx sdk.Context, handler func(del,
 	}
 }
 
+// GetDelegatorValidatorWithdrawAddr returns the address receiving the rewards
+// of a delegation, defaulting to the delegator withdraw address when no address
+// is set for the validator.
+func (k Keeper) GetDelegatorValidatorWithdrawAddr(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetDelegatorValidatorWithdrawAddrKey(delAddr, valAddr))
+	if b == nil {
+		return k.GetDelegatorWithdrawAddr(ctx, delAddr)
+	}
+	return sdk.AccAddress(b)
+}
+
+// SetDelegatorValidatorWithdrawAddr sets the address receiving the rewards of a delegation.
+func (k Keeper) SetDelegatorValidatorWithdrawAddr(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, withdrawAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDelegatorValidatorWithdrawAddrKey(delAddr, valAddr), withdrawAddr.Bytes())
+}
+
+// DeleteDelegatorValidatorWithdrawAddr deletes the address receiving the rewards
+// of a delegation.
+func (k Keeper) DeleteDelegatorValidatorWithdrawAddr(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDelegatorValidatorWithdrawAddrKey(delAddr, valAddr))
+}
+
+// IterateDelegatorValidatorWithdrawAddrs iterates over the per-validator
+// delegator withdraw addrs.
+func (k Keeper) IterateDelegatorValidatorWithdrawAddrs(ctx sdk.Context, handler func(del sdk.AccAddress, val sdk.ValAddress, addr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := storetypes.KVStorePrefixIterator(store, types.DelegatorValidatorWithdrawAddrPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		addr := sdk.AccAddress(iter.Value())
+		del, val := types.GetDelegatorValidatorWithdrawInfoAddresses(iter.Key())
+		if handler(del, val, addr) {
+			break
+		}
+	}
+}
+
 // get the global fee pool distribution info
 func (k Keeper) GetFeePool(ctx sdk.Context) (feePool types.FeePool) {
 	store := ctx.KVStore(k.storeKey)
@@ -41,16 +41,21 @@ func TestMigrateJSON(t *testing.T) {
 	require.NoError(t, err)
 
 	expected := `{
+	"auto_compound_delegations": [],
+	"continuous_funds": [],
 	"delegator_starting_infos": [],
+	"delegator_validator_withdraw_infos": [],
 	"delegator_withdraw_infos": [],
 	"fee_pool": {
 		"community_pool": []
 	},
+	"next_continuous_fund_id": "1",
 	"outstanding_rewards": [],
 	"params": {
 		"base_proposer_reward": "0.000000000000000000",
 		"bonus_proposer_reward": "0.000000000000000000",
 		"community_tax": "0.020000000000000000",
+		"max_auto_compounds_per_block": 100,
 		"withdraw_addr_enabled": true
 	},
 	"previous_proposer": "",
@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -63,6 +64,26 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &eventB)
 			return fmt.Sprintf("%v\n%v", eventA, eventB)
 
+		case bytes.Equal(kvA.Key[:1], types.AutoCompoundDelegationPrefix):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.AutoCompoundCursorKey):
+			delAddrA, valAddrA := types.GetAutoCompoundDelegationAddresses(append(types.AutoCompoundDelegationPrefix, kvA.Value...))
+			delAddrB, valAddrB := types.GetAutoCompoundDelegationAddresses(append(types.AutoCompoundDelegationPrefix, kvB.Value...))
+			return fmt.Sprintf("%v %v\n%v %v", delAddrA, valAddrA, delAddrB, valAddrB)
+
+		case bytes.Equal(kvA.Key[:1], types.DelegatorValidatorWithdrawAddrPrefix):
+			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.ContinuousFundPrefix):
+			var fundA, fundB types.ContinuousFund
+			cdc.MustUnmarshal(kvA.Value, &fundA)
+			cdc.MustUnmarshal(kvB.Value, &fundB)
+			return fmt.Sprintf("%v\n%v", fundA, fundB)
+
+		case bytes.Equal(kvA.Key[:1], types.NextContinuousFundIDKey):
+			return fmt.Sprintf("%v\n%v", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
 		default:
 			panic(fmt.Sprintf("invalid distribution key prefix %X", kvA.Key[:1]))
 		}
@@ -38,6 +38,7 @@ func TestDecodeDistributionStore(t *testing.T) {
 	historicalRewards := types.NewValidatorHistoricalRewards(decCoins, 100)
 	currentRewards := types.NewValidatorCurrentRewards(decCoins, 5)
 	slashEvent := types.NewValidatorSlashEvent(10, math.LegacyOneDec())
+	fund := types.NewContinuousFund(1, delAddr1, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)), 10, 20, 0)
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
@@ -50,6 +51,11 @@ func TestDecodeDistributionStore(t *testing.T) {
 			{Key: types.GetValidatorCurrentRewardsKey(valAddr1), Value: cdc.MustMarshal(&currentRewards)},
 			{Key: types.GetValidatorAccumulatedCommissionKey(valAddr1), Value: cdc.MustMarshal(&commission)},
 			{Key: types.GetValidatorSlashEventKeyPrefix(valAddr1, 13), Value: cdc.MustMarshal(&slashEvent)},
+			{Key: types.GetAutoCompoundDelegationKey(delAddr1, valAddr1), Value: []byte{}},
+			{Key: types.AutoCompoundCursorKey, Value: types.GetAutoCompoundDelegationKey(delAddr1, valAddr1)[1:]},
+			{Key: types.GetDelegatorValidatorWithdrawAddrKey(delAddr1, valAddr1), Value: delAddr1.Bytes()},
+			{Key: types.GetContinuousFundKey(1), Value: cdc.MustMarshal(&fund)},
+			{Key: types.NextContinuousFundIDKey, Value: sdk.Uint64ToBigEndian(2)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -67,6 +73,11 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"ValidatorCurrentRewards", fmt.Sprintf("%v\n%v", currentRewards, currentRewards)},
 		{"ValidatorAccumulatedCommission", fmt.Sprintf("%v\n%v", commission, commission)},
 		{"ValidatorSlashEvent", fmt.Sprintf("%v\n%v", slashEvent, slashEvent)},
+		{"AutoCompoundDelegation", fmt.Sprintf("%v\n%v", []byte{}, []byte{})},
+		{"AutoCompoundCursor", fmt.Sprintf("%v %v\n%v %v", delAddr1, valAddr1, delAddr1, valAddr1)},
+		{"DelegatorValidatorWithdrawAddr", fmt.Sprintf("%v\n%v", delAddr1, delAddr1)},
+		{"ContinuousFund", fmt.Sprintf("%v\n%v", fund, fund)},
+		{"NextContinuousFundID", "2\n2"},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -12,8 +12,9 @@ import (
 
 // Simulation parameter constants
 const (
-	CommunityTax    = "community_tax"
-	WithdrawEnabled = "withdraw_enabled"
+	CommunityTax             = "community_tax"
+	WithdrawEnabled          = "withdraw_enabled"
+	MaxAutoCompoundsPerBlock = "max_auto_compounds_per_block"
 )
 
 // GenCommunityTax randomized CommunityTax
@@ -26,6 +27,11 @@ func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
 }
 
+// GenMaxAutoCompoundsPerBlock returns a randomized MaxAutoCompoundsPerBlock parameter.
+func GenMaxAutoCompoundsPerBlock(r *rand.Rand) uint32 {
+	return uint32(r.Intn(100) + 1)
+}
+
 // RandomizedGenState generates a random GenesisState for distribution
 func RandomizedGenState(simState *module.SimulationState) {
 	var communityTax math.LegacyDec
@@ -40,11 +46,18 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { withdrawEnabled = GenWithdrawEnabled(r) },
 	)
 
+	var maxAutoCompoundsPerBlock uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxAutoCompoundsPerBlock, &maxAutoCompoundsPerBlock, simState.Rand,
+		func(r *rand.Rand) { maxAutoCompoundsPerBlock = GenMaxAutoCompoundsPerBlock(r) },
+	)
+
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
-			CommunityTax:        communityTax,
-			WithdrawAddrEnabled: withdrawEnabled,
+			CommunityTax:             communityTax,
+			WithdrawAddrEnabled:      withdrawEnabled,
+			MaxAutoCompoundsPerBlock: maxAutoCompoundsPerBlock,
 		},
 	}
 
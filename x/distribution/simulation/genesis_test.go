@@ -43,6 +43,7 @@ func TestRandomizedGenState(t *testing.T) {
 
 	require.Equal(t, dec1, distrGenesis.Params.CommunityTax)
 	require.Equal(t, true, distrGenesis.Params.WithdrawAddrEnabled)
+	require.Equal(t, uint32(1), distrGenesis.Params.MaxAutoCompoundsPerBlock)
 	require.Len(t, distrGenesis.DelegatorStartingInfos, 0)
 	require.Len(t, distrGenesis.DelegatorWithdrawInfos, 0)
 	require.Len(t, distrGenesis.ValidatorSlashEvents, 0)
@@ -24,6 +24,13 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/distribution/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgCommunityPoolSpend{}, "cosmos-sdk/distr/MsgCommunityPoolSpend")
 	legacy.RegisterAminoMsg(cdc, &MsgDepositValidatorRewardsPool{}, "cosmos-sdk/distr/MsgDepositValRewards")
+	legacy.RegisterAminoMsg(cdc, &MsgSetAutoCompound{}, "cosmos-sdk/distr/MsgSetAutoCompound")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawAllDelegatorRewards{}, "cosmos-sdk/distr/MsgWithdrawAllRewards")
+	legacy.RegisterAminoMsg(cdc, &MsgSetValidatorWithdrawAddress{}, "cosmos-sdk/distr/MsgSetValWithdrawAddr")
+	legacy.RegisterAminoMsg(cdc, &MsgCommunityPoolMultiSpend{}, "cosmos-sdk/distr/MsgPoolMultiSpend")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateContinuousFund{}, "cosmos-sdk/distr/MsgCreateContFund")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelContinuousFund{}, "cosmos-sdk/distr/MsgCancelContFund")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawTokenizeShareRecordReward{}, "cosmos-sdk/distr/MsgWithdrawTSRReward")
 
 	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/distribution/Params", nil)
 }
@@ -38,6 +45,13 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgCommunityPoolSpend{},
 		&MsgDepositValidatorRewardsPool{},
+		&MsgSetAutoCompound{},
+		&MsgWithdrawAllDelegatorRewards{},
+		&MsgSetValidatorWithdrawAddress{},
+		&MsgCommunityPoolMultiSpend{},
+		&MsgCreateContinuousFund{},
+		&MsgCancelContinuousFund{},
+		&MsgWithdrawTokenizeShareRecordReward{},
 	)
 
 	registry.RegisterImplementations(
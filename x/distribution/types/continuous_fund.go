@@ -0,0 +1,57 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewContinuousFund creates a new continuous fund paying amount to recipient
+// every period blocks, starting at startHeight.
+func NewContinuousFund(id uint64, recipient sdk.AccAddress, amount sdk.Coins, period uint64, startHeight, endHeight int64) ContinuousFund {
+	return ContinuousFund{
+		Id:          id,
+		Recipient:   recipient.String(),
+		Amount:      amount,
+		Period:      period,
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+	}
+}
+
+// IsPayoutHeight returns true if a payout of the continuous fund is due at the
+// given height.
+func (f ContinuousFund) IsPayoutHeight(height int64) bool {
+	if height < f.StartHeight || f.IsExpired(height) {
+		return false
+	}
+
+	return uint64(height-f.StartHeight)%f.Period == 0
+}
+
+// IsExpired returns true if no payout of the continuous fund is due at or
+// after the given height.
+func (f ContinuousFund) IsExpired(height int64) bool {
+	return f.EndHeight != 0 && height > f.EndHeight
+}
+
+// Validate performs a stateless validation of the continuous fund.
+func (f ContinuousFund) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(f.Recipient); err != nil {
+		return errorsmod.Wrapf(ErrInvalidContinuousFund, "invalid recipient: %s", err)
+	}
+
+	if !f.Amount.IsValid() || f.Amount.IsZero() {
+		return errorsmod.Wrapf(ErrInvalidContinuousFund, "invalid amount: %s", f.Amount)
+	}
+
+	if f.Period == 0 {
+		return errorsmod.Wrap(ErrInvalidContinuousFund, "period must be positive")
+	}
+
+	if f.EndHeight != 0 && f.EndHeight < f.StartHeight {
+		return errorsmod.Wrapf(ErrInvalidContinuousFund, "end height %d is before start height %d", f.EndHeight, f.StartHeight)
+	}
+
+	return nil
+}
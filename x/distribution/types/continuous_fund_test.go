@@ -0,0 +1,56 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestContinuousFundPayoutHeight(t *testing.T) {
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	fund := types.NewContinuousFund(1, sdk.AccAddress([]byte("recipient___________")), amount, 5, 10, 20)
+
+	for height, expected := range map[int64]bool{5: false, 9: false, 10: true, 12: false, 15: true, 20: true, 25: false} {
+		require.Equal(t, expected, fund.IsPayoutHeight(height), height)
+	}
+
+	require.False(t, fund.IsExpired(20))
+	require.True(t, fund.IsExpired(21))
+
+	fund.EndHeight = 0
+	require.True(t, fund.IsPayoutHeight(25))
+	require.False(t, fund.IsExpired(1000))
+}
+
+func TestContinuousFundValidate(t *testing.T) {
+	recipient := sdk.AccAddress([]byte("recipient___________"))
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	tests := []struct {
+		name  string
+		fund  types.ContinuousFund
+		valid bool
+	}{
+		{"valid", types.NewContinuousFund(1, recipient, amount, 5, 10, 20), true},
+		{"valid without end height", types.NewContinuousFund(1, recipient, amount, 5, 10, 0), true},
+		{"invalid recipient", types.ContinuousFund{Id: 1, Amount: amount, Period: 5, StartHeight: 10}, false},
+		{"zero amount", types.NewContinuousFund(1, recipient, sdk.NewCoins(), 5, 10, 0), false},
+		{"zero period", types.NewContinuousFund(1, recipient, amount, 0, 10, 0), false},
+		{"end height before start height", types.NewContinuousFund(1, recipient, amount, 5, 10, 9), false},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.fund.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidContinuousFund)
+			}
+		})
+	}
+}
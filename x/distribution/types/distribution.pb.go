@@ -37,6 +37,11 @@ type Params struct {
 	// in the x/distribution module's reward mechanism.
 	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward"` // Deprecated: Do not use.
 	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// max_auto_compounds_per_block defines the maximum number of auto-compounded
+	// delegations processed per block, zero disabling auto-compounding.
+	//
+	// Since: cosmos-sdk 0.50
+	MaxAutoCompoundsPerBlock uint32 `protobuf:"varint,5,opt,name=max_auto_compounds_per_block,json=maxAutoCompoundsPerBlock,proto3" json:"max_auto_compounds_per_block,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,6 +84,13 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetMaxAutoCompoundsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxAutoCompoundsPerBlock
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -622,6 +634,157 @@ func (m *CommunityPoolSpendProposalWithDeposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageInfo
 
+// CommunityPoolSpendRecipient defines a recipient of a community pool spend and
+// the amount sent to it.
+//
+// Since: cosmos-sdk 0.50
+type CommunityPoolSpendRecipient struct {
+	Recipient string                                   `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *CommunityPoolSpendRecipient) Reset()         { *m = CommunityPoolSpendRecipient{} }
+func (m *CommunityPoolSpendRecipient) String() string { return proto.CompactTextString(m) }
+func (*CommunityPoolSpendRecipient) ProtoMessage()    {}
+func (*CommunityPoolSpendRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{12}
+}
+func (m *CommunityPoolSpendRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityPoolSpendRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityPoolSpendRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommunityPoolSpendRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityPoolSpendRecipient.Merge(m, src)
+}
+func (m *CommunityPoolSpendRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityPoolSpendRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityPoolSpendRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityPoolSpendRecipient proto.InternalMessageInfo
+
+func (m *CommunityPoolSpendRecipient) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *CommunityPoolSpendRecipient) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// ContinuousFund defines a budget stream paying an amount from the community
+// pool to a recipient every period blocks, until it expires or is cancelled.
+//
+// Since: cosmos-sdk 0.50
+type ContinuousFund struct {
+	// id is the unique identifier of the continuous fund.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// recipient is the address receiving the payouts.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount paid out every period.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// period is the number of blocks between two payouts.
+	Period uint64 `protobuf:"varint,4,opt,name=period,proto3" json:"period,omitempty"`
+	// start_height is the height of the first payout.
+	StartHeight int64 `protobuf:"varint,5,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the height after which no payout is made and the continuous
+	// fund is removed. Zero means that the continuous fund never expires.
+	EndHeight int64 `protobuf:"varint,6,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *ContinuousFund) Reset()         { *m = ContinuousFund{} }
+func (m *ContinuousFund) String() string { return proto.CompactTextString(m) }
+func (*ContinuousFund) ProtoMessage()    {}
+func (*ContinuousFund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{13}
+}
+func (m *ContinuousFund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContinuousFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContinuousFund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContinuousFund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContinuousFund.Merge(m, src)
+}
+func (m *ContinuousFund) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContinuousFund) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContinuousFund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContinuousFund proto.InternalMessageInfo
+
+func (m *ContinuousFund) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ContinuousFund) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *ContinuousFund) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *ContinuousFund) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *ContinuousFund) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *ContinuousFund) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
@@ -635,6 +798,8 @@ func init() {
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
 	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
+	proto.RegisterType((*CommunityPoolSpendRecipient)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendRecipient")
+	proto.RegisterType((*ContinuousFund)(nil), "cosmos.distribution.v1beta1.ContinuousFund")
 }
 
 func init() {
@@ -642,70 +807,80 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1006 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x34, 0x89, 0xd3, 0x4c, 0xdb, 0x84, 0x4e, 0x7e, 0xd4, 0x71, 0x8b, 0x1d, 0x56, 0x2a,
-	0x84, 0x40, 0x6c, 0x52, 0x2e, 0x28, 0xe2, 0x12, 0xdb, 0xad, 0xe0, 0x42, 0xa3, 0x0d, 0xa2, 0x88,
-	0xcb, 0x6a, 0xbc, 0x3b, 0xb1, 0x47, 0xdd, 0x9d, 0x59, 0x66, 0x66, 0x9d, 0xe4, 0xc0, 0x3d, 0x70,
-	0x00, 0x6e, 0xa0, 0x9e, 0x2a, 0xb8, 0x54, 0x9c, 0x72, 0xc8, 0x8d, 0x7f, 0xa0, 0xe2, 0x54, 0x15,
-	0x09, 0x21, 0x0e, 0x29, 0x24, 0x87, 0x20, 0xfe, 0x0a, 0x34, 0x3b, 0xe3, 0x5d, 0x27, 0x84, 0x52,
-	0xa9, 0xb5, 0xb8, 0x24, 0x9e, 0xef, 0xcd, 0xbe, 0xef, 0x7d, 0xef, 0xbd, 0x79, 0x33, 0xb0, 0xe6,
-	0x73, 0x19, 0x71, 0x59, 0x0f, 0xa8, 0x54, 0x82, 0xb6, 0x13, 0x45, 0x39, 0xab, 0xf7, 0x56, 0xda,
-	0x44, 0xe1, 0x95, 0x13, 0x60, 0x2d, 0x16, 0x5c, 0x71, 0x74, 0xd5, 0xec, 0xaf, 0x9d, 0x30, 0xd9,
-	0xfd, 0xe5, 0x99, 0x0e, 0xef, 0xf0, 0x74, 0x5f, 0x5d, 0xff, 0x32, 0x9f, 0x94, 0x2b, 0x96, 0xa2,
-	0x8d, 0x25, 0xc9, 0x5c, 0xfb, 0x9c, 0x5a, 0x97, 0xe5, 0x79, 0x63, 0xf7, 0xcc, 0x87, 0xd6, 0xbf,
-	0x31, 0x5d, 0xc6, 0x11, 0x65, 0xbc, 0x9e, 0xfe, 0x35, 0x90, 0xf3, 0xe3, 0x08, 0x2c, 0xae, 0x63,
-	0x81, 0x23, 0x89, 0x36, 0xe1, 0x25, 0x9f, 0x47, 0x51, 0xc2, 0xa8, 0xda, 0xf1, 0x14, 0xde, 0x2e,
-	0x81, 0x05, 0xb0, 0x38, 0xd1, 0x58, 0x7b, 0x78, 0x50, 0x2d, 0xfc, 0x76, 0x50, 0x7d, 0xb5, 0x43,
-	0x55, 0x37, 0x69, 0xd7, 0x7c, 0x1e, 0x59, 0xaf, 0xf6, 0xdf, 0xb2, 0x0c, 0xee, 0xd6, 0xd5, 0x4e,
-	0x4c, 0x64, 0xad, 0x45, 0xfc, 0xc7, 0xfb, 0xcb, 0xd0, 0x92, 0xb6, 0x88, 0xff, 0xe0, 0x78, 0x6f,
-	0x09, 0xb8, 0x17, 0x33, 0xbf, 0x1f, 0xe2, 0x6d, 0x94, 0xc0, 0x19, 0x1d, 0xbb, 0x0e, 0x30, 0xe6,
-	0x92, 0x08, 0x4f, 0x90, 0x2d, 0x2c, 0x82, 0xd2, 0xb9, 0x94, 0xae, 0xf9, 0xdc, 0x74, 0x25, 0xe0,
-	0x22, 0x4d, 0xb0, 0x6e, 0xfd, 0xbb, 0xa9, 0x7b, 0xb4, 0x05, 0x67, 0xdb, 0x9c, 0x25, 0xf2, 0x1f,
-	0xbc, 0x23, 0x2f, 0x8e, 0x77, 0x3a, 0x65, 0x38, 0x45, 0x7c, 0x03, 0xce, 0x6e, 0x51, 0xd5, 0x0d,
-	0x04, 0xde, 0xf2, 0x70, 0x10, 0x08, 0x8f, 0x30, 0xdc, 0x0e, 0x49, 0x50, 0x1a, 0x5d, 0x00, 0x8b,
-	0xe7, 0xdd, 0xe9, 0xbe, 0x71, 0x2d, 0x08, 0xc4, 0x4d, 0x63, 0x5a, 0xbd, 0xfe, 0xc5, 0xf1, 0xde,
-	0xd2, 0xc2, 0x00, 0xf1, 0xf6, 0xc9, 0x96, 0x32, 0x25, 0x73, 0x7e, 0x01, 0xb0, 0xfc, 0x11, 0x0e,
-	0x69, 0x80, 0x15, 0x17, 0xef, 0x51, 0xa9, 0xb8, 0xa0, 0x3e, 0x0e, 0x0d, 0xb1, 0x44, 0x5f, 0x02,
-	0x78, 0xc5, 0x4f, 0xa2, 0x24, 0xc4, 0x8a, 0xf6, 0x88, 0xd5, 0xeb, 0x09, 0xac, 0x28, 0x2f, 0x81,
-	0x85, 0x91, 0xc5, 0x0b, 0x37, 0xae, 0xd9, 0x86, 0xad, 0xe9, 0x84, 0xf5, 0x1b, 0x4f, 0x2b, 0x6a,
-	0x72, 0xca, 0x1a, 0xef, 0xe8, 0x9c, 0xfc, 0xf0, 0xa4, 0xfa, 0xc6, 0xb3, 0xe5, 0x44, 0x7f, 0x23,
-	0x4d, 0xc5, 0x67, 0x73, 0x5a, 0x13, 0x8c, 0xab, 0x49, 0xd1, 0x6b, 0x70, 0x4a, 0x90, 0x4d, 0x22,
-	0x08, 0xf3, 0x89, 0xe7, 0xf3, 0x84, 0xa9, 0xb4, 0xea, 0x97, 0xdc, 0xc9, 0x0c, 0x6e, 0x6a, 0xd4,
-	0xf9, 0x1e, 0xc0, 0x2b, 0x99, 0xb0, 0x66, 0x22, 0x04, 0x61, 0xaa, 0xaf, 0x2a, 0x86, 0xe3, 0x46,
-	0x89, 0x1c, 0xb2, 0x88, 0x3e, 0x0d, 0x9a, 0x83, 0xc5, 0x98, 0x08, 0xca, 0x4d, 0x8f, 0x8e, 0xba,
-	0x76, 0xe5, 0x7c, 0x0b, 0x60, 0x25, 0x8b, 0x72, 0xcd, 0xb7, 0x9a, 0x49, 0xd0, 0xe4, 0x51, 0x44,
-	0xa5, 0xa4, 0x9c, 0xa1, 0x1e, 0x84, 0x7e, 0xb6, 0x1a, 0x72, 0xbc, 0x03, 0x4c, 0xce, 0x57, 0x00,
-	0x5e, 0xcd, 0x42, 0xbb, 0x9d, 0x28, 0xa9, 0x30, 0x0b, 0x28, 0xeb, 0xfc, 0x6f, 0x49, 0x74, 0xee,
-	0x01, 0x38, 0x9d, 0x45, 0xb4, 0x11, 0x62, 0xd9, 0xbd, 0xd9, 0x23, 0x4c, 0xa1, 0xd7, 0xe1, 0x4b,
-	0xbd, 0x3e, 0xec, 0xd9, 0x34, 0x83, 0x34, 0xcd, 0x53, 0x19, 0xbe, 0x9e, 0xc2, 0xe8, 0x63, 0x78,
-	0x7e, 0x53, 0x60, 0x5f, 0x9f, 0x00, 0x3b, 0x2d, 0xde, 0x7d, 0x9e, 0x53, 0xeb, 0x66, 0xde, 0x9c,
-	0xcf, 0x01, 0x9c, 0x39, 0x23, 0x38, 0x89, 0x3e, 0x85, 0x73, 0x79, 0x74, 0x52, 0x1b, 0x3c, 0x92,
-	0x5a, 0x6c, 0xda, 0xde, 0xaa, 0x3d, 0x65, 0x82, 0xd7, 0xce, 0x70, 0xd9, 0x98, 0xd0, 0x21, 0x9b,
-	0xdc, 0xcc, 0xf4, 0xce, 0xa0, 0x74, 0x76, 0x01, 0x1c, 0xbf, 0x45, 0xc8, 0x3a, 0xe7, 0x21, 0xfa,
-	0x0c, 0x4e, 0xe6, 0x33, 0x39, 0xe6, 0x3c, 0x1c, 0x72, 0xb5, 0xf2, 0x1b, 0x40, 0xd3, 0x3b, 0xdf,
-	0x9c, 0x83, 0xe5, 0xe6, 0x20, 0xb2, 0x11, 0x13, 0x16, 0x98, 0xf9, 0x86, 0x43, 0x34, 0x03, 0xc7,
-	0x14, 0x55, 0x21, 0x31, 0x37, 0x85, 0x6b, 0x16, 0x68, 0x01, 0x5e, 0x08, 0x88, 0xf4, 0x05, 0x8d,
-	0xf3, 0x42, 0xb9, 0x83, 0x10, 0xba, 0x06, 0x27, 0x04, 0xf1, 0x69, 0x4c, 0x09, 0x53, 0x66, 0xfc,
-	0xba, 0x39, 0x80, 0x76, 0x60, 0x11, 0x47, 0xe9, 0x6c, 0x18, 0x4d, 0xb5, 0xce, 0x9f, 0xa9, 0x35,
-	0x15, 0x7a, 0xcb, 0x0a, 0x5d, 0x7c, 0x06, 0xa1, 0xa9, 0xca, 0x7b, 0xc7, 0x7b, 0x4b, 0x17, 0x43,
-	0xd2, 0xc1, 0xfe, 0x8e, 0xe7, 0xe7, 0xb2, 0x2d, 0xe1, 0xea, 0xe2, 0xee, 0xfd, 0x6a, 0xe1, 0xcf,
-	0xfb, 0xd5, 0xc2, 0x4f, 0xfb, 0xcb, 0x65, 0xcb, 0xda, 0xe1, 0xbd, 0x01, 0x52, 0xa6, 0x74, 0xcc,
-	0xc0, 0x79, 0x02, 0xe0, 0x6c, 0x8b, 0x68, 0x4f, 0xba, 0x7a, 0x0a, 0x0b, 0x45, 0x59, 0xe7, 0x7d,
-	0xb6, 0x99, 0xce, 0xb8, 0x58, 0x90, 0x1e, 0xe5, 0xfa, 0xaa, 0x19, 0x6c, 0xe7, 0xc9, 0x3e, 0x6c,
-	0xbb, 0xf9, 0x0e, 0x1c, 0x93, 0x0a, 0xdf, 0x25, 0xb6, 0x95, 0x5f, 0xc0, 0x3d, 0x6b, 0xfc, 0xa1,
-	0x16, 0x2c, 0x76, 0x09, 0xed, 0x74, 0x4d, 0x6e, 0x47, 0x1b, 0x6f, 0xfe, 0x75, 0x50, 0x9d, 0xf2,
-	0x05, 0xd1, 0x23, 0x98, 0x79, 0xc6, 0xf4, 0xdd, 0xf1, 0xde, 0xd2, 0x69, 0xcc, 0xe6, 0xc2, 0x2c,
-	0x9c, 0x3f, 0x00, 0x9c, 0xb7, 0x0a, 0x29, 0x67, 0x99, 0x56, 0x7b, 0xa9, 0x7d, 0x00, 0x2f, 0xe7,
-	0xe7, 0x42, 0xdf, 0x6a, 0x44, 0x4a, 0xfb, 0x60, 0x78, 0xe5, 0xf1, 0xfe, 0xf2, 0xcb, 0x36, 0xb4,
-	0x7c, 0x3a, 0x9a, 0x2d, 0x1b, 0x4a, 0xe8, 0x21, 0x94, 0x9f, 0x78, 0x8b, 0x23, 0x06, 0x8b, 0xd9,
-	0x33, 0x60, 0x98, 0x0d, 0x6e, 0x59, 0x56, 0x47, 0x75, 0xa5, 0x9d, 0x9f, 0x01, 0xbc, 0xfe, 0xef,
-	0xfd, 0x7d, 0x87, 0xaa, 0x6e, 0x8b, 0xc4, 0x5c, 0x52, 0x35, 0xa4, 0x56, 0x9f, 0x1b, 0x68, 0x75,
-	0x6d, 0xb2, 0x2b, 0x54, 0x82, 0xe3, 0x81, 0x21, 0x2e, 0x8d, 0xa5, 0x86, 0xfe, 0x72, 0xd5, 0xd9,
-	0xfd, 0xcf, 0xee, 0x6c, 0xdc, 0x7e, 0x70, 0x58, 0x01, 0x0f, 0x0f, 0x2b, 0xe0, 0xd1, 0x61, 0x05,
-	0xfc, 0x7e, 0x58, 0x01, 0x5f, 0x1f, 0x55, 0x0a, 0x8f, 0x8e, 0x2a, 0x85, 0x5f, 0x8f, 0x2a, 0x85,
-	0x4f, 0x56, 0x9e, 0x9a, 0xb3, 0x53, 0xef, 0x8c, 0x34, 0x85, 0xed, 0x62, 0xfa, 0x56, 0x7c, 0xfb,
-	0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2f, 0xcc, 0x9a, 0x24, 0xde, 0x0a, 0x00, 0x00,
+	// 1154 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xc1, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf6, 0x38, 0xae, 0xdb, 0x4c, 0xdb, 0xb4, 0x9d, 0x3a, 0xad, 0xeb, 0xb6, 0xb6, 0xbb, 0x52,
+	0x7f, 0x3f, 0x13, 0x88, 0x43, 0x8b, 0x84, 0x50, 0x84, 0x90, 0x62, 0xa7, 0x55, 0xb9, 0xd0, 0x68,
+	0x83, 0x28, 0xe2, 0xb2, 0x1a, 0xef, 0x4e, 0xec, 0x51, 0xbc, 0x33, 0xcb, 0xcc, 0xac, 0x93, 0x1c,
+	0xb8, 0x07, 0x0e, 0xc0, 0x0d, 0xd4, 0x53, 0x05, 0x97, 0x0a, 0x09, 0x29, 0x87, 0xfc, 0x11, 0x15,
+	0xa7, 0x28, 0x48, 0x08, 0x71, 0x48, 0x21, 0x39, 0x04, 0xf1, 0x2f, 0x70, 0x41, 0xb3, 0x33, 0x5e,
+	0xdb, 0x69, 0x28, 0x15, 0x6d, 0xca, 0x25, 0xc9, 0xbc, 0x37, 0xfb, 0xbe, 0xef, 0x7b, 0xf3, 0xde,
+	0x9b, 0x09, 0xac, 0xfb, 0x5c, 0x86, 0x5c, 0xce, 0x04, 0x54, 0x2a, 0x41, 0x5b, 0xb1, 0xa2, 0x9c,
+	0xcd, 0xf4, 0x6e, 0xb4, 0x88, 0xc2, 0x37, 0x46, 0x8c, 0xf5, 0x48, 0x70, 0xc5, 0xd1, 0x65, 0xb3,
+	0xbf, 0x3e, 0xe2, 0xb2, 0xfb, 0x4b, 0x85, 0x36, 0x6f, 0xf3, 0x64, 0xdf, 0x8c, 0xfe, 0xcb, 0x7c,
+	0x52, 0x2a, 0x5b, 0x88, 0x16, 0x96, 0x24, 0x0d, 0xed, 0x73, 0x6a, 0x43, 0x96, 0x2e, 0x19, 0xbf,
+	0x67, 0x3e, 0xb4, 0xf1, 0x8d, 0xeb, 0x1c, 0x0e, 0x29, 0xe3, 0x33, 0xc9, 0x4f, 0x63, 0x72, 0xfe,
+	0x1c, 0x83, 0xf9, 0x05, 0x2c, 0x70, 0x28, 0xd1, 0x12, 0x3c, 0xed, 0xf3, 0x30, 0x8c, 0x19, 0x55,
+	0x6b, 0x9e, 0xc2, 0xab, 0x45, 0x50, 0x05, 0xb5, 0xf1, 0xc6, 0xdc, 0xa3, 0x9d, 0x4a, 0xe6, 0x97,
+	0x9d, 0xca, 0xff, 0xda, 0x54, 0x75, 0xe2, 0x56, 0xdd, 0xe7, 0xa1, 0x8d, 0x6a, 0x7f, 0x4d, 0xcb,
+	0x60, 0x79, 0x46, 0xad, 0x45, 0x44, 0xd6, 0xe7, 0x89, 0xbf, 0xbd, 0x39, 0x0d, 0x2d, 0xe8, 0x3c,
+	0xf1, 0x1f, 0xee, 0x6f, 0x4c, 0x01, 0xf7, 0x54, 0x1a, 0xf7, 0x7d, 0xbc, 0x8a, 0x62, 0x58, 0xd0,
+	0xdc, 0x35, 0xc1, 0x88, 0x4b, 0x22, 0x3c, 0x41, 0x56, 0xb0, 0x08, 0x8a, 0xd9, 0x04, 0xae, 0xf9,
+	0xdc, 0x70, 0x45, 0xe0, 0x22, 0x0d, 0xb0, 0x60, 0xe3, 0xbb, 0x49, 0x78, 0xb4, 0x02, 0x27, 0x5b,
+	0x9c, 0xc5, 0xf2, 0x09, 0xdc, 0xb1, 0x17, 0x87, 0x7b, 0x3e, 0x41, 0x38, 0x00, 0x7c, 0x13, 0x4e,
+	0xae, 0x50, 0xd5, 0x09, 0x04, 0x5e, 0xf1, 0x70, 0x10, 0x08, 0x8f, 0x30, 0xdc, 0xea, 0x92, 0xa0,
+	0x98, 0xab, 0x82, 0xda, 0x09, 0xf7, 0x7c, 0xdf, 0x39, 0x17, 0x04, 0xe2, 0x96, 0x71, 0xa1, 0x77,
+	0xe0, 0x95, 0x10, 0xaf, 0x7a, 0x38, 0x56, 0xdc, 0xf3, 0x79, 0x18, 0xf1, 0x98, 0x05, 0xd2, 0x8b,
+	0x88, 0xf0, 0x5a, 0x5d, 0xee, 0x2f, 0x17, 0x8f, 0x55, 0x41, 0xed, 0xb4, 0x5b, 0x0c, 0xf1, 0xea,
+	0x5c, 0xac, 0x78, 0xb3, 0xbf, 0x63, 0x81, 0x88, 0x86, 0xf6, 0xcf, 0x5e, 0xff, 0x6c, 0x7f, 0x63,
+	0xaa, 0x3a, 0x44, 0x7c, 0x75, 0xb4, 0x24, 0xcd, 0x91, 0x3b, 0x3f, 0x01, 0x58, 0xfa, 0x00, 0x77,
+	0x69, 0x80, 0x15, 0x17, 0x77, 0xa8, 0x54, 0x5c, 0x50, 0x1f, 0x77, 0x0d, 0x71, 0x89, 0x3e, 0x07,
+	0xf0, 0xa2, 0x1f, 0x87, 0x71, 0x17, 0x2b, 0xda, 0x23, 0x36, 0x5f, 0x9e, 0xc0, 0x8a, 0xf2, 0x22,
+	0xa8, 0x8e, 0xd5, 0x4e, 0xde, 0xbc, 0x62, 0x0b, 0xbe, 0xae, 0x13, 0xde, 0x2f, 0x5c, 0x9d, 0x91,
+	0x26, 0xa7, 0xac, 0xf1, 0x96, 0xce, 0xe9, 0x77, 0x8f, 0x2b, 0xaf, 0x3e, 0x5b, 0x4e, 0xf5, 0x37,
+	0xd2, 0x54, 0xcc, 0xe4, 0x00, 0xd6, 0x90, 0x71, 0x35, 0x28, 0xfa, 0x3f, 0x3c, 0x23, 0xc8, 0x12,
+	0x11, 0x84, 0xf9, 0xc4, 0xf3, 0x79, 0xcc, 0x54, 0x52, 0x35, 0xa7, 0xdd, 0x89, 0xd4, 0xdc, 0xd4,
+	0x56, 0xe7, 0x5b, 0x00, 0x2f, 0xa6, 0xc2, 0x9a, 0xb1, 0x10, 0x84, 0xa9, 0xbe, 0xaa, 0x08, 0x1e,
+	0x37, 0x4a, 0xe4, 0x11, 0x8b, 0xe8, 0xc3, 0xa0, 0x0b, 0x30, 0x1f, 0x11, 0x41, 0xb9, 0xa9, 0xf1,
+	0x9c, 0x6b, 0x57, 0xce, 0xd7, 0x00, 0x96, 0x53, 0x96, 0x73, 0xbe, 0xd5, 0x4c, 0x82, 0x26, 0x0f,
+	0x43, 0x2a, 0x25, 0xe5, 0x0c, 0xf5, 0x20, 0xf4, 0xd3, 0xd5, 0x11, 0xf3, 0x1d, 0x42, 0x72, 0xbe,
+	0x00, 0xf0, 0x72, 0x4a, 0xed, 0x6e, 0xac, 0xa4, 0xc2, 0x2c, 0xa0, 0xac, 0xfd, 0x9f, 0x25, 0xd1,
+	0xb9, 0x0f, 0xe0, 0xf9, 0x94, 0xd1, 0x62, 0x17, 0xcb, 0xce, 0xad, 0x1e, 0x61, 0x0a, 0xbd, 0x02,
+	0xcf, 0xf6, 0xfa, 0x66, 0xcf, 0xa6, 0x19, 0x24, 0x69, 0x3e, 0x93, 0xda, 0x17, 0x12, 0x33, 0xfa,
+	0x10, 0x9e, 0x58, 0x12, 0xd8, 0xd7, 0x1d, 0x60, 0xa7, 0xcd, 0xdb, 0xcf, 0xd3, 0xf5, 0x6e, 0x1a,
+	0xcd, 0xf9, 0x14, 0xc0, 0xc2, 0x21, 0xe4, 0x24, 0xfa, 0x18, 0x5e, 0x18, 0xb0, 0x93, 0xda, 0xe1,
+	0x91, 0xc4, 0x63, 0xd3, 0xf6, 0x7a, 0xfd, 0x29, 0x37, 0x40, 0xfd, 0x90, 0x90, 0x8d, 0x71, 0x4d,
+	0xd9, 0xe4, 0xa6, 0xd0, 0x3b, 0x04, 0xd2, 0x59, 0x07, 0xf0, 0xf8, 0x6d, 0x42, 0x16, 0x38, 0xef,
+	0xa2, 0x4f, 0xe0, 0xc4, 0x60, 0xa6, 0x47, 0x9c, 0x77, 0x8f, 0xf8, 0xb4, 0x06, 0x37, 0x88, 0x86,
+	0x77, 0xbe, 0xca, 0xc2, 0x52, 0x73, 0xd8, 0xb2, 0x18, 0x11, 0x16, 0x98, 0xf9, 0x88, 0xbb, 0xa8,
+	0x00, 0x8f, 0x29, 0xaa, 0xba, 0xc4, 0xdc, 0x34, 0xae, 0x59, 0xa0, 0x2a, 0x3c, 0x19, 0x10, 0xe9,
+	0x0b, 0x1a, 0x0d, 0x0e, 0xca, 0x1d, 0x36, 0xa1, 0x2b, 0x70, 0x5c, 0x10, 0x9f, 0x46, 0x94, 0x30,
+	0x65, 0xc6, 0xb7, 0x3b, 0x30, 0xa0, 0x35, 0x98, 0xc7, 0x61, 0x32, 0x1b, 0x72, 0x89, 0xd6, 0x4b,
+	0x87, 0x6a, 0x4d, 0x84, 0xde, 0xb6, 0x42, 0x6b, 0xcf, 0x20, 0x34, 0x51, 0x79, 0x7f, 0x7f, 0x63,
+	0xea, 0x54, 0x97, 0xb4, 0xb1, 0xbf, 0xe6, 0xf9, 0x03, 0xd9, 0x16, 0x70, 0xb6, 0xb6, 0xfe, 0xa0,
+	0x92, 0xf9, 0xfd, 0x41, 0x25, 0xf3, 0xc3, 0xe6, 0x74, 0xc9, 0xa2, 0xb6, 0x79, 0x6f, 0x08, 0x94,
+	0x29, 0xcd, 0x19, 0x38, 0x8f, 0x01, 0x9c, 0x9c, 0x27, 0x3a, 0x92, 0x3e, 0x3d, 0x85, 0x85, 0xa2,
+	0xac, 0xfd, 0x2e, 0x5b, 0x4a, 0x66, 0x5c, 0x24, 0x48, 0x8f, 0xf2, 0x58, 0x8e, 0x96, 0xf3, 0x44,
+	0xdf, 0x6c, 0xab, 0xf9, 0x1e, 0x3c, 0x26, 0x15, 0x5e, 0x26, 0xc5, 0xec, 0x8b, 0xba, 0xa7, 0x4d,
+	0x3c, 0x34, 0x0f, 0xf3, 0x1d, 0x42, 0xdb, 0x1d, 0x93, 0xdb, 0x5c, 0xe3, 0xb5, 0x3f, 0x76, 0x2a,
+	0x67, 0x7c, 0x41, 0xf4, 0x08, 0x66, 0x9e, 0x71, 0x7d, 0xb3, 0xbf, 0x31, 0x75, 0xd0, 0x66, 0x73,
+	0x61, 0x16, 0xce, 0x6f, 0x00, 0x5e, 0xb2, 0x0a, 0x29, 0x67, 0xa9, 0x56, 0x7b, 0x29, 0xbe, 0x07,
+	0xcf, 0x0d, 0xfa, 0x42, 0xdf, 0x8a, 0x44, 0x4a, 0xfb, 0xe0, 0xb8, 0xb6, 0xbd, 0x39, 0x7d, 0xd5,
+	0x52, 0x1b, 0x4c, 0x47, 0xb3, 0x65, 0x51, 0x09, 0x3d, 0x84, 0xce, 0xf6, 0x0e, 0xd8, 0x11, 0x83,
+	0xf9, 0xf4, 0x19, 0x71, 0x94, 0x05, 0x6e, 0x51, 0x66, 0x73, 0xfa, 0xa4, 0x9d, 0x1f, 0x01, 0xbc,
+	0xfe, 0xf7, 0xf5, 0x7d, 0x8f, 0xaa, 0xce, 0x3c, 0x89, 0xb8, 0xa4, 0xea, 0x88, 0x4a, 0xfd, 0xc2,
+	0x50, 0xa9, 0x6b, 0x97, 0x5d, 0xa1, 0x22, 0x3c, 0x1e, 0x18, 0xe0, 0xe4, 0xa5, 0x30, 0xee, 0xf6,
+	0x97, 0xb3, 0xce, 0xfa, 0x3f, 0x56, 0xa7, 0xb3, 0x05, 0xe0, 0xe5, 0x27, 0x55, 0xb9, 0x29, 0xea,
+	0x9b, 0xc3, 0x9c, 0xcc, 0x99, 0x15, 0xb7, 0x37, 0xa7, 0x0b, 0x36, 0xe4, 0xe8, 0x51, 0x1d, 0xda,
+	0x98, 0xd9, 0x97, 0xdc, 0x98, 0xce, 0xf7, 0x59, 0x38, 0xa1, 0xe5, 0x51, 0x16, 0xf3, 0x58, 0xde,
+	0x8e, 0x59, 0x80, 0x26, 0x60, 0x96, 0xf6, 0x5b, 0x2b, 0x4b, 0x83, 0x51, 0x55, 0xd9, 0x7f, 0xa3,
+	0x6a, 0xec, 0x25, 0xab, 0x1a, 0x7a, 0x57, 0xe4, 0x86, 0xdf, 0x15, 0xe8, 0x1a, 0x3c, 0x25, 0xf5,
+	0x48, 0xb1, 0x7d, 0x99, 0xd4, 0xc0, 0x98, 0x7b, 0x32, 0xb1, 0xdd, 0x49, 0x4c, 0xe8, 0x2a, 0x84,
+	0x84, 0x05, 0xfd, 0x0d, 0xf9, 0x64, 0xc3, 0x38, 0x61, 0x81, 0x71, 0x37, 0xee, 0x3e, 0xdc, 0x2d,
+	0x83, 0x47, 0xbb, 0x65, 0xb0, 0xb5, 0x5b, 0x06, 0xbf, 0xee, 0x96, 0xc1, 0x97, 0x7b, 0xe5, 0xcc,
+	0xd6, 0x5e, 0x39, 0xf3, 0xf3, 0x5e, 0x39, 0xf3, 0xd1, 0x8d, 0xa7, 0xf2, 0x3f, 0xf0, 0xd4, 0x4c,
+	0xe4, 0xb4, 0xf2, 0xc9, 0xbf, 0x1b, 0x6f, 0xfc, 0x35, 0x00, 0x03, 0x25, 0x55, 0xae, 0x21, 0x0d,
+	0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -739,6 +914,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.MaxAutoCompoundsPerBlock != that1.MaxAutoCompoundsPerBlock {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1046,6 +1224,82 @@ func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *CommunityPoolSpendRecipient) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommunityPoolSpendRecipient)
+	if !ok {
+		that2, ok := that.(CommunityPoolSpendRecipient)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ContinuousFund) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContinuousFund)
+	if !ok {
+		that2, ok := that.(ContinuousFund)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if this.Period != that1.Period {
+		return false
+	}
+	if this.StartHeight != that1.StartHeight {
+		return false
+	}
+	if this.EndHeight != that1.EndHeight {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1066,6 +1320,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxAutoCompoundsPerBlock != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.MaxAutoCompoundsPerBlock))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1582,6 +1841,114 @@ func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *CommunityPoolSpendRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolSpendRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolSpendRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContinuousFund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContinuousFund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContinuousFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Period != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDistribution(v)
 	base := offset
@@ -1608,6 +1975,9 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	if m.MaxAutoCompoundsPerBlock != 0 {
+		n += 1 + sovDistribution(uint64(m.MaxAutoCompoundsPerBlock))
+	}
 	return n
 }
 
@@ -1813,18 +2183,68 @@ func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
 	return n
 }
 
-func sovDistribution(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDistribution(x uint64) (n int) {
-	return sovDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+func (m *CommunityPoolSpendRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContinuousFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDistribution(uint64(m.Id))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.Period != 0 {
+		n += 1 + sovDistribution(uint64(m.Period))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovDistribution(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovDistribution(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func sovDistribution(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDistribution(x uint64) (n int) {
+	return sovDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowDistribution
@@ -1970,6 +2390,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAutoCompoundsPerBlock", wireType)
+			}
+			m.MaxAutoCompoundsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAutoCompoundsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -3264,6 +3703,314 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CommunityPoolSpendRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityPoolSpendRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityPoolSpendRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContinuousFund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContinuousFund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContinuousFund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDistribution(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
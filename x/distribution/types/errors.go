@@ -16,4 +16,6 @@ var (
 	ErrEmptyProposalRecipient  = errors.Register(ModuleName, 11, "invalid community pool spend proposal recipient")
 	ErrNoValidatorExists       = errors.Register(ModuleName, 12, "validator does not exist")
 	ErrNoDelegationExists      = errors.Register(ModuleName, 13, "delegation does not exist")
+	ErrContinuousFundNotFound  = errors.Register(ModuleName, 14, "continuous fund not found")
+	ErrInvalidContinuousFund   = errors.Register(ModuleName, 15, "invalid continuous fund")
 )
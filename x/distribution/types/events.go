@@ -2,14 +2,24 @@ package types
 
 // distribution module event types
 const (
-	EventTypeSetWithdrawAddress = "set_withdraw_address"
-	EventTypeRewards            = "rewards"
-	EventTypeCommission         = "commission"
-	EventTypeWithdrawRewards    = "withdraw_rewards"
-	EventTypeWithdrawCommission = "withdraw_commission"
-	EventTypeProposerReward     = "proposer_reward"
+	EventTypeSetWithdrawAddress          = "set_withdraw_address"
+	EventTypeSetValidatorWithdrawAddress = "set_validator_withdraw_address"
+	EventTypeRewards                     = "rewards"
+	EventTypeCommission                  = "commission"
+	EventTypeWithdrawRewards             = "withdraw_rewards"
+	EventTypeWithdrawCommission          = "withdraw_commission"
+	EventTypeProposerReward              = "proposer_reward"
+	EventTypeSetAutoCompound             = "set_auto_compound"
+	EventTypeAutoCompound                = "auto_compound"
+	EventTypeContinuousFundPayout        = "continuous_fund_payout"
+	EventTypeWithdrawTokenizeShareReward = "withdraw_tokenize_share_reward"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
 	AttributeKeyDelegator       = "delegator"
+	AttributeKeyEnabled         = "enabled"
+	AttributeKeyContinuousFund  = "continuous_fund_id"
+	AttributeKeyRecipient       = "recipient"
+	AttributeKeyRecordID        = "record_id"
+	AttributeKeyOwner           = "owner"
 )
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -36,6 +38,10 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		AutoCompoundDelegations:         []AutoCompoundDelegation{},
+		DelegatorValidatorWithdrawInfos: []DelegatorValidatorWithdrawInfo{},
+		ContinuousFunds:                 []ContinuousFund{},
+		NextContinuousFundId:            1,
 	}
 }
 
@@ -44,5 +50,22 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+
+	ids := make(map[uint64]bool, len(gs.ContinuousFunds))
+	for _, fund := range gs.ContinuousFunds {
+		if err := fund.Validate(); err != nil {
+			return err
+		}
+
+		if ids[fund.Id] {
+			return fmt.Errorf("duplicate continuous fund id %d", fund.Id)
+		}
+		ids[fund.Id] = true
+
+		if fund.Id >= gs.NextContinuousFundId {
+			return fmt.Errorf("continuous fund id %d is not lower than the next continuous fund id %d", fund.Id, gs.NextContinuousFundId)
+		}
+	}
+
 	return gs.FeePool.ValidateGenesis()
 }
@@ -70,6 +70,96 @@ func (m *DelegatorWithdrawInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DelegatorWithdrawInfo proto.InternalMessageInfo
 
+// DelegatorValidatorWithdrawInfo is the address where the distribution rewards
+// of a single delegation are withdrawn to. This struct is only used at genesis
+// to feed in per-validator withdraw addresses.
+//
+// Since: cosmos-sdk 0.50
+type DelegatorValidatorWithdrawInfo struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// withdraw_address is the address to withdraw the delegation rewards to.
+	WithdrawAddress string `protobuf:"bytes,3,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+}
+
+func (m *DelegatorValidatorWithdrawInfo) Reset()         { *m = DelegatorValidatorWithdrawInfo{} }
+func (m *DelegatorValidatorWithdrawInfo) String() string { return proto.CompactTextString(m) }
+func (*DelegatorValidatorWithdrawInfo) ProtoMessage()    {}
+func (*DelegatorValidatorWithdrawInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{1}
+}
+func (m *DelegatorValidatorWithdrawInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorValidatorWithdrawInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorValidatorWithdrawInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorValidatorWithdrawInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorValidatorWithdrawInfo.Merge(m, src)
+}
+func (m *DelegatorValidatorWithdrawInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorValidatorWithdrawInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorValidatorWithdrawInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorValidatorWithdrawInfo proto.InternalMessageInfo
+
+// AutoCompoundDelegation is a delegation whose rewards are auto-compounded.
+//
+// Since: cosmos-sdk 0.50
+type AutoCompoundDelegation struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *AutoCompoundDelegation) Reset()         { *m = AutoCompoundDelegation{} }
+func (m *AutoCompoundDelegation) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundDelegation) ProtoMessage()    {}
+func (*AutoCompoundDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{2}
+}
+func (m *AutoCompoundDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundDelegation.Merge(m, src)
+}
+func (m *AutoCompoundDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundDelegation proto.InternalMessageInfo
+
 // ValidatorOutstandingRewardsRecord is used for import/export via genesis json.
 type ValidatorOutstandingRewardsRecord struct {
 	// validator_address is the address of the validator.
@@ -82,7 +172,7 @@ func (m *ValidatorOutstandingRewardsRecord) Reset()         { *m = ValidatorOuts
 func (m *ValidatorOutstandingRewardsRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorOutstandingRewardsRecord) ProtoMessage()    {}
 func (*ValidatorOutstandingRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{1}
+	return fileDescriptor_76eed0f9489db580, []int{3}
 }
 func (m *ValidatorOutstandingRewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -124,7 +214,7 @@ func (m *ValidatorAccumulatedCommissionRecord) Reset()         { *m = ValidatorA
 func (m *ValidatorAccumulatedCommissionRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorAccumulatedCommissionRecord) ProtoMessage()    {}
 func (*ValidatorAccumulatedCommissionRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{2}
+	return fileDescriptor_76eed0f9489db580, []int{4}
 }
 func (m *ValidatorAccumulatedCommissionRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -168,7 +258,7 @@ func (m *ValidatorHistoricalRewardsRecord) Reset()         { *m = ValidatorHisto
 func (m *ValidatorHistoricalRewardsRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorHistoricalRewardsRecord) ProtoMessage()    {}
 func (*ValidatorHistoricalRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{3}
+	return fileDescriptor_76eed0f9489db580, []int{5}
 }
 func (m *ValidatorHistoricalRewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -209,7 +299,7 @@ func (m *ValidatorCurrentRewardsRecord) Reset()         { *m = ValidatorCurrentR
 func (m *ValidatorCurrentRewardsRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorCurrentRewardsRecord) ProtoMessage()    {}
 func (*ValidatorCurrentRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{4}
+	return fileDescriptor_76eed0f9489db580, []int{6}
 }
 func (m *ValidatorCurrentRewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -252,7 +342,7 @@ func (m *DelegatorStartingInfoRecord) Reset()         { *m = DelegatorStartingIn
 func (m *DelegatorStartingInfoRecord) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfoRecord) ProtoMessage()    {}
 func (*DelegatorStartingInfoRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{5}
+	return fileDescriptor_76eed0f9489db580, []int{7}
 }
 func (m *DelegatorStartingInfoRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -297,7 +387,7 @@ func (m *ValidatorSlashEventRecord) Reset()         { *m = ValidatorSlashEventRe
 func (m *ValidatorSlashEventRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEventRecord) ProtoMessage()    {}
 func (*ValidatorSlashEventRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{6}
+	return fileDescriptor_76eed0f9489db580, []int{8}
 }
 func (m *ValidatorSlashEventRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -348,13 +438,32 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// auto_compound_delegations defines the delegations whose rewards are
+	// auto-compounded at genesis.
+	//
+	// Since: cosmos-sdk 0.50
+	AutoCompoundDelegations []AutoCompoundDelegation `protobuf:"bytes,11,rep,name=auto_compound_delegations,json=autoCompoundDelegations,proto3" json:"auto_compound_delegations"`
+	// delegator_validator_withdraw_infos defines the per-validator delegator
+	// withdraw infos at genesis.
+	//
+	// Since: cosmos-sdk 0.50
+	DelegatorValidatorWithdrawInfos []DelegatorValidatorWithdrawInfo `protobuf:"bytes,12,rep,name=delegator_validator_withdraw_infos,json=delegatorValidatorWithdrawInfos,proto3" json:"delegator_validator_withdraw_infos"`
+	// continuous_funds defines the continuous funds at genesis.
+	//
+	// Since: cosmos-sdk 0.50
+	ContinuousFunds []ContinuousFund `protobuf:"bytes,13,rep,name=continuous_funds,json=continuousFunds,proto3" json:"continuous_funds"`
+	// next_continuous_fund_id defines the identifier of the next continuous fund
+	// at genesis.
+	//
+	// Since: cosmos-sdk 0.50
+	NextContinuousFundId uint64 `protobuf:"varint,14,opt,name=next_continuous_fund_id,json=nextContinuousFundId,proto3" json:"next_continuous_fund_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{7}
+	return fileDescriptor_76eed0f9489db580, []int{9}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,6 +494,8 @@ var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*DelegatorWithdrawInfo)(nil), "cosmos.distribution.v1beta1.DelegatorWithdrawInfo")
+	proto.RegisterType((*DelegatorValidatorWithdrawInfo)(nil), "cosmos.distribution.v1beta1.DelegatorValidatorWithdrawInfo")
+	proto.RegisterType((*AutoCompoundDelegation)(nil), "cosmos.distribution.v1beta1.AutoCompoundDelegation")
 	proto.RegisterType((*ValidatorOutstandingRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorOutstandingRewardsRecord")
 	proto.RegisterType((*ValidatorAccumulatedCommissionRecord)(nil), "cosmos.distribution.v1beta1.ValidatorAccumulatedCommissionRecord")
 	proto.RegisterType((*ValidatorHistoricalRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewardsRecord")
@@ -399,66 +510,75 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 938 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x3a, 0x25, 0x4d, 0x26, 0x45, 0x34, 0xd3, 0x34, 0x6c, 0xd2, 0x76, 0x9d, 0x94, 0x1e,
-	0x0a, 0x28, 0x6b, 0x12, 0x10, 0x54, 0x45, 0x20, 0x35, 0x6e, 0xcb, 0x8f, 0x03, 0x8d, 0x6c, 0x09,
-	0x04, 0x42, 0xb2, 0xc6, 0xbb, 0xe3, 0xf5, 0x08, 0x7b, 0xc7, 0x9a, 0x19, 0xaf, 0x01, 0x89, 0x03,
-	0xa7, 0x22, 0xc4, 0x81, 0x13, 0xe2, 0x58, 0x71, 0xaa, 0x90, 0x90, 0x38, 0xf4, 0x8f, 0xe8, 0xb1,
-	0xaa, 0x38, 0x70, 0x02, 0xe4, 0x1c, 0x40, 0x1c, 0xf9, 0x0b, 0xd0, 0xce, 0xcc, 0xee, 0x8e, 0xb3,
-	0xdb, 0xad, 0x1b, 0xf0, 0xa5, 0x8d, 0x67, 0xde, 0x7b, 0xdf, 0xf7, 0xbd, 0xf7, 0xf6, 0xbd, 0x01,
-	0xcf, 0x7b, 0x94, 0x0f, 0x28, 0xaf, 0xfb, 0x84, 0x0b, 0x46, 0x3a, 0x23, 0x41, 0x68, 0x58, 0x8f,
-	0x76, 0x3b, 0x58, 0xa0, 0xdd, 0x7a, 0x80, 0x43, 0xcc, 0x09, 0x77, 0x87, 0x8c, 0x0a, 0x0a, 0xcf,
-	0x29, 0x53, 0xd7, 0x34, 0x75, 0xb5, 0xe9, 0xe6, 0x5a, 0x40, 0x03, 0x2a, 0xed, 0xea, 0xf1, 0x5f,
-	0xca, 0x65, 0xd3, 0xd1, 0xd1, 0x3b, 0x88, 0xe3, 0x34, 0xaa, 0x47, 0x49, 0xa8, 0xef, 0xdd, 0x32,
-	0xf4, 0x29, 0x1c, 0x65, 0xbf, 0xa1, 0xec, 0xdb, 0x0a, 0x48, 0xf3, 0x51, 0x57, 0xab, 0x68, 0x40,
-	0x42, 0x5a, 0x97, 0xff, 0xaa, 0xa3, 0x8b, 0x3f, 0x59, 0xe0, 0xec, 0x75, 0xdc, 0xc7, 0x01, 0x12,
-	0x94, 0x7d, 0x40, 0x44, 0xcf, 0x67, 0x68, 0xfc, 0x4e, 0xd8, 0xa5, 0xf0, 0x06, 0x58, 0xf5, 0x93,
-	0x8b, 0x36, 0xf2, 0x7d, 0x86, 0x39, 0xb7, 0xad, 0x2d, 0xeb, 0xf2, 0xf2, 0xbe, 0xfd, 0xf0, 0xde,
-	0xce, 0x9a, 0x8e, 0x7c, 0x4d, 0xdd, 0xb4, 0x04, 0x23, 0x61, 0xd0, 0x3c, 0x9d, 0xba, 0xe8, 0x73,
-	0xd8, 0x00, 0xa7, 0xc7, 0x3a, 0x6c, 0x1a, 0xa5, 0xfa, 0x98, 0x28, 0xcf, 0x24, 0x1e, 0xfa, 0xf8,
-	0xea, 0xd2, 0x57, 0x77, 0x6a, 0x95, 0xbf, 0xee, 0xd4, 0x2a, 0x17, 0x6f, 0x57, 0xc1, 0xf6, 0xfb,
-	0xa8, 0x4f, 0xfc, 0x18, 0xe3, 0xd6, 0x48, 0x70, 0x81, 0x42, 0x3f, 0xf6, 0xc1, 0x63, 0xc4, 0x7c,
-	0xde, 0xc4, 0x1e, 0x65, 0x3e, 0x7c, 0x0f, 0xac, 0x46, 0x89, 0xd1, 0x11, 0xee, 0xdb, 0x0f, 0xef,
-	0xed, 0x5c, 0xd0, 0xa8, 0x69, 0xa0, 0x23, 0x22, 0xa2, 0x23, 0xe7, 0xf0, 0xb6, 0x05, 0xce, 0xd0,
-	0x0c, 0xac, 0xcd, 0x14, 0x9a, 0x5d, 0xdd, 0x5a, 0xb8, 0xbc, 0xb2, 0x77, 0x5e, 0x97, 0xc8, 0x8d,
-	0x4b, 0x98, 0x54, 0xdb, 0xbd, 0x8e, 0xbd, 0x06, 0x25, 0xe1, 0xfe, 0x95, 0xfb, 0xbf, 0xd5, 0x2a,
-	0x3f, 0xfe, 0x5e, 0x7b, 0x31, 0x20, 0xa2, 0x37, 0xea, 0xb8, 0x1e, 0x1d, 0xe8, 0xaa, 0xe8, 0xff,
-	0x76, 0xb8, 0xff, 0x49, 0x5d, 0x7c, 0x36, 0xc4, 0x3c, 0xf1, 0xe1, 0x77, 0xff, 0xfc, 0xf9, 0x05,
-	0xab, 0x09, 0x69, 0x4e, 0x9f, 0x91, 0x89, 0xbf, 0x2d, 0x70, 0x29, 0x13, 0xe0, 0x79, 0xa3, 0xc1,
-	0xa8, 0x8f, 0x04, 0xf6, 0x1b, 0x74, 0x30, 0x20, 0x9c, 0x13, 0x1a, 0xce, 0x29, 0x19, 0x3d, 0xb0,
-	0x82, 0x32, 0x38, 0x59, 0xcc, 0x95, 0xbd, 0xd7, 0xdd, 0x92, 0xce, 0x77, 0xcb, 0x79, 0xee, 0x2f,
-	0xc7, 0x29, 0x52, 0x9a, 0xcd, 0xd0, 0x86, 0xd8, 0x7f, 0x2c, 0xb0, 0x95, 0x06, 0x79, 0x9b, 0x70,
-	0x41, 0x19, 0xf1, 0x50, 0x7f, 0xbe, 0x55, 0x5f, 0x07, 0x8b, 0x43, 0xcc, 0x08, 0x55, 0x1a, 0x4f,
-	0x34, 0xf5, 0x2f, 0xf8, 0x31, 0x38, 0x99, 0x34, 0xc0, 0x82, 0x14, 0xff, 0xda, 0x6c, 0xe2, 0x73,
-	0xbc, 0x4d, 0xe1, 0x49, 0x48, 0x43, 0xf4, 0x2f, 0x16, 0xb8, 0x90, 0x3a, 0x37, 0x46, 0x8c, 0xe1,
-	0x50, 0xcc, 0x57, 0xf1, 0x87, 0x99, 0x32, 0x55, 0xd6, 0x57, 0x66, 0x53, 0x36, 0x4d, 0xee, 0x31,
-	0xb2, 0x7e, 0xa8, 0x82, 0x73, 0xe9, 0xc8, 0x69, 0x09, 0xc4, 0x04, 0x09, 0x83, 0x78, 0xe4, 0x68,
-	0x51, 0xff, 0xd3, 0xe0, 0x29, 0xcc, 0x4d, 0xf5, 0xf8, 0xb9, 0xe9, 0x80, 0xa7, 0xb9, 0x26, 0xdb,
-	0x26, 0x61, 0x97, 0xea, 0xda, 0xef, 0x95, 0x66, 0xa8, 0x50, 0xa7, 0x99, 0x9f, 0x53, 0xdc, 0xb8,
-	0x30, 0x92, 0xf4, 0x5d, 0x15, 0x6c, 0xa4, 0xd4, 0x5a, 0x7d, 0xc4, 0x7b, 0x37, 0x22, 0x99, 0xe1,
-	0x79, 0x75, 0x7a, 0x0f, 0x93, 0xa0, 0x27, 0x92, 0x4e, 0x57, 0xbf, 0x8c, 0x2f, 0x60, 0x61, 0xea,
-	0x0b, 0xa0, 0xe0, 0x6c, 0x86, 0xcf, 0x63, 0x76, 0x6d, 0x1c, 0xd3, 0xb3, 0x4f, 0xc8, 0x9c, 0xbc,
-	0x34, 0x5b, 0xd7, 0x64, 0xb2, 0xcc, 0x8c, 0x9c, 0x89, 0xf2, 0xf7, 0x46, 0x62, 0xbe, 0x59, 0x06,
-	0xa7, 0xde, 0x52, 0x3b, 0xb7, 0x25, 0x90, 0xc0, 0xf0, 0x26, 0x58, 0x1c, 0x22, 0x86, 0x06, 0x2a,
-	0x01, 0x2b, 0x7b, 0xcf, 0x95, 0x82, 0x1f, 0x48, 0x53, 0x13, 0x4f, 0x7b, 0xc3, 0x77, 0xc1, 0x52,
-	0x17, 0xe3, 0xf6, 0x90, 0xd2, 0xbe, 0x6e, 0xfe, 0x4b, 0xa5, 0x91, 0x6e, 0x62, 0x7c, 0x40, 0x69,
-	0x7f, 0xaa, 0xd9, 0xbb, 0xea, 0x0c, 0x8e, 0x81, 0x9d, 0xb5, 0x70, 0xba, 0xfe, 0xe2, 0xae, 0x89,
-	0x47, 0xc6, 0xc2, 0xec, 0x6d, 0x63, 0x6e, 0x64, 0x13, 0x69, 0xdd, 0x2f, 0xb2, 0xe0, 0xf1, 0xb7,
-	0x33, 0x64, 0x38, 0x22, 0x74, 0x24, 0x1f, 0x00, 0x43, 0xca, 0x31, 0x93, 0x45, 0x29, 0xfd, 0x76,
-	0x12, 0x97, 0x03, 0xed, 0x01, 0x3f, 0x2f, 0x5e, 0x77, 0x4f, 0x49, 0xea, 0x6f, 0xce, 0x56, 0xdd,
-	0x47, 0x2d, 0x67, 0x53, 0x46, 0xc1, 0x86, 0x83, 0xdf, 0x5b, 0x60, 0xdb, 0x68, 0xee, 0x6c, 0x1d,
-	0xb4, 0xbd, 0x74, 0x63, 0x70, 0x7b, 0x51, 0x52, 0xb9, 0xf6, 0x1f, 0xb6, 0x4e, 0x9e, 0x4d, 0x2d,
-	0x2a, 0x75, 0xe0, 0xf0, 0x6b, 0x0b, 0x9c, 0xcf, 0xa8, 0xf5, 0xd2, 0x71, 0x9e, 0x26, 0xe8, 0xa4,
-	0x64, 0xf5, 0xc6, 0x31, 0xd7, 0x41, 0x9e, 0xd1, 0x66, 0xf4, 0x48, 0x63, 0xf8, 0xa5, 0x05, 0x36,
-	0x32, 0x32, 0x9e, 0x9a, 0xc0, 0x29, 0x93, 0x25, 0xc9, 0xe4, 0xea, 0x71, 0xc6, 0x77, 0x9e, 0xc6,
-	0xb3, 0x51, 0xb1, 0x25, 0xfc, 0xc2, 0xec, 0xf3, 0xa9, 0xe9, 0xc8, 0xed, 0x65, 0xc9, 0xe0, 0xca,
-	0x93, 0x8f, 0xc7, 0x3c, 0x7e, 0xd6, 0xed, 0xa6, 0x1d, 0x87, 0x63, 0xb0, 0x5e, 0x38, 0x86, 0xb8,
-	0x0d, 0x24, 0xf8, 0xab, 0x4f, 0x3a, 0x87, 0xf2, 0xd0, 0x6b, 0x05, 0xd3, 0xc8, 0x58, 0x66, 0xfb,
-	0xb7, 0xee, 0x4e, 0x1c, 0xeb, 0xfe, 0xc4, 0xb1, 0x1e, 0x4c, 0x1c, 0xeb, 0x8f, 0x89, 0x63, 0x7d,
-	0x7b, 0xe8, 0x54, 0x1e, 0x1c, 0x3a, 0x95, 0x5f, 0x0f, 0x9d, 0xca, 0x47, 0xbb, 0xa5, 0x6f, 0xbe,
-	0x4f, 0xa7, 0xdf, 0xf4, 0xf2, 0x09, 0xd8, 0x59, 0x94, 0xef, 0xf2, 0x97, 0xff, 0x0d, 0x00, 0x00,
-	0xff, 0xff, 0xbe, 0x75, 0xf7, 0x68, 0x75, 0x0c, 0x00, 0x00,
+	// 1086 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xda, 0x21, 0x4d, 0xc6, 0x29, 0x4d, 0xb6, 0xa9, 0xbb, 0x49, 0x5b, 0x3b, 0x09, 0x3d,
+	0x14, 0xaa, 0xd8, 0x24, 0xe5, 0x47, 0x55, 0x04, 0x52, 0xe2, 0x36, 0x50, 0x0e, 0x34, 0x4a, 0x24,
+	0x10, 0x08, 0x69, 0x35, 0xde, 0x19, 0xdb, 0x23, 0xec, 0x19, 0x6b, 0x67, 0xd6, 0x2e, 0x95, 0x38,
+	0x70, 0xa1, 0x88, 0x13, 0x12, 0x12, 0xe2, 0x58, 0x71, 0xaa, 0x90, 0x90, 0x38, 0xf4, 0x00, 0xff,
+	0x41, 0x8f, 0x55, 0xc5, 0x81, 0x13, 0xa0, 0xe4, 0x00, 0xe2, 0xc8, 0x5f, 0x80, 0x76, 0x76, 0x76,
+	0x77, 0xd6, 0xde, 0x6e, 0xdc, 0x20, 0x4b, 0xbd, 0xb4, 0xf1, 0xcc, 0xfb, 0xf1, 0x7d, 0xdf, 0xbc,
+	0x79, 0xf3, 0x16, 0xbc, 0xe8, 0x30, 0xde, 0x65, 0xbc, 0x86, 0x08, 0x17, 0x2e, 0x69, 0x78, 0x82,
+	0x30, 0x5a, 0xeb, 0x6f, 0x34, 0xb0, 0x80, 0x1b, 0xb5, 0x16, 0xa6, 0x98, 0x13, 0x5e, 0xed, 0xb9,
+	0x4c, 0x30, 0xf3, 0x5c, 0x60, 0x5a, 0xd5, 0x4d, 0xab, 0xca, 0x74, 0x79, 0xb1, 0xc5, 0x5a, 0x4c,
+	0xda, 0xd5, 0xfc, 0xbf, 0x02, 0x97, 0xe5, 0xb2, 0x8a, 0xde, 0x80, 0x1c, 0x47, 0x51, 0x1d, 0x46,
+	0xa8, 0xda, 0xaf, 0x66, 0x65, 0x4f, 0xe4, 0x09, 0xec, 0x97, 0x02, 0x7b, 0x3b, 0x48, 0xa4, 0xf0,
+	0x04, 0x5b, 0x0b, 0xb0, 0x4b, 0x28, 0xab, 0xc9, 0x7f, 0x83, 0xa5, 0xb5, 0x1f, 0x0d, 0x70, 0xe6,
+	0x3a, 0xee, 0xe0, 0x16, 0x14, 0xcc, 0xfd, 0x80, 0x88, 0x36, 0x72, 0xe1, 0xe0, 0x26, 0x6d, 0x32,
+	0xf3, 0x06, 0x58, 0x40, 0xe1, 0x86, 0x0d, 0x11, 0x72, 0x31, 0xe7, 0x96, 0xb1, 0x62, 0x5c, 0x9a,
+	0xdd, 0xb6, 0x1e, 0x3f, 0x58, 0x5f, 0x54, 0x91, 0xb7, 0x82, 0x9d, 0x7d, 0xe1, 0x12, 0xda, 0xda,
+	0x9b, 0x8f, 0x5c, 0xd4, 0xba, 0x59, 0x07, 0xf3, 0x03, 0x15, 0x36, 0x8a, 0x92, 0x3f, 0x22, 0xca,
+	0xa9, 0xd0, 0x43, 0x2d, 0x5f, 0x9b, 0xf9, 0xf2, 0x5e, 0x25, 0xf7, 0xf7, 0xbd, 0x4a, 0x6e, 0xed,
+	0x8b, 0x3c, 0x28, 0x47, 0x78, 0xdf, 0x87, 0x1d, 0x82, 0x26, 0x05, 0xfc, 0x3d, 0xb0, 0xd0, 0x0f,
+	0xe3, 0x0f, 0x21, 0x5f, 0x7d, 0xfc, 0x60, 0xfd, 0x82, 0x0a, 0x13, 0x61, 0x18, 0x8a, 0xd7, 0x1f,
+	0x5a, 0x4f, 0x15, 0xa2, 0x70, 0x7c, 0x21, 0x7e, 0x31, 0x40, 0x69, 0xcb, 0x13, 0xac, 0xce, 0xba,
+	0x3d, 0xe6, 0x51, 0xa4, 0x44, 0x21, 0x8c, 0x3e, 0xa3, 0x02, 0x68, 0xd8, 0xef, 0xe6, 0xc1, 0x6a,
+	0xe4, 0x76, 0xcb, 0x13, 0x5c, 0x40, 0x8a, 0x7c, 0x27, 0x3c, 0x80, 0x2e, 0xe2, 0x7b, 0xd8, 0x61,
+	0x2e, 0x4a, 0xcf, 0x6f, 0x1c, 0xff, 0x00, 0xee, 0x1a, 0xe0, 0x34, 0x8b, 0x93, 0xd9, 0x6e, 0x90,
+	0xcd, 0xca, 0xaf, 0x14, 0x2e, 0x15, 0x37, 0xcf, 0xab, 0x7b, 0x56, 0xf5, 0xef, 0x61, 0x78, 0x65,
+	0xab, 0xd7, 0xb1, 0x53, 0x67, 0x84, 0x6e, 0x5f, 0x7d, 0xf8, 0x7b, 0x25, 0xf7, 0xc3, 0x1f, 0x95,
+	0xcb, 0x2d, 0x22, 0xda, 0x5e, 0xa3, 0xea, 0xb0, 0xae, 0xba, 0x5a, 0xea, 0xbf, 0x75, 0x8e, 0x3e,
+	0xa9, 0x89, 0x4f, 0x7b, 0x98, 0x87, 0x3e, 0xfc, 0xfe, 0x5f, 0x3f, 0xbd, 0x64, 0xec, 0x99, 0x6c,
+	0x84, 0x9f, 0xa6, 0xc4, 0x3f, 0x06, 0xb8, 0x18, 0x13, 0x70, 0x1c, 0xaf, 0xeb, 0x75, 0xa0, 0xc0,
+	0xa8, 0xce, 0xba, 0x5d, 0xc2, 0x39, 0x61, 0x74, 0x42, 0x62, 0xb4, 0x41, 0x11, 0xc6, 0xe9, 0xe4,
+	0xb1, 0x16, 0x37, 0xdf, 0xa8, 0x66, 0xb4, 0xaf, 0x6a, 0x36, 0xce, 0xed, 0x59, 0x5f, 0xa2, 0x80,
+	0xb3, 0x1e, 0x5a, 0x23, 0xfb, 0xaf, 0x01, 0x56, 0xa2, 0x20, 0xef, 0x10, 0x2e, 0x98, 0x4b, 0x1c,
+	0xd8, 0x99, 0xec, 0xa9, 0x97, 0xc0, 0x74, 0x0f, 0xbb, 0x84, 0x05, 0x1c, 0xa7, 0xf6, 0xd4, 0x2f,
+	0xf3, 0x63, 0x70, 0x22, 0x2c, 0x80, 0x82, 0x24, 0xff, 0xfa, 0x78, 0xe4, 0x47, 0x70, 0xeb, 0xc4,
+	0xc3, 0x90, 0x1a, 0xe9, 0x5f, 0x0d, 0x70, 0x21, 0x72, 0xae, 0x7b, 0xae, 0x8b, 0xa9, 0x98, 0x2c,
+	0xe3, 0x0f, 0x63, 0x66, 0xc1, 0xb1, 0xbe, 0x32, 0x1e, 0xb3, 0x24, 0xb8, 0x23, 0x68, 0x7d, 0x9f,
+	0x07, 0xe7, 0xa2, 0x3e, 0xbc, 0x2f, 0xa0, 0x2b, 0x08, 0x6d, 0xf9, 0xed, 0x57, 0x91, 0x7a, 0x46,
+	0x9b, 0x70, 0x03, 0x9c, 0xe4, 0x0a, 0xac, 0x4d, 0x68, 0x93, 0xa9, 0xb3, 0xdf, 0xcc, 0x54, 0x28,
+	0x95, 0xa7, 0xae, 0xcf, 0x1c, 0xd7, 0x36, 0x34, 0x91, 0xbe, 0xcd, 0x83, 0xa5, 0x08, 0xda, 0x7e,
+	0x07, 0xf2, 0xf6, 0x8d, 0xbe, 0x54, 0x78, 0x52, 0x95, 0xde, 0xc6, 0xa4, 0xd5, 0x16, 0x61, 0xa5,
+	0x07, 0xbf, 0xb4, 0x1b, 0x50, 0x48, 0xdc, 0x00, 0x06, 0xce, 0xc4, 0xf9, 0xb9, 0x8f, 0xce, 0xc6,
+	0x3e, 0x3c, 0x6b, 0x4a, 0x6a, 0xf2, 0xf2, 0x78, 0x55, 0x13, 0xd3, 0xd2, 0x15, 0x39, 0xdd, 0x1f,
+	0xdd, 0xd7, 0x84, 0xf9, 0x79, 0x0e, 0xcc, 0xbd, 0x1d, 0x0c, 0x4e, 0xfb, 0x02, 0x0a, 0x6c, 0xee,
+	0x80, 0xe9, 0x1e, 0x74, 0x61, 0x37, 0x10, 0xa0, 0xb8, 0xf9, 0x42, 0x66, 0xf2, 0x5d, 0x69, 0xaa,
+	0xe7, 0x53, 0xde, 0xe6, 0xbb, 0x60, 0xa6, 0x89, 0xb1, 0xdd, 0x63, 0xac, 0xa3, 0x8a, 0xff, 0x62,
+	0x66, 0xa4, 0x1d, 0x8c, 0x77, 0x19, 0xeb, 0x24, 0x8a, 0xbd, 0x19, 0xac, 0x99, 0x03, 0x60, 0xc5,
+	0x25, 0x1c, 0x3d, 0xdd, 0x7e, 0xd5, 0xf8, 0x2d, 0xa3, 0x30, 0x7e, 0xd9, 0xe8, 0xd3, 0x89, 0x9e,
+	0xa9, 0x84, 0xd2, 0x2c, 0xb8, 0x7f, 0x77, 0x7a, 0x2e, 0xee, 0x13, 0xe6, 0xc9, 0x29, 0xae, 0xc7,
+	0x38, 0x76, 0xad, 0xa9, 0xa3, 0xee, 0x4e, 0xe8, 0xb2, 0xab, 0x3c, 0xcc, 0x3b, 0xe9, 0xcf, 0xdd,
+	0x73, 0x12, 0xfa, 0x5b, 0xe3, 0x9d, 0xee, 0x93, 0x1e, 0x67, 0x9d, 0x46, 0xca, 0x0b, 0x67, 0x7e,
+	0x67, 0x80, 0x55, 0xad, 0xb8, 0xe3, 0xe7, 0xc0, 0x76, 0xa2, 0x17, 0x83, 0x5b, 0xd3, 0x12, 0xca,
+	0xd6, 0xff, 0x78, 0x75, 0x46, 0xd1, 0x54, 0xfa, 0x99, 0x0e, 0xdc, 0xfc, 0xca, 0x00, 0xe7, 0x63,
+	0x68, 0xed, 0xa8, 0x9d, 0x47, 0x02, 0x9d, 0x90, 0xa8, 0xde, 0x3c, 0xe6, 0x73, 0x30, 0x8a, 0x68,
+	0xb9, 0xff, 0x44, 0x63, 0xf3, 0x73, 0x03, 0x2c, 0xc5, 0x60, 0x9c, 0xa0, 0x03, 0x47, 0x48, 0x66,
+	0x24, 0x92, 0x6b, 0xc7, 0x69, 0xdf, 0xa3, 0x30, 0xce, 0xf6, 0xd3, 0x2d, 0xcd, 0xcf, 0xf4, 0x3a,
+	0x4f, 0x74, 0x47, 0x6e, 0xcd, 0x4a, 0x04, 0x57, 0x9f, 0xbe, 0x3d, 0x8e, 0xe6, 0x2f, 0xa1, 0x34,
+	0x3b, 0x6e, 0x0e, 0x40, 0x29, 0xb5, 0x0d, 0x71, 0x0b, 0xc8, 0xe4, 0xaf, 0x3d, 0x6d, 0x1f, 0x1a,
+	0x4d, 0xbd, 0x98, 0xd2, 0x8d, 0xb8, 0x79, 0x07, 0x2c, 0x41, 0x4f, 0x30, 0xdb, 0x51, 0x13, 0xb4,
+	0x8d, 0xa2, 0x11, 0x9a, 0x5b, 0x45, 0x99, 0xfb, 0x4a, 0x66, 0xee, 0xf4, 0xf1, 0x3b, 0xa1, 0x39,
+	0x4c, 0x35, 0xe1, 0xe6, 0x37, 0x06, 0x58, 0x8b, 0x45, 0x8f, 0xf9, 0x0f, 0xb5, 0x99, 0xb9, 0x95,
+	0xc2, 0x91, 0x63, 0x59, 0xf6, 0xd7, 0x50, 0xe2, 0x6a, 0xa0, 0x4c, 0x53, 0x6e, 0x42, 0x30, 0xef,
+	0x30, 0x2a, 0x08, 0xf5, 0xfc, 0xd6, 0xd3, 0xf4, 0x28, 0xe2, 0xd6, 0x49, 0x09, 0xe1, 0x72, 0x26,
+	0x84, 0x7a, 0xe4, 0xb4, 0xe3, 0xd1, 0x84, 0xf2, 0xa7, 0x9c, 0xc4, 0x16, 0x37, 0x5f, 0x05, 0x67,
+	0x29, 0xbe, 0x2d, 0xec, 0xa1, 0x3c, 0x36, 0x41, 0xd6, 0xf3, 0xf2, 0x75, 0x5a, 0xf4, 0xb7, 0x93,
+	0x01, 0x6f, 0x6a, 0x43, 0xe4, 0xf6, 0xad, 0xfb, 0x07, 0x65, 0xe3, 0xe1, 0x41, 0xd9, 0x78, 0x74,
+	0x50, 0x36, 0xfe, 0x3c, 0x28, 0x1b, 0x5f, 0x1f, 0x96, 0x73, 0x8f, 0x0e, 0xcb, 0xb9, 0xdf, 0x0e,
+	0xcb, 0xb9, 0x8f, 0x36, 0x32, 0xe7, 0xf3, 0xdb, 0xc9, 0x8f, 0x68, 0x39, 0xae, 0x37, 0xa6, 0xe5,
+	0x87, 0xf0, 0x95, 0xff, 0x06, 0x00, 0xbb, 0x2e, 0x20, 0x80, 0xe6, 0x0f, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -498,6 +618,87 @@ func (m *DelegatorWithdrawInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DelegatorValidatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorValidatorWithdrawInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorValidatorWithdrawInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoCompoundDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ValidatorOutstandingRewardsRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -784,6 +985,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextContinuousFundId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextContinuousFundId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.ContinuousFunds) > 0 {
+		for iNdEx := len(m.ContinuousFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContinuousFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.DelegatorValidatorWithdrawInfos) > 0 {
+		for iNdEx := len(m.DelegatorValidatorWithdrawInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorValidatorWithdrawInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.AutoCompoundDelegations) > 0 {
+		for iNdEx := len(m.AutoCompoundDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompoundDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -940,6 +1188,44 @@ func (m *DelegatorWithdrawInfo) Size() (n int) {
 	return n
 }
 
+func (m *DelegatorValidatorWithdrawInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *AutoCompoundDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func (m *ValidatorOutstandingRewardsRecord) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1084,35 +1370,316 @@ func (m *GenesisState) Size() (n int) {
 			l = e.Size()
 			n += 1 + l + sovGenesis(uint64(l))
 		}
-	}
-	if len(m.ValidatorCurrentRewards) > 0 {
-		for _, e := range m.ValidatorCurrentRewards {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.ValidatorCurrentRewards) > 0 {
+		for _, e := range m.ValidatorCurrentRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegatorStartingInfos) > 0 {
+		for _, e := range m.DelegatorStartingInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorSlashEvents) > 0 {
+		for _, e := range m.ValidatorSlashEvents {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoCompoundDelegations) > 0 {
+		for _, e := range m.AutoCompoundDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegatorValidatorWithdrawInfos) > 0 {
+		for _, e := range m.DelegatorValidatorWithdrawInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContinuousFunds) > 0 {
+		for _, e := range m.ContinuousFunds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextContinuousFundId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextContinuousFundId))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DelegatorWithdrawInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorWithdrawInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorWithdrawInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatorValidatorWithdrawInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorValidatorWithdrawInfo: wiretype end group for non-group")
 		}
-	}
-	if len(m.DelegatorStartingInfos) > 0 {
-		for _, e := range m.DelegatorStartingInfos {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorValidatorWithdrawInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
-	}
-	if len(m.ValidatorSlashEvents) > 0 {
-		for _, e := range m.ValidatorSlashEvents {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *DelegatorWithdrawInfo) Unmarshal(dAtA []byte) error {
+func (m *AutoCompoundDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1135,10 +1702,10 @@ func (m *DelegatorWithdrawInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatorWithdrawInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutoCompoundDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatorWithdrawInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutoCompoundDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1175,7 +1742,7 @@ func (m *DelegatorWithdrawInfo) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1203,7 +1770,7 @@ func (m *DelegatorWithdrawInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2371,6 +2938,127 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundDelegations = append(m.AutoCompoundDelegations, AutoCompoundDelegation{})
+			if err := m.AutoCompoundDelegations[len(m.AutoCompoundDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorValidatorWithdrawInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorValidatorWithdrawInfos = append(m.DelegatorValidatorWithdrawInfos, DelegatorValidatorWithdrawInfo{})
+			if err := m.DelegatorValidatorWithdrawInfos[len(m.DelegatorValidatorWithdrawInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuousFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContinuousFunds = append(m.ContinuousFunds, ContinuousFund{})
+			if err := m.ContinuousFunds[len(m.ContinuousFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextContinuousFundId", wireType)
+			}
+			m.NextContinuousFundId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextContinuousFundId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -41,6 +41,16 @@ const (
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
 //
 // - 0x09: Params
+//
+// - 0x0a<accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>: []byte{}
+//
+// - 0x0b: AutoCompoundCursor
+//
+// - 0x0c<accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>: sdk.AccAddress
+//
+// - 0x0d<fundID_Bytes>: ContinuousFund
+//
+// - 0x0e: NextContinuousFundID
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -54,6 +64,14 @@ var (
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
 
 	ParamsKey = []byte{0x09} // key for distribution module params
+
+	AutoCompoundDelegationPrefix = []byte{0x0a} // key for auto-compounded delegations
+	AutoCompoundCursorKey        = []byte{0x0b} // key for the last auto-compounded delegation
+
+	DelegatorValidatorWithdrawAddrPrefix = []byte{0x0c} // key for delegator withdraw address per validator
+
+	ContinuousFundPrefix    = []byte{0x0d} // key for continuous funds
+	NextContinuousFundIDKey = []byte{0x0e} // key for the next continuous fund id
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -98,6 +116,39 @@ func GetDelegatorStartingInfoAddresses(key []byte) (valAddr sdk.ValAddress, delA
 	return
 }
 
+// GetAutoCompoundDelegationAddresses creates the addresses from an auto-compounded delegation key.
+func GetAutoCompoundDelegationAddresses(key []byte) (delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	// key is in the format:
+	// 0x0a<accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>
+	kv.AssertKeyAtLeastLength(key, 2)
+	delAddrLen := int(key[1])
+	kv.AssertKeyAtLeastLength(key, 3+delAddrLen)
+	delAddr = sdk.AccAddress(key[2 : 2+delAddrLen])
+	valAddrLen := int(key[2+delAddrLen])
+	kv.AssertKeyAtLeastLength(key, 4+delAddrLen)
+	valAddr = sdk.ValAddress(key[3+delAddrLen:])
+	kv.AssertKeyLength(valAddr.Bytes(), valAddrLen)
+
+	return
+}
+
+// GetDelegatorValidatorWithdrawInfoAddresses creates the addresses from a delegator's
+// per-validator withdraw info key.
+func GetDelegatorValidatorWithdrawInfoAddresses(key []byte) (delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	// key is in the format:
+	// 0x0c<accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>
+	kv.AssertKeyAtLeastLength(key, 2)
+	delAddrLen := int(key[1])
+	kv.AssertKeyAtLeastLength(key, 3+delAddrLen)
+	delAddr = sdk.AccAddress(key[2 : 2+delAddrLen])
+	valAddrLen := int(key[2+delAddrLen])
+	kv.AssertKeyAtLeastLength(key, 4+delAddrLen)
+	valAddr = sdk.ValAddress(key[3+delAddrLen:])
+	kv.AssertKeyLength(valAddr.Bytes(), valAddrLen)
+
+	return
+}
+
 // GetValidatorHistoricalRewardsAddressPeriod creates the address & period from a validator's historical rewards key.
 func GetValidatorHistoricalRewardsAddressPeriod(key []byte) (valAddr sdk.ValAddress, period uint64) {
 	// key is in the format:
@@ -214,3 +265,26 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 
 	return append(prefix, periodBz...)
 }
+
+// GetAutoCompoundDelegationsPrefix creates the prefix key for a delegator's auto-compounded delegations.
+func GetAutoCompoundDelegationsPrefix(d sdk.AccAddress) []byte {
+	return append(AutoCompoundDelegationPrefix, address.MustLengthPrefix(d.Bytes())...)
+}
+
+// GetAutoCompoundDelegationKey creates the key for an auto-compounded delegation.
+func GetAutoCompoundDelegationKey(d sdk.AccAddress, v sdk.ValAddress) []byte {
+	return append(GetAutoCompoundDelegationsPrefix(d), address.MustLengthPrefix(v.Bytes())...)
+}
+
+// GetDelegatorValidatorWithdrawAddrKey creates the key for a delegator's withdraw addr
+// for the rewards of a single validator.
+func GetDelegatorValidatorWithdrawAddrKey(d sdk.AccAddress, v sdk.ValAddress) []byte {
+	return append(append(DelegatorValidatorWithdrawAddrPrefix, address.MustLengthPrefix(d.Bytes())...), address.MustLengthPrefix(v.Bytes())...)
+}
+
+// GetContinuousFundKey creates the key for a continuous fund.
+func GetContinuousFundKey(id uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, id)
+	return append(ContinuousFundPrefix, b...)
+}
@@ -13,6 +13,13 @@ var (
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 	_ sdk.Msg = (*MsgCommunityPoolSpend)(nil)
 	_ sdk.Msg = (*MsgDepositValidatorRewardsPool)(nil)
+	_ sdk.Msg = (*MsgSetAutoCompound)(nil)
+	_ sdk.Msg = (*MsgWithdrawAllDelegatorRewards)(nil)
+	_ sdk.Msg = (*MsgSetValidatorWithdrawAddress)(nil)
+	_ sdk.Msg = (*MsgCommunityPoolMultiSpend)(nil)
+	_ sdk.Msg = (*MsgCreateContinuousFund)(nil)
+	_ sdk.Msg = (*MsgCancelContinuousFund)(nil)
+	_ sdk.Msg = (*MsgWithdrawTokenizeShareRecordReward)(nil)
 
 	_ legacytx.LegacyMsg = (*MsgSetWithdrawAddress)(nil)
 	_ legacytx.LegacyMsg = (*MsgWithdrawDelegatorReward)(nil)
@@ -20,6 +27,13 @@ var (
 	_ legacytx.LegacyMsg = (*MsgUpdateParams)(nil)
 	_ legacytx.LegacyMsg = (*MsgCommunityPoolSpend)(nil)
 	_ legacytx.LegacyMsg = (*MsgDepositValidatorRewardsPool)(nil)
+	_ legacytx.LegacyMsg = (*MsgSetAutoCompound)(nil)
+	_ legacytx.LegacyMsg = (*MsgWithdrawAllDelegatorRewards)(nil)
+	_ legacytx.LegacyMsg = (*MsgSetValidatorWithdrawAddress)(nil)
+	_ legacytx.LegacyMsg = (*MsgCommunityPoolMultiSpend)(nil)
+	_ legacytx.LegacyMsg = (*MsgCreateContinuousFund)(nil)
+	_ legacytx.LegacyMsg = (*MsgCancelContinuousFund)(nil)
+	_ legacytx.LegacyMsg = (*MsgWithdrawTokenizeShareRecordReward)(nil)
 )
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr sdk.AccAddress) *MsgSetWithdrawAddress {
@@ -152,3 +166,144 @@ func (msg MsgDepositValidatorRewardsPool) GetSignBytes() []byte {
 	bz := ModuleCdc.MustMarshalJSON(&msg)
 	return sdk.MustSortJSON(bz)
 }
+
+// NewMsgSetAutoCompound returns a new MsgSetAutoCompound enabling or disabling
+// the auto-compounding of the rewards of a delegation.
+func NewMsgSetAutoCompound(delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Enabled:          enabled,
+	}
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes, which is the delegator.
+func (msg MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetAutoCompound message that the
+// expected signer needs to sign.
+func (msg MsgSetAutoCompound) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// NewMsgWithdrawAllDelegatorRewards returns a new MsgWithdrawAllDelegatorRewards
+// withdrawing the rewards of a delegator from all of its validators.
+func NewMsgWithdrawAllDelegatorRewards(delAddr sdk.AccAddress) *MsgWithdrawAllDelegatorRewards {
+	return &MsgWithdrawAllDelegatorRewards{
+		DelegatorAddress: delAddr.String(),
+	}
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes, which is the delegator.
+func (msg MsgWithdrawAllDelegatorRewards) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes returns the raw bytes for a MsgWithdrawAllDelegatorRewards
+// message that the expected signer needs to sign.
+func (msg MsgWithdrawAllDelegatorRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// NewMsgSetValidatorWithdrawAddress returns a new MsgSetValidatorWithdrawAddress
+// setting the withdraw address for the rewards of a delegator from a single
+// validator. A nil withdraw address removes it.
+func NewMsgSetValidatorWithdrawAddress(delAddr sdk.AccAddress, valAddr sdk.ValAddress, withdrawAddr sdk.AccAddress) *MsgSetValidatorWithdrawAddress {
+	msg := &MsgSetValidatorWithdrawAddress{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+	}
+	if withdrawAddr != nil {
+		msg.WithdrawAddress = withdrawAddr.String()
+	}
+
+	return msg
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes, which is the delegator.
+func (msg MsgSetValidatorWithdrawAddress) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetValidatorWithdrawAddress
+// message that the expected signer needs to sign.
+func (msg MsgSetValidatorWithdrawAddress) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes, which is the authority.
+func (msg MsgCommunityPoolMultiSpend) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes returns the raw bytes for a MsgCommunityPoolMultiSpend message that
+// the expected signer needs to sign.
+func (msg MsgCommunityPoolMultiSpend) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes, which is the authority.
+func (msg MsgCreateContinuousFund) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes returns the raw bytes for a MsgCreateContinuousFund message that
+// the expected signer needs to sign.
+func (msg MsgCreateContinuousFund) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes, which is the authority.
+func (msg MsgCancelContinuousFund) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes returns the raw bytes for a MsgCancelContinuousFund message that
+// the expected signer needs to sign.
+func (msg MsgCancelContinuousFund) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// NewMsgWithdrawTokenizeShareRecordReward returns a new
+// MsgWithdrawTokenizeShareRecordReward withdrawing the rewards of the
+// delegation of a tokenize share record to its owner.
+func NewMsgWithdrawTokenizeShareRecordReward(ownerAddr sdk.AccAddress, recordID uint64) *MsgWithdrawTokenizeShareRecordReward {
+	return &MsgWithdrawTokenizeShareRecordReward{
+		OwnerAddress: ownerAddr.String(),
+		RecordId:     recordID,
+	}
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes, which is the owner of the record.
+func (msg MsgWithdrawTokenizeShareRecordReward) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	return []sdk.AccAddress{owner}
+}
+
+// GetSignBytes returns the raw bytes for a MsgWithdrawTokenizeShareRecordReward
+// message that the expected signer needs to sign.
+func (msg MsgWithdrawTokenizeShareRecordReward) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
@@ -13,6 +13,8 @@ func DefaultParams() Params {
 		BaseProposerReward:  math.LegacyZeroDec(),            // deprecated
 		BonusProposerReward: math.LegacyZeroDec(),            // deprecated
 		WithdrawAddrEnabled: true,
+
+		MaxAutoCompoundsPerBlock: 100,
 	}
 }
 
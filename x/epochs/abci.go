@@ -0,0 +1,64 @@
+package epochs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+// BeginBlocker ends the epochs whose duration elapsed and starts the next ones,
+// calling the epoch hooks. At most one epoch of each identifier ends per block,
+// so epochs missed while no block was produced are caught up one block at a time.
+func BeginBlocker(ctx sdk.Context, k *keeper.Keeper) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	for _, epoch := range k.AllEpochInfos(ctx) {
+		// the first epoch starts at the first block reaching its start time
+		shouldInitialEpochStart := !epoch.EpochCountingStarted && !epoch.StartTime.After(ctx.BlockTime())
+		epochEndTime := epoch.EndTime()
+		shouldEpochEnd := epoch.EpochCountingStarted && !ctx.BlockTime().Before(epochEndTime)
+		if !shouldInitialEpochStart && !shouldEpochEnd {
+			continue
+		}
+
+		epoch.CurrentEpochStartHeight = ctx.BlockHeight()
+
+		if shouldInitialEpochStart {
+			epoch.EpochCountingStarted = true
+			epoch.CurrentEpoch = 1
+			epoch.CurrentEpochStartTime = epoch.StartTime
+			k.Logger(ctx).Info("starting epoch counting", "identifier", epoch.Identifier)
+		} else {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeEpochEnd,
+					sdk.NewAttribute(types.AttributeKeyEpochIdentifier, epoch.Identifier),
+					sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprintf("%d", epoch.CurrentEpoch)),
+				),
+			)
+			// hook errors are logged by the keeper and do not stop the epochs
+			_ = k.AfterEpochEnd(ctx, epoch.Identifier, epoch.CurrentEpoch)
+
+			epoch.CurrentEpoch++
+			epoch.CurrentEpochStartTime = epochEndTime
+			k.Logger(ctx).Info("starting new epoch", "identifier", epoch.Identifier, "epoch", epoch.CurrentEpoch)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEpochStart,
+				sdk.NewAttribute(types.AttributeKeyEpochIdentifier, epoch.Identifier),
+				sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprintf("%d", epoch.CurrentEpoch)),
+				sdk.NewAttribute(types.AttributeKeyEpochStartTime, epoch.CurrentEpochStartTime.UTC().Format(time.RFC3339Nano)),
+			),
+		)
+		k.SetEpochInfo(ctx, epoch)
+		_ = k.BeforeEpochStart(ctx, epoch.Identifier, epoch.CurrentEpoch)
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package epochs_test
+
+import (
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/epochs"
+	"github.com/cosmos/cosmos-sdk/x/epochs/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+type recordingHooks struct {
+	ended   []int64
+	started []int64
+}
+
+func (h *recordingHooks) AfterEpochEnd(_ sdk.Context, _ string, epochNumber int64) error {
+	h.ended = append(h.ended, epochNumber)
+	return nil
+}
+
+func (h *recordingHooks) BeforeEpochStart(_ sdk.Context, _ string, epochNumber int64) error {
+	h.started = append(h.started, epochNumber)
+	return nil
+}
+
+func TestBeginBlocker(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(epochs.AppModuleBasic{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+
+	k := keeper.NewKeeper(encCfg.Codec, key)
+	hooks := &recordingHooks{}
+	k.SetHooks(hooks)
+
+	genesisTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 1, Time: genesisTime})
+	require.NoError(t, k.AddEpochInfo(ctx, types.NewEpochInfo("hour", time.Hour, genesisTime.Add(time.Minute))))
+
+	// the first epoch has not started yet
+	require.NoError(t, epochs.BeginBlocker(ctx, k))
+	epoch, _ := k.GetEpochInfo(ctx, "hour")
+	require.False(t, epoch.EpochCountingStarted)
+	require.Empty(t, hooks.started)
+
+	// the first epoch starts once its start time is reached
+	ctx = ctx.WithBlockHeader(cmtproto.Header{Height: 2, Time: genesisTime.Add(2 * time.Minute)})
+	require.NoError(t, epochs.BeginBlocker(ctx, k))
+	epoch, _ = k.GetEpochInfo(ctx, "hour")
+	require.True(t, epoch.EpochCountingStarted)
+	require.Equal(t, int64(1), epoch.CurrentEpoch)
+	require.Equal(t, int64(2), epoch.CurrentEpochStartHeight)
+	require.Equal(t, genesisTime.Add(time.Minute), epoch.CurrentEpochStartTime)
+	require.Equal(t, []int64{1}, hooks.started)
+	require.Empty(t, hooks.ended)
+
+	// the epoch does not end before its duration elapsed
+	ctx = ctx.WithBlockHeader(cmtproto.Header{Height: 3, Time: genesisTime.Add(30 * time.Minute)})
+	require.NoError(t, epochs.BeginBlocker(ctx, k))
+	epoch, _ = k.GetEpochInfo(ctx, "hour")
+	require.Equal(t, int64(1), epoch.CurrentEpoch)
+
+	// the epoch ends and the next one starts
+	ctx = ctx.WithBlockHeader(cmtproto.Header{Height: 4, Time: genesisTime.Add(61 * time.Minute)}).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, epochs.BeginBlocker(ctx, k))
+	epoch, _ = k.GetEpochInfo(ctx, "hour")
+	require.Equal(t, int64(2), epoch.CurrentEpoch)
+	require.Equal(t, int64(4), epoch.CurrentEpochStartHeight)
+	require.Equal(t, genesisTime.Add(61*time.Minute), epoch.CurrentEpochStartTime)
+	require.Equal(t, []int64{1}, hooks.ended)
+	require.Equal(t, []int64{1, 2}, hooks.started)
+
+	numBlocks, err := k.NumBlocksSinceEpochStart(ctx.WithBlockHeight(7), "hour")
+	require.NoError(t, err)
+	require.Equal(t, int64(3), numBlocks)
+
+	attrs, ok := ctx.EventManager().Events().GetAttributes(types.AttributeKeyEpochNumber)
+	require.True(t, ok)
+	require.Equal(t, "1", attrs[0].Value)
+	require.Equal(t, "2", attrs[1].Value)
+}
@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+// GetQueryCmd returns the cli query commands for the epochs module.
+func GetQueryCmd() *cobra.Command {
+	epochsQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the epochs module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	epochsQueryCmd.AddCommand(
+		GetCmdQueryEpochInfos(),
+		GetCmdQueryCurrentEpoch(),
+	)
+
+	return epochsQueryCmd
+}
+
+// GetCmdQueryEpochInfos implements a command to return all the epochs tracked
+// by the module.
+func GetCmdQueryEpochInfos() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-infos",
+		Short: "Query all the epochs tracked by the module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EpochInfos(cmd.Context(), &types.QueryEpochInfosRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryCurrentEpoch implements a command to return the number of the
+// current epoch of a given identifier.
+func GetCmdQueryCurrentEpoch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "current-epoch [identifier]",
+		Short:   "Query the number of the current epoch of a given identifier",
+		Example: fmt.Sprintf("%s query %s current-epoch week", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CurrentEpoch(cmd.Context(), &types.QueryCurrentEpochRequest{Identifier: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+// GetEpochInfo returns the epoch info with the given identifier.
+func (k Keeper) GetEpochInfo(ctx sdk.Context, identifier string) (types.EpochInfo, bool) {
+	epoch := types.EpochInfo{}
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EpochInfoKey(identifier))
+	if bz == nil {
+		return epoch, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &epoch)
+	return epoch, true
+}
+
+// AddEpochInfo adds a new epoch to track. A zero start time is replaced by the
+// current block time, and the start height of the current epoch is set to the
+// current block height.
+func (k Keeper) AddEpochInfo(ctx sdk.Context, epoch types.EpochInfo) error {
+	if err := epoch.Validate(); err != nil {
+		return err
+	}
+
+	if _, found := k.GetEpochInfo(ctx, epoch.Identifier); found {
+		return errorsmod.Wrap(types.ErrDuplicateEpoch, epoch.Identifier)
+	}
+
+	if epoch.StartTime.IsZero() {
+		epoch.StartTime = ctx.BlockTime()
+	}
+	epoch.CurrentEpochStartHeight = ctx.BlockHeight()
+
+	k.SetEpochInfo(ctx, epoch)
+	return nil
+}
+
+// SetEpochInfo stores the epoch info.
+func (k Keeper) SetEpochInfo(ctx sdk.Context, epoch types.EpochInfo) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&epoch)
+	store.Set(types.EpochInfoKey(epoch.Identifier), bz)
+}
+
+// DeleteEpochInfo stops tracking the epoch with the given identifier.
+func (k Keeper) DeleteEpochInfo(ctx sdk.Context, identifier string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.EpochInfoKey(identifier))
+}
+
+// IterateEpochInfo iterates over all the epoch infos, ordered by identifier.
+// The iteration stops when the callback returns true.
+func (k Keeper) IterateEpochInfo(ctx sdk.Context, cb func(epoch types.EpochInfo) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.EpochInfoKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var epoch types.EpochInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &epoch)
+
+		if cb(epoch) {
+			break
+		}
+	}
+}
+
+// AllEpochInfos returns all the epoch infos, ordered by identifier.
+func (k Keeper) AllEpochInfos(ctx sdk.Context) []types.EpochInfo {
+	epochs := []types.EpochInfo{}
+	k.IterateEpochInfo(ctx, func(epoch types.EpochInfo) bool {
+		epochs = append(epochs, epoch)
+		return false
+	})
+
+	return epochs
+}
+
+// NumBlocksSinceEpochStart returns the number of blocks since the start of the
+// current epoch with the given identifier.
+func (k Keeper) NumBlocksSinceEpochStart(ctx sdk.Context, identifier string) (int64, error) {
+	epoch, found := k.GetEpochInfo(ctx, identifier)
+	if !found {
+		return 0, errorsmod.Wrap(types.ErrEpochNotFound, identifier)
+	}
+
+	return ctx.BlockHeight() - epoch.CurrentEpochStartHeight, nil
+}
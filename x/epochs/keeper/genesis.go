@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+// InitGenesis initializes the epochs module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	for _, epoch := range genState.Epochs {
+		if err := k.AddEpochInfo(ctx, epoch); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the epochs module's genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.AllEpochInfos(ctx))
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// EpochInfos returns all the epochs tracked by the module.
+func (k Keeper) EpochInfos(c context.Context, _ *types.QueryEpochInfosRequest) (*types.QueryEpochInfosResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryEpochInfosResponse{Epochs: k.AllEpochInfos(ctx)}, nil
+}
+
+// CurrentEpoch returns the number of the current epoch of a given identifier.
+func (k Keeper) CurrentEpoch(c context.Context, req *types.QueryCurrentEpochRequest) (*types.QueryCurrentEpochResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Identifier == "" {
+		return nil, status.Error(codes.InvalidArgument, "epoch identifier cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	epoch, found := k.GetEpochInfo(ctx, req.Identifier)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "epoch %s not found", req.Identifier)
+	}
+
+	return &types.QueryCurrentEpochResponse{CurrentEpoch: epoch.CurrentEpoch}, nil
+}
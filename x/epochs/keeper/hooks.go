@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+var _ types.EpochHooks = Keeper{}
+
+// AfterEpochEnd runs the AfterEpochEnd hooks. The state changes of a failing
+// hook are discarded and the error is logged, so that a hook cannot halt the
+// chain.
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	k.runHook(ctx, "AfterEpochEnd", epochIdentifier, epochNumber, func(ctx sdk.Context) error {
+		return k.hooks.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+	})
+	return nil
+}
+
+// BeforeEpochStart runs the BeforeEpochStart hooks. The state changes of a
+// failing hook are discarded and the error is logged, so that a hook cannot
+// halt the chain.
+func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	k.runHook(ctx, "BeforeEpochStart", epochIdentifier, epochNumber, func(ctx sdk.Context) error {
+		return k.hooks.BeforeEpochStart(ctx, epochIdentifier, epochNumber)
+	})
+	return nil
+}
+
+func (k Keeper) runHook(ctx sdk.Context, name, epochIdentifier string, epochNumber int64, fn func(sdk.Context) error) {
+	if k.hooks == nil {
+		return
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := fn(cacheCtx); err != nil {
+		k.Logger(ctx).Error(
+			"epoch hook failed",
+			"hook", name,
+			"epoch_identifier", epochIdentifier,
+			"epoch_number", epochNumber,
+			"err", err,
+		)
+		return
+	}
+
+	writeCache()
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"cosmossdk.io/log"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+// Keeper of the epochs store
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+	hooks    types.EpochHooks
+}
+
+// NewKeeper creates a new epochs Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey) *Keeper {
+	return &Keeper{
+		cdc:      cdc,
+		storeKey: key,
+	}
+}
+
+// SetHooks sets the epoch hooks. Multiple hooks can be combined with
+// types.NewMultiEpochHooks.
+func (k *Keeper) SetHooks(eh types.EpochHooks) {
+	if k.hooks != nil {
+		panic("cannot set epochs hooks twice")
+	}
+
+	k.hooks = eh
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/suite"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/epochs"
+	"github.com/cosmos/cosmos-sdk/x/epochs/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx          sdk.Context
+	epochsKeeper *keeper.Keeper
+	queryClient  types.QueryClient
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(epochs.AppModuleBasic{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 1, Time: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+	s.epochsKeeper = keeper.NewKeeper(encCfg.Codec, key)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, encCfg.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, s.epochsKeeper)
+	s.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func (s *KeeperTestSuite) TestAddEpochInfo() {
+	epoch := types.NewEpochInfo("hour", time.Hour, time.Time{})
+	s.Require().NoError(s.epochsKeeper.AddEpochInfo(s.ctx, epoch))
+
+	stored, found := s.epochsKeeper.GetEpochInfo(s.ctx, "hour")
+	s.Require().True(found)
+	s.Require().Equal(s.ctx.BlockTime(), stored.StartTime)
+	s.Require().Equal(s.ctx.BlockHeight(), stored.CurrentEpochStartHeight)
+	s.Require().False(stored.EpochCountingStarted)
+
+	err := s.epochsKeeper.AddEpochInfo(s.ctx, epoch)
+	s.Require().ErrorIs(err, types.ErrDuplicateEpoch)
+
+	err = s.epochsKeeper.AddEpochInfo(s.ctx, types.NewEpochInfo("minute", 0, time.Time{}))
+	s.Require().ErrorIs(err, types.ErrInvalidEpochInfo)
+
+	s.epochsKeeper.DeleteEpochInfo(s.ctx, "hour")
+	_, found = s.epochsKeeper.GetEpochInfo(s.ctx, "hour")
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	genState := types.DefaultGenesisState()
+	s.epochsKeeper.InitGenesis(s.ctx, genState)
+
+	exported := s.epochsKeeper.ExportGenesis(s.ctx)
+	s.Require().Len(exported.Epochs, 2)
+	for _, epoch := range exported.Epochs {
+		s.Require().Equal(s.ctx.BlockTime(), epoch.StartTime)
+	}
+	s.Require().Equal(types.DayEpochID, exported.Epochs[0].Identifier)
+	s.Require().Equal(types.WeekEpochID, exported.Epochs[1].Identifier)
+
+	res, err := s.queryClient.EpochInfos(s.ctx, &types.QueryEpochInfosRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(exported.Epochs, res.Epochs)
+}
+
+func (s *KeeperTestSuite) TestCurrentEpoch() {
+	epoch := types.NewEpochInfo("hour", time.Hour, time.Time{})
+	epoch.CurrentEpoch = 3
+	s.Require().NoError(s.epochsKeeper.AddEpochInfo(s.ctx, epoch))
+
+	_, err := s.queryClient.CurrentEpoch(s.ctx, &types.QueryCurrentEpochRequest{})
+	s.Require().Error(err)
+
+	_, err = s.queryClient.CurrentEpoch(s.ctx, &types.QueryCurrentEpochRequest{Identifier: "day"})
+	s.Require().ErrorContains(err, "not found")
+
+	res, err := s.queryClient.CurrentEpoch(s.ctx, &types.QueryCurrentEpochRequest{Identifier: "hour"})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(3), res.CurrentEpoch)
+}
+
+func (s *KeeperTestSuite) TestHooks() {
+	okHooks := &mockHooks{}
+	failingHooks := &mockHooks{err: errors.New("hook failed")}
+	s.epochsKeeper.SetHooks(types.NewMultiEpochHooks(okHooks, failingHooks))
+	s.Require().Panics(func() { s.epochsKeeper.SetHooks(okHooks) })
+
+	s.Require().NoError(s.epochsKeeper.AfterEpochEnd(s.ctx, "day", 1))
+	s.Require().NoError(s.epochsKeeper.BeforeEpochStart(s.ctx, "day", 2))
+	s.Require().Equal([]int64{1}, okHooks.ended)
+	s.Require().Equal([]int64{2}, okHooks.started)
+}
+
+type mockHooks struct {
+	err     error
+	ended   []int64
+	started []int64
+}
+
+func (h *mockHooks) AfterEpochEnd(_ sdk.Context, _ string, epochNumber int64) error {
+	h.ended = append(h.ended, epochNumber)
+	return h.err
+}
+
+func (h *mockHooks) BeforeEpochStart(_ sdk.Context, _ string, epochNumber int64) error {
+	h.started = append(h.started, epochNumber)
+	return h.err
+}
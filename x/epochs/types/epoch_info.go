@@ -0,0 +1,51 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Identifiers of the default epochs.
+const (
+	DayEpochID  = "day"
+	WeekEpochID = "week"
+)
+
+// NewEpochInfo returns an EpochInfo which starts counting at the given start
+// time. A zero start time is replaced by the block time at genesis.
+func NewEpochInfo(identifier string, duration time.Duration, startTime time.Time) EpochInfo {
+	return EpochInfo{
+		Identifier:              identifier,
+		StartTime:               startTime,
+		Duration:                duration,
+		CurrentEpoch:            0,
+		CurrentEpochStartHeight: 0,
+		CurrentEpochStartTime:   time.Time{},
+		EpochCountingStarted:    false,
+	}
+}
+
+// Validate performs a stateless validation of the epoch info.
+func (e EpochInfo) Validate() error {
+	if strings.TrimSpace(e.Identifier) == "" {
+		return errorsmod.Wrap(ErrInvalidEpochInfo, "epoch identifier cannot be blank")
+	}
+	if e.Duration <= 0 {
+		return errorsmod.Wrapf(ErrInvalidEpochInfo, "epoch %s: duration must be positive", e.Identifier)
+	}
+	if e.CurrentEpoch < 0 {
+		return errorsmod.Wrapf(ErrInvalidEpochInfo, "epoch %s: current epoch cannot be negative", e.Identifier)
+	}
+	if e.CurrentEpochStartHeight < 0 {
+		return errorsmod.Wrapf(ErrInvalidEpochInfo, "epoch %s: current epoch start height cannot be negative", e.Identifier)
+	}
+
+	return nil
+}
+
+// EndTime returns the time at which the current epoch ends.
+func (e EpochInfo) EndTime() time.Time {
+	return e.CurrentEpochStartTime.Add(e.Duration)
+}
@@ -0,0 +1,10 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/epochs module sentinel errors
+var (
+	ErrInvalidEpochInfo = errors.Register(ModuleName, 2, "invalid epoch info")
+	ErrEpochNotFound    = errors.Register(ModuleName, 3, "epoch not found")
+	ErrDuplicateEpoch   = errors.Register(ModuleName, 4, "duplicate epoch")
+)
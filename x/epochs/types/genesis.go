@@ -0,0 +1,38 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(epochs []EpochInfo) *GenesisState {
+	return &GenesisState{Epochs: epochs}
+}
+
+// DefaultGenesisState creates a default GenesisState object tracking a daily
+// and a weekly epoch, both starting at genesis.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState([]EpochInfo{
+		NewEpochInfo(DayEpochID, 24*time.Hour, time.Time{}),
+		NewEpochInfo(WeekEpochID, 7*24*time.Hour, time.Time{}),
+	})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	identifiers := make(map[string]bool, len(gs.Epochs))
+	for _, epoch := range gs.Epochs {
+		if identifiers[epoch.Identifier] {
+			return errorsmod.Wrap(ErrDuplicateEpoch, epoch.Identifier)
+		}
+		if err := epoch.Validate(); err != nil {
+			return err
+		}
+		identifiers[epoch.Identifier] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expErr   error
+	}{
+		{
+			name:     "default genesis",
+			genState: types.DefaultGenesisState(),
+		},
+		{
+			name:     "empty genesis",
+			genState: types.NewGenesisState(nil),
+		},
+		{
+			name: "duplicate identifier",
+			genState: types.NewGenesisState([]types.EpochInfo{
+				types.NewEpochInfo("day", 24*time.Hour, time.Time{}),
+				types.NewEpochInfo("day", time.Hour, time.Time{}),
+			}),
+			expErr: types.ErrDuplicateEpoch,
+		},
+		{
+			name: "blank identifier",
+			genState: types.NewGenesisState([]types.EpochInfo{
+				types.NewEpochInfo(" ", time.Hour, time.Time{}),
+			}),
+			expErr: types.ErrInvalidEpochInfo,
+		},
+		{
+			name: "zero duration",
+			genState: types.NewGenesisState([]types.EpochInfo{
+				types.NewEpochInfo("hour", 0, time.Time{}),
+			}),
+			expErr: types.ErrInvalidEpochInfo,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EpochHooks defines the hooks called by the epochs module when an epoch ends
+// and a new one starts. Modules register them to run logic once per period
+// instead of every N blocks.
+type EpochHooks interface {
+	// AfterEpochEnd is called when an epoch ends, with the number of the epoch
+	// which ended.
+	AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error
+	// BeforeEpochStart is called when a new epoch starts, with the number of the
+	// epoch which starts.
+	BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error
+}
+
+var _ EpochHooks = MultiEpochHooks{}
+
+// MultiEpochHooks combines multiple epoch hooks. All hook functions are run in
+// sequence, each on its own branch of the state: the state changes of a hook
+// returning an error are discarded without affecting the other hooks.
+type MultiEpochHooks []EpochHooks
+
+// NewMultiEpochHooks returns a MultiEpochHooks running the given hooks.
+func NewMultiEpochHooks(hooks ...EpochHooks) MultiEpochHooks {
+	return hooks
+}
+
+// AfterEpochEnd runs the AfterEpochEnd hook of all the hooks.
+func (h MultiEpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return h.run(ctx, func(ctx sdk.Context, hook EpochHooks) error {
+		return hook.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+	})
+}
+
+// BeforeEpochStart runs the BeforeEpochStart hook of all the hooks.
+func (h MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return h.run(ctx, func(ctx sdk.Context, hook EpochHooks) error {
+		return hook.BeforeEpochStart(ctx, epochIdentifier, epochNumber)
+	})
+}
+
+func (h MultiEpochHooks) run(ctx sdk.Context, fn func(sdk.Context, EpochHooks) error) error {
+	var errs []error
+	for i := range h {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := fn(cacheCtx, h[i]); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		writeCache()
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,17 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "epochs"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+// EpochInfoKeyPrefix is the prefix of the keys storing the epoch infos.
+var EpochInfoKeyPrefix = []byte{0x01}
+
+// EpochInfoKey returns the store key of the epoch info with the given identifier.
+func EpochInfoKey(identifier string) []byte {
+	return append(EpochInfoKeyPrefix, []byte(identifier)...)
+}
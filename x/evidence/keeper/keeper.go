@@ -70,7 +70,7 @@ func (k *Keeper) SetRouter(rtr types.Router) {
 // GetEvidenceHandler returns a registered Handler for a given Evidence type. If
 // no handler exists, an error is returned.
 func (k Keeper) GetEvidenceHandler(evidenceRoute string) (types.Handler, error) {
-	if !k.router.HasRoute(evidenceRoute) {
+	if k.router == nil || !k.router.HasRoute(evidenceRoute) {
 		return nil, errors.Wrap(types.ErrNoEvidenceHandlerExists, evidenceRoute)
 	}
 
@@ -85,11 +85,11 @@ func (k Keeper) SubmitEvidence(ctx sdk.Context, evidence exported.Evidence) erro
 	if _, ok := k.GetEvidence(ctx, evidence.Hash()); ok {
 		return errors.Wrap(types.ErrEvidenceExists, strings.ToUpper(hex.EncodeToString(evidence.Hash())))
 	}
-	if !k.router.HasRoute(evidence.Route()) {
-		return errors.Wrap(types.ErrNoEvidenceHandlerExists, evidence.Route())
+	handler, err := k.GetEvidenceHandler(evidence.Route())
+	if err != nil {
+		return err
 	}
 
-	handler := k.router.GetRoute(evidence.Route())
 	if err := handler(ctx, evidence); err != nil {
 		return errors.Wrap(types.ErrInvalidEvidence, err.Error())
 	}
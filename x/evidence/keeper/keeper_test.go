@@ -219,4 +219,10 @@ func (suite *KeeperTestSuite) TestGetEvidenceHandler() {
 	handler, err = suite.evidenceKeeper.GetEvidenceHandler("invalidHandler")
 	suite.Error(err)
 	suite.Nil(handler)
+
+	// a keeper without router has no handler
+	var k keeper.Keeper
+	handler, err = k.GetEvidenceHandler((&types.Equivocation{}).Route())
+	suite.ErrorIs(err, types.ErrNoEvidenceHandlerExists)
+	suite.Nil(handler)
 }
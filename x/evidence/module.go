@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
@@ -67,6 +69,11 @@ func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
 	return cdc.MustMarshalJSON(types.DefaultGenesisState())
 }
 
+// NewGenesisState returns an empty genesis state of the evidence module.
+func (AppModuleBasic) NewGenesisState() proto.Message {
+	return &types.GenesisState{}
+}
+
 // ValidateGenesis performs genesis state validation for the evidence module.
 func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
 	var gs types.GenesisState
@@ -209,6 +216,10 @@ type ModuleInputs struct {
 	StakingKeeper  types.StakingKeeper
 	SlashingKeeper types.SlashingKeeper
 	AddressCodec   address.Codec
+
+	// HandlerRoutes are the handlers of custom Evidence types provided by the
+	// application or other modules.
+	HandlerRoutes []types.HandlerRoute
 }
 
 type ModuleOutputs struct {
@@ -220,6 +231,22 @@ type ModuleOutputs struct {
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
 	k := keeper.NewKeeper(in.Cdc, in.Key, in.StakingKeeper, in.SlashingKeeper, in.AddressCodec)
+
+	// The router can only be set once, so it is left to the application when
+	// no handler is provided.
+	if len(in.HandlerRoutes) > 0 {
+		// Default route order is a lexical sort by Route.
+		sort.Slice(in.HandlerRoutes, func(i, j int) bool {
+			return in.HandlerRoutes[i].Route < in.HandlerRoutes[j].Route
+		})
+
+		router := types.NewRouter()
+		for _, r := range in.HandlerRoutes {
+			router.AddRoute(r.Route, r.Handler)
+		}
+		k.SetRouter(router)
+	}
+
 	m := NewAppModule(*k)
 
 	return ModuleOutputs{EvidenceKeeper: *k, Module: m}
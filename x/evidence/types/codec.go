@@ -1,6 +1,8 @@
 package types
 
 import (
+	"github.com/cosmos/gogoproto/proto"
+
 	"cosmossdk.io/x/evidence/exported"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -34,6 +36,19 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
+// RegisterEvidenceImplementations registers custom Evidence implementations
+// with the interface registry, so that they can be submitted with
+// MsgSubmitEvidence and persisted by the evidence module. A Handler must be
+// registered on the router for the Route of each of the Evidence types.
+func RegisterEvidenceImplementations(registry types.InterfaceRegistry, evidence ...exported.Evidence) {
+	impls := make([]proto.Message, len(evidence))
+	for i, e := range evidence {
+		impls[i] = e
+	}
+
+	registry.RegisterImplementations((*exported.Evidence)(nil), impls...)
+}
+
 var (
 	amino     = codec.NewLegacyAmino()
 	ModuleCdc = codec.NewAminoCodec(amino)
@@ -25,6 +25,16 @@ type (
 		Sealed() bool
 	}
 
+	// HandlerRoute defines a Handler for the Evidence types routed on Route.
+	// Applications and modules can provide a HandlerRoute to register the
+	// verification and slashing logic of custom Evidence types, which must also
+	// be registered with the interface registry through
+	// RegisterEvidenceImplementations.
+	HandlerRoute struct {
+		Route   string
+		Handler Handler
+	}
+
 	router struct {
 		routes map[string]Handler
 		sealed bool
@@ -80,3 +90,6 @@ func (rtr *router) GetRoute(path string) Handler {
 	}
 	return rtr.routes[path]
 }
+
+// IsManyPerContainerType implements the depinject.ManyPerContainerType interface.
+func (HandlerRoute) IsManyPerContainerType() {}
@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/x/evidence/types"
 	"github.com/stretchr/testify/require"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -26,3 +27,16 @@ func TestRouter(t *testing.T) {
 	require.Panics(t, func() { r.AddRoute("test", testHandler) })
 	require.Panics(t, func() { r.AddRoute("    ", testHandler) })
 }
+
+func TestRegisterEvidenceImplementations(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	registry.RegisterInterface("cosmos.evidence.v1beta1.Evidence", (*exported.Evidence)(nil))
+	types.RegisterEvidenceImplementations(registry, &types.Equivocation{})
+
+	evidenceAny, err := codectypes.NewAnyWithValue(&types.Equivocation{Height: 10})
+	require.NoError(t, err)
+
+	var evidence exported.Evidence
+	require.NoError(t, registry.UnpackAny(evidenceAny, &evidence))
+	require.Equal(t, int64(10), evidence.GetHeight())
+}
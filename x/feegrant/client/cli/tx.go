@@ -19,11 +19,12 @@ import (
 
 // flag for feegrant module
 const (
-	FlagExpiration  = "expiration"
-	FlagPeriod      = "period"
-	FlagPeriodLimit = "period-limit"
-	FlagSpendLimit  = "spend-limit"
-	FlagAllowedMsgs = "allowed-messages"
+	FlagExpiration    = "expiration"
+	FlagPeriod        = "period"
+	FlagPeriodLimit   = "period-limit"
+	FlagSpendLimit    = "spend-limit"
+	FlagAllowedMsgs   = "allowed-messages"
+	FlagAllowedDenoms = "allowed-denoms"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -59,8 +60,11 @@ Examples:
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --period 3600 --period-limit 10stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z 
-	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote"
+	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote" or
+%s tx %s grant cosmos1skjw... cosmos1skjw... --period 3600 --period-limit 10stake
+	--allowed-messages "/cosmos.gov.v1beta1.MsgVote" --allowed-denoms stake
 				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
+				version.AppName, feegrant.ModuleName,
 			),
 		),
 		Args: cobra.ExactArgs(2),
@@ -106,7 +110,10 @@ Examples:
 				basic.Expiration = &expiresAtTime
 			}
 
-			var grant feegrant.FeeAllowanceI
+			var (
+				grant    feegrant.FeeAllowanceI
+				periodic *feegrant.PeriodicAllowance
+			)
 			grant = &basic
 
 			periodClock, err := cmd.Flags().GetInt64(FlagPeriod)
@@ -139,7 +146,7 @@ Examples:
 					return fmt.Errorf("period (%d) cannot reset after expiration (%v)", periodClock, exp)
 				}
 
-				periodic := feegrant.PeriodicAllowance{
+				periodic = &feegrant.PeriodicAllowance{
 					Basic:            basic,
 					Period:           getPeriod(periodClock),
 					PeriodReset:      getPeriodReset(periodClock),
@@ -147,7 +154,7 @@ Examples:
 					PeriodCanSpend:   periodLimit,
 				}
 
-				grant = &periodic
+				grant = periodic
 			}
 
 			allowedMsgs, err := cmd.Flags().GetStringSlice(FlagAllowedMsgs)
@@ -155,7 +162,22 @@ Examples:
 				return err
 			}
 
-			if len(allowedMsgs) > 0 {
+			allowedDenoms, err := cmd.Flags().GetStringSlice(FlagAllowedDenoms)
+			if err != nil {
+				return err
+			}
+
+			if len(allowedDenoms) > 0 {
+				if periodic == nil {
+					return fmt.Errorf("allowed denoms can only be set on a periodic allowance")
+				}
+
+				if len(allowedMsgs) == 0 {
+					return fmt.Errorf("allowed messages must be set with allowed denoms")
+				}
+
+				grant = feegrant.NewFilteredPeriodicAllowance(*periodic, allowedMsgs, allowedDenoms)
+			} else if len(allowedMsgs) > 0 {
 				grant, err = feegrant.NewAllowedMsgAllowance(grant, allowedMsgs)
 				if err != nil {
 					return err
@@ -173,6 +195,7 @@ Examples:
 
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().StringSlice(FlagAllowedMsgs, []string{}, "Set of allowed messages for fee allowance")
+	cmd.Flags().StringSlice(FlagAllowedDenoms, []string{}, "Set of allowed fee denoms for a periodic fee allowance restricted to the allowed messages")
 	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the grant expires for the user")
 	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
 	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration(in seconds) in which period_limit coins can be spent before that allowance is reset (ex: 3600)")
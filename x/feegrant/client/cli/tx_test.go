@@ -771,6 +771,88 @@ func (s *CLITestSuite) TestFilteredFeeAllowance() {
 }
 
 // msgVote votes for a proposal
+func (s *CLITestSuite) TestFilteredPeriodicFeeAllowance() {
+	granter := s.addedGranter
+	grantee := s.addedGrantee
+	clientCtx := s.clientCtx
+
+	commonFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))).String()),
+	}
+	allowMsgs := sdk.MsgTypeURL(&govv1.MsgVote{})
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErrMsg string
+	}{
+		{
+			"allowed denoms without period",
+			append(
+				[]string{
+					granter.String(),
+					grantee.String(),
+					fmt.Sprintf("--%s=%s", cli.FlagAllowedMsgs, allowMsgs),
+					fmt.Sprintf("--%s=%s", cli.FlagAllowedDenoms, sdk.DefaultBondDenom),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			"allowed denoms can only be set on a periodic allowance",
+		},
+		{
+			"allowed denoms without allowed messages",
+			append(
+				[]string{
+					granter.String(),
+					grantee.String(),
+					fmt.Sprintf("--%s=%d", cli.FlagPeriod, 3600),
+					fmt.Sprintf("--%s=%s", cli.FlagPeriodLimit, "10stake"),
+					fmt.Sprintf("--%s=%s", cli.FlagAllowedDenoms, sdk.DefaultBondDenom),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			"allowed messages must be set with allowed denoms",
+		},
+		{
+			"valid filtered periodic fee grant",
+			append(
+				[]string{
+					granter.String(),
+					grantee.String(),
+					fmt.Sprintf("--%s=%d", cli.FlagPeriod, 3600),
+					fmt.Sprintf("--%s=%s", cli.FlagPeriodLimit, "10stake"),
+					fmt.Sprintf("--%s=%s", cli.FlagAllowedMsgs, allowMsgs),
+					fmt.Sprintf("--%s=%s", cli.FlagAllowedDenoms, sdk.DefaultBondDenom),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+					fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+				},
+				commonFlags...,
+			),
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewCmdFeeGrant(codecaddress.NewBech32Codec("cosmos"))
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErrMsg != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expectErrMsg)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Contains(out.String(), "/cosmos.feegrant.v1beta1.FilteredPeriodicAllowance")
+			}
+		})
+	}
+}
+
 func (s *CLITestSuite) msgVote(clientCtx client.Context, from, id, vote string, extraArgs ...string) error {
 	commonArgs := []string{
 		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
@@ -6,6 +6,7 @@ const (
 	EventTypeRevokeFeeGrant = "revoke_feegrant"
 	EventTypeSetFeeGrant    = "set_feegrant"
 	EventTypeUpdateFeeGrant = "update_feegrant"
+	EventTypePruneFeeGrant  = "prune_feegrant"
 
 	AttributeKeyGranter = "granter"
 	AttributeKeyGrantee = "grantee"
@@ -219,6 +219,74 @@ func (m *AllowedMsgAllowance) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AllowedMsgAllowance proto.InternalMessageInfo
 
+// FilteredPeriodicAllowance extends PeriodicAllowance to restrict the
+// allowance to the allowed message types and the allowed fee denoms.
+//
+// Since: cosmos-sdk 0.50
+type FilteredPeriodicAllowance struct {
+	// periodic specifies the periodic allowance used by the grantee.
+	Periodic PeriodicAllowance `protobuf:"bytes,1,opt,name=periodic,proto3" json:"periodic"`
+	// allowed_messages are the messages for which the grantee has the access.
+	AllowedMessages []string `protobuf:"bytes,2,rep,name=allowed_messages,json=allowedMessages,proto3" json:"allowed_messages,omitempty"`
+	// allowed_denoms are the denoms the fees can be paid with. If it is empty,
+	// the fees can be paid with any denom.
+	AllowedDenoms []string `protobuf:"bytes,3,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+}
+
+func (m *FilteredPeriodicAllowance) Reset()         { *m = FilteredPeriodicAllowance{} }
+func (m *FilteredPeriodicAllowance) String() string { return proto.CompactTextString(m) }
+func (*FilteredPeriodicAllowance) ProtoMessage()    {}
+func (*FilteredPeriodicAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{3}
+}
+func (m *FilteredPeriodicAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FilteredPeriodicAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FilteredPeriodicAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FilteredPeriodicAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilteredPeriodicAllowance.Merge(m, src)
+}
+func (m *FilteredPeriodicAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *FilteredPeriodicAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilteredPeriodicAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilteredPeriodicAllowance proto.InternalMessageInfo
+
+func (m *FilteredPeriodicAllowance) GetPeriodic() PeriodicAllowance {
+	if m != nil {
+		return m.Periodic
+	}
+	return PeriodicAllowance{}
+}
+
+func (m *FilteredPeriodicAllowance) GetAllowedMessages() []string {
+	if m != nil {
+		return m.AllowedMessages
+	}
+	return nil
+}
+
+func (m *FilteredPeriodicAllowance) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
 // Grant is stored in the KVStore to record a grant with full context
 type Grant struct {
 	// granter is the address of the user granting an allowance of their funds.
@@ -233,7 +301,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7279582900c30aea, []int{3}
+	return fileDescriptor_7279582900c30aea, []int{4}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -287,6 +355,7 @@ func init() {
 	proto.RegisterType((*BasicAllowance)(nil), "cosmos.feegrant.v1beta1.BasicAllowance")
 	proto.RegisterType((*PeriodicAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicAllowance")
 	proto.RegisterType((*AllowedMsgAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedMsgAllowance")
+	proto.RegisterType((*FilteredPeriodicAllowance)(nil), "cosmos.feegrant.v1beta1.FilteredPeriodicAllowance")
 	proto.RegisterType((*Grant)(nil), "cosmos.feegrant.v1beta1.Grant")
 }
 
@@ -295,49 +364,53 @@ func init() {
 }
 
 var fileDescriptor_7279582900c30aea = []byte{
-	// 658 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x4f, 0xd4, 0x4e,
-	0x14, 0xdf, 0x61, 0x81, 0x6f, 0x76, 0x96, 0x2f, 0x42, 0x25, 0xb1, 0x4b, 0x4c, 0x4b, 0x36, 0x51,
-	0x17, 0x12, 0xda, 0x80, 0x37, 0x4e, 0x50, 0x0c, 0xa8, 0x81, 0x84, 0x14, 0x4f, 0x26, 0xa6, 0x99,
-	0xb6, 0x43, 0x9d, 0xb0, 0xed, 0x34, 0x9d, 0xa2, 0xec, 0xd5, 0x93, 0xd1, 0x83, 0x1c, 0x8d, 0x27,
-	0x8e, 0xc6, 0x13, 0x07, 0xfe, 0x08, 0xe2, 0xc1, 0x10, 0x4f, 0x7a, 0x11, 0x03, 0x07, 0xce, 0xfe,
-	0x07, 0xa6, 0x33, 0xd3, 0x6e, 0x61, 0x25, 0x42, 0x62, 0xb8, 0xec, 0x76, 0xde, 0xbc, 0xf7, 0xf9,
-	0xf1, 0xde, 0x6b, 0x0a, 0xef, 0x7a, 0x94, 0x85, 0x94, 0x99, 0x1b, 0x18, 0x07, 0x09, 0x8a, 0x52,
-	0xf3, 0xc5, 0x8c, 0x8b, 0x53, 0x34, 0x53, 0x04, 0x8c, 0x38, 0xa1, 0x29, 0x55, 0x6e, 0x89, 0x3c,
-	0xa3, 0x08, 0xcb, 0xbc, 0xf1, 0xb1, 0x80, 0x06, 0x94, 0xe7, 0x98, 0xd9, 0x93, 0x48, 0x1f, 0x6f,
-	0x04, 0x94, 0x06, 0x6d, 0x6c, 0xf2, 0x93, 0xbb, 0xb5, 0x61, 0xa2, 0xa8, 0x93, 0x5f, 0x09, 0x24,
-	0x47, 0xd4, 0x48, 0x58, 0x71, 0xa5, 0x49, 0x31, 0x2e, 0x62, 0xb8, 0x10, 0xe2, 0x51, 0x12, 0xc9,
-	0xfb, 0x51, 0x14, 0x92, 0x88, 0x9a, 0xfc, 0x57, 0x86, 0xf4, 0xf3, 0x44, 0x29, 0x09, 0x31, 0x4b,
-	0x51, 0x18, 0xe7, 0x98, 0xe7, 0x13, 0xfc, 0xad, 0x04, 0xa5, 0x84, 0x4a, 0xcc, 0xe6, 0x6e, 0x1f,
-	0x1c, 0xb6, 0x10, 0x23, 0xde, 0x42, 0xbb, 0x4d, 0x5f, 0xa2, 0xc8, 0xc3, 0xca, 0x2b, 0x00, 0xeb,
-	0x2c, 0xc6, 0x91, 0xef, 0xb4, 0x49, 0x48, 0x52, 0x15, 0x4c, 0x54, 0x5b, 0xf5, 0xd9, 0x86, 0x21,
-	0xb5, 0x66, 0xea, 0x72, 0xfb, 0xc6, 0x22, 0x25, 0x91, 0xb5, 0x74, 0xf0, 0x43, 0xaf, 0x7c, 0x3a,
-	0xd2, 0x5b, 0x01, 0x49, 0x9f, 0x6f, 0xb9, 0x86, 0x47, 0x43, 0x69, 0x4c, 0xfe, 0x4d, 0x33, 0x7f,
-	0xd3, 0x4c, 0x3b, 0x31, 0x66, 0xbc, 0x80, 0x7d, 0x38, 0xdd, 0x9b, 0x1a, 0x6a, 0xe3, 0x00, 0x79,
-	0x1d, 0x27, 0xf3, 0xc7, 0x3e, 0x9e, 0xee, 0x4d, 0x01, 0x1b, 0x72, 0xd6, 0x95, 0x8c, 0x54, 0x99,
-	0x87, 0x10, 0x6f, 0xc7, 0x44, 0x68, 0x55, 0xfb, 0x26, 0x40, 0xab, 0x3e, 0x3b, 0x6e, 0x08, 0x33,
-	0x46, 0x6e, 0xc6, 0x78, 0x92, 0xbb, 0xb5, 0xfa, 0x77, 0x8e, 0x74, 0x60, 0x97, 0x6a, 0xe6, 0x96,
-	0x3f, 0xef, 0x4f, 0xdf, 0xb9, 0x60, 0x6c, 0xc6, 0x12, 0xc6, 0x85, 0xe1, 0x47, 0x6f, 0x4e, 0xf7,
-	0xa6, 0x1a, 0x25, 0xa5, 0x67, 0xfb, 0xd1, 0xfc, 0xde, 0x0f, 0x47, 0xd7, 0x70, 0x42, 0xa8, 0x5f,
-	0xee, 0xd2, 0x43, 0x38, 0xe0, 0x66, 0x79, 0x2a, 0xe0, 0xda, 0xee, 0x19, 0x17, 0x51, 0x9d, 0x45,
-	0xb3, 0x6a, 0x59, 0xb3, 0x84, 0x5f, 0x01, 0xa0, 0xcc, 0xc3, 0xc1, 0x98, 0xc3, 0x4b, 0x9b, 0x8d,
-	0x1e, 0x9b, 0x0f, 0xe4, 0xcc, 0xac, 0xff, 0xb3, 0xe2, 0xf7, 0x47, 0x3a, 0x10, 0x00, 0xb2, 0x4e,
-	0x79, 0x07, 0xa0, 0x22, 0x1e, 0x9d, 0xf2, 0xe0, 0xaa, 0xd7, 0x35, 0xb8, 0x11, 0x41, 0xbe, 0xde,
-	0x1d, 0xdf, 0x5b, 0x00, 0x65, 0xd0, 0xf1, 0x50, 0x24, 0x54, 0xa9, 0xfd, 0xd7, 0xa5, 0x67, 0x58,
-	0x50, 0x2f, 0xa2, 0x88, 0x4b, 0x52, 0x56, 0xe0, 0x90, 0x14, 0x93, 0x60, 0x86, 0x53, 0x75, 0xe0,
-	0xaf, 0xeb, 0xc4, 0x1b, 0xbd, 0x53, 0x34, 0xba, 0x2e, 0xca, 0xed, 0xac, 0x7a, 0xee, 0xf1, 0x95,
-	0x16, 0xeb, 0x76, 0x49, 0x79, 0xcf, 0x16, 0x35, 0x7f, 0x01, 0x78, 0x93, 0x9f, 0xb0, 0xbf, 0xca,
-	0x82, 0xee, 0x76, 0x3d, 0x83, 0x35, 0x94, 0x1f, 0xe4, 0x86, 0x8d, 0xf5, 0xc8, 0x5d, 0x88, 0x3a,
-	0xd6, 0xe4, 0xa5, 0xc5, 0xd8, 0x5d, 0x44, 0x65, 0x12, 0x8e, 0x20, 0xc1, 0xea, 0x84, 0x98, 0x31,
-	0x14, 0x60, 0xa6, 0xf6, 0x4d, 0x54, 0x5b, 0x35, 0xfb, 0x86, 0x8c, 0xaf, 0xca, 0xf0, 0xdc, 0xda,
-	0xeb, 0x5d, 0xbd, 0x72, 0x25, 0xc7, 0x5a, 0xc9, 0xf1, 0x1f, 0xbc, 0x35, 0xbf, 0x00, 0x38, 0xb0,
-	0x9c, 0x41, 0x28, 0xb3, 0xf0, 0x3f, 0x8e, 0x85, 0x13, 0xee, 0xb1, 0x66, 0xa9, 0x5f, 0xf7, 0xa7,
-	0xc7, 0x24, 0xd1, 0x82, 0xef, 0x27, 0x98, 0xb1, 0xf5, 0x34, 0x21, 0x51, 0x60, 0xe7, 0x89, 0xdd,
-	0x1a, 0xcc, 0x5f, 0x97, 0x4b, 0xd4, 0x9c, 0xeb, 0x66, 0xf5, 0x5f, 0x77, 0xd3, 0x9a, 0x39, 0x38,
-	0xd6, 0xc0, 0xe1, 0xb1, 0x06, 0x7e, 0x1e, 0x6b, 0x60, 0xe7, 0x44, 0xab, 0x1c, 0x9e, 0x68, 0x95,
-	0x6f, 0x27, 0x5a, 0xe5, 0xa9, 0xfc, 0x6c, 0x30, 0x7f, 0xd3, 0x20, 0xd4, 0xdc, 0x2e, 0xbe, 0x2a,
-	0xee, 0x20, 0xa7, 0xbd, 0xff, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x76, 0xbd, 0x15, 0x80, 0x06,
-	0x00, 0x00,
+	// 721 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xb1, 0x4f, 0xdb, 0x4c,
+	0x14, 0xcf, 0x25, 0xc0, 0xf7, 0xe5, 0x02, 0x7c, 0xe0, 0x0f, 0xa9, 0x0e, 0xaa, 0x1c, 0x14, 0x95,
+	0x36, 0x44, 0xc2, 0x16, 0x74, 0xcb, 0x04, 0x06, 0x41, 0x5b, 0x81, 0x44, 0x4d, 0xa7, 0x4a, 0x55,
+	0x74, 0x89, 0x0f, 0xf7, 0x44, 0xec, 0xb3, 0x7c, 0xa6, 0x25, 0x6b, 0xa7, 0xaa, 0x1d, 0xca, 0xd0,
+	0xa1, 0xea, 0xc4, 0x58, 0x75, 0x62, 0xe0, 0x8f, 0x40, 0x1d, 0x2a, 0xd4, 0xa9, 0x5d, 0x4a, 0x05,
+	0x03, 0x73, 0xff, 0x83, 0xca, 0x77, 0x67, 0xc7, 0x90, 0x46, 0x25, 0x52, 0xc5, 0x92, 0xd8, 0xef,
+	0xde, 0xef, 0xf7, 0x7e, 0xbf, 0xf7, 0xde, 0xc9, 0xf0, 0x76, 0x93, 0x32, 0x97, 0x32, 0x63, 0x0b,
+	0x63, 0x27, 0x40, 0x5e, 0x68, 0x3c, 0x9b, 0x6b, 0xe0, 0x10, 0xcd, 0x25, 0x01, 0xdd, 0x0f, 0x68,
+	0x48, 0x95, 0x1b, 0x22, 0x4f, 0x4f, 0xc2, 0x32, 0x6f, 0x72, 0xc2, 0xa1, 0x0e, 0xe5, 0x39, 0x46,
+	0xf4, 0x24, 0xd2, 0x27, 0x8b, 0x0e, 0xa5, 0x4e, 0x0b, 0x1b, 0xfc, 0xad, 0xb1, 0xb3, 0x65, 0x20,
+	0xaf, 0x1d, 0x1f, 0x09, 0xa6, 0xba, 0xc0, 0x48, 0x5a, 0x71, 0xa4, 0x49, 0x31, 0x0d, 0xc4, 0x70,
+	0x22, 0xa4, 0x49, 0x89, 0x27, 0xcf, 0xc7, 0x91, 0x4b, 0x3c, 0x6a, 0xf0, 0x5f, 0x19, 0x2a, 0x5d,
+	0x2e, 0x14, 0x12, 0x17, 0xb3, 0x10, 0xb9, 0x7e, 0xcc, 0x79, 0x39, 0xc1, 0xde, 0x09, 0x50, 0x48,
+	0xa8, 0xe4, 0x2c, 0xef, 0x67, 0xe1, 0xa8, 0x89, 0x18, 0x69, 0x2e, 0xb6, 0x5a, 0xf4, 0x39, 0xf2,
+	0x9a, 0x58, 0x79, 0x01, 0x60, 0x81, 0xf9, 0xd8, 0xb3, 0xeb, 0x2d, 0xe2, 0x92, 0x50, 0x05, 0x53,
+	0xb9, 0x4a, 0x61, 0xbe, 0xa8, 0x4b, 0xad, 0x91, 0xba, 0xd8, 0xbe, 0xbe, 0x44, 0x89, 0x67, 0xae,
+	0x1c, 0x7d, 0x2f, 0x65, 0x3e, 0x9e, 0x94, 0x2a, 0x0e, 0x09, 0x9f, 0xee, 0x34, 0xf4, 0x26, 0x75,
+	0xa5, 0x31, 0xf9, 0x37, 0xcb, 0xec, 0x6d, 0x23, 0x6c, 0xfb, 0x98, 0x71, 0x00, 0x7b, 0x7f, 0x7e,
+	0x50, 0x1d, 0x6e, 0x61, 0x07, 0x35, 0xdb, 0xf5, 0xc8, 0x1f, 0xfb, 0x70, 0x7e, 0x50, 0x05, 0x16,
+	0xe4, 0x55, 0xd7, 0xa2, 0xa2, 0xca, 0x02, 0x84, 0x78, 0xd7, 0x27, 0x42, 0xab, 0x9a, 0x9d, 0x02,
+	0x95, 0xc2, 0xfc, 0xa4, 0x2e, 0xcc, 0xe8, 0xb1, 0x19, 0xfd, 0x51, 0xec, 0xd6, 0x1c, 0xd8, 0x3b,
+	0x29, 0x01, 0x2b, 0x85, 0xa9, 0xad, 0x7e, 0x3a, 0x9c, 0x9d, 0xee, 0x31, 0x36, 0x7d, 0x05, 0xe3,
+	0xc4, 0xf0, 0xfd, 0x57, 0xe7, 0x07, 0xd5, 0x62, 0x4a, 0xe9, 0xc5, 0x7e, 0x94, 0xbf, 0x0d, 0xc0,
+	0xf1, 0x0d, 0x1c, 0x10, 0x6a, 0xa7, 0xbb, 0x74, 0x0f, 0x0e, 0x36, 0xa2, 0x3c, 0x15, 0x70, 0x6d,
+	0x77, 0xf4, 0x5e, 0xa5, 0x2e, 0xb2, 0x99, 0xf9, 0xa8, 0x59, 0xc2, 0xaf, 0x20, 0x50, 0x16, 0xe0,
+	0x90, 0xcf, 0xe9, 0xa5, 0xcd, 0x62, 0x97, 0xcd, 0x65, 0x39, 0x33, 0x73, 0x24, 0x02, 0xbf, 0x3b,
+	0x29, 0x01, 0x41, 0x20, 0x71, 0xca, 0x1b, 0x00, 0x15, 0xf1, 0x58, 0x4f, 0x0f, 0x2e, 0x77, 0x5d,
+	0x83, 0x1b, 0x13, 0xc5, 0x37, 0x3b, 0xe3, 0x7b, 0x0d, 0xa0, 0x0c, 0xd6, 0x9b, 0xc8, 0x13, 0xaa,
+	0xd4, 0x81, 0xeb, 0xd2, 0x33, 0x2a, 0x4a, 0x2f, 0x21, 0x8f, 0x4b, 0x52, 0xd6, 0xe0, 0xb0, 0x14,
+	0x13, 0x60, 0x86, 0x43, 0x75, 0xf0, 0x8f, 0xeb, 0xc4, 0x1b, 0xbd, 0x97, 0x34, 0xba, 0x20, 0xe0,
+	0x56, 0x84, 0xae, 0x3d, 0xe8, 0x6b, 0xb1, 0x6e, 0xa6, 0x94, 0x77, 0x6d, 0x51, 0xf9, 0x27, 0x80,
+	0xff, 0xf3, 0x37, 0x6c, 0xaf, 0x33, 0xa7, 0xb3, 0x5d, 0x4f, 0x60, 0x1e, 0xc5, 0x2f, 0x72, 0xc3,
+	0x26, 0xba, 0xe4, 0x2e, 0x7a, 0x6d, 0x73, 0xe6, 0xca, 0x62, 0xac, 0x0e, 0xa3, 0x32, 0x03, 0xc7,
+	0x90, 0xa8, 0x5a, 0x77, 0x31, 0x63, 0xc8, 0xc1, 0x4c, 0xcd, 0x4e, 0xe5, 0x2a, 0x79, 0xeb, 0x3f,
+	0x19, 0x5f, 0x97, 0xe1, 0xda, 0xc6, 0xcb, 0xfd, 0x52, 0xa6, 0x2f, 0xc7, 0x5a, 0xca, 0xf1, 0x6f,
+	0xbc, 0x95, 0xdf, 0x66, 0x61, 0x71, 0x85, 0xb4, 0x42, 0x1c, 0x60, 0xbb, 0xfb, 0x5e, 0x3d, 0x84,
+	0xff, 0xfa, 0x32, 0x28, 0x8d, 0x57, 0x7b, 0x5e, 0xad, 0x2e, 0x74, 0xfa, 0x76, 0x25, 0x34, 0x7d,
+	0xb8, 0x55, 0xa6, 0xe1, 0x68, 0x9c, 0x6a, 0x63, 0x8f, 0xba, 0x8c, 0x5f, 0xa2, 0xbc, 0x35, 0x22,
+	0xa3, 0xcb, 0x3c, 0x58, 0xb3, 0xfa, 0x6a, 0xc8, 0xad, 0x54, 0x43, 0x7a, 0x1a, 0x2f, 0x7f, 0x06,
+	0x70, 0x70, 0x35, 0x22, 0x52, 0xe6, 0xe1, 0x3f, 0x9c, 0x11, 0x07, 0xbc, 0x03, 0x79, 0x53, 0xfd,
+	0x72, 0x38, 0x3b, 0x21, 0xcb, 0x2d, 0xda, 0x76, 0x80, 0x19, 0xdb, 0x0c, 0x03, 0xe2, 0x39, 0x56,
+	0x9c, 0xd8, 0xc1, 0x60, 0x35, 0x7b, 0x35, 0xcc, 0xa5, 0x25, 0xcb, 0xfd, 0xed, 0x25, 0x33, 0xe7,
+	0x8e, 0x4e, 0x35, 0x70, 0x7c, 0xaa, 0x81, 0x1f, 0xa7, 0x1a, 0xd8, 0x3b, 0xd3, 0x32, 0xc7, 0x67,
+	0x5a, 0xe6, 0xeb, 0x99, 0x96, 0x79, 0x2c, 0xbf, 0xa6, 0xcc, 0xde, 0xd6, 0x09, 0x35, 0x76, 0x93,
+	0x8f, 0x6d, 0x63, 0x88, 0x97, 0xbd, 0xfb, 0x6b, 0x00, 0x25, 0x4b, 0xe6, 0x7f, 0x97, 0x07, 0x00,
+	0x00,
 }
 
 func (m *BasicAllowance) Marshal() (dAtA []byte, err error) {
@@ -508,6 +581,57 @@ func (m *AllowedMsgAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FilteredPeriodicAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FilteredPeriodicAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FilteredPeriodicAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedMessages) > 0 {
+		for iNdEx := len(m.AllowedMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMessages[iNdEx])
+			copy(dAtA[i:], m.AllowedMessages[iNdEx])
+			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.AllowedMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Periodic.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFeegrant(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -633,6 +757,29 @@ func (m *AllowedMsgAllowance) Size() (n int) {
 	return n
 }
 
+func (m *FilteredPeriodicAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Periodic.Size()
+	n += 1 + l + sovFeegrant(uint64(l))
+	if len(m.AllowedMessages) > 0 {
+		for _, s := range m.AllowedMessages {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Grant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1115,6 +1262,153 @@ func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FilteredPeriodicAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FilteredPeriodicAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FilteredPeriodicAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periodic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Periodic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMessages = append(m.AllowedMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
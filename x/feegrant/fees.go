@@ -29,3 +29,13 @@ type FeeAllowanceI interface {
 	// ExpiresAt returns the expiry time of the allowance.
 	ExpiresAt() (*time.Time, error)
 }
+
+// RefundableFeeAllowanceI is implemented by the fee allowances to which the
+// fees they accepted can be given back, e.g. when the fees paid for the unused
+// gas of a tx are refunded. See Keeper.RefundGrantedFees.
+type RefundableFeeAllowanceI interface {
+	FeeAllowanceI
+
+	// Refund gives back fees accepted by Accept in the same tx to the allowance.
+	Refund(ctx context.Context, fee sdk.Coins) error
+}
@@ -19,7 +19,7 @@ const (
 )
 
 var (
-	_ FeeAllowanceI                 = (*AllowedMsgAllowance)(nil)
+	_ RefundableFeeAllowanceI       = (*AllowedMsgAllowance)(nil)
 	_ types.UnpackInterfacesMessage = (*AllowedMsgAllowance)(nil)
 )
 
@@ -87,6 +87,26 @@ func (a *AllowedMsgAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []
 	return remove, err
 }
 
+// Refund implements RefundableFeeAllowanceI, it gives the fee back to the
+// allowance it wraps, if that allowance can be refunded.
+func (a *AllowedMsgAllowance) Refund(ctx context.Context, fee sdk.Coins) error {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	refundable, ok := allowance.(RefundableFeeAllowanceI)
+	if !ok {
+		return nil
+	}
+
+	if err := refundable.Refund(ctx, fee); err != nil {
+		return err
+	}
+
+	return a.SetAllowance(refundable)
+}
+
 func (a *AllowedMsgAllowance) allowedMsgsToMap(ctx sdk.Context) map[string]bool {
 	msgsMap := make(map[string]bool, len(a.AllowedMessages))
 	for _, msg := range a.AllowedMessages {
@@ -0,0 +1,121 @@
+package feegrant
+
+import (
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ RefundableFeeAllowanceI = (*FilteredPeriodicAllowance)(nil)
+
+// NewFilteredPeriodicAllowance creates a new periodic allowance restricted to
+// the allowed messages and the allowed fee denoms.
+func NewFilteredPeriodicAllowance(periodic PeriodicAllowance, allowedMsgs, allowedDenoms []string) *FilteredPeriodicAllowance {
+	return &FilteredPeriodicAllowance{
+		Periodic:        periodic,
+		AllowedMessages: allowedMsgs,
+		AllowedDenoms:   allowedDenoms,
+	}
+}
+
+// Accept checks that all the messages and fee denoms are allowed before
+// deducting the fee from the periodic allowance.
+func (a *FilteredPeriodicAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if !a.allMsgTypesAllowed(sdkCtx, msgs) {
+		return false, errorsmod.Wrap(ErrMessageNotAllowed, "message does not exist in allowed messages")
+	}
+
+	if denom, ok := a.allFeeDenomsAllowed(sdkCtx, fee); !ok {
+		return false, errorsmod.Wrapf(ErrDenomNotAllowed, "fee denom %s does not exist in allowed denoms", denom)
+	}
+
+	return a.Periodic.Accept(ctx, fee, msgs)
+}
+
+// Refund implements RefundableFeeAllowanceI, it gives the fee back to the
+// periodic allowance.
+func (a *FilteredPeriodicAllowance) Refund(ctx context.Context, fee sdk.Coins) error {
+	return a.Periodic.Refund(ctx, fee)
+}
+
+func (a *FilteredPeriodicAllowance) allMsgTypesAllowed(ctx sdk.Context, msgs []sdk.Msg) bool {
+	msgsMap := make(map[string]bool, len(a.AllowedMessages))
+	for _, msg := range a.AllowedMessages {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check msg")
+		msgsMap[msg] = true
+	}
+
+	for _, msg := range msgs {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check msg")
+		if !msgsMap[sdk.MsgTypeURL(msg)] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// allFeeDenomsAllowed returns the first fee denom which is not allowed, if any.
+func (a *FilteredPeriodicAllowance) allFeeDenomsAllowed(ctx sdk.Context, fee sdk.Coins) (string, bool) {
+	if len(a.AllowedDenoms) == 0 {
+		return "", true
+	}
+
+	denomsMap := make(map[string]bool, len(a.AllowedDenoms))
+	for _, denom := range a.AllowedDenoms {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check denom")
+		denomsMap[denom] = true
+	}
+
+	for _, coin := range fee {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check denom")
+		if !denomsMap[coin.Denom] {
+			return coin.Denom, false
+		}
+	}
+
+	return "", true
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a FilteredPeriodicAllowance) ValidateBasic() error {
+	if err := a.Periodic.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if len(a.AllowedMessages) == 0 {
+		return errorsmod.Wrap(ErrNoMessages, "allowed messages shouldn't be empty")
+	}
+
+	for _, denom := range a.AllowedDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid allowed denom %s: %s", denom, err)
+		}
+	}
+
+	// ensure the spend limits can only be spent with the allowed denoms
+	if len(a.AllowedDenoms) > 0 {
+		allowed := make(map[string]bool, len(a.AllowedDenoms))
+		for _, denom := range a.AllowedDenoms {
+			allowed[denom] = true
+		}
+
+		for _, coin := range a.Periodic.PeriodSpendLimit {
+			if !allowed[coin.Denom] {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "period spend limit denom %s is not an allowed denom", coin.Denom)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (a FilteredPeriodicAllowance) ExpiresAt() (*time.Time, error) {
+	return a.Periodic.ExpiresAt()
+}
@@ -0,0 +1,133 @@
+package feegrant_test
+
+import (
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestFilteredPeriodicFeeValidAllow(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Time: time.Now()})
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	leftAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 512))
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 1))
+
+	now := ctx.BlockTime()
+	oneHour := now.Add(1 * time.Hour)
+	tenMinutes := time.Duration(10) * time.Minute
+
+	periodic := feegrant.PeriodicAllowance{
+		Basic: feegrant.BasicAllowance{
+			SpendLimit: atom,
+			Expiration: &oneHour,
+		},
+		Period:           tenMinutes,
+		PeriodSpendLimit: atom,
+		PeriodReset:      now.Add(-1 * time.Minute),
+	}
+	sendMsgType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	cases := map[string]struct {
+		allowance *feegrant.FilteredPeriodicAllowance
+		fee       sdk.Coins
+		msgs      []sdk.Msg
+		valid     bool // all other checks are ignored if valid=false
+		accept    bool
+		errMsg    string
+		remains   sdk.Coins
+	}{
+		"empty allowed messages": {
+			allowance: feegrant.NewFilteredPeriodicAllowance(periodic, nil, []string{"atom"}),
+			valid:     false,
+		},
+		"invalid allowed denom": {
+			allowance: feegrant.NewFilteredPeriodicAllowance(periodic, []string{sendMsgType}, []string{"@"}),
+			valid:     false,
+		},
+		"period spend limit with a denom not allowed": {
+			allowance: feegrant.NewFilteredPeriodicAllowance(periodic, []string{sendMsgType}, []string{"eth"}),
+			valid:     false,
+		},
+		"invalid periodic allowance": {
+			allowance: feegrant.NewFilteredPeriodicAllowance(feegrant.PeriodicAllowance{}, []string{sendMsgType}, []string{"atom"}),
+			valid:     false,
+		},
+		"allowed message and denom": {
+			allowance: feegrant.NewFilteredPeriodicAllowance(periodic, []string{sendMsgType}, []string{"atom"}),
+			fee:       smallAtom,
+			msgs:      []sdk.Msg{&banktypes.MsgSend{}},
+			valid:     true,
+			accept:    true,
+			remains:   leftAtom,
+		},
+		"any denom when allowed denoms are empty": {
+			allowance: feegrant.NewFilteredPeriodicAllowance(periodic, []string{sendMsgType}, nil),
+			fee:       smallAtom,
+			msgs:      []sdk.Msg{&banktypes.MsgSend{}},
+			valid:     true,
+			accept:    true,
+			remains:   leftAtom,
+		},
+		"message not allowed": {
+			allowance: feegrant.NewFilteredPeriodicAllowance(periodic, []string{sendMsgType}, []string{"atom"}),
+			fee:       smallAtom,
+			msgs:      []sdk.Msg{&banktypes.MsgSend{}, &stakingtypes.MsgDelegate{}},
+			valid:     true,
+			accept:    false,
+			errMsg:    "message not allowed",
+		},
+		"denom not allowed": {
+			allowance: feegrant.NewFilteredPeriodicAllowance(periodic, []string{sendMsgType}, []string{"atom"}),
+			fee:       eth,
+			msgs:      []sdk.Msg{&banktypes.MsgSend{}},
+			valid:     true,
+			accept:    false,
+			errMsg:    "fee denom not allowed",
+		},
+		"over period limit": {
+			allowance: feegrant.NewFilteredPeriodicAllowance(periodic, []string{sendMsgType}, []string{"atom"}),
+			fee:       atom.Add(smallAtom...),
+			msgs:      []sdk.Msg{&banktypes.MsgSend{}},
+			valid:     true,
+			accept:    false,
+			errMsg:    "period limit",
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			err := tc.allowance.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			removed, err := tc.allowance.Accept(ctx, tc.fee, tc.msgs)
+			if !tc.accept {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, removed)
+			require.Equal(t, tc.remains, tc.allowance.Periodic.Basic.SpendLimit)
+			require.Equal(t, tc.remains, tc.allowance.Periodic.PeriodCanSpend)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -257,6 +258,36 @@ func (k Keeper) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddr
 	return k.UpdateAllowance(ctx, granter, grantee, grant)
 }
 
+// RefundGrantedFees gives back fees used with UseGrantedFees in the same tx,
+// e.g. the fees refunded for the unused gas of the tx, to the allowance granted
+// by the granter to the grantee. Nothing is given back if the allowance cannot
+// be refunded, or if it no longer exists because the fees used it up.
+func (k Keeper) RefundGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) error {
+	f, err := k.getGrant(ctx, granter, grantee)
+	if errors.Is(err, sdkerrors.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	grant, err := f.GetGrant()
+	if err != nil {
+		return err
+	}
+
+	refundable, ok := grant.(feegrant.RefundableFeeAllowanceI)
+	if !ok {
+		return nil
+	}
+
+	if err := refundable.Refund(ctx, fee); err != nil {
+		return err
+	}
+
+	return k.UpdateAllowance(ctx, granter, grantee, refundable)
+}
+
 func emitUseGrantEvent(ctx context.Context, granter, grantee string) {
 	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -311,9 +342,11 @@ func (k Keeper) addToFeeAllowanceQueue(ctx context.Context, grantKey []byte, exp
 	return store.Set(feegrant.FeeAllowancePrefixQueue(exp, grantKey), []byte{})
 }
 
-// RemoveExpiredAllowances iterates grantsByExpiryQueue and deletes the expired grants.
+// RemoveExpiredAllowances iterates grantsByExpiryQueue and deletes the expired grants,
+// emitting a prune event for each of them.
 func (k Keeper) RemoveExpiredAllowances(ctx context.Context) error {
-	exp := sdk.UnwrapSDKContext(ctx).BlockTime()
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	exp := sdkCtx.BlockTime()
 	store := k.storeService.OpenKVStore(ctx)
 	iterator, err := store.Iterator(feegrant.FeeAllowanceQueueKeyPrefix, storetypes.InclusiveEndBytes(feegrant.AllowanceByExpTimeKey(&exp)))
 	if err != nil {
@@ -332,6 +365,14 @@ func (k Keeper) RemoveExpiredAllowances(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				feegrant.EventTypePruneFeeGrant,
+				sdk.NewAttribute(feegrant.AttributeKeyGranter, sdk.AccAddress(granter).String()),
+				sdk.NewAttribute(feegrant.AttributeKeyGrantee, sdk.AccAddress(grantee).String()),
+			),
+		)
 	}
 	return nil
 }
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
@@ -18,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -304,6 +306,93 @@ func (suite *KeeperTestSuite) TestUseGrantedFee() {
 	suite.Contains(err.Error(), "fee-grant not found")
 }
 
+func (suite *KeeperTestSuite) TestRefundGrantedFees() {
+	oneYear := suite.ctx.BlockTime().AddDate(1, 0, 0)
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	period := time.Hour
+
+	basic := &feegrant.BasicAllowance{SpendLimit: suite.atom, Expiration: &oneYear}
+	periodic := &feegrant.PeriodicAllowance{
+		Basic:            *basic,
+		Period:           period,
+		PeriodSpendLimit: smallAtom,
+		PeriodCanSpend:   smallAtom,
+		PeriodReset:      suite.ctx.BlockTime().Add(period),
+	}
+	allowedMsg, err := feegrant.NewAllowedMsgAllowance(periodic, []string{sdk.MsgTypeURL(&feegrant.MsgGrantAllowance{})})
+	suite.Require().NoError(err)
+
+	cases := map[string]struct {
+		allowance feegrant.FeeAllowanceI
+		fee       sdk.Coins
+		refund    sdk.Coins
+		msgs      []sdk.Msg
+		final     feegrant.FeeAllowanceI
+	}{
+		"basic allowance": {
+			allowance: basic,
+			fee:       smallAtom,
+			refund:    sdk.NewCoins(sdk.NewInt64Coin("atom", 40)),
+			final: &feegrant.BasicAllowance{
+				SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 495)),
+				Expiration: &oneYear,
+			},
+		},
+		"periodic allowance": {
+			allowance: periodic,
+			fee:       smallAtom,
+			refund:    sdk.NewCoins(sdk.NewInt64Coin("atom", 40)),
+			final: &feegrant.PeriodicAllowance{
+				Basic: feegrant.BasicAllowance{
+					SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 495)),
+					Expiration: &oneYear,
+				},
+				Period:           period,
+				PeriodSpendLimit: smallAtom,
+				PeriodCanSpend:   sdk.NewCoins(sdk.NewInt64Coin("atom", 40)),
+				PeriodReset:      suite.ctx.BlockTime().Add(period),
+			},
+		},
+		"allowed msg allowance": {
+			allowance: allowedMsg,
+			fee:       smallAtom,
+			refund:    smallAtom,
+			msgs:      []sdk.Msg{&feegrant.MsgGrantAllowance{}},
+			final:     allowedMsg,
+		},
+		"used up allowance": {
+			allowance: basic,
+			fee:       suite.atom,
+			refund:    smallAtom,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		suite.Run(name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			granter, grantee := suite.addrs[0], suite.addrs[1]
+
+			err := suite.feegrantKeeper.GrantAllowance(ctx, granter, grantee, tc.allowance)
+			suite.Require().NoError(err)
+
+			err = suite.feegrantKeeper.UseGrantedFees(ctx, granter, grantee, tc.fee, tc.msgs)
+			suite.Require().NoError(err)
+
+			err = suite.feegrantKeeper.RefundGrantedFees(ctx, granter, grantee, tc.refund)
+			suite.Require().NoError(err)
+
+			loaded, err := suite.feegrantKeeper.GetAllowance(ctx, granter, grantee)
+			if tc.final == nil {
+				suite.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.final, loaded)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestIterateGrants() {
 	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
 	exp := suite.ctx.BlockTime().AddDate(1, 0, 0)
@@ -405,13 +494,26 @@ func (suite *KeeperTestSuite) TestPruneGrants() {
 			}
 			err := suite.feegrantKeeper.GrantAllowance(suite.ctx, tc.granter, tc.grantee, tc.allowance)
 			suite.NoError(err)
-			suite.feegrantKeeper.RemoveExpiredAllowances(tc.ctx)
-			grant, err := suite.feegrantKeeper.GetAllowance(tc.ctx, tc.granter, tc.grantee)
+			ctx := tc.ctx.WithEventManager(sdk.NewEventManager())
+			suite.feegrantKeeper.RemoveExpiredAllowances(ctx)
+			grant, err := suite.feegrantKeeper.GetAllowance(ctx, tc.granter, tc.grantee)
+
+			// grants of the previous cases may be pruned as well
+			pruned := false
+			for _, e := range ctx.EventManager().Events() {
+				if e.Type == feegrant.EventTypePruneFeeGrant &&
+					e.Attributes[0].Value == tc.granter.String() && e.Attributes[1].Value == tc.grantee.String() {
+					pruned = true
+				}
+			}
+
 			if tc.expErrMsg != "" {
 				suite.Error(err)
 				suite.Contains(err.Error(), tc.expErrMsg)
+				suite.True(pruned)
 			} else {
 				suite.NotNil(grant)
+				suite.False(pruned)
 			}
 			if tc.postRun != nil {
 				tc.postRun()
@@ -0,0 +1,40 @@
+package feemarket
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// EndBlocker updates the base fee of the next block from the gas used by the
+// current block.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	var maxGas int64
+	if cp := ctx.ConsensusParams(); cp.Block != nil {
+		maxGas = cp.Block.MaxGas
+	}
+
+	var gasUsed uint64
+	if meter := ctx.BlockGasMeter(); meter != nil {
+		gasUsed = meter.GasConsumedToLimit()
+	}
+
+	params := k.GetParams(ctx)
+	baseFee := params.NextBaseFee(k.GetBaseFee(ctx), gasUsed, maxGas)
+	k.SetBaseFee(ctx, baseFee)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBaseFee,
+			sdk.NewAttribute(types.AttributeKeyBaseFee, baseFee.String()),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, sdk.NewIntFromUint64(gasUsed).String()),
+		),
+	)
+
+	return nil
+}
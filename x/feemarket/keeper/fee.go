@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// TxFeeChecker implements the x/auth/ante TxFeeChecker using the base fee of
+// the fee market instead of the validator min gas prices. Since the base fee
+// is part of the state, the check is run in both CheckTx and DeliverTx, so
+// that every node accepts the same txs. The tx priority is the gas price paid
+// in the fee denom. The validator min gas prices are checked instead while the
+// fee market is disabled.
+func (k Keeper) TxFeeChecker(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+	params := k.GetParams(ctx)
+	if !params.Enabled {
+		return ante.CheckTxFeeWithValidatorMinGasPrices(ctx, tx)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, 0, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	feeCoins := feeTx.GetFee()
+	gas := feeTx.GetGas()
+
+	// genesis txs are not subject to the fee market
+	if ctx.BlockHeight() == 0 {
+		return feeCoins, 0, nil
+	}
+
+	if gas > math.MaxInt64 {
+		return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "gas limit too high: %d", gas)
+	}
+
+	baseFee := k.GetBaseFee(ctx)
+
+	// fee = ceil(baseFee * gasLimit)
+	required := sdk.NewCoin(params.FeeDenom, baseFee.MulInt64(int64(gas)).Ceil().RoundInt())
+	paid := feeCoins.AmountOf(params.FeeDenom)
+	if paid.LT(required.Amount) {
+		return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, required)
+	}
+
+	return feeCoins, getTxPriority(paid, int64(gas)), nil
+}
+
+// getTxPriority returns the gas price paid by the tx in the fee denom.
+func getTxPriority(paid sdkmath.Int, gas int64) int64 {
+	if gas == 0 {
+		return math.MaxInt64
+	}
+
+	gasPrice := paid.QuoRaw(gas)
+	if !gasPrice.IsInt64() {
+		return math.MaxInt64
+	}
+
+	return gasPrice.Int64()
+}
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// Keeper of the feemarket store
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new feemarket Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, authority string) Keeper {
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  key,
+		authority: authority,
+	}
+}
+
+// GetAuthority returns the x/feemarket module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// SetParams sets the x/feemarket module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// GetParams returns the current x/feemarket module parameters, defaulting to
+// types.DefaultParams when the module genesis was not initialized.
+func (k Keeper) GetParams(ctx sdk.Context) (p types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	k.cdc.MustUnmarshal(bz, &p)
+	return p
+}
+
+// SetBaseFee sets the base fee per unit of gas required by the next block.
+func (k Keeper) SetBaseFee(ctx sdk.Context, baseFee math.LegacyDec) {
+	bz, err := baseFee.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.BaseFeeKey, bz)
+}
+
+// GetBaseFee returns the base fee per unit of gas required by the current
+// block. It defaults to the min base fee when no base fee has been set.
+func (k Keeper) GetBaseFee(ctx sdk.Context) math.LegacyDec {
+	bz := ctx.KVStore(k.storeKey).Get(types.BaseFeeKey)
+	if bz == nil {
+		return k.GetParams(ctx).MinBaseFee
+	}
+
+	var baseFee math.LegacyDec
+	if err := baseFee.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return baseFee
+}
@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx       sdk.Context
+	keeper    keeper.Keeper
+	msgServer types.MsgServer
+	txConfig  client.TxConfig
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(feemarket.AppModuleBasic{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithBlockHeight(1)
+	s.txConfig = encCfg.TxConfig
+
+	s.keeper = keeper.NewKeeper(encCfg.Codec, key, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	genesis := types.DefaultGenesisState()
+	genesis.Params.Enabled = true
+	s.keeper.InitGenesis(s.ctx, genesis)
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+}
+
+func (s *KeeperTestSuite) newTx(fees sdk.Coins, gas uint64) sdk.Tx {
+	builder := s.txConfig.NewTxBuilder()
+	builder.SetFeeAmount(fees)
+	builder.SetGasLimit(gas)
+	return builder.GetTx()
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	genesis := types.NewGenesisState(types.DefaultParams(), math.LegacyNewDec(3))
+	s.keeper.InitGenesis(s.ctx, genesis)
+	s.Require().Equal(genesis, s.keeper.ExportGenesis(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestGetParamsDefault() {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	k := keeper.NewKeeper(moduletestutil.MakeTestEncodingConfig().Codec, key, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+
+	// the end blocker runs with the default params without genesis
+	s.Require().Equal(types.DefaultParams(), k.GetParams(ctx))
+	s.Require().NoError(feemarket.EndBlocker(ctx, k))
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := types.DefaultParams()
+	params.BaseFeeChangeDenominator = 16
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: "invalid", Params: params})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	invalid := params
+	invalid.FeeDenom = ""
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.keeper.GetAuthority(), Params: invalid})
+	s.Require().Error(err)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.keeper.GetAuthority(), Params: params})
+	s.Require().NoError(err)
+	s.Require().Equal(params, s.keeper.GetParams(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestQueryBaseFee() {
+	s.keeper.SetBaseFee(s.ctx, math.LegacyNewDecWithPrec(5, 1))
+
+	res, err := s.keeper.BaseFee(s.ctx, &types.QueryBaseFeeRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.DefaultBondDenom, res.Denom)
+	s.Require().Equal(math.LegacyNewDecWithPrec(5, 1), res.BaseFee)
+}
+
+func (s *KeeperTestSuite) TestTxFeeChecker() {
+	s.keeper.SetBaseFee(s.ctx, math.LegacyNewDecWithPrec(15, 1))
+	denom := sdk.DefaultBondDenom
+
+	testCases := []struct {
+		name        string
+		ctx         sdk.Context
+		fees        sdk.Coins
+		gas         uint64
+		expPriority int64
+		expErr      error
+	}{
+		{"exact fee", s.ctx, sdk.NewCoins(sdk.NewInt64Coin(denom, 150)), 100, 1, nil},
+		{"fee rounded up", s.ctx, sdk.NewCoins(sdk.NewInt64Coin(denom, 150)), 99, 1, nil},
+		{"higher gas price", s.ctx, sdk.NewCoins(sdk.NewInt64Coin(denom, 1000)), 100, 10, nil},
+		{"insufficient fee", s.ctx, sdk.NewCoins(sdk.NewInt64Coin(denom, 149)), 100, 0, sdkerrors.ErrInsufficientFee},
+		{"wrong denom", s.ctx, sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)), 100, 0, sdkerrors.ErrInsufficientFee},
+		{"enforced in deliver tx", s.ctx.WithIsCheckTx(false), sdk.NewCoins(sdk.NewInt64Coin(denom, 149)), 100, 0, sdkerrors.ErrInsufficientFee},
+		{"validator min gas prices ignored", s.ctx.WithIsCheckTx(true).WithMinGasPrices(sdk.NewDecCoins(sdk.NewInt64DecCoin(denom, 10))), sdk.NewCoins(sdk.NewInt64Coin(denom, 150)), 100, 1, nil},
+		{"genesis tx", s.ctx.WithBlockHeight(0), sdk.Coins{}, 100, 0, nil},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			fees, priority, err := s.keeper.TxFeeChecker(tc.ctx, s.newTx(tc.fees, tc.gas))
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.fees, fees)
+			s.Require().Equal(tc.expPriority, priority)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestTxFeeCheckerDisabled() {
+	params := s.keeper.GetParams(s.ctx)
+	params.Enabled = false
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+	denom := sdk.DefaultBondDenom
+
+	// the base fee is not required in deliver tx
+	_, _, err := s.keeper.TxFeeChecker(s.ctx, s.newTx(sdk.Coins{}, 100))
+	s.Require().NoError(err)
+
+	// the validator min gas prices are checked in check tx
+	ctx := s.ctx.WithIsCheckTx(true).WithMinGasPrices(sdk.NewDecCoins(sdk.NewInt64DecCoin(denom, 10)))
+	_, _, err = s.keeper.TxFeeChecker(ctx, s.newTx(sdk.NewCoins(sdk.NewInt64Coin(denom, 999)), 100))
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+
+	_, _, err = s.keeper.TxFeeChecker(ctx, s.newTx(sdk.NewCoins(sdk.NewInt64Coin(denom, 1000)), 100))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestEndBlocker() {
+	ctx := s.ctx.WithConsensusParams(cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: 1000}})
+	s.keeper.SetBaseFee(ctx, math.LegacyOneDec())
+
+	// full block
+	meter := storetypes.NewGasMeter(1000)
+	meter.ConsumeGas(1000, "test")
+	s.Require().NoError(feemarket.EndBlocker(ctx.WithBlockGasMeter(meter), s.keeper))
+	s.Require().Equal(math.LegacyNewDecWithPrec(1125, 3), s.keeper.GetBaseFee(ctx))
+
+	// empty block
+	s.Require().NoError(feemarket.EndBlocker(ctx.WithBlockGasMeter(storetypes.NewGasMeter(1000)), s.keeper))
+	s.Require().Equal(math.LegacyNewDecWithPrec(984375, 6), s.keeper.GetBaseFee(ctx))
+}
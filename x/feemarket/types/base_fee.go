@@ -0,0 +1,39 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+)
+
+// NextBaseFee returns the base fee of the next block given the base fee and
+// the gas used by the current block, following EIP-1559: the base fee
+// increases when the block used more gas than targeted and decreases when it
+// used less, by at most 1/BaseFeeChangeDenominator per block. The target is
+// maxGas/ElasticityMultiplier. The base fee never goes below MinBaseFee, and
+// is left unchanged when the block has no gas limit.
+func (p Params) NextBaseFee(baseFee math.LegacyDec, gasUsed uint64, maxGas int64) math.LegacyDec {
+	if maxGas <= 0 {
+		return math.LegacyMaxDec(baseFee, p.MinBaseFee)
+	}
+
+	target := uint64(maxGas) / p.ElasticityMultiplier
+	if target == 0 {
+		return math.LegacyMaxDec(baseFee, p.MinBaseFee)
+	}
+
+	targetDec := math.LegacyNewDecFromInt(math.NewIntFromUint64(target))
+	denominator := math.LegacyNewDecFromInt(math.NewIntFromUint64(p.BaseFeeChangeDenominator))
+
+	var next math.LegacyDec
+	switch {
+	case gasUsed > target:
+		gasDelta := math.LegacyNewDecFromInt(math.NewIntFromUint64(gasUsed - target))
+		next = baseFee.Add(baseFee.Mul(gasDelta).Quo(targetDec).Quo(denominator))
+	case gasUsed < target:
+		gasDelta := math.LegacyNewDecFromInt(math.NewIntFromUint64(target - gasUsed))
+		next = baseFee.Sub(baseFee.Mul(gasDelta).Quo(targetDec).Quo(denominator))
+	default:
+		next = baseFee
+	}
+
+	return math.LegacyMaxDec(next, p.MinBaseFee)
+}
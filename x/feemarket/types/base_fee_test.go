@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestNextBaseFee(t *testing.T) {
+	params := types.DefaultParams()
+	baseFee := math.LegacyNewDec(1)
+
+	testCases := []struct {
+		name     string
+		baseFee  math.LegacyDec
+		gasUsed  uint64
+		maxGas   int64
+		expected math.LegacyDec
+	}{
+		{"no block gas limit", baseFee, 1000, -1, baseFee},
+		{"gas used at target", baseFee, 500, 1000, baseFee},
+		{"full block", baseFee, 1000, 1000, math.LegacyNewDecWithPrec(1125, 3)},
+		{"gas used above target", baseFee, 750, 1000, math.LegacyNewDecWithPrec(10625, 4)},
+		{"empty block", baseFee, 0, 1000, math.LegacyNewDecWithPrec(875, 3)},
+		{"gas used below target", baseFee, 250, 1000, math.LegacyNewDecWithPrec(9375, 4)},
+		{"floored at min base fee", params.MinBaseFee, 0, 1000, params.MinBaseFee},
+		{"raised to min base fee", math.LegacyZeroDec(), 500, 1000, params.MinBaseFee},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, params.NextBaseFee(tc.baseFee, tc.gasUsed, tc.maxGas))
+		})
+	}
+}
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, types.ValidateGenesis(*types.DefaultGenesisState()))
+
+	params := types.DefaultParams()
+	require.Error(t, types.ValidateGenesis(*types.NewGenesisState(params, math.LegacyZeroDec())))
+
+	params.MinBaseFee = math.LegacyZeroDec()
+	require.Error(t, types.ValidateGenesis(*types.NewGenesisState(params, math.LegacyOneDec())))
+
+	params = types.DefaultParams()
+	params.ElasticityMultiplier = 0
+	require.Error(t, types.ValidateGenesis(*types.NewGenesisState(params, math.LegacyOneDec())))
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, baseFee math.LegacyDec) *GenesisState {
+	return &GenesisState{
+		Params:  params,
+		BaseFee: baseFee,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	params := DefaultParams()
+	return NewGenesisState(params, params.MinBaseFee)
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	if data.BaseFee.IsNil() || data.BaseFee.LT(data.Params.MinBaseFee) {
+		return fmt.Errorf("base fee must be greater than or equal to the min base fee (%s): %s", data.Params.MinBaseFee, data.BaseFee)
+	}
+
+	return nil
+}
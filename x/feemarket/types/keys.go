@@ -0,0 +1,16 @@
+package types
+
+var (
+	// ParamsKey is the key to use for the module parameters.
+	ParamsKey = []byte{0x00}
+	// BaseFeeKey is the key to use for the current base fee.
+	BaseFeeKey = []byte{0x01}
+)
+
+const (
+	// module name
+	ModuleName = "feemarket"
+
+	// StoreKey is the default store key for feemarket
+	StoreKey = ModuleName
+)
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewParams returns Params instance with the given values.
+func NewParams(feeDenom string, minBaseFee math.LegacyDec, baseFeeChangeDenominator, elasticityMultiplier uint64) Params {
+	return Params{
+		FeeDenom:                 feeDenom,
+		MinBaseFee:               minBaseFee,
+		BaseFeeChangeDenominator: baseFeeChangeDenominator,
+		ElasticityMultiplier:     elasticityMultiplier,
+	}
+}
+
+// DefaultParams returns default x/feemarket module parameters.
+func DefaultParams() Params {
+	return Params{
+		FeeDenom:                 sdk.DefaultBondDenom,
+		MinBaseFee:               math.LegacyNewDecWithPrec(25, 4),
+		BaseFeeChangeDenominator: 8,
+		ElasticityMultiplier:     2,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if strings.TrimSpace(p.FeeDenom) == "" {
+		return errors.New("fee denom cannot be blank")
+	}
+	if err := sdk.ValidateDenom(p.FeeDenom); err != nil {
+		return err
+	}
+	if p.MinBaseFee.IsNil() || !p.MinBaseFee.IsPositive() {
+		return fmt.Errorf("min base fee must be positive: %s", p.MinBaseFee)
+	}
+	if p.BaseFeeChangeDenominator == 0 {
+		return errors.New("base fee change denominator must be positive")
+	}
+	if p.ElasticityMultiplier == 0 {
+		return errors.New("elasticity multiplier must be positive")
+	}
+
+	return nil
+}
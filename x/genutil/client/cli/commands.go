@@ -23,7 +23,14 @@ func Commands(txConfig client.TxConfig, moduleBasics module.BasicManager, defaul
 
 // CommandsWithCustomMigrationMap adds core sdk's sub-commands into genesis command with custom migration map.
 // This custom migration map can be used by the application to add its own migration map.
+// The genesis migrations registered by the modules are added to the custom migration map,
+// and run after its migration to the same version.
 func CommandsWithCustomMigrationMap(txConfig client.TxConfig, moduleBasics module.BasicManager, defaultNodeHome string, migrationMap genutiltypes.MigrationMap) *cobra.Command {
+	moduleMigrationMap, err := ModuleMigrationMap(moduleBasics)
+	if err != nil {
+		panic(err)
+	}
+
 	cmd := &cobra.Command{
 		Use:                        "genesis",
 		Short:                      "Application's genesis-related subcommands",
@@ -35,10 +42,12 @@ func CommandsWithCustomMigrationMap(txConfig client.TxConfig, moduleBasics modul
 
 	cmd.AddCommand(
 		GenTxCmd(moduleBasics, txConfig, banktypes.GenesisBalancesIterator{}, defaultNodeHome),
-		MigrateGenesisCmd(migrationMap),
+		MigrateGenesisCmd(migrationMap.Merge(moduleMigrationMap)),
 		CollectGenTxsCmd(banktypes.GenesisBalancesIterator{}, defaultNodeHome, gentxModule.GenTxValidator),
 		ValidateGenesisCmd(moduleBasics),
 		AddGenesisAccountCmd(defaultNodeHome),
+		AddGenesisAccountsCmd(defaultNodeHome),
+		DiffGenesisCmd(moduleBasics),
 	)
 
 	return cmd
@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+// DiffGenesisCmd returns a command comparing two genesis files.
+func DiffGenesisCmd(mbm module.BasicManager) *cobra.Command {
+	return &cobra.Command{
+		Use:   "diff [genesis-file-a] [genesis-file-b]",
+		Short: "Print the differences between two genesis files",
+		Long: `Structurally compare two genesis files and print the differences of the top level fields,
+of the consensus genesis and of the genesis state of each module.
+
+The genesis state of each module is decoded with the codec of the application and encoded back
+before being compared, so that fields in a different order or omitted default values are not
+reported as differences. Differences are printed as "+ path: value" for values only in the second file,
+"- path: value" for values only in the first file and "~ path: a -> b" for changed values.
+
+The command fails if the genesis files differ, which is useful to check an export/import
+round trip or an upgrade rehearsal.`,
+		Example: fmt.Sprintf("%s genesis diff exported.json reexported.json", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			genesisA, err := types.AppGenesisFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			genesisB, err := types.AppGenesisFromFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			diffs, err := genutil.DiffAppGenesis(clientCtx.Codec, mbm, genesisA, genesisB)
+			if err != nil {
+				return err
+			}
+
+			if len(diffs) == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "genesis files are identical")
+				return nil
+			}
+
+			for _, diff := range diffs {
+				fmt.Fprintf(cmd.OutOrStdout(), "%s:\n", diff.Section)
+				for _, difference := range diff.Differences {
+					fmt.Fprintf(cmd.OutOrStdout(), "  %s\n", difference)
+				}
+			}
+
+			cmd.SilenceUsage = true
+			return fmt.Errorf("genesis files differ in %d sections", len(diffs))
+		},
+	}
+}
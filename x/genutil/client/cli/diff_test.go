@@ -0,0 +1,40 @@
+package cli_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func TestDiffGenesis(t *testing.T) {
+	bz, err := os.ReadFile("../../types/testdata/app_genesis.json")
+	require.NoError(t, err)
+	genesis := string(bz)
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{}, staking.AppModuleBasic{})
+	mbm := module.NewBasicManager(auth.AppModuleBasic{}, bank.AppModuleBasic{}, staking.AppModuleBasic{})
+	clientCtx := client.Context{}.WithCodec(encCfg.Codec)
+
+	genesisFile := testutil.WriteToNewTempFile(t, genesis)
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.DiffGenesisCmd(mbm), []string{genesisFile.Name(), genesisFile.Name()})
+	require.NoError(t, err)
+	require.Contains(t, out.String(), "genesis files are identical")
+
+	require.True(t, strings.Contains(genesis, `"chain_id":"demo"`))
+	otherFile := testutil.WriteToNewTempFile(t, strings.Replace(genesis, `"chain_id":"demo"`, `"chain_id":"other"`, 1))
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cli.DiffGenesisCmd(mbm), []string{genesisFile.Name(), otherFile.Name()})
+	require.ErrorContains(t, err, "genesis files differ in 1 sections")
+	require.Contains(t, out.String(), "genesis:\n  ~ chain_id: \"demo\" -> \"other\"")
+}
@@ -2,13 +2,22 @@ package cli
 
 import (
 	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	auth "github.com/cosmos/cosmos-sdk/x/auth/helpers"
 
 	"github.com/spf13/cobra"
@@ -86,3 +95,199 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 
 	return cmd
 }
+
+// AddGenesisAccountsCmd returns add-accounts cobra Command.
+// It adds all the accounts of a CSV or JSON file to genesis.json in a single pass,
+// which is much faster than calling add-genesis-account for each account.
+func AddGenesisAccountsCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-accounts [file]",
+		Short: "Add genesis accounts from a CSV or JSON file to genesis.json",
+		Long: `Add genesis accounts from a CSV or JSON file to genesis.json. Each account must
+specify the account address and a list of initial coins, and may optionally be supplied
+with vesting parameters. The file format is inferred from its extension.
+
+A JSON file contains a list of accounts:
+
+[
+  {
+    "address": "cosmos1...",
+    "coins": "1000stake,500atom",
+    "vesting_amount": "500stake",
+    "vesting_start_time": 1672531200,
+    "vesting_end_time": 1704067200
+  }
+]
+
+A CSV file starts with a header naming its columns, the vesting columns are optional:
+
+address,coins,vesting_amount,vesting_start_time,vesting_end_time
+cosmos1...,"1000stake,500atom",500stake,1672531200,1704067200
+
+All the accounts are validated before genesis.json is written. An address which is
+already in genesis.json, or which is repeated in the file, is an error unless the
+append flag is given.
+`,
+		Example: fmt.Sprintf("%s genesis add-accounts airdrop.csv", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			accounts, err := ParseGenesisAccountsFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			appendflag, _ := cmd.Flags().GetBool(flagAppendMode)
+
+			if err := auth.AddGenesisAccounts(clientCtx.Codec, accounts, appendflag, config.GenesisFile()); err != nil {
+				return err
+			}
+
+			cmd.Printf("added %d genesis accounts\n", len(accounts))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Bool(flagAppendMode, false, "append the coins to the accounts already in the genesis.json file")
+
+	return cmd
+}
+
+// genesisAccountRecord is an account of a genesis accounts file.
+type genesisAccountRecord struct {
+	Address      string `json:"address"`
+	Coins        string `json:"coins"`
+	VestingAmt   string `json:"vesting_amount"`
+	VestingStart int64  `json:"vesting_start_time"`
+	VestingEnd   int64  `json:"vesting_end_time"`
+}
+
+// ParseGenesisAccountsFile parses the genesis accounts of a CSV or JSON file.
+func ParseGenesisAccountsFile(path string) ([]auth.GenesisAccountEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var records []genesisAccountRecord
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+		if err := json.NewDecoder(f).Decode(&records); err != nil {
+			return nil, fmt.Errorf("failed to decode accounts file: %w", err)
+		}
+	case ".csv":
+		if records, err = readGenesisAccountsCSV(f); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported accounts file extension %q; must be .csv or .json", ext)
+	}
+
+	accounts := make([]auth.GenesisAccountEntry, len(records))
+	for i, record := range records {
+		accounts[i], err = record.toEntry()
+		if err != nil {
+			return nil, fmt.Errorf("invalid account #%d: %w", i+1, err)
+		}
+	}
+
+	return accounts, nil
+}
+
+// readGenesisAccountsCSV reads the genesis accounts of a CSV file, whose
+// columns are named by its header.
+func readGenesisAccountsCSV(r io.Reader) ([]genesisAccountRecord, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read accounts file header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+
+	for _, name := range []string{"address", "coins"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("accounts file header is missing the %s column", name)
+		}
+	}
+
+	var records []genesisAccountRecord
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read accounts file: %w", err)
+		}
+
+		column := func(name string) string {
+			if i, ok := columns[name]; ok {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+
+		record := genesisAccountRecord{
+			Address:    column("address"),
+			Coins:      column("coins"),
+			VestingAmt: column("vesting_amount"),
+		}
+
+		if record.VestingStart, err = parseOptionalInt(column("vesting_start_time")); err != nil {
+			return nil, fmt.Errorf("invalid account #%d vesting start time: %w", len(records)+1, err)
+		}
+		if record.VestingEnd, err = parseOptionalInt(column("vesting_end_time")); err != nil {
+			return nil, fmt.Errorf("invalid account #%d vesting end time: %w", len(records)+1, err)
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+func parseOptionalInt(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseInt(s, 10, 64)
+}
+
+func (r genesisAccountRecord) toEntry() (auth.GenesisAccountEntry, error) {
+	addr, err := sdk.AccAddressFromBech32(r.Address)
+	if err != nil {
+		return auth.GenesisAccountEntry{}, err
+	}
+
+	coins, err := sdk.ParseCoinsNormalized(r.Coins)
+	if err != nil {
+		return auth.GenesisAccountEntry{}, fmt.Errorf("failed to parse coins: %w", err)
+	}
+
+	vestingAmt, err := sdk.ParseCoinsNormalized(r.VestingAmt)
+	if err != nil {
+		return auth.GenesisAccountEntry{}, fmt.Errorf("failed to parse vesting amount: %w", err)
+	}
+
+	return auth.GenesisAccountEntry{
+		Address:      addr,
+		Coins:        coins,
+		VestingAmt:   vestingAmt,
+		VestingStart: r.VestingStart,
+		VestingEnd:   r.VestingEnd,
+	}, nil
+}
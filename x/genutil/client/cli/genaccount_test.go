@@ -3,6 +3,8 @@ package cli_test
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/spf13/viper"
@@ -18,8 +20,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 	genutiltest "github.com/cosmos/cosmos-sdk/x/genutil/client/testutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
 
 func TestAddGenesisAccountCmd(t *testing.T) {
@@ -104,3 +109,111 @@ func TestAddGenesisAccountCmd(t *testing.T) {
 		})
 	}
 }
+
+func TestAddGenesisAccountsCmd(t *testing.T) {
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	tests := []struct {
+		name       string
+		file       string
+		content    string
+		appendFlag bool
+		expectErr  bool
+		expBalance int
+	}{
+		{
+			name: "csv",
+			file: "accounts.csv",
+			content: fmt.Sprintf("address,coins,vesting_amount,vesting_end_time\n%s,\"1000atom,2000stake\",,\n%s,1000stake,500stake,1704067200\n",
+				addr1, addr2),
+			expBalance: 2,
+		},
+		{
+			name: "json",
+			file: "accounts.json",
+			content: fmt.Sprintf(`[{"address":"%s","coins":"1000atom"},{"address":"%s","coins":"1000stake","vesting_amount":"500stake","vesting_start_time":1672531200,"vesting_end_time":1704067200}]`,
+				addr1, addr2),
+			expBalance: 2,
+		},
+		{
+			name:      "duplicate address",
+			file:      "accounts.csv",
+			content:   fmt.Sprintf("address,coins\n%s,1000atom\n%s,1000atom\n", addr1, addr1),
+			expectErr: true,
+		},
+		{
+			name:       "duplicate address with append",
+			file:       "accounts.csv",
+			content:    fmt.Sprintf("address,coins\n%s,1000atom\n%s,1000atom\n", addr1, addr1),
+			appendFlag: true,
+			expBalance: 1,
+		},
+		{
+			name:      "invalid address",
+			file:      "accounts.csv",
+			content:   "address,coins\ninvalid,1000atom\n",
+			expectErr: true,
+		},
+		{
+			name:      "invalid vesting",
+			file:      "accounts.json",
+			content:   fmt.Sprintf(`[{"address":"%s","coins":"1000atom","vesting_amount":"2000atom","vesting_end_time":1704067200}]`, addr1),
+			expectErr: true,
+		},
+		{
+			name:      "missing column",
+			file:      "accounts.csv",
+			content:   fmt.Sprintf("address\n%s\n", addr1),
+			expectErr: true,
+		},
+		{
+			name:      "unsupported extension",
+			file:      "accounts.txt",
+			content:   fmt.Sprintf("address,coins\n%s,1000atom\n", addr1),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			home := t.TempDir()
+			logger := log.NewNopLogger()
+			cfg, err := genutiltest.CreateDefaultCometConfig(home)
+			require.NoError(t, err)
+
+			appCodec := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, vesting.AppModuleBasic{}).Codec
+			err = genutiltest.ExecInitCmd(testMbm, home, appCodec)
+			require.NoError(t, err)
+
+			serverCtx := server.NewContext(viper.New(), cfg, logger)
+			clientCtx := client.Context{}.WithCodec(appCodec).WithHomeDir(home)
+
+			ctx := context.Background()
+			ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
+			ctx = context.WithValue(ctx, server.ServerContextKey, serverCtx)
+
+			path := filepath.Join(home, tc.file)
+			require.NoError(t, os.WriteFile(path, []byte(tc.content), 0o600))
+
+			cmd := genutilcli.AddGenesisAccountsCmd(home)
+			cmd.SetArgs([]string{
+				path,
+				fmt.Sprintf("--%s=home", flags.FlagHome),
+				fmt.Sprintf("--append=%t", tc.appendFlag),
+			})
+
+			if tc.expectErr {
+				require.Error(t, cmd.ExecuteContext(ctx))
+				return
+			}
+			require.NoError(t, cmd.ExecuteContext(ctx))
+
+			appState, _, err := genutiltypes.GenesisStateFromGenFile(cfg.GenesisFile())
+			require.NoError(t, err)
+			bankGenState := banktypes.GetGenesisStateFromAppState(appCodec, appState)
+			require.Len(t, bankGenState.Balances, tc.expBalance)
+		})
+	}
+}
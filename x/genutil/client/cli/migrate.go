@@ -12,6 +12,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
 	v043 "github.com/cosmos/cosmos-sdk/x/genutil/migrations/v043"
 	v046 "github.com/cosmos/cosmos-sdk/x/genutil/migrations/v046"
@@ -19,7 +20,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
 
-const flagGenesisTime = "genesis-time"
+const (
+	flagGenesisTime = "genesis-time"
+	flagFromVersion = "from-version"
+)
 
 // MigrationMap is a map of SDK versions to their respective genesis migration functions.
 var MigrationMap = types.MigrationMap{
@@ -31,6 +35,7 @@ var MigrationMap = types.MigrationMap{
 // MigrateGenesisCmd returns a command to execute genesis state migration.
 // Applications should pass their own migration map to this function.
 // When the application migration includes a SDK migration, the Cosmos SDK migration function should as well be called.
+// The genesis migrations registered by the modules can be added to the migration map with ModuleMigrationMap.
 func MigrateGenesisCmd(migrations types.MigrationMap) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "migrate [target-version] [genesis-file]",
@@ -49,6 +54,22 @@ func MigrateGenesisCmd(migrations types.MigrationMap) *cobra.Command {
 				return fmt.Errorf("unknown migration function for version: %s (supported versions %s)", target, strings.Join(versions, ", "))
 			}
 
+			// with a source version, all the migrations from the source version
+			// to the target version are run in order
+			targets := []string{target}
+			if fromVersion, _ := cmd.Flags().GetString(flagFromVersion); fromVersion != "" {
+				if types.CompareVersions(fromVersion, target) >= 0 {
+					return fmt.Errorf("source version %s must be older than target version %s", fromVersion, target)
+				}
+
+				targets = nil
+				for _, version := range migrations.Versions() {
+					if types.CompareVersions(version, fromVersion) > 0 && types.CompareVersions(version, target) <= 0 {
+						targets = append(targets, version)
+					}
+				}
+			}
+
 			importGenesis := args[1]
 			appGenesis, err := types.AppGenesisFromFile(importGenesis)
 			if err != nil {
@@ -72,9 +93,12 @@ func MigrateGenesisCmd(migrations types.MigrationMap) *cobra.Command {
 				return fmt.Errorf("failed to JSON unmarshal initial genesis state: %w", err)
 			}
 
-			newGenState, err := migrationFunc(initialState, clientCtx)
-			if err != nil {
-				return fmt.Errorf("failed to migrate genesis state: %w", err)
+			newGenState := initialState
+			for _, version := range targets {
+				newGenState, err = migrations[version](newGenState, clientCtx)
+				if err != nil {
+					return fmt.Errorf("failed to migrate genesis state to %s: %w", version, err)
+				}
 			}
 
 			appGenesis.AppState, err = json.Marshal(newGenState)
@@ -119,8 +143,24 @@ func MigrateGenesisCmd(migrations types.MigrationMap) *cobra.Command {
 	}
 
 	cmd.Flags().String(flagGenesisTime, "", "Override genesis_time with this flag")
+	cmd.Flags().String(flagFromVersion, "", "Version of the source genesis, to run all the migrations from this version to the target version")
 	cmd.Flags().String(flags.FlagChainID, "", "Override chain_id with this flag")
 	cmd.Flags().String(flags.FlagOutputDocument, "", "Exported state is written to the given file instead of STDOUT")
 
 	return cmd
 }
+
+// ModuleMigrationMap returns the MigrationMap of the genesis migrations
+// registered by the modules implementing types.HasGenesisMigrations.
+func ModuleMigrationMap(mbm module.BasicManager) (types.MigrationMap, error) {
+	migrator := types.NewGenesisMigrator()
+	for _, name := range maps.Keys(mbm) {
+		if mod, ok := mbm[name].(types.HasGenesisMigrations); ok {
+			if err := mod.RegisterGenesisMigrations(migrator); err != nil {
+				return nil, fmt.Errorf("failed to register %s genesis migrations: %w", name, err)
+			}
+		}
+	}
+
+	return migrator.MigrationMap(), nil
+}
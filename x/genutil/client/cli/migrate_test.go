@@ -1,7 +1,9 @@
 package cli_test
 
 import (
+	"encoding/json"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -11,6 +13,7 @@ import (
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
 
 func TestMigrateGenesis(t *testing.T) {
@@ -71,3 +74,71 @@ func TestMigrateGenesis(t *testing.T) {
 		})
 	}
 }
+
+func TestMigrateGenesisFromVersion(t *testing.T) {
+	// each migration records its version in the genesis state of a mock module
+	migration := func(version string) types.MigrationCallback {
+		return func(appState types.AppMap, _ client.Context) (types.AppMap, error) {
+			var versions []string
+			if appState["mock"] != nil {
+				if err := json.Unmarshal(appState["mock"], &versions); err != nil {
+					return nil, err
+				}
+			}
+
+			bz, err := json.Marshal(append(versions, version))
+			appState["mock"] = bz
+			return appState, err
+		}
+	}
+
+	migrationMap := types.MigrationMap{
+		"v1.0":  migration("v1.0"),
+		"v2.0":  migration("v2.0"),
+		"v10.0": migration("v10.0"),
+		"v11.0": migration("v11.0"),
+	}
+
+	bz, err := os.ReadFile("../../types/testdata/app_genesis.json")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		args        []string
+		expErr      string
+		expVersions []string
+	}{
+		{"target version only", []string{"v10.0"}, "", []string{"v10.0"}},
+		{"from version", []string{"v10.0", "--from-version=v1.0"}, "", []string{"v2.0", "v10.0"}},
+		{"from unregistered version", []string{"v11.0", "--from-version=v1.5"}, "", []string{"v2.0", "v10.0", "v11.0"}},
+		{"from newer version", []string{"v2.0", "--from-version=v10.0"}, "must be older than target version", nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			genesisFile := testutil.WriteToNewTempFile(t, string(bz))
+			outputFile := filepath.Join(t.TempDir(), "genesis.json")
+			_, err := clitestutil.ExecTestCLICmd(
+				client.Context{Codec: moduletestutil.MakeTestEncodingConfig().Codec},
+				cli.MigrateGenesisCmd(migrationMap),
+				append([]string{tc.args[0], genesisFile.Name(), "--output-document=" + outputFile}, tc.args[1:]...),
+			)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			appGenesis, err := types.AppGenesisFromFile(outputFile)
+			require.NoError(t, err)
+
+			var appState types.AppMap
+			require.NoError(t, json.Unmarshal(appGenesis.AppState, &appState))
+
+			var versions []string
+			require.NoError(t, json.Unmarshal(appState["mock"], &versions))
+			require.Equal(t, tc.expVersions, versions)
+		})
+	}
+}
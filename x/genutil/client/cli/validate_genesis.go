@@ -3,20 +3,26 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"io"
+	"sort"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
 
 const chainUpgradeGuide = "https://github.com/cosmos/cosmos-sdk/blob/main/UPGRADING.md"
 
+const flagVerbose = "verbose"
+
 // ValidateGenesisCmd takes a genesis file, and makes sure that it is valid.
 func ValidateGenesisCmd(mbm module.BasicManager) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:     "validate [file]",
 		Aliases: []string{"validate-genesis"},
 		Args:    cobra.RangeArgs(0, 1),
@@ -49,7 +55,11 @@ func ValidateGenesisCmd(mbm module.BasicManager) *cobra.Command {
 				return fmt.Errorf("error unmarshalling genesis doc %s: %s", genesis, err.Error())
 			}
 
-			if err = mbm.ValidateGenesis(cdc, clientCtx.TxConfig, genState); err != nil {
+			if verbose, _ := cmd.Flags().GetBool(flagVerbose); verbose {
+				if failed := validateGenesisVerbose(cmd.OutOrStdout(), cdc, clientCtx.TxConfig, mbm, genState); failed > 0 {
+					return fmt.Errorf("error validating genesis file %s: %d checks failed", genesis, failed)
+				}
+			} else if err = mbm.ValidateGenesis(cdc, clientCtx.TxConfig, genState); err != nil {
 				return fmt.Errorf("error validating genesis file %s: %s", genesis, err.Error())
 			}
 
@@ -57,4 +67,48 @@ func ValidateGenesisCmd(mbm module.BasicManager) *cobra.Command {
 			return nil
 		},
 	}
+
+	cmd.Flags().Bool(flagVerbose, false, "Validate the genesis of each module separately, cross check the genesis states of the modules and report every failure")
+
+	return cmd
+}
+
+// validateGenesisVerbose runs the ValidateGenesis of each module and the
+// genesis cross checks, reports the result of each of them to w and returns
+// the number of failed checks. Contrary to BasicManager.ValidateGenesis, it
+// does not stop at the first failure.
+func validateGenesisVerbose(
+	w io.Writer,
+	cdc codec.JSONCodec,
+	txConfig client.TxEncodingConfig,
+	mbm module.BasicManager,
+	genState map[string]json.RawMessage,
+) (failed int) {
+	report := func(name string, err error) {
+		if err != nil {
+			failed++
+			fmt.Fprintf(w, "FAIL %s: %s\n", name, err)
+			return
+		}
+
+		fmt.Fprintf(w, "ok   %s\n", name)
+	}
+
+	names := make([]string, 0, len(mbm))
+	for name := range mbm {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if mod, ok := mbm[name].(module.HasGenesisBasics); ok {
+			report(name, mod.ValidateGenesis(cdc, txConfig, genState[name]))
+		}
+	}
+
+	for _, check := range genutil.DefaultGenesisCrossChecks() {
+		report("cross check "+check.Name, check.Check(cdc, genState))
+	}
+
+	return failed
 }
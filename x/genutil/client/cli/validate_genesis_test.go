@@ -1,13 +1,18 @@
 package cli_test
 
 import (
+	"encoding/json"
 	"os"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/stretchr/testify/require"
 )
 
@@ -67,3 +72,33 @@ func TestValidateGenesis(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateGenesisVerbose(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(bank.AppModuleBasic{}, staking.AppModuleBasic{})
+	mbm := module.NewBasicManager(bank.AppModuleBasic{}, staking.AppModuleBasic{})
+	clientCtx := client.Context{}.WithCodec(encCfg.Codec).WithTxConfig(encCfg.TxConfig)
+
+	bz, err := os.ReadFile("../../types/testdata/app_genesis.json")
+	require.NoError(t, err)
+
+	genesisFile := testutil.WriteToNewTempFile(t, string(bz))
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.ValidateGenesisCmd(mbm), []string{genesisFile.Name(), "--verbose"})
+	require.NoError(t, err)
+	require.Contains(t, out.String(), "ok   bank")
+	require.Contains(t, out.String(), "ok   staking")
+	require.Contains(t, out.String(), "ok   cross check staking pools")
+
+	// an invalid bank genesis fails its module validation and the supply cross check
+	var genesis map[string]interface{}
+	require.NoError(t, json.Unmarshal(bz, &genesis))
+	genesis["app_state"].(map[string]interface{})["bank"].(map[string]interface{})["supply"] = []map[string]string{{"denom": "stake", "amount": "1"}}
+	bz, err = json.Marshal(genesis)
+	require.NoError(t, err)
+
+	genesisFile = testutil.WriteToNewTempFile(t, string(bz))
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cli.ValidateGenesisCmd(mbm), []string{genesisFile.Name(), "--verbose"})
+	require.ErrorContains(t, err, "2 checks failed")
+	require.Contains(t, out.String(), "FAIL bank: genesis supply is incorrect")
+	require.Contains(t, out.String(), "FAIL cross check bank supply: supply of stake is 1")
+	require.Contains(t, out.String(), "ok   staking")
+}
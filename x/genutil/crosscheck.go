@@ -0,0 +1,163 @@
+package genutil
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GenesisCrossCheck defines a check of the consistency of the genesis state,
+// which can span several modules and thus cannot be verified by the
+// ValidateGenesis of a single module.
+type GenesisCrossCheck struct {
+	Name  string
+	Check func(cdc codec.JSONCodec, appState map[string]json.RawMessage) error
+}
+
+// DefaultGenesisCrossChecks returns the cross checks of the genesis state of the
+// SDK modules.
+func DefaultGenesisCrossChecks() []GenesisCrossCheck {
+	return []GenesisCrossCheck{
+		{Name: "bank supply", Check: CheckBankSupply},
+		{Name: "staking delegations", Check: CheckStakingDelegations},
+		{Name: "staking pools", Check: CheckStakingPools},
+	}
+}
+
+// CheckBankSupply checks that the supply of each denom in the bank genesis state
+// is the sum of the balances of the accounts. A genesis state without supply is
+// valid, its supply being computed at genesis.
+func CheckBankSupply(cdc codec.JSONCodec, appState map[string]json.RawMessage) error {
+	bankGenState, err := bankGenesisState(cdc, appState)
+	if err != nil || bankGenState.Supply.Empty() {
+		return err
+	}
+
+	total := sdk.NewCoins()
+	for _, balance := range bankGenState.Balances {
+		total = total.Add(balance.Coins...)
+	}
+
+	for _, coin := range total.Add(bankGenState.Supply...) {
+		supply, balances := bankGenState.Supply.AmountOf(coin.Denom), total.AmountOf(coin.Denom)
+		if !supply.Equal(balances) {
+			return fmt.Errorf("supply of %s is %s, but the balances sum to %s", coin.Denom, supply, balances)
+		}
+	}
+
+	return nil
+}
+
+// CheckStakingDelegations checks that the delegations of the staking genesis
+// state are to existing validators and that their shares sum to the delegator
+// shares of each validator.
+func CheckStakingDelegations(cdc codec.JSONCodec, appState map[string]json.RawMessage) error {
+	stakingGenState, err := stakingGenesisState(cdc, appState)
+	if err != nil {
+		return err
+	}
+
+	shares := make(map[string]math.LegacyDec, len(stakingGenState.Validators))
+	for _, validator := range stakingGenState.Validators {
+		shares[validator.OperatorAddress] = math.LegacyZeroDec()
+	}
+
+	for _, delegation := range stakingGenState.Delegations {
+		valShares, ok := shares[delegation.ValidatorAddress]
+		if !ok {
+			return fmt.Errorf("delegation of %s is to unknown validator %s", delegation.DelegatorAddress, delegation.ValidatorAddress)
+		}
+
+		shares[delegation.ValidatorAddress] = valShares.Add(delegation.Shares)
+	}
+
+	for _, validator := range stakingGenState.Validators {
+		if !validator.DelegatorShares.Equal(shares[validator.OperatorAddress]) {
+			return fmt.Errorf("validator %s has %s delegator shares, but its delegations sum to %s",
+				validator.OperatorAddress, validator.DelegatorShares, shares[validator.OperatorAddress])
+		}
+	}
+
+	return nil
+}
+
+// CheckStakingPools checks that the balances of the bonded and not bonded pools
+// are the tokens of the bonded validators, respectively the tokens of the
+// unbonding and unbonded validators and of the unbonding delegations.
+func CheckStakingPools(cdc codec.JSONCodec, appState map[string]json.RawMessage) error {
+	stakingGenState, err := stakingGenesisState(cdc, appState)
+	if err != nil {
+		return err
+	}
+
+	bankGenState, err := bankGenesisState(cdc, appState)
+	if err != nil {
+		return err
+	}
+
+	bondedTokens, notBondedTokens := math.ZeroInt(), math.ZeroInt()
+	for _, validator := range stakingGenState.Validators {
+		if validator.IsBonded() {
+			bondedTokens = bondedTokens.Add(validator.GetTokens())
+		} else {
+			notBondedTokens = notBondedTokens.Add(validator.GetTokens())
+		}
+	}
+
+	for _, ubd := range stakingGenState.UnbondingDelegations {
+		for _, entry := range ubd.Entries {
+			notBondedTokens = notBondedTokens.Add(entry.Balance)
+		}
+	}
+
+	balances := make(map[string]sdk.Coins, len(bankGenState.Balances))
+	for _, balance := range bankGenState.Balances {
+		balances[balance.Address] = balance.Coins
+	}
+
+	for _, pool := range []struct {
+		name   string
+		tokens math.Int
+	}{
+		{stakingtypes.BondedPoolName, bondedTokens},
+		{stakingtypes.NotBondedPoolName, notBondedTokens},
+	} {
+		addr := authtypes.NewModuleAddress(pool.name).String()
+		balance := balances[addr].AmountOf(stakingGenState.Params.BondDenom)
+		if !balance.Equal(pool.tokens) {
+			return fmt.Errorf("%s module account %s has a balance of %s%s, but the validators and unbonding delegations hold %s%s",
+				pool.name, addr, balance, stakingGenState.Params.BondDenom, pool.tokens, stakingGenState.Params.BondDenom)
+		}
+	}
+
+	return nil
+}
+
+func bankGenesisState(cdc codec.JSONCodec, appState map[string]json.RawMessage) (*banktypes.GenesisState, error) {
+	var genState banktypes.GenesisState
+	if appState[banktypes.ModuleName] != nil {
+		if err := cdc.UnmarshalJSON(appState[banktypes.ModuleName], &genState); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s genesis state: %w", banktypes.ModuleName, err)
+		}
+	}
+
+	return &genState, nil
+}
+
+func stakingGenesisState(cdc codec.JSONCodec, appState map[string]json.RawMessage) (*stakingtypes.GenesisState, error) {
+	var genState stakingtypes.GenesisState
+	if appState[stakingtypes.ModuleName] != nil {
+		if err := cdc.UnmarshalJSON(appState[stakingtypes.ModuleName], &genState); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s genesis state: %w", stakingtypes.ModuleName, err)
+		}
+	}
+
+	return &genState, nil
+}
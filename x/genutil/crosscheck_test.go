@@ -0,0 +1,125 @@
+package genutil_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func crossCheckGenesis(
+	t *testing.T,
+	cdc codec.JSONCodec,
+	bankGenState *banktypes.GenesisState,
+	stakingGenState *stakingtypes.GenesisState,
+) map[string]json.RawMessage {
+	t.Helper()
+
+	return map[string]json.RawMessage{
+		banktypes.ModuleName:    cdc.MustMarshalJSON(bankGenState),
+		stakingtypes.ModuleName: cdc.MustMarshalJSON(stakingGenState),
+	}
+}
+
+func TestGenesisCrossChecks(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(bank.AppModuleBasic{}, staking.AppModuleBasic{}).Codec
+
+	delAddr := sdk.AccAddress("delegator___________")
+	valAddr := sdk.ValAddress("validator___________")
+	bondedPool := authtypes.NewModuleAddress(stakingtypes.BondedPoolName)
+
+	validator, err := stakingtypes.NewValidator(valAddr, ed25519.GenPrivKey().PubKey(), stakingtypes.Description{})
+	require.NoError(t, err)
+	validator.Status = stakingtypes.Bonded
+	validator.Tokens = math.NewInt(100)
+	validator.DelegatorShares = math.LegacyNewDec(100)
+
+	newGenState := func() (*banktypes.GenesisState, *stakingtypes.GenesisState) {
+		bankGenState := banktypes.DefaultGenesisState()
+		bankGenState.Balances = []banktypes.Balance{
+			{Address: delAddr.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))},
+			{Address: bondedPool.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))},
+		}
+		bankGenState.Supply = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 150))
+
+		stakingGenState := stakingtypes.DefaultGenesisState()
+		stakingGenState.Validators = []stakingtypes.Validator{validator}
+		stakingGenState.Delegations = []stakingtypes.Delegation{
+			stakingtypes.NewDelegation(delAddr, valAddr, math.LegacyNewDec(100)),
+		}
+
+		return bankGenState, stakingGenState
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(*banktypes.GenesisState, *stakingtypes.GenesisState)
+		expErr   map[string]string
+	}{
+		{
+			name:     "valid",
+			malleate: func(*banktypes.GenesisState, *stakingtypes.GenesisState) {},
+			expErr:   map[string]string{},
+		},
+		{
+			name: "supply is not the sum of the balances",
+			malleate: func(bankGenState *banktypes.GenesisState, _ *stakingtypes.GenesisState) {
+				bankGenState.Supply = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200))
+			},
+			expErr: map[string]string{"bank supply": "supply of stake is 200, but the balances sum to 150"},
+		},
+		{
+			name: "delegation to unknown validator",
+			malleate: func(_ *banktypes.GenesisState, stakingGenState *stakingtypes.GenesisState) {
+				stakingGenState.Delegations[0].ValidatorAddress = sdk.ValAddress("unknown_____________").String()
+			},
+			expErr: map[string]string{"staking delegations": "to unknown validator"},
+		},
+		{
+			name: "delegations are not the validator shares",
+			malleate: func(_ *banktypes.GenesisState, stakingGenState *stakingtypes.GenesisState) {
+				stakingGenState.Delegations[0].Shares = math.LegacyNewDec(50)
+			},
+			expErr: map[string]string{"staking delegations": "has 100.000000000000000000 delegator shares, but its delegations sum to 50.000000000000000000"},
+		},
+		{
+			name: "bonded pool balance is not the bonded tokens",
+			malleate: func(bankGenState *banktypes.GenesisState, _ *stakingtypes.GenesisState) {
+				bankGenState.Balances[1].Coins = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))
+				bankGenState.Supply = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+			},
+			expErr: map[string]string{"staking pools": "bonded_tokens_pool module account " + bondedPool.String() + " has a balance of 50stake, but the validators and unbonding delegations hold 100stake"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			bankGenState, stakingGenState := newGenState()
+			tc.malleate(bankGenState, stakingGenState)
+			appState := crossCheckGenesis(t, cdc, bankGenState, stakingGenState)
+
+			for _, check := range genutil.DefaultGenesisCrossChecks() {
+				err := check.Check(cdc, appState)
+				if expErr, ok := tc.expErr[check.Name]; ok {
+					require.ErrorContains(t, err, expErr, check.Name)
+				} else {
+					require.NoError(t, err, check.Name)
+				}
+			}
+		})
+	}
+}
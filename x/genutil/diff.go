@@ -0,0 +1,257 @@
+package genutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+// maxDiffValueLen is the maximum length of a value printed in a difference.
+const maxDiffValueLen = 80
+
+// GenesisDiff defines the differences of a section of two genesis files. The
+// section is either "genesis" for the top level fields, "consensus" for the
+// consensus genesis or the name of a module for its app state.
+type GenesisDiff struct {
+	Section     string
+	Differences []string
+}
+
+// DiffAppGenesis structurally compares two genesis files and returns the
+// differences of each section, sections without differences being omitted.
+//
+// The genesis state of the modules of the basic manager implementing
+// types.HasGenesisState is decoded with the codec and re-encoded before being
+// compared, so that two encodings of the same genesis state, e.g. with fields
+// in a different order or with omitted default values, are equal. The genesis
+// state of the other modules is compared as is. The order of the fields of an
+// object is ignored while the order of the elements of arrays is significant.
+func DiffAppGenesis(cdc codec.JSONCodec, mbm module.BasicManager, a, b *types.AppGenesis) ([]GenesisDiff, error) {
+	var diffs []GenesisDiff
+
+	topLevel := func(g *types.AppGenesis) map[string]interface{} {
+		return map[string]interface{}{
+			"app_name":       g.AppName,
+			"app_version":    g.AppVersion,
+			"genesis_time":   g.GenesisTime.UTC().String(),
+			"chain_id":       g.ChainID,
+			"initial_height": json.Number(fmt.Sprint(g.InitialHeight)),
+			"app_hash":       fmt.Sprintf("%X", g.AppHash),
+		}
+	}
+	if differences := diffJSON("", topLevel(a), topLevel(b)); len(differences) > 0 {
+		diffs = append(diffs, GenesisDiff{Section: "genesis", Differences: differences})
+	}
+
+	consensusA, err := decodeJSON(a.Consensus)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode consensus genesis: %w", err)
+	}
+	consensusB, err := decodeJSON(b.Consensus)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode consensus genesis: %w", err)
+	}
+	if differences := diffJSON("", consensusA, consensusB); len(differences) > 0 {
+		diffs = append(diffs, GenesisDiff{Section: "consensus", Differences: differences})
+	}
+
+	var appStateA, appStateB map[string]json.RawMessage
+	if err := json.Unmarshal(a.AppState, &appStateA); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal app state: %w", err)
+	}
+	if err := json.Unmarshal(b.AppState, &appStateB); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal app state: %w", err)
+	}
+
+	modules := make(map[string]struct{}, len(appStateA))
+	for module := range appStateA {
+		modules[module] = struct{}{}
+	}
+	for module := range appStateB {
+		modules[module] = struct{}{}
+	}
+
+	names := make([]string, 0, len(modules))
+	for module := range modules {
+		names = append(names, module)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		stateA, err := decodeGenesisState(cdc, mbm[name], appStateA[name])
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s genesis state: %w", name, err)
+		}
+		stateB, err := decodeGenesisState(cdc, mbm[name], appStateB[name])
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s genesis state: %w", name, err)
+		}
+
+		if differences := diffJSON("", stateA, stateB); len(differences) > 0 {
+			diffs = append(diffs, GenesisDiff{Section: name, Differences: differences})
+		}
+	}
+
+	return diffs, nil
+}
+
+// decodeGenesisState decodes the genesis state of a module. If the module
+// implements types.HasGenesisState, the genesis state is first unmarshaled
+// with the codec and marshaled back, which emits every field with its default
+// value and encodes each value canonically.
+func decodeGenesisState(cdc codec.JSONCodec, m module.AppModuleBasic, bz json.RawMessage) (interface{}, error) {
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	if m, ok := m.(types.HasGenesisState); ok {
+		genState := m.NewGenesisState()
+		if err := cdc.UnmarshalJSON(bz, genState); err != nil {
+			return nil, err
+		}
+
+		var err error
+		if bz, err = cdc.MarshalJSON(genState); err != nil {
+			return nil, err
+		}
+	}
+
+	return decodeJSON(bz)
+}
+
+// decodeJSON decodes a JSON value, or the JSON encoding of a value, keeping
+// numbers as json.Number.
+func decodeJSON(v interface{}) (interface{}, error) {
+	bz, ok := v.(json.RawMessage)
+	if !ok {
+		var err error
+		if bz, err = json.Marshal(v); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	var decoded interface{}
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, err
+	}
+
+	return decoded, nil
+}
+
+// diffJSON returns the differences between two decoded JSON values at path.
+func diffJSON(path string, a, b interface{}) []string {
+	switch {
+	case a == nil && b == nil:
+		return nil
+	case a == nil:
+		return []string{fmt.Sprintf("+ %s: %s", pathOrRoot(path), formatJSON(b))}
+	case b == nil:
+		return []string{fmt.Sprintf("- %s: %s", pathOrRoot(path), formatJSON(a))}
+	}
+
+	switch av := a.(type) {
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok {
+			break
+		}
+
+		keys := make(map[string]struct{}, len(av))
+		for key := range av {
+			keys[key] = struct{}{}
+		}
+		for key := range bv {
+			keys[key] = struct{}{}
+		}
+
+		sortedKeys := make([]string, 0, len(keys))
+		for key := range keys {
+			sortedKeys = append(sortedKeys, key)
+		}
+		sort.Strings(sortedKeys)
+
+		var differences []string
+		for _, key := range sortedKeys {
+			keyPath := key
+			if path != "" {
+				keyPath = path + "." + key
+			}
+
+			aValue, inA := av[key]
+			bValue, inB := bv[key]
+			switch {
+			case !inA:
+				differences = append(differences, fmt.Sprintf("+ %s: %s", keyPath, formatJSON(bValue)))
+			case !inB:
+				differences = append(differences, fmt.Sprintf("- %s: %s", keyPath, formatJSON(aValue)))
+			default:
+				differences = append(differences, diffJSON(keyPath, aValue, bValue)...)
+			}
+		}
+
+		return differences
+
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok {
+			break
+		}
+
+		var differences []string
+		for i := 0; i < len(av) || i < len(bv); i++ {
+			elemPath := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= len(av):
+				differences = append(differences, fmt.Sprintf("+ %s: %s", elemPath, formatJSON(bv[i])))
+			case i >= len(bv):
+				differences = append(differences, fmt.Sprintf("- %s: %s", elemPath, formatJSON(av[i])))
+			default:
+				differences = append(differences, diffJSON(elemPath, av[i], bv[i])...)
+			}
+		}
+
+		return differences
+
+	default:
+		if a == b {
+			return nil
+		}
+	}
+
+	return []string{fmt.Sprintf("~ %s: %s -> %s", pathOrRoot(path), formatJSON(a), formatJSON(b))}
+}
+
+func pathOrRoot(path string) string {
+	if path == "" {
+		return "."
+	}
+
+	return path
+}
+
+// formatJSON returns the compact JSON encoding of a value, truncated to
+// maxDiffValueLen characters.
+func formatJSON(v interface{}) string {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+
+	s := string(bz)
+	if len(s) > maxDiffValueLen {
+		s = s[:maxDiffValueLen-3] + "..."
+	}
+
+	return s
+}
@@ -0,0 +1,89 @@
+package genutil_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+func TestDiffAppGenesis(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(bank.AppModuleBasic{}).Codec
+	mbm := module.NewBasicManager(bank.AppModuleBasic{})
+
+	newGenesis := func(chainID, appState string) *types.AppGenesis {
+		return &types.AppGenesis{
+			ChainID:   chainID,
+			AppState:  json.RawMessage(appState),
+			Consensus: &types.ConsensusGenesis{},
+		}
+	}
+
+	testCases := []struct {
+		name   string
+		a, b   *types.AppGenesis
+		expErr bool
+		exp    []genutil.GenesisDiff
+	}{
+		{
+			name: "identical with different field order and defaults",
+			a:    newGenesis("test", `{"bank":{"params":{"default_send_enabled":true},"balances":[{"address":"a","coins":[{"denom":"stake","amount":"100"}]}]},"custom":{"a":"1","b":[]}}`),
+			b:    newGenesis("test", `{"bank":{"balances":[{"coins":[{"amount":"100","denom":"stake"}],"address":"a"}],"params":{"default_send_enabled":true,"send_enabled":[]},"supply":[]},"custom":{"b":[],"a":"1"}}`),
+		},
+		{
+			name: "zero values are not absent values",
+			a:    newGenesis("test", `{"bank":{"balances":[{"address":"a","coins":[{"denom":"stake","amount":"0"}]}]},"custom":{"a":"0"}}`),
+			b:    newGenesis("test", `{"bank":{"balances":[{"address":"a"}]},"custom":{}}`),
+			exp: []genutil.GenesisDiff{
+				{Section: "bank", Differences: []string{`- balances[0].coins[0]: {"amount":"0","denom":"stake"}`}},
+				{Section: "custom", Differences: []string{`- a: "0"`}},
+			},
+		},
+		{
+			name: "differences",
+			a:    newGenesis("test-1", `{"auth":{"accounts":[]},"bank":{"balances":[{"address":"a","coins":[{"denom":"stake","amount":"100"}]}]}}`),
+			b:    newGenesis("test-2", `{"bank":{"balances":[{"address":"a","coins":[{"denom":"stake","amount":"200"}]},{"address":"b"}]},"custom":{"a":"1"}}`),
+			exp: []genutil.GenesisDiff{
+				{Section: "genesis", Differences: []string{`~ chain_id: "test-1" -> "test-2"`}},
+				{Section: "auth", Differences: []string{`- .: {"accounts":[]}`}},
+				{Section: "bank", Differences: []string{
+					`~ balances[0].coins[0].amount: "100" -> "200"`,
+					`+ balances[1]: {"address":"b","coins":[]}`,
+				}},
+				{Section: "custom", Differences: []string{`+ .: {"a":"1"}`}},
+			},
+		},
+		{
+			name:   "invalid module genesis",
+			a:      newGenesis("test", `{"bank":{"balances":[}}`),
+			b:      newGenesis("test", `{}`),
+			expErr: true,
+		},
+		{
+			name:   "unknown field in module genesis",
+			a:      newGenesis("test", `{"bank":{"unknown":[]}}`),
+			b:      newGenesis("test", `{}`),
+			expErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			diffs, err := genutil.DiffAppGenesis(cdc, mbm, tc.a, tc.b)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, diffs)
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// HasGenesisMigrations is the interface for modules which register migrations
+// of their genesis state between versions.
+type HasGenesisMigrations interface {
+	RegisterGenesisMigrations(*GenesisMigrator) error
+}
+
+// GenesisMigrator registers the migrations of the genesis state of each module
+// to a version, similarly to the store migrations registered with the module
+// Configurator, and builds the MigrationMap running them.
+type GenesisMigrator struct {
+	// migrations maps a version to the migrations of the modules to this version
+	migrations map[string]map[string]ModuleMigrationCallback
+}
+
+// NewGenesisMigrator returns a new GenesisMigrator without migrations.
+func NewGenesisMigrator() *GenesisMigrator {
+	return &GenesisMigrator{migrations: make(map[string]map[string]ModuleMigrationCallback)}
+}
+
+// RegisterMigration registers a migration of the genesis state of the given
+// module to the given version. Only one migration can be registered for each
+// module and version.
+func (m *GenesisMigrator) RegisterMigration(moduleName, version string, callback ModuleMigrationCallback) error {
+	if callback == nil {
+		return fmt.Errorf("nil genesis migration for module %s and version %s", moduleName, version)
+	}
+
+	if m.migrations[version] == nil {
+		m.migrations[version] = make(map[string]ModuleMigrationCallback)
+	}
+
+	if _, ok := m.migrations[version][moduleName]; ok {
+		return fmt.Errorf("genesis migration for module %s and version %s already registered", moduleName, version)
+	}
+
+	m.migrations[version][moduleName] = callback
+	return nil
+}
+
+// MigrationMap returns a MigrationMap which runs, for each version, the
+// migrations of the modules registered for it in the lexical order of the
+// module names. The modules which are not in the genesis state are skipped.
+func (m *GenesisMigrator) MigrationMap() MigrationMap {
+	migrationMap := make(MigrationMap, len(m.migrations))
+	for version, migrations := range m.migrations {
+		version, migrations := version, migrations
+
+		moduleNames := make([]string, 0, len(migrations))
+		for moduleName := range migrations {
+			moduleNames = append(moduleNames, moduleName)
+		}
+		sort.Strings(moduleNames)
+
+		migrationMap[version] = func(appState AppMap, clientCtx client.Context) (AppMap, error) {
+			for _, moduleName := range moduleNames {
+				if appState[moduleName] == nil {
+					continue
+				}
+
+				genState, err := migrations[moduleName](appState[moduleName], clientCtx)
+				if err != nil {
+					return nil, fmt.Errorf("failed to migrate %s genesis state to %s: %w", moduleName, version, err)
+				}
+
+				appState[moduleName] = genState
+			}
+
+			return appState, nil
+		}
+	}
+
+	return migrationMap
+}
+
+// Merge returns a MigrationMap with the migrations of both maps. When both maps
+// have a migration to the same version, the migration of mm is run first.
+func (mm MigrationMap) Merge(other MigrationMap) MigrationMap {
+	merged := make(MigrationMap, len(mm)+len(other))
+	for version, callback := range mm {
+		merged[version] = callback
+	}
+
+	for version, callback := range other {
+		first, ok := merged[version]
+		if !ok {
+			merged[version] = callback
+			continue
+		}
+
+		second := callback
+		merged[version] = func(appState AppMap, clientCtx client.Context) (AppMap, error) {
+			appState, err := first(appState, clientCtx)
+			if err != nil {
+				return nil, err
+			}
+
+			return second(appState, clientCtx)
+		}
+	}
+
+	return merged
+}
+
+// Versions returns the versions of the map, from the oldest to the newest.
+func (mm MigrationMap) Versions() []string {
+	versions := make([]string, 0, len(mm))
+	for version := range mm {
+		versions = append(versions, version)
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		return CompareVersions(versions[i], versions[j]) < 0
+	})
+
+	return versions
+}
+
+// CompareVersions compares two versions of the form v1.2.3 by their numeric
+// components, returning -1, 0 or 1. Missing components are zero, components
+// which are not numeric, like pre-release suffixes, are compared lexically.
+func CompareVersions(a, b string) int {
+	as := strings.Split(strings.TrimPrefix(a, "v"), ".")
+	bs := strings.Split(strings.TrimPrefix(b, "v"), ".")
+
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		ac, bc := "0", "0"
+		if i < len(as) {
+			ac = as[i]
+		}
+		if i < len(bs) {
+			bc = bs[i]
+		}
+
+		an, aErr := strconv.ParseUint(ac, 10, 64)
+		bn, bErr := strconv.ParseUint(bc, 10, 64)
+		switch {
+		case aErr == nil && bErr == nil && an != bn:
+			if an < bn {
+				return -1
+			}
+			return 1
+		case (aErr != nil || bErr != nil) && ac != bc:
+			return strings.Compare(ac, bc)
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,101 @@
+package types_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+// appendMigration returns a module migration appending the suffix to the
+// string genesis state of the module.
+func appendMigration(suffix string) types.ModuleMigrationCallback {
+	return func(genState json.RawMessage, _ client.Context) (json.RawMessage, error) {
+		var s string
+		if err := json.Unmarshal(genState, &s); err != nil {
+			return nil, err
+		}
+
+		return json.Marshal(s + suffix)
+	}
+}
+
+func TestGenesisMigrator(t *testing.T) {
+	migrator := types.NewGenesisMigrator()
+	require.NoError(t, migrator.RegisterMigration("bank", "v2", appendMigration("-bank2")))
+	require.NoError(t, migrator.RegisterMigration("auth", "v2", appendMigration("-auth2")))
+	require.NoError(t, migrator.RegisterMigration("bank", "v3", appendMigration("-bank3")))
+	require.NoError(t, migrator.RegisterMigration("gov", "v3", appendMigration("-gov3")))
+
+	require.ErrorContains(t, migrator.RegisterMigration("bank", "v2", appendMigration("")), "already registered")
+	require.ErrorContains(t, migrator.RegisterMigration("bank", "v4", nil), "nil genesis migration")
+
+	migrationMap := migrator.MigrationMap()
+	require.Equal(t, []string{"v2", "v3"}, migrationMap.Versions())
+
+	appState, err := migrationMap["v2"](types.AppMap{
+		"auth": json.RawMessage(`"auth"`),
+		"bank": json.RawMessage(`"bank"`),
+	}, client.Context{})
+	require.NoError(t, err)
+
+	// modules without genesis state are skipped
+	appState, err = migrationMap["v3"](appState, client.Context{})
+	require.NoError(t, err)
+	require.Equal(t, types.AppMap{
+		"auth": json.RawMessage(`"auth-auth2"`),
+		"bank": json.RawMessage(`"bank-bank2-bank3"`),
+	}, appState)
+
+	_, err = migrationMap["v2"](types.AppMap{"bank": json.RawMessage(`{}`)}, client.Context{})
+	require.ErrorContains(t, err, "failed to migrate bank genesis state to v2")
+}
+
+func TestMigrationMapMerge(t *testing.T) {
+	var calls []string
+	callback := func(name string, err error) types.MigrationCallback {
+		return func(appState types.AppMap, _ client.Context) (types.AppMap, error) {
+			calls = append(calls, name)
+			return appState, err
+		}
+	}
+
+	merged := types.MigrationMap{
+		"v1": callback("sdk-v1", nil),
+		"v2": callback("sdk-v2", nil),
+	}.Merge(types.MigrationMap{
+		"v2": callback("modules-v2", nil),
+		"v3": callback("modules-v3", errors.New("failure")),
+	})
+	require.Equal(t, []string{"v1", "v2", "v3"}, merged.Versions())
+
+	_, err := merged["v2"](types.AppMap{}, client.Context{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"sdk-v2", "modules-v2"}, calls)
+
+	_, err = merged["v3"](types.AppMap{}, client.Context{})
+	require.ErrorContains(t, err, "failure")
+}
+
+func TestCompareVersions(t *testing.T) {
+	testCases := []struct {
+		a, b string
+		exp  int
+	}{
+		{"v0.47", "v0.47", 0},
+		{"v0.47", "v0.47.0", 0},
+		{"v0.46", "v0.47", -1},
+		{"v0.9", "v0.10", -1},
+		{"v1.0", "v0.50", 1},
+		{"v0.47.1", "v0.47", 1},
+		{"v0.50.0-rc1", "v0.50.0-rc2", -1},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.exp, types.CompareVersions(tc.a, tc.b), "%s <-> %s", tc.a, tc.b)
+	}
+}
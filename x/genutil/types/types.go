@@ -3,6 +3,8 @@ package types
 import (
 	"encoding/json"
 
+	"github.com/cosmos/gogoproto/proto"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 )
@@ -17,6 +19,10 @@ type (
 
 	// MigrationMap defines a mapping from a version to a MigrationCallback.
 	MigrationMap map[string]MigrationCallback
+
+	// ModuleMigrationCallback converts the genesis state of a module from the
+	// previous version to the targeted one.
+	ModuleMigrationCallback func(json.RawMessage, client.Context) (json.RawMessage, error)
 )
 
 // ModuleName is genutil
@@ -39,3 +45,11 @@ func NewInitConfig(chainID, genTxsDir, nodeID string, valPubKey cryptotypes.PubK
 		ValPubKey: valPubKey,
 	}
 }
+
+// HasGenesisState is implemented by the modules whose genesis state is a proto
+// message. It is used to decode the genesis state of the module with the codec,
+// e.g. to compare genesis files.
+type HasGenesisState interface {
+	// NewGenesisState returns an empty genesis state of the module.
+	NewGenesisState() proto.Message
+}
@@ -55,7 +55,7 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal v1.Proposal) bool {
 		var tagValue, logMsg string
 
-		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
+		passes, burnDeposits, tallySnapshot := keeper.TallyWithSnapshot(ctx, proposal)
 
 		// If an expedited proposal fails, we do not want to update
 		// the deposit at this point since the proposal is converted to regular.
@@ -72,6 +72,12 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 		keeper.RemoveFromActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
 
 		switch {
+		case passes && proposal.IsMultipleChoice():
+			// a multiple choice proposal has no messages to execute, its
+			// outcome is the final tally result of each of its options
+			proposal.Status = v1.StatusTallied
+			tagValue = types.AttributeValueProposalTallied
+			logMsg = "tallied"
 		case passes:
 			var (
 				idx    int
@@ -143,13 +149,27 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 			logMsg = "rejected"
 		}
 
-		proposal.FinalTallyResult = &tallyResults
+		proposal.FinalTallyResult = tallySnapshot.Tally
+		proposal.FinalOptionTallyResults = tallySnapshot.OptionTallyResults
 
 		keeper.SetProposal(ctx, proposal)
 
+		// an expedited proposal converted to a regular proposal is tallied again
+		// at the end of its extended voting period
+		if proposal.Status != v1.StatusVotingPeriod {
+			keeper.SetTallySnapshot(ctx, tallySnapshot)
+		}
+
 		// when proposal become active
 		keeper.Hooks().AfterProposalVotingPeriodEnded(ctx, proposal.Id)
 
+		switch proposal.Status {
+		case v1.StatusPassed:
+			keeper.Hooks().AfterProposalPassed(ctx, proposal.Id)
+		case v1.StatusRejected, v1.StatusFailed:
+			keeper.Hooks().AfterProposalFailed(ctx, proposal.Id)
+		}
+
 		logger.Info(
 			"proposal tallied",
 			"proposal", proposal.Id,
@@ -170,4 +190,51 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 
 		return false
 	})
+
+	// execute the scheduled param changes which are due
+	for _, change := range keeper.GetDueScheduledParamChanges(ctx) {
+		var tagValue, logMsg string
+
+		// Messages may mutate state thus we use a cached context. If the handler
+		// fails, no state mutation is written and the error message is logged.
+		cacheCtx, writeCache := ctx.CacheContext()
+		msg, err := change.GetUpdateParamsMsg()
+		if err == nil {
+			var res *sdk.Result
+			res, err = keeper.Router().Handler(msg)(cacheCtx, msg)
+			if err == nil {
+				// write state to the underlying multi-store
+				writeCache()
+
+				// propagate the msg events to the current context
+				ctx.EventManager().EmitEvents(res.GetEvents())
+			}
+		}
+
+		if err == nil {
+			tagValue = types.AttributeValueParamChangeExec
+			logMsg = "executed"
+		} else {
+			tagValue = types.AttributeValueParamChangeFailed
+			logMsg = fmt.Sprintf("failed on execution: %s", err)
+		}
+
+		keeper.DeleteScheduledParamChange(ctx, change.Id)
+
+		logger.Info(
+			"scheduled param change",
+			"param_change", change.Id,
+			"msg", change.Msg.TypeUrl,
+			"results", logMsg,
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeParamChange,
+				sdk.NewAttribute(types.AttributeKeyParamChangeID, fmt.Sprintf("%d", change.Id)),
+				sdk.NewAttribute(types.AttributeKeyParamChangeResult, tagValue),
+				sdk.NewAttribute(types.AttributeKeyParamChangeLog, logMsg),
+			),
+		)
+	}
 }
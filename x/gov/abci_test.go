@@ -395,6 +395,13 @@ func TestProposalPassedEndblocker(t *testing.T) {
 			macc = suite.GovKeeper.GetGovernanceAccount(ctx)
 			require.NotNil(t, macc)
 			require.True(t, suite.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).Equal(initialModuleAccCoins))
+
+			// the vote of the validator is recorded in the tally snapshot
+			snapshot, found := suite.GovKeeper.GetTallySnapshot(ctx, proposal.Id)
+			require.True(t, found)
+			require.Equal(t, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10).String(), snapshot.Tally.YesCount)
+			require.Equal(t, snapshot.Tally.YesCount, snapshot.ValidatorTally.YesCount)
+			require.Equal(t, "0", snapshot.DelegatorTally.YesCount)
 		})
 	}
 }
@@ -450,6 +457,53 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	require.Equal(t, v1.StatusFailed, proposal.Status)
 }
 
+func TestEndBlockerMultipleChoiceProposalTallied(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, cmtproto.Header{})
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 1, valTokens)
+
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+	header := cmtproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	valAddr := sdk.ValAddress(addrs[0])
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	suite.StakingKeeper.EndBlocker(ctx)
+
+	voteOptions := v1.ProposalVoteOptions{Options: []string{"Option A", "Option B"}}
+	proposal, err := suite.GovKeeper.SubmitMultipleChoiceProposal(ctx, "", "title", "summary", addrs[0], voteOptions)
+	require.NoError(t, err)
+
+	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
+	govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+	_, err = govMsgSvr.Deposit(ctx, v1.NewMsgDeposit(addrs[0], proposal.Id, proposalCoins))
+	require.NoError(t, err)
+
+	err = suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionTwo), "")
+	require.NoError(t, err)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(*suite.GovKeeper.GetParams(ctx).MaxDepositPeriod).Add(*suite.GovKeeper.GetParams(ctx).VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	gov.EndBlocker(ctx, suite.GovKeeper)
+
+	// the proposal is tallied rather than passed, and records the votes for its options
+	attr, eventOk := ctx.EventManager().Events().GetAttributes(types.AttributeKeyProposalResult)
+	require.True(t, eventOk)
+	require.Equal(t, types.AttributeValueProposalTallied, attr[len(attr)-1].Value)
+
+	proposal, ok := suite.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.True(t, ok)
+	require.Equal(t, v1.StatusTallied, proposal.Status)
+	require.Equal(t, []*v1.OptionTallyResult{
+		v1.NewOptionTallyResult(v1.OptionOne, math.ZeroInt()),
+		v1.NewOptionTallyResult(v1.OptionTwo, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10)),
+	}, proposal.FinalOptionTallyResults)
+	require.True(t, proposal.FinalTallyResult.Equals(v1.EmptyTallyResult()))
+}
+
 func TestExpeditedProposal_PassAndConversionToRegular(t *testing.T) {
 	testcases := []struct {
 		name string
@@ -683,3 +737,37 @@ func getDepositMultiplier(expedited bool) int64 {
 
 	return 1
 }
+
+func TestEndBlockerScheduledParamChange(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, cmtproto.Header{})
+
+	header := cmtproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx = ctx.WithBlockHeight(header.Height)
+
+	params := suite.GovKeeper.GetParams(ctx)
+	params.BurnVoteVeto = !params.BurnVoteVeto
+	msg := &v1.MsgUpdateParams{Authority: suite.GovKeeper.GetAuthority(), Params: params}
+
+	id, err := suite.GovKeeper.ScheduleParamChange(ctx, msg, ctx.BlockHeight()+1, nil)
+	require.NoError(t, err)
+
+	// the change is not due yet
+	gov.EndBlocker(ctx, suite.GovKeeper)
+	require.NotEqual(t, params.BurnVoteVeto, suite.GovKeeper.GetParams(ctx).BurnVoteVeto)
+	_, found := suite.GovKeeper.GetScheduledParamChange(ctx, id)
+	require.True(t, found)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	gov.EndBlocker(ctx, suite.GovKeeper)
+
+	require.Equal(t, params.BurnVoteVeto, suite.GovKeeper.GetParams(ctx).BurnVoteVeto)
+	_, found = suite.GovKeeper.GetScheduledParamChange(ctx, id)
+	require.False(t, found)
+
+	attr, ok := ctx.EventManager().Events().GetAttributes(types.AttributeKeyParamChangeResult)
+	require.True(t, ok)
+	require.Equal(t, types.AttributeValueParamChangeExec, attr[0].Value)
+}
@@ -38,6 +38,10 @@ func GetQueryCmd(ac address.Codec) *cobra.Command {
 		GetCmdQueryDeposit(),
 		GetCmdQueryDeposits(),
 		GetCmdQueryTally(),
+		GetCmdQueryProposalVoteOptions(),
+		GetCmdQueryOptionTally(),
+		GetCmdQueryTallySnapshot(),
+		GetCmdQueryScheduledParamChanges(),
 		GetCmdConstitution(),
 	)
 
@@ -103,7 +107,7 @@ func GetCmdQueryProposals(ac address.Codec) *cobra.Command {
 Example:
 $ %s query gov proposals --depositor cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
 $ %s query gov proposals --voter cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
-$ %s query gov proposals --status (DepositPeriod|VotingPeriod|Passed|Rejected)
+$ %s query gov proposals --status (DepositPeriod|VotingPeriod|Passed|Rejected|Tallied)
 $ %s query gov proposals --page=2 --limit=100
 `,
 				version.AppName, version.AppName, version.AppName, version.AppName,
@@ -172,7 +176,7 @@ $ %s query gov proposals --page=2 --limit=100
 
 	cmd.Flags().String(flagDepositor, "", "(optional) filter by proposals deposited on by depositor")
 	cmd.Flags().String(flagVoter, "", "(optional) filter by proposals voted on by voted")
-	cmd.Flags().String(flagStatus, "", "(optional) filter proposals by proposal status, status: deposit_period/voting_period/passed/rejected")
+	cmd.Flags().String(flagStatus, "", "(optional) filter proposals by proposal status, status: deposit_period/voting_period/passed/rejected/tallied")
 	flags.AddPaginationFlagsToCmd(cmd, "proposals")
 	flags.AddQueryFlagsToCmd(cmd)
 
@@ -509,6 +513,190 @@ $ %s query gov tally 1
 	return cmd
 }
 
+// GetCmdQueryProposalVoteOptions implements the query proposal vote options command.
+func GetCmdQueryProposalVoteOptions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposal-vote-options [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the vote options of a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the vote options of a proposal: the custom options of
+a multiple choice proposal, or the standard options otherwise.
+
+Example:
+$ %s query gov proposal-vote-options 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			res, err := queryClient.ProposalVoteOptions(
+				cmd.Context(),
+				&v1.QueryProposalVoteOptionsRequest{ProposalId: proposalID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.VoteOptions)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryOptionTally implements the query option tally command.
+func GetCmdQueryOptionTally() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "option-tally [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the tally of each option of a multiple choice proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tally of each option of a multiple choice proposal. The
+options are given by their 1-based index in the vote options of the proposal.
+
+Example:
+$ %s query gov option-tally 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			res, err := queryClient.OptionTallyResults(
+				cmd.Context(),
+				&v1.QueryOptionTallyResultsRequest{ProposalId: proposalID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTallySnapshot implements the query tally snapshot command.
+func GetCmdQueryTallySnapshot() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tally-snapshot [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the final tally breakdown of a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the final tally breakdown of a proposal, recorded when its
+voting period ended, including the split between validator and delegator votes.
+
+Example:
+$ %s query gov tally-snapshot 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			res, err := queryClient.TallySnapshot(
+				cmd.Context(),
+				&v1.QueryTallySnapshotRequest{ProposalId: proposalID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.TallySnapshot)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryScheduledParamChanges implements the query scheduled param changes command.
+func GetCmdQueryScheduledParamChanges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-param-changes",
+		Args:  cobra.NoArgs,
+		Short: "Query the pending scheduled param changes",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the governance approved param changes which are scheduled to be
+executed at a future height or time.
+
+Example:
+$ %s query gov scheduled-param-changes
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ScheduledParamChanges(
+				cmd.Context(),
+				&v1.QueryScheduledParamChangesRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scheduled param changes")
+
+	return cmd
+}
+
 // GetCmdQueryParams implements the query params command.
 //
 //nolint:staticcheck // this function contains deprecated commands that we need.
@@ -70,6 +70,7 @@ func NewTxCmd(legacyPropCmds []*cobra.Command) *cobra.Command {
 		NewCmdVote(),
 		NewCmdWeightedVote(),
 		NewCmdSubmitProposal(),
+		NewCmdSubmitMultipleChoiceProposal(),
 		NewCmdDraftProposal(),
 		NewCmdCancelProposal(),
 
@@ -111,7 +112,10 @@ Where proposal.json contains:
   "deposit": "10stake"
   "title: "My proposal"
   "summary": "A short summary of my proposal",
-  "expedited": false
+  "expedited": false,
+  // optimistic proposals pass unless they are rejected, and can only be
+  // submitted by the optimistic authorized addresses
+  "optimistic": false
 }
 
 metadata example: 
@@ -142,6 +146,65 @@ metadata example:
 			if err != nil {
 				return fmt.Errorf("invalid message: %w", err)
 			}
+			msg.Optimistic = proposal.Optimistic
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitMultipleChoiceProposal implements submitting a multiple choice proposal transaction command.
+func NewCmdSubmitMultipleChoiceProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-multiple-choice-proposal [path/to/proposal.json]",
+		Short: "Submit a multiple choice proposal along with some metadata and deposit",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a multiple choice proposal along with some metadata and deposit.
+It should be defined in a JSON file. The proposal has no messages, and is
+voted on with the 1-based index of an option, "one" to "four" naming the
+first four options.
+
+Example:
+$ %s tx gov submit-multiple-choice-proposal path/to/proposal.json
+
+Where proposal.json contains:
+
+{
+  // metadata can be any of base64 encoded, raw text, stringified json, IPFS link to json
+  "metadata": "4pIMOgIGx1vZGU=",
+  "deposit": "10stake",
+  "title": "My proposal",
+  "summary": "A short summary of my proposal",
+  // at least two vote options must be defined
+  "vote_options": {
+    "options": ["Option A", "Option B", "Option C"]
+  }
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, deposit, err := parseSubmitMultipleChoiceProposal(args[0])
+			if err != nil {
+				return err
+			}
+
+			if err := proposal.VoteOptions.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg := v1.NewMultipleChoiceMsgSubmitProposal(deposit, clientCtx.GetFromAddress().String(), proposal.Metadata, proposal.Title, proposal.Summary, &proposal.VoteOptions)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -307,12 +370,14 @@ func NewCmdVote() *cobra.Command {
 		Short: "Vote for an active proposal, options: yes/no/no_with_veto/abstain",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Submit a vote for an active proposal. You can
-find the proposal-id by running "%s query gov proposals".
+find the proposal-id by running "%s query gov proposals". A multiple choice
+proposal is voted on with the 1-based index of one of its options.
 
 Example:
 $ %s tx gov vote 1 yes --from mykey
+$ %s tx gov vote 2 5 --from mykey
 `,
-				version.AppName, version.AppName,
+				version.AppName, version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
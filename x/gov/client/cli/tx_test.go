@@ -172,6 +172,76 @@ func (s *CLITestSuite) TestNewCmdSubmitProposal() {
 	}
 }
 
+func (s *CLITestSuite) TestNewCmdSubmitMultipleChoiceProposal() {
+	val := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
+
+	invalidProp := `{
+		"title": "My awesome title",
+		"summary": "My awesome description",
+		"deposit": "5431stake",
+		"vote_options": {
+			"options": ["Option A"]
+		}
+	}`
+	invalidPropFile := testutil.WriteToNewTempFile(s.T(), invalidProp)
+	defer invalidPropFile.Close()
+
+	validProp := `{
+		"title": "My awesome title",
+		"summary": "My awesome description",
+		"deposit": "5431stake",
+		"vote_options": {
+			"options": ["Option A", "Option B", "Option C", "Option D", "Option E"]
+		}
+	}`
+	validPropFile := testutil.WriteToNewTempFile(s.T(), validProp)
+	defer validPropFile.Close()
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErrMsg string
+	}{
+		{
+			"invalid vote options",
+			[]string{
+				invalidPropFile.Name(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10))).String()),
+			},
+			"at least two vote options",
+		},
+		{
+			"valid proposal",
+			[]string{
+				validPropFile.Name(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+			},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewCmdSubmitMultipleChoiceProposal()
+
+			out, err := clitestutil.ExecTestCLICmd(s.clientCtx, cmd, tc.args)
+			if tc.expectErrMsg != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expectErrMsg)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Contains(out.String(), "/cosmos.gov.v1.MsgSubmitMultipleChoiceProposal")
+				s.Require().Contains(out.String(), "Option E")
+			}
+		})
+	}
+}
+
 func (s *CLITestSuite) TestNewCmdSubmitLegacyProposal() {
 	val := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
 
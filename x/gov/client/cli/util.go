@@ -82,12 +82,44 @@ func parseSubmitLegacyProposal(fs *pflag.FlagSet) (*legacyProposal, error) {
 // proposal defines the new Msg-based proposal.
 type proposal struct {
 	// Msgs defines an array of sdk.Msgs proto-JSON-encoded as Anys.
-	Messages  []json.RawMessage `json:"messages,omitempty"`
-	Metadata  string            `json:"metadata"`
-	Deposit   string            `json:"deposit"`
-	Title     string            `json:"title"`
-	Summary   string            `json:"summary"`
-	Expedited bool              `json:"expedited"`
+	Messages   []json.RawMessage `json:"messages,omitempty"`
+	Metadata   string            `json:"metadata"`
+	Deposit    string            `json:"deposit"`
+	Title      string            `json:"title"`
+	Summary    string            `json:"summary"`
+	Expedited  bool              `json:"expedited"`
+	Optimistic bool              `json:"optimistic"`
+}
+
+// multipleChoiceProposal defines a multiple choice proposal.
+type multipleChoiceProposal struct {
+	Metadata    string                    `json:"metadata"`
+	Deposit     string                    `json:"deposit"`
+	Title       string                    `json:"title"`
+	Summary     string                    `json:"summary"`
+	VoteOptions govv1.ProposalVoteOptions `json:"vote_options"`
+}
+
+// parseSubmitMultipleChoiceProposal reads and parses the multiple choice proposal.
+func parseSubmitMultipleChoiceProposal(path string) (multipleChoiceProposal, sdk.Coins, error) {
+	var proposal multipleChoiceProposal
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return proposal, nil, err
+	}
+
+	err = json.Unmarshal(contents, &proposal)
+	if err != nil {
+		return proposal, nil, err
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+	if err != nil {
+		return proposal, nil, err
+	}
+
+	return proposal, deposit, nil
 }
 
 // parseSubmitProposal reads and parses the proposal.
@@ -3,6 +3,7 @@ package utils
 import (
 	"strings"
 
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
@@ -21,6 +22,20 @@ func NormalizeVoteOption(option string) string {
 	case "NoWithVeto", "no_with_veto":
 		return v1beta1.OptionNoWithVeto.String()
 
+	// the first four options of a multiple choice proposal, which share their
+	// index with the standard options
+	case "One", "one":
+		return v1beta1.OptionYes.String()
+
+	case "Two", "two":
+		return v1beta1.OptionAbstain.String()
+
+	case "Three", "three":
+		return v1beta1.OptionNo.String()
+
+	case "Four", "four":
+		return v1beta1.OptionNoWithVeto.String()
+
 	default:
 		return option
 	}
@@ -62,6 +77,8 @@ func NormalizeProposalStatus(status string) string {
 		return v1beta1.StatusPassed.String()
 	case "Rejected", "rejected":
 		return v1beta1.StatusRejected.String()
+	case "Tallied", "tallied":
+		return v1.StatusTallied.String()
 	default:
 		return status
 	}
@@ -75,6 +75,8 @@ func TestNormalizeProposalStatus(t *testing.T) {
 		{"Passed", args{"Passed"}, "PROPOSAL_STATUS_PASSED"},
 		{"Rejected", args{"Rejected"}, "PROPOSAL_STATUS_REJECTED"},
 		{"rejected", args{"rejected"}, "PROPOSAL_STATUS_REJECTED"},
+		{"Tallied", args{"Tallied"}, "PROPOSAL_STATUS_TALLIED"},
+		{"tallied", args{"tallied"}, "PROPOSAL_STATUS_TALLIED"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -41,6 +41,19 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 		k.SetProposal(ctx, *proposal)
 	}
 
+	for _, snapshot := range data.TallySnapshots {
+		k.SetTallySnapshot(ctx, *snapshot)
+	}
+
+	nextParamChangeID := uint64(1)
+	for _, change := range data.ScheduledParamChanges {
+		k.SetScheduledParamChange(ctx, *change)
+		if change.Id >= nextParamChangeID {
+			nextParamChangeID = change.Id + 1
+		}
+	}
+	k.SetParamChangeID(ctx, nextParamChangeID)
+
 	// if account has zero balance it probably means it's not set, so we set it
 	balance := bk.GetAllBalances(ctx, moduleAcc.GetAddress())
 	if balance.IsZero() {
@@ -71,11 +84,13 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *v1.GenesisState {
 	}
 
 	return &v1.GenesisState{
-		StartingProposalId: startingProposalID,
-		Deposits:           proposalsDeposits,
-		Votes:              proposalsVotes,
-		Proposals:          proposals,
-		Params:             &params,
-		Constitution:       constitution,
+		StartingProposalId:    startingProposalID,
+		Deposits:              proposalsDeposits,
+		Votes:                 proposalsVotes,
+		Proposals:             proposals,
+		Params:                &params,
+		Constitution:          constitution,
+		TallySnapshots:        k.GetTallySnapshots(ctx),
+		ScheduledParamChanges: k.GetScheduledParamChanges(ctx),
 	}
 }
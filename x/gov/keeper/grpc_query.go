@@ -99,6 +99,112 @@ func (q Keeper) Proposals(c context.Context, req *v1.QueryProposalsRequest) (*v1
 	return &v1.QueryProposalsResponse{Proposals: filteredProposals, Pagination: pageRes}, nil
 }
 
+// ProposalVoteOptions returns the valid vote options of a proposal
+func (q Keeper) ProposalVoteOptions(c context.Context, req *v1.QueryProposalVoteOptionsRequest) (*v1.QueryProposalVoteOptionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, found := q.GetProposal(ctx, req.ProposalId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+	}
+
+	return &v1.QueryProposalVoteOptionsResponse{VoteOptions: proposal.GetValidVoteOptions()}, nil
+}
+
+// OptionTallyResults returns the tally of each option of a multiple choice proposal
+func (q Keeper) OptionTallyResults(c context.Context, req *v1.QueryOptionTallyResultsRequest) (*v1.QueryOptionTallyResultsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, found := q.GetProposal(ctx, req.ProposalId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+	}
+
+	if !proposal.IsMultipleChoice() {
+		return nil, status.Errorf(codes.InvalidArgument, "proposal %d is not a multiple choice proposal", req.ProposalId)
+	}
+
+	var optionTallyResults []*v1.OptionTallyResult
+
+	switch proposal.Status {
+	case v1.StatusDepositPeriod:
+		optionTallyResults = v1.EmptyOptionTallyResults(len(proposal.VoteOptions.Options))
+
+	case v1.StatusVotingPeriod:
+		_, _, snapshot := q.TallyWithSnapshot(ctx, proposal)
+		optionTallyResults = snapshot.OptionTallyResults
+
+	default:
+		optionTallyResults = proposal.FinalOptionTallyResults
+	}
+
+	return &v1.QueryOptionTallyResultsResponse{OptionTallyResults: optionTallyResults}, nil
+}
+
+// TallySnapshot returns the final tally breakdown of a proposal
+func (q Keeper) TallySnapshot(c context.Context, req *v1.QueryTallySnapshotRequest) (*v1.QueryTallySnapshotResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	snapshot, found := q.GetTallySnapshot(ctx, req.ProposalId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "tally snapshot of proposal %d doesn't exist", req.ProposalId)
+	}
+
+	return &v1.QueryTallySnapshotResponse{TallySnapshot: &snapshot}, nil
+}
+
+// ScheduledParamChanges returns all the pending scheduled param changes
+func (q Keeper) ScheduledParamChanges(c context.Context, req *v1.QueryScheduledParamChangesRequest) (*v1.QueryScheduledParamChangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var changes []*v1.ScheduledParamChange
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	changeStore := prefix.NewStore(store, types.ScheduledParamChangesKeyPrefix)
+
+	pageRes, err := query.Paginate(changeStore, req.Pagination, func(key, value []byte) error {
+		var change v1.ScheduledParamChange
+		if err := q.cdc.Unmarshal(value, &change); err != nil {
+			return err
+		}
+
+		changes = append(changes, &change)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryScheduledParamChangesResponse{ScheduledParamChanges: changes, Pagination: pageRes}, nil
+}
+
 // Vote returns Voted information based on proposalID, voterAddr
 func (q Keeper) Vote(c context.Context, req *v1.QueryVoteRequest) (*v1.QueryVoteResponse, error) {
 	if req == nil {
@@ -273,6 +379,10 @@ func (q Keeper) TallyResult(c context.Context, req *v1.QueryTallyResultRequest)
 		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
 	}
 
+	if proposal.IsMultipleChoice() {
+		return nil, status.Errorf(codes.InvalidArgument, "proposal %d is a multiple choice proposal, query the tally of its options instead", req.ProposalId)
+	}
+
 	var tallyResult v1.TallyResult
 
 	switch {
@@ -1436,6 +1436,27 @@ func (suite *KeeperTestSuite) TestGRPCQueryTallyResult() {
 			},
 			true,
 		},
+		{
+			"multiple choice proposal",
+			func() {
+				propTime := time.Now()
+				proposal := v1.Proposal{
+					Id:               1,
+					Status:           v1.StatusTallied,
+					FinalTallyResult: &v1.TallyResult{},
+					SubmitTime:       &propTime,
+					VotingStartTime:  &propTime,
+					VotingEndTime:    &propTime,
+					Metadata:         "proposal metadata",
+					ProposalType:     v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE,
+					VoteOptions:      &v1.ProposalVoteOptions{Options: []string{"A", "B"}},
+				}
+				suite.govKeeper.SetProposal(ctx, proposal)
+
+				req = &v1.QueryTallyResultRequest{ProposalId: proposal.Id}
+			},
+			false,
+		},
 		{
 			"proposal status deposit",
 			func() {
@@ -1506,6 +1527,177 @@ func (suite *KeeperTestSuite) TestGRPCQueryTallyResult() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryOptionTallyResults() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	var (
+		req        *v1.QueryOptionTallyResultsRequest
+		expResults []*v1.OptionTallyResult
+	)
+
+	propTime := time.Now()
+	newMultipleChoiceProposal := func(status v1.ProposalStatus, finalResults []*v1.OptionTallyResult) v1.Proposal {
+		return v1.Proposal{
+			Id:                      1,
+			Status:                  status,
+			FinalTallyResult:        &v1.TallyResult{},
+			SubmitTime:              &propTime,
+			VotingStartTime:         &propTime,
+			VotingEndTime:           &propTime,
+			Metadata:                "proposal metadata",
+			ProposalType:            v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE,
+			VoteOptions:             &v1.ProposalVoteOptions{Options: []string{"A", "B", "C", "D", "E"}},
+			FinalOptionTallyResults: finalResults,
+		}
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &v1.QueryOptionTallyResultsRequest{}
+			},
+			false,
+		},
+		{
+			"non existing proposal request",
+			func() {
+				req = &v1.QueryOptionTallyResultsRequest{ProposalId: 2}
+			},
+			false,
+		},
+		{
+			"standard proposal",
+			func() {
+				proposal := v1.Proposal{
+					Id:               1,
+					Status:           v1.StatusPassed,
+					FinalTallyResult: &v1.TallyResult{},
+					SubmitTime:       &propTime,
+					VotingStartTime:  &propTime,
+					VotingEndTime:    &propTime,
+					Metadata:         "proposal metadata",
+				}
+				suite.govKeeper.SetProposal(ctx, proposal)
+
+				req = &v1.QueryOptionTallyResultsRequest{ProposalId: proposal.Id}
+			},
+			false,
+		},
+		{
+			"proposal status deposit",
+			func() {
+				suite.govKeeper.SetProposal(ctx, newMultipleChoiceProposal(v1.StatusDepositPeriod, nil))
+
+				req = &v1.QueryOptionTallyResultsRequest{ProposalId: 1}
+				expResults = v1.EmptyOptionTallyResults(5)
+			},
+			true,
+		},
+		{
+			"proposal status tallied",
+			func() {
+				expResults = []*v1.OptionTallyResult{
+					v1.NewOptionTallyResult(v1.OptionOne, math.NewInt(2)),
+					v1.NewOptionTallyResult(v1.OptionTwo, math.ZeroInt()),
+					v1.NewOptionTallyResult(v1.OptionThree, math.NewInt(1)),
+					v1.NewOptionTallyResult(v1.OptionFour, math.ZeroInt()),
+					v1.NewOptionTallyResult(v1.VoteOption(5), math.NewInt(7)),
+				}
+				suite.govKeeper.SetProposal(ctx, newMultipleChoiceProposal(v1.StatusTallied, expResults))
+
+				req = &v1.QueryOptionTallyResultsRequest{ProposalId: 1}
+			},
+			true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			testCase.malleate()
+
+			res, err := queryClient.OptionTallyResults(gocontext.Background(), req)
+
+			if testCase.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResults, res.OptionTallyResults)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryTallySnapshot() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	var (
+		req         *v1.QueryTallySnapshotRequest
+		expSnapshot v1.TallySnapshot
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &v1.QueryTallySnapshotRequest{}
+			},
+			false,
+		},
+		{
+			"non existing snapshot request",
+			func() {
+				req = &v1.QueryTallySnapshotRequest{ProposalId: 2}
+			},
+			false,
+		},
+		{
+			"valid request",
+			func() {
+				expSnapshot = v1.NewTallySnapshot(
+					1,
+					v1.NewTallyResult(math.NewInt(5), math.NewInt(1), math.ZeroInt(), math.ZeroInt()),
+					v1.NewTallyResult(math.NewInt(4), math.ZeroInt(), math.ZeroInt(), math.ZeroInt()),
+					v1.NewTallyResult(math.NewInt(1), math.NewInt(1), math.ZeroInt(), math.ZeroInt()),
+					math.NewInt(10),
+					ctx.BlockTime(),
+				)
+				suite.govKeeper.SetTallySnapshot(ctx, expSnapshot)
+
+				req = &v1.QueryTallySnapshotRequest{ProposalId: 1}
+			},
+			true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			testCase.malleate()
+
+			res, err := queryClient.TallySnapshot(gocontext.Background(), req)
+
+			if testCase.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expSnapshot.String(), res.TallySnapshot.String())
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestLegacyGRPCQueryTallyResult() {
 	suite.reset()
 	ctx, queryClient := suite.ctx, suite.legacyQueryClient
@@ -23,6 +23,8 @@ type MockGovHooksReceiver struct {
 	AfterProposalVoteValid              bool
 	AfterProposalFailedMinDepositValid  bool
 	AfterProposalVotingPeriodEndedValid bool
+	AfterProposalPassedValid            bool
+	AfterProposalFailedValid            bool
 }
 
 func (h *MockGovHooksReceiver) AfterProposalSubmission(ctx sdk.Context, proposalID uint64) {
@@ -45,6 +47,14 @@ func (h *MockGovHooksReceiver) AfterProposalVotingPeriodEnded(ctx sdk.Context, p
 	h.AfterProposalVotingPeriodEndedValid = true
 }
 
+func (h *MockGovHooksReceiver) AfterProposalPassed(ctx sdk.Context, proposalID uint64) {
+	h.AfterProposalPassedValid = true
+}
+
+func (h *MockGovHooksReceiver) AfterProposalFailed(ctx sdk.Context, proposalID uint64) {
+	h.AfterProposalFailedValid = true
+}
+
 func TestHooks(t *testing.T) {
 	minDeposit := v1.DefaultParams().MinDeposit
 	govKeeper, authKeeper, bankKeeper, stakingKeeper, _, _, ctx := setupGovKeeper(t)
@@ -66,6 +76,8 @@ func TestHooks(t *testing.T) {
 	require.False(t, govHooksReceiver.AfterProposalVoteValid)
 	require.False(t, govHooksReceiver.AfterProposalFailedMinDepositValid)
 	require.False(t, govHooksReceiver.AfterProposalVotingPeriodEndedValid)
+	require.False(t, govHooksReceiver.AfterProposalPassedValid)
+	require.False(t, govHooksReceiver.AfterProposalFailedValid)
 
 	tp := TestProposal
 	_, err := govKeeper.SubmitProposal(ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false)
@@ -96,4 +108,8 @@ func TestHooks(t *testing.T) {
 	ctx = ctx.WithBlockHeader(newHeader)
 	gov.EndBlocker(ctx, govKeeper)
 	require.True(t, govHooksReceiver.AfterProposalVotingPeriodEndedValid)
+
+	// the vote has no voting power, so the proposal does not reach quorum
+	require.True(t, govHooksReceiver.AfterProposalFailedValid)
+	require.False(t, govHooksReceiver.AfterProposalPassedValid)
 }
@@ -68,16 +68,79 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 		return nil, err
 	}
 
-	proposal, err := k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer, msg.Expedited)
+	var proposal v1.Proposal
+	if msg.Optimistic {
+		if msg.Expedited {
+			return nil, errors.Wrap(govtypes.ErrInvalidProposalType, "optimistic proposals cannot be expedited")
+		}
+
+		proposal, err = k.Keeper.SubmitOptimisticProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer)
+	} else {
+		proposal, err = k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer, msg.Expedited)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	bytes, err := proposal.Marshal()
+	if err := k.addInitialDeposit(ctx, proposal, proposer, initialDeposit); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgSubmitProposalResponse{
+		ProposalId: proposal.Id,
+	}, nil
+}
+
+// SubmitMultipleChoiceProposal implements the MsgServer.SubmitMultipleChoiceProposal method.
+func (k msgServer) SubmitMultipleChoiceProposal(goCtx context.Context, msg *v1.MsgSubmitMultipleChoiceProposal) (*v1.MsgSubmitMultipleChoiceProposalResponse, error) {
+	if msg.Title == "" {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "proposal title cannot be empty")
+	}
+	if msg.Summary == "" {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "proposal summary cannot be empty")
+	}
+	if msg.VoteOptions == nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "vote options cannot be empty")
+	}
+
+	proposer, err := k.authKeeper.StringToBytes(msg.GetProposer())
 	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid proposer address: %s", err)
+	}
+
+	if err := validateDeposit(sdk.NewCoins(msg.InitialDeposit...)); err != nil {
 		return nil, err
 	}
 
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	initialDeposit := msg.GetInitialDeposit()
+
+	if err := k.validateInitialDeposit(ctx, initialDeposit, false); err != nil {
+		return nil, err
+	}
+
+	proposal, err := k.Keeper.SubmitMultipleChoiceProposal(ctx, msg.Metadata, msg.Title, msg.Summary, proposer, *msg.VoteOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.addInitialDeposit(ctx, proposal, proposer, initialDeposit); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgSubmitMultipleChoiceProposalResponse{
+		ProposalId: proposal.Id,
+	}, nil
+}
+
+// addInitialDeposit charges the gas of a submitted proposal and adds the
+// initial deposit of its proposer.
+func (k msgServer) addInitialDeposit(ctx sdk.Context, proposal v1.Proposal, proposer sdk.AccAddress, initialDeposit sdk.Coins) error {
+	bytes, err := proposal.Marshal()
+	if err != nil {
+		return err
+	}
+
 	// ref: https://github.com/cosmos/cosmos-sdk/issues/9683
 	ctx.GasMeter().ConsumeGas(
 		3*ctx.KVGasConfig().WriteCostPerByte*uint64(len(bytes)),
@@ -86,9 +149,9 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 
 	defer telemetry.IncrCounter(1, govtypes.ModuleName, "proposal")
 
-	votingStarted, err := k.Keeper.AddDeposit(ctx, proposal.Id, proposer, msg.GetInitialDeposit())
+	votingStarted, err := k.Keeper.AddDeposit(ctx, proposal.Id, proposer, initialDeposit)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	if votingStarted {
@@ -99,9 +162,7 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 		)
 	}
 
-	return &v1.MsgSubmitProposalResponse{
-		ProposalId: proposal.Id,
-	}, nil
+	return nil
 }
 
 // CancelProposals implements the MsgServer.CancelProposal method.
@@ -165,7 +226,9 @@ func (k msgServer) Vote(goCtx context.Context, msg *v1.MsgVote) (*v1.MsgVoteResp
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
 	}
 
-	if !v1.ValidVoteOption(msg.Option) {
+	// the option is checked against the vote options of the proposal when the
+	// vote is added, as a multiple choice proposal has custom options
+	if msg.Option <= v1.OptionEmpty {
 		return nil, errors.Wrap(govtypes.ErrInvalidVote, msg.Option.String())
 	}
 
@@ -294,6 +357,26 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *v1.MsgUpdateParams)
 	return &v1.MsgUpdateParamsResponse{}, nil
 }
 
+// ScheduleParamChange implements the MsgServer.ScheduleParamChange method.
+func (k msgServer) ScheduleParamChange(goCtx context.Context, msg *v1.MsgScheduleParamChange) (*v1.MsgScheduleParamChangeResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	updateParamsMsg, err := msg.GetUpdateParamsMsg()
+	if err != nil {
+		return nil, errors.Wrap(govtypes.ErrInvalidParamChange, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	id, err := k.Keeper.ScheduleParamChange(ctx, updateParamsMsg, msg.Height, msg.Time)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgScheduleParamChangeResponse{Id: id}, nil
+}
+
 type legacyMsgServer struct {
 	govAcct string
 	server  v1.MsgServer
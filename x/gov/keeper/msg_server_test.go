@@ -213,6 +213,124 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSubmitMultipleChoiceProposalReq() {
+	suite.reset()
+	proposer := suite.addrs[0]
+	initialDeposit := sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(100)))
+
+	cases := map[string]struct {
+		voteOptions *v1.ProposalVoteOptions
+		expErr      bool
+		expErrMsg   string
+	}{
+		"empty vote options": {
+			voteOptions: nil,
+			expErr:      true,
+			expErrMsg:   "vote options cannot be empty",
+		},
+		"single vote option": {
+			voteOptions: &v1.ProposalVoteOptions{Options: []string{"Vote for me"}},
+			expErr:      true,
+			expErrMsg:   "at least two vote options",
+		},
+		"empty vote option": {
+			voteOptions: &v1.ProposalVoteOptions{Options: []string{"A", "", "C"}},
+			expErr:      true,
+			expErrMsg:   "vote option 2 cannot be empty",
+		},
+		"duplicated vote option": {
+			voteOptions: &v1.ProposalVoteOptions{Options: []string{"A", "B", "A"}},
+			expErr:      true,
+			expErrMsg:   "duplicated vote option A",
+		},
+		"all good": {
+			voteOptions: &v1.ProposalVoteOptions{Options: []string{"A", "B", "C"}},
+			expErr:      false,
+		},
+		"more than four vote options": {
+			voteOptions: &v1.ProposalVoteOptions{Options: []string{"A", "B", "C", "D", "E", "F"}},
+			expErr:      false,
+		},
+	}
+
+	for name, tc := range cases {
+		suite.Run(name, func() {
+			msg := v1.NewMultipleChoiceMsgSubmitProposal(initialDeposit, proposer.String(), "", "Proposal", "description of proposal", tc.voteOptions)
+			res, err := suite.msgSrvr.SubmitMultipleChoiceProposal(suite.ctx, msg)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			proposal, found := suite.govKeeper.GetProposal(suite.ctx, res.ProposalId)
+			suite.Require().True(found)
+			suite.Require().Equal(v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE, proposal.ProposalType)
+			suite.Require().Equal(tc.voteOptions, proposal.VoteOptions)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSubmitOptimisticProposalReq() {
+	suite.reset()
+	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+	authorized, unauthorized := suite.addrs[0], suite.addrs[1]
+	coins := sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(100)))
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: govAcct.String(),
+		ToAddress:   authorized.String(),
+		Amount:      coins,
+	}
+
+	params := suite.govKeeper.GetParams(suite.ctx)
+	params.OptimisticAuthorizedAddresses = []string{authorized.String()}
+	suite.Require().NoError(suite.govKeeper.SetParams(suite.ctx, params))
+
+	cases := map[string]struct {
+		proposer  sdk.AccAddress
+		expedited bool
+		expErr    bool
+		expErrMsg string
+	}{
+		"unauthorized proposer": {
+			proposer:  unauthorized,
+			expErr:    true,
+			expErrMsg: "is not authorized to submit optimistic proposals",
+		},
+		"optimistic expedited proposal": {
+			proposer:  authorized,
+			expedited: true,
+			expErr:    true,
+			expErrMsg: "optimistic proposals cannot be expedited",
+		},
+		"all good": {
+			proposer: authorized,
+			expErr:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		suite.Run(name, func() {
+			msg, err := v1.NewMsgSubmitProposal([]sdk.Msg{bankMsg}, coins, tc.proposer.String(), "", "Proposal", "description of proposal", tc.expedited)
+			suite.Require().NoError(err)
+			msg.Optimistic = true
+
+			res, err := suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			proposal, found := suite.govKeeper.GetProposal(suite.ctx, res.ProposalId)
+			suite.Require().True(found)
+			suite.Require().Equal(v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC, proposal.ProposalType)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestCancelProposalReq() {
 	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
 	addrs := suite.addrs
@@ -1707,3 +1825,116 @@ func (suite *KeeperTestSuite) TestSubmitProposal_InitialDeposit() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgScheduleParamChange() {
+	authority := suite.govKeeper.GetAuthority()
+	params := v1.DefaultParams()
+	params.BurnVoteVeto = false
+	updateParamsMsg := &v1.MsgUpdateParams{Authority: authority, Params: params}
+	futureHeight := suite.ctx.BlockHeight() + 10
+	futureTime := suite.ctx.BlockTime().Add(time.Hour)
+	pastTime := suite.ctx.BlockTime().Add(-time.Hour)
+
+	testCases := []struct {
+		name      string
+		input     func() *v1.MsgScheduleParamChange
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			input: func() *v1.MsgScheduleParamChange {
+				msg, err := v1.NewMsgScheduleParamChange("authority", updateParamsMsg, futureHeight, nil)
+				suite.Require().NoError(err)
+				return msg
+			},
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "not a MsgUpdateParams",
+			input: func() *v1.MsgScheduleParamChange {
+				msg, err := v1.NewMsgScheduleParamChange(authority, TestProposal[0], futureHeight, nil)
+				suite.Require().NoError(err)
+				return msg
+			},
+			expErrMsg: "only MsgUpdateParams messages can be scheduled",
+		},
+		{
+			name: "neither height nor time",
+			input: func() *v1.MsgScheduleParamChange {
+				msg, err := v1.NewMsgScheduleParamChange(authority, updateParamsMsg, 0, nil)
+				suite.Require().NoError(err)
+				return msg
+			},
+			expErrMsg: "one of height and time must be set",
+		},
+		{
+			name: "both height and time",
+			input: func() *v1.MsgScheduleParamChange {
+				msg, err := v1.NewMsgScheduleParamChange(authority, updateParamsMsg, futureHeight, &futureTime)
+				suite.Require().NoError(err)
+				return msg
+			},
+			expErrMsg: "only one of height and time can be set",
+		},
+		{
+			name: "time in the past",
+			input: func() *v1.MsgScheduleParamChange {
+				msg, err := v1.NewMsgScheduleParamChange(authority, updateParamsMsg, 0, &pastTime)
+				suite.Require().NoError(err)
+				return msg
+			},
+			expErrMsg: "param change must be scheduled in the future",
+		},
+		{
+			name: "invalid signer of the scheduled message",
+			input: func() *v1.MsgScheduleParamChange {
+				msg, err := v1.NewMsgScheduleParamChange(authority, &v1.MsgUpdateParams{Authority: address1, Params: params}, futureHeight, nil)
+				suite.Require().NoError(err)
+				return msg
+			},
+			expErrMsg: "expected gov account as only signer",
+		},
+		{
+			name: "valid height",
+			input: func() *v1.MsgScheduleParamChange {
+				msg, err := v1.NewMsgScheduleParamChange(authority, updateParamsMsg, futureHeight, nil)
+				suite.Require().NoError(err)
+				return msg
+			},
+		},
+		{
+			name: "valid time",
+			input: func() *v1.MsgScheduleParamChange {
+				msg, err := v1.NewMsgScheduleParamChange(authority, updateParamsMsg, 0, &futureTime)
+				suite.Require().NoError(err)
+				return msg
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg := tc.input()
+			res, err := suite.msgSrvr.ScheduleParamChange(suite.ctx, msg)
+			if tc.expErrMsg != "" {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			change, found := suite.govKeeper.GetScheduledParamChange(suite.ctx, res.Id)
+			suite.Require().True(found)
+			suite.Require().Equal(msg.Height, change.Height)
+			suite.Require().Equal(msg.Msg.TypeUrl, change.Msg.TypeUrl)
+
+			queryRes, err := suite.queryClient.ScheduledParamChanges(suite.ctx, &v1.QueryScheduledParamChangesRequest{})
+			suite.Require().NoError(err)
+			var ids []uint64
+			for _, c := range queryRes.ScheduledParamChanges {
+				ids = append(ids, c.Id)
+			}
+			suite.Require().Contains(ids, change.Id)
+		})
+	}
+}
@@ -16,6 +16,56 @@ import (
 
 // SubmitProposal creates a new proposal given an array of messages
 func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress, expedited bool) (v1.Proposal, error) {
+	return keeper.submitProposal(ctx, messages, metadata, title, summary, proposer, expedited, v1.ProposalType_PROPOSAL_TYPE_STANDARD, nil)
+}
+
+// SubmitOptimisticProposal creates a new optimistic proposal given an array of
+// messages. An optimistic proposal passes unless it is rejected by the optimistic
+// rejected threshold of the voting power, and can only be submitted by the
+// optimistic authorized addresses.
+func (keeper Keeper) SubmitOptimisticProposal(ctx sdk.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress) (v1.Proposal, error) {
+	params := keeper.GetParams(ctx)
+
+	authorized := false
+	for _, addr := range params.OptimisticAuthorizedAddresses {
+		if addr == proposer.String() {
+			authorized = true
+			break
+		}
+	}
+	if !authorized {
+		return v1.Proposal{}, errorsmod.Wrapf(types.ErrInvalidProposer, "%s is not authorized to submit optimistic proposals", proposer)
+	}
+
+	return keeper.submitProposal(ctx, messages, metadata, title, summary, proposer, false, v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC, nil)
+}
+
+// SubmitMultipleChoiceProposal creates a new multiple choice proposal with the
+// given vote options. A multiple choice proposal has no messages, and its
+// outcome is the tally of the votes for each option.
+func (keeper Keeper) SubmitMultipleChoiceProposal(ctx sdk.Context, metadata, title, summary string, proposer sdk.AccAddress, voteOptions v1.ProposalVoteOptions) (v1.Proposal, error) {
+	if err := voteOptions.ValidateBasic(); err != nil {
+		return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposalContent, err.Error())
+	}
+
+	for _, option := range voteOptions.Options {
+		if err := keeper.assertMetadataLength(option); err != nil {
+			return v1.Proposal{}, err
+		}
+	}
+
+	return keeper.submitProposal(ctx, nil, metadata, title, summary, proposer, false, v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE, &voteOptions)
+}
+
+func (keeper Keeper) submitProposal(
+	ctx sdk.Context,
+	messages []sdk.Msg,
+	metadata, title, summary string,
+	proposer sdk.AccAddress,
+	expedited bool,
+	proposalType v1.ProposalType,
+	voteOptions *v1.ProposalVoteOptions,
+) (v1.Proposal, error) {
 	err := keeper.assertMetadataLength(metadata)
 	if err != nil {
 		return v1.Proposal{}, err
@@ -93,6 +143,8 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	if err != nil {
 		return v1.Proposal{}, err
 	}
+	proposal.ProposalType = proposalType
+	proposal.VoteOptions = voteOptions
 
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, *proposal.DepositEndTime)
@@ -112,7 +164,8 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	return proposal, nil
 }
 
-// CancelProposal will cancel proposal before the voting period ends
+// CancelProposal will cancel proposal during its deposit period, or during the
+// first part of its voting period defined by the proposal_cancel_max_period param.
 func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer string) error {
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	if !ok {
@@ -140,9 +193,14 @@ func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer
 		return types.ErrVotingPeriodEnded.Wrapf("voting period is already ended for this proposal %d", proposalID)
 	}
 
+	// Check proposal is not too far in its voting period to be canceled.
+	params := keeper.GetParams(ctx)
+	if deadline := proposal.GetCancelDeadlineFromParams(params); deadline != nil && !ctx.BlockTime().Before(*deadline) {
+		return types.ErrCancelPeriodEnded.Wrapf("proposal %d can no longer be canceled since %s", proposalID, deadline)
+	}
+
 	// burn the (deposits * proposal_cancel_rate) amount or sent to cancellation destination address.
 	// and deposits * (1 - proposal_cancel_rate) will be sent to depositors.
-	params := keeper.GetParams(ctx)
 	err := keeper.ChargeDeposit(ctx, proposal.Id, params.ProposalCancelDest, params.ProposalCancelRatio)
 	if err != nil {
 		return err
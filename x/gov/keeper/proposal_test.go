@@ -316,6 +316,56 @@ func (suite *KeeperTestSuite) TestCancelProposal() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestCancelProposalMaxPeriod() {
+	params := suite.govKeeper.GetParams(suite.ctx)
+	params.ProposalCancelMaxPeriod = "0.5"
+	suite.Require().NoError(suite.govKeeper.SetParams(suite.ctx, params))
+
+	testCases := []struct {
+		name        string
+		elapsed     time.Duration
+		expectedErr error
+	}{
+		{
+			name:    "start of the voting period",
+			elapsed: 0,
+		},
+		{
+			name:    "before the end of the cancel period",
+			elapsed: *params.VotingPeriod/2 - time.Second,
+		},
+		{
+			name:        "end of the cancel period",
+			elapsed:     *params.VotingPeriod / 2,
+			expectedErr: types.ErrCancelPeriodEnded,
+		},
+		{
+			name:        "after the end of the voting period",
+			elapsed:     *params.VotingPeriod + time.Second,
+			expectedErr: types.ErrVotingPeriodEnded,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "title", "summary", suite.addrs[0], false)
+			suite.Require().NoError(err)
+			suite.govKeeper.ActivateVotingPeriod(suite.ctx, proposal)
+
+			ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(tc.elapsed))
+			err = suite.govKeeper.CancelProposal(ctx, proposal.Id, suite.addrs[0].String())
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				suite.govKeeper.DeleteProposal(suite.ctx, proposal.Id)
+			} else {
+				suite.Require().NoError(err)
+				_, found := suite.govKeeper.GetProposal(suite.ctx, proposal.Id)
+				suite.Require().False(found)
+			}
+		})
+	}
+}
+
 func TestMigrateProposalMessages(t *testing.T) {
 	content := v1beta1.NewTextProposal("Test", "description")
 	contentMsg, err := v1.NewLegacyContent(content, sdk.AccAddress("test1").String())
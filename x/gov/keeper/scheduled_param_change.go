@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// ScheduleParamChange schedules the given MsgUpdateParams message to be executed
+// at the given future height or time, and returns the id of the scheduled param change.
+func (keeper Keeper) ScheduleParamChange(ctx sdk.Context, msg sdk.Msg, height int64, t *time.Time) (uint64, error) {
+	id := keeper.GetParamChangeID(ctx)
+	change, err := v1.NewScheduledParamChange(id, msg, height, t)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := change.ValidateBasic(); err != nil {
+		return 0, errorsmod.Wrap(types.ErrInvalidParamChange, err.Error())
+	}
+
+	if change.IsDue(ctx.BlockHeight(), ctx.BlockTime()) {
+		return 0, errorsmod.Wrap(types.ErrInvalidParamChange, "param change must be scheduled in the future")
+	}
+
+	// perform a basic validation of the message
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return 0, errorsmod.Wrap(types.ErrInvalidParamChange, err.Error())
+		}
+	}
+
+	// assert that the governance module account is the only signer of the message
+	signers := msg.GetSigners()
+	if len(signers) != 1 || !signers[0].Equals(keeper.GetGovernanceAccount(ctx).GetAddress()) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidSigner, "%v", signers)
+	}
+
+	// use the msg service router to see that there is a valid route for that message.
+	if handler := keeper.router.Handler(msg); handler == nil {
+		return 0, errorsmod.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
+	}
+
+	keeper.SetScheduledParamChange(ctx, change)
+	keeper.SetParamChangeID(ctx, id+1)
+
+	return id, nil
+}
+
+// GetParamChangeID gets the id of the next scheduled param change
+func (keeper Keeper) GetParamChangeID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.ParamChangeIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	return types.GetProposalIDFromBytes(bz)
+}
+
+// SetParamChangeID sets the id of the next scheduled param change
+func (keeper Keeper) SetParamChangeID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.ParamChangeIDKey, types.GetProposalIDBytes(id))
+}
+
+// SetScheduledParamChange sets a scheduled param change to store, and inserts
+// it in the queue of the param changes executed at its height or time
+func (keeper Keeper) SetScheduledParamChange(ctx sdk.Context, change v1.ScheduledParamChange) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&change)
+	store.Set(types.ScheduledParamChangeKey(change.Id), bz)
+	store.Set(paramChangeQueueKey(change), types.GetProposalIDBytes(change.Id))
+}
+
+// GetScheduledParamChange gets a scheduled param change from store by id
+func (keeper Keeper) GetScheduledParamChange(ctx sdk.Context, id uint64) (change v1.ScheduledParamChange, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.ScheduledParamChangeKey(id))
+	if bz == nil {
+		return change, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &change)
+	return change, true
+}
+
+// DeleteScheduledParamChange deletes a scheduled param change from store, and
+// removes it from its queue
+func (keeper Keeper) DeleteScheduledParamChange(ctx sdk.Context, id uint64) {
+	change, found := keeper.GetScheduledParamChange(ctx, id)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.ScheduledParamChangeKey(id))
+	store.Delete(paramChangeQueueKey(change))
+}
+
+// paramChangeQueueKey returns the key of a scheduled param change in the queue
+// of the param changes executed at its height or time
+func paramChangeQueueKey(change v1.ScheduledParamChange) []byte {
+	if change.Time != nil {
+		return types.ParamChangeTimeQueueKey(change.Id, *change.Time)
+	}
+
+	return types.ParamChangeHeightQueueKey(change.Id, change.Height)
+}
+
+// IterateScheduledParamChanges iterates over all the scheduled param changes, by
+// ascending id, and performs a callback function
+func (keeper Keeper) IterateScheduledParamChanges(ctx sdk.Context, cb func(change v1.ScheduledParamChange) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ScheduledParamChangesKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var change v1.ScheduledParamChange
+		keeper.cdc.MustUnmarshal(iterator.Value(), &change)
+
+		if cb(change) {
+			break
+		}
+	}
+}
+
+// GetScheduledParamChanges returns all the scheduled param changes from store
+func (keeper Keeper) GetScheduledParamChanges(ctx sdk.Context) (changes v1.ScheduledParamChanges) {
+	keeper.IterateScheduledParamChanges(ctx, func(change v1.ScheduledParamChange) bool {
+		changes = append(changes, &change)
+		return false
+	})
+	return
+}
+
+// GetDueScheduledParamChanges returns the scheduled param changes which must be
+// executed at the current block height and time, by ascending id. Only the
+// param changes which are due are read, from the height and time queues.
+func (keeper Keeper) GetDueScheduledParamChanges(ctx sdk.Context) (changes []v1.ScheduledParamChange) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	var ids []uint64
+	for _, queue := range [][2][]byte{
+		{types.ParamChangeHeightQueuePrefix, types.ParamChangeByHeightKey(ctx.BlockHeight())},
+		{types.ParamChangeTimeQueuePrefix, types.ParamChangeByTimeKey(ctx.BlockTime())},
+	} {
+		iterator := store.Iterator(queue[0], storetypes.PrefixEndBytes(queue[1]))
+		for ; iterator.Valid(); iterator.Next() {
+			ids = append(ids, types.GetProposalIDFromBytes(iterator.Value()))
+		}
+		iterator.Close()
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	for _, id := range ids {
+		change, found := keeper.GetScheduledParamChange(ctx, id)
+		if !found {
+			panic(fmt.Sprintf("scheduled param change %d does not exist", id))
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes
+}
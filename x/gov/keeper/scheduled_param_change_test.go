@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"time"
+
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func (suite *KeeperTestSuite) TestGetDueScheduledParamChanges() {
+	ctx := suite.ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0).UTC())
+	msg := &v1.MsgUpdateParams{Authority: suite.govKeeper.GetAuthority(), Params: v1.DefaultParams()}
+
+	pastTime, nowTime, futureTime := ctx.BlockTime().Add(-time.Hour), ctx.BlockTime(), ctx.BlockTime().Add(time.Hour)
+	schedules := []struct {
+		height int64
+		time   *time.Time
+	}{
+		{height: 11},
+		{time: &nowTime},
+		{height: 9},
+		{time: &futureTime},
+		{height: 10},
+		{time: &pastTime},
+	}
+
+	for i, schedule := range schedules {
+		change, err := v1.NewScheduledParamChange(uint64(i+1), msg, schedule.height, schedule.time)
+		suite.Require().NoError(err)
+		suite.govKeeper.SetScheduledParamChange(ctx, change)
+	}
+
+	dueIDs := func() (ids []uint64) {
+		for _, change := range suite.govKeeper.GetDueScheduledParamChanges(ctx) {
+			ids = append(ids, change.Id)
+		}
+		return ids
+	}
+
+	// the due param changes are returned by ascending id
+	suite.Require().Equal([]uint64{2, 3, 5, 6}, dueIDs())
+
+	// a deleted param change is removed from its queue, otherwise it would be
+	// looked up as due
+	suite.govKeeper.DeleteScheduledParamChange(ctx, 3)
+	suite.govKeeper.DeleteScheduledParamChange(ctx, 6)
+	suite.Require().Equal([]uint64{2, 5}, dueIDs())
+
+	ctx = ctx.WithBlockHeight(11).WithBlockTime(futureTime)
+	suite.Require().Equal([]uint64{1, 2, 4, 5}, dueIDs())
+}
@@ -2,7 +2,10 @@ package keeper
 
 import (
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -12,11 +15,17 @@ import (
 // Tally iterates over the votes and updates the tally of a proposal based on the voting power of the
 // voters
 func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes, burnDeposits bool, tallyResults v1.TallyResult) {
-	results := make(map[v1.VoteOption]sdk.Dec)
-	results[v1.OptionYes] = math.LegacyZeroDec()
-	results[v1.OptionAbstain] = math.LegacyZeroDec()
-	results[v1.OptionNo] = math.LegacyZeroDec()
-	results[v1.OptionNoWithVeto] = math.LegacyZeroDec()
+	passes, burnDeposits, snapshot := keeper.TallyWithSnapshot(ctx, proposal)
+	return passes, burnDeposits, *snapshot.Tally
+}
+
+// TallyWithSnapshot tallies a proposal like Tally, and also returns the breakdown
+// of the tally between the votes of validators and the votes of delegators.
+func (keeper Keeper) TallyWithSnapshot(ctx sdk.Context, proposal v1.Proposal) (passes, burnDeposits bool, snapshot v1.TallySnapshot) {
+	validOptions := proposal.GetValidVoteOptions()
+	results := newTallyMap(validOptions)
+	validatorResults := newTallyMap(validOptions)
+	delegatorResults := newTallyMap(validOptions)
 
 	totalVotingPower := math.LegacyZeroDec()
 	currValidators := make(map[string]v1.ValidatorGovInfo)
@@ -42,11 +51,19 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes, burnD
 		}
 
 		valAddrStr := sdk.ValAddress(voter).String()
-		if val, ok := currValidators[valAddrStr]; ok {
+		val, isValidator := currValidators[valAddrStr]
+		if isValidator {
 			val.Vote = vote.Options
 			currValidators[valAddrStr] = val
 		}
 
+		// the voting power of the self-delegations of a validator is counted
+		// as cast by the validator
+		voterResults := delegatorResults
+		if isValidator {
+			voterResults = validatorResults
+		}
+
 		// iterate over all delegations from voter, deduct from any delegated-to validators
 		keeper.sk.IterateDelegations(ctx, voter, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
 			valAddrStr := delegation.GetValidatorAddr().String()
@@ -64,6 +81,7 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes, burnD
 					weight, _ := math.LegacyNewDecFromStr(option.Weight)
 					subPower := votingPower.Mul(weight)
 					results[option.Option] = results[option.Option].Add(subPower)
+					voterResults[option.Option] = voterResults[option.Option].Add(subPower)
 				}
 				totalVotingPower = totalVotingPower.Add(votingPower)
 			}
@@ -88,35 +106,76 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes, burnD
 			weight, _ := math.LegacyNewDecFromStr(option.Weight)
 			subPower := votingPower.Mul(weight)
 			results[option.Option] = results[option.Option].Add(subPower)
+			validatorResults[option.Option] = validatorResults[option.Option].Add(subPower)
 		}
 		totalVotingPower = totalVotingPower.Add(votingPower)
 	}
 
 	params := keeper.GetParams(ctx)
-	tallyResults = v1.NewTallyResultFromMap(results)
+	totalBondedTokens := keeper.sk.TotalBondedTokens(ctx)
+	if proposal.IsMultipleChoice() {
+		// the options of a multiple choice proposal are tallied by index, as
+		// they don't match the counts of the standard vote options
+		snapshot = v1.NewTallySnapshot(
+			proposal.Id,
+			v1.EmptyTallyResult(),
+			v1.EmptyTallyResult(),
+			v1.EmptyTallyResult(),
+			totalBondedTokens,
+			ctx.BlockTime(),
+		)
+		snapshot.OptionTallyResults = v1.NewOptionTallyResultsFromMap(results, len(validOptions.Options))
+	} else {
+		snapshot = v1.NewTallySnapshot(
+			proposal.Id,
+			v1.NewTallyResultFromMap(results),
+			v1.NewTallyResultFromMap(validatorResults),
+			v1.NewTallyResultFromMap(delegatorResults),
+			totalBondedTokens,
+			ctx.BlockTime(),
+		)
+	}
 
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
 	// If there is no staked coins, the proposal fails
-	if keeper.sk.TotalBondedTokens(ctx).IsZero() {
-		return false, false, tallyResults
+	if totalBondedTokens.IsZero() {
+		return false, false, snapshot
+	}
+
+	// An optimistic proposal passes unless enough of the total voting power
+	// votes no or no with veto
+	if proposal.IsOptimistic() {
+		optimisticRejectedThreshold, _ := math.LegacyNewDecFromStr(params.OptimisticRejectedThreshold)
+		rejectingVotingPower := results[v1.OptionNo].Add(results[v1.OptionNoWithVeto])
+		if rejectingVotingPower.Quo(math.LegacyNewDecFromInt(totalBondedTokens)).GTE(optimisticRejectedThreshold) {
+			return false, false, snapshot
+		}
+
+		return true, false, snapshot
 	}
 
 	// If there is not enough quorum of votes, the proposal fails
-	percentVoting := totalVotingPower.Quo(math.LegacyNewDecFromInt(keeper.sk.TotalBondedTokens(ctx)))
+	percentVoting := totalVotingPower.Quo(math.LegacyNewDecFromInt(totalBondedTokens))
 	quorum, _ := math.LegacyNewDecFromStr(params.Quorum)
 	if percentVoting.LT(quorum) {
-		return false, params.BurnVoteQuorum, tallyResults
+		return false, params.BurnVoteQuorum, snapshot
+	}
+
+	// A multiple choice proposal has no threshold, it is tallied once quorum is
+	// reached and its outcome is the tally of the votes for each option
+	if proposal.IsMultipleChoice() {
+		return true, false, snapshot
 	}
 
 	// If no one votes (everyone abstains), proposal fails
 	if totalVotingPower.Sub(results[v1.OptionAbstain]).Equal(math.LegacyZeroDec()) {
-		return false, false, tallyResults
+		return false, false, snapshot
 	}
 
 	// If more than 1/3 of voters veto, proposal fails
 	vetoThreshold, _ := math.LegacyNewDecFromStr(params.VetoThreshold)
 	if results[v1.OptionNoWithVeto].Quo(totalVotingPower).GT(vetoThreshold) {
-		return false, params.BurnVoteVeto, tallyResults
+		return false, params.BurnVoteVeto, snapshot
 	}
 
 	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
@@ -130,9 +189,53 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes, burnD
 
 	threshold, _ := math.LegacyNewDecFromStr(thresholdStr)
 	if results[v1.OptionYes].Quo(totalVotingPower.Sub(results[v1.OptionAbstain])).GT(threshold) {
-		return true, false, tallyResults
+		return true, false, snapshot
 	}
 
 	// If more than 1/2 of non-abstaining voters vote No, proposal fails
-	return false, false, tallyResults
+	return false, false, snapshot
+}
+
+// newTallyMap returns a tally map with a zero count for each of the vote
+// options, which are indexed from 1 like the standard vote options.
+func newTallyMap(voteOptions *v1.ProposalVoteOptions) map[v1.VoteOption]sdk.Dec {
+	results := make(map[v1.VoteOption]sdk.Dec, len(voteOptions.Options))
+	for i := range voteOptions.Options {
+		results[v1.VoteOption(i+1)] = math.LegacyZeroDec()
+	}
+	return results
+}
+
+// SetTallySnapshot sets the tally snapshot of a proposal
+func (keeper Keeper) SetTallySnapshot(ctx sdk.Context, snapshot v1.TallySnapshot) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&snapshot)
+	store.Set(types.TallySnapshotKey(snapshot.ProposalId), bz)
+}
+
+// GetTallySnapshot gets the tally snapshot of a proposal
+func (keeper Keeper) GetTallySnapshot(ctx sdk.Context, proposalID uint64) (snapshot v1.TallySnapshot, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.TallySnapshotKey(proposalID))
+	if bz == nil {
+		return snapshot, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &snapshot)
+	return snapshot, true
+}
+
+// GetTallySnapshots returns all the tally snapshots from store
+func (keeper Keeper) GetTallySnapshots(ctx sdk.Context) (snapshots []*v1.TallySnapshot) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.TallySnapshotKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot v1.TallySnapshot
+		keeper.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		snapshots = append(snapshots, &snapshot)
+	}
+
+	return snapshots
 }
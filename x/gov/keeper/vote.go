@@ -19,15 +19,27 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 		return errors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
 	}
 
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return errors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+
 	err := keeper.assertMetadataLength(metadata)
 	if err != nil {
 		return err
 	}
 
+	validOptions := proposal.GetValidVoteOptions()
 	for _, option := range options {
-		if !v1.ValidWeightedVoteOption(*option) {
+		if !option.IsValid() {
 			return errors.Wrap(types.ErrInvalidVote, option.String())
 		}
+
+		// the votes must be for one of the options of the proposal, which
+		// are the custom options of a multiple choice proposal
+		if !validOptions.HasOption(option.Option) {
+			return errors.Wrapf(types.ErrInvalidVote, "%s is not an option of proposal %d", option.Option, proposalID)
+		}
 	}
 
 	vote := v1.NewVote(proposalID, voterAddr, options, metadata)
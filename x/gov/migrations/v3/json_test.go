@@ -90,6 +90,7 @@ func TestMigrateJSON(t *testing.T) {
 		{
 			"deposit_end_time": "2001-09-09T01:46:40Z",
 			"expedited": false,
+			"final_option_tally_results": [],
 			"final_tally_result": {
 				"abstain_count": "0",
 				"no_count": "0",
@@ -109,6 +110,7 @@ func TestMigrateJSON(t *testing.T) {
 				}
 			],
 			"metadata": "",
+			"proposal_type": "PROPOSAL_TYPE_UNSPECIFIED",
 			"proposer": "",
 			"status": "PROPOSAL_STATUS_DEPOSIT_PERIOD",
 			"submit_time": "2001-09-09T01:46:40Z",
@@ -120,16 +122,19 @@ func TestMigrateJSON(t *testing.T) {
 					"denom": "stake"
 				}
 			],
+			"vote_options": null,
 			"voting_end_time": "2001-09-09T01:46:40Z",
 			"voting_start_time": "2001-09-09T01:46:40Z"
 		}
 	],
+	"scheduled_param_changes": [],
 	"starting_proposal_id": "1",
 	"tally_params": {
 		"quorum": "0.334000000000000000",
 		"threshold": "0.500000000000000000",
 		"veto_threshold": "0.334000000000000000"
 	},
+	"tally_snapshots": [],
 	"votes": [
 		{
 			"metadata": "",
@@ -26,9 +26,12 @@ func MigrateJSON(oldState *v1.GenesisState) (*v1.GenesisState, error) {
 		defaultParams.MinInitialDepositRatio,
 		defaultParams.ProposalCancelRatio,
 		defaultParams.ProposalCancelDest,
+		defaultParams.ProposalCancelMaxPeriod,
+		defaultParams.OptimisticRejectedThreshold,
 		defaultParams.BurnProposalDepositPrevote,
 		defaultParams.BurnVoteQuorum,
 		defaultParams.BurnVoteVeto,
+		defaultParams.OptimisticAuthorizedAddresses,
 	)
 
 	return &v1.GenesisState{
@@ -79,7 +79,10 @@ func TestMigrateJSON(t *testing.T) {
 			}
 		],
 		"min_initial_deposit_ratio": "0.000000000000000000",
+		"optimistic_authorized_addresses": [],
+		"optimistic_rejected_threshold": "0.100000000000000000",
 		"proposal_cancel_dest": "",
+		"proposal_cancel_max_period": "0.500000000000000000",
 		"proposal_cancel_ratio": "0.500000000000000000",
 		"quorum": "0.334000000000000000",
 		"threshold": "0.500000000000000000",
@@ -87,8 +90,10 @@ func TestMigrateJSON(t *testing.T) {
 		"voting_period": "172800s"
 	},
 	"proposals": [],
+	"scheduled_param_changes": [],
 	"starting_proposal_id": "1",
 	"tally_params": null,
+	"tally_snapshots": [],
 	"votes": [],
 	"voting_params": null
 }`
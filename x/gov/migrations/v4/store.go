@@ -39,9 +39,12 @@ func migrateParams(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace
 		defaultParams.MinInitialDepositRatio,
 		defaultParams.ProposalCancelRatio,
 		defaultParams.ProposalCancelDest,
+		defaultParams.ProposalCancelMaxPeriod,
+		defaultParams.OptimisticRejectedThreshold,
 		defaultParams.BurnProposalDepositPrevote,
 		defaultParams.BurnVoteQuorum,
 		defaultParams.BurnVoteVeto,
+		defaultParams.OptimisticAuthorizedAddresses,
 	)
 
 	bz, err := cdc.Marshal(&params)
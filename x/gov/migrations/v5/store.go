@@ -26,6 +26,8 @@ func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.Binar
 	params.ExpeditedThreshold = defaultParams.ExpeditedThreshold
 	params.ProposalCancelRatio = defaultParams.ProposalCancelRatio
 	params.ProposalCancelDest = defaultParams.ProposalCancelDest
+	params.ProposalCancelMaxPeriod = defaultParams.ProposalCancelMaxPeriod
+	params.OptimisticRejectedThreshold = defaultParams.OptimisticRejectedThreshold
 
 	bz, err := cdc.Marshal(&params)
 	if err != nil {
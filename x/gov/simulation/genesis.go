@@ -17,17 +17,19 @@ import (
 
 // Simulation parameter constants
 const (
-	MinDeposit            = "min_deposit"
-	ExpeditedMinDeposit   = "expedited_min_deposit"
-	DepositPeriod         = "deposit_period"
-	MinInitialRatio       = "min_initial_ratio"
-	VotingPeriod          = "voting_period"
-	ExpeditedVotingPeriod = "expedited_voting_period"
-	Quorum                = "quorum"
-	Threshold             = "threshold"
-	ExpeditedThreshold    = "expedited_threshold"
-	Veto                  = "veto"
-	ProposalCancelRate    = "proposal_cancel_rate"
+	MinDeposit                  = "min_deposit"
+	ExpeditedMinDeposit         = "expedited_min_deposit"
+	DepositPeriod               = "deposit_period"
+	MinInitialRatio             = "min_initial_ratio"
+	VotingPeriod                = "voting_period"
+	ExpeditedVotingPeriod       = "expedited_voting_period"
+	Quorum                      = "quorum"
+	Threshold                   = "threshold"
+	ExpeditedThreshold          = "expedited_threshold"
+	Veto                        = "veto"
+	ProposalCancelRate          = "proposal_cancel_rate"
+	ProposalCancelPeriod        = "proposal_cancel_period"
+	OptimisticRejectedThreshold = "optimistic_rejected_threshold"
 
 	// ExpeditedThreshold must be at least as large as the regular Threshold
 	// Therefore, we use this break out point in randomization.
@@ -64,6 +66,16 @@ func GenProposalCancelRate(r *rand.Rand) sdk.Dec {
 	return sdk.NewDec(int64(simulation.RandIntBetween(r, 0, 99))).Quo(sdk.NewDec(100))
 }
 
+// GenProposalCancelMaxPeriod returns randomized ProposalCancelMaxPeriod
+func GenProposalCancelMaxPeriod(r *rand.Rand) sdk.Dec {
+	return sdk.NewDec(int64(simulation.RandIntBetween(r, 0, 101))).Quo(sdk.NewDec(100))
+}
+
+// GenOptimisticRejectedThreshold returns randomized OptimisticRejectedThreshold
+func GenOptimisticRejectedThreshold(r *rand.Rand) sdk.Dec {
+	return sdk.NewDec(int64(simulation.RandIntBetween(r, 1, 25))).Quo(sdk.NewDec(100))
+}
+
 // GenVotingPeriod returns randomized VotingPeriod
 func GenVotingPeriod(r *rand.Rand) time.Duration {
 	return time.Duration(simulation.RandIntBetween(r, expeditedMaxVotingPeriod, 2*expeditedMaxVotingPeriod)) * time.Second
@@ -164,9 +176,21 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { veto = GenVeto(r) },
 	)
 
+	var proposalCancelMaxPeriod sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ProposalCancelPeriod, &proposalCancelMaxPeriod, simState.Rand,
+		func(r *rand.Rand) { proposalCancelMaxPeriod = GenProposalCancelMaxPeriod(r) },
+	)
+
+	var optimisticRejectedThreshold sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, OptimisticRejectedThreshold, &optimisticRejectedThreshold, simState.Rand,
+		func(r *rand.Rand) { optimisticRejectedThreshold = GenOptimisticRejectedThreshold(r) },
+	)
+
 	govGenesis := v1.NewGenesisState(
 		startingProposalID,
-		v1.NewParams(minDeposit, expeditedMinDeposit, depositPeriod, votingPeriod, expeditedVotingPeriod, quorum.String(), threshold.String(), expitedVotingThreshold.String(), veto.String(), minInitialDepositRatio.String(), proposalCancelRate.String(), "", simState.Rand.Intn(2) == 0, simState.Rand.Intn(2) == 0, simState.Rand.Intn(2) == 0),
+		v1.NewParams(minDeposit, expeditedMinDeposit, depositPeriod, votingPeriod, expeditedVotingPeriod, quorum.String(), threshold.String(), expitedVotingThreshold.String(), veto.String(), minInitialDepositRatio.String(), proposalCancelRate.String(), "", proposalCancelMaxPeriod.String(), optimisticRejectedThreshold.String(), simState.Rand.Intn(2) == 0, simState.Rand.Intn(2) == 0, simState.Rand.Intn(2) == 0, nil),
 	)
 
 	bz, err := json.MarshalIndent(&govGenesis, "", " ")
@@ -61,6 +61,7 @@ func TestRandomizedGenState(t *testing.T) {
 	assert.Equal(t, tallyThreshold, govGenesis.Params.Threshold)
 	assert.Equal(t, tallyExpeditedThreshold, govGenesis.Params.ExpeditedThreshold)
 	assert.Equal(t, tallyVetoThreshold, govGenesis.Params.VetoThreshold)
+	assert.Equal(t, "0.850000000000000000", govGenesis.Params.ProposalCancelMaxPeriod)
 	assert.Equal(t, uint64(0x28), govGenesis.StartingProposalId)
 	assert.DeepEqual(t, []*v1.Deposit{}, govGenesis.Deposits)
 	assert.DeepEqual(t, []*v1.Vote{}, govGenesis.Votes)
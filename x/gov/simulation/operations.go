@@ -512,6 +512,10 @@ func SimulateMsgCancelProposal(
 			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelProposal, "invalid proposal status"), nil, nil
 		}
 
+		if deadline := proposal.GetCancelDeadlineFromParams(k.GetParams(ctx)); deadline != nil && !ctx.BlockTime().Before(*deadline) {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelProposal, "cancel period ended"), nil, nil
+		}
+
 		account := ak.GetAccount(ctx, simAccount.Address)
 		spendable := bk.SpendableCoins(ctx, account.GetAddress())
 
@@ -7,6 +7,7 @@ package testutil
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	math "cosmossdk.io/math"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -159,6 +160,20 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 	return m.recorder
 }
 
+// AcceptEscrow mocks base method.
+func (m *MockBankKeeper) AcceptEscrow(ctx types.Context, counterparty types.AccAddress, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptEscrow", ctx, counterparty, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptEscrow indicates an expected call of AcceptEscrow.
+func (mr *MockBankKeeperMockRecorder) AcceptEscrow(ctx, counterparty, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptEscrow", reflect.TypeOf((*MockBankKeeper)(nil).AcceptEscrow), ctx, counterparty, id)
+}
+
 // AllBalances mocks base method.
 func (m *MockBankKeeper) AllBalances(arg0 context.Context, arg1 *types0.QueryAllBalancesRequest) (*types0.QueryAllBalancesResponse, error) {
 	m.ctrl.T.Helper()
@@ -174,6 +189,30 @@ func (mr *MockBankKeeperMockRecorder) AllBalances(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllBalances", reflect.TypeOf((*MockBankKeeper)(nil).AllBalances), arg0, arg1)
 }
 
+// AppendLockedCoinsGetter mocks base method.
+func (m *MockBankKeeper) AppendLockedCoinsGetter(getter types0.GetLockedCoinsFn) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AppendLockedCoinsGetter", getter)
+}
+
+// AppendLockedCoinsGetter indicates an expected call of AppendLockedCoinsGetter.
+func (mr *MockBankKeeperMockRecorder) AppendLockedCoinsGetter(getter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendLockedCoinsGetter", reflect.TypeOf((*MockBankKeeper)(nil).AppendLockedCoinsGetter), getter)
+}
+
+// AppendSendRestriction mocks base method.
+func (m *MockBankKeeper) AppendSendRestriction(restriction types0.SendRestrictionFn) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AppendSendRestriction", restriction)
+}
+
+// AppendSendRestriction indicates an expected call of AppendSendRestriction.
+func (mr *MockBankKeeperMockRecorder) AppendSendRestriction(restriction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendSendRestriction", reflect.TypeOf((*MockBankKeeper)(nil).AppendSendRestriction), restriction)
+}
+
 // Balance mocks base method.
 func (m *MockBankKeeper) Balance(arg0 context.Context, arg1 *types0.QueryBalanceRequest) (*types0.QueryBalanceResponse, error) {
 	m.ctrl.T.Helper()
@@ -217,6 +256,45 @@ func (mr *MockBankKeeperMockRecorder) BurnCoins(ctx, moduleName, amt interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BurnCoins", reflect.TypeOf((*MockBankKeeper)(nil).BurnCoins), ctx, moduleName, amt)
 }
 
+// ClearLockedCoinsGetter mocks base method.
+func (m *MockBankKeeper) ClearLockedCoinsGetter() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ClearLockedCoinsGetter")
+}
+
+// ClearLockedCoinsGetter indicates an expected call of ClearLockedCoinsGetter.
+func (mr *MockBankKeeperMockRecorder) ClearLockedCoinsGetter() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearLockedCoinsGetter", reflect.TypeOf((*MockBankKeeper)(nil).ClearLockedCoinsGetter))
+}
+
+// ClearSendRestriction mocks base method.
+func (m *MockBankKeeper) ClearSendRestriction() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ClearSendRestriction")
+}
+
+// ClearSendRestriction indicates an expected call of ClearSendRestriction.
+func (mr *MockBankKeeperMockRecorder) ClearSendRestriction() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearSendRestriction", reflect.TypeOf((*MockBankKeeper)(nil).ClearSendRestriction))
+}
+
+// CreateEscrow mocks base method.
+func (m *MockBankKeeper) CreateEscrow(ctx types.Context, creator, counterparty types.AccAddress, amt, expected types.Coins, timeout time.Time) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEscrow", ctx, creator, counterparty, amt, expected, timeout)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEscrow indicates an expected call of CreateEscrow.
+func (mr *MockBankKeeperMockRecorder) CreateEscrow(ctx, creator, counterparty, amt, expected, timeout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEscrow", reflect.TypeOf((*MockBankKeeper)(nil).CreateEscrow), ctx, creator, counterparty, amt, expected, timeout)
+}
+
 // DelegateCoins mocks base method.
 func (m *MockBankKeeper) DelegateCoins(ctx types.Context, delegatorAddr, moduleAccAddr types.AccAddress, amt types.Coins) error {
 	m.ctrl.T.Helper()
@@ -307,6 +385,36 @@ func (mr *MockBankKeeperMockRecorder) DenomsMetadata(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomsMetadata", reflect.TypeOf((*MockBankKeeper)(nil).DenomsMetadata), arg0, arg1)
 }
 
+// Escrow mocks base method.
+func (m *MockBankKeeper) Escrow(arg0 context.Context, arg1 *types0.QueryEscrowRequest) (*types0.QueryEscrowResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Escrow", arg0, arg1)
+	ret0, _ := ret[0].(*types0.QueryEscrowResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Escrow indicates an expected call of Escrow.
+func (mr *MockBankKeeperMockRecorder) Escrow(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Escrow", reflect.TypeOf((*MockBankKeeper)(nil).Escrow), arg0, arg1)
+}
+
+// Escrows mocks base method.
+func (m *MockBankKeeper) Escrows(arg0 context.Context, arg1 *types0.QueryEscrowsRequest) (*types0.QueryEscrowsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Escrows", arg0, arg1)
+	ret0, _ := ret[0].(*types0.QueryEscrowsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Escrows indicates an expected call of Escrows.
+func (mr *MockBankKeeperMockRecorder) Escrows(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Escrows", reflect.TypeOf((*MockBankKeeper)(nil).Escrows), arg0, arg1)
+}
+
 // ExportGenesis mocks base method.
 func (m *MockBankKeeper) ExportGenesis(arg0 types.Context) *types0.GenesisState {
 	m.ctrl.T.Helper()
@@ -434,6 +542,21 @@ func (mr *MockBankKeeperMockRecorder) GetDenomMetaData(ctx, denom interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).GetDenomMetaData), ctx, denom)
 }
 
+// GetEscrow mocks base method.
+func (m *MockBankKeeper) GetEscrow(ctx types.Context, id uint64) (types0.Escrow, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEscrow", ctx, id)
+	ret0, _ := ret[0].(types0.Escrow)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetEscrow indicates an expected call of GetEscrow.
+func (mr *MockBankKeeperMockRecorder) GetEscrow(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEscrow", reflect.TypeOf((*MockBankKeeper)(nil).GetEscrow), ctx, id)
+}
+
 // GetPaginatedTotalSupply mocks base method.
 func (m *MockBankKeeper) GetPaginatedTotalSupply(ctx types.Context, pagination *query.PageRequest) (types.Coins, *query.PageResponse, error) {
 	m.ctrl.T.Helper()
@@ -464,6 +587,20 @@ func (mr *MockBankKeeperMockRecorder) GetParams(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParams", reflect.TypeOf((*MockBankKeeper)(nil).GetParams), ctx)
 }
 
+// GetPausedDenoms mocks base method.
+func (m *MockBankKeeper) GetPausedDenoms(ctx types.Context) []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPausedDenoms", ctx)
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// GetPausedDenoms indicates an expected call of GetPausedDenoms.
+func (mr *MockBankKeeperMockRecorder) GetPausedDenoms(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPausedDenoms", reflect.TypeOf((*MockBankKeeper)(nil).GetPausedDenoms), ctx)
+}
+
 // GetSendEnabledEntry mocks base method.
 func (m *MockBankKeeper) GetSendEnabledEntry(ctx types.Context, denom string) (types0.SendEnabled, bool) {
 	m.ctrl.T.Helper()
@@ -535,6 +672,20 @@ func (mr *MockBankKeeperMockRecorder) HasSupply(ctx, denom interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSupply", reflect.TypeOf((*MockBankKeeper)(nil).HasSupply), ctx, denom)
 }
 
+// Hooks mocks base method.
+func (m *MockBankKeeper) Hooks() types0.BankHooks {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hooks")
+	ret0, _ := ret[0].(types0.BankHooks)
+	return ret0
+}
+
+// Hooks indicates an expected call of Hooks.
+func (mr *MockBankKeeperMockRecorder) Hooks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hooks", reflect.TypeOf((*MockBankKeeper)(nil).Hooks))
+}
+
 // InitGenesis mocks base method.
 func (m *MockBankKeeper) InitGenesis(arg0 types.Context, arg1 *types0.GenesisState) {
 	m.ctrl.T.Helper()
@@ -561,6 +712,20 @@ func (mr *MockBankKeeperMockRecorder) InputOutputCoins(ctx, inputs, outputs inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InputOutputCoins", reflect.TypeOf((*MockBankKeeper)(nil).InputOutputCoins), ctx, inputs, outputs)
 }
 
+// IsDenomPaused mocks base method.
+func (m *MockBankKeeper) IsDenomPaused(ctx types.Context, denom string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsDenomPaused", ctx, denom)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsDenomPaused indicates an expected call of IsDenomPaused.
+func (mr *MockBankKeeperMockRecorder) IsDenomPaused(ctx, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDenomPaused", reflect.TypeOf((*MockBankKeeper)(nil).IsDenomPaused), ctx, denom)
+}
+
 // IsSendEnabledCoin mocks base method.
 func (m *MockBankKeeper) IsSendEnabledCoin(ctx types.Context, coin types.Coin) bool {
 	m.ctrl.T.Helper()
@@ -696,6 +861,21 @@ func (mr *MockBankKeeperMockRecorder) MintCoins(ctx, moduleName, amt interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MintCoins", reflect.TypeOf((*MockBankKeeper)(nil).MintCoins), ctx, moduleName, amt)
 }
 
+// ModuleAccountBalances mocks base method.
+func (m *MockBankKeeper) ModuleAccountBalances(arg0 context.Context, arg1 *types0.QueryModuleAccountBalancesRequest) (*types0.QueryModuleAccountBalancesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModuleAccountBalances", arg0, arg1)
+	ret0, _ := ret[0].(*types0.QueryModuleAccountBalancesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModuleAccountBalances indicates an expected call of ModuleAccountBalances.
+func (mr *MockBankKeeperMockRecorder) ModuleAccountBalances(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModuleAccountBalances", reflect.TypeOf((*MockBankKeeper)(nil).ModuleAccountBalances), arg0, arg1)
+}
+
 // Params mocks base method.
 func (m *MockBankKeeper) Params(arg0 context.Context, arg1 *types0.QueryParamsRequest) (*types0.QueryParamsResponse, error) {
 	m.ctrl.T.Helper()
@@ -711,6 +891,47 @@ func (mr *MockBankKeeperMockRecorder) Params(arg0, arg1 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Params", reflect.TypeOf((*MockBankKeeper)(nil).Params), arg0, arg1)
 }
 
+// PausedDenoms mocks base method.
+func (m *MockBankKeeper) PausedDenoms(arg0 context.Context, arg1 *types0.QueryPausedDenomsRequest) (*types0.QueryPausedDenomsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PausedDenoms", arg0, arg1)
+	ret0, _ := ret[0].(*types0.QueryPausedDenomsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PausedDenoms indicates an expected call of PausedDenoms.
+func (mr *MockBankKeeperMockRecorder) PausedDenoms(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PausedDenoms", reflect.TypeOf((*MockBankKeeper)(nil).PausedDenoms), arg0, arg1)
+}
+
+// PrependSendRestriction mocks base method.
+func (m *MockBankKeeper) PrependSendRestriction(restriction types0.SendRestrictionFn) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PrependSendRestriction", restriction)
+}
+
+// PrependSendRestriction indicates an expected call of PrependSendRestriction.
+func (mr *MockBankKeeperMockRecorder) PrependSendRestriction(restriction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrependSendRestriction", reflect.TypeOf((*MockBankKeeper)(nil).PrependSendRestriction), restriction)
+}
+
+// RefundEscrow mocks base method.
+func (m *MockBankKeeper) RefundEscrow(ctx types.Context, creator types.AccAddress, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundEscrow", ctx, creator, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefundEscrow indicates an expected call of RefundEscrow.
+func (mr *MockBankKeeperMockRecorder) RefundEscrow(ctx, creator, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundEscrow", reflect.TypeOf((*MockBankKeeper)(nil).RefundEscrow), ctx, creator, id)
+}
+
 // SendCoins mocks base method.
 func (m *MockBankKeeper) SendCoins(ctx types.Context, fromAddr, toAddr types.AccAddress, amt types.Coins) error {
 	m.ctrl.T.Helper()
@@ -806,6 +1027,30 @@ func (mr *MockBankKeeperMockRecorder) SetDenomMetaData(ctx, denomMetaData interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomMetaData), ctx, denomMetaData)
 }
 
+// SetDenomPaused mocks base method.
+func (m *MockBankKeeper) SetDenomPaused(ctx types.Context, denom string, paused bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetDenomPaused", ctx, denom, paused)
+}
+
+// SetDenomPaused indicates an expected call of SetDenomPaused.
+func (mr *MockBankKeeperMockRecorder) SetDenomPaused(ctx, denom, paused interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomPaused", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomPaused), ctx, denom, paused)
+}
+
+// SetHooks mocks base method.
+func (m *MockBankKeeper) SetHooks(bh types0.BankHooks) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetHooks", bh)
+}
+
+// SetHooks indicates an expected call of SetHooks.
+func (mr *MockBankKeeperMockRecorder) SetHooks(bh interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHooks", reflect.TypeOf((*MockBankKeeper)(nil).SetHooks), bh)
+}
+
 // SetParams mocks base method.
 func (m *MockBankKeeper) SetParams(ctx types.Context, params types0.Params) error {
 	m.ctrl.T.Helper()
@@ -820,6 +1065,22 @@ func (mr *MockBankKeeperMockRecorder) SetParams(ctx, params interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetParams", reflect.TypeOf((*MockBankKeeper)(nil).SetParams), ctx, params)
 }
 
+// SetPauseExemptModuleAccounts mocks base method.
+func (m *MockBankKeeper) SetPauseExemptModuleAccounts(moduleNames ...string) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range moduleNames {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "SetPauseExemptModuleAccounts", varargs...)
+}
+
+// SetPauseExemptModuleAccounts indicates an expected call of SetPauseExemptModuleAccounts.
+func (mr *MockBankKeeperMockRecorder) SetPauseExemptModuleAccounts(moduleNames ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPauseExemptModuleAccounts", reflect.TypeOf((*MockBankKeeper)(nil).SetPauseExemptModuleAccounts), moduleNames...)
+}
+
 // SetSendEnabled mocks base method.
 func (m *MockBankKeeper) SetSendEnabled(ctx types.Context, denom string, value bool) {
 	m.ctrl.T.Helper()
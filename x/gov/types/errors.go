@@ -27,4 +27,6 @@ var (
 	ErrNoDeposits              = errors.Register(ModuleName, 19, "no deposits found")
 	ErrVotingPeriodEnded       = errors.Register(ModuleName, 20, "voting period already ended")
 	ErrInvalidProposal         = errors.Register(ModuleName, 21, "invalid proposal")
+	ErrCancelPeriodEnded       = errors.Register(ModuleName, 22, "cancel period already ended")
+	ErrInvalidParamChange      = errors.Register(ModuleName, 23, "invalid scheduled param change")
 )
@@ -8,6 +8,7 @@ const (
 	EventTypeInactiveProposal = "inactive_proposal"
 	EventTypeActiveProposal   = "active_proposal"
 	EventTypeCancelProposal   = "cancel_proposal"
+	EventTypeParamChange      = "scheduled_param_change"
 
 	AttributeKeyProposalResult              = "proposal_result"
 	AttributeKeyOption                      = "option"
@@ -21,6 +22,13 @@ const (
 	AttributeValueExpeditedProposalRejected = "expedited_proposal_rejected" // didn't meet expedited vote quorum
 	AttributeValueProposalFailed            = "proposal_failed"             // error on proposal handler
 	AttributeValueProposalCanceled          = "proposal_canceled"           // error on proposal handler
+	AttributeValueProposalTallied           = "proposal_tallied"            // multiple choice proposal met vote quorum
+
+	AttributeKeyParamChangeID       = "param_change_id"
+	AttributeKeyParamChangeResult   = "param_change_result"
+	AttributeKeyParamChangeLog      = "param_change_log"
+	AttributeValueParamChangeExec   = "param_change_executed"
+	AttributeValueParamChangeFailed = "param_change_failed"
 
 	AttributeKeyProposalType   = "proposal_type"
 	AttributeSignalTitle       = "signal_title"
@@ -71,6 +71,8 @@ type GovHooks interface {
 	AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress)        // Must be called after a vote on a proposal is cast
 	AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID uint64)                      // Must be called when proposal fails to reach min deposit
 	AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64)                     // Must be called when proposal's finishes it's voting period
+	AfterProposalPassed(ctx sdk.Context, proposalID uint64)                                // Must be called when a proposal passes and its messages are executed
+	AfterProposalFailed(ctx sdk.Context, proposalID uint64)                                // Must be called when a proposal is rejected or fails on execution
 }
 
 type GovHooksWrapper struct{ GovHooks }
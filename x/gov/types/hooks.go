@@ -42,3 +42,15 @@ func (h MultiGovHooks) AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalI
 		h[i].AfterProposalVotingPeriodEnded(ctx, proposalID)
 	}
 }
+
+func (h MultiGovHooks) AfterProposalPassed(ctx sdk.Context, proposalID uint64) {
+	for i := range h {
+		h[i].AfterProposalPassed(ctx, proposalID)
+	}
+}
+
+func (h MultiGovHooks) AfterProposalFailed(ctx sdk.Context, proposalID uint64) {
+	for i := range h {
+		h[i].AfterProposalFailed(ctx, proposalID)
+	}
+}
@@ -38,6 +38,16 @@ const (
 // - 0x20<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: Voter
 //
 // - 0x30: Params
+//
+// - 0x40<proposalID_Bytes>: TallySnapshot
+//
+// - 0x50<paramChangeID_Bytes>: ScheduledParamChange
+//
+// - 0x51: nextParamChangeID
+//
+// - 0x52<height_Bytes><paramChangeID_Bytes>: paramChangeID of a ScheduledParamChange executed at a height
+//
+// - 0x53<time_Bytes><paramChangeID_Bytes>: paramChangeID of a ScheduledParamChange executed at a time
 var (
 	ProposalsKeyPrefix            = []byte{0x00}
 	ActiveProposalQueuePrefix     = []byte{0x01}
@@ -52,6 +62,13 @@ var (
 	// ParamsKey is the key to query all gov params
 	ParamsKey = []byte{0x30}
 
+	TallySnapshotKeyPrefix = []byte{0x40}
+
+	ScheduledParamChangesKeyPrefix = []byte{0x50}
+	ParamChangeIDKey               = []byte{0x51}
+	ParamChangeHeightQueuePrefix   = []byte{0x52}
+	ParamChangeTimeQueuePrefix     = []byte{0x53}
+
 	// KeyConstitution is the key string used to store the chain's constitution
 	KeyConstitution = []byte("constitution")
 )
@@ -120,6 +137,36 @@ func VoteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
 	return append(VotesKey(proposalID), address.MustLengthPrefix(voterAddr.Bytes())...)
 }
 
+// TallySnapshotKey gets the key of the tally snapshot of a proposal
+func TallySnapshotKey(proposalID uint64) []byte {
+	return append(TallySnapshotKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// ScheduledParamChangeKey gets the key of a scheduled param change
+func ScheduledParamChangeKey(paramChangeID uint64) []byte {
+	return append(ScheduledParamChangesKeyPrefix, GetProposalIDBytes(paramChangeID)...)
+}
+
+// ParamChangeByHeightKey gets the param change height queue key by height
+func ParamChangeByHeightKey(height int64) []byte {
+	return append(ParamChangeHeightQueuePrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// ParamChangeHeightQueueKey returns the key for a paramChangeID in the param change height queue
+func ParamChangeHeightQueueKey(paramChangeID uint64, height int64) []byte {
+	return append(ParamChangeByHeightKey(height), GetProposalIDBytes(paramChangeID)...)
+}
+
+// ParamChangeByTimeKey gets the param change time queue key by time
+func ParamChangeByTimeKey(t time.Time) []byte {
+	return append(ParamChangeTimeQueuePrefix, sdk.FormatTimeBytes(t)...)
+}
+
+// ParamChangeTimeQueueKey returns the key for a paramChangeID in the param change time queue
+func ParamChangeTimeQueueKey(paramChangeID uint64, t time.Time) []byte {
+	return append(ParamChangeByTimeKey(t), GetProposalIDBytes(paramChangeID)...)
+}
+
 // Split keys function; used for iterators
 
 // SplitProposalKey split the proposal key and returns the proposal id
@@ -20,6 +20,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgVoteWeighted{}, "cosmos-sdk/v1/MsgVoteWeighted")
 	legacy.RegisterAminoMsg(cdc, &MsgExecLegacyContent{}, "cosmos-sdk/v1/MsgExecLegacyContent")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/gov/v1/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgSubmitMultipleChoiceProposal{}, "cosmos-sdk/v1/MsgSubmitMultipleChoice")
+	legacy.RegisterAminoMsg(cdc, &MsgScheduleParamChange{}, "cosmos-sdk/v1/MsgScheduleParamChange")
 }
 
 // RegisterInterfaces registers the interfaces types with the Interface Registry.
@@ -31,6 +33,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgDeposit{},
 		&MsgExecLegacyContent{},
 		&MsgUpdateParams{},
+		&MsgSubmitMultipleChoiceProposal{},
+		&MsgScheduleParamChange{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
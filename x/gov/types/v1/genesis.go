@@ -2,6 +2,7 @@ package v1
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
 )
@@ -33,6 +34,18 @@ func ValidateGenesis(data *GenesisState) error {
 		return errors.New("starting proposal id must be greater than 0")
 	}
 
+	ids := make(map[uint64]bool, len(data.ScheduledParamChanges))
+	for _, change := range data.ScheduledParamChanges {
+		if ids[change.Id] {
+			return fmt.Errorf("duplicate scheduled param change id: %d", change.Id)
+		}
+		ids[change.Id] = true
+
+		if err := change.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid scheduled param change %d: %w", change.Id, err)
+		}
+	}
+
 	return data.Params.ValidateBasic()
 }
 
@@ -46,5 +59,5 @@ func (data GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 			return err
 		}
 	}
-	return nil
+	return ScheduledParamChanges(data.ScheduledParamChanges).UnpackInterfaces(unpacker)
 }
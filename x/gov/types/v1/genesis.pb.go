@@ -52,6 +52,14 @@ type GenesisState struct {
 	//
 	// Since: cosmos-sdk 0.48
 	Constitution string `protobuf:"bytes,9,opt,name=constitution,proto3" json:"constitution,omitempty"`
+	// tally_snapshots defines all the tally snapshots of finished proposals present at genesis.
+	//
+	// Since: cosmos-sdk 0.50
+	TallySnapshots []*TallySnapshot `protobuf:"bytes,10,rep,name=tally_snapshots,json=tallySnapshots,proto3" json:"tally_snapshots,omitempty"`
+	// scheduled_param_changes defines all the pending scheduled param changes present at genesis.
+	//
+	// Since: cosmos-sdk 0.50
+	ScheduledParamChanges []*ScheduledParamChange `protobuf:"bytes,11,rep,name=scheduled_param_changes,json=scheduledParamChanges,proto3" json:"scheduled_param_changes,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -153,6 +161,20 @@ func (m *GenesisState) GetConstitution() string {
 	return ""
 }
 
+func (m *GenesisState) GetTallySnapshots() []*TallySnapshot {
+	if m != nil {
+		return m.TallySnapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledParamChanges() []*ScheduledParamChange {
+	if m != nil {
+		return m.ScheduledParamChanges
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.v1.GenesisState")
 }
@@ -160,31 +182,35 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/genesis.proto", fileDescriptor_ef7cfd15e3ded621) }
 
 var fileDescriptor_ef7cfd15e3ded621 = []byte{
-	// 379 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcd, 0x4e, 0xfa, 0x40,
-	0x14, 0xc5, 0x19, 0xbe, 0xfe, 0x30, 0x94, 0xff, 0x62, 0xfc, 0xa0, 0x01, 0xd3, 0x34, 0xac, 0x6a,
-	0x0c, 0xad, 0x60, 0x7c, 0x00, 0x09, 0x86, 0xb8, 0x23, 0xd5, 0xb8, 0x70, 0x43, 0x0a, 0x6d, 0x6a,
-	0x23, 0xf4, 0x36, 0x9d, 0x61, 0x22, 0x6f, 0xe1, 0x63, 0xb9, 0x64, 0xe9, 0xd2, 0xc0, 0x63, 0xb8,
-	0x31, 0xcc, 0xb4, 0x02, 0xd5, 0xd5, 0x24, 0xf7, 0xfc, 0xce, 0x99, 0x93, 0x9b, 0x8b, 0x5b, 0x53,
-	0xa0, 0x73, 0xa0, 0x96, 0x0f, 0xdc, 0xe2, 0x5d, 0xcb, 0xf7, 0x42, 0x8f, 0x06, 0xd4, 0x8c, 0x62,
-	0x60, 0x40, 0xea, 0x52, 0x34, 0x7d, 0xe0, 0x26, 0xef, 0x36, 0x1b, 0x19, 0x16, 0xb8, 0xe4, 0xda,
-	0x5f, 0x05, 0xac, 0x0c, 0xa5, 0xf3, 0x9e, 0x39, 0xcc, 0x23, 0x97, 0xf8, 0x98, 0x32, 0x27, 0x66,
-	0x41, 0xe8, 0x8f, 0xa3, 0x18, 0x22, 0xa0, 0xce, 0x6c, 0x1c, 0xb8, 0x2a, 0xd2, 0x91, 0x51, 0xb4,
-	0x49, 0xaa, 0x8d, 0x12, 0xe9, 0xce, 0x25, 0x3d, 0x5c, 0x71, 0xbd, 0x08, 0x68, 0xc0, 0xa8, 0x9a,
-	0xd7, 0x0b, 0x46, 0xad, 0x77, 0x6a, 0x1e, 0xfc, 0x6e, 0x0e, 0xa4, 0x6c, 0xff, 0x70, 0xe4, 0x1c,
-	0x97, 0x38, 0x30, 0x8f, 0xaa, 0x05, 0x61, 0x38, 0xca, 0x18, 0x1e, 0x81, 0x79, 0xb6, 0x24, 0xc8,
-	0x35, 0xae, 0xa6, 0x3d, 0xa8, 0x5a, 0x14, 0x78, 0x23, 0x83, 0xa7, 0x65, 0xec, 0x1d, 0x49, 0x86,
-	0xf8, 0x7f, 0xf2, 0xdb, 0x38, 0x72, 0x62, 0x67, 0x4e, 0xd5, 0x92, 0x8e, 0x8c, 0x5a, 0xef, 0xec,
-	0xef, 0x6e, 0x23, 0xc1, 0xf4, 0xf3, 0x2a, 0xb2, 0xeb, 0xee, 0xfe, 0x88, 0x0c, 0x70, 0x9d, 0x83,
-	0x5c, 0x87, 0xcc, 0x29, 0x8b, 0x9c, 0xd6, 0xef, 0xca, 0xdb, 0xb5, 0xec, 0x62, 0x14, 0xbe, 0x37,
-	0x21, 0x37, 0x58, 0x61, 0xce, 0x6c, 0xb6, 0x4c, 0x43, 0xfe, 0x89, 0x90, 0x66, 0x26, 0xe4, 0x61,
-	0x8b, 0xec, 0x65, 0xd4, 0xd8, 0x6e, 0x40, 0x3a, 0xb8, 0x9c, 0x98, 0x2b, 0xc2, 0x7c, 0x92, 0xdd,
-	0x82, 0x10, 0xed, 0x04, 0x22, 0x6d, 0xac, 0x4c, 0x21, 0xa4, 0x2c, 0x60, 0x0b, 0x16, 0x40, 0xa8,
-	0x56, 0x75, 0x64, 0x54, 0xed, 0x83, 0x59, 0xff, 0xf6, 0x7d, 0xad, 0xa1, 0xd5, 0x5a, 0x43, 0x9f,
-	0x6b, 0x0d, 0xbd, 0x6d, 0xb4, 0xdc, 0x6a, 0xa3, 0xe5, 0x3e, 0x36, 0x5a, 0xee, 0xe9, 0xc2, 0x0f,
-	0xd8, 0xf3, 0x62, 0x62, 0x4e, 0x61, 0x6e, 0x25, 0xb7, 0x23, 0x9f, 0x0e, 0x75, 0x5f, 0xac, 0x57,
-	0x71, 0x48, 0x6c, 0x19, 0x79, 0xd4, 0xe2, 0xdd, 0x49, 0x59, 0xdc, 0xd2, 0xd5, 0x77, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x1b, 0x69, 0x07, 0x35, 0x92, 0x02, 0x00, 0x00,
+	// 443 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xe3, 0xa6, 0x09, 0xcd, 0x26, 0x29, 0xd2, 0x42, 0xc9, 0xaa, 0x45, 0x96, 0x55, 0x2e,
+	0x41, 0xa8, 0x36, 0x09, 0xe2, 0x01, 0x28, 0xad, 0x2a, 0x6e, 0xd5, 0x06, 0x71, 0x80, 0x43, 0xe4,
+	0xda, 0x2b, 0xc7, 0x22, 0xf1, 0x58, 0x99, 0xc9, 0x8a, 0xbe, 0x05, 0x6f, 0xc0, 0xeb, 0x70, 0xec,
+	0x91, 0x23, 0x4a, 0x5e, 0x04, 0x75, 0xd7, 0x26, 0xae, 0x9b, 0x53, 0x94, 0xf9, 0xbf, 0xff, 0xdf,
+	0xdf, 0xa3, 0x61, 0x27, 0x11, 0xe0, 0x02, 0x30, 0x48, 0x40, 0x07, 0x7a, 0x14, 0x24, 0x2a, 0x53,
+	0x98, 0xa2, 0x9f, 0x2f, 0x81, 0x80, 0xf7, 0xad, 0xe8, 0x27, 0xa0, 0x7d, 0x3d, 0x3a, 0x1e, 0xd4,
+	0x58, 0xd0, 0x96, 0x3b, 0xfd, 0xd5, 0x62, 0xbd, 0x2b, 0xeb, 0x9c, 0x50, 0x48, 0x8a, 0xbf, 0x65,
+	0xcf, 0x91, 0xc2, 0x25, 0xa5, 0x59, 0x32, 0xcd, 0x97, 0x90, 0x03, 0x86, 0xf3, 0x69, 0x1a, 0x0b,
+	0xc7, 0x73, 0x86, 0xfb, 0x92, 0x97, 0xda, 0x75, 0x21, 0x7d, 0x8a, 0xf9, 0x98, 0x1d, 0xc4, 0x2a,
+	0x07, 0x4c, 0x09, 0xc5, 0x9e, 0xd7, 0x1c, 0x76, 0xc7, 0x2f, 0xfc, 0x07, 0xaf, 0xfb, 0x17, 0x56,
+	0x96, 0xff, 0x39, 0xfe, 0x9a, 0xb5, 0x34, 0x90, 0x42, 0xd1, 0x34, 0x86, 0x67, 0x35, 0xc3, 0x17,
+	0x20, 0x25, 0x2d, 0xc1, 0xdf, 0xb3, 0x4e, 0xd9, 0x03, 0xc5, 0xbe, 0xc1, 0x07, 0x35, 0xbc, 0x2c,
+	0x23, 0xb7, 0x24, 0xbf, 0x62, 0x87, 0xc5, 0x6b, 0xd3, 0x3c, 0x5c, 0x86, 0x0b, 0x14, 0x2d, 0xcf,
+	0x19, 0x76, 0xc7, 0x2f, 0x77, 0x77, 0xbb, 0x36, 0xcc, 0xf9, 0x9e, 0x70, 0x64, 0x3f, 0xae, 0x8e,
+	0xf8, 0x05, 0xeb, 0x6b, 0xb0, 0xeb, 0xb0, 0x39, 0x6d, 0x93, 0x73, 0xf2, 0xb8, 0xf2, 0xfd, 0x5a,
+	0xb6, 0x31, 0x3d, 0x5d, 0x99, 0xf0, 0x0f, 0xac, 0x47, 0xe1, 0x7c, 0x7e, 0x5b, 0x86, 0x3c, 0x31,
+	0x21, 0xc7, 0xb5, 0x90, 0xcf, 0xf7, 0x48, 0x25, 0xa3, 0x4b, 0xdb, 0x01, 0x3f, 0x63, 0xed, 0xc2,
+	0x7c, 0x60, 0xcc, 0x47, 0xf5, 0x2d, 0x18, 0x51, 0x16, 0x10, 0x3f, 0x65, 0xbd, 0x08, 0x32, 0xa4,
+	0x94, 0x56, 0x94, 0x42, 0x26, 0x3a, 0x9e, 0x33, 0xec, 0xc8, 0x07, 0x33, 0x7e, 0xc9, 0x9e, 0xda,
+	0x56, 0x98, 0x85, 0x39, 0xce, 0x80, 0x50, 0x30, 0xaf, 0xb9, 0x63, 0x4b, 0xa6, 0xd8, 0xa4, 0x80,
+	0xe4, 0x21, 0x55, 0xff, 0x22, 0xff, 0xc6, 0x06, 0x18, 0xcd, 0x54, 0xbc, 0x9a, 0xab, 0xd8, 0x7e,
+	0xe0, 0x34, 0x9a, 0x85, 0x59, 0xa2, 0x50, 0x74, 0x4d, 0xdc, 0xab, 0x5a, 0xdc, 0xa4, 0xa4, 0x4d,
+	0xe7, 0x8f, 0x86, 0x95, 0x47, 0xb8, 0x63, 0x8a, 0xe7, 0x97, 0xbf, 0xd7, 0xae, 0x73, 0xb7, 0x76,
+	0x9d, 0xbf, 0x6b, 0xd7, 0xf9, 0xb9, 0x71, 0x1b, 0x77, 0x1b, 0xb7, 0xf1, 0x67, 0xe3, 0x36, 0xbe,
+	0xbe, 0x49, 0x52, 0x9a, 0xad, 0x6e, 0xfc, 0x08, 0x16, 0x41, 0x71, 0xdf, 0xf6, 0xe7, 0x0c, 0xe3,
+	0xef, 0xc1, 0x0f, 0x73, 0xec, 0x74, 0x9b, 0x2b, 0x0c, 0xf4, 0xe8, 0xa6, 0x6d, 0xee, 0xfd, 0xdd,
+	0xbf, 0x01, 0x00, 0x14, 0xd7, 0x24, 0x6a, 0x36, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -207,6 +233,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScheduledParamChanges) > 0 {
+		for iNdEx := len(m.ScheduledParamChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledParamChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.TallySnapshots) > 0 {
+		for iNdEx := len(m.TallySnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TallySnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Constitution) > 0 {
 		i -= len(m.Constitution)
 		copy(dAtA[i:], m.Constitution)
@@ -370,6 +424,18 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.TallySnapshots) > 0 {
+		for _, e := range m.TallySnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScheduledParamChanges) > 0 {
+		for _, e := range m.ScheduledParamChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -705,6 +771,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.Constitution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallySnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TallySnapshots = append(m.TallySnapshots, &TallySnapshot{})
+			if err := m.TallySnapshots[len(m.TallySnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledParamChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledParamChanges = append(m.ScheduledParamChanges, &ScheduledParamChange{})
+			if err := m.ScheduledParamChanges[len(m.ScheduledParamChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -32,6 +32,45 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ProposalType enumerates the valid proposal types.
+// All proposal types are v1.Proposal which have different voting periods or tallying logic.
+//
+// Since: cosmos-sdk 0.50
+type ProposalType int32
+
+const (
+	// PROPOSAL_TYPE_UNSPECIFIED defines no proposal type, which fallback to PROPOSAL_TYPE_STANDARD.
+	ProposalType_PROPOSAL_TYPE_UNSPECIFIED ProposalType = 0
+	// PROPOSAL_TYPE_STANDARD defines the type for a standard proposal.
+	ProposalType_PROPOSAL_TYPE_STANDARD ProposalType = 1
+	// PROPOSAL_TYPE_MULTIPLE_CHOICE defines the type for a multiple choice proposal.
+	ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE ProposalType = 2
+	// PROPOSAL_TYPE_OPTIMISTIC defines the type for an optimistic proposal.
+	ProposalType_PROPOSAL_TYPE_OPTIMISTIC ProposalType = 3
+)
+
+var ProposalType_name = map[int32]string{
+	0: "PROPOSAL_TYPE_UNSPECIFIED",
+	1: "PROPOSAL_TYPE_STANDARD",
+	2: "PROPOSAL_TYPE_MULTIPLE_CHOICE",
+	3: "PROPOSAL_TYPE_OPTIMISTIC",
+}
+
+var ProposalType_value = map[string]int32{
+	"PROPOSAL_TYPE_UNSPECIFIED":     0,
+	"PROPOSAL_TYPE_STANDARD":        1,
+	"PROPOSAL_TYPE_MULTIPLE_CHOICE": 2,
+	"PROPOSAL_TYPE_OPTIMISTIC":      3,
+}
+
+func (x ProposalType) String() string {
+	return proto.EnumName(ProposalType_name, int32(x))
+}
+
+func (ProposalType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{0}
+}
+
 // VoteOption enumerates the valid vote options for a given governance proposal.
 type VoteOption int32
 
@@ -46,6 +85,25 @@ const (
 	VoteOption_VOTE_OPTION_NO VoteOption = 3
 	// VOTE_OPTION_NO_WITH_VETO defines a no with veto vote option.
 	VoteOption_VOTE_OPTION_NO_WITH_VETO VoteOption = 4
+	// VOTE_OPTION_ONE defines the first option of a multiple choice proposal.
+	// The vote option of a vote on a multiple choice proposal is the 1-based
+	// index of one of its options, VOTE_OPTION_ONE to VOTE_OPTION_FOUR name the
+	// first four.
+	//
+	// Since: cosmos-sdk 0.50
+	VoteOption_VOTE_OPTION_ONE VoteOption = 1
+	// VOTE_OPTION_TWO defines the second option of a multiple choice proposal.
+	//
+	// Since: cosmos-sdk 0.50
+	VoteOption_VOTE_OPTION_TWO VoteOption = 2
+	// VOTE_OPTION_THREE defines the third option of a multiple choice proposal.
+	//
+	// Since: cosmos-sdk 0.50
+	VoteOption_VOTE_OPTION_THREE VoteOption = 3
+	// VOTE_OPTION_FOUR defines the fourth option of a multiple choice proposal.
+	//
+	// Since: cosmos-sdk 0.50
+	VoteOption_VOTE_OPTION_FOUR VoteOption = 4
 )
 
 var VoteOption_name = map[int32]string{
@@ -54,6 +112,10 @@ var VoteOption_name = map[int32]string{
 	2: "VOTE_OPTION_ABSTAIN",
 	3: "VOTE_OPTION_NO",
 	4: "VOTE_OPTION_NO_WITH_VETO",
+	// Duplicate value: 1: "VOTE_OPTION_ONE",
+	// Duplicate value: 2: "VOTE_OPTION_TWO",
+	// Duplicate value: 3: "VOTE_OPTION_THREE",
+	// Duplicate value: 4: "VOTE_OPTION_FOUR",
 }
 
 var VoteOption_value = map[string]int32{
@@ -62,6 +124,10 @@ var VoteOption_value = map[string]int32{
 	"VOTE_OPTION_ABSTAIN":      2,
 	"VOTE_OPTION_NO":           3,
 	"VOTE_OPTION_NO_WITH_VETO": 4,
+	"VOTE_OPTION_ONE":          1,
+	"VOTE_OPTION_TWO":          2,
+	"VOTE_OPTION_THREE":        3,
+	"VOTE_OPTION_FOUR":         4,
 }
 
 func (x VoteOption) String() string {
@@ -69,7 +135,7 @@ func (x VoteOption) String() string {
 }
 
 func (VoteOption) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{0}
+	return fileDescriptor_e05cb1c0d030febb, []int{1}
 }
 
 // ProposalStatus enumerates the valid statuses of a proposal.
@@ -93,6 +159,11 @@ const (
 	// PROPOSAL_STATUS_FAILED defines a proposal status of a proposal that has
 	// failed.
 	ProposalStatus_PROPOSAL_STATUS_FAILED ProposalStatus = 5
+	// PROPOSAL_STATUS_TALLIED defines a proposal status of a multiple choice
+	// proposal that has reached quorum. Its outcome is the final tally result.
+	//
+	// Since: cosmos-sdk 0.50
+	ProposalStatus_PROPOSAL_STATUS_TALLIED ProposalStatus = 6
 )
 
 var ProposalStatus_name = map[int32]string{
@@ -102,6 +173,7 @@ var ProposalStatus_name = map[int32]string{
 	3: "PROPOSAL_STATUS_PASSED",
 	4: "PROPOSAL_STATUS_REJECTED",
 	5: "PROPOSAL_STATUS_FAILED",
+	6: "PROPOSAL_STATUS_TALLIED",
 }
 
 var ProposalStatus_value = map[string]int32{
@@ -111,6 +183,7 @@ var ProposalStatus_value = map[string]int32{
 	"PROPOSAL_STATUS_PASSED":         3,
 	"PROPOSAL_STATUS_REJECTED":       4,
 	"PROPOSAL_STATUS_FAILED":         5,
+	"PROPOSAL_STATUS_TALLIED":        6,
 }
 
 func (x ProposalStatus) String() string {
@@ -118,7 +191,7 @@ func (x ProposalStatus) String() string {
 }
 
 func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{1}
+	return fileDescriptor_e05cb1c0d030febb, []int{2}
 }
 
 // WeightedVoteOption defines a unit of vote for vote split.
@@ -281,6 +354,20 @@ type Proposal struct {
 	//
 	// Since: cosmos-sdk 0.48
 	Expedited bool `protobuf:"varint,14,opt,name=expedited,proto3" json:"expedited,omitempty"`
+	// proposal_type defines the type of the proposal.
+	//
+	// Since: cosmos-sdk 0.50
+	ProposalType ProposalType `protobuf:"varint,15,opt,name=proposal_type,json=proposalType,proto3,enum=cosmos.gov.v1.ProposalType" json:"proposal_type,omitempty"`
+	// vote_options defines the vote options of a multiple choice proposal.
+	//
+	// Since: cosmos-sdk 0.50
+	VoteOptions *ProposalVoteOptions `protobuf:"bytes,16,opt,name=vote_options,json=voteOptions,proto3" json:"vote_options,omitempty"`
+	// final_option_tally_results are the final tally results of each option of
+	// a multiple choice proposal. They are not populated until the proposal's
+	// voting period has ended.
+	//
+	// Since: cosmos-sdk 0.50
+	FinalOptionTallyResults []*OptionTallyResult `protobuf:"bytes,17,rep,name=final_option_tally_results,json=finalOptionTallyResults,proto3" json:"final_option_tally_results,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -414,6 +501,76 @@ func (m *Proposal) GetExpedited() bool {
 	return false
 }
 
+func (m *Proposal) GetProposalType() ProposalType {
+	if m != nil {
+		return m.ProposalType
+	}
+	return ProposalType_PROPOSAL_TYPE_UNSPECIFIED
+}
+
+func (m *Proposal) GetVoteOptions() *ProposalVoteOptions {
+	if m != nil {
+		return m.VoteOptions
+	}
+	return nil
+}
+
+func (m *Proposal) GetFinalOptionTallyResults() []*OptionTallyResult {
+	if m != nil {
+		return m.FinalOptionTallyResults
+	}
+	return nil
+}
+
+// ProposalVoteOptions defines the vote options of a proposal. A vote is cast
+// for an option with the 1-based index of the option as vote option.
+//
+// Since: cosmos-sdk 0.50
+type ProposalVoteOptions struct {
+	// options are the options of the proposal.
+	Options []string `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+}
+
+func (m *ProposalVoteOptions) Reset()         { *m = ProposalVoteOptions{} }
+func (m *ProposalVoteOptions) String() string { return proto.CompactTextString(m) }
+func (*ProposalVoteOptions) ProtoMessage()    {}
+func (*ProposalVoteOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{3}
+}
+func (m *ProposalVoteOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalVoteOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalVoteOptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalVoteOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalVoteOptions.Merge(m, src)
+}
+func (m *ProposalVoteOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalVoteOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalVoteOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalVoteOptions proto.InternalMessageInfo
+
+func (m *ProposalVoteOptions) GetOptions() []string {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
 // TallyResult defines a standard tally for a governance proposal.
 type TallyResult struct {
 	// yes_count is the number of yes votes on a proposal.
@@ -430,7 +587,7 @@ func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{3}
+	return fileDescriptor_e05cb1c0d030febb, []int{4}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,6 +644,170 @@ func (m *TallyResult) GetNoWithVetoCount() string {
 	return ""
 }
 
+// TallySnapshot defines the final tally breakdown of a proposal, recorded when
+// its voting period ends.
+//
+// Since: cosmos-sdk 0.50
+type TallySnapshot struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// tally is the final tally result of the proposal.
+	Tally *TallyResult `protobuf:"bytes,2,opt,name=tally,proto3" json:"tally,omitempty"`
+	// validator_tally is the part of the tally cast by validators, including the
+	// voting power their delegators inherited.
+	ValidatorTally *TallyResult `protobuf:"bytes,3,opt,name=validator_tally,json=validatorTally,proto3" json:"validator_tally,omitempty"`
+	// delegator_tally is the part of the tally cast by delegators who voted
+	// themselves.
+	DelegatorTally *TallyResult `protobuf:"bytes,4,opt,name=delegator_tally,json=delegatorTally,proto3" json:"delegator_tally,omitempty"`
+	// total_bonded_tokens is the total amount of bonded tokens at tally time.
+	TotalBondedTokens string `protobuf:"bytes,5,opt,name=total_bonded_tokens,json=totalBondedTokens,proto3" json:"total_bonded_tokens,omitempty"`
+	// tally_time is the time at which the proposal was tallied.
+	TallyTime *time.Time `protobuf:"bytes,6,opt,name=tally_time,json=tallyTime,proto3,stdtime" json:"tally_time,omitempty"`
+	// option_tally_results are the tally results of each option of a multiple
+	// choice proposal.
+	OptionTallyResults []*OptionTallyResult `protobuf:"bytes,7,rep,name=option_tally_results,json=optionTallyResults,proto3" json:"option_tally_results,omitempty"`
+}
+
+func (m *TallySnapshot) Reset()         { *m = TallySnapshot{} }
+func (m *TallySnapshot) String() string { return proto.CompactTextString(m) }
+func (*TallySnapshot) ProtoMessage()    {}
+func (*TallySnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{5}
+}
+func (m *TallySnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TallySnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TallySnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TallySnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TallySnapshot.Merge(m, src)
+}
+func (m *TallySnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *TallySnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_TallySnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TallySnapshot proto.InternalMessageInfo
+
+func (m *TallySnapshot) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *TallySnapshot) GetTally() *TallyResult {
+	if m != nil {
+		return m.Tally
+	}
+	return nil
+}
+
+func (m *TallySnapshot) GetValidatorTally() *TallyResult {
+	if m != nil {
+		return m.ValidatorTally
+	}
+	return nil
+}
+
+func (m *TallySnapshot) GetDelegatorTally() *TallyResult {
+	if m != nil {
+		return m.DelegatorTally
+	}
+	return nil
+}
+
+func (m *TallySnapshot) GetTotalBondedTokens() string {
+	if m != nil {
+		return m.TotalBondedTokens
+	}
+	return ""
+}
+
+func (m *TallySnapshot) GetTallyTime() *time.Time {
+	if m != nil {
+		return m.TallyTime
+	}
+	return nil
+}
+
+func (m *TallySnapshot) GetOptionTallyResults() []*OptionTallyResult {
+	if m != nil {
+		return m.OptionTallyResults
+	}
+	return nil
+}
+
+// OptionTallyResult defines the tally result of an option of a multiple choice
+// proposal.
+//
+// Since: cosmos-sdk 0.50
+type OptionTallyResult struct {
+	// option is the 1-based index of the option in the vote options of the proposal.
+	Option uint32 `protobuf:"varint,1,opt,name=option,proto3" json:"option,omitempty"`
+	// count is the number of votes for the option.
+	Count string `protobuf:"bytes,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *OptionTallyResult) Reset()         { *m = OptionTallyResult{} }
+func (m *OptionTallyResult) String() string { return proto.CompactTextString(m) }
+func (*OptionTallyResult) ProtoMessage()    {}
+func (*OptionTallyResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{6}
+}
+func (m *OptionTallyResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OptionTallyResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OptionTallyResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OptionTallyResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptionTallyResult.Merge(m, src)
+}
+func (m *OptionTallyResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *OptionTallyResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptionTallyResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OptionTallyResult proto.InternalMessageInfo
+
+func (m *OptionTallyResult) GetOption() uint32 {
+	if m != nil {
+		return m.Option
+	}
+	return 0
+}
+
+func (m *OptionTallyResult) GetCount() string {
+	if m != nil {
+		return m.Count
+	}
+	return ""
+}
+
 // Vote defines a vote on a governance proposal.
 // A Vote consists of a proposal ID, the voter, and the vote option.
 type Vote struct {
@@ -504,7 +825,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{4}
+	return fileDescriptor_e05cb1c0d030febb, []int{7}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -576,7 +897,7 @@ func (m *DepositParams) Reset()         { *m = DepositParams{} }
 func (m *DepositParams) String() string { return proto.CompactTextString(m) }
 func (*DepositParams) ProtoMessage()    {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{5}
+	return fileDescriptor_e05cb1c0d030febb, []int{8}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -631,7 +952,7 @@ func (m *VotingParams) Reset()         { *m = VotingParams{} }
 func (m *VotingParams) String() string { return proto.CompactTextString(m) }
 func (*VotingParams) ProtoMessage()    {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{6}
+	return fileDescriptor_e05cb1c0d030febb, []int{9}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -685,7 +1006,7 @@ func (m *TallyParams) Reset()         { *m = TallyParams{} }
 func (m *TallyParams) String() string { return proto.CompactTextString(m) }
 func (*TallyParams) ProtoMessage()    {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{7}
+	return fileDescriptor_e05cb1c0d030febb, []int{10}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -746,15 +1067,15 @@ type Params struct {
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 	// The cancel ratio which will not be returned back to the depositors when a proposal is cancelled.
 	//
@@ -773,7 +1094,7 @@ type Params struct {
 	//
 	// Since: cosmos-sdk 0.48
 	ExpeditedThreshold string `protobuf:"bytes,11,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
-	// Minimum expedited deposit for a proposal to enter voting period.
+	//  Minimum expedited deposit for a proposal to enter voting period.
 	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
 	// burn deposits if a proposal does not meet quorum
 	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
@@ -781,13 +1102,27 @@ type Params struct {
 	BurnProposalDepositPrevote bool `protobuf:"varint,14,opt,name=burn_proposal_deposit_prevote,json=burnProposalDepositPrevote,proto3" json:"burn_proposal_deposit_prevote,omitempty"`
 	// burn deposits if quorum with vote type no_veto is met
 	BurnVoteVeto bool `protobuf:"varint,15,opt,name=burn_vote_veto,json=burnVoteVeto,proto3" json:"burn_vote_veto,omitempty"`
+	// proposal_cancel_max_period defines how far in the voting period a proposer can cancel a proposal,
+	// as a fraction of the voting period. A proposal can always be canceled during its deposit period.
+	//
+	// Since: cosmos-sdk 0.50
+	ProposalCancelMaxPeriod string `protobuf:"bytes,16,opt,name=proposal_cancel_max_period,json=proposalCancelMaxPeriod,proto3" json:"proposal_cancel_max_period,omitempty"`
+	// optimistic_authorized_addresses is the list of addresses allowed to submit optimistic proposals.
+	//
+	// Since: cosmos-sdk 0.50
+	OptimisticAuthorizedAddresses []string `protobuf:"bytes,17,rep,name=optimistic_authorized_addresses,json=optimisticAuthorizedAddresses,proto3" json:"optimistic_authorized_addresses,omitempty"`
+	// optimistic_rejected_threshold is the minimum proportion of the total voting power voting no or
+	// no with veto for an optimistic proposal to be rejected.
+	//
+	// Since: cosmos-sdk 0.50
+	OptimisticRejectedThreshold string `protobuf:"bytes,18,opt,name=optimistic_rejected_threshold,json=optimisticRejectedThreshold,proto3" json:"optimistic_rejected_threshold,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{8}
+	return fileDescriptor_e05cb1c0d030febb, []int{11}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -921,111 +1256,245 @@ func (m *Params) GetBurnVoteVeto() bool {
 	return false
 }
 
+func (m *Params) GetProposalCancelMaxPeriod() string {
+	if m != nil {
+		return m.ProposalCancelMaxPeriod
+	}
+	return ""
+}
+
+func (m *Params) GetOptimisticAuthorizedAddresses() []string {
+	if m != nil {
+		return m.OptimisticAuthorizedAddresses
+	}
+	return nil
+}
+
+func (m *Params) GetOptimisticRejectedThreshold() string {
+	if m != nil {
+		return m.OptimisticRejectedThreshold
+	}
+	return ""
+}
+
+// ScheduledParamChange defines a governance approved parameter update, executed
+// once its scheduled height or time is reached.
+//
+// Since: cosmos-sdk 0.50
+type ScheduledParamChange struct {
+	// id defines the unique id of the scheduled param change.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// msg is the MsgUpdateParams message executed once the change is due.
+	Msg *types1.Any `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// height is the block height at which the change is executed, 0 if the
+	// change is scheduled by time.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the change is executed, empty if the
+	// change is scheduled by height.
+	Time *time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time,omitempty"`
+}
+
+func (m *ScheduledParamChange) Reset()         { *m = ScheduledParamChange{} }
+func (m *ScheduledParamChange) String() string { return proto.CompactTextString(m) }
+func (*ScheduledParamChange) ProtoMessage()    {}
+func (*ScheduledParamChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{12}
+}
+func (m *ScheduledParamChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledParamChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledParamChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledParamChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledParamChange.Merge(m, src)
+}
+func (m *ScheduledParamChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledParamChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledParamChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledParamChange proto.InternalMessageInfo
+
+func (m *ScheduledParamChange) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ScheduledParamChange) GetMsg() *types1.Any {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *ScheduledParamChange) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ScheduledParamChange) GetTime() *time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.gov.v1.ProposalType", ProposalType_name, ProposalType_value)
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.v1.WeightedVoteOption")
 	proto.RegisterType((*Deposit)(nil), "cosmos.gov.v1.Deposit")
 	proto.RegisterType((*Proposal)(nil), "cosmos.gov.v1.Proposal")
+	proto.RegisterType((*ProposalVoteOptions)(nil), "cosmos.gov.v1.ProposalVoteOptions")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.gov.v1.TallyResult")
+	proto.RegisterType((*TallySnapshot)(nil), "cosmos.gov.v1.TallySnapshot")
+	proto.RegisterType((*OptionTallyResult)(nil), "cosmos.gov.v1.OptionTallyResult")
 	proto.RegisterType((*Vote)(nil), "cosmos.gov.v1.Vote")
 	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.v1.DepositParams")
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1.VotingParams")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
 	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
+	proto.RegisterType((*ScheduledParamChange)(nil), "cosmos.gov.v1.ScheduledParamChange")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1389 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcb, 0x6e, 0xdb, 0x46,
-	0x17, 0x36, 0x75, 0xd7, 0xd1, 0xc5, 0xca, 0xd8, 0x89, 0x69, 0x27, 0x96, 0x1c, 0x21, 0x08, 0xfc,
-	0xe7, 0x22, 0xfd, 0x4e, 0x9a, 0x2e, 0x9a, 0x02, 0x85, 0x64, 0x31, 0x0d, 0x8d, 0xc4, 0x52, 0x29,
-	0x45, 0x4e, 0xba, 0x21, 0x28, 0x71, 0x22, 0x13, 0x15, 0x39, 0x2a, 0x39, 0x52, 0xac, 0x47, 0xe8,
-	0x2e, 0xcb, 0xae, 0x8a, 0x2e, 0xbb, 0xec, 0x22, 0xe8, 0x33, 0x64, 0x55, 0x04, 0xe9, 0xa2, 0xdd,
-	0x34, 0x29, 0x92, 0x45, 0x81, 0x3c, 0x45, 0xc1, 0xe1, 0x50, 0x94, 0x64, 0xb5, 0xb6, 0xb3, 0xb1,
-	0xc5, 0x73, 0xbe, 0xef, 0x9b, 0x33, 0xe7, 0x32, 0x43, 0xc2, 0x5a, 0x97, 0x38, 0x26, 0x71, 0xca,
-	0x3d, 0x32, 0x2a, 0x8f, 0x76, 0xdc, 0x7f, 0xa5, 0x81, 0x4d, 0x28, 0x41, 0x19, 0xcf, 0x51, 0x72,
-	0x2d, 0xa3, 0x9d, 0x8d, 0x3c, 0xc7, 0x75, 0x34, 0x07, 0x97, 0x47, 0x3b, 0x1d, 0x4c, 0xb5, 0x9d,
-	0x72, 0x97, 0x18, 0x96, 0x07, 0xdf, 0x58, 0xed, 0x91, 0x1e, 0x61, 0x3f, 0xcb, 0xee, 0x2f, 0x6e,
-	0x2d, 0xf4, 0x08, 0xe9, 0xf5, 0x71, 0x99, 0x3d, 0x75, 0x86, 0x4f, 0xcb, 0xd4, 0x30, 0xb1, 0x43,
-	0x35, 0x73, 0xc0, 0x01, 0xeb, 0xf3, 0x00, 0xcd, 0x1a, 0x73, 0x57, 0x7e, 0xde, 0xa5, 0x0f, 0x6d,
-	0x8d, 0x1a, 0xc4, 0x5f, 0x71, 0xdd, 0x8b, 0x48, 0xf5, 0x16, 0xe5, 0xd1, 0x7a, 0xae, 0x73, 0x9a,
-	0x69, 0x58, 0xa4, 0xcc, 0xfe, 0x7a, 0xa6, 0x22, 0x01, 0x74, 0x80, 0x8d, 0xde, 0x21, 0xc5, 0x7a,
-	0x9b, 0x50, 0x5c, 0x1f, 0xb8, 0x4a, 0x68, 0x07, 0x62, 0x84, 0xfd, 0x12, 0x85, 0x2d, 0x61, 0x3b,
-	0x7b, 0x6b, 0xbd, 0x34, 0xb3, 0xeb, 0x52, 0x00, 0x55, 0x38, 0x10, 0x5d, 0x85, 0xd8, 0x33, 0x26,
-	0x24, 0x86, 0xb6, 0x84, 0xed, 0x64, 0x35, 0xfb, 0xfa, 0xc5, 0x4d, 0xe0, 0xac, 0x1a, 0xee, 0x2a,
-	0xdc, 0x5b, 0xfc, 0x51, 0x80, 0x78, 0x0d, 0x0f, 0x88, 0x63, 0x50, 0x54, 0x80, 0xd4, 0xc0, 0x26,
-	0x03, 0xe2, 0x68, 0x7d, 0xd5, 0xd0, 0xd9, 0x5a, 0x11, 0x05, 0x7c, 0x93, 0xac, 0xa3, 0x4f, 0x21,
-	0xa9, 0x7b, 0x58, 0x62, 0x73, 0x5d, 0xf1, 0xf5, 0x8b, 0x9b, 0xab, 0x5c, 0xb7, 0xa2, 0xeb, 0x36,
-	0x76, 0x9c, 0x26, 0xb5, 0x0d, 0xab, 0xa7, 0x04, 0x50, 0xf4, 0x39, 0xc4, 0x34, 0x93, 0x0c, 0x2d,
-	0x2a, 0x86, 0xb7, 0xc2, 0xdb, 0xa9, 0x20, 0x7e, 0xb7, 0x4c, 0x25, 0x5e, 0xa6, 0xd2, 0x2e, 0x31,
-	0xac, 0x6a, 0xf2, 0xe5, 0x9b, 0xc2, 0xd2, 0x4f, 0x7f, 0xff, 0x7c, 0x4d, 0x50, 0x38, 0xa7, 0xf8,
-	0x36, 0x0a, 0x89, 0x06, 0x0f, 0x02, 0x65, 0x21, 0x34, 0x09, 0x2d, 0x64, 0xe8, 0xe8, 0xff, 0x90,
-	0x30, 0xb1, 0xe3, 0x68, 0x3d, 0xec, 0x88, 0x21, 0x26, 0xbe, 0x5a, 0xf2, 0x2a, 0x52, 0xf2, 0x2b,
-	0x52, 0xaa, 0x58, 0x63, 0x65, 0x82, 0x42, 0x77, 0x20, 0xe6, 0x50, 0x8d, 0x0e, 0x1d, 0x31, 0xcc,
-	0x92, 0xb9, 0x39, 0x97, 0x4c, 0x7f, 0xa9, 0x26, 0x03, 0x29, 0x1c, 0x8c, 0xee, 0x03, 0x7a, 0x6a,
-	0x58, 0x5a, 0x5f, 0xa5, 0x5a, 0xbf, 0x3f, 0x56, 0x6d, 0xec, 0x0c, 0xfb, 0x54, 0x8c, 0x6c, 0x09,
-	0xdb, 0xa9, 0x5b, 0x1b, 0x73, 0x12, 0x2d, 0x17, 0xa2, 0x30, 0x84, 0x92, 0x63, 0xac, 0x29, 0x0b,
-	0xaa, 0x40, 0xca, 0x19, 0x76, 0x4c, 0x83, 0xaa, 0x6e, 0x9b, 0x89, 0x51, 0x2e, 0x31, 0x1f, 0x75,
-	0xcb, 0xef, 0xc1, 0x6a, 0xe4, 0xf9, 0xdb, 0x82, 0xa0, 0x80, 0x47, 0x72, 0xcd, 0x68, 0x0f, 0x72,
-	0x3c, 0xbb, 0x2a, 0xb6, 0x74, 0x4f, 0x27, 0x76, 0x4a, 0x9d, 0x2c, 0x67, 0x4a, 0x96, 0xce, 0xb4,
-	0x64, 0xc8, 0x50, 0x42, 0xb5, 0xbe, 0xca, 0xed, 0x62, 0xfc, 0x0c, 0x35, 0x4a, 0x33, 0xaa, 0xdf,
-	0x40, 0x0f, 0xe0, 0xdc, 0x88, 0x50, 0xc3, 0xea, 0xa9, 0x0e, 0xd5, 0x6c, 0xbe, 0xbf, 0xc4, 0x29,
-	0xe3, 0x5a, 0xf6, 0xa8, 0x4d, 0x97, 0xc9, 0x02, 0xbb, 0x0f, 0xdc, 0x14, 0xec, 0x31, 0x79, 0x4a,
-	0xad, 0x8c, 0x47, 0xf4, 0xb7, 0xb8, 0xe1, 0x36, 0x09, 0xd5, 0x74, 0x8d, 0x6a, 0x22, 0xb8, 0x6d,
-	0xab, 0x4c, 0x9e, 0xd1, 0x2a, 0x44, 0xa9, 0x41, 0xfb, 0x58, 0x4c, 0x31, 0x87, 0xf7, 0x80, 0x44,
-	0x88, 0x3b, 0x43, 0xd3, 0xd4, 0xec, 0xb1, 0x98, 0x66, 0x76, 0xff, 0x11, 0x7d, 0x02, 0x09, 0x6f,
-	0x22, 0xb0, 0x2d, 0x66, 0x4e, 0x18, 0x81, 0x09, 0x12, 0x5d, 0x82, 0x24, 0x3e, 0x1a, 0x60, 0xdd,
-	0xa0, 0x58, 0x17, 0xb3, 0x5b, 0xc2, 0x76, 0x42, 0x09, 0x0c, 0xc5, 0xdf, 0x05, 0x48, 0x4d, 0x77,
-	0xc8, 0x75, 0x48, 0x8e, 0xb1, 0xa3, 0x76, 0xd9, 0xc8, 0x08, 0xc7, 0xe6, 0x57, 0xb6, 0xa8, 0x92,
-	0x18, 0x63, 0x67, 0xd7, 0xf5, 0xa3, 0xdb, 0x90, 0xd1, 0x3a, 0x0e, 0xd5, 0x0c, 0x8b, 0x13, 0x42,
-	0x0b, 0x09, 0x69, 0x0e, 0xf2, 0x48, 0xff, 0x83, 0x84, 0x45, 0x38, 0x3e, 0xbc, 0x10, 0x1f, 0xb7,
-	0x88, 0x07, 0xbd, 0x0b, 0xc8, 0x22, 0xea, 0x33, 0x83, 0x1e, 0xaa, 0x23, 0x4c, 0x7d, 0x52, 0x64,
-	0x21, 0x69, 0xd9, 0x22, 0x07, 0x06, 0x3d, 0x6c, 0x63, 0xea, 0x91, 0x8b, 0xbf, 0x08, 0x10, 0x71,
-	0x4f, 0xa7, 0x93, 0xcf, 0x96, 0x12, 0x44, 0x47, 0x84, 0xe2, 0x93, 0xcf, 0x15, 0x0f, 0x86, 0xee,
-	0x42, 0xdc, 0x3b, 0xea, 0x1c, 0x31, 0xc2, 0x1a, 0xf6, 0xf2, 0xdc, 0x10, 0x1e, 0x3f, 0x47, 0x15,
-	0x9f, 0x31, 0xd3, 0x10, 0xd1, 0xd9, 0x86, 0xd8, 0x8b, 0x24, 0xc2, 0xb9, 0x48, 0xf1, 0x4f, 0x01,
-	0x32, 0xbc, 0xad, 0x1b, 0x9a, 0xad, 0x99, 0x0e, 0x7a, 0x02, 0x29, 0xd3, 0xb0, 0x26, 0x53, 0x22,
-	0x9c, 0x34, 0x25, 0x9b, 0xee, 0x94, 0x7c, 0x78, 0x53, 0x38, 0x3f, 0xc5, 0xba, 0x41, 0x4c, 0x83,
-	0x62, 0x73, 0x40, 0xc7, 0x0a, 0x98, 0x86, 0xe5, 0xcf, 0x8d, 0x09, 0xc8, 0xd4, 0x8e, 0x7c, 0x90,
-	0x3a, 0xc0, 0xb6, 0x41, 0x74, 0x96, 0x08, 0x77, 0x85, 0xf9, 0x66, 0xaf, 0xf1, 0x0b, 0xa6, 0x7a,
-	0xe5, 0xc3, 0x9b, 0xc2, 0xa5, 0xe3, 0xc4, 0x60, 0x91, 0xef, 0xdd, 0x59, 0xc8, 0x99, 0xda, 0x91,
-	0xbf, 0x13, 0xe6, 0xff, 0x2c, 0x24, 0x0a, 0xc5, 0xc7, 0x90, 0x6e, 0xb3, 0x19, 0xe1, 0xbb, 0xab,
-	0x01, 0x9f, 0x19, 0x7f, 0x75, 0xe1, 0xa4, 0xd5, 0x23, 0x4c, 0x3d, 0xed, 0xb1, 0xa6, 0x94, 0x7f,
-	0xf0, 0x9b, 0x99, 0x2b, 0x5f, 0x85, 0xd8, 0xb7, 0x43, 0x62, 0x0f, 0xcd, 0x05, 0x9d, 0xcc, 0x6e,
-	0x22, 0xcf, 0x8b, 0x6e, 0x40, 0x92, 0x1e, 0xda, 0xd8, 0x39, 0x24, 0x7d, 0xfd, 0x5f, 0x2e, 0xad,
-	0x00, 0x80, 0xee, 0x40, 0x96, 0x75, 0x63, 0x40, 0x09, 0x2f, 0xa4, 0x64, 0x5c, 0x54, 0xcb, 0x07,
-	0xb1, 0x00, 0x7f, 0x8b, 0x43, 0x8c, 0xc7, 0x26, 0x9d, 0xb1, 0xa6, 0x53, 0x27, 0xdf, 0x74, 0xfd,
-	0x1e, 0x7e, 0x5c, 0xfd, 0x22, 0x8b, 0xeb, 0x73, 0xbc, 0x16, 0xe1, 0x8f, 0xa8, 0xc5, 0x54, 0xde,
-	0x23, 0xa7, 0xcf, 0x7b, 0xf4, 0xec, 0x79, 0x8f, 0x9d, 0x22, 0xef, 0x48, 0x86, 0x75, 0x37, 0xd1,
-	0x86, 0x65, 0x50, 0x23, 0xb8, 0x6a, 0x54, 0x16, 0xbe, 0x18, 0x5f, 0xa8, 0x70, 0xc1, 0x34, 0x2c,
-	0xd9, 0xc3, 0xf3, 0xf4, 0x28, 0x2e, 0x1a, 0x55, 0xe1, 0xfc, 0xe4, 0x24, 0xe9, 0x6a, 0x56, 0x17,
-	0xf7, 0xb9, 0x4c, 0x62, 0xa1, 0xcc, 0x8a, 0x0f, 0xde, 0x65, 0x58, 0x4f, 0x63, 0x0f, 0x56, 0xe7,
-	0x35, 0x74, 0xec, 0x50, 0x76, 0xbf, 0xfc, 0xd7, 0xd9, 0x83, 0x66, 0xc5, 0x6a, 0xd8, 0xa1, 0xe8,
-	0x00, 0xd6, 0x26, 0x27, 0xb9, 0x3a, 0x5b, 0x37, 0x38, 0x5d, 0xdd, 0xce, 0x4f, 0xf8, 0xed, 0xe9,
-	0x02, 0x7e, 0x01, 0x2b, 0x81, 0x70, 0x90, 0xef, 0xd4, 0xc2, 0x6d, 0xa2, 0x09, 0x34, 0x48, 0xfa,
-	0x63, 0x08, 0x94, 0xd5, 0xe9, 0x3e, 0x4f, 0x9f, 0xa1, 0xcf, 0x83, 0x18, 0x1e, 0x06, 0x0d, 0xbf,
-	0x0d, 0xb9, 0xce, 0xd0, 0xb6, 0xdc, 0xed, 0x62, 0x95, 0x77, 0x59, 0x86, 0xdd, 0x6a, 0x59, 0xd7,
-	0xee, 0x1e, 0xb9, 0x5f, 0x79, 0xdd, 0x55, 0x81, 0x4d, 0x86, 0x9c, 0xa4, 0x7b, 0x32, 0x24, 0x36,
-	0x76, 0xd9, 0xfc, 0x32, 0xdc, 0x70, 0x41, 0xfe, 0x9b, 0x97, 0x3f, 0x0d, 0x1e, 0x02, 0x5d, 0x81,
-	0x6c, 0xb0, 0x98, 0xdb, 0x56, 0xe2, 0x32, 0xe3, 0xa4, 0xfd, 0xa5, 0xdc, 0xeb, 0xe6, 0xda, 0x77,
-	0x02, 0xc0, 0xd4, 0x2b, 0xf3, 0x45, 0x58, 0x6b, 0xd7, 0x5b, 0x92, 0x5a, 0x6f, 0xb4, 0xe4, 0xfa,
-	0xbe, 0xfa, 0x68, 0xbf, 0xd9, 0x90, 0x76, 0xe5, 0x7b, 0xb2, 0x54, 0xcb, 0x2d, 0xa1, 0x15, 0x58,
-	0x9e, 0x76, 0x3e, 0x91, 0x9a, 0x39, 0x01, 0xad, 0xc1, 0xca, 0xb4, 0xb1, 0x52, 0x6d, 0xb6, 0x2a,
-	0xf2, 0x7e, 0x2e, 0x84, 0x10, 0x64, 0xa7, 0x1d, 0xfb, 0xf5, 0x5c, 0x18, 0x5d, 0x02, 0x71, 0xd6,
-	0xa6, 0x1e, 0xc8, 0xad, 0xfb, 0x6a, 0x5b, 0x6a, 0xd5, 0x73, 0x91, 0x6b, 0xbf, 0x0a, 0x90, 0x9d,
-	0x7d, 0x8d, 0x44, 0x05, 0xb8, 0xd8, 0x50, 0xea, 0x8d, 0x7a, 0xb3, 0xf2, 0x40, 0x6d, 0xb6, 0x2a,
-	0xad, 0x47, 0xcd, 0xb9, 0x98, 0x8a, 0x90, 0x9f, 0x07, 0xd4, 0xa4, 0x46, 0xbd, 0x29, 0xb7, 0xd4,
-	0x86, 0xa4, 0xc8, 0xf5, 0x5a, 0x4e, 0x40, 0x97, 0x61, 0x73, 0x1e, 0xd3, 0xae, 0xb7, 0xe4, 0xfd,
-	0x2f, 0x7d, 0x48, 0x08, 0x6d, 0xc0, 0x85, 0x79, 0x48, 0xa3, 0xd2, 0x6c, 0x4a, 0x35, 0x2f, 0xe8,
-	0x79, 0x9f, 0x22, 0xed, 0x49, 0xbb, 0x2d, 0xa9, 0x96, 0x8b, 0x2c, 0x62, 0xde, 0xab, 0xc8, 0x0f,
-	0xa4, 0x5a, 0x2e, 0x5a, 0x95, 0x5e, 0xbe, 0xcb, 0x0b, 0xaf, 0xde, 0xe5, 0x85, 0xbf, 0xde, 0xe5,
-	0x85, 0xe7, 0xef, 0xf3, 0x4b, 0xaf, 0xde, 0xe7, 0x97, 0xfe, 0x78, 0x9f, 0x5f, 0xfa, 0xfa, 0x7a,
-	0xcf, 0xa0, 0x87, 0xc3, 0x4e, 0xa9, 0x4b, 0x4c, 0xfe, 0x71, 0xc3, 0xff, 0xdd, 0x74, 0xf4, 0x6f,
-	0xca, 0x47, 0xec, 0x83, 0x8d, 0x8e, 0x07, 0xd8, 0x71, 0xbf, 0xc6, 0x62, 0x6c, 0x02, 0x6e, 0xff,
-	0x13, 0x00, 0x00, 0xff, 0xff, 0xba, 0xe0, 0x97, 0xe3, 0xce, 0x0d, 0x00, 0x00,
+	// 1861 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xcd, 0x73, 0x23, 0x57,
+	0x11, 0xf7, 0x48, 0xb2, 0x2c, 0xb5, 0x25, 0x79, 0xfc, 0xec, 0x5d, 0x8f, 0xbd, 0xeb, 0x8f, 0xa8,
+	0xb6, 0xb6, 0xcc, 0x26, 0x2b, 0xc5, 0xf9, 0xe0, 0x40, 0x28, 0x82, 0x2c, 0xcd, 0x62, 0x2d, 0xb6,
+	0xa5, 0x8c, 0x66, 0xed, 0x2c, 0x55, 0xd4, 0x30, 0xd6, 0xbc, 0x48, 0x43, 0x34, 0xf3, 0x84, 0xe6,
+	0xc9, 0xb1, 0xf9, 0x13, 0x38, 0xe5, 0xc8, 0x81, 0xa2, 0x38, 0x72, 0xe4, 0x90, 0xe2, 0xce, 0x89,
+	0x1c, 0x53, 0x39, 0x00, 0x17, 0x16, 0x6a, 0x97, 0x2a, 0xaa, 0xc2, 0x3f, 0x41, 0xbd, 0x8f, 0xf9,
+	0x90, 0xac, 0x8d, 0xed, 0x5c, 0x6c, 0x4d, 0xf7, 0xef, 0xd7, 0xaf, 0x5f, 0x77, 0xbf, 0x7e, 0x3d,
+	0x03, 0x6b, 0x5d, 0x12, 0x78, 0x24, 0xa8, 0xf6, 0xc8, 0x79, 0xf5, 0x7c, 0x8f, 0xfd, 0xab, 0x0c,
+	0x47, 0x84, 0x12, 0x54, 0x14, 0x8a, 0x0a, 0x93, 0x9c, 0xef, 0x6d, 0x6c, 0x49, 0xdc, 0x99, 0x1d,
+	0xe0, 0xea, 0xf9, 0xde, 0x19, 0xa6, 0xf6, 0x5e, 0xb5, 0x4b, 0x5c, 0x5f, 0xc0, 0x37, 0x56, 0x7b,
+	0xa4, 0x47, 0xf8, 0xcf, 0x2a, 0xfb, 0x25, 0xa5, 0xdb, 0x3d, 0x42, 0x7a, 0x03, 0x5c, 0xe5, 0x4f,
+	0x67, 0xe3, 0x4f, 0xaa, 0xd4, 0xf5, 0x70, 0x40, 0x6d, 0x6f, 0x28, 0x01, 0xeb, 0xd3, 0x00, 0xdb,
+	0xbf, 0x94, 0xaa, 0xad, 0x69, 0x95, 0x33, 0x1e, 0xd9, 0xd4, 0x25, 0xe1, 0x8a, 0xeb, 0xc2, 0x23,
+	0x4b, 0x2c, 0x2a, 0xbd, 0x15, 0xaa, 0x65, 0xdb, 0x73, 0x7d, 0x52, 0xe5, 0x7f, 0x85, 0xa8, 0x4c,
+	0x00, 0x9d, 0x62, 0xb7, 0xd7, 0xa7, 0xd8, 0x39, 0x21, 0x14, 0xb7, 0x86, 0xcc, 0x12, 0xda, 0x83,
+	0x2c, 0xe1, 0xbf, 0x34, 0x65, 0x47, 0xd9, 0x2d, 0xbd, 0xb3, 0x5e, 0x99, 0xd8, 0x75, 0x25, 0x86,
+	0x1a, 0x12, 0x88, 0x1e, 0x42, 0xf6, 0x33, 0x6e, 0x48, 0x4b, 0xed, 0x28, 0xbb, 0xf9, 0xfd, 0xd2,
+	0xd7, 0x5f, 0x3c, 0x06, 0xc9, 0x6a, 0xe0, 0xae, 0x21, 0xb5, 0xe5, 0x3f, 0x28, 0xb0, 0xd0, 0xc0,
+	0x43, 0x12, 0xb8, 0x14, 0x6d, 0xc3, 0xe2, 0x70, 0x44, 0x86, 0x24, 0xb0, 0x07, 0x96, 0xeb, 0xf0,
+	0xb5, 0x32, 0x06, 0x84, 0xa2, 0xa6, 0x83, 0xbe, 0x0f, 0x79, 0x47, 0x60, 0xc9, 0x48, 0xda, 0xd5,
+	0xbe, 0xfe, 0xe2, 0xf1, 0xaa, 0xb4, 0x5b, 0x73, 0x9c, 0x11, 0x0e, 0x82, 0x0e, 0x1d, 0xb9, 0x7e,
+	0xcf, 0x88, 0xa1, 0xe8, 0x87, 0x90, 0xb5, 0x3d, 0x32, 0xf6, 0xa9, 0x96, 0xde, 0x49, 0xef, 0x2e,
+	0xc6, 0xfe, 0xb3, 0x34, 0x55, 0x64, 0x9a, 0x2a, 0x75, 0xe2, 0xfa, 0xfb, 0xf9, 0x2f, 0x5f, 0x6c,
+	0xcf, 0xfd, 0xf1, 0xbf, 0x7f, 0x7a, 0xa4, 0x18, 0x92, 0x53, 0xfe, 0xeb, 0x02, 0xe4, 0xda, 0xd2,
+	0x09, 0x54, 0x82, 0x54, 0xe4, 0x5a, 0xca, 0x75, 0xd0, 0xdb, 0x90, 0xf3, 0x70, 0x10, 0xd8, 0x3d,
+	0x1c, 0x68, 0x29, 0x6e, 0x7c, 0xb5, 0x22, 0x32, 0x52, 0x09, 0x33, 0x52, 0xa9, 0xf9, 0x97, 0x46,
+	0x84, 0x42, 0xef, 0x43, 0x36, 0xa0, 0x36, 0x1d, 0x07, 0x5a, 0x9a, 0x07, 0x73, 0x73, 0x2a, 0x98,
+	0xe1, 0x52, 0x1d, 0x0e, 0x32, 0x24, 0x18, 0x1d, 0x00, 0xfa, 0xc4, 0xf5, 0xed, 0x81, 0x45, 0xed,
+	0xc1, 0xe0, 0xd2, 0x1a, 0xe1, 0x60, 0x3c, 0xa0, 0x5a, 0x66, 0x47, 0xd9, 0x5d, 0x7c, 0x67, 0x63,
+	0xca, 0x84, 0xc9, 0x20, 0x06, 0x47, 0x18, 0x2a, 0x67, 0x25, 0x24, 0xa8, 0x06, 0x8b, 0xc1, 0xf8,
+	0xcc, 0x73, 0xa9, 0xc5, 0xca, 0x4c, 0x9b, 0x97, 0x26, 0xa6, 0xbd, 0x36, 0xc3, 0x1a, 0xdc, 0xcf,
+	0x7c, 0xfe, 0xaf, 0x6d, 0xc5, 0x00, 0x41, 0x62, 0x62, 0xf4, 0x14, 0x54, 0x19, 0x5d, 0x0b, 0xfb,
+	0x8e, 0xb0, 0x93, 0xbd, 0xa1, 0x9d, 0x92, 0x64, 0xea, 0xbe, 0xc3, 0x6d, 0x35, 0xa1, 0x48, 0x09,
+	0xb5, 0x07, 0x96, 0x94, 0x6b, 0x0b, 0xb7, 0xc8, 0x51, 0x81, 0x53, 0xc3, 0x02, 0x3a, 0x84, 0xe5,
+	0x73, 0x42, 0x5d, 0xbf, 0x67, 0x05, 0xd4, 0x1e, 0xc9, 0xfd, 0xe5, 0x6e, 0xe8, 0xd7, 0x92, 0xa0,
+	0x76, 0x18, 0x93, 0x3b, 0x76, 0x00, 0x52, 0x14, 0xef, 0x31, 0x7f, 0x43, 0x5b, 0x45, 0x41, 0x0c,
+	0xb7, 0xb8, 0xc1, 0x8a, 0x84, 0xda, 0x8e, 0x4d, 0x6d, 0x0d, 0x58, 0xd9, 0x1a, 0xd1, 0x33, 0x5a,
+	0x85, 0x79, 0xea, 0xd2, 0x01, 0xd6, 0x16, 0xb9, 0x42, 0x3c, 0x20, 0x0d, 0x16, 0x82, 0xb1, 0xe7,
+	0xd9, 0xa3, 0x4b, 0xad, 0xc0, 0xe5, 0xe1, 0x23, 0x7a, 0x0f, 0x72, 0xe2, 0x44, 0xe0, 0x91, 0x56,
+	0xbc, 0xe6, 0x08, 0x44, 0x48, 0x74, 0x1f, 0xf2, 0xf8, 0x62, 0x88, 0x1d, 0x97, 0x62, 0x47, 0x2b,
+	0xed, 0x28, 0xbb, 0x39, 0x23, 0x16, 0xa0, 0x1f, 0x43, 0x31, 0x3a, 0x78, 0xf4, 0x72, 0x88, 0xb5,
+	0x25, 0x5e, 0x99, 0xf7, 0x5e, 0x53, 0x99, 0xe6, 0xe5, 0x10, 0x1b, 0x85, 0x61, 0xe2, 0x09, 0xe9,
+	0x50, 0x38, 0x27, 0x14, 0x5b, 0xe2, 0xf4, 0x07, 0x9a, 0xca, 0x03, 0x55, 0x7e, 0x8d, 0x81, 0xb8,
+	0x5f, 0x04, 0xc6, 0xe2, 0x79, 0xfc, 0x80, 0x7e, 0x0e, 0x1b, 0xa2, 0xc8, 0x85, 0x9d, 0x89, 0x5a,
+	0x0f, 0xb4, 0x65, 0x5e, 0x18, 0x3b, 0x53, 0x46, 0x05, 0x37, 0x59, 0xf2, 0x6b, 0xdc, 0xc6, 0x15,
+	0x79, 0x50, 0xae, 0xc2, 0xca, 0x0c, 0x17, 0x58, 0xb0, 0x43, 0xbf, 0x95, 0x9d, 0x34, 0x0b, 0xb6,
+	0x7c, 0x2c, 0xff, 0x5d, 0x81, 0xc5, 0xe4, 0xd1, 0x79, 0x13, 0xf2, 0x97, 0x38, 0xb0, 0xba, 0xbc,
+	0x97, 0x28, 0x57, 0x1a, 0x5b, 0xd3, 0xa7, 0x46, 0xee, 0x12, 0x07, 0x75, 0xa6, 0x47, 0xef, 0x42,
+	0xd1, 0x3e, 0x0b, 0xa8, 0xed, 0xfa, 0x92, 0x90, 0x9a, 0x49, 0x28, 0x48, 0x90, 0x20, 0x7d, 0x0f,
+	0x72, 0x3e, 0x91, 0xf8, 0xf4, 0x4c, 0xfc, 0x82, 0x4f, 0x04, 0xf4, 0x03, 0x40, 0x3e, 0xb1, 0x3e,
+	0x73, 0x69, 0xdf, 0x3a, 0xc7, 0x34, 0x24, 0x65, 0x66, 0x92, 0x96, 0x7c, 0x72, 0xea, 0xd2, 0xfe,
+	0x09, 0xa6, 0x82, 0x5c, 0xfe, 0x4b, 0x1a, 0x8a, 0x7c, 0x67, 0x1d, 0xdf, 0x1e, 0x06, 0x7d, 0x72,
+	0x83, 0xee, 0xfb, 0x36, 0xcc, 0xf3, 0x7c, 0x68, 0x29, 0x79, 0x0a, 0x5e, 0xdf, 0x74, 0x04, 0x10,
+	0xd5, 0x61, 0xe9, 0xdc, 0x1e, 0xb8, 0x8e, 0x4d, 0xc9, 0x48, 0xe4, 0x52, 0x4b, 0x5f, 0xcb, 0x2d,
+	0x45, 0x14, 0x33, 0x34, 0xe2, 0xe0, 0x01, 0xee, 0x25, 0x8c, 0x5c, 0xdf, 0xf5, 0x4a, 0x11, 0x45,
+	0x18, 0xf9, 0x11, 0xac, 0x88, 0x26, 0x73, 0x46, 0x7c, 0x07, 0x3b, 0x16, 0x25, 0x9f, 0x62, 0x3f,
+	0xd0, 0xe6, 0x67, 0x06, 0x6b, 0x99, 0x43, 0xf7, 0x39, 0xd2, 0xe4, 0x40, 0xf4, 0x21, 0x80, 0xa8,
+	0xc5, 0x5b, 0xb5, 0xba, 0x3c, 0xe7, 0x30, 0x29, 0x32, 0x60, 0x75, 0x66, 0x4d, 0x2f, 0xdc, 0xb0,
+	0xa6, 0x11, 0xb9, 0x5a, 0xce, 0x1f, 0xc1, 0xf2, 0x15, 0x20, 0xba, 0x3b, 0x71, 0x57, 0x17, 0xa3,
+	0x0b, 0xf9, 0x01, 0xcc, 0x7f, 0x5b, 0x15, 0x0a, 0x65, 0xf9, 0xcf, 0x0a, 0x64, 0xd8, 0xd1, 0xb8,
+	0xbe, 0x1a, 0x2a, 0x30, 0xcf, 0x4e, 0xee, 0xf5, 0xf7, 0xb0, 0x80, 0xa1, 0x0f, 0xe2, 0x43, 0x96,
+	0xe1, 0x7b, 0x7e, 0x63, 0x6a, 0xcf, 0x57, 0xe7, 0x8e, 0xe8, 0x1c, 0x4e, 0x34, 0xd0, 0xf9, 0xc9,
+	0x06, 0xfa, 0x34, 0x93, 0x4b, 0xab, 0x99, 0xf2, 0x3f, 0x15, 0x28, 0xca, 0x6b, 0xa0, 0x6d, 0x8f,
+	0x6c, 0x2f, 0x40, 0xcf, 0x61, 0xd1, 0x73, 0xfd, 0xe8, 0x56, 0x51, 0xae, 0xbb, 0x55, 0x36, 0xd9,
+	0xad, 0xf2, 0xcd, 0x8b, 0xed, 0x3b, 0x09, 0xd6, 0x5b, 0xc4, 0x73, 0x29, 0xf6, 0x86, 0xf4, 0xd2,
+	0x00, 0xcf, 0xf5, 0xc3, 0x7b, 0xc6, 0x03, 0xe4, 0xd9, 0x17, 0x21, 0xc8, 0x1a, 0xe2, 0x91, 0x4b,
+	0x1c, 0x79, 0x2c, 0xd6, 0xaf, 0x54, 0x45, 0x43, 0x0e, 0x64, 0xfb, 0x0f, 0xbe, 0x79, 0xb1, 0x7d,
+	0xff, 0x2a, 0x31, 0x5e, 0xe4, 0xb7, 0xac, 0x68, 0x54, 0xcf, 0xbe, 0x08, 0x77, 0xc2, 0xf5, 0x3f,
+	0x48, 0x69, 0x4a, 0xf9, 0x63, 0x28, 0x9c, 0xf0, 0x3b, 0x45, 0xee, 0xae, 0x01, 0xf2, 0x8e, 0x09,
+	0x57, 0x57, 0xae, 0x5b, 0x3d, 0xc3, 0xad, 0x17, 0x04, 0x2b, 0x61, 0xf9, 0xf7, 0x61, 0x8f, 0x93,
+	0x96, 0x1f, 0x42, 0xf6, 0x57, 0x63, 0x32, 0x1a, 0x7b, 0x9a, 0x32, 0x7b, 0x72, 0x13, 0x5a, 0xf4,
+	0x16, 0xe4, 0x69, 0x7f, 0x84, 0x83, 0x3e, 0x19, 0x38, 0xaf, 0x19, 0xf2, 0x62, 0x00, 0x7a, 0x1f,
+	0x4a, 0xbc, 0x49, 0xc5, 0x94, 0xf4, 0x4c, 0x4a, 0x91, 0xa1, 0xcc, 0x10, 0xc4, 0x1d, 0xfc, 0x5b,
+	0x1e, 0xb2, 0xd2, 0x37, 0xfd, 0x96, 0x39, 0x4d, 0x4c, 0x0a, 0xc9, 0xfc, 0x1d, 0x7d, 0xb7, 0xfc,
+	0x65, 0x66, 0xe7, 0xe7, 0x6a, 0x2e, 0xd2, 0xdf, 0x21, 0x17, 0x89, 0xb8, 0x67, 0x6e, 0x1e, 0xf7,
+	0xf9, 0xdb, 0xc7, 0x3d, 0x7b, 0x83, 0xb8, 0xa3, 0x26, 0xac, 0xb3, 0x40, 0xbb, 0xbe, 0x4b, 0xdd,
+	0x78, 0x34, 0xb3, 0xb8, 0xfb, 0xda, 0xc2, 0x4c, 0x0b, 0x77, 0x3d, 0xd7, 0x6f, 0x0a, 0xbc, 0x0c,
+	0x8f, 0xc1, 0xd0, 0x68, 0x1f, 0xee, 0x44, 0x9d, 0xa4, 0x6b, 0xfb, 0x5d, 0x3c, 0x90, 0x66, 0x72,
+	0x33, 0xcd, 0xac, 0x84, 0xe0, 0x3a, 0xc7, 0x0a, 0x1b, 0x4f, 0x61, 0x75, 0xda, 0x86, 0x83, 0x03,
+	0xaa, 0xe5, 0xaf, 0xe9, 0x3d, 0x68, 0xd2, 0x58, 0x03, 0x07, 0x14, 0x9d, 0xc2, 0x5a, 0x34, 0xf9,
+	0x58, 0x93, 0x79, 0x83, 0x9b, 0xe5, 0xed, 0x4e, 0xc4, 0x3f, 0x49, 0x26, 0xf0, 0x43, 0x58, 0x89,
+	0x0d, 0xc7, 0xf1, 0x5e, 0x9c, 0xb9, 0x4d, 0x14, 0x41, 0xe3, 0xa0, 0x7f, 0x0c, 0xb1, 0x65, 0x2b,
+	0x59, 0xe7, 0x85, 0x5b, 0xd4, 0x79, 0xec, 0xc3, 0x51, 0x5c, 0xf0, 0xbb, 0xa0, 0x9e, 0x8d, 0x47,
+	0xbe, 0xc5, 0x67, 0x34, 0x59, 0x65, 0x45, 0x3e, 0x05, 0x96, 0x98, 0x9c, 0xb5, 0xdc, 0x8f, 0x44,
+	0x75, 0xd5, 0x60, 0x93, 0x23, 0xa3, 0x70, 0x47, 0x87, 0x64, 0x84, 0x19, 0x5b, 0x0e, 0x8f, 0x1b,
+	0x0c, 0x14, 0xce, 0x52, 0xe1, 0x69, 0x10, 0x08, 0xf4, 0x00, 0x4a, 0xf1, 0x62, 0xac, 0xac, 0xf8,
+	0x38, 0x99, 0x33, 0x0a, 0xe1, 0x52, 0x6c, 0x0a, 0x41, 0x3f, 0x85, 0x8d, 0xe9, 0x94, 0xb2, 0x33,
+	0x29, 0x33, 0xa1, 0xce, 0x0c, 0xda, 0xda, 0x64, 0x3a, 0x8f, 0xec, 0x0b, 0x19, 0xfa, 0x5f, 0xc0,
+	0x36, 0xbb, 0x2a, 0x3c, 0x37, 0xa0, 0x6e, 0xd7, 0xb2, 0xc7, 0xb4, 0x4f, 0x46, 0xee, 0xaf, 0xb1,
+	0x63, 0xd9, 0xa2, 0x1c, 0xb0, 0x18, 0x1e, 0xbf, 0xad, 0x54, 0x36, 0x63, 0x03, 0xb5, 0x88, 0x5f,
+	0x0b, 0xe9, 0xc8, 0x80, 0x04, 0xc0, 0x1a, 0xe1, 0x5f, 0xe2, 0xee, 0x64, 0x9a, 0xd1, 0x4c, 0x8f,
+	0xef, 0xc5, 0x24, 0x43, 0x72, 0xa2, 0x7c, 0x97, 0x7f, 0xa7, 0xc0, 0x6a, 0xa7, 0xdb, 0xc7, 0xce,
+	0x78, 0x80, 0x1d, 0xde, 0xe1, 0xea, 0x7d, 0xdb, 0xef, 0xe1, 0x2b, 0x2f, 0x99, 0x0f, 0x21, 0xed,
+	0x05, 0x3d, 0xd9, 0xa0, 0x66, 0xbf, 0x5f, 0x32, 0x00, 0xbb, 0xfb, 0xfb, 0xe2, 0xa5, 0x9b, 0x75,
+	0xa0, 0xb4, 0x21, 0x9f, 0xd0, 0x7b, 0x90, 0xe1, 0x73, 0x4b, 0xe6, 0x86, 0x73, 0x0b, 0x47, 0x3f,
+	0xfa, 0x8d, 0x02, 0x85, 0xe4, 0xc8, 0x8f, 0x36, 0x61, 0xbd, 0x6d, 0xb4, 0xda, 0xad, 0x4e, 0xed,
+	0xd0, 0x32, 0x9f, 0xb7, 0x75, 0xeb, 0xd9, 0x71, 0xa7, 0xad, 0xd7, 0x9b, 0x4f, 0x9a, 0x7a, 0x43,
+	0x9d, 0x43, 0x1b, 0x70, 0x77, 0x52, 0xdd, 0x31, 0x6b, 0xc7, 0x8d, 0x9a, 0xd1, 0x50, 0x15, 0xf4,
+	0x06, 0x6c, 0x4e, 0xea, 0x8e, 0x9e, 0x1d, 0x9a, 0xcd, 0xf6, 0xa1, 0x6e, 0xd5, 0x0f, 0x5a, 0xcd,
+	0xba, 0xae, 0xa6, 0xd0, 0x7d, 0xd0, 0x26, 0x21, 0xad, 0xb6, 0xd9, 0x3c, 0x6a, 0x76, 0xcc, 0x66,
+	0x5d, 0x4d, 0x3f, 0xfa, 0x8f, 0x02, 0x90, 0xf8, 0x22, 0x71, 0x0f, 0xd6, 0x4e, 0x5a, 0xa6, 0xc0,
+	0xb4, 0x8e, 0xa7, 0x1c, 0x59, 0x81, 0xa5, 0xa4, 0xf2, 0xb9, 0xde, 0x51, 0x15, 0xb4, 0x06, 0x2b,
+	0x49, 0x61, 0x6d, 0xbf, 0x63, 0xd6, 0x9a, 0xc7, 0x6a, 0x0a, 0x21, 0x28, 0x25, 0x15, 0xc7, 0x2d,
+	0x35, 0xcd, 0x7c, 0x99, 0x94, 0x59, 0xa7, 0x4d, 0xf3, 0xc0, 0x3a, 0xd1, 0xcd, 0x96, 0x9a, 0x99,
+	0xb6, 0xdf, 0x3a, 0xd6, 0x55, 0x65, 0x5a, 0x68, 0x9e, 0xb6, 0xd4, 0x14, 0xba, 0x03, 0xcb, 0x13,
+	0xc2, 0x03, 0x43, 0xd7, 0xd5, 0x34, 0x5a, 0x05, 0x35, 0x29, 0x7e, 0xd2, 0x7a, 0x66, 0xa8, 0x99,
+	0x8d, 0x94, 0xaa, 0x3c, 0xfa, 0x9f, 0x02, 0xa5, 0xc9, 0x0f, 0x00, 0x68, 0x1b, 0xee, 0x45, 0x71,
+	0xe9, 0x98, 0x35, 0xf3, 0x59, 0x67, 0x6a, 0xbb, 0x65, 0xd8, 0x9a, 0x06, 0x34, 0xf4, 0x76, 0xab,
+	0xd3, 0x34, 0xad, 0xb6, 0x6e, 0x34, 0x5b, 0xd3, 0xf1, 0x97, 0x98, 0x93, 0x96, 0xd9, 0x3c, 0xfe,
+	0x49, 0x08, 0x49, 0x4d, 0xa4, 0x4f, 0x42, 0xda, 0xb5, 0x4e, 0x47, 0x6f, 0x88, 0x78, 0x4c, 0xeb,
+	0x0c, 0xfd, 0xa9, 0x5e, 0x37, 0xf5, 0x86, 0x9a, 0x99, 0xc5, 0x7c, 0x52, 0x6b, 0x1e, 0xea, 0x0d,
+	0x75, 0x9e, 0x25, 0x6a, 0x5a, 0x67, 0xd6, 0x0e, 0x0f, 0x99, 0xe7, 0xd9, 0x7d, 0xfd, 0xcb, 0x97,
+	0x5b, 0xca, 0x57, 0x2f, 0xb7, 0x94, 0x7f, 0xbf, 0xdc, 0x52, 0x3e, 0x7f, 0xb5, 0x35, 0xf7, 0xd5,
+	0xab, 0xad, 0xb9, 0x7f, 0xbc, 0xda, 0x9a, 0xfb, 0xd9, 0x9b, 0x3d, 0x97, 0xf6, 0xc7, 0x67, 0x95,
+	0x2e, 0xf1, 0xe4, 0x37, 0x2b, 0xf9, 0xef, 0x71, 0xe0, 0x7c, 0x5a, 0xbd, 0xe0, 0xdf, 0xe1, 0xd8,
+	0x9b, 0x6a, 0xc0, 0x3e, 0xb2, 0x65, 0x79, 0x21, 0xbf, 0xfb, 0xff, 0x01, 0x00, 0xc6, 0x1e, 0x0f,
+	0xe8, 0xa5, 0x13, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -1132,21 +1601,56 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Expedited {
-		i--
-		if m.Expedited {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.FinalOptionTallyResults) > 0 {
+		for iNdEx := len(m.FinalOptionTallyResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalOptionTallyResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
 		}
-		i--
-		dAtA[i] = 0x70
 	}
-	if len(m.Proposer) > 0 {
-		i -= len(m.Proposer)
-		copy(dAtA[i:], m.Proposer)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
-		i--
+	if m.VoteOptions != nil {
+		{
+			size, err := m.VoteOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.ProposalType != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalType))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.Expedited {
+		i--
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
+		i--
 		dAtA[i] = 0x6a
 	}
 	if len(m.Summary) > 0 {
@@ -1171,22 +1675,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 	}
 	if m.VotingEndTime != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
-		if err1 != nil {
-			return 0, err1
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
+		if err2 != nil {
+			return 0, err2
 		}
-		i -= n1
-		i = encodeVarintGov(dAtA, i, uint64(n1))
+		i -= n2
+		i = encodeVarintGov(dAtA, i, uint64(n2))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.VotingStartTime != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
-		if err2 != nil {
-			return 0, err2
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
+		if err3 != nil {
+			return 0, err3
 		}
-		i -= n2
-		i = encodeVarintGov(dAtA, i, uint64(n2))
+		i -= n3
+		i = encodeVarintGov(dAtA, i, uint64(n3))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -1205,22 +1709,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.DepositEndTime != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
-		if err3 != nil {
-			return 0, err3
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i -= n3
-		i = encodeVarintGov(dAtA, i, uint64(n3))
+		i -= n4
+		i = encodeVarintGov(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x32
 	}
 	if m.SubmitTime != nil {
-		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
-		if err4 != nil {
-			return 0, err4
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n4
-		i = encodeVarintGov(dAtA, i, uint64(n4))
+		i -= n5
+		i = encodeVarintGov(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -1263,6 +1767,38 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProposalVoteOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalVoteOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalVoteOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Options[iNdEx])
+			copy(dAtA[i:], m.Options[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.Options[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TallyResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1314,6 +1850,136 @@ func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TallySnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TallySnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallySnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OptionTallyResults) > 0 {
+		for iNdEx := len(m.OptionTallyResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OptionTallyResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.TallyTime != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.TallyTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TallyTime):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintGov(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TotalBondedTokens) > 0 {
+		i -= len(m.TotalBondedTokens)
+		copy(dAtA[i:], m.TotalBondedTokens)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.TotalBondedTokens)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.DelegatorTally != nil {
+		{
+			size, err := m.DelegatorTally.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ValidatorTally != nil {
+		{
+			size, err := m.ValidatorTally.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Tally != nil {
+		{
+			size, err := m.Tally.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OptionTallyResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OptionTallyResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OptionTallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Count) > 0 {
+		i -= len(m.Count)
+		copy(dAtA[i:], m.Count)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Count)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Option != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Vote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1391,12 +2057,12 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.MaxDepositPeriod != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err6 != nil {
-			return 0, err6
+		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err11 != nil {
+			return 0, err11
 		}
-		i -= n6
-		i = encodeVarintGov(dAtA, i, uint64(n6))
+		i -= n11
+		i = encodeVarintGov(dAtA, i, uint64(n11))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1438,12 +2104,12 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VotingPeriod != nil {
-		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err7 != nil {
-			return 0, err7
+		n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err12 != nil {
+			return 0, err12
 		}
-		i -= n7
-		i = encodeVarintGov(dAtA, i, uint64(n7))
+		i -= n12
+		i = encodeVarintGov(dAtA, i, uint64(n12))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -1514,6 +2180,35 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OptimisticRejectedThreshold) > 0 {
+		i -= len(m.OptimisticRejectedThreshold)
+		copy(dAtA[i:], m.OptimisticRejectedThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptimisticRejectedThreshold)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.OptimisticAuthorizedAddresses) > 0 {
+		for iNdEx := len(m.OptimisticAuthorizedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OptimisticAuthorizedAddresses[iNdEx])
+			copy(dAtA[i:], m.OptimisticAuthorizedAddresses[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.OptimisticAuthorizedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.ProposalCancelMaxPeriod) > 0 {
+		i -= len(m.ProposalCancelMaxPeriod)
+		copy(dAtA[i:], m.ProposalCancelMaxPeriod)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelMaxPeriod)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	if m.BurnVoteVeto {
 		i--
 		if m.BurnVoteVeto {
@@ -1566,12 +2261,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x5a
 	}
 	if m.ExpeditedVotingPeriod != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
-		if err8 != nil {
-			return 0, err8
+		n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
+		if err13 != nil {
+			return 0, err13
 		}
-		i -= n8
-		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i -= n13
+		i = encodeVarintGov(dAtA, i, uint64(n13))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -1618,22 +2313,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if m.VotingPeriod != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err9 != nil {
-			return 0, err9
+		n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err14 != nil {
+			return 0, err14
 		}
-		i -= n9
-		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i -= n14
+		i = encodeVarintGov(dAtA, i, uint64(n14))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.MaxDepositPeriod != nil {
-		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err10 != nil {
-			return 0, err10
+		n15, err15 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err15 != nil {
+			return 0, err15
 		}
-		i -= n10
-		i = encodeVarintGov(dAtA, i, uint64(n10))
+		i -= n15
+		i = encodeVarintGov(dAtA, i, uint64(n15))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1654,6 +2349,61 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScheduledParamChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledParamChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledParamChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Time != nil {
+		n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintGov(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -1766,6 +2516,34 @@ func (m *Proposal) Size() (n int) {
 	if m.Expedited {
 		n += 2
 	}
+	if m.ProposalType != 0 {
+		n += 1 + sovGov(uint64(m.ProposalType))
+	}
+	if m.VoteOptions != nil {
+		l = m.VoteOptions.Size()
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if len(m.FinalOptionTallyResults) > 0 {
+		for _, e := range m.FinalOptionTallyResults {
+			l = e.Size()
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProposalVoteOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Options) > 0 {
+		for _, s := range m.Options {
+			l = len(s)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1794,6 +2572,60 @@ func (m *TallyResult) Size() (n int) {
 	return n
 }
 
+func (m *TallySnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	if m.Tally != nil {
+		l = m.Tally.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.ValidatorTally != nil {
+		l = m.ValidatorTally.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.DelegatorTally != nil {
+		l = m.DelegatorTally.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.TotalBondedTokens)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.TallyTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TallyTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.OptionTallyResults) > 0 {
+		for _, e := range m.OptionTallyResults {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OptionTallyResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != 0 {
+		n += 1 + sovGov(uint64(m.Option))
+	}
+	l = len(m.Count)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
 func (m *Vote) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1940,6 +2772,43 @@ func (m *Params) Size() (n int) {
 	if m.BurnVoteVeto {
 		n += 2
 	}
+	l = len(m.ProposalCancelMaxPeriod)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if len(m.OptimisticAuthorizedAddresses) > 0 {
+		for _, s := range m.OptimisticAuthorizedAddresses {
+			l = len(s)
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.OptimisticRejectedThreshold)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledParamChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGov(uint64(m.Height))
+	}
+	if m.Time != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -2648,6 +3517,177 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Expedited = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalType", wireType)
+			}
+			m.ProposalType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalType |= ProposalType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VoteOptions == nil {
+				m.VoteOptions = &ProposalVoteOptions{}
+			}
+			if err := m.VoteOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalOptionTallyResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalOptionTallyResults = append(m.FinalOptionTallyResults, &OptionTallyResult{})
+			if err := m.FinalOptionTallyResults[len(m.FinalOptionTallyResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalVoteOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalVoteOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalVoteOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2766,7 +3806,400 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
 			}
-			var stringLen uint64
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NoCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVetoCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NoWithVetoCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallySnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallySnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallySnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tally == nil {
+				m.Tally = &TallyResult{}
+			}
+			if err := m.Tally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorTally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidatorTally == nil {
+				m.ValidatorTally = &TallyResult{}
+			}
+			if err := m.ValidatorTally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorTally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DelegatorTally == nil {
+				m.DelegatorTally = &TallyResult{}
+			}
+			if err := m.DelegatorTally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBondedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalBondedTokens = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TallyTime == nil {
+				m.TallyTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.TallyTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionTallyResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionTallyResults = append(m.OptionTallyResults, &OptionTallyResult{})
+			if err := m.OptionTallyResults[len(m.OptionTallyResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OptionTallyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OptionTallyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OptionTallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2776,27 +4209,14 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Option |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NoCount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVetoCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2824,7 +4244,7 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NoWithVetoCount = string(dAtA[iNdEx:postIndex])
+			m.Count = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3855,6 +5275,262 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.BurnVoteVeto = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelMaxPeriod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalCancelMaxPeriod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticAuthorizedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptimisticAuthorizedAddresses = append(m.OptimisticAuthorizedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticRejectedThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptimisticRejectedThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledParamChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledParamChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledParamChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &types1.Any{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
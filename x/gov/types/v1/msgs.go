@@ -1,6 +1,8 @@
 package v1
 
 import (
+	"time"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
@@ -10,9 +12,9 @@ import (
 )
 
 var (
-	_, _, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgCancelProposal{}
-	_, _, _, _, _, _, _ legacytx.LegacyMsg                 = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgCancelProposal{}
-	_, _                codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
+	_, _, _, _, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgCancelProposal{}, &MsgSubmitMultipleChoiceProposal{}, &MsgScheduleParamChange{}
+	_, _, _, _, _, _, _, _, _ legacytx.LegacyMsg                 = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgCancelProposal{}, &MsgSubmitMultipleChoiceProposal{}, &MsgScheduleParamChange{}
+	_, _, _                   codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}, &MsgScheduleParamChange{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
@@ -193,3 +195,73 @@ func (msg MsgCancelProposal) GetSigners() []sdk.AccAddress {
 	proposer, _ := sdk.AccAddressFromBech32(msg.Proposer)
 	return []sdk.AccAddress{proposer}
 }
+
+// NewMultipleChoiceMsgSubmitProposal creates a new MsgSubmitMultipleChoiceProposal.
+func NewMultipleChoiceMsgSubmitProposal(
+	initialDeposit sdk.Coins,
+	proposer, metadata, title, summary string,
+	votingOptions *ProposalVoteOptions,
+) *MsgSubmitMultipleChoiceProposal {
+	return &MsgSubmitMultipleChoiceProposal{
+		InitialDeposit: initialDeposit,
+		Proposer:       proposer,
+		Metadata:       metadata,
+		Title:          title,
+		Summary:        summary,
+		VoteOptions:    votingOptions,
+	}
+}
+
+// GetSignBytes implements Msg
+func (m MsgSubmitMultipleChoiceProposal) GetSignBytes() []byte {
+	bz := codec.Amino.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (m MsgSubmitMultipleChoiceProposal) GetSigners() []sdk.AccAddress {
+	proposer, _ := sdk.AccAddressFromBech32(m.Proposer)
+	return []sdk.AccAddress{proposer}
+}
+
+// NewMsgScheduleParamChange creates a new MsgScheduleParamChange instance.
+func NewMsgScheduleParamChange(authority string, msg sdk.Msg, height int64, t *time.Time) (*MsgScheduleParamChange, error) {
+	anys, err := sdktx.SetMsgs([]sdk.Msg{msg})
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgScheduleParamChange{
+		Authority: authority,
+		Msg:       anys[0],
+		Height:    height,
+		Time:      t,
+	}, nil
+}
+
+// GetUpdateParamsMsg unpacks the scheduled MsgUpdateParams message.
+func (msg MsgScheduleParamChange) GetUpdateParamsMsg() (sdk.Msg, error) {
+	msgs, err := sdktx.GetMsgs([]*codectypes.Any{msg.Msg}, "sdk.MsgParamChange")
+	if err != nil {
+		return nil, err
+	}
+
+	return msgs[0], nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgScheduleParamChange) GetSignBytes() []byte {
+	bz := codec.Amino.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgScheduleParamChange) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgScheduleParamChange) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, []*codectypes.Any{msg.Msg})
+}
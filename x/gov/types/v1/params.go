@@ -18,18 +18,20 @@ const (
 
 // Default governance params
 var (
-	DefaultMinDepositTokens          = sdkmath.NewInt(10000000)
-	DefaultMinExpeditedDepositTokens = DefaultMinDepositTokens.Mul(sdkmath.NewInt(DefaultMinExpeditedDepositTokensRatio))
-	DefaultQuorum                    = sdkmath.LegacyNewDecWithPrec(334, 3)
-	DefaultThreshold                 = sdkmath.LegacyNewDecWithPrec(5, 1)
-	DefaultExpeditedThreshold        = sdkmath.LegacyNewDecWithPrec(667, 3)
-	DefaultVetoThreshold             = sdkmath.LegacyNewDecWithPrec(334, 3)
-	DefaultMinInitialDepositRatio    = sdkmath.LegacyZeroDec()
-	DefaultProposalCancelRatio       = sdkmath.LegacyMustNewDecFromStr("0.5")
-	DefaultProposalCancelDestAddress = ""
-	DefaultBurnProposalPrevote       = false // set to false to replicate behavior of when this change was made (0.47)
-	DefaultBurnVoteQuorom            = false // set to false to  replicate behavior of when this change was made (0.47)
-	DefaultBurnVoteVeto              = true  // set to true to replicate behavior of when this change was made (0.47)
+	DefaultMinDepositTokens            = sdkmath.NewInt(10000000)
+	DefaultMinExpeditedDepositTokens   = DefaultMinDepositTokens.Mul(sdkmath.NewInt(DefaultMinExpeditedDepositTokensRatio))
+	DefaultQuorum                      = sdkmath.LegacyNewDecWithPrec(334, 3)
+	DefaultThreshold                   = sdkmath.LegacyNewDecWithPrec(5, 1)
+	DefaultExpeditedThreshold          = sdkmath.LegacyNewDecWithPrec(667, 3)
+	DefaultVetoThreshold               = sdkmath.LegacyNewDecWithPrec(334, 3)
+	DefaultMinInitialDepositRatio      = sdkmath.LegacyZeroDec()
+	DefaultProposalCancelRatio         = sdkmath.LegacyMustNewDecFromStr("0.5")
+	DefaultProposalCancelDestAddress   = ""
+	DefaultProposalCancelMaxPeriod     = sdkmath.LegacyMustNewDecFromStr("0.5")
+	DefaultOptimisticRejectedThreshold = sdkmath.LegacyNewDecWithPrec(1, 1)
+	DefaultBurnProposalPrevote         = false // set to false to replicate behavior of when this change was made (0.47)
+	DefaultBurnVoteQuorom              = false // set to false to  replicate behavior of when this change was made (0.47)
+	DefaultBurnVoteVeto                = true  // set to true to replicate behavior of when this change was made (0.47)
 )
 
 // Deprecated: NewDepositParams creates a new DepositParams object
@@ -59,24 +61,28 @@ func NewVotingParams(votingPeriod *time.Duration) VotingParams {
 // NewParams creates a new Params instance with given values.
 func NewParams(
 	minDeposit, expeditedminDeposit sdk.Coins, maxDepositPeriod, votingPeriod, expeditedVotingPeriod time.Duration,
-	quorum, threshold, expeditedThreshold, vetoThreshold, minInitialDepositRatio, proposalCancelRatio, proposalCancelDest string, burnProposalDeposit, burnVoteQuorum, burnVoteVeto bool,
+	quorum, threshold, expeditedThreshold, vetoThreshold, minInitialDepositRatio, proposalCancelRatio, proposalCancelDest, proposalCancelMaxPeriod, optimisticRejectedThreshold string, burnProposalDeposit, burnVoteQuorum, burnVoteVeto bool,
+	optimisticAuthorizedAddresses []string,
 ) Params {
 	return Params{
-		MinDeposit:                 minDeposit,
-		ExpeditedMinDeposit:        expeditedminDeposit,
-		MaxDepositPeriod:           &maxDepositPeriod,
-		VotingPeriod:               &votingPeriod,
-		ExpeditedVotingPeriod:      &expeditedVotingPeriod,
-		Quorum:                     quorum,
-		Threshold:                  threshold,
-		ExpeditedThreshold:         expeditedThreshold,
-		VetoThreshold:              vetoThreshold,
-		MinInitialDepositRatio:     minInitialDepositRatio,
-		ProposalCancelRatio:        proposalCancelRatio,
-		ProposalCancelDest:         proposalCancelDest,
-		BurnProposalDepositPrevote: burnProposalDeposit,
-		BurnVoteQuorum:             burnVoteQuorum,
-		BurnVoteVeto:               burnVoteVeto,
+		MinDeposit:                    minDeposit,
+		ExpeditedMinDeposit:           expeditedminDeposit,
+		MaxDepositPeriod:              &maxDepositPeriod,
+		VotingPeriod:                  &votingPeriod,
+		ExpeditedVotingPeriod:         &expeditedVotingPeriod,
+		Quorum:                        quorum,
+		Threshold:                     threshold,
+		ExpeditedThreshold:            expeditedThreshold,
+		VetoThreshold:                 vetoThreshold,
+		MinInitialDepositRatio:        minInitialDepositRatio,
+		ProposalCancelRatio:           proposalCancelRatio,
+		ProposalCancelDest:            proposalCancelDest,
+		ProposalCancelMaxPeriod:       proposalCancelMaxPeriod,
+		OptimisticRejectedThreshold:   optimisticRejectedThreshold,
+		OptimisticAuthorizedAddresses: optimisticAuthorizedAddresses,
+		BurnProposalDepositPrevote:    burnProposalDeposit,
+		BurnVoteQuorum:                burnVoteQuorum,
+		BurnVoteVeto:                  burnVoteVeto,
 	}
 }
 
@@ -95,9 +101,12 @@ func DefaultParams() Params {
 		DefaultMinInitialDepositRatio.String(),
 		DefaultProposalCancelRatio.String(),
 		DefaultProposalCancelDestAddress,
+		DefaultProposalCancelMaxPeriod.String(),
+		DefaultOptimisticRejectedThreshold.String(),
 		DefaultBurnProposalPrevote,
 		DefaultBurnVoteQuorom,
 		DefaultBurnVoteVeto,
+		nil,
 	)
 }
 
@@ -215,5 +224,33 @@ func (p Params) ValidateBasic() error {
 		}
 	}
 
+	proposalCancelMaxPeriod, err := sdkmath.LegacyNewDecFromStr(p.ProposalCancelMaxPeriod)
+	if err != nil {
+		return fmt.Errorf("invalid max cancel period of proposal: %w", err)
+	}
+	if proposalCancelMaxPeriod.IsNegative() {
+		return fmt.Errorf("max cancel period of proposal must be positive: %s", proposalCancelMaxPeriod)
+	}
+	if proposalCancelMaxPeriod.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("max cancel period of proposal is too large: %s", proposalCancelMaxPeriod)
+	}
+
+	optimisticRejectedThreshold, err := sdkmath.LegacyNewDecFromStr(p.OptimisticRejectedThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid optimistic rejected threshold string: %w", err)
+	}
+	if !optimisticRejectedThreshold.IsPositive() {
+		return fmt.Errorf("optimistic rejected threshold must be positive: %s", optimisticRejectedThreshold)
+	}
+	if optimisticRejectedThreshold.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("optimistic rejected threshold too large: %s", optimisticRejectedThreshold)
+	}
+
+	for _, addr := range p.OptimisticAuthorizedAddresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid optimistic authorized address: %s", addr)
+		}
+	}
+
 	return nil
 }
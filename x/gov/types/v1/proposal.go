@@ -5,6 +5,8 @@ import (
 	"strings"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
@@ -20,6 +22,7 @@ const (
 	StatusPassed        = ProposalStatus_PROPOSAL_STATUS_PASSED
 	StatusRejected      = ProposalStatus_PROPOSAL_STATUS_REJECTED
 	StatusFailed        = ProposalStatus_PROPOSAL_STATUS_FAILED
+	StatusTallied       = ProposalStatus_PROPOSAL_STATUS_TALLIED
 )
 
 // NewProposal creates a new Proposal instance
@@ -63,6 +66,45 @@ func (p Proposal) GetMinDepositFromParams(params Params) sdk.Coins {
 	return params.MinDeposit
 }
 
+// GetCancelDeadlineFromParams returns the time from which the proposal can no
+// longer be canceled by its proposer, i.e. the start of its voting period plus
+// the max cancel period fraction of the voting period from the gov params.
+// It returns nil if the proposal has not entered its voting period.
+func (p Proposal) GetCancelDeadlineFromParams(params Params) *time.Time {
+	if p.VotingStartTime == nil || p.VotingEndTime == nil {
+		return nil
+	}
+
+	maxCancelPeriod := sdkmath.LegacyMustNewDecFromStr(params.ProposalCancelMaxPeriod)
+	votingPeriod := p.VotingEndTime.Sub(*p.VotingStartTime)
+	cancelPeriod := sdkmath.LegacyNewDec(int64(votingPeriod)).Mul(maxCancelPeriod).TruncateInt64()
+	deadline := p.VotingStartTime.Add(time.Duration(cancelPeriod))
+
+	return &deadline
+}
+
+// IsMultipleChoice returns true if the proposal is a multiple choice proposal.
+func (p Proposal) IsMultipleChoice() bool {
+	return p.ProposalType == ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE
+}
+
+// IsOptimistic returns true if the proposal is an optimistic proposal.
+func (p Proposal) IsOptimistic() bool {
+	return p.ProposalType == ProposalType_PROPOSAL_TYPE_OPTIMISTIC
+}
+
+// GetValidVoteOptions returns the vote options of the proposal: the custom options
+// of a multiple choice proposal, or the standard options otherwise.
+func (p Proposal) GetValidVoteOptions() *ProposalVoteOptions {
+	if p.IsMultipleChoice() && p.VoteOptions != nil {
+		return p.VoteOptions
+	}
+
+	return &ProposalVoteOptions{
+		Options: []string{"yes", "abstain", "no", "no_with_veto"},
+	}
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (p Proposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	return sdktx.UnpackInterfaces(unpacker, p.Messages)
@@ -126,8 +168,37 @@ func ValidProposalStatus(status ProposalStatus) bool {
 		status == StatusVotingPeriod ||
 		status == StatusPassed ||
 		status == StatusRejected ||
-		status == StatusFailed {
+		status == StatusFailed ||
+		status == StatusTallied {
 		return true
 	}
 	return false
 }
+
+// ValidateBasic performs basic validation of the vote options of a multiple
+// choice proposal. At least two options must be defined, and the options must
+// be non-empty and unique.
+func (o ProposalVoteOptions) ValidateBasic() error {
+	if len(o.Options) < 2 {
+		return fmt.Errorf("a multiple choice proposal must have at least two vote options, got %d", len(o.Options))
+	}
+
+	seen := make(map[string]bool, len(o.Options))
+	for i, option := range o.Options {
+		if option == "" {
+			return fmt.Errorf("vote option %d cannot be empty", i+1)
+		}
+		if seen[option] {
+			return fmt.Errorf("duplicated vote option %s", option)
+		}
+		seen[option] = true
+	}
+
+	return nil
+}
+
+// HasOption returns true if the given vote option is the 1-based index of one
+// of the vote options.
+func (o ProposalVoteOptions) HasOption(option VoteOption) bool {
+	return option > OptionEmpty && int(option) <= len(o.Options)
+}
@@ -80,3 +80,29 @@ func TestProposalGetMinDepositFromParams(t *testing.T) {
 		require.Equal(t, tc.expectedMinDeposit, actualMinDeposit[0].Amount)
 	}
 }
+
+func TestProposalGetValidVoteOptions(t *testing.T) {
+	proposal, err := v1.NewProposal([]sdk.Msg{}, 1, time.Now(), time.Now(), "", "title", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false)
+	require.NoError(t, err)
+
+	// a standard proposal is voted on with the standard options
+	voteOptions := proposal.GetValidVoteOptions()
+	require.Equal(t, []string{"yes", "abstain", "no", "no_with_veto"}, voteOptions.Options)
+	require.True(t, voteOptions.HasOption(v1.OptionNoWithVeto))
+	require.False(t, voteOptions.HasOption(v1.VoteOption(5)))
+	require.False(t, voteOptions.HasOption(v1.OptionEmpty))
+
+	// a multiple choice proposal is voted on with the index of its options
+	proposal.ProposalType = v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE
+	proposal.VoteOptions = &v1.ProposalVoteOptions{Options: []string{"A", "B", "C", "D", "E", "F"}}
+	voteOptions = proposal.GetValidVoteOptions()
+	require.True(t, voteOptions.HasOption(v1.VoteOption(6)))
+	require.False(t, voteOptions.HasOption(v1.VoteOption(7)))
+	require.False(t, voteOptions.HasOption(v1.VoteOption(-1)))
+
+	option, err := v1.VoteOptionFromString("6")
+	require.NoError(t, err)
+	require.Equal(t, v1.VoteOption(6), option)
+	_, err = v1.VoteOptionFromString("0")
+	require.Error(t, err)
+}
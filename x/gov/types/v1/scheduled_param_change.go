@@ -0,0 +1,98 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// paramChangeMsgSuffix is the suffix of the type URL of the messages which can be scheduled
+const paramChangeMsgSuffix = ".MsgUpdateParams"
+
+// NewScheduledParamChange creates a new ScheduledParamChange instance
+func NewScheduledParamChange(id uint64, msg sdk.Msg, height int64, t *time.Time) (ScheduledParamChange, error) {
+	msgs, err := sdktx.SetMsgs([]sdk.Msg{msg})
+	if err != nil {
+		return ScheduledParamChange{}, err
+	}
+
+	return ScheduledParamChange{
+		Id:     id,
+		Msg:    msgs[0],
+		Height: height,
+		Time:   t,
+	}, nil
+}
+
+// GetUpdateParamsMsg returns the MsgUpdateParams message of the scheduled param change
+func (c ScheduledParamChange) GetUpdateParamsMsg() (sdk.Msg, error) {
+	msgs, err := sdktx.GetMsgs([]*types.Any{c.Msg}, "sdk.MsgParamChange")
+	if err != nil {
+		return nil, err
+	}
+
+	return msgs[0], nil
+}
+
+// IsDue returns true if the scheduled param change must be executed at the
+// given block height and time.
+func (c ScheduledParamChange) IsDue(height int64, blockTime time.Time) bool {
+	if c.Time != nil {
+		return !blockTime.Before(*c.Time)
+	}
+
+	return height >= c.Height
+}
+
+// ValidateBasic performs a stateless validation of the scheduled param change
+func (c ScheduledParamChange) ValidateBasic() error {
+	if c.Msg == nil {
+		return errors.New("scheduled param change message cannot be empty")
+	}
+
+	if !strings.HasSuffix(c.Msg.TypeUrl, paramChangeMsgSuffix) {
+		return fmt.Errorf("only %s messages can be scheduled, got %s", paramChangeMsgSuffix[1:], c.Msg.TypeUrl)
+	}
+
+	return ValidateParamChangeSchedule(c.Height, c.Time)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (c ScheduledParamChange) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, []*types.Any{c.Msg})
+}
+
+// ValidateParamChangeSchedule checks that exactly one of height and time is set
+func ValidateParamChangeSchedule(height int64, t *time.Time) error {
+	switch {
+	case height < 0:
+		return fmt.Errorf("height must be positive: %d", height)
+	case height == 0 && t == nil:
+		return errors.New("one of height and time must be set")
+	case height > 0 && t != nil:
+		return errors.New("only one of height and time can be set")
+	}
+
+	return nil
+}
+
+// ScheduledParamChanges is an array of scheduled param changes
+type ScheduledParamChanges []*ScheduledParamChange
+
+var _ types.UnpackInterfacesMessage = ScheduledParamChanges{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (c ScheduledParamChanges) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, x := range c {
+		err := x.UnpackInterfaces(unpacker)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
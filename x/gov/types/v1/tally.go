@@ -1,6 +1,8 @@
 package v1
 
 import (
+	"time"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -52,6 +54,35 @@ func EmptyTallyResult() TallyResult {
 	return NewTallyResult(math.ZeroInt(), math.ZeroInt(), math.ZeroInt(), math.ZeroInt())
 }
 
+// NewOptionTallyResult creates a new OptionTallyResult instance
+func NewOptionTallyResult(option VoteOption, count math.Int) *OptionTallyResult {
+	return &OptionTallyResult{
+		Option: uint32(option),
+		Count:  count.String(),
+	}
+}
+
+// NewOptionTallyResultsFromMap creates the tally results of the first numOptions
+// options of a multiple choice proposal from a Option -> Dec map
+func NewOptionTallyResultsFromMap(results map[VoteOption]sdk.Dec, numOptions int) []*OptionTallyResult {
+	optionResults := make([]*OptionTallyResult, numOptions)
+	for i := range optionResults {
+		option := VoteOption(i + 1)
+		count := math.ZeroInt()
+		if result, ok := results[option]; ok {
+			count = result.TruncateInt()
+		}
+		optionResults[i] = NewOptionTallyResult(option, count)
+	}
+	return optionResults
+}
+
+// EmptyOptionTallyResults returns the empty tally results of the first
+// numOptions options of a multiple choice proposal.
+func EmptyOptionTallyResults(numOptions int) []*OptionTallyResult {
+	return NewOptionTallyResultsFromMap(nil, numOptions)
+}
+
 // Equals returns if two tally results are equal.
 func (tr TallyResult) Equals(comp TallyResult) bool {
 	return tr.YesCount == comp.YesCount &&
@@ -59,3 +90,15 @@ func (tr TallyResult) Equals(comp TallyResult) bool {
 		tr.NoCount == comp.NoCount &&
 		tr.NoWithVetoCount == comp.NoWithVetoCount
 }
+
+// NewTallySnapshot creates a new TallySnapshot instance
+func NewTallySnapshot(proposalID uint64, tally, validatorTally, delegatorTally TallyResult, totalBondedTokens math.Int, tallyTime time.Time) TallySnapshot {
+	return TallySnapshot{
+		ProposalId:        proposalID,
+		Tally:             &tally,
+		ValidatorTally:    &validatorTally,
+		DelegatorTally:    &delegatorTally,
+		TotalBondedTokens: totalBondedTokens.String(),
+		TallyTime:         &tallyTime,
+	}
+}
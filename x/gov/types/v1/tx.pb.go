@@ -60,6 +60,11 @@ type MsgSubmitProposal struct {
 	//
 	// Since: cosmos-sdk 0.48
 	Expedited bool `protobuf:"varint,7,opt,name=expedited,proto3" json:"expedited,omitempty"`
+	// optimistic defines if the proposal is optimistic, i.e. passes unless it is
+	// rejected by the optimistic rejected threshold of the voting power.
+	//
+	// Since: cosmos-sdk 0.50
+	Optimistic bool `protobuf:"varint,8,opt,name=optimistic,proto3" json:"optimistic,omitempty"`
 }
 
 func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
@@ -144,6 +149,13 @@ func (m *MsgSubmitProposal) GetExpedited() bool {
 	return false
 }
 
+func (m *MsgSubmitProposal) GetOptimistic() bool {
+	if m != nil {
+		return m.Optimistic
+	}
+	return false
+}
+
 // MsgSubmitProposalResponse defines the Msg/SubmitProposal response type.
 type MsgSubmitProposalResponse struct {
 	// proposal_id defines the unique id of the proposal.
@@ -824,6 +836,275 @@ func (m *MsgCancelProposalResponse) GetCanceledHeight() uint64 {
 	return 0
 }
 
+// MsgSubmitMultipleChoiceProposal defines a message to submit a multiple choice proposal.
+//
+// Since: cosmos-sdk 0.50
+type MsgSubmitMultipleChoiceProposal struct {
+	// initial_deposit is the deposit value that must be paid at proposal submission.
+	InitialDeposit []types1.Coin `protobuf:"bytes,1,rep,name=initial_deposit,json=initialDeposit,proto3" json:"initial_deposit"`
+	// proposer is the account address of the proposer.
+	Proposer string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// metadata is any arbitrary metadata attached to the proposal.
+	Metadata string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// title is the title of the proposal.
+	Title string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	// summary is the summary of the proposal
+	Summary string `protobuf:"bytes,5,opt,name=summary,proto3" json:"summary,omitempty"`
+	// vote_options defines the vote options for the proposal.
+	VoteOptions *ProposalVoteOptions `protobuf:"bytes,6,opt,name=vote_options,json=voteOptions,proto3" json:"vote_options,omitempty"`
+}
+
+func (m *MsgSubmitMultipleChoiceProposal) Reset()         { *m = MsgSubmitMultipleChoiceProposal{} }
+func (m *MsgSubmitMultipleChoiceProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitMultipleChoiceProposal) ProtoMessage()    {}
+func (*MsgSubmitMultipleChoiceProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{14}
+}
+func (m *MsgSubmitMultipleChoiceProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitMultipleChoiceProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitMultipleChoiceProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitMultipleChoiceProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitMultipleChoiceProposal.Merge(m, src)
+}
+func (m *MsgSubmitMultipleChoiceProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitMultipleChoiceProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitMultipleChoiceProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitMultipleChoiceProposal proto.InternalMessageInfo
+
+func (m *MsgSubmitMultipleChoiceProposal) GetInitialDeposit() []types1.Coin {
+	if m != nil {
+		return m.InitialDeposit
+	}
+	return nil
+}
+
+func (m *MsgSubmitMultipleChoiceProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *MsgSubmitMultipleChoiceProposal) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+func (m *MsgSubmitMultipleChoiceProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *MsgSubmitMultipleChoiceProposal) GetSummary() string {
+	if m != nil {
+		return m.Summary
+	}
+	return ""
+}
+
+func (m *MsgSubmitMultipleChoiceProposal) GetVoteOptions() *ProposalVoteOptions {
+	if m != nil {
+		return m.VoteOptions
+	}
+	return nil
+}
+
+// MsgSubmitMultipleChoiceProposalResponse defines the Msg/SubmitMultipleChoiceProposal response type.
+//
+// Since: cosmos-sdk 0.50
+type MsgSubmitMultipleChoiceProposalResponse struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *MsgSubmitMultipleChoiceProposalResponse) Reset() {
+	*m = MsgSubmitMultipleChoiceProposalResponse{}
+}
+func (m *MsgSubmitMultipleChoiceProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitMultipleChoiceProposalResponse) ProtoMessage()    {}
+func (*MsgSubmitMultipleChoiceProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{15}
+}
+func (m *MsgSubmitMultipleChoiceProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitMultipleChoiceProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitMultipleChoiceProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitMultipleChoiceProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitMultipleChoiceProposalResponse.Merge(m, src)
+}
+func (m *MsgSubmitMultipleChoiceProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitMultipleChoiceProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitMultipleChoiceProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitMultipleChoiceProposalResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitMultipleChoiceProposalResponse) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// MsgScheduleParamChange is the Msg/ScheduleParamChange request type.
+//
+// Since: cosmos-sdk 0.50
+type MsgScheduleParamChange struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// msg is the MsgUpdateParams message executed once the change is due.
+	Msg *types.Any `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// height is the block height at which the change is executed.
+	// Exactly one of height and time must be set.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the change is executed.
+	// Exactly one of height and time must be set.
+	Time *time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time,omitempty"`
+}
+
+func (m *MsgScheduleParamChange) Reset()         { *m = MsgScheduleParamChange{} }
+func (m *MsgScheduleParamChange) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleParamChange) ProtoMessage()    {}
+func (*MsgScheduleParamChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{16}
+}
+func (m *MsgScheduleParamChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleParamChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleParamChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleParamChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleParamChange.Merge(m, src)
+}
+func (m *MsgScheduleParamChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleParamChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleParamChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleParamChange proto.InternalMessageInfo
+
+func (m *MsgScheduleParamChange) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgScheduleParamChange) GetMsg() *types.Any {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *MsgScheduleParamChange) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MsgScheduleParamChange) GetTime() *time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+// MsgScheduleParamChangeResponse defines the response structure for executing a
+// MsgScheduleParamChange message.
+//
+// Since: cosmos-sdk 0.50
+type MsgScheduleParamChangeResponse struct {
+	// id defines the unique id of the scheduled param change.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgScheduleParamChangeResponse) Reset()         { *m = MsgScheduleParamChangeResponse{} }
+func (m *MsgScheduleParamChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleParamChangeResponse) ProtoMessage()    {}
+func (*MsgScheduleParamChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{17}
+}
+func (m *MsgScheduleParamChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleParamChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleParamChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleParamChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleParamChangeResponse.Merge(m, src)
+}
+func (m *MsgScheduleParamChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleParamChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleParamChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleParamChangeResponse proto.InternalMessageInfo
+
+func (m *MsgScheduleParamChangeResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos.gov.v1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "cosmos.gov.v1.MsgSubmitProposalResponse")
@@ -839,78 +1120,95 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.gov.v1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgCancelProposal)(nil), "cosmos.gov.v1.MsgCancelProposal")
 	proto.RegisterType((*MsgCancelProposalResponse)(nil), "cosmos.gov.v1.MsgCancelProposalResponse")
+	proto.RegisterType((*MsgSubmitMultipleChoiceProposal)(nil), "cosmos.gov.v1.MsgSubmitMultipleChoiceProposal")
+	proto.RegisterType((*MsgSubmitMultipleChoiceProposalResponse)(nil), "cosmos.gov.v1.MsgSubmitMultipleChoiceProposalResponse")
+	proto.RegisterType((*MsgScheduleParamChange)(nil), "cosmos.gov.v1.MsgScheduleParamChange")
+	proto.RegisterType((*MsgScheduleParamChangeResponse)(nil), "cosmos.gov.v1.MsgScheduleParamChangeResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/tx.proto", fileDescriptor_9ff8f4a63b6fc9a9) }
 
 var fileDescriptor_9ff8f4a63b6fc9a9 = []byte{
-	// 1042 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xe6, 0xc3, 0x4e, 0x26, 0x4d, 0xa2, 0xac, 0xdc, 0x76, 0xbd, 0x2a, 0x6b, 0x77, 0x8b,
-	0xc0, 0x4a, 0xc8, 0x2e, 0x0e, 0xb4, 0x42, 0xa6, 0x42, 0xaa, 0x43, 0x05, 0x95, 0x30, 0x54, 0x5b,
-	0x28, 0x12, 0x42, 0x8a, 0xc6, 0xde, 0x61, 0xb3, 0xc2, 0xbb, 0xb3, 0xf2, 0x8c, 0xad, 0xf8, 0x86,
-	0x38, 0xf6, 0xd4, 0x3f, 0x83, 0x63, 0x0e, 0xbd, 0xf5, 0xc4, 0xad, 0xe2, 0x54, 0x71, 0xe2, 0x54,
-	0x50, 0x22, 0x08, 0xe2, 0x9f, 0x00, 0xcd, 0xc7, 0xae, 0xf7, 0x2b, 0x1f, 0x70, 0xe0, 0x62, 0xed,
-	0xfc, 0xde, 0xc7, 0xbc, 0xf7, 0x7b, 0xf3, 0xde, 0x33, 0xb8, 0x36, 0xc0, 0x24, 0xc0, 0xc4, 0xf6,
-	0xf0, 0xc4, 0x9e, 0xb4, 0x6d, 0x7a, 0x68, 0x45, 0x23, 0x4c, 0xb1, 0xba, 0x26, 0x70, 0xcb, 0xc3,
-	0x13, 0x6b, 0xd2, 0xd6, 0x0d, 0xa9, 0xd6, 0x87, 0x04, 0xd9, 0x93, 0x76, 0x1f, 0x51, 0xd8, 0xb6,
-	0x07, 0xd8, 0x0f, 0x85, 0xba, 0x7e, 0x3d, 0xeb, 0x86, 0x59, 0x09, 0x41, 0xcd, 0xc3, 0x1e, 0xe6,
-	0x9f, 0x36, 0xfb, 0x92, 0x68, 0x5d, 0xa8, 0xef, 0x0b, 0x81, 0xbc, 0x4a, 0x8a, 0x3c, 0x8c, 0xbd,
-	0x21, 0xb2, 0xf9, 0xa9, 0x3f, 0xfe, 0xc6, 0x86, 0xe1, 0x34, 0x77, 0x49, 0x40, 0x3c, 0x76, 0x49,
-	0x40, 0x3c, 0x29, 0xd8, 0x84, 0x81, 0x1f, 0x62, 0x9b, 0xff, 0x4a, 0xa8, 0x91, 0x77, 0x43, 0xfd,
-	0x00, 0x11, 0x0a, 0x83, 0x48, 0x28, 0x98, 0xa7, 0xf3, 0x60, 0xb3, 0x47, 0xbc, 0x47, 0xe3, 0x7e,
-	0xe0, 0xd3, 0x87, 0x23, 0x1c, 0x61, 0x02, 0x87, 0xea, 0xdb, 0x60, 0x39, 0x40, 0x84, 0x40, 0x0f,
-	0x11, 0x4d, 0x69, 0x2e, 0xb4, 0x56, 0x77, 0x6b, 0x96, 0xf0, 0x64, 0xc5, 0x9e, 0xac, 0x7b, 0xe1,
-	0xd4, 0x49, 0xb4, 0xd4, 0x1e, 0xd8, 0xf0, 0x43, 0x9f, 0xfa, 0x70, 0xb8, 0xef, 0xa2, 0x08, 0x13,
-	0x9f, 0x6a, 0xf3, 0xdc, 0xb0, 0x6e, 0xc9, 0xbc, 0x18, 0x67, 0x96, 0xe4, 0xcc, 0xda, 0xc3, 0x7e,
-	0xd8, 0x5d, 0x79, 0xf1, 0xaa, 0x31, 0xf7, 0xc3, 0xe9, 0xd1, 0x96, 0xe2, 0xac, 0x4b, 0xe3, 0x0f,
-	0x85, 0xad, 0xfa, 0x2e, 0x58, 0x8e, 0x78, 0x30, 0x68, 0xa4, 0x2d, 0x34, 0x95, 0xd6, 0x4a, 0x57,
-	0xfb, 0xf9, 0xd9, 0x4e, 0x4d, 0xba, 0xba, 0xe7, 0xba, 0x23, 0x44, 0xc8, 0x23, 0x3a, 0xf2, 0x43,
-	0xcf, 0x49, 0x34, 0x55, 0x9d, 0x85, 0x4d, 0xa1, 0x0b, 0x29, 0xd4, 0x16, 0x99, 0x95, 0x93, 0x9c,
-	0xd5, 0x1a, 0x58, 0xa2, 0x3e, 0x1d, 0x22, 0x6d, 0x89, 0x0b, 0xc4, 0x41, 0xd5, 0x40, 0x95, 0x8c,
-	0x83, 0x00, 0x8e, 0xa6, 0x5a, 0x85, 0xe3, 0xf1, 0x51, 0xbd, 0x01, 0x56, 0xd0, 0x61, 0x84, 0x5c,
-	0x9f, 0x22, 0x57, 0xab, 0x36, 0x95, 0xd6, 0xb2, 0x33, 0x03, 0x3a, 0xed, 0xef, 0x4f, 0x8f, 0xb6,
-	0x92, 0x8b, 0x9f, 0x9c, 0x1e, 0x6d, 0x35, 0x44, 0x6c, 0x3b, 0xc4, 0xfd, 0x96, 0x55, 0xa5, 0xc0,
-	0xa9, 0x79, 0x17, 0xd4, 0x0b, 0xa0, 0x83, 0x48, 0x84, 0x43, 0x82, 0xd4, 0x06, 0x58, 0x8d, 0x24,
-	0xb6, 0xef, 0xbb, 0x9a, 0xd2, 0x54, 0x5a, 0x8b, 0x0e, 0x88, 0xa1, 0x07, 0xae, 0xf9, 0x5c, 0x01,
-	0xb5, 0x1e, 0xf1, 0xee, 0x1f, 0xa2, 0xc1, 0x27, 0xc8, 0x83, 0x83, 0xe9, 0x1e, 0x0e, 0x29, 0x0a,
-	0xa9, 0xfa, 0x29, 0xa8, 0x0e, 0xc4, 0x27, 0xb7, 0x3a, 0xa3, 0x52, 0x5d, 0xe3, 0xa7, 0x67, 0x3b,
-	0x7a, 0xe6, 0x31, 0xc7, 0x85, 0xe0, 0xb6, 0x4e, 0xec, 0x84, 0xe5, 0x0d, 0xc7, 0xf4, 0x00, 0x8f,
-	0x7c, 0x3a, 0xd5, 0xe6, 0x39, 0x27, 0x33, 0xa0, 0x73, 0x9b, 0xe5, 0x3d, 0x3b, 0xb3, 0xc4, 0xcd,
-	0x42, 0xe2, 0x85, 0x20, 0x4d, 0x03, 0xdc, 0x28, 0xc3, 0xe3, 0xf4, 0xcd, 0xdf, 0x15, 0x50, 0xed,
-	0x11, 0xef, 0x31, 0xa6, 0x48, 0xbd, 0x5d, 0x42, 0x45, 0xb7, 0xf6, 0xd7, 0xab, 0x46, 0x1a, 0x16,
-	0xaf, 0x26, 0x45, 0x90, 0x6a, 0x81, 0xa5, 0x09, 0xa6, 0x68, 0x24, 0x62, 0x3e, 0xe7, 0xb9, 0x08,
-	0x35, 0xb5, 0x0d, 0x2a, 0x38, 0xa2, 0x3e, 0x0e, 0xf9, 0xfb, 0x5a, 0x9f, 0xbd, 0x53, 0xc1, 0x8e,
-	0xc5, 0x62, 0xf9, 0x8c, 0x2b, 0x38, 0x52, 0xf1, 0xbc, 0xe7, 0xd5, 0x79, 0x9d, 0x11, 0x23, 0x5c,
-	0x33, 0x52, 0xae, 0x16, 0x48, 0x61, 0xfe, 0xcc, 0x4d, 0xb0, 0x21, 0x3f, 0x93, 0xd4, 0xff, 0x56,
-	0x12, 0xec, 0x4b, 0xe4, 0x7b, 0x07, 0x14, 0xb9, 0xff, 0x17, 0x05, 0xef, 0x83, 0xaa, 0xc8, 0x8c,
-	0x68, 0x0b, 0xbc, 0x57, 0x6f, 0xe6, 0x38, 0x88, 0x03, 0x4a, 0x71, 0x11, 0x5b, 0x9c, 0x4b, 0xc6,
-	0x5b, 0x59, 0x32, 0x5e, 0x2b, 0x25, 0x23, 0x76, 0x6e, 0xd6, 0xc1, 0xf5, 0x1c, 0x94, 0x90, 0xf3,
-	0x87, 0x02, 0x40, 0x8f, 0x78, 0xf1, 0x54, 0xf8, 0x8f, 0xbc, 0xdc, 0x01, 0x2b, 0x72, 0x26, 0xe1,
-	0x8b, 0xb9, 0x99, 0xa9, 0xaa, 0x77, 0x41, 0x05, 0x06, 0x78, 0x1c, 0x52, 0x49, 0xcf, 0xe5, 0x46,
-	0x99, 0xb4, 0xe9, 0x6c, 0xf3, 0x56, 0x49, 0xbc, 0x31, 0x22, 0xb4, 0x02, 0x11, 0x32, 0x33, 0xb3,
-	0x06, 0xd4, 0xd9, 0x29, 0x49, 0xff, 0xb9, 0x78, 0x1b, 0x5f, 0x44, 0x2e, 0xa4, 0xe8, 0x21, 0x1c,
-	0xc1, 0x80, 0xb0, 0x64, 0x66, 0xfd, 0xa9, 0x5c, 0x94, 0x4c, 0xa2, 0xaa, 0xbe, 0x07, 0x2a, 0x11,
-	0xf7, 0xc0, 0x19, 0x58, 0xdd, 0xbd, 0x9a, 0xab, 0xb5, 0x70, 0x9f, 0x49, 0x44, 0xe8, 0x77, 0xee,
-	0x14, 0x7b, 0xfe, 0x56, 0x2a, 0x91, 0xc3, 0x78, 0xdb, 0xe5, 0x22, 0x95, 0x75, 0x4d, 0x43, 0x49,
-	0x62, 0x4f, 0x14, 0xbe, 0x75, 0xf6, 0x60, 0x38, 0x40, 0xc3, 0xd4, 0xd6, 0x29, 0x29, 0xef, 0x46,
-	0xae, 0xbc, 0x99, 0xca, 0xa6, 0xd7, 0xc4, 0xfc, 0x65, 0xd7, 0x44, 0x67, 0x2d, 0x33, 0xbc, 0xcd,
-	0x1f, 0x15, 0x3e, 0x99, 0xb3, 0xc1, 0x24, 0x93, 0xf9, 0xdf, 0x07, 0xf5, 0x00, 0xac, 0x0d, 0xb8,
-	0x2f, 0xe4, 0xee, 0xb3, 0x75, 0x2b, 0x09, 0xd7, 0x0b, 0x73, 0xf9, 0xf3, 0x78, 0x17, 0x77, 0x97,
-	0x19, 0xeb, 0x4f, 0x7f, 0x6d, 0x28, 0xce, 0x95, 0xd8, 0x94, 0x09, 0xd5, 0x37, 0xc1, 0x46, 0xe2,
-	0xea, 0x80, 0x37, 0x07, 0x9f, 0x56, 0x8b, 0xce, 0x7a, 0x0c, 0x7f, 0xcc, 0xd1, 0xdd, 0x3f, 0x17,
-	0xc1, 0x42, 0x8f, 0x78, 0xea, 0xd7, 0x60, 0x3d, 0xb7, 0xca, 0x9b, 0xb9, 0x3a, 0x17, 0x76, 0x90,
-	0xde, 0xba, 0x48, 0x23, 0xe1, 0x02, 0x81, 0xcd, 0xe2, 0x02, 0xba, 0x55, 0x34, 0x2f, 0x28, 0xe9,
-	0xdb, 0x97, 0x50, 0x4a, 0xae, 0xf9, 0x00, 0x2c, 0xf2, 0x4d, 0x70, 0xad, 0x68, 0xc4, 0x70, 0xdd,
-	0x28, 0xc7, 0x13, 0xfb, 0xc7, 0xe0, 0x4a, 0x66, 0x9c, 0x9e, 0xa1, 0x1f, 0xcb, 0xf5, 0x37, 0xce,
-	0x97, 0x27, 0x7e, 0x3f, 0x02, 0xd5, 0x78, 0x12, 0xd5, 0x8b, 0x26, 0x52, 0xa4, 0xdf, 0x3c, 0x53,
-	0x94, 0x0e, 0x30, 0xd3, 0xd3, 0x25, 0x01, 0xa6, 0xe5, 0x65, 0x01, 0x96, 0xb5, 0x15, 0xab, 0x7e,
-	0xae, 0xa5, 0x4a, 0xaa, 0x9f, 0xd5, 0x28, 0xab, 0x7e, 0x79, 0x27, 0xe8, 0x4b, 0xdf, 0xb1, 0xb1,
-	0xd0, 0xbd, 0xff, 0xe2, 0xd8, 0x50, 0x5e, 0x1e, 0x1b, 0xca, 0x6f, 0xc7, 0x86, 0xf2, 0xf4, 0xc4,
-	0x98, 0x7b, 0x79, 0x62, 0xcc, 0xfd, 0x72, 0x62, 0xcc, 0x7d, 0xb5, 0xed, 0xf9, 0xf4, 0x60, 0xdc,
-	0xb7, 0x06, 0x38, 0x90, 0x7f, 0x66, 0xed, 0xc2, 0x9c, 0xa0, 0xd3, 0x08, 0x11, 0xf6, 0xd7, 0xb9,
-	0xc2, 0xdb, 0xe0, 0x9d, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xda, 0xbe, 0x64, 0x9b, 0x7a, 0x0b,
-	0x00, 0x00,
+	// 1262 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x8f, 0xdb, 0x44,
+	0x14, 0x5f, 0x27, 0xd9, 0x64, 0xf7, 0x6d, 0x9b, 0xd5, 0x9a, 0x74, 0xeb, 0x58, 0xc5, 0xd9, 0xba,
+	0xff, 0xa2, 0x96, 0x75, 0x9a, 0xd2, 0x16, 0x14, 0x2a, 0xa4, 0x66, 0xa9, 0xa0, 0x88, 0x40, 0xe5,
+	0x42, 0x91, 0x50, 0xa5, 0x95, 0x37, 0x1e, 0x1c, 0xab, 0xb1, 0xc7, 0xca, 0x4c, 0xa2, 0xcd, 0x0d,
+	0x71, 0x00, 0xa9, 0xa7, 0x7e, 0x0c, 0x8e, 0x3d, 0xf4, 0xd6, 0x13, 0xb7, 0x8a, 0x53, 0xc5, 0x89,
+	0x53, 0x41, 0xad, 0xa0, 0x12, 0x47, 0xbe, 0x40, 0xd1, 0x8c, 0xed, 0x49, 0x1c, 0x3b, 0x9b, 0x6d,
+	0x85, 0xb8, 0x44, 0x9e, 0xf7, 0x7e, 0xef, 0xcd, 0x7b, 0xbf, 0x79, 0xf3, 0xde, 0x04, 0x36, 0xbb,
+	0x98, 0x78, 0x98, 0x34, 0x1c, 0x3c, 0x6a, 0x8c, 0x9a, 0x0d, 0xba, 0x6f, 0x04, 0x03, 0x4c, 0xb1,
+	0x7c, 0x34, 0x94, 0x1b, 0x0e, 0x1e, 0x19, 0xa3, 0xa6, 0xaa, 0x45, 0xb0, 0x3d, 0x8b, 0xa0, 0xc6,
+	0xa8, 0xb9, 0x87, 0xa8, 0xd5, 0x6c, 0x74, 0xb1, 0xeb, 0x87, 0x70, 0xf5, 0x78, 0xd2, 0x0d, 0xb3,
+	0x0a, 0x15, 0x15, 0x07, 0x3b, 0x98, 0x7f, 0x36, 0xd8, 0x57, 0x24, 0xad, 0x86, 0xf0, 0xdd, 0x50,
+	0x11, 0x6d, 0x15, 0xa9, 0x1c, 0x8c, 0x9d, 0x3e, 0x6a, 0xf0, 0xd5, 0xde, 0xf0, 0xdb, 0x86, 0xe5,
+	0x8f, 0x67, 0x36, 0xf1, 0x88, 0xc3, 0x36, 0xf1, 0x88, 0x13, 0x29, 0x36, 0x2c, 0xcf, 0xf5, 0x71,
+	0x83, 0xff, 0x46, 0xa2, 0xda, 0xac, 0x1b, 0xea, 0x7a, 0x88, 0x50, 0xcb, 0x0b, 0x42, 0x80, 0xfe,
+	0x63, 0x1e, 0x36, 0x3a, 0xc4, 0xb9, 0x3d, 0xdc, 0xf3, 0x5c, 0x7a, 0x6b, 0x80, 0x03, 0x4c, 0xac,
+	0xbe, 0x7c, 0x11, 0x56, 0x3c, 0x44, 0x88, 0xe5, 0x20, 0xa2, 0x48, 0x5b, 0xf9, 0xfa, 0xda, 0xa5,
+	0x8a, 0x11, 0x7a, 0x32, 0x62, 0x4f, 0xc6, 0x75, 0x7f, 0x6c, 0x0a, 0x94, 0xdc, 0x81, 0x75, 0xd7,
+	0x77, 0xa9, 0x6b, 0xf5, 0x77, 0x6d, 0x14, 0x60, 0xe2, 0x52, 0x25, 0xc7, 0x0d, 0xab, 0x46, 0x94,
+	0x17, 0xe3, 0xcc, 0x88, 0x38, 0x33, 0x76, 0xb0, 0xeb, 0xb7, 0x57, 0x9f, 0x3c, 0xab, 0x2d, 0xfd,
+	0xf4, 0xf2, 0xe1, 0x79, 0xc9, 0x2c, 0x47, 0xc6, 0x1f, 0x85, 0xb6, 0xf2, 0x65, 0x58, 0x09, 0x78,
+	0x30, 0x68, 0xa0, 0xe4, 0xb7, 0xa4, 0xfa, 0x6a, 0x5b, 0xf9, 0xf5, 0xd1, 0x76, 0x25, 0x72, 0x75,
+	0xdd, 0xb6, 0x07, 0x88, 0x90, 0xdb, 0x74, 0xe0, 0xfa, 0x8e, 0x29, 0x90, 0xb2, 0xca, 0xc2, 0xa6,
+	0x96, 0x6d, 0x51, 0x4b, 0x29, 0x30, 0x2b, 0x53, 0xac, 0xe5, 0x0a, 0x2c, 0x53, 0x97, 0xf6, 0x91,
+	0xb2, 0xcc, 0x15, 0xe1, 0x42, 0x56, 0xa0, 0x44, 0x86, 0x9e, 0x67, 0x0d, 0xc6, 0x4a, 0x91, 0xcb,
+	0xe3, 0xa5, 0x7c, 0x02, 0x56, 0xd1, 0x7e, 0x80, 0x6c, 0x97, 0x22, 0x5b, 0x29, 0x6d, 0x49, 0xf5,
+	0x15, 0x73, 0x22, 0x90, 0x35, 0x00, 0x1c, 0x50, 0xd7, 0x73, 0x09, 0x75, 0xbb, 0xca, 0x0a, 0x57,
+	0x4f, 0x49, 0x5a, 0xcd, 0xef, 0x5f, 0x3e, 0x3c, 0x2f, 0x02, 0xbb, 0xff, 0xf2, 0xe1, 0xf9, 0x5a,
+	0x18, 0xfb, 0x36, 0xb1, 0xef, 0xb1, 0x53, 0x4b, 0x71, 0xae, 0x5f, 0x83, 0x6a, 0x4a, 0x68, 0x22,
+	0x12, 0x60, 0x9f, 0x20, 0xb9, 0x06, 0x6b, 0x41, 0x24, 0xdb, 0x75, 0x6d, 0x45, 0xda, 0x92, 0xea,
+	0x05, 0x13, 0x62, 0xd1, 0x4d, 0x5b, 0x7f, 0x2c, 0x41, 0xa5, 0x43, 0x9c, 0x1b, 0xfb, 0xa8, 0xfb,
+	0x19, 0x72, 0xac, 0xee, 0x78, 0x07, 0xfb, 0x14, 0xf9, 0x54, 0xfe, 0x1c, 0x4a, 0xdd, 0xf0, 0x93,
+	0x5b, 0xcd, 0x39, 0xc9, 0xb6, 0xf6, 0xcb, 0xa3, 0x6d, 0x35, 0x51, 0xec, 0xf1, 0x41, 0x71, 0x5b,
+	0x33, 0x76, 0xc2, 0x78, 0xb1, 0x86, 0xb4, 0x87, 0x07, 0x2e, 0x1d, 0x2b, 0x39, 0xce, 0xd9, 0x44,
+	0xd0, 0xba, 0xc2, 0xf2, 0x9e, 0xac, 0x59, 0xe2, 0x7a, 0x2a, 0xf1, 0x54, 0x90, 0xba, 0x06, 0x27,
+	0xb2, 0xe4, 0x71, 0xfa, 0xfa, 0x9f, 0x12, 0x94, 0x3a, 0xc4, 0xb9, 0x83, 0x29, 0x92, 0xaf, 0x64,
+	0x50, 0xd1, 0xae, 0xfc, 0xfd, 0xac, 0x36, 0x2d, 0x0e, 0xab, 0x6a, 0x8a, 0x20, 0xd9, 0x80, 0xe5,
+	0x11, 0xa6, 0x68, 0xa0, 0xe4, 0x16, 0x94, 0x53, 0x08, 0x93, 0x9b, 0x50, 0x64, 0xe7, 0x89, 0x7d,
+	0x5e, 0x7f, 0xe5, 0x49, 0x1d, 0x87, 0xec, 0x18, 0x2c, 0x96, 0x2f, 0x38, 0xc0, 0x8c, 0x80, 0x07,
+	0x95, 0x5f, 0xeb, 0x34, 0x23, 0x26, 0x74, 0xcd, 0x48, 0x39, 0x96, 0x22, 0x85, 0xf9, 0xd3, 0x37,
+	0x60, 0x3d, 0xfa, 0x14, 0xa9, 0xbf, 0x92, 0x84, 0xec, 0x6b, 0xe4, 0x3a, 0x3d, 0x56, 0x7d, 0xff,
+	0x13, 0x05, 0x1f, 0x40, 0x29, 0xcc, 0x8c, 0x28, 0x79, 0x7e, 0x97, 0x4f, 0xce, 0x70, 0x10, 0x07,
+	0x34, 0xc5, 0x45, 0x6c, 0x71, 0x20, 0x19, 0xef, 0x24, 0xc9, 0x78, 0x3b, 0x93, 0x8c, 0xd8, 0xb9,
+	0x5e, 0x85, 0xe3, 0x33, 0x22, 0x41, 0xce, 0x5f, 0x12, 0x40, 0x87, 0x38, 0x71, 0xd7, 0x78, 0x43,
+	0x5e, 0xae, 0xc2, 0x6a, 0xd4, 0xb3, 0xf0, 0x62, 0x6e, 0x26, 0x50, 0xf9, 0x1a, 0x14, 0x2d, 0x0f,
+	0x0f, 0x7d, 0x1a, 0xd1, 0x73, 0xb8, 0x56, 0x17, 0xd9, 0xb4, 0x2e, 0xf0, 0xab, 0x22, 0xbc, 0x31,
+	0x22, 0x94, 0x14, 0x11, 0x51, 0x66, 0x7a, 0x05, 0xe4, 0xc9, 0x4a, 0xa4, 0xff, 0x38, 0xac, 0x8d,
+	0xaf, 0x02, 0xdb, 0xa2, 0xe8, 0x96, 0x35, 0xb0, 0x3c, 0xc2, 0x92, 0x99, 0xdc, 0x4f, 0x69, 0x51,
+	0x32, 0x02, 0x2a, 0xbf, 0x0f, 0xc5, 0x80, 0x7b, 0xe0, 0x0c, 0xac, 0x5d, 0x3a, 0x36, 0x73, 0xd6,
+	0xa1, 0xfb, 0x44, 0x22, 0x21, 0xbe, 0x75, 0x35, 0x7d, 0xe7, 0x4f, 0x4d, 0x25, 0xb2, 0x1f, 0x4f,
+	0xc3, 0x99, 0x48, 0xa3, 0x73, 0x9d, 0x16, 0x89, 0xc4, 0xee, 0x4b, 0x7c, 0x2a, 0xed, 0x58, 0x7e,
+	0x17, 0xf5, 0xa7, 0xa6, 0x52, 0xc6, 0xf1, 0xae, 0xcf, 0x1c, 0x6f, 0xe2, 0x64, 0xa7, 0xc7, 0x48,
+	0xee, 0xb0, 0x63, 0xa4, 0x75, 0x34, 0xd1, 0xbc, 0xf5, 0x9f, 0x25, 0xa8, 0xa6, 0x82, 0x11, 0x9d,
+	0xf9, 0xf5, 0x83, 0xba, 0x09, 0x47, 0xbb, 0xdc, 0x17, 0xb2, 0x77, 0xd9, 0x38, 0x8e, 0x08, 0x57,
+	0x53, 0x7d, 0xf9, 0xcb, 0x78, 0x56, 0xb7, 0x57, 0x18, 0xeb, 0x0f, 0x7e, 0xaf, 0x49, 0xe6, 0x91,
+	0xd8, 0x94, 0x29, 0xe5, 0x73, 0xb0, 0x2e, 0x5c, 0xf5, 0xf8, 0xe5, 0xe0, 0xdd, 0xaa, 0x60, 0x96,
+	0x63, 0xf1, 0x27, 0x5c, 0xaa, 0xff, 0x93, 0x83, 0x9a, 0x98, 0x2e, 0x9d, 0x61, 0x9f, 0xba, 0x41,
+	0x1f, 0xed, 0xf4, 0xb0, 0xdb, 0x45, 0x82, 0xde, 0x8c, 0x11, 0x2e, 0xfd, 0x47, 0x23, 0x3c, 0xf7,
+	0x46, 0x23, 0x3c, 0x3f, 0x6f, 0x84, 0x17, 0xe6, 0x8c, 0xf0, 0xe5, 0xe4, 0x08, 0xbf, 0x01, 0x47,
+	0x58, 0x8f, 0xd9, 0x8d, 0x9b, 0x58, 0x91, 0xf3, 0xac, 0xcf, 0x16, 0x76, 0x94, 0xff, 0xa4, 0x89,
+	0x11, 0x73, 0x6d, 0x34, 0x59, 0xb4, 0xde, 0x4b, 0xcd, 0xf2, 0x33, 0x73, 0x66, 0x79, 0x92, 0x58,
+	0xfd, 0x53, 0x38, 0xb7, 0x80, 0xf3, 0xc3, 0xcf, 0xf7, 0x57, 0x12, 0x6c, 0x32, 0x67, 0xdd, 0x1e,
+	0xb2, 0x87, 0xfd, 0xf0, 0xbe, 0xec, 0xf4, 0x2c, 0xdf, 0x41, 0x6f, 0x7c, 0xe3, 0xcf, 0x42, 0xde,
+	0x23, 0x8e, 0x92, 0x9b, 0xff, 0x2a, 0x30, 0x19, 0x40, 0xde, 0x84, 0xe2, 0x54, 0x6d, 0xe5, 0xcd,
+	0x68, 0x25, 0x5f, 0x86, 0x02, 0x2f, 0xdf, 0xc2, 0xc2, 0xf2, 0x2d, 0xf0, 0xd2, 0xe5, 0xe8, 0x90,
+	0xcd, 0x64, 0xb7, 0x38, 0x9d, 0xa6, 0x33, 0x9d, 0xa6, 0x7e, 0x11, 0xb4, 0x6c, 0x8d, 0x20, 0xb1,
+	0x0c, 0x39, 0xc1, 0x5d, 0xce, 0xb5, 0x2f, 0x3d, 0x2b, 0x42, 0xbe, 0x43, 0x1c, 0xf9, 0x2e, 0x94,
+	0x67, 0xde, 0xb7, 0x5b, 0x33, 0x35, 0x90, 0x7a, 0x78, 0xa9, 0xf5, 0x45, 0x08, 0xb1, 0x2b, 0x82,
+	0x8d, 0xf4, 0xab, 0xeb, 0x54, 0xda, 0x3c, 0x05, 0x52, 0x2f, 0x1c, 0x02, 0x24, 0xb6, 0xf9, 0x10,
+	0x0a, 0xfc, 0xf9, 0xb3, 0x99, 0x36, 0x62, 0x72, 0x55, 0xcb, 0x96, 0x0b, 0xfb, 0x3b, 0x70, 0x24,
+	0xf1, 0x86, 0x98, 0x83, 0x8f, 0xf5, 0xea, 0xd9, 0x83, 0xf5, 0xc2, 0xef, 0xc7, 0x50, 0x8a, 0x6f,
+	0x7c, 0x35, 0x6d, 0x12, 0xa9, 0xd4, 0x93, 0x73, 0x55, 0xd3, 0x01, 0x26, 0x06, 0x59, 0x46, 0x80,
+	0xd3, 0x7a, 0xf5, 0xec, 0xc1, 0x7a, 0xe1, 0xf7, 0x2e, 0x94, 0x67, 0xe6, 0x48, 0xc6, 0xe9, 0x27,
+	0x11, 0x6a, 0x7d, 0x11, 0x42, 0x78, 0xff, 0x41, 0x82, 0x13, 0x07, 0x76, 0x55, 0x63, 0x5e, 0x21,
+	0x65, 0xe3, 0xd5, 0xab, 0xaf, 0x87, 0x17, 0x81, 0xdc, 0x83, 0xb7, 0xb2, 0x9a, 0xc3, 0x99, 0x0c,
+	0x77, 0x69, 0x98, 0xba, 0x7d, 0x28, 0x58, 0xbc, 0x99, 0xba, 0xfc, 0x1d, 0x6b, 0xf9, 0xed, 0x1b,
+	0x4f, 0x9e, 0x6b, 0xd2, 0xd3, 0xe7, 0x9a, 0xf4, 0xc7, 0x73, 0x4d, 0x7a, 0xf0, 0x42, 0x5b, 0x7a,
+	0xfa, 0x42, 0x5b, 0xfa, 0xed, 0x85, 0xb6, 0xf4, 0xcd, 0x05, 0xc7, 0xa5, 0xbd, 0xe1, 0x9e, 0xd1,
+	0xc5, 0x5e, 0xf4, 0xbf, 0xb6, 0x91, 0x7a, 0x12, 0xd0, 0x71, 0x80, 0x08, 0xfb, 0x17, 0x5d, 0xe4,
+	0x2d, 0xe3, 0xdd, 0x7f, 0x07, 0x00, 0xa4, 0x9c, 0x00, 0x35, 0x85, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -945,6 +1243,16 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.48
 	CancelProposal(ctx context.Context, in *MsgCancelProposal, opts ...grpc.CallOption) (*MsgCancelProposalResponse, error)
+	// SubmitMultipleChoiceProposal defines a method to create new multiple choice proposal.
+	//
+	// Since: cosmos-sdk 0.50
+	SubmitMultipleChoiceProposal(ctx context.Context, in *MsgSubmitMultipleChoiceProposal, opts ...grpc.CallOption) (*MsgSubmitMultipleChoiceProposalResponse, error)
+	// ScheduleParamChange defines a governance operation for scheduling a
+	// parameter update at a future height or time.
+	// The authority is defined in the keeper.
+	//
+	// Since: cosmos-sdk 0.50
+	ScheduleParamChange(ctx context.Context, in *MsgScheduleParamChange, opts ...grpc.CallOption) (*MsgScheduleParamChangeResponse, error)
 }
 
 type msgClient struct {
@@ -1018,6 +1326,24 @@ func (c *msgClient) CancelProposal(ctx context.Context, in *MsgCancelProposal, o
 	return out, nil
 }
 
+func (c *msgClient) SubmitMultipleChoiceProposal(ctx context.Context, in *MsgSubmitMultipleChoiceProposal, opts ...grpc.CallOption) (*MsgSubmitMultipleChoiceProposalResponse, error) {
+	out := new(MsgSubmitMultipleChoiceProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Msg/SubmitMultipleChoiceProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleParamChange(ctx context.Context, in *MsgScheduleParamChange, opts ...grpc.CallOption) (*MsgScheduleParamChangeResponse, error) {
+	out := new(MsgScheduleParamChangeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Msg/ScheduleParamChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitProposal defines a method to create new proposal given the messages.
@@ -1040,6 +1366,16 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.48
 	CancelProposal(context.Context, *MsgCancelProposal) (*MsgCancelProposalResponse, error)
+	// SubmitMultipleChoiceProposal defines a method to create new multiple choice proposal.
+	//
+	// Since: cosmos-sdk 0.50
+	SubmitMultipleChoiceProposal(context.Context, *MsgSubmitMultipleChoiceProposal) (*MsgSubmitMultipleChoiceProposalResponse, error)
+	// ScheduleParamChange defines a governance operation for scheduling a
+	// parameter update at a future height or time.
+	// The authority is defined in the keeper.
+	//
+	// Since: cosmos-sdk 0.50
+	ScheduleParamChange(context.Context, *MsgScheduleParamChange) (*MsgScheduleParamChangeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1067,6 +1403,12 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) CancelProposal(ctx context.Context, req *MsgCancelProposal) (*MsgCancelProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelProposal not implemented")
 }
+func (*UnimplementedMsgServer) SubmitMultipleChoiceProposal(ctx context.Context, req *MsgSubmitMultipleChoiceProposal) (*MsgSubmitMultipleChoiceProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitMultipleChoiceProposal not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleParamChange(ctx context.Context, req *MsgScheduleParamChange) (*MsgScheduleParamChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleParamChange not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1198,6 +1540,42 @@ func _Msg_CancelProposal_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitMultipleChoiceProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitMultipleChoiceProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitMultipleChoiceProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Msg/SubmitMultipleChoiceProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitMultipleChoiceProposal(ctx, req.(*MsgSubmitMultipleChoiceProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleParamChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleParamChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleParamChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Msg/ScheduleParamChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleParamChange(ctx, req.(*MsgScheduleParamChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1230,6 +1608,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelProposal",
 			Handler:    _Msg_CancelProposal_Handler,
 		},
+		{
+			MethodName: "SubmitMultipleChoiceProposal",
+			Handler:    _Msg_SubmitMultipleChoiceProposal_Handler,
+		},
+		{
+			MethodName: "ScheduleParamChange",
+			Handler:    _Msg_ScheduleParamChange_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
@@ -1255,6 +1641,16 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Optimistic {
+		i--
+		if m.Optimistic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.Expedited {
 		i--
 		if m.Expedited {
@@ -1777,30 +2173,220 @@ func (m *MsgCancelProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSubmitMultipleChoiceProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSubmitProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSubmitMultipleChoiceProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitMultipleChoiceProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Messages) > 0 {
-		for _, e := range m.Messages {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.InitialDeposit) > 0 {
+	if m.VoteOptions != nil {
+		{
+			size, err := m.VoteOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Summary) > 0 {
+		i -= len(m.Summary)
+		copy(dAtA[i:], m.Summary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Summary)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InitialDeposit) > 0 {
+		for iNdEx := len(m.InitialDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InitialDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitMultipleChoiceProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitMultipleChoiceProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitMultipleChoiceProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleParamChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleParamChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleParamChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Time != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintTx(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleParamChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleParamChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleParamChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSubmitProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.InitialDeposit) > 0 {
 		for _, e := range m.InitialDeposit {
 			l = e.Size()
 			n += 1 + l + sovTx(uint64(l))
@@ -1825,6 +2411,9 @@ func (m *MsgSubmitProposal) Size() (n int) {
 	if m.Expedited {
 		n += 2
 	}
+	if m.Optimistic {
+		n += 2
+	}
 	return n
 }
 
@@ -2021,6 +2610,89 @@ func (m *MsgCancelProposalResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSubmitMultipleChoiceProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InitialDeposit) > 0 {
+		for _, e := range m.InitialDeposit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Summary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.VoteOptions != nil {
+		l = m.VoteOptions.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitMultipleChoiceProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgScheduleParamChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if m.Time != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgScheduleParamChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2272,20 +2944,40 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Expedited = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Optimistic", wireType)
 			}
-			iNdEx += skippy
-		}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Optimistic = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
 
 	if iNdEx > l {
@@ -3521,6 +4213,565 @@ func (m *MsgCancelProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSubmitMultipleChoiceProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitMultipleChoiceProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitMultipleChoiceProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialDeposit = append(m.InitialDeposit, types1.Coin{})
+			if err := m.InitialDeposit[len(m.InitialDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VoteOptions == nil {
+				m.VoteOptions = &ProposalVoteOptions{}
+			}
+			if err := m.VoteOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitMultipleChoiceProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitMultipleChoiceProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitMultipleChoiceProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleParamChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleParamChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleParamChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &types.Any{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleParamChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleParamChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleParamChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
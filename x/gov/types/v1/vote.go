@@ -2,6 +2,7 @@ package v1
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"cosmossdk.io/math"
@@ -15,6 +16,13 @@ const (
 	OptionNo         = VoteOption_VOTE_OPTION_NO
 	OptionNoWithVeto = VoteOption_VOTE_OPTION_NO_WITH_VETO
 	OptionAbstain    = VoteOption_VOTE_OPTION_ABSTAIN
+
+	// OptionOne to OptionFour are the first four vote options of a multiple
+	// choice proposal, which is voted on with the 1-based index of its options.
+	OptionOne   = VoteOption_VOTE_OPTION_ONE
+	OptionTwo   = VoteOption_VOTE_OPTION_TWO
+	OptionThree = VoteOption_VOTE_OPTION_THREE
+	OptionFour  = VoteOption_VOTE_OPTION_FOUR
 )
 
 // NewVote creates a new Vote instance
@@ -61,7 +69,10 @@ func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) *WeightedVoteOptio
 	return &WeightedVoteOption{Option: option, Weight: weight.String()}
 }
 
-// IsValid returns true if the sub vote is valid and false otherwise.
+// IsValid returns true if the sub vote is valid and false otherwise. As a
+// multiple choice proposal has custom options, the option is only checked
+// to be set here, and is checked against the vote options of the proposal
+// when the vote is added.
 func (w *WeightedVoteOption) IsValid() bool {
 	weight, err := math.LegacyNewDecFromStr(w.Weight)
 	if err != nil {
@@ -72,7 +83,7 @@ func (w *WeightedVoteOption) IsValid() bool {
 		return false
 	}
 
-	return ValidVoteOption(w.Option)
+	return w.Option > OptionEmpty
 }
 
 // NewNonSplitVoteOption creates a single option vote with weight 1
@@ -103,6 +114,11 @@ func (v WeightedVoteOptions) String() (out string) {
 // VoteOptionFromString returns a VoteOption from a string. It returns an error
 // if the string is invalid.
 func VoteOptionFromString(str string) (VoteOption, error) {
+	// the options of a multiple choice proposal are given by their 1-based index
+	if index, err := strconv.ParseUint(str, 10, 31); err == nil && index > 0 {
+		return VoteOption(index), nil
+	}
+
 	option, ok := VoteOption_value[str]
 	if !ok {
 		return OptionEmpty, fmt.Errorf("'%s' is not a valid vote option, available options: yes/no/no_with_veto/abstain", str)
@@ -1,6 +1,8 @@
 package group
 
 import (
+	"github.com/cosmos/gogoproto/proto"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -64,6 +66,20 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	)
 }
 
+// RegisterDecisionPolicies registers application defined decision policies as
+// implementations of the DecisionPolicy interface, so that they can be used by
+// group policies alongside the threshold and percentage decision policies.
+// Policies which should be supported by the legacy amino JSON sign mode must
+// also be registered on the application's legacy amino codec.
+func RegisterDecisionPolicies(registry cdctypes.InterfaceRegistry, policies ...DecisionPolicy) {
+	impls := make([]proto.Message, len(policies))
+	for i, policy := range policies {
+		impls[i] = policy
+	}
+
+	registry.RegisterImplementations((*DecisionPolicy)(nil), impls...)
+}
+
 func init() {
 	// Register all Amino interfaces and concrete types on the authz  and gov Amino codec so that this can later be
 	// used to properly serialize MsgGrant, MsgExec and MsgSubmitProposal instances
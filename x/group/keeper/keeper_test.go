@@ -53,6 +53,7 @@ func (s *TestSuite) SetupTest() {
 
 	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
 	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModuleBasic{}, bank.AppModuleBasic{})
+	group.RegisterDecisionPolicies(encCfg.InterfaceRegistry, &unanimityDecisionPolicy{})
 	s.addrs = simtestutil.CreateIncrementalAccounts(6)
 
 	// setup gomock and initialize some globally expected executions
@@ -409,6 +410,81 @@ func (s *TestSuite) createGroupAndGroupPolicy(
 	return policyAddr, groupID
 }
 
+// unanimityDecisionPolicy is an application defined decision policy which
+// allows a proposal only when all the weight of its group voted yes.
+type unanimityDecisionPolicy struct {
+	group.ThresholdDecisionPolicy
+}
+
+var _ group.DecisionPolicyWithContext = &unanimityDecisionPolicy{}
+
+func (*unanimityDecisionPolicy) XXX_MessageName() string {
+	return "cosmos.group.v1.testutil.UnanimityDecisionPolicy"
+}
+
+func (p *unanimityDecisionPolicy) AllowWithContext(_ context.Context, _ group.Proposal, tallyResult group.TallyResult, groupInfo group.GroupInfo) (group.DecisionPolicyResult, error) {
+	if tallyResult.YesCount == groupInfo.TotalWeight {
+		return group.DecisionPolicyResult{Allow: true, Final: true}, nil
+	}
+
+	return group.DecisionPolicyResult{Allow: false, Final: tallyResult.NoCount != "0"}, nil
+}
+
+func (s *TestSuite) TestCustomDecisionPolicy() {
+	addrs := s.addrs
+	members := []group.MemberRequest{
+		{Address: addrs[2].String(), Weight: "2"},
+		{Address: addrs[3].String(), Weight: "1"},
+	}
+
+	// a threshold of 1 would accept the proposal with the first yes vote
+	policy := &unanimityDecisionPolicy{
+		ThresholdDecisionPolicy: *group.NewThresholdDecisionPolicy("1", time.Hour, 0).(*group.ThresholdDecisionPolicy),
+	}
+	policyAddr, _ := s.createGroupAndGroupPolicy(addrs[0], members, policy)
+
+	policyInfo, err := s.groupKeeper.GroupPolicyInfo(s.ctx, &group.QueryGroupPolicyInfoRequest{Address: policyAddr})
+	s.Require().NoError(err)
+	storedPolicy, err := policyInfo.Info.GetDecisionPolicy()
+	s.Require().NoError(err)
+	s.Require().IsType(&unanimityDecisionPolicy{}, storedPolicy)
+
+	proposalRes, err := s.groupKeeper.SubmitProposal(s.ctx, &group.MsgSubmitProposal{
+		GroupPolicyAddress: policyAddr,
+		Proposers:          []string{addrs[2].String()},
+	})
+	s.Require().NoError(err)
+
+	execReq := &group.MsgExec{ProposalId: proposalRes.ProposalId, Executor: addrs[2].String()}
+
+	_, err = s.groupKeeper.Vote(s.ctx, &group.MsgVote{
+		ProposalId: proposalRes.ProposalId,
+		Voter:      addrs[2].String(),
+		Option:     group.VOTE_OPTION_YES,
+	})
+	s.Require().NoError(err)
+
+	// the proposal is not accepted until all the members voted yes
+	execRes, err := s.groupKeeper.Exec(s.ctx, execReq)
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, execRes.Result)
+
+	proposal, err := s.groupKeeper.Proposal(s.ctx, &group.QueryProposalRequest{ProposalId: proposalRes.ProposalId})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_SUBMITTED, proposal.Proposal.Status)
+
+	_, err = s.groupKeeper.Vote(s.ctx, &group.MsgVote{
+		ProposalId: proposalRes.ProposalId,
+		Voter:      addrs[3].String(),
+		Option:     group.VOTE_OPTION_YES,
+	})
+	s.Require().NoError(err)
+
+	execRes, err = s.groupKeeper.Exec(s.ctx, execReq)
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, execRes.Result)
+}
+
 func (s *TestSuite) TestTallyProposalsAtVPEnd() {
 	addrs := s.addrs
 	addr1 := addrs[0]
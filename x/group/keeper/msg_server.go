@@ -765,7 +765,12 @@ func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *group.Proposal, groupInfo g
 		return err
 	}
 
-	result, err := policy.Allow(tallyResult, groupInfo.TotalWeight)
+	var result group.DecisionPolicyResult
+	if policyWithCtx, ok := policy.(group.DecisionPolicyWithContext); ok {
+		result, err = policyWithCtx.AllowWithContext(ctx, *p, tallyResult, groupInfo)
+	} else {
+		result, err = policy.Allow(tallyResult, groupInfo.TotalWeight)
+	}
 	if err != nil {
 		return errorsmod.Wrap(err, "policy allow")
 	}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 
@@ -83,6 +84,11 @@ func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
 	return cdc.MustMarshalJSON(group.NewGenesisState())
 }
 
+// NewGenesisState returns an empty genesis state of the group module.
+func (AppModuleBasic) NewGenesisState() proto.Message {
+	return &group.GenesisState{}
+}
+
 // ValidateGenesis performs genesis state validation for the group module.
 func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config sdkclient.TxEncodingConfig, bz json.RawMessage) error {
 	var data group.GenesisState
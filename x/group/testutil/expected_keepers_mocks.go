@@ -141,6 +141,36 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 	return m.recorder
 }
 
+// AcceptEscrow mocks base method.
+func (m *MockBankKeeper) AcceptEscrow(arg0 context.Context, arg1 *types0.MsgAcceptEscrow) (*types0.MsgAcceptEscrowResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptEscrow", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgAcceptEscrowResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptEscrow indicates an expected call of AcceptEscrow.
+func (mr *MockBankKeeperMockRecorder) AcceptEscrow(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptEscrow", reflect.TypeOf((*MockBankKeeper)(nil).AcceptEscrow), arg0, arg1)
+}
+
+// CreateEscrow mocks base method.
+func (m *MockBankKeeper) CreateEscrow(arg0 context.Context, arg1 *types0.MsgCreateEscrow) (*types0.MsgCreateEscrowResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEscrow", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgCreateEscrowResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEscrow indicates an expected call of CreateEscrow.
+func (mr *MockBankKeeperMockRecorder) CreateEscrow(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEscrow", reflect.TypeOf((*MockBankKeeper)(nil).CreateEscrow), arg0, arg1)
+}
+
 // GetAllBalances mocks base method.
 func (m *MockBankKeeper) GetAllBalances(ctx types.Context, addr types.AccAddress) types.Coins {
 	m.ctrl.T.Helper()
@@ -184,6 +214,21 @@ func (mr *MockBankKeeperMockRecorder) MultiSend(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MultiSend", reflect.TypeOf((*MockBankKeeper)(nil).MultiSend), arg0, arg1)
 }
 
+// RefundEscrow mocks base method.
+func (m *MockBankKeeper) RefundEscrow(arg0 context.Context, arg1 *types0.MsgRefundEscrow) (*types0.MsgRefundEscrowResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundEscrow", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgRefundEscrowResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefundEscrow indicates an expected call of RefundEscrow.
+func (mr *MockBankKeeperMockRecorder) RefundEscrow(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundEscrow", reflect.TypeOf((*MockBankKeeper)(nil).RefundEscrow), arg0, arg1)
+}
+
 // Send mocks base method.
 func (m *MockBankKeeper) Send(arg0 context.Context, arg1 *types0.MsgSend) (*types0.MsgSendResponse, error) {
 	m.ctrl.T.Helper()
@@ -213,6 +258,36 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderMo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
 }
 
+// SetDenomMetadata mocks base method.
+func (m *MockBankKeeper) SetDenomMetadata(arg0 context.Context, arg1 *types0.MsgSetDenomMetadata) (*types0.MsgSetDenomMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDenomMetadata", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgSetDenomMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDenomMetadata indicates an expected call of SetDenomMetadata.
+func (mr *MockBankKeeperMockRecorder) SetDenomMetadata(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomMetadata", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomMetadata), arg0, arg1)
+}
+
+// SetPausedDenoms mocks base method.
+func (m *MockBankKeeper) SetPausedDenoms(arg0 context.Context, arg1 *types0.MsgSetPausedDenoms) (*types0.MsgSetPausedDenomsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPausedDenoms", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgSetPausedDenomsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPausedDenoms indicates an expected call of SetPausedDenoms.
+func (mr *MockBankKeeperMockRecorder) SetPausedDenoms(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPausedDenoms", reflect.TypeOf((*MockBankKeeper)(nil).SetPausedDenoms), arg0, arg1)
+}
+
 // SetSendEnabled mocks base method.
 func (m *MockBankKeeper) SetSendEnabled(arg0 context.Context, arg1 *types0.MsgSetSendEnabled) (*types0.MsgSetSendEnabledResponse, error) {
 	m.ctrl.T.Helper()
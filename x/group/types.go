@@ -1,6 +1,7 @@
 package group
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -47,6 +48,19 @@ type DecisionPolicy interface {
 	Validate(g GroupInfo, config Config) error
 }
 
+// DecisionPolicyWithContext is an optional extension of DecisionPolicy for
+// application defined decision policies whose decision depends on the
+// proposal, its group or the application state (e.g. a token-weighted policy
+// reading a balances snapshot). When a decision policy implements it,
+// AllowWithContext is used instead of Allow to tally the proposal.
+type DecisionPolicyWithContext interface {
+	DecisionPolicy
+
+	// AllowWithContext defines policy-specific logic to allow a proposal to
+	// pass or not, based on its tally result and the group it belongs to.
+	AllowWithContext(ctx context.Context, proposal Proposal, tallyResult TallyResult, groupInfo GroupInfo) (DecisionPolicyResult, error)
+}
+
 // Implements DecisionPolicy Interface
 var _ DecisionPolicy = &ThresholdDecisionPolicy{}
 
@@ -24,6 +24,11 @@ type Keeper struct {
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
+
+	// inflationCalculationFn calculates the inflation rate during BeginBlock.
+	// It is shared by the copies of the keeper, so that it can be set once the
+	// keeper was passed to the module.
+	inflationCalculationFn *types.InflationCalculationFn
 }
 
 // NewKeeper creates a new mint Keeper instance
@@ -41,14 +46,35 @@ func NewKeeper(
 		panic(fmt.Sprintf("the x/%s module account has not been set", types.ModuleName))
 	}
 
+	inflationCalculationFn := types.InflationCalculationFn(types.DefaultInflationCalculationFn)
+
 	return Keeper{
-		cdc:              cdc,
-		storeKey:         key,
-		stakingKeeper:    sk,
-		bankKeeper:       bk,
-		feeCollectorName: feeCollectorName,
-		authority:        authority,
+		cdc:                    cdc,
+		storeKey:               key,
+		stakingKeeper:          sk,
+		bankKeeper:             bk,
+		feeCollectorName:       feeCollectorName,
+		authority:              authority,
+		inflationCalculationFn: &inflationCalculationFn,
+	}
+}
+
+// SetInflationCalculationFn sets the function used to calculate the inflation
+// rate during BeginBlock, e.g. to implement a fixed emission schedule. If fn is
+// nil, types.DefaultInflationCalculationFn is used. The function is set for all
+// the copies of the keeper, and must be set before the chain starts.
+func (k Keeper) SetInflationCalculationFn(fn types.InflationCalculationFn) {
+	if fn == nil {
+		fn = types.DefaultInflationCalculationFn
 	}
+
+	*k.inflationCalculationFn = fn
+}
+
+// CalculateInflation returns the inflation rate for the given minter, params and
+// bonded ratio using the keeper's inflation calculation function.
+func (k Keeper) CalculateInflation(ctx sdk.Context, minter types.Minter, params types.Params, bondedRatio math.LegacyDec) math.LegacyDec {
+	return (*k.inflationCalculationFn)(ctx, minter, params, bondedRatio)
 }
 
 // GetAuthority returns the x/mint module's authority.
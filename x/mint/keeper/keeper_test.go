@@ -139,3 +139,29 @@ func (s *IntegrationTestSuite) TestAliasFunctions() {
 	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, fees).Return(nil)
 	s.Require().Nil(s.mintKeeper.AddCollectedFees(s.ctx, fees))
 }
+
+func (s *IntegrationTestSuite) TestInflationCalculationFn() {
+	minter := s.mintKeeper.GetMinter(s.ctx)
+	params := s.mintKeeper.GetParams(s.ctx)
+	bondedRatio := sdkmath.LegacyNewDecWithPrec(5, 1)
+
+	// the default bonded ratio formula is used when no function is set
+	s.Require().Equal(
+		types.DefaultInflationCalculationFn(s.ctx, minter, params, bondedRatio),
+		s.mintKeeper.CalculateInflation(s.ctx, minter, params, bondedRatio),
+	)
+
+	// the function is set for the copies of the keeper, e.g. the one of the module
+	fixedInflation := sdkmath.LegacyNewDecWithPrec(2, 2)
+	keeperCopy := s.mintKeeper
+	keeperCopy.SetInflationCalculationFn(func(sdk.Context, types.Minter, types.Params, sdkmath.LegacyDec) sdkmath.LegacyDec {
+		return fixedInflation
+	})
+	s.Require().Equal(fixedInflation, s.mintKeeper.CalculateInflation(s.ctx, minter, params, bondedRatio))
+
+	s.mintKeeper.SetInflationCalculationFn(nil)
+	s.Require().Equal(
+		types.DefaultInflationCalculationFn(s.ctx, minter, params, bondedRatio),
+		s.mintKeeper.CalculateInflation(s.ctx, minter, params, bondedRatio),
+	)
+}
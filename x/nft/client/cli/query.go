@@ -10,14 +10,16 @@ import (
 	"cosmossdk.io/x/nft"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/version"
 )
 
 // Flag names and values
 const (
-	FlagOwner   = "owner"
-	FlagClassID = "class-id"
+	FlagOwner     = "owner"
+	FlagClassID   = "class-id"
+	FlagSalePrice = "sale-price"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -39,6 +41,7 @@ func GetQueryCmd(ac address.Codec) *cobra.Command {
 		GetCmdQueryOwner(),
 		GetCmdQueryBalance(),
 		GetCmdQuerySupply(),
+		GetCmdQueryRoyaltyInfo(),
 	)
 	return nftQueryCmd
 }
@@ -268,3 +271,46 @@ func GetCmdQuerySupply() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryRoyaltyInfo implements the query royalty info command.
+func GetCmdQueryRoyaltyInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "royalty [class-id] [nft-id]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "query the royalty of an NFT and the royalty amount owed for a sale price.",
+		Example: fmt.Sprintf(`$ %s query %s royalty <class-id> <nft-id> --%s=1000stake`, version.AppName, nft.ModuleName, FlagSalePrice),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &nft.QueryRoyaltyInfoRequest{
+				ClassId: args[0],
+				Id:      args[1],
+			}
+
+			salePrice, err := cmd.Flags().GetString(FlagSalePrice)
+			if err != nil {
+				return err
+			}
+			if len(salePrice) > 0 {
+				coin, err := sdk.ParseCoinNormalized(salePrice)
+				if err != nil {
+					return err
+				}
+				req.SalePrice = &coin
+			}
+
+			queryClient := nft.NewQueryClient(clientCtx)
+			res, err := queryClient.RoyaltyInfo(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagSalePrice, "", "The sale price to compute the royalty amount for")
+	return cmd
+}
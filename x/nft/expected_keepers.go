@@ -20,3 +20,15 @@ type AccountKeeper interface {
 
 	address.Codec
 }
+
+// NFTHooks defines the hooks called by the nft keeper, allowing other modules
+// to react to or restrict the lifecycle of nfts, e.g. to enforce royalties.
+type NFTHooks interface {
+	// BeforeTransfer is called before an nft is transferred; returning an
+	// error aborts the transfer.
+	BeforeTransfer(ctx context.Context, classID, nftID string, sender, receiver sdk.AccAddress) error
+	// AfterMint is called after an nft is minted.
+	AfterMint(ctx context.Context, classID, nftID string, receiver sdk.AccAddress) error
+	// AfterBurn is called after an nft is burned.
+	AfterBurn(ctx context.Context, classID, nftID string, owner sdk.AccAddress) error
+}
@@ -10,12 +10,18 @@ func ValidateGenesis(data GenesisState, ac address.Codec) error {
 		if len(class.Id) == 0 {
 			return ErrEmptyClassID
 		}
+		if err := class.Royalty.Validate(ac); err != nil {
+			return err
+		}
 	}
 	for _, entry := range data.Entries {
 		for _, nft := range entry.Nfts {
 			if len(nft.Id) == 0 {
 				return ErrEmptyNFTID
 			}
+			if err := nft.Royalty.Validate(ac); err != nil {
+				return err
+			}
 			if _, err := ac.StringToBytes(entry.Owner); err != nil {
 				return err
 			}
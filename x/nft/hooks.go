@@ -0,0 +1,47 @@
+package nft
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ NFTHooks = MultiNFTHooks{}
+
+// MultiNFTHooks combines multiple nft hooks, all hook functions are run in array sequence
+type MultiNFTHooks []NFTHooks
+
+// NewMultiNFTHooks returns a new MultiNFTHooks
+func NewMultiNFTHooks(hooks ...NFTHooks) MultiNFTHooks {
+	return hooks
+}
+
+// BeforeTransfer implements NFTHooks, it stops at the first error.
+func (h MultiNFTHooks) BeforeTransfer(ctx context.Context, classID, nftID string, sender, receiver sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].BeforeTransfer(ctx, classID, nftID, sender, receiver); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterMint implements NFTHooks, it stops at the first error.
+func (h MultiNFTHooks) AfterMint(ctx context.Context, classID, nftID string, receiver sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].AfterMint(ctx, classID, nftID, receiver); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterBurn implements NFTHooks, it stops at the first error.
+func (h MultiNFTHooks) AfterBurn(ctx context.Context, classID, nftID string, owner sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].AfterBurn(ctx, classID, nftID, owner); err != nil {
+			return err
+		}
+	}
+	return nil
+}
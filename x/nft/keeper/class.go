@@ -15,6 +15,9 @@ func (k Keeper) SaveClass(ctx context.Context, class nft.Class) error {
 	if k.HasClass(ctx, class.Id) {
 		return errors.Wrap(nft.ErrClassExists, class.Id)
 	}
+	if err := class.Royalty.Validate(k.ac); err != nil {
+		return err
+	}
 	bz, err := k.cdc.Marshal(&class)
 	if err != nil {
 		return errors.Wrap(err, "Marshal nft.Class failed")
@@ -28,6 +31,9 @@ func (k Keeper) UpdateClass(ctx context.Context, class nft.Class) error {
 	if !k.HasClass(ctx, class.Id) {
 		return errors.Wrap(nft.ErrClassNotExists, class.Id)
 	}
+	if err := class.Royalty.Validate(k.ac); err != nil {
+		return err
+	}
 	bz, err := k.cdc.Marshal(&class)
 	if err != nil {
 		return errors.Wrap(err, "Marshal nft.Class failed")
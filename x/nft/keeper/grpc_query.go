@@ -195,3 +195,35 @@ func (k Keeper) Classes(goCtx context.Context, r *nft.QueryClassesRequest) (*nft
 		Pagination: pageRes,
 	}, nil
 }
+
+// RoyaltyInfo return the royalty of an NFT and the royalty amount owed for a sale price, same as royaltyInfo in ERC2981
+func (k Keeper) RoyaltyInfo(goCtx context.Context, r *nft.QueryRoyaltyInfoRequest) (*nft.QueryRoyaltyInfoResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+	if len(r.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+	if r.SalePrice != nil {
+		if err := r.SalePrice.Validate(); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid sale price: %s", err)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	royalty, err := k.GetRoyaltyInfo(ctx, r.ClassId, r.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &nft.QueryRoyaltyInfoResponse{Royalty: royalty}
+	if royalty != nil && r.SalePrice != nil {
+		amount := royalty.Amount(*r.SalePrice)
+		res.RoyaltyAmount = &amount
+	}
+	return res, nil
+}
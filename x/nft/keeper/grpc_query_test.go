@@ -9,6 +9,8 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"cosmossdk.io/x/nft"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestGRPCQuery(t *testing.T) {
@@ -591,3 +593,95 @@ func (s *TestSuite) TestClasses() {
 		})
 	}
 }
+
+func (s *TestSuite) TestRoyaltyInfo() {
+	classRoyalty := &nft.RoyaltyInfo{Recipient: s.addrs[1].String(), BasisPoints: 500}
+	nftRoyalty := &nft.RoyaltyInfo{Recipient: s.addrs[2].String(), BasisPoints: 250}
+
+	class := nft.Class{
+		Id:      testClassID,
+		Name:    testClassName,
+		Symbol:  testClassSymbol,
+		Royalty: &nft.RoyaltyInfo{Recipient: s.addrs[1].String(), BasisPoints: nft.MaxRoyaltyBasisPoints + 1},
+	}
+	err := s.nftKeeper.SaveClass(s.ctx, class)
+	s.Require().ErrorIs(err, nft.ErrInvalidRoyalty)
+
+	class.Royalty = classRoyalty
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, class))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[0]))
+
+	err = s.nftKeeper.Mint(s.ctx, nft.NFT{
+		ClassId: testClassID,
+		Id:      "kitty2",
+		Royalty: &nft.RoyaltyInfo{Recipient: s.addrs[2].String(), BasisPoints: nft.MaxRoyaltyBasisPoints + 1},
+	}, s.addrs[0])
+	s.Require().ErrorIs(err, nft.ErrInvalidRoyalty)
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: "kitty2", Royalty: nftRoyalty}, s.addrs[0]))
+
+	salePrice := sdk.NewInt64Coin("stake", 1999)
+	testCases := []struct {
+		msg        string
+		req        *nft.QueryRoyaltyInfoRequest
+		expError   string
+		expRoyalty *nft.RoyaltyInfo
+		expAmount  *sdk.Coin
+	}{
+		{
+			"fail empty ClassId",
+			&nft.QueryRoyaltyInfoRequest{},
+			nft.ErrEmptyClassID.Error(),
+			nil,
+			nil,
+		},
+		{
+			"fail empty nft id",
+			&nft.QueryRoyaltyInfoRequest{ClassId: testClassID},
+			nft.ErrEmptyNFTID.Error(),
+			nil,
+			nil,
+		},
+		{
+			"fail nft id not exist",
+			&nft.QueryRoyaltyInfoRequest{ClassId: testClassID, Id: "kitty3"},
+			nft.ErrNFTNotExists.Error(),
+			nil,
+			nil,
+		},
+		{
+			"success class royalty without sale price",
+			&nft.QueryRoyaltyInfoRequest{ClassId: testClassID, Id: testID},
+			"",
+			classRoyalty,
+			nil,
+		},
+		{
+			"success class royalty",
+			&nft.QueryRoyaltyInfoRequest{ClassId: testClassID, Id: testID, SalePrice: &salePrice},
+			"",
+			classRoyalty,
+			&sdk.Coin{Denom: "stake", Amount: sdk.NewInt(99)},
+		},
+		{
+			"success nft royalty overrides class royalty",
+			&nft.QueryRoyaltyInfoRequest{ClassId: testClassID, Id: "kitty2", SalePrice: &salePrice},
+			"",
+			nftRoyalty,
+			&sdk.Coin{Denom: "stake", Amount: sdk.NewInt(49)},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			require := s.Require()
+			res, err := s.queryClient.RoyaltyInfo(gocontext.Background(), tc.req)
+			if tc.expError != "" {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expError)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tc.expRoyalty, res.Royalty)
+			require.Equal(tc.expAmount, res.RoyaltyAmount)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/x/nft"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ nft.NFTHooks = &mockNFTHooks{}
+
+type mockNFTHooks struct {
+	beforeTransferCalls int
+	afterMintCalls      int
+	afterBurnCalls      int
+	rejectTransfer      bool
+}
+
+func (h *mockNFTHooks) BeforeTransfer(_ context.Context, _, _ string, _, _ sdk.AccAddress) error {
+	h.beforeTransferCalls++
+	if h.rejectTransfer {
+		return errors.New("transfer rejected by hook")
+	}
+	return nil
+}
+
+func (h *mockNFTHooks) AfterMint(_ context.Context, _, _ string, _ sdk.AccAddress) error {
+	h.afterMintCalls++
+	return nil
+}
+
+func (h *mockNFTHooks) AfterBurn(_ context.Context, _, _ string, _ sdk.AccAddress) error {
+	h.afterBurnCalls++
+	return nil
+}
+
+func (s *TestSuite) TestHooks() {
+	hooks := &mockNFTHooks{}
+	s.nftKeeper.SetHooks(nft.NewMultiNFTHooks(hooks))
+
+	err := s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID})
+	s.Require().NoError(err)
+
+	err = s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[0])
+	s.Require().NoError(err)
+	s.Require().Equal(1, hooks.afterMintCalls)
+
+	err = s.nftKeeper.Transfer(s.ctx, testClassID, testID, s.addrs[1])
+	s.Require().NoError(err)
+	s.Require().Equal(1, hooks.beforeTransferCalls)
+
+	// a hook error aborts the transfer
+	hooks.rejectTransfer = true
+	err = s.nftKeeper.Transfer(s.ctx, testClassID, testID, s.addrs[2])
+	s.Require().ErrorContains(err, "transfer rejected by hook")
+	s.Require().Equal(s.addrs[1], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+
+	err = s.nftKeeper.BatchTransfer(s.ctx, testClassID, []string{testID}, s.addrs[2])
+	s.Require().ErrorContains(err, "transfer rejected by hook")
+
+	err = s.nftKeeper.Burn(s.ctx, testClassID, testID)
+	s.Require().NoError(err)
+	s.Require().Equal(1, hooks.afterBurnCalls)
+}
+
+func (s *TestSuite) TestNonTransferableClass() {
+	err := s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID, NonTransferable: true})
+	s.Require().NoError(err)
+
+	err = s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[0])
+	s.Require().NoError(err)
+
+	err = s.nftKeeper.Transfer(s.ctx, testClassID, testID, s.addrs[1])
+	s.Require().ErrorIs(err, nft.ErrNonTransferable)
+
+	err = s.nftKeeper.BatchTransfer(s.ctx, testClassID, []string{testID}, s.addrs[1])
+	s.Require().ErrorIs(err, nft.ErrNonTransferable)
+	s.Require().Equal(s.addrs[0], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+
+	// nfts of a non-transferable class can still be burned
+	err = s.nftKeeper.Burn(s.ctx, testClassID, testID)
+	s.Require().NoError(err)
+}
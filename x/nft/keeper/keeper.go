@@ -14,6 +14,7 @@ type Keeper struct {
 	storeService store.KVStoreService
 	bk           nft.BankKeeper
 	ac           address.Codec
+	hooks        nft.NFTHooks
 }
 
 // NewKeeper creates a new nft Keeper instance
@@ -32,3 +33,15 @@ func NewKeeper(storeService store.KVStoreService,
 		ac:           ak,
 	}
 }
+
+// SetHooks sets the hooks for the nft module. It returns a pointer to the
+// keeper as the keeper is usually passed by value to the other modules.
+func (k *Keeper) SetHooks(nh nft.NFTHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set nft hooks twice")
+	}
+
+	k.hooks = nh
+
+	return k
+}
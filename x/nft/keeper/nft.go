@@ -21,14 +21,17 @@ func (k Keeper) Mint(ctx context.Context, token nft.NFT, receiver sdk.AccAddress
 		return errors.Wrap(nft.ErrNFTExists, token.Id)
 	}
 
-	k.mintWithNoCheck(ctx, token, receiver)
-	return nil
+	return k.mintWithNoCheck(ctx, token, receiver)
 }
 
 // mintWithNoCheck defines a method for minting a new nft
 // Note: this method does not check whether the class already exists in nft.
 // The upper-layer application needs to check it when it needs to use it.
-func (k Keeper) mintWithNoCheck(ctx context.Context, token nft.NFT, receiver sdk.AccAddress) {
+func (k Keeper) mintWithNoCheck(ctx context.Context, token nft.NFT, receiver sdk.AccAddress) error {
+	if err := token.Royalty.Validate(k.ac); err != nil {
+		return err
+	}
+
 	k.setNFT(ctx, token)
 	k.setOwner(ctx, token.ClassId, token.Id, receiver)
 	k.incrTotalSupply(ctx, token.ClassId)
@@ -38,6 +41,11 @@ func (k Keeper) mintWithNoCheck(ctx context.Context, token nft.NFT, receiver sdk
 		Id:      token.Id,
 		Owner:   receiver.String(),
 	})
+
+	if k.hooks != nil {
+		return k.hooks.AfterMint(ctx, token.ClassId, token.Id, receiver)
+	}
+	return nil
 }
 
 // Burn defines a method for burning a nft from a specific account.
@@ -51,8 +59,7 @@ func (k Keeper) Burn(ctx context.Context, classID, nftID string) error {
 		return errors.Wrap(nft.ErrNFTNotExists, nftID)
 	}
 
-	k.burnWithNoCheck(ctx, classID, nftID)
-	return nil
+	return k.burnWithNoCheck(ctx, classID, nftID)
 }
 
 // burnWithNoCheck defines a method for burning a nft from a specific account.
@@ -70,6 +77,10 @@ func (k Keeper) burnWithNoCheck(ctx context.Context, classID, nftID string) erro
 		Id:      nftID,
 		Owner:   owner.String(),
 	})
+
+	if k.hooks != nil {
+		return k.hooks.AfterBurn(ctx, classID, nftID, owner)
+	}
 	return nil
 }
 
@@ -83,15 +94,19 @@ func (k Keeper) Update(ctx context.Context, token nft.NFT) error {
 	if !k.HasNFT(ctx, token.ClassId, token.Id) {
 		return errors.Wrap(nft.ErrNFTNotExists, token.Id)
 	}
-	k.updateWithNoCheck(ctx, token)
-	return nil
+	return k.updateWithNoCheck(ctx, token)
 }
 
 // Update defines a method for updating an exist nft
 // Note: this method does not check whether the class already exists in nft.
 // The upper-layer application needs to check it when it needs to use it
-func (k Keeper) updateWithNoCheck(ctx context.Context, token nft.NFT) {
+func (k Keeper) updateWithNoCheck(ctx context.Context, token nft.NFT) error {
+	if err := token.Royalty.Validate(k.ac); err != nil {
+		return err
+	}
+
 	k.setNFT(ctx, token)
+	return nil
 }
 
 // Transfer defines a method for sending a nft from one account to another account.
@@ -101,16 +116,20 @@ func (k Keeper) Transfer(ctx context.Context,
 	nftID string,
 	receiver sdk.AccAddress,
 ) error {
-	if !k.HasClass(ctx, classID) {
+	class, has := k.GetClass(ctx, classID)
+	if !has {
 		return errors.Wrap(nft.ErrClassNotExists, classID)
 	}
 
+	if class.NonTransferable {
+		return errors.Wrap(nft.ErrNonTransferable, classID)
+	}
+
 	if !k.HasNFT(ctx, classID, nftID) {
 		return errors.Wrap(nft.ErrNFTNotExists, nftID)
 	}
 
-	k.transferWithNoCheck(ctx, classID, nftID, receiver)
-	return nil
+	return k.transferWithNoCheck(ctx, classID, nftID, receiver)
 }
 
 // Transfer defines a method for sending a nft from one account to another account.
@@ -122,6 +141,12 @@ func (k Keeper) transferWithNoCheck(ctx context.Context,
 	receiver sdk.AccAddress,
 ) error {
 	owner := k.GetOwner(ctx, classID, nftID)
+	if k.hooks != nil {
+		if err := k.hooks.BeforeTransfer(ctx, classID, nftID, owner, receiver); err != nil {
+			return err
+		}
+	}
+
 	k.deleteOwner(ctx, classID, nftID, owner)
 	k.setOwner(ctx, classID, nftID, receiver)
 	return nil
@@ -166,6 +191,24 @@ func (k Keeper) GetNFTsOfClass(ctx context.Context, classID string) (nfts []nft.
 	return nfts
 }
 
+// GetRoyaltyInfo returns the royalty of the specified nft, falling back to the royalty of its class.
+// It returns nil if neither the nft nor its class define a royalty.
+func (k Keeper) GetRoyaltyInfo(ctx context.Context, classID, nftID string) (*nft.RoyaltyInfo, error) {
+	token, has := k.GetNFT(ctx, classID, nftID)
+	if !has {
+		return nil, errors.Wrap(nft.ErrNFTNotExists, nftID)
+	}
+	if token.Royalty != nil {
+		return token.Royalty, nil
+	}
+
+	class, has := k.GetClass(ctx, classID)
+	if !has {
+		return nil, errors.Wrap(nft.ErrClassNotExists, classID)
+	}
+	return class.Royalty, nil
+}
+
 // GetOwner returns the owner information of the specified nft
 func (k Keeper) GetOwner(ctx context.Context, classID, nftID string) sdk.AccAddress {
 	store := k.storeService.OpenKVStore(ctx)
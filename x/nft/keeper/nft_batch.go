@@ -25,7 +25,9 @@ func (k Keeper) BatchMint(ctx context.Context,
 		}
 
 		checked[token.ClassId] = true
-		k.mintWithNoCheck(ctx, token, receiver)
+		if err := k.mintWithNoCheck(ctx, token, receiver); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -60,7 +62,9 @@ func (k Keeper) BatchUpdate(ctx context.Context, tokens []nft.NFT) error {
 			return errors.Wrap(nft.ErrNFTNotExists, token.Id)
 		}
 		checked[token.ClassId] = true
-		k.updateWithNoCheck(ctx, token)
+		if err := k.updateWithNoCheck(ctx, token); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -72,15 +76,21 @@ func (k Keeper) BatchTransfer(ctx context.Context,
 	nftIDs []string,
 	receiver sdk.AccAddress,
 ) error {
-	if !k.HasClass(ctx, classID) {
+	class, has := k.GetClass(ctx, classID)
+	if !has {
 		return errors.Wrap(nft.ErrClassNotExists, classID)
 	}
+
+	if class.NonTransferable {
+		return errors.Wrap(nft.ErrNonTransferable, classID)
+	}
+
 	for _, nftID := range nftIDs {
 		if !k.HasNFT(ctx, classID, nftID) {
 			return errors.Wrap(nft.ErrNFTNotExists, nftID)
 		}
 		if err := k.transferWithNoCheck(ctx, classID, nftID, receiver); err != nil {
-			return errors.Wrap(nft.ErrNFTNotExists, nftID)
+			return err
 		}
 	}
 	return nil
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
@@ -69,6 +70,11 @@ func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
 	return cdc.MustMarshalJSON(nft.DefaultGenesisState())
 }
 
+// NewGenesisState returns an empty genesis state of the nft module.
+func (AppModuleBasic) NewGenesisState() proto.Message {
+	return &nft.GenesisState{}
+}
+
 // ValidateGenesis performs genesis state validation for the nft module.
 func (ab AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config sdkclient.TxEncodingConfig, bz json.RawMessage) error {
 	var data nft.GenesisState
@@ -39,6 +39,15 @@ type Class struct {
 	UriHash string `protobuf:"bytes,6,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
 	// data is the app specific metadata of the NFT class. Optional
 	Data *types.Any `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	// non_transferable defines whether the nfts of the class cannot be transferred
+	// once minted, e.g. for soulbound tokens. Optional
+	//
+	// Since: cosmos-sdk 0.50
+	NonTransferable bool `protobuf:"varint,8,opt,name=non_transferable,json=nonTransferable,proto3" json:"non_transferable,omitempty"`
+	// royalty defines the default royalty of the nfts of the class. Optional
+	//
+	// Since: cosmos-sdk 0.50
+	Royalty *RoyaltyInfo `protobuf:"bytes,9,opt,name=royalty,proto3" json:"royalty,omitempty"`
 }
 
 func (m *Class) Reset()         { *m = Class{} }
@@ -123,6 +132,20 @@ func (m *Class) GetData() *types.Any {
 	return nil
 }
 
+func (m *Class) GetNonTransferable() bool {
+	if m != nil {
+		return m.NonTransferable
+	}
+	return false
+}
+
+func (m *Class) GetRoyalty() *RoyaltyInfo {
+	if m != nil {
+		return m.Royalty
+	}
+	return nil
+}
+
 // NFT defines the NFT.
 type NFT struct {
 	// class_id associated with the NFT, similar to the contract address of ERC721
@@ -135,6 +158,10 @@ type NFT struct {
 	UriHash string `protobuf:"bytes,4,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
 	// data is an app specific data of the NFT. Optional
 	Data *types.Any `protobuf:"bytes,10,opt,name=data,proto3" json:"data,omitempty"`
+	// royalty defines the royalty of the NFT, overriding the royalty of its class. Optional
+	//
+	// Since: cosmos-sdk 0.50
+	Royalty *RoyaltyInfo `protobuf:"bytes,11,opt,name=royalty,proto3" json:"royalty,omitempty"`
 }
 
 func (m *NFT) Reset()         { *m = NFT{} }
@@ -205,35 +232,107 @@ func (m *NFT) GetData() *types.Any {
 	return nil
 }
 
+func (m *NFT) GetRoyalty() *RoyaltyInfo {
+	if m != nil {
+		return m.Royalty
+	}
+	return nil
+}
+
+// RoyaltyInfo defines the royalty paid to a recipient on the sales of an NFT,
+// similar to ERC2981.
+//
+// Since: cosmos-sdk 0.50
+type RoyaltyInfo struct {
+	// recipient is the address receiving the royalty
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// basis_points is the share of the sale price paid as royalty, in basis points (1/10000)
+	BasisPoints uint32 `protobuf:"varint,2,opt,name=basis_points,json=basisPoints,proto3" json:"basis_points,omitempty"`
+}
+
+func (m *RoyaltyInfo) Reset()         { *m = RoyaltyInfo{} }
+func (m *RoyaltyInfo) String() string { return proto.CompactTextString(m) }
+func (*RoyaltyInfo) ProtoMessage()    {}
+func (*RoyaltyInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{2}
+}
+func (m *RoyaltyInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoyaltyInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoyaltyInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoyaltyInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoyaltyInfo.Merge(m, src)
+}
+func (m *RoyaltyInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoyaltyInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoyaltyInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoyaltyInfo proto.InternalMessageInfo
+
+func (m *RoyaltyInfo) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *RoyaltyInfo) GetBasisPoints() uint32 {
+	if m != nil {
+		return m.BasisPoints
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Class)(nil), "cosmos.nft.v1beta1.Class")
 	proto.RegisterType((*NFT)(nil), "cosmos.nft.v1beta1.NFT")
+	proto.RegisterType((*RoyaltyInfo)(nil), "cosmos.nft.v1beta1.RoyaltyInfo")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/nft.proto", fileDescriptor_eb8ebf8e8053172c) }
 
 var fileDescriptor_eb8ebf8e8053172c = []byte{
-	// 308 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x4a, 0xf3, 0x40,
-	0x14, 0x85, 0x3b, 0x49, 0x9a, 0xfc, 0xff, 0x14, 0x44, 0x06, 0x91, 0xa9, 0xc8, 0x10, 0xba, 0xca,
-	0x42, 0x26, 0x54, 0x9f, 0x40, 0x05, 0xd1, 0x8d, 0x8b, 0xe2, 0xca, 0x4d, 0x99, 0x64, 0xd2, 0x66,
-	0x30, 0xc9, 0x94, 0x4c, 0x22, 0xe6, 0x09, 0xdc, 0xfa, 0x40, 0x3e, 0x80, 0xcb, 0x2e, 0x5d, 0x4a,
-	0xf2, 0x22, 0x32, 0x93, 0x58, 0x5c, 0x14, 0xdc, 0xdd, 0x7b, 0xce, 0xe1, 0xf2, 0x1d, 0x2e, 0x3c,
-	0x8d, 0xa5, 0xca, 0xa5, 0x0a, 0x8b, 0x55, 0x15, 0x3e, 0xcf, 0xa3, 0xa4, 0x62, 0x73, 0x3d, 0xd3,
-	0x4d, 0x29, 0x2b, 0x89, 0x50, 0xef, 0x52, 0xad, 0x0c, 0xee, 0xc9, 0x74, 0x2d, 0xe5, 0x3a, 0x4b,
-	0x42, 0x93, 0x88, 0xea, 0x55, 0xc8, 0x8a, 0xa6, 0x8f, 0xcf, 0xde, 0x01, 0x1c, 0x5f, 0x67, 0x4c,
-	0x29, 0x74, 0x00, 0x2d, 0xc1, 0x31, 0xf0, 0x41, 0xf0, 0x7f, 0x61, 0x09, 0x8e, 0x10, 0x74, 0x0a,
-	0x96, 0x27, 0xd8, 0x32, 0x8a, 0x99, 0xd1, 0x31, 0x74, 0x55, 0x93, 0x47, 0x32, 0xc3, 0xb6, 0x51,
-	0x87, 0x0d, 0xf9, 0x70, 0xc2, 0x13, 0x15, 0x97, 0x62, 0x53, 0x09, 0x59, 0x60, 0xc7, 0x98, 0xbf,
-	0x25, 0x74, 0x08, 0xed, 0xba, 0x14, 0x78, 0x6c, 0x1c, 0x3d, 0xa2, 0x29, 0xfc, 0x57, 0x97, 0x62,
-	0x99, 0x32, 0x95, 0x62, 0xd7, 0xc8, 0x5e, 0x5d, 0x8a, 0x5b, 0xa6, 0x52, 0x14, 0x40, 0x87, 0xb3,
-	0x8a, 0x61, 0xcf, 0x07, 0xc1, 0xe4, 0xfc, 0x88, 0xf6, 0xf8, 0xf4, 0x07, 0x9f, 0x5e, 0x16, 0xcd,
-	0xc2, 0x24, 0x66, 0xaf, 0x00, 0xda, 0xf7, 0x37, 0x0f, 0xfa, 0x58, 0xac, 0x5b, 0x2c, 0x77, 0x15,
-	0x3c, 0xb3, 0xdf, 0xf1, 0xa1, 0x97, 0xb5, 0xeb, 0x35, 0x90, 0xd8, 0xfb, 0x49, 0x9c, 0xfd, 0x24,
-	0xf0, 0x2f, 0x92, 0xab, 0xb3, 0x8f, 0x96, 0x80, 0x6d, 0x4b, 0xc0, 0x57, 0x4b, 0xc0, 0x5b, 0x47,
-	0x46, 0xdb, 0x8e, 0x8c, 0x3e, 0x3b, 0x32, 0x7a, 0x1c, 0x3e, 0xa2, 0xf8, 0x13, 0x15, 0x32, 0x7c,
-	0xd1, 0xbf, 0x8a, 0x5c, 0x73, 0xe1, 0xe2, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xf2, 0xf7, 0xce, 0x23,
-	0xcc, 0x01, 0x00, 0x00,
+	// 405 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x4f, 0x6b, 0xd4, 0x40,
+	0x18, 0xc6, 0x77, 0xb2, 0xdb, 0xcd, 0xee, 0x1b, 0xff, 0x94, 0x41, 0x64, 0x2a, 0x25, 0xc6, 0x9e,
+	0x22, 0xc8, 0x84, 0xea, 0xc9, 0xa3, 0x0a, 0x62, 0x2f, 0x45, 0x42, 0x4f, 0x5e, 0xc2, 0x24, 0x99,
+	0x74, 0x07, 0xb3, 0x33, 0x61, 0x66, 0x22, 0xe6, 0x5b, 0xf8, 0x19, 0xfc, 0x28, 0x9e, 0x3c, 0xf6,
+	0xe8, 0x51, 0x76, 0xbf, 0x88, 0x64, 0x92, 0xd6, 0x80, 0x05, 0xe9, 0xed, 0xcd, 0xef, 0x7d, 0x08,
+	0xef, 0xef, 0x61, 0xe0, 0xb8, 0x50, 0x66, 0xab, 0x4c, 0x22, 0x2b, 0x9b, 0x7c, 0x39, 0xcd, 0xb9,
+	0x65, 0xa7, 0xfd, 0x4c, 0x1b, 0xad, 0xac, 0xc2, 0x78, 0xd8, 0xd2, 0x9e, 0x8c, 0xdb, 0x27, 0x47,
+	0x97, 0x4a, 0x5d, 0xd6, 0x3c, 0x71, 0x89, 0xbc, 0xad, 0x12, 0x26, 0xbb, 0x21, 0x7e, 0xf2, 0xdd,
+	0x83, 0x83, 0x77, 0x35, 0x33, 0x06, 0x3f, 0x00, 0x4f, 0x94, 0x04, 0x45, 0x28, 0x5e, 0xa7, 0x9e,
+	0x28, 0x31, 0x86, 0x85, 0x64, 0x5b, 0x4e, 0x3c, 0x47, 0xdc, 0x8c, 0x1f, 0xc3, 0xd2, 0x74, 0xdb,
+	0x5c, 0xd5, 0x64, 0xee, 0xe8, 0xf8, 0x85, 0x23, 0x08, 0x4a, 0x6e, 0x0a, 0x2d, 0x1a, 0x2b, 0x94,
+	0x24, 0x0b, 0xb7, 0x9c, 0x22, 0x7c, 0x08, 0xf3, 0x56, 0x0b, 0x72, 0xe0, 0x36, 0xfd, 0x88, 0x8f,
+	0x60, 0xd5, 0x6a, 0x91, 0x6d, 0x98, 0xd9, 0x90, 0xa5, 0xc3, 0x7e, 0xab, 0xc5, 0x07, 0x66, 0x36,
+	0x38, 0x86, 0x45, 0xc9, 0x2c, 0x23, 0x7e, 0x84, 0xe2, 0xe0, 0xe5, 0x23, 0x3a, 0x9c, 0x4f, 0xaf,
+	0xcf, 0xa7, 0x6f, 0x64, 0x97, 0xba, 0x04, 0x7e, 0x0e, 0x87, 0x52, 0xc9, 0xcc, 0x6a, 0x26, 0x4d,
+	0xc5, 0x35, 0xcb, 0x6b, 0x4e, 0x56, 0x11, 0x8a, 0x57, 0xe9, 0x43, 0xa9, 0xe4, 0xc5, 0x04, 0xe3,
+	0xd7, 0xe0, 0x6b, 0xd5, 0xb1, 0xda, 0x76, 0x64, 0xed, 0xfe, 0xfb, 0x94, 0xfe, 0x5b, 0x15, 0x4d,
+	0x87, 0xc8, 0x99, 0xac, 0x54, 0x7a, 0x9d, 0x3f, 0xf9, 0x81, 0x60, 0x7e, 0xfe, 0xfe, 0xa2, 0x3f,
+	0xb9, 0xe8, 0xbb, 0xca, 0x6e, 0x8a, 0xf2, 0xdd, 0xf7, 0x59, 0x39, 0xb6, 0xe7, 0xdd, 0xb4, 0x37,
+	0xfa, 0xce, 0x6f, 0xf7, 0x5d, 0xdc, 0xee, 0x0b, 0xff, 0xf5, 0x9d, 0x48, 0x04, 0x77, 0x94, 0x38,
+	0x87, 0x60, 0xc2, 0xf1, 0x31, 0xac, 0x35, 0x2f, 0x44, 0x23, 0xb8, 0xb4, 0xa3, 0xcc, 0x5f, 0x80,
+	0x9f, 0xc1, 0xbd, 0x9c, 0x19, 0x61, 0xb2, 0x46, 0x09, 0x69, 0x8d, 0x13, 0xbb, 0x9f, 0x06, 0x8e,
+	0x7d, 0x74, 0xe8, 0xed, 0x8b, 0x9f, 0xbb, 0x10, 0x5d, 0xed, 0x42, 0xf4, 0x7b, 0x17, 0xa2, 0x6f,
+	0xfb, 0x70, 0x76, 0xb5, 0x0f, 0x67, 0xbf, 0xf6, 0xe1, 0xec, 0xd3, 0xf8, 0x04, 0x4d, 0xf9, 0x99,
+	0x0a, 0x95, 0x7c, 0xed, 0x1f, 0x67, 0xbe, 0x74, 0x32, 0xaf, 0xfe, 0x0c, 0x00, 0x31, 0x2c, 0xf0,
+	0xcc, 0xbd, 0x02, 0x00, 0x00,
 }
 
 func (m *Class) Marshal() (dAtA []byte, err error) {
@@ -256,6 +355,28 @@ func (m *Class) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNft(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.NonTransferable {
+		i--
+		if m.NonTransferable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.Data != nil {
 		{
 			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
@@ -333,6 +454,18 @@ func (m *NFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNft(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.Data != nil {
 		{
 			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
@@ -376,6 +509,41 @@ func (m *NFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RoyaltyInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoyaltyInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoyaltyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BasisPoints != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.BasisPoints))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintNft(dAtA []byte, offset int, v uint64) int {
 	offset -= sovNft(v)
 	base := offset
@@ -421,6 +589,13 @@ func (m *Class) Size() (n int) {
 		l = m.Data.Size()
 		n += 1 + l + sovNft(uint64(l))
 	}
+	if m.NonTransferable {
+		n += 2
+	}
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovNft(uint64(l))
+	}
 	return n
 }
 
@@ -450,6 +625,26 @@ func (m *NFT) Size() (n int) {
 		l = m.Data.Size()
 		n += 1 + l + sovNft(uint64(l))
 	}
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovNft(uint64(l))
+	}
+	return n
+}
+
+func (m *RoyaltyInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.BasisPoints != 0 {
+		n += 1 + sovNft(uint64(m.BasisPoints))
+	}
 	return n
 }
 
@@ -716,6 +911,62 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonTransferable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NonTransferable = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &RoyaltyInfo{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNft(dAtA[iNdEx:])
@@ -930,6 +1181,143 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &RoyaltyInfo{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoyaltyInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoyaltyInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoyaltyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
+			}
+			m.BasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNft(dAtA[iNdEx:])
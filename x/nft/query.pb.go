@@ -6,6 +6,7 @@ package nft
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -736,6 +737,129 @@ func (m *QueryClassesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryRoyaltyInfoRequest is the request type for the Query/RoyaltyInfo RPC method
+//
+// Since: cosmos-sdk 0.50
+type QueryRoyaltyInfoRequest struct {
+	// class_id associated with the nft
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// id is a unique identifier of the NFT
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// sale_price is the price the royalty amount is computed for. Optional
+	SalePrice *types.Coin `protobuf:"bytes,3,opt,name=sale_price,json=salePrice,proto3" json:"sale_price,omitempty"`
+}
+
+func (m *QueryRoyaltyInfoRequest) Reset()         { *m = QueryRoyaltyInfoRequest{} }
+func (m *QueryRoyaltyInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRoyaltyInfoRequest) ProtoMessage()    {}
+func (*QueryRoyaltyInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{14}
+}
+func (m *QueryRoyaltyInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoyaltyInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoyaltyInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRoyaltyInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoyaltyInfoRequest.Merge(m, src)
+}
+func (m *QueryRoyaltyInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoyaltyInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoyaltyInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoyaltyInfoRequest proto.InternalMessageInfo
+
+func (m *QueryRoyaltyInfoRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *QueryRoyaltyInfoRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *QueryRoyaltyInfoRequest) GetSalePrice() *types.Coin {
+	if m != nil {
+		return m.SalePrice
+	}
+	return nil
+}
+
+// QueryRoyaltyInfoResponse is the response type for the Query/RoyaltyInfo RPC method
+//
+// Since: cosmos-sdk 0.50
+type QueryRoyaltyInfoResponse struct {
+	// royalty is the royalty of the nft, it is empty if neither the nft nor its class define one
+	Royalty *RoyaltyInfo `protobuf:"bytes,1,opt,name=royalty,proto3" json:"royalty,omitempty"`
+	// royalty_amount is the royalty owed for the sale price, it is empty if no sale price is given
+	RoyaltyAmount *types.Coin `protobuf:"bytes,2,opt,name=royalty_amount,json=royaltyAmount,proto3" json:"royalty_amount,omitempty"`
+}
+
+func (m *QueryRoyaltyInfoResponse) Reset()         { *m = QueryRoyaltyInfoResponse{} }
+func (m *QueryRoyaltyInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRoyaltyInfoResponse) ProtoMessage()    {}
+func (*QueryRoyaltyInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{15}
+}
+func (m *QueryRoyaltyInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoyaltyInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoyaltyInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRoyaltyInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoyaltyInfoResponse.Merge(m, src)
+}
+func (m *QueryRoyaltyInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoyaltyInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoyaltyInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoyaltyInfoResponse proto.InternalMessageInfo
+
+func (m *QueryRoyaltyInfoResponse) GetRoyalty() *RoyaltyInfo {
+	if m != nil {
+		return m.Royalty
+	}
+	return nil
+}
+
+func (m *QueryRoyaltyInfoResponse) GetRoyaltyAmount() *types.Coin {
+	if m != nil {
+		return m.RoyaltyAmount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.nft.v1beta1.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceResponse)(nil), "cosmos.nft.v1beta1.QueryBalanceResponse")
@@ -751,57 +875,67 @@ func init() {
 	proto.RegisterType((*QueryClassResponse)(nil), "cosmos.nft.v1beta1.QueryClassResponse")
 	proto.RegisterType((*QueryClassesRequest)(nil), "cosmos.nft.v1beta1.QueryClassesRequest")
 	proto.RegisterType((*QueryClassesResponse)(nil), "cosmos.nft.v1beta1.QueryClassesResponse")
+	proto.RegisterType((*QueryRoyaltyInfoRequest)(nil), "cosmos.nft.v1beta1.QueryRoyaltyInfoRequest")
+	proto.RegisterType((*QueryRoyaltyInfoResponse)(nil), "cosmos.nft.v1beta1.QueryRoyaltyInfoResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/query.proto", fileDescriptor_0d24e0db697b0f9d) }
 
 var fileDescriptor_0d24e0db697b0f9d = []byte{
-	// 719 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4b, 0x6f, 0xd3, 0x4c,
-	0x14, 0x86, 0x3b, 0x49, 0xd3, 0x7c, 0xdf, 0xa9, 0xc4, 0x65, 0xa8, 0x20, 0x35, 0x60, 0x45, 0xee,
-	0x25, 0x69, 0x4b, 0x67, 0x7a, 0x91, 0x58, 0x01, 0x8b, 0x22, 0x82, 0xd8, 0x14, 0x08, 0x5d, 0x21,
-	0x21, 0xe4, 0x34, 0x4e, 0x64, 0x91, 0x7a, 0xd2, 0x8e, 0x03, 0x54, 0x55, 0x17, 0x74, 0x81, 0xa8,
-	0xd8, 0x20, 0xd1, 0xdf, 0xc3, 0x9a, 0x65, 0x25, 0x36, 0x2c, 0x51, 0xcb, 0x0f, 0x41, 0x9e, 0x39,
-	0x4e, 0x6d, 0xea, 0xd8, 0x55, 0xc4, 0xd2, 0x99, 0xf7, 0x9c, 0xf7, 0xf1, 0x9c, 0x4b, 0x0c, 0xe6,
-	0xa6, 0x90, 0x5b, 0x42, 0x72, 0xaf, 0xe5, 0xf3, 0xb7, 0xcb, 0x0d, 0xc7, 0xb7, 0x97, 0xf9, 0x76,
-	0xcf, 0xd9, 0xd9, 0x65, 0xdd, 0x1d, 0xe1, 0x0b, 0x4a, 0xf5, 0x39, 0xf3, 0x5a, 0x3e, 0xc3, 0x73,
-	0x63, 0x1e, 0x63, 0x1a, 0xb6, 0x74, 0xb4, 0xb8, 0x1f, 0xda, 0xb5, 0xdb, 0xae, 0x67, 0xfb, 0xae,
-	0xf0, 0x74, 0xbc, 0x71, 0xab, 0x2d, 0x44, 0xbb, 0xe3, 0x70, 0xbb, 0xeb, 0x72, 0xdb, 0xf3, 0x84,
-	0xaf, 0x0e, 0x65, 0x78, 0x9a, 0xe0, 0x1e, 0x38, 0xa9, 0x53, 0xab, 0x06, 0xd7, 0x9e, 0x07, 0xd9,
-	0xd7, 0xec, 0x8e, 0xed, 0x6d, 0x3a, 0x75, 0x67, 0xbb, 0xe7, 0x48, 0x9f, 0x4e, 0xc2, 0x7f, 0x9b,
-	0x1d, 0x5b, 0xca, 0xd7, 0x6e, 0xb3, 0x44, 0xca, 0xa4, 0xfa, 0x7f, 0xbd, 0xa8, 0x9e, 0x9f, 0x34,
-	0xe9, 0x04, 0x14, 0xc4, 0x3b, 0xcf, 0xd9, 0x29, 0xe5, 0xd4, 0xef, 0xfa, 0xc1, 0x62, 0x30, 0x11,
-	0xcf, 0x23, 0xbb, 0xc2, 0x93, 0x0e, 0xbd, 0x0e, 0x63, 0xf6, 0x96, 0xe8, 0x79, 0xbe, 0x4a, 0x33,
-	0x5a, 0xc7, 0x27, 0xeb, 0x01, 0x5c, 0x55, 0xfa, 0xa7, 0x41, 0xf4, 0x05, 0x5c, 0x2f, 0x41, 0xce,
-	0x6d, 0xa2, 0x65, 0xce, 0x6d, 0x5a, 0xf3, 0x40, 0xa3, 0xf1, 0xe8, 0xd6, 0x67, 0x23, 0x51, 0x36,
-	0x8e, 0xda, 0x17, 0xbd, 0x6e, 0xb7, 0xb3, 0x9b, 0x6d, 0x66, 0x2d, 0xe2, 0xa5, 0x84, 0x01, 0x19,
-	0xef, 0xf2, 0x99, 0xc0, 0x15, 0xa5, 0x5f, 0xaf, 0x6d, 0xc8, 0x61, 0x6f, 0x90, 0xd6, 0x00, 0xce,
-	0x2a, 0x5b, 0xca, 0x97, 0x49, 0x75, 0x7c, 0x65, 0x96, 0x61, 0x6b, 0x04, 0x6d, 0xc0, 0x74, 0xcf,
-	0x60, 0x0d, 0xd9, 0x33, 0xbb, 0x1d, 0x96, 0xab, 0x1e, 0x89, 0xb4, 0x0e, 0x09, 0x5e, 0xad, 0xa6,
-	0x41, 0xf6, 0x05, 0x18, 0xf5, 0x5a, 0xbe, 0x2c, 0x91, 0x72, 0xbe, 0x3a, 0xbe, 0x72, 0x83, 0x9d,
-	0x6f, 0x39, 0xb6, 0x5e, 0xdb, 0xa8, 0x2b, 0x11, 0x7d, 0x1c, 0x43, 0xc9, 0x29, 0x94, 0x4a, 0x26,
-	0x8a, 0x76, 0x8a, 0xb1, 0xdc, 0x83, 0xcb, 0x21, 0xca, 0x10, 0x35, 0xbe, 0x7f, 0x76, 0xad, 0xfd,
-	0xf7, 0x98, 0x83, 0xbc, 0xd7, 0xd2, 0x05, 0x48, 0x79, 0x8d, 0x40, 0x63, 0x31, 0xbc, 0x87, 0x87,
-	0x41, 0xfa, 0x0b, 0x54, 0xfd, 0x11, 0xb6, 0x09, 0xea, 0xd1, 0x90, 0x43, 0x41, 0x09, 0xd0, 0x72,
-	0x32, 0xc9, 0x52, 0x47, 0x68, 0x9d, 0xf5, 0x0a, 0x9b, 0x47, 0xfd, 0xe8, 0xf4, 0x8d, 0xe3, 0xe5,
-	0x25, 0x43, 0x97, 0xf7, 0x88, 0xe0, 0xa4, 0xf5, 0xf3, 0x23, 0xe8, 0x2a, 0xe8, 0x37, 0x71, 0xc2,
-	0x22, 0xa7, 0xa0, 0x86, 0xca, 0x7f, 0x56, 0xe9, 0x95, 0x6f, 0x45, 0x28, 0x28, 0x2c, 0x7a, 0x44,
-	0xa0, 0x88, 0x5b, 0x80, 0x56, 0x92, 0x10, 0x12, 0xf6, 0x8d, 0x51, 0xcd, 0x16, 0x6a, 0x53, 0xeb,
-	0xee, 0xc1, 0x8f, 0xdf, 0x5f, 0x73, 0x4b, 0x94, 0xf1, 0x84, 0xbd, 0xd6, 0xd0, 0x62, 0xbe, 0xa7,
-	0x46, 0x6a, 0x9f, 0xef, 0x85, 0xb5, 0xde, 0xa7, 0x87, 0x04, 0x0a, 0x6a, 0x59, 0xd0, 0x99, 0x81,
-	0x5e, 0xd1, 0x65, 0x64, 0xcc, 0x66, 0xc9, 0x10, 0x68, 0x59, 0x01, 0x2d, 0xd0, 0xb9, 0x24, 0x20,
-	0xc5, 0x11, 0xc1, 0xe0, 0x7b, 0x01, 0xcb, 0x27, 0x02, 0x63, 0x7a, 0xb7, 0xd0, 0xc1, 0x2e, 0xb1,
-	0x6d, 0x65, 0x54, 0x32, 0x75, 0x88, 0xb3, 0xa8, 0x70, 0x2a, 0x74, 0x26, 0x09, 0x47, 0x2a, 0x6d,
-	0xf4, 0x5a, 0x7a, 0x30, 0x1a, 0xec, 0x09, 0x3a, 0x3d, 0x30, 0x7f, 0x64, 0xa9, 0x19, 0x33, 0x19,
-	0x2a, 0x64, 0x28, 0x2b, 0x06, 0x83, 0x96, 0x78, 0xf2, 0x7f, 0x8f, 0xa4, 0x07, 0x04, 0xf2, 0xeb,
-	0xb5, 0x0d, 0x3a, 0x95, 0x96, 0x30, 0x74, 0x9d, 0x4e, 0x17, 0xa1, 0xe9, 0x92, 0x32, 0x9d, 0xa7,
-	0xd5, 0x41, 0xa6, 0xe7, 0xca, 0xf0, 0x91, 0x40, 0x41, 0xcd, 0x43, 0x4a, 0x4b, 0x44, 0x97, 0x47,
-	0x4a, 0x4b, 0xc4, 0x76, 0x86, 0xc5, 0x14, 0x4a, 0x95, 0xce, 0x26, 0xa1, 0xe0, 0xe8, 0x45, 0x8b,
-	0xf0, 0x81, 0x40, 0x11, 0xc7, 0x39, 0x65, 0x64, 0xe2, 0x0b, 0x25, 0x65, 0x64, 0xfe, 0xda, 0x0c,
-	0xd6, 0x94, 0xc2, 0xb9, 0x4d, 0x6f, 0xa6, 0xe0, 0xac, 0xdd, 0xf9, 0x7e, 0x62, 0x92, 0xe3, 0x13,
-	0x93, 0xfc, 0x3a, 0x31, 0xc9, 0x97, 0x53, 0x73, 0xe4, 0xf8, 0xd4, 0x1c, 0xf9, 0x79, 0x6a, 0x8e,
-	0xbc, 0xc4, 0xcf, 0x13, 0xd9, 0x7c, 0xc3, 0x5c, 0xc1, 0xdf, 0x07, 0xd1, 0x8d, 0x31, 0xf5, 0xf5,
-	0xb0, 0xfa, 0x27, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x6c, 0x33, 0x5d, 0xdb, 0x08, 0x00, 0x00,
+	// 840 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xdf, 0x4f, 0xd3, 0x5c,
+	0x18, 0xc7, 0x39, 0x1b, 0x63, 0xf0, 0x90, 0x97, 0xf7, 0xe5, 0x40, 0x5e, 0x46, 0xdf, 0xd7, 0xba,
+	0x14, 0xd8, 0x06, 0x83, 0x96, 0x1f, 0x89, 0xd1, 0x44, 0x8d, 0x62, 0x9c, 0xe1, 0x06, 0x71, 0x72,
+	0x65, 0x62, 0x48, 0xb7, 0x75, 0x4b, 0xe3, 0xe8, 0x19, 0x3b, 0x9d, 0xba, 0x10, 0x4c, 0xe4, 0xc2,
+	0x48, 0xbc, 0x31, 0x91, 0xc4, 0x2b, 0xff, 0x1f, 0x2f, 0x49, 0xbc, 0xf1, 0xca, 0x18, 0xf0, 0x0f,
+	0x31, 0x3d, 0x7d, 0x3a, 0x5a, 0xd7, 0xad, 0x48, 0xbc, 0xa3, 0x3d, 0xdf, 0xe7, 0x79, 0x3e, 0xe7,
+	0x7c, 0x4f, 0xbf, 0x0c, 0xe4, 0x32, 0xe3, 0xbb, 0x8c, 0x6b, 0x56, 0xd5, 0xd6, 0x9e, 0xaf, 0x94,
+	0x0c, 0x5b, 0x5f, 0xd1, 0xf6, 0x5a, 0x46, 0xb3, 0xad, 0x36, 0x9a, 0xcc, 0x66, 0x94, 0xba, 0xeb,
+	0xaa, 0x55, 0xb5, 0x55, 0x5c, 0x97, 0x16, 0xb0, 0xa6, 0xa4, 0x73, 0xc3, 0x15, 0x77, 0x4a, 0x1b,
+	0x7a, 0xcd, 0xb4, 0x74, 0xdb, 0x64, 0x96, 0x5b, 0x2f, 0xc9, 0x7e, 0xad, 0xa7, 0x2a, 0x33, 0xd3,
+	0x5b, 0xff, 0xbf, 0xc6, 0x58, 0xad, 0x6e, 0x68, 0x7a, 0xc3, 0xd4, 0x74, 0xcb, 0x62, 0xb6, 0x28,
+	0xe6, 0xde, 0x6a, 0x08, 0x9d, 0x43, 0x22, 0x56, 0x95, 0x02, 0x4c, 0x3c, 0x72, 0xa6, 0xaf, 0xeb,
+	0x75, 0xdd, 0x2a, 0x1b, 0x45, 0x63, 0xaf, 0x65, 0x70, 0x9b, 0x4e, 0xc3, 0x70, 0xb9, 0xae, 0x73,
+	0xbe, 0x63, 0x56, 0x52, 0x24, 0x4d, 0x72, 0x23, 0xc5, 0xa4, 0x78, 0xde, 0xa8, 0xd0, 0x49, 0x48,
+	0xb0, 0x17, 0x96, 0xd1, 0x4c, 0xc5, 0xc4, 0x7b, 0xf7, 0x41, 0x51, 0x61, 0x32, 0xd8, 0x87, 0x37,
+	0x98, 0xc5, 0x0d, 0xfa, 0x2f, 0x0c, 0xe9, 0xbb, 0xac, 0x65, 0xd9, 0xa2, 0xcd, 0x60, 0x11, 0x9f,
+	0x94, 0xdb, 0x30, 0x2e, 0xf4, 0x0f, 0x9d, 0xea, 0x0b, 0x4c, 0x1d, 0x83, 0x98, 0x59, 0xc1, 0x91,
+	0x31, 0xb3, 0xa2, 0x2c, 0x00, 0xf5, 0xd7, 0xe3, 0xb4, 0x0e, 0x1b, 0xf1, 0xb3, 0x69, 0xa8, 0x7d,
+	0xdc, 0x6a, 0x34, 0xea, 0xed, 0xe8, 0x61, 0xca, 0x12, 0x4c, 0x04, 0x0a, 0x22, 0xf6, 0xf2, 0x8e,
+	0xc0, 0x3f, 0x42, 0xbf, 0x59, 0xd8, 0xe6, 0x97, 0x3d, 0x41, 0x5a, 0x00, 0x38, 0x77, 0x3e, 0x15,
+	0x4f, 0x93, 0xdc, 0xe8, 0x6a, 0x46, 0xc5, 0xab, 0xe3, 0x58, 0xaf, 0xba, 0x77, 0x0a, 0x3d, 0x54,
+	0xb7, 0xf4, 0x9a, 0x67, 0x57, 0xd1, 0x57, 0xa9, 0x1c, 0x11, 0x18, 0xf7, 0xd1, 0x20, 0x7b, 0x1e,
+	0x06, 0xad, 0xaa, 0xcd, 0x53, 0x24, 0x1d, 0xcf, 0x8d, 0xae, 0x4e, 0xa9, 0xdd, 0x57, 0x52, 0xdd,
+	0x2c, 0x6c, 0x17, 0x85, 0x88, 0x3e, 0x08, 0xa0, 0xc4, 0x04, 0x4a, 0x36, 0x12, 0xc5, 0x9d, 0x14,
+	0x60, 0xb9, 0x09, 0x7f, 0x7b, 0x28, 0x97, 0xf0, 0xf8, 0xd6, 0xf9, 0xb1, 0x76, 0xf6, 0x31, 0x0f,
+	0x71, 0xab, 0xea, 0x1a, 0xd0, 0x67, 0x1b, 0x8e, 0x46, 0x51, 0xf1, 0x1c, 0xee, 0x39, 0xed, 0x2f,
+	0xe0, 0xfa, 0x7d, 0xa0, 0x7e, 0x3d, 0x0e, 0xd4, 0x20, 0x21, 0x04, 0x38, 0x72, 0x3a, 0x6c, 0xa4,
+	0x5b, 0xe1, 0xea, 0x94, 0xa7, 0x78, 0x79, 0xc4, 0x4b, 0xa3, 0x33, 0x38, 0x68, 0x2f, 0xb9, 0xb4,
+	0xbd, 0xc7, 0x04, 0x26, 0x83, 0xfd, 0x11, 0x74, 0x0d, 0xdc, 0x9d, 0x18, 0x9e, 0xc9, 0x7d, 0x50,
+	0x3d, 0xe5, 0x9f, 0x73, 0xfa, 0x15, 0x4c, 0x09, 0xaa, 0x22, 0x6b, 0xeb, 0x75, 0xbb, 0xbd, 0x61,
+	0x55, 0xd9, 0xef, 0x3b, 0x4e, 0xaf, 0x03, 0x70, 0xbd, 0x6e, 0xec, 0x34, 0x9a, 0x66, 0xd9, 0xc0,
+	0x6f, 0x60, 0x3a, 0x80, 0xd3, 0xd9, 0x07, 0x33, 0xad, 0xe2, 0x88, 0x23, 0xde, 0x72, 0xb4, 0xca,
+	0x47, 0x02, 0xa9, 0x6e, 0x00, 0x3c, 0x9a, 0x1b, 0x90, 0x6c, 0xba, 0xaf, 0xf1, 0xe0, 0xaf, 0x86,
+	0x1d, 0x8d, 0xbf, 0xd2, 0xd3, 0xd3, 0x3b, 0x30, 0x86, 0x7f, 0xee, 0xe0, 0xb7, 0x1f, 0x8b, 0xa2,
+	0xfa, 0x0b, 0x0b, 0xee, 0x0a, 0xfd, 0xea, 0xb7, 0x61, 0x48, 0x08, 0x32, 0x7a, 0x4c, 0x20, 0x89,
+	0xf9, 0x48, 0xb3, 0x61, 0x04, 0x21, 0x49, 0x2c, 0xe5, 0xa2, 0x85, 0xee, 0x2e, 0x95, 0x6b, 0x87,
+	0x5f, 0x7e, 0x7c, 0x88, 0x2d, 0x53, 0x55, 0x0b, 0x49, 0xfc, 0x92, 0x2b, 0xd6, 0xf6, 0x45, 0xd8,
+	0x1c, 0x68, 0xfb, 0x9e, 0x25, 0x07, 0xf4, 0x88, 0x40, 0x42, 0xc4, 0x28, 0x9d, 0xeb, 0x39, 0xcb,
+	0x1f, 0xd3, 0x52, 0x26, 0x4a, 0x86, 0x40, 0x2b, 0x02, 0x28, 0x4f, 0xe7, 0xc3, 0x80, 0x04, 0x87,
+	0x0f, 0x43, 0xdb, 0x77, 0x58, 0xde, 0x12, 0x18, 0x72, 0x53, 0x97, 0xf6, 0x9e, 0x12, 0xc8, 0x71,
+	0x29, 0x1b, 0xa9, 0x43, 0x9c, 0x25, 0x81, 0x93, 0xa5, 0x73, 0x61, 0x38, 0x5c, 0x68, 0xfd, 0xc7,
+	0xd2, 0x82, 0x41, 0x27, 0x41, 0xe9, 0x6c, 0xcf, 0xfe, 0xbe, 0xb8, 0x97, 0xe6, 0x22, 0x54, 0xc8,
+	0x90, 0x16, 0x0c, 0x12, 0x4d, 0x69, 0xe1, 0xff, 0x95, 0x39, 0x3d, 0x24, 0x10, 0xdf, 0x2c, 0x6c,
+	0xd3, 0x99, 0x7e, 0x0d, 0xbd, 0xa9, 0xb3, 0xfd, 0x45, 0x38, 0x74, 0x59, 0x0c, 0x5d, 0xa0, 0xb9,
+	0x5e, 0x43, 0xbb, 0x6c, 0x78, 0x43, 0x20, 0x21, 0x92, 0xa2, 0xcf, 0x95, 0xf0, 0xc7, 0xaa, 0x94,
+	0x89, 0x92, 0x21, 0x8a, 0x2a, 0x50, 0x72, 0x34, 0x13, 0x86, 0x82, 0xa1, 0xe4, 0x37, 0xe1, 0x35,
+	0x81, 0x24, 0x06, 0x5d, 0x9f, 0x4f, 0x26, 0x18, 0xb5, 0x52, 0x2e, 0x5a, 0x88, 0x38, 0x33, 0x02,
+	0xe7, 0x0a, 0xfd, 0xaf, 0x0f, 0x0e, 0xfd, 0x44, 0x60, 0xd4, 0x97, 0x0d, 0x34, 0xdf, 0xb3, 0x7d,
+	0x77, 0xf8, 0x49, 0x8b, 0x17, 0x13, 0x23, 0xcf, 0x9a, 0xe0, 0x59, 0xa2, 0xf9, 0x30, 0x1e, 0x8c,
+	0x95, 0x5f, 0xcd, 0x5a, 0x5f, 0xfc, 0x7c, 0x2a, 0x93, 0x93, 0x53, 0x99, 0x7c, 0x3f, 0x95, 0xc9,
+	0xfb, 0x33, 0x79, 0xe0, 0xe4, 0x4c, 0x1e, 0xf8, 0x7a, 0x26, 0x0f, 0x3c, 0xc1, 0x1f, 0x9e, 0xbc,
+	0xf2, 0x4c, 0x35, 0x99, 0xf6, 0xd2, 0xe9, 0x56, 0x1a, 0x12, 0xbf, 0xfb, 0xd6, 0x7e, 0x0e, 0x00,
+	0xd8, 0x68, 0xb6, 0xb6, 0xb5, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -831,6 +965,11 @@ type QueryClient interface {
 	Class(ctx context.Context, in *QueryClassRequest, opts ...grpc.CallOption) (*QueryClassResponse, error)
 	// Classes queries all NFT classes
 	Classes(ctx context.Context, in *QueryClassesRequest, opts ...grpc.CallOption) (*QueryClassesResponse, error)
+	// RoyaltyInfo queries the royalty of an NFT, which defaults to the royalty of its class, and the royalty amount
+	// owed for a sale price, same as royaltyInfo in ERC2981.
+	//
+	// Since: cosmos-sdk 0.50
+	RoyaltyInfo(ctx context.Context, in *QueryRoyaltyInfoRequest, opts ...grpc.CallOption) (*QueryRoyaltyInfoResponse, error)
 }
 
 type queryClient struct {
@@ -904,6 +1043,15 @@ func (c *queryClient) Classes(ctx context.Context, in *QueryClassesRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) RoyaltyInfo(ctx context.Context, in *QueryRoyaltyInfoRequest, opts ...grpc.CallOption) (*QueryRoyaltyInfoResponse, error) {
+	out := new(QueryRoyaltyInfoResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Query/RoyaltyInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Balance queries the number of NFTs of a given class owned by the owner, same as balanceOf in ERC721
@@ -921,6 +1069,11 @@ type QueryServer interface {
 	Class(context.Context, *QueryClassRequest) (*QueryClassResponse, error)
 	// Classes queries all NFT classes
 	Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error)
+	// RoyaltyInfo queries the royalty of an NFT, which defaults to the royalty of its class, and the royalty amount
+	// owed for a sale price, same as royaltyInfo in ERC2981.
+	//
+	// Since: cosmos-sdk 0.50
+	RoyaltyInfo(context.Context, *QueryRoyaltyInfoRequest) (*QueryRoyaltyInfoResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -948,6 +1101,9 @@ func (*UnimplementedQueryServer) Class(ctx context.Context, req *QueryClassReque
 func (*UnimplementedQueryServer) Classes(ctx context.Context, req *QueryClassesRequest) (*QueryClassesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Classes not implemented")
 }
+func (*UnimplementedQueryServer) RoyaltyInfo(ctx context.Context, req *QueryRoyaltyInfoRequest) (*QueryRoyaltyInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoyaltyInfo not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1079,6 +1235,24 @@ func _Query_Classes_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RoyaltyInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoyaltyInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RoyaltyInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.Query/RoyaltyInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RoyaltyInfo(ctx, req.(*QueryRoyaltyInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.nft.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1111,6 +1285,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Classes",
 			Handler:    _Query_Classes_Handler,
 		},
+		{
+			MethodName: "RoyaltyInfo",
+			Handler:    _Query_RoyaltyInfo_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/nft/v1beta1/query.proto",
@@ -1625,6 +1803,102 @@ func (m *QueryClassesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryRoyaltyInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoyaltyInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoyaltyInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SalePrice != nil {
+		{
+			size, err := m.SalePrice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoyaltyInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoyaltyInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoyaltyInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RoyaltyAmount != nil {
+		{
+			size, err := m.RoyaltyAmount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1848,6 +2122,44 @@ func (m *QueryClassesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryRoyaltyInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SalePrice != nil {
+		l = m.SalePrice.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRoyaltyInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RoyaltyAmount != nil {
+		l = m.RoyaltyAmount.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3228,6 +3540,278 @@ func (m *QueryClassesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryRoyaltyInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRoyaltyInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRoyaltyInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SalePrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SalePrice == nil {
+				m.SalePrice = &types.Coin{}
+			}
+			if err := m.SalePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRoyaltyInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRoyaltyInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRoyaltyInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &RoyaltyInfo{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RoyaltyAmount == nil {
+				m.RoyaltyAmount = &types.Coin{}
+			}
+			if err := m.RoyaltyAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
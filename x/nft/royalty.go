@@ -0,0 +1,31 @@
+package nft
+
+import (
+	"cosmossdk.io/core/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxRoyaltyBasisPoints is the maximum royalty basis points, i.e. 100% of the sale price
+const MaxRoyaltyBasisPoints = 10000
+
+// Validate checks that the royalty recipient is a valid address and that the
+// basis points do not exceed MaxRoyaltyBasisPoints. A nil royalty is valid.
+func (r *RoyaltyInfo) Validate(ac address.Codec) error {
+	if r == nil {
+		return nil
+	}
+	if r.BasisPoints > MaxRoyaltyBasisPoints {
+		return ErrInvalidRoyalty.Wrapf("basis points %d exceed %d", r.BasisPoints, MaxRoyaltyBasisPoints)
+	}
+	if _, err := ac.StringToBytes(r.Recipient); err != nil {
+		return ErrInvalidRoyalty.Wrapf("invalid recipient address: %s", err)
+	}
+	return nil
+}
+
+// Amount returns the royalty owed for the given sale price, rounded down.
+func (r RoyaltyInfo) Amount(salePrice sdk.Coin) sdk.Coin {
+	amount := salePrice.Amount.MulRaw(int64(r.BasisPoints)).QuoRaw(MaxRoyaltyBasisPoints)
+	return sdk.NewCoin(salePrice.Denom, amount)
+}
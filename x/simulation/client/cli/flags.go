@@ -14,6 +14,7 @@ var (
 	FlagExportParamsHeightValue int
 	FlagExportStatePathValue    string
 	FlagExportStatsPathValue    string
+	FlagReproBundleDirValue     string
 	FlagSeedValue               int64
 	FlagInitialBlockHeightValue int
 	FlagNumBlocksValue          int
@@ -33,12 +34,13 @@ var (
 // GetSimulatorFlags gets the values of all the available simulation flags
 func GetSimulatorFlags() {
 	// config fields
-	flag.StringVar(&FlagGenesisFileValue, "Genesis", "", "custom simulation genesis file; cannot be used with params file")
-	flag.StringVar(&FlagParamsFileValue, "Params", "", "custom simulation params file which overrides any random params; cannot be used with genesis")
+	flag.StringVar(&FlagGenesisFileValue, "Genesis", "", "custom simulation genesis file")
+	flag.StringVar(&FlagParamsFileValue, "Params", "", "custom simulation params file (JSON or TOML) which overrides any random operation weights and params")
 	flag.StringVar(&FlagExportParamsPathValue, "ExportParamsPath", "", "custom file path to save the exported params JSON")
 	flag.IntVar(&FlagExportParamsHeightValue, "ExportParamsHeight", 0, "height to which export the randomly generated params")
 	flag.StringVar(&FlagExportStatePathValue, "ExportStatePath", "", "custom file path to save the exported app state JSON")
 	flag.StringVar(&FlagExportStatsPathValue, "ExportStatsPath", "", "custom file path to save the exported simulation statistics JSON")
+	flag.StringVar(&FlagReproBundleDirValue, "ReproBundleDir", "", "custom directory to save a reproduction bundle to if the simulation fails")
 	flag.Int64Var(&FlagSeedValue, "Seed", 42, "simulation random seed")
 	flag.IntVar(&FlagInitialBlockHeightValue, "InitialBlockHeight", 1, "initial block to start the simulation")
 	flag.IntVar(&FlagNumBlocksValue, "NumBlocks", 500, "number of new blocks to simulate from the initial block height")
@@ -65,6 +67,7 @@ func NewConfigFromFlags() simulation.Config {
 		ExportParamsHeight: FlagExportParamsHeightValue,
 		ExportStatePath:    FlagExportStatePathValue,
 		ExportStatsPath:    FlagExportStatsPathValue,
+		ReproBundleDir:     FlagReproBundleDirValue,
 		Seed:               FlagSeedValue,
 		InitialBlockHeight: FlagInitialBlockHeightValue,
 		NumBlocks:          FlagNumBlocksValue,
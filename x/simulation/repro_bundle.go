@@ -0,0 +1,272 @@
+package simulation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// maxReproBundleOps is the number of the last operations written to the
+// operations log of a reproduction bundle.
+const maxReproBundleOps = 1000
+
+// failureRecorder wraps the testing.TB of a simulation to record the message of
+// its failure, for the reproduction bundle.
+type failureRecorder struct {
+	testing.TB
+	failure string
+}
+
+func (f *failureRecorder) Fatalf(format string, args ...interface{}) {
+	f.failure = fmt.Sprintf(format, args...)
+	f.TB.Fatalf(format, args...)
+}
+
+func (f *failureRecorder) FailNow() {
+	if f.failure == "" {
+		f.failure = "queued operation failed"
+	}
+	f.TB.FailNow()
+}
+
+// writeReproBundle writes the reproduction bundle of a failed simulation to dir,
+// with the tail of the operations log of logWriter.
+func writeReproBundle(dir string, bundle simulation.ReproBundle, logWriter LogWriter) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	bz, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, simulation.ReproBundleFile), bz, 0o600); err != nil {
+		return err
+	}
+
+	paramsFile := simulation.ReproBundleParamsFile
+	if filepath.Ext(bundle.Config.ParamsFile) == ".toml" {
+		paramsFile = simulation.ReproBundleParamsTOMLFile
+	}
+
+	for src, dst := range map[string]string{
+		bundle.Config.ParamsFile:  paramsFile,
+		bundle.Config.GenesisFile: simulation.ReproBundleGenesisFile,
+	} {
+		if src == "" {
+			continue
+		}
+
+		bz, err := os.ReadFile(src)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(dir, dst), bz, 0o600); err != nil {
+			return err
+		}
+	}
+
+	lw, ok := logWriter.(*StandardLogWriter)
+	if !ok {
+		return nil
+	}
+
+	f, err := os.Create(filepath.Join(dir, simulation.ReproBundleOpsFile))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	entries := lw.OpEntries
+	if len(entries) > maxReproBundleOps {
+		entries = entries[len(entries)-maxReproBundleOps:]
+	}
+	for _, entry := range entries {
+		if _, err := fmt.Fprintf(f, "%s\n", entry.MustMarshal()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// replayTB is the testing.TB of a replayed simulation, which stops the
+// simulation on failure without failing a test. The testing.TB interface is
+// only embedded for its unexported method, every exported method is
+// implemented.
+type replayTB struct {
+	testing.TB
+	w        io.Writer
+	ctx      context.Context
+	cancel   context.CancelFunc
+	cleanups []func()
+	failed   bool
+	skipped  bool
+}
+
+func newReplayTB(w io.Writer) *replayTB {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &replayTB{w: w, ctx: ctx, cancel: cancel}
+}
+
+func (tb *replayTB) Helper() {}
+
+func (tb *replayTB) Name() string { return "replay" }
+
+func (tb *replayTB) Context() context.Context { return tb.ctx }
+
+func (tb *replayTB) Output() io.Writer { return tb.w }
+
+func (tb *replayTB) Failed() bool { return tb.failed }
+
+func (tb *replayTB) Skipped() bool { return tb.skipped }
+
+func (tb *replayTB) Log(args ...interface{}) { fmt.Fprintln(tb.w, args...) }
+
+func (tb *replayTB) Logf(format string, args ...interface{}) { fmt.Fprintf(tb.w, format+"\n", args...) }
+
+func (tb *replayTB) Attr(key, value string) { tb.Logf("=== ATTR  %s %s %s", tb.Name(), key, value) }
+
+func (tb *replayTB) Fail() { tb.failed = true }
+
+func (tb *replayTB) FailNow() {
+	tb.failed = true
+	runtime.Goexit()
+}
+
+func (tb *replayTB) Error(args ...interface{}) {
+	tb.Log(args...)
+	tb.Fail()
+}
+
+func (tb *replayTB) Errorf(format string, args ...interface{}) {
+	tb.Logf(format, args...)
+	tb.Fail()
+}
+
+func (tb *replayTB) Fatal(args ...interface{}) {
+	tb.Log(args...)
+	tb.FailNow()
+}
+
+func (tb *replayTB) Fatalf(format string, args ...interface{}) {
+	tb.Logf(format, args...)
+	tb.FailNow()
+}
+
+func (tb *replayTB) SkipNow() {
+	tb.skipped = true
+	runtime.Goexit()
+}
+
+func (tb *replayTB) Skip(args ...interface{}) {
+	tb.Log(args...)
+	tb.SkipNow()
+}
+
+func (tb *replayTB) Skipf(format string, args ...interface{}) {
+	tb.Logf(format, args...)
+	tb.SkipNow()
+}
+
+func (tb *replayTB) Cleanup(f func()) { tb.cleanups = append(tb.cleanups, f) }
+
+func (tb *replayTB) TempDir() string {
+	dir, err := os.MkdirTemp("", "replay")
+	if err != nil {
+		tb.Fatalf("failed to create temporary directory: %v", err)
+	}
+	tb.Cleanup(func() { _ = os.RemoveAll(dir) })
+
+	return dir
+}
+
+func (tb *replayTB) ArtifactDir() string { return tb.TempDir() }
+
+func (tb *replayTB) Setenv(key, value string) {
+	prev, ok := os.LookupEnv(key)
+	if err := os.Setenv(key, value); err != nil {
+		tb.Fatalf("failed to set environment variable %s: %v", key, err)
+	}
+	tb.Cleanup(func() {
+		if ok {
+			_ = os.Setenv(key, prev)
+		} else {
+			_ = os.Unsetenv(key)
+		}
+	})
+}
+
+func (tb *replayTB) Chdir(dir string) {
+	prev, err := os.Getwd()
+	if err != nil {
+		tb.Fatalf("failed to get the working directory: %v", err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		tb.Fatalf("failed to change the working directory: %v", err)
+	}
+	tb.Cleanup(func() { _ = os.Chdir(prev) })
+}
+
+// runCleanups cancels the context and runs the cleanup functions in the
+// reverse order of their registration, as testing.T does at the end of a test.
+func (tb *replayTB) runCleanups() {
+	tb.cancel()
+	for i := len(tb.cleanups) - 1; i >= 0; i-- {
+		tb.cleanups[i]()
+	}
+	tb.cleanups = nil
+}
+
+// ReplayReproBundle replays the simulation of a reproduction bundle against a
+// new app. If the replayed simulation fails, its own reproduction bundle is
+// written to dir and returned, so that its failure, app hash and operations log
+// can be compared to those of the original bundle: a failure at another height
+// or with another app hash reveals a nondeterminism.
+func ReplayReproBundle(
+	w io.Writer,
+	bundle simulation.ReproBundle,
+	dir string,
+	app *baseapp.BaseApp,
+	appStateFn simulation.AppStateFn,
+	randAccFn simulation.RandomAccountFn,
+	ops WeightedOperations,
+	blockedAddrs map[string]bool,
+	cdc codec.JSONCodec,
+) (replayed simulation.ReproBundle, failed bool, err error) {
+	config := bundle.Config
+	config.ReproBundleDir = dir
+
+	tb := newReplayTB(w)
+	defer tb.runCleanups()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		// the panic has already been recorded in the reproduction bundle
+		defer func() { _ = recover() }()
+
+		_, _, err = SimulateFromSeed(tb, w, app, appStateFn, randAccFn, ops, blockedAddrs, config, cdc)
+	}()
+	<-done
+
+	if _, statErr := os.Stat(filepath.Join(dir, simulation.ReproBundleFile)); statErr != nil {
+		return simulation.ReproBundle{}, false, err
+	}
+
+	replayed, readErr := simulation.ReadReproBundle(dir)
+	if readErr != nil {
+		return simulation.ReproBundle{}, true, readErr
+	}
+
+	return replayed, true, err
+}
@@ -0,0 +1,87 @@
+package simulation
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestReproBundle(t *testing.T) {
+	dir := t.TempDir()
+	paramsFile := filepath.Join(dir, "sim_params.json")
+	require.NoError(t, os.WriteFile(paramsFile, []byte(`{"op_weight_msg_send":100}`), 0o600))
+
+	logWriter := &StandardLogWriter{}
+	for height := int64(1); height <= maxReproBundleOps; height++ {
+		logWriter.AddEntry(BeginBlockEntry(height))
+		logWriter.AddEntry(EndBlockEntry(height))
+	}
+
+	bundleDir := filepath.Join(dir, "bundle")
+	bundle := simulation.ReproBundle{
+		Config:      simulation.Config{Seed: 42, NumBlocks: 500, ParamsFile: paramsFile, ChainID: "simulation-app"},
+		Height:      123,
+		Operations:  4567,
+		Failure:     "invariant broken",
+		LastAppHash: "ABCD",
+	}
+	require.NoError(t, writeReproBundle(bundleDir, bundle, logWriter))
+
+	read, err := simulation.ReadReproBundle(bundleDir)
+	require.NoError(t, err)
+
+	expected := bundle
+	expected.Config.ParamsFile = filepath.Join(bundleDir, simulation.ReproBundleParamsFile)
+	require.Equal(t, expected, read)
+
+	bz, err := os.ReadFile(read.Config.ParamsFile)
+	require.NoError(t, err)
+	require.Equal(t, `{"op_weight_msg_send":100}`, string(bz))
+
+	bz, err = os.ReadFile(filepath.Join(bundleDir, simulation.ReproBundleOpsFile))
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(bz)), "\n")
+	require.Len(t, lines, maxReproBundleOps)
+	require.Equal(t, string(EndBlockEntry(maxReproBundleOps).MustMarshal()), lines[len(lines)-1])
+}
+
+func TestReplayTB(t *testing.T) {
+	var buf bytes.Buffer
+	tb := newReplayTB(&buf)
+
+	dir := tb.TempDir()
+	require.DirExists(t, dir)
+	require.DirExists(t, tb.ArtifactDir())
+
+	tb.Setenv("REPLAY_TB_TEST", "1")
+	require.Equal(t, "1", os.Getenv("REPLAY_TB_TEST"))
+
+	var cleanups []int
+	tb.Cleanup(func() { cleanups = append(cleanups, 1) })
+	tb.Cleanup(func() { cleanups = append(cleanups, 2) })
+
+	tb.Errorf("error %d", 1)
+	require.True(t, tb.Failed())
+	require.Equal(t, tb.w, tb.Output())
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		tb.Skipf("skipped %d", 2)
+	}()
+	<-done
+	require.True(t, tb.Skipped())
+	require.Equal(t, "error 1\nskipped 2\n", buf.String())
+
+	tb.runCleanups()
+	require.Equal(t, []int{2, 1}, cleanups)
+	require.NoDirExists(t, dir)
+	require.Empty(t, os.Getenv("REPLAY_TB_TEST"))
+	require.Error(t, tb.Context().Err())
+}
@@ -6,6 +6,7 @@ import (
 	"math/rand"
 	"os"
 	"os/signal"
+	"runtime/debug"
 	"syscall"
 	"testing"
 	"time"
@@ -62,6 +63,13 @@ func SimulateFromSeed(
 	// in case we have to end early, don't os.Exit so that we can run cleanup code.
 	testingMode, _, b := getTestingMode(tb)
 
+	// record the failure of the simulation for its reproduction bundle
+	var recorder *failureRecorder
+	if config.ReproBundleDir != "" {
+		recorder = &failureRecorder{TB: tb}
+		tb = recorder
+	}
+
 	fmt.Fprintf(w, "Starting SimulateFromSeed with randomness created with seed %d\n", int(config.Seed))
 	r := rand.New(rand.NewSource(config.Seed))
 	params := RandomParams(r)
@@ -80,6 +88,13 @@ func SimulateFromSeed(
 
 	config.ChainID = chainID
 
+	// an app with a manual clock has its clock follow the time of the simulated
+	// blocks, so that the logic reading the clock is deterministic too
+	clock, _ := app.Clock().(*sdk.ManualClock)
+	if clock != nil {
+		clock.Set(genesisTimestamp)
+	}
+
 	fmt.Printf(
 		"Starting the simulation from time %v (unixtime %v)\n",
 		genesisTimestamp.UTC().Format(time.UnixDate), genesisTimestamp.Unix(),
@@ -148,6 +163,35 @@ func SimulateFromSeed(
 		}()
 	}
 
+	if recorder != nil {
+		// write the reproduction bundle if the simulation panics or fails
+		defer func() {
+			r := recover()
+			if r != nil {
+				recorder.failure = fmt.Sprintf("panic: %v\n%s", r, debug.Stack())
+			}
+
+			if recorder.failure != "" {
+				bundle := simulation.ReproBundle{
+					Config:      config,
+					Height:      header.Height,
+					Operations:  opCount,
+					Failure:     recorder.failure,
+					LastAppHash: fmt.Sprintf("%X", app.LastCommitID().Hash),
+				}
+				if err := writeReproBundle(config.ReproBundleDir, bundle, logWriter); err != nil {
+					fmt.Fprintf(w, "\nFailed to write the reproduction bundle: %v\n", err)
+				} else {
+					fmt.Fprintf(w, "\nReproduction bundle written to %s\n", config.ReproBundleDir)
+				}
+			}
+
+			if r != nil {
+				panic(r)
+			}
+		}()
+	}
+
 	// set exported params to the initial state
 	if config.ExportParamsPath != "" && config.ExportParamsHeight == 0 {
 		exportedParams = params
@@ -191,6 +235,9 @@ func SimulateFromSeed(
 			time.Duration(minTimePerBlock) * time.Second)
 		header.Time = header.Time.Add(
 			time.Duration(int64(r.Intn(int(timeDiff)))) * time.Second)
+		if clock != nil {
+			clock.Set(header.Time)
+		}
 		header.ProposerAddress = validators.randomProposer(r)
 
 		logWriter.AddEntry(EndBlockEntry(int64(height)))
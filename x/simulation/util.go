@@ -14,17 +14,15 @@ import (
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 )
 
+// getTestingMode returns whether the simulation is run in testing mode, that is
+// by a test or a replay rather than by a benchmark.
 func getTestingMode(tb testing.TB) (testingMode bool, t *testing.T, b *testing.B) {
-	testingMode = false
-
-	if _t, ok := tb.(*testing.T); ok {
-		t = _t
-		testingMode = true
-	} else {
-		b = tb.(*testing.B)
+	if _b, ok := tb.(*testing.B); ok {
+		return false, nil, _b
 	}
 
-	return testingMode, t, b
+	t, _ = tb.(*testing.T)
+	return true, t, nil
 }
 
 // getBlockSize returns a block size as determined from the transition matrix.
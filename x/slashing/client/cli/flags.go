@@ -2,4 +2,5 @@ package cli
 
 const (
 	FlagAddressValidator = "validator"
+	FlagWindow           = "window"
 )
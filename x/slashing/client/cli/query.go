@@ -27,6 +27,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQuerySigningInfo(),
 		GetCmdQueryParams(),
 		GetCmdQuerySigningInfos(),
+		GetCmdQueryMissedBlocks(),
+		GetCmdQueryUptime(),
 	)
 
 	return slashingQueryCmd
@@ -108,6 +110,90 @@ $ <appd> query slashing signing-infos
 	return cmd
 }
 
+// GetCmdQueryMissedBlocks implements the command to query the missed blocks of
+// a validator.
+func GetCmdQueryMissedBlocks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "missed-blocks [validator-conspub]",
+		Short: "Query the missed blocks of a validator's signed blocks window",
+		Long: strings.TrimSpace(`Use a validators' consensus public key to find the missed blocks of the signed blocks window of that validator:
+
+$ <appd> query slashing missed-blocks '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"OauFcTKbN5Lx3fJL689cikXBqe+hcp6Y+x0rYUdR9Jk="}'
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pk cryptotypes.PubKey
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &pk); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			consAddr := sdk.ConsAddress(pk.Address())
+			params := &types.QueryMissedBlocksRequest{ConsAddress: consAddr.String()}
+			res, err := queryClient.MissedBlocks(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryUptime implements the command to query the uptime of a validator.
+func GetCmdQueryUptime() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "uptime [validator-conspub]",
+		Short: "Query the uptime of a validator over its most recent blocks",
+		Long: strings.TrimSpace(`Use a validators' consensus public key to compute the uptime of that validator over its most recent blocks.
+The window defaults to, and cannot exceed, the signed blocks window:
+
+$ <appd> query slashing uptime '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"OauFcTKbN5Lx3fJL689cikXBqe+hcp6Y+x0rYUdR9Jk="}' --window=1000
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pk cryptotypes.PubKey
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &pk); err != nil {
+				return err
+			}
+
+			window, err := cmd.Flags().GetInt64(FlagWindow)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			consAddr := sdk.ConsAddress(pk.Address())
+			params := &types.QueryUptimeRequest{ConsAddress: consAddr.String(), Window: window}
+			res, err := queryClient.Uptime(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64(FlagWindow, 0, "The number of most recent blocks to compute the uptime over, defaults to the signed blocks window")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryParams implements a command to fetch slashing parameters.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -119,6 +119,46 @@ func (s *CLITestSuite) TestGetCmdQuerySigningInfo() {
 	}
 }
 
+func (s *CLITestSuite) TestGetCmdQueryUptime() {
+	pubKeyBz, err := s.encCfg.Codec.MarshalInterfaceJSON(s.pub)
+	s.Require().NoError(err)
+	pubKeyStr := string(pubKeyBz)
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{"invalid address", []string{"foo"}, true},
+		{"invalid window", []string{pubKeyStr, fmt.Sprintf("--%s=foo", cli.FlagWindow)}, true},
+		{
+			"valid address (json output)",
+			[]string{
+				pubKeyStr,
+				fmt.Sprintf("--%s=100", cli.FlagWindow),
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryUptime()
+			clientCtx := s.clientCtx
+
+			_, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
 func (s *CLITestSuite) TestGetCmdQueryParams() {
 	testCases := []struct {
 		name string
@@ -76,3 +76,70 @@ func (k Keeper) SigningInfos(c context.Context, req *types.QuerySigningInfosRequ
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+// MissedBlocks returns the missed blocks of the signed blocks window of a
+// specific validator.
+func (k Keeper) MissedBlocks(c context.Context, req *types.QueryMissedBlocksRequest) (*types.QueryMissedBlocksResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	signingInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "SigningInfo not found for validator %s", req.ConsAddress)
+	}
+
+	return &types.QueryMissedBlocksResponse{
+		IndexOffset:  signingInfo.IndexOffset,
+		MissedBlocks: k.GetValidatorMissedBlocks(ctx, consAddr),
+	}, nil
+}
+
+// Uptime returns the uptime of a specific validator over its most recent
+// blocks.
+func (k Keeper) Uptime(c context.Context, req *types.QueryUptimeRequest) (*types.QueryUptimeResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	signedBlocksWindow := k.SignedBlocksWindow(ctx)
+
+	window := req.Window
+	switch {
+	case window == 0:
+		window = signedBlocksWindow
+	case window < 0 || window > signedBlocksWindow:
+		return nil, status.Errorf(codes.InvalidArgument, "window must be between 1 and the signed blocks window %d, got %d", signedBlocksWindow, window)
+	}
+
+	if !k.HasValidatorSigningInfo(ctx, consAddr) {
+		return nil, status.Errorf(codes.NotFound, "SigningInfo not found for validator %s", req.ConsAddress)
+	}
+
+	uptime, blocks, missed, err := k.GetValidatorUptime(ctx, consAddr, window)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUptimeResponse{Uptime: uptime, Blocks: blocks, MissedBlocks: missed}, nil
+}
@@ -88,3 +88,55 @@ func (s *KeeperTestSuite) TestGRPCSigningInfos() {
 	require.NotNil(infoResp.Pagination.NextKey)
 	require.Equal(uint64(2), infoResp.Pagination.Total)
 }
+
+func (s *KeeperTestSuite) TestGRPCMissedBlocksAndUptime() {
+	queryClient, ctx, keeper := s.queryClient, s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	params := testutil.TestParams()
+	params.SignedBlocksWindow = 100
+	require.NoError(keeper.SetParams(ctx, params))
+
+	_, err := queryClient.Uptime(gocontext.Background(), &slashingtypes.QueryUptimeRequest{ConsAddress: consAddr.String()})
+	require.Error(err)
+
+	// the validator signed 150 blocks, and missed the 10 most recent ones
+	signingInfo := slashingtypes.NewValidatorSigningInfo(consAddr, 0, 150, time.Unix(0, 0), false, 10)
+	keeper.SetValidatorSigningInfo(ctx, consAddr, signingInfo)
+	for offset := int64(140); offset < 150; offset++ {
+		require.NoError(keeper.SetMissedBlockBitmapValue(ctx, consAddr, offset%params.SignedBlocksWindow, true))
+	}
+
+	missedResp, err := queryClient.MissedBlocks(gocontext.Background(), &slashingtypes.QueryMissedBlocksRequest{ConsAddress: consAddr.String()})
+	require.NoError(err)
+	require.Equal(int64(150), missedResp.IndexOffset)
+	require.Len(missedResp.MissedBlocks, 10)
+
+	testCases := []struct {
+		window    int64
+		expErr    bool
+		expBlocks int64
+		expMissed int64
+		expUptime sdk.Dec
+	}{
+		{0, false, 100, 10, sdk.NewDecWithPrec(9, 1)},
+		{20, false, 20, 10, sdk.NewDecWithPrec(5, 1)},
+		{5, false, 5, 5, sdk.ZeroDec()},
+		{101, true, 0, 0, sdk.Dec{}},
+		{-1, true, 0, 0, sdk.Dec{}},
+	}
+
+	for _, tc := range testCases {
+		uptimeResp, err := queryClient.Uptime(gocontext.Background(),
+			&slashingtypes.QueryUptimeRequest{ConsAddress: consAddr.String(), Window: tc.window})
+		if tc.expErr {
+			require.Error(err)
+			continue
+		}
+
+		require.NoError(err)
+		require.Equal(tc.expBlocks, uptimeResp.Blocks)
+		require.Equal(tc.expMissed, uptimeResp.MissedBlocks)
+		require.True(tc.expUptime.Equal(uptimeResp.Uptime), uptimeResp.Uptime.String())
+	}
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
@@ -120,6 +121,27 @@ func (s *KeeperTestSuite) TestJailAndSlashWithInfractionReason() {
 	s.slashingKeeper.Jail(s.ctx, consAddr)
 }
 
+func (s *KeeperTestSuite) TestInfractionPenalty() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	// without infraction penalties, the legacy params are used
+	params := keeper.GetParams(ctx)
+	require.Equal(params.SlashFractionDoubleSign, keeper.SlashFractionDoubleSign(ctx))
+	require.Equal(params.SlashFractionDowntime, keeper.SlashFractionDowntime(ctx))
+	require.Equal(params.DowntimeJailDuration, keeper.DowntimeJailDuration(ctx))
+	require.True(keeper.InfractionPenalty(ctx, stakingtypes.Infraction_INFRACTION_UNSPECIFIED).SlashFraction.IsZero())
+
+	downtimePenalty := slashingtypes.NewInfractionPenalty(stakingtypes.Infraction_INFRACTION_DOWNTIME, sdk.NewDecWithPrec(1, 3), time.Hour)
+	params.InfractionPenalties = []slashingtypes.InfractionPenalty{downtimePenalty}
+	require.NoError(keeper.SetParams(ctx, params))
+
+	require.Equal(downtimePenalty, keeper.InfractionPenalty(ctx, stakingtypes.Infraction_INFRACTION_DOWNTIME))
+	require.Equal(downtimePenalty.SlashFraction, keeper.SlashFractionDowntime(ctx))
+	require.Equal(time.Hour, keeper.DowntimeJailDuration(ctx))
+	require.Equal(params.SlashFractionDoubleSign, keeper.SlashFractionDoubleSign(ctx))
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -113,6 +113,97 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 			expectErr: true,
 			expErrMsg: "downtime slash fraction cannot be negative",
 		},
+		{
+			name: "set invalid infraction penalty slash fraction",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:      int64(750),
+					MinSignedPerWindow:      minSignedPerWindow,
+					DowntimeJailDuration:    time.Duration(34800000000000),
+					SlashFractionDoubleSign: slashFractionDoubleSign,
+					SlashFractionDowntime:   slashFractionDowntime,
+					InfractionPenalties: []slashingtypes.InfractionPenalty{
+						slashingtypes.NewInfractionPenalty(types.Infraction_INFRACTION_DOWNTIME, invalidVal, time.Hour),
+					},
+				},
+			},
+			expectErr: true,
+			expErrMsg: "INFRACTION_DOWNTIME slash fraction cannot be negative",
+		},
+		{
+			name: "set unspecified infraction penalty",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:      int64(750),
+					MinSignedPerWindow:      minSignedPerWindow,
+					DowntimeJailDuration:    time.Duration(34800000000000),
+					SlashFractionDoubleSign: slashFractionDoubleSign,
+					SlashFractionDowntime:   slashFractionDowntime,
+					InfractionPenalties: []slashingtypes.InfractionPenalty{
+						slashingtypes.NewInfractionPenalty(types.Infraction_INFRACTION_UNSPECIFIED, slashFractionDowntime, time.Hour),
+					},
+				},
+			},
+			expectErr: true,
+			expErrMsg: "invalid infraction",
+		},
+		{
+			name: "set zero downtime jail duration",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:      int64(750),
+					MinSignedPerWindow:      minSignedPerWindow,
+					DowntimeJailDuration:    time.Duration(34800000000000),
+					SlashFractionDoubleSign: slashFractionDoubleSign,
+					SlashFractionDowntime:   slashFractionDowntime,
+					InfractionPenalties: []slashingtypes.InfractionPenalty{
+						slashingtypes.NewInfractionPenalty(types.Infraction_INFRACTION_DOWNTIME, slashFractionDowntime, 0),
+					},
+				},
+			},
+			expectErr: true,
+			expErrMsg: "INFRACTION_DOWNTIME jail duration must be positive",
+		},
+		{
+			name: "set duplicate infraction penalties",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:      int64(750),
+					MinSignedPerWindow:      minSignedPerWindow,
+					DowntimeJailDuration:    time.Duration(34800000000000),
+					SlashFractionDoubleSign: slashFractionDoubleSign,
+					SlashFractionDowntime:   slashFractionDowntime,
+					InfractionPenalties: []slashingtypes.InfractionPenalty{
+						slashingtypes.NewInfractionPenalty(types.Infraction_INFRACTION_DOWNTIME, slashFractionDowntime, time.Hour),
+						slashingtypes.NewInfractionPenalty(types.Infraction_INFRACTION_DOWNTIME, slashFractionDowntime, time.Minute),
+					},
+				},
+			},
+			expectErr: true,
+			expErrMsg: "duplicate penalty for infraction INFRACTION_DOWNTIME",
+		},
+		{
+			name: "set valid infraction penalties",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:      int64(750),
+					MinSignedPerWindow:      minSignedPerWindow,
+					DowntimeJailDuration:    time.Duration(34800000000000),
+					SlashFractionDoubleSign: slashFractionDoubleSign,
+					SlashFractionDowntime:   slashFractionDowntime,
+					InfractionPenalties: []slashingtypes.InfractionPenalty{
+						slashingtypes.NewInfractionPenalty(types.Infraction_INFRACTION_DOUBLE_SIGN, slashFractionDoubleSign, 0),
+						slashingtypes.NewInfractionPenalty(types.Infraction_INFRACTION_DOWNTIME, slashFractionDowntime, time.Hour),
+					},
+				},
+			},
+			expectErr: false,
+		},
 		{
 			name: "set full valid params",
 			request: &slashingtypes.MsgUpdateParams{
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // SignedBlocksWindow - sliding window for downtime slashing
@@ -24,17 +25,22 @@ func (k Keeper) MinSignedPerWindow(ctx sdk.Context) int64 {
 
 // DowntimeJailDuration - Downtime unbond duration
 func (k Keeper) DowntimeJailDuration(ctx sdk.Context) (res time.Duration) {
-	return k.GetParams(ctx).DowntimeJailDuration
+	return k.InfractionPenalty(ctx, stakingtypes.Infraction_INFRACTION_DOWNTIME).JailDuration
 }
 
 // SlashFractionDoubleSign - fraction of power slashed in case of double sign
 func (k Keeper) SlashFractionDoubleSign(ctx sdk.Context) (res sdk.Dec) {
-	return k.GetParams(ctx).SlashFractionDoubleSign
+	return k.InfractionPenalty(ctx, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN).SlashFraction
 }
 
 // SlashFractionDowntime - fraction of power slashed for downtime
 func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
-	return k.GetParams(ctx).SlashFractionDowntime
+	return k.InfractionPenalty(ctx, stakingtypes.Infraction_INFRACTION_DOWNTIME).SlashFraction
+}
+
+// InfractionPenalty - slash fraction and jail duration applied for the given infraction
+func (k Keeper) InfractionPenalty(ctx sdk.Context, infraction stakingtypes.Infraction) types.InfractionPenalty {
+	return k.GetParams(ctx).PenaltyFor(infraction)
 }
 
 // GetParams returns the current x/slashing module parameters.
@@ -227,3 +227,50 @@ func (k Keeper) GetValidatorMissedBlocks(ctx sdk.Context, addr sdk.ConsAddress)
 
 	return missedBlocks
 }
+
+// GetValidatorUptime returns the ratio of signed blocks of a validator over its
+// most recent blocks, along with the number of blocks it was computed over and
+// the number of missed blocks among them. The window is capped by the number of
+// blocks the validator was expected to sign since it was bonded, and the
+// SignedBlocksWindow param must be an upper bound of the window.
+func (k Keeper) GetValidatorUptime(ctx sdk.Context, addr sdk.ConsAddress, window int64) (uptime sdk.Dec, blocks, missed int64, err error) {
+	signInfo, found := k.GetValidatorSigningInfo(ctx, addr)
+	if !found {
+		return sdk.Dec{}, 0, 0, types.ErrNoSigningInfoFound.Wrap(addr.String())
+	}
+
+	signedBlocksWindow := k.SignedBlocksWindow(ctx)
+	blocks = window
+	if signInfo.IndexOffset < blocks {
+		blocks = signInfo.IndexOffset
+	}
+	if blocks == 0 {
+		return sdk.OneDec(), 0, 0, nil
+	}
+
+	// the bitmap chunks are decoded at most once, as consecutive indexes mostly
+	// fall in the same chunk
+	chunks := make(map[int64]*bitset.BitSet)
+	for i := int64(1); i <= blocks; i++ {
+		index := (signInfo.IndexOffset - i) % signedBlocksWindow
+		chunkIndex := index / types.MissedBlockBitmapChunkSize
+
+		bs, ok := chunks[chunkIndex]
+		if !ok {
+			bs = bitset.New(uint(types.MissedBlockBitmapChunkSize))
+			if chunk := k.getMissedBlockBitmapChunk(ctx, addr, chunkIndex); chunk != nil {
+				if err := bs.UnmarshalBinary(chunk); err != nil {
+					return sdk.Dec{}, 0, 0, errors.Wrapf(err, "failed to decode bitmap chunk; index: %d", index)
+				}
+			}
+			chunks[chunkIndex] = bs
+		}
+
+		if bs.Test(uint(index % types.MissedBlockBitmapChunkSize)) {
+			missed++
+		}
+	}
+
+	uptime = sdk.NewDec(blocks - missed).QuoInt64(blocks)
+	return uptime, blocks, missed, nil
+}
@@ -13,6 +13,9 @@ const (
 	AttributeKeyMissedBlocks = "missed_blocks"
 	AttributeKeyBurnedCoins  = "burned_coins"
 
+	AttributeKeyInfraction    = "infraction"
+	AttributeKeySlashFraction = "slash_fraction"
+
 	AttributeValueDoubleSign       = "double_sign"
 	AttributeValueMissingSignature = "missing_signature"
 )
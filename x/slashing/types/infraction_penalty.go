@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// NewInfractionPenalty creates a new InfractionPenalty object
+func NewInfractionPenalty(infraction stakingtypes.Infraction, slashFraction math.LegacyDec, jailDuration time.Duration) InfractionPenalty {
+	return InfractionPenalty{
+		Infraction:    infraction,
+		SlashFraction: slashFraction,
+		JailDuration:  jailDuration,
+	}
+}
+
+// PenaltyFor returns the penalty applied for the given infraction. An infraction
+// without an entry in the infraction penalties falls back to the double sign or
+// downtime params, and any other infraction to no penalty.
+func (p Params) PenaltyFor(infraction stakingtypes.Infraction) InfractionPenalty {
+	for _, penalty := range p.InfractionPenalties {
+		if penalty.Infraction == infraction {
+			return penalty
+		}
+	}
+
+	switch infraction {
+	case stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN:
+		return NewInfractionPenalty(infraction, p.SlashFractionDoubleSign, 0)
+	case stakingtypes.Infraction_INFRACTION_DOWNTIME:
+		return NewInfractionPenalty(infraction, p.SlashFractionDowntime, p.DowntimeJailDuration)
+	default:
+		return NewInfractionPenalty(infraction, math.LegacyZeroDec(), 0)
+	}
+}
+
+// Validate validates the infraction penalty
+func (p InfractionPenalty) Validate() error {
+	if _, ok := stakingtypes.Infraction_name[int32(p.Infraction)]; !ok || p.Infraction == stakingtypes.Infraction_INFRACTION_UNSPECIFIED {
+		return fmt.Errorf("invalid infraction: %s", p.Infraction)
+	}
+
+	if p.SlashFraction.IsNil() {
+		return fmt.Errorf("%s slash fraction cannot be nil", p.Infraction)
+	}
+	if p.SlashFraction.IsNegative() {
+		return fmt.Errorf("%s slash fraction cannot be negative: %s", p.Infraction, p.SlashFraction)
+	}
+	if p.SlashFraction.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("%s slash fraction too large: %s", p.Infraction, p.SlashFraction)
+	}
+
+	if p.JailDuration < 0 {
+		return fmt.Errorf("%s jail duration cannot be negative: %s", p.Infraction, p.JailDuration)
+	}
+	if p.Infraction == stakingtypes.Infraction_INFRACTION_DOWNTIME && p.JailDuration == 0 {
+		return fmt.Errorf("%s jail duration must be positive", p.Infraction)
+	}
+
+	return nil
+}
+
+func validateInfractionPenalties(i interface{}) error {
+	v, ok := i.([]InfractionPenalty)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[stakingtypes.Infraction]bool, len(v))
+	for _, penalty := range v {
+		if seen[penalty.Infraction] {
+			return fmt.Errorf("duplicate penalty for infraction %s", penalty.Infraction)
+		}
+		if err := penalty.Validate(); err != nil {
+			return err
+		}
+		seen[penalty.Infraction] = true
+	}
+
+	return nil
+}
@@ -63,6 +63,9 @@ func (p Params) Validate() error {
 	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
 		return err
 	}
+	if err := validateInfractionPenalties(p.InfractionPenalties); err != nil {
+		return err
+	}
 	return nil
 }
 
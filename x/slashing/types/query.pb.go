@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -309,6 +310,234 @@ func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryMissedBlocksRequest is the request type for the Query/MissedBlocks RPC
+// method
+//
+// Since: cosmos-sdk 0.50
+type QueryMissedBlocksRequest struct {
+	// cons_address is the address to query the missed blocks of
+	ConsAddress string `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+}
+
+func (m *QueryMissedBlocksRequest) Reset()         { *m = QueryMissedBlocksRequest{} }
+func (m *QueryMissedBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMissedBlocksRequest) ProtoMessage()    {}
+func (*QueryMissedBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{6}
+}
+func (m *QueryMissedBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMissedBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMissedBlocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMissedBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMissedBlocksRequest.Merge(m, src)
+}
+func (m *QueryMissedBlocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMissedBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMissedBlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMissedBlocksRequest proto.InternalMessageInfo
+
+func (m *QueryMissedBlocksRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+// QueryMissedBlocksResponse is the response type for the Query/MissedBlocks RPC
+// method
+//
+// Since: cosmos-sdk 0.50
+type QueryMissedBlocksResponse struct {
+	// index_offset is the index offset of the validator signing info, the index
+	// of the most recent block in the bitmap being (index_offset - 1) modulo the
+	// signed blocks window
+	IndexOffset int64 `protobuf:"varint,1,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	// missed_blocks are the bitmap indexes of the missed blocks in the signed
+	// blocks window
+	MissedBlocks []MissedBlock `protobuf:"bytes,2,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks"`
+}
+
+func (m *QueryMissedBlocksResponse) Reset()         { *m = QueryMissedBlocksResponse{} }
+func (m *QueryMissedBlocksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMissedBlocksResponse) ProtoMessage()    {}
+func (*QueryMissedBlocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{7}
+}
+func (m *QueryMissedBlocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMissedBlocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMissedBlocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMissedBlocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMissedBlocksResponse.Merge(m, src)
+}
+func (m *QueryMissedBlocksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMissedBlocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMissedBlocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMissedBlocksResponse proto.InternalMessageInfo
+
+func (m *QueryMissedBlocksResponse) GetIndexOffset() int64 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *QueryMissedBlocksResponse) GetMissedBlocks() []MissedBlock {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return nil
+}
+
+// QueryUptimeRequest is the request type for the Query/Uptime RPC method
+//
+// Since: cosmos-sdk 0.50
+type QueryUptimeRequest struct {
+	// cons_address is the address to query the uptime of
+	ConsAddress string `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+	// window is the number of most recent blocks to compute the uptime over. It
+	// defaults to, and cannot exceed, the signed blocks window.
+	Window int64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (m *QueryUptimeRequest) Reset()         { *m = QueryUptimeRequest{} }
+func (m *QueryUptimeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUptimeRequest) ProtoMessage()    {}
+func (*QueryUptimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{8}
+}
+func (m *QueryUptimeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUptimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUptimeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUptimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUptimeRequest.Merge(m, src)
+}
+func (m *QueryUptimeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUptimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUptimeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUptimeRequest proto.InternalMessageInfo
+
+func (m *QueryUptimeRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+func (m *QueryUptimeRequest) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// QueryUptimeResponse is the response type for the Query/Uptime RPC method
+//
+// Since: cosmos-sdk 0.50
+type QueryUptimeResponse struct {
+	// uptime is the ratio of signed blocks over the blocks of the window
+	Uptime github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=uptime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"uptime"`
+	// blocks is the number of blocks the uptime was computed over, which is less
+	// than the requested window while the validator has not been bonded for it
+	Blocks int64 `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// missed_blocks is the number of blocks missed among them
+	MissedBlocks int64 `protobuf:"varint,3,opt,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks,omitempty"`
+}
+
+func (m *QueryUptimeResponse) Reset()         { *m = QueryUptimeResponse{} }
+func (m *QueryUptimeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUptimeResponse) ProtoMessage()    {}
+func (*QueryUptimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{9}
+}
+func (m *QueryUptimeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUptimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUptimeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUptimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUptimeResponse.Merge(m, src)
+}
+func (m *QueryUptimeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUptimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUptimeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUptimeResponse proto.InternalMessageInfo
+
+func (m *QueryUptimeResponse) GetBlocks() int64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *QueryUptimeResponse) GetMissedBlocks() int64 {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.slashing.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.slashing.v1beta1.QueryParamsResponse")
@@ -316,6 +545,10 @@ func init() {
 	proto.RegisterType((*QuerySigningInfoResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfoResponse")
 	proto.RegisterType((*QuerySigningInfosRequest)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosRequest")
 	proto.RegisterType((*QuerySigningInfosResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosResponse")
+	proto.RegisterType((*QueryMissedBlocksRequest)(nil), "cosmos.slashing.v1beta1.QueryMissedBlocksRequest")
+	proto.RegisterType((*QueryMissedBlocksResponse)(nil), "cosmos.slashing.v1beta1.QueryMissedBlocksResponse")
+	proto.RegisterType((*QueryUptimeRequest)(nil), "cosmos.slashing.v1beta1.QueryUptimeRequest")
+	proto.RegisterType((*QueryUptimeResponse)(nil), "cosmos.slashing.v1beta1.QueryUptimeResponse")
 }
 
 func init() {
@@ -323,43 +556,58 @@ func init() {
 }
 
 var fileDescriptor_791b11d41a861ed0 = []byte{
-	// 566 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0xad, 0x16, 0x3a, 0x29, 0xa2, 0x63, 0xa0, 0x69, 0x90, 0x8d, 0xae, 0x90, 0x96,
-	0x6a, 0x76, 0x4c, 0x44, 0x3c, 0x88, 0x07, 0x73, 0xb0, 0x08, 0x1e, 0x34, 0x85, 0x82, 0x5e, 0xc2,
-	0x6c, 0x32, 0x9d, 0x0e, 0x6e, 0x66, 0xb6, 0x3b, 0x9b, 0x60, 0x11, 0x3d, 0x78, 0xf6, 0x20, 0xf8,
-	0x19, 0x04, 0x8f, 0x2a, 0x7e, 0x88, 0x1e, 0x8b, 0x5e, 0x3c, 0x89, 0x24, 0x82, 0x77, 0x3f, 0x81,
-	0x64, 0x66, 0x92, 0x4c, 0x89, 0xab, 0x29, 0x5e, 0x92, 0xe5, 0xbd, 0xf7, 0x7f, 0xff, 0xdf, 0x7b,
-	0x79, 0x1b, 0x78, 0xb9, 0x2d, 0x55, 0x57, 0x2a, 0xac, 0x22, 0xa2, 0xf6, 0xb8, 0x60, 0xb8, 0x5f,
-	0x0b, 0x69, 0x4a, 0x6a, 0x78, 0xbf, 0x47, 0x93, 0x83, 0x20, 0x4e, 0x64, 0x2a, 0xd1, 0xaa, 0x29,
-	0x0a, 0xc6, 0x45, 0x81, 0x2d, 0x2a, 0x6d, 0x5a, 0x75, 0x48, 0x14, 0x35, 0x8a, 0x89, 0x3e, 0x26,
-	0x8c, 0x0b, 0x92, 0x72, 0x29, 0x4c, 0x93, 0x52, 0x81, 0x49, 0x26, 0xf5, 0x23, 0x1e, 0x3d, 0xd9,
-	0xe8, 0x05, 0x26, 0x25, 0x8b, 0x28, 0x26, 0x31, 0xc7, 0x44, 0x08, 0x99, 0x6a, 0x89, 0xb2, 0xd9,
-	0x4a, 0x16, 0xdd, 0x84, 0xc4, 0xd4, 0xad, 0x99, 0xba, 0x96, 0x69, 0x6f, 0x69, 0x4d, 0xea, 0x1c,
-	0xe9, 0x72, 0x21, 0xb1, 0xfe, 0x34, 0x21, 0xbf, 0x00, 0xd1, 0xc3, 0x11, 0xeb, 0x03, 0x92, 0x90,
-	0xae, 0x6a, 0xd2, 0xfd, 0x1e, 0x55, 0xa9, 0xff, 0x08, 0x9e, 0x3f, 0x16, 0x55, 0xb1, 0x14, 0x8a,
-	0xa2, 0x06, 0x5c, 0x8a, 0x75, 0xa4, 0x08, 0x2e, 0x82, 0x8d, 0x7c, 0xbd, 0x1c, 0x64, 0x2c, 0x23,
-	0x30, 0xc2, 0xc6, 0xf2, 0xe1, 0xb7, 0x72, 0xee, 0xdd, 0xcf, 0xf7, 0x9b, 0xa0, 0x69, 0x95, 0xfe,
-	0x0e, 0x5c, 0xd5, 0xad, 0xb7, 0x39, 0x13, 0x5c, 0xb0, 0x7b, 0x62, 0x57, 0x5a, 0x57, 0x74, 0x0b,
-	0xae, 0xb4, 0xa5, 0x50, 0x2d, 0xd2, 0xe9, 0x24, 0x54, 0x19, 0x93, 0xe5, 0x46, 0xf1, 0xf3, 0xa7,
-	0x6a, 0xc1, 0xfa, 0xdc, 0x31, 0x99, 0xed, 0x34, 0xe1, 0x82, 0x35, 0xf3, 0xa3, 0x6a, 0x1b, 0xf2,
-	0x5f, 0xc0, 0xe2, 0x6c, 0x5f, 0xcb, 0x1d, 0xc2, 0xb3, 0x7d, 0x12, 0xb5, 0x94, 0x49, 0xb5, 0xb8,
-	0xd8, 0x95, 0x76, 0x82, 0x6a, 0xe6, 0x04, 0x3b, 0x24, 0xe2, 0x1d, 0x92, 0xca, 0xc4, 0x69, 0xe8,
-	0xce, 0x73, 0xa6, 0x4f, 0x22, 0x27, 0xe5, 0x87, 0xb3, 0xfe, 0xe3, 0x75, 0xa2, 0xbb, 0x10, 0x4e,
-	0x4f, 0xc0, 0x3a, 0x57, 0xc6, 0xce, 0xa3, 0x7b, 0x09, 0xcc, 0x85, 0x4d, 0xb7, 0xc7, 0xa8, 0xd5,
-	0x36, 0x1d, 0xa5, 0xff, 0x11, 0xc0, 0xb5, 0x3f, 0x98, 0xd8, 0x29, 0xef, 0xc3, 0x53, 0x76, 0xb2,
-	0xc5, 0xff, 0x9a, 0x4c, 0x77, 0x41, 0x5b, 0xc7, 0x98, 0x17, 0x34, 0xf3, 0xfa, 0x3f, 0x99, 0x0d,
-	0x8a, 0x0b, 0x5d, 0xff, 0xb5, 0x08, 0x4f, 0x6b, 0x68, 0xf4, 0x0a, 0xc0, 0x25, 0x73, 0x18, 0xe8,
-	0x4a, 0x26, 0xdd, 0xec, 0x35, 0x96, 0xae, 0xce, 0x57, 0x6c, 0xbc, 0xfd, 0xf5, 0x97, 0x5f, 0x7e,
-	0xbc, 0x59, 0xb8, 0x84, 0xca, 0x38, 0xeb, 0x85, 0x31, 0x97, 0x88, 0x3e, 0x00, 0x98, 0x77, 0x56,
-	0x80, 0xae, 0xfd, 0xdd, 0x66, 0xf6, 0x60, 0x4b, 0xb5, 0x13, 0x28, 0x2c, 0xdd, 0x6d, 0x4d, 0x77,
-	0x13, 0xdd, 0xc8, 0xa4, 0x73, 0xaf, 0x54, 0xe1, 0x67, 0xee, 0x1b, 0xf1, 0x1c, 0xbd, 0x05, 0x70,
-	0xc5, 0xfd, 0xf1, 0xd1, 0xfc, 0x08, 0x93, 0x75, 0xd6, 0x4f, 0x22, 0xb1, 0xd8, 0x81, 0xc6, 0xde,
-	0x40, 0x95, 0xf9, 0xb0, 0x1b, 0x5b, 0x87, 0x03, 0x0f, 0x1c, 0x0d, 0x3c, 0xf0, 0x7d, 0xe0, 0x81,
-	0xd7, 0x43, 0x2f, 0x77, 0x34, 0xf4, 0x72, 0x5f, 0x87, 0x5e, 0xee, 0x71, 0x95, 0xf1, 0x74, 0xaf,
-	0x17, 0x06, 0x6d, 0xd9, 0x1d, 0xf7, 0x32, 0x5f, 0x55, 0xd5, 0x79, 0x82, 0x9f, 0x4e, 0x1b, 0xa7,
-	0x07, 0x31, 0x55, 0xe1, 0x92, 0xfe, 0x9b, 0xba, 0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x5f, 0xae,
-	0x17, 0x98, 0x9c, 0x05, 0x00, 0x00,
+	// 802 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xee, 0x52, 0x6d, 0xc2, 0xb4, 0x1a, 0x1d, 0x88, 0x94, 0x46, 0x5b, 0x59, 0xb4, 0x10, 0xa4,
+	0xbb, 0x52, 0x62, 0x3c, 0xf8, 0x23, 0xb1, 0xa0, 0xc4, 0xa0, 0x51, 0x8b, 0x92, 0xe8, 0xa5, 0x4e,
+	0xdb, 0xe9, 0x32, 0xa1, 0x9d, 0x29, 0x9d, 0x2d, 0x3f, 0x62, 0xf4, 0xe0, 0xd9, 0x83, 0x89, 0xde,
+	0x3d, 0x99, 0xe8, 0x4d, 0x0d, 0x7f, 0x81, 0x27, 0x8e, 0x04, 0x2f, 0xc6, 0x03, 0x31, 0xd4, 0xc4,
+	0x7f, 0xc3, 0xec, 0xcc, 0xb4, 0xdd, 0xb5, 0x2c, 0x14, 0xf4, 0x02, 0xbb, 0x6f, 0xde, 0xf7, 0xbe,
+	0xef, 0x7d, 0x3b, 0xef, 0xa5, 0x60, 0xb8, 0xc0, 0x78, 0x85, 0x71, 0x93, 0x97, 0x11, 0x5f, 0x20,
+	0xd4, 0x32, 0x97, 0x27, 0xf2, 0xd8, 0x46, 0x13, 0xe6, 0x52, 0x1d, 0xd7, 0xd6, 0x8c, 0x6a, 0x8d,
+	0xd9, 0x0c, 0x0e, 0xc8, 0x24, 0xa3, 0x99, 0x64, 0xa8, 0xa4, 0xd8, 0x98, 0x42, 0xe7, 0x11, 0xc7,
+	0x12, 0xd1, 0xc2, 0x57, 0x91, 0x45, 0x28, 0xb2, 0x09, 0xa3, 0xb2, 0x48, 0xac, 0xdf, 0x62, 0x16,
+	0x13, 0x8f, 0xa6, 0xf3, 0xa4, 0xa2, 0xa7, 0x2d, 0xc6, 0xac, 0x32, 0x36, 0x51, 0x95, 0x98, 0x88,
+	0x52, 0x66, 0x0b, 0x08, 0x57, 0xa7, 0x49, 0x3f, 0x75, 0x2d, 0x25, 0x32, 0xef, 0xbc, 0x5f, 0x9e,
+	0x85, 0x29, 0xe6, 0xa4, 0x59, 0x6e, 0x50, 0xa6, 0xe5, 0xa4, 0x0a, 0xd5, 0x94, 0x3c, 0x3a, 0x89,
+	0x2a, 0x84, 0x32, 0x53, 0xfc, 0x95, 0x21, 0xbd, 0x1f, 0xc0, 0x07, 0x4e, 0x4b, 0xf7, 0x51, 0x0d,
+	0x55, 0x78, 0x16, 0x2f, 0xd5, 0x31, 0xb7, 0xf5, 0xc7, 0xa0, 0xcf, 0x13, 0xe5, 0x55, 0x46, 0x39,
+	0x86, 0x19, 0x10, 0xaa, 0x8a, 0x48, 0x54, 0x3b, 0xab, 0x8d, 0x86, 0xd3, 0x09, 0xc3, 0xc7, 0x33,
+	0x43, 0x02, 0x33, 0xbd, 0x1b, 0xdb, 0x89, 0xc0, 0x87, 0xdf, 0x9f, 0xc6, 0xb4, 0xac, 0x42, 0xea,
+	0xf3, 0x60, 0x40, 0x94, 0x9e, 0x23, 0x16, 0x25, 0xd4, 0xba, 0x4d, 0x4b, 0x4c, 0xb1, 0xc2, 0x2b,
+	0x20, 0x52, 0x60, 0x94, 0xe7, 0x50, 0xb1, 0x58, 0xc3, 0x5c, 0x92, 0xf4, 0x66, 0xa2, 0x5b, 0xeb,
+	0xa9, 0x7e, 0xc5, 0x73, 0x43, 0x9e, 0xcc, 0xd9, 0x35, 0x42, 0xad, 0x6c, 0xd8, 0xc9, 0x56, 0x21,
+	0xfd, 0x05, 0x88, 0x76, 0xd6, 0x55, 0xba, 0xf3, 0xe0, 0xc4, 0x32, 0x2a, 0xe7, 0xb8, 0x3c, 0xca,
+	0x11, 0x5a, 0x62, 0xaa, 0x83, 0x94, 0x6f, 0x07, 0xf3, 0xa8, 0x4c, 0x8a, 0xc8, 0x66, 0x35, 0x57,
+	0x41, 0x77, 0x3f, 0xc7, 0x97, 0x51, 0xd9, 0x75, 0xa4, 0xe7, 0x3b, 0xf9, 0x9b, 0x76, 0xc2, 0x5b,
+	0x00, 0xb4, 0x6f, 0x8a, 0x62, 0x4e, 0x36, 0x99, 0x9d, 0x6b, 0x65, 0xc8, 0x8b, 0xd8, 0x76, 0xcf,
+	0xc2, 0x0a, 0x9b, 0x75, 0x21, 0xf5, 0x2f, 0x1a, 0x18, 0xdc, 0x85, 0x44, 0x75, 0x79, 0x07, 0x1c,
+	0x51, 0x9d, 0x05, 0xff, 0xa9, 0x33, 0x51, 0x05, 0xce, 0x78, 0x34, 0xf7, 0x08, 0xcd, 0x23, 0xfb,
+	0x6a, 0x96, 0x52, 0x3c, 0xa2, 0x9f, 0x2a, 0x63, 0xee, 0x12, 0xce, 0x71, 0x31, 0x53, 0x66, 0x85,
+	0xc5, 0x96, 0x31, 0xd3, 0xbb, 0x7e, 0xf1, 0xa1, 0xad, 0xf5, 0xd4, 0x19, 0xc5, 0x34, 0xe5, 0xd4,
+	0xa3, 0xbc, 0xce, 0xf7, 0xf8, 0xf4, 0x6f, 0x9b, 0xb6, 0x78, 0x29, 0x94, 0x2d, 0x43, 0x20, 0x42,
+	0x68, 0x11, 0xaf, 0xe6, 0x58, 0xa9, 0xc4, 0xb1, 0x2d, 0x38, 0x82, 0xd9, 0xb0, 0x88, 0xdd, 0x13,
+	0x21, 0xf8, 0x10, 0x1c, 0xab, 0x08, 0x68, 0x2e, 0x2f, 0xb0, 0xd1, 0x1e, 0x61, 0xe1, 0x39, 0x5f,
+	0x0b, 0x5d, 0x44, 0x6e, 0xe7, 0x22, 0x95, 0x76, 0x9c, 0xeb, 0x35, 0x35, 0x5a, 0x8f, 0xaa, 0x36,
+	0xa9, 0xe0, 0xff, 0xda, 0x32, 0x3c, 0x05, 0x42, 0x2b, 0x84, 0x16, 0xd9, 0x8a, 0xf8, 0x32, 0xc1,
+	0xac, 0x7a, 0xd3, 0xdf, 0x69, 0xa0, 0xcf, 0x43, 0xaa, 0x4c, 0x98, 0x05, 0xa1, 0xba, 0x88, 0x08,
+	0xbe, 0x48, 0x66, 0xd2, 0x11, 0xfd, 0x63, 0x3b, 0x91, 0xb4, 0x88, 0xbd, 0x50, 0xcf, 0x1b, 0x05,
+	0x56, 0x51, 0xab, 0x42, 0xfd, 0x4b, 0xf1, 0xe2, 0xa2, 0x69, 0xaf, 0x55, 0x31, 0x37, 0xa6, 0x71,
+	0x41, 0x8d, 0xb0, 0x2c, 0xe1, 0x90, 0xb7, 0x7c, 0x12, 0xe4, 0xf2, 0x0d, 0x0e, 0xff, 0x6d, 0x63,
+	0x50, 0x1c, 0x7b, 0x5c, 0x49, 0x37, 0x42, 0xe0, 0xa8, 0x50, 0x08, 0x5f, 0x69, 0x20, 0x24, 0xf7,
+	0x04, 0xbc, 0xe0, 0xeb, 0x74, 0xe7, 0x72, 0x8a, 0x8d, 0x77, 0x97, 0x2c, 0x3b, 0xd7, 0x47, 0x5e,
+	0x7e, 0xfb, 0xf5, 0xa6, 0x67, 0x08, 0x26, 0x4c, 0xbf, 0xf5, 0x29, 0x17, 0x13, 0xfc, 0xac, 0x81,
+	0xb0, 0x6b, 0x22, 0xe0, 0xc5, 0xbd, 0x69, 0x3a, 0xf7, 0x57, 0x6c, 0xe2, 0x00, 0x08, 0xa5, 0xee,
+	0x9a, 0x50, 0x77, 0x19, 0x5e, 0xf2, 0x55, 0xe7, 0x5e, 0x5a, 0xdc, 0x7c, 0xe6, 0xbe, 0x3b, 0xcf,
+	0xe1, 0x7b, 0x0d, 0x44, 0x5c, 0x65, 0x39, 0xec, 0x5e, 0x42, 0xcb, 0xce, 0xf4, 0x41, 0x20, 0x4a,
+	0xb6, 0x21, 0x64, 0x8f, 0xc2, 0x64, 0x77, 0xb2, 0xe1, 0x57, 0x0d, 0x44, 0xdc, 0xc3, 0xb9, 0x9f,
+	0xce, 0x5d, 0x76, 0x45, 0x2c, 0x7d, 0x10, 0x88, 0xd2, 0x39, 0x2b, 0x74, 0xde, 0x84, 0x53, 0x87,
+	0xb2, 0xd7, 0xf4, 0xdc, 0x66, 0xf8, 0x51, 0x03, 0x21, 0x39, 0x56, 0xfb, 0xdd, 0x57, 0xcf, 0xc4,
+	0xc7, 0xc6, 0xbb, 0x4b, 0x56, 0x92, 0xa7, 0x85, 0xe4, 0xeb, 0xf0, 0xea, 0xe1, 0x24, 0xcb, 0x11,
+	0xcd, 0xcc, 0x6c, 0xec, 0xc4, 0xb5, 0xcd, 0x9d, 0xb8, 0xf6, 0x73, 0x27, 0xae, 0xbd, 0x6e, 0xc4,
+	0x03, 0x9b, 0x8d, 0x78, 0xe0, 0x7b, 0x23, 0x1e, 0x78, 0x92, 0xda, 0x73, 0xe2, 0x57, 0xdb, 0x74,
+	0x62, 0xf8, 0xf3, 0x21, 0xf1, 0x33, 0x61, 0xf2, 0xcf, 0x00, 0x9d, 0xda, 0x03, 0x30, 0x43, 0x09,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -380,6 +628,15 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// MissedBlocks queries the missed blocks of the signed blocks window of given
+	// cons address
+	//
+	// Since: cosmos-sdk 0.50
+	MissedBlocks(ctx context.Context, in *QueryMissedBlocksRequest, opts ...grpc.CallOption) (*QueryMissedBlocksResponse, error)
+	// Uptime queries the uptime of given cons address over its most recent blocks
+	//
+	// Since: cosmos-sdk 0.50
+	Uptime(ctx context.Context, in *QueryUptimeRequest, opts ...grpc.CallOption) (*QueryUptimeResponse, error)
 }
 
 type queryClient struct {
@@ -417,6 +674,24 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) MissedBlocks(ctx context.Context, in *QueryMissedBlocksRequest, opts ...grpc.CallOption) (*QueryMissedBlocksResponse, error) {
+	out := new(QueryMissedBlocksResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/MissedBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Uptime(ctx context.Context, in *QueryUptimeRequest, opts ...grpc.CallOption) (*QueryUptimeResponse, error) {
+	out := new(QueryUptimeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/Uptime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of slashing module
@@ -425,6 +700,15 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// MissedBlocks queries the missed blocks of the signed blocks window of given
+	// cons address
+	//
+	// Since: cosmos-sdk 0.50
+	MissedBlocks(context.Context, *QueryMissedBlocksRequest) (*QueryMissedBlocksResponse, error)
+	// Uptime queries the uptime of given cons address over its most recent blocks
+	//
+	// Since: cosmos-sdk 0.50
+	Uptime(context.Context, *QueryUptimeRequest) (*QueryUptimeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -440,6 +724,12 @@ func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySign
 func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (*UnimplementedQueryServer) MissedBlocks(ctx context.Context, req *QueryMissedBlocksRequest) (*QueryMissedBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MissedBlocks not implemented")
+}
+func (*UnimplementedQueryServer) Uptime(ctx context.Context, req *QueryUptimeRequest) (*QueryUptimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Uptime not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -499,6 +789,42 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MissedBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMissedBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MissedBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/MissedBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MissedBlocks(ctx, req.(*QueryMissedBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Uptime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUptimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Uptime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/Uptime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Uptime(ctx, req.(*QueryUptimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -515,6 +841,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "MissedBlocks",
+			Handler:    _Query_MissedBlocks_Handler,
+		},
+		{
+			MethodName: "Uptime",
+			Handler:    _Query_Uptime_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
@@ -723,76 +1057,226 @@ func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMissedBlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryMissedBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySigningInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryMissedBlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ConsAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySigningInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMissedBlocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ValSigningInfo.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySigningInfosRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryMissedBlocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMissedBlocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.MissedBlocks) > 0 {
+		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissedBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if m.IndexOffset != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySigningInfosResponse) Size() (n int) {
-	if m == nil {
+func (m *QueryUptimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUptimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUptimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUptimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUptimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUptimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissedBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Uptime.Size()
+		i -= size
+		if _, err := m.Uptime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySigningInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ValSigningInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySigningInfosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningInfosResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -803,20 +1287,382 @@ func (m *QuerySigningInfosResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMissedBlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMissedBlocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IndexOffset != 0 {
+		n += 1 + sovQuery(uint64(m.IndexOffset))
+	}
+	if len(m.MissedBlocks) > 0 {
+		for _, e := range m.MissedBlocks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUptimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *QueryUptimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Uptime.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	if m.MissedBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.MissedBlocks))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValSigningInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValSigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -839,12 +1685,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningInfosRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -866,7 +1748,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -889,15 +1771,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningInfosResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -924,7 +1806,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Info = append(m.Info, ValidatorSigningInfo{})
+			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -949,7 +1868,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMissedBlocksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -972,10 +1891,10 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMissedBlocksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMissedBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1031,7 +1950,7 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMissedBlocksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1054,15 +1973,34 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMissedBlocksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMissedBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValSigningInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1089,7 +2027,8 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValSigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MissedBlocks = append(m.MissedBlocks, MissedBlock{})
+			if err := m.MissedBlocks[len(m.MissedBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1114,7 +2053,7 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUptimeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1137,17 +2076,17 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfosRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUptimeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUptimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1157,28 +2096,43 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1200,7 +2154,7 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUptimeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1223,17 +2177,17 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfosResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUptimeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUptimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1243,31 +2197,30 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Info = append(m.Info, ValidatorSigningInfo{})
-			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Uptime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
 			}
-			var msglen int
+			m.Blocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1277,28 +2230,30 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Blocks |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MissedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
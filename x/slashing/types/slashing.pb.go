@@ -8,6 +8,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
@@ -134,6 +135,12 @@ type Params struct {
 	DowntimeJailDuration    time.Duration                          `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime"`
+	// infraction_penalties defines the penalties applied per infraction type. An
+	// infraction without an entry falls back to slash_fraction_double_sign, or
+	// to slash_fraction_downtime and downtime_jail_duration.
+	//
+	// Since: cosmos-sdk 0.50
+	InfractionPenalties []InfractionPenalty `protobuf:"bytes,6,rep,name=infraction_penalties,json=infractionPenalties,proto3" json:"infraction_penalties"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -183,9 +190,78 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetInfractionPenalties() []InfractionPenalty {
+	if m != nil {
+		return m.InfractionPenalties
+	}
+	return nil
+}
+
+// InfractionPenalty defines the penalty applied to a validator committing a
+// given infraction.
+//
+// Since: cosmos-sdk 0.50
+type InfractionPenalty struct {
+	// infraction is the infraction type the penalty applies to.
+	Infraction types.Infraction `protobuf:"varint,1,opt,name=infraction,proto3,enum=cosmos.staking.v1beta1.Infraction" json:"infraction,omitempty"`
+	// slash_fraction is the fraction of the stake slashed for the infraction.
+	SlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction"`
+	// jail_duration is the duration for which the validator is jailed. It is not
+	// used for infractions tombstoning the validator, such as double signing.
+	JailDuration time.Duration `protobuf:"bytes,3,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration"`
+}
+
+func (m *InfractionPenalty) Reset()         { *m = InfractionPenalty{} }
+func (m *InfractionPenalty) String() string { return proto.CompactTextString(m) }
+func (*InfractionPenalty) ProtoMessage()    {}
+func (*InfractionPenalty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
+}
+func (m *InfractionPenalty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InfractionPenalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InfractionPenalty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InfractionPenalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InfractionPenalty.Merge(m, src)
+}
+func (m *InfractionPenalty) XXX_Size() int {
+	return m.Size()
+}
+func (m *InfractionPenalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_InfractionPenalty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InfractionPenalty proto.InternalMessageInfo
+
+func (m *InfractionPenalty) GetInfraction() types.Infraction {
+	if m != nil {
+		return m.Infraction
+	}
+	return types.Infraction_INFRACTION_UNSPECIFIED
+}
+
+func (m *InfractionPenalty) GetJailDuration() time.Duration {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
+	proto.RegisterType((*InfractionPenalty)(nil), "cosmos.slashing.v1beta1.InfractionPenalty")
 }
 
 func init() {
@@ -193,47 +269,54 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3f, 0x4f, 0x14, 0x4f,
-	0x18, 0xbe, 0x01, 0x7e, 0xfc, 0x74, 0x0e, 0x13, 0x5d, 0x0f, 0x59, 0x2e, 0xba, 0x77, 0x50, 0x90,
-	0x0b, 0x09, 0xbb, 0x82, 0x1d, 0x56, 0x1e, 0xc4, 0xe0, 0x9f, 0x44, 0x72, 0xf8, 0x27, 0xb1, 0x70,
-	0x32, 0xbb, 0x33, 0xb7, 0x37, 0xb2, 0x3b, 0x73, 0xd9, 0x99, 0x15, 0x88, 0x8d, 0x8d, 0x8d, 0x15,
-	0xa5, 0xb1, 0xb2, 0xa4, 0xa4, 0xf0, 0x0b, 0x58, 0x98, 0x50, 0x12, 0x2b, 0x63, 0x81, 0xe6, 0x28,
-	0xf0, 0x63, 0x98, 0x9d, 0x99, 0x05, 0x02, 0x89, 0x15, 0xcd, 0xdd, 0xee, 0xf3, 0x3c, 0xef, 0xfb,
-	0xbc, 0xf3, 0xcc, 0x9b, 0x85, 0x33, 0x91, 0x90, 0xa9, 0x90, 0x81, 0x4c, 0xb0, 0xec, 0x31, 0x1e,
-	0x07, 0x6f, 0xe6, 0x43, 0xaa, 0xf0, 0xfc, 0x31, 0xe0, 0xf7, 0x33, 0xa1, 0x84, 0x33, 0x61, 0x74,
-	0xfe, 0x31, 0x6c, 0x75, 0xf5, 0x5a, 0x2c, 0x62, 0xa1, 0x35, 0x41, 0xf1, 0x64, 0xe4, 0x75, 0x2f,
-	0x16, 0x22, 0x4e, 0x68, 0xa0, 0xdf, 0xc2, 0xbc, 0x1b, 0x90, 0x3c, 0xc3, 0x8a, 0x09, 0x6e, 0xf9,
-	0xc6, 0x59, 0x5e, 0xb1, 0x94, 0x4a, 0x85, 0xd3, 0xbe, 0x15, 0x4c, 0x1a, 0x3f, 0x64, 0x3a, 0x5b,
-	0x73, 0x43, 0x5d, 0xc3, 0x29, 0xe3, 0x22, 0xd0, 0xbf, 0x06, 0x9a, 0xfe, 0x3a, 0x04, 0x6b, 0xcf,
-	0x71, 0xc2, 0x08, 0x56, 0x22, 0x5b, 0x63, 0x31, 0x67, 0x3c, 0x7e, 0xc0, 0xbb, 0xc2, 0xb9, 0x0b,
-	0xff, 0xc7, 0x84, 0x64, 0x54, 0x4a, 0x17, 0x34, 0x41, 0xeb, 0x72, 0x7b, 0xea, 0xfb, 0x97, 0xb9,
-	0x5b, 0xb6, 0xdd, 0x92, 0xe0, 0x92, 0x72, 0x99, 0xcb, 0x7b, 0x46, 0xb2, 0xa6, 0x32, 0xc6, 0xe3,
-	0x4e, 0x59, 0xe1, 0x4c, 0xc1, 0x31, 0xa9, 0x70, 0xa6, 0x50, 0x8f, 0xb2, 0xb8, 0xa7, 0xdc, 0xa1,
-	0x26, 0x68, 0x0d, 0x77, 0xaa, 0x1a, 0x5b, 0xd1, 0x50, 0x21, 0x61, 0x9c, 0xd0, 0x4d, 0x24, 0xba,
-	0x5d, 0x49, 0x95, 0x3b, 0x6c, 0x24, 0x1a, 0x7b, 0xa2, 0x21, 0xe7, 0x31, 0x1c, 0x7b, 0x8d, 0x59,
-	0x42, 0x09, 0xca, 0xb9, 0x62, 0x89, 0x3b, 0xd2, 0x04, 0xad, 0xea, 0x42, 0xdd, 0x37, 0x09, 0xf8,
-	0x65, 0x02, 0xfe, 0xd3, 0x32, 0x81, 0xf6, 0x95, 0xbd, 0x83, 0x46, 0x65, 0xfb, 0x57, 0x03, 0xec,
-	0x1c, 0xed, 0xce, 0x82, 0x4e, 0xd5, 0x94, 0x3f, 0x2b, 0xaa, 0x1d, 0x0f, 0x42, 0x25, 0xd2, 0x50,
-	0x2a, 0xc1, 0x29, 0x71, 0xff, 0x6b, 0x82, 0xd6, 0xa5, 0xce, 0x29, 0xc4, 0x59, 0x80, 0xe3, 0x29,
-	0x93, 0x92, 0x12, 0x14, 0x26, 0x22, 0x5a, 0x97, 0x28, 0x12, 0x39, 0x57, 0x34, 0x73, 0x47, 0xf5,
-	0x64, 0xd7, 0x0d, 0xd9, 0xd6, 0xdc, 0x92, 0xa1, 0x16, 0x47, 0xfe, 0x7c, 0x6e, 0x80, 0xe9, 0x6f,
-	0x23, 0x70, 0x74, 0x15, 0x67, 0x38, 0x95, 0xce, 0x6d, 0x58, 0x93, 0x2c, 0xe6, 0x27, 0x4d, 0x36,
-	0x18, 0x27, 0x62, 0x43, 0x47, 0x38, 0xdc, 0x71, 0x0c, 0x67, 0x7a, 0xbc, 0xd0, 0x8c, 0xf3, 0xb6,
-	0xb0, 0xe5, 0xc8, 0x56, 0xf5, 0x69, 0x56, 0x96, 0x14, 0x99, 0x8d, 0xb5, 0x57, 0x8a, 0x13, 0xfd,
-	0x3c, 0x68, 0xcc, 0xc4, 0x4c, 0xf5, 0xf2, 0xd0, 0x8f, 0x44, 0x6a, 0xef, 0xd4, 0xfe, 0xcd, 0x49,
-	0xb2, 0x1e, 0xa8, 0xad, 0x3e, 0x95, 0xfe, 0x32, 0x8d, 0x3e, 0x1d, 0xed, 0xce, 0x5e, 0xb5, 0x0b,
-	0x40, 0x68, 0x84, 0xc2, 0x2d, 0x45, 0xa5, 0x09, 0xc3, 0x49, 0x19, 0x5f, 0xd3, 0x2e, 0xab, 0x34,
-	0xb3, 0xe6, 0xaf, 0xe0, 0x0d, 0x22, 0x36, 0x78, 0xb1, 0x42, 0xa8, 0xc8, 0x0a, 0x95, 0xcb, 0xa6,
-	0xaf, 0xa3, 0xba, 0x30, 0x79, 0x2e, 0xeb, 0x65, 0x2b, 0x30, 0x51, 0x7f, 0x3c, 0x8e, 0xba, 0x56,
-	0xf6, 0x79, 0x88, 0x59, 0x52, 0x8a, 0x9c, 0xf7, 0x00, 0xd6, 0xf5, 0xde, 0xa3, 0x6e, 0x86, 0xa3,
-	0x02, 0x42, 0x44, 0xe4, 0x61, 0x42, 0xf5, 0x79, 0xf5, 0x85, 0x5e, 0xe4, 0x11, 0x27, 0xb4, 0xd7,
-	0x7d, 0x6b, 0xb5, 0xac, 0x9d, 0x8a, 0x23, 0x3b, 0xef, 0x00, 0x9c, 0x38, 0x37, 0x87, 0x99, 0x57,
-	0x6f, 0xc2, 0x45, 0x0e, 0x31, 0x7e, 0x66, 0x08, 0x63, 0xb3, 0x38, 0xf5, 0xe1, 0x68, 0x77, 0xf6,
-	0xe6, 0xa9, 0x5e, 0x9b, 0x27, 0x5f, 0x0e, 0xb3, 0x3c, 0xed, 0x47, 0x3b, 0x03, 0x0f, 0xec, 0x0d,
-	0x3c, 0xb0, 0x3f, 0xf0, 0xc0, 0xef, 0x81, 0x07, 0xb6, 0x0f, 0xbd, 0xca, 0xfe, 0xa1, 0x57, 0xf9,
-	0x71, 0xe8, 0x55, 0x5e, 0xce, 0xfd, 0x73, 0xb2, 0x53, 0xdd, 0xf4, 0x90, 0xe1, 0xa8, 0xbe, 0xb2,
-	0x3b, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x8c, 0xa5, 0x44, 0xa7, 0x04, 0x00, 0x00,
+	// 737 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x4f, 0x13, 0x41,
+	0x18, 0xed, 0x52, 0xa8, 0x32, 0x05, 0x22, 0x43, 0x91, 0xa5, 0xd1, 0x6d, 0x69, 0x0c, 0x69, 0x9a,
+	0xb0, 0x95, 0x7a, 0xc3, 0x93, 0x85, 0x18, 0xf0, 0x47, 0x24, 0xc5, 0x1f, 0x89, 0x07, 0x37, 0xb3,
+	0xdd, 0xe9, 0x76, 0x64, 0x77, 0xa6, 0xd9, 0x99, 0x0a, 0xc4, 0x8b, 0x17, 0x2f, 0x9e, 0xb8, 0x69,
+	0x3c, 0x79, 0xe4, 0x26, 0x07, 0xff, 0x01, 0x6f, 0x1c, 0x89, 0x27, 0xe3, 0x01, 0x4d, 0x39, 0xe0,
+	0x9f, 0x61, 0x76, 0x66, 0x77, 0x29, 0x34, 0x7a, 0x91, 0x4b, 0xdb, 0xfd, 0xde, 0xfb, 0xe6, 0xbd,
+	0xef, 0xed, 0xd7, 0x01, 0xf3, 0x4d, 0xc6, 0x7d, 0xc6, 0xab, 0xdc, 0x43, 0xbc, 0x4d, 0xa8, 0x5b,
+	0x7d, 0xb5, 0x68, 0x63, 0x81, 0x16, 0x93, 0x82, 0xd9, 0x09, 0x98, 0x60, 0x70, 0x46, 0xf1, 0xcc,
+	0xa4, 0x1c, 0xf1, 0xf2, 0x39, 0x97, 0xb9, 0x4c, 0x72, 0xaa, 0xe1, 0x2f, 0x45, 0xcf, 0x1b, 0x2e,
+	0x63, 0xae, 0x87, 0xab, 0xf2, 0xc9, 0xee, 0xb6, 0xaa, 0x4e, 0x37, 0x40, 0x82, 0x30, 0x1a, 0xe1,
+	0x85, 0xf3, 0xb8, 0x20, 0x3e, 0xe6, 0x02, 0xf9, 0x9d, 0x88, 0x30, 0xab, 0xf4, 0x2c, 0x75, 0x72,
+	0x24, 0xae, 0xa0, 0x49, 0xe4, 0x13, 0xca, 0xaa, 0xf2, 0x33, 0x2a, 0xdd, 0x88, 0xa7, 0x10, 0x68,
+	0xf3, 0xcc, 0x10, 0xea, 0x59, 0xb1, 0x4a, 0x5f, 0x87, 0x40, 0xee, 0x29, 0xf2, 0x88, 0x83, 0x04,
+	0x0b, 0x36, 0x88, 0x4b, 0x09, 0x75, 0xd7, 0x68, 0x8b, 0xc1, 0xdb, 0xe0, 0x12, 0x72, 0x9c, 0x00,
+	0x73, 0xae, 0x6b, 0x45, 0xad, 0x3c, 0x5a, 0x9f, 0xfb, 0xf6, 0x65, 0xe1, 0x7a, 0x24, 0xba, 0xcc,
+	0x28, 0xc7, 0x94, 0x77, 0xf9, 0x1d, 0x45, 0xd9, 0x10, 0x01, 0xa1, 0x6e, 0x23, 0xee, 0x80, 0x73,
+	0x60, 0x8c, 0x0b, 0x14, 0x08, 0xab, 0x8d, 0x89, 0xdb, 0x16, 0xfa, 0x50, 0x51, 0x2b, 0xa7, 0x1b,
+	0x59, 0x59, 0x5b, 0x95, 0xa5, 0x90, 0x42, 0xa8, 0x83, 0xb7, 0x2d, 0xd6, 0x6a, 0x71, 0x2c, 0xf4,
+	0xb4, 0xa2, 0xc8, 0xda, 0x23, 0x59, 0x82, 0x0f, 0xc0, 0xd8, 0x4b, 0x44, 0x3c, 0xec, 0x58, 0x5d,
+	0x2a, 0x88, 0xa7, 0x0f, 0x17, 0xb5, 0x72, 0xb6, 0x96, 0x37, 0x55, 0x4e, 0x66, 0x9c, 0x93, 0xf9,
+	0x38, 0xce, 0xa9, 0x3e, 0x7e, 0x70, 0x54, 0x48, 0xed, 0xfe, 0x2c, 0x68, 0x7b, 0x27, 0xfb, 0x15,
+	0xad, 0x91, 0x55, 0xed, 0x4f, 0xc2, 0x6e, 0x68, 0x00, 0x20, 0x98, 0x6f, 0x73, 0xc1, 0x28, 0x76,
+	0xf4, 0x91, 0xa2, 0x56, 0xbe, 0xdc, 0xe8, 0xab, 0xc0, 0x1a, 0x98, 0xf6, 0x09, 0xe7, 0xd8, 0xb1,
+	0x6c, 0x8f, 0x35, 0x37, 0xb9, 0xd5, 0x64, 0x5d, 0x2a, 0x70, 0xa0, 0x67, 0xa4, 0xb3, 0x29, 0x05,
+	0xd6, 0x25, 0xb6, 0xac, 0xa0, 0xa5, 0xe1, 0xdf, 0x9f, 0x0a, 0x5a, 0xe9, 0xf3, 0x08, 0xc8, 0xac,
+	0xa3, 0x00, 0xf9, 0x1c, 0xde, 0x04, 0x39, 0x4e, 0x5c, 0x7a, 0x7a, 0xc8, 0x16, 0xa1, 0x0e, 0xdb,
+	0x92, 0x11, 0xa6, 0x1b, 0x50, 0x61, 0xea, 0x8c, 0x67, 0x12, 0x81, 0xaf, 0x43, 0x59, 0x6a, 0x45,
+	0x5d, 0x1d, 0x1c, 0xc4, 0x2d, 0x61, 0x66, 0x63, 0xf5, 0xd5, 0x70, 0xa2, 0x1f, 0x47, 0x85, 0x79,
+	0x97, 0x88, 0x76, 0xd7, 0x36, 0x9b, 0xcc, 0x8f, 0xde, 0x7c, 0xf4, 0xb5, 0xc0, 0x9d, 0xcd, 0xaa,
+	0xd8, 0xe9, 0x60, 0x6e, 0xae, 0xe0, 0xe6, 0xc7, 0x93, 0xfd, 0xca, 0x95, 0x68, 0x4d, 0x1c, 0xdc,
+	0xb4, 0xec, 0x1d, 0x81, 0xb9, 0x0a, 0x03, 0xfa, 0x84, 0x6e, 0x48, 0x95, 0x75, 0x1c, 0x44, 0xe2,
+	0x2f, 0xc0, 0x55, 0x87, 0x6d, 0xd1, 0x70, 0xd1, 0xac, 0x30, 0x2b, 0x2b, 0x5e, 0x49, 0xf9, 0x3a,
+	0xb2, 0xb5, 0xd9, 0x81, 0xac, 0x57, 0x22, 0x82, 0x8a, 0xfa, 0x43, 0x12, 0x75, 0x2e, 0x3e, 0xe7,
+	0x1e, 0x22, 0x5e, 0x4c, 0x82, 0x6f, 0x35, 0x90, 0x97, 0xff, 0x0e, 0xab, 0x15, 0xa0, 0x66, 0x58,
+	0xb2, 0x1c, 0xd6, 0xb5, 0x3d, 0x2c, 0xe7, 0xd5, 0x87, 0x2f, 0x78, 0xc4, 0x19, 0xa9, 0x75, 0x37,
+	0x92, 0x5a, 0x91, 0x4a, 0xe1, 0xc8, 0xf0, 0x8d, 0x06, 0x66, 0x06, 0x7c, 0x28, 0xbf, 0xfa, 0xc8,
+	0x05, 0x9b, 0x98, 0x3e, 0x67, 0x42, 0xc9, 0xc0, 0x36, 0xc8, 0x11, 0x9a, 0xa8, 0x77, 0x30, 0x45,
+	0x9e, 0x20, 0x98, 0xeb, 0x99, 0x62, 0xba, 0x9c, 0xad, 0x55, 0xcc, 0xbf, 0xdc, 0x25, 0xe6, 0x5a,
+	0xd2, 0xb4, 0x2e, 0x7b, 0x76, 0xea, 0xa3, 0xa1, 0x55, 0xa5, 0x35, 0x45, 0xce, 0xa1, 0x04, 0xf3,
+	0xa5, 0xb9, 0x77, 0x27, 0xfb, 0x95, 0x6b, 0x7d, 0xae, 0xb7, 0x4f, 0x6f, 0x32, 0xb5, 0xa6, 0xa5,
+	0xf7, 0x43, 0x60, 0x72, 0xe0, 0x60, 0x58, 0x07, 0xe0, 0xf4, 0x3c, 0xb9, 0xb2, 0x13, 0xb5, 0x52,
+	0x62, 0x2c, 0xba, 0x36, 0x06, 0x7d, 0x35, 0xfa, 0xba, 0x20, 0x03, 0x13, 0x67, 0x83, 0xbe, 0xf0,
+	0x3d, 0x1e, 0x3f, 0x93, 0x2f, 0x7c, 0x08, 0xc6, 0xff, 0x6f, 0x73, 0xe5, 0x1d, 0x93, 0x80, 0xf7,
+	0xf7, 0x7a, 0x86, 0x76, 0xd0, 0x33, 0xb4, 0xc3, 0x9e, 0xa1, 0xfd, 0xea, 0x19, 0xda, 0xee, 0xb1,
+	0x91, 0x3a, 0x3c, 0x36, 0x52, 0xdf, 0x8f, 0x8d, 0xd4, 0xf3, 0x85, 0x7f, 0xba, 0xef, 0xcb, 0x59,
+	0x0e, 0x62, 0x67, 0xa4, 0xf8, 0xad, 0x3f, 0x03, 0x00, 0x30, 0x96, 0xb3, 0x3d, 0x51, 0x06, 0x00,
+	0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -309,6 +392,44 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if len(this.InfractionPenalties) != len(that1.InfractionPenalties) {
+		return false
+	}
+	for i := range this.InfractionPenalties {
+		if !this.InfractionPenalties[i].Equal(&that1.InfractionPenalties[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *InfractionPenalty) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InfractionPenalty)
+	if !ok {
+		that2, ok := that.(InfractionPenalty)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Infraction != that1.Infraction {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if this.JailDuration != that1.JailDuration {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -394,6 +515,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.InfractionPenalties) > 0 {
+		for iNdEx := len(m.InfractionPenalties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InfractionPenalties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -440,6 +575,52 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *InfractionPenalty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InfractionPenalty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InfractionPenalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Infraction != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Infraction))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSlashing(v)
 	base := offset
@@ -495,6 +676,28 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	if len(m.InfractionPenalties) > 0 {
+		for _, e := range m.InfractionPenalties {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InfractionPenalty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Infraction != 0 {
+		n += 1 + sovSlashing(uint64(m.Infraction))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
 	return n
 }
 
@@ -876,6 +1079,175 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionPenalties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InfractionPenalties = append(m.InfractionPenalties, InfractionPenalty{})
+			if err := m.InfractionPenalties[len(m.InfractionPenalties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InfractionPenalty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InfractionPenalty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InfractionPenalty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infraction", wireType)
+			}
+			m.Infraction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Infraction |= types.Infraction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// validatorsCompletionCacheKey is the completion cache key under which the
+// validator operator addresses are stored.
+const validatorsCompletionCacheKey = "staking-validators"
+
+// ValidatorAddressCompletion is a client.CompletionFunc that suggests the
+// operator addresses of the validators of the chain. Since listing validators
+// is an expensive query, the results are cached in the client home directory
+// for client.DefaultCompletionCacheTTL.
+func ValidatorAddressCompletion(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	addrs, err := client.CachedCompletions(clientCtx, validatorsCompletionCacheKey, client.DefaultCompletionCacheTTL, func() ([]string, error) {
+		queryClient := types.NewQueryClient(clientCtx)
+
+		var (
+			addrs []string
+			key   []byte
+		)
+		for {
+			res, err := queryClient.Validators(cmd.Context(), &types.QueryValidatorsRequest{
+				Pagination: &query.PageRequest{Key: key},
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			for _, val := range res.Validators {
+				addrs = append(addrs, val.OperatorAddress)
+			}
+
+			if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+				return addrs, nil
+			}
+			key = res.Pagination.NextKey
+		}
+	})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	return client.FilterCompletions(addrs, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// validatorArgsCompletion returns a client.CompletionFunc that completes
+// validator operator addresses for the positional arguments at the given
+// indexes only.
+func validatorArgsCompletion(positions ...int) client.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		for _, pos := range positions {
+			if len(args) == pos {
+				return ValidatorAddressCompletion(cmd, args, toComplete)
+			}
+		}
+
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+}
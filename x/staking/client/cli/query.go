@@ -30,6 +30,7 @@ func GetQueryCmd(ac address.Codec) *cobra.Command {
 		GetCmdQueryDelegations(ac),
 		GetCmdQueryUnbondingDelegation(ac),
 		GetCmdQueryUnbondingDelegations(ac),
+		GetCmdQueryCancellableUnbondingDelegations(ac),
 		GetCmdQueryRedelegation(ac),
 		GetCmdQueryRedelegations(ac),
 		GetCmdQueryValidator(),
@@ -85,6 +86,7 @@ $ %s query staking validator %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	cmd.ValidArgsFunction = validatorArgsCompletion(0)
 
 	return cmd
 }
@@ -182,6 +184,7 @@ $ %s query staking unbonding-delegations-from %s1gghjut3ccd8ay0zduzj64hwre2fxs9l
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	cmd.ValidArgsFunction = validatorArgsCompletion(0)
 	flags.AddPaginationFlagsToCmd(cmd, "unbonding delegations")
 
 	return cmd
@@ -237,6 +240,7 @@ $ %s query staking redelegations-from %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	cmd.ValidArgsFunction = validatorArgsCompletion(0)
 	flags.AddPaginationFlagsToCmd(cmd, "validator redelegations")
 
 	return cmd
@@ -283,6 +287,7 @@ func GetCmdQueryDelegation(ac address.Codec) *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	cmd.ValidArgsFunction = validatorArgsCompletion(1)
 
 	return cmd
 }
@@ -392,6 +397,7 @@ $ %s query staking delegations-to %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	cmd.ValidArgsFunction = validatorArgsCompletion(0)
 	flags.AddPaginationFlagsToCmd(cmd, "validator delegations")
 
 	return cmd
@@ -448,6 +454,7 @@ $ %s query staking unbonding-delegation %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	cmd.ValidArgsFunction = validatorArgsCompletion(1)
 
 	return cmd
 }
@@ -507,6 +514,61 @@ $ %s query staking unbonding-delegations %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru
 	return cmd
 }
 
+// GetCmdQueryCancellableUnbondingDelegations implements the command to query
+// the unbonding-delegation entries of a delegator which can be cancelled.
+func GetCmdQueryCancellableUnbondingDelegations(ac address.Codec) *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "cancellable-unbonding-delegations [delegator-addr]",
+		Short: "Query the unbonding-delegation entries of one delegator which can be cancelled",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the unbonding delegation entries of an individual delegator which are not complete yet, and can be cancelled with the cancel-unbond command.
+
+Example:
+$ %s query staking cancellable-unbonding-delegations %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			_, err = ac.StringToBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryCancellableUnbondingDelegationsRequest{
+				DelegatorAddr: args[0],
+				Pagination:    pageReq,
+			}
+
+			res, err := queryClient.CancellableUnbondingDelegations(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "cancellable unbonding delegations")
+
+	return cmd
+}
+
 // GetCmdQueryRedelegation implements the command to query a single
 // redelegation record.
 func GetCmdQueryRedelegation(ac address.Codec) *cobra.Command {
@@ -564,6 +626,7 @@ $ %s query staking redelegation %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1l2r
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	cmd.ValidArgsFunction = validatorArgsCompletion(1, 2)
 
 	return cmd
 }
@@ -274,6 +274,50 @@ func (s *CLITestSuite) TestGetCmdQueryUnbondingDelegations() {
 	}
 }
 
+func (s *CLITestSuite) TestGetCmdQueryCancellableUnbondingDelegations() {
+	testCases := []struct {
+		name   string
+		args   []string
+		expErr bool
+	}{
+		{
+			"wrong delegator address",
+			[]string{
+				"wrongDelAddr",
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			true,
+		},
+		{
+			"valid request",
+			[]string{
+				s.addrs[0].String(),
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryCancellableUnbondingDelegations(address.NewBech32Codec("cosmos"))
+			clientCtx := s.clientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expErr {
+				s.Require().Error(err)
+			} else {
+				var ubds types.QueryCancellableUnbondingDelegationsResponse
+				err = s.clientCtx.Codec.UnmarshalJSON(out.Bytes(), &ubds)
+
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
 func (s *CLITestSuite) TestGetCmdQueryUnbondingDelegation() {
 	testCases := []struct {
 		name   string
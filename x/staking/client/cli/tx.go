@@ -50,6 +50,9 @@ func NewTxCmd() *cobra.Command {
 		NewRedelegateCmd(),
 		NewUnbondCmd(),
 		NewCancelUnbondingDelegation(),
+		NewTokenizeSharesCmd(),
+		NewRedeemTokensCmd(),
+		NewValidatorBondCmd(),
 	)
 
 	return stakingTxCmd
@@ -214,6 +217,7 @@ $ %s tx staking delegate %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm 1000stake --f
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.ValidArgsFunction = validatorArgsCompletion(0)
 
 	return cmd
 }
@@ -263,6 +267,7 @@ $ %s tx staking redelegate %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj %s1l2rsakp3
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.ValidArgsFunction = validatorArgsCompletion(0, 1)
 
 	return cmd
 }
@@ -308,6 +313,7 @@ $ %s tx staking unbond %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake --from
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.ValidArgsFunction = validatorArgsCompletion(0)
 
 	return cmd
 }
@@ -359,6 +365,138 @@ $ %s tx staking cancel-unbond %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.ValidArgsFunction = validatorArgsCompletion(0)
+
+	return cmd
+}
+
+// NewTokenizeSharesCmd returns a CLI command handler for creating a MsgTokenizeShares transaction.
+func NewTokenizeSharesCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "tokenize-share [validator-addr] [amount] [tokenized-share-owner]",
+		Short: "Tokenize delegation shares into transferable share tokens",
+		Args:  cobra.ExactArgs(3),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Tokenize the delegation shares worth an amount of delegated coins into share tokens, sent to the tokenized share owner.
+
+Example:
+$ %s tx staking tokenize-share %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm --from mykey
+`,
+				version.AppName, bech32PrefixValAddr, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			owner, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTokenizeShares(delAddr, valAddr, amount, owner)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.ValidArgsFunction = validatorArgsCompletion(0)
+
+	return cmd
+}
+
+// NewRedeemTokensCmd returns a CLI command handler for creating a MsgRedeemTokensForShares transaction.
+func NewRedeemTokensCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "redeem-tokens [amount]",
+		Short: "Redeem share tokens for delegation shares",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Redeem an amount of share tokens for the delegation shares they are worth.
+
+Example:
+$ %s tx staking redeem-tokens 100%s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj/1 --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRedeemTokensForShares(delAddr, amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewValidatorBondCmd returns a CLI command handler for creating a MsgValidatorBond transaction.
+func NewValidatorBondCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "validator-bond [validator-addr]",
+		Short: "Designate a delegation as a validator bond",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Designate your delegation to a validator as a validator bond, which allows the validator to receive liquid staked delegations.
+
+Example:
+$ %s tx staking validator-bond %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgValidatorBond(delAddr, valAddr)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.ValidArgsFunction = validatorArgsCompletion(0)
 
 	return cmd
 }
@@ -45,9 +45,91 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateLiquidStaking(data); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
+// validateGenesisStateLiquidStaking validates the liquid staking state, whose
+// tokenize share records must have unique ids up to the last record id.
+func validateGenesisStateLiquidStaking(data *types.GenesisState) error {
+	if data.LiquidStakingParams != nil {
+		if err := data.LiquidStakingParams.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if !data.TotalLiquidStakedTokens.IsNil() && data.TotalLiquidStakedTokens.IsNegative() {
+		return fmt.Errorf("negative total liquid staked tokens: %s", data.TotalLiquidStakedTokens)
+	}
+
+	liquidValidators := make(map[string]bool, len(data.LiquidValidators))
+	for _, lv := range data.LiquidValidators {
+		if _, err := sdk.ValAddressFromBech32(lv.OperatorAddress); err != nil {
+			return fmt.Errorf("invalid liquid validator address %s: %w", lv.OperatorAddress, err)
+		}
+
+		if liquidValidators[lv.OperatorAddress] {
+			return fmt.Errorf("duplicate liquid validator %s", lv.OperatorAddress)
+		}
+
+		shares := lv.LiquidShares
+		if shares.ValidatorBondShares.IsNil() || shares.ValidatorBondShares.IsNegative() ||
+			shares.LiquidShares.IsNil() || shares.LiquidShares.IsNegative() {
+			return fmt.Errorf("invalid liquid shares of validator %s", lv.OperatorAddress)
+		}
+
+		liquidValidators[lv.OperatorAddress] = true
+	}
+
+	bonds := make(map[string]bool, len(data.ValidatorBondDelegations))
+	for _, bond := range data.ValidatorBondDelegations {
+		if _, err := sdk.AccAddressFromBech32(bond.DelegatorAddress); err != nil {
+			return fmt.Errorf("invalid validator bond delegator address %s: %w", bond.DelegatorAddress, err)
+		}
+
+		if _, err := sdk.ValAddressFromBech32(bond.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid validator bond validator address %s: %w", bond.ValidatorAddress, err)
+		}
+
+		key := bond.DelegatorAddress + "/" + bond.ValidatorAddress
+		if bonds[key] {
+			return fmt.Errorf("duplicate validator bond of %s to %s", bond.DelegatorAddress, bond.ValidatorAddress)
+		}
+
+		bonds[key] = true
+	}
+
+	records := make(map[uint64]bool, len(data.TokenizeShareRecords))
+	for _, record := range data.TokenizeShareRecords {
+		if record.Id == 0 || record.Id > data.LastTokenizeShareRecordId {
+			return fmt.Errorf("tokenize share record %d is not between 1 and the last record id %d", record.Id, data.LastTokenizeShareRecordId)
+		}
+
+		if records[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record %d", record.Id)
+		}
+
+		if _, err := sdk.ValAddressFromBech32(record.Validator); err != nil {
+			return fmt.Errorf("invalid validator address of tokenize share record %d: %w", record.Id, err)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(record.Owner); err != nil {
+			return fmt.Errorf("invalid owner address of tokenize share record %d: %w", record.Id, err)
+		}
+
+		if record.ModuleAccount != record.GetModuleAddress().String() {
+			return fmt.Errorf("tokenize share record %d is held by %s instead of %s", record.Id, record.ModuleAccount, record.GetModuleAddress())
+		}
+
+		records[record.Id] = true
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
@@ -40,6 +40,54 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = types.Bonded
 		}, true},
+		// validate the liquid staking state
+		{"tokenize share record", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{types.NewTokenizeShareRecord(1, genValidators1[0].GetOperator(), sdk.AccAddress(pk.Address()))}
+			data.LastTokenizeShareRecordId = 1
+		}, false},
+		{"tokenize share record after the last record id", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{types.NewTokenizeShareRecord(2, genValidators1[0].GetOperator(), sdk.AccAddress(pk.Address()))}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
+		{"duplicate tokenize share record", func(data *types.GenesisState) {
+			record := types.NewTokenizeShareRecord(1, genValidators1[0].GetOperator(), sdk.AccAddress(pk.Address()))
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{record, record}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
+		{"tokenize share record held by another account", func(data *types.GenesisState) {
+			record := types.NewTokenizeShareRecord(1, genValidators1[0].GetOperator(), sdk.AccAddress(pk.Address()))
+			record.ModuleAccount = sdk.AccAddress(pk.Address()).String()
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{record}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
+		{"tokenize share record without owner", func(data *types.GenesisState) {
+			record := types.NewTokenizeShareRecord(1, genValidators1[0].GetOperator(), sdk.AccAddress(pk.Address()))
+			record.Owner = ""
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{record}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
+		{"duplicate liquid validator", func(data *types.GenesisState) {
+			lv := types.LiquidValidator{OperatorAddress: genValidators1[0].OperatorAddress, LiquidShares: types.NewValidatorLiquidShares()}
+			data.LiquidValidators = []types.LiquidValidator{lv, lv}
+		}, true},
+		{"negative liquid shares", func(data *types.GenesisState) {
+			shares := types.NewValidatorLiquidShares()
+			shares.LiquidShares = math.LegacyNewDec(-1)
+			data.LiquidValidators = []types.LiquidValidator{{OperatorAddress: genValidators1[0].OperatorAddress, LiquidShares: shares}}
+		}, true},
+		{"duplicate validator bond", func(data *types.GenesisState) {
+			bond := types.ValidatorBondDelegation{
+				DelegatorAddress: sdk.AccAddress(pk.Address()).String(),
+				ValidatorAddress: genValidators1[0].OperatorAddress,
+			}
+			data.ValidatorBondDelegations = []types.ValidatorBondDelegation{bond, bond}
+		}, true},
+		{"invalid liquid staking params", func(data *types.GenesisState) {
+			data.LiquidStakingParams.GlobalLiquidStakingCap = math.LegacyNewDec(2)
+		}, true},
+		{"negative total liquid staked tokens", func(data *types.GenesisState) {
+			data.TotalLiquidStakedTokens = math.NewInt(-1)
+		}, true},
 	}
 
 	for _, tt := range tests {
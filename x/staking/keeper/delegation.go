@@ -131,6 +131,7 @@ func (k Keeper) RemoveDelegation(ctx sdk.Context, delegation types.Delegation) e
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetDelegationKey(delegatorAddress, delegation.GetValidatorAddr()))
 	store.Delete(types.GetDelegationsByValKey(delegation.GetValidatorAddr(), delegatorAddress))
+	store.Delete(types.GetValidatorBondDelegationKey(delegatorAddress, delegation.GetValidatorAddr()))
 
 	return nil
 }
@@ -170,6 +171,30 @@ func (k Keeper) GetUnbondingDelegation(ctx sdk.Context, delAddr sdk.AccAddress,
 	return ubd, true
 }
 
+// GetCancellableUnbondingEntries returns the entries of an unbonding delegation
+// which can still be cancelled, i.e. the entries which are not completed yet of
+// a validator which is neither jailed nor has an invalid exchange rate.
+func (k Keeper) GetCancellableUnbondingEntries(ctx sdk.Context, ubd types.UnbondingDelegation) []types.UnbondingDelegationEntry {
+	valAddr, err := sdk.ValAddressFromBech32(ubd.ValidatorAddress)
+	if err != nil {
+		return nil
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found || validator.InvalidExRate() || validator.IsJailed() {
+		return nil
+	}
+
+	var entries []types.UnbondingDelegationEntry
+	for _, entry := range ubd.Entries {
+		if !entry.CompletionTime.Before(ctx.BlockTime()) {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries
+}
+
 // GetUnbondingDelegationsFromValidator returns all unbonding delegations from a
 // particular validator.
 func (k Keeper) GetUnbondingDelegationsFromValidator(ctx sdk.Context, valAddr sdk.ValAddress) (ubds []types.UnbondingDelegation) {
@@ -332,7 +357,7 @@ func (k Keeper) RemoveUnbondingDelegation(ctx sdk.Context, ubd types.UnbondingDe
 func (k Keeper) SetUnbondingDelegationEntry(
 	ctx sdk.Context, delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress,
 	creationHeight int64, minTime time.Time, balance math.Int,
-) types.UnbondingDelegation {
+) (types.UnbondingDelegation, error) {
 	ubd, found := k.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
 	id := k.IncrementUnbondingID(ctx)
 	if found {
@@ -347,10 +372,10 @@ func (k Keeper) SetUnbondingDelegationEntry(
 	k.SetUnbondingDelegationByUnbondingID(ctx, ubd, id)
 
 	if err := k.Hooks().AfterUnbondingInitiated(ctx, id); err != nil {
-		k.Logger(ctx).Error("failed to call after unbonding initiated hook", "error", err)
+		return ubd, err
 	}
 
-	return ubd
+	return ubd, nil
 }
 
 // unbonding delegation queue timeslice operations
@@ -526,7 +551,7 @@ func (k Keeper) SetRedelegationEntry(ctx sdk.Context,
 	validatorDstAddr sdk.ValAddress, creationHeight int64,
 	minTime time.Time, balance math.Int,
 	sharesSrc, sharesDst sdk.Dec,
-) types.Redelegation {
+) (types.Redelegation, error) {
 	red, found := k.GetRedelegation(ctx, delegatorAddr, validatorSrcAddr, validatorDstAddr)
 	id := k.IncrementUnbondingID(ctx)
 	if found {
@@ -542,10 +567,10 @@ func (k Keeper) SetRedelegationEntry(ctx sdk.Context,
 	k.SetRedelegationByUnbondingID(ctx, red, id)
 
 	if err := k.Hooks().AfterUnbondingInitiated(ctx, id); err != nil {
-		k.Logger(ctx).Error("failed to call after unbonding initiated hook", "error", err)
+		return red, err
 	}
 
-	return red
+	return red, nil
 }
 
 // IterateRedelegations iterates through all redelegations.
@@ -742,6 +767,10 @@ func (k Keeper) Delegate(
 	delegation.Shares = delegation.Shares.Add(newShares)
 	k.SetDelegation(ctx, delegation)
 
+	if k.IsValidatorBond(ctx, delAddr, validator.GetOperator()) {
+		k.increaseValidatorBondShares(ctx, validator.GetOperator(), newShares)
+	}
+
 	// Call the after-modification hook
 	if err := k.Hooks().AfterDelegationModified(ctx, delegatorAddress, delegation.GetValidatorAddr()); err != nil {
 		return newShares, err
@@ -776,6 +805,13 @@ func (k Keeper) Unbond(
 		return amount, types.ErrNoValidatorFound
 	}
 
+	// the validator bond shares must keep covering the liquid shares of the validator
+	if k.IsValidatorBond(ctx, delAddr, valAddr) {
+		if err := k.safelyDecreaseValidatorBondShares(ctx, valAddr, shares); err != nil {
+			return amount, err
+		}
+	}
+
 	// subtract shares from delegation
 	delegation.Shares = delegation.Shares.Sub(shares)
 
@@ -873,7 +909,11 @@ func (k Keeper) Undelegate(
 	}
 
 	completionTime := ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
-	ubd := k.SetUnbondingDelegationEntry(ctx, delAddr, valAddr, ctx.BlockHeight(), completionTime, returnAmount)
+	ubd, err := k.SetUnbondingDelegationEntry(ctx, delAddr, valAddr, ctx.BlockHeight(), completionTime, returnAmount)
+	if err != nil {
+		return time.Time{}, math.Int{}, err
+	}
+
 	k.InsertUBDQueue(ctx, ubd, completionTime)
 
 	return completionTime, returnAmount, nil
@@ -891,6 +931,7 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 	bondDenom := k.GetParams(ctx).BondDenom
 	balances := sdk.NewCoins()
 	ctxTime := ctx.BlockHeader().Time
+	var completedIDs []uint64
 
 	delegatorAddress, err := k.authKeeper.StringToBytes(ubd.DelegatorAddress)
 	if err != nil {
@@ -916,6 +957,8 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 
 				balances = balances.Add(amt)
 			}
+
+			completedIDs = append(completedIDs, entry.UnbondingId)
 		}
 	}
 
@@ -926,6 +969,8 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 		k.SetUnbondingDelegation(ctx, ubd)
 	}
 
+	k.afterUnbondingCompleted(ctx, completedIDs)
+
 	return balances, nil
 }
 
@@ -978,10 +1023,14 @@ func (k Keeper) BeginRedelegation(
 		return completionTime, nil
 	}
 
-	red := k.SetRedelegationEntry(
+	red, err := k.SetRedelegationEntry(
 		ctx, delAddr, valSrcAddr, valDstAddr,
 		height, completionTime, returnAmount, sharesAmount, sharesCreated,
 	)
+	if err != nil {
+		return time.Time{}, err
+	}
+
 	k.InsertRedelegationQueue(ctx, red, completionTime)
 
 	return completionTime, nil
@@ -1001,6 +1050,7 @@ func (k Keeper) CompleteRedelegation(
 	bondDenom := k.GetParams(ctx).BondDenom
 	balances := sdk.NewCoins()
 	ctxTime := ctx.BlockHeader().Time
+	var completedIDs []uint64
 
 	// loop through all the entries and complete mature redelegation entries
 	for i := 0; i < len(red.Entries); i++ {
@@ -1013,6 +1063,8 @@ func (k Keeper) CompleteRedelegation(
 			if !entry.InitialBalance.IsZero() {
 				balances = balances.Add(sdk.NewCoin(bondDenom, entry.InitialBalance))
 			}
+
+			completedIDs = append(completedIDs, entry.UnbondingId)
 		}
 	}
 
@@ -1023,6 +1075,8 @@ func (k Keeper) CompleteRedelegation(
 		k.SetRedelegation(ctx, red)
 	}
 
+	k.afterUnbondingCompleted(ctx, completedIDs)
+
 	return balances, nil
 }
 
@@ -1067,3 +1121,143 @@ func (k Keeper) ValidateUnbondAmount(
 
 	return shares, nil
 }
+
+// TransferUnbonding moves up to the given amount of tokens of the unbonding
+// delegation of fromAddr from valAddr to an unbonding delegation of toAddr,
+// keeping the completion times of the moved entries. It returns the moved
+// amount. The entries on hold are not moved.
+func (k Keeper) TransferUnbonding(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantAmt math.Int,
+) (math.Int, error) {
+	transferred := math.ZeroInt()
+	ubd, found := k.GetUnbondingDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred, nil
+	}
+
+	// move the latest entries first, which are the last to complete
+	for i := len(ubd.Entries) - 1; i >= 0 && transferred.LT(wantAmt); i-- {
+		entry := ubd.Entries[i]
+		if entry.OnHold() {
+			continue
+		}
+
+		amt := math.MinInt(entry.Balance, wantAmt.Sub(transferred))
+		if !amt.IsPositive() {
+			continue
+		}
+
+		entry.Balance = entry.Balance.Sub(amt)
+		entry.InitialBalance = entry.InitialBalance.Sub(math.MinInt(amt, entry.InitialBalance))
+		if entry.Balance.IsZero() {
+			ubd.RemoveEntry(int64(i))
+		} else {
+			ubd.Entries[i] = entry
+		}
+
+		toUbd, err := k.SetUnbondingDelegationEntry(ctx, toAddr, valAddr, entry.CreationHeight, entry.CompletionTime, amt)
+		if err != nil {
+			return transferred, err
+		}
+		k.InsertUBDQueue(ctx, toUbd, entry.CompletionTime)
+
+		transferred = transferred.Add(amt)
+	}
+
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	return transferred, nil
+}
+
+// TransferDelegation moves up to the given shares of the delegation of fromAddr
+// to valAddr to a delegation of toAddr, and returns the moved shares. The
+// shares received from redelegations, which can still be slashed for the
+// infractions of their source validator, are not moved.
+func (k Keeper) TransferDelegation(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantShares sdk.Dec,
+) (sdk.Dec, error) {
+	delegation, found := k.GetDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return math.LegacyZeroDec(), nil
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return math.LegacyZeroDec(), types.ErrNoValidatorFound
+	}
+
+	redelegatedShares := math.LegacyZeroDec()
+	k.IterateDelegatorRedelegations(ctx, fromAddr, func(red types.Redelegation) (stop bool) {
+		if red.ValidatorDstAddress != valAddr.String() {
+			return false
+		}
+
+		for _, entry := range red.Entries {
+			redelegatedShares = redelegatedShares.Add(entry.SharesDst)
+		}
+		return false
+	})
+
+	shares := sdk.MinDec(wantShares, delegation.Shares.Sub(redelegatedShares))
+	if !shares.IsPositive() {
+		return math.LegacyZeroDec(), nil
+	}
+
+	// the validator bond shares must keep covering the liquid shares of the validator
+	if k.IsValidatorBond(ctx, fromAddr, valAddr) {
+		if err := k.safelyDecreaseValidatorBondShares(ctx, valAddr, shares); err != nil {
+			return math.LegacyZeroDec(), err
+		}
+	}
+
+	if err := k.Hooks().BeforeDelegationSharesModified(ctx, fromAddr, valAddr); err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	delegation.Shares = delegation.Shares.Sub(shares)
+	if delegation.Shares.IsZero() {
+		if err := k.RemoveDelegation(ctx, delegation); err != nil {
+			return math.LegacyZeroDec(), err
+		}
+	} else {
+		k.SetDelegation(ctx, delegation)
+		if err := k.Hooks().AfterDelegationModified(ctx, fromAddr, valAddr); err != nil {
+			return math.LegacyZeroDec(), err
+		}
+	}
+
+	// the validator is jailed if its self-delegation falls below its minimum
+	if bytes.Equal(fromAddr, validator.GetOperator()) && !validator.Jailed &&
+		validator.TokensFromShares(delegation.Shares).TruncateInt().LT(validator.MinSelfDelegation) {
+		k.jailValidator(ctx, validator)
+	}
+
+	toDelegation, found := k.GetDelegation(ctx, toAddr, valAddr)
+	var err error
+	if found {
+		err = k.Hooks().BeforeDelegationSharesModified(ctx, toAddr, valAddr)
+	} else {
+		toDelegation = types.NewDelegation(toAddr, valAddr, math.LegacyZeroDec())
+		err = k.Hooks().BeforeDelegationCreated(ctx, toAddr, valAddr)
+	}
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	toDelegation.Shares = toDelegation.Shares.Add(shares)
+	k.SetDelegation(ctx, toDelegation)
+
+	if k.IsValidatorBond(ctx, toAddr, valAddr) {
+		k.increaseValidatorBondShares(ctx, valAddr, shares)
+	}
+
+	if err := k.Hooks().AfterDelegationModified(ctx, toAddr, valAddr); err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	return shares, nil
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"time"
 
 	"cosmossdk.io/math"
@@ -278,6 +279,34 @@ func (s *KeeperTestSuite) TestUnbondingDelegation() {
 	require.Equal(0, len(resUnbonds))
 }
 
+func (s *KeeperTestSuite) TestGetCancellableUnbondingEntries() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddrs, valAddrs := createValAddrs(1)
+	blockTime := time.Unix(1000, 0).UTC()
+	ctx = ctx.WithBlockTime(blockTime)
+
+	ubd := stakingtypes.NewUnbondingDelegation(delAddrs[0], valAddrs[0], 1, blockTime.Add(-time.Second), sdk.NewInt(5), 0)
+	ubd.AddEntry(2, blockTime.Add(time.Hour), sdk.NewInt(10), 1)
+
+	// the validator does not exist
+	require.Empty(keeper.GetCancellableUnbondingEntries(ctx, ubd))
+
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	keeper.SetValidator(ctx, validator)
+
+	// only the entry which is not completed yet can be cancelled
+	entries := keeper.GetCancellableUnbondingEntries(ctx, ubd)
+	require.Len(entries, 1)
+	require.Equal(int64(2), entries[0].CreationHeight)
+
+	// the entries of a jailed validator cannot be cancelled
+	validator.Jailed = true
+	keeper.SetValidator(ctx, validator)
+	require.Empty(keeper.GetCancellableUnbondingEntries(ctx, ubd))
+}
+
 func (s *KeeperTestSuite) TestUnbondDelegation() {
 	ctx, keeper := s.ctx, s.stakingKeeper
 	require := s.Require()
@@ -316,6 +345,47 @@ func (s *KeeperTestSuite) TestUnbondDelegation() {
 	require.Equal(remainingTokens, validator.BondedTokens())
 }
 
+func (s *KeeperTestSuite) TestUndelegateHooks() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddrs, valAddrs := createValAddrs(1)
+
+	for _, addr := range delAddrs {
+		s.accountKeeper.EXPECT().StringToBytes(addr.String()).Return(addr, nil).AnyTimes()
+		s.accountKeeper.EXPECT().BytesToString(addr).Return(addr.String(), nil).AnyTimes()
+	}
+
+	hooks := testutil.NewMockStakingHooks(gomock.NewController(s.T()))
+	hooks.EXPECT().BeforeDelegationSharesModified(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	hooks.EXPECT().AfterDelegationModified(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	keeper.SetHooks(hooks)
+
+	startTokens := keeper.TokensFromConsensusPower(ctx, 10)
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+	keeper.SetValidator(ctx, validator)
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(delAddrs[0], valAddrs[0], issuedShares))
+
+	// an error of the AfterUnbondingInitiated hook aborts the undelegation,
+	// whose state changes are discarded like those of a failed message
+	cacheCtx, _ := ctx.CacheContext()
+	hooks.EXPECT().AfterUnbondingInitiated(gomock.Any(), uint64(1)).Return(errors.New("unbonding vetoed"))
+	_, _, err := keeper.Undelegate(cacheCtx, delAddrs[0], valAddrs[0], sdk.NewDec(1))
+	require.ErrorContains(err, "unbonding vetoed")
+
+	hooks.EXPECT().AfterUnbondingInitiated(gomock.Any(), uint64(1)).Return(nil)
+	completionTime, amount, err := keeper.Undelegate(ctx, delAddrs[0], valAddrs[0], sdk.NewDec(1))
+	require.NoError(err)
+
+	// the AfterUnbondingCompleted hook is called once the unbonding is completed
+	hooks.EXPECT().AfterUnbondingCompleted(gomock.Any(), uint64(1)).Return(nil)
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), stakingtypes.NotBondedPoolName, delAddrs[0], gomock.Any()).Return(nil)
+	balances, err := keeper.CompleteUnbonding(ctx.WithBlockTime(completionTime), delAddrs[0], valAddrs[0])
+	require.NoError(err)
+	require.Equal(amount, balances.AmountOf(keeper.BondDenom(ctx)))
+}
+
 // // test undelegating self delegation from a validator pushing it below MinSelfDelegation
 // // shift it from the bonded to unbonding state and jailed
 func (s *KeeperTestSuite) TestUndelegateSelfDelegationBelowMinSelfDelegation() {
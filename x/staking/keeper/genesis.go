@@ -103,6 +103,47 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 		}
 	}
 
+	if data.LiquidStakingParams != nil {
+		if err := k.SetLiquidStakingParams(ctx, *data.LiquidStakingParams); err != nil {
+			panic(err)
+		}
+	}
+
+	if !data.TotalLiquidStakedTokens.IsNil() {
+		k.setTotalLiquidStakedTokens(ctx, data.TotalLiquidStakedTokens)
+	}
+
+	for _, lv := range data.LiquidValidators {
+		valAddr, err := sdk.ValAddressFromBech32(lv.OperatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		k.setValidatorLiquidShares(ctx, valAddr, lv.LiquidShares)
+	}
+
+	for _, bond := range data.ValidatorBondDelegations {
+		delAddr, err := k.authKeeper.StringToBytes(bond.DelegatorAddress)
+		if err != nil {
+			panic(fmt.Errorf("invalid delegator address: %s", err))
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(bond.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		k.setValidatorBondDelegation(ctx, delAddr, valAddr)
+	}
+
+	for _, record := range data.TokenizeShareRecords {
+		k.setTokenizeShareRecord(ctx, record)
+	}
+
+	if data.LastTokenizeShareRecordId != 0 {
+		k.setLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordId)
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -196,14 +237,22 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		return false
 	})
 
+	liquidStakingParams := k.GetLiquidStakingParams(ctx)
+
 	return &types.GenesisState{
-		Params:               k.GetParams(ctx),
-		LastTotalPower:       k.GetLastTotalPower(ctx),
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           k.GetAllValidators(ctx),
-		Delegations:          k.GetAllDelegations(ctx),
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		Params:                    k.GetParams(ctx),
+		LastTotalPower:            k.GetLastTotalPower(ctx),
+		LastValidatorPowers:       lastValidatorPowers,
+		Validators:                k.GetAllValidators(ctx),
+		Delegations:               k.GetAllDelegations(ctx),
+		UnbondingDelegations:      unbondingDelegations,
+		Redelegations:             redelegations,
+		Exported:                  true,
+		LiquidStakingParams:       &liquidStakingParams,
+		TotalLiquidStakedTokens:   k.GetTotalLiquidStakedTokens(ctx),
+		LiquidValidators:          k.GetAllLiquidValidators(ctx),
+		ValidatorBondDelegations:  k.GetAllValidatorBondDelegations(ctx),
+		TokenizeShareRecords:      k.GetAllTokenizeShareRecords(ctx),
+		LastTokenizeShareRecordId: k.GetLastTokenizeShareRecordID(ctx),
 	}
 }
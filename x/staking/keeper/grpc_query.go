@@ -387,6 +387,50 @@ func (k Querier) DelegatorUnbondingDelegations(c context.Context, req *types.Que
 	}, nil
 }
 
+// CancellableUnbondingDelegations queries the unbonding delegation entries of a given delegator address which can be cancelled
+func (k Querier) CancellableUnbondingDelegations(c context.Context, req *types.QueryCancellableUnbondingDelegationsRequest) (*types.QueryCancellableUnbondingDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.DelegatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+	var unbondingDelegations types.UnbondingDelegations
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	delAddr, err := k.authKeeper.StringToBytes(req.DelegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	unbStore := prefix.NewStore(store, types.GetUBDsKey(delAddr))
+	pageRes, err := query.FilteredPaginate(unbStore, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		unbond, err := types.UnmarshalUBD(k.cdc, value)
+		if err != nil {
+			return false, err
+		}
+
+		unbond.Entries = k.GetCancellableUnbondingEntries(ctx, unbond)
+		if len(unbond.Entries) == 0 {
+			return false, nil
+		}
+
+		if accumulate {
+			unbondingDelegations = append(unbondingDelegations, unbond)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCancellableUnbondingDelegationsResponse{
+		UnbondingResponses: unbondingDelegations, Pagination: pageRes,
+	}, nil
+}
+
 // HistoricalInfo queries the historical info for given height
 func (k Querier) HistoricalInfo(c context.Context, req *types.QueryHistoricalInfoRequest) (*types.QueryHistoricalInfoResponse, error) {
 	if req == nil {
@@ -499,6 +543,58 @@ func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// LiquidStakingParams queries the liquid staking parameters
+func (k Querier) LiquidStakingParams(c context.Context, _ *types.QueryLiquidStakingParamsRequest) (*types.QueryLiquidStakingParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetLiquidStakingParams(ctx)
+
+	return &types.QueryLiquidStakingParamsResponse{Params: params}, nil
+}
+
+// TotalLiquidStaked queries the total amount of liquid staked tokens
+func (k Querier) TotalLiquidStaked(c context.Context, _ *types.QueryTotalLiquidStakedRequest) (*types.QueryTotalLiquidStakedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	tokens := k.GetTotalLiquidStakedTokens(ctx)
+
+	return &types.QueryTotalLiquidStakedResponse{Tokens: tokens}, nil
+}
+
+// ValidatorLiquidShares queries the validator bond and liquid shares of a validator
+func (k Querier) ValidatorLiquidShares(c context.Context, req *types.QueryValidatorLiquidSharesRequest) (*types.QueryValidatorLiquidSharesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	liquidShares := k.GetValidatorLiquidShares(ctx, valAddr)
+
+	return &types.QueryValidatorLiquidSharesResponse{LiquidShares: liquidShares}, nil
+}
+
+// TokenizeShareRecord queries a tokenize share record by its id
+func (k Querier) TokenizeShareRecord(c context.Context, req *types.QueryTokenizeShareRecordRequest) (*types.QueryTokenizeShareRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	record, found := k.GetTokenizeShareRecord(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "tokenize share record %d not found", req.Id)
+	}
+
+	return &types.QueryTokenizeShareRecordResponse{Record: record}, nil
+}
+
 func queryRedelegation(ctx sdk.Context, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
 	delAddr, err := k.authKeeper.StringToBytes(req.DelegatorAddr)
 	if err != nil {
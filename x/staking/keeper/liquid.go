@@ -0,0 +1,525 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetLiquidStakingParams returns the liquid staking parameters, the default
+// ones if they were never set.
+func (k Keeper) GetLiquidStakingParams(ctx sdk.Context) types.LiquidStakingParams {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LiquidStakingParamsKey)
+	if bz == nil {
+		return types.DefaultLiquidStakingParams()
+	}
+
+	var params types.LiquidStakingParams
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetLiquidStakingParams sets the liquid staking parameters.
+func (k Keeper) SetLiquidStakingParams(ctx sdk.Context, params types.LiquidStakingParams) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.LiquidStakingParamsKey, bz)
+
+	return nil
+}
+
+// GetTotalLiquidStakedTokens returns the total amount of liquid staked tokens.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx sdk.Context) math.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TotalLiquidStakedTokensKey)
+	if bz == nil {
+		return math.ZeroInt()
+	}
+
+	ip := sdk.IntProto{}
+	k.cdc.MustUnmarshal(bz, &ip)
+
+	return ip.Int
+}
+
+// setTotalLiquidStakedTokens sets the total amount of liquid staked tokens.
+func (k Keeper) setTotalLiquidStakedTokens(ctx sdk.Context, tokens math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: tokens})
+	store.Set(types.TotalLiquidStakedTokensKey, bz)
+}
+
+// GetValidatorLiquidShares returns the validator bond and liquid shares of a
+// validator.
+func (k Keeper) GetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress) types.ValidatorLiquidShares {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorLiquidSharesKey(valAddr))
+	if bz == nil {
+		return types.NewValidatorLiquidShares()
+	}
+
+	var shares types.ValidatorLiquidShares
+	k.cdc.MustUnmarshal(bz, &shares)
+	return shares
+}
+
+// setValidatorLiquidShares sets the validator bond and liquid shares of a
+// validator, or removes them when both are zero.
+func (k Keeper) setValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares types.ValidatorLiquidShares) {
+	store := ctx.KVStore(k.storeKey)
+	if shares.ValidatorBondShares.IsZero() && shares.LiquidShares.IsZero() {
+		store.Delete(types.GetValidatorLiquidSharesKey(valAddr))
+		return
+	}
+
+	store.Set(types.GetValidatorLiquidSharesKey(valAddr), k.cdc.MustMarshal(&shares))
+}
+
+// GetAllLiquidValidators returns the validator bond and liquid shares of all
+// the validators having some.
+func (k Keeper) GetAllLiquidValidators(ctx sdk.Context) (liquidValidators []types.LiquidValidator) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ValidatorLiquidSharesKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		valAddr := sdk.ValAddress(iterator.Key()[len(types.ValidatorLiquidSharesKey)+1:])
+
+		var shares types.ValidatorLiquidShares
+		k.cdc.MustUnmarshal(iterator.Value(), &shares)
+		liquidValidators = append(liquidValidators, types.LiquidValidator{
+			OperatorAddress: valAddr.String(),
+			LiquidShares:    shares,
+		})
+	}
+
+	return liquidValidators
+}
+
+// IsValidatorBond returns whether the delegation is designated as a validator
+// bond.
+func (k Keeper) IsValidatorBond(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetValidatorBondDelegationKey(delAddr, valAddr))
+}
+
+// GetAllValidatorBondDelegations returns all the delegations designated as
+// validator bonds.
+func (k Keeper) GetAllValidatorBondDelegations(ctx sdk.Context) (bonds []types.ValidatorBondDelegation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ValidatorBondDelegationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		delAddr, valAddr := types.ParseValidatorBondDelegationKey(iterator.Key())
+		bonds = append(bonds, types.ValidatorBondDelegation{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: valAddr.String(),
+		})
+	}
+
+	return bonds
+}
+
+// setValidatorBondDelegation designates a delegation as a validator bond,
+// without updating the validator bond shares of the validator.
+func (k Keeper) setValidatorBondDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorBondDelegationKey(delAddr, valAddr), []byte{})
+}
+
+// SetValidatorBond designates an existing delegation as a validator bond, which
+// adds its shares to the validator bond shares of the validator.
+func (k Keeper) SetValidatorBond(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return types.ErrNoDelegation
+	}
+
+	if k.IsValidatorBond(ctx, delAddr, valAddr) {
+		return types.ErrValidatorBondAlreadySet
+	}
+
+	k.setValidatorBondDelegation(ctx, delAddr, valAddr)
+	k.increaseValidatorBondShares(ctx, valAddr, delegation.Shares)
+	return nil
+}
+
+// increaseValidatorBondShares adds the shares to the validator bond shares of
+// the validator.
+func (k Keeper) increaseValidatorBondShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) {
+	liquidShares := k.GetValidatorLiquidShares(ctx, valAddr)
+	liquidShares.ValidatorBondShares = liquidShares.ValidatorBondShares.Add(shares)
+	k.setValidatorLiquidShares(ctx, valAddr, liquidShares)
+}
+
+// safelyDecreaseValidatorBondShares removes the shares from the validator bond
+// shares of the validator. It fails if the remaining validator bond shares
+// would no longer cover the liquid shares of the validator.
+func (k Keeper) safelyDecreaseValidatorBondShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) error {
+	liquidShares := k.GetValidatorLiquidShares(ctx, valAddr)
+	bondShares := liquidShares.ValidatorBondShares.Sub(shares)
+	if bondShares.IsNegative() {
+		bondShares = math.LegacyZeroDec()
+	}
+
+	params := k.GetLiquidStakingParams(ctx)
+	if params.ValidatorBondEnabled() && liquidShares.LiquidShares.GT(bondShares.Mul(params.ValidatorBondFactor)) {
+		return errorsmod.Wrapf(
+			types.ErrInsufficientValidatorBondShares,
+			"liquid shares %s, validator bond shares %s", liquidShares.LiquidShares, bondShares,
+		)
+	}
+
+	liquidShares.ValidatorBondShares = bondShares
+	k.setValidatorLiquidShares(ctx, valAddr, liquidShares)
+	return nil
+}
+
+// checkLiquidStakingCaps returns an error if liquid staking the given shares
+// and tokens of the validator would exceed the global or validator liquid
+// staking caps, or the validator bond of the validator.
+func (k Keeper) checkLiquidStakingCaps(ctx sdk.Context, validator types.Validator, shares sdk.Dec, tokens math.Int) error {
+	params := k.GetLiquidStakingParams(ctx)
+
+	// a global cap of 1 allows liquid staking all the tokens, including the
+	// ones delegated to unbonded validators
+	if params.GlobalLiquidStakingCap.LT(math.LegacyOneDec()) {
+		totalLiquidStaked := k.GetTotalLiquidStakedTokens(ctx).Add(tokens)
+		if math.LegacyNewDecFromInt(totalLiquidStaked).GT(params.GlobalLiquidStakingCap.MulInt(k.TotalBondedTokens(ctx))) {
+			return types.ErrGlobalLiquidStakingCapExceeded
+		}
+	}
+
+	liquidShares := k.GetValidatorLiquidShares(ctx, validator.GetOperator())
+	validatorLiquidShares := liquidShares.LiquidShares.Add(shares)
+	if validatorLiquidShares.GT(params.ValidatorLiquidStakingCap.Mul(validator.DelegatorShares)) {
+		return types.ErrValidatorLiquidStakingCapExceeded
+	}
+
+	if params.ValidatorBondEnabled() && validatorLiquidShares.GT(liquidShares.ValidatorBondShares.Mul(params.ValidatorBondFactor)) {
+		return types.ErrInsufficientValidatorBondShares
+	}
+
+	return nil
+}
+
+// increaseLiquidStaked adds the shares to the liquid shares of the validator,
+// and the tokens to the total liquid staked tokens.
+func (k Keeper) increaseLiquidStaked(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec, tokens math.Int) {
+	liquidShares := k.GetValidatorLiquidShares(ctx, valAddr)
+	liquidShares.LiquidShares = liquidShares.LiquidShares.Add(shares)
+	k.setValidatorLiquidShares(ctx, valAddr, liquidShares)
+
+	k.setTotalLiquidStakedTokens(ctx, k.GetTotalLiquidStakedTokens(ctx).Add(tokens))
+}
+
+// decreaseLiquidStaked removes the shares from the liquid shares of the
+// validator, and the tokens from the total liquid staked tokens. Both are
+// floored at zero, as the rounding of the shares to tokens conversions can make
+// them slightly differ from the tokenized amounts.
+func (k Keeper) decreaseLiquidStaked(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec, tokens math.Int) {
+	liquidShares := k.GetValidatorLiquidShares(ctx, valAddr)
+	liquidShares.LiquidShares = liquidShares.LiquidShares.Sub(shares)
+	if liquidShares.LiquidShares.IsNegative() {
+		liquidShares.LiquidShares = math.LegacyZeroDec()
+	}
+	k.setValidatorLiquidShares(ctx, valAddr, liquidShares)
+
+	totalLiquidStaked := k.GetTotalLiquidStakedTokens(ctx).Sub(math.MinInt(tokens, k.GetTotalLiquidStakedTokens(ctx)))
+	k.setTotalLiquidStakedTokens(ctx, totalLiquidStaked)
+}
+
+// GetTokenizeShareRecord returns the tokenize share record with the given id.
+func (k Keeper) GetTokenizeShareRecord(ctx sdk.Context, id uint64) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTokenizeShareRecordKey(id))
+	if bz == nil {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// setTokenizeShareRecord sets a tokenize share record.
+func (k Keeper) setTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenizeShareRecordKey(record.Id), k.cdc.MustMarshal(&record))
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records.
+func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) (records []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.TokenizeShareRecordKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizeShareRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// deleteTokenizeShareRecord removes the tokenize share record with the given id.
+func (k Keeper) deleteTokenizeShareRecord(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordKey(id))
+}
+
+// removeTokenizeShareRecord removes a tokenize share record whose delegation
+// was fully unbonded, and sends the rewards left on its account to its owner.
+// The unbonded tokens must not have been moved to the account of the record yet.
+func (k Keeper) removeTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) error {
+	k.deleteTokenizeShareRecord(ctx, record.Id)
+
+	owner, err := sdk.AccAddressFromBech32(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	recordAddr := record.GetModuleAddress()
+	rewards := k.bankKeeper.GetAllBalances(ctx, recordAddr)
+	if rewards.IsZero() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoins(ctx, recordAddr, owner, rewards)
+}
+
+// GetLastTokenizeShareRecordID returns the id of the last tokenize share
+// record, zero if none was ever created.
+func (k Keeper) GetLastTokenizeShareRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastTokenizeShareRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// setLastTokenizeShareRecordID sets the id of the last tokenize share record.
+func (k Keeper) setLastTokenizeShareRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// nextTokenizeShareRecordID increments and returns a unique id for a tokenize
+// share record.
+func (k Keeper) nextTokenizeShareRecordID(ctx sdk.Context) uint64 {
+	id := k.GetLastTokenizeShareRecordID(ctx) + 1
+	k.setLastTokenizeShareRecordID(ctx, id)
+
+	return id
+}
+
+// TokenizeShares converts the shares of a delegation worth the given amount of
+// tokens into share tokens sent to the owner. The shares are moved to a
+// delegation held by a new tokenize share record, and the minted share tokens
+// are worth one share each. The owner also becomes the owner of the record,
+// receiving the rewards of its delegation.
+func (k Keeper) TokenizeShares(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int, owner sdk.AccAddress,
+) (sdk.Coin, error) {
+	if k.bankKeeper.BlockedAddr(owner) {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", owner)
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	if k.IsValidatorBond(ctx, delAddr, valAddr) {
+		return sdk.Coin{}, types.ErrValidatorBondNotAllowedForTokenizeShares
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the unbonded tokens are sent to the record, so only the delegated vested
+	// tokens of vesting accounts can be tokenized
+	bondDenom := k.BondDenom(ctx)
+	if acc, ok := k.authKeeper.GetAccount(ctx, delAddr).(vestexported.VestingAccount); ok {
+		if acc.GetDelegatedFree().AmountOf(bondDenom).LT(amount) {
+			return sdk.Coin{}, types.ErrTokenizeSharesOfVestingTokens
+		}
+	}
+
+	if err := k.checkLiquidStakingCaps(ctx, validator, shares, amount); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	record := types.NewTokenizeShareRecord(k.nextTokenizeShareRecordID(ctx), valAddr, owner)
+	recordAddr := record.GetModuleAddress()
+
+	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if returnAmount.IsZero() {
+		return sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "too few tokens to tokenize (truncates to zero tokens)")
+	}
+
+	if validator.IsBonded() {
+		k.bondedTokensToNotBonded(ctx, returnAmount)
+	}
+
+	returnCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, returnAmount))
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, returnCoins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, delAddr, recordAddr, returnCoins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the validator may have been jailed if the delegator is its operator
+	validator, found = k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	newShares, err := k.Delegate(ctx, recordAddr, returnAmount, types.Unbonded, validator, true)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), newShares.TruncateInt())
+	if !shareToken.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "too few shares to tokenize (truncates to zero share tokens)")
+	}
+
+	k.setTokenizeShareRecord(ctx, record)
+	k.increaseLiquidStaked(ctx, valAddr, newShares, returnAmount)
+
+	shareTokens := sdk.NewCoins(shareToken)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, shareTokens); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, shareTokens); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return shareToken, nil
+}
+
+// RedeemTokensForShares burns share tokens of the delegator, and moves the
+// shares they are worth from the delegation of their tokenize share record to a
+// delegation of the delegator. The record is removed once all its share tokens
+// are redeemed. It returns the tokens delegated by the delegator.
+func (k Keeper) RedeemTokensForShares(ctx sdk.Context, delAddr sdk.AccAddress, amount sdk.Coin) (sdk.Coin, error) {
+	id, err := types.ParseShareTokenDenom(amount.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	record, found := k.GetTokenizeShareRecord(ctx, id)
+	if !found {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrTokenizeShareRecordNotFound, "id %d", id)
+	}
+
+	if record.GetShareTokenDenom() != amount.Denom {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidShareTokenDenom, "expected %s, got %s", record.GetShareTokenDenom(), amount.Denom)
+	}
+
+	if balance := k.bankKeeper.GetBalance(ctx, delAddr, amount.Denom); balance.Amount.LT(amount.Amount) {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", balance, amount)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	recordAddr := record.GetModuleAddress()
+	delegation, found := k.GetDelegation(ctx, recordAddr, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoDelegation
+	}
+
+	// redeeming all the share tokens also redeems the fraction of share left
+	// over by the truncation of the minted share tokens
+	shares := math.LegacyNewDecFromInt(amount.Amount)
+	if amount.Amount.Equal(k.bankKeeper.GetSupply(ctx, amount.Denom).Amount) || shares.GT(delegation.Shares) {
+		shares = delegation.Shares
+	}
+
+	k.decreaseLiquidStaked(ctx, valAddr, shares, validator.TokensFromShares(shares).TruncateInt())
+
+	burnCoins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, burnCoins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnCoins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	returnAmount, err := k.Unbond(ctx, recordAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if _, found := k.GetDelegation(ctx, recordAddr, valAddr); !found {
+		if err := k.removeTokenizeShareRecord(ctx, record); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	bondDenom := k.BondDenom(ctx)
+	if returnAmount.IsZero() {
+		return sdk.NewCoin(bondDenom, returnAmount), nil
+	}
+
+	if validator.IsBonded() {
+		k.bondedTokensToNotBonded(ctx, returnAmount)
+	}
+
+	returnCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, returnAmount))
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, recordAddr, returnCoins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, recordAddr, delAddr, returnCoins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	validator, found = k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	if _, err := k.Delegate(ctx, delAddr, returnAmount, types.Unbonded, validator, true); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(bondDenom, returnAmount), nil
+}
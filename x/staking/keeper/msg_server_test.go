@@ -1132,3 +1132,65 @@ func (s *KeeperTestSuite) TestMsgUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMsgUpdateLiquidStakingParams() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	testCases := []struct {
+		name      string
+		input     *stakingtypes.MsgUpdateLiquidStakingParams
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "valid params",
+			input: &stakingtypes.MsgUpdateLiquidStakingParams{
+				Authority: keeper.GetAuthority(),
+				Params:    stakingtypes.NewLiquidStakingParams(math.LegacyNewDecWithPrec(25, 2), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(250)),
+			},
+			expErr: false,
+		},
+		{
+			name: "invalid authority",
+			input: &stakingtypes.MsgUpdateLiquidStakingParams{
+				Authority: "invalid",
+				Params:    stakingtypes.DefaultLiquidStakingParams(),
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "global cap cannot be bigger than 100",
+			input: &stakingtypes.MsgUpdateLiquidStakingParams{
+				Authority: keeper.GetAuthority(),
+				Params:    stakingtypes.NewLiquidStakingParams(math.LegacyNewDec(2), math.LegacyOneDec(), stakingtypes.DefaultValidatorBondFactor),
+			},
+			expErr:    true,
+			expErrMsg: "global liquid staking cap: cap must be between 0 and 1",
+		},
+		{
+			name: "negative validator bond factor",
+			input: &stakingtypes.MsgUpdateLiquidStakingParams{
+				Authority: keeper.GetAuthority(),
+				Params:    stakingtypes.NewLiquidStakingParams(math.LegacyOneDec(), math.LegacyOneDec(), math.LegacyNewDec(-2)),
+			},
+			expErr:    true,
+			expErrMsg: "validator bond factor must be positive or -1",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.T().Run(tc.name, func(t *testing.T) {
+			_, err := s.msgServer.UpdateLiquidStakingParams(ctx, tc.input)
+			if tc.expErr {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(err)
+				require.Equal(tc.input.Params, keeper.GetLiquidStakingParams(ctx))
+			}
+		})
+	}
+}
@@ -130,6 +130,8 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		}
 	}
 
+	k.emitDelegationsSlashEvent(ctx, validator, tokensToBurn)
+
 	// Deduct from validator's bonded tokens and update the validator.
 	// Burn the slashed tokens from the pool account and decrease the total supply.
 	validator = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
@@ -227,6 +229,18 @@ func (k Keeper) SlashUnbondingDelegation(ctx sdk.Context, unbondingDelegation ty
 		panic(err)
 	}
 
+	if burnedAmount.IsPositive() {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSlashDelegator,
+				sdk.NewAttribute(types.AttributeKeyValidator, unbondingDelegation.ValidatorAddress),
+				sdk.NewAttribute(types.AttributeKeyDelegator, unbondingDelegation.DelegatorAddress),
+				sdk.NewAttribute(types.AttributeKeySlashSource, types.AttributeValueSlashSourceUnbondingDelegation),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, burnedAmount.String()),
+			),
+		)
+	}
+
 	return totalSlashAmount
 }
 
@@ -316,5 +330,37 @@ func (k Keeper) SlashRedelegation(ctx sdk.Context, srcValidator types.Validator,
 		panic(err)
 	}
 
+	if burnedAmount := bondedBurnedAmount.Add(notBondedBurnedAmount); burnedAmount.IsPositive() {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSlashDelegator,
+				sdk.NewAttribute(types.AttributeKeyValidator, redelegation.ValidatorSrcAddress),
+				sdk.NewAttribute(types.AttributeKeyDstValidator, redelegation.ValidatorDstAddress),
+				sdk.NewAttribute(types.AttributeKeyDelegator, redelegation.DelegatorAddress),
+				sdk.NewAttribute(types.AttributeKeySlashSource, types.AttributeValueSlashSourceRedelegation),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, burnedAmount.String()),
+			),
+		)
+	}
+
 	return totalSlashAmount
 }
+
+// emitDelegationsSlashEvent emits a single event with the tokens burned from
+// the delegations to the validator and the validator delegator shares, the
+// amount burned from a delegation being proportional to its shares. One event
+// is emitted whatever the number of delegations, which is unbounded.
+func (k Keeper) emitDelegationsSlashEvent(ctx sdk.Context, validator types.Validator, tokensToBurn math.Int) {
+	if !tokensToBurn.IsPositive() {
+		return
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashDelegations,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDelegatorShares, validator.DelegatorShares.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, tokensToBurn.String()),
+		),
+	)
+}
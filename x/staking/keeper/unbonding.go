@@ -34,6 +34,17 @@ func (k Keeper) DeleteUnbondingIndex(ctx sdk.Context, id uint64) {
 	store.Delete(types.GetUnbondingIndexKey(id))
 }
 
+// afterUnbondingCompleted calls the AfterUnbondingCompleted hook for the
+// unbonding operations completed in the EndBlocker. As there is no message to
+// abort at this point, hook errors are only logged.
+func (k Keeper) afterUnbondingCompleted(ctx sdk.Context, ids []uint64) {
+	for _, id := range ids {
+		if err := k.Hooks().AfterUnbondingCompleted(ctx, id); err != nil {
+			k.Logger(ctx).Error("failed to call after unbonding completed hook", "id", id, "error", err)
+		}
+	}
+}
+
 func (k Keeper) GetUnbondingType(ctx sdk.Context, id uint64) (unbondingType types.UnbondingType, found bool) {
 	store := ctx.KVStore(k.storeKey)
 
@@ -263,6 +274,8 @@ func (k Keeper) unbondingDelegationEntryCanComplete(ctx sdk.Context, id uint64)
 	}
 	ubd.Entries[i].UnbondingOnHoldRefCount--
 
+	var completed bool
+
 	// Check if entry is matured.
 	if !ubd.Entries[i].OnHold() && ubd.Entries[i].IsMature(ctx.BlockHeader().Time) {
 		// If matured, complete it.
@@ -287,6 +300,8 @@ func (k Keeper) unbondingDelegationEntryCanComplete(ctx sdk.Context, id uint64)
 		ubd.RemoveEntry(int64(i))
 		// Remove from the UnbondingIndex
 		k.DeleteUnbondingIndex(ctx, id)
+
+		completed = true
 	}
 
 	// set the unbonding delegation or remove it if there are no more entries
@@ -296,6 +311,10 @@ func (k Keeper) unbondingDelegationEntryCanComplete(ctx sdk.Context, id uint64)
 		k.SetUnbondingDelegation(ctx, ubd)
 	}
 
+	if completed {
+		return k.Hooks().AfterUnbondingCompleted(ctx, id)
+	}
+
 	// Successfully completed unbonding
 	return nil
 }
@@ -321,12 +340,16 @@ func (k Keeper) redelegationEntryCanComplete(ctx sdk.Context, id uint64) error {
 	}
 	red.Entries[i].UnbondingOnHoldRefCount--
 
+	var completed bool
+
 	if !red.Entries[i].OnHold() && red.Entries[i].IsMature(ctx.BlockHeader().Time) {
 		// If matured, complete it.
 		// Remove entry
 		red.RemoveEntry(int64(i))
 		// Remove from the Unbonding index
 		k.DeleteUnbondingIndex(ctx, id)
+
+		completed = true
 	}
 
 	// set the redelegation or remove it if there are no more entries
@@ -336,6 +359,10 @@ func (k Keeper) redelegationEntryCanComplete(ctx sdk.Context, id uint64) error {
 		k.SetRedelegation(ctx, red)
 	}
 
+	if completed {
+		return k.Hooks().AfterUnbondingCompleted(ctx, id)
+	}
+
 	// Successfully completed unbonding
 	return nil
 }
@@ -458,6 +458,7 @@ func (k Keeper) UnbondAllMatureValidators(ctx sdk.Context) {
 				}
 
 				if val.UnbondingOnHoldRefCount == 0 {
+					unbondingIDs := val.UnbondingIds
 					for _, id := range val.UnbondingIds {
 						k.DeleteUnbondingIndex(ctx, id)
 					}
@@ -473,6 +474,8 @@ func (k Keeper) UnbondAllMatureValidators(ctx sdk.Context) {
 
 					// remove validator from queue
 					k.DeleteValidatorQueue(ctx, val)
+
+					k.afterUnbondingCompleted(ctx, unbondingIDs)
 				}
 			}
 		}
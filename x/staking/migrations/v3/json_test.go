@@ -38,8 +38,15 @@ func TestMigrateJSON(t *testing.T) {
 	expected := `{
 	"delegations": [],
 	"exported": false,
+	"last_tokenize_share_record_id": "0",
 	"last_total_power": "0",
 	"last_validator_powers": [],
+	"liquid_staking_params": {
+		"global_liquid_staking_cap": "1.000000000000000000",
+		"validator_bond_factor": "-1.000000000000000000",
+		"validator_liquid_staking_cap": "1.000000000000000000"
+	},
+	"liquid_validators": [],
 	"params": {
 		"bond_denom": "stake",
 		"historical_entries": 10000,
@@ -49,7 +56,10 @@ func TestMigrateJSON(t *testing.T) {
 		"unbonding_time": "1814400s"
 	},
 	"redelegations": [],
+	"tokenize_share_records": [],
+	"total_liquid_staked_tokens": "0",
 	"unbonding_delegations": [],
+	"validator_bond_delegations": [],
 	"validators": []
 }`
 
@@ -0,0 +1,71 @@
+package v6
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v5 to v6. The
+// migration includes:
+//
+// - Raising the commission rate of the validators below the MinCommissionRate
+// param to it, along with their max commission rate when it is also below.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return nil
+	}
+
+	var params types.Params
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+
+	return migrateValidatorsCommission(ctx, store, cdc, params.MinCommissionRate)
+}
+
+func migrateValidatorsCommission(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec, minRate sdk.Dec) error {
+	if minRate.IsNil() || !minRate.IsPositive() {
+		return nil
+	}
+
+	var validators []types.Validator
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.ValidatorsKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		validator, err := types.UnmarshalValidator(cdc, iterator.Value())
+		if err != nil {
+			return err
+		}
+
+		if validator.Commission.Rate.GTE(minRate) {
+			continue
+		}
+
+		validator.Commission.Rate = minRate
+		if validator.Commission.MaxRate.LT(minRate) {
+			validator.Commission.MaxRate = minRate
+		}
+		validator.Commission.UpdateTime = ctx.BlockTime()
+
+		validators = append(validators, validator)
+	}
+
+	for i := range validators {
+		valAddr, err := sdk.ValAddressFromBech32(validators[i].OperatorAddress)
+		if err != nil {
+			return err
+		}
+
+		store.Set(types.GetValidatorKey(valAddr), types.MustMarshalValidator(cdc, &validators[i]))
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package v6_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	v6 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v6"
+	stakingtestutil "github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrateValidatorsCommission(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	blockTime := time.Unix(1_000_000, 0).UTC()
+	ctx = ctx.WithBlockHeader(cmtproto.Header{Time: blockTime})
+	store := ctx.KVStore(storeKey)
+
+	params := types.DefaultParams()
+	params.MinCommissionRate = math.LegacyNewDecWithPrec(5, 2)
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	pks := simtestutil.CreateTestPubKeys(3)
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(simtestutil.CreateIncrementalAccounts(3))
+	commissions := []types.Commission{
+		// below the minimum rate, with a max rate above it
+		types.NewCommission(math.LegacyNewDecWithPrec(1, 2), math.LegacyNewDecWithPrec(20, 2), math.LegacyNewDecWithPrec(1, 2)),
+		// below the minimum rate, with a max rate below it
+		types.NewCommission(math.LegacyZeroDec(), math.LegacyNewDecWithPrec(2, 2), math.LegacyNewDecWithPrec(1, 2)),
+		// above the minimum rate
+		types.NewCommission(math.LegacyNewDecWithPrec(10, 2), math.LegacyNewDecWithPrec(20, 2), math.LegacyNewDecWithPrec(1, 2)),
+	}
+	for i, commission := range commissions {
+		validator := stakingtestutil.NewValidator(t, valAddrs[i], pks[i])
+		validator.Commission = commission
+		store.Set(types.GetValidatorKey(valAddrs[i]), types.MustMarshalValidator(cdc, &validator))
+	}
+
+	require.NoError(t, v6.MigrateStore(ctx, storeKey, cdc))
+
+	getCommission := func(i int) types.Commission {
+		return types.MustUnmarshalValidator(cdc, store.Get(types.GetValidatorKey(valAddrs[i]))).Commission
+	}
+
+	commission := getCommission(0)
+	require.Equal(t, params.MinCommissionRate, commission.Rate)
+	require.Equal(t, math.LegacyNewDecWithPrec(20, 2), commission.MaxRate)
+	require.Equal(t, blockTime, commission.UpdateTime)
+
+	commission = getCommission(1)
+	require.Equal(t, params.MinCommissionRate, commission.Rate)
+	require.Equal(t, params.MinCommissionRate, commission.MaxRate)
+
+	require.Equal(t, commissions[2], getCommission(2))
+}
@@ -64,6 +64,41 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &paramsB)
 
 			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+		case bytes.Equal(kvA.Key[:1], types.LiquidStakingParamsKey):
+			var paramsA, paramsB types.LiquidStakingParams
+
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+		case bytes.Equal(kvA.Key[:1], types.TotalLiquidStakedTokensKey):
+			var tokensA, tokensB sdk.IntProto
+
+			cdc.MustUnmarshal(kvA.Value, &tokensA)
+			cdc.MustUnmarshal(kvB.Value, &tokensB)
+
+			return fmt.Sprintf("%v\n%v", tokensA, tokensB)
+		case bytes.Equal(kvA.Key[:1], types.ValidatorLiquidSharesKey):
+			var sharesA, sharesB types.ValidatorLiquidShares
+
+			cdc.MustUnmarshal(kvA.Value, &sharesA)
+			cdc.MustUnmarshal(kvB.Value, &sharesB)
+
+			return fmt.Sprintf("%v\n%v", sharesA, sharesB)
+		case bytes.Equal(kvA.Key[:1], types.ValidatorBondDelegationKey):
+			delAddrA, valAddrA := types.ParseValidatorBondDelegationKey(kvA.Key)
+			delAddrB, valAddrB := types.ParseValidatorBondDelegationKey(kvB.Key)
+
+			return fmt.Sprintf("%v %v\n%v %v", delAddrA, valAddrA, delAddrB, valAddrB)
+		case bytes.Equal(kvA.Key[:1], types.TokenizeShareRecordKey):
+			var recordA, recordB types.TokenizeShareRecord
+
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+		case bytes.Equal(kvA.Key[:1], types.LastTokenizeShareRecordIDKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
 		default:
 			panic(fmt.Sprintf("invalid staking key prefix %X", kvA.Key[:1]))
 		}
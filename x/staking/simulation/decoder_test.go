@@ -32,6 +32,9 @@ func TestDecodeStore(t *testing.T) {
 	del := types.NewDelegation(delAddr1, valAddr1, math.LegacyOneDec())
 	ubd := types.NewUnbondingDelegation(delAddr1, valAddr1, 15, bondTime, math.OneInt(), 1)
 	red := types.NewRedelegation(delAddr1, valAddr1, valAddr1, 12, bondTime, math.OneInt(), math.LegacyOneDec(), 0)
+	liquidParams := types.DefaultLiquidStakingParams()
+	liquidShares := types.ValidatorLiquidShares{ValidatorBondShares: math.LegacyOneDec(), LiquidShares: math.LegacyOneDec()}
+	record := types.NewTokenizeShareRecord(1, valAddr1, delAddr1)
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
@@ -41,6 +44,12 @@ func TestDecodeStore(t *testing.T) {
 			{Key: types.GetDelegationKey(delAddr1, valAddr1), Value: cdc.MustMarshal(&del)},
 			{Key: types.GetUBDKey(delAddr1, valAddr1), Value: cdc.MustMarshal(&ubd)},
 			{Key: types.GetREDKey(delAddr1, valAddr1, valAddr1), Value: cdc.MustMarshal(&red)},
+			{Key: types.LiquidStakingParamsKey, Value: cdc.MustMarshal(&liquidParams)},
+			{Key: types.TotalLiquidStakedTokensKey, Value: cdc.MustMarshal(&sdk.IntProto{Int: math.OneInt()})},
+			{Key: types.GetValidatorLiquidSharesKey(valAddr1), Value: cdc.MustMarshal(&liquidShares)},
+			{Key: types.GetValidatorBondDelegationKey(delAddr1, valAddr1), Value: []byte{}},
+			{Key: types.GetTokenizeShareRecordKey(1), Value: cdc.MustMarshal(&record)},
+			{Key: types.LastTokenizeShareRecordIDKey, Value: sdk.Uint64ToBigEndian(1)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -55,6 +64,12 @@ func TestDecodeStore(t *testing.T) {
 		{"Delegation", fmt.Sprintf("%v\n%v", del, del)},
 		{"UnbondingDelegation", fmt.Sprintf("%v\n%v", ubd, ubd)},
 		{"Redelegation", fmt.Sprintf("%v\n%v", red, red)},
+		{"LiquidStakingParams", fmt.Sprintf("%v\n%v", liquidParams, liquidParams)},
+		{"TotalLiquidStakedTokens", fmt.Sprintf("%v\n%v", math.OneInt(), math.OneInt())},
+		{"ValidatorLiquidShares", fmt.Sprintf("%v\n%v", liquidShares, liquidShares)},
+		{"ValidatorBondDelegation", fmt.Sprintf("%v %v\n%v %v", delAddr1, valAddr1, delAddr1, valAddr1)},
+		{"TokenizeShareRecord", fmt.Sprintf("%v\n%v", record, record)},
+		{"LastTokenizeShareRecordID", "1\n1"},
 		{"other", ""},
 	}
 	for i, tt := range tests {
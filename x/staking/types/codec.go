@@ -23,12 +23,17 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgBeginRedelegate{}, "cosmos-sdk/MsgBeginRedelegate")
 	legacy.RegisterAminoMsg(cdc, &MsgCancelUnbondingDelegation{}, "cosmos-sdk/MsgCancelUnbondingDelegation")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/staking/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgTokenizeShares{}, "cosmos-sdk/MsgTokenizeShares")
+	legacy.RegisterAminoMsg(cdc, &MsgRedeemTokensForShares{}, "cosmos-sdk/MsgRedeemTokensForShares")
+	legacy.RegisterAminoMsg(cdc, &MsgValidatorBond{}, "cosmos-sdk/MsgValidatorBond")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateLiquidStakingParams{}, "cosmos-sdk/MsgUpdateLiquidStakingParams")
 
 	cdc.RegisterInterface((*isStakeAuthorization_Validators)(nil), nil)
 	cdc.RegisterConcrete(&StakeAuthorization_AllowList{}, "cosmos-sdk/StakeAuthorization/AllowList", nil)
 	cdc.RegisterConcrete(&StakeAuthorization_DenyList{}, "cosmos-sdk/StakeAuthorization/DenyList", nil)
 	cdc.RegisterConcrete(&StakeAuthorization{}, "cosmos-sdk/StakeAuthorization", nil)
 	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/staking/Params", nil)
+	cdc.RegisterConcrete(LiquidStakingParams{}, "cosmos-sdk/x/staking/LiquidStakingParams", nil)
 }
 
 // RegisterInterfaces registers the x/staking interfaces types with the interface registry
@@ -41,6 +46,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgBeginRedelegate{},
 		&MsgCancelUnbondingDelegation{},
 		&MsgUpdateParams{},
+		&MsgTokenizeShares{},
+		&MsgRedeemTokensForShares{},
+		&MsgValidatorBond{},
+		&MsgUpdateLiquidStakingParams{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
@@ -10,6 +10,11 @@ const (
 	EventTypeUnbond                    = "unbond"
 	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
 	EventTypeRedelegate                = "redelegate"
+	EventTypeTokenizeShares            = "tokenize_shares"
+	EventTypeRedeemShares              = "redeem_shares"
+	EventTypeValidatorBond             = "validator_bond"
+	EventTypeSlashDelegator            = "slash_delegator"
+	EventTypeSlashDelegations          = "slash_delegations"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -20,4 +25,11 @@ const (
 	AttributeKeyCreationHeight    = "creation_height"
 	AttributeKeyCompletionTime    = "completion_time"
 	AttributeKeyNewShares         = "new_shares"
+	AttributeKeyShareOwner        = "share_owner"
+	AttributeKeyShareRecordID     = "share_record_id"
+	AttributeKeySlashSource       = "source"
+	AttributeKeyDelegatorShares   = "delegator_shares"
+
+	AttributeValueSlashSourceUnbondingDelegation = "unbonding_delegation"
+	AttributeValueSlashSourceRedelegation        = "redelegation"
 )
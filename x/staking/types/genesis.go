@@ -9,17 +9,23 @@ import (
 
 // NewGenesisState creates a new GenesisState instanc e
 func NewGenesisState(params Params, validators []Validator, delegations []Delegation) *GenesisState {
+	liquidStakingParams := DefaultLiquidStakingParams()
+
 	return &GenesisState{
-		Params:      params,
-		Validators:  validators,
-		Delegations: delegations,
+		Params:              params,
+		Validators:          validators,
+		Delegations:         delegations,
+		LiquidStakingParams: &liquidStakingParams,
 	}
 }
 
 // DefaultGenesisState gets the raw genesis raw message for testing
 func DefaultGenesisState() *GenesisState {
+	liquidStakingParams := DefaultLiquidStakingParams()
+
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:              DefaultParams(),
+		LiquidStakingParams: &liquidStakingParams,
 	}
 }
 
@@ -45,6 +45,33 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	Exported      bool           `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// liquid_staking_params defines the liquid staking parameters, the default
+	// ones if unset.
+	//
+	// Since: cosmos-sdk 0.50
+	LiquidStakingParams *LiquidStakingParams `protobuf:"bytes,9,opt,name=liquid_staking_params,json=liquidStakingParams,proto3" json:"liquid_staking_params,omitempty"`
+	// total_liquid_staked_tokens is the total amount of liquid staked tokens.
+	//
+	// Since: cosmos-sdk 0.50
+	TotalLiquidStakedTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=total_liquid_staked_tokens,json=totalLiquidStakedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_liquid_staked_tokens"`
+	// liquid_validators defines the validator bond and liquid shares of the
+	// validators.
+	//
+	// Since: cosmos-sdk 0.50
+	LiquidValidators []LiquidValidator `protobuf:"bytes,11,rep,name=liquid_validators,json=liquidValidators,proto3" json:"liquid_validators"`
+	// validator_bond_delegations defines the delegations designated as validator
+	// bonds.
+	//
+	// Since: cosmos-sdk 0.50
+	ValidatorBondDelegations []ValidatorBondDelegation `protobuf:"bytes,12,rep,name=validator_bond_delegations,json=validatorBondDelegations,proto3" json:"validator_bond_delegations"`
+	// tokenize_share_records defines the records backing the share tokens.
+	//
+	// Since: cosmos-sdk 0.50
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,13,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records"`
+	// last_tokenize_share_record_id is the id of the last tokenize share record.
+	//
+	// Since: cosmos-sdk 0.50
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,14,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -129,6 +156,41 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetLiquidStakingParams() *LiquidStakingParams {
+	if m != nil {
+		return m.LiquidStakingParams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLiquidValidators() []LiquidValidator {
+	if m != nil {
+		return m.LiquidValidators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorBondDelegations() []ValidatorBondDelegation {
+	if m != nil {
+		return m.ValidatorBondDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -180,39 +242,51 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x1c, 0xc5, 0x6d, 0x42, 0xd2, 0xf4, 0x52, 0x10, 0x1c, 0x29, 0x32, 0x19, 0x9c, 0x10, 0x55, 0x28,
-	0x2a, 0xd4, 0x56, 0xd3, 0x8d, 0xad, 0x11, 0x02, 0x21, 0x55, 0xa2, 0x72, 0x29, 0x03, 0x12, 0x8a,
-	0x2e, 0xf5, 0xc9, 0x3d, 0xc5, 0xb9, 0xb3, 0x7c, 0xff, 0x94, 0xf2, 0x0d, 0x18, 0x99, 0x99, 0x3a,
-	0x32, 0x32, 0xf0, 0x21, 0x3a, 0x56, 0x4c, 0x88, 0xa1, 0x42, 0xc9, 0x00, 0x1f, 0x03, 0xf9, 0xce,
-	0x31, 0x87, 0x52, 0x77, 0x49, 0x7c, 0xfe, 0xbf, 0xf7, 0x7b, 0x4f, 0xf2, 0xff, 0xd0, 0xc6, 0x91,
-	0x90, 0x13, 0x21, 0x7d, 0x09, 0x64, 0xcc, 0x78, 0xe4, 0x9f, 0x6c, 0x8f, 0x28, 0x90, 0x6d, 0x3f,
-	0xa2, 0x9c, 0x4a, 0x26, 0xbd, 0x24, 0x15, 0x20, 0xf0, 0x7d, 0xad, 0xf2, 0x72, 0x95, 0x97, 0xab,
-	0x5a, 0xcd, 0x48, 0x44, 0x42, 0x49, 0xfc, 0xec, 0x49, 0xab, 0x5b, 0x65, 0xcc, 0x85, 0x5b, 0xab,
-	0x1e, 0x68, 0xd5, 0x50, 0xdb, 0xf3, 0x00, 0x3d, 0xba, 0x4b, 0x26, 0x8c, 0x0b, 0x5f, 0xfd, 0xea,
-	0x57, 0xdd, 0xcf, 0x55, 0xb4, 0xf6, 0x42, 0x77, 0x3a, 0x00, 0x02, 0x14, 0xef, 0xa2, 0x5a, 0x42,
-	0x52, 0x32, 0x91, 0x8e, 0xdd, 0xb1, 0x7b, 0x8d, 0xbe, 0xeb, 0x5d, 0xdd, 0xd1, 0xdb, 0x57, 0xaa,
-	0xc1, 0xea, 0xf9, 0x65, 0xdb, 0xfa, 0xf2, 0xfb, 0xeb, 0xa6, 0x1d, 0xe4, 0x46, 0xfc, 0x0e, 0xdd,
-	0x89, 0x89, 0x84, 0x21, 0x08, 0x20, 0xf1, 0x30, 0x11, 0xef, 0x69, 0xea, 0xdc, 0xe8, 0xd8, 0xbd,
-	0xb5, 0xc1, 0x4e, 0x26, 0xfe, 0x79, 0xd9, 0x7e, 0x14, 0x31, 0x38, 0x9e, 0x8e, 0xbc, 0x23, 0x31,
-	0xc9, 0x1b, 0xe6, 0x7f, 0x5b, 0x32, 0x1c, 0xfb, 0xf0, 0x21, 0xa1, 0xd2, 0x7b, 0xc9, 0x41, 0x63,
-	0x6f, 0x67, 0xb0, 0xd7, 0x19, 0x6b, 0x3f, 0x43, 0x61, 0x86, 0xd6, 0x15, 0xfe, 0x84, 0xc4, 0x2c,
-	0x24, 0x20, 0x52, 0x1d, 0x21, 0x9d, 0x4a, 0xa7, 0xd2, 0x6b, 0xf4, 0x37, 0xcb, 0x0a, 0xef, 0x11,
-	0x09, 0x6f, 0x16, 0x1e, 0x85, 0x32, 0xcb, 0xdf, 0x8b, 0x97, 0xc6, 0x12, 0xef, 0x21, 0x54, 0xa4,
-	0x48, 0xe7, 0xa6, 0xe2, 0x3f, 0x2c, 0xe3, 0x17, 0x66, 0x13, 0x6b, 0xf8, 0xf1, 0x2b, 0xd4, 0x08,
-	0x69, 0x4c, 0x23, 0x02, 0x4c, 0x70, 0xe9, 0x54, 0x15, 0xae, 0x5b, 0x86, 0x7b, 0x56, 0x48, 0x4d,
-	0x9e, 0x49, 0xc0, 0x63, 0xb4, 0x3e, 0xe5, 0x23, 0xc1, 0x43, 0xc6, 0xa3, 0xa1, 0x89, 0xae, 0x29,
-	0xf4, 0xe3, 0x32, 0xf4, 0xe1, 0xc2, 0x74, 0x75, 0x46, 0x73, 0xba, 0x3c, 0x97, 0xf8, 0x10, 0xdd,
-	0x4a, 0xa9, 0x19, 0xb2, 0xa2, 0x42, 0x36, 0xca, 0x42, 0x02, 0x43, 0x6c, 0xd2, 0xff, 0xa7, 0xe0,
-	0x16, 0xaa, 0xd3, 0xd3, 0x44, 0xa4, 0x40, 0x43, 0xa7, 0xde, 0xb1, 0x7b, 0xf5, 0xa0, 0x38, 0x77,
-	0x8f, 0x11, 0x5e, 0xfe, 0x68, 0xb8, 0x8f, 0x56, 0x48, 0x18, 0xa6, 0x54, 0xea, 0x15, 0x5d, 0x1d,
-	0x38, 0xdf, 0xbf, 0x6d, 0x35, 0xf3, 0x16, 0xbb, 0x7a, 0x72, 0x00, 0x29, 0xe3, 0x51, 0xb0, 0x10,
-	0xe2, 0x26, 0xaa, 0xfe, 0xdb, 0xc3, 0x4a, 0xa0, 0x0f, 0x4f, 0xeb, 0x1f, 0xcf, 0xda, 0xd6, 0x9f,
-	0xb3, 0xb6, 0x35, 0x78, 0x7e, 0x3e, 0x73, 0xed, 0x8b, 0x99, 0x6b, 0xff, 0x9a, 0xb9, 0xf6, 0xa7,
-	0xb9, 0x6b, 0x5d, 0xcc, 0x5d, 0xeb, 0xc7, 0xdc, 0xb5, 0xde, 0x3e, 0xb9, 0x76, 0x55, 0x4f, 0x8b,
-	0xcb, 0xa8, 0x96, 0x76, 0x54, 0x53, 0xb7, 0x6a, 0xe7, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe5,
-	0x05, 0x1a, 0xb6, 0xff, 0x03, 0x00, 0x00,
+	// 695 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x4e, 0xdb, 0x4a,
+	0x14, 0xc6, 0xe3, 0xcb, 0xbf, 0x64, 0x02, 0x08, 0x86, 0xc0, 0x35, 0x91, 0xae, 0xe3, 0x4b, 0x51,
+	0x1b, 0xd1, 0x62, 0x0b, 0xd8, 0x55, 0x5d, 0x94, 0xa8, 0x6a, 0x85, 0x84, 0x54, 0xe4, 0x40, 0x17,
+	0x48, 0x95, 0x35, 0x61, 0x46, 0x66, 0x14, 0xc7, 0x93, 0x7a, 0x26, 0x14, 0xfa, 0x04, 0x5d, 0xf6,
+	0x11, 0x58, 0x76, 0xd9, 0x05, 0x0f, 0xc1, 0x12, 0xb1, 0xaa, 0xba, 0x40, 0x15, 0x2c, 0xda, 0x75,
+	0x9f, 0xa0, 0xf2, 0xcc, 0x24, 0x31, 0x4a, 0x9c, 0xaa, 0x1b, 0x88, 0xe7, 0x7c, 0xe7, 0xf7, 0x9d,
+	0x71, 0xbe, 0x1c, 0xb0, 0x7a, 0xc4, 0x78, 0x8b, 0x71, 0x97, 0x0b, 0xd4, 0xa4, 0x51, 0xe0, 0x9e,
+	0x6c, 0x34, 0x88, 0x40, 0x1b, 0x6e, 0x40, 0x22, 0xc2, 0x29, 0x77, 0xda, 0x31, 0x13, 0x0c, 0x2e,
+	0x29, 0x95, 0xa3, 0x55, 0x8e, 0x56, 0x95, 0x4b, 0x01, 0x0b, 0x98, 0x94, 0xb8, 0xc9, 0x27, 0xa5,
+	0x2e, 0x67, 0x31, 0xbb, 0xdd, 0x4a, 0xf5, 0x20, 0x43, 0x15, 0xd2, 0x77, 0x1d, 0x8a, 0xb5, 0x68,
+	0x59, 0x89, 0x7c, 0xe5, 0xa1, 0xa7, 0x50, 0xa5, 0x79, 0xd4, 0xa2, 0x11, 0x73, 0xe5, 0x5f, 0x75,
+	0xb4, 0xf2, 0xab, 0x00, 0xa6, 0x5f, 0xa9, 0xc1, 0xeb, 0x02, 0x09, 0x02, 0xb7, 0xc1, 0x64, 0x1b,
+	0xc5, 0xa8, 0xc5, 0x4d, 0xc3, 0x36, 0xaa, 0xc5, 0x4d, 0xcb, 0x19, 0x7e, 0x11, 0x67, 0x4f, 0xaa,
+	0x6a, 0x85, 0xcb, 0x9b, 0x4a, 0xee, 0xf3, 0x8f, 0x2f, 0x6b, 0x86, 0xa7, 0x1b, 0xe1, 0x5b, 0x30,
+	0x17, 0x22, 0x2e, 0x7c, 0xc1, 0x04, 0x0a, 0xfd, 0x36, 0x7b, 0x4f, 0x62, 0xf3, 0x1f, 0xdb, 0xa8,
+	0x4e, 0xd7, 0xb6, 0x12, 0xf1, 0xb7, 0x9b, 0xca, 0xc3, 0x80, 0x8a, 0xe3, 0x4e, 0xc3, 0x39, 0x62,
+	0x2d, 0x3d, 0xa1, 0xfe, 0xb7, 0xce, 0x71, 0xd3, 0x15, 0x67, 0x6d, 0xc2, 0x9d, 0x9d, 0x48, 0x28,
+	0xec, 0x6c, 0x02, 0xdb, 0x4f, 0x58, 0x7b, 0x09, 0x0a, 0x52, 0xb0, 0x28, 0xf1, 0x27, 0x28, 0xa4,
+	0x18, 0x09, 0x16, 0x2b, 0x0b, 0x6e, 0x8e, 0xd9, 0x63, 0xd5, 0xe2, 0xe6, 0x5a, 0xd6, 0xc0, 0xbb,
+	0x88, 0x8b, 0x37, 0xdd, 0x1e, 0x89, 0x4a, 0x0f, 0xbf, 0x10, 0x0e, 0x94, 0x39, 0xdc, 0x05, 0xa0,
+	0xe7, 0xc2, 0xcd, 0x71, 0xc9, 0xff, 0x3f, 0x8b, 0xdf, 0x6b, 0x4e, 0x63, 0x53, 0xfd, 0xf0, 0x35,
+	0x28, 0x62, 0x12, 0x92, 0x00, 0x09, 0xca, 0x22, 0x6e, 0x4e, 0x48, 0xdc, 0x4a, 0x16, 0xee, 0x45,
+	0x4f, 0x9a, 0xe6, 0xa5, 0x09, 0xb0, 0x09, 0x16, 0x3b, 0x51, 0x83, 0x45, 0x98, 0x46, 0x81, 0x9f,
+	0x46, 0x4f, 0x4a, 0xf4, 0xe3, 0x2c, 0xf4, 0x41, 0xb7, 0x69, 0xb8, 0x47, 0xa9, 0x33, 0x58, 0xe7,
+	0xf0, 0x00, 0xcc, 0xc4, 0x24, 0x6d, 0x32, 0x25, 0x4d, 0x56, 0xb3, 0x4c, 0x3c, 0x82, 0x87, 0xd2,
+	0xef, 0x53, 0x60, 0x19, 0xe4, 0xc9, 0x69, 0x9b, 0xc5, 0x82, 0x60, 0x33, 0x6f, 0x1b, 0xd5, 0xbc,
+	0xd7, 0x7b, 0x86, 0x3e, 0x58, 0x54, 0xd1, 0xf6, 0x35, 0xdc, 0xd7, 0xd1, 0x2c, 0xd8, 0xc6, 0xa8,
+	0xfb, 0xed, 0xca, 0xa6, 0xba, 0x3a, 0x55, 0x39, 0xf5, 0x16, 0xc2, 0xc1, 0x43, 0x78, 0x06, 0xca,
+	0x2a, 0xa4, 0x29, 0x1b, 0x82, 0x7d, 0xc1, 0x9a, 0x24, 0xe2, 0x26, 0xb0, 0x8d, 0x6a, 0xa1, 0xf6,
+	0xec, 0xef, 0x32, 0x7b, 0x7d, 0xb1, 0x0e, 0xf4, 0x58, 0x3b, 0x91, 0xf0, 0xfe, 0x95, 0xfc, 0xfe,
+	0x40, 0x04, 0xef, 0x4b, 0x38, 0x3c, 0x04, 0xf3, 0xda, 0x34, 0x95, 0xb0, 0xa2, 0x7c, 0xa5, 0x8f,
+	0x46, 0xdf, 0xab, 0x9f, 0xb3, 0xf1, 0x64, 0x34, 0x6f, 0x2e, 0xbc, 0x7f, 0xcc, 0x21, 0x07, 0xe5,
+	0xfe, 0x8f, 0x23, 0xf9, 0x2a, 0xef, 0x85, 0x63, 0x5a, 0x9a, 0xb8, 0x7f, 0x8e, 0x31, 0x8b, 0x70,
+	0x2a, 0x20, 0xca, 0xcc, 0x3c, 0x19, 0x5e, 0xe6, 0x30, 0x00, 0x4b, 0xf2, 0xbd, 0xd1, 0x0f, 0xc4,
+	0xe7, 0xc7, 0x28, 0x26, 0x7e, 0x4c, 0x8e, 0x58, 0x8c, 0xb9, 0x39, 0x33, 0x3a, 0x8d, 0xfb, 0xba,
+	0xab, 0x9e, 0x34, 0x79, 0xb2, 0x47, 0x9b, 0x95, 0xc4, 0x60, 0x89, 0xc3, 0xe7, 0xe0, 0x3f, 0xbd,
+	0x5e, 0x86, 0xb8, 0xf9, 0x14, 0x9b, 0xb3, 0xb6, 0x51, 0x1d, 0xf7, 0x96, 0xd5, 0xda, 0x18, 0x00,
+	0xec, 0xe0, 0x95, 0x63, 0x00, 0x07, 0x97, 0x01, 0xdc, 0x04, 0x53, 0x08, 0xe3, 0x98, 0x70, 0xb5,
+	0xfa, 0x0a, 0x35, 0xf3, 0xfa, 0x62, 0xbd, 0xa4, 0x87, 0xde, 0x56, 0x95, 0xba, 0x88, 0x69, 0x14,
+	0x78, 0x5d, 0x21, 0x2c, 0x81, 0x89, 0xfe, 0x7e, 0x1b, 0xf3, 0xd4, 0xc3, 0xd3, 0xfc, 0xc7, 0xf3,
+	0x4a, 0xee, 0xe7, 0x79, 0x25, 0x57, 0x7b, 0x79, 0x79, 0x6b, 0x19, 0x57, 0xb7, 0x96, 0xf1, 0xfd,
+	0xd6, 0x32, 0x3e, 0xdd, 0x59, 0xb9, 0xab, 0x3b, 0x2b, 0xf7, 0xf5, 0xce, 0xca, 0x1d, 0x3e, 0x19,
+	0x19, 0xa7, 0xd3, 0xde, 0x8e, 0x97, 0xc1, 0x6a, 0x4c, 0xca, 0x6d, 0xbd, 0xf5, 0x7b, 0x00, 0x84,
+	0x17, 0x94, 0x44, 0x7c, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -235,6 +309,75 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ValidatorBondDelegations) > 0 {
+		for iNdEx := len(m.ValidatorBondDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorBondDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.LiquidValidators) > 0 {
+		for iNdEx := len(m.LiquidValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	{
+		size := m.TotalLiquidStakedTokens.Size()
+		i -= size
+		if _, err := m.TotalLiquidStakedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.LiquidStakingParams != nil {
+		{
+			size, err := m.LiquidStakingParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -427,6 +570,33 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if m.LiquidStakingParams != nil {
+		l = m.LiquidStakingParams.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.TotalLiquidStakedTokens.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.LiquidValidators) > 0 {
+		for _, e := range m.LiquidValidators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorBondDelegations) > 0 {
+		for _, e := range m.ValidatorBondDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
+	}
 	return n
 }
 
@@ -737,6 +907,197 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidStakingParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LiquidStakingParams == nil {
+				m.LiquidStakingParams = &LiquidStakingParams{}
+			}
+			if err := m.LiquidStakingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidStakedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalLiquidStakedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidValidators = append(m.LiquidValidators, LiquidValidator{})
+			if err := m.LiquidValidators[len(m.LiquidValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorBondDelegations = append(m.ValidatorBondDelegations, ValidatorBondDelegation{})
+			if err := m.ValidatorBondDelegations[len(m.ValidatorBondDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+			}
+			m.LastTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -112,3 +112,12 @@ func (h MultiStakingHooks) AfterUnbondingInitiated(ctx sdk.Context, id uint64) e
 	}
 	return nil
 }
+
+func (h MultiStakingHooks) AfterUnbondingCompleted(ctx sdk.Context, id uint64) error {
+	for i := range h {
+		if err := h[i].AfterUnbondingCompleted(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -54,6 +54,13 @@ var (
 	ValidatorUpdatesKey = []byte{0x61} // prefix for the end block validator updates key
 
 	ParamsKey = []byte{0x51} // prefix for parameters for module x/staking
+
+	LiquidStakingParamsKey       = []byte{0x81} // key for the liquid staking parameters
+	TotalLiquidStakedTokensKey   = []byte{0x82} // key for the total liquid staked tokens
+	ValidatorLiquidSharesKey     = []byte{0x83} // prefix for the validator bond and liquid shares of each validator
+	ValidatorBondDelegationKey   = []byte{0x84} // prefix for the delegations designated as validator bond
+	TokenizeShareRecordKey       = []byte{0x85} // prefix for the tokenize share records
+	LastTokenizeShareRecordIDKey = []byte{0x86} // key for the id of the last tokenize share record
 )
 
 // UnbondingType defines the type of unbonding operation
@@ -421,3 +428,33 @@ func GetHistoricalInfoKey(height int64) []byte {
 	binary.BigEndian.PutUint64(heightBytes, uint64(height))
 	return append(HistoricalInfoKey, heightBytes...)
 }
+
+// GetValidatorLiquidSharesKey returns the key of the validator bond and liquid
+// shares of a validator.
+func GetValidatorLiquidSharesKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorLiquidSharesKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetValidatorBondDelegationKey returns the key marking a delegation as a
+// validator bond: ValidatorBondDelegationKey | lenValAddr | valAddr | lenDelAddr | delAddr.
+func GetValidatorBondDelegationKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(append(ValidatorBondDelegationKey, address.MustLengthPrefix(valAddr)...), address.MustLengthPrefix(delAddr)...)
+}
+
+// ParseValidatorBondDelegationKey returns the delegator and validator addresses
+// of a key marking a delegation as a validator bond.
+func ParseValidatorBondDelegationKey(key []byte) (sdk.AccAddress, sdk.ValAddress) {
+	kv.AssertKeyAtLeastLength(key, 2)
+	valAddrLen := int(key[1])
+	kv.AssertKeyAtLeastLength(key, 3+valAddrLen)
+	valAddr := sdk.ValAddress(key[2 : 2+valAddrLen])
+	delAddr := sdk.AccAddress(key[3+valAddrLen:])
+	kv.AssertKeyLength(delAddr, int(key[2+valAddrLen]))
+
+	return delAddr, valAddr
+}
+
+// GetTokenizeShareRecordKey returns the key of a tokenize share record.
+func GetTokenizeShareRecordKey(id uint64) []byte {
+	return append(TokenizeShareRecordKey, sdk.Uint64ToBigEndian(id)...)
+}
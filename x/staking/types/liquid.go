@@ -0,0 +1,133 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// tokenizeShareRecordModulePrefix is the prefix of the names the addresses of
+// the tokenize share records are derived from.
+const tokenizeShareRecordModulePrefix = "tokenizeshare_"
+
+var (
+	// DefaultGlobalLiquidStakingCap allows all the bonded tokens to be liquid staked.
+	DefaultGlobalLiquidStakingCap = math.LegacyOneDec()
+	// DefaultValidatorLiquidStakingCap allows all the shares of a validator to be liquid staked.
+	DefaultValidatorLiquidStakingCap = math.LegacyOneDec()
+	// DefaultValidatorBondFactor disables the validator bond requirement.
+	DefaultValidatorBondFactor = math.LegacyNewDec(-1)
+)
+
+// NewLiquidStakingParams creates a new LiquidStakingParams instance.
+func NewLiquidStakingParams(globalCap, validatorCap, validatorBondFactor sdk.Dec) LiquidStakingParams {
+	return LiquidStakingParams{
+		GlobalLiquidStakingCap:    globalCap,
+		ValidatorLiquidStakingCap: validatorCap,
+		ValidatorBondFactor:       validatorBondFactor,
+	}
+}
+
+// DefaultLiquidStakingParams returns the default liquid staking parameters,
+// which don't restrict the liquid staking.
+func DefaultLiquidStakingParams() LiquidStakingParams {
+	return NewLiquidStakingParams(
+		DefaultGlobalLiquidStakingCap,
+		DefaultValidatorLiquidStakingCap,
+		DefaultValidatorBondFactor,
+	)
+}
+
+// Validate validates the liquid staking parameters.
+func (p LiquidStakingParams) Validate() error {
+	if err := validateLiquidStakingCap(p.GlobalLiquidStakingCap); err != nil {
+		return fmt.Errorf("global liquid staking cap: %w", err)
+	}
+
+	if err := validateLiquidStakingCap(p.ValidatorLiquidStakingCap); err != nil {
+		return fmt.Errorf("validator liquid staking cap: %w", err)
+	}
+
+	if p.ValidatorBondFactor.IsNil() {
+		return fmt.Errorf("validator bond factor cannot be nil")
+	}
+
+	if p.ValidatorBondFactor.IsNegative() && !p.ValidatorBondFactor.Equal(DefaultValidatorBondFactor) {
+		return fmt.Errorf("validator bond factor must be positive or -1: %s", p.ValidatorBondFactor)
+	}
+
+	return nil
+}
+
+// ValidatorBondEnabled returns whether the liquid shares of the validators are
+// capped by their validator bond shares.
+func (p LiquidStakingParams) ValidatorBondEnabled() bool {
+	return !p.ValidatorBondFactor.IsNegative()
+}
+
+func validateLiquidStakingCap(v sdk.Dec) error {
+	if v.IsNil() {
+		return fmt.Errorf("cap cannot be nil")
+	}
+
+	if v.IsNegative() || v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("cap must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
+
+// NewValidatorLiquidShares returns ValidatorLiquidShares without any validator
+// bond and liquid shares.
+func NewValidatorLiquidShares() ValidatorLiquidShares {
+	return ValidatorLiquidShares{
+		ValidatorBondShares: math.LegacyZeroDec(),
+		LiquidShares:        math.LegacyZeroDec(),
+	}
+}
+
+// NewTokenizeShareRecord creates a new TokenizeShareRecord instance, holding
+// its delegation with an address derived from its id, and paying its rewards to
+// the owner.
+func NewTokenizeShareRecord(id uint64, valAddr sdk.ValAddress, owner sdk.AccAddress) TokenizeShareRecord {
+	record := TokenizeShareRecord{
+		Id:        id,
+		Validator: valAddr.String(),
+		Owner:     owner.String(),
+	}
+	record.ModuleAccount = record.GetModuleAddress().String()
+
+	return record
+}
+
+// GetModuleAddress returns the address of the account holding the delegation
+// of the record.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(fmt.Sprintf("%s%d", tokenizeShareRecordModulePrefix, r.Id))
+}
+
+// GetShareTokenDenom returns the denom of the share tokens of the record.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return fmt.Sprintf("%s/%d", strings.ToLower(r.Validator), r.Id)
+}
+
+// ParseShareTokenDenom returns the id of the tokenize share record of the share
+// tokens with the given denom.
+func ParseShareTokenDenom(denom string) (uint64, error) {
+	valAddr, rawID, ok := strings.Cut(denom, "/")
+	if !ok || valAddr == "" {
+		return 0, ErrInvalidShareTokenDenom.Wrap(denom)
+	}
+
+	id, err := strconv.ParseUint(rawID, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidShareTokenDenom.Wrapf("%s: %s", denom, err)
+	}
+
+	return id, nil
+}
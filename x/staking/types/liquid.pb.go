@@ -0,0 +1,1440 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/staking/v1beta1/liquid.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LiquidStakingParams defines the parameters of the liquid staking.
+//
+// Since: cosmos-sdk 0.50
+type LiquidStakingParams struct {
+	// global_liquid_staking_cap is the maximum fraction of the bonded tokens
+	// which can be liquid staked.
+	GlobalLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum fraction of the delegator
+	// shares of a validator which can be liquid staked.
+	ValidatorLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_liquid_staking_cap"`
+	// validator_bond_factor is the maximum ratio of the liquid shares of a
+	// validator to its validator bond shares. A negative value disables the
+	// validator bond requirement.
+	ValidatorBondFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=validator_bond_factor,json=validatorBondFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_bond_factor"`
+}
+
+func (m *LiquidStakingParams) Reset()         { *m = LiquidStakingParams{} }
+func (m *LiquidStakingParams) String() string { return proto.CompactTextString(m) }
+func (*LiquidStakingParams) ProtoMessage()    {}
+func (*LiquidStakingParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a189e2cd65a50765, []int{0}
+}
+func (m *LiquidStakingParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidStakingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidStakingParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidStakingParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidStakingParams.Merge(m, src)
+}
+func (m *LiquidStakingParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidStakingParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidStakingParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidStakingParams proto.InternalMessageInfo
+
+// ValidatorLiquidShares defines the validator bond and liquid shares of a
+// validator.
+//
+// Since: cosmos-sdk 0.50
+type ValidatorLiquidShares struct {
+	// validator_bond_shares is the sum of the shares of the delegations
+	// designated as validator bond.
+	ValidatorBondShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=validator_bond_shares,json=validatorBondShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_bond_shares"`
+	// liquid_shares is the sum of the tokenized shares of the validator.
+	LiquidShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=liquid_shares,json=liquidShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquid_shares"`
+}
+
+func (m *ValidatorLiquidShares) Reset()         { *m = ValidatorLiquidShares{} }
+func (m *ValidatorLiquidShares) String() string { return proto.CompactTextString(m) }
+func (*ValidatorLiquidShares) ProtoMessage()    {}
+func (*ValidatorLiquidShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a189e2cd65a50765, []int{1}
+}
+func (m *ValidatorLiquidShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorLiquidShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorLiquidShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorLiquidShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorLiquidShares.Merge(m, src)
+}
+func (m *ValidatorLiquidShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorLiquidShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorLiquidShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorLiquidShares proto.InternalMessageInfo
+
+// TokenizeShareRecord defines the delegation backing the share tokens of a
+// tokenization.
+//
+// Since: cosmos-sdk 0.50
+type TokenizeShareRecord struct {
+	// id is the unique id of the record, part of the share tokens denom.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// module_account is the address of the account holding the delegation.
+	ModuleAccount string `protobuf:"bytes,2,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
+	// validator is the address of the validator of the delegation.
+	Validator string `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
+	// owner is the address receiving the rewards of the delegation, the owner
+	// of the share tokens when they were minted.
+	Owner string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a189e2cd65a50765, []int{2}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// LiquidValidator defines the validator bond and liquid shares of a validator
+// in the genesis state.
+//
+// Since: cosmos-sdk 0.50
+type LiquidValidator struct {
+	// operator_address is the address of the validator.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// liquid_shares are the validator bond and liquid shares of the validator.
+	LiquidShares ValidatorLiquidShares `protobuf:"bytes,2,opt,name=liquid_shares,json=liquidShares,proto3" json:"liquid_shares"`
+}
+
+func (m *LiquidValidator) Reset()         { *m = LiquidValidator{} }
+func (m *LiquidValidator) String() string { return proto.CompactTextString(m) }
+func (*LiquidValidator) ProtoMessage()    {}
+func (*LiquidValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a189e2cd65a50765, []int{3}
+}
+func (m *LiquidValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidValidator.Merge(m, src)
+}
+func (m *LiquidValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidValidator proto.InternalMessageInfo
+
+func (m *LiquidValidator) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *LiquidValidator) GetLiquidShares() ValidatorLiquidShares {
+	if m != nil {
+		return m.LiquidShares
+	}
+	return ValidatorLiquidShares{}
+}
+
+// ValidatorBondDelegation defines a delegation designated as a validator bond
+// in the genesis state.
+//
+// Since: cosmos-sdk 0.50
+type ValidatorBondDelegation struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the address of the validator of the delegation.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *ValidatorBondDelegation) Reset()         { *m = ValidatorBondDelegation{} }
+func (m *ValidatorBondDelegation) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBondDelegation) ProtoMessage()    {}
+func (*ValidatorBondDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a189e2cd65a50765, []int{4}
+}
+func (m *ValidatorBondDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorBondDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorBondDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorBondDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBondDelegation.Merge(m, src)
+}
+func (m *ValidatorBondDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorBondDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBondDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorBondDelegation proto.InternalMessageInfo
+
+func (m *ValidatorBondDelegation) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorBondDelegation) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*LiquidStakingParams)(nil), "cosmos.staking.v1beta1.LiquidStakingParams")
+	proto.RegisterType((*ValidatorLiquidShares)(nil), "cosmos.staking.v1beta1.ValidatorLiquidShares")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
+	proto.RegisterType((*LiquidValidator)(nil), "cosmos.staking.v1beta1.LiquidValidator")
+	proto.RegisterType((*ValidatorBondDelegation)(nil), "cosmos.staking.v1beta1.ValidatorBondDelegation")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/staking/v1beta1/liquid.proto", fileDescriptor_a189e2cd65a50765)
+}
+
+var fileDescriptor_a189e2cd65a50765 = []byte{
+	// 586 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0xa5, 0x05, 0xa9, 0x07, 0xfd, 0xe5, 0xb4, 0xc5, 0xa9, 0xc0, 0x85, 0x20, 0xa1, 0xaa,
+	0xc2, 0xb6, 0x0a, 0x1b, 0x4b, 0x95, 0x10, 0x3a, 0x55, 0x08, 0xa5, 0xa8, 0x03, 0x12, 0xb2, 0xce,
+	0xbe, 0xab, 0x7b, 0x8a, 0xe3, 0x17, 0x7c, 0x4e, 0xf8, 0x21, 0x26, 0x46, 0x26, 0xfe, 0x0c, 0xc6,
+	0x0c, 0x99, 0x3b, 0x77, 0xac, 0xb2, 0x80, 0x18, 0x2a, 0x94, 0x0c, 0xfd, 0x0f, 0x98, 0x51, 0x7d,
+	0x17, 0x27, 0x6d, 0x0d, 0x74, 0x08, 0x4b, 0x62, 0xbf, 0xf7, 0xbd, 0xf7, 0x7d, 0xef, 0xbe, 0xe7,
+	0xc3, 0xf7, 0x3d, 0x10, 0x0d, 0x10, 0xb6, 0x88, 0x49, 0x9d, 0x87, 0xbe, 0xdd, 0xde, 0x74, 0x59,
+	0x4c, 0x36, 0xed, 0x80, 0xbf, 0x69, 0x71, 0x6a, 0x35, 0x23, 0x88, 0x41, 0x5b, 0x91, 0x20, 0x4b,
+	0x81, 0x2c, 0x05, 0x5a, 0x5d, 0xf2, 0xc1, 0x87, 0x04, 0x62, 0x9f, 0x3d, 0x49, 0xf4, 0x6a, 0x51,
+	0xa2, 0x1d, 0x99, 0x50, 0xa5, 0x32, 0x65, 0x28, 0x36, 0x97, 0x08, 0x96, 0x52, 0x79, 0xc0, 0x43,
+	0x95, 0x5f, 0x24, 0x0d, 0x1e, 0x82, 0x9d, 0xfc, 0xca, 0x50, 0xe9, 0x70, 0x0a, 0x17, 0x76, 0x12,
+	0x31, 0xbb, 0x92, 0xfd, 0x05, 0x89, 0x48, 0x43, 0x68, 0x1f, 0x71, 0xd1, 0x0f, 0xc0, 0x25, 0x81,
+	0x23, 0xa5, 0x3a, 0x4a, 0x9c, 0xe3, 0x91, 0xa6, 0x8e, 0xee, 0xa2, 0xf5, 0x99, 0x4a, 0xf9, 0xe8,
+	0x64, 0x2d, 0xf7, 0xe3, 0x64, 0xed, 0x81, 0xcf, 0xe3, 0x83, 0x96, 0x6b, 0x79, 0xd0, 0x50, 0x72,
+	0xd4, 0x9f, 0x29, 0x68, 0xdd, 0x8e, 0xdf, 0x37, 0x99, 0xb0, 0xaa, 0xcc, 0xeb, 0x75, 0x4d, 0xac,
+	0xd4, 0x56, 0x99, 0xf7, 0xf5, 0xb4, 0xb3, 0x81, 0x6a, 0x2b, 0x92, 0xe3, 0x9c, 0x80, 0xa7, 0xa4,
+	0xa9, 0x7d, 0x42, 0xf8, 0x76, 0x9b, 0x04, 0x9c, 0x92, 0x18, 0xa2, 0x2c, 0x05, 0xf9, 0x49, 0x29,
+	0x28, 0xa6, 0x34, 0x97, 0x44, 0xb4, 0xf0, 0xf2, 0x48, 0x83, 0x0b, 0x21, 0x75, 0xf6, 0x89, 0x17,
+	0x43, 0xa4, 0x4f, 0x4d, 0x8a, 0xbc, 0x90, 0xf6, 0xaf, 0x40, 0x48, 0xb7, 0x93, 0xee, 0x4f, 0xcc,
+	0xcf, 0xa7, 0x9d, 0x8d, 0xf5, 0xb1, 0x16, 0xef, 0xd2, 0xed, 0xc9, 0x30, 0xaa, 0xf4, 0x0b, 0xe1,
+	0xe5, 0xbd, 0x0b, 0x33, 0x1c, 0x90, 0x88, 0x89, 0x0c, 0xfd, 0x22, 0x49, 0xe8, 0xe8, 0xff, 0xe8,
+	0x57, 0xb4, 0xfb, 0x78, 0x76, 0x68, 0x98, 0xa4, 0x9b, 0x98, 0x57, 0x37, 0x83, 0xb1, 0xf1, 0x4a,
+	0xdf, 0x10, 0x2e, 0xbc, 0x84, 0x3a, 0x0b, 0xf9, 0x07, 0x96, 0x84, 0x6a, 0xcc, 0x83, 0x88, 0x6a,
+	0x73, 0x38, 0xcf, 0x69, 0x32, 0xe3, 0x74, 0x2d, 0xcf, 0xa9, 0xb6, 0x85, 0xe7, 0x1a, 0x40, 0x5b,
+	0x01, 0x73, 0x88, 0xe7, 0x41, 0x2b, 0x8c, 0x95, 0x20, 0xbd, 0xd7, 0x35, 0x97, 0x14, 0x45, 0x99,
+	0xd2, 0x88, 0x09, 0xb1, 0x1b, 0x47, 0x3c, 0xf4, 0x6b, 0xb3, 0x12, 0x5f, 0x96, 0x70, 0x6d, 0x0b,
+	0xcf, 0xa4, 0x73, 0x2a, 0xef, 0xef, 0xf5, 0xba, 0xe6, 0x1d, 0x55, 0x9b, 0x1e, 0xfe, 0xf9, 0x26,
+	0xa3, 0x1a, 0xcd, 0xc2, 0xd7, 0xe0, 0x6d, 0xc8, 0x22, 0x7d, 0xfa, 0x1f, 0xc4, 0x12, 0x56, 0x3a,
+	0x44, 0x78, 0x5e, 0x3a, 0x99, 0xf6, 0xd6, 0x76, 0xf0, 0x02, 0x34, 0x59, 0x94, 0x78, 0x49, 0x64,
+	0x91, 0x8e, 0xae, 0xaa, 0x65, 0x7e, 0x58, 0xaa, 0xc2, 0xda, 0xeb, 0x2c, 0x8f, 0x6e, 0x3c, 0x32,
+	0xad, 0xec, 0x9b, 0xc8, 0xca, 0x5c, 0xb0, 0xca, 0xcc, 0x99, 0xa5, 0x59, 0xd6, 0x74, 0x10, 0xbe,
+	0xb5, 0x37, 0xbe, 0x1a, 0x55, 0x16, 0x30, 0x9f, 0xc4, 0x1c, 0x42, 0xed, 0x19, 0x5e, 0xa4, 0xf2,
+	0xed, 0xd2, 0x24, 0x7f, 0x3e, 0x98, 0x85, 0xb4, 0x64, 0x38, 0xc1, 0x73, 0xbc, 0x38, 0x5a, 0xee,
+	0x61, 0x9b, 0xfc, 0x55, 0x0f, 0x64, 0xa1, 0x7d, 0x21, 0x5e, 0xd9, 0x3e, 0xea, 0x1b, 0xe8, 0xb8,
+	0x6f, 0xa0, 0x9f, 0x7d, 0x03, 0x7d, 0x19, 0x18, 0xb9, 0xe3, 0x81, 0x91, 0xfb, 0x3e, 0x30, 0x72,
+	0xaf, 0x1e, 0xfe, 0x75, 0x61, 0x47, 0x1f, 0x67, 0xb2, 0xba, 0xee, 0xf5, 0xe4, 0x5a, 0x7d, 0xfc,
+	0x7b, 0x00, 0x01, 0x60, 0x66, 0x54, 0xf9, 0x05, 0x00, 0x00,
+}
+
+func (m *LiquidStakingParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidStakingParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidStakingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ValidatorBondFactor.Size()
+		i -= size
+		if _, err := m.ValidatorBondFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquid(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquid(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquid(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorLiquidShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorLiquidShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorLiquidShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LiquidShares.Size()
+		i -= size
+		if _, err := m.LiquidShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquid(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ValidatorBondShares.Size()
+		i -= size
+		if _, err := m.ValidatorBondShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquid(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintLiquid(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintLiquid(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintLiquid(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintLiquid(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LiquidShares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquid(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintLiquid(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorBondDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorBondDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorBondDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLiquid(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintLiquid(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLiquid(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLiquid(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LiquidStakingParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovLiquid(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovLiquid(uint64(l))
+	l = m.ValidatorBondFactor.Size()
+	n += 1 + l + sovLiquid(uint64(l))
+	return n
+}
+
+func (m *ValidatorLiquidShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ValidatorBondShares.Size()
+	n += 1 + l + sovLiquid(uint64(l))
+	l = m.LiquidShares.Size()
+	n += 1 + l + sovLiquid(uint64(l))
+	return n
+}
+
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquid(uint64(m.Id))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovLiquid(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovLiquid(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovLiquid(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquid(uint64(l))
+	}
+	l = m.LiquidShares.Size()
+	n += 1 + l + sovLiquid(uint64(l))
+	return n
+}
+
+func (m *ValidatorBondDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquid(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquid(uint64(l))
+	}
+	return n
+}
+
+func sovLiquid(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLiquid(x uint64) (n int) {
+	return sovLiquid(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LiquidStakingParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidStakingParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidStakingParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorBondFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorLiquidShares) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorLiquidShares: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorLiquidShares: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorBondShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorBondDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorBondDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorBondDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLiquid(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLiquid
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLiquid
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLiquid
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLiquid
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLiquid
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLiquid
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLiquid        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLiquid          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLiquid = fmt.Errorf("proto: unexpected end of group")
+)
@@ -66,9 +66,12 @@ func BeginBlocker(k *keeper.Keeper, ctx sdk.Context) {
 
 		// Prepare shutdown if we don't have an upgrade handler for this upgrade name (meaning this software is out of date)
 		if !k.HasHandler(plan.Name) {
+			// Run the pre-upgrade handler, if any, before halting.
+			preUpgrade := k.PreUpgrade(ctx, plan)
+
 			// Write the upgrade info to disk. The UpgradeStoreLoader uses this info to perform or skip
 			// store migrations.
-			err := k.DumpUpgradeInfoToDisk(ctx.BlockHeight(), plan)
+			err := k.DumpUpgradeInfoWithPreUpgradeToDisk(ctx.BlockHeight(), plan, preUpgrade)
 			if err != nil {
 				panic(fmt.Errorf("unable to write upgrade info to filesystem: %s", err.Error()))
 			}
@@ -1,6 +1,7 @@
 package upgrade_test
 
 import (
+	"encoding/json"
 	"errors"
 	"os"
 	"testing"
@@ -401,6 +402,56 @@ func TestDumpUpgradeInfoToFile(t *testing.T) {
 	require.Nil(err)
 }
 
+func TestPreUpgradeHandler(t *testing.T) {
+	s := setupTest(t, 10, map[int64]bool{})
+	require := require.New(t)
+
+	var called int
+	s.keeper.SetPreUpgradeHandler(func(_ sdk.Context, plan types.Plan) (map[string]string, error) {
+		called++
+		if called > 1 {
+			return nil, errors.New("cache flush failed")
+		}
+		return map[string]string{"config": "migrated"}, nil
+	})
+
+	err := s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{Name: "test", Height: s.ctx.BlockHeight() + 1}}) //nolint:staticcheck // we're testing deprecated code
+	require.NoError(err)
+
+	readUpgradeInfo := func() types.UpgradeInfo {
+		upgradeInfoFilePath, err := s.keeper.GetUpgradeInfoPath()
+		require.NoError(err)
+		bz, err := os.ReadFile(upgradeInfoFilePath)
+		require.NoError(err)
+		var upgradeInfo types.UpgradeInfo
+		require.NoError(json.Unmarshal(bz, &upgradeInfo))
+		return upgradeInfo
+	}
+
+	t.Log("Verify the pre-upgrade handler runs before halting and its results are dumped to file")
+	newCtx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(time.Now())
+	require.Panics(func() {
+		s.module.BeginBlock(newCtx)
+	})
+	require.Equal(1, called)
+
+	upgradeInfo := readUpgradeInfo()
+	require.Equal("test", upgradeInfo.Name)
+	require.Equal(newCtx.BlockHeight(), upgradeInfo.Height)
+	require.Equal(&types.PreUpgradeResult{Results: map[string]string{"config": "migrated"}}, upgradeInfo.PreUpgrade)
+
+	t.Log("Verify a pre-upgrade handler failure still halts and is recorded in the file")
+	require.Panics(func() {
+		s.module.BeginBlock(newCtx)
+	})
+	require.Equal(2, called)
+	require.Equal(&types.PreUpgradeResult{Error: "cache flush failed"}, readUpgradeInfo().PreUpgrade)
+
+	t.Log("Verify the pre-upgrade handler is only run when halting, not when the upgrade is applied")
+	VerifyDoUpgrade(t)
+	require.Equal(3, called)
+}
+
 // TODO: add testcase to for `no upgrade handler is present for last applied upgrade`.
 func TestBinaryVersion(t *testing.T) {
 	var skipHeight int64 = 15
@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cosmos/gogoproto/jsonpb"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// NewCmdMigrationPlan implements a command listing the module migrations and
+// store upgrades of an upgrade from the version maps of the two binaries.
+func NewCmdMigrationPlan() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migration-plan [current-versions] [upgraded-versions]",
+		Args:  cobra.ExactArgs(2),
+		Short: "List the module migrations and store upgrades of an upgrade",
+		Long: "List the module migrations and store upgrades of an upgrade, by comparing the consensus versions of the modules of the current and of the upgraded binaries.\n" +
+			"Both files are the json output of the consensus_versions or module_versions query of the upgrade module, on a node running the corresponding binary.",
+		Example: fmt.Sprintf("$ %s query upgrade consensus_versions -o json > current.json # with the current binary\n"+
+			"$ %s query upgrade consensus_versions -o json > upgraded.json # with the upgraded binary\n"+
+			"$ %s upgrade migration-plan current.json upgraded.json", version.AppName, version.AppName, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fromVM, err := readVersionMap(args[0])
+			if err != nil {
+				return err
+			}
+			toVM, err := readVersionMap(args[1])
+			if err != nil {
+				return err
+			}
+
+			plan, err := types.NewModuleMigrationPlan(fromVM, toVM)
+			if err != nil {
+				return err
+			}
+
+			if plan.Empty() {
+				cmd.Println("no module changes")
+				return nil
+			}
+
+			if len(plan.Migrations) > 0 {
+				cmd.Println("module migrations, run by the upgrade handler with RunMigrations:")
+				for _, m := range plan.Migrations {
+					cmd.Printf("  %s: %d -> %d\n", m.Name, m.FromVersion, m.ToVersion)
+				}
+			}
+			if len(plan.Added) > 0 {
+				cmd.Printf("added modules, to add to the store upgrades: %s\n", strings.Join(plan.Added, ", "))
+			}
+			if len(plan.Deleted) > 0 {
+				cmd.Printf("deleted modules, to delete from the store upgrades: %s\n", strings.Join(plan.Deleted, ", "))
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// readVersionMap reads a version map from a json file of module versions.
+func readVersionMap(path string) (module.VersionMap, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var res types.QueryConsensusVersionsResponse
+	if err := jsonpb.Unmarshal(f, &res); err != nil {
+		return nil, fmt.Errorf("failed to parse module versions of %s: %w", path, err)
+	}
+
+	vm := make(module.VersionMap, len(res.ModuleVersions))
+	for _, mv := range res.ModuleVersions {
+		vm[mv.Name] = mv.Version
+	}
+
+	return vm, nil
+}
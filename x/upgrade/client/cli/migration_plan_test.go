@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrationPlan(t *testing.T) {
+	dir := t.TempDir()
+	current := filepath.Join(dir, "current.json")
+	upgraded := filepath.Join(dir, "upgraded.json")
+	require.NoError(t, os.WriteFile(current, []byte(`{"module_versions":[{"name":"bank","version":"3"},{"name":"crisis","version":"2"}]}`), 0o600))
+	require.NoError(t, os.WriteFile(upgraded, []byte(`{"module_versions":[{"name":"bank","version":"4"},{"name":"nft","version":"1"}]}`), 0o600))
+
+	cmd := NewCmdMigrationPlan()
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+	cmd.SetArgs([]string{current, upgraded})
+	require.NoError(t, cmd.Execute())
+	require.Equal(t, "module migrations, run by the upgrade handler with RunMigrations:\n"+
+		"  bank: 3 -> 4\n"+
+		"added modules, to add to the store upgrades: nft\n"+
+		"deleted modules, to delete from the store upgrades: crisis\n", out.String())
+
+	out.Reset()
+	cmd.SetArgs([]string{current, current})
+	require.NoError(t, cmd.Execute())
+	require.Equal(t, "no module changes\n", out.String())
+
+	cmd.SetArgs([]string{upgraded, current})
+	require.EqualError(t, cmd.Execute(), "consensus version of module bank decreased from 4 to 3")
+}
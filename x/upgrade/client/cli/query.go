@@ -23,6 +23,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCurrentPlanCmd(),
 		GetAppliedPlanCmd(),
 		GetModuleVersionsCmd(),
+		GetConsensusVersionsCmd(),
 	)
 
 	return cmd
@@ -155,3 +156,41 @@ func GetModuleVersionsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetConsensusVersionsCmd returns the consensus versions of the modules of the
+// running binary
+func GetConsensusVersionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consensus_versions [optional module_name]",
+		Short: "get the consensus versions of the modules of the running binary",
+		Long: "Gets a list of module names and the consensus versions of the running binary.\n" +
+			"They differ from the module versions from state when the binary has been upgraded\n" +
+			"but the upgrade has not been applied yet.\n" +
+			"Following the command with a specific module name will return only\n" +
+			"that module's information.",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			params := types.QueryConsensusVersionsRequest{}
+			if len(args) == 1 {
+				params.ModuleName = args[0]
+			}
+
+			res, err := queryClient.ConsensusVersions(cmd.Context(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
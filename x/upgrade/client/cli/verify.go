@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/x/upgrade/plan"
+	"cosmossdk.io/x/upgrade/types"
+)
+
+const (
+	FlagBinary = "binary"
+	FlagOSArch = "os-arch"
+)
+
+// GetUpgradeCmd returns the upgrade commands meant to be run manually by node operators
+func GetUpgradeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   types.ModuleName,
+		Short: "Upgrade operator subcommands",
+	}
+
+	cmd.AddCommand(
+		NewCmdVerifyBinary(),
+		NewCmdMigrationPlan(),
+	)
+
+	return cmd
+}
+
+// NewCmdVerifyBinary implements a command verifying the binaries referenced by an upgrade info.
+func NewCmdVerifyBinary() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-binary [upgrade-info] (--binary [path]) [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Verify the binaries referenced by an upgrade info",
+		Long: "Verify the binaries referenced by an upgrade info, given as json or as a url to a json file.\n" +
+			"If --binary is set, the local binary is verified against the sha256 checksum of the given os/arch.\n" +
+			"Otherwise, all binaries and mirrors are downloaded and verified against their sha256 checksums.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			planInfo, err := plan.ParseInfo(args[0])
+			if err != nil {
+				return err
+			}
+			if err := planInfo.ValidateBasic(); err != nil {
+				return err
+			}
+
+			binary, err := cmd.Flags().GetString(FlagBinary)
+			if err != nil {
+				return err
+			}
+
+			if binary == "" {
+				daemonName, err := cmd.Flags().GetString(FlagDaemonName)
+				if err != nil {
+					return err
+				}
+				if err := planInfo.ValidateFull(daemonName); err != nil {
+					return err
+				}
+				cmd.Println("all binaries verified")
+				return nil
+			}
+
+			osArch, err := cmd.Flags().GetString(FlagOSArch)
+			if err != nil {
+				return err
+			}
+			checksum, ok := planInfo.Checksum(osArch)
+			if !ok {
+				return fmt.Errorf("no checksum found for os/arch: neither %s, nor any", osArch)
+			}
+			if err := plan.VerifyBinaryChecksum(binary, checksum); err != nil {
+				return err
+			}
+			cmd.Printf("binary %s verified for %s\n", binary, osArch)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagBinary, "", "The path of a local binary to verify, instead of downloading the binaries")
+	cmd.Flags().String(FlagOSArch, fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH), "The os/arch of the local binary")
+	cmd.Flags().String(FlagDaemonName, getDefaultDaemonName(), "The name of the executable being upgraded. Default is the DAEMON_NAME env var if set, or else this executable")
+
+	return cmd
+}
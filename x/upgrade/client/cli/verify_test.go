@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyBinary(t *testing.T) {
+	binary := filepath.Join(t.TempDir(), "simd")
+	require.NoError(t, os.WriteFile(binary, []byte("#!/usr/bin\necho 'simd'\n"), 0o600))
+	checksum := fmt.Sprintf("%x", sha256.Sum256([]byte("#!/usr/bin\necho 'simd'\n")))
+	badChecksum := fmt.Sprintf("%x", sha256.Sum256([]byte("tampered")))
+	dummyURL := "https://example.com/simd?checksum=sha256:" + checksum
+
+	makeInfo := func(osArch, checksum string) string {
+		return fmt.Sprintf(`{"binaries":{"%s":"%s"},"checksums":{"%s":"%s"}}`, osArch, dummyURL, osArch, checksum)
+	}
+
+	testCases := []struct {
+		name   string
+		info   string
+		osArch string
+		expErr string
+	}{
+		{
+			name:   "valid checksum",
+			info:   makeInfo("linux/amd64", checksum),
+			osArch: "linux/amd64",
+		},
+		{
+			name:   "valid checksum with any fallback",
+			info:   makeInfo("any", checksum),
+			osArch: "linux/amd64",
+		},
+		{
+			name:   "checksum mismatch",
+			info:   makeInfo("linux/amd64", badChecksum),
+			osArch: "linux/amd64",
+			expErr: "checksum mismatch",
+		},
+		{
+			name:   "no checksum for os/arch",
+			info:   makeInfo("linux/amd64", checksum),
+			osArch: "darwin/arm64",
+			expErr: "no checksum found for os/arch",
+		},
+		{
+			name:   "invalid checksum",
+			info:   makeInfo("linux/amd64", "not-a-checksum"),
+			osArch: "linux/amd64",
+			expErr: "invalid checksum in checksums[linux/amd64]",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := NewCmdVerifyBinary()
+			cmd.SetArgs([]string{tc.info, "--" + FlagBinary, binary, "--" + FlagOSArch, tc.osArch})
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+			err := cmd.Execute()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"sort"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/upgrade/types"
@@ -72,6 +73,39 @@ func (k Keeper) ModuleVersions(c context.Context, req *types.QueryModuleVersions
 	}, nil
 }
 
+// ConsensusVersions implements the Query/ConsensusVersions gRPC method,
+// returning the consensus versions of the modules of the running binary.
+func (k Keeper) ConsensusVersions(c context.Context, req *types.QueryConsensusVersionsRequest) (*types.QueryConsensusVersionsResponse, error) {
+	if k.initVersionMap == nil {
+		return nil, errorsmod.Wrap(errors.ErrNotFound, "x/upgrade: QueryConsensusVersions consensus versions of the modules not set, see SetInitVersionMap")
+	}
+
+	// check if a specific module was requested
+	if len(req.ModuleName) > 0 {
+		if version, ok := k.initVersionMap[req.ModuleName]; ok {
+			res := []*types.ModuleVersion{{Name: req.ModuleName, Version: version}}
+			return &types.QueryConsensusVersionsResponse{ModuleVersions: res}, nil
+		}
+		// module requested, but not found
+		return nil, errorsmod.Wrapf(errors.ErrNotFound, "x/upgrade: QueryConsensusVersions module %s not found", req.ModuleName)
+	}
+
+	names := make([]string, 0, len(k.initVersionMap))
+	for name := range k.initVersionMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	mv := make([]*types.ModuleVersion, 0, len(names))
+	for _, name := range names {
+		mv = append(mv, &types.ModuleVersion{Name: name, Version: k.initVersionMap[name]})
+	}
+
+	return &types.QueryConsensusVersionsResponse{
+		ModuleVersions: mv,
+	}, nil
+}
+
 // Authority implements the Query/Authority gRPC method, returning the account capable of performing upgrades
 func (k Keeper) Authority(c context.Context, req *types.QueryAuthorityRequest) (*types.QueryAuthorityResponse, error) {
 	return &types.QueryAuthorityResponse{Address: k.authority}, nil
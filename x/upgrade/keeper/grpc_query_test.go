@@ -219,6 +219,27 @@ func (suite *UpgradeTestSuite) TestModuleVersions() {
 	}
 }
 
+func (suite *UpgradeTestSuite) TestConsensusVersions() {
+	_, err := suite.queryClient.ConsensusVersions(context.Background(), &types.QueryConsensusVersionsRequest{})
+	suite.Require().Error(err)
+
+	suite.upgradeKeeper.SetInitVersionMap(module.VersionMap{
+		"staking": 4,
+		"bank":    3,
+	})
+
+	res, err := suite.queryClient.ConsensusVersions(context.Background(), &types.QueryConsensusVersionsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.ModuleVersion{{Name: "bank", Version: 3}, {Name: "staking", Version: 4}}, res.ModuleVersions)
+
+	res, err = suite.queryClient.ConsensusVersions(context.Background(), &types.QueryConsensusVersionsRequest{ModuleName: "staking"})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.ModuleVersion{{Name: "staking", Version: 4}}, res.ModuleVersions)
+
+	_, err = suite.queryClient.ConsensusVersions(context.Background(), &types.QueryConsensusVersionsRequest{ModuleName: "abcdefg"})
+	suite.Require().Error(err)
+}
+
 func (suite *UpgradeTestSuite) TestAuthority() {
 	res, err := suite.queryClient.Authority(context.Background(), &types.QueryAuthorityRequest{})
 	suite.Require().NoError(err)
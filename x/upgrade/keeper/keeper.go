@@ -36,6 +36,7 @@ type Keeper struct {
 	storeKey           storetypes.StoreKey             // key to access x/upgrade store
 	cdc                codec.BinaryCodec               // App-wide binary codec
 	upgradeHandlers    map[string]types.UpgradeHandler // map of plan name to upgrade handler
+	preUpgradeHandler  types.PreUpgradeHandler         // handler run before halting for an upgrade this binary cannot handle
 	versionSetter      xp.ProtocolVersionSetter        // implements setting the protocol version field on BaseApp
 	downgradeVerified  bool                            // tells if we've already sanity checked that this binary version isn't being used against an old state.
 	authority          string                          // the address capable of executing and canceling an upgrade. Usually the gov module account
@@ -88,6 +89,30 @@ func (k *Keeper) GetInitVersionMap() module.VersionMap {
 	return k.initVersionMap
 }
 
+// SetPreUpgradeHandler sets the PreUpgradeHandler, which is called by this binary
+// at the height of an upgrade it has no UpgradeHandler for, right before halting.
+func (k *Keeper) SetPreUpgradeHandler(preUpgradeHandler types.PreUpgradeHandler) {
+	k.preUpgradeHandler = preUpgradeHandler
+}
+
+// PreUpgrade runs the PreUpgradeHandler, if any, for the given plan. A handler
+// error does not prevent the node from halting, it is recorded in the result
+// instead. Any state change made by the handler is discarded.
+func (k Keeper) PreUpgrade(ctx sdk.Context, plan types.Plan) *types.PreUpgradeResult {
+	if k.preUpgradeHandler == nil {
+		return nil
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	results, err := k.preUpgradeHandler(cacheCtx, plan)
+	if err != nil {
+		k.Logger(ctx).Error("pre-upgrade handler failed", "upgrade", plan.Name, "err", err)
+		return &types.PreUpgradeResult{Results: results, Error: err.Error()}
+	}
+
+	return &types.PreUpgradeResult{Results: results}
+}
+
 // SetUpgradeHandler sets an UpgradeHandler for the upgrade specified by name. This handler will be called when the upgrade
 // with this name is applied. In order for an upgrade with the given name to proceed, a handler for this upgrade
 // must be set even if it is a no-op function.
@@ -397,15 +422,24 @@ func (k Keeper) IsSkipHeight(height int64) bool {
 
 // DumpUpgradeInfoToDisk writes upgrade information to UpgradeInfoFileName.
 func (k Keeper) DumpUpgradeInfoToDisk(height int64, p types.Plan) error {
+	return k.DumpUpgradeInfoWithPreUpgradeToDisk(height, p, nil)
+}
+
+// DumpUpgradeInfoWithPreUpgradeToDisk writes upgrade information, along with the
+// outcome of the PreUpgradeHandler, to UpgradeInfoFileName.
+func (k Keeper) DumpUpgradeInfoWithPreUpgradeToDisk(height int64, p types.Plan, preUpgrade *types.PreUpgradeResult) error {
 	upgradeInfoFilePath, err := k.GetUpgradeInfoPath()
 	if err != nil {
 		return err
 	}
 
-	upgradeInfo := types.Plan{
-		Name:   p.Name,
-		Height: height,
-		Info:   p.Info,
+	upgradeInfo := types.UpgradeInfo{
+		Plan: types.Plan{
+			Name:   p.Name,
+			Height: height,
+			Info:   p.Info,
+		},
+		PreUpgrade: preUpgrade,
 	}
 	info, err := json.Marshal(upgradeInfo)
 	if err != nil {
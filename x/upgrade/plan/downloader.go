@@ -1,8 +1,11 @@
 package plan
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
 	neturl "net/url"
 	"os"
 	"path/filepath"
@@ -141,3 +144,42 @@ func ValidateIsURLWithChecksum(urlStr string) error {
 	}
 	return nil
 }
+
+// ValidateSHA256Checksum checks that the given string is a hex encoded sha256 checksum,
+// optionally prefixed with "sha256:".
+func ValidateSHA256Checksum(checksum string) error {
+	bz, err := hex.DecodeString(strings.TrimPrefix(checksum, "sha256:"))
+	if err != nil {
+		return fmt.Errorf("checksum is not hex encoded: %w", err)
+	}
+	if len(bz) != sha256.Size {
+		return fmt.Errorf("invalid sha256 checksum length: expected %d bytes, got %d", sha256.Size, len(bz))
+	}
+	return nil
+}
+
+// VerifyBinaryChecksum checks that the sha256 checksum of the file at the given path
+// matches the given hex encoded checksum, optionally prefixed with "sha256:".
+func VerifyBinaryChecksum(path, checksum string) error {
+	if err := ValidateSHA256Checksum(checksum); err != nil {
+		return err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, f); err != nil {
+		return fmt.Errorf("could not read %s: %w", path, err)
+	}
+
+	actual := hex.EncodeToString(hasher.Sum(nil))
+	expected := strings.ToLower(strings.TrimPrefix(checksum, "sha256:"))
+	if actual != expected {
+		return fmt.Errorf("checksum mismatch for %s: expected %s, got %s", path, expected, actual)
+	}
+	return nil
+}
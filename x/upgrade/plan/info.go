@@ -16,11 +16,23 @@ import (
 // Info is the special structure that the Plan.Info string can be (as json).
 type Info struct {
 	Binaries BinaryDownloadURLMap `json:"binaries"`
+	// Mirrors are additional URLs the binaries can be downloaded from, tried in order
+	// when downloading from Binaries fails. Optional.
+	Mirrors BinaryMirrorURLsMap `json:"mirrors,omitempty"`
+	// Checksums are the sha256 checksums of the binaries themselves, verified once
+	// downloaded and, if needed, unpacked. Optional.
+	Checksums BinaryChecksumMap `json:"checksums,omitempty"`
 }
 
 // BinaryDownloadURLMap is a map of os/architecture stings to a URL where the binary can be downloaded.
 type BinaryDownloadURLMap map[string]string
 
+// BinaryMirrorURLsMap is a map of os/architecture strings to mirror URLs where the binary can be downloaded.
+type BinaryMirrorURLsMap map[string][]string
+
+// BinaryChecksumMap is a map of os/architecture strings to the hex encoded sha256 checksum of the binary.
+type BinaryChecksumMap map[string]string
+
 // ParseInfo parses an info string into a map of os/arch strings to URL string.
 // If the infoStr is a url, an GET request will be made to it, and its response will be parsed instead.
 func ParseInfo(infoStr string) (*Info, error) {
@@ -47,20 +59,126 @@ func ParseInfo(infoStr string) (*Info, error) {
 	return &planInfo, nil
 }
 
+// ValidateBasic does stateless validation of this Info.
+// It checks that:
+//   - Binaries.ValidateBasic() doesn't return an error.
+//   - All Mirrors and Checksums entries have a corresponding Binaries entry.
+//   - All Mirrors entries are valid URLs containing a checksum query parameter.
+//   - All Checksums entries are hex encoded sha256 checksums.
+func (m Info) ValidateBasic() error {
+	if err := m.Binaries.ValidateBasic(); err != nil {
+		return err
+	}
+
+	for osArch, urls := range m.Mirrors {
+		if _, ok := m.Binaries[osArch]; !ok {
+			return fmt.Errorf("mirrors[%s] has no corresponding binaries entry", osArch)
+		}
+		for _, url := range urls {
+			if err := ValidateIsURLWithChecksum(url); err != nil {
+				return fmt.Errorf("invalid url \"%s\" in mirrors[%s]: %v", url, osArch, err)
+			}
+		}
+	}
+
+	for osArch, checksum := range m.Checksums {
+		if _, ok := m.Binaries[osArch]; !ok {
+			return fmt.Errorf("checksums[%s] has no corresponding binaries entry", osArch)
+		}
+		if err := ValidateSHA256Checksum(checksum); err != nil {
+			return fmt.Errorf("invalid checksum in checksums[%s]: %v", osArch, err)
+		}
+	}
+
+	return nil
+}
+
 // ValidateFull does all possible validation of this Info.
 // The provided daemonName is the name of the executable file expected in all downloaded directories.
 // It checks that:
-//   - Binaries.ValidateBasic() doesn't return an error
+//   - ValidateBasic() doesn't return an error
 //   - Binaries.CheckURLs(daemonName) doesn't return an error.
+//   - All mirrors can be downloaded and all downloaded binaries match their Checksums entry, if any.
 //
 // Warning: This is an expensive process. See BinaryDownloadURLMap.CheckURLs for more info.
 func (m Info) ValidateFull(daemonName string) error {
-	if err := m.Binaries.ValidateBasic(); err != nil {
+	if err := m.ValidateBasic(); err != nil {
 		return err
 	}
-	if err := m.Binaries.CheckURLs(daemonName); err != nil {
+	if len(m.Mirrors) == 0 && len(m.Checksums) == 0 {
+		return m.Binaries.CheckURLs(daemonName)
+	}
+
+	tempDir, err := os.MkdirTemp("", "os-arch-downloads")
+	if err != nil {
+		return fmt.Errorf("could not create temp directory: %w", err)
+	}
+	defer os.RemoveAll(tempDir)
+	for osArch := range m.Binaries {
+		for i, url := range m.DownloadURLs(osArch) {
+			dstRoot := filepath.Join(tempDir, fmt.Sprintf("%s-%d", strings.ReplaceAll(osArch, "/", "-"), i))
+			if err := m.downloadAndVerify(dstRoot, osArch, url, daemonName); err != nil {
+				return fmt.Errorf("error downloading binary for os/arch %s: %v", osArch, err)
+			}
+		}
+	}
+	return nil
+}
+
+// DownloadURLs returns the URLs the binary for the given os/arch can be downloaded from,
+// the Binaries entry first, followed by its Mirrors. It falls back to the "any" entry
+// if there is no entry for the given os/arch.
+func (m Info) DownloadURLs(osArch string) []string {
+	url, ok := m.Binaries[osArch]
+	if !ok {
+		osArch = "any"
+		if url, ok = m.Binaries[osArch]; !ok {
+			return nil
+		}
+	}
+
+	return append([]string{url}, m.Mirrors[osArch]...)
+}
+
+// DownloadUpgrade downloads the binary for the given os/arch into the provided directory,
+// trying its mirrors in order if downloading from the Binaries entry fails. The downloaded
+// binary is verified against its Checksums entry, if any. See DownloadUpgrade for more info.
+func (m Info) DownloadUpgrade(dstRoot, osArch, daemonName string) error {
+	urls := m.DownloadURLs(osArch)
+	if len(urls) == 0 {
+		return fmt.Errorf("cannot find binary for os/arch: neither %s, nor any", osArch)
+	}
+
+	var errs []error
+	for _, url := range urls {
+		err := m.downloadAndVerify(dstRoot, osArch, url, daemonName)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", url, err))
+	}
+	return errors.Join(errs...)
+}
+
+// Checksum returns the sha256 checksum of the binary for the given os/arch,
+// falling back to the "any" entry if there is no entry for the given os/arch.
+func (m Info) Checksum(osArch string) (string, bool) {
+	if _, ok := m.Binaries[osArch]; !ok {
+		osArch = "any"
+	}
+	checksum, ok := m.Checksums[osArch]
+	return checksum, ok
+}
+
+// downloadAndVerify downloads the given url into the provided directory and verifies
+// the downloaded binary against the checksum of the given os/arch, if any.
+func (m Info) downloadAndVerify(dstRoot, osArch, url, daemonName string) error {
+	if err := DownloadUpgrade(dstRoot, url, daemonName); err != nil {
 		return err
 	}
+	if checksum, ok := m.Checksum(osArch); ok {
+		return VerifyBinaryChecksum(filepath.Join(dstRoot, "bin", daemonName), checksum)
+	}
 	return nil
 }
 
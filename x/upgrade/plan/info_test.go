@@ -1,6 +1,10 @@
 package plan
 
 import (
+	"crypto/sha256"
+	"fmt"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -333,3 +337,150 @@ func (s *InfoTestSuite) TestBinaryDownloadURLMapCheckURLs() {
 		})
 	}
 }
+
+func (s *InfoTestSuite) TestInfoValidateBasic() {
+	goodURL := "https://example.com/simd?checksum=sha256:b5a2c96250612366ea272ffac6d9744aaf4b45aacd96aa7cfcb931ee3b558259"
+	goodChecksum := "b5a2c96250612366ea272ffac6d9744aaf4b45aacd96aa7cfcb931ee3b558259"
+
+	tests := []struct {
+		name     string
+		planInfo Info
+		errs     []string
+	}{
+		{
+			name: "mirrors and checksums",
+			planInfo: Info{
+				Binaries:  BinaryDownloadURLMap{"linux/amd64": goodURL},
+				Mirrors:   BinaryMirrorURLsMap{"linux/amd64": {goodURL}},
+				Checksums: BinaryChecksumMap{"linux/amd64": "sha256:" + goodChecksum},
+			},
+			errs: nil,
+		},
+		{
+			name:     "empty binaries",
+			planInfo: Info{Binaries: BinaryDownloadURLMap{}},
+			errs:     []string{"no \"binaries\" entries found"},
+		},
+		{
+			name: "mirror without binary",
+			planInfo: Info{
+				Binaries: BinaryDownloadURLMap{"linux/amd64": goodURL},
+				Mirrors:  BinaryMirrorURLsMap{"darwin/arm64": {goodURL}},
+			},
+			errs: []string{"mirrors[darwin/arm64] has no corresponding binaries entry"},
+		},
+		{
+			name: "mirror without checksum parameter",
+			planInfo: Info{
+				Binaries: BinaryDownloadURLMap{"linux/amd64": goodURL},
+				Mirrors:  BinaryMirrorURLsMap{"linux/amd64": {"https://example.com/simd"}},
+			},
+			errs: []string{"invalid url", "mirrors[linux/amd64]", "missing checksum query parameter"},
+		},
+		{
+			name: "checksum without binary",
+			planInfo: Info{
+				Binaries:  BinaryDownloadURLMap{"linux/amd64": goodURL},
+				Checksums: BinaryChecksumMap{"darwin/arm64": goodChecksum},
+			},
+			errs: []string{"checksums[darwin/arm64] has no corresponding binaries entry"},
+		},
+		{
+			name: "checksum too short",
+			planInfo: Info{
+				Binaries:  BinaryDownloadURLMap{"linux/amd64": goodURL},
+				Checksums: BinaryChecksumMap{"linux/amd64": "b5a2c962"},
+			},
+			errs: []string{"invalid checksum in checksums[linux/amd64]", "invalid sha256 checksum length"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.T().Run(tc.name, func(t *testing.T) {
+			actualErr := tc.planInfo.ValidateBasic()
+			if len(tc.errs) > 0 {
+				require.Error(t, actualErr)
+				for _, expectedErr := range tc.errs {
+					assert.Contains(t, actualErr.Error(), expectedErr)
+				}
+			} else {
+				require.NoError(t, actualErr)
+			}
+		})
+	}
+}
+
+func (s *InfoTestSuite) TestInfoDownloadUpgrade() {
+	binaryFile := NewTestFile("simd", "#!/usr/bin\necho 'simd'\n")
+	binaryPath := s.saveTestFile(binaryFile)
+	binaryURL := makeFileURL(s.T(), binaryPath)
+	binaryChecksum := fmt.Sprintf("%x", sha256.Sum256(binaryFile.Contents))
+	missingURL := "file:///no/such/file/exists/hopefully.zip?checksum=sha256:b5a2c96250612366ea272ffac6d9744aaf4b45aacd96aa7cfcb931ee3b558259"
+
+	tests := []struct {
+		name     string
+		planInfo Info
+		osArch   string
+		errs     []string
+	}{
+		{
+			name: "binary with checksum",
+			planInfo: Info{
+				Binaries:  BinaryDownloadURLMap{"linux/amd64": binaryURL},
+				Checksums: BinaryChecksumMap{"linux/amd64": binaryChecksum},
+			},
+			osArch: "linux/amd64",
+		},
+		{
+			name: "falls back to mirror",
+			planInfo: Info{
+				Binaries:  BinaryDownloadURLMap{"any": missingURL},
+				Mirrors:   BinaryMirrorURLsMap{"any": {missingURL, binaryURL}},
+				Checksums: BinaryChecksumMap{"any": binaryChecksum},
+			},
+			osArch: "linux/amd64",
+		},
+		{
+			name: "all urls fail",
+			planInfo: Info{
+				Binaries: BinaryDownloadURLMap{"linux/amd64": missingURL},
+				Mirrors:  BinaryMirrorURLsMap{"linux/amd64": {missingURL}},
+			},
+			osArch: "linux/amd64",
+			errs:   []string{"no such file or directory"},
+		},
+		{
+			name: "checksum mismatch",
+			planInfo: Info{
+				Binaries:  BinaryDownloadURLMap{"linux/amd64": binaryURL},
+				Checksums: BinaryChecksumMap{"linux/amd64": "b5a2c96250612366ea272ffac6d9744aaf4b45aacd96aa7cfcb931ee3b558259"},
+			},
+			osArch: "linux/amd64",
+			errs:   []string{"checksum mismatch"},
+		},
+		{
+			name: "no binary for os/arch",
+			planInfo: Info{
+				Binaries: BinaryDownloadURLMap{"linux/amd64": binaryURL},
+			},
+			osArch: "darwin/arm64",
+			errs:   []string{"cannot find binary for os/arch"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.T().Run(tc.name, func(t *testing.T) {
+			dstRoot := filepath.Join(s.Home, "dst", strings.ReplaceAll(tc.name, " ", "-"))
+			actualErr := tc.planInfo.DownloadUpgrade(dstRoot, tc.osArch, "daemon")
+			if len(tc.errs) > 0 {
+				require.Error(t, actualErr)
+				for _, expectedErr := range tc.errs {
+					assert.Contains(t, actualErr.Error(), expectedErr)
+				}
+			} else {
+				require.NoError(t, actualErr)
+				requireFileEquals(t, filepath.Join(dstRoot, "bin", "daemon"), binaryFile)
+			}
+		})
+	}
+}
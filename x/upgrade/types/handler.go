@@ -24,3 +24,12 @@ import (
 //
 // Please also refer to docs/core/upgrade.md for more information.
 type UpgradeHandler func(ctx sdk.Context, plan Plan, fromVM module.VersionMap) (module.VersionMap, error)
+
+// PreUpgradeHandler specifies the type of function that is called by the old
+// binary at the height of an upgrade it has no UpgradeHandler for, right before
+// halting. It can be used for tasks like config migration or cache flushing.
+//
+// Any state change made by the handler is discarded, as the node halts. The
+// returned results are written into the upgrade info file next to the plan, so
+// they can be consumed by tools like cosmovisor.
+type PreUpgradeHandler func(ctx sdk.Context, plan Plan) (map[string]string, error)
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// ModuleMigration is the in-place store migration of a module from a consensus
+// version to a greater one.
+type ModuleMigration struct {
+	Name        string
+	FromVersion uint64
+	ToVersion   uint64
+}
+
+// ModuleMigrationPlan lists the changes of the modules between the version maps
+// of two binaries, which an upgrade handler and its store upgrades must handle.
+type ModuleMigrationPlan struct {
+	// Migrations are the modules whose consensus version increased, which are
+	// migrated by Manager.RunMigrations.
+	Migrations []ModuleMigration
+	// Added are the new modules, whose store must be added by the store upgrades
+	// and whose InitGenesis is run by Manager.RunMigrations.
+	Added []string
+	// Deleted are the removed modules, whose store must be deleted by the store
+	// upgrades.
+	Deleted []string
+}
+
+// NewModuleMigrationPlan compares the version map of the current binary with
+// the version map of the upgraded binary and returns the resulting migration
+// plan, sorted by module name. An error is returned if the consensus version of
+// a module decreased.
+func NewModuleMigrationPlan(fromVM, toVM module.VersionMap) (ModuleMigrationPlan, error) {
+	var plan ModuleMigrationPlan
+	for _, name := range sortedModuleNames(toVM) {
+		fromVersion, ok := fromVM[name]
+		toVersion := toVM[name]
+		switch {
+		case !ok:
+			plan.Added = append(plan.Added, name)
+		case fromVersion > toVersion:
+			return ModuleMigrationPlan{}, fmt.Errorf("consensus version of module %s decreased from %d to %d", name, fromVersion, toVersion)
+		case fromVersion < toVersion:
+			plan.Migrations = append(plan.Migrations, ModuleMigration{Name: name, FromVersion: fromVersion, ToVersion: toVersion})
+		}
+	}
+
+	for _, name := range sortedModuleNames(fromVM) {
+		if _, ok := toVM[name]; !ok {
+			plan.Deleted = append(plan.Deleted, name)
+		}
+	}
+
+	return plan, nil
+}
+
+// Empty returns true if the plan contains no change of the modules.
+func (p ModuleMigrationPlan) Empty() bool {
+	return len(p.Migrations) == 0 && len(p.Added) == 0 && len(p.Deleted) == 0
+}
+
+func sortedModuleNames(vm module.VersionMap) []string {
+	names := make([]string, 0, len(vm))
+	for name := range vm {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
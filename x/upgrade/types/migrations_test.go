@@ -0,0 +1,35 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+func TestNewModuleMigrationPlan(t *testing.T) {
+	plan, err := types.NewModuleMigrationPlan(
+		module.VersionMap{"auth": 4, "bank": 3, "crisis": 2, "staking": 4},
+		module.VersionMap{"auth": 5, "bank": 3, "nft": 1, "staking": 5},
+	)
+	require.NoError(t, err)
+	require.Equal(t, types.ModuleMigrationPlan{
+		Migrations: []types.ModuleMigration{
+			{Name: "auth", FromVersion: 4, ToVersion: 5},
+			{Name: "staking", FromVersion: 4, ToVersion: 5},
+		},
+		Added:   []string{"nft"},
+		Deleted: []string{"crisis"},
+	}, plan)
+	require.False(t, plan.Empty())
+
+	plan, err = types.NewModuleMigrationPlan(module.VersionMap{"bank": 3}, module.VersionMap{"bank": 3})
+	require.NoError(t, err)
+	require.True(t, plan.Empty())
+
+	_, err = types.NewModuleMigrationPlan(module.VersionMap{"bank": 3}, module.VersionMap{"bank": 2})
+	require.EqualError(t, err, "consensus version of module bank decreased from 3 to 2")
+}
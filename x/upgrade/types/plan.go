@@ -12,6 +12,20 @@ import (
 // UpgradeInfoFileName file to store upgrade information
 const UpgradeInfoFilename = "upgrade-info.json"
 
+// UpgradeInfo is the content of the upgrade info file, written to disk by the
+// old binary when halting for an upgrade. It extends the plan with the outcome
+// of the PreUpgradeHandler, if any.
+type UpgradeInfo struct {
+	Plan
+	PreUpgrade *PreUpgradeResult `json:"pre_upgrade,omitempty"`
+}
+
+// PreUpgradeResult is the outcome of running the PreUpgradeHandler.
+type PreUpgradeResult struct {
+	Results map[string]string `json:"results,omitempty"`
+	Error   string            `json:"error,omitempty"`
+}
+
 // ValidateBasic does basic validation of a Plan
 func (p Plan) ValidateBasic() error {
 	if !p.Time.IsZero() {
@@ -406,6 +406,102 @@ func (m *QueryModuleVersionsResponse) GetModuleVersions() []*ModuleVersion {
 	return nil
 }
 
+// QueryConsensusVersionsRequest is the request type for the
+// Query/ConsensusVersions RPC method.
+type QueryConsensusVersionsRequest struct {
+	// module_name is a field to query the consensus version of a specific
+	// module. Leaving this empty will fetch the consensus versions of all the
+	// modules.
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+}
+
+func (m *QueryConsensusVersionsRequest) Reset()         { *m = QueryConsensusVersionsRequest{} }
+func (m *QueryConsensusVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsensusVersionsRequest) ProtoMessage()    {}
+func (*QueryConsensusVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{8}
+}
+func (m *QueryConsensusVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsensusVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsensusVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsensusVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsensusVersionsRequest.Merge(m, src)
+}
+func (m *QueryConsensusVersionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsensusVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsensusVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsensusVersionsRequest proto.InternalMessageInfo
+
+func (m *QueryConsensusVersionsRequest) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+// QueryConsensusVersionsResponse is the response type for the
+// Query/ConsensusVersions RPC method.
+type QueryConsensusVersionsResponse struct {
+	// module_versions is a list of module names with their consensus versions.
+	ModuleVersions []*ModuleVersion `protobuf:"bytes,1,rep,name=module_versions,json=moduleVersions,proto3" json:"module_versions,omitempty"`
+}
+
+func (m *QueryConsensusVersionsResponse) Reset()         { *m = QueryConsensusVersionsResponse{} }
+func (m *QueryConsensusVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsensusVersionsResponse) ProtoMessage()    {}
+func (*QueryConsensusVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{9}
+}
+func (m *QueryConsensusVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsensusVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsensusVersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsensusVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsensusVersionsResponse.Merge(m, src)
+}
+func (m *QueryConsensusVersionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsensusVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsensusVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsensusVersionsResponse proto.InternalMessageInfo
+
+func (m *QueryConsensusVersionsResponse) GetModuleVersions() []*ModuleVersion {
+	if m != nil {
+		return m.ModuleVersions
+	}
+	return nil
+}
+
 // QueryAuthorityRequest is the request type for Query/Authority
 //
 // Since: cosmos-sdk 0.46
@@ -416,7 +512,7 @@ func (m *QueryAuthorityRequest) Reset()         { *m = QueryAuthorityRequest{} }
 func (m *QueryAuthorityRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAuthorityRequest) ProtoMessage()    {}
 func (*QueryAuthorityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a334d07ad8374f0, []int{8}
+	return fileDescriptor_4a334d07ad8374f0, []int{10}
 }
 func (m *QueryAuthorityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -456,7 +552,7 @@ func (m *QueryAuthorityResponse) Reset()         { *m = QueryAuthorityResponse{}
 func (m *QueryAuthorityResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAuthorityResponse) ProtoMessage()    {}
 func (*QueryAuthorityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a334d07ad8374f0, []int{9}
+	return fileDescriptor_4a334d07ad8374f0, []int{11}
 }
 func (m *QueryAuthorityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -501,6 +597,8 @@ func init() {
 	proto.RegisterType((*QueryUpgradedConsensusStateResponse)(nil), "cosmos.upgrade.v1beta1.QueryUpgradedConsensusStateResponse")
 	proto.RegisterType((*QueryModuleVersionsRequest)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsRequest")
 	proto.RegisterType((*QueryModuleVersionsResponse)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsResponse")
+	proto.RegisterType((*QueryConsensusVersionsRequest)(nil), "cosmos.upgrade.v1beta1.QueryConsensusVersionsRequest")
+	proto.RegisterType((*QueryConsensusVersionsResponse)(nil), "cosmos.upgrade.v1beta1.QueryConsensusVersionsResponse")
 	proto.RegisterType((*QueryAuthorityRequest)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityRequest")
 	proto.RegisterType((*QueryAuthorityResponse)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityResponse")
 }
@@ -510,47 +608,50 @@ func init() {
 }
 
 var fileDescriptor_4a334d07ad8374f0 = []byte{
-	// 632 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x41, 0x4f, 0xd4, 0x40,
-	0x14, 0x66, 0x16, 0x44, 0x79, 0x6b, 0xd0, 0x4c, 0xe2, 0x52, 0x2b, 0x59, 0x71, 0x40, 0x85, 0x28,
-	0x1d, 0x58, 0x12, 0x63, 0x30, 0x1a, 0x95, 0x8b, 0x18, 0x25, 0xba, 0x46, 0x0f, 0x5e, 0x36, 0x03,
-	0x9d, 0x2c, 0x8d, 0xdd, 0x4e, 0xe9, 0x4c, 0x89, 0x84, 0x70, 0xf1, 0xe4, 0xd1, 0xc4, 0x78, 0xf5,
-	0xe6, 0xc5, 0x5f, 0xe2, 0x91, 0xc4, 0x8b, 0x07, 0x0f, 0x06, 0xfc, 0x09, 0xfe, 0x00, 0xd3, 0xe9,
-	0x94, 0x74, 0xa1, 0x2d, 0xe8, 0x6d, 0x3b, 0xef, 0xfb, 0xbe, 0xf7, 0xbd, 0xce, 0xf7, 0xb6, 0x40,
-	0xd6, 0x84, 0xec, 0x09, 0x49, 0xe3, 0xb0, 0x1b, 0x31, 0x97, 0xd3, 0xcd, 0xf9, 0x55, 0xae, 0xd8,
-	0x3c, 0xdd, 0x88, 0x79, 0xb4, 0xe5, 0x84, 0x91, 0x50, 0x02, 0x37, 0x52, 0x8c, 0x63, 0x30, 0x8e,
-	0xc1, 0xd8, 0xe3, 0x5d, 0x21, 0xba, 0x3e, 0xa7, 0x2c, 0xf4, 0x28, 0x0b, 0x02, 0xa1, 0x98, 0xf2,
-	0x44, 0x20, 0x53, 0x96, 0x3d, 0x55, 0xa2, 0x9c, 0xa9, 0x68, 0x14, 0xb9, 0x08, 0x63, 0xcf, 0x93,
-	0x56, 0x4b, 0x71, 0x14, 0xf1, 0x40, 0x3d, 0xf3, 0x59, 0xd0, 0xe6, 0x1b, 0x31, 0x97, 0x8a, 0x3c,
-	0x01, 0xeb, 0x68, 0x49, 0x86, 0x22, 0x90, 0x1c, 0xcf, 0xc1, 0x50, 0xe8, 0xb3, 0xc0, 0x42, 0x13,
-	0x68, 0xba, 0xde, 0x1a, 0x77, 0x8a, 0x1d, 0x3a, 0x9a, 0xa3, 0x91, 0x64, 0xd6, 0x34, 0x7a, 0x10,
-	0x86, 0xbe, 0xc7, 0xdd, 0x5c, 0x23, 0x8c, 0x61, 0x28, 0x60, 0x3d, 0xae, 0xc5, 0x46, 0xda, 0xfa,
-	0x37, 0x69, 0x99, 0xe6, 0x7d, 0x70, 0xd3, 0xbc, 0x01, 0xc3, 0xeb, 0xdc, 0xeb, 0xae, 0x2b, 0xcd,
-	0x18, 0x6c, 0x9b, 0x27, 0xb2, 0x0c, 0x44, 0x73, 0x5e, 0xa6, 0x2e, 0xdc, 0xa5, 0x04, 0x1d, 0xc8,
-	0x58, 0xbe, 0x50, 0x4c, 0xf1, 0xac, 0xdb, 0x65, 0xa8, 0xfb, 0x4c, 0xaa, 0x4e, 0x9f, 0x04, 0x24,
-	0x47, 0x8f, 0xf4, 0xc9, 0x62, 0xcd, 0x42, 0xc4, 0x83, 0xc9, 0x4a, 0x29, 0xe3, 0xe4, 0x36, 0x58,
-	0x66, 0x64, 0xb7, 0xb3, 0x96, 0x41, 0x3a, 0x32, 0xc1, 0x58, 0xb5, 0x09, 0x34, 0x7d, 0xb6, 0xdd,
-	0x88, 0x0b, 0x15, 0x92, 0x26, 0x8f, 0x87, 0xce, 0xa0, 0xf3, 0x35, 0x72, 0x17, 0x6c, 0xdd, 0xea,
-	0xa9, 0x70, 0x63, 0x9f, 0xbf, 0xe2, 0x91, 0x4c, 0x2e, 0x31, 0xe7, 0xb6, 0xa7, 0x0b, 0x9d, 0xdc,
-	0x2b, 0x82, 0xf4, 0x68, 0x25, 0x79, 0x51, 0x3d, 0xb8, 0x54, 0x48, 0x37, 0x0e, 0x57, 0xe0, 0x9c,
-	0xe1, 0x6f, 0x9a, 0x92, 0x85, 0x26, 0x06, 0xa7, 0xeb, 0xad, 0xab, 0x65, 0x77, 0xd6, 0x27, 0xd4,
-	0x1e, 0xed, 0xf5, 0xe9, 0x92, 0x31, 0xb8, 0x90, 0xde, 0x4b, 0xac, 0xd6, 0x45, 0xe4, 0xa9, 0xad,
-	0x2c, 0x2d, 0x2d, 0x68, 0x1c, 0x2e, 0x18, 0x0b, 0x16, 0x9c, 0x66, 0xae, 0x1b, 0x71, 0x29, 0x8d,
-	0xfd, 0xec, 0xb1, 0xf5, 0x67, 0x18, 0x4e, 0x69, 0x12, 0xfe, 0x8c, 0xa0, 0x9e, 0xcb, 0x19, 0xa6,
-	0x65, 0xee, 0x4a, 0xc2, 0x6a, 0xcf, 0x9d, 0x9c, 0x90, 0xda, 0x22, 0x37, 0xdf, 0x7d, 0xff, 0xfd,
-	0xb1, 0x76, 0x0d, 0x4f, 0xd1, 0x92, 0x45, 0x59, 0x4b, 0x49, 0x9d, 0x24, 0xbe, 0xf8, 0x0b, 0x82,
-	0x7a, 0x2e, 0x8b, 0xc7, 0x18, 0x3c, 0x1a, 0xf2, 0x63, 0x0c, 0x16, 0xc4, 0x9c, 0x2c, 0x68, 0x83,
-	0xb3, 0xf8, 0x46, 0x99, 0x41, 0x96, 0x92, 0xb4, 0x41, 0xba, 0x9d, 0xe4, 0x63, 0x07, 0xff, 0x44,
-	0xd0, 0x28, 0x0e, 0x2d, 0x5e, 0xac, 0x74, 0x50, 0xb9, 0x34, 0xf6, 0x9d, 0xff, 0xe2, 0x9a, 0x41,
-	0x96, 0xf5, 0x20, 0xf7, 0xf1, 0x3d, 0x5a, 0xfd, 0x97, 0x74, 0x64, 0x87, 0xe8, 0x76, 0x6e, 0x53,
-	0x77, 0xde, 0xd7, 0x10, 0xfe, 0x8a, 0x60, 0xb4, 0x3f, 0xe9, 0xb8, 0x55, 0x69, 0xad, 0x70, 0xab,
-	0xec, 0x85, 0x7f, 0xe2, 0x98, 0x31, 0xa8, 0x1e, 0x63, 0x06, 0x5f, 0x2f, 0x1b, 0xe3, 0xd0, 0xa2,
-	0xe1, 0x4f, 0x08, 0x46, 0x0e, 0xd6, 0x01, 0xcf, 0x56, 0x07, 0xe0, 0xd0, 0x3e, 0xd9, 0xce, 0x49,
-	0xe1, 0xc6, 0xdd, 0x8c, 0x76, 0x37, 0x89, 0xaf, 0x94, 0xa6, 0x25, 0xa3, 0x3c, 0xbc, 0xf5, 0x6d,
-	0xaf, 0x89, 0x76, 0xf7, 0x9a, 0xe8, 0xd7, 0x5e, 0x13, 0x7d, 0xd8, 0x6f, 0x0e, 0xec, 0xee, 0x37,
-	0x07, 0x7e, 0xec, 0x37, 0x07, 0x5e, 0x8f, 0xa7, 0x5c, 0xe9, 0xbe, 0x71, 0x3c, 0x41, 0xdf, 0x1e,
-	0x68, 0xa8, 0xad, 0x90, 0xcb, 0xd5, 0x61, 0xfd, 0xc9, 0x58, 0xf8, 0x1b, 0x00, 0x00, 0xff, 0xff,
-	0x28, 0x77, 0x58, 0xe1, 0xb4, 0x06, 0x00, 0x00,
+	// 680 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x41, 0x4f, 0xd4, 0x40,
+	0x14, 0xc7, 0x99, 0x05, 0x11, 0xde, 0x1a, 0xd4, 0x49, 0x5c, 0x6a, 0xc5, 0x8a, 0x05, 0x15, 0x14,
+	0x5a, 0x28, 0x91, 0x18, 0x8c, 0x06, 0xe5, 0x22, 0x46, 0x89, 0xae, 0xd1, 0x83, 0x97, 0xcd, 0x40,
+	0x27, 0x4b, 0xe3, 0x6e, 0xa7, 0x74, 0xa6, 0x44, 0x42, 0xb8, 0x78, 0xf2, 0x68, 0x62, 0xbc, 0x7a,
+	0xf3, 0x62, 0xe2, 0xd5, 0xcf, 0xe0, 0x91, 0xc4, 0x8b, 0x07, 0x0f, 0x06, 0xfc, 0x20, 0xa6, 0xd3,
+	0xe9, 0xa6, 0xcb, 0x6e, 0x2b, 0x6b, 0xe2, 0x6d, 0xdb, 0x79, 0xff, 0xf7, 0x7e, 0x6f, 0xfa, 0xfe,
+	0x6f, 0xc1, 0xdc, 0x60, 0xbc, 0xc9, 0xb8, 0x1d, 0x05, 0xf5, 0x90, 0xb8, 0xd4, 0xde, 0x9e, 0x5f,
+	0xa7, 0x82, 0xcc, 0xdb, 0x5b, 0x11, 0x0d, 0x77, 0xac, 0x20, 0x64, 0x82, 0xe1, 0x4a, 0x12, 0x63,
+	0xa9, 0x18, 0x4b, 0xc5, 0xe8, 0x63, 0x75, 0xc6, 0xea, 0x0d, 0x6a, 0x93, 0xc0, 0xb3, 0x89, 0xef,
+	0x33, 0x41, 0x84, 0xc7, 0x7c, 0x9e, 0xa8, 0xf4, 0xc9, 0x9c, 0xcc, 0x69, 0x16, 0x19, 0x65, 0x9e,
+	0x87, 0xd1, 0xa7, 0x71, 0xa9, 0x95, 0x28, 0x0c, 0xa9, 0x2f, 0x9e, 0x34, 0x88, 0x5f, 0xa5, 0x5b,
+	0x11, 0xe5, 0xc2, 0x7c, 0x04, 0x5a, 0xe7, 0x11, 0x0f, 0x98, 0xcf, 0x29, 0x9e, 0x83, 0x81, 0xa0,
+	0x41, 0x7c, 0x0d, 0x8d, 0xa3, 0xa9, 0xb2, 0x33, 0x66, 0x75, 0x27, 0xb4, 0xa4, 0x46, 0x46, 0x9a,
+	0xb3, 0xaa, 0xd0, 0xbd, 0x20, 0x68, 0x78, 0xd4, 0xcd, 0x14, 0xc2, 0x18, 0x06, 0x7c, 0xd2, 0xa4,
+	0x32, 0xd9, 0x70, 0x55, 0xfe, 0x36, 0x1d, 0xd0, 0x3a, 0xc3, 0x55, 0xf1, 0x0a, 0x0c, 0x6e, 0x52,
+	0xaf, 0xbe, 0x29, 0xa4, 0xa2, 0xbf, 0xaa, 0x9e, 0xcc, 0x55, 0x30, 0xa5, 0xe6, 0x79, 0x42, 0xe1,
+	0xae, 0xc4, 0xd1, 0x3e, 0x8f, 0xf8, 0x33, 0x41, 0x04, 0x4d, 0xab, 0x5d, 0x82, 0x72, 0x83, 0x70,
+	0x51, 0x6b, 0x4b, 0x01, 0xf1, 0xab, 0x07, 0xf2, 0xcd, 0x52, 0x49, 0x43, 0xa6, 0x07, 0x13, 0x85,
+	0xa9, 0x14, 0xc9, 0x2d, 0xd0, 0x54, 0xcb, 0x6e, 0x6d, 0x23, 0x0d, 0xa9, 0xf1, 0x38, 0x46, 0x2b,
+	0x8d, 0xa3, 0xa9, 0x53, 0xd5, 0x4a, 0xd4, 0x35, 0x43, 0x5c, 0xe4, 0xe1, 0xc0, 0x10, 0x3a, 0x53,
+	0x32, 0xef, 0x80, 0x2e, 0x4b, 0x3d, 0x66, 0x6e, 0xd4, 0xa0, 0x2f, 0x68, 0xc8, 0xe3, 0x8f, 0x98,
+	0xa1, 0x6d, 0xca, 0x83, 0x5a, 0xe6, 0x8a, 0x20, 0x79, 0xb5, 0x16, 0x5f, 0x54, 0x13, 0x2e, 0x74,
+	0x95, 0x2b, 0xc2, 0x35, 0x38, 0xad, 0xf4, 0xdb, 0xea, 0x48, 0x43, 0xe3, 0xfd, 0x53, 0x65, 0xe7,
+	0x4a, 0xde, 0x37, 0x6b, 0x4b, 0x54, 0x1d, 0x69, 0xb6, 0xe5, 0x35, 0x97, 0xe1, 0x62, 0x32, 0x14,
+	0x69, 0x3b, 0x3d, 0x03, 0x07, 0x60, 0xe4, 0x65, 0xf8, 0x4f, 0xcc, 0xa3, 0x70, 0x2e, 0x99, 0xa5,
+	0x48, 0x6c, 0xb2, 0xd0, 0x13, 0x3b, 0xe9, 0x84, 0x3b, 0x50, 0x39, 0x7a, 0xa0, 0x10, 0x34, 0x38,
+	0x49, 0x5c, 0x37, 0xa4, 0x9c, 0xab, 0x0e, 0xd2, 0x47, 0xe7, 0xcb, 0x10, 0x9c, 0x90, 0x22, 0xfc,
+	0x11, 0x41, 0x39, 0xe3, 0x0d, 0x6c, 0xe7, 0xd1, 0xe5, 0x18, 0x4c, 0x9f, 0x3b, 0xbe, 0x20, 0xc1,
+	0x32, 0x67, 0xde, 0x7c, 0xff, 0xfd, 0xbe, 0x74, 0x15, 0x4f, 0xda, 0x39, 0xe6, 0xde, 0x48, 0x44,
+	0xb5, 0xd8, 0x72, 0xf8, 0x13, 0x82, 0x72, 0xc6, 0x3f, 0x7f, 0x01, 0xec, 0x34, 0xa6, 0x3e, 0x77,
+	0x7c, 0x81, 0x02, 0x5c, 0x90, 0x80, 0xb3, 0xf8, 0x46, 0x1e, 0x20, 0x49, 0x44, 0x12, 0xd0, 0xde,
+	0x8d, 0x47, 0x64, 0x0f, 0xff, 0x44, 0x50, 0xe9, 0x6e, 0x34, 0xbc, 0x54, 0x48, 0x50, 0x68, 0x74,
+	0xfd, 0xf6, 0x3f, 0x69, 0x55, 0x23, 0xab, 0xb2, 0x91, 0x65, 0x7c, 0xd7, 0x2e, 0x5e, 0xa3, 0x1d,
+	0xbe, 0xb7, 0x77, 0x33, 0xdb, 0x65, 0xef, 0x6d, 0x09, 0xe1, 0xcf, 0x08, 0x46, 0xda, 0xdd, 0x89,
+	0x9d, 0x42, 0xb4, 0xae, 0x9b, 0x40, 0x5f, 0xe8, 0x49, 0xa3, 0xda, 0xb0, 0x65, 0x1b, 0xd3, 0xf8,
+	0x5a, 0x5e, 0x1b, 0x47, 0x8c, 0x86, 0xbf, 0x22, 0x38, 0xdb, 0xe1, 0x4c, 0x7c, 0xb3, 0x78, 0x52,
+	0x73, 0x76, 0x81, 0xbe, 0xd8, 0xab, 0x4c, 0x51, 0x3b, 0x92, 0x7a, 0x06, 0x5f, 0xcf, 0x1d, 0xf3,
+	0xd6, 0x9d, 0xb7, 0xc0, 0x3f, 0x20, 0x18, 0x6e, 0xf9, 0x18, 0xcf, 0x16, 0x4f, 0xee, 0x91, 0x45,
+	0xa0, 0x5b, 0xc7, 0x0d, 0x57, 0x80, 0xd3, 0x12, 0x70, 0x02, 0x5f, 0xce, 0x1d, 0xf3, 0x54, 0x72,
+	0x7f, 0xf1, 0xdb, 0x81, 0x81, 0xf6, 0x0f, 0x0c, 0xf4, 0xeb, 0xc0, 0x40, 0xef, 0x0e, 0x8d, 0xbe,
+	0xfd, 0x43, 0xa3, 0xef, 0xc7, 0xa1, 0xd1, 0xf7, 0x72, 0x2c, 0xd1, 0x72, 0xf7, 0x95, 0xe5, 0x31,
+	0xfb, 0x75, 0x2b, 0x87, 0xd8, 0x09, 0x28, 0x5f, 0x1f, 0x94, 0xff, 0xcf, 0x0b, 0x7f, 0x06, 0x00,
+	0xf5, 0x03, 0x48, 0x5b, 0x21, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -580,6 +681,10 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.43
 	ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error)
+	// ConsensusVersions queries the consensus versions of the modules of the
+	// running binary, which are ahead of the module versions from state when the
+	// binary has been upgraded but the upgrade has not been applied yet.
+	ConsensusVersions(ctx context.Context, in *QueryConsensusVersionsRequest, opts ...grpc.CallOption) (*QueryConsensusVersionsResponse, error)
 	// Returns the account with authority to conduct upgrades
 	//
 	// Since: cosmos-sdk 0.46
@@ -631,6 +736,15 @@ func (c *queryClient) ModuleVersions(ctx context.Context, in *QueryModuleVersion
 	return out, nil
 }
 
+func (c *queryClient) ConsensusVersions(ctx context.Context, in *QueryConsensusVersionsRequest, opts ...grpc.CallOption) (*QueryConsensusVersionsResponse, error) {
+	out := new(QueryConsensusVersionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/ConsensusVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Authority(ctx context.Context, in *QueryAuthorityRequest, opts ...grpc.CallOption) (*QueryAuthorityResponse, error) {
 	out := new(QueryAuthorityResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/Authority", in, out, opts...)
@@ -657,6 +771,10 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.43
 	ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error)
+	// ConsensusVersions queries the consensus versions of the modules of the
+	// running binary, which are ahead of the module versions from state when the
+	// binary has been upgraded but the upgrade has not been applied yet.
+	ConsensusVersions(context.Context, *QueryConsensusVersionsRequest) (*QueryConsensusVersionsResponse, error)
 	// Returns the account with authority to conduct upgrades
 	//
 	// Since: cosmos-sdk 0.46
@@ -679,6 +797,9 @@ func (*UnimplementedQueryServer) UpgradedConsensusState(ctx context.Context, req
 func (*UnimplementedQueryServer) ModuleVersions(ctx context.Context, req *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModuleVersions not implemented")
 }
+func (*UnimplementedQueryServer) ConsensusVersions(ctx context.Context, req *QueryConsensusVersionsRequest) (*QueryConsensusVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsensusVersions not implemented")
+}
 func (*UnimplementedQueryServer) Authority(ctx context.Context, req *QueryAuthorityRequest) (*QueryAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authority not implemented")
 }
@@ -759,6 +880,24 @@ func _Query_ModuleVersions_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ConsensusVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsensusVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConsensusVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/ConsensusVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConsensusVersions(ctx, req.(*QueryConsensusVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Authority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAuthorityRequest)
 	if err := dec(in); err != nil {
@@ -797,6 +936,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ModuleVersions",
 			Handler:    _Query_ModuleVersions_Handler,
 		},
+		{
+			MethodName: "ConsensusVersions",
+			Handler:    _Query_ConsensusVersions_Handler,
+		},
 		{
 			MethodName: "Authority",
 			Handler:    _Query_Authority_Handler,
@@ -1047,6 +1190,73 @@ func (m *QueryModuleVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryConsensusVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsensusVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsensusVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsensusVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsensusVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsensusVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModuleVersions) > 0 {
+		for iNdEx := len(m.ModuleVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ModuleVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryAuthorityRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1211,6 +1421,34 @@ func (m *QueryModuleVersionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryConsensusVersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsensusVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ModuleVersions) > 0 {
+		for _, e := range m.ModuleVersions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryAuthorityRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1845,6 +2083,172 @@ func (m *QueryModuleVersionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryConsensusVersionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsensusVersionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsensusVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsensusVersionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsensusVersionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsensusVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleVersions = append(m.ModuleVersions, &ModuleVersion{})
+			if err := m.ModuleVersions[len(m.ModuleVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryAuthorityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0